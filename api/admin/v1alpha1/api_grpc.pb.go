@@ -0,0 +1,1039 @@
+//
+//Chunk Explorer, a platform for hosting and discovering Minecraft servers.
+//Copyright (C) 2025 Yannic Rieger <oss@76k.io>
+//
+//This program is free software; you can redistribute it and/or
+//modify it under the terms of the GNU Lesser General Public
+//License as published by the Free Software Foundation; either
+//version 3 of the License, or (at your option) any later version.
+//
+//This program is distributed in the hope that it will be useful,
+//but WITHOUT ANY WARRANTY; without even the implied warranty of
+//MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//Lesser General Public License for more details.
+//
+//You should have received a copy of the GNU Lesser General Public License
+//along with this program; if not, write to the Free Software Foundation,
+//Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301, USA.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: admin/v1alpha1/api.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_GetMaintenanceMode_FullMethodName               = "/admin.v1alpha1.AdminService/GetMaintenanceMode"
+	AdminService_SetMaintenanceMode_FullMethodName               = "/admin.v1alpha1.AdminService/SetMaintenanceMode"
+	AdminService_SetDesiredPlatformdVersion_FullMethodName       = "/admin.v1alpha1.AdminService/SetDesiredPlatformdVersion"
+	AdminService_ListPlatformdRollouts_FullMethodName            = "/admin.v1alpha1.AdminService/ListPlatformdRollouts"
+	AdminService_ListNodeConditions_FullMethodName               = "/admin.v1alpha1.AdminService/ListNodeConditions"
+	AdminService_ListNodeEvents_FullMethodName                   = "/admin.v1alpha1.AdminService/ListNodeEvents"
+	AdminService_ListNodeCapacities_FullMethodName               = "/admin.v1alpha1.AdminService/ListNodeCapacities"
+	AdminService_ListCrossInstanceTrafficRequests_FullMethodName = "/admin.v1alpha1.AdminService/ListCrossInstanceTrafficRequests"
+	AdminService_ReviewCrossInstanceTraffic_FullMethodName       = "/admin.v1alpha1.AdminService/ReviewCrossInstanceTraffic"
+	AdminService_CreateChunkTemplate_FullMethodName              = "/admin.v1alpha1.AdminService/CreateChunkTemplate"
+	AdminService_UpdateChunkTemplate_FullMethodName              = "/admin.v1alpha1.AdminService/UpdateChunkTemplate"
+	AdminService_DeleteChunkTemplate_FullMethodName              = "/admin.v1alpha1.AdminService/DeleteChunkTemplate"
+	AdminService_GetCostReport_FullMethodName                    = "/admin.v1alpha1.AdminService/GetCostReport"
+	AdminService_GetRuntimeSettings_FullMethodName               = "/admin.v1alpha1.AdminService/GetRuntimeSettings"
+	AdminService_SetLogLevels_FullMethodName                     = "/admin.v1alpha1.AdminService/SetLogLevels"
+	AdminService_SetFeatureFlag_FullMethodName                   = "/admin.v1alpha1.AdminService/SetFeatureFlag"
+	AdminService_ListRuntimeSettingChanges_FullMethodName        = "/admin.v1alpha1.AdminService/ListRuntimeSettingChanges"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService is used by operators of the platform.
+type AdminServiceClient interface {
+	// GetMaintenanceMode returns whether the platform is in maintenance mode.
+	GetMaintenanceMode(ctx context.Context, in *GetMaintenanceModeRequest, opts ...grpc.CallOption) (*GetMaintenanceModeResponse, error)
+	// SetMaintenanceMode enables or disables the maintenance mode. While enabled,
+	// no new instances can be run and no flavor versions can be built. Nodes
+	// are still able to report the status of their instances and remove them,
+	// so the platform can be safely maintained, e.g. for database maintenance.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*SetMaintenanceModeResponse, error)
+	// SetDesiredPlatformdVersion rolls out the given platformd version to the
+	// nodes. If no node ids are passed, it is rolled out to all nodes. Nodes
+	// download, verify and install the version themselves, without stopping
+	// the instances running on them, and report their progress, which can be
+	// queried using ListPlatformdRollouts.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - one of the nodes does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - no platformd release url has been configured
+	SetDesiredPlatformdVersion(ctx context.Context, in *SetDesiredPlatformdVersionRequest, opts ...grpc.CallOption) (*SetDesiredPlatformdVersionResponse, error)
+	// ListPlatformdRollouts returns the platformd version and the status of
+	// the rollout of the desired version for every node.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListPlatformdRollouts(ctx context.Context, in *ListPlatformdRolloutsRequest, opts ...grpc.CallOption) (*ListPlatformdRolloutsResponse, error)
+	// ListNodeConditions returns the problems currently reported by nodes,
+	// like disk pressure or an unresponsive container runtime. No instances
+	// are scheduled to nodes that report at least one condition.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListNodeConditions(ctx context.Context, in *ListNodeConditionsRequest, opts ...grpc.CallOption) (*ListNodeConditionsResponse, error)
+	// ListNodeEvents returns the most recent events raised when nodes started
+	// or stopped reporting a condition, newest first.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListNodeEvents(ctx context.Context, in *ListNodeEventsRequest, opts ...grpc.CallOption) (*ListNodeEventsResponse, error)
+	// ListNodeCapacities returns the capacity of every node as seen by the
+	// scheduler, e.g. to find out why instances are stuck in PENDING. Only
+	// healthy nodes with available slots are considered when scheduling.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListNodeCapacities(ctx context.Context, in *ListNodeCapacitiesRequest, opts ...grpc.CallOption) (*ListNodeCapacitiesResponse, error)
+	// ListCrossInstanceTrafficRequests returns the cross-instance traffic
+	// requests of chunks that are waiting to be reviewed, oldest first.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListCrossInstanceTrafficRequests(ctx context.Context, in *ListCrossInstanceTrafficRequestsRequest, opts ...grpc.CallOption) (*ListCrossInstanceTrafficRequestsResponse, error)
+	// ReviewCrossInstanceTraffic approves or rejects the cross-instance traffic
+	// request of a chunk. Previously reviewed requests can be reviewed again,
+	// e.g. to revoke an approval. Only instances created afterward are affected.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - the chunk has not requested cross-instance traffic
+	ReviewCrossInstanceTraffic(ctx context.Context, in *ReviewCrossInstanceTrafficRequest, opts ...grpc.CallOption) (*ReviewCrossInstanceTrafficResponse, error)
+	// CreateChunkTemplate adds a template users can create their chunk from.
+	// The files are taken from the given flavor version, which has to be built.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - the flavor version does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the flavor version has not been built
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another template
+	CreateChunkTemplate(ctx context.Context, in *CreateChunkTemplateRequest, opts ...grpc.CallOption) (*CreateChunkTemplateResponse, error)
+	// UpdateChunkTemplate replaces the description and flavor version of the
+	// template. Chunks that have been created from it are not affected.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - the template or the flavor version does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the flavor version has not been built
+	UpdateChunkTemplate(ctx context.Context, in *UpdateChunkTemplateRequest, opts ...grpc.CallOption) (*UpdateChunkTemplateResponse, error)
+	// DeleteChunkTemplate removes the template. Chunks that have been created
+	// from it are not affected.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - the template does not exist
+	DeleteChunkTemplate(ctx context.Context, in *DeleteChunkTemplateRequest, opts ...grpc.CallOption) (*DeleteChunkTemplateResponse, error)
+	// GetCostReport returns what running the instances of each chunk and
+	// the nodes has cost between start and end. The window defaults like
+	// for GetChunkStats. Chunks are sorted by their total cost.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - INVALID_ARGUMENT:
+	//   - start is not before end
+	GetCostReport(ctx context.Context, in *GetCostReportRequest, opts ...grpc.CallOption) (*GetCostReportResponse, error)
+	// GetRuntimeSettings returns the log levels and feature flags that can
+	// be changed while the platform is running.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	GetRuntimeSettings(ctx context.Context, in *GetRuntimeSettingsRequest, opts ...grpc.CallOption) (*GetRuntimeSettingsResponse, error)
+	// SetLogLevels changes the log levels of all control plane replicas or
+	// of platformd on all nodes, without restarting them. Replicas apply them
+	// once their cached settings expire, nodes with their next discovery. Empty
+	// levels restore the levels passed on startup.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - INVALID_ARGUMENT:
+	//   - the log levels are not in the format of the log-level flag
+	SetLogLevels(ctx context.Context, in *SetLogLevelsRequest, opts ...grpc.CallOption) (*SetLogLevelsResponse, error)
+	// SetFeatureFlag enables or disables a feature of the control plane or
+	// platformd, without restarting them. Features are enabled by default.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - INVALID_ARGUMENT:
+	//   - the feature flag does not exist
+	SetFeatureFlag(ctx context.Context, in *SetFeatureFlagRequest, opts ...grpc.CallOption) (*SetFeatureFlagResponse, error)
+	// ListRuntimeSettingChanges returns the latest changes made to the
+	// runtime settings, newest first.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListRuntimeSettingChanges(ctx context.Context, in *ListRuntimeSettingChangesRequest, opts ...grpc.CallOption) (*ListRuntimeSettingChangesResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) GetMaintenanceMode(ctx context.Context, in *GetMaintenanceModeRequest, opts ...grpc.CallOption) (*GetMaintenanceModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetMaintenanceMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*SetMaintenanceModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetMaintenanceMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDesiredPlatformdVersion(ctx context.Context, in *SetDesiredPlatformdVersionRequest, opts ...grpc.CallOption) (*SetDesiredPlatformdVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDesiredPlatformdVersionResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetDesiredPlatformdVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPlatformdRollouts(ctx context.Context, in *ListPlatformdRolloutsRequest, opts ...grpc.CallOption) (*ListPlatformdRolloutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlatformdRolloutsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPlatformdRollouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNodeConditions(ctx context.Context, in *ListNodeConditionsRequest, opts ...grpc.CallOption) (*ListNodeConditionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNodeConditionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListNodeConditions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNodeEvents(ctx context.Context, in *ListNodeEventsRequest, opts ...grpc.CallOption) (*ListNodeEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNodeEventsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListNodeEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNodeCapacities(ctx context.Context, in *ListNodeCapacitiesRequest, opts ...grpc.CallOption) (*ListNodeCapacitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNodeCapacitiesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListNodeCapacities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListCrossInstanceTrafficRequests(ctx context.Context, in *ListCrossInstanceTrafficRequestsRequest, opts ...grpc.CallOption) (*ListCrossInstanceTrafficRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCrossInstanceTrafficRequestsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListCrossInstanceTrafficRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReviewCrossInstanceTraffic(ctx context.Context, in *ReviewCrossInstanceTrafficRequest, opts ...grpc.CallOption) (*ReviewCrossInstanceTrafficResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewCrossInstanceTrafficResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReviewCrossInstanceTraffic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateChunkTemplate(ctx context.Context, in *CreateChunkTemplateRequest, opts ...grpc.CallOption) (*CreateChunkTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChunkTemplateResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateChunkTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateChunkTemplate(ctx context.Context, in *UpdateChunkTemplateRequest, opts ...grpc.CallOption) (*UpdateChunkTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChunkTemplateResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateChunkTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteChunkTemplate(ctx context.Context, in *DeleteChunkTemplateRequest, opts ...grpc.CallOption) (*DeleteChunkTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChunkTemplateResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteChunkTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetCostReport(ctx context.Context, in *GetCostReportRequest, opts ...grpc.CallOption) (*GetCostReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCostReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetCostReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetRuntimeSettings(ctx context.Context, in *GetRuntimeSettingsRequest, opts ...grpc.CallOption) (*GetRuntimeSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRuntimeSettingsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetRuntimeSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetLogLevels(ctx context.Context, in *SetLogLevelsRequest, opts ...grpc.CallOption) (*SetLogLevelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLogLevelsResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetLogLevels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetFeatureFlag(ctx context.Context, in *SetFeatureFlagRequest, opts ...grpc.CallOption) (*SetFeatureFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFeatureFlagResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetFeatureFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListRuntimeSettingChanges(ctx context.Context, in *ListRuntimeSettingChangesRequest, opts ...grpc.CallOption) (*ListRuntimeSettingChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRuntimeSettingChangesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListRuntimeSettingChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService is used by operators of the platform.
+type AdminServiceServer interface {
+	// GetMaintenanceMode returns whether the platform is in maintenance mode.
+	GetMaintenanceMode(context.Context, *GetMaintenanceModeRequest) (*GetMaintenanceModeResponse, error)
+	// SetMaintenanceMode enables or disables the maintenance mode. While enabled,
+	// no new instances can be run and no flavor versions can be built. Nodes
+	// are still able to report the status of their instances and remove them,
+	// so the platform can be safely maintained, e.g. for database maintenance.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*SetMaintenanceModeResponse, error)
+	// SetDesiredPlatformdVersion rolls out the given platformd version to the
+	// nodes. If no node ids are passed, it is rolled out to all nodes. Nodes
+	// download, verify and install the version themselves, without stopping
+	// the instances running on them, and report their progress, which can be
+	// queried using ListPlatformdRollouts.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - one of the nodes does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - no platformd release url has been configured
+	SetDesiredPlatformdVersion(context.Context, *SetDesiredPlatformdVersionRequest) (*SetDesiredPlatformdVersionResponse, error)
+	// ListPlatformdRollouts returns the platformd version and the status of
+	// the rollout of the desired version for every node.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListPlatformdRollouts(context.Context, *ListPlatformdRolloutsRequest) (*ListPlatformdRolloutsResponse, error)
+	// ListNodeConditions returns the problems currently reported by nodes,
+	// like disk pressure or an unresponsive container runtime. No instances
+	// are scheduled to nodes that report at least one condition.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListNodeConditions(context.Context, *ListNodeConditionsRequest) (*ListNodeConditionsResponse, error)
+	// ListNodeEvents returns the most recent events raised when nodes started
+	// or stopped reporting a condition, newest first.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListNodeEvents(context.Context, *ListNodeEventsRequest) (*ListNodeEventsResponse, error)
+	// ListNodeCapacities returns the capacity of every node as seen by the
+	// scheduler, e.g. to find out why instances are stuck in PENDING. Only
+	// healthy nodes with available slots are considered when scheduling.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListNodeCapacities(context.Context, *ListNodeCapacitiesRequest) (*ListNodeCapacitiesResponse, error)
+	// ListCrossInstanceTrafficRequests returns the cross-instance traffic
+	// requests of chunks that are waiting to be reviewed, oldest first.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListCrossInstanceTrafficRequests(context.Context, *ListCrossInstanceTrafficRequestsRequest) (*ListCrossInstanceTrafficRequestsResponse, error)
+	// ReviewCrossInstanceTraffic approves or rejects the cross-instance traffic
+	// request of a chunk. Previously reviewed requests can be reviewed again,
+	// e.g. to revoke an approval. Only instances created afterward are affected.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - the chunk has not requested cross-instance traffic
+	ReviewCrossInstanceTraffic(context.Context, *ReviewCrossInstanceTrafficRequest) (*ReviewCrossInstanceTrafficResponse, error)
+	// CreateChunkTemplate adds a template users can create their chunk from.
+	// The files are taken from the given flavor version, which has to be built.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - the flavor version does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the flavor version has not been built
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another template
+	CreateChunkTemplate(context.Context, *CreateChunkTemplateRequest) (*CreateChunkTemplateResponse, error)
+	// UpdateChunkTemplate replaces the description and flavor version of the
+	// template. Chunks that have been created from it are not affected.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - the template or the flavor version does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the flavor version has not been built
+	UpdateChunkTemplate(context.Context, *UpdateChunkTemplateRequest) (*UpdateChunkTemplateResponse, error)
+	// DeleteChunkTemplate removes the template. Chunks that have been created
+	// from it are not affected.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - the template does not exist
+	DeleteChunkTemplate(context.Context, *DeleteChunkTemplateRequest) (*DeleteChunkTemplateResponse, error)
+	// GetCostReport returns what running the instances of each chunk and
+	// the nodes has cost between start and end. The window defaults like
+	// for GetChunkStats. Chunks are sorted by their total cost.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - INVALID_ARGUMENT:
+	//   - start is not before end
+	GetCostReport(context.Context, *GetCostReportRequest) (*GetCostReportResponse, error)
+	// GetRuntimeSettings returns the log levels and feature flags that can
+	// be changed while the platform is running.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	GetRuntimeSettings(context.Context, *GetRuntimeSettingsRequest) (*GetRuntimeSettingsResponse, error)
+	// SetLogLevels changes the log levels of all control plane replicas or
+	// of platformd on all nodes, without restarting them. Replicas apply them
+	// once their cached settings expire, nodes with their next discovery. Empty
+	// levels restore the levels passed on startup.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - INVALID_ARGUMENT:
+	//   - the log levels are not in the format of the log-level flag
+	SetLogLevels(context.Context, *SetLogLevelsRequest) (*SetLogLevelsResponse, error)
+	// SetFeatureFlag enables or disables a feature of the control plane or
+	// platformd, without restarting them. Features are enabled by default.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - INVALID_ARGUMENT:
+	//   - the feature flag does not exist
+	SetFeatureFlag(context.Context, *SetFeatureFlagRequest) (*SetFeatureFlagResponse, error)
+	// ListRuntimeSettingChanges returns the latest changes made to the
+	// runtime settings, newest first.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ListRuntimeSettingChanges(context.Context, *ListRuntimeSettingChangesRequest) (*ListRuntimeSettingChangesResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) GetMaintenanceMode(context.Context, *GetMaintenanceModeRequest) (*GetMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceMode not implemented")
+}
+func (UnimplementedAdminServiceServer) SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*SetMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedAdminServiceServer) SetDesiredPlatformdVersion(context.Context, *SetDesiredPlatformdVersionRequest) (*SetDesiredPlatformdVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDesiredPlatformdVersion not implemented")
+}
+func (UnimplementedAdminServiceServer) ListPlatformdRollouts(context.Context, *ListPlatformdRolloutsRequest) (*ListPlatformdRolloutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlatformdRollouts not implemented")
+}
+func (UnimplementedAdminServiceServer) ListNodeConditions(context.Context, *ListNodeConditionsRequest) (*ListNodeConditionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeConditions not implemented")
+}
+func (UnimplementedAdminServiceServer) ListNodeEvents(context.Context, *ListNodeEventsRequest) (*ListNodeEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeEvents not implemented")
+}
+func (UnimplementedAdminServiceServer) ListNodeCapacities(context.Context, *ListNodeCapacitiesRequest) (*ListNodeCapacitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeCapacities not implemented")
+}
+func (UnimplementedAdminServiceServer) ListCrossInstanceTrafficRequests(context.Context, *ListCrossInstanceTrafficRequestsRequest) (*ListCrossInstanceTrafficRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCrossInstanceTrafficRequests not implemented")
+}
+func (UnimplementedAdminServiceServer) ReviewCrossInstanceTraffic(context.Context, *ReviewCrossInstanceTrafficRequest) (*ReviewCrossInstanceTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewCrossInstanceTraffic not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateChunkTemplate(context.Context, *CreateChunkTemplateRequest) (*CreateChunkTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChunkTemplate not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateChunkTemplate(context.Context, *UpdateChunkTemplateRequest) (*UpdateChunkTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChunkTemplate not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteChunkTemplate(context.Context, *DeleteChunkTemplateRequest) (*DeleteChunkTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChunkTemplate not implemented")
+}
+func (UnimplementedAdminServiceServer) GetCostReport(context.Context, *GetCostReportRequest) (*GetCostReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCostReport not implemented")
+}
+func (UnimplementedAdminServiceServer) GetRuntimeSettings(context.Context, *GetRuntimeSettingsRequest) (*GetRuntimeSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuntimeSettings not implemented")
+}
+func (UnimplementedAdminServiceServer) SetLogLevels(context.Context, *SetLogLevelsRequest) (*SetLogLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevels not implemented")
+}
+func (UnimplementedAdminServiceServer) SetFeatureFlag(context.Context, *SetFeatureFlagRequest) (*SetFeatureFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeatureFlag not implemented")
+}
+func (UnimplementedAdminServiceServer) ListRuntimeSettingChanges(context.Context, *ListRuntimeSettingChangesRequest) (*ListRuntimeSettingChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuntimeSettingChanges not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_GetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetMaintenanceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetMaintenanceMode(ctx, req.(*GetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetMaintenanceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetDesiredPlatformdVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDesiredPlatformdVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetDesiredPlatformdVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetDesiredPlatformdVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetDesiredPlatformdVersion(ctx, req.(*SetDesiredPlatformdVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPlatformdRollouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlatformdRolloutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPlatformdRollouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPlatformdRollouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPlatformdRollouts(ctx, req.(*ListPlatformdRolloutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNodeConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeConditionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNodeConditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListNodeConditions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNodeConditions(ctx, req.(*ListNodeConditionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNodeEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNodeEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListNodeEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNodeEvents(ctx, req.(*ListNodeEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNodeCapacities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeCapacitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNodeCapacities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListNodeCapacities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNodeCapacities(ctx, req.(*ListNodeCapacitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListCrossInstanceTrafficRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCrossInstanceTrafficRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListCrossInstanceTrafficRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListCrossInstanceTrafficRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListCrossInstanceTrafficRequests(ctx, req.(*ListCrossInstanceTrafficRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReviewCrossInstanceTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCrossInstanceTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReviewCrossInstanceTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReviewCrossInstanceTraffic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReviewCrossInstanceTraffic(ctx, req.(*ReviewCrossInstanceTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateChunkTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChunkTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateChunkTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateChunkTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateChunkTemplate(ctx, req.(*CreateChunkTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateChunkTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChunkTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateChunkTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateChunkTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateChunkTemplate(ctx, req.(*UpdateChunkTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteChunkTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChunkTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteChunkTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteChunkTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteChunkTemplate(ctx, req.(*DeleteChunkTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetCostReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCostReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetCostReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetCostReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetCostReport(ctx, req.(*GetCostReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetRuntimeSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuntimeSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetRuntimeSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetRuntimeSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetRuntimeSettings(ctx, req.(*GetRuntimeSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetLogLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetLogLevels(ctx, req.(*SetLogLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetFeatureFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetFeatureFlag(ctx, req.(*SetFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListRuntimeSettingChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuntimeSettingChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListRuntimeSettingChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListRuntimeSettingChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListRuntimeSettingChanges(ctx, req.(*ListRuntimeSettingChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.v1alpha1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMaintenanceMode",
+			Handler:    _AdminService_GetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _AdminService_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "SetDesiredPlatformdVersion",
+			Handler:    _AdminService_SetDesiredPlatformdVersion_Handler,
+		},
+		{
+			MethodName: "ListPlatformdRollouts",
+			Handler:    _AdminService_ListPlatformdRollouts_Handler,
+		},
+		{
+			MethodName: "ListNodeConditions",
+			Handler:    _AdminService_ListNodeConditions_Handler,
+		},
+		{
+			MethodName: "ListNodeEvents",
+			Handler:    _AdminService_ListNodeEvents_Handler,
+		},
+		{
+			MethodName: "ListNodeCapacities",
+			Handler:    _AdminService_ListNodeCapacities_Handler,
+		},
+		{
+			MethodName: "ListCrossInstanceTrafficRequests",
+			Handler:    _AdminService_ListCrossInstanceTrafficRequests_Handler,
+		},
+		{
+			MethodName: "ReviewCrossInstanceTraffic",
+			Handler:    _AdminService_ReviewCrossInstanceTraffic_Handler,
+		},
+		{
+			MethodName: "CreateChunkTemplate",
+			Handler:    _AdminService_CreateChunkTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateChunkTemplate",
+			Handler:    _AdminService_UpdateChunkTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteChunkTemplate",
+			Handler:    _AdminService_DeleteChunkTemplate_Handler,
+		},
+		{
+			MethodName: "GetCostReport",
+			Handler:    _AdminService_GetCostReport_Handler,
+		},
+		{
+			MethodName: "GetRuntimeSettings",
+			Handler:    _AdminService_GetRuntimeSettings_Handler,
+		},
+		{
+			MethodName: "SetLogLevels",
+			Handler:    _AdminService_SetLogLevels_Handler,
+		},
+		{
+			MethodName: "SetFeatureFlag",
+			Handler:    _AdminService_SetFeatureFlag_Handler,
+		},
+		{
+			MethodName: "ListRuntimeSettingChanges",
+			Handler:    _AdminService_ListRuntimeSettingChanges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin/v1alpha1/api.proto",
+}
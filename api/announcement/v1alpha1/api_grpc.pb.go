@@ -0,0 +1,257 @@
+//
+//Chunk Explorer, a platform for hosting and discovering Minecraft servers.
+//Copyright (C) 2025 Yannic Rieger <oss@76k.io>
+//
+//This program is free software; you can redistribute it and/or
+//modify it under the terms of the GNU Lesser General Public
+//License as published by the Free Software Foundation; either
+//version 3 of the License, or (at your option) any later version.
+//
+//This program is distributed in the hope that it will be useful,
+//but WITHOUT ANY WARRANTY; without even the implied warranty of
+//MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//Lesser General Public License for more details.
+//
+//You should have received a copy of the GNU Lesser General Public License
+//along with this program; if not, write to the Free Software Foundation,
+//Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301, USA.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: announcement/v1alpha1/api.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AnnouncementService_ListAnnouncements_FullMethodName  = "/announcement.v1alpha1.AnnouncementService/ListAnnouncements"
+	AnnouncementService_CreateAnnouncement_FullMethodName = "/announcement.v1alpha1.AnnouncementService/CreateAnnouncement"
+	AnnouncementService_DeleteAnnouncement_FullMethodName = "/announcement.v1alpha1.AnnouncementService/DeleteAnnouncement"
+)
+
+// AnnouncementServiceClient is the client API for AnnouncementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AnnouncementServiceClient interface {
+	// ListAnnouncements returns all announcements that are currently shown,
+	// ordered by their severity starting with the most severe one. This
+	// endpoint does not require authentication.
+	ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error)
+	// CreateAnnouncement publishes a new announcement. Only admins are
+	// allowed to create announcements.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - INVALID_ARGUMENT:
+	//   - ends_at is not after starts_at
+	CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*CreateAnnouncementResponse, error)
+	// DeleteAnnouncement removes an announcement, regardless of whether it
+	// is currently shown. Only admins are allowed to delete announcements.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - the announcement does not exist
+	DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementRequest, opts ...grpc.CallOption) (*DeleteAnnouncementResponse, error)
+}
+
+type announcementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnnouncementServiceClient(cc grpc.ClientConnInterface) AnnouncementServiceClient {
+	return &announcementServiceClient{cc}
+}
+
+func (c *announcementServiceClient) ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, AnnouncementService_ListAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementServiceClient) CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*CreateAnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAnnouncementResponse)
+	err := c.cc.Invoke(ctx, AnnouncementService_CreateAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementServiceClient) DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementRequest, opts ...grpc.CallOption) (*DeleteAnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAnnouncementResponse)
+	err := c.cc.Invoke(ctx, AnnouncementService_DeleteAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnnouncementServiceServer is the server API for AnnouncementService service.
+// All implementations must embed UnimplementedAnnouncementServiceServer
+// for forward compatibility.
+type AnnouncementServiceServer interface {
+	// ListAnnouncements returns all announcements that are currently shown,
+	// ordered by their severity starting with the most severe one. This
+	// endpoint does not require authentication.
+	ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error)
+	// CreateAnnouncement publishes a new announcement. Only admins are
+	// allowed to create announcements.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - INVALID_ARGUMENT:
+	//   - ends_at is not after starts_at
+	CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*CreateAnnouncementResponse, error)
+	// DeleteAnnouncement removes an announcement, regardless of whether it
+	// is currently shown. Only admins are allowed to delete announcements.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	//
+	// - NOT_FOUND:
+	//   - the announcement does not exist
+	DeleteAnnouncement(context.Context, *DeleteAnnouncementRequest) (*DeleteAnnouncementResponse, error)
+	mustEmbedUnimplementedAnnouncementServiceServer()
+}
+
+// UnimplementedAnnouncementServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnnouncementServiceServer struct{}
+
+func (UnimplementedAnnouncementServiceServer) ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnouncements not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*CreateAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnouncement not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) DeleteAnnouncement(context.Context, *DeleteAnnouncementRequest) (*DeleteAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnnouncement not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) mustEmbedUnimplementedAnnouncementServiceServer() {}
+func (UnimplementedAnnouncementServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeAnnouncementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnnouncementServiceServer will
+// result in compilation errors.
+type UnsafeAnnouncementServiceServer interface {
+	mustEmbedUnimplementedAnnouncementServiceServer()
+}
+
+func RegisterAnnouncementServiceServer(s grpc.ServiceRegistrar, srv AnnouncementServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnnouncementServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnnouncementService_ServiceDesc, srv)
+}
+
+func _AnnouncementService_ListAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).ListAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_ListAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).ListAnnouncements(ctx, req.(*ListAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementService_CreateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).CreateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_CreateAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).CreateAnnouncement(ctx, req.(*CreateAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementService_DeleteAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).DeleteAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_DeleteAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).DeleteAnnouncement(ctx, req.(*DeleteAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnnouncementService_ServiceDesc is the grpc.ServiceDesc for AnnouncementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnnouncementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "announcement.v1alpha1.AnnouncementService",
+	HandlerType: (*AnnouncementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAnnouncements",
+			Handler:    _AnnouncementService_ListAnnouncements_Handler,
+		},
+		{
+			MethodName: "CreateAnnouncement",
+			Handler:    _AnnouncementService_CreateAnnouncement_Handler,
+		},
+		{
+			MethodName: "DeleteAnnouncement",
+			Handler:    _AnnouncementService_DeleteAnnouncement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "announcement/v1alpha1/api.proto",
+}
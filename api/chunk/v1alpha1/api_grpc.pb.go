@@ -40,16 +40,55 @@ const (
 	ChunkService_CreateChunk_FullMethodName                   = "/chunk.v1alpha1.ChunkService/CreateChunk"
 	ChunkService_GetChunk_FullMethodName                      = "/chunk.v1alpha1.ChunkService/GetChunk"
 	ChunkService_UpdateChunk_FullMethodName                   = "/chunk.v1alpha1.ChunkService/UpdateChunk"
+	ChunkService_RenameChunk_FullMethodName                   = "/chunk.v1alpha1.ChunkService/RenameChunk"
+	ChunkService_GetChunkByName_FullMethodName                = "/chunk.v1alpha1.ChunkService/GetChunkByName"
+	ChunkService_CheckChunkName_FullMethodName                = "/chunk.v1alpha1.ChunkService/CheckChunkName"
 	ChunkService_ListChunks_FullMethodName                    = "/chunk.v1alpha1.ChunkService/ListChunks"
 	ChunkService_CreateFlavor_FullMethodName                  = "/chunk.v1alpha1.ChunkService/CreateFlavor"
 	ChunkService_CreateFlavorVersion_FullMethodName           = "/chunk.v1alpha1.ChunkService/CreateFlavorVersion"
 	ChunkService_BuildFlavorVersion_FullMethodName            = "/chunk.v1alpha1.ChunkService/BuildFlavorVersion"
 	ChunkService_GetUploadURL_FullMethodName                  = "/chunk.v1alpha1.ChunkService/GetUploadURL"
 	ChunkService_GetSupportedMinecraftVersions_FullMethodName = "/chunk.v1alpha1.ChunkService/GetSupportedMinecraftVersions"
+	ChunkService_GetMinecraftVersion_FullMethodName           = "/chunk.v1alpha1.ChunkService/GetMinecraftVersion"
 	ChunkService_UploadThumbnail_FullMethodName               = "/chunk.v1alpha1.ChunkService/UploadThumbnail"
 	ChunkService_DeleteFlavor_FullMethodName                  = "/chunk.v1alpha1.ChunkService/DeleteFlavor"
 	ChunkService_DeleteChunk_FullMethodName                   = "/chunk.v1alpha1.ChunkService/DeleteChunk"
 	ChunkService_GetFlavor_FullMethodName                     = "/chunk.v1alpha1.ChunkService/GetFlavor"
+	ChunkService_PrecheckFlavorVersion_FullMethodName         = "/chunk.v1alpha1.ChunkService/PrecheckFlavorVersion"
+	ChunkService_PatchFlavorVersion_FullMethodName            = "/chunk.v1alpha1.ChunkService/PatchFlavorVersion"
+	ChunkService_GetFlavorVersionFile_FullMethodName          = "/chunk.v1alpha1.ChunkService/GetFlavorVersionFile"
+	ChunkService_GetFlavorVersion_FullMethodName              = "/chunk.v1alpha1.ChunkService/GetFlavorVersion"
+	ChunkService_CreateAsset_FullMethodName                   = "/chunk.v1alpha1.ChunkService/CreateAsset"
+	ChunkService_StartCanary_FullMethodName                   = "/chunk.v1alpha1.ChunkService/StartCanary"
+	ChunkService_GetCanary_FullMethodName                     = "/chunk.v1alpha1.ChunkService/GetCanary"
+	ChunkService_PromoteCanary_FullMethodName                 = "/chunk.v1alpha1.ChunkService/PromoteCanary"
+	ChunkService_AbortCanary_FullMethodName                   = "/chunk.v1alpha1.ChunkService/AbortCanary"
+	ChunkService_PromoteVersion_FullMethodName                = "/chunk.v1alpha1.ChunkService/PromoteVersion"
+	ChunkService_ListFlavorChannels_FullMethodName            = "/chunk.v1alpha1.ChunkService/ListFlavorChannels"
+	ChunkService_SetRetentionPolicy_FullMethodName            = "/chunk.v1alpha1.ChunkService/SetRetentionPolicy"
+	ChunkService_GetRetentionPolicy_FullMethodName            = "/chunk.v1alpha1.ChunkService/GetRetentionPolicy"
+	ChunkService_SetAutoscalingPolicy_FullMethodName          = "/chunk.v1alpha1.ChunkService/SetAutoscalingPolicy"
+	ChunkService_GetAutoscalingPolicy_FullMethodName          = "/chunk.v1alpha1.ChunkService/GetAutoscalingPolicy"
+	ChunkService_DeleteAutoscalingPolicy_FullMethodName       = "/chunk.v1alpha1.ChunkService/DeleteAutoscalingPolicy"
+	ChunkService_SetChunkReadme_FullMethodName                = "/chunk.v1alpha1.ChunkService/SetChunkReadme"
+	ChunkService_GetChunkReadme_FullMethodName                = "/chunk.v1alpha1.ChunkService/GetChunkReadme"
+	ChunkService_CreateChunkMedia_FullMethodName              = "/chunk.v1alpha1.ChunkService/CreateChunkMedia"
+	ChunkService_ListChunkMedia_FullMethodName                = "/chunk.v1alpha1.ChunkService/ListChunkMedia"
+	ChunkService_DeleteChunkMedia_FullMethodName              = "/chunk.v1alpha1.ChunkService/DeleteChunkMedia"
+	ChunkService_ImportChunk_FullMethodName                   = "/chunk.v1alpha1.ChunkService/ImportChunk"
+	ChunkService_StartChunkImport_FullMethodName              = "/chunk.v1alpha1.ChunkService/StartChunkImport"
+	ChunkService_GetChunkImport_FullMethodName                = "/chunk.v1alpha1.ChunkService/GetChunkImport"
+	ChunkService_ListChunkTemplates_FullMethodName            = "/chunk.v1alpha1.ChunkService/ListChunkTemplates"
+	ChunkService_InstantiateChunkTemplate_FullMethodName      = "/chunk.v1alpha1.ChunkService/InstantiateChunkTemplate"
+	ChunkService_RequestCrossInstanceTraffic_FullMethodName   = "/chunk.v1alpha1.ChunkService/RequestCrossInstanceTraffic"
+	ChunkService_GetCrossInstanceTraffic_FullMethodName       = "/chunk.v1alpha1.ChunkService/GetCrossInstanceTraffic"
+	ChunkService_WithdrawCrossInstanceTraffic_FullMethodName  = "/chunk.v1alpha1.ChunkService/WithdrawCrossInstanceTraffic"
+	ChunkService_CreateSchedule_FullMethodName                = "/chunk.v1alpha1.ChunkService/CreateSchedule"
+	ChunkService_ListSchedules_FullMethodName                 = "/chunk.v1alpha1.ChunkService/ListSchedules"
+	ChunkService_DeleteSchedule_FullMethodName                = "/chunk.v1alpha1.ChunkService/DeleteSchedule"
+	ChunkService_PreviewNextRuns_FullMethodName               = "/chunk.v1alpha1.ChunkService/PreviewNextRuns"
+	ChunkService_GetChunkStats_FullMethodName                 = "/chunk.v1alpha1.ChunkService/GetChunkStats"
+	ChunkService_ApplyChunkSpec_FullMethodName                = "/chunk.v1alpha1.ChunkService/ApplyChunkSpec"
 )
 
 // ChunkServiceClient is the client API for ChunkService service.
@@ -74,6 +113,9 @@ type ChunkServiceClient interface {
 	//     the tag.
 	//   - name exceeds the maximum amount of allowed chars
 	//   - description exceeds the maximum amount of allowed chars.
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another chunk
 	CreateChunk(ctx context.Context, in *CreateChunkRequest, opts ...grpc.CallOption) (*CreateChunkResponse, error)
 	// GetChunk returns the chunk specified by the provided id.
 	//
@@ -86,7 +128,7 @@ type ChunkServiceClient interface {
 	GetChunk(ctx context.Context, in *GetChunkRequest, opts ...grpc.CallOption) (*GetChunkResponse, error)
 	// UpdateChunk updates the fields of the given chunk.
 	// Note that tags will be completely replaced with the
-	// provided ones.
+	// provided ones. Changing the name behaves like RenameChunk.
 	//
 	// Defined error codes:
 	// - NOT_FOUND:
@@ -103,7 +145,40 @@ type ChunkServiceClient interface {
 	//     the tag.
 	//   - name exceeds the maximum amount of allowed chars
 	//   - description exceeds the maximum amount of allowed chars.
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another chunk
 	UpdateChunk(ctx context.Context, in *UpdateChunkRequest, opts ...grpc.CallOption) (*UpdateChunkResponse, error)
+	// RenameChunk changes the name of a chunk. The previous name stays reserved
+	// for the chunk, so looking up the chunk by its previous name, for example
+	// when joining it through a proxy, still works. Only the owner of the chunk
+	// is allowed to rename it.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - name is invalid, see CreateChunk
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another chunk
+	RenameChunk(ctx context.Context, in *RenameChunkRequest, opts ...grpc.CallOption) (*RenameChunkResponse, error)
+	// GetChunkByName returns the chunk using the given name. Previous names
+	// of renamed chunks resolve to the chunk as well, clients can compare the
+	// name of the returned chunk to detect this and redirect to the new name.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - no chunk uses the name
+	GetChunkByName(ctx context.Context, in *GetChunkByNameRequest, opts ...grpc.CallOption) (*GetChunkByNameResponse, error)
+	// CheckChunkName tells whether a name can be used for a new chunk, see
+	// ChunkNameStatus. This allows clients to fail before uploading anything.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - name is invalid, see CreateChunk
+	CheckChunkName(ctx context.Context, in *CheckChunkNameRequest, opts ...grpc.CallOption) (*CheckChunkNameResponse, error)
 	// ListChunks lists all available Chunks.
 	ListChunks(ctx context.Context, in *ListChunksRequest, opts ...grpc.CallOption) (*ListChunksResponse, error)
 	// CreateFlavor creates a new flavor for a given chunk.
@@ -140,6 +215,14 @@ type ChunkServiceClient interface {
 	//
 	// - FAILED_PRECONDITION:
 	//   - the provided version hash does not match with the provided file hashes
+	//   - a referenced asset does not exist
+	//   - the files exceed the build limits according to their reported sizes.
+	//     each exceeded limit is described by a PreconditionFailure detail.
+	//   - the java version is not available for the minecraft version
+	//
+	// - ABORTED:
+	//   - another version of the flavor has been created concurrently. the
+	//     changed files have to be determined again by retrying the request.
 	CreateFlavorVersion(ctx context.Context, in *CreateFlavorVersionRequest, opts ...grpc.CallOption) (*CreateFlavorVersionResponse, error)
 	// BuildFlavorVersion will initiate the process for building a checkpoint image.
 	// there are multiple steps involved. Calling this endpoint multiple times will
@@ -149,11 +232,19 @@ type ChunkServiceClient interface {
 	// Defined error codes:
 	// - FAILED_PRECONDITION:
 	//   - the flavor version files have not been uploaded yet.
+	//   - a referenced asset has not been uploaded yet.
+	//   - the flavor version exceeds the build limits. see PrecheckFlavorVersion.
+	//
+	// - ABORTED:
+	//   - a build of the flavor version is being initiated by a concurrent call.
+	//     the error details contain an ErrorInfo with the reason ALREADY_BUILDING.
 	BuildFlavorVersion(ctx context.Context, in *BuildFlavorVersionRequest, opts ...grpc.CallOption) (*BuildFlavorVersionResponse, error)
-	// GetUploadURL returns a presigned URL for use with a S3 client. If the expiry date
-	// is reached the client can call this endpoint again and will receive a new valid
-	// URL. Calling this endpoint multiple without the expiry date being reached will
-	// lead to the same URL being returned.
+	// GetUploadURL returns a presigned URL the tarball can be uploaded to using a
+	// PUT request. Depending on the storage backend configured in the control plane,
+	// the client has to send additional headers along with the request. If the expiry
+	// date is reached the client can call this endpoint again and will receive a new
+	// valid URL. Calling this endpoint multiple without the expiry date being reached
+	// will lead to the same URL being returned.
 	//
 	// Defined error codes:
 	// - NOT_FOUND:
@@ -169,6 +260,14 @@ type ChunkServiceClient interface {
 	//     documentation of GetUploadURLRequest
 	GetUploadURL(ctx context.Context, in *GetUploadURLRequest, opts ...grpc.CallOption) (*GetUploadURLResponse, error)
 	GetSupportedMinecraftVersions(ctx context.Context, in *GetSupportedMinecraftVersionsRequest, opts ...grpc.CallOption) (*GetSupportedMinecraftVersionsResponse, error)
+	// GetMinecraftVersion returns the given supported minecraft version, including the
+	// image flavor versions running it are based on. this allows building flavor images
+	// locally, the same way the platform does.
+	//
+	// Defined error codes:
+	// - FAILED_PRECONDITION:
+	//   - the minecraft version is not supported
+	GetMinecraftVersion(ctx context.Context, in *GetMinecraftVersionRequest, opts ...grpc.CallOption) (*GetMinecraftVersionResponse, error)
 	// UploadThumbnail uploads the given PNG image. Formats other than PNG are not supported.
 	//
 	// Defined error codes:
@@ -209,6 +308,377 @@ type ChunkServiceClient interface {
 	// - NOT_FOUND:
 	//   - the targeted flavor does not exist
 	GetFlavor(ctx context.Context, in *GetFlavorRequest, opts ...grpc.CallOption) (*GetFlavorResponse, error)
+	// PrecheckFlavorVersion checks whether a flavor version with the given properties
+	// would be accepted by the build process, so clients are able to reject a flavor
+	// version before uploading any files. Builds of flavor versions exceeding the
+	// limits are rejected, and the reason is reported by the build_error field of
+	// the flavor version.
+	PrecheckFlavorVersion(ctx context.Context, in *PrecheckFlavorVersionRequest, opts ...grpc.CallOption) (*PrecheckFlavorVersionResponse, error)
+	// PatchFlavorVersion is an alternative to uploading a tarball using GetUploadURL,
+	// meant for flavor versions that only change a few small files. Instead of a
+	// tarball, the changed files are uploaded individually using the returned URLs
+	// and the control plane assembles the flavor version from them and the files
+	// of previous versions. Files that are already known to the control plane are
+	// not returned and do not need to be uploaded. The content of all files is
+	// verified against the flavor version hash when building the flavor version.
+	// Calling this endpoint again replaces the previously requested patch.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the targeted flavor version does not exist
+	//
+	// - ALREADY_EXISTS:
+	//   - the files of the flavor version have already been uploaded
+	//
+	// - INVALID_ARGUMENT:
+	//   - the patch contains too many files or its files are too big. use
+	//     GetUploadURL instead.
+	//   - a file is not part of the flavor version or its hash does not match.
+	//
+	// - FAILED_PRECONDITION:
+	//   - a file exceeds the maximum file size of the build limits. each file is
+	//     described by a PreconditionFailure detail.
+	PatchFlavorVersion(ctx context.Context, in *PatchFlavorVersionRequest, opts ...grpc.CallOption) (*PatchFlavorVersionResponse, error)
+	// GetFlavorVersionFile returns the URL the content of a file of a flavor
+	// version can be downloaded from. Only the owner of the chunk is allowed
+	// to download files. Files are available once the flavor version has
+	// been built.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor version does not exist
+	//   - the flavor version does not contain a file with the given path
+	//
+	// - FAILED_PRECONDITION:
+	//   - the content of the file is not available, because the flavor version
+	//     has not been built yet.
+	GetFlavorVersionFile(ctx context.Context, in *GetFlavorVersionFileRequest, opts ...grpc.CallOption) (*GetFlavorVersionFileResponse, error)
+	// GetFlavorVersion returns a single flavor version together with its build
+	// provenance, which records what exactly the flavor version has been built
+	// from, so it can be traced back long after it has been published. Only the
+	// owner of the chunk is allowed to get the flavor version this way.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor version does not exist
+	GetFlavorVersion(ctx context.Context, in *GetFlavorVersionRequest, opts ...grpc.CallOption) (*GetFlavorVersionResponse, error)
+	// CreateAsset registers an asset bundle, like a resource pack, that can be shared
+	// between flavor versions. Asset bundles are gzip compressed tarballs containing
+	// files relative to the server root, and are identified by their hash. If the
+	// bundle has not been uploaded yet, a presigned URL is returned it has to be
+	// uploaded to using a PUT request. Bundles are only stored once, so creating an
+	// asset that has already been uploaded returns no URL. Flavor versions reference
+	// assets by their hash, see CreateFlavorVersionRequest.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the hash is not a hex encoded sha256 digest
+	//   - the bundle exceeds the maximum allowed size
+	CreateAsset(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error)
+	// StartCanary marks a flavor version as canary of another version of the same
+	// flavor, see Canary. Only the owner of the chunk is allowed to start canaries.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the versions are not different versions of the same flavor
+	//
+	// - NOT_FOUND:
+	//   - one of the flavor versions does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - one of the flavor versions has not been built successfully
+	//
+	// - ALREADY_EXISTS:
+	//   - the flavor already has an active canary
+	StartCanary(ctx context.Context, in *StartCanaryRequest, opts ...grpc.CallOption) (*StartCanaryResponse, error)
+	// GetCanary returns the most recent canary of a flavor, including stats
+	// comparing the instances launched for the stable and canary version.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor has no canary
+	GetCanary(ctx context.Context, in *GetCanaryRequest, opts ...grpc.CallOption) (*GetCanaryResponse, error)
+	// PromoteCanary ends the active canary of a flavor. Afterward, instances
+	// launched for the stable version run the canary version.
+	//
+	// Defined error codes:
+	// - FAILED_PRECONDITION:
+	//   - the flavor has no active canary
+	PromoteCanary(ctx context.Context, in *PromoteCanaryRequest, opts ...grpc.CallOption) (*PromoteCanaryResponse, error)
+	// AbortCanary ends the active canary of a flavor. Afterward, instances
+	// launched for the canary version run the stable version.
+	//
+	// Defined error codes:
+	// - FAILED_PRECONDITION:
+	//   - the flavor has no active canary
+	AbortCanary(ctx context.Context, in *AbortCanaryRequest, opts ...grpc.CallOption) (*AbortCanaryResponse, error)
+	// PromoteVersion publishes a flavor version to a channel of its flavor, see
+	// FlavorChannel. The version previously published to the channel is replaced.
+	// Only the owner of the chunk is allowed to promote versions.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor version does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the flavor version has not been built successfully
+	PromoteVersion(ctx context.Context, in *PromoteVersionRequest, opts ...grpc.CallOption) (*PromoteVersionResponse, error)
+	// ListFlavorChannels returns the channels of a flavor together with the
+	// versions published to them.
+	ListFlavorChannels(ctx context.Context, in *ListFlavorChannelsRequest, opts ...grpc.CallOption) (*ListFlavorChannelsResponse, error)
+	// SetRetentionPolicy replaces the retention policy of a chunk, see
+	// RetentionPolicy. Flavor versions are not removed right away, but
+	// by a job running periodically. Only the owner of the chunk is
+	// allowed to set its retention policy.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error)
+	// GetRetentionPolicy returns the retention policy of a chunk. If no
+	// policy has been set, a policy with all rules disabled is returned.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*GetRetentionPolicyResponse, error)
+	// SetAutoscalingPolicy replaces the autoscaling policy of a flavor, see
+	// AutoscalingPolicy. Instances are not started or stopped right away, but
+	// by the autoscaler running periodically. Only the owner of the chunk is
+	// allowed to set the policy.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - max_instances is lower than min_instances or exceeds the limit
+	//     configured by the platform
+	SetAutoscalingPolicy(ctx context.Context, in *SetAutoscalingPolicyRequest, opts ...grpc.CallOption) (*SetAutoscalingPolicyResponse, error)
+	// GetAutoscalingPolicy returns the autoscaling policy of a flavor.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor does not exist or is not autoscaled
+	GetAutoscalingPolicy(ctx context.Context, in *GetAutoscalingPolicyRequest, opts ...grpc.CallOption) (*GetAutoscalingPolicyResponse, error)
+	// DeleteAutoscalingPolicy stops autoscaling the flavor. Instances started
+	// by the autoscaler keep running until they are stopped by the owner.
+	DeleteAutoscalingPolicy(ctx context.Context, in *DeleteAutoscalingPolicyRequest, opts ...grpc.CallOption) (*DeleteAutoscalingPolicyResponse, error)
+	// SetChunkReadme replaces the markdown description shown on the page of a
+	// chunk, see ChunkReadme. Only the owner of the chunk is allowed to set it.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - the readme exceeds 64KiB
+	SetChunkReadme(ctx context.Context, in *SetChunkReadmeRequest, opts ...grpc.CallOption) (*SetChunkReadmeResponse, error)
+	// GetChunkReadme returns the readme of a chunk. If no readme
+	// has been set, an empty readme is returned.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	GetChunkReadme(ctx context.Context, in *GetChunkReadmeRequest, opts ...grpc.CallOption) (*GetChunkReadmeResponse, error)
+	// CreateChunkMedia adds an image to the page of a chunk and returns the
+	// presigned URL the image has to be uploaded to using a PUT request. Adding
+	// an image the chunk already has, returns the existing media and no URL if
+	// the image has already been uploaded. Only the owner of the chunk is
+	// allowed to add images.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - the hash is not a hex encoded sha256 digest
+	//   - the content type is not one of image/png, image/jpeg or image/webp
+	//   - the image exceeds the maximum allowed size
+	//
+	// - RESOURCE_EXHAUSTED:
+	//   - the chunk already has the maximum number of images
+	CreateChunkMedia(ctx context.Context, in *CreateChunkMediaRequest, opts ...grpc.CallOption) (*CreateChunkMediaResponse, error)
+	// ListChunkMedia returns the images of a chunk in the order they have been
+	// added, including the URLs they can be downloaded from. Images that have
+	// not been uploaded yet are omitted.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	ListChunkMedia(ctx context.Context, in *ListChunkMediaRequest, opts ...grpc.CallOption) (*ListChunkMediaResponse, error)
+	// DeleteChunkMedia removes an image from the page of a chunk. Only the
+	// owner of the chunk is allowed to remove images.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the media or the chunk does not exist
+	DeleteChunkMedia(ctx context.Context, in *DeleteChunkMediaRequest, opts ...grpc.CallOption) (*DeleteChunkMediaResponse, error)
+	// ImportChunk creates a chunk with a single flavor from an archive of an
+	// existing Paper server, so creators do not have to use the CLI. The chunk
+	// and flavor are created right away and the archive, either a zip file or
+	// a gzip compressed tarball, has to be uploaded to the returned URL using a
+	// PUT request. Afterward, StartChunkImport has to be called.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the chunk or flavor name or the version is invalid, see CreateChunk,
+	//     CreateFlavor and CreateFlavorVersion.
+	//   - the archive exceeds the maximum allowed size
+	//
+	// - FAILED_PRECONDITION:
+	//   - the minecraft version is not supported
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another chunk
+	ImportChunk(ctx context.Context, in *ImportChunkRequest, opts ...grpc.CallOption) (*ImportChunkResponse, error)
+	// StartChunkImport unpacks the uploaded archive in the background. Files that
+	// are generated by the server at runtime, like caches, logs and the server jar,
+	// are excluded the same way the CLI does. Once unpacked, the flavor version is
+	// created and built. The progress can be retrieved using GetChunkImport.
+	// Calling this endpoint again has no effect once the import has been started.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the import does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the archive has not been uploaded yet
+	StartChunkImport(ctx context.Context, in *StartChunkImportRequest, opts ...grpc.CallOption) (*StartChunkImportResponse, error)
+	// GetChunkImport returns the import. Only the owner of the chunk is allowed
+	// to retrieve it.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the import does not exist
+	GetChunkImport(ctx context.Context, in *GetChunkImportRequest, opts ...grpc.CallOption) (*GetChunkImportResponse, error)
+	// ListChunkTemplates returns the templates chunks can be created from,
+	// ordered by their name. Templates are managed by admins.
+	ListChunkTemplates(ctx context.Context, in *ListChunkTemplatesRequest, opts ...grpc.CallOption) (*ListChunkTemplatesResponse, error)
+	// InstantiateChunkTemplate creates a chunk with a single flavor from the
+	// template. The first version of the flavor contains the files of the
+	// template, which do not have to be uploaded, and is built right away.
+	// The files can be downloaded using GetFlavorVersionFile.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the chunk name is invalid, see CreateChunk
+	//
+	// - NOT_FOUND:
+	//   - the template does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the flavor version of the template has not been built
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another chunk
+	InstantiateChunkTemplate(ctx context.Context, in *InstantiateChunkTemplateRequest, opts ...grpc.CallOption) (*InstantiateChunkTemplateResponse, error)
+	// RequestCrossInstanceTraffic asks for the instances of a chunk to be allowed
+	// to connect to other instances on the same node, see CrossInstanceTraffic.
+	// Requesting it again replaces the reason and requires another approval.
+	// Only the owner of the chunk is allowed to request it.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	RequestCrossInstanceTraffic(ctx context.Context, in *RequestCrossInstanceTrafficRequest, opts ...grpc.CallOption) (*RequestCrossInstanceTrafficResponse, error)
+	// GetCrossInstanceTraffic returns the cross-instance traffic request of a
+	// chunk. If nothing has been requested, the state is TRAFFIC_NOT_REQUESTED.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	GetCrossInstanceTraffic(ctx context.Context, in *GetCrossInstanceTrafficRequest, opts ...grpc.CallOption) (*GetCrossInstanceTrafficResponse, error)
+	// WithdrawCrossInstanceTraffic removes the request of a chunk, regardless of
+	// its state. Instances created afterward are isolated again.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	//   - the chunk has not requested cross-instance traffic
+	WithdrawCrossInstanceTraffic(ctx context.Context, in *WithdrawCrossInstanceTrafficRequest, opts ...grpc.CallOption) (*WithdrawCrossInstanceTrafficResponse, error)
+	// CreateSchedule adds a schedule that starts and stops instances of a
+	// flavor version of the chunk, see InstanceSchedule. Only runs after the
+	// schedule has been created are performed. Only the owner of the chunk is
+	// allowed to create schedules.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk or the flavor version does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the flavor version has not been built
+	//
+	// - INVALID_ARGUMENT:
+	//   - one of the cron expressions or the time zone is invalid
+	//
+	// - RESOURCE_EXHAUSTED:
+	//   - the chunk has reached the maximum number of schedules
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	// ListSchedules returns the schedules of a chunk.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	// DeleteSchedule removes a schedule. The instance it has started, if
+	// any, keeps running until it is stopped by the owner.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the schedule does not exist
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	// PreviewNextRuns returns the next times a schedule with the given cron
+	// expressions and time zone would start or stop its instance. It can be
+	// used to check the expressions before creating the schedule.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - one of the cron expressions or the time zone is invalid
+	PreviewNextRuns(ctx context.Context, in *PreviewNextRunsRequest, opts ...grpc.CallOption) (*PreviewNextRunsResponse, error)
+	// GetChunkStats returns what running the instances of the chunk has cost
+	// between start and end. If end is not set, it is now. If start is not
+	// set, it is 30 days before end. Only the owner of the chunk is allowed
+	// to get its stats.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - start is not before end
+	GetChunkStats(ctx context.Context, in *GetChunkStatsRequest, opts ...grpc.CallOption) (*GetChunkStatsResponse, error)
+	// ApplyChunkSpec reconciles the chunk to the desired state described by the
+	// spec, see ChunkSpec. The chunk is identified by its name and created if it
+	// does not exist yet. Applying the same spec again does not change anything,
+	// which allows applying it from CI pipelines on every commit. The changes
+	// that have been made, or would be made if dry_run is set, are returned.
+	// Versions referenced by the spec have to be published and built before.
+	// If applying the spec fails midway, applying it again continues where it
+	// stopped.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the chunk name, description or tags are invalid, see CreateChunk
+	//   - a flavor is specified more than once
+	//   - a schedule refers to a flavor that is not part of the spec
+	//   - a referenced version has not been published
+	//   - one of the cron expressions or time zones is invalid
+	//   - max_instances is lower than min_instances or exceeds the limit
+	//     configured by the platform
+	//
+	// - FAILED_PRECONDITION:
+	//   - a referenced version has not been built
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is used by a chunk of another user
+	//
+	// - RESOURCE_EXHAUSTED:
+	//   - the spec contains more schedules than a chunk can have
+	//
+	// - ABORTED:
+	//   - the spec of the chunk is being applied concurrently
+	ApplyChunkSpec(ctx context.Context, in *ApplyChunkSpecRequest, opts ...grpc.CallOption) (*ApplyChunkSpecResponse, error)
 }
 
 type chunkServiceClient struct {
@@ -249,6 +719,36 @@ func (c *chunkServiceClient) UpdateChunk(ctx context.Context, in *UpdateChunkReq
 	return out, nil
 }
 
+func (c *chunkServiceClient) RenameChunk(ctx context.Context, in *RenameChunkRequest, opts ...grpc.CallOption) (*RenameChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameChunkResponse)
+	err := c.cc.Invoke(ctx, ChunkService_RenameChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetChunkByName(ctx context.Context, in *GetChunkByNameRequest, opts ...grpc.CallOption) (*GetChunkByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChunkByNameResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetChunkByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) CheckChunkName(ctx context.Context, in *CheckChunkNameRequest, opts ...grpc.CallOption) (*CheckChunkNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckChunkNameResponse)
+	err := c.cc.Invoke(ctx, ChunkService_CheckChunkName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chunkServiceClient) ListChunks(ctx context.Context, in *ListChunksRequest, opts ...grpc.CallOption) (*ListChunksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListChunksResponse)
@@ -309,6 +809,16 @@ func (c *chunkServiceClient) GetSupportedMinecraftVersions(ctx context.Context,
 	return out, nil
 }
 
+func (c *chunkServiceClient) GetMinecraftVersion(ctx context.Context, in *GetMinecraftVersionRequest, opts ...grpc.CallOption) (*GetMinecraftVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMinecraftVersionResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetMinecraftVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chunkServiceClient) UploadThumbnail(ctx context.Context, in *UploadThumbnailRequest, opts ...grpc.CallOption) (*UploadThumbnailResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadThumbnailResponse)
@@ -349,71 +859,457 @@ func (c *chunkServiceClient) GetFlavor(ctx context.Context, in *GetFlavorRequest
 	return out, nil
 }
 
-// ChunkServiceServer is the server API for ChunkService service.
-// All implementations must embed UnimplementedChunkServiceServer
-// for forward compatibility.
-//
-// ChunkService provides the public api for interacting with Chunks
-// and flavors of Chunks.
-type ChunkServiceServer interface {
-	// CreateChunk creates a new chunk without any flavors.
-	// chunks that do not have any flavors will be deleted
-	// after some time.
-	//
-	// Defined error codes:
-	// - INVALID_ARGUMENT:
-	//   - name is invalid. names cannot start or end with a
-	//     space, underscore or slash. they cannot contain linux
-	//     path operators (.. or ../ or /).
-	//   - too many tags have been provided
-	//   - tag is invalid. "tags can only contain lower-case ascii letters,
-	//     numbers and dashes. dashes cannot be at the start or beginning of
-	//     the tag.
-	//   - name exceeds the maximum amount of allowed chars
-	//   - description exceeds the maximum amount of allowed chars.
-	CreateChunk(context.Context, *CreateChunkRequest) (*CreateChunkResponse, error)
-	// GetChunk returns the chunk specified by the provided id.
-	//
-	// Defined error codes:
-	// - NOT_FOUND:
-	//   - chunk with the provided id does not exist
-	//
-	// - INVALID_ARGUMENT:
-	//   - id is invalid
-	GetChunk(context.Context, *GetChunkRequest) (*GetChunkResponse, error)
-	// UpdateChunk updates the fields of the given chunk.
-	// Note that tags will be completely replaced with the
-	// provided ones.
-	//
-	// Defined error codes:
-	// - NOT_FOUND:
-	//   - chunk with the provided id does not exist or is deleted
-	//
-	// - INVALID_ARGUMENT:
-	//   - chunk id is invalid
-	//   - name is invalid. names cannot start or end with a space,
-	//     underscore or slash. they cannot contain linux path
-	//     operators (.. or ../ or /).
-	//   - too many tags have been provided
-	//   - tag is invalid. "tags can only contain lower-case ascii letters,
-	//     numbers and dashes. dashes cannot be at the start or beginning of
-	//     the tag.
-	//   - name exceeds the maximum amount of allowed chars
-	//   - description exceeds the maximum amount of allowed chars.
-	UpdateChunk(context.Context, *UpdateChunkRequest) (*UpdateChunkResponse, error)
-	// ListChunks lists all available Chunks.
-	ListChunks(context.Context, *ListChunksRequest) (*ListChunksResponse, error)
-	// CreateFlavor creates a new flavor for a given chunk.
-	//
-	// Defined error codes:
-	// - ALREADY_EXISTS:
-	//   - a flavor with the given name already exists for this chunk.
-	//     this error will also be returned if the flavor is currently
-	//     in the process of being deleted.
-	//
-	// - INVALID_ARGUMENT:
-	//   - the provided chunk id is invalid
-	//   - the provided flavor name is invalid. names cannot start or end
+func (c *chunkServiceClient) PrecheckFlavorVersion(ctx context.Context, in *PrecheckFlavorVersionRequest, opts ...grpc.CallOption) (*PrecheckFlavorVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrecheckFlavorVersionResponse)
+	err := c.cc.Invoke(ctx, ChunkService_PrecheckFlavorVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) PatchFlavorVersion(ctx context.Context, in *PatchFlavorVersionRequest, opts ...grpc.CallOption) (*PatchFlavorVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchFlavorVersionResponse)
+	err := c.cc.Invoke(ctx, ChunkService_PatchFlavorVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetFlavorVersionFile(ctx context.Context, in *GetFlavorVersionFileRequest, opts ...grpc.CallOption) (*GetFlavorVersionFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFlavorVersionFileResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetFlavorVersionFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetFlavorVersion(ctx context.Context, in *GetFlavorVersionRequest, opts ...grpc.CallOption) (*GetFlavorVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFlavorVersionResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetFlavorVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) CreateAsset(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAssetResponse)
+	err := c.cc.Invoke(ctx, ChunkService_CreateAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) StartCanary(ctx context.Context, in *StartCanaryRequest, opts ...grpc.CallOption) (*StartCanaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartCanaryResponse)
+	err := c.cc.Invoke(ctx, ChunkService_StartCanary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetCanary(ctx context.Context, in *GetCanaryRequest, opts ...grpc.CallOption) (*GetCanaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCanaryResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetCanary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) PromoteCanary(ctx context.Context, in *PromoteCanaryRequest, opts ...grpc.CallOption) (*PromoteCanaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteCanaryResponse)
+	err := c.cc.Invoke(ctx, ChunkService_PromoteCanary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) AbortCanary(ctx context.Context, in *AbortCanaryRequest, opts ...grpc.CallOption) (*AbortCanaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortCanaryResponse)
+	err := c.cc.Invoke(ctx, ChunkService_AbortCanary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) PromoteVersion(ctx context.Context, in *PromoteVersionRequest, opts ...grpc.CallOption) (*PromoteVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteVersionResponse)
+	err := c.cc.Invoke(ctx, ChunkService_PromoteVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) ListFlavorChannels(ctx context.Context, in *ListFlavorChannelsRequest, opts ...grpc.CallOption) (*ListFlavorChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlavorChannelsResponse)
+	err := c.cc.Invoke(ctx, ChunkService_ListFlavorChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, ChunkService_SetRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*GetRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) SetAutoscalingPolicy(ctx context.Context, in *SetAutoscalingPolicyRequest, opts ...grpc.CallOption) (*SetAutoscalingPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAutoscalingPolicyResponse)
+	err := c.cc.Invoke(ctx, ChunkService_SetAutoscalingPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetAutoscalingPolicy(ctx context.Context, in *GetAutoscalingPolicyRequest, opts ...grpc.CallOption) (*GetAutoscalingPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAutoscalingPolicyResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetAutoscalingPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) DeleteAutoscalingPolicy(ctx context.Context, in *DeleteAutoscalingPolicyRequest, opts ...grpc.CallOption) (*DeleteAutoscalingPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAutoscalingPolicyResponse)
+	err := c.cc.Invoke(ctx, ChunkService_DeleteAutoscalingPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) SetChunkReadme(ctx context.Context, in *SetChunkReadmeRequest, opts ...grpc.CallOption) (*SetChunkReadmeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChunkReadmeResponse)
+	err := c.cc.Invoke(ctx, ChunkService_SetChunkReadme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetChunkReadme(ctx context.Context, in *GetChunkReadmeRequest, opts ...grpc.CallOption) (*GetChunkReadmeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChunkReadmeResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetChunkReadme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) CreateChunkMedia(ctx context.Context, in *CreateChunkMediaRequest, opts ...grpc.CallOption) (*CreateChunkMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChunkMediaResponse)
+	err := c.cc.Invoke(ctx, ChunkService_CreateChunkMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) ListChunkMedia(ctx context.Context, in *ListChunkMediaRequest, opts ...grpc.CallOption) (*ListChunkMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChunkMediaResponse)
+	err := c.cc.Invoke(ctx, ChunkService_ListChunkMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) DeleteChunkMedia(ctx context.Context, in *DeleteChunkMediaRequest, opts ...grpc.CallOption) (*DeleteChunkMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChunkMediaResponse)
+	err := c.cc.Invoke(ctx, ChunkService_DeleteChunkMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) ImportChunk(ctx context.Context, in *ImportChunkRequest, opts ...grpc.CallOption) (*ImportChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportChunkResponse)
+	err := c.cc.Invoke(ctx, ChunkService_ImportChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) StartChunkImport(ctx context.Context, in *StartChunkImportRequest, opts ...grpc.CallOption) (*StartChunkImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartChunkImportResponse)
+	err := c.cc.Invoke(ctx, ChunkService_StartChunkImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetChunkImport(ctx context.Context, in *GetChunkImportRequest, opts ...grpc.CallOption) (*GetChunkImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChunkImportResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetChunkImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) ListChunkTemplates(ctx context.Context, in *ListChunkTemplatesRequest, opts ...grpc.CallOption) (*ListChunkTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChunkTemplatesResponse)
+	err := c.cc.Invoke(ctx, ChunkService_ListChunkTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) InstantiateChunkTemplate(ctx context.Context, in *InstantiateChunkTemplateRequest, opts ...grpc.CallOption) (*InstantiateChunkTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstantiateChunkTemplateResponse)
+	err := c.cc.Invoke(ctx, ChunkService_InstantiateChunkTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) RequestCrossInstanceTraffic(ctx context.Context, in *RequestCrossInstanceTrafficRequest, opts ...grpc.CallOption) (*RequestCrossInstanceTrafficResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestCrossInstanceTrafficResponse)
+	err := c.cc.Invoke(ctx, ChunkService_RequestCrossInstanceTraffic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetCrossInstanceTraffic(ctx context.Context, in *GetCrossInstanceTrafficRequest, opts ...grpc.CallOption) (*GetCrossInstanceTrafficResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCrossInstanceTrafficResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetCrossInstanceTraffic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) WithdrawCrossInstanceTraffic(ctx context.Context, in *WithdrawCrossInstanceTrafficRequest, opts ...grpc.CallOption) (*WithdrawCrossInstanceTrafficResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawCrossInstanceTrafficResponse)
+	err := c.cc.Invoke(ctx, ChunkService_WithdrawCrossInstanceTraffic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, ChunkService_CreateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, ChunkService_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, ChunkService_DeleteSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) PreviewNextRuns(ctx context.Context, in *PreviewNextRunsRequest, opts ...grpc.CallOption) (*PreviewNextRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewNextRunsResponse)
+	err := c.cc.Invoke(ctx, ChunkService_PreviewNextRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) GetChunkStats(ctx context.Context, in *GetChunkStatsRequest, opts ...grpc.CallOption) (*GetChunkStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChunkStatsResponse)
+	err := c.cc.Invoke(ctx, ChunkService_GetChunkStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) ApplyChunkSpec(ctx context.Context, in *ApplyChunkSpecRequest, opts ...grpc.CallOption) (*ApplyChunkSpecResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyChunkSpecResponse)
+	err := c.cc.Invoke(ctx, ChunkService_ApplyChunkSpec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChunkServiceServer is the server API for ChunkService service.
+// All implementations must embed UnimplementedChunkServiceServer
+// for forward compatibility.
+//
+// ChunkService provides the public api for interacting with Chunks
+// and flavors of Chunks.
+type ChunkServiceServer interface {
+	// CreateChunk creates a new chunk without any flavors.
+	// chunks that do not have any flavors will be deleted
+	// after some time.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - name is invalid. names cannot start or end with a
+	//     space, underscore or slash. they cannot contain linux
+	//     path operators (.. or ../ or /).
+	//   - too many tags have been provided
+	//   - tag is invalid. "tags can only contain lower-case ascii letters,
+	//     numbers and dashes. dashes cannot be at the start or beginning of
+	//     the tag.
+	//   - name exceeds the maximum amount of allowed chars
+	//   - description exceeds the maximum amount of allowed chars.
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another chunk
+	CreateChunk(context.Context, *CreateChunkRequest) (*CreateChunkResponse, error)
+	// GetChunk returns the chunk specified by the provided id.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - chunk with the provided id does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - id is invalid
+	GetChunk(context.Context, *GetChunkRequest) (*GetChunkResponse, error)
+	// UpdateChunk updates the fields of the given chunk.
+	// Note that tags will be completely replaced with the
+	// provided ones. Changing the name behaves like RenameChunk.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - chunk with the provided id does not exist or is deleted
+	//
+	// - INVALID_ARGUMENT:
+	//   - chunk id is invalid
+	//   - name is invalid. names cannot start or end with a space,
+	//     underscore or slash. they cannot contain linux path
+	//     operators (.. or ../ or /).
+	//   - too many tags have been provided
+	//   - tag is invalid. "tags can only contain lower-case ascii letters,
+	//     numbers and dashes. dashes cannot be at the start or beginning of
+	//     the tag.
+	//   - name exceeds the maximum amount of allowed chars
+	//   - description exceeds the maximum amount of allowed chars.
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another chunk
+	UpdateChunk(context.Context, *UpdateChunkRequest) (*UpdateChunkResponse, error)
+	// RenameChunk changes the name of a chunk. The previous name stays reserved
+	// for the chunk, so looking up the chunk by its previous name, for example
+	// when joining it through a proxy, still works. Only the owner of the chunk
+	// is allowed to rename it.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - name is invalid, see CreateChunk
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another chunk
+	RenameChunk(context.Context, *RenameChunkRequest) (*RenameChunkResponse, error)
+	// GetChunkByName returns the chunk using the given name. Previous names
+	// of renamed chunks resolve to the chunk as well, clients can compare the
+	// name of the returned chunk to detect this and redirect to the new name.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - no chunk uses the name
+	GetChunkByName(context.Context, *GetChunkByNameRequest) (*GetChunkByNameResponse, error)
+	// CheckChunkName tells whether a name can be used for a new chunk, see
+	// ChunkNameStatus. This allows clients to fail before uploading anything.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - name is invalid, see CreateChunk
+	CheckChunkName(context.Context, *CheckChunkNameRequest) (*CheckChunkNameResponse, error)
+	// ListChunks lists all available Chunks.
+	ListChunks(context.Context, *ListChunksRequest) (*ListChunksResponse, error)
+	// CreateFlavor creates a new flavor for a given chunk.
+	//
+	// Defined error codes:
+	// - ALREADY_EXISTS:
+	//   - a flavor with the given name already exists for this chunk.
+	//     this error will also be returned if the flavor is currently
+	//     in the process of being deleted.
+	//
+	// - INVALID_ARGUMENT:
+	//   - the provided chunk id is invalid
+	//   - the provided flavor name is invalid. names cannot start or end
 	//     with a space, underscore or slash. they cannot contain linux path
 	//     operators (.. or ../ or /).
 	CreateFlavor(context.Context, *CreateFlavorRequest) (*CreateFlavorResponse, error)
@@ -437,6 +1333,14 @@ type ChunkServiceServer interface {
 	//
 	// - FAILED_PRECONDITION:
 	//   - the provided version hash does not match with the provided file hashes
+	//   - a referenced asset does not exist
+	//   - the files exceed the build limits according to their reported sizes.
+	//     each exceeded limit is described by a PreconditionFailure detail.
+	//   - the java version is not available for the minecraft version
+	//
+	// - ABORTED:
+	//   - another version of the flavor has been created concurrently. the
+	//     changed files have to be determined again by retrying the request.
 	CreateFlavorVersion(context.Context, *CreateFlavorVersionRequest) (*CreateFlavorVersionResponse, error)
 	// BuildFlavorVersion will initiate the process for building a checkpoint image.
 	// there are multiple steps involved. Calling this endpoint multiple times will
@@ -446,11 +1350,19 @@ type ChunkServiceServer interface {
 	// Defined error codes:
 	// - FAILED_PRECONDITION:
 	//   - the flavor version files have not been uploaded yet.
+	//   - a referenced asset has not been uploaded yet.
+	//   - the flavor version exceeds the build limits. see PrecheckFlavorVersion.
+	//
+	// - ABORTED:
+	//   - a build of the flavor version is being initiated by a concurrent call.
+	//     the error details contain an ErrorInfo with the reason ALREADY_BUILDING.
 	BuildFlavorVersion(context.Context, *BuildFlavorVersionRequest) (*BuildFlavorVersionResponse, error)
-	// GetUploadURL returns a presigned URL for use with a S3 client. If the expiry date
-	// is reached the client can call this endpoint again and will receive a new valid
-	// URL. Calling this endpoint multiple without the expiry date being reached will
-	// lead to the same URL being returned.
+	// GetUploadURL returns a presigned URL the tarball can be uploaded to using a
+	// PUT request. Depending on the storage backend configured in the control plane,
+	// the client has to send additional headers along with the request. If the expiry
+	// date is reached the client can call this endpoint again and will receive a new
+	// valid URL. Calling this endpoint multiple without the expiry date being reached
+	// will lead to the same URL being returned.
 	//
 	// Defined error codes:
 	// - NOT_FOUND:
@@ -466,6 +1378,14 @@ type ChunkServiceServer interface {
 	//     documentation of GetUploadURLRequest
 	GetUploadURL(context.Context, *GetUploadURLRequest) (*GetUploadURLResponse, error)
 	GetSupportedMinecraftVersions(context.Context, *GetSupportedMinecraftVersionsRequest) (*GetSupportedMinecraftVersionsResponse, error)
+	// GetMinecraftVersion returns the given supported minecraft version, including the
+	// image flavor versions running it are based on. this allows building flavor images
+	// locally, the same way the platform does.
+	//
+	// Defined error codes:
+	// - FAILED_PRECONDITION:
+	//   - the minecraft version is not supported
+	GetMinecraftVersion(context.Context, *GetMinecraftVersionRequest) (*GetMinecraftVersionResponse, error)
 	// UploadThumbnail uploads the given PNG image. Formats other than PNG are not supported.
 	//
 	// Defined error codes:
@@ -506,306 +1426,1496 @@ type ChunkServiceServer interface {
 	// - NOT_FOUND:
 	//   - the targeted flavor does not exist
 	GetFlavor(context.Context, *GetFlavorRequest) (*GetFlavorResponse, error)
+	// PrecheckFlavorVersion checks whether a flavor version with the given properties
+	// would be accepted by the build process, so clients are able to reject a flavor
+	// version before uploading any files. Builds of flavor versions exceeding the
+	// limits are rejected, and the reason is reported by the build_error field of
+	// the flavor version.
+	PrecheckFlavorVersion(context.Context, *PrecheckFlavorVersionRequest) (*PrecheckFlavorVersionResponse, error)
+	// PatchFlavorVersion is an alternative to uploading a tarball using GetUploadURL,
+	// meant for flavor versions that only change a few small files. Instead of a
+	// tarball, the changed files are uploaded individually using the returned URLs
+	// and the control plane assembles the flavor version from them and the files
+	// of previous versions. Files that are already known to the control plane are
+	// not returned and do not need to be uploaded. The content of all files is
+	// verified against the flavor version hash when building the flavor version.
+	// Calling this endpoint again replaces the previously requested patch.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the targeted flavor version does not exist
+	//
+	// - ALREADY_EXISTS:
+	//   - the files of the flavor version have already been uploaded
+	//
+	// - INVALID_ARGUMENT:
+	//   - the patch contains too many files or its files are too big. use
+	//     GetUploadURL instead.
+	//   - a file is not part of the flavor version or its hash does not match.
+	//
+	// - FAILED_PRECONDITION:
+	//   - a file exceeds the maximum file size of the build limits. each file is
+	//     described by a PreconditionFailure detail.
+	PatchFlavorVersion(context.Context, *PatchFlavorVersionRequest) (*PatchFlavorVersionResponse, error)
+	// GetFlavorVersionFile returns the URL the content of a file of a flavor
+	// version can be downloaded from. Only the owner of the chunk is allowed
+	// to download files. Files are available once the flavor version has
+	// been built.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor version does not exist
+	//   - the flavor version does not contain a file with the given path
+	//
+	// - FAILED_PRECONDITION:
+	//   - the content of the file is not available, because the flavor version
+	//     has not been built yet.
+	GetFlavorVersionFile(context.Context, *GetFlavorVersionFileRequest) (*GetFlavorVersionFileResponse, error)
+	// GetFlavorVersion returns a single flavor version together with its build
+	// provenance, which records what exactly the flavor version has been built
+	// from, so it can be traced back long after it has been published. Only the
+	// owner of the chunk is allowed to get the flavor version this way.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor version does not exist
+	GetFlavorVersion(context.Context, *GetFlavorVersionRequest) (*GetFlavorVersionResponse, error)
+	// CreateAsset registers an asset bundle, like a resource pack, that can be shared
+	// between flavor versions. Asset bundles are gzip compressed tarballs containing
+	// files relative to the server root, and are identified by their hash. If the
+	// bundle has not been uploaded yet, a presigned URL is returned it has to be
+	// uploaded to using a PUT request. Bundles are only stored once, so creating an
+	// asset that has already been uploaded returns no URL. Flavor versions reference
+	// assets by their hash, see CreateFlavorVersionRequest.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the hash is not a hex encoded sha256 digest
+	//   - the bundle exceeds the maximum allowed size
+	CreateAsset(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error)
+	// StartCanary marks a flavor version as canary of another version of the same
+	// flavor, see Canary. Only the owner of the chunk is allowed to start canaries.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the versions are not different versions of the same flavor
+	//
+	// - NOT_FOUND:
+	//   - one of the flavor versions does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - one of the flavor versions has not been built successfully
+	//
+	// - ALREADY_EXISTS:
+	//   - the flavor already has an active canary
+	StartCanary(context.Context, *StartCanaryRequest) (*StartCanaryResponse, error)
+	// GetCanary returns the most recent canary of a flavor, including stats
+	// comparing the instances launched for the stable and canary version.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor has no canary
+	GetCanary(context.Context, *GetCanaryRequest) (*GetCanaryResponse, error)
+	// PromoteCanary ends the active canary of a flavor. Afterward, instances
+	// launched for the stable version run the canary version.
+	//
+	// Defined error codes:
+	// - FAILED_PRECONDITION:
+	//   - the flavor has no active canary
+	PromoteCanary(context.Context, *PromoteCanaryRequest) (*PromoteCanaryResponse, error)
+	// AbortCanary ends the active canary of a flavor. Afterward, instances
+	// launched for the canary version run the stable version.
+	//
+	// Defined error codes:
+	// - FAILED_PRECONDITION:
+	//   - the flavor has no active canary
+	AbortCanary(context.Context, *AbortCanaryRequest) (*AbortCanaryResponse, error)
+	// PromoteVersion publishes a flavor version to a channel of its flavor, see
+	// FlavorChannel. The version previously published to the channel is replaced.
+	// Only the owner of the chunk is allowed to promote versions.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor version does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the flavor version has not been built successfully
+	PromoteVersion(context.Context, *PromoteVersionRequest) (*PromoteVersionResponse, error)
+	// ListFlavorChannels returns the channels of a flavor together with the
+	// versions published to them.
+	ListFlavorChannels(context.Context, *ListFlavorChannelsRequest) (*ListFlavorChannelsResponse, error)
+	// SetRetentionPolicy replaces the retention policy of a chunk, see
+	// RetentionPolicy. Flavor versions are not removed right away, but
+	// by a job running periodically. Only the owner of the chunk is
+	// allowed to set its retention policy.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error)
+	// GetRetentionPolicy returns the retention policy of a chunk. If no
+	// policy has been set, a policy with all rules disabled is returned.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	GetRetentionPolicy(context.Context, *GetRetentionPolicyRequest) (*GetRetentionPolicyResponse, error)
+	// SetAutoscalingPolicy replaces the autoscaling policy of a flavor, see
+	// AutoscalingPolicy. Instances are not started or stopped right away, but
+	// by the autoscaler running periodically. Only the owner of the chunk is
+	// allowed to set the policy.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - max_instances is lower than min_instances or exceeds the limit
+	//     configured by the platform
+	SetAutoscalingPolicy(context.Context, *SetAutoscalingPolicyRequest) (*SetAutoscalingPolicyResponse, error)
+	// GetAutoscalingPolicy returns the autoscaling policy of a flavor.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the flavor does not exist or is not autoscaled
+	GetAutoscalingPolicy(context.Context, *GetAutoscalingPolicyRequest) (*GetAutoscalingPolicyResponse, error)
+	// DeleteAutoscalingPolicy stops autoscaling the flavor. Instances started
+	// by the autoscaler keep running until they are stopped by the owner.
+	DeleteAutoscalingPolicy(context.Context, *DeleteAutoscalingPolicyRequest) (*DeleteAutoscalingPolicyResponse, error)
+	// SetChunkReadme replaces the markdown description shown on the page of a
+	// chunk, see ChunkReadme. Only the owner of the chunk is allowed to set it.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - the readme exceeds 64KiB
+	SetChunkReadme(context.Context, *SetChunkReadmeRequest) (*SetChunkReadmeResponse, error)
+	// GetChunkReadme returns the readme of a chunk. If no readme
+	// has been set, an empty readme is returned.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	GetChunkReadme(context.Context, *GetChunkReadmeRequest) (*GetChunkReadmeResponse, error)
+	// CreateChunkMedia adds an image to the page of a chunk and returns the
+	// presigned URL the image has to be uploaded to using a PUT request. Adding
+	// an image the chunk already has, returns the existing media and no URL if
+	// the image has already been uploaded. Only the owner of the chunk is
+	// allowed to add images.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - the hash is not a hex encoded sha256 digest
+	//   - the content type is not one of image/png, image/jpeg or image/webp
+	//   - the image exceeds the maximum allowed size
+	//
+	// - RESOURCE_EXHAUSTED:
+	//   - the chunk already has the maximum number of images
+	CreateChunkMedia(context.Context, *CreateChunkMediaRequest) (*CreateChunkMediaResponse, error)
+	// ListChunkMedia returns the images of a chunk in the order they have been
+	// added, including the URLs they can be downloaded from. Images that have
+	// not been uploaded yet are omitted.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	ListChunkMedia(context.Context, *ListChunkMediaRequest) (*ListChunkMediaResponse, error)
+	// DeleteChunkMedia removes an image from the page of a chunk. Only the
+	// owner of the chunk is allowed to remove images.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the media or the chunk does not exist
+	DeleteChunkMedia(context.Context, *DeleteChunkMediaRequest) (*DeleteChunkMediaResponse, error)
+	// ImportChunk creates a chunk with a single flavor from an archive of an
+	// existing Paper server, so creators do not have to use the CLI. The chunk
+	// and flavor are created right away and the archive, either a zip file or
+	// a gzip compressed tarball, has to be uploaded to the returned URL using a
+	// PUT request. Afterward, StartChunkImport has to be called.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the chunk or flavor name or the version is invalid, see CreateChunk,
+	//     CreateFlavor and CreateFlavorVersion.
+	//   - the archive exceeds the maximum allowed size
+	//
+	// - FAILED_PRECONDITION:
+	//   - the minecraft version is not supported
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another chunk
+	ImportChunk(context.Context, *ImportChunkRequest) (*ImportChunkResponse, error)
+	// StartChunkImport unpacks the uploaded archive in the background. Files that
+	// are generated by the server at runtime, like caches, logs and the server jar,
+	// are excluded the same way the CLI does. Once unpacked, the flavor version is
+	// created and built. The progress can be retrieved using GetChunkImport.
+	// Calling this endpoint again has no effect once the import has been started.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the import does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the archive has not been uploaded yet
+	StartChunkImport(context.Context, *StartChunkImportRequest) (*StartChunkImportResponse, error)
+	// GetChunkImport returns the import. Only the owner of the chunk is allowed
+	// to retrieve it.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the import does not exist
+	GetChunkImport(context.Context, *GetChunkImportRequest) (*GetChunkImportResponse, error)
+	// ListChunkTemplates returns the templates chunks can be created from,
+	// ordered by their name. Templates are managed by admins.
+	ListChunkTemplates(context.Context, *ListChunkTemplatesRequest) (*ListChunkTemplatesResponse, error)
+	// InstantiateChunkTemplate creates a chunk with a single flavor from the
+	// template. The first version of the flavor contains the files of the
+	// template, which do not have to be uploaded, and is built right away.
+	// The files can be downloaded using GetFlavorVersionFile.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the chunk name is invalid, see CreateChunk
+	//
+	// - NOT_FOUND:
+	//   - the template does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the flavor version of the template has not been built
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is already used by another chunk
+	InstantiateChunkTemplate(context.Context, *InstantiateChunkTemplateRequest) (*InstantiateChunkTemplateResponse, error)
+	// RequestCrossInstanceTraffic asks for the instances of a chunk to be allowed
+	// to connect to other instances on the same node, see CrossInstanceTraffic.
+	// Requesting it again replaces the reason and requires another approval.
+	// Only the owner of the chunk is allowed to request it.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	RequestCrossInstanceTraffic(context.Context, *RequestCrossInstanceTrafficRequest) (*RequestCrossInstanceTrafficResponse, error)
+	// GetCrossInstanceTraffic returns the cross-instance traffic request of a
+	// chunk. If nothing has been requested, the state is TRAFFIC_NOT_REQUESTED.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	GetCrossInstanceTraffic(context.Context, *GetCrossInstanceTrafficRequest) (*GetCrossInstanceTrafficResponse, error)
+	// WithdrawCrossInstanceTraffic removes the request of a chunk, regardless of
+	// its state. Instances created afterward are isolated again.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	//   - the chunk has not requested cross-instance traffic
+	WithdrawCrossInstanceTraffic(context.Context, *WithdrawCrossInstanceTrafficRequest) (*WithdrawCrossInstanceTrafficResponse, error)
+	// CreateSchedule adds a schedule that starts and stops instances of a
+	// flavor version of the chunk, see InstanceSchedule. Only runs after the
+	// schedule has been created are performed. Only the owner of the chunk is
+	// allowed to create schedules.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk or the flavor version does not exist
+	//
+	// - FAILED_PRECONDITION:
+	//   - the flavor version has not been built
+	//
+	// - INVALID_ARGUMENT:
+	//   - one of the cron expressions or the time zone is invalid
+	//
+	// - RESOURCE_EXHAUSTED:
+	//   - the chunk has reached the maximum number of schedules
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	// ListSchedules returns the schedules of a chunk.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// DeleteSchedule removes a schedule. The instance it has started, if
+	// any, keeps running until it is stopped by the owner.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the schedule does not exist
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	// PreviewNextRuns returns the next times a schedule with the given cron
+	// expressions and time zone would start or stop its instance. It can be
+	// used to check the expressions before creating the schedule.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - one of the cron expressions or the time zone is invalid
+	PreviewNextRuns(context.Context, *PreviewNextRunsRequest) (*PreviewNextRunsResponse, error)
+	// GetChunkStats returns what running the instances of the chunk has cost
+	// between start and end. If end is not set, it is now. If start is not
+	// set, it is 30 days before end. Only the owner of the chunk is allowed
+	// to get its stats.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the chunk does not exist
+	//
+	// - INVALID_ARGUMENT:
+	//   - start is not before end
+	GetChunkStats(context.Context, *GetChunkStatsRequest) (*GetChunkStatsResponse, error)
+	// ApplyChunkSpec reconciles the chunk to the desired state described by the
+	// spec, see ChunkSpec. The chunk is identified by its name and created if it
+	// does not exist yet. Applying the same spec again does not change anything,
+	// which allows applying it from CI pipelines on every commit. The changes
+	// that have been made, or would be made if dry_run is set, are returned.
+	// Versions referenced by the spec have to be published and built before.
+	// If applying the spec fails midway, applying it again continues where it
+	// stopped.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the chunk name, description or tags are invalid, see CreateChunk
+	//   - a flavor is specified more than once
+	//   - a schedule refers to a flavor that is not part of the spec
+	//   - a referenced version has not been published
+	//   - one of the cron expressions or time zones is invalid
+	//   - max_instances is lower than min_instances or exceeds the limit
+	//     configured by the platform
+	//
+	// - FAILED_PRECONDITION:
+	//   - a referenced version has not been built
+	//
+	// - ALREADY_EXISTS:
+	//   - the name is used by a chunk of another user
+	//
+	// - RESOURCE_EXHAUSTED:
+	//   - the spec contains more schedules than a chunk can have
+	//
+	// - ABORTED:
+	//   - the spec of the chunk is being applied concurrently
+	ApplyChunkSpec(context.Context, *ApplyChunkSpecRequest) (*ApplyChunkSpecResponse, error)
+	mustEmbedUnimplementedChunkServiceServer()
+}
+
+// UnimplementedChunkServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChunkServiceServer struct{}
+
+func (UnimplementedChunkServiceServer) CreateChunk(context.Context, *CreateChunkRequest) (*CreateChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChunk not implemented")
+}
+func (UnimplementedChunkServiceServer) GetChunk(context.Context, *GetChunkRequest) (*GetChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunk not implemented")
+}
+func (UnimplementedChunkServiceServer) UpdateChunk(context.Context, *UpdateChunkRequest) (*UpdateChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChunk not implemented")
+}
+func (UnimplementedChunkServiceServer) RenameChunk(context.Context, *RenameChunkRequest) (*RenameChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameChunk not implemented")
+}
+func (UnimplementedChunkServiceServer) GetChunkByName(context.Context, *GetChunkByNameRequest) (*GetChunkByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunkByName not implemented")
+}
+func (UnimplementedChunkServiceServer) CheckChunkName(context.Context, *CheckChunkNameRequest) (*CheckChunkNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckChunkName not implemented")
+}
+func (UnimplementedChunkServiceServer) ListChunks(context.Context, *ListChunksRequest) (*ListChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChunks not implemented")
+}
+func (UnimplementedChunkServiceServer) CreateFlavor(context.Context, *CreateFlavorRequest) (*CreateFlavorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFlavor not implemented")
+}
+func (UnimplementedChunkServiceServer) CreateFlavorVersion(context.Context, *CreateFlavorVersionRequest) (*CreateFlavorVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFlavorVersion not implemented")
+}
+func (UnimplementedChunkServiceServer) BuildFlavorVersion(context.Context, *BuildFlavorVersionRequest) (*BuildFlavorVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildFlavorVersion not implemented")
+}
+func (UnimplementedChunkServiceServer) GetUploadURL(context.Context, *GetUploadURLRequest) (*GetUploadURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadURL not implemented")
+}
+func (UnimplementedChunkServiceServer) GetSupportedMinecraftVersions(context.Context, *GetSupportedMinecraftVersionsRequest) (*GetSupportedMinecraftVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupportedMinecraftVersions not implemented")
+}
+func (UnimplementedChunkServiceServer) GetMinecraftVersion(context.Context, *GetMinecraftVersionRequest) (*GetMinecraftVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMinecraftVersion not implemented")
+}
+func (UnimplementedChunkServiceServer) UploadThumbnail(context.Context, *UploadThumbnailRequest) (*UploadThumbnailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadThumbnail not implemented")
+}
+func (UnimplementedChunkServiceServer) DeleteFlavor(context.Context, *DeleteFlavorRequest) (*DeleteFlavorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFlavor not implemented")
+}
+func (UnimplementedChunkServiceServer) DeleteChunk(context.Context, *DeleteChunkRequest) (*DeleteChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChunk not implemented")
+}
+func (UnimplementedChunkServiceServer) GetFlavor(context.Context, *GetFlavorRequest) (*GetFlavorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlavor not implemented")
+}
+func (UnimplementedChunkServiceServer) PrecheckFlavorVersion(context.Context, *PrecheckFlavorVersionRequest) (*PrecheckFlavorVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrecheckFlavorVersion not implemented")
+}
+func (UnimplementedChunkServiceServer) PatchFlavorVersion(context.Context, *PatchFlavorVersionRequest) (*PatchFlavorVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchFlavorVersion not implemented")
+}
+func (UnimplementedChunkServiceServer) GetFlavorVersionFile(context.Context, *GetFlavorVersionFileRequest) (*GetFlavorVersionFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlavorVersionFile not implemented")
+}
+func (UnimplementedChunkServiceServer) GetFlavorVersion(context.Context, *GetFlavorVersionRequest) (*GetFlavorVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlavorVersion not implemented")
+}
+func (UnimplementedChunkServiceServer) CreateAsset(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAsset not implemented")
+}
+func (UnimplementedChunkServiceServer) StartCanary(context.Context, *StartCanaryRequest) (*StartCanaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCanary not implemented")
+}
+func (UnimplementedChunkServiceServer) GetCanary(context.Context, *GetCanaryRequest) (*GetCanaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCanary not implemented")
+}
+func (UnimplementedChunkServiceServer) PromoteCanary(context.Context, *PromoteCanaryRequest) (*PromoteCanaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteCanary not implemented")
+}
+func (UnimplementedChunkServiceServer) AbortCanary(context.Context, *AbortCanaryRequest) (*AbortCanaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortCanary not implemented")
+}
+func (UnimplementedChunkServiceServer) PromoteVersion(context.Context, *PromoteVersionRequest) (*PromoteVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteVersion not implemented")
+}
+func (UnimplementedChunkServiceServer) ListFlavorChannels(context.Context, *ListFlavorChannelsRequest) (*ListFlavorChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlavorChannels not implemented")
+}
+func (UnimplementedChunkServiceServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedChunkServiceServer) GetRetentionPolicy(context.Context, *GetRetentionPolicyRequest) (*GetRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionPolicy not implemented")
+}
+func (UnimplementedChunkServiceServer) SetAutoscalingPolicy(context.Context, *SetAutoscalingPolicyRequest) (*SetAutoscalingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoscalingPolicy not implemented")
+}
+func (UnimplementedChunkServiceServer) GetAutoscalingPolicy(context.Context, *GetAutoscalingPolicyRequest) (*GetAutoscalingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAutoscalingPolicy not implemented")
+}
+func (UnimplementedChunkServiceServer) DeleteAutoscalingPolicy(context.Context, *DeleteAutoscalingPolicyRequest) (*DeleteAutoscalingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAutoscalingPolicy not implemented")
+}
+func (UnimplementedChunkServiceServer) SetChunkReadme(context.Context, *SetChunkReadmeRequest) (*SetChunkReadmeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChunkReadme not implemented")
+}
+func (UnimplementedChunkServiceServer) GetChunkReadme(context.Context, *GetChunkReadmeRequest) (*GetChunkReadmeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunkReadme not implemented")
+}
+func (UnimplementedChunkServiceServer) CreateChunkMedia(context.Context, *CreateChunkMediaRequest) (*CreateChunkMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChunkMedia not implemented")
+}
+func (UnimplementedChunkServiceServer) ListChunkMedia(context.Context, *ListChunkMediaRequest) (*ListChunkMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChunkMedia not implemented")
+}
+func (UnimplementedChunkServiceServer) DeleteChunkMedia(context.Context, *DeleteChunkMediaRequest) (*DeleteChunkMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChunkMedia not implemented")
+}
+func (UnimplementedChunkServiceServer) ImportChunk(context.Context, *ImportChunkRequest) (*ImportChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportChunk not implemented")
+}
+func (UnimplementedChunkServiceServer) StartChunkImport(context.Context, *StartChunkImportRequest) (*StartChunkImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartChunkImport not implemented")
+}
+func (UnimplementedChunkServiceServer) GetChunkImport(context.Context, *GetChunkImportRequest) (*GetChunkImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunkImport not implemented")
+}
+func (UnimplementedChunkServiceServer) ListChunkTemplates(context.Context, *ListChunkTemplatesRequest) (*ListChunkTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChunkTemplates not implemented")
+}
+func (UnimplementedChunkServiceServer) InstantiateChunkTemplate(context.Context, *InstantiateChunkTemplateRequest) (*InstantiateChunkTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateChunkTemplate not implemented")
+}
+func (UnimplementedChunkServiceServer) RequestCrossInstanceTraffic(context.Context, *RequestCrossInstanceTrafficRequest) (*RequestCrossInstanceTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCrossInstanceTraffic not implemented")
+}
+func (UnimplementedChunkServiceServer) GetCrossInstanceTraffic(context.Context, *GetCrossInstanceTrafficRequest) (*GetCrossInstanceTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrossInstanceTraffic not implemented")
+}
+func (UnimplementedChunkServiceServer) WithdrawCrossInstanceTraffic(context.Context, *WithdrawCrossInstanceTrafficRequest) (*WithdrawCrossInstanceTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCrossInstanceTraffic not implemented")
+}
+func (UnimplementedChunkServiceServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedChunkServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedChunkServiceServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (UnimplementedChunkServiceServer) PreviewNextRuns(context.Context, *PreviewNextRunsRequest) (*PreviewNextRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewNextRuns not implemented")
+}
+func (UnimplementedChunkServiceServer) GetChunkStats(context.Context, *GetChunkStatsRequest) (*GetChunkStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunkStats not implemented")
+}
+func (UnimplementedChunkServiceServer) ApplyChunkSpec(context.Context, *ApplyChunkSpecRequest) (*ApplyChunkSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyChunkSpec not implemented")
+}
+func (UnimplementedChunkServiceServer) mustEmbedUnimplementedChunkServiceServer() {}
+func (UnimplementedChunkServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeChunkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChunkServiceServer will
+// result in compilation errors.
+type UnsafeChunkServiceServer interface {
 	mustEmbedUnimplementedChunkServiceServer()
 }
 
-// UnimplementedChunkServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedChunkServiceServer struct{}
+func RegisterChunkServiceServer(s grpc.ServiceRegistrar, srv ChunkServiceServer) {
+	// If the following call pancis, it indicates UnimplementedChunkServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChunkService_ServiceDesc, srv)
+}
+
+func _ChunkService_CreateChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).CreateChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_CreateChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).CreateChunk(ctx, req.(*CreateChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetChunk(ctx, req.(*GetChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_UpdateChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).UpdateChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_UpdateChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).UpdateChunk(ctx, req.(*UpdateChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_RenameChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).RenameChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_RenameChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).RenameChunk(ctx, req.(*RenameChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetChunkByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetChunkByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetChunkByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetChunkByName(ctx, req.(*GetChunkByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_CheckChunkName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckChunkNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).CheckChunkName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_CheckChunkName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).CheckChunkName(ctx, req.(*CheckChunkNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_ListChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).ListChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_ListChunks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).ListChunks(ctx, req.(*ListChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_CreateFlavor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlavorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).CreateFlavor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_CreateFlavor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).CreateFlavor(ctx, req.(*CreateFlavorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_CreateFlavorVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlavorVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).CreateFlavorVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_CreateFlavorVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).CreateFlavorVersion(ctx, req.(*CreateFlavorVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_BuildFlavorVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildFlavorVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).BuildFlavorVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_BuildFlavorVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).BuildFlavorVersion(ctx, req.(*BuildFlavorVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetUploadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetUploadURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetUploadURL(ctx, req.(*GetUploadURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetSupportedMinecraftVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSupportedMinecraftVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetSupportedMinecraftVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetSupportedMinecraftVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetSupportedMinecraftVersions(ctx, req.(*GetSupportedMinecraftVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetMinecraftVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMinecraftVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetMinecraftVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetMinecraftVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetMinecraftVersion(ctx, req.(*GetMinecraftVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_UploadThumbnail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadThumbnailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).UploadThumbnail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_UploadThumbnail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).UploadThumbnail(ctx, req.(*UploadThumbnailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_DeleteFlavor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFlavorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).DeleteFlavor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_DeleteFlavor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).DeleteFlavor(ctx, req.(*DeleteFlavorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedChunkServiceServer) CreateChunk(context.Context, *CreateChunkRequest) (*CreateChunkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateChunk not implemented")
+func _ChunkService_DeleteChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).DeleteChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_DeleteChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).DeleteChunk(ctx, req.(*DeleteChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) GetChunk(context.Context, *GetChunkRequest) (*GetChunkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetChunk not implemented")
+
+func _ChunkService_GetFlavor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlavorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetFlavor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetFlavor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetFlavor(ctx, req.(*GetFlavorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) UpdateChunk(context.Context, *UpdateChunkRequest) (*UpdateChunkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateChunk not implemented")
+
+func _ChunkService_PrecheckFlavorVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrecheckFlavorVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).PrecheckFlavorVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_PrecheckFlavorVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).PrecheckFlavorVersion(ctx, req.(*PrecheckFlavorVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) ListChunks(context.Context, *ListChunksRequest) (*ListChunksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListChunks not implemented")
+
+func _ChunkService_PatchFlavorVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchFlavorVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).PatchFlavorVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_PatchFlavorVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).PatchFlavorVersion(ctx, req.(*PatchFlavorVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) CreateFlavor(context.Context, *CreateFlavorRequest) (*CreateFlavorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFlavor not implemented")
+
+func _ChunkService_GetFlavorVersionFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlavorVersionFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetFlavorVersionFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetFlavorVersionFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetFlavorVersionFile(ctx, req.(*GetFlavorVersionFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) CreateFlavorVersion(context.Context, *CreateFlavorVersionRequest) (*CreateFlavorVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFlavorVersion not implemented")
+
+func _ChunkService_GetFlavorVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlavorVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetFlavorVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetFlavorVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetFlavorVersion(ctx, req.(*GetFlavorVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) BuildFlavorVersion(context.Context, *BuildFlavorVersionRequest) (*BuildFlavorVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BuildFlavorVersion not implemented")
+
+func _ChunkService_CreateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).CreateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_CreateAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).CreateAsset(ctx, req.(*CreateAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) GetUploadURL(context.Context, *GetUploadURLRequest) (*GetUploadURLResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUploadURL not implemented")
+
+func _ChunkService_StartCanary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCanaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).StartCanary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_StartCanary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).StartCanary(ctx, req.(*StartCanaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetCanary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCanaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetCanary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetCanary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetCanary(ctx, req.(*GetCanaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_PromoteCanary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteCanaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).PromoteCanary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_PromoteCanary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).PromoteCanary(ctx, req.(*PromoteCanaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_AbortCanary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortCanaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).AbortCanary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_AbortCanary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).AbortCanary(ctx, req.(*AbortCanaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_PromoteVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).PromoteVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_PromoteVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).PromoteVersion(ctx, req.(*PromoteVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_ListFlavorChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlavorChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).ListFlavorChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_ListFlavorChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).ListFlavorChannels(ctx, req.(*ListFlavorChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_SetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetRetentionPolicy(ctx, req.(*GetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_SetAutoscalingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoscalingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).SetAutoscalingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_SetAutoscalingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).SetAutoscalingPolicy(ctx, req.(*SetAutoscalingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_GetAutoscalingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAutoscalingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetAutoscalingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetAutoscalingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetAutoscalingPolicy(ctx, req.(*GetAutoscalingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) GetSupportedMinecraftVersions(context.Context, *GetSupportedMinecraftVersionsRequest) (*GetSupportedMinecraftVersionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSupportedMinecraftVersions not implemented")
+
+func _ChunkService_DeleteAutoscalingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAutoscalingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).DeleteAutoscalingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_DeleteAutoscalingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).DeleteAutoscalingPolicy(ctx, req.(*DeleteAutoscalingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) UploadThumbnail(context.Context, *UploadThumbnailRequest) (*UploadThumbnailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadThumbnail not implemented")
+
+func _ChunkService_SetChunkReadme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChunkReadmeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).SetChunkReadme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_SetChunkReadme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).SetChunkReadme(ctx, req.(*SetChunkReadmeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) DeleteFlavor(context.Context, *DeleteFlavorRequest) (*DeleteFlavorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFlavor not implemented")
+
+func _ChunkService_GetChunkReadme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkReadmeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).GetChunkReadme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_GetChunkReadme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).GetChunkReadme(ctx, req.(*GetChunkReadmeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) DeleteChunk(context.Context, *DeleteChunkRequest) (*DeleteChunkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteChunk not implemented")
+
+func _ChunkService_CreateChunkMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChunkMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).CreateChunkMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_CreateChunkMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).CreateChunkMedia(ctx, req.(*CreateChunkMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) GetFlavor(context.Context, *GetFlavorRequest) (*GetFlavorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFlavor not implemented")
+
+func _ChunkService_ListChunkMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChunkMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).ListChunkMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_ListChunkMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).ListChunkMedia(ctx, req.(*ListChunkMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedChunkServiceServer) mustEmbedUnimplementedChunkServiceServer() {}
-func (UnimplementedChunkServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeChunkServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ChunkServiceServer will
-// result in compilation errors.
-type UnsafeChunkServiceServer interface {
-	mustEmbedUnimplementedChunkServiceServer()
+func _ChunkService_DeleteChunkMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChunkMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).DeleteChunkMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_DeleteChunkMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).DeleteChunkMedia(ctx, req.(*DeleteChunkMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterChunkServiceServer(s grpc.ServiceRegistrar, srv ChunkServiceServer) {
-	// If the following call pancis, it indicates UnimplementedChunkServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ChunkService_ImportChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ChunkService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).ImportChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkService_ImportChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).ImportChunk(ctx, req.(*ImportChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_CreateChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateChunkRequest)
+func _ChunkService_StartChunkImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartChunkImportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).CreateChunk(ctx, in)
+		return srv.(ChunkServiceServer).StartChunkImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_CreateChunk_FullMethodName,
+		FullMethod: ChunkService_StartChunkImport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).CreateChunk(ctx, req.(*CreateChunkRequest))
+		return srv.(ChunkServiceServer).StartChunkImport(ctx, req.(*StartChunkImportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_GetChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetChunkRequest)
+func _ChunkService_GetChunkImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkImportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).GetChunk(ctx, in)
+		return srv.(ChunkServiceServer).GetChunkImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_GetChunk_FullMethodName,
+		FullMethod: ChunkService_GetChunkImport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).GetChunk(ctx, req.(*GetChunkRequest))
+		return srv.(ChunkServiceServer).GetChunkImport(ctx, req.(*GetChunkImportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_UpdateChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateChunkRequest)
+func _ChunkService_ListChunkTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChunkTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).UpdateChunk(ctx, in)
+		return srv.(ChunkServiceServer).ListChunkTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_UpdateChunk_FullMethodName,
+		FullMethod: ChunkService_ListChunkTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).UpdateChunk(ctx, req.(*UpdateChunkRequest))
+		return srv.(ChunkServiceServer).ListChunkTemplates(ctx, req.(*ListChunkTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_ListChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListChunksRequest)
+func _ChunkService_InstantiateChunkTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateChunkTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).ListChunks(ctx, in)
+		return srv.(ChunkServiceServer).InstantiateChunkTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_ListChunks_FullMethodName,
+		FullMethod: ChunkService_InstantiateChunkTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).ListChunks(ctx, req.(*ListChunksRequest))
+		return srv.(ChunkServiceServer).InstantiateChunkTemplate(ctx, req.(*InstantiateChunkTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_CreateFlavor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFlavorRequest)
+func _ChunkService_RequestCrossInstanceTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCrossInstanceTrafficRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).CreateFlavor(ctx, in)
+		return srv.(ChunkServiceServer).RequestCrossInstanceTraffic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_CreateFlavor_FullMethodName,
+		FullMethod: ChunkService_RequestCrossInstanceTraffic_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).CreateFlavor(ctx, req.(*CreateFlavorRequest))
+		return srv.(ChunkServiceServer).RequestCrossInstanceTraffic(ctx, req.(*RequestCrossInstanceTrafficRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_CreateFlavorVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFlavorVersionRequest)
+func _ChunkService_GetCrossInstanceTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrossInstanceTrafficRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).CreateFlavorVersion(ctx, in)
+		return srv.(ChunkServiceServer).GetCrossInstanceTraffic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_CreateFlavorVersion_FullMethodName,
+		FullMethod: ChunkService_GetCrossInstanceTraffic_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).CreateFlavorVersion(ctx, req.(*CreateFlavorVersionRequest))
+		return srv.(ChunkServiceServer).GetCrossInstanceTraffic(ctx, req.(*GetCrossInstanceTrafficRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_BuildFlavorVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuildFlavorVersionRequest)
+func _ChunkService_WithdrawCrossInstanceTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCrossInstanceTrafficRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).BuildFlavorVersion(ctx, in)
+		return srv.(ChunkServiceServer).WithdrawCrossInstanceTraffic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_BuildFlavorVersion_FullMethodName,
+		FullMethod: ChunkService_WithdrawCrossInstanceTraffic_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).BuildFlavorVersion(ctx, req.(*BuildFlavorVersionRequest))
+		return srv.(ChunkServiceServer).WithdrawCrossInstanceTraffic(ctx, req.(*WithdrawCrossInstanceTrafficRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_GetUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUploadURLRequest)
+func _ChunkService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).GetUploadURL(ctx, in)
+		return srv.(ChunkServiceServer).CreateSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_GetUploadURL_FullMethodName,
+		FullMethod: ChunkService_CreateSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).GetUploadURL(ctx, req.(*GetUploadURLRequest))
+		return srv.(ChunkServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_GetSupportedMinecraftVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSupportedMinecraftVersionsRequest)
+func _ChunkService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).GetSupportedMinecraftVersions(ctx, in)
+		return srv.(ChunkServiceServer).ListSchedules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_GetSupportedMinecraftVersions_FullMethodName,
+		FullMethod: ChunkService_ListSchedules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).GetSupportedMinecraftVersions(ctx, req.(*GetSupportedMinecraftVersionsRequest))
+		return srv.(ChunkServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_UploadThumbnail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadThumbnailRequest)
+func _ChunkService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).UploadThumbnail(ctx, in)
+		return srv.(ChunkServiceServer).DeleteSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_UploadThumbnail_FullMethodName,
+		FullMethod: ChunkService_DeleteSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).UploadThumbnail(ctx, req.(*UploadThumbnailRequest))
+		return srv.(ChunkServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_DeleteFlavor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFlavorRequest)
+func _ChunkService_PreviewNextRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewNextRunsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).DeleteFlavor(ctx, in)
+		return srv.(ChunkServiceServer).PreviewNextRuns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_DeleteFlavor_FullMethodName,
+		FullMethod: ChunkService_PreviewNextRuns_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).DeleteFlavor(ctx, req.(*DeleteFlavorRequest))
+		return srv.(ChunkServiceServer).PreviewNextRuns(ctx, req.(*PreviewNextRunsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_DeleteChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteChunkRequest)
+func _ChunkService_GetChunkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).DeleteChunk(ctx, in)
+		return srv.(ChunkServiceServer).GetChunkStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_DeleteChunk_FullMethodName,
+		FullMethod: ChunkService_GetChunkStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).DeleteChunk(ctx, req.(*DeleteChunkRequest))
+		return srv.(ChunkServiceServer).GetChunkStats(ctx, req.(*GetChunkStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChunkService_GetFlavor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFlavorRequest)
+func _ChunkService_ApplyChunkSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyChunkSpecRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChunkServiceServer).GetFlavor(ctx, in)
+		return srv.(ChunkServiceServer).ApplyChunkSpec(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ChunkService_GetFlavor_FullMethodName,
+		FullMethod: ChunkService_ApplyChunkSpec_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChunkServiceServer).GetFlavor(ctx, req.(*GetFlavorRequest))
+		return srv.(ChunkServiceServer).ApplyChunkSpec(ctx, req.(*ApplyChunkSpecRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -829,6 +2939,18 @@ var ChunkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChunk",
 			Handler:    _ChunkService_UpdateChunk_Handler,
 		},
+		{
+			MethodName: "RenameChunk",
+			Handler:    _ChunkService_RenameChunk_Handler,
+		},
+		{
+			MethodName: "GetChunkByName",
+			Handler:    _ChunkService_GetChunkByName_Handler,
+		},
+		{
+			MethodName: "CheckChunkName",
+			Handler:    _ChunkService_CheckChunkName_Handler,
+		},
 		{
 			MethodName: "ListChunks",
 			Handler:    _ChunkService_ListChunks_Handler,
@@ -853,6 +2975,10 @@ var ChunkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSupportedMinecraftVersions",
 			Handler:    _ChunkService_GetSupportedMinecraftVersions_Handler,
 		},
+		{
+			MethodName: "GetMinecraftVersion",
+			Handler:    _ChunkService_GetMinecraftVersion_Handler,
+		},
 		{
 			MethodName: "UploadThumbnail",
 			Handler:    _ChunkService_UploadThumbnail_Handler,
@@ -869,6 +2995,146 @@ var ChunkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFlavor",
 			Handler:    _ChunkService_GetFlavor_Handler,
 		},
+		{
+			MethodName: "PrecheckFlavorVersion",
+			Handler:    _ChunkService_PrecheckFlavorVersion_Handler,
+		},
+		{
+			MethodName: "PatchFlavorVersion",
+			Handler:    _ChunkService_PatchFlavorVersion_Handler,
+		},
+		{
+			MethodName: "GetFlavorVersionFile",
+			Handler:    _ChunkService_GetFlavorVersionFile_Handler,
+		},
+		{
+			MethodName: "GetFlavorVersion",
+			Handler:    _ChunkService_GetFlavorVersion_Handler,
+		},
+		{
+			MethodName: "CreateAsset",
+			Handler:    _ChunkService_CreateAsset_Handler,
+		},
+		{
+			MethodName: "StartCanary",
+			Handler:    _ChunkService_StartCanary_Handler,
+		},
+		{
+			MethodName: "GetCanary",
+			Handler:    _ChunkService_GetCanary_Handler,
+		},
+		{
+			MethodName: "PromoteCanary",
+			Handler:    _ChunkService_PromoteCanary_Handler,
+		},
+		{
+			MethodName: "AbortCanary",
+			Handler:    _ChunkService_AbortCanary_Handler,
+		},
+		{
+			MethodName: "PromoteVersion",
+			Handler:    _ChunkService_PromoteVersion_Handler,
+		},
+		{
+			MethodName: "ListFlavorChannels",
+			Handler:    _ChunkService_ListFlavorChannels_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _ChunkService_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetRetentionPolicy",
+			Handler:    _ChunkService_GetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "SetAutoscalingPolicy",
+			Handler:    _ChunkService_SetAutoscalingPolicy_Handler,
+		},
+		{
+			MethodName: "GetAutoscalingPolicy",
+			Handler:    _ChunkService_GetAutoscalingPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteAutoscalingPolicy",
+			Handler:    _ChunkService_DeleteAutoscalingPolicy_Handler,
+		},
+		{
+			MethodName: "SetChunkReadme",
+			Handler:    _ChunkService_SetChunkReadme_Handler,
+		},
+		{
+			MethodName: "GetChunkReadme",
+			Handler:    _ChunkService_GetChunkReadme_Handler,
+		},
+		{
+			MethodName: "CreateChunkMedia",
+			Handler:    _ChunkService_CreateChunkMedia_Handler,
+		},
+		{
+			MethodName: "ListChunkMedia",
+			Handler:    _ChunkService_ListChunkMedia_Handler,
+		},
+		{
+			MethodName: "DeleteChunkMedia",
+			Handler:    _ChunkService_DeleteChunkMedia_Handler,
+		},
+		{
+			MethodName: "ImportChunk",
+			Handler:    _ChunkService_ImportChunk_Handler,
+		},
+		{
+			MethodName: "StartChunkImport",
+			Handler:    _ChunkService_StartChunkImport_Handler,
+		},
+		{
+			MethodName: "GetChunkImport",
+			Handler:    _ChunkService_GetChunkImport_Handler,
+		},
+		{
+			MethodName: "ListChunkTemplates",
+			Handler:    _ChunkService_ListChunkTemplates_Handler,
+		},
+		{
+			MethodName: "InstantiateChunkTemplate",
+			Handler:    _ChunkService_InstantiateChunkTemplate_Handler,
+		},
+		{
+			MethodName: "RequestCrossInstanceTraffic",
+			Handler:    _ChunkService_RequestCrossInstanceTraffic_Handler,
+		},
+		{
+			MethodName: "GetCrossInstanceTraffic",
+			Handler:    _ChunkService_GetCrossInstanceTraffic_Handler,
+		},
+		{
+			MethodName: "WithdrawCrossInstanceTraffic",
+			Handler:    _ChunkService_WithdrawCrossInstanceTraffic_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _ChunkService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _ChunkService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _ChunkService_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "PreviewNextRuns",
+			Handler:    _ChunkService_PreviewNextRuns_Handler,
+		},
+		{
+			MethodName: "GetChunkStats",
+			Handler:    _ChunkService_GetChunkStats_Handler,
+		},
+		{
+			MethodName: "ApplyChunkSpec",
+			Handler:    _ChunkService_ApplyChunkSpec_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chunk/v1alpha1/api.proto",
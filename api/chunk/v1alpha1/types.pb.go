@@ -99,6 +99,376 @@ func (BuildStatus) EnumDescriptor() ([]byte, []int) {
 	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{0}
 }
 
+// RestartPolicy decides what happens once the server
+// of an instance stops running or is unhealthy.
+type RestartPolicy int32
+
+const (
+	// the instance is removed.
+	RestartPolicy_NEVER RestartPolicy = 0
+	// the server is restarted regardless of why it stopped.
+	RestartPolicy_ALWAYS RestartPolicy = 1
+	// the server is only restarted if it did not exit cleanly.
+	RestartPolicy_ON_FAILURE RestartPolicy = 2
+)
+
+// Enum value maps for RestartPolicy.
+var (
+	RestartPolicy_name = map[int32]string{
+		0: "NEVER",
+		1: "ALWAYS",
+		2: "ON_FAILURE",
+	}
+	RestartPolicy_value = map[string]int32{
+		"NEVER":      0,
+		"ALWAYS":     1,
+		"ON_FAILURE": 2,
+	}
+)
+
+func (x RestartPolicy) Enum() *RestartPolicy {
+	p := new(RestartPolicy)
+	*p = x
+	return p
+}
+
+func (x RestartPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RestartPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_chunk_v1alpha1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (RestartPolicy) Type() protoreflect.EnumType {
+	return &file_chunk_v1alpha1_types_proto_enumTypes[1]
+}
+
+func (x RestartPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RestartPolicy.Descriptor instead.
+func (RestartPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{1}
+}
+
+type CanaryState int32
+
+const (
+	CanaryState_ACTIVE   CanaryState = 0
+	CanaryState_PROMOTED CanaryState = 1
+	CanaryState_ABORTED  CanaryState = 2
+)
+
+// Enum value maps for CanaryState.
+var (
+	CanaryState_name = map[int32]string{
+		0: "ACTIVE",
+		1: "PROMOTED",
+		2: "ABORTED",
+	}
+	CanaryState_value = map[string]int32{
+		"ACTIVE":   0,
+		"PROMOTED": 1,
+		"ABORTED":  2,
+	}
+)
+
+func (x CanaryState) Enum() *CanaryState {
+	p := new(CanaryState)
+	*p = x
+	return p
+}
+
+func (x CanaryState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanaryState) Descriptor() protoreflect.EnumDescriptor {
+	return file_chunk_v1alpha1_types_proto_enumTypes[2].Descriptor()
+}
+
+func (CanaryState) Type() protoreflect.EnumType {
+	return &file_chunk_v1alpha1_types_proto_enumTypes[2]
+}
+
+func (x CanaryState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanaryState.Descriptor instead.
+func (CanaryState) EnumDescriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{2}
+}
+
+// the values are prefixed, because enum values share the
+// scope of the package and would clash with BuildStatus.
+type ChunkImportState int32
+
+const (
+	// the archive has not been uploaded yet.
+	ChunkImportState_IMPORT_PENDING_UPLOAD ChunkImportState = 0
+	// the archive is being unpacked.
+	ChunkImportState_IMPORT_IMPORTING ChunkImportState = 1
+	// the flavor version has been created and is being built.
+	ChunkImportState_IMPORT_COMPLETED ChunkImportState = 2
+	ChunkImportState_IMPORT_FAILED    ChunkImportState = 3
+)
+
+// Enum value maps for ChunkImportState.
+var (
+	ChunkImportState_name = map[int32]string{
+		0: "IMPORT_PENDING_UPLOAD",
+		1: "IMPORT_IMPORTING",
+		2: "IMPORT_COMPLETED",
+		3: "IMPORT_FAILED",
+	}
+	ChunkImportState_value = map[string]int32{
+		"IMPORT_PENDING_UPLOAD": 0,
+		"IMPORT_IMPORTING":      1,
+		"IMPORT_COMPLETED":      2,
+		"IMPORT_FAILED":         3,
+	}
+)
+
+func (x ChunkImportState) Enum() *ChunkImportState {
+	p := new(ChunkImportState)
+	*p = x
+	return p
+}
+
+func (x ChunkImportState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChunkImportState) Descriptor() protoreflect.EnumDescriptor {
+	return file_chunk_v1alpha1_types_proto_enumTypes[3].Descriptor()
+}
+
+func (ChunkImportState) Type() protoreflect.EnumType {
+	return &file_chunk_v1alpha1_types_proto_enumTypes[3]
+}
+
+func (x ChunkImportState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChunkImportState.Descriptor instead.
+func (ChunkImportState) EnumDescriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{3}
+}
+
+// the values are prefixed, because enum values share the
+// scope of the package.
+type CrossInstanceTrafficState int32
+
+const (
+	// the chunk has not requested cross-instance traffic.
+	CrossInstanceTrafficState_TRAFFIC_NOT_REQUESTED CrossInstanceTrafficState = 0
+	// the request is waiting to be reviewed by an admin.
+	CrossInstanceTrafficState_TRAFFIC_REQUESTED CrossInstanceTrafficState = 1
+	CrossInstanceTrafficState_TRAFFIC_APPROVED  CrossInstanceTrafficState = 2
+	CrossInstanceTrafficState_TRAFFIC_REJECTED  CrossInstanceTrafficState = 3
+)
+
+// Enum value maps for CrossInstanceTrafficState.
+var (
+	CrossInstanceTrafficState_name = map[int32]string{
+		0: "TRAFFIC_NOT_REQUESTED",
+		1: "TRAFFIC_REQUESTED",
+		2: "TRAFFIC_APPROVED",
+		3: "TRAFFIC_REJECTED",
+	}
+	CrossInstanceTrafficState_value = map[string]int32{
+		"TRAFFIC_NOT_REQUESTED": 0,
+		"TRAFFIC_REQUESTED":     1,
+		"TRAFFIC_APPROVED":      2,
+		"TRAFFIC_REJECTED":      3,
+	}
+)
+
+func (x CrossInstanceTrafficState) Enum() *CrossInstanceTrafficState {
+	p := new(CrossInstanceTrafficState)
+	*p = x
+	return p
+}
+
+func (x CrossInstanceTrafficState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CrossInstanceTrafficState) Descriptor() protoreflect.EnumDescriptor {
+	return file_chunk_v1alpha1_types_proto_enumTypes[4].Descriptor()
+}
+
+func (CrossInstanceTrafficState) Type() protoreflect.EnumType {
+	return &file_chunk_v1alpha1_types_proto_enumTypes[4]
+}
+
+func (x CrossInstanceTrafficState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CrossInstanceTrafficState.Descriptor instead.
+func (CrossInstanceTrafficState) EnumDescriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{4}
+}
+
+// the values are prefixed, because enum values share the
+// scope of the package.
+type ScheduleAction int32
+
+const (
+	ScheduleAction_SCHEDULE_START ScheduleAction = 0
+	ScheduleAction_SCHEDULE_STOP  ScheduleAction = 1
+)
+
+// Enum value maps for ScheduleAction.
+var (
+	ScheduleAction_name = map[int32]string{
+		0: "SCHEDULE_START",
+		1: "SCHEDULE_STOP",
+	}
+	ScheduleAction_value = map[string]int32{
+		"SCHEDULE_START": 0,
+		"SCHEDULE_STOP":  1,
+	}
+)
+
+func (x ScheduleAction) Enum() *ScheduleAction {
+	p := new(ScheduleAction)
+	*p = x
+	return p
+}
+
+func (x ScheduleAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_chunk_v1alpha1_types_proto_enumTypes[5].Descriptor()
+}
+
+func (ScheduleAction) Type() protoreflect.EnumType {
+	return &file_chunk_v1alpha1_types_proto_enumTypes[5]
+}
+
+func (x ScheduleAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleAction.Descriptor instead.
+func (ScheduleAction) EnumDescriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{5}
+}
+
+// the values are prefixed, because enum values share the
+// scope of the package.
+type ChunkSpecChangeAction int32
+
+const (
+	ChunkSpecChangeAction_SPEC_CHANGE_CREATE ChunkSpecChangeAction = 0
+	ChunkSpecChangeAction_SPEC_CHANGE_UPDATE ChunkSpecChangeAction = 1
+	ChunkSpecChangeAction_SPEC_CHANGE_DELETE ChunkSpecChangeAction = 2
+)
+
+// Enum value maps for ChunkSpecChangeAction.
+var (
+	ChunkSpecChangeAction_name = map[int32]string{
+		0: "SPEC_CHANGE_CREATE",
+		1: "SPEC_CHANGE_UPDATE",
+		2: "SPEC_CHANGE_DELETE",
+	}
+	ChunkSpecChangeAction_value = map[string]int32{
+		"SPEC_CHANGE_CREATE": 0,
+		"SPEC_CHANGE_UPDATE": 1,
+		"SPEC_CHANGE_DELETE": 2,
+	}
+)
+
+func (x ChunkSpecChangeAction) Enum() *ChunkSpecChangeAction {
+	p := new(ChunkSpecChangeAction)
+	*p = x
+	return p
+}
+
+func (x ChunkSpecChangeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChunkSpecChangeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_chunk_v1alpha1_types_proto_enumTypes[6].Descriptor()
+}
+
+func (ChunkSpecChangeAction) Type() protoreflect.EnumType {
+	return &file_chunk_v1alpha1_types_proto_enumTypes[6]
+}
+
+func (x ChunkSpecChangeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChunkSpecChangeAction.Descriptor instead.
+func (ChunkSpecChangeAction) EnumDescriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{6}
+}
+
+// ChunkNameStatus tells whether a name can be used for a new chunk.
+type ChunkNameStatus int32
+
+const (
+	// no chunk uses the name.
+	ChunkNameStatus_AVAILABLE ChunkNameStatus = 0
+	// one of the chunks of the caller uses the name, either as
+	// its current or as one of its previous names.
+	ChunkNameStatus_OWNED ChunkNameStatus = 1
+	// the name is used by a chunk of another user or by a chunk
+	// that has been deleted.
+	ChunkNameStatus_TAKEN ChunkNameStatus = 2
+)
+
+// Enum value maps for ChunkNameStatus.
+var (
+	ChunkNameStatus_name = map[int32]string{
+		0: "AVAILABLE",
+		1: "OWNED",
+		2: "TAKEN",
+	}
+	ChunkNameStatus_value = map[string]int32{
+		"AVAILABLE": 0,
+		"OWNED":     1,
+		"TAKEN":     2,
+	}
+)
+
+func (x ChunkNameStatus) Enum() *ChunkNameStatus {
+	p := new(ChunkNameStatus)
+	*p = x
+	return p
+}
+
+func (x ChunkNameStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChunkNameStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chunk_v1alpha1_types_proto_enumTypes[7].Descriptor()
+}
+
+func (ChunkNameStatus) Type() protoreflect.EnumType {
+	return &file_chunk_v1alpha1_types_proto_enumTypes[7]
+}
+
+func (x ChunkNameStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChunkNameStatus.Descriptor instead.
+func (ChunkNameStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{7}
+}
+
 // Chunk defines the configuration and metadata
 // of user-generated content. This can be anything
 // from a minigame to a freebuild server.
@@ -108,7 +478,9 @@ type Chunk struct {
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Name of the chunk. can be up to 50 chars.
+	// Name of the chunk. can be up to 50 chars. Names are unique
+	// across all chunks. Names only differing in case or punctuation,
+	// like "Sky Wars" and "sky-wars", are considered the same.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Description briefly describes what the user can
 	// expect when running the Chunk. can be up to 100
@@ -318,6 +690,35 @@ type FlavorVersion struct {
 	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	MinPlayers       uint32                 `protobuf:"varint,11,opt,name=minPlayers,proto3" json:"minPlayers,omitempty"`
 	MaxPlayers       uint32                 `protobuf:"varint,12,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+	// resources that have been requested for the last checkpoint
+	// build. not set if the node defaults have been used.
+	CheckpointResources *CheckpointResources `protobuf:"bytes,13,opt,name=checkpoint_resources,json=checkpointResources,proto3" json:"checkpoint_resources,omitempty"`
+	// human-readable reason why the build has been rejected, for
+	// example because the flavor version exceeds the build limits.
+	// only set if the build status is one of the failed states.
+	BuildError string `protobuf:"bytes,14,opt,name=build_error,json=buildError,proto3" json:"build_error,omitempty"`
+	// progress of the current build step in percent. currently
+	// only reported while the image is being pushed.
+	BuildProgress uint32 `protobuf:"varint,15,opt,name=build_progress,json=buildProgress,proto3" json:"build_progress,omitempty"`
+	// hashes of the assets the flavor version is built with.
+	AssetHashes []string `protobuf:"bytes,16,rep,name=asset_hashes,json=assetHashes,proto3" json:"asset_hashes,omitempty"`
+	// time the server has to start, before failing health probes
+	// cause it to be considered unhealthy. 0 means node default.
+	StartupGracePeriodSeconds uint32        `protobuf:"varint,17,opt,name=startup_grace_period_seconds,json=startupGracePeriodSeconds,proto3" json:"startup_grace_period_seconds,omitempty"`
+	RestartPolicy             RestartPolicy `protobuf:"varint,18,opt,name=restart_policy,json=restartPolicy,proto3,enum=chunk.v1alpha1.RestartPolicy" json:"restart_policy,omitempty"`
+	// maximum number of restarts if the restart policy is
+	// ON_FAILURE. 0 means the server is restarted indefinitely.
+	RestartMaxRetries uint32 `protobuf:"varint,19,opt,name=restart_max_retries,json=restartMaxRetries,proto3" json:"restart_max_retries,omitempty"`
+	// time it took until the restored checkpoint responded to a
+	// server list ping during the build. 0 if the restore of the
+	// checkpoint has not been verified.
+	RestoreLatencyMs uint32 `protobuf:"varint,20,opt,name=restore_latency_ms,json=restoreLatencyMs,proto3" json:"restore_latency_ms,omitempty"`
+	// java runtime the server is started with. 0 means the
+	// default of the base image of the minecraft version.
+	JavaVersion uint32 `protobuf:"varint,21,opt,name=java_version,json=javaVersion,proto3" json:"java_version,omitempty"`
+	// template of the command the server is started with. empty
+	// means the command of the base image is used.
+	StartupCommand string `protobuf:"bytes,22,opt,name=startup_command,json=startupCommand,proto3" json:"startup_command,omitempty"`
 }
 
 func (x *FlavorVersion) Reset() {
@@ -420,83 +821,118 @@ func (x *FlavorVersion) GetMaxPlayers() uint32 {
 	return 0
 }
 
-type FileHashes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *FlavorVersion) GetCheckpointResources() *CheckpointResources {
+	if x != nil {
+		return x.CheckpointResources
+	}
+	return nil
+}
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+func (x *FlavorVersion) GetBuildError() string {
+	if x != nil {
+		return x.BuildError
+	}
+	return ""
 }
 
-func (x *FileHashes) Reset() {
-	*x = FileHashes{}
-	mi := &file_chunk_v1alpha1_types_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FlavorVersion) GetBuildProgress() uint32 {
+	if x != nil {
+		return x.BuildProgress
+	}
+	return 0
 }
 
-func (x *FileHashes) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FlavorVersion) GetAssetHashes() []string {
+	if x != nil {
+		return x.AssetHashes
+	}
+	return nil
 }
 
-func (*FileHashes) ProtoMessage() {}
+func (x *FlavorVersion) GetStartupGracePeriodSeconds() uint32 {
+	if x != nil {
+		return x.StartupGracePeriodSeconds
+	}
+	return 0
+}
 
-func (x *FileHashes) ProtoReflect() protoreflect.Message {
-	mi := &file_chunk_v1alpha1_types_proto_msgTypes[3]
+func (x *FlavorVersion) GetRestartPolicy() RestartPolicy {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RestartPolicy
 	}
-	return mi.MessageOf(x)
+	return RestartPolicy_NEVER
 }
 
-// Deprecated: Use FileHashes.ProtoReflect.Descriptor instead.
-func (*FileHashes) Descriptor() ([]byte, []int) {
-	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{3}
+func (x *FlavorVersion) GetRestartMaxRetries() uint32 {
+	if x != nil {
+		return x.RestartMaxRetries
+	}
+	return 0
 }
 
-func (x *FileHashes) GetPath() string {
+func (x *FlavorVersion) GetRestoreLatencyMs() uint32 {
 	if x != nil {
-		return x.Path
+		return x.RestoreLatencyMs
 	}
-	return ""
+	return 0
 }
 
-func (x *FileHashes) GetHash() string {
+func (x *FlavorVersion) GetJavaVersion() uint32 {
 	if x != nil {
-		return x.Hash
+		return x.JavaVersion
+	}
+	return 0
+}
+
+func (x *FlavorVersion) GetStartupCommand() string {
+	if x != nil {
+		return x.StartupCommand
 	}
 	return ""
 }
 
-type File struct {
+// BuildProvenance records what a flavor version has been built from.
+type BuildProvenance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-}
-
-func (x *File) Reset() {
-	*x = File{}
-	mi := &file_chunk_v1alpha1_types_proto_msgTypes[4]
+	// digest of the base image of the minecraft version.
+	BaseImageDigest string `protobuf:"bytes,1,opt,name=base_image_digest,json=baseImageDigest,proto3" json:"base_image_digest,omitempty"`
+	// digest of the image containing the files of the flavor version.
+	ImageDigest string `protobuf:"bytes,2,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
+	// vcs revision of the control plane that built the image.
+	BuilderVersion string `protobuf:"bytes,3,opt,name=builder_version,json=builderVersion,proto3" json:"builder_version,omitempty"`
+	// hash identifying all inputs of the image. this is the root of the
+	// hash tree of the files, combined with the hashes of the assets.
+	InputHash string `protobuf:"bytes,4,opt,name=input_hash,json=inputHash,proto3" json:"input_hash,omitempty"`
+	// jar files contained in the image, including the ones of assets.
+	Plugins      []*PluginHash          `protobuf:"bytes,5,rep,name=plugins,proto3" json:"plugins,omitempty"`
+	ImageBuiltAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=image_built_at,json=imageBuiltAt,proto3" json:"image_built_at,omitempty"`
+	// node the checkpoint has been built on. not set, if
+	// the checkpoint has not been built yet.
+	CheckpointNodeId string `protobuf:"bytes,7,opt,name=checkpoint_node_id,json=checkpointNodeId,proto3" json:"checkpoint_node_id,omitempty"`
+	// vcs revision of platformd running on the node at the time
+	// the checkpoint has been built.
+	PlatformdVersion  string                 `protobuf:"bytes,8,opt,name=platformd_version,json=platformdVersion,proto3" json:"platformd_version,omitempty"`
+	CheckpointBuiltAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=checkpoint_built_at,json=checkpointBuiltAt,proto3" json:"checkpoint_built_at,omitempty"`
+}
+
+func (x *BuildProvenance) Reset() {
+	*x = BuildProvenance{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *File) String() string {
+func (x *BuildProvenance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*File) ProtoMessage() {}
+func (*BuildProvenance) ProtoMessage() {}
 
-func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_chunk_v1alpha1_types_proto_msgTypes[4]
+func (x *BuildProvenance) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,49 +943,1629 @@ func (x *File) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use File.ProtoReflect.Descriptor instead.
-func (*File) Descriptor() ([]byte, []int) {
-	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BuildProvenance.ProtoReflect.Descriptor instead.
+func (*BuildProvenance) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *File) GetPath() string {
+func (x *BuildProvenance) GetBaseImageDigest() string {
 	if x != nil {
-		return x.Path
+		return x.BaseImageDigest
+	}
+	return ""
+}
+
+func (x *BuildProvenance) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
+func (x *BuildProvenance) GetBuilderVersion() string {
+	if x != nil {
+		return x.BuilderVersion
+	}
+	return ""
+}
+
+func (x *BuildProvenance) GetInputHash() string {
+	if x != nil {
+		return x.InputHash
+	}
+	return ""
+}
+
+func (x *BuildProvenance) GetPlugins() []*PluginHash {
+	if x != nil {
+		return x.Plugins
+	}
+	return nil
+}
+
+func (x *BuildProvenance) GetImageBuiltAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ImageBuiltAt
+	}
+	return nil
+}
+
+func (x *BuildProvenance) GetCheckpointNodeId() string {
+	if x != nil {
+		return x.CheckpointNodeId
+	}
+	return ""
+}
+
+func (x *BuildProvenance) GetPlatformdVersion() string {
+	if x != nil {
+		return x.PlatformdVersion
+	}
+	return ""
+}
+
+func (x *BuildProvenance) GetCheckpointBuiltAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckpointBuiltAt
+	}
+	return nil
+}
+
+type PluginHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// absolute path of the jar file inside the image.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// hex encoded, 256-bit SHA256 digest of the jar file.
+	Sha256 string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *PluginHash) Reset() {
+	*x = PluginHash{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginHash) ProtoMessage() {}
+
+func (x *PluginHash) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginHash.ProtoReflect.Descriptor instead.
+func (*PluginHash) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PluginHash) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PluginHash) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// Asset is a bundle of files, like a resource pack, that
+// can be shared between flavor versions, so it only has
+// to be uploaded and stored once.
+type Asset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hex encoded, 256-bit SHA256 digest of the bundle.
+	Hash      string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	SizeBytes uint64                 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Asset) ProtoMessage() {}
+
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Asset) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Asset) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Asset) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Canary splits the instances launched for a flavor between its stable
+// version and a new canary version, so creators can safely roll out risky
+// changes. While active, the given percentage of instances launched for
+// either version run the canary version. Once promoted, instances launched
+// for the stable version run the canary version. Once aborted, instances
+// launched for the canary version run the stable version.
+type Canary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FlavorId              string                 `protobuf:"bytes,2,opt,name=flavor_id,json=flavorId,proto3" json:"flavor_id,omitempty"`
+	StableFlavorVersionId string                 `protobuf:"bytes,3,opt,name=stable_flavor_version_id,json=stableFlavorVersionId,proto3" json:"stable_flavor_version_id,omitempty"`
+	CanaryFlavorVersionId string                 `protobuf:"bytes,4,opt,name=canary_flavor_version_id,json=canaryFlavorVersionId,proto3" json:"canary_flavor_version_id,omitempty"`
+	Percentage            uint32                 `protobuf:"varint,5,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	State                 CanaryState            `protobuf:"varint,6,opt,name=state,proto3,enum=chunk.v1alpha1.CanaryState" json:"state,omitempty"`
+	StableStats           *CanaryStats           `protobuf:"bytes,7,opt,name=stable_stats,json=stableStats,proto3" json:"stable_stats,omitempty"`
+	CanaryStats           *CanaryStats           `protobuf:"bytes,8,opt,name=canary_stats,json=canaryStats,proto3" json:"canary_stats,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Canary) Reset() {
+	*x = Canary{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Canary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canary) ProtoMessage() {}
+
+func (x *Canary) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canary.ProtoReflect.Descriptor instead.
+func (*Canary) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Canary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Canary) GetFlavorId() string {
+	if x != nil {
+		return x.FlavorId
+	}
+	return ""
+}
+
+func (x *Canary) GetStableFlavorVersionId() string {
+	if x != nil {
+		return x.StableFlavorVersionId
+	}
+	return ""
+}
+
+func (x *Canary) GetCanaryFlavorVersionId() string {
+	if x != nil {
+		return x.CanaryFlavorVersionId
+	}
+	return ""
+}
+
+func (x *Canary) GetPercentage() uint32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *Canary) GetState() CanaryState {
+	if x != nil {
+		return x.State
+	}
+	return CanaryState_ACTIVE
+}
+
+func (x *Canary) GetStableStats() *CanaryStats {
+	if x != nil {
+		return x.StableStats
+	}
+	return nil
+}
+
+func (x *Canary) GetCanaryStats() *CanaryStats {
+	if x != nil {
+		return x.CanaryStats
+	}
+	return nil
+}
+
+func (x *Canary) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Canary) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CanaryStats describe the instances launched for one of
+// the versions of a canary while the canary was active.
+type CanaryStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Launches uint64 `protobuf:"varint,1,opt,name=launches,proto3" json:"launches,omitempty"`
+	// number of instances that crashed or have been killed
+	// at least once.
+	CrashedInstances uint64 `protobuf:"varint,2,opt,name=crashed_instances,json=crashedInstances,proto3" json:"crashed_instances,omitempty"`
+	// number of player connections. only instances that have
+	// not been removed yet are taken into account.
+	Connections uint64 `protobuf:"varint,3,opt,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *CanaryStats) Reset() {
+	*x = CanaryStats{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanaryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanaryStats) ProtoMessage() {}
+
+func (x *CanaryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanaryStats.ProtoReflect.Descriptor instead.
+func (*CanaryStats) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CanaryStats) GetLaunches() uint64 {
+	if x != nil {
+		return x.Launches
+	}
+	return 0
+}
+
+func (x *CanaryStats) GetCrashedInstances() uint64 {
+	if x != nil {
+		return x.CrashedInstances
+	}
+	return 0
+}
+
+func (x *CanaryStats) GetConnections() uint64 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+// FlavorChannel points to the flavor version launched for the channel of a
+// flavor. This allows publishing a version to a "beta" channel consumed by
+// test instances, while the "stable" channel keeps serving players. The
+// autoscaler runs the version of the "stable" channel if one is published.
+type FlavorChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlavorId        string                 `protobuf:"bytes,1,opt,name=flavor_id,json=flavorId,proto3" json:"flavor_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FlavorVersionId string                 `protobuf:"bytes,3,opt,name=flavor_version_id,json=flavorVersionId,proto3" json:"flavor_version_id,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *FlavorChannel) Reset() {
+	*x = FlavorChannel{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlavorChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlavorChannel) ProtoMessage() {}
+
+func (x *FlavorChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlavorChannel.ProtoReflect.Descriptor instead.
+func (*FlavorChannel) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FlavorChannel) GetFlavorId() string {
+	if x != nil {
+		return x.FlavorId
+	}
+	return ""
+}
+
+func (x *FlavorChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlavorChannel) GetFlavorVersionId() string {
+	if x != nil {
+		return x.FlavorVersionId
+	}
+	return ""
+}
+
+func (x *FlavorChannel) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// RetentionPolicy decides which flavor versions of a chunk are removed
+// together with their images. A version is kept if it is one of the
+// keep_last_versions most recently created versions of its flavor or
+// if it has been created less than keep_days days ago. Rules set to 0
+// are disabled and if all rules are disabled, all versions are kept.
+// Versions that are still being built, run by instances, part of an
+// active canary or published to a channel are never removed.
+type RetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkId          string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	KeepLastVersions uint32                 `protobuf:"varint,2,opt,name=keep_last_versions,json=keepLastVersions,proto3" json:"keep_last_versions,omitempty"`
+	KeepDays         uint32                 `protobuf:"varint,3,opt,name=keep_days,json=keepDays,proto3" json:"keep_days,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RetentionPolicy) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetKeepLastVersions() uint32 {
+	if x != nil {
+		return x.KeepLastVersions
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetKeepDays() uint32 {
+	if x != nil {
+		return x.KeepDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// AutoscalingPolicy keeps enough instances of the latest built version of a
+// flavor running to serve its players. The demand of a flavor consists of
+// the players connected to its instances and the players that could not
+// join recently, because no instance had room for them. The autoscaler
+// starts as many instances as needed to fill target_utilization percent
+// of their player slots, but never fewer than min_instances or more than
+// max_instances. Instances started by hand count towards the demand that
+// is already served, but are never stopped by the autoscaler.
+//
+// Instances are started at most once per scale_up_cooldown_seconds. Only
+// empty instances started by the autoscaler are stopped, at most once per
+// scale_down_cooldown_seconds after the last scaling decision.
+type AutoscalingPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlavorId                 string `protobuf:"bytes,1,opt,name=flavor_id,json=flavorId,proto3" json:"flavor_id,omitempty"`
+	MinInstances             uint32 `protobuf:"varint,2,opt,name=min_instances,json=minInstances,proto3" json:"min_instances,omitempty"`
+	MaxInstances             uint32 `protobuf:"varint,3,opt,name=max_instances,json=maxInstances,proto3" json:"max_instances,omitempty"`
+	TargetUtilization        uint32 `protobuf:"varint,4,opt,name=target_utilization,json=targetUtilization,proto3" json:"target_utilization,omitempty"`
+	ScaleUpCooldownSeconds   uint32 `protobuf:"varint,5,opt,name=scale_up_cooldown_seconds,json=scaleUpCooldownSeconds,proto3" json:"scale_up_cooldown_seconds,omitempty"`
+	ScaleDownCooldownSeconds uint32 `protobuf:"varint,6,opt,name=scale_down_cooldown_seconds,json=scaleDownCooldownSeconds,proto3" json:"scale_down_cooldown_seconds,omitempty"`
+	// not set if the autoscaler has not started any instances yet.
+	LastScaleUpAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_scale_up_at,json=lastScaleUpAt,proto3" json:"last_scale_up_at,omitempty"`
+	// not set if the autoscaler has not stopped any instances yet.
+	LastScaleDownAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_scale_down_at,json=lastScaleDownAt,proto3" json:"last_scale_down_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AutoscalingPolicy) Reset() {
+	*x = AutoscalingPolicy{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoscalingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoscalingPolicy) ProtoMessage() {}
+
+func (x *AutoscalingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoscalingPolicy.ProtoReflect.Descriptor instead.
+func (*AutoscalingPolicy) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AutoscalingPolicy) GetFlavorId() string {
+	if x != nil {
+		return x.FlavorId
+	}
+	return ""
+}
+
+func (x *AutoscalingPolicy) GetMinInstances() uint32 {
+	if x != nil {
+		return x.MinInstances
+	}
+	return 0
+}
+
+func (x *AutoscalingPolicy) GetMaxInstances() uint32 {
+	if x != nil {
+		return x.MaxInstances
+	}
+	return 0
+}
+
+func (x *AutoscalingPolicy) GetTargetUtilization() uint32 {
+	if x != nil {
+		return x.TargetUtilization
+	}
+	return 0
+}
+
+func (x *AutoscalingPolicy) GetScaleUpCooldownSeconds() uint32 {
+	if x != nil {
+		return x.ScaleUpCooldownSeconds
+	}
+	return 0
+}
+
+func (x *AutoscalingPolicy) GetScaleDownCooldownSeconds() uint32 {
+	if x != nil {
+		return x.ScaleDownCooldownSeconds
+	}
+	return 0
+}
+
+func (x *AutoscalingPolicy) GetLastScaleUpAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastScaleUpAt
+	}
+	return nil
+}
+
+func (x *AutoscalingPolicy) GetLastScaleDownAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastScaleDownAt
+	}
+	return nil
+}
+
+func (x *AutoscalingPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ChunkReadme is the long-form markdown description shown
+// on the page of a chunk. can be up to 64KiB.
+type ChunkReadme struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkId   string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	Markdown  string                 `protobuf:"bytes,2,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ChunkReadme) Reset() {
+	*x = ChunkReadme{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkReadme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkReadme) ProtoMessage() {}
+
+func (x *ChunkReadme) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkReadme.ProtoReflect.Descriptor instead.
+func (*ChunkReadme) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChunkReadme) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *ChunkReadme) GetMarkdown() string {
+	if x != nil {
+		return x.Markdown
+	}
+	return ""
+}
+
+func (x *ChunkReadme) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ChunkMedia is an image shown on the page of a chunk, like
+// a screenshot. images are identified by their content, so
+// a chunk cannot contain the same image twice.
+type ChunkMedia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChunkId string `protobuf:"bytes,2,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	// hex encoded, 256-bit SHA256 digest of the image.
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// one of image/png, image/jpeg or image/webp.
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes   uint64 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// presigned URL the image can be downloaded from. only set
+	// when listing media.
+	DownloadUrl          string                 `protobuf:"bytes,6,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	DownloadUrlExpiresAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=download_url_expires_at,json=downloadUrlExpiresAt,proto3" json:"download_url_expires_at,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ChunkMedia) Reset() {
+	*x = ChunkMedia{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkMedia) ProtoMessage() {}
+
+func (x *ChunkMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkMedia.ProtoReflect.Descriptor instead.
+func (*ChunkMedia) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ChunkMedia) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChunkMedia) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *ChunkMedia) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ChunkMedia) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ChunkMedia) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ChunkMedia) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ChunkMedia) GetDownloadUrlExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DownloadUrlExpiresAt
+	}
+	return nil
+}
+
+func (x *ChunkMedia) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CheckpointResources overrides the resources assigned to the
+// server while it is being prepared for checkpointing. large
+// modpacks for example might need more memory to start up.
+// fields that are not set fall back to the defaults of the
+// node the checkpoint is created on.
+type CheckpointResources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cpu time in thousandths of a cpu core.
+	CpuMillis        uint32 `protobuf:"varint,1,opt,name=cpu_millis,json=cpuMillis,proto3" json:"cpu_millis,omitempty"`
+	MemoryLimitBytes uint64 `protobuf:"varint,2,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3" json:"memory_limit_bytes,omitempty"`
+}
+
+func (x *CheckpointResources) Reset() {
+	*x = CheckpointResources{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckpointResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointResources) ProtoMessage() {}
+
+func (x *CheckpointResources) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointResources.ProtoReflect.Descriptor instead.
+func (*CheckpointResources) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CheckpointResources) GetCpuMillis() uint32 {
+	if x != nil {
+		return x.CpuMillis
+	}
+	return 0
+}
+
+func (x *CheckpointResources) GetMemoryLimitBytes() uint64 {
+	if x != nil {
+		return x.MemoryLimitBytes
+	}
+	return 0
+}
+
+// BuildLimits restrict the size of flavor versions that are
+// accepted by the build process. limits that are 0 are not
+// enforced.
+type BuildLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxFileCount uint64 `protobuf:"varint,1,opt,name=max_file_count,json=maxFileCount,proto3" json:"max_file_count,omitempty"`
+	// maximum uncompressed size of all files of a flavor version.
+	MaxTotalSizeBytes uint64 `protobuf:"varint,2,opt,name=max_total_size_bytes,json=maxTotalSizeBytes,proto3" json:"max_total_size_bytes,omitempty"`
+	// maximum compressed size of the image layer containing
+	// the files of a flavor version.
+	MaxLayerSizeBytes uint64 `protobuf:"varint,3,opt,name=max_layer_size_bytes,json=maxLayerSizeBytes,proto3" json:"max_layer_size_bytes,omitempty"`
+	// maximum uncompressed size of a single file.
+	MaxFileSizeBytes uint64 `protobuf:"varint,4,opt,name=max_file_size_bytes,json=maxFileSizeBytes,proto3" json:"max_file_size_bytes,omitempty"`
+}
+
+func (x *BuildLimits) Reset() {
+	*x = BuildLimits{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildLimits) ProtoMessage() {}
+
+func (x *BuildLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildLimits.ProtoReflect.Descriptor instead.
+func (*BuildLimits) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BuildLimits) GetMaxFileCount() uint64 {
+	if x != nil {
+		return x.MaxFileCount
+	}
+	return 0
+}
+
+func (x *BuildLimits) GetMaxTotalSizeBytes() uint64 {
+	if x != nil {
+		return x.MaxTotalSizeBytes
+	}
+	return 0
+}
+
+func (x *BuildLimits) GetMaxLayerSizeBytes() uint64 {
+	if x != nil {
+		return x.MaxLayerSizeBytes
+	}
+	return 0
+}
+
+func (x *BuildLimits) GetMaxFileSizeBytes() uint64 {
+	if x != nil {
+		return x.MaxFileSizeBytes
+	}
+	return 0
+}
+
+type FileHashes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// size of the file as reported when creating the flavor version.
+	// 0 for files of flavor versions created before sizes were recorded.
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *FileHashes) Reset() {
+	*x = FileHashes{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileHashes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileHashes) ProtoMessage() {}
+
+func (x *FileHashes) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileHashes.ProtoReflect.Descriptor instead.
+func (*FileHashes) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FileHashes) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileHashes) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *FileHashes) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *File) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
 func (x *File) GetData() []byte {
 	if x != nil {
-		return x.Data
+		return x.Data
+	}
+	return nil
+}
+
+type MinecraftVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// base_image is the image flavor versions running this
+	// minecraft version are based on. the server files are
+	// located in /opt/paper.
+	BaseImage string `protobuf:"bytes,2,opt,name=base_image,json=baseImage,proto3" json:"base_image,omitempty"`
+}
+
+func (x *MinecraftVersion) Reset() {
+	*x = MinecraftVersion{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MinecraftVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinecraftVersion) ProtoMessage() {}
+
+func (x *MinecraftVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinecraftVersion.ProtoReflect.Descriptor instead.
+func (*MinecraftVersion) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MinecraftVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *MinecraftVersion) GetBaseImage() string {
+	if x != nil {
+		return x.BaseImage
+	}
+	return ""
+}
+
+type Thumbnail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hash is the xxh3 hash of the image
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *Thumbnail) Reset() {
+	*x = Thumbnail{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Thumbnail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Thumbnail) ProtoMessage() {}
+
+func (x *Thumbnail) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thumbnail.ProtoReflect.Descriptor instead.
+func (*Thumbnail) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Thumbnail) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// ChunkImport creates the first version of a flavor from an uploaded archive
+// of an existing server. The chunk and flavor are created right away, the
+// flavor version once the archive has been unpacked.
+type ChunkImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChunkId  string `protobuf:"bytes,2,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	FlavorId string `protobuf:"bytes,3,opt,name=flavor_id,json=flavorId,proto3" json:"flavor_id,omitempty"`
+	// only set once the archive has been unpacked.
+	FlavorVersionId string           `protobuf:"bytes,4,opt,name=flavor_version_id,json=flavorVersionId,proto3" json:"flavor_version_id,omitempty"`
+	State           ChunkImportState `protobuf:"varint,5,opt,name=state,proto3,enum=chunk.v1alpha1.ChunkImportState" json:"state,omitempty"`
+	// human-readable reason why the import failed, for example because
+	// the archive exceeds the build limits. only set if the state is
+	// IMPORT_FAILED.
+	Error     string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ChunkImport) Reset() {
+	*x = ChunkImport{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkImport) ProtoMessage() {}
+
+func (x *ChunkImport) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkImport.ProtoReflect.Descriptor instead.
+func (*ChunkImport) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ChunkImport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChunkImport) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *ChunkImport) GetFlavorId() string {
+	if x != nil {
+		return x.FlavorId
+	}
+	return ""
+}
+
+func (x *ChunkImport) GetFlavorVersionId() string {
+	if x != nil {
+		return x.FlavorVersionId
+	}
+	return ""
+}
+
+func (x *ChunkImport) GetState() ChunkImportState {
+	if x != nil {
+		return x.State
+	}
+	return ChunkImportState_IMPORT_PENDING_UPLOAD
+}
+
+func (x *ChunkImport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ChunkImport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChunkImport) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ChunkTemplate is a curated starter chunk. Creating a chunk from a template
+// copies the files of its flavor version into the new chunk.
+type ChunkTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// the built flavor version the files are copied from.
+	FlavorVersionId string                 `protobuf:"bytes,4,opt,name=flavor_version_id,json=flavorVersionId,proto3" json:"flavor_version_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ChunkTemplate) Reset() {
+	*x = ChunkTemplate{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkTemplate) ProtoMessage() {}
+
+func (x *ChunkTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkTemplate.ProtoReflect.Descriptor instead.
+func (*ChunkTemplate) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChunkTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChunkTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChunkTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ChunkTemplate) GetFlavorVersionId() string {
+	if x != nil {
+		return x.FlavorVersionId
+	}
+	return ""
+}
+
+func (x *ChunkTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChunkTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CrossInstanceTraffic is the request of a chunk to let its instances connect
+// to other instances on the same node. Instances are isolated from each other
+// by default, so the request has to be approved by an admin first. Approving
+// or revoking it only affects instances created afterward.
+type CrossInstanceTraffic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkId string                    `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	State   CrossInstanceTrafficState `protobuf:"varint,2,opt,name=state,proto3,enum=chunk.v1alpha1.CrossInstanceTrafficState" json:"state,omitempty"`
+	// why the instances of the chunk need to talk to each other,
+	// e.g. because a lobby hands off players to arena instances.
+	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy string `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	// only set once the request has been reviewed.
+	ReviewedBy string `protobuf:"bytes,5,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	// message left by the admin reviewing the request.
+	ReviewMessage string                 `protobuf:"bytes,6,opt,name=review_message,json=reviewMessage,proto3" json:"review_message,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *CrossInstanceTraffic) Reset() {
+	*x = CrossInstanceTraffic{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrossInstanceTraffic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossInstanceTraffic) ProtoMessage() {}
+
+func (x *CrossInstanceTraffic) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossInstanceTraffic.ProtoReflect.Descriptor instead.
+func (*CrossInstanceTraffic) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CrossInstanceTraffic) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *CrossInstanceTraffic) GetState() CrossInstanceTrafficState {
+	if x != nil {
+		return x.State
+	}
+	return CrossInstanceTrafficState_TRAFFIC_NOT_REQUESTED
+}
+
+func (x *CrossInstanceTraffic) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CrossInstanceTraffic) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CrossInstanceTraffic) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *CrossInstanceTraffic) GetReviewMessage() string {
+	if x != nil {
+		return x.ReviewMessage
+	}
+	return ""
+}
+
+func (x *CrossInstanceTraffic) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CrossInstanceTraffic) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// InstanceSchedule starts an instance of a flavor version at the times
+// matching start_cron and stops it at the times matching stop_cron. Cron
+// expressions consist of the five fields minute, hour, day of month, month
+// and day of week, e.g. "0 18 * * FRI". Both are evaluated in the time zone
+// of the schedule. At most one instance is running per schedule, so a start
+// is skipped if the instance of the previous start is still there.
+type InstanceSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChunkId         string `protobuf:"bytes,2,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	FlavorVersionId string `protobuf:"bytes,3,opt,name=flavor_version_id,json=flavorVersionId,proto3" json:"flavor_version_id,omitempty"`
+	StartCron       string `protobuf:"bytes,4,opt,name=start_cron,json=startCron,proto3" json:"start_cron,omitempty"`
+	// empty if the instance is never stopped by the schedule.
+	StopCron string `protobuf:"bytes,5,opt,name=stop_cron,json=stopCron,proto3" json:"stop_cron,omitempty"`
+	// IANA time zone, e.g. Europe/Berlin.
+	Timezone string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// the instance started by the schedule. empty if the schedule
+	// has not started an instance or has stopped it again.
+	InstanceId string                 `protobuf:"bytes,7,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	CreatedBy  string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *InstanceSchedule) Reset() {
+	*x = InstanceSchedule{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSchedule) ProtoMessage() {}
+
+func (x *InstanceSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSchedule.ProtoReflect.Descriptor instead.
+func (*InstanceSchedule) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InstanceSchedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InstanceSchedule) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *InstanceSchedule) GetFlavorVersionId() string {
+	if x != nil {
+		return x.FlavorVersionId
+	}
+	return ""
+}
+
+func (x *InstanceSchedule) GetStartCron() string {
+	if x != nil {
+		return x.StartCron
+	}
+	return ""
+}
+
+func (x *InstanceSchedule) GetStopCron() string {
+	if x != nil {
+		return x.StopCron
+	}
+	return ""
+}
+
+func (x *InstanceSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *InstanceSchedule) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceSchedule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *InstanceSchedule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type Thumbnail struct {
+// ScheduleRun is a point in time a schedule starts or stops its instance.
+type ScheduleRun struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// hash is the xxh3 hash of the image
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Action ScheduleAction         `protobuf:"varint,2,opt,name=action,proto3,enum=chunk.v1alpha1.ScheduleAction" json:"action,omitempty"`
 }
 
-func (x *Thumbnail) Reset() {
-	*x = Thumbnail{}
-	mi := &file_chunk_v1alpha1_types_proto_msgTypes[5]
+func (x *ScheduleRun) Reset() {
+	*x = ScheduleRun{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Thumbnail) String() string {
+func (x *ScheduleRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Thumbnail) ProtoMessage() {}
+func (*ScheduleRun) ProtoMessage() {}
 
-func (x *Thumbnail) ProtoReflect() protoreflect.Message {
-	mi := &file_chunk_v1alpha1_types_proto_msgTypes[5]
+func (x *ScheduleRun) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,14 +2576,341 @@ func (x *Thumbnail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Thumbnail.ProtoReflect.Descriptor instead.
-func (*Thumbnail) Descriptor() ([]byte, []int) {
-	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ScheduleRun.ProtoReflect.Descriptor instead.
+func (*ScheduleRun) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Thumbnail) GetHash() string {
+func (x *ScheduleRun) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Hash
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetAction() ScheduleAction {
+	if x != nil {
+		return x.Action
+	}
+	return ScheduleAction_SCHEDULE_START
+}
+
+// Cost is attributed to instances based on the time they have been
+// running and the resources assigned to them. Costs are in the
+// currency the platform is billed in.
+type Cost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cpu    float64 `protobuf:"fixed64,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory float64 `protobuf:"fixed64,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// share of the flat price of the node the instances ran on.
+	Node  float64 `protobuf:"fixed64,3,opt,name=node,proto3" json:"node,omitempty"`
+	Total float64 `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *Cost) Reset() {
+	*x = Cost{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cost) ProtoMessage() {}
+
+func (x *Cost) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cost.ProtoReflect.Descriptor instead.
+func (*Cost) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Cost) GetCpu() float64 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *Cost) GetMemory() float64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Cost) GetNode() float64 {
+	if x != nil {
+		return x.Node
+	}
+	return 0
+}
+
+func (x *Cost) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// InstanceCost is the cost of an instance within the requested window.
+type InstanceCost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId  string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	CpuMillis   uint64                 `protobuf:"varint,2,opt,name=cpu_millis,json=cpuMillis,proto3" json:"cpu_millis,omitempty"`
+	MemoryBytes uint64                 `protobuf:"varint,3,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	StartedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// not set while the instance is running.
+	StoppedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"`
+	// time the instance has been running within the window.
+	Hours float64 `protobuf:"fixed64,6,opt,name=hours,proto3" json:"hours,omitempty"`
+	Cost  *Cost   `protobuf:"bytes,7,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *InstanceCost) Reset() {
+	*x = InstanceCost{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceCost) ProtoMessage() {}
+
+func (x *InstanceCost) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceCost.ProtoReflect.Descriptor instead.
+func (*InstanceCost) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InstanceCost) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceCost) GetCpuMillis() uint64 {
+	if x != nil {
+		return x.CpuMillis
+	}
+	return 0
+}
+
+func (x *InstanceCost) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *InstanceCost) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *InstanceCost) GetStoppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return nil
+}
+
+func (x *InstanceCost) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *InstanceCost) GetCost() *Cost {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+// ChunkCost is the sum of the costs of the instances of a chunk.
+type ChunkCost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkId       string  `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	ChunkName     string  `protobuf:"bytes,2,opt,name=chunk_name,json=chunkName,proto3" json:"chunk_name,omitempty"`
+	Instances     uint32  `protobuf:"varint,3,opt,name=instances,proto3" json:"instances,omitempty"`
+	InstanceHours float64 `protobuf:"fixed64,4,opt,name=instance_hours,json=instanceHours,proto3" json:"instance_hours,omitempty"`
+	Cost          *Cost   `protobuf:"bytes,5,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *ChunkCost) Reset() {
+	*x = ChunkCost{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkCost) ProtoMessage() {}
+
+func (x *ChunkCost) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkCost.ProtoReflect.Descriptor instead.
+func (*ChunkCost) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChunkCost) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *ChunkCost) GetChunkName() string {
+	if x != nil {
+		return x.ChunkName
+	}
+	return ""
+}
+
+func (x *ChunkCost) GetInstances() uint32 {
+	if x != nil {
+		return x.Instances
+	}
+	return 0
+}
+
+func (x *ChunkCost) GetInstanceHours() float64 {
+	if x != nil {
+		return x.InstanceHours
+	}
+	return 0
+}
+
+func (x *ChunkCost) GetCost() *Cost {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+// ChunkSpecChange is a change made when applying a chunk spec.
+type ChunkSpecChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action ChunkSpecChangeAction `protobuf:"varint,1,opt,name=action,proto3,enum=chunk.v1alpha1.ChunkSpecChangeAction" json:"action,omitempty"`
+	// the changed part of the chunk, e.g. flavors/lobby/channels/stable.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// empty if the part has been created.
+	OldValue string `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// empty if the part has been deleted.
+	NewValue string `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (x *ChunkSpecChange) Reset() {
+	*x = ChunkSpecChange{}
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkSpecChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkSpecChange) ProtoMessage() {}
+
+func (x *ChunkSpecChange) ProtoReflect() protoreflect.Message {
+	mi := &file_chunk_v1alpha1_types_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkSpecChange.ProtoReflect.Descriptor instead.
+func (*ChunkSpecChange) Descriptor() ([]byte, []int) {
+	return file_chunk_v1alpha1_types_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChunkSpecChange) GetAction() ChunkSpecChangeAction {
+	if x != nil {
+		return x.Action
+	}
+	return ChunkSpecChangeAction_SPEC_CHANGE_CREATE
+}
+
+func (x *ChunkSpecChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ChunkSpecChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ChunkSpecChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
 	}
 	return ""
 }
@@ -624,7 +2967,7 @@ var file_chunk_v1alpha1_types_proto_rawDesc = []byte{
 	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x22, 0x99, 0x03, 0x0a, 0x0d, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x22, 0x8d, 0x07, 0x0a, 0x0d, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
@@ -649,24 +2992,407 @@ var file_chunk_v1alpha1_types_proto_rawDesc = []byte{
 	0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
 	0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x34, 0x0a, 0x0a,
-	0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x22, 0x2e, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x1f, 0x0a, 0x09, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x2a, 0x85, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00,
-	0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10,
-	0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44,
-	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10, 0x03, 0x12,
-	0x1b, 0x0a, 0x17, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x42, 0x55,
-	0x49, 0x4c, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
-	0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x42, 0x5e, 0x0a, 0x28, 0x63,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a, 0x14,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x13, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
+	0x3f, 0x0a, 0x1c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x47, 0x72,
+	0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x78, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6a, 0x61, 0x76, 0x61, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6a, 0x61, 0x76, 0x61,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x75, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x22, 0xc7, 0x03, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x34, 0x0a, 0x07, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x12, 0x40, 0x0a, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x74,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x74, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4a,
+	0x0a, 0x13, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x75, 0x69,
+	0x6c, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x41, 0x74, 0x22, 0x38, 0x0a, 0x0a, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x22, 0x75, 0x0a, 0x05, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf0, 0x03, 0x0a, 0x06,
+	0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6c, 0x61, 0x76, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6c,
+	0x61, 0x76, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x61,
+	0x76, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18,
+	0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0b, 0x63, 0x61, 0x6e,
+	0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x78,
+	0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x72, 0x61,
+	0x73, 0x68, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x0d, 0x46, 0x6c, 0x61,
+	0x76, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c,
+	0x61, 0x76, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x6c, 0x61, 0x76, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x66,
+	0x6c, 0x61, 0x76, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49,
+	0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6b,
+	0x65, 0x65, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xec, 0x03, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69,
+	0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75,
+	0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x75, 0x70, 0x5f,
+	0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x43,
+	0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3d,
+	0x0a, 0x1b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x63, 0x6f, 0x6f,
+	0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x18, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x43, 0x6f,
+	0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x43, 0x0a,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x75, 0x70, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70,
+	0x41, 0x74, 0x12, 0x47, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7f, 0x0a, 0x0b, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52,
+	0x65, 0x61, 0x64, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbe, 0x02, 0x0a, 0x0a, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x51, 0x0a, 0x17, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x55, 0x72, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x62, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x70, 0x75, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xc4, 0x01, 0x0a,
+	0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x65,
+	0x63, 0x72, 0x61, 0x66, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x1f, 0x0a, 0x09, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61,
+	0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xc5, 0x02, 0x0a, 0x0b, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x76, 0x6f,
+	0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf7,
+	0x01, 0x0a, 0x0d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xeb, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbc, 0x02, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x72, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x72, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x75, 0x0a, 0x0b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x75, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x04,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xa7, 0x02, 0x0a, 0x0c, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x70,
+	0x75, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x63, 0x70, 0x75, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x63, 0x6f,
+	0x73, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12,
+	0x28, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x73, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x0f, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3d, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0x85, 0x01, 0x0a, 0x0b, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4d, 0x41, 0x47, 0x45,
+	0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x41, 0x47,
+	0x45, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x42,
+	0x55, 0x49, 0x4c, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44,
+	0x10, 0x05, 0x2a, 0x36, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x4e,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x2a, 0x34, 0x0a, 0x0b, 0x43, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x2a, 0x6c, 0x0a, 0x10, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x49,
+	0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x79,
+	0x0a, 0x19, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x54,
+	0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49,
+	0x43, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x37, 0x0a, 0x0e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x00, 0x12,
+	0x11, 0x0a, 0x0d, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50,
+	0x10, 0x01, 0x2a, 0x5f, 0x0a, 0x15, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x50, 0x45, 0x43, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x50, 0x45, 0x43, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x10, 0x02, 0x2a, 0x36, 0x0a, 0x0f, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x54, 0x41, 0x4b, 0x45, 0x4e, 0x10, 0x02, 0x42, 0x5e, 0x0a, 0x28, 0x63,
 	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x65, 0x78, 0x70, 0x6c, 0x6f, 0x72, 0x65, 0x72, 0x2e, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
@@ -688,37 +3414,101 @@ func file_chunk_v1alpha1_types_proto_rawDescGZIP() []byte {
 	return file_chunk_v1alpha1_types_proto_rawDescData
 }
 
-var file_chunk_v1alpha1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_chunk_v1alpha1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_chunk_v1alpha1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_chunk_v1alpha1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_chunk_v1alpha1_types_proto_goTypes = []any{
-	(BuildStatus)(0),              // 0: chunk.v1alpha1.BuildStatus
-	(*Chunk)(nil),                 // 1: chunk.v1alpha1.Chunk
-	(*Flavor)(nil),                // 2: chunk.v1alpha1.Flavor
-	(*FlavorVersion)(nil),         // 3: chunk.v1alpha1.FlavorVersion
-	(*FileHashes)(nil),            // 4: chunk.v1alpha1.FileHashes
-	(*File)(nil),                  // 5: chunk.v1alpha1.File
-	(*Thumbnail)(nil),             // 6: chunk.v1alpha1.Thumbnail
-	(*v1alpha1.User)(nil),         // 7: user.v1alpha1.User
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(BuildStatus)(0),               // 0: chunk.v1alpha1.BuildStatus
+	(RestartPolicy)(0),             // 1: chunk.v1alpha1.RestartPolicy
+	(CanaryState)(0),               // 2: chunk.v1alpha1.CanaryState
+	(ChunkImportState)(0),          // 3: chunk.v1alpha1.ChunkImportState
+	(CrossInstanceTrafficState)(0), // 4: chunk.v1alpha1.CrossInstanceTrafficState
+	(ScheduleAction)(0),            // 5: chunk.v1alpha1.ScheduleAction
+	(ChunkSpecChangeAction)(0),     // 6: chunk.v1alpha1.ChunkSpecChangeAction
+	(ChunkNameStatus)(0),           // 7: chunk.v1alpha1.ChunkNameStatus
+	(*Chunk)(nil),                  // 8: chunk.v1alpha1.Chunk
+	(*Flavor)(nil),                 // 9: chunk.v1alpha1.Flavor
+	(*FlavorVersion)(nil),          // 10: chunk.v1alpha1.FlavorVersion
+	(*BuildProvenance)(nil),        // 11: chunk.v1alpha1.BuildProvenance
+	(*PluginHash)(nil),             // 12: chunk.v1alpha1.PluginHash
+	(*Asset)(nil),                  // 13: chunk.v1alpha1.Asset
+	(*Canary)(nil),                 // 14: chunk.v1alpha1.Canary
+	(*CanaryStats)(nil),            // 15: chunk.v1alpha1.CanaryStats
+	(*FlavorChannel)(nil),          // 16: chunk.v1alpha1.FlavorChannel
+	(*RetentionPolicy)(nil),        // 17: chunk.v1alpha1.RetentionPolicy
+	(*AutoscalingPolicy)(nil),      // 18: chunk.v1alpha1.AutoscalingPolicy
+	(*ChunkReadme)(nil),            // 19: chunk.v1alpha1.ChunkReadme
+	(*ChunkMedia)(nil),             // 20: chunk.v1alpha1.ChunkMedia
+	(*CheckpointResources)(nil),    // 21: chunk.v1alpha1.CheckpointResources
+	(*BuildLimits)(nil),            // 22: chunk.v1alpha1.BuildLimits
+	(*FileHashes)(nil),             // 23: chunk.v1alpha1.FileHashes
+	(*File)(nil),                   // 24: chunk.v1alpha1.File
+	(*MinecraftVersion)(nil),       // 25: chunk.v1alpha1.MinecraftVersion
+	(*Thumbnail)(nil),              // 26: chunk.v1alpha1.Thumbnail
+	(*ChunkImport)(nil),            // 27: chunk.v1alpha1.ChunkImport
+	(*ChunkTemplate)(nil),          // 28: chunk.v1alpha1.ChunkTemplate
+	(*CrossInstanceTraffic)(nil),   // 29: chunk.v1alpha1.CrossInstanceTraffic
+	(*InstanceSchedule)(nil),       // 30: chunk.v1alpha1.InstanceSchedule
+	(*ScheduleRun)(nil),            // 31: chunk.v1alpha1.ScheduleRun
+	(*Cost)(nil),                   // 32: chunk.v1alpha1.Cost
+	(*InstanceCost)(nil),           // 33: chunk.v1alpha1.InstanceCost
+	(*ChunkCost)(nil),              // 34: chunk.v1alpha1.ChunkCost
+	(*ChunkSpecChange)(nil),        // 35: chunk.v1alpha1.ChunkSpecChange
+	(*v1alpha1.User)(nil),          // 36: user.v1alpha1.User
+	(*timestamppb.Timestamp)(nil),  // 37: google.protobuf.Timestamp
 }
 var file_chunk_v1alpha1_types_proto_depIdxs = []int32{
-	2,  // 0: chunk.v1alpha1.Chunk.flavors:type_name -> chunk.v1alpha1.Flavor
-	7,  // 1: chunk.v1alpha1.Chunk.owner:type_name -> user.v1alpha1.User
-	8,  // 2: chunk.v1alpha1.Chunk.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 3: chunk.v1alpha1.Chunk.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 4: chunk.v1alpha1.Chunk.thumbnail:type_name -> chunk.v1alpha1.Thumbnail
-	8,  // 5: chunk.v1alpha1.Chunk.deleted_at:type_name -> google.protobuf.Timestamp
-	3,  // 6: chunk.v1alpha1.Flavor.versions:type_name -> chunk.v1alpha1.FlavorVersion
-	8,  // 7: chunk.v1alpha1.Flavor.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 8: chunk.v1alpha1.Flavor.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 9: chunk.v1alpha1.FlavorVersion.file_hashes:type_name -> chunk.v1alpha1.FileHashes
+	9,  // 0: chunk.v1alpha1.Chunk.flavors:type_name -> chunk.v1alpha1.Flavor
+	36, // 1: chunk.v1alpha1.Chunk.owner:type_name -> user.v1alpha1.User
+	37, // 2: chunk.v1alpha1.Chunk.created_at:type_name -> google.protobuf.Timestamp
+	37, // 3: chunk.v1alpha1.Chunk.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 4: chunk.v1alpha1.Chunk.thumbnail:type_name -> chunk.v1alpha1.Thumbnail
+	37, // 5: chunk.v1alpha1.Chunk.deleted_at:type_name -> google.protobuf.Timestamp
+	10, // 6: chunk.v1alpha1.Flavor.versions:type_name -> chunk.v1alpha1.FlavorVersion
+	37, // 7: chunk.v1alpha1.Flavor.created_at:type_name -> google.protobuf.Timestamp
+	37, // 8: chunk.v1alpha1.Flavor.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 9: chunk.v1alpha1.FlavorVersion.file_hashes:type_name -> chunk.v1alpha1.FileHashes
 	0,  // 10: chunk.v1alpha1.FlavorVersion.build_status:type_name -> chunk.v1alpha1.BuildStatus
-	8,  // 11: chunk.v1alpha1.FlavorVersion.created_at:type_name -> google.protobuf.Timestamp
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	37, // 11: chunk.v1alpha1.FlavorVersion.created_at:type_name -> google.protobuf.Timestamp
+	21, // 12: chunk.v1alpha1.FlavorVersion.checkpoint_resources:type_name -> chunk.v1alpha1.CheckpointResources
+	1,  // 13: chunk.v1alpha1.FlavorVersion.restart_policy:type_name -> chunk.v1alpha1.RestartPolicy
+	12, // 14: chunk.v1alpha1.BuildProvenance.plugins:type_name -> chunk.v1alpha1.PluginHash
+	37, // 15: chunk.v1alpha1.BuildProvenance.image_built_at:type_name -> google.protobuf.Timestamp
+	37, // 16: chunk.v1alpha1.BuildProvenance.checkpoint_built_at:type_name -> google.protobuf.Timestamp
+	37, // 17: chunk.v1alpha1.Asset.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 18: chunk.v1alpha1.Canary.state:type_name -> chunk.v1alpha1.CanaryState
+	15, // 19: chunk.v1alpha1.Canary.stable_stats:type_name -> chunk.v1alpha1.CanaryStats
+	15, // 20: chunk.v1alpha1.Canary.canary_stats:type_name -> chunk.v1alpha1.CanaryStats
+	37, // 21: chunk.v1alpha1.Canary.created_at:type_name -> google.protobuf.Timestamp
+	37, // 22: chunk.v1alpha1.Canary.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 23: chunk.v1alpha1.FlavorChannel.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 24: chunk.v1alpha1.RetentionPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 25: chunk.v1alpha1.AutoscalingPolicy.last_scale_up_at:type_name -> google.protobuf.Timestamp
+	37, // 26: chunk.v1alpha1.AutoscalingPolicy.last_scale_down_at:type_name -> google.protobuf.Timestamp
+	37, // 27: chunk.v1alpha1.AutoscalingPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 28: chunk.v1alpha1.ChunkReadme.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 29: chunk.v1alpha1.ChunkMedia.download_url_expires_at:type_name -> google.protobuf.Timestamp
+	37, // 30: chunk.v1alpha1.ChunkMedia.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 31: chunk.v1alpha1.ChunkImport.state:type_name -> chunk.v1alpha1.ChunkImportState
+	37, // 32: chunk.v1alpha1.ChunkImport.created_at:type_name -> google.protobuf.Timestamp
+	37, // 33: chunk.v1alpha1.ChunkImport.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 34: chunk.v1alpha1.ChunkTemplate.created_at:type_name -> google.protobuf.Timestamp
+	37, // 35: chunk.v1alpha1.ChunkTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 36: chunk.v1alpha1.CrossInstanceTraffic.state:type_name -> chunk.v1alpha1.CrossInstanceTrafficState
+	37, // 37: chunk.v1alpha1.CrossInstanceTraffic.created_at:type_name -> google.protobuf.Timestamp
+	37, // 38: chunk.v1alpha1.CrossInstanceTraffic.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 39: chunk.v1alpha1.InstanceSchedule.created_at:type_name -> google.protobuf.Timestamp
+	37, // 40: chunk.v1alpha1.ScheduleRun.time:type_name -> google.protobuf.Timestamp
+	5,  // 41: chunk.v1alpha1.ScheduleRun.action:type_name -> chunk.v1alpha1.ScheduleAction
+	37, // 42: chunk.v1alpha1.InstanceCost.started_at:type_name -> google.protobuf.Timestamp
+	37, // 43: chunk.v1alpha1.InstanceCost.stopped_at:type_name -> google.protobuf.Timestamp
+	32, // 44: chunk.v1alpha1.InstanceCost.cost:type_name -> chunk.v1alpha1.Cost
+	32, // 45: chunk.v1alpha1.ChunkCost.cost:type_name -> chunk.v1alpha1.Cost
+	6,  // 46: chunk.v1alpha1.ChunkSpecChange.action:type_name -> chunk.v1alpha1.ChunkSpecChangeAction
+	47, // [47:47] is the sub-list for method output_type
+	47, // [47:47] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_chunk_v1alpha1_types_proto_init() }
@@ -731,8 +3521,8 @@ func file_chunk_v1alpha1_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chunk_v1alpha1_types_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      8,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
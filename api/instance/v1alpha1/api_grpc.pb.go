@@ -40,8 +40,23 @@ const (
 	InstanceService_GetInstance_FullMethodName                  = "/instance.v1alpha1.InstanceService/GetInstance"
 	InstanceService_ListInstances_FullMethodName                = "/instance.v1alpha1.InstanceService/ListInstances"
 	InstanceService_RunFlavorVersion_FullMethodName             = "/instance.v1alpha1.InstanceService/RunFlavorVersion"
+	InstanceService_StopInstance_FullMethodName                 = "/instance.v1alpha1.InstanceService/StopInstance"
 	InstanceService_DiscoverInstances_FullMethodName            = "/instance.v1alpha1.InstanceService/DiscoverInstances"
+	InstanceService_WatchInstances_FullMethodName               = "/instance.v1alpha1.InstanceService/WatchInstances"
 	InstanceService_ReceiveInstanceStatusReports_FullMethodName = "/instance.v1alpha1.InstanceService/ReceiveInstanceStatusReports"
+	InstanceService_GetInstanceRedirects_FullMethodName         = "/instance.v1alpha1.InstanceService/GetInstanceRedirects"
+	InstanceService_GetInstanceEvents_FullMethodName            = "/instance.v1alpha1.InstanceService/GetInstanceEvents"
+	InstanceService_GetInstanceTimeline_FullMethodName          = "/instance.v1alpha1.InstanceService/GetInstanceTimeline"
+	InstanceService_CreateInstanceSnapshot_FullMethodName       = "/instance.v1alpha1.InstanceService/CreateInstanceSnapshot"
+	InstanceService_PlanPlacement_FullMethodName                = "/instance.v1alpha1.InstanceService/PlanPlacement"
+	InstanceService_GetPlatformdUpdate_FullMethodName           = "/instance.v1alpha1.InstanceService/GetPlatformdUpdate"
+	InstanceService_ReportPlatformdUpdateStatus_FullMethodName  = "/instance.v1alpha1.InstanceService/ReportPlatformdUpdateStatus"
+	InstanceService_ReportNodeConditions_FullMethodName         = "/instance.v1alpha1.InstanceService/ReportNodeConditions"
+	InstanceService_ReceiveInstanceLogs_FullMethodName          = "/instance.v1alpha1.InstanceService/ReceiveInstanceLogs"
+	InstanceService_GetInstanceLogs_FullMethodName              = "/instance.v1alpha1.InstanceService/GetInstanceLogs"
+	InstanceService_CreateInstanceInvite_FullMethodName         = "/instance.v1alpha1.InstanceService/CreateInstanceInvite"
+	InstanceService_ListInstanceInvites_FullMethodName          = "/instance.v1alpha1.InstanceService/ListInstanceInvites"
+	InstanceService_RevokeInstanceInvite_FullMethodName         = "/instance.v1alpha1.InstanceService/RevokeInstanceInvite"
 )
 
 // InstanceServiceClient is the client API for InstanceService service.
@@ -63,14 +78,211 @@ type InstanceServiceClient interface {
 	// Chunk and schedules it to be run on a node. The connection information
 	// of the returned instance will only be partially set, because the
 	// port will not be allocated at this point. However, the IP address
-	// is set.
+	// is set. Instead of a flavor version, a channel of a flavor can be
+	// selected, in which case the version published to it is run.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - no version has been published to the selected channel
 	RunFlavorVersion(ctx context.Context, in *RunFlavorVersionRequest, opts ...grpc.CallOption) (*RunFlavorVersionResponse, error)
+	// StopInstance stops the instance and removes it afterward. Stopping
+	// happens asynchronously, the instance will be in DELETING state until
+	// the node it is running on has removed it. Only the owner of the
+	// instance is allowed to stop it.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the instance does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	//
+	// - FAILED_PRECONDITION:
+	//   - the instance cannot be stopped in its current state
+	StopInstance(ctx context.Context, in *StopInstanceRequest, opts ...grpc.CallOption) (*StopInstanceResponse, error)
 	// DiscoverInstances returns all workloads that have been scheduled to a node for
 	// creation or removal. Platformd identifies itself using its unique node key.
+	//
+	// If a revision obtained from a previous call is passed, only instances
+	// that changed since that revision are returned. Instances that have been
+	// removed are not part of a delta, so callers should periodically request
+	// the full list by omitting the revision.
 	DiscoverInstances(ctx context.Context, in *DiscoverInstanceRequest, opts ...grpc.CallOption) (*DiscoverInstanceResponse, error)
+	// WatchInstances streams the instances scheduled to a node, so platformd
+	// gets notified about changes without having to poll DiscoverInstances.
+	// The first message contains all instances of the node, every following
+	// message only the instances that changed afterward. Like with
+	// DiscoverInstances, removed instances are not part of a delta.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the node key is missing
+	WatchInstances(ctx context.Context, in *WatchInstancesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchInstancesResponse], error)
 	// ReceiveInstanceStatusReports is intended to be called by platformd in order to report
-	// status updates back to the control plane.
+	// status updates back to the control plane. nodes hosting many instances have to split
+	// their reports into multiple requests. requests may be gzip compressed.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - more than 1000 reports have been sent
 	ReceiveInstanceStatusReports(ctx context.Context, in *ReceiveInstanceStatusReportsRequest, opts ...grpc.CallOption) (*ReceiveInstanceStatusReportsResponse, error)
+	// GetInstanceRedirects returns the redirects a node has to apply. If the
+	// port of an instance changes, for example because its workload had to be
+	// recreated, connections to the previous endpoint are forwarded to the
+	// new one for a grace period, so players do not end up nowhere.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the node key is missing
+	GetInstanceRedirects(ctx context.Context, in *GetInstanceRedirectsRequest, opts ...grpc.CallOption) (*GetInstanceRedirectsResponse, error)
+	// GetInstanceEvents returns the most recent events of an instance,
+	// newest first. Events are retained after the instance has been
+	// deleted, so users can find out why an instance disappeared.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	GetInstanceEvents(ctx context.Context, in *GetInstanceEventsRequest, opts ...grpc.CallOption) (*GetInstanceEventsResponse, error)
+	// GetInstanceTimeline returns the lifecycle events of an instance,
+	// oldest first. They include when the instance has been scheduled,
+	// its images were pulled, its containers were started, it became
+	// ready, the first player joined and when it has been removed, which
+	// helps finding out why an instance took long to start.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	GetInstanceTimeline(ctx context.Context, in *GetInstanceTimelineRequest, opts ...grpc.CallOption) (*GetInstanceTimelineResponse, error)
+	// CreateInstanceSnapshot takes a snapshot of the server directory of a running
+	// instance, including logs, worlds and configs, so it can be inspected after the
+	// server behaved strangely. The snapshot can be downloaded as gzip compressed
+	// tarball using the returned URL until it expires. Only the owner of the instance
+	// is allowed to create snapshots.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the instance does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	//
+	// - FAILED_PRECONDITION:
+	//   - the instance is not running
+	//   - the snapshot exceeds the maximum allowed size
+	CreateInstanceSnapshot(ctx context.Context, in *CreateInstanceSnapshotRequest, opts ...grpc.CallOption) (*CreateInstanceSnapshotResponse, error)
+	// PlanPlacement returns the nodes the scheduler would place the requested
+	// number of instances of a flavor version on, as well as the capacity that
+	// remains afterward. No instances are created, so this can be used to find
+	// out whether there is enough capacity before actually running instances.
+	// Because instances are scheduled concurrently, the returned plan is only
+	// a snapshot of the current capacity.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided flavor version id is invalid
+	//   - replicas is not between 1 and 10000
+	//
+	// - NOT_FOUND:
+	//   - the flavor version does not exist
+	PlanPlacement(ctx context.Context, in *PlanPlacementRequest, opts ...grpc.CallOption) (*PlanPlacementResponse, error)
+	// GetPlatformdUpdate records the platformd version the node is running and
+	// returns the update it should install, if a different version has been
+	// rolled out to it. Platformd identifies itself using its unique node key.
+	// The rollout to the node is completed, once it reports to be running the
+	// desired version.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the node does not exist
+	GetPlatformdUpdate(ctx context.Context, in *GetPlatformdUpdateRequest, opts ...grpc.CallOption) (*GetPlatformdUpdateResponse, error)
+	// ReportPlatformdUpdateStatus reports the progress of the update to the
+	// given version. Reports for versions that are no longer rolled out to
+	// the node are ignored.
+	ReportPlatformdUpdateStatus(ctx context.Context, in *ReportPlatformdUpdateStatusRequest, opts ...grpc.CallOption) (*ReportPlatformdUpdateStatusResponse, error)
+	// ReportNodeConditions replaces the conditions of the node with the given
+	// ones. Conditions describe local problems detected by platformd, like disk
+	// pressure. No instances are scheduled to nodes that report at least one
+	// condition, so nodes have to report an empty list once they recovered.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the node key is missing
+	//
+	// - NOT_FOUND:
+	//   - the node does not exist
+	ReportNodeConditions(ctx context.Context, in *ReportNodeConditionsRequest, opts ...grpc.CallOption) (*ReportNodeConditionsResponse, error)
+	// ReceiveInstanceLogs is intended to be called by platformd in order to ship
+	// the output of the minecraft servers running on the node. Logs of instances
+	// that do not exist anymore are dropped.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the node key is missing
+	ReceiveInstanceLogs(ctx context.Context, in *ReceiveInstanceLogsRequest, opts ...grpc.CallOption) (*ReceiveInstanceLogsResponse, error)
+	// GetInstanceLogs returns the log files shipped for an instance, oldest
+	// first. They are retained after the instance and its node are gone, so
+	// users can find out what happened, until no output has been shipped
+	// for the configured retention period. Each file can be downloaded
+	// using the returned URL until it expires.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	GetInstanceLogs(ctx context.Context, in *GetInstanceLogsRequest, opts ...grpc.CallOption) (*GetInstanceLogsResponse, error)
+	// CreateInstanceInvite creates an invite players can use to join the
+	// instance. The returned token has to be passed to the proxy plugin,
+	// which redeems it using the IntegrationService before letting the
+	// player join. The token is only returned once.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//   - max_uses is greater than 1000
+	//   - valid_for_minutes is not between 1 and 10080
+	//
+	// - NOT_FOUND:
+	//   - the instance does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	CreateInstanceInvite(ctx context.Context, in *CreateInstanceInviteRequest, opts ...grpc.CallOption) (*CreateInstanceInviteResponse, error)
+	// ListInstanceInvites returns all invites of the instance including the
+	// number of times they have been used, oldest first. Expired and revoked
+	// invites are returned as well. Tokens are never returned.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the instance does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	ListInstanceInvites(ctx context.Context, in *ListInstanceInvitesRequest, opts ...grpc.CallOption) (*ListInstanceInvitesResponse, error)
+	// RevokeInstanceInvite revokes the invite, so it cannot be used anymore.
+	// Players that already joined using it are not affected. Revoking an
+	// invite multiple times is not an error.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided invite id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the invite does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	RevokeInstanceInvite(ctx context.Context, in *RevokeInstanceInviteRequest, opts ...grpc.CallOption) (*RevokeInstanceInviteResponse, error)
 }
 
 type instanceServiceClient struct {
@@ -111,6 +323,16 @@ func (c *instanceServiceClient) RunFlavorVersion(ctx context.Context, in *RunFla
 	return out, nil
 }
 
+func (c *instanceServiceClient) StopInstance(ctx context.Context, in *StopInstanceRequest, opts ...grpc.CallOption) (*StopInstanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopInstanceResponse)
+	err := c.cc.Invoke(ctx, InstanceService_StopInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *instanceServiceClient) DiscoverInstances(ctx context.Context, in *DiscoverInstanceRequest, opts ...grpc.CallOption) (*DiscoverInstanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DiscoverInstanceResponse)
@@ -121,6 +343,25 @@ func (c *instanceServiceClient) DiscoverInstances(ctx context.Context, in *Disco
 	return out, nil
 }
 
+func (c *instanceServiceClient) WatchInstances(ctx context.Context, in *WatchInstancesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchInstancesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InstanceService_ServiceDesc.Streams[0], InstanceService_WatchInstances_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchInstancesRequest, WatchInstancesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InstanceService_WatchInstancesClient = grpc.ServerStreamingClient[WatchInstancesResponse]
+
 func (c *instanceServiceClient) ReceiveInstanceStatusReports(ctx context.Context, in *ReceiveInstanceStatusReportsRequest, opts ...grpc.CallOption) (*ReceiveInstanceStatusReportsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReceiveInstanceStatusReportsResponse)
@@ -131,6 +372,136 @@ func (c *instanceServiceClient) ReceiveInstanceStatusReports(ctx context.Context
 	return out, nil
 }
 
+func (c *instanceServiceClient) GetInstanceRedirects(ctx context.Context, in *GetInstanceRedirectsRequest, opts ...grpc.CallOption) (*GetInstanceRedirectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInstanceRedirectsResponse)
+	err := c.cc.Invoke(ctx, InstanceService_GetInstanceRedirects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetInstanceEvents(ctx context.Context, in *GetInstanceEventsRequest, opts ...grpc.CallOption) (*GetInstanceEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInstanceEventsResponse)
+	err := c.cc.Invoke(ctx, InstanceService_GetInstanceEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetInstanceTimeline(ctx context.Context, in *GetInstanceTimelineRequest, opts ...grpc.CallOption) (*GetInstanceTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInstanceTimelineResponse)
+	err := c.cc.Invoke(ctx, InstanceService_GetInstanceTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) CreateInstanceSnapshot(ctx context.Context, in *CreateInstanceSnapshotRequest, opts ...grpc.CallOption) (*CreateInstanceSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInstanceSnapshotResponse)
+	err := c.cc.Invoke(ctx, InstanceService_CreateInstanceSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) PlanPlacement(ctx context.Context, in *PlanPlacementRequest, opts ...grpc.CallOption) (*PlanPlacementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanPlacementResponse)
+	err := c.cc.Invoke(ctx, InstanceService_PlanPlacement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetPlatformdUpdate(ctx context.Context, in *GetPlatformdUpdateRequest, opts ...grpc.CallOption) (*GetPlatformdUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlatformdUpdateResponse)
+	err := c.cc.Invoke(ctx, InstanceService_GetPlatformdUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ReportPlatformdUpdateStatus(ctx context.Context, in *ReportPlatformdUpdateStatusRequest, opts ...grpc.CallOption) (*ReportPlatformdUpdateStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportPlatformdUpdateStatusResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ReportPlatformdUpdateStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ReportNodeConditions(ctx context.Context, in *ReportNodeConditionsRequest, opts ...grpc.CallOption) (*ReportNodeConditionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportNodeConditionsResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ReportNodeConditions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ReceiveInstanceLogs(ctx context.Context, in *ReceiveInstanceLogsRequest, opts ...grpc.CallOption) (*ReceiveInstanceLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiveInstanceLogsResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ReceiveInstanceLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetInstanceLogs(ctx context.Context, in *GetInstanceLogsRequest, opts ...grpc.CallOption) (*GetInstanceLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInstanceLogsResponse)
+	err := c.cc.Invoke(ctx, InstanceService_GetInstanceLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) CreateInstanceInvite(ctx context.Context, in *CreateInstanceInviteRequest, opts ...grpc.CallOption) (*CreateInstanceInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInstanceInviteResponse)
+	err := c.cc.Invoke(ctx, InstanceService_CreateInstanceInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ListInstanceInvites(ctx context.Context, in *ListInstanceInvitesRequest, opts ...grpc.CallOption) (*ListInstanceInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstanceInvitesResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ListInstanceInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) RevokeInstanceInvite(ctx context.Context, in *RevokeInstanceInviteRequest, opts ...grpc.CallOption) (*RevokeInstanceInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInstanceInviteResponse)
+	err := c.cc.Invoke(ctx, InstanceService_RevokeInstanceInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InstanceServiceServer is the server API for InstanceService service.
 // All implementations must embed UnimplementedInstanceServiceServer
 // for forward compatibility.
@@ -150,14 +521,211 @@ type InstanceServiceServer interface {
 	// Chunk and schedules it to be run on a node. The connection information
 	// of the returned instance will only be partially set, because the
 	// port will not be allocated at this point. However, the IP address
-	// is set.
+	// is set. Instead of a flavor version, a channel of a flavor can be
+	// selected, in which case the version published to it is run.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - no version has been published to the selected channel
 	RunFlavorVersion(context.Context, *RunFlavorVersionRequest) (*RunFlavorVersionResponse, error)
+	// StopInstance stops the instance and removes it afterward. Stopping
+	// happens asynchronously, the instance will be in DELETING state until
+	// the node it is running on has removed it. Only the owner of the
+	// instance is allowed to stop it.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the instance does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	//
+	// - FAILED_PRECONDITION:
+	//   - the instance cannot be stopped in its current state
+	StopInstance(context.Context, *StopInstanceRequest) (*StopInstanceResponse, error)
 	// DiscoverInstances returns all workloads that have been scheduled to a node for
 	// creation or removal. Platformd identifies itself using its unique node key.
+	//
+	// If a revision obtained from a previous call is passed, only instances
+	// that changed since that revision are returned. Instances that have been
+	// removed are not part of a delta, so callers should periodically request
+	// the full list by omitting the revision.
 	DiscoverInstances(context.Context, *DiscoverInstanceRequest) (*DiscoverInstanceResponse, error)
+	// WatchInstances streams the instances scheduled to a node, so platformd
+	// gets notified about changes without having to poll DiscoverInstances.
+	// The first message contains all instances of the node, every following
+	// message only the instances that changed afterward. Like with
+	// DiscoverInstances, removed instances are not part of a delta.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the node key is missing
+	WatchInstances(*WatchInstancesRequest, grpc.ServerStreamingServer[WatchInstancesResponse]) error
 	// ReceiveInstanceStatusReports is intended to be called by platformd in order to report
-	// status updates back to the control plane.
+	// status updates back to the control plane. nodes hosting many instances have to split
+	// their reports into multiple requests. requests may be gzip compressed.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - more than 1000 reports have been sent
 	ReceiveInstanceStatusReports(context.Context, *ReceiveInstanceStatusReportsRequest) (*ReceiveInstanceStatusReportsResponse, error)
+	// GetInstanceRedirects returns the redirects a node has to apply. If the
+	// port of an instance changes, for example because its workload had to be
+	// recreated, connections to the previous endpoint are forwarded to the
+	// new one for a grace period, so players do not end up nowhere.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the node key is missing
+	GetInstanceRedirects(context.Context, *GetInstanceRedirectsRequest) (*GetInstanceRedirectsResponse, error)
+	// GetInstanceEvents returns the most recent events of an instance,
+	// newest first. Events are retained after the instance has been
+	// deleted, so users can find out why an instance disappeared.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	GetInstanceEvents(context.Context, *GetInstanceEventsRequest) (*GetInstanceEventsResponse, error)
+	// GetInstanceTimeline returns the lifecycle events of an instance,
+	// oldest first. They include when the instance has been scheduled,
+	// its images were pulled, its containers were started, it became
+	// ready, the first player joined and when it has been removed, which
+	// helps finding out why an instance took long to start.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	GetInstanceTimeline(context.Context, *GetInstanceTimelineRequest) (*GetInstanceTimelineResponse, error)
+	// CreateInstanceSnapshot takes a snapshot of the server directory of a running
+	// instance, including logs, worlds and configs, so it can be inspected after the
+	// server behaved strangely. The snapshot can be downloaded as gzip compressed
+	// tarball using the returned URL until it expires. Only the owner of the instance
+	// is allowed to create snapshots.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the instance does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	//
+	// - FAILED_PRECONDITION:
+	//   - the instance is not running
+	//   - the snapshot exceeds the maximum allowed size
+	CreateInstanceSnapshot(context.Context, *CreateInstanceSnapshotRequest) (*CreateInstanceSnapshotResponse, error)
+	// PlanPlacement returns the nodes the scheduler would place the requested
+	// number of instances of a flavor version on, as well as the capacity that
+	// remains afterward. No instances are created, so this can be used to find
+	// out whether there is enough capacity before actually running instances.
+	// Because instances are scheduled concurrently, the returned plan is only
+	// a snapshot of the current capacity.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided flavor version id is invalid
+	//   - replicas is not between 1 and 10000
+	//
+	// - NOT_FOUND:
+	//   - the flavor version does not exist
+	PlanPlacement(context.Context, *PlanPlacementRequest) (*PlanPlacementResponse, error)
+	// GetPlatformdUpdate records the platformd version the node is running and
+	// returns the update it should install, if a different version has been
+	// rolled out to it. Platformd identifies itself using its unique node key.
+	// The rollout to the node is completed, once it reports to be running the
+	// desired version.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - the node does not exist
+	GetPlatformdUpdate(context.Context, *GetPlatformdUpdateRequest) (*GetPlatformdUpdateResponse, error)
+	// ReportPlatformdUpdateStatus reports the progress of the update to the
+	// given version. Reports for versions that are no longer rolled out to
+	// the node are ignored.
+	ReportPlatformdUpdateStatus(context.Context, *ReportPlatformdUpdateStatusRequest) (*ReportPlatformdUpdateStatusResponse, error)
+	// ReportNodeConditions replaces the conditions of the node with the given
+	// ones. Conditions describe local problems detected by platformd, like disk
+	// pressure. No instances are scheduled to nodes that report at least one
+	// condition, so nodes have to report an empty list once they recovered.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the node key is missing
+	//
+	// - NOT_FOUND:
+	//   - the node does not exist
+	ReportNodeConditions(context.Context, *ReportNodeConditionsRequest) (*ReportNodeConditionsResponse, error)
+	// ReceiveInstanceLogs is intended to be called by platformd in order to ship
+	// the output of the minecraft servers running on the node. Logs of instances
+	// that do not exist anymore are dropped.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the node key is missing
+	ReceiveInstanceLogs(context.Context, *ReceiveInstanceLogsRequest) (*ReceiveInstanceLogsResponse, error)
+	// GetInstanceLogs returns the log files shipped for an instance, oldest
+	// first. They are retained after the instance and its node are gone, so
+	// users can find out what happened, until no output has been shipped
+	// for the configured retention period. Each file can be downloaded
+	// using the returned URL until it expires.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	GetInstanceLogs(context.Context, *GetInstanceLogsRequest) (*GetInstanceLogsResponse, error)
+	// CreateInstanceInvite creates an invite players can use to join the
+	// instance. The returned token has to be passed to the proxy plugin,
+	// which redeems it using the IntegrationService before letting the
+	// player join. The token is only returned once.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//   - max_uses is greater than 1000
+	//   - valid_for_minutes is not between 1 and 10080
+	//
+	// - NOT_FOUND:
+	//   - the instance does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	CreateInstanceInvite(context.Context, *CreateInstanceInviteRequest) (*CreateInstanceInviteResponse, error)
+	// ListInstanceInvites returns all invites of the instance including the
+	// number of times they have been used, oldest first. Expired and revoked
+	// invites are returned as well. Tokens are never returned.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the instance does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	ListInstanceInvites(context.Context, *ListInstanceInvitesRequest) (*ListInstanceInvitesResponse, error)
+	// RevokeInstanceInvite revokes the invite, so it cannot be used anymore.
+	// Players that already joined using it are not affected. Revoking an
+	// invite multiple times is not an error.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided invite id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the invite does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	RevokeInstanceInvite(context.Context, *RevokeInstanceInviteRequest) (*RevokeInstanceInviteResponse, error)
 	mustEmbedUnimplementedInstanceServiceServer()
 }
 
@@ -177,12 +745,57 @@ func (UnimplementedInstanceServiceServer) ListInstances(context.Context, *ListIn
 func (UnimplementedInstanceServiceServer) RunFlavorVersion(context.Context, *RunFlavorVersionRequest) (*RunFlavorVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunFlavorVersion not implemented")
 }
+func (UnimplementedInstanceServiceServer) StopInstance(context.Context, *StopInstanceRequest) (*StopInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopInstance not implemented")
+}
 func (UnimplementedInstanceServiceServer) DiscoverInstances(context.Context, *DiscoverInstanceRequest) (*DiscoverInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiscoverInstances not implemented")
 }
+func (UnimplementedInstanceServiceServer) WatchInstances(*WatchInstancesRequest, grpc.ServerStreamingServer[WatchInstancesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInstances not implemented")
+}
 func (UnimplementedInstanceServiceServer) ReceiveInstanceStatusReports(context.Context, *ReceiveInstanceStatusReportsRequest) (*ReceiveInstanceStatusReportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReceiveInstanceStatusReports not implemented")
 }
+func (UnimplementedInstanceServiceServer) GetInstanceRedirects(context.Context, *GetInstanceRedirectsRequest) (*GetInstanceRedirectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceRedirects not implemented")
+}
+func (UnimplementedInstanceServiceServer) GetInstanceEvents(context.Context, *GetInstanceEventsRequest) (*GetInstanceEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceEvents not implemented")
+}
+func (UnimplementedInstanceServiceServer) GetInstanceTimeline(context.Context, *GetInstanceTimelineRequest) (*GetInstanceTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceTimeline not implemented")
+}
+func (UnimplementedInstanceServiceServer) CreateInstanceSnapshot(context.Context, *CreateInstanceSnapshotRequest) (*CreateInstanceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInstanceSnapshot not implemented")
+}
+func (UnimplementedInstanceServiceServer) PlanPlacement(context.Context, *PlanPlacementRequest) (*PlanPlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanPlacement not implemented")
+}
+func (UnimplementedInstanceServiceServer) GetPlatformdUpdate(context.Context, *GetPlatformdUpdateRequest) (*GetPlatformdUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlatformdUpdate not implemented")
+}
+func (UnimplementedInstanceServiceServer) ReportPlatformdUpdateStatus(context.Context, *ReportPlatformdUpdateStatusRequest) (*ReportPlatformdUpdateStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPlatformdUpdateStatus not implemented")
+}
+func (UnimplementedInstanceServiceServer) ReportNodeConditions(context.Context, *ReportNodeConditionsRequest) (*ReportNodeConditionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportNodeConditions not implemented")
+}
+func (UnimplementedInstanceServiceServer) ReceiveInstanceLogs(context.Context, *ReceiveInstanceLogsRequest) (*ReceiveInstanceLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveInstanceLogs not implemented")
+}
+func (UnimplementedInstanceServiceServer) GetInstanceLogs(context.Context, *GetInstanceLogsRequest) (*GetInstanceLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceLogs not implemented")
+}
+func (UnimplementedInstanceServiceServer) CreateInstanceInvite(context.Context, *CreateInstanceInviteRequest) (*CreateInstanceInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInstanceInvite not implemented")
+}
+func (UnimplementedInstanceServiceServer) ListInstanceInvites(context.Context, *ListInstanceInvitesRequest) (*ListInstanceInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstanceInvites not implemented")
+}
+func (UnimplementedInstanceServiceServer) RevokeInstanceInvite(context.Context, *RevokeInstanceInviteRequest) (*RevokeInstanceInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInstanceInvite not implemented")
+}
 func (UnimplementedInstanceServiceServer) mustEmbedUnimplementedInstanceServiceServer() {}
 func (UnimplementedInstanceServiceServer) testEmbeddedByValue()                         {}
 
@@ -258,6 +871,24 @@ func _InstanceService_RunFlavorVersion_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstanceService_StopInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).StopInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_StopInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).StopInstance(ctx, req.(*StopInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InstanceService_DiscoverInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DiscoverInstanceRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +907,17 @@ func _InstanceService_DiscoverInstances_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstanceService_WatchInstances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInstancesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InstanceServiceServer).WatchInstances(m, &grpc.GenericServerStream[WatchInstancesRequest, WatchInstancesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InstanceService_WatchInstancesServer = grpc.ServerStreamingServer[WatchInstancesResponse]
+
 func _InstanceService_ReceiveInstanceStatusReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReceiveInstanceStatusReportsRequest)
 	if err := dec(in); err != nil {
@@ -294,6 +936,240 @@ func _InstanceService_ReceiveInstanceStatusReports_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstanceService_GetInstanceRedirects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceRedirectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetInstanceRedirects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetInstanceRedirects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetInstanceRedirects(ctx, req.(*GetInstanceRedirectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetInstanceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetInstanceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetInstanceEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetInstanceEvents(ctx, req.(*GetInstanceEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetInstanceTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetInstanceTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetInstanceTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetInstanceTimeline(ctx, req.(*GetInstanceTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_CreateInstanceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInstanceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).CreateInstanceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_CreateInstanceSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).CreateInstanceSnapshot(ctx, req.(*CreateInstanceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_PlanPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).PlanPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_PlanPlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).PlanPlacement(ctx, req.(*PlanPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetPlatformdUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlatformdUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetPlatformdUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetPlatformdUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetPlatformdUpdate(ctx, req.(*GetPlatformdUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_ReportPlatformdUpdateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPlatformdUpdateStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ReportPlatformdUpdateStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ReportPlatformdUpdateStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ReportPlatformdUpdateStatus(ctx, req.(*ReportPlatformdUpdateStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_ReportNodeConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportNodeConditionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ReportNodeConditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ReportNodeConditions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ReportNodeConditions(ctx, req.(*ReportNodeConditionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_ReceiveInstanceLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveInstanceLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ReceiveInstanceLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ReceiveInstanceLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ReceiveInstanceLogs(ctx, req.(*ReceiveInstanceLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetInstanceLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetInstanceLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetInstanceLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetInstanceLogs(ctx, req.(*GetInstanceLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_CreateInstanceInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInstanceInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).CreateInstanceInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_CreateInstanceInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).CreateInstanceInvite(ctx, req.(*CreateInstanceInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_ListInstanceInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstanceInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ListInstanceInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ListInstanceInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ListInstanceInvites(ctx, req.(*ListInstanceInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_RevokeInstanceInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInstanceInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).RevokeInstanceInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_RevokeInstanceInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).RevokeInstanceInvite(ctx, req.(*RevokeInstanceInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InstanceService_ServiceDesc is the grpc.ServiceDesc for InstanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -313,6 +1189,10 @@ var InstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunFlavorVersion",
 			Handler:    _InstanceService_RunFlavorVersion_Handler,
 		},
+		{
+			MethodName: "StopInstance",
+			Handler:    _InstanceService_StopInstance_Handler,
+		},
 		{
 			MethodName: "DiscoverInstances",
 			Handler:    _InstanceService_DiscoverInstances_Handler,
@@ -321,7 +1201,65 @@ var InstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReceiveInstanceStatusReports",
 			Handler:    _InstanceService_ReceiveInstanceStatusReports_Handler,
 		},
+		{
+			MethodName: "GetInstanceRedirects",
+			Handler:    _InstanceService_GetInstanceRedirects_Handler,
+		},
+		{
+			MethodName: "GetInstanceEvents",
+			Handler:    _InstanceService_GetInstanceEvents_Handler,
+		},
+		{
+			MethodName: "GetInstanceTimeline",
+			Handler:    _InstanceService_GetInstanceTimeline_Handler,
+		},
+		{
+			MethodName: "CreateInstanceSnapshot",
+			Handler:    _InstanceService_CreateInstanceSnapshot_Handler,
+		},
+		{
+			MethodName: "PlanPlacement",
+			Handler:    _InstanceService_PlanPlacement_Handler,
+		},
+		{
+			MethodName: "GetPlatformdUpdate",
+			Handler:    _InstanceService_GetPlatformdUpdate_Handler,
+		},
+		{
+			MethodName: "ReportPlatformdUpdateStatus",
+			Handler:    _InstanceService_ReportPlatformdUpdateStatus_Handler,
+		},
+		{
+			MethodName: "ReportNodeConditions",
+			Handler:    _InstanceService_ReportNodeConditions_Handler,
+		},
+		{
+			MethodName: "ReceiveInstanceLogs",
+			Handler:    _InstanceService_ReceiveInstanceLogs_Handler,
+		},
+		{
+			MethodName: "GetInstanceLogs",
+			Handler:    _InstanceService_GetInstanceLogs_Handler,
+		},
+		{
+			MethodName: "CreateInstanceInvite",
+			Handler:    _InstanceService_CreateInstanceInvite_Handler,
+		},
+		{
+			MethodName: "ListInstanceInvites",
+			Handler:    _InstanceService_ListInstanceInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInstanceInvite",
+			Handler:    _InstanceService_RevokeInstanceInvite_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInstances",
+			Handler:       _InstanceService_WatchInstances_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "instance/v1alpha1/api.proto",
 }
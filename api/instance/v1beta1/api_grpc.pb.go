@@ -0,0 +1,347 @@
+//
+//Chunk Explorer, a platform for hosting and discovering Minecraft servers.
+//Copyright (C) 2025 Yannic Rieger <oss@76k.io>
+//
+//This program is free software; you can redistribute it and/or
+//modify it under the terms of the GNU Lesser General Public
+//License as published by the Free Software Foundation; either
+//version 3 of the License, or (at your option) any later version.
+//
+//This program is distributed in the hope that it will be useful,
+//but WITHOUT ANY WARRANTY; without even the implied warranty of
+//MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//Lesser General Public License for more details.
+//
+//You should have received a copy of the GNU Lesser General Public License
+//along with this program; if not, write to the Free Software Foundation,
+//Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301, USA.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: instance/v1beta1/api.proto
+
+package v1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InstanceService_GetInstance_FullMethodName      = "/instance.v1beta1.InstanceService/GetInstance"
+	InstanceService_ListInstances_FullMethodName    = "/instance.v1beta1.InstanceService/ListInstances"
+	InstanceService_RunFlavorVersion_FullMethodName = "/instance.v1beta1.InstanceService/RunFlavorVersion"
+	InstanceService_StopInstance_FullMethodName     = "/instance.v1beta1.InstanceService/StopInstance"
+)
+
+// InstanceServiceClient is the client API for InstanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// InstanceService is the stable subset of instance.v1alpha1.InstanceService
+// used by players and developers. Calls only used by nodes, like reporting
+// the status of instances, are not part of it and remain in v1alpha1.
+//
+// The matching v1alpha1 calls are deprecated. The control plane serves both
+// versions and sets the explorer-deprecation response header when a
+// deprecated call is made.
+type InstanceServiceClient interface {
+	// GetInstance returns an instance by its id.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - instance with the specified id could not be found
+	//
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	GetInstance(ctx context.Context, in *GetInstanceRequest, opts ...grpc.CallOption) (*GetInstanceResponse, error)
+	// ListInstances returns all created instances.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the page size or page token is invalid
+	ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error)
+	// RunFlavorVersion creates an instance for a specific flavor version of a
+	// Chunk and schedules it to be run on a node. The port of the returned
+	// instance is not set, because it is allocated once the instance has
+	// been created on the node. Instead of a flavor version, a channel of a
+	// flavor can be selected, in which case the version published to it is run.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - no version has been published to the selected channel
+	RunFlavorVersion(ctx context.Context, in *RunFlavorVersionRequest, opts ...grpc.CallOption) (*RunFlavorVersionResponse, error)
+	// StopInstance stops the instance and removes it afterward. Stopping
+	// happens asynchronously, the instance will be in DELETING state until
+	// the node it is running on has removed it. Only the owner of the
+	// instance is allowed to stop it.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the instance does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	//
+	// - FAILED_PRECONDITION:
+	//   - the instance cannot be stopped in its current state
+	StopInstance(ctx context.Context, in *StopInstanceRequest, opts ...grpc.CallOption) (*StopInstanceResponse, error)
+}
+
+type instanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInstanceServiceClient(cc grpc.ClientConnInterface) InstanceServiceClient {
+	return &instanceServiceClient{cc}
+}
+
+func (c *instanceServiceClient) GetInstance(ctx context.Context, in *GetInstanceRequest, opts ...grpc.CallOption) (*GetInstanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInstanceResponse)
+	err := c.cc.Invoke(ctx, InstanceService_GetInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstancesResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ListInstances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) RunFlavorVersion(ctx context.Context, in *RunFlavorVersionRequest, opts ...grpc.CallOption) (*RunFlavorVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunFlavorVersionResponse)
+	err := c.cc.Invoke(ctx, InstanceService_RunFlavorVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) StopInstance(ctx context.Context, in *StopInstanceRequest, opts ...grpc.CallOption) (*StopInstanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopInstanceResponse)
+	err := c.cc.Invoke(ctx, InstanceService_StopInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InstanceServiceServer is the server API for InstanceService service.
+// All implementations must embed UnimplementedInstanceServiceServer
+// for forward compatibility.
+//
+// InstanceService is the stable subset of instance.v1alpha1.InstanceService
+// used by players and developers. Calls only used by nodes, like reporting
+// the status of instances, are not part of it and remain in v1alpha1.
+//
+// The matching v1alpha1 calls are deprecated. The control plane serves both
+// versions and sets the explorer-deprecation response header when a
+// deprecated call is made.
+type InstanceServiceServer interface {
+	// GetInstance returns an instance by its id.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - instance with the specified id could not be found
+	//
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	GetInstance(context.Context, *GetInstanceRequest) (*GetInstanceResponse, error)
+	// ListInstances returns all created instances.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the page size or page token is invalid
+	ListInstances(context.Context, *ListInstancesRequest) (*ListInstancesResponse, error)
+	// RunFlavorVersion creates an instance for a specific flavor version of a
+	// Chunk and schedules it to be run on a node. The port of the returned
+	// instance is not set, because it is allocated once the instance has
+	// been created on the node. Instead of a flavor version, a channel of a
+	// flavor can be selected, in which case the version published to it is run.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - no version has been published to the selected channel
+	RunFlavorVersion(context.Context, *RunFlavorVersionRequest) (*RunFlavorVersionResponse, error)
+	// StopInstance stops the instance and removes it afterward. Stopping
+	// happens asynchronously, the instance will be in DELETING state until
+	// the node it is running on has removed it. Only the owner of the
+	// instance is allowed to stop it.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided instance id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the instance does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not the owner of the instance
+	//
+	// - FAILED_PRECONDITION:
+	//   - the instance cannot be stopped in its current state
+	StopInstance(context.Context, *StopInstanceRequest) (*StopInstanceResponse, error)
+	mustEmbedUnimplementedInstanceServiceServer()
+}
+
+// UnimplementedInstanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInstanceServiceServer struct{}
+
+func (UnimplementedInstanceServiceServer) GetInstance(context.Context, *GetInstanceRequest) (*GetInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstance not implemented")
+}
+func (UnimplementedInstanceServiceServer) ListInstances(context.Context, *ListInstancesRequest) (*ListInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+}
+func (UnimplementedInstanceServiceServer) RunFlavorVersion(context.Context, *RunFlavorVersionRequest) (*RunFlavorVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunFlavorVersion not implemented")
+}
+func (UnimplementedInstanceServiceServer) StopInstance(context.Context, *StopInstanceRequest) (*StopInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopInstance not implemented")
+}
+func (UnimplementedInstanceServiceServer) mustEmbedUnimplementedInstanceServiceServer() {}
+func (UnimplementedInstanceServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeInstanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InstanceServiceServer will
+// result in compilation errors.
+type UnsafeInstanceServiceServer interface {
+	mustEmbedUnimplementedInstanceServiceServer()
+}
+
+func RegisterInstanceServiceServer(s grpc.ServiceRegistrar, srv InstanceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInstanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InstanceService_ServiceDesc, srv)
+}
+
+func _InstanceService_GetInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetInstance(ctx, req.(*GetInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_ListInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ListInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ListInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ListInstances(ctx, req.(*ListInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_RunFlavorVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunFlavorVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).RunFlavorVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_RunFlavorVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).RunFlavorVersion(ctx, req.(*RunFlavorVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_StopInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).StopInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_StopInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).StopInstance(ctx, req.(*StopInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InstanceService_ServiceDesc is the grpc.ServiceDesc for InstanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InstanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "instance.v1beta1.InstanceService",
+	HandlerType: (*InstanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetInstance",
+			Handler:    _InstanceService_GetInstance_Handler,
+		},
+		{
+			MethodName: "ListInstances",
+			Handler:    _InstanceService_ListInstances_Handler,
+		},
+		{
+			MethodName: "RunFlavorVersion",
+			Handler:    _InstanceService_RunFlavorVersion_Handler,
+		},
+		{
+			MethodName: "StopInstance",
+			Handler:    _InstanceService_StopInstance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "instance/v1beta1/api.proto",
+}
@@ -0,0 +1,315 @@
+//
+//Chunk Explorer, a platform for hosting and discovering Minecraft servers.
+//Copyright (C) 2025 Yannic Rieger <oss@76k.io>
+//
+//This program is free software; you can redistribute it and/or
+//modify it under the terms of the GNU Lesser General Public
+//License as published by the Free Software Foundation; either
+//version 3 of the License, or (at your option) any later version.
+//
+//This program is distributed in the hope that it will be useful,
+//but WITHOUT ANY WARRANTY; without even the implied warranty of
+//MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//Lesser General Public License for more details.
+//
+//You should have received a copy of the GNU Lesser General Public License
+//along with this program; if not, write to the Free Software Foundation,
+//Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301, USA.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: integration/v1alpha1/api.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	IntegrationService_ResolveChunk_FullMethodName         = "/integration.v1alpha1.IntegrationService/ResolveChunk"
+	IntegrationService_ListBackends_FullMethodName         = "/integration.v1alpha1.IntegrationService/ListBackends"
+	IntegrationService_ReportPlayerEvents_FullMethodName   = "/integration.v1alpha1.IntegrationService/ReportPlayerEvents"
+	IntegrationService_RedeemInstanceInvite_FullMethodName = "/integration.v1alpha1.IntegrationService/RedeemInstanceInvite"
+)
+
+// IntegrationServiceClient is the client API for IntegrationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// IntegrationService is used by proxy plugins, like Velocity or BungeeCord,
+// to route players to instances. callers authenticate using one of the
+// integration tokens configured on the control plane, passed in the
+// authorization header.
+type IntegrationServiceClient interface {
+	// ResolveChunk returns the instance of a chunk a player should be sent to.
+	// the instance with the fewest players that still has room is chosen.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - there is no running instance with room for another player
+	//
+	// - FAILED_PRECONDITION:
+	//   - running instances of multiple chunks with the given name exist
+	ResolveChunk(ctx context.Context, in *ResolveChunkRequest, opts ...grpc.CallOption) (*ResolveChunkResponse, error)
+	// ListBackends returns all running instances. plugins are expected to
+	// call this periodically and register or unregister backend servers
+	// by comparing the returned names with the ones registered previously.
+	ListBackends(ctx context.Context, in *ListBackendsRequest, opts ...grpc.CallOption) (*ListBackendsResponse, error)
+	// ReportPlayerEvents records players joining or leaving instances.
+	// events are applied in order. events for instances that do not
+	// exist (anymore) are ignored.
+	ReportPlayerEvents(ctx context.Context, in *ReportPlayerEventsRequest, opts ...grpc.CallOption) (*ReportPlayerEventsResponse, error)
+	// RedeemInstanceInvite returns the instance a player joining using the
+	// given invite token should be sent to and counts the use of the invite.
+	// plugins are expected to call this before letting the player join. the
+	// invite is not used, if the instance is not joinable.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the invite does not exist, expired, was revoked or is used up
+	//
+	// - NOT_FOUND:
+	//   - the instance is not running or has no room for another player
+	RedeemInstanceInvite(ctx context.Context, in *RedeemInstanceInviteRequest, opts ...grpc.CallOption) (*RedeemInstanceInviteResponse, error)
+}
+
+type integrationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIntegrationServiceClient(cc grpc.ClientConnInterface) IntegrationServiceClient {
+	return &integrationServiceClient{cc}
+}
+
+func (c *integrationServiceClient) ResolveChunk(ctx context.Context, in *ResolveChunkRequest, opts ...grpc.CallOption) (*ResolveChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveChunkResponse)
+	err := c.cc.Invoke(ctx, IntegrationService_ResolveChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *integrationServiceClient) ListBackends(ctx context.Context, in *ListBackendsRequest, opts ...grpc.CallOption) (*ListBackendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBackendsResponse)
+	err := c.cc.Invoke(ctx, IntegrationService_ListBackends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *integrationServiceClient) ReportPlayerEvents(ctx context.Context, in *ReportPlayerEventsRequest, opts ...grpc.CallOption) (*ReportPlayerEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportPlayerEventsResponse)
+	err := c.cc.Invoke(ctx, IntegrationService_ReportPlayerEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *integrationServiceClient) RedeemInstanceInvite(ctx context.Context, in *RedeemInstanceInviteRequest, opts ...grpc.CallOption) (*RedeemInstanceInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemInstanceInviteResponse)
+	err := c.cc.Invoke(ctx, IntegrationService_RedeemInstanceInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IntegrationServiceServer is the server API for IntegrationService service.
+// All implementations must embed UnimplementedIntegrationServiceServer
+// for forward compatibility.
+//
+// IntegrationService is used by proxy plugins, like Velocity or BungeeCord,
+// to route players to instances. callers authenticate using one of the
+// integration tokens configured on the control plane, passed in the
+// authorization header.
+type IntegrationServiceServer interface {
+	// ResolveChunk returns the instance of a chunk a player should be sent to.
+	// the instance with the fewest players that still has room is chosen.
+	//
+	// Defined error codes:
+	// - NOT_FOUND:
+	//   - there is no running instance with room for another player
+	//
+	// - FAILED_PRECONDITION:
+	//   - running instances of multiple chunks with the given name exist
+	ResolveChunk(context.Context, *ResolveChunkRequest) (*ResolveChunkResponse, error)
+	// ListBackends returns all running instances. plugins are expected to
+	// call this periodically and register or unregister backend servers
+	// by comparing the returned names with the ones registered previously.
+	ListBackends(context.Context, *ListBackendsRequest) (*ListBackendsResponse, error)
+	// ReportPlayerEvents records players joining or leaving instances.
+	// events are applied in order. events for instances that do not
+	// exist (anymore) are ignored.
+	ReportPlayerEvents(context.Context, *ReportPlayerEventsRequest) (*ReportPlayerEventsResponse, error)
+	// RedeemInstanceInvite returns the instance a player joining using the
+	// given invite token should be sent to and counts the use of the invite.
+	// plugins are expected to call this before letting the player join. the
+	// invite is not used, if the instance is not joinable.
+	//
+	// Defined error codes:
+	// - PERMISSION_DENIED:
+	//   - the invite does not exist, expired, was revoked or is used up
+	//
+	// - NOT_FOUND:
+	//   - the instance is not running or has no room for another player
+	RedeemInstanceInvite(context.Context, *RedeemInstanceInviteRequest) (*RedeemInstanceInviteResponse, error)
+	mustEmbedUnimplementedIntegrationServiceServer()
+}
+
+// UnimplementedIntegrationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIntegrationServiceServer struct{}
+
+func (UnimplementedIntegrationServiceServer) ResolveChunk(context.Context, *ResolveChunkRequest) (*ResolveChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveChunk not implemented")
+}
+func (UnimplementedIntegrationServiceServer) ListBackends(context.Context, *ListBackendsRequest) (*ListBackendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackends not implemented")
+}
+func (UnimplementedIntegrationServiceServer) ReportPlayerEvents(context.Context, *ReportPlayerEventsRequest) (*ReportPlayerEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPlayerEvents not implemented")
+}
+func (UnimplementedIntegrationServiceServer) RedeemInstanceInvite(context.Context, *RedeemInstanceInviteRequest) (*RedeemInstanceInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemInstanceInvite not implemented")
+}
+func (UnimplementedIntegrationServiceServer) mustEmbedUnimplementedIntegrationServiceServer() {}
+func (UnimplementedIntegrationServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeIntegrationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IntegrationServiceServer will
+// result in compilation errors.
+type UnsafeIntegrationServiceServer interface {
+	mustEmbedUnimplementedIntegrationServiceServer()
+}
+
+func RegisterIntegrationServiceServer(s grpc.ServiceRegistrar, srv IntegrationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedIntegrationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IntegrationService_ServiceDesc, srv)
+}
+
+func _IntegrationService_ResolveChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationServiceServer).ResolveChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationService_ResolveChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationServiceServer).ResolveChunk(ctx, req.(*ResolveChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntegrationService_ListBackends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationServiceServer).ListBackends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationService_ListBackends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationServiceServer).ListBackends(ctx, req.(*ListBackendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntegrationService_ReportPlayerEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPlayerEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationServiceServer).ReportPlayerEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationService_ReportPlayerEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationServiceServer).ReportPlayerEvents(ctx, req.(*ReportPlayerEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntegrationService_RedeemInstanceInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemInstanceInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationServiceServer).RedeemInstanceInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationService_RedeemInstanceInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationServiceServer).RedeemInstanceInvite(ctx, req.(*RedeemInstanceInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IntegrationService_ServiceDesc is the grpc.ServiceDesc for IntegrationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IntegrationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "integration.v1alpha1.IntegrationService",
+	HandlerType: (*IntegrationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ResolveChunk",
+			Handler:    _IntegrationService_ResolveChunk_Handler,
+		},
+		{
+			MethodName: "ListBackends",
+			Handler:    _IntegrationService_ListBackends_Handler,
+		},
+		{
+			MethodName: "ReportPlayerEvents",
+			Handler:    _IntegrationService_ReportPlayerEvents_Handler,
+		},
+		{
+			MethodName: "RedeemInstanceInvite",
+			Handler:    _IntegrationService_RedeemInstanceInvite_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "integration/v1alpha1/api.proto",
+}
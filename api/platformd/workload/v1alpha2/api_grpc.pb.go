@@ -40,6 +40,8 @@ const (
 	WorkloadService_WorkloadStatus_FullMethodName   = "/platformd.workload.v1alpha2.WorkloadService/WorkloadStatus"
 	WorkloadService_StopWorkload_FullMethodName     = "/platformd.workload.v1alpha2.WorkloadService/StopWorkload"
 	WorkloadService_WorkloadMetadata_FullMethodName = "/platformd.workload.v1alpha2.WorkloadService/WorkloadMetadata"
+	WorkloadService_PrepareWorkload_FullMethodName  = "/platformd.workload.v1alpha2.WorkloadService/PrepareWorkload"
+	WorkloadService_SnapshotWorkload_FullMethodName = "/platformd.workload.v1alpha2.WorkloadService/SnapshotWorkload"
 )
 
 // WorkloadServiceClient is the client API for WorkloadService service.
@@ -49,6 +51,15 @@ type WorkloadServiceClient interface {
 	WorkloadStatus(ctx context.Context, in *WorkloadStatusRequest, opts ...grpc.CallOption) (*WorkloadStatusResponse, error)
 	StopWorkload(ctx context.Context, in *WorkloadStopRequest, opts ...grpc.CallOption) (*WorkloadStopResponse, error)
 	WorkloadMetadata(ctx context.Context, in *WorkloadMetadataRequest, opts ...grpc.CallOption) (*WorkloadMetadataResponse, error)
+	// PrepareWorkload is called by the control plane when an instance has
+	// been scheduled to the node. it allocates the port and pulls the images
+	// of the workload, so creating it is faster once the instance is picked
+	// up by the node. calling it multiple times for the same workload is safe.
+	PrepareWorkload(ctx context.Context, in *PrepareWorkloadRequest, opts ...grpc.CallOption) (*PrepareWorkloadResponse, error)
+	// SnapshotWorkload streams a gzip compressed tarball of the server directory
+	// of the running workload. it is called by the control plane when users request
+	// a snapshot of their instance for debugging purposes.
+	SnapshotWorkload(ctx context.Context, in *SnapshotWorkloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SnapshotWorkloadResponse], error)
 }
 
 type workloadServiceClient struct {
@@ -89,6 +100,35 @@ func (c *workloadServiceClient) WorkloadMetadata(ctx context.Context, in *Worklo
 	return out, nil
 }
 
+func (c *workloadServiceClient) PrepareWorkload(ctx context.Context, in *PrepareWorkloadRequest, opts ...grpc.CallOption) (*PrepareWorkloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareWorkloadResponse)
+	err := c.cc.Invoke(ctx, WorkloadService_PrepareWorkload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workloadServiceClient) SnapshotWorkload(ctx context.Context, in *SnapshotWorkloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SnapshotWorkloadResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WorkloadService_ServiceDesc.Streams[0], WorkloadService_SnapshotWorkload_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SnapshotWorkloadRequest, SnapshotWorkloadResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkloadService_SnapshotWorkloadClient = grpc.ServerStreamingClient[SnapshotWorkloadResponse]
+
 // WorkloadServiceServer is the server API for WorkloadService service.
 // All implementations must embed UnimplementedWorkloadServiceServer
 // for forward compatibility.
@@ -96,6 +136,15 @@ type WorkloadServiceServer interface {
 	WorkloadStatus(context.Context, *WorkloadStatusRequest) (*WorkloadStatusResponse, error)
 	StopWorkload(context.Context, *WorkloadStopRequest) (*WorkloadStopResponse, error)
 	WorkloadMetadata(context.Context, *WorkloadMetadataRequest) (*WorkloadMetadataResponse, error)
+	// PrepareWorkload is called by the control plane when an instance has
+	// been scheduled to the node. it allocates the port and pulls the images
+	// of the workload, so creating it is faster once the instance is picked
+	// up by the node. calling it multiple times for the same workload is safe.
+	PrepareWorkload(context.Context, *PrepareWorkloadRequest) (*PrepareWorkloadResponse, error)
+	// SnapshotWorkload streams a gzip compressed tarball of the server directory
+	// of the running workload. it is called by the control plane when users request
+	// a snapshot of their instance for debugging purposes.
+	SnapshotWorkload(*SnapshotWorkloadRequest, grpc.ServerStreamingServer[SnapshotWorkloadResponse]) error
 	mustEmbedUnimplementedWorkloadServiceServer()
 }
 
@@ -115,6 +164,12 @@ func (UnimplementedWorkloadServiceServer) StopWorkload(context.Context, *Workloa
 func (UnimplementedWorkloadServiceServer) WorkloadMetadata(context.Context, *WorkloadMetadataRequest) (*WorkloadMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WorkloadMetadata not implemented")
 }
+func (UnimplementedWorkloadServiceServer) PrepareWorkload(context.Context, *PrepareWorkloadRequest) (*PrepareWorkloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareWorkload not implemented")
+}
+func (UnimplementedWorkloadServiceServer) SnapshotWorkload(*SnapshotWorkloadRequest, grpc.ServerStreamingServer[SnapshotWorkloadResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SnapshotWorkload not implemented")
+}
 func (UnimplementedWorkloadServiceServer) mustEmbedUnimplementedWorkloadServiceServer() {}
 func (UnimplementedWorkloadServiceServer) testEmbeddedByValue()                         {}
 
@@ -190,6 +245,35 @@ func _WorkloadService_WorkloadMetadata_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkloadService_PrepareWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareWorkloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkloadServiceServer).PrepareWorkload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkloadService_PrepareWorkload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkloadServiceServer).PrepareWorkload(ctx, req.(*PrepareWorkloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkloadService_SnapshotWorkload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SnapshotWorkloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkloadServiceServer).SnapshotWorkload(m, &grpc.GenericServerStream[SnapshotWorkloadRequest, SnapshotWorkloadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkloadService_SnapshotWorkloadServer = grpc.ServerStreamingServer[SnapshotWorkloadResponse]
+
 // WorkloadService_ServiceDesc is the grpc.ServiceDesc for WorkloadService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -209,7 +293,17 @@ var WorkloadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WorkloadMetadata",
 			Handler:    _WorkloadService_WorkloadMetadata_Handler,
 		},
+		{
+			MethodName: "PrepareWorkload",
+			Handler:    _WorkloadService_PrepareWorkload_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SnapshotWorkload",
+			Handler:       _WorkloadService_SnapshotWorkload_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "platformd/workload/v1alpha2/api.proto",
 }
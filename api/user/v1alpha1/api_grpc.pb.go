@@ -37,8 +37,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_Register_FullMethodName = "/user.v1alpha1.UserService/Register"
-	UserService_Login_FullMethodName    = "/user.v1alpha1.UserService/Login"
+	UserService_Register_FullMethodName                      = "/user.v1alpha1.UserService/Register"
+	UserService_Login_FullMethodName                         = "/user.v1alpha1.UserService/Login"
+	UserService_GetNotificationPreferences_FullMethodName    = "/user.v1alpha1.UserService/GetNotificationPreferences"
+	UserService_UpdateNotificationPreferences_FullMethodName = "/user.v1alpha1.UserService/UpdateNotificationPreferences"
+	UserService_Logout_FullMethodName                        = "/user.v1alpha1.UserService/Logout"
+	UserService_RevokeUserTokens_FullMethodName              = "/user.v1alpha1.UserService/RevokeUserTokens"
+	UserService_ExportUserData_FullMethodName                = "/user.v1alpha1.UserService/ExportUserData"
+	UserService_DeleteUser_FullMethodName                    = "/user.v1alpha1.UserService/DeleteUser"
+	UserService_ImpersonateUser_FullMethodName               = "/user.v1alpha1.UserService/ImpersonateUser"
+	UserService_ListImpersonations_FullMethodName            = "/user.v1alpha1.UserService/ListImpersonations"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -52,6 +60,103 @@ type UserServiceClient interface {
 	//   - a user with the provided email or nickname does already exist
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// GetNotificationPreferences returns the notifications the caller opted into.
+	// Users that never updated their preferences do not receive any notifications.
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error)
+	// UpdateNotificationPreferences replaces the notification preferences of the caller.
+	// Notifications are sent via email to the address the user registered with.
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error)
+	// Logout revokes the api token used to make this call. Revoked tokens are
+	// rejected, even if they did not expire yet.
+	//
+	// Defined error codes:
+	// - FAILED_PRECONDITION:
+	//   - the token has been issued without an id and cannot be revoked
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// RevokeUserTokens revokes all api tokens that have been issued to a user
+	// so far. It is intended to lock out a compromised user until they log in
+	// again. Users can revoke their own tokens, revoking the tokens of other
+	// users is only allowed for admins.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided user id is invalid
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is neither the user nor an admin
+	RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeUserTokensResponse, error)
+	// ExportUserData bundles the data stored about a user into a gzip compressed
+	// tarball of json documents. The export contains the profile and notification
+	// preferences of the user, the chunks owned by the user including all flavor
+	// versions and their builds, as well as the instances created by the user
+	// including their events. Users can export their own data, exporting the data
+	// of other users is only allowed for admins.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided user id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the user does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is neither the user nor an admin
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	// DeleteUser removes the personal data of a user. The user itself is anonymized
+	// instead of removed, so instances created by the user stay attributable to an
+	// account, but the nickname and email address are replaced. Notification
+	// preferences and archived chunks of the user are removed and all api tokens
+	// are revoked. The chunks owned by the user are either deleted or transferred
+	// to another user, depending on the provided chunk policy. Users can delete
+	// themselves, deleting other users is only allowed for admins. Transferring
+	// chunks is only allowed for admins as well.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided user id is invalid
+	//   - chunks are transferred, but no or an invalid transfer_to user id is provided
+	//   - chunks are transferred to the user that is deleted
+	//
+	// - NOT_FOUND:
+	//   - the user or the user chunks are transferred to does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is neither the user nor an admin
+	//   - chunks are transferred, but the caller is not an admin
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// ImpersonateUser issues an api token that acts as the given user, so
+	// support staff can reproduce issues reported by the user. The token
+	// expires after the requested duration, which is capped by the platform.
+	// Starting the impersonation and every call made with the token are
+	// recorded and shown to the user, see ListImpersonations. The tokens of
+	// the user, including the issued one, can be revoked by the user at any
+	// time using RevokeUserTokens. Only admins are allowed to impersonate
+	// users, tokens issued by this call cannot be used to impersonate users.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided user id is invalid
+	//   - no reason is provided
+	//   - the requested duration exceeds the maximum allowed
+	//
+	// - NOT_FOUND:
+	//   - the user does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...grpc.CallOption) (*ImpersonateUserResponse, error)
+	// ListImpersonations returns the impersonations of a user including the
+	// calls made while impersonating them, the most recent one first. Users
+	// can list their own impersonations, listing the impersonations of other
+	// users is only allowed for admins.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided user id is invalid
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is neither the user nor an admin
+	ListImpersonations(ctx context.Context, in *ListImpersonationsRequest, opts ...grpc.CallOption) (*ListImpersonationsResponse, error)
 }
 
 type userServiceClient struct {
@@ -82,6 +187,86 @@ func (c *userServiceClient) Login(ctx context.Context, in *LoginRequest, opts ..
 	return out, nil
 }
 
+func (c *userServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, UserService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeUserTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeUserTokensResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeUserTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, UserService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...grpc.CallOption) (*ImpersonateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateUserResponse)
+	err := c.cc.Invoke(ctx, UserService_ImpersonateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListImpersonations(ctx context.Context, in *ListImpersonationsRequest, opts ...grpc.CallOption) (*ListImpersonationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImpersonationsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListImpersonations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -93,6 +278,103 @@ type UserServiceServer interface {
 	//   - a user with the provided email or nickname does already exist
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// GetNotificationPreferences returns the notifications the caller opted into.
+	// Users that never updated their preferences do not receive any notifications.
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error)
+	// UpdateNotificationPreferences replaces the notification preferences of the caller.
+	// Notifications are sent via email to the address the user registered with.
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error)
+	// Logout revokes the api token used to make this call. Revoked tokens are
+	// rejected, even if they did not expire yet.
+	//
+	// Defined error codes:
+	// - FAILED_PRECONDITION:
+	//   - the token has been issued without an id and cannot be revoked
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// RevokeUserTokens revokes all api tokens that have been issued to a user
+	// so far. It is intended to lock out a compromised user until they log in
+	// again. Users can revoke their own tokens, revoking the tokens of other
+	// users is only allowed for admins.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided user id is invalid
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is neither the user nor an admin
+	RevokeUserTokens(context.Context, *RevokeUserTokensRequest) (*RevokeUserTokensResponse, error)
+	// ExportUserData bundles the data stored about a user into a gzip compressed
+	// tarball of json documents. The export contains the profile and notification
+	// preferences of the user, the chunks owned by the user including all flavor
+	// versions and their builds, as well as the instances created by the user
+	// including their events. Users can export their own data, exporting the data
+	// of other users is only allowed for admins.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided user id is invalid
+	//
+	// - NOT_FOUND:
+	//   - the user does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is neither the user nor an admin
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	// DeleteUser removes the personal data of a user. The user itself is anonymized
+	// instead of removed, so instances created by the user stay attributable to an
+	// account, but the nickname and email address are replaced. Notification
+	// preferences and archived chunks of the user are removed and all api tokens
+	// are revoked. The chunks owned by the user are either deleted or transferred
+	// to another user, depending on the provided chunk policy. Users can delete
+	// themselves, deleting other users is only allowed for admins. Transferring
+	// chunks is only allowed for admins as well.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided user id is invalid
+	//   - chunks are transferred, but no or an invalid transfer_to user id is provided
+	//   - chunks are transferred to the user that is deleted
+	//
+	// - NOT_FOUND:
+	//   - the user or the user chunks are transferred to does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is neither the user nor an admin
+	//   - chunks are transferred, but the caller is not an admin
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// ImpersonateUser issues an api token that acts as the given user, so
+	// support staff can reproduce issues reported by the user. The token
+	// expires after the requested duration, which is capped by the platform.
+	// Starting the impersonation and every call made with the token are
+	// recorded and shown to the user, see ListImpersonations. The tokens of
+	// the user, including the issued one, can be revoked by the user at any
+	// time using RevokeUserTokens. Only admins are allowed to impersonate
+	// users, tokens issued by this call cannot be used to impersonate users.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided user id is invalid
+	//   - no reason is provided
+	//   - the requested duration exceeds the maximum allowed
+	//
+	// - NOT_FOUND:
+	//   - the user does not exist
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is not an admin
+	ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error)
+	// ListImpersonations returns the impersonations of a user including the
+	// calls made while impersonating them, the most recent one first. Users
+	// can list their own impersonations, listing the impersonations of other
+	// users is only allowed for admins.
+	//
+	// Defined error codes:
+	// - INVALID_ARGUMENT:
+	//   - the provided user id is invalid
+	//
+	// - PERMISSION_DENIED:
+	//   - the caller is neither the user nor an admin
+	ListImpersonations(context.Context, *ListImpersonationsRequest) (*ListImpersonationsResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -109,6 +391,30 @@ func (UnimplementedUserServiceServer) Register(context.Context, *RegisterRequest
 func (UnimplementedUserServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedUserServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeUserTokens(context.Context, *RevokeUserTokensRequest) (*RevokeUserTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserTokens not implemented")
+}
+func (UnimplementedUserServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImpersonateUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListImpersonations(context.Context, *ListImpersonationsRequest) (*ListImpersonationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImpersonations not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -166,6 +472,150 @@ func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeUserTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeUserTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeUserTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeUserTokens(ctx, req.(*RevokeUserTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ImpersonateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ImpersonateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ImpersonateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ImpersonateUser(ctx, req.(*ImpersonateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListImpersonations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImpersonationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListImpersonations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListImpersonations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListImpersonations(ctx, req.(*ListImpersonationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +631,38 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _UserService_Login_Handler,
 		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _UserService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _UserService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _UserService_Logout_Handler,
+		},
+		{
+			MethodName: "RevokeUserTokens",
+			Handler:    _UserService_RevokeUserTokens_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _UserService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "ImpersonateUser",
+			Handler:    _UserService_ImpersonateUser_Handler,
+		},
+		{
+			MethodName: "ListImpersonations",
+			Handler:    _UserService_ListImpersonations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1alpha1/api.proto",
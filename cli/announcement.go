@@ -0,0 +1,85 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	announcementv1alpha1 "github.com/spacechunks/explorer/api/announcement/v1alpha1"
+)
+
+// Announcements prints the announcements published by the platform
+// admins, like upcoming maintenance windows or new features.
+type Announcements struct {
+	logger *slog.Logger
+	client announcementv1alpha1.AnnouncementServiceClient
+	once   sync.Once
+}
+
+func NewAnnouncements(
+	logger *slog.Logger,
+	client announcementv1alpha1.AnnouncementServiceClient,
+) *Announcements {
+	return &Announcements{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Print writes the currently active announcements to w. announcements are
+// only printed once per invocation, even if Print is called multiple times.
+// errors are only logged, because failing to fetch announcements should not
+// prevent any command from running.
+func (a *Announcements) Print(ctx context.Context, w io.Writer) {
+	if a == nil {
+		return
+	}
+
+	a.once.Do(func() {
+		resp, err := a.client.ListAnnouncements(ctx, &announcementv1alpha1.ListAnnouncementsRequest{})
+		if err != nil {
+			a.logger.DebugContext(ctx, "failed to list announcements", "err", err)
+			return
+		}
+
+		for _, ann := range resp.GetAnnouncements() {
+			color, label := severityStyle(ann.GetSeverity())
+			_, _ = fmt.Fprintf(w, "%s[%s]%s %s\n", color, label, ColorReset, ann.GetMessage())
+		}
+
+		if len(resp.GetAnnouncements()) > 0 {
+			_, _ = fmt.Fprintln(w)
+		}
+	})
+}
+
+func severityStyle(s announcementv1alpha1.Severity) (string, string) {
+	switch s {
+	case announcementv1alpha1.Severity_CRITICAL:
+		return ColorRed, "CRITICAL"
+	case announcementv1alpha1.Severity_WARNING:
+		return ColorYellow, "WARNING"
+	default:
+		return ColorCyan, "INFO"
+	}
+}
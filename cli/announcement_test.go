@@ -0,0 +1,102 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	announcementv1alpha1 "github.com/spacechunks/explorer/api/announcement/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type fakeAnnouncementClient struct {
+	announcementv1alpha1.AnnouncementServiceClient
+	calls int
+	resp  *announcementv1alpha1.ListAnnouncementsResponse
+	err   error
+}
+
+func (c *fakeAnnouncementClient) ListAnnouncements(
+	_ context.Context,
+	_ *announcementv1alpha1.ListAnnouncementsRequest,
+	_ ...grpc.CallOption,
+) (*announcementv1alpha1.ListAnnouncementsResponse, error) {
+	c.calls++
+	return c.resp, c.err
+}
+
+func TestAnnouncementsPrint(t *testing.T) {
+	tests := []struct {
+		name     string
+		resp     *announcementv1alpha1.ListAnnouncementsResponse
+		err      error
+		expected string
+	}{
+		{
+			name: "prints announcements",
+			resp: &announcementv1alpha1.ListAnnouncementsResponse{
+				Announcements: []*announcementv1alpha1.Announcement{
+					{
+						Message:  "database upgrade tonight",
+						Severity: announcementv1alpha1.Severity_WARNING,
+					},
+					{
+						Message:  "checkpoints are now faster",
+						Severity: announcementv1alpha1.Severity_INFO,
+					},
+				},
+			},
+			expected: cli.ColorYellow + "[WARNING]" + cli.ColorReset + " database upgrade tonight\n" +
+				cli.ColorCyan + "[INFO]" + cli.ColorReset + " checkpoints are now faster\n\n",
+		},
+		{
+			name:     "no announcements",
+			resp:     &announcementv1alpha1.ListAnnouncementsResponse{},
+			expected: "",
+		},
+		{
+			name:     "errors are ignored",
+			err:      errors.New("unavailable"),
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx    = context.Background()
+				out    bytes.Buffer
+				client = &fakeAnnouncementClient{resp: tt.resp, err: tt.err}
+				a      = cli.NewAnnouncements(slog.New(slog.DiscardHandler), client)
+			)
+
+			a.Print(ctx, &out)
+			a.Print(ctx, &out)
+
+			require.Equal(t, tt.expected, out.String())
+			require.Equal(t, 1, client.calls)
+		})
+	}
+}
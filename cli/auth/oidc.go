@@ -34,6 +34,18 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// ErrInteractionRequired is returned if the user has to log in
+// again, but the context does not allow opening the browser.
+var ErrInteractionRequired = errors.New("login required")
+
+type nonInteractiveKey struct{}
+
+// NonInteractive returns a context that prevents the login flow from being
+// started. this is used for shell completions, which must not open the browser.
+func NonInteractive(ctx context.Context) context.Context {
+	return context.WithValue(ctx, nonInteractiveKey{}, true)
+}
+
 type Service interface {
 	APIToken(ctx context.Context) (string, error)
 	IDToken(ctx context.Context) (string, error)
@@ -61,6 +73,13 @@ type OIDC struct {
 	userClient     userv1alpha1.UserServiceClient
 	clientID       string
 	state          *state.Data
+	onLogin        func(ctx context.Context)
+}
+
+// OnLogin registers fn to be called after the user has logged
+// in to the control plane and received a new api token.
+func (svc *OIDC) OnLogin(fn func(ctx context.Context)) {
+	svc.onLogin = fn
 }
 
 func (svc OIDC) APIToken(ctx context.Context) (string, error) {
@@ -88,6 +107,10 @@ func (svc OIDC) APIToken(ctx context.Context) (string, error) {
 		svc.state.Update(state.Data{
 			ControlPlaneAPIToken: apiTok,
 		})
+
+		if svc.onLogin != nil {
+			svc.onLogin(ctx)
+		}
 	}
 
 	// we got what we need: a still valid or newly issued api token.
@@ -158,6 +181,10 @@ func (svc OIDC) getAPIToken(ctx context.Context, idToken string) (string, error)
 }
 
 func (svc OIDC) getIDToken(ctx context.Context) (string, error) {
+	if ctx.Value(nonInteractiveKey{}) != nil {
+		return "", ErrInteractionRequired
+	}
+
 	provider, err := oidc.NewProvider(ctx, svc.issuerEndpoint)
 	if err != nil {
 		return "", fmt.Errorf("provider: %w", err)
@@ -0,0 +1,47 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrIDTokenUnavailable = errors.New("id token is not available when using a static api token")
+
+// Static always returns a preconfigured api token. This is mainly
+// useful when talking to a control plane running in dev mode, which
+// does not have an identity provider configured.
+type Static struct {
+	token string
+}
+
+func NewStatic(token string) *Static {
+	return &Static{
+		token: token,
+	}
+}
+
+func (s Static) APIToken(_ context.Context) (string, error) {
+	return s.token, nil
+}
+
+func (s Static) IDToken(_ context.Context) (string, error) {
+	return "", ErrIDTokenUnavailable
+}
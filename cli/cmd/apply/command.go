@@ -0,0 +1,189 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spacechunks/explorer/cli/config"
+	"github.com/spf13/cobra"
+)
+
+func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		path, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return fmt.Errorf("file flag: %w", err)
+		}
+
+		if path == "" {
+			path = ".chunk.yaml"
+		}
+
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return fmt.Errorf("dry-run flag: %w", err)
+		}
+
+		prune, err := cmd.Flags().GetBool("prune")
+		if err != nil {
+			return fmt.Errorf("prune flag: %w", err)
+		}
+
+		cfg, err := config.ReadWithResolvedPaths(path)
+		if err != nil {
+			return fmt.Errorf("read config: %w", err)
+		}
+
+		if issues := config.Validate(cfg); issues != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "%sFailed to validate config file:\n", cli.ColorRed)
+			sec := cli.Section().WithWriter(os.Stderr)
+			for p, errs := range issues {
+				sec.AddRow(fmt.Sprintf("%s- %s:", cli.ColorRed, p), strings.Join(errs, ",")+cli.ColorReset)
+			}
+			sec.Print()
+			return errors.New("invalid config file")
+		}
+
+		resp, err := cliCtx.Client.ApplyChunkSpec(ctx, &chunkv1alpha1.ApplyChunkSpecRequest{
+			Spec:   chunkSpec(cfg.Chunk),
+			DryRun: dryRun,
+			Prune:  prune,
+		})
+		if err != nil {
+			return fmt.Errorf("apply chunk spec: %w", err)
+		}
+
+		return cli.Print(cmd, resp, func() {
+			printChanges(os.Stdout, cfg.Chunk.Name, dryRun, resp.GetChanges())
+		})
+	}
+
+	return &cobra.Command{
+		Use:   "apply",
+		Short: "Makes a chunk match its config file.",
+		Long: `Creates or updates the chunk described by the config file, so its metadata, flavors,
+channels, autoscaling policies and schedules match the file. Applying the same file again
+changes nothing, which makes apply suitable for CI pipelines. Flavors that are not part of
+the file are only deleted when using --prune. Use --dry-run to only show what would change.
+
+Versions referenced by channels and schedules have to be published using 'chunk publish' first.`,
+		Args:         cobra.NoArgs,
+		RunE:         run,
+		SilenceUsage: true,
+	}
+}
+
+func chunkSpec(c config.Chunk) *chunkv1alpha1.ChunkSpec {
+	flavors := make([]*chunkv1alpha1.FlavorSpec, 0, len(c.Flavors))
+	for _, f := range c.Flavors {
+		spec := &chunkv1alpha1.FlavorSpec{
+			Name:     f.Name,
+			Channels: f.Channels,
+		}
+
+		if a := f.Autoscaling; a != nil {
+			spec.Autoscaling = &chunkv1alpha1.AutoscalingSpec{
+				MinInstances:      uint32(a.MinInstances),
+				MaxInstances:      uint32(a.MaxInstances),
+				TargetUtilization: uint32(a.TargetUtilization),
+			}
+		}
+
+		flavors = append(flavors, spec)
+	}
+
+	schedules := make([]*chunkv1alpha1.ScheduleSpec, 0, len(c.Schedules))
+	for _, s := range c.Schedules {
+		schedules = append(schedules, &chunkv1alpha1.ScheduleSpec{
+			Flavor:    s.Flavor,
+			Version:   s.Version,
+			StartCron: s.StartCron,
+			StopCron:  s.StopCron,
+			Timezone:  s.Timezone,
+		})
+	}
+
+	return &chunkv1alpha1.ChunkSpec{
+		Name:        c.Name,
+		Description: c.Description,
+		Tags:        c.Tags,
+		Flavors:     flavors,
+		Schedules:   schedules,
+	}
+}
+
+func printChanges(w io.Writer, name string, dryRun bool, changes []*chunkv1alpha1.ChunkSpecChange) {
+	// my-chunk (dry run):
+	//   + flavors/lobby/channels/beta      v3
+	//   ~ flavors/lobby/autoscaling        min=1 max=3 target=80% -> min=2 max=3 target=80%
+	//   - schedules/lobby/v1               start="0 18 * * FRI" stop="" timezone=UTC
+	// 3 changes: 1 created, 1 updated, 1 deleted
+
+	header := name + ":"
+	if dryRun {
+		header = name + " (dry run):"
+	}
+
+	_, _ = fmt.Fprintln(w, header)
+
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(w, "  No changes.")
+		return
+	}
+
+	var created, updated, deleted int
+	sec := cli.Section().WithWriter(w)
+	for _, c := range changes {
+		var (
+			color  string
+			prefix string
+			value  string
+		)
+
+		switch c.GetAction() {
+		case chunkv1alpha1.ChunkSpecChangeAction_SPEC_CHANGE_CREATE:
+			created++
+			color, prefix, value = cli.ColorGreen, "+", c.GetNewValue()
+		case chunkv1alpha1.ChunkSpecChangeAction_SPEC_CHANGE_UPDATE:
+			updated++
+			color, prefix, value = cli.ColorYellow, "~", c.GetOldValue()+" -> "+c.GetNewValue()
+		case chunkv1alpha1.ChunkSpecChangeAction_SPEC_CHANGE_DELETE:
+			deleted++
+			color, prefix, value = cli.ColorRed, "-", c.GetOldValue()
+		default:
+		}
+
+		sec.AddRow(color+"  "+prefix+" "+c.GetPath(), value+cli.ColorReset)
+	}
+	sec.Print()
+
+	_, _ = fmt.Fprintf(
+		w,
+		"\n%d changes: %d created, %d updated, %d deleted\n",
+		len(changes), created, updated, deleted,
+	)
+}
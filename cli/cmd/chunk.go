@@ -21,13 +21,20 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spacechunks/explorer/cli"
+	"github.com/spacechunks/explorer/cli/auth"
 	deletechunk "github.com/spacechunks/explorer/cli/cmd/delete"
+	"github.com/spacechunks/explorer/cli/cmd/events"
 	"github.com/spacechunks/explorer/cli/cmd/inspect"
+	"github.com/spacechunks/explorer/cli/cmd/invite"
 	"github.com/spacechunks/explorer/cli/cmd/list"
 	"github.com/spacechunks/explorer/cli/cmd/publish"
 	"github.com/spacechunks/explorer/cli/cmd/run"
+	"github.com/spacechunks/explorer/cli/cmd/snapshot"
+	"github.com/spacechunks/explorer/cli/cmd/stop"
+	"github.com/spacechunks/explorer/cli/cmd/timeline"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/metadata"
 )
@@ -40,6 +47,8 @@ func newChunkCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 
 	publishCmd := requireAPIToken(ctx, cliCtx, publish.NewCommand)
 	publishCmd.Flags().StringP("file", "f", "", "Path to the chunk config file")
+	_ = publishCmd.MarkFlagFilename("file", "yaml", "yml")
+	publishCmd.Flags().Bool("no-tty", false, "Print progress line by line instead of redrawing it, e.g. in CI")
 
 	c.AddCommand(
 		publishCmd,
@@ -47,6 +56,29 @@ func newChunkCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 		requireAPIToken(ctx, cliCtx, list.NewCommand),
 		requireAPIToken(ctx, cliCtx, inspect.NewCommand),
 		requireAPIToken(ctx, cliCtx, deletechunk.NewCommand),
+		requireAPIToken(ctx, cliCtx, events.NewCommand),
+		requireAPIToken(ctx, cliCtx, timeline.NewCommand),
+		requireAPIToken(ctx, cliCtx, stop.NewCommand),
+		requireAPIToken(ctx, cliCtx, snapshot.NewCommand),
+		newInviteCommand(ctx, cliCtx),
+	)
+	return c
+}
+
+func newInviteCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "invite",
+		Short: "Commands for inviting players to instances.",
+	}
+
+	createCmd := requireAPIToken(ctx, cliCtx, invite.NewCreateCommand)
+	createCmd.Flags().Uint32("max-uses", 0, "Number of times the invite can be used, 0 means unlimited")
+	createCmd.Flags().Duration("valid-for", time.Hour, "Duration after which the invite expires, at most 168h")
+
+	c.AddCommand(
+		createCmd,
+		requireAPIToken(ctx, cliCtx, invite.NewListCommand),
+		requireAPIToken(ctx, cliCtx, invite.NewRevokeCommand),
 	)
 	return c
 }
@@ -68,5 +100,24 @@ func requireAPIToken(
 
 		return fn(ctx, cliCtx).RunE(cmd, args)
 	}
+
+	if cmd.ValidArgsFunction != nil {
+		cmd.ValidArgsFunction = func(
+			cmd *cobra.Command,
+			args []string,
+			toComplete string,
+		) ([]cobra.Completion, cobra.ShellCompDirective) {
+			// completions are requested while typing, so
+			// we must not ask the user to log in again.
+			tok, err := cliCtx.Auth.APIToken(auth.NonInteractive(ctx))
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+
+			ctx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", tok))
+			return fn(ctx, cliCtx).ValidArgsFunction(cmd, args, toComplete)
+		}
+	}
+
 	return cmd
 }
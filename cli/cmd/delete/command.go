@@ -3,6 +3,7 @@ package delete
 import (
 	"context"
 	"fmt"
+	"os"
 
 	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
 	"github.com/spacechunks/explorer/cli"
@@ -17,7 +18,7 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 
 		// TODO: replace by GetChunkRequest with name filter
 
-		_, err := cliCtx.Client.GetChunk(ctx, &chunkv1alpha1.GetChunkRequest{
+		resp, err := cliCtx.Client.GetChunk(ctx, &chunkv1alpha1.GetChunkRequest{
 			Id: args[0],
 		})
 		if err != nil {
@@ -25,12 +26,12 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 		}
 
 		if !cli.Prompt(cli.ColorRed + "Are you sure you want to delete your Chunk? This CANNOT be undone! (y/n):" + cli.ColorReset) { //nolint:lll
-			fmt.Println("Aborted.")
+			_, _ = fmt.Fprintln(os.Stderr, "Aborted.")
 			return nil
 		}
 
 		if !cli.Prompt(cli.ColorRed + "Are you really, really sure? (y/n):" + cli.ColorReset) {
-			fmt.Println("Aborted.")
+			_, _ = fmt.Fprintln(os.Stderr, "Aborted.")
 			return nil
 		}
 
@@ -41,13 +42,16 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 			return fmt.Errorf("error while deleting chunk: %w", err)
 		}
 
-		return nil
+		return cli.Print(cmd, resp.Chunk, func() {
+			fmt.Println("Chunk deleted.")
+		})
 	}
 
 	return &cobra.Command{
-		Use:          "delete",
-		Short:        "Permanently deletes a Chunk",
-		RunE:         run,
-		SilenceUsage: true,
+		Use:               "delete",
+		Short:             "Permanently deletes a Chunk",
+		RunE:              run,
+		ValidArgsFunction: cli.CompleteChunkIDs(ctx, cliCtx),
+		SilenceUsage:      true,
 	}
 }
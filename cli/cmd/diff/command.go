@@ -0,0 +1,333 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package diff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spacechunks/explorer/cli/config"
+	"github.com/spacechunks/explorer/internal/textdiff"
+	"github.com/spacechunks/explorer/pkg/client"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxContentSize is the biggest file a content diff is shown for.
+	maxContentSize = 1 << 20
+	contextLines   = 3
+)
+
+// flavorResult is printed when using a machine-readable output format.
+type flavorResult struct {
+	Flavor        string         `json:"flavor"`
+	RemoteVersion string         `json:"remote_version,omitempty"`
+	Changes       []changeResult `json:"changes"`
+}
+
+type changeResult struct {
+	Kind            string `json:"kind"`
+	Path            string `json:"path"`
+	LocalSizeBytes  uint64 `json:"local_size_bytes"`
+	RemoteSizeBytes uint64 `json:"remote_size_bytes"`
+	SizeDeltaBytes  int64  `json:"size_delta_bytes"`
+	ContentDiff     string `json:"content_diff,omitempty"`
+	// ContentNote tells why no content diff is shown, if it was requested.
+	ContentNote string `json:"content_note,omitempty"`
+}
+
+func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		path, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return fmt.Errorf("file flag: %w", err)
+		}
+
+		if path == "" {
+			path = ".chunk.yaml"
+		}
+
+		content, err := cmd.Flags().GetBool("content")
+		if err != nil {
+			return fmt.Errorf("content flag: %w", err)
+		}
+
+		cfg, err := config.ReadWithResolvedPaths(path)
+		if err != nil {
+			return fmt.Errorf("read config: %w", err)
+		}
+
+		flavors := cfg.Chunk.Flavors
+		if len(args) > 0 {
+			flavors = nil
+			for _, f := range cfg.Chunk.Flavors {
+				if f.Name == args[0] {
+					flavors = append(flavors, f)
+				}
+			}
+
+			if len(flavors) == 0 {
+				return fmt.Errorf("flavor %q not found in %s", args[0], path)
+			}
+		}
+
+		chunkID, err := findChunkID(ctx, cliCtx.Client, cfg.Chunk.Name)
+		if err != nil {
+			return fmt.Errorf("error while finding chunk: %w", err)
+		}
+
+		results := make([]flavorResult, 0, len(flavors))
+		for _, f := range flavors {
+			d, err := cliCtx.API.DiffFlavor(ctx, chunkID, f.Path, f.Name)
+			if err != nil {
+				return fmt.Errorf("diff flavor %s: %w", f.Name, err)
+			}
+
+			res := flavorResult{
+				Flavor:        f.Name,
+				RemoteVersion: d.Remote.GetVersion(),
+				Changes:       make([]changeResult, 0, len(d.Changes)),
+			}
+
+			for _, c := range d.Changes {
+				cr := changeResult{
+					Kind:            string(c.Kind),
+					Path:            c.Path,
+					LocalSizeBytes:  c.LocalSizeBytes,
+					RemoteSizeBytes: c.RemoteSizeBytes,
+					SizeDeltaBytes:  c.SizeDelta(),
+				}
+
+				if content && c.Kind == client.ChangeChanged {
+					cr.ContentDiff, cr.ContentNote, err = contentDiff(ctx, cliCtx.API, d.Remote.GetId(), c)
+					if err != nil {
+						return fmt.Errorf("content diff %s: %w", c.Path, err)
+					}
+				}
+
+				res.Changes = append(res.Changes, cr)
+			}
+
+			results = append(results, res)
+		}
+
+		return cli.Print(cmd, results, func() {
+			for i, res := range results {
+				if i > 0 {
+					fmt.Println()
+				}
+				printFlavor(os.Stdout, res)
+			}
+		})
+	}
+
+	return &cobra.Command{
+		Use:   "diff [flavor]",
+		Short: "Shows which files changed compared to the latest published version of a flavor.",
+		Long: `Shows which files of a flavor have been added, changed or removed compared to the latest
+published version, without publishing anything. If no flavor is given, all flavors are compared.
+Use --content to show the changed lines of text files.`,
+		Args:         cobra.MaximumNArgs(1),
+		RunE:         run,
+		SilenceUsage: true,
+	}
+}
+
+// findChunkID returns the id of the chunk with the given name. an empty
+// id is returned if the chunk does not exist yet.
+func findChunkID(ctx context.Context, c chunkv1alpha1.ChunkServiceClient, name string) (string, error) {
+	resp, err := c.CheckChunkName(ctx, &chunkv1alpha1.CheckChunkNameRequest{
+		Name: name,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch resp.GetStatus() {
+	case chunkv1alpha1.ChunkNameStatus_AVAILABLE:
+		return "", nil
+	case chunkv1alpha1.ChunkNameStatus_OWNED:
+		return resp.GetChunkId(), nil
+	default:
+		return "", fmt.Errorf("chunk name %q is already taken, choose a different name", name)
+	}
+}
+
+// contentDiff returns the changed lines of the file. a note is returned instead
+// if the file is binary, too large or the remote content is not available.
+func contentDiff(
+	ctx context.Context,
+	api *client.Client,
+	versionID string,
+	c client.FileChange,
+) (string, string, error) {
+	if c.LocalSizeBytes > maxContentSize || c.RemoteSizeBytes > maxContentSize {
+		return "", "file too large to show differences", nil
+	}
+
+	local, err := os.ReadFile(c.LocalPath)
+	if err != nil {
+		return "", "", fmt.Errorf("read local file: %w", err)
+	}
+
+	if !textdiff.IsText(local) {
+		return "", "binary file", nil
+	}
+
+	remote, err := api.FlavorVersionFile(ctx, versionID, c.Path, maxContentSize)
+	if errors.Is(err, client.ErrFileTooLarge) {
+		return "", "file too large to show differences", nil
+	}
+
+	if status.Code(err) == codes.FailedPrecondition {
+		return "", "content not available until the version has been built", nil
+	}
+
+	if err != nil {
+		return "", "", err
+	}
+
+	if !textdiff.IsText(remote) {
+		return "", "binary file", nil
+	}
+
+	out, err := textdiff.Unified("a/"+c.Path, "b/"+c.Path, string(remote), string(local), contextLines)
+	if errors.Is(err, textdiff.ErrTooLarge) {
+		return "", "too many changes to show differences", nil
+	}
+
+	return out, "", err
+}
+
+func printFlavor(w io.Writer, res flavorResult) {
+	// MyFlavor (v1 -> local):
+	//   + plugins/new.jar       1.2 MiB
+	//   ~ server.properties     1.1 KiB  (+12 B)
+	//   - plugins/old.jar               (-3.0 KiB)
+	// 3 files changed: 1 added, 1 changed, 1 removed
+
+	header := res.Flavor + " (" + res.RemoteVersion + " -> local):"
+	if res.RemoteVersion == "" {
+		header = res.Flavor + " (not published yet):"
+	}
+
+	_, _ = fmt.Fprintln(w, header)
+
+	if len(res.Changes) == 0 {
+		_, _ = fmt.Fprintln(w, "  No changes.")
+		return
+	}
+
+	var added, changed, removed int
+	sec := cli.Section().WithWriter(w)
+	for _, c := range res.Changes {
+		var (
+			color  string
+			prefix string
+			size   string
+			delta  string
+		)
+
+		switch client.ChangeKind(c.Kind) {
+		case client.ChangeAdded:
+			added++
+			color, prefix, size = cli.ColorGreen, "+", formatSize(int64(c.LocalSizeBytes))
+		case client.ChangeChanged:
+			changed++
+			color, prefix, size = cli.ColorYellow, "~", formatSize(int64(c.LocalSizeBytes))
+			delta = formatDelta(c.SizeDeltaBytes)
+		case client.ChangeRemoved:
+			removed++
+			color, prefix = cli.ColorRed, "-"
+			delta = formatDelta(c.SizeDeltaBytes)
+		default:
+		}
+
+		sec.AddRow(color+"  "+prefix+" "+c.Path, strings.TrimSpace(size+"  "+delta)+cli.ColorReset)
+	}
+	sec.Print()
+
+	for _, c := range res.Changes {
+		if c.ContentNote != "" {
+			_, _ = fmt.Fprintf(w, "\n%s: %s\n", c.Path, c.ContentNote)
+			continue
+		}
+
+		if c.ContentDiff != "" {
+			_, _ = fmt.Fprintln(w)
+			printUnified(w, c.ContentDiff)
+		}
+	}
+
+	_, _ = fmt.Fprintf(
+		w,
+		"\n%d files changed: %d added, %d changed, %d removed\n",
+		len(res.Changes), added, changed, removed,
+	)
+}
+
+func printUnified(w io.Writer, diff string) {
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			_, _ = fmt.Fprintln(w, line)
+		case strings.HasPrefix(line, "+"):
+			_, _ = fmt.Fprintln(w, cli.ColorGreen+line+cli.ColorReset)
+		case strings.HasPrefix(line, "-"):
+			_, _ = fmt.Fprintln(w, cli.ColorRed+line+cli.ColorReset)
+		default:
+			_, _ = fmt.Fprintln(w, line)
+		}
+	}
+}
+
+// formatSize formats n bytes using binary units.
+func formatSize(n int64) string {
+	const unit = 1024
+	if n < 0 {
+		n = -n
+	}
+
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func formatDelta(n int64) string {
+	if n < 0 {
+		return "(-" + formatSize(n) + ")"
+	}
+	return "(+" + formatSize(n) + ")"
+}
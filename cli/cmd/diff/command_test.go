@@ -0,0 +1,124 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package diff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatSize(t *testing.T) {
+	tests := []struct {
+		n        int64
+		expected string
+	}{
+		{n: 0, expected: "0 B"},
+		{n: 1023, expected: "1023 B"},
+		{n: 1024, expected: "1.0 KiB"},
+		{n: 1536, expected: "1.5 KiB"},
+		{n: -2048, expected: "2.0 KiB"},
+		{n: 5 << 20, expected: "5.0 MiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			require.Equal(t, tt.expected, formatSize(tt.n))
+		})
+	}
+}
+
+func TestPrintFlavor(t *testing.T) {
+	tests := []struct {
+		name     string
+		res      flavorResult
+		contains []string
+	}{
+		{
+			name: "no changes",
+			res: flavorResult{
+				Flavor:        "a",
+				RemoteVersion: "v1",
+				Changes:       []changeResult{},
+			},
+			contains: []string{"a (v1 -> local):", "No changes."},
+		},
+		{
+			name: "not published",
+			res: flavorResult{
+				Flavor: "a",
+				Changes: []changeResult{
+					{Kind: "added", Path: "server.properties", LocalSizeBytes: 10, SizeDeltaBytes: 10},
+				},
+			},
+			contains: []string{
+				"a (not published yet):",
+				"+ server.properties",
+				"10 B",
+				"1 files changed: 1 added, 0 changed, 0 removed",
+			},
+		},
+		{
+			name: "changes with content",
+			res: flavorResult{
+				Flavor:        "a",
+				RemoteVersion: "v1",
+				Changes: []changeResult{
+					{
+						Kind:            "changed",
+						Path:            "server.properties",
+						LocalSizeBytes:  12,
+						RemoteSizeBytes: 10,
+						SizeDeltaBytes:  2,
+						ContentDiff:     "--- a/server.properties\n+++ b/server.properties\n@@ -1 +1 @@\n-a=1\n+a=12\n",
+					},
+					{
+						Kind:            "changed",
+						Path:            "world/level.dat",
+						LocalSizeBytes:  1,
+						RemoteSizeBytes: 1,
+						ContentNote:     "binary file",
+					},
+					{Kind: "removed", Path: "old.jar", RemoteSizeBytes: 2048, SizeDeltaBytes: -2048},
+				},
+			},
+			contains: []string{
+				"~ server.properties",
+				"(+2 B)",
+				"- old.jar",
+				"(-2.0 KiB)",
+				"+++ b/server.properties",
+				"+a=12",
+				"world/level.dat: binary file",
+				"3 files changed: 0 added, 2 changed, 1 removed",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			printFlavor(&buf, tt.res)
+			for _, s := range tt.contains {
+				require.Contains(t, buf.String(), s)
+			}
+		})
+	}
+}
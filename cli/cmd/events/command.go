@@ -0,0 +1,61 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rodaine/table"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spf13/cobra"
+)
+
+func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("instance id is missing")
+		}
+
+		resp, err := cliCtx.InstanceClient.GetInstanceEvents(ctx, &instancev1alpha1.GetInstanceEventsRequest{
+			InstanceId: args[0],
+		})
+		if err != nil {
+			return fmt.Errorf("error while getting instance events: %w", err)
+		}
+
+		return cli.Print(cmd, resp, func() {
+			t := table.New("TIME", "TYPE", "EXIT CODE", "MESSAGE")
+			for _, ev := range resp.Events {
+				t.AddRow(ev.CreatedAt.AsTime().Format(time.RFC1123Z), ev.Type, ev.ExitCode, ev.Message)
+			}
+			t.Print()
+		})
+	}
+
+	return &cobra.Command{
+		Use:               "events",
+		Short:             "Shows why an instance stopped, for example because it ran out of memory.",
+		RunE:              run,
+		ValidArgsFunction: cli.CompleteInstanceIDs(ctx, cliCtx),
+		SilenceUsage:      true,
+	}
+}
@@ -0,0 +1,410 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package initialize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spacechunks/explorer/cli/config"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	defaultFlavorVersion = "v1"
+	defaultMinPlayers    = 1
+	defaultMaxPlayers    = 20
+)
+
+type result struct {
+	Path    string        `json:"path"`
+	ChunkID string        `json:"chunk_id,omitempty"`
+	Config  config.Config `json:"config"`
+}
+
+func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		var (
+			dir, _            = cmd.Flags().GetString("dir")
+			path, _           = cmd.Flags().GetString("file")
+			force, _          = cmd.Flags().GetBool("force")
+			create, _         = cmd.Flags().GetBool("create")
+			nonInteractive, _ = cmd.Flags().GetBool("non-interactive")
+			name, _           = cmd.Flags().GetString("name")
+			description, _    = cmd.Flags().GetString("description")
+			tags, _           = cmd.Flags().GetStringSlice("tags")
+			template, _       = cmd.Flags().GetString("template")
+		)
+
+		interactive := !nonInteractive && term.IsTerminal(int(os.Stdin.Fd()))
+
+		dir, err := filepath.Abs(dir)
+		if err != nil {
+			return fmt.Errorf("resolve directory: %w", err)
+		}
+
+		if path == "" {
+			path = filepath.Join(dir, ".chunk.yaml")
+		}
+
+		if _, err := os.Stat(path); err == nil && !force {
+			return fmt.Errorf("%s already exists, use --force to overwrite it", path)
+		}
+
+		// the files of templates are downloaded to the directory,
+		// so there is no server to detect yet.
+		var servers []server
+		if template == "" {
+			servers, err = detectServers(dir)
+			if err != nil {
+				return fmt.Errorf("detect servers: %w", err)
+			}
+
+			if len(servers) == 0 {
+				return fmt.Errorf(
+					"no minecraft server found in %s or its subdirectories, "+
+						"make sure they contain a server.properties file",
+					dir,
+				)
+			}
+		}
+
+		ask := func(label string, def string) string {
+			if !interactive {
+				return def
+			}
+			return cli.Ask(label, def)
+		}
+
+		if name == "" {
+			name = ask("Chunk name", filepath.Base(dir))
+		}
+
+		if description == "" {
+			description = ask("Chunk description", "")
+		}
+
+		if len(tags) == 0 && interactive {
+			if t := ask("Tags (comma separated)", ""); t != "" {
+				tags = strings.Split(t, ",")
+				for i := range tags {
+					tags[i] = strings.TrimSpace(tags[i])
+				}
+			}
+		}
+
+		if template != "" {
+			cfg, chunkID, err := fromTemplate(ctx, cliCtx, template, dir, path, config.Chunk{
+				Name:        name,
+				Description: description,
+				Tags:        tags,
+			}, force)
+			if err != nil {
+				return err
+			}
+
+			return printResult(cmd, result{Path: path, ChunkID: chunkID, Config: cfg})
+		}
+
+		cfg := config.Config{
+			Version: "v1alpha1",
+			Chunk: config.Chunk{
+				Name:        name,
+				Description: description,
+				Tags:        tags,
+			},
+		}
+
+		for _, srv := range servers {
+			rel, err := filepath.Rel(filepath.Dir(path), srv.path)
+			if err != nil {
+				return fmt.Errorf("relative path: %w", err)
+			}
+
+			rel = "./" + filepath.ToSlash(rel)
+
+			if interactive && len(servers) > 1 && !cli.Prompt(fmt.Sprintf("Add flavor for %s? (y/n):", rel)) {
+				continue
+			}
+
+			if !srv.paper {
+				_, _ = fmt.Fprintf(os.Stderr,
+					"%sWARNING: %s does not look like a Paper server. Currently, only Paper is supported.%s\n",
+					cli.ColorYellow, rel, cli.ColorReset,
+				)
+			}
+
+			flavorName := filepath.Base(srv.path)
+			if srv.path == dir {
+				flavorName = "default"
+			}
+
+			flavor, err := flavorFromServer(cmd, srv, flavorName, ask)
+			if err != nil {
+				return err
+			}
+
+			flavor.Path = rel
+			cfg.Chunk.Flavors = append(cfg.Chunk.Flavors, flavor)
+		}
+
+		if err := validate(cfg); err != nil {
+			return err
+		}
+
+		var chunkID string
+		if create || (interactive && cli.Prompt("Create the Chunk now? (y/n):")) {
+			tok, err := cliCtx.Auth.APIToken(ctx)
+			if err != nil {
+				return fmt.Errorf("authentication failed: %w", err)
+			}
+
+			authCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", tok))
+
+			// check everything the control plane would reject before writing the
+			// config, so the user does not end up with a config that cannot be published.
+			if err := validateRemote(authCtx, cliCtx, cfg); err != nil {
+				return err
+			}
+
+			if err := writeConfig(cfg, path); err != nil {
+				return err
+			}
+
+			resp, err := cliCtx.Client.CreateChunk(authCtx, &chunkv1alpha1.CreateChunkRequest{
+				Name:        cfg.Chunk.Name,
+				Description: cfg.Chunk.Description,
+				Tags:        cfg.Chunk.Tags,
+			})
+			if err != nil {
+				return fmt.Errorf("error while creating chunk: %w", err)
+			}
+
+			chunkID = resp.GetChunk().GetId()
+		} else if err := writeConfig(cfg, path); err != nil {
+			return err
+		}
+
+		return printResult(cmd, result{Path: path, ChunkID: chunkID, Config: cfg})
+	}
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Creates a .chunk.yaml for the Minecraft servers found in the current directory.",
+		Long: `Creates a .chunk.yaml for the Minecraft servers found in the current directory.
+
+If the directory itself contains a server, a single flavor is proposed. Otherwise, a
+flavor is proposed for every subdirectory containing a server. Values that are not
+passed using flags are asked for, unless --non-interactive is set.
+
+Using --template, the Chunk is created from one of the templates of the Explorer
+instead, and the files of the template are downloaded to the directory.`,
+		Args:         cobra.NoArgs,
+		RunE:         run,
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().String("dir", ".", "Directory containing the servers")
+	cmd.Flags().StringP("file", "f", "", "Path of the config file to create (default \"<dir>/.chunk.yaml\")")
+	cmd.Flags().Bool("force", false, "Overwrite an existing config file")
+	cmd.Flags().Bool("create", false, "Create the Chunk in the Explorer after writing the config file")
+	cmd.Flags().Bool("non-interactive", false, "Do not ask for values, use flags and detected defaults instead")
+	cmd.Flags().String("name", "", "Name of the Chunk (default: name of the directory)")
+	cmd.Flags().String("description", "", "Description of the Chunk")
+	cmd.Flags().StringSlice("tags", nil, "Tags of the Chunk")
+	cmd.Flags().String("template", "", "Create the Chunk from the template with the given name")
+	cmd.Flags().String("minecraft-version", "", "Minecraft version of all flavors (default: detected version)")
+	cmd.Flags().Int("min-players", 0, "Minimum amount of players of all flavors (default 1)")
+	cmd.Flags().Int("max-players", 0, "Maximum amount of players of all flavors (default: max-players of server.properties)")
+
+	_ = cmd.MarkFlagDirname("dir")
+	_ = cmd.MarkFlagFilename("file", "yaml", "yml")
+
+	return cmd
+}
+
+func printResult(cmd *cobra.Command, res result) error {
+	return cli.Print(cmd, res, func() {
+		fmt.Printf("%sCreated %s%s\n", cli.ColorGreen, res.Path, cli.ColorReset)
+		sec := cli.Section()
+		for _, f := range res.Config.Chunk.Flavors {
+			sec.AddRow(" "+f.Name+":", fmt.Sprintf("%s (Minecraft %s)", f.Path, f.MinecraftVersion))
+		}
+		sec.Print()
+		if res.ChunkID != "" {
+			fmt.Printf("Chunk created with id %s.\n", res.ChunkID)
+		}
+		fmt.Println("Run 'explorer chunk publish' to publish your Chunk.")
+	})
+}
+
+func flavorFromServer(
+	cmd *cobra.Command,
+	srv server,
+	name string,
+	ask func(string, string) string,
+) (config.Flavor, error) {
+	var (
+		mcVersion, _  = cmd.Flags().GetString("minecraft-version")
+		minPlayers, _ = cmd.Flags().GetInt("min-players")
+		maxPlayers, _ = cmd.Flags().GetInt("max-players")
+	)
+
+	prefix := "Flavor " + name + ": "
+
+	name = ask(prefix+"name", name)
+
+	if mcVersion == "" {
+		mcVersion = ask(prefix+"Minecraft version", srv.minecraftVersion)
+	}
+
+	if minPlayers == 0 {
+		v, err := askInt(ask, prefix+"min players", defaultMinPlayers)
+		if err != nil {
+			return config.Flavor{}, err
+		}
+		minPlayers = v
+	}
+
+	if maxPlayers == 0 {
+		def := srv.maxPlayers
+		if def == 0 {
+			def = defaultMaxPlayers
+		}
+
+		v, err := askInt(ask, prefix+"max players", def)
+		if err != nil {
+			return config.Flavor{}, err
+		}
+		maxPlayers = v
+	}
+
+	return config.Flavor{
+		Name:             name,
+		Version:          defaultFlavorVersion,
+		MinecraftVersion: mcVersion,
+		MinPlayers:       minPlayers,
+		MaxPlayers:       maxPlayers,
+	}, nil
+}
+
+func askInt(ask func(string, string) string, label string, def int) (int, error) {
+	v := ask(label, strconv.Itoa(def))
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %q is not a number", label, v)
+	}
+	return n, nil
+}
+
+// validate checks the config using the same rules publish does.
+func validate(cfg config.Config) error {
+	if len(cfg.Chunk.Flavors) == 0 {
+		return errors.New("at least one flavor is required")
+	}
+
+	issues := config.Validate(cfg)
+
+	names := make([]string, 0, len(cfg.Chunk.Flavors))
+	for i, f := range cfg.Chunk.Flavors {
+		if slices.Contains(names, f.Name) {
+			if issues == nil {
+				issues = make(map[string][]string)
+			}
+			key := fmt.Sprintf("chunk.flavors[%d].name", i)
+			issues[key] = append(issues[key], "flavor names must be unique")
+		}
+		names = append(names, f.Name)
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "%sThe config is not valid:\n", cli.ColorRed)
+	sec := cli.Section().WithWriter(os.Stderr)
+	for path, errs := range issues {
+		sec.AddRow(fmt.Sprintf("- %s:", path), strings.Join(errs, ","))
+	}
+	sec.Print()
+	_, _ = fmt.Fprint(os.Stderr, cli.ColorReset)
+
+	return errors.New("invalid config, pass the missing values using flags or run init interactively")
+}
+
+// validateRemote checks the config against constraints only the control plane knows about.
+func validateRemote(ctx context.Context, cliCtx cli.Context, cfg config.Config) error {
+	resp, err := cliCtx.Client.GetSupportedMinecraftVersions(
+		ctx,
+		&chunkv1alpha1.GetSupportedMinecraftVersionsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("get minecraft versions: %w", err)
+	}
+
+	for _, f := range cfg.Chunk.Flavors {
+		if !slices.Contains(resp.GetVersions(), f.MinecraftVersion) {
+			return fmt.Errorf(
+				"flavor %s: minecraft version %s is not supported, supported versions are: %s (latest: %s)",
+				f.Name,
+				f.MinecraftVersion,
+				strings.Join(resp.GetVersions(), ","),
+				newestVersion(resp.GetVersions()),
+			)
+		}
+	}
+
+	// TODO: get chunk by name
+	chunks, err := cliCtx.Client.ListChunks(ctx, &chunkv1alpha1.ListChunksRequest{})
+	if err != nil {
+		return fmt.Errorf("error while listing chunks: %w", err)
+	}
+
+	for _, c := range chunks.GetChunks() {
+		if c.GetName() == cfg.Chunk.Name {
+			return fmt.Errorf("a chunk named %s already exists", cfg.Chunk.Name)
+		}
+	}
+
+	return nil
+}
+
+func writeConfig(cfg config.Config, path string) error {
+	data, err := yaml.Marshal(&cfg)
+	if err != nil {
+		return fmt.Errorf("couldn't marshal YAML: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("couldn't write file: %w", err)
+	}
+
+	return nil
+}
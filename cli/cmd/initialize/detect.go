@@ -0,0 +1,192 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package initialize
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// paper jars are named like paper-1.21.4-15.jar
+	paperJarRegex = regexp.MustCompile(`^paper-(\d+\.\d+(?:\.\d+)?)-\d+\.jar$`)
+
+	// the version history written by paper contains something like: git-Paper-15 (MC: 1.21.4)
+	versionHistoryRegex = regexp.MustCompile(`\(MC: (\d+\.\d+(?:\.\d+)?)\)`)
+
+	// files that only exist in paper server directories
+	paperMarkers = []string{
+		"paper.yml",
+		filepath.Join("config", "paper-global.yml"),
+		"version_history.json",
+	}
+)
+
+// server is a directory containing a minecraft server.
+type server struct {
+	path string
+
+	// minecraftVersion is empty if it could not be detected
+	minecraftVersion string
+
+	// maxPlayers is 0 if server.properties does not set it
+	maxPlayers int
+	paper      bool
+}
+
+// detectServers returns dir, if it contains a server. otherwise
+// all direct subdirectories containing a server are returned.
+func detectServers(dir string) ([]server, error) {
+	if srv, ok := detectServer(dir); ok {
+		return []server{srv}, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []server
+	for _, e := range entries {
+		if !e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+
+		if srv, ok := detectServer(filepath.Join(dir, e.Name())); ok {
+			servers = append(servers, srv)
+		}
+	}
+
+	return servers, nil
+}
+
+func detectServer(dir string) (server, bool) {
+	srv := server{
+		path: dir,
+	}
+
+	props, err := os.Open(filepath.Join(dir, "server.properties"))
+	hasProps := err == nil
+	if hasProps {
+		defer props.Close()
+		srv.maxPlayers = maxPlayers(props)
+	}
+
+	for _, m := range paperMarkers {
+		if _, err := os.Stat(filepath.Join(dir, m)); err == nil {
+			srv.paper = true
+		}
+	}
+
+	if v := versionFromHistory(dir); v != "" {
+		srv.minecraftVersion = v
+	}
+
+	entries, _ := os.ReadDir(dir)
+	for _, e := range entries {
+		matches := paperJarRegex.FindStringSubmatch(e.Name())
+		if matches == nil {
+			continue
+		}
+
+		srv.paper = true
+		if srv.minecraftVersion == "" {
+			srv.minecraftVersion = matches[1]
+		}
+	}
+
+	return srv, hasProps || srv.paper
+}
+
+func maxPlayers(f *os.File) int {
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, val, ok := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !ok || strings.TrimSpace(key) != "max-players" {
+			continue
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(val))
+		if err != nil {
+			return 0
+		}
+
+		return n
+	}
+	return 0
+}
+
+func versionFromHistory(dir string) string {
+	data, err := os.ReadFile(filepath.Join(dir, "version_history.json"))
+	if err != nil {
+		return ""
+	}
+
+	var history struct {
+		CurrentVersion string `json:"currentVersion"`
+	}
+
+	if err := json.Unmarshal(data, &history); err != nil {
+		return ""
+	}
+
+	matches := versionHistoryRegex.FindStringSubmatch(history.CurrentVersion)
+	if matches == nil {
+		return ""
+	}
+
+	return matches[1]
+}
+
+// newestVersion returns the highest minecraft version of the given ones.
+func newestVersion(versions []string) string {
+	if len(versions) == 0 {
+		return ""
+	}
+
+	return slices.MaxFunc(versions, compareVersions)
+}
+
+func compareVersions(a, b string) int {
+	var (
+		as = strings.Split(a, ".")
+		bs = strings.Split(b, ".")
+	)
+
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			return x - y
+		}
+	}
+
+	return 0
+}
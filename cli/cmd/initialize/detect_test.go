@@ -0,0 +1,103 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package initialize
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectServers(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected []server
+	}{
+		{
+			name: "directory itself is a server",
+			files: map[string]string{
+				"server.properties":   "motd=hello\nmax-players = 16\n",
+				"paper-1.21.4-15.jar": "",
+				"plugins/a.jar":       "",
+			},
+			expected: []server{
+				{
+					path:             ".",
+					minecraftVersion: "1.21.4",
+					maxPlayers:       16,
+					paper:            true,
+				},
+			},
+		},
+		{
+			name: "servers in subdirectories",
+			files: map[string]string{
+				"8x1/server.properties":    "max-players=8",
+				"8x1/version_history.json": `{"currentVersion": "git-Paper-196 (MC: 1.21.8)"}`,
+				"8x4/server.properties":    "",
+				"assets/logo.png":          "",
+				".git/config":              "",
+			},
+			expected: []server{
+				{
+					path:             "8x1",
+					minecraftVersion: "1.21.8",
+					maxPlayers:       8,
+					paper:            true,
+				},
+				{
+					path: "8x4",
+				},
+			},
+		},
+		{
+			name: "no servers",
+			files: map[string]string{
+				"README.md": "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for path, content := range tt.files {
+				full := filepath.Join(dir, path)
+				require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o755))
+				require.NoError(t, os.WriteFile(full, []byte(content), 0o644))
+			}
+
+			for i := range tt.expected {
+				tt.expected[i].path = filepath.Join(dir, tt.expected[i].path)
+			}
+
+			servers, err := detectServers(dir)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, servers)
+		})
+	}
+}
+
+func TestNewestVersion(t *testing.T) {
+	require.Equal(t, "1.21.10", newestVersion([]string{"1.21.4", "1.21.10", "1.20", "1.21"}))
+	require.Empty(t, newestVersion(nil))
+}
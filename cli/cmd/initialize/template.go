@@ -0,0 +1,115 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package initialize
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spacechunks/explorer/cli/config"
+	"google.golang.org/grpc/metadata"
+)
+
+// fromTemplate creates the chunk from the template and downloads its files
+// to dir, so creators can start with a working server instead of an empty one.
+func fromTemplate(
+	ctx context.Context,
+	cliCtx cli.Context,
+	template string,
+	dir string,
+	path string,
+	chunk config.Chunk,
+	force bool,
+) (config.Config, string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return config.Config{}, "", fmt.Errorf("read directory: %w", err)
+	}
+
+	// files of the template would replace the existing ones.
+	if len(entries) > 0 && !force {
+		return config.Config{}, "", fmt.Errorf("%s is not empty, use --force to add the template files anyway", dir)
+	}
+
+	tok, err := cliCtx.Auth.APIToken(ctx)
+	if err != nil {
+		return config.Config{}, "", fmt.Errorf("authentication failed: %w", err)
+	}
+
+	authCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", tok))
+
+	resp, err := cliCtx.Client.InstantiateChunkTemplate(authCtx, &chunkv1alpha1.InstantiateChunkTemplateRequest{
+		TemplateName: template,
+		ChunkName:    chunk.Name,
+		Description:  chunk.Description,
+		Tags:         chunk.Tags,
+	})
+	if err != nil {
+		return config.Config{}, "", fmt.Errorf("error while creating chunk from template: %w", err)
+	}
+
+	var (
+		c       = resp.GetChunk()
+		version = resp.GetVersion()
+	)
+
+	if len(c.GetFlavors()) == 0 {
+		return config.Config{}, "", fmt.Errorf("chunk %s has been created without a flavor", c.GetId())
+	}
+
+	if err := cliCtx.API.DownloadFlavorVersion(authCtx, version, dir); err != nil {
+		return config.Config{}, "", fmt.Errorf("download template files: %w", err)
+	}
+
+	rel, err := filepath.Rel(filepath.Dir(path), dir)
+	if err != nil {
+		return config.Config{}, "", fmt.Errorf("relative path: %w", err)
+	}
+
+	cfg := config.Config{
+		Version: "v1alpha1",
+		Chunk: config.Chunk{
+			Name:        c.GetName(),
+			Description: c.GetDescription(),
+			Tags:        c.GetTags(),
+			Flavors: []config.Flavor{
+				{
+					Name:             c.GetFlavors()[0].GetName(),
+					Version:          version.GetVersion(),
+					MinecraftVersion: version.GetMinecraftVersion(),
+					Path:             "./" + filepath.ToSlash(rel),
+					MinPlayers:       int(version.GetMinPlayers()),
+					MaxPlayers:       int(version.GetMaxPlayers()),
+					JavaVersion:      int(version.GetJavaVersion()),
+					StartupCommand:   version.GetStartupCommand(),
+				},
+			},
+		},
+	}
+
+	if err := writeConfig(cfg, path); err != nil {
+		return config.Config{}, "", err
+	}
+
+	return cfg, c.GetId(), nil
+}
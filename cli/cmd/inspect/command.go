@@ -47,54 +47,55 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 
 		found := resp.GetChunk()
 
-		var (
-			indent1 = " "
-			indent2 = "  "
-			indent3 = "   "
-			indent4 = "    "
-		)
-
-		chunkData := cli.Section()
-		chunkData.AddRow("ID: ", found.Id)
-		chunkData.AddRow("Name: ", found.Name)
-		chunkData.AddRow("Owner: ", found.Owner.Nickname)
-		chunkData.AddRow("Description: ", found.Description)
-		chunkData.AddRow("Tags: ", strings.Join(found.Tags, ","))
-		chunkData.AddRow("Created at: ", fmtTime(found.CreatedAt))
-		chunkData.AddRow("Updated at: ", fmtTime(found.UpdatedAt))
-		chunkData.Print()
-
-		fmt.Println("Flavors:")
-
-		for _, f := range found.Flavors {
-			flavorData := cli.Section()
-			flavorData.AddRow(indent1+f.Name+":", "")
-			flavorData.AddRow(indent2+"ID:", f.Id)
-			flavorData.AddRow(indent2+"Created at:", fmtTime(f.CreatedAt))
-			flavorData.AddRow(indent2+"Updated at:", fmtTime(f.UpdatedAt))
-			flavorData.Print()
-			fmt.Println(indent2 + "Versions:")
-			for _, v := range f.Versions {
-				versionData := cli.Section()
-				versionData.AddRow(indent3+v.Version+":", "")
-				versionData.AddRow(indent4+"ID:", v.Id)
-				versionData.AddRow(indent4+"Minecraft version"+":", v.MinecraftVersion)
-				versionData.AddRow(indent4+"Min Players"+":", v.MinPlayers)
-				versionData.AddRow(indent4+"Max Players"+":", v.MaxPlayers)
-				versionData.AddRow(indent4+"Created at:", fmtTime(v.CreatedAt))
-				versionData.AddRow(indent4+"Build status:", v.BuildStatus)
-				versionData.Print()
+		return cli.Print(cmd, found, func() {
+			var (
+				indent1 = " "
+				indent2 = "  "
+				indent3 = "   "
+				indent4 = "    "
+			)
+
+			chunkData := cli.Section()
+			chunkData.AddRow("ID: ", found.Id)
+			chunkData.AddRow("Name: ", found.Name)
+			chunkData.AddRow("Owner: ", found.Owner.Nickname)
+			chunkData.AddRow("Description: ", found.Description)
+			chunkData.AddRow("Tags: ", strings.Join(found.Tags, ","))
+			chunkData.AddRow("Created at: ", fmtTime(found.CreatedAt))
+			chunkData.AddRow("Updated at: ", fmtTime(found.UpdatedAt))
+			chunkData.Print()
+
+			fmt.Println("Flavors:")
+
+			for _, f := range found.Flavors {
+				flavorData := cli.Section()
+				flavorData.AddRow(indent1+f.Name+":", "")
+				flavorData.AddRow(indent2+"ID:", f.Id)
+				flavorData.AddRow(indent2+"Created at:", fmtTime(f.CreatedAt))
+				flavorData.AddRow(indent2+"Updated at:", fmtTime(f.UpdatedAt))
+				flavorData.Print()
+				fmt.Println(indent2 + "Versions:")
+				for _, v := range f.Versions {
+					versionData := cli.Section()
+					versionData.AddRow(indent3+v.Version+":", "")
+					versionData.AddRow(indent4+"ID:", v.Id)
+					versionData.AddRow(indent4+"Minecraft version"+":", v.MinecraftVersion)
+					versionData.AddRow(indent4+"Min Players"+":", v.MinPlayers)
+					versionData.AddRow(indent4+"Max Players"+":", v.MaxPlayers)
+					versionData.AddRow(indent4+"Created at:", fmtTime(v.CreatedAt))
+					versionData.AddRow(indent4+"Build status:", v.BuildStatus)
+					versionData.Print()
+				}
 			}
-		}
-
-		return nil
+		})
 	}
 
 	return &cobra.Command{
-		Use:          "inspect",
-		Short:        "Shows detailed information about a single Chunk.",
-		RunE:         run,
-		SilenceUsage: true,
+		Use:               "inspect",
+		Short:             "Shows detailed information about a single Chunk.",
+		RunE:              run,
+		ValidArgsFunction: cli.CompleteChunkIDs(ctx, cliCtx),
+		SilenceUsage:      true,
 	}
 }
 
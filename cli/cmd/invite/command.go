@@ -0,0 +1,145 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/rodaine/table"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spf13/cobra"
+)
+
+func NewCreateCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("instance id is missing")
+		}
+
+		var (
+			maxUses, _  = cmd.Flags().GetUint32("max-uses")
+			validFor, _ = cmd.Flags().GetDuration("valid-for")
+		)
+
+		resp, err := cliCtx.InstanceClient.CreateInstanceInvite(ctx, &instancev1alpha1.CreateInstanceInviteRequest{
+			InstanceId:      args[0],
+			MaxUses:         maxUses,
+			ValidForMinutes: uint32(math.Ceil(validFor.Minutes())),
+		})
+		if err != nil {
+			return fmt.Errorf("error while creating instance invite: %w", err)
+		}
+
+		invite := resp.GetInvite()
+
+		return cli.Print(cmd, invite, func() {
+			fmt.Printf("Invite created, it is valid until %s.\n", formatTime(invite.GetExpiresAt().AsTime()))
+			fmt.Printf("Share this token, it will not be shown again:\n%s\n", invite.GetToken())
+		})
+	}
+
+	return &cobra.Command{
+		Use:               "create",
+		Short:             "Creates an invite players can use to join the instance.",
+		RunE:              run,
+		ValidArgsFunction: cli.CompleteInstanceIDs(ctx, cliCtx),
+		SilenceUsage:      true,
+	}
+}
+
+func NewListCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("instance id is missing")
+		}
+
+		resp, err := cliCtx.InstanceClient.ListInstanceInvites(ctx, &instancev1alpha1.ListInstanceInvitesRequest{
+			InstanceId: args[0],
+		})
+		if err != nil {
+			return fmt.Errorf("error while listing instance invites: %w", err)
+		}
+
+		return cli.Print(cmd, resp, func() {
+			t := table.New("ID", "USES", "EXPIRES", "STATE")
+			for _, i := range resp.GetInvites() {
+				uses := fmt.Sprintf("%d/%d", i.GetUses(), i.GetMaxUses())
+				if i.GetMaxUses() == 0 {
+					uses = fmt.Sprintf("%d/unlimited", i.GetUses())
+				}
+
+				t.AddRow(i.GetId(), uses, formatTime(i.GetExpiresAt().AsTime()), state(i))
+			}
+			t.Print()
+		})
+	}
+
+	return &cobra.Command{
+		Use:               "list",
+		Short:             "Lists the invites of an instance and how often they have been used.",
+		RunE:              run,
+		ValidArgsFunction: cli.CompleteInstanceIDs(ctx, cliCtx),
+		SilenceUsage:      true,
+	}
+}
+
+func NewRevokeCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("invite id is missing")
+		}
+
+		if _, err := cliCtx.InstanceClient.RevokeInstanceInvite(ctx, &instancev1alpha1.RevokeInstanceInviteRequest{
+			InviteId: args[0],
+		}); err != nil {
+			return fmt.Errorf("error while revoking instance invite: %w", err)
+		}
+
+		fmt.Println("Invite revoked.")
+		return nil
+	}
+
+	return &cobra.Command{
+		Use:          "revoke",
+		Short:        "Revokes an invite, so it cannot be used anymore.",
+		RunE:         run,
+		SilenceUsage: true,
+	}
+}
+
+func state(i *instancev1alpha1.InstanceInvite) string {
+	switch {
+	case i.GetRevokedAt() != nil:
+		return "revoked"
+	case !time.Now().Before(i.GetExpiresAt().AsTime()):
+		return "expired"
+	case i.GetMaxUses() != 0 && i.GetUses() >= i.GetMaxUses():
+		return "used up"
+	default:
+		return "active"
+	}
+}
+
+func formatTime(t time.Time) string {
+	return t.Local().Format(time.RFC1123Z)
+}
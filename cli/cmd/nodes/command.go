@@ -0,0 +1,72 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rodaine/table"
+	adminv1alpha1 "github.com/spacechunks/explorer/api/admin/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spf13/cobra"
+)
+
+func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		resp, err := cliCtx.AdminClient.ListNodeCapacities(ctx, &adminv1alpha1.ListNodeCapacitiesRequest{})
+		if err != nil {
+			return fmt.Errorf("error while listing node capacities: %w", err)
+		}
+
+		return cli.Print(cmd, resp, func() {
+			t := table.New("NAME", "SLOTS", "AVAILABLE", "HEALTHY", "CAPABILITIES", "LAST SEEN", "ID")
+			for _, n := range resp.Nodes {
+				lastSeen := "never"
+				if n.LastSeenAt != nil {
+					lastSeen = n.LastSeenAt.AsTime().Format(time.RFC1123Z)
+				}
+
+				if n.Lost {
+					lastSeen += " (lost)"
+				}
+
+				t.AddRow(
+					n.NodeName,
+					n.Slots,
+					n.AvailableSlots,
+					!n.Unhealthy,
+					strings.Join(n.Capabilities, ","),
+					lastSeen,
+					n.NodeId,
+				)
+			}
+			t.Print()
+		})
+	}
+
+	return &cobra.Command{
+		Use:          "nodes",
+		Short:        "Shows the capacity of the nodes as seen by the scheduler. Only available to admins.",
+		RunE:         run,
+		SilenceUsage: true,
+	}
+}
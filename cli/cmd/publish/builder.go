@@ -23,12 +23,15 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"os"
 	"path/filepath"
 	"slices"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -37,6 +40,7 @@ import (
 	"github.com/spacechunks/explorer/internal/file"
 	"github.com/spacechunks/explorer/internal/ptr"
 	"github.com/spacechunks/explorer/internal/tarhelper"
+	"github.com/spacechunks/explorer/pkg/client"
 )
 
 /*
@@ -63,17 +67,60 @@ import (
 type buildUpdate struct {
 	data           buildData
 	buildStatus    *string
+	buildProgress  uint32
 	uploadProgress *uint
 	err            error
 }
 
+func (u buildUpdate) progress() flavorProgress {
+	p := flavorProgress{
+		name: u.data.local.name,
+		err:  u.err,
+	}
+
+	switch u.data.phase {
+	case buildPhasePrerequisites:
+		p.phase = flavorPhasePreparing
+	case buildPhaseUpload:
+		p.phase = flavorPhaseUploading
+	case buildPhaseTriggerBuild, buildPhaseBuildComplete:
+		p.phase = flavorPhaseBuilding
+	default:
+	}
+
+	if u.uploadProgress != nil {
+		p.phase = flavorPhaseUploading
+		p.percent = *u.uploadProgress
+	}
+
+	if u.buildStatus != nil {
+		p.phase = flavorPhaseBuilding
+		p.status = *u.buildStatus
+		p.percent = uint(u.buildProgress)
+		p.done = p.status == chunkv1alpha1.BuildStatus_COMPLETED.String()
+		if p.done {
+			p.percent = 100
+		}
+	}
+
+	return p
+}
+
 type builder struct {
 	client       chunkv1alpha1.ChunkServiceClient
+	logger       *slog.Logger
 	updates      chan buildUpdate
 	buildCounter *atomic.Int32
 	changeSetDir string
 }
 
+// limits for uploading files using a patch. these are the defaults
+// of the control plane, if a diff exceeds them a tarball is used.
+const (
+	patchMaxFiles     = 20
+	patchMaxSizeBytes = 16 << 20
+)
+
 type buildPhase int
 
 const (
@@ -93,6 +140,10 @@ func (b builder) build(ctx context.Context, data buildData) {
 	b.buildCounter.Add(1)
 	defer b.buildCounter.Add(-1)
 
+	b.updates <- buildUpdate{
+		data: data,
+	}
+
 	for {
 		switch data.phase {
 		case buildPhasePrerequisites:
@@ -135,20 +186,42 @@ func (b builder) build(ctx context.Context, data buildData) {
 				Id: data.chunkID,
 			})
 			if err != nil {
-				fmt.Println("error while getting chunk:", err)
+				b.logger.DebugContext(ctx, "error while getting chunk", "chunk_id", data.chunkID, "err", err)
+				continue
 			}
 
 			flavor := cli.Find(c.GetChunk().Flavors, func(f *chunkv1alpha1.Flavor) bool {
 				return f.Name == data.local.name
 			})
+			if flavor == nil {
+				continue
+			}
 
-			status := flavor.Versions[0].BuildStatus
+			// look up the version by name, because the latest version
+			// could have been published concurrently by someone else.
+			version := cli.Find(flavor.Versions, func(v *chunkv1alpha1.FlavorVersion) bool {
+				return v.Version == data.local.version
+			})
+			if version == nil {
+				continue
+			}
+
+			status := version.BuildStatus
 
-			b.updates <- buildUpdate{
-				data:        data,
-				buildStatus: ptr.Pointer(status.String()),
+			upd := buildUpdate{
+				data:          data,
+				buildStatus:   ptr.Pointer(status.String()),
+				buildProgress: version.GetBuildProgress(),
 			}
 
+			// set if the control plane rejected the build, for example
+			// because the flavor exceeds the configured build limits.
+			if buildErr := version.GetBuildError(); buildErr != "" {
+				upd.err = errors.New(buildErr)
+			}
+
+			b.updates <- upd
+
 			if status == chunkv1alpha1.BuildStatus_COMPLETED ||
 				status == chunkv1alpha1.BuildStatus_IMAGE_BUILD_FAILED ||
 				status == chunkv1alpha1.BuildStatus_CHECKPOINT_BUILD_FAILED {
@@ -181,6 +254,10 @@ func (b builder) Wait(ctx context.Context, f func(update buildUpdate)) {
 }
 
 func (b builder) handlePrerequisites(ctx context.Context, data *buildData) error {
+	if err := b.precheck(ctx, data); err != nil {
+		return err
+	}
+
 	resp, err := b.client.GetChunk(ctx, &chunkv1alpha1.GetChunkRequest{
 		Id: data.chunkID,
 	})
@@ -205,19 +282,25 @@ func (b builder) handlePrerequisites(ctx context.Context, data *buildData) error
 	hashes := make([]*chunkv1alpha1.FileHashes, 0, len(data.local.files))
 	for _, fh := range data.local.files {
 		hashes = append(hashes, &chunkv1alpha1.FileHashes{
-			Path: data.local.serverRelPath(fh.Path),
-			Hash: fh.Hash,
+			Path:      data.local.serverRelPath(fh.Path),
+			Hash:      fh.Hash,
+			SizeBytes: fh.SizeBytes,
 		})
 	}
 
 	_, err = b.client.CreateFlavorVersion(ctx, &chunkv1alpha1.CreateFlavorVersionRequest{
-		FlavorId:         remoteFlavor.Id,
-		Version:          data.local.version,
-		Hash:             data.local.hash,
-		FileHashes:       hashes,
-		MinecraftVersion: data.local.minecraftVersion,
-		MinPlayers:       data.local.minPlayers,
-		MaxPlayers:       data.local.maxPlayers,
+		FlavorId:                  remoteFlavor.Id,
+		Version:                   data.local.version,
+		Hash:                      data.local.hash,
+		FileHashes:                hashes,
+		MinecraftVersion:          data.local.minecraftVersion,
+		MinPlayers:                data.local.minPlayers,
+		MaxPlayers:                data.local.maxPlayers,
+		StartupGracePeriodSeconds: data.local.startupGracePeriod,
+		RestartPolicy:             data.local.restartPolicy,
+		RestartMaxRetries:         data.local.restartMaxRetries,
+		JavaVersion:               data.local.javaVersion,
+		StartupCommand:            data.local.startupCommand,
 	})
 	if err != nil {
 		return fmt.Errorf("error while creating flavor version: %w", err)
@@ -227,6 +310,35 @@ func (b builder) handlePrerequisites(ctx context.Context, data *buildData) error
 	return nil
 }
 
+// precheck asks the control plane whether the flavor is within the build limits,
+// so we do not create a flavor version and upload files that will be rejected anyway.
+func (b builder) precheck(ctx context.Context, data *buildData) error {
+	var totalSize, largestSize uint64
+	for _, f := range data.local.files {
+		info, err := os.Stat(f.Path)
+		if err != nil {
+			return fmt.Errorf("error while reading file %s: %w", f.Path, err)
+		}
+		totalSize += uint64(info.Size())
+		largestSize = max(largestSize, uint64(info.Size()))
+	}
+
+	resp, err := b.client.PrecheckFlavorVersion(ctx, &chunkv1alpha1.PrecheckFlavorVersionRequest{
+		FileCount:            uint64(len(data.local.files)),
+		TotalSizeBytes:       totalSize,
+		LargestFileSizeBytes: largestSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error while checking build limits: %w", err)
+	}
+
+	if len(resp.Violations) > 0 {
+		return fmt.Errorf("flavor exceeds build limits: %s", strings.Join(resp.Violations, "; "))
+	}
+
+	return nil
+}
+
 func (b builder) handleUpload(ctx context.Context, data *buildData) error {
 	resp, err := b.client.GetChunk(ctx, &chunkv1alpha1.GetChunkRequest{
 		Id: data.chunkID,
@@ -268,14 +380,24 @@ func (b builder) handleUpload(ctx context.Context, data *buildData) error {
 		added = data.local.files
 	}
 
-	// TODO: debug log
-	//for _, l := range added {
-	//	fmt.Println("added", l)
-	//}
-	//
-	//for _, l := range changed {
-	//	fmt.Println("changed", l)
-	//}
+	for _, f := range added {
+		b.logger.DebugContext(ctx, "file added", "flavor", data.local.name, "path", f.Path)
+	}
+
+	for _, f := range changed {
+		b.logger.DebugContext(ctx, "file changed", "flavor", data.local.name, "path", f.Path)
+	}
+
+	// small diffs on top of a previous version are uploaded file by file,
+	// so we do not have to create and upload a tarball for them.
+	if len(remoteFlavor.Versions) > 1 && fitsPatch(append(added, changed...)) {
+		if err := b.handlePatchUpload(ctx, data, remoteVersion.Id, append(added, changed...)); err != nil {
+			return err
+		}
+
+		data.phase = buildPhaseTriggerBuild
+		return nil
+	}
 
 	files := make([]*os.File, 0, len(data.local.files))
 	for _, localFile := range data.local.files {
@@ -333,6 +455,10 @@ func (b builder) handleUpload(ctx context.Context, data *buildData) error {
 
 	req.ContentLength = int64(len(tarData))
 
+	for k, v := range uploadURLResp.Headers {
+		req.Header.Set(k, v)
+	}
+
 	progReader.OnProgress(func(progress uint) {
 		b.updates <- buildUpdate{
 			data:           *data,
@@ -353,7 +479,7 @@ func (b builder) handleUpload(ctx context.Context, data *buildData) error {
 		if err != nil {
 			return fmt.Errorf("error while uploading: unexpected status code: %d", uploadResp.StatusCode)
 		}
-		fmt.Println(string(body))
+		b.logger.DebugContext(ctx, "upload failed", "status", uploadResp.StatusCode, "body", string(body))
 		return fmt.Errorf("error while uploading: unexpected status code: %d", uploadResp.StatusCode)
 	}
 
@@ -361,6 +487,95 @@ func (b builder) handleUpload(ctx context.Context, data *buildData) error {
 	return nil
 }
 
+// fitsPatch reports whether the files are small enough to be uploaded using a patch.
+func fitsPatch(files []file.Hash) bool {
+	if len(files) == 0 || len(files) > patchMaxFiles {
+		return false
+	}
+
+	var size int64
+	for _, f := range files {
+		info, err := os.Stat(f.Path)
+		if err != nil {
+			return false
+		}
+		size += info.Size()
+	}
+
+	return size <= patchMaxSizeBytes
+}
+
+func (b builder) handlePatchUpload(ctx context.Context, data *buildData, versionID string, files []file.Hash) error {
+	var (
+		patchFiles = make([]*chunkv1alpha1.PatchFile, 0, len(files))
+		contents   = make(map[string][]byte, len(files))
+		total      int
+	)
+
+	for _, f := range files {
+		content, err := os.ReadFile(f.Path)
+		if err != nil {
+			return fmt.Errorf("error while reading file %s: %w", f.Path, err)
+		}
+
+		path := data.local.serverRelPath(f.Path)
+		digest := sha256.Sum256(content)
+
+		contents[path] = content
+		total += len(content)
+		patchFiles = append(patchFiles, &chunkv1alpha1.PatchFile{
+			Path:        path,
+			Hash:        f.Hash,
+			ContentHash: base64.StdEncoding.EncodeToString(digest[:]),
+			SizeBytes:   uint64(len(content)),
+		})
+	}
+
+	patchResp, err := b.client.PatchFlavorVersion(ctx, &chunkv1alpha1.PatchFlavorVersionRequest{
+		FlavorVersionId: versionID,
+		Files:           patchFiles,
+	})
+	if err != nil {
+		return fmt.Errorf("error while patching flavor version: %w", err)
+	}
+
+	var uploaded int
+	for _, up := range patchResp.Uploads {
+		content := contents[up.Path]
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, up.Url, bytes.NewReader(content))
+		if err != nil {
+			return fmt.Errorf("error while creating upload request: %w", err)
+		}
+
+		req.ContentLength = int64(len(content))
+
+		for k, v := range patchResp.Headers {
+			req.Header.Set(k, v)
+		}
+
+		uploadResp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("error while uploading %s: %w", up.Path, err)
+		}
+
+		uploadResp.Body.Close()
+
+		if uploadResp.StatusCode != http.StatusOK {
+			return fmt.Errorf("error while uploading %s: unexpected status code: %d", up.Path, uploadResp.StatusCode)
+		}
+
+		uploaded += len(content)
+		progress := uint(float64(uploaded) / float64(max(total, 1)) * 100)
+		b.updates <- buildUpdate{
+			data:           *data,
+			uploadProgress: &progress,
+		}
+	}
+
+	return nil
+}
+
 func (b builder) handleTriggerBuild(ctx context.Context, data *buildData) error {
 	resp, err := b.client.GetChunk(ctx, &chunkv1alpha1.GetChunkRequest{
 		Id: data.chunkID,
@@ -387,7 +602,7 @@ func (b builder) handleTriggerBuild(ctx context.Context, data *buildData) error
 
 	if _, err := b.client.BuildFlavorVersion(ctx, &chunkv1alpha1.BuildFlavorVersionRequest{
 		FlavorVersionId: remoteVersion.Id,
-	}); err != nil {
+	}); err != nil && !client.IsAlreadyBuilding(err) {
 		return fmt.Errorf("error occured when trying to initate server image build: %w", err)
 	}
 
@@ -21,6 +21,7 @@ package publish
 import (
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"slices"
@@ -43,7 +44,7 @@ var (
 )
 
 type conflict interface {
-	Print()
+	Print(w io.Writer)
 	Flavor() localFlavor
 }
 
@@ -56,10 +57,10 @@ func (c versionMismatchConflict) Flavor() localFlavor {
 	return c.flavor
 }
 
-func (c versionMismatchConflict) Print() {
-	fmt.Printf("%s - Hash of local files differs from what is found in the control plane.\n", indent2)
-	fmt.Printf("%s   This is caused by chaning the local files.\n", indent2)
-	fmt.Printf("%s   Local: %s, Control plane: %s. \n", indent2, c.flavor.hash, c.remoteHash)
+func (c versionMismatchConflict) Print(w io.Writer) {
+	fmt.Fprintf(w, "%s - Hash of local files differs from what is found in the control plane.\n", indent2)
+	fmt.Fprintf(w, "%s   This is caused by chaning the local files.\n", indent2)
+	fmt.Fprintf(w, "%s   Local: %s, Control plane: %s. \n", indent2, c.flavor.hash, c.remoteHash)
 }
 
 type versionExistConflict struct {
@@ -70,8 +71,8 @@ func (c versionExistConflict) Flavor() localFlavor {
 	return c.flavor
 }
 
-func (c versionExistConflict) Print() {
-	fmt.Printf("%s - A Flavor with version %s already exists.\n", indent2, c.flavor.version)
+func (c versionExistConflict) Print(w io.Writer) {
+	fmt.Fprintf(w, "%s - A Flavor with version %s already exists.\n", indent2, c.flavor.version)
 }
 
 type errorConflict struct {
@@ -83,8 +84,8 @@ func (c errorConflict) Flavor() localFlavor {
 	return c.flavor
 }
 
-func (c errorConflict) Print() {
-	fmt.Printf("%s - An error occured: %v\n", indent2, c.err)
+func (c errorConflict) Print(w io.Writer) {
+	fmt.Fprintf(w, "%s - An error occured: %v\n", indent2, c.err)
 }
 
 type versionNotSupporterConflict struct {
@@ -96,9 +97,9 @@ func (c versionNotSupporterConflict) Flavor() localFlavor {
 	return c.flavor
 }
 
-func (c versionNotSupporterConflict) Print() {
-	fmt.Printf("%s - Provided version %s is not supported.\n", indent2, c.flavor.minecraftVersion)
-	fmt.Printf("%s   Supported versions are: %s\n", indent2, strings.Join(c.supportedVersions, ","))
+func (c versionNotSupporterConflict) Print(w io.Writer) {
+	fmt.Fprintf(w, "%s - Provided version %s is not supported.\n", indent2, c.flavor.minecraftVersion)
+	fmt.Fprintf(w, "%s   Supported versions are: %s\n", indent2, strings.Join(c.supportedVersions, ","))
 }
 
 type actionable struct {
@@ -117,7 +118,13 @@ type plan struct {
 	minOrMaxPlayersChanged bool
 }
 
-func newPlan(logger *slog.Logger, cfg config.Config, supportedVersions []string, chunk *chunkv1alpha1.Chunk) plan {
+func newPlan(
+	logger *slog.Logger,
+	view progressView,
+	cfg config.Config,
+	supportedVersions []string,
+	chunk *chunkv1alpha1.Chunk,
+) plan {
 	p := plan{}
 
 	for _, remote := range chunk.Flavors {
@@ -137,12 +144,17 @@ func newPlan(logger *slog.Logger, cfg config.Config, supportedVersions []string,
 
 	for _, f := range cfg.Chunk.Flavors {
 		local := localFlavor{
-			name:             f.Name,
-			version:          f.Version,
-			path:             f.Path,
-			minecraftVersion: f.MinecraftVersion,
-			minPlayers:       uint32(f.MinPlayers),
-			maxPlayers:       uint32(f.MaxPlayers),
+			name:               f.Name,
+			version:            f.Version,
+			path:               f.Path,
+			minecraftVersion:   f.MinecraftVersion,
+			minPlayers:         uint32(f.MinPlayers),
+			maxPlayers:         uint32(f.MaxPlayers),
+			startupGracePeriod: uint32(f.StartupGracePeriodSeconds),
+			restartPolicy:      restartPolicy(f.RestartPolicy),
+			restartMaxRetries:  uint32(f.RestartMaxRetries),
+			javaVersion:        uint32(f.JavaVersion),
+			startupCommand:     f.StartupCommand,
 		}
 
 		if !slices.Contains(supportedVersions, local.minecraftVersion) {
@@ -161,7 +173,22 @@ func newPlan(logger *slog.Logger, cfg config.Config, supportedVersions []string,
 			continue
 		}
 
-		hash, fileHashes, err := localFileHashes(logger, f.Path)
+		hash, fileHashes, err := file.HashDirWithProgress(logger, f.Path, func(hashed int) {
+			view.update(flavorProgress{
+				name:   f.Name,
+				phase:  flavorPhaseHashing,
+				hashed: hashed,
+			})
+		})
+
+		view.update(flavorProgress{
+			name:   f.Name,
+			phase:  flavorPhaseHashing,
+			hashed: len(fileHashes),
+			done:   err == nil,
+			err:    err,
+		})
+
 		if err != nil {
 			p.conflicts = append(p.conflicts, errorConflict{
 				flavor: local,
@@ -206,13 +233,14 @@ func newPlan(logger *slog.Logger, cfg config.Config, supportedVersions []string,
 				}
 
 				p.changedFlavors = append(p.changedFlavors, changedFlavor{
-					onDisk:         local,
-					prevVersion:    prevVersion.Version,
-					prevMinPlayers: prevVersion.MinPlayers,
-					prevMaxPlayers: prevVersion.MaxPlayers,
-					addedFiles:     added,
-					modifiedFiles:  changed,
-					removedFiles:   removed,
+					onDisk:                 local,
+					prevVersion:            prevVersion.Version,
+					prevMinPlayers:         prevVersion.MinPlayers,
+					prevMaxPlayers:         prevVersion.MaxPlayers,
+					prevStartupGracePeriod: prevVersion.StartupGracePeriodSeconds,
+					addedFiles:             added,
+					modifiedFiles:          changed,
+					removedFiles:           removed,
 				})
 				continue
 			}
@@ -323,7 +351,20 @@ func newPlan(logger *slog.Logger, cfg config.Config, supportedVersions []string,
 	return p
 }
 
-func (p plan) print() {
+// restartPolicy converts the restart policy found in the config.
+// validation already made sure the value is one of the known ones.
+func restartPolicy(s string) chunkv1alpha1.RestartPolicy {
+	switch s {
+	case config.RestartPolicyAlways:
+		return chunkv1alpha1.RestartPolicy_ALWAYS
+	case config.RestartPolicyOnFailure:
+		return chunkv1alpha1.RestartPolicy_ON_FAILURE
+	default:
+		return chunkv1alpha1.RestartPolicy_NEVER
+	}
+}
+
+func (p plan) print(w io.Writer) {
 	// New:
 	//  + MyFlavor
 	//    + Version: v12.04
@@ -347,31 +388,49 @@ func (p plan) print() {
 	//     x Version: v3
 
 	if len(p.addedFlavors) > 0 {
-		fmt.Println("New flavors:")
+		fmt.Fprintln(w, "New flavors:")
 		for _, fl := range p.addedFlavors {
-			sec := cli.Section()
+			sec := cli.Section().WithWriter(w)
 			sec.AddRow(cli.ColorGreen+indent1+fl.name+":", "")
 			sec.AddRow(indent2+addPrefix+"Version:", fl.version)
 			sec.AddRow(indent2+addPrefix+"Path:", fl.path)
 			sec.AddRow(indent2+addPrefix+"Min Players:", fl.minPlayers)
 			sec.AddRow(indent2+addPrefix+"Max Players:", fl.maxPlayers)
+			if fl.startupGracePeriod > 0 {
+				sec.AddRow(indent2+addPrefix+"Startup Grace Period:", fmt.Sprintf("%ds", fl.startupGracePeriod))
+			}
+			if fl.restartPolicy != chunkv1alpha1.RestartPolicy_NEVER {
+				sec.AddRow(indent2+addPrefix+"Restart Policy:", fl.restartPolicy.String())
+			}
+			if fl.javaVersion > 0 {
+				sec.AddRow(indent2+addPrefix+"Java Version:", fl.javaVersion)
+			}
+			if fl.startupCommand != "" {
+				sec.AddRow(indent2+addPrefix+"Startup Command:", fl.startupCommand)
+			}
 			sec.AddRow(indent2+addPrefix+"Files:", "")
 			sec.Print()
 			for _, fi := range fl.files {
-				fmt.Println(indent3, addPrefix, fi.Path)
+				fmt.Fprintln(w, indent3, addPrefix, fi.Path)
 			}
 		}
 	}
 
 	if len(p.changedFlavors) > 0 {
-		fmt.Println(cli.ColorReset + "\nModified flavors:")
+		fmt.Fprintln(w, cli.ColorReset+"\nModified flavors:")
 		for _, fl := range p.changedFlavors {
-			sec := cli.Section()
+			sec := cli.Section().WithWriter(w)
 			sec.AddRow(cli.ColorYellow+indent1+fl.onDisk.name+":", "")
 			sec.AddRow(indent2+modPrefix+"Version:", fmt.Sprintf("%s -> %s", fl.prevVersion, fl.onDisk.version))
 			sec.AddRow(indent2+modPrefix+"Path:", fl.onDisk.path)
 			sec.AddRow(indent2+modPrefix+"Min Players:", fmt.Sprintf("%d -> %d", fl.prevMinPlayers, fl.onDisk.minPlayers))
 			sec.AddRow(indent2+modPrefix+"Max Players:", fmt.Sprintf("%d -> %d", fl.prevMaxPlayers, fl.onDisk.maxPlayers))
+			if fl.prevStartupGracePeriod != fl.onDisk.startupGracePeriod {
+				sec.AddRow(
+					indent2+modPrefix+"Startup Grace Period:",
+					fmt.Sprintf("%ds -> %ds", fl.prevStartupGracePeriod, fl.onDisk.startupGracePeriod),
+				)
+			}
 
 			if len(fl.addedFiles)+len(fl.modifiedFiles)+len(fl.removedFiles) > 0 {
 				sec.AddRow(indent2+modPrefix+"Files:", "")
@@ -380,34 +439,34 @@ func (p plan) print() {
 			sec.Print()
 
 			for _, fh := range fl.addedFiles {
-				fmt.Println(indent3, addPrefix, fh.Path)
+				fmt.Fprintln(w, indent3, addPrefix, fh.Path)
 			}
 			for _, fh := range fl.modifiedFiles {
-				fmt.Println(indent3, modPrefix, fh.Path)
+				fmt.Fprintln(w, indent3, modPrefix, fh.Path)
 			}
 			for _, fh := range fl.removedFiles {
-				fmt.Println(indent3, rmPrefix, fh.Path)
+				fmt.Fprintln(w, indent3, rmPrefix, fh.Path)
 			}
 		}
 	}
 
 	if len(p.deletedFlavors) > 0 {
-		fmt.Println(cli.ColorRed + "\nWARNING: THE FOLLOWING FLAVORS WILL BE DELETED:")
+		fmt.Fprintln(w, cli.ColorRed+"\nWARNING: THE FOLLOWING FLAVORS WILL BE DELETED:")
 		for _, fl := range p.deletedFlavors {
-			sec := cli.Section()
+			sec := cli.Section().WithWriter(w)
 			sec.AddRow(cli.ColorRed + indent1 + "=> " + fl.name)
 			sec.Print()
 		}
 
 		if p.chunkWillBeRemoved {
-			fmt.Println(cli.ColorRed + "\nWARNING: THE CHUNK WILL ALSO BE DELETED (due to all flavors being deleted)")
+			fmt.Fprintln(w, cli.ColorRed+"\nWARNING: THE CHUNK WILL ALSO BE DELETED (due to all flavors being deleted)")
 		}
 	}
 
 	if len(p.actionables) > 0 {
-		fmt.Println(cli.ColorReset + "\nActions to be performed for the following flavors: ")
+		fmt.Fprintln(w, cli.ColorReset+"\nActions to be performed for the following flavors: ")
 		for _, a := range p.actionables {
-			sec := cli.Section()
+			sec := cli.Section().WithWriter(w)
 			if a.phase == buildPhaseUpload {
 				sec.AddRow(cli.ColorCyan+indent1+a.flavor.name+" ", "=> Retry uploading files")
 			}
@@ -419,26 +478,26 @@ func (p plan) print() {
 	}
 
 	if len(p.conflicts) > 0 {
-		fmt.Println(cli.ColorReset + "\nConflicts: ")
+		fmt.Fprintln(w, cli.ColorReset+"\nConflicts: ")
 		for _, c := range p.conflicts {
-			fmt.Printf("%s%s%s:\n", indent1, conflictPrefix, c.Flavor().name)
-			c.Print()
+			fmt.Fprintf(w, "%s%s%s:\n", indent1, conflictPrefix, c.Flavor().name)
+			c.Print(w)
 		}
 		versions := make([]string, 0, len(p.conflicts))
 		for _, c := range p.conflicts {
 			versions = append(versions, c.Flavor().name)
 		}
-		fmt.Printf(
+		fmt.Fprintf(w,
 			"\nWARNING: %s contain conflicts and will NOT be published when proceeding.\n",
 			strings.Join(versions, ", "),
 		)
 	}
 
 	if p.updateThumbnail {
-		fmt.Printf("%s%sThumbnail => Will be updated\n", indent1, cli.ColorYellow)
+		fmt.Fprintf(w, "%s%sThumbnail => Will be updated\n", indent1, cli.ColorYellow)
 	}
 
-	fmt.Println(cli.ColorReset)
+	fmt.Fprintln(w, cli.ColorReset)
 }
 
 //func test() {
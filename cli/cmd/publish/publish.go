@@ -21,12 +21,10 @@ package publish
 import (
 	"context"
 	"fmt"
-	"io/fs"
-	"log/slog"
+	"io"
 	"maps"
 	"os"
 	"path/filepath"
-	"regexp"
 	"slices"
 	"strings"
 	"sync/atomic"
@@ -36,16 +34,18 @@ import (
 	"github.com/spacechunks/explorer/cli/config"
 	"github.com/spacechunks/explorer/internal/file"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 type changedFlavor struct {
-	onDisk         localFlavor
-	prevVersion    string
-	prevMinPlayers uint32
-	prevMaxPlayers uint32
-	addedFiles     []file.Hash
-	modifiedFiles  []file.Hash
-	removedFiles   []file.Hash
+	onDisk                 localFlavor
+	prevVersion            string
+	prevMinPlayers         uint32
+	prevMaxPlayers         uint32
+	prevStartupGracePeriod uint32
+	addedFiles             []file.Hash
+	modifiedFiles          []file.Hash
+	removedFiles           []file.Hash
 }
 
 type localFlavor struct {
@@ -57,6 +57,25 @@ type localFlavor struct {
 	files            []file.Hash
 	minPlayers       uint32
 	maxPlayers       uint32
+	// startupGracePeriod in seconds
+	startupGracePeriod uint32
+	restartPolicy      chunkv1alpha1.RestartPolicy
+	restartMaxRetries  uint32
+	javaVersion        uint32
+	startupCommand     string
+}
+
+// publishResult is printed when using a machine-readable output format.
+type publishResult struct {
+	ChunkID string         `json:"chunk_id"`
+	Flavors []flavorResult `json:"flavors"`
+}
+
+type flavorResult struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	BuildStatus string `json:"build_status,omitempty"`
+	Error       string `json:"error,omitempty"`
 }
 
 type deletedFlavor struct {
@@ -154,9 +173,22 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 			return fmt.Errorf("read config: %w", err)
 		}
 
+		// everything that is not the result of the command is printed to stderr
+		// when using a machine-readable output format, so it can be parsed.
+		var (
+			machine = cli.MachineReadable(cmd)
+			out     = io.Writer(os.Stdout)
+		)
+
+		if machine {
+			out = os.Stderr
+		}
+
+		cliCtx.Announcements.Print(ctx, out)
+
 		if issues := config.Validate(cfg); issues != nil {
-			fmt.Printf("%sFailed to validate config file:\n", cli.ColorRed)
-			sec := cli.Section()
+			_, _ = fmt.Fprintf(out, "%sFailed to validate config file:\n", cli.ColorRed)
+			sec := cli.Section().WithWriter(out)
 			for path, errs := range issues {
 				sec.AddRow(
 					fmt.Sprintf("%s- %s:", cli.ColorRed, path),
@@ -168,15 +200,11 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 			return nil
 		}
 
-		// TODO: get chunk by name
 		chunk, err := findChunk(ctx, cliCtx.Client, cfg.Chunk.Name)
 		if err != nil {
 			return fmt.Errorf("error while finding chunk: %w", err)
 		}
 
-		// TODO: check owner of chunk, if owner is not the current user
-		//       fail with error message that chunk already exists.
-
 		if chunk == nil {
 			chunk = &chunkv1alpha1.Chunk{
 				Name:        cfg.Chunk.Name,
@@ -193,8 +221,14 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 			return fmt.Errorf("get minecraft versions: %w", err)
 		}
 
-		plan := newPlan(cliCtx.Logger, cfg, resp.Versions, chunk)
-		plan.print()
+		view, err := newProgressView(cmd, machine)
+		if err != nil {
+			return err
+		}
+
+		plan := newPlan(cliCtx.Logger, view, cfg, resp.Versions, chunk)
+		view.finish()
+		plan.print(out)
 
 		if len(plan.addedFlavors)+
 			len(plan.changedFlavors)+
@@ -202,8 +236,9 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 			len(plan.deletedFlavors) == 0 &&
 			!plan.updateThumbnail &&
 			!plan.minOrMaxPlayersChanged {
-			fmt.Println("Nothing to publish.")
-			return nil
+			return cli.Print(cmd, publishResult{ChunkID: chunk.Id, Flavors: []flavorResult{}}, func() {
+				fmt.Println("Nothing to publish.")
+			})
 		}
 
 		if !cli.Prompt("Are you sure you want to publish? (y/n):") {
@@ -211,7 +246,7 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 		}
 
 		if chunk.Id == "" {
-			fmt.Println("Chunk does not exist, creating new Chunk.")
+			_, _ = fmt.Fprintln(out, "Chunk does not exist, creating new Chunk.")
 			resp, err := cliCtx.Client.CreateChunk(ctx, &chunkv1alpha1.CreateChunkRequest{
 				Name:        cfg.Chunk.Name,
 				Description: cfg.Chunk.Description,
@@ -227,13 +262,13 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 		if plan.updateThumbnail {
 			data, err := os.ReadFile(cfg.Chunk.Thumbnail)
 			if err != nil {
-				fmt.Printf("Thumbnail: Error reading thumbnail: %v\n", err)
+				_, _ = fmt.Fprintf(out, "Thumbnail: Error reading thumbnail: %v\n", err)
 			} else {
 				if _, err := cliCtx.Client.UploadThumbnail(ctx, &chunkv1alpha1.UploadThumbnailRequest{
 					ChunkId: chunk.Id,
 					Image:   data,
 				}); err != nil {
-					fmt.Printf("Thumbnail: Error uploading thumbnail: %v\n", err)
+					_, _ = fmt.Fprintf(out, "Thumbnail: Error uploading thumbnail: %v\n", err)
 				}
 			}
 		}
@@ -242,12 +277,13 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 			if _, err := cliCtx.Client.DeleteFlavor(ctx, &chunkv1alpha1.DeleteFlavorRequest{
 				Id: df.id,
 			}); err != nil {
-				fmt.Printf("Error deleting flavor '%s': %v\n", df.name, err)
+				_, _ = fmt.Fprintf(out, "Error deleting flavor '%s': %v\n", df.name, err)
 			}
 		}
 
 		b := builder{
 			client:       cliCtx.Client,
+			logger:       cliCtx.Logger,
 			updates:      make(chan buildUpdate),
 			buildCounter: &atomic.Int32{},
 			changeSetDir: os.TempDir(),
@@ -279,20 +315,34 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 
 		updates := make(map[string]buildUpdate)
 
-		// this builds the following line in the terminal and redraws it once we receive an update
-		// <flavor1>: <status> | <flavor2>: <status> | <flavor3>: <status> etc...
 		b.Wait(ctx, func(u buildUpdate) {
-			fmt.Print("\033[2K") // clear current line
 			updates[u.data.local.name] = u
-			display(updates)
-			fmt.Print("\r")
+			view.update(u.progress())
 		})
+		view.finish()
 
-		// have to re-draw again, because we clear the line in Wait
-		display(updates)
-		fmt.Println()
+		res := publishResult{
+			ChunkID: chunk.Id,
+			Flavors: make([]flavorResult, 0, len(updates)),
+		}
 
-		return nil
+		for _, k := range slices.Sorted(maps.Keys(updates)) {
+			u := updates[k]
+			fr := flavorResult{
+				Name:    u.data.local.name,
+				Version: u.data.local.version,
+			}
+			if u.buildStatus != nil {
+				fr.BuildStatus = *u.buildStatus
+			}
+			if u.err != nil {
+				fr.Error = u.err.Error()
+			}
+			res.Flavors = append(res.Flavors, fr)
+		}
+
+		// the progress view already shows the result of each flavor
+		return cli.Print(cmd, res, func() {})
 	}
 
 	return &cobra.Command{
@@ -303,138 +353,56 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 	}
 }
 
-func display(updates map[string]buildUpdate) {
-	var (
-		keys = slices.Collect(maps.Keys(updates))
-		c    = 0
-	)
-
-	slices.Sort(keys)
-
-	for _, k := range keys {
-		upd := updates[k]
-		c++
-		fmt.Printf("%s: ", upd.data.local.name)
-		if upd.err != nil {
-			fmt.Printf("%s%s%s\n", cli.ColorRed, upd.err.Error(), cli.ColorReset)
-		}
-
-		if upd.uploadProgress != nil {
-			fmt.Printf("Uploading (%d%%)", *upd.uploadProgress)
-		}
+// newProgressView returns the view showing the progress of publishing. progress
+// is not shown when using a machine-readable output format. lines are redrawn in
+// place, unless stdout is not a terminal or --no-tty has been passed.
+func newProgressView(cmd *cobra.Command, machine bool) (progressView, error) {
+	noTTY, err := cmd.Flags().GetBool("no-tty")
+	if err != nil {
+		return nil, fmt.Errorf("no-tty flag: %w", err)
+	}
 
-		if upd.buildStatus != nil {
-			if *upd.buildStatus == "COMPLETED" {
-				fmt.Printf("%s%s%s", cli.ColorGreen, *upd.buildStatus, cli.ColorReset)
-			} else if strings.Contains(*upd.buildStatus, "FAILED") {
-				fmt.Printf("%s%s%s", cli.ColorRed, *upd.buildStatus, cli.ColorReset)
-			} else {
-				fmt.Printf("%s", *upd.buildStatus)
+	fd := int(os.Stdout.Fd())
+
+	switch {
+	case machine:
+		return nopView{}, nil
+	case noTTY || !term.IsTerminal(fd):
+		return newPlainView(os.Stdout), nil
+	default:
+		return newTTYView(os.Stdout, func() int {
+			w, _, err := term.GetSize(fd)
+			if err != nil || w <= 0 {
+				return 80
 			}
-		}
-
-		if c != len(updates) {
-			fmt.Print(" | ")
-		}
+			return w
+		}), nil
 	}
 }
 
+// findChunk returns the chunk owned by the current user that has the given name.
+// nil is returned if the name is still available. if the name belongs to another
+// user, an error is returned before anything is uploaded.
 func findChunk(ctx context.Context, c chunkv1alpha1.ChunkServiceClient, name string) (*chunkv1alpha1.Chunk, error) {
-	resp, err := c.ListChunks(ctx, &chunkv1alpha1.ListChunksRequest{})
+	resp, err := c.CheckChunkName(ctx, &chunkv1alpha1.CheckChunkNameRequest{
+		Name: name,
+	})
 	if err != nil {
 		return nil, err
 	}
-	for _, chunk := range resp.Chunks {
-		if chunk.Name != name {
-			continue
-		}
-		return chunk, nil
-	}
-	return nil, nil
-}
-
-func localFileHashes(logger *slog.Logger, flavorPath string) (string, []file.Hash, error) {
-	var (
-		fileHashes = make([]file.Hash, 0)
-		excluded   = []string{
-			filepath.Join("cache", ".*"),
-			filepath.Join("versions", ".*"),
-			filepath.Join("libraries", ".*"),
-			filepath.Join("logs", ".*"),
-			filepath.Join("plugins", ".paper-remapped", ".*"),
-			// matches paper jars in the format of paper-<mc-version>-<build>.jar
-			// or just plain paper.jar
-			"(?:^|[\\\\/])paper(?:-\\d+(?:\\.\\d+)*-\\d+)?\\.jar$",
-		}
-	)
-
-	if err := filepath.WalkDir(flavorPath, func(path string, d fs.DirEntry, err error) error {
-		if d.IsDir() {
-			return nil
-		}
-
-		if err != nil {
-			fmt.Printf("Could not walk into directory %s: %v", path, err)
-			return nil
-		}
 
-		for _, p := range excluded {
-			matched, err := regexp.Match(p, []byte(path))
-			if err != nil {
-				return fmt.Errorf("error while matching pattern %s: %w", p, err)
-			}
-			if matched {
-				logger.Debug("skipping excluded file", "path", path, "regex", p)
-				return nil
-			}
-		}
-
-		// the containers that are being built by the controlplane
-		// are linux only, so use the linux path separator, if we are
-		// using the cli on windows or any other platform that does not
-		// use "/".
-		//tmp := strings.ReplaceAll(path, string(os.PathSeparator), "/")
-
-		// exclude the user specific portion of the path so we are left with
-		// the path relative to the server root. for example if a plugin in the
-		// flavor is located at /home/some_user/my_chunk/flavor1/plugins/myplugin.jar
-		// we remove everything so we are left with only plugins/myplugin.jar
-		rel := strings.ReplaceAll(filepath.ToSlash(path), filepath.ToSlash(filepath.Clean(flavorPath))+"/", "")
-
-		f, err := os.Open(path)
-		if err != nil {
-			return fmt.Errorf("error while opening file: %w", err)
-		}
-
-		defer f.Close()
-
-		hash, err := file.ComputeHashStr(f)
+	switch resp.GetStatus() {
+	case chunkv1alpha1.ChunkNameStatus_AVAILABLE:
+		return nil, nil
+	case chunkv1alpha1.ChunkNameStatus_OWNED:
+		getResp, err := c.GetChunk(ctx, &chunkv1alpha1.GetChunkRequest{
+			Id: resp.GetChunkId(),
+		})
 		if err != nil {
-			return fmt.Errorf("error while computing hash: %w", err)
+			return nil, err
 		}
-
-		logger.Debug("using file", "path", path, "rel", rel, "hash", hash)
-
-		// use file hashes here, so we don't have to keep the whole files content in ram.
-		// we'll read the content later again, when uploading the files to the server.
-		// drawback here is that if files change in between, the server will reject the
-		// uploaded files, but the chances of this happening should be quite small.
-		fileHashes = append(fileHashes, file.Hash{
-			Path: path,
-			Hash: hash,
-		})
-
-		return nil
-	}); err != nil {
-		return "", nil, err
-	}
-
-	file.SortHashes(fileHashes)
-
-	tree, err := file.HashTree(fileHashes)
-	if err != nil {
-		return "", nil, err
+		return getResp.GetChunk(), nil
+	default:
+		return nil, fmt.Errorf("chunk name %q is already taken, choose a different name", name)
 	}
-
-	return file.HashTreeRootString(tree), fileHashes, nil
 }
@@ -0,0 +1,282 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package publish
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spacechunks/explorer/cli"
+)
+
+type flavorPhase int
+
+const (
+	flavorPhaseHashing flavorPhase = iota
+	flavorPhasePreparing
+	flavorPhaseUploading
+	flavorPhaseBuilding
+)
+
+func (p flavorPhase) String() string {
+	switch p {
+	case flavorPhaseHashing:
+		return "hashing"
+	case flavorPhasePreparing:
+		return "preparing"
+	case flavorPhaseUploading:
+		return "uploading"
+	case flavorPhaseBuilding:
+		return "building"
+	default:
+		return "unknown"
+	}
+}
+
+// flavorProgress is the state of a single flavor shown by a progressView.
+type flavorProgress struct {
+	name    string
+	phase   flavorPhase
+	hashed  int
+	percent uint
+	status  string
+	done    bool
+	err     error
+}
+
+func (p flavorProgress) failed() bool {
+	return p.err != nil || strings.Contains(p.status, "FAILED")
+}
+
+// progressView displays the progress of all flavors being published, one
+// row per flavor. views are not safe for concurrent use, updates have to be
+// passed from a single goroutine, like the one calling builder.Wait.
+type progressView interface {
+	// update replaces the row of the flavor.
+	update(p flavorProgress)
+	// finish displays the final state of all rows. rows passed to update
+	// afterward are displayed below the current ones.
+	finish()
+}
+
+type nopView struct{}
+
+func (nopView) update(flavorProgress) {}
+
+func (nopView) finish() {}
+
+// plainView prints a line every time the phase or status of a flavor changes.
+// it is used if the output is not a terminal, for example in ci pipelines,
+// where redrawing lines does not work.
+type plainView struct {
+	w    io.Writer
+	last map[string]string
+}
+
+func newPlainView(w io.Writer) *plainView {
+	return &plainView{
+		w:    w,
+		last: make(map[string]string),
+	}
+}
+
+func (v *plainView) update(p flavorProgress) {
+	line := plainLine(p)
+	if v.last[p.name] == line {
+		return
+	}
+
+	v.last[p.name] = line
+	_, _ = fmt.Fprintln(v.w, line)
+}
+
+func (v *plainView) finish() {
+	clear(v.last)
+}
+
+func plainLine(p flavorProgress) string {
+	if p.err != nil {
+		return fmt.Sprintf("%s: %s failed: %v", p.name, p.phase, p.err)
+	}
+
+	switch p.phase {
+	case flavorPhaseHashing:
+		if p.done {
+			return fmt.Sprintf("%s: hashed %d files", p.name, p.hashed)
+		}
+		return fmt.Sprintf("%s: hashing files", p.name)
+	case flavorPhaseUploading:
+		// only print every 25% so logs are not flooded.
+		return fmt.Sprintf("%s: uploading %d%%", p.name, p.percent/25*25)
+	case flavorPhaseBuilding:
+		if p.percent > 0 && !p.done {
+			return fmt.Sprintf("%s: building %s %d%%", p.name, p.status, p.percent/25*25)
+		}
+		return fmt.Sprintf("%s: building %s", p.name, p.status)
+	default:
+		return fmt.Sprintf("%s: %s", p.name, p.phase)
+	}
+}
+
+// ttyRedrawInterval limits how often rows are redrawn, if only the progress
+// of a flavor changed. this prevents flickering when hashing many small files.
+const ttyRedrawInterval = 50 * time.Millisecond
+
+// ttyView redraws all rows in place every time a row changes. rows are
+// truncated to the width of the terminal, because wrapped lines would
+// break moving the cursor back to the first row.
+type ttyView struct {
+	w        io.Writer
+	width    func() int
+	rows     map[string]flavorProgress
+	drawn    int
+	lastDraw time.Time
+}
+
+func newTTYView(w io.Writer, width func() int) *ttyView {
+	return &ttyView{
+		w:     w,
+		width: width,
+		rows:  make(map[string]flavorProgress),
+	}
+}
+
+func (v *ttyView) update(p flavorProgress) {
+	prev, ok := v.rows[p.name]
+	v.rows[p.name] = p
+
+	changed := !ok || prev.phase != p.phase || prev.status != p.status || p.done || p.err != nil
+	if !changed && time.Since(v.lastDraw) < ttyRedrawInterval {
+		return
+	}
+
+	v.draw()
+}
+
+func (v *ttyView) finish() {
+	if len(v.rows) > 0 {
+		v.draw()
+	}
+
+	clear(v.rows)
+	v.drawn = 0
+}
+
+func (v *ttyView) draw() {
+	var (
+		buf       strings.Builder
+		names     = slices.Sorted(maps.Keys(v.rows))
+		nameWidth int
+		// do not use the last column, some terminals wrap the line once it is written.
+		width = v.width() - 1
+	)
+
+	for _, n := range names {
+		nameWidth = max(nameWidth, len([]rune(n)))
+	}
+
+	// move the cursor back to the first row
+	if v.drawn > 0 {
+		_, _ = fmt.Fprintf(&buf, "\033[%dA", v.drawn)
+	}
+
+	for _, n := range names {
+		row := v.rows[n]
+		line := truncate(ttyLine(row, nameWidth, width), width)
+
+		buf.WriteString("\r\033[2K") // clear current line
+		switch {
+		case row.failed():
+			buf.WriteString(cli.ColorRed + line + cli.ColorReset)
+		case row.done:
+			buf.WriteString(cli.ColorGreen + line + cli.ColorReset)
+		default:
+			buf.WriteString(line)
+		}
+		buf.WriteString("\n")
+	}
+
+	v.drawn = len(names)
+	v.lastDraw = time.Now()
+
+	// write everything at once, so the terminal never shows a partially drawn view.
+	_, _ = io.WriteString(v.w, buf.String())
+}
+
+const (
+	maxBarWidth = 30
+	minBarWidth = 10
+)
+
+func ttyLine(p flavorProgress, nameWidth int, width int) string {
+	prefix := fmt.Sprintf("%-*s  %-9s  ", nameWidth, p.name, p.phase)
+
+	var (
+		detail  string
+		showBar bool
+	)
+
+	switch {
+	case p.err != nil:
+		detail = p.err.Error()
+	case p.phase == flavorPhaseHashing:
+		detail = fmt.Sprintf("%d files", p.hashed)
+	case p.phase == flavorPhaseUploading:
+		showBar = true
+	case p.phase == flavorPhaseBuilding:
+		showBar = p.percent > 0 || p.done
+		detail = p.status
+	default:
+	}
+
+	if !showBar {
+		return prefix + detail
+	}
+
+	pct := fmt.Sprintf("%3d%%", p.percent)
+	if detail != "" {
+		pct += "  "
+	}
+
+	// the bar takes the space that is left, so narrow terminals
+	// still show the status of all flavors.
+	barWidth := min(maxBarWidth, width-len([]rune(prefix))-len(pct)-len(detail)-3)
+	if barWidth < minBarWidth {
+		return prefix + pct + detail
+	}
+
+	return prefix + progressBar(p.percent, barWidth) + " " + pct + detail
+}
+
+func progressBar(percent uint, width int) string {
+	filled := int(min(percent, 100)) * width / 100
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", width-filled) + "]"
+}
+
+func truncate(s string, width int) string {
+	r := []rune(s)
+	if width <= 0 || len(r) <= width {
+		return s
+	}
+	return string(r[:width])
+}
@@ -0,0 +1,153 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package publish
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlainViewPrintsChanges(t *testing.T) {
+	var (
+		buf  bytes.Buffer
+		view = newPlainView(&buf)
+	)
+
+	for _, p := range []flavorProgress{
+		{name: "a", phase: flavorPhaseHashing, hashed: 1},
+		{name: "a", phase: flavorPhaseHashing, hashed: 2},
+		{name: "a", phase: flavorPhaseHashing, hashed: 2, done: true},
+		{name: "a", phase: flavorPhaseUploading, percent: 10},
+		{name: "a", phase: flavorPhaseUploading, percent: 20},
+		{name: "a", phase: flavorPhaseUploading, percent: 30},
+		{name: "b", phase: flavorPhasePreparing, err: errors.New("boom")},
+		{name: "a", phase: flavorPhaseBuilding, status: "BUILDING_IMAGE"},
+		{name: "a", phase: flavorPhaseBuilding, status: "COMPLETED", percent: 100, done: true},
+	} {
+		view.update(p)
+	}
+
+	expected := []string{
+		"a: hashing files",
+		"a: hashed 2 files",
+		"a: uploading 0%",
+		"a: uploading 25%",
+		"b: preparing failed: boom",
+		"a: building BUILDING_IMAGE",
+		"a: building COMPLETED",
+	}
+
+	require.Equal(t, expected, strings.Split(strings.TrimSpace(buf.String()), "\n"))
+}
+
+func TestTTYViewRedrawsRows(t *testing.T) {
+	var (
+		buf  bytes.Buffer
+		view = newTTYView(&buf, func() int { return 80 })
+	)
+
+	view.update(flavorProgress{name: "a", phase: flavorPhasePreparing})
+	view.update(flavorProgress{name: "b", phase: flavorPhasePreparing})
+	buf.Reset()
+
+	view.update(flavorProgress{name: "a", phase: flavorPhaseUploading, percent: 50})
+
+	out := buf.String()
+	require.True(t, strings.HasPrefix(out, "\033[2A"), "cursor is not moved to the first row")
+	require.Equal(t, 2, strings.Count(out, "\n"))
+	require.Contains(t, out, "a  uploading  [###############---------------]  50%")
+
+	view.finish()
+	buf.Reset()
+
+	// rows after finishing are drawn below the previous ones
+	view.update(flavorProgress{name: "c", phase: flavorPhasePreparing})
+	require.Equal(t, "\r\033[2Kc  preparing  \n", buf.String())
+}
+
+func TestTTYLineFitsWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		progress flavorProgress
+		width    int
+		expected string
+	}{
+		{
+			name:     "bar is shown",
+			progress: flavorProgress{name: "a", phase: flavorPhaseBuilding, status: "BUILDING_IMAGE", percent: 20},
+			width:    80,
+			expected: "a  building   [######------------------------]  20%  BUILDING_IMAGE",
+		},
+		{
+			name:     "bar shrinks",
+			progress: flavorProgress{name: "a", phase: flavorPhaseUploading, percent: 50},
+			width:    32,
+			expected: "a  uploading  [#####------]  50%",
+		},
+		{
+			name:     "bar is hidden if terminal is too narrow",
+			progress: flavorProgress{name: "a", phase: flavorPhaseBuilding, status: "BUILDING_IMAGE", percent: 20},
+			width:    40,
+			expected: "a  building    20%  BUILDING_IMAGE",
+		},
+		{
+			name:     "errors are shown instead of bar",
+			progress: flavorProgress{name: "a", phase: flavorPhaseUploading, err: errors.New("boom")},
+			width:    80,
+			expected: "a  uploading  boom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := ttyLine(tt.progress, 1, tt.width)
+			require.Equal(t, tt.expected, line)
+			require.LessOrEqual(t, len(line), tt.width)
+		})
+	}
+
+	require.Equal(t, "abc", truncate("abcdef", 3))
+}
+
+func TestBuildUpdateProgress(t *testing.T) {
+	data := buildData{
+		local: localFlavor{name: "a"},
+		phase: buildPhaseUpload,
+	}
+
+	require.Equal(t, flavorProgress{
+		name:    "a",
+		phase:   flavorPhaseUploading,
+		percent: 42,
+	}, buildUpdate{data: data, uploadProgress: ptr.Pointer(uint(42))}.progress())
+
+	data.phase = buildPhaseBuildComplete
+	require.Equal(t, flavorProgress{
+		name:    "a",
+		phase:   flavorPhaseBuilding,
+		status:  "COMPLETED",
+		percent: 100,
+		done:    true,
+	}, buildUpdate{data: data, buildStatus: ptr.Pointer("COMPLETED")}.progress())
+}
@@ -21,12 +21,17 @@ package register
 import (
 	"context"
 	"fmt"
+	"os"
 
 	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
 	"github.com/spacechunks/explorer/cli"
 	"github.com/spf13/cobra"
 )
 
+type registration struct {
+	Nickname string `json:"nickname"`
+}
+
 func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 	run := func(cmd *cobra.Command, args []string) error {
 		tok, err := cliCtx.Auth.IDToken(ctx)
@@ -34,11 +39,13 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 			return fmt.Errorf("login failed: %w", err)
 		}
 
-		fmt.Println("Before you proceed, make sure to read and accept our privacy policy.")
-		fmt.Println("Visit: https://chunks.space/privacy")
+		// the prompt is printed to stderr, so it does not end up in the
+		// output of scripts using a machine-readable output format.
+		_, _ = fmt.Fprintln(os.Stderr, "Before you proceed, make sure to read and accept our privacy policy.")
+		_, _ = fmt.Fprintln(os.Stderr, "Visit: https://chunks.space/privacy")
 
 		if !cli.Prompt("I have read and accepted the privacy policy (y/n):") {
-			fmt.Println("You have to accept our privacy policy to register!")
+			_, _ = fmt.Fprintln(os.Stderr, "You have to accept our privacy policy to register!")
 			return nil
 		}
 
@@ -50,7 +57,9 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 			return fmt.Errorf("register failed: %w", err)
 		}
 
-		return nil
+		return cli.Print(cmd, registration{Nickname: args[0]}, func() {
+			fmt.Printf("Registered as %s.\n", args[0])
+		})
 	}
 
 	return &cobra.Command{
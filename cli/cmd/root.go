@@ -22,6 +22,9 @@ import (
 	"context"
 
 	"github.com/spacechunks/explorer/cli"
+	"github.com/spacechunks/explorer/cli/cmd/apply"
+	"github.com/spacechunks/explorer/cli/cmd/diff"
+	"github.com/spacechunks/explorer/cli/cmd/initialize"
 	"github.com/spacechunks/explorer/cli/cmd/register"
 	"github.com/spacechunks/explorer/cli/cmd/version"
 	"github.com/spf13/cobra"
@@ -32,11 +35,32 @@ func Root(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 		Use: "explorer",
 		Long: `A library of creations, where everyone can share their projects with the world.
 A place of discovery and play. All within a single unified system.`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			_, err := cli.Output(cmd)
+			return err
+		},
 	}
 
+	cli.RegisterOutputFlag(root)
+
+	applyCmd := requireAPIToken(ctx, cliCtx, apply.NewCommand)
+	applyCmd.Flags().StringP("file", "f", "", "Path to the chunk config file")
+	_ = applyCmd.MarkFlagFilename("file", "yaml", "yml")
+	applyCmd.Flags().Bool("dry-run", false, "Only show the changes without applying them")
+	applyCmd.Flags().Bool("prune", false, "Delete flavors that are not part of the config file")
+
+	diffCmd := requireAPIToken(ctx, cliCtx, diff.NewCommand)
+	diffCmd.Flags().StringP("file", "f", "", "Path to the chunk config file")
+	_ = diffCmd.MarkFlagFilename("file", "yaml", "yml")
+	diffCmd.Flags().Bool("content", false, "Show the changed lines of text files")
+
 	chunkCmd := newChunkCommand(ctx, cliCtx)
 	root.AddCommand(
+		newAdminCommand(ctx, cliCtx),
+		applyCmd,
 		chunkCmd,
+		diffCmd,
+		initialize.NewCommand(ctx, cliCtx),
 		register.NewCommand(ctx, cliCtx),
 		version.NewCommand(),
 	)
@@ -22,35 +22,75 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	instancev1beta1 "github.com/spacechunks/explorer/api/instance/v1beta1"
 	"github.com/spacechunks/explorer/cli"
+	"github.com/spacechunks/explorer/internal/workloadenv"
 	"github.com/spf13/cobra"
 )
 
 func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 	run := func(cmd *cobra.Command, args []string) error {
+		var (
+			seed, _    = cmd.Flags().GetString("world-seed")
+			mode, _    = cmd.Flags().GetString("game-mode")
+			custom, _  = cmd.Flags().GetString("params")
+			local, _   = cmd.Flags().GetBool("local")
+			group, _   = cmd.Flags().GetString("placement-group")
+			channel, _ = cmd.Flags().GetString("channel")
+		)
+
+		gameMode, ok := instancev1alpha1.GameMode_value[strings.ToUpper(mode)]
+		if mode != "" && !ok {
+			return fmt.Errorf("unknown game mode %q", mode)
+		}
+
+		params := &instancev1alpha1.InstanceParams{
+			WorldSeed: seed,
+			GameMode:  instancev1alpha1.GameMode(gameMode),
+			Custom:    custom,
+		}
+
+		if local {
+			return runLocal(ctx, cmd, cliCtx, args, params)
+		}
+
 		if len(args) < 2 {
 			return errors.New("chunk id and flavor name required")
 		}
 
-		var (
-			flavorVersionID = args[0]
-		)
-
 		// TODO: find flavor
 
-		resp, err := cliCtx.InstanceClient.RunFlavorVersion(ctx, &instancev1alpha1.RunFlavorVersionRequest{
-			FlavorVersionId: flavorVersionID,
-		})
+		req := &instancev1beta1.RunFlavorVersionRequest{
+			FlavorVersionId: args[0],
+			// game modes share the same numbers in both api versions.
+			Params: &instancev1beta1.InstanceParams{
+				WorldSeed: seed,
+				GameMode:  instancev1beta1.GameMode(gameMode),
+				Custom:    custom,
+			},
+			PlacementGroup: group,
+		}
+
+		// the version published to the channel is run, so
+		// the first argument is the id of the flavor instead.
+		if channel != "" {
+			req.FlavorVersionId = ""
+			req.FlavorId = args[0]
+			req.Channel = channel
+		}
+
+		resp, err := cliCtx.InstanceBetaClient.RunFlavorVersion(ctx, req)
 		if err != nil {
 			return fmt.Errorf("failed to run chunk: %w", err)
 		}
 
 		t := time.NewTicker(1 * time.Second)
 		for range t.C {
-			resp, err := cliCtx.InstanceClient.GetInstance(ctx, &instancev1alpha1.GetInstanceRequest{
+			resp, err := cliCtx.InstanceBetaClient.GetInstance(ctx, &instancev1beta1.GetInstanceRequest{
 				Id: resp.Instance.Id,
 			})
 			if err != nil {
@@ -58,16 +98,56 @@ func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
 				break
 			}
 
-			fmt.Printf("%s:%d (%s)", resp.Instance.Ip, resp.Instance.Port, resp.Instance.State)
+			// scripts are only interested in the instance once it is
+			// running or failed, so print it once and stop watching.
+			if cli.MachineReadable(cmd) {
+				switch resp.Instance.State {
+				case instancev1beta1.InstanceState_INSTANCE_STATE_PENDING,
+					instancev1beta1.InstanceState_INSTANCE_STATE_CREATING:
+					continue
+				}
+				return cli.Print(cmd, resp.Instance, func() {})
+			}
+
+			fmt.Printf(
+				"%s:%d (%s)",
+				resp.Instance.GetEndpoint().GetIp(),
+				resp.Instance.GetEndpoint().GetPort(),
+				strings.TrimPrefix(resp.Instance.GetState().String(), "INSTANCE_STATE_"),
+			)
 			fmt.Print("\r")
 		}
 		return nil
 	}
 
-	return &cobra.Command{
-		Use:          "run",
-		Short:        "Run a Chunk flavor. (THIS IS A DEVELOPMENT COMMAND AND WILL PROBABLY REMOVED LATER)",
-		RunE:         run,
-		SilenceUsage: true,
+	cmd := &cobra.Command{
+		Use:               "run",
+		Short:             "Run a Chunk flavor. (THIS IS A DEVELOPMENT COMMAND AND WILL PROBABLY REMOVED LATER)",
+		RunE:              run,
+		ValidArgsFunction: cli.CompleteFlavorVersionIDs(ctx, cliCtx),
+		SilenceUsage:      true,
 	}
+
+	cmd.Flags().String("world-seed", "", "Seed used to generate the world of the instance")
+	cmd.Flags().String("game-mode", "", "Game mode of the instance: survival, creative, adventure or spectator")
+	cmd.Flags().String("params", "", "JSON document passed to the server of the instance")
+	cmd.Flags().String("placement-group", "", "Instances of the same placement group are preferably run on the same node")
+	cmd.Flags().String("channel", "", "Run the version published to this channel of the flavor passed as first argument")
+
+	cmd.Flags().Bool("local", false, "Build the flavor and run it locally using docker or podman")
+	cmd.Flags().StringP("file", "f", ".chunk.yaml", "Path to the chunk config used by --local")
+	cmd.Flags().String("runtime", "", "Container runtime used by --local: docker or podman. Detected if not set")
+	cmd.Flags().Int("port", workloadenv.ServerPort, "Host port the server is reachable on when using --local")
+	cmd.Flags().String("image", "", "Run an existing image instead of building the flavor when using --local")
+
+	_ = cmd.RegisterFlagCompletionFunc("game-mode", cobra.FixedCompletions(
+		[]cobra.Completion{"survival", "creative", "adventure", "spectator"},
+		cobra.ShellCompDirectiveNoFileComp,
+	))
+	_ = cmd.RegisterFlagCompletionFunc("runtime", cobra.FixedCompletions(
+		[]cobra.Completion{"docker", "podman"},
+		cobra.ShellCompDirectiveNoFileComp,
+	))
+
+	return cmd
 }
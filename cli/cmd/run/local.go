@@ -0,0 +1,348 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package run
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spacechunks/explorer/cli/config"
+	"github.com/spacechunks/explorer/internal/file"
+	"github.com/spacechunks/explorer/internal/serverconfig"
+	"github.com/spacechunks/explorer/internal/workloadenv"
+	"github.com/spf13/cobra"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_.-]+`)
+
+type localResult struct {
+	Image     string `json:"image"`
+	Container string `json:"container"`
+	Port      int    `json:"port"`
+}
+
+// runLocal builds the image of a flavor the same way the platform does and
+// runs it using docker or podman. this allows creators to test their chunk
+// before publishing it.
+func runLocal(
+	ctx context.Context,
+	cmd *cobra.Command,
+	cliCtx cli.Context,
+	args []string,
+	params *instancev1alpha1.InstanceParams,
+) error {
+	var (
+		cfgPath, _ = cmd.Flags().GetString("file")
+		rt, _      = cmd.Flags().GetString("runtime")
+		port, _    = cmd.Flags().GetInt("port")
+		img, _     = cmd.Flags().GetString("image")
+		labels     = map[string]string{
+			workloadenv.LabelSchemaVersion: workloadenv.LabelSchemaV1,
+			workloadenv.LabelWorkloadType:  workloadenv.WorkloadTypeInstance,
+			workloadenv.LabelWorkloadPort:  strconv.Itoa(port),
+		}
+	)
+
+	rt, err := containerRuntime(rt)
+	if err != nil {
+		return err
+	}
+
+	name := "explorer-local"
+	if img == "" {
+		cfg, err := config.ReadWithResolvedPaths(cfgPath)
+		if err != nil {
+			return fmt.Errorf("failed to read config: %w", err)
+		}
+
+		flavor, err := findFlavor(cfg, args)
+		if err != nil {
+			return err
+		}
+
+		id := sanitizeName(cfg.Chunk.Name + "-" + flavor.Name)
+		name = "explorer-local-" + id
+		img = "explorer-local/" + id + ":latest"
+
+		labels[workloadenv.LabelChunkName] = cfg.Chunk.Name
+
+		if err := buildImage(ctx, cmd, cliCtx, rt, img, flavor); err != nil {
+			return err
+		}
+	}
+
+	labels[workloadenv.LabelWorkloadName] = name
+
+	runArgs := []string{
+		"run",
+		"--rm",
+		"--name", name,
+		"-p", fmt.Sprintf("%d:%d", port, workloadenv.ServerPort),
+	}
+
+	for k, v := range labels {
+		runArgs = append(runArgs, "--label", k+"="+v)
+	}
+
+	if p, ok := workloadenv.NewParams(params); ok {
+		dir, err := writeParams(name, p)
+		if err != nil {
+			return fmt.Errorf("failed to write params: %w", err)
+		}
+
+		runArgs = append(runArgs, "-v", dir+":"+workloadenv.ParamsDir+":ro")
+		for _, env := range p.Env() {
+			runArgs = append(runArgs, "--env", env.Key+"="+env.Value)
+		}
+	}
+
+	// a container of a previous run started in detached mode
+	// could still exist, which would cause the name to conflict.
+	_ = exec.Command(rt, "rm", "-f", name).Run()
+
+	if cli.MachineReadable(cmd) {
+		runArgs = append(runArgs, "-d", img)
+		if out, err := exec.Command(rt, runArgs...).CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to start container: %w: %s", err, out)
+		}
+
+		return cli.Print(cmd, localResult{
+			Image:     img,
+			Container: name,
+			Port:      port,
+		}, func() {})
+	}
+
+	fmt.Printf("Server is reachable at localhost:%d (port %d inside the container)\n", port, workloadenv.ServerPort)
+	fmt.Println("Press Ctrl+C to stop the server.")
+	fmt.Println()
+
+	// the interrupt is also received by the container runtime, which stops
+	// the container. so keep running until it exited, otherwise the container
+	// would be left behind.
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	defer signal.Stop(sig)
+
+	runArgs = append(runArgs, img)
+	c := exec.Command(rt, runArgs...)
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+
+	if err := c.Run(); err != nil {
+		// the container runtime exits with a non-zero
+		// code, if the server has been stopped by the user.
+		if len(sig) > 0 {
+			return nil
+		}
+		return fmt.Errorf("failed to run container: %w", err)
+	}
+
+	return nil
+}
+
+// buildImage builds an image consisting of the base image of the minecraft version
+// and the files of the flavor, whose server configs have been sanitized.
+func buildImage(
+	ctx context.Context,
+	cmd *cobra.Command,
+	cliCtx cli.Context,
+	rt string,
+	img string,
+	flavor config.Flavor,
+) error {
+	resp, err := cliCtx.Client.GetMinecraftVersion(ctx, &chunkv1alpha1.GetMinecraftVersionRequest{
+		Version: flavor.MinecraftVersion,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get minecraft version: %w", err)
+	}
+
+	buildDir, err := os.MkdirTemp("", "explorer-local-")
+	if err != nil {
+		return fmt.Errorf("failed to create build dir: %w", err)
+	}
+	defer os.RemoveAll(buildDir)
+
+	serverDir := filepath.Join(buildDir, "files", filepath.FromSlash(workloadenv.ServerDir))
+	if err := copyFlavorFiles(cliCtx.Logger, flavor.Path, serverDir); err != nil {
+		return fmt.Errorf("failed to copy flavor files: %w", err)
+	}
+
+	root, err := os.OpenRoot(serverDir)
+	if err != nil {
+		return fmt.Errorf("failed to open server dir: %w", err)
+	}
+	defer root.Close()
+
+	if err := serverconfig.SanitizeConfigs(root); err != nil {
+		return fmt.Errorf("failed to sanitize configs: %w", err)
+	}
+
+	dockerfile := fmt.Sprintf("FROM %s\nCOPY files/ /\n", resp.Version.BaseImage)
+	if err := os.WriteFile(filepath.Join(buildDir, "Dockerfile"), []byte(dockerfile), 0o644); err != nil {
+		return fmt.Errorf("failed to write Dockerfile: %w", err)
+	}
+
+	// the build output is not part of the result, so do not
+	// mix it into the output scripts are reading.
+	var out io.Writer = os.Stdout
+	if cli.MachineReadable(cmd) {
+		out = os.Stderr
+	}
+
+	c := exec.Command(rt, "build", "-t", img, buildDir)
+	c.Stdout = out
+	c.Stderr = os.Stderr
+
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("failed to build image: %w", err)
+	}
+
+	return nil
+}
+
+// copyFlavorFiles copies the files of the flavor that would be uploaded when
+// publishing it. files generated by the server at runtime are not copied.
+func copyFlavorFiles(logger *slog.Logger, src string, dst string) error {
+	_, hashes, err := file.HashDir(logger, src)
+	if err != nil {
+		return fmt.Errorf("hash files: %w", err)
+	}
+
+	for _, h := range hashes {
+		rel, err := filepath.Rel(src, h.Path)
+		if err != nil {
+			return fmt.Errorf("rel: %w", err)
+		}
+
+		if err := copyFile(h.Path, filepath.Join(dst, rel)); err != nil {
+			return fmt.Errorf("copy %s: %w", rel, err)
+		}
+	}
+
+	return nil
+}
+
+func copyFile(src string, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+
+	return out.Close()
+}
+
+// writeParams writes the params file to the cache dir of the user. the dir is
+// not removed afterward, because containers started in detached mode still need it.
+func writeParams(name string, p workloadenv.Params) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("cache dir: %w", err)
+	}
+
+	dir := filepath.Join(cacheDir, "explorer", "local", name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("create params dir: %w", err)
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("marshal params: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, workloadenv.ParamsFileName), data, 0o644); err != nil {
+		return "", fmt.Errorf("write params file: %w", err)
+	}
+
+	return dir, nil
+}
+
+// findFlavor returns the flavor with the name passed as the first argument.
+// if no name is passed, the config must only contain a single flavor.
+func findFlavor(cfg config.Config, args []string) (config.Flavor, error) {
+	flavors := cfg.Chunk.Flavors
+	if len(args) == 0 {
+		if len(flavors) != 1 {
+			return config.Flavor{}, errors.New("config contains multiple flavors, specify the name of the flavor to run")
+		}
+		return flavors[0], nil
+	}
+
+	for _, f := range flavors {
+		if f.Name == args[0] {
+			return f, nil
+		}
+	}
+
+	return config.Flavor{}, fmt.Errorf("flavor %q not found in config", args[0])
+}
+
+// containerRuntime returns the container runtime to use. if none
+// has been specified, docker is preferred over podman.
+func containerRuntime(rt string) (string, error) {
+	if rt != "" {
+		if _, err := exec.LookPath(rt); err != nil {
+			return "", fmt.Errorf("container runtime %q not found: %w", rt, err)
+		}
+		return rt, nil
+	}
+
+	for _, candidate := range []string{"docker", "podman"} {
+		if _, err := exec.LookPath(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+
+	return "", errors.New("neither docker nor podman found, install one of them or specify --runtime")
+}
+
+// sanitizeName returns a name that is valid for both images and containers.
+func sanitizeName(name string) string {
+	return strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-.")
+}
@@ -0,0 +1,104 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package run
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spacechunks/explorer/cli/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindFlavor(t *testing.T) {
+	var (
+		single = config.Config{Chunk: config.Chunk{Flavors: []config.Flavor{{Name: "a"}}}}
+		multi  = config.Config{Chunk: config.Chunk{Flavors: []config.Flavor{{Name: "a"}, {Name: "b"}}}}
+	)
+
+	tests := []struct {
+		name     string
+		cfg      config.Config
+		args     []string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "single flavor without name",
+			cfg:      single,
+			expected: "a",
+		},
+		{
+			name: "multiple flavors without name",
+			cfg:  multi,
+			err:  true,
+		},
+		{
+			name:     "multiple flavors with name",
+			cfg:      multi,
+			args:     []string{"b"},
+			expected: "b",
+		},
+		{
+			name: "unknown name",
+			cfg:  multi,
+			args: []string{"c"},
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := findFlavor(tt.cfg, tt.args)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, f.Name)
+		})
+	}
+}
+
+func TestCopyFlavorFilesSkipsGeneratedFiles(t *testing.T) {
+	var (
+		src = t.TempDir()
+		dst = t.TempDir()
+	)
+
+	for _, p := range []string{"server.properties", "plugins/p.jar", "logs/latest.log", "paper-1.21.4-100.jar"} {
+		path := filepath.Join(src, p)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(p), 0o644))
+	}
+
+	require.NoError(t, copyFlavorFiles(slog.New(slog.DiscardHandler), src, dst))
+
+	require.FileExists(t, filepath.Join(dst, "server.properties"))
+	require.FileExists(t, filepath.Join(dst, "plugins", "p.jar"))
+	require.NoFileExists(t, filepath.Join(dst, "logs", "latest.log"))
+	require.NoFileExists(t, filepath.Join(dst, "paper-1.21.4-100.jar"))
+}
+
+func TestSanitizeName(t *testing.T) {
+	require.Equal(t, "my-chunk-flavor_1", sanitizeName("My Chunk-flavor_1"))
+	require.Equal(t, "chunk", sanitizeName("-chunk!"))
+}
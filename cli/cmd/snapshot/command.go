@@ -0,0 +1,63 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spf13/cobra"
+)
+
+func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("instance id is missing")
+		}
+
+		resp, err := cliCtx.InstanceClient.CreateInstanceSnapshot(ctx, &instancev1alpha1.CreateInstanceSnapshotRequest{
+			InstanceId: args[0],
+		})
+		if err != nil {
+			return fmt.Errorf("error while creating instance snapshot: %w", err)
+		}
+
+		snap := resp.GetSnapshot()
+
+		return cli.Print(cmd, snap, func() {
+			fmt.Printf("Snapshot created (%d bytes).\n", snap.GetSizeBytes())
+			fmt.Printf(
+				"Download it until %s from:\n%s\n",
+				snap.GetDownloadUrlExpiresAt().AsTime().Local().Format(time.RFC1123Z),
+				snap.GetDownloadUrl(),
+			)
+		})
+	}
+
+	return &cobra.Command{
+		Use:               "snapshot",
+		Short:             "Takes a snapshot of the server directory of a running instance, including logs and worlds.",
+		RunE:              run,
+		ValidArgsFunction: cli.CompleteInstanceIDs(ctx, cliCtx),
+		SilenceUsage:      true,
+	}
+}
@@ -0,0 +1,61 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package stop
+
+import (
+	"context"
+	"fmt"
+
+	instancev1beta1 "github.com/spacechunks/explorer/api/instance/v1beta1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spf13/cobra"
+)
+
+func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("instance id is missing")
+		}
+
+		if _, err := cliCtx.InstanceBetaClient.StopInstance(ctx, &instancev1beta1.StopInstanceRequest{
+			Id: args[0],
+		}); err != nil {
+			return fmt.Errorf("error while stopping instance: %w", err)
+		}
+
+		resp, err := cliCtx.InstanceBetaClient.GetInstance(ctx, &instancev1beta1.GetInstanceRequest{
+			Id: args[0],
+		})
+		if err != nil {
+			return fmt.Errorf("error while getting instance: %w", err)
+		}
+
+		return cli.Print(cmd, resp.Instance, func() {
+			fmt.Println("Instance is stopping.")
+		})
+	}
+
+	return &cobra.Command{
+		Use:               "stop",
+		Short:             "Stops a running instance",
+		RunE:              run,
+		ValidArgsFunction: cli.CompleteInstanceIDs(ctx, cliCtx),
+		SilenceUsage:      true,
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rodaine/table"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spf13/cobra"
+)
+
+func NewCommand(ctx context.Context, cliCtx cli.Context) *cobra.Command {
+	run := func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("instance id is missing")
+		}
+
+		resp, err := cliCtx.InstanceClient.GetInstanceTimeline(ctx, &instancev1alpha1.GetInstanceTimelineRequest{
+			InstanceId: args[0],
+		})
+		if err != nil {
+			return fmt.Errorf("error while getting instance timeline: %w", err)
+		}
+
+		return cli.Print(cmd, resp, func() {
+			if len(resp.Events) == 0 {
+				fmt.Println("no events have been recorded for this instance")
+				return
+			}
+
+			t := table.New("TIME", "SINCE START", "STEP", "EVENT", "MESSAGE")
+			for _, r := range rows(resp.Events) {
+				t.AddRow(r.time.Format(time.RFC1123Z), "+"+r.sinceStart.String(), r.step.String(), r.event, r.message)
+			}
+			t.Print()
+		})
+	}
+
+	return &cobra.Command{
+		Use:               "timeline",
+		Short:             "Shows what happened to an instance over time, for example to find out why it took long to start.",
+		RunE:              run,
+		ValidArgsFunction: cli.CompleteInstanceIDs(ctx, cliCtx),
+		SilenceUsage:      true,
+	}
+}
+
+type row struct {
+	time time.Time
+	// sinceStart is the time passed since the first event.
+	sinceStart time.Duration
+	// step is the time passed since the previous event.
+	step    time.Duration
+	event   string
+	message string
+}
+
+// rows computes the durations between the events, which have to
+// be sorted oldest first. events are timestamped by the control
+// plane and the node, whose clocks might be off slightly, so
+// negative durations are shown as zero.
+func rows(events []*instancev1alpha1.InstanceEvent) []row {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var (
+		ret   = make([]row, 0, len(events))
+		start = events[0].GetCreatedAt().AsTime()
+		prev  = start
+	)
+
+	for _, ev := range events {
+		at := ev.GetCreatedAt().AsTime()
+		ret = append(ret, row{
+			time:       at,
+			sinceStart: max(at.Sub(start), 0).Round(time.Millisecond),
+			step:       max(at.Sub(prev), 0).Round(time.Millisecond),
+			event:      ev.GetType().String(),
+			message:    ev.GetMessage(),
+		})
+		prev = at
+	}
+
+	return ret
+}
@@ -22,42 +22,46 @@ import (
 	"fmt"
 	"runtime/debug"
 
+	"github.com/spacechunks/explorer/cli"
 	"github.com/spf13/cobra"
 )
 
+type versionInfo struct {
+	Commit string `json:"commit"`
+	Dirty  bool   `json:"dirty"`
+}
+
 func NewCommand() *cobra.Command {
-	run := func(cmd *cobra.Command, args []string) {
+	run := func(cmd *cobra.Command, args []string) error {
 		info, ok := debug.ReadBuildInfo()
 		if !ok {
-			return
+			return nil
 		}
 
-		var (
-			str    = ""
-			dirty  = false
-			commit = ""
-		)
+		var v versionInfo
 
 		for _, setting := range info.Settings {
 			switch setting.Key {
 			case "vcs.revision":
-				commit = setting.Value
+				v.Commit = setting.Value
 			case "vcs.modified":
-				dirty = true
+				v.Dirty = true
 			}
 		}
 
-		str += "Commit: " + commit
-		if dirty {
-			str += "+dirty"
-		}
-		fmt.Println(str)
+		return cli.Print(cmd, v, func() {
+			str := "Commit: " + v.Commit
+			if v.Dirty {
+				str += "+dirty"
+			}
+			fmt.Println(str)
+		})
 	}
 
 	return &cobra.Command{
 		Use:          "version",
 		Short:        "Displays version information",
-		Run:          run,
+		RunE:         run,
 		SilenceUsage: true,
 	}
 }
@@ -0,0 +1,124 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	instancev1beta1 "github.com/spacechunks/explorer/api/instance/v1beta1"
+	"github.com/spf13/cobra"
+)
+
+// the completion functions below complete the id passed as first argument.
+// ids are hard to remember, so the name of the resource is shown as
+// description, which is displayed by shells that support it.
+
+// CompleteChunkIDs completes the ids of all chunks.
+func CompleteChunkIDs(ctx context.Context, cliCtx Context) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		resp, err := cliCtx.Client.ListChunks(ctx, &chunkv1alpha1.ListChunksRequest{})
+		if err != nil {
+			cobra.CompDebugln(fmt.Sprintf("list chunks: %v", err), false)
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		var comps []cobra.Completion
+		for _, c := range resp.Chunks {
+			if !strings.HasPrefix(c.Id, toComplete) {
+				continue
+			}
+			comps = append(comps, cobra.CompletionWithDesc(c.Id, c.Name))
+		}
+
+		return comps, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// CompleteFlavorVersionIDs completes the ids of all flavor versions
+// that have been built successfully, so they can be run.
+func CompleteFlavorVersionIDs(ctx context.Context, cliCtx Context) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		resp, err := cliCtx.Client.ListChunks(ctx, &chunkv1alpha1.ListChunksRequest{})
+		if err != nil {
+			cobra.CompDebugln(fmt.Sprintf("list chunks: %v", err), false)
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		var comps []cobra.Completion
+		for _, c := range resp.Chunks {
+			for _, f := range c.Flavors {
+				for _, v := range f.Versions {
+					if v.BuildStatus != chunkv1alpha1.BuildStatus_COMPLETED || !strings.HasPrefix(v.Id, toComplete) {
+						continue
+					}
+					comps = append(comps, cobra.CompletionWithDesc(
+						v.Id,
+						fmt.Sprintf("%s/%s %s", c.Name, f.Name, v.Version),
+					))
+				}
+			}
+		}
+
+		return comps, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// CompleteInstanceIDs completes the ids of all instances.
+func CompleteInstanceIDs(ctx context.Context, cliCtx Context) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		resp, err := cliCtx.InstanceBetaClient.ListInstances(ctx, &instancev1beta1.ListInstancesRequest{})
+		if err != nil {
+			cobra.CompDebugln(fmt.Sprintf("list instances: %v", err), false)
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		var comps []cobra.Completion
+		for _, ins := range resp.Instances {
+			if !strings.HasPrefix(ins.Id, toComplete) {
+				continue
+			}
+			comps = append(comps, cobra.CompletionWithDesc(
+				ins.Id,
+				fmt.Sprintf(
+					"%s %s (%s)",
+					ins.GetChunkName(),
+					ins.GetFlavorVersion(),
+					strings.TrimPrefix(ins.GetState().String(), "INSTANCE_STATE_"),
+				),
+			))
+		}
+
+		return comps, cobra.ShellCompDirectiveNoFileComp
+	}
+}
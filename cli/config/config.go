@@ -8,6 +8,7 @@ import (
 
 	"github.com/Oudwins/zog"
 	"github.com/goccy/go-yaml"
+	"github.com/spacechunks/explorer/internal/chunkname"
 )
 
 type Config struct {
@@ -18,9 +19,14 @@ type Config struct {
 type Chunk struct {
 	Name        string   `json:"name"`
 	Description string   `json:"description"`
-	Thumbnail   string   `json:"thumbnail"`
-	Tags        []string `json:"tags"`
+	Thumbnail   string   `json:"thumbnail,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
 	Flavors     []Flavor `json:"flavors"`
+
+	// Schedules start and stop instances of a flavor version at fixed
+	// times. they are only used by apply, which replaces all schedules
+	// of the chunk with the ones listed here.
+	Schedules []Schedule `json:"schedules,omitempty"`
 }
 
 type Flavor struct {
@@ -30,26 +36,110 @@ type Flavor struct {
 	Path             string `json:"path"`
 	MinPlayers       int    `json:"minPlayers"`
 	MaxPlayers       int    `json:"maxPlayers"`
+
+	// StartupGracePeriodSeconds is the time the server has to start, before
+	// failing health checks cause it to be restarted. big modpacks may need
+	// a couple of minutes. if not set, the default of the node is used.
+	StartupGracePeriodSeconds int `json:"startupGracePeriodSeconds,omitempty"`
+
+	// RestartPolicy is one of always, on-failure or never. defaults to never.
+	// RestartMaxRetries limits the restarts of the on-failure policy.
+	RestartPolicy     string `json:"restartPolicy,omitempty"`
+	RestartMaxRetries int    `json:"restartMaxRetries,omitempty"`
+
+	// JavaVersion is the java runtime the server is started with. older
+	// modpacks may require 17. if not set, the default of the minecraft
+	// version is used.
+	JavaVersion int `json:"javaVersion,omitempty"`
+
+	// StartupCommand overrides the command the server is started with.
+	// it has to be one of the templates allowed by the platform.
+	StartupCommand string `json:"startupCommand,omitempty"`
+
+	// Channels maps channel names to the published version they point to.
+	// channels not listed here are left untouched by apply.
+	Channels map[string]string `json:"channels,omitempty"`
+
+	// Autoscaling configures the number of instances kept running. if not
+	// set, apply removes the autoscaling policy of the flavor.
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
 }
 
+type Autoscaling struct {
+	MinInstances int `json:"minInstances"`
+	MaxInstances int `json:"maxInstances"`
+
+	// TargetUtilization is the percentage of player slots instances are
+	// scaled to. if not set, the default of the platform is used.
+	TargetUtilization int `json:"targetUtilization,omitempty"`
+}
+
+type Schedule struct {
+	Flavor    string `json:"flavor"`
+	Version   string `json:"version"`
+	StartCron string `json:"startCron"`
+	StopCron  string `json:"stopCron,omitempty"`
+	Timezone  string `json:"timezone,omitempty"`
+}
+
+const (
+	RestartPolicyAlways    = "always"
+	RestartPolicyOnFailure = "on-failure"
+	RestartPolicyNever     = "never"
+)
+
 var schemaV1Alpha1 = zog.Struct(zog.Shape{
 	"version": zog.String().Match(regexp.MustCompile("v1alpha1")).Required(),
 	"chunk": zog.Struct(zog.Shape{
-		"name":        zog.String().Max(50).Required(),
+		"name": zog.String().Max(50).Required().TestFunc(
+			validChunkName,
+			zog.Message("must contain letters or digits and must not contain '/' or start or end with a separator"),
+		),
 		"description": zog.String().Max(100).Required(),
 		"thumbnail":   zog.String().Optional(),
 		"tags":        zog.Slice(zog.String()).Max(4).Optional(),
 		"flavors": zog.Slice(zog.Struct(zog.Shape{
-			"name":             zog.String().Max(25).Required(),
-			"version":          zog.String().Required(),
-			"minecraftVersion": zog.String().Required(),
-			"path":             zog.String().Required(),
-			"minPlayers":       zog.Int().GTE(1).Required(),
-			"maxPlayers":       zog.Int().GTE(1).Required(),
+			"name":                      zog.String().Max(25).Required(),
+			"version":                   zog.String().Required(),
+			"minecraftVersion":          zog.String().Required(),
+			"path":                      zog.String().Required(),
+			"minPlayers":                zog.Int().GTE(1).Required(),
+			"maxPlayers":                zog.Int().GTE(1).Required(),
+			"startupGracePeriodSeconds": zog.Int().GTE(0).LTE(3600).Optional(),
+			"restartPolicy": zog.String().OneOf([]string{
+				RestartPolicyAlways,
+				RestartPolicyOnFailure,
+				RestartPolicyNever,
+			}).Optional(),
+			"restartMaxRetries": zog.Int().GTE(0).Optional(),
+			"javaVersion":       zog.Int().OneOf([]int{17, 21}).Optional(),
+			"startupCommand":    zog.String().Max(512).Optional(),
+			"autoscaling": zog.Ptr(zog.Struct(zog.Shape{
+				"minInstances":      zog.Int().GTE(0),
+				"maxInstances":      zog.Int().GTE(1).Required(),
+				"targetUtilization": zog.Int().GTE(0).LTE(100).Optional(),
+			})),
 		})),
+		"schedules": zog.Slice(zog.Struct(zog.Shape{
+			"flavor":    zog.String().Required(),
+			"version":   zog.String().Required(),
+			"startCron": zog.String().Required(),
+			"stopCron":  zog.String().Optional(),
+			"timezone":  zog.String().Optional(),
+		})).Optional(),
 	}),
 })
 
+// validChunkName applies the same rules the control plane enforces,
+// so publish fails before anything is uploaded. empty names are left
+// to the Required check.
+func validChunkName(name *string, _ zog.Ctx) bool {
+	if *name == "" {
+		return true
+	}
+	return chunkname.Validate(*name) == nil
+}
+
 func Validate(cfg Config) map[string][]string {
 	var flattened map[string][]string
 	issues := schemaV1Alpha1.Validate(&cfg)
@@ -3,11 +3,14 @@ package cli
 import (
 	"log/slog"
 
+	adminv1alpha1 "github.com/spacechunks/explorer/api/admin/v1alpha1"
 	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	instancev1beta1 "github.com/spacechunks/explorer/api/instance/v1beta1"
 	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
 	"github.com/spacechunks/explorer/cli/auth"
 	"github.com/spacechunks/explorer/cli/state"
+	"github.com/spacechunks/explorer/pkg/client"
 )
 
 type Context struct {
@@ -16,6 +19,13 @@ type Context struct {
 	State          state.Data
 	Client         chunkv1alpha1.ChunkServiceClient
 	InstanceClient instancev1alpha1.InstanceServiceClient
-	UserClient     userv1alpha1.UserServiceClient
-	Auth           auth.Service
+	// InstanceBetaClient has to be used for calls that are part
+	// of v1beta1, the v1alpha1 variants of them are deprecated.
+	InstanceBetaClient instancev1beta1.InstanceServiceClient
+	UserClient         userv1alpha1.UserServiceClient
+	AdminClient        adminv1alpha1.AdminServiceClient
+	Auth               auth.Service
+	Announcements      *Announcements
+	// API shares the connection of the clients above.
+	API *client.Client
 }
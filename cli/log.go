@@ -0,0 +1,53 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cli
+
+import (
+	"io"
+	"log/slog"
+
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/spf13/pflag"
+)
+
+// LogFlags control which logs are printed. like TLSFlags, they
+// have to be known before the commands are executed.
+type LogFlags struct {
+	Verbose bool
+}
+
+// Register adds the flags to the given flag set.
+func (f *LogFlags) Register(fs *pflag.FlagSet) {
+	fs.BoolVar(&f.Verbose, "verbose", f.Verbose, "print debug logs, e.g. about files being uploaded and api calls")
+}
+
+// NewLogger creates a logger writing to w. debug logs are only written if
+// --verbose has been passed. logs should be written to stderr, so they do
+// not mix with the machine-readable output of commands.
+func NewLogger(w io.Writer, flags LogFlags) *slog.Logger {
+	level := slog.LevelInfo
+	if flags.Verbose {
+		level = slog.LevelDebug
+	}
+
+	return slog.New(logging.NewHandler(
+		slog.NewTextHandler(w, &slog.HandlerOptions{Level: slog.LevelDebug}),
+		logging.NewLevels(level),
+	))
+}
@@ -0,0 +1,115 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// OutputFormat is the format command results are printed in.
+// table is meant to be read by humans, json and yaml are
+// meant to be consumed by scripts.
+type OutputFormat string
+
+const (
+	OutputTable OutputFormat = "table"
+	OutputJSON  OutputFormat = "json"
+	OutputYAML  OutputFormat = "yaml"
+)
+
+var outputFormats = []string{
+	string(OutputTable),
+	string(OutputJSON),
+	string(OutputYAML),
+}
+
+// RegisterOutputFlag adds the global --output flag to the given command
+// and all of its sub commands.
+func RegisterOutputFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringP("output", "o", string(OutputTable), "Output format: table, json or yaml")
+	_ = cmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(outputFormats, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// Output returns the format selected using --output.
+func Output(cmd *cobra.Command) (OutputFormat, error) {
+	f, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return "", fmt.Errorf("output flag: %w", err)
+	}
+
+	if !slices.Contains(outputFormats, f) {
+		return "", fmt.Errorf("unknown output format %q, must be one of: table, json, yaml", f)
+	}
+
+	return OutputFormat(f), nil
+}
+
+// MachineReadable reports whether the output is meant to be consumed by scripts.
+// commands should not print anything other than their result in this case, so
+// the output can be parsed.
+func MachineReadable(cmd *cobra.Command) bool {
+	f, err := Output(cmd)
+	return err == nil && f != OutputTable
+}
+
+// Print prints v using the format selected with --output. table is called
+// if the table format has been selected. proto messages are encoded using
+// protojson, everything else using encoding/json.
+func Print(cmd *cobra.Command, v any, table func()) error {
+	f, err := Output(cmd)
+	if err != nil {
+		return err
+	}
+
+	if f == OutputTable {
+		table()
+		return nil
+	}
+
+	var data []byte
+	if msg, ok := v.(proto.Message); ok {
+		data, err = protojson.MarshalOptions{
+			Multiline:     true,
+			UseProtoNames: true,
+		}.Marshal(msg)
+	} else {
+		data, err = json.MarshalIndent(v, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("marshal output: %w", err)
+	}
+
+	if f == OutputYAML {
+		data, err = yaml.JSONToYAML(data)
+		if err != nil {
+			return fmt.Errorf("marshal output: %w", err)
+		}
+	}
+
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), strings.TrimRight(string(data), "\n"))
+	return nil
+}
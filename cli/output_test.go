@@ -0,0 +1,97 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cli_test
+
+import (
+	"bytes"
+	"testing"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	"github.com/spacechunks/explorer/cli"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrint(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		v        any
+		expected string
+		err      bool
+	}{
+		{
+			name:     "table",
+			format:   "table",
+			v:        &chunkv1alpha1.Chunk{Id: "abc"},
+			expected: "table\n",
+		},
+		{
+			name:     "proto as json uses proto names",
+			format:   "json",
+			v:        &chunkv1alpha1.Flavor{Id: "abc", Name: "vanilla"},
+			expected: `{"id": "abc", "name": "vanilla"}`,
+		},
+		{
+			name:   "struct as yaml",
+			format: "yaml",
+			v: struct {
+				Commit string `json:"commit"`
+			}{Commit: "abc"},
+			expected: "commit: abc\n",
+		},
+		{
+			name:   "unknown format",
+			format: "xml",
+			v:      &chunkv1alpha1.Chunk{},
+			err:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				out bytes.Buffer
+				cmd = &cobra.Command{}
+			)
+
+			cli.RegisterOutputFlag(cmd)
+			cmd.SetOut(&out)
+			require.NoError(t, cmd.ParseFlags([]string{"--output=" + tt.format}))
+
+			err := cli.Print(cmd, tt.v, func() {
+				out.WriteString("table\n")
+			})
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// protojson randomizes whitespace, so compare the documents instead
+			if tt.format == "json" {
+				require.JSONEq(t, tt.expected, out.String())
+				return
+			}
+
+			require.Equal(t, tt.expected, out.String())
+		})
+	}
+}
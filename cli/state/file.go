@@ -0,0 +1,221 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package state
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	keySize          = 32
+	saltSize         = 16
+	pbkdf2Iterations = 600_000
+
+	kdfKeyring    = "keyring"
+	kdfPassphrase = "pbkdf2-sha256"
+)
+
+// PassphraseFunc returns the passphrase used to encrypt the state file
+// if the os keyring is not used. it is called at most once.
+type PassphraseFunc func() ([]byte, error)
+
+// envelope is the on-disk format of the encrypted state file.
+type envelope struct {
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations,omitempty"`
+	Salt       []byte `json:"salt,omitempty"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// encryptedFile stores the state data encrypted using AES-256-GCM. the
+// key is either stored in the os keyring, or derived from a passphrase.
+type encryptedFile struct {
+	path       string
+	useKeyring bool
+	passphrase PassphraseFunc
+
+	// the passphrase is cached, so the user does not
+	// have to enter it every time the state is saved.
+	pass []byte
+}
+
+func (f *encryptedFile) load() ([]byte, error) {
+	raw, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var env envelope
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return nil, fmt.Errorf("parse state file: %w", err)
+	}
+
+	var key []byte
+	switch env.KDF {
+	case kdfKeyring:
+		key, err = keyringKey(false)
+		if err != nil {
+			return nil, fmt.Errorf("keyring key: %w", err)
+		}
+	case kdfPassphrase:
+		pass, err := f.getPassphrase()
+		if err != nil {
+			return nil, err
+		}
+
+		key, err = pbkdf2.Key(sha256.New, string(pass), env.Salt, env.Iterations, keySize)
+		if err != nil {
+			return nil, fmt.Errorf("derive key: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported key derivation %q", env.KDF)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := gcm.Open(nil, env.Nonce, env.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"decrypt state file (wrong passphrase?). remove %s to start over: %w", f.path, err,
+		)
+	}
+
+	return data, nil
+}
+
+func (f *encryptedFile) save(data []byte) error {
+	var (
+		env = envelope{KDF: kdfKeyring}
+		key []byte
+		err error
+	)
+
+	if f.useKeyring {
+		key, err = keyringKey(true)
+		if err != nil {
+			return fmt.Errorf("keyring key: %w", err)
+		}
+	} else {
+		pass, err := f.getPassphrase()
+		if err != nil {
+			return err
+		}
+
+		env.KDF = kdfPassphrase
+		env.Iterations = pbkdf2Iterations
+		env.Salt = make([]byte, saltSize)
+		if _, err := rand.Read(env.Salt); err != nil {
+			return fmt.Errorf("generate salt: %w", err)
+		}
+
+		key, err = pbkdf2.Key(sha256.New, string(pass), env.Salt, env.Iterations, keySize)
+		if err != nil {
+			return fmt.Errorf("derive key: %w", err)
+		}
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	env.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(env.Nonce); err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+
+	env.Ciphertext = gcm.Seal(nil, env.Nonce, data, nil)
+
+	raw, err := json.Marshal(env)
+	if err != nil {
+		return fmt.Errorf("marshal state file: %w", err)
+	}
+
+	// write to a temporary file first, so the state file
+	// is not left half-written if something goes wrong.
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), ".state-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(raw); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write temp file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temp file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), f.path); err != nil {
+		return fmt.Errorf("rename temp file: %w", err)
+	}
+
+	return nil
+}
+
+func (f *encryptedFile) getPassphrase() ([]byte, error) {
+	if f.pass != nil {
+		return f.pass, nil
+	}
+
+	if f.passphrase == nil {
+		return nil, errors.New("no passphrase available to encrypt the state file")
+	}
+
+	pass, err := f.passphrase()
+	if err != nil {
+		return nil, fmt.Errorf("passphrase: %w", err)
+	}
+
+	if len(pass) == 0 {
+		return nil, errors.New("passphrase must not be empty")
+	}
+
+	f.pass = pass
+	return pass, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+
+	return gcm, nil
+}
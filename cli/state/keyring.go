@@ -0,0 +1,71 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package state
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+const (
+	keyringService = "explorer"
+	keyringAccount = "state-encryption-key"
+)
+
+var (
+	errKeyNotFound        = errors.New("key not found in keyring")
+	ErrKeyringUnavailable = errors.New("os keyring is not available")
+)
+
+// keyringKey returns the key used to encrypt the state file from the os keyring.
+// if create is true and no key has been stored yet, a new random key is generated.
+//
+// only the key is stored in the keyring and not the state itself, because some
+// keyrings, like the windows credential manager, limit the size of secrets.
+func keyringKey(create bool) ([]byte, error) {
+	if !keyringAvailable() {
+		return nil, ErrKeyringUnavailable
+	}
+
+	encoded, err := keyringGet(keyringService, keyringAccount)
+	if err == nil {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("decode key: %w", err)
+		}
+		return key, nil
+	}
+
+	if !errors.Is(err, errKeyNotFound) || !create {
+		return nil, err
+	}
+
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+
+	if err := keyringSet(keyringService, keyringAccount, base64.StdEncoding.EncodeToString(key)); err != nil {
+		return nil, fmt.Errorf("store key: %w", err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,72 @@
+//go:build !darwin && !windows
+
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package state
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// keys are stored in the secret service (gnome keyring, kwallet, ...)
+// using secret-tool, which is part of libsecret.
+
+func keyringAvailable() bool {
+	if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return false
+	}
+	_, err := exec.LookPath("secret-tool")
+	return err == nil
+}
+
+func keyringGet(service string, account string) (string, error) {
+	out, err := exec.Command("secret-tool", "lookup", "service", service, "account", account).Output()
+	if err != nil {
+		// secret-tool exits with 1 and does not print
+		// anything if the secret does not exist.
+		if _, ok := errors.AsType[*exec.ExitError](err); ok && len(out) == 0 {
+			return "", errKeyNotFound
+		}
+		return "", fmt.Errorf("secret-tool lookup: %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func keyringSet(service string, account string, secret string) error {
+	cmd := exec.Command(
+		"secret-tool", "store",
+		"--label", "Explorer CLI state encryption key",
+		"service", service,
+		"account", account,
+	)
+	cmd.Stdin = strings.NewReader(secret)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("secret-tool store: %w: %s", err, stderr.String())
+	}
+	return nil
+}
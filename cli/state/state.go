@@ -20,6 +20,7 @@ package state
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -37,30 +38,149 @@ type Config struct {
 	ControlPlaneEndpoint string `json:"controlPlaneEndpoint"`
 	IDPIssuerEndpoint    string `json:"idpIssuerEndpoint"`
 	IDPClientID          string `json:"idpClientId"`
+
+	// Insecure disables transport security when talking to
+	// the control plane. Only meant for local development.
+	Insecure bool `json:"insecure,omitempty"`
+
+	// CAFile is the path to a PEM encoded bundle of certificates that are
+	// trusted in addition to the system roots when verifying the control plane.
+	CAFile string `json:"caFile,omitempty"`
+
+	// InsecureSkipVerify disables verification of the certificate presented by
+	// the control plane. This makes the connection vulnerable to interception.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+
+	// Endpoints contains transport settings for specific control plane
+	// endpoints, which take precedence over the global settings above.
+	Endpoints map[string]EndpointConfig `json:"endpoints,omitempty"`
+
+	// StateStore controls where the key used to encrypt the state
+	// file is kept. See the StateStore* constants for possible values.
+	StateStore string `json:"stateStore,omitempty"`
+}
+
+type EndpointConfig struct {
+	CAFile             string `json:"caFile,omitempty"`
+	Insecure           bool   `json:"insecure,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// Endpoint returns the transport settings used for the configured
+// control plane endpoint, taking per-endpoint settings into account.
+func (c Config) Endpoint() EndpointConfig {
+	ret := EndpointConfig{
+		CAFile:             c.CAFile,
+		Insecure:           c.Insecure,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	e, ok := c.Endpoints[c.ControlPlaneEndpoint]
+	if !ok {
+		return ret
+	}
+
+	if e.CAFile != "" {
+		ret.CAFile = e.CAFile
+	}
+
+	ret.Insecure = ret.Insecure || e.Insecure
+	ret.InsecureSkipVerify = ret.InsecureSkipVerify || e.InsecureSkipVerify
+	return ret
 }
 
+const (
+	// StateStoreAuto uses the os keyring if it is available
+	// and falls back to a passphrase otherwise.
+	StateStoreAuto = "auto"
+
+	// StateStoreKeyring keeps the key in the os keyring, which is the macOS
+	// Keychain, the Windows Credential Manager or the Secret Service on linux.
+	StateStoreKeyring = "keyring"
+
+	// StateStoreFile derives the key from a passphrase.
+	StateStoreFile = "file"
+)
+
 type Data struct {
 	IDToken              string `json:"idToken"`
 	ControlPlaneAPIToken string `json:"controlPlaneApiToken"`
+
+	file *encryptedFile
 }
 
-func New() (Data, error) {
+// New reads the encrypted state file. If the state has been stored in plaintext
+// by an older version of the cli, it is migrated to the encrypted state file.
+// passphrase is only called if the os keyring is not used.
+func New(cfg Config, passphrase PassphraseFunc) (Data, error) {
 	cfgHome, err := fshelper.ConfigHome()
 	if err != nil {
 		return Data{}, err
 	}
 
-	data, err := os.ReadFile(filepath.Join(cfgHome, "state.json"))
+	f := &encryptedFile{
+		path:       filepath.Join(cfgHome, "state.enc"),
+		passphrase: passphrase,
+	}
+
+	switch cfg.StateStore {
+	case "", StateStoreAuto:
+		f.useKeyring = keyringAvailable()
+	case StateStoreKeyring:
+		if !keyringAvailable() {
+			return Data{}, ErrKeyringUnavailable
+		}
+		f.useKeyring = true
+	case StateStoreFile:
+	default:
+		return Data{}, fmt.Errorf("unknown state store %q", cfg.StateStore)
+	}
+
+	data, err := f.load()
+	if errors.Is(err, os.ErrNotExist) {
+		return migratePlaintext(filepath.Join(cfgHome, "state.json"), f)
+	}
+
+	if err != nil {
+		return Data{}, err
+	}
+
+	state := Data{
+		file: f,
+	}
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return Data{}, err
+	}
+
+	return state, nil
+}
+
+// migratePlaintext encrypts the plaintext state file written by older
+// versions of the cli and removes it afterward.
+func migratePlaintext(path string, f *encryptedFile) (Data, error) {
+	state := Data{
+		file: f,
+	}
+
+	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return Data{}, nil
+			return state, nil
 		}
 		return Data{}, err
 	}
 
-	var state Data
 	if err := json.Unmarshal(data, &state); err != nil {
-		return Data{}, err
+		return Data{}, fmt.Errorf("parse plaintext state: %w", err)
+	}
+
+	if err := state.persist(); err != nil {
+		return Data{}, fmt.Errorf("encrypt plaintext state: %w", err)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return Data{}, fmt.Errorf("remove plaintext state: %w", err)
 	}
 
 	return state, nil
@@ -81,26 +201,17 @@ func (d *Data) Update(new Data) {
 	}
 }
 
-func (s *Data) persist() error {
-	cfgHome, err := fshelper.ConfigHome()
-	if err != nil {
-		return err
-	}
-
-	f, err := os.OpenFile(filepath.Join(cfgHome, "state.json"), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0777)
-	if err != nil {
-		return err
+func (d *Data) persist() error {
+	// state that has not been read from
+	// disk is only kept in memory.
+	if d.file == nil {
+		return nil
 	}
-	defer f.Close()
 
-	data, err := json.Marshal(s)
+	data, err := json.Marshal(d)
 	if err != nil {
 		return err
 	}
 
-	if _, err := f.Write(data); err != nil {
-		return err
-	}
-
-	return nil
+	return d.file.save(data)
 }
@@ -0,0 +1,95 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigratePlaintextState(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		cfg  = Config{StateStore: StateStoreFile}
+		pass = func() ([]byte, error) {
+			return []byte("secret"), nil
+		}
+	)
+
+	t.Setenv("XDG_CONFIG_HOME", dir)
+
+	err := os.WriteFile(
+		filepath.Join(dir, "state.json"),
+		[]byte(`{"idToken":"id","controlPlaneApiToken":"plaintext-api-token"}`),
+		0600,
+	)
+	require.NoError(t, err)
+
+	migrated, err := New(cfg, pass)
+	require.NoError(t, err)
+	require.Equal(t, "id", migrated.IDToken)
+	require.Equal(t, "plaintext-api-token", migrated.ControlPlaneAPIToken)
+
+	_, err = os.Stat(filepath.Join(dir, "state.json"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	raw, err := os.ReadFile(filepath.Join(dir, "state.enc"))
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "plaintext-api-token")
+
+	migrated.Update(Data{ControlPlaneAPIToken: "new"})
+
+	read, err := New(cfg, pass)
+	require.NoError(t, err)
+	require.Equal(t, "id", read.IDToken)
+	require.Equal(t, "new", read.ControlPlaneAPIToken)
+
+	_, err = New(cfg, func() ([]byte, error) {
+		return []byte("wrong"), nil
+	})
+	require.Error(t, err)
+}
+
+func TestConfigEndpoint(t *testing.T) {
+	cfg := Config{
+		ControlPlaneEndpoint: "localhost:9012",
+		CAFile:               "global.pem",
+		Endpoints: map[string]EndpointConfig{
+			"localhost:9012": {
+				Insecure: true,
+			},
+			"other:443": {
+				CAFile: "other.pem",
+			},
+		},
+	}
+
+	require.Equal(t, EndpointConfig{
+		CAFile:   "global.pem",
+		Insecure: true,
+	}, cfg.Endpoint())
+
+	cfg.ControlPlaneEndpoint = "other:443"
+	require.Equal(t, EndpointConfig{
+		CAFile: "other.pem",
+	}, cfg.Endpoint())
+}
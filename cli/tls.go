@@ -0,0 +1,106 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cli
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spacechunks/explorer/cli/state"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TLSFlags override the transport settings from the config file.
+type TLSFlags struct {
+	CAFile   string
+	Insecure bool
+}
+
+// Register adds the flags to the given flag set. They have to be known before
+// the commands are executed, so they are parsed on their own first and then
+// registered on the root command to show up in the help output.
+func (f *TLSFlags) Register(fs *pflag.FlagSet) {
+	fs.StringVar(&f.CAFile, "ca-file", f.CAFile, "path to a PEM encoded CA bundle used to verify the control plane")
+	fs.BoolVar(&f.Insecure, "insecure", f.Insecure, "skip verification of the control plane certificate. DANGEROUS")
+}
+
+// TransportCredentials returns the credentials used to connect to the control plane.
+// The certificate of the control plane is verified against the system roots and the
+// configured CA bundle, unless verification has been disabled explicitly.
+func TransportCredentials(cfg state.Config, flags TLSFlags) (credentials.TransportCredentials, error) {
+	e := cfg.Endpoint()
+
+	if flags.CAFile != "" {
+		e.CAFile = flags.CAFile
+	}
+
+	if flags.Insecure {
+		e.InsecureSkipVerify = true
+	}
+
+	if e.Insecure {
+		return insecure.NewCredentials(), nil
+	}
+
+	if e.InsecureSkipVerify {
+		_, _ = fmt.Fprintln(os.Stderr, ColorRed+
+			"WARNING: THE CERTIFICATE OF THE CONTROL PLANE IS NOT VERIFIED. "+
+			"ANYONE ON THE NETWORK CAN INTERCEPT YOUR CREDENTIALS."+ColorReset)
+		return credentials.NewTLS(&tls.Config{
+			InsecureSkipVerify: true, //nolint:gosec
+		}), nil
+	}
+
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if e.CAFile != "" {
+		pool, err := loadCAFile(e.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+func loadCAFile(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read ca file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New("ca file does not contain any PEM encoded certificates")
+	}
+
+	return pool, nil
+}
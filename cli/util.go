@@ -70,12 +70,15 @@ func Section() table.Table {
 	return t
 }
 
+// stdin is shared by all prompts, so input that has already
+// been buffered is not lost when prompting multiple times.
+var stdin = bufio.NewReader(os.Stdin)
+
 func Prompt(label string) bool {
 	var s string
-	r := bufio.NewReader(os.Stdin)
 	for {
-		_, _ = fmt.Fprint(os.Stdout, label+" ")
-		s, _ = r.ReadString('\n')
+		_, _ = fmt.Fprint(os.Stderr, label+" ")
+		s, _ = stdin.ReadString('\n')
 		s = strings.TrimSpace(s)
 		if strings.ToLower(s) == "yes" || strings.ToLower(s) == "y" {
 			return true
@@ -85,3 +88,17 @@ func Prompt(label string) bool {
 		}
 	}
 }
+
+// Ask prompts for a value. def is returned if the user does not enter anything.
+func Ask(label string, def string) string {
+	if def != "" {
+		label += " [" + def + "]"
+	}
+
+	_, _ = fmt.Fprint(os.Stderr, label+": ")
+	s, _ := stdin.ReadString('\n')
+	if s = strings.TrimSpace(s); s == "" {
+		return def
+	}
+	return s
+}
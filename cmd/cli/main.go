@@ -20,74 +20,134 @@ package main
 
 import (
 	"context"
-	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"log/slog"
 	"os"
 	"path/filepath"
 
+	adminv1alpha1 "github.com/spacechunks/explorer/api/admin/v1alpha1"
+	announcementv1alpha1 "github.com/spacechunks/explorer/api/announcement/v1alpha1"
 	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	instancev1beta1 "github.com/spacechunks/explorer/api/instance/v1beta1"
 	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
 	"github.com/spacechunks/explorer/cli"
 	"github.com/spacechunks/explorer/cli/auth"
 	clicmd "github.com/spacechunks/explorer/cli/cmd"
 	"github.com/spacechunks/explorer/cli/fshelper"
 	"github.com/spacechunks/explorer/cli/state"
+	"github.com/spacechunks/explorer/internal/deprecation"
+	"github.com/spacechunks/explorer/internal/grpcclient"
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/spacechunks/explorer/pkg/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/term"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 )
 
 func main() {
-	var (
-		ctx    = context.Background()
-		logger = newLogger()
-	)
+	ctx := context.Background()
 
 	cfg, err := createOrReadConfig()
 	if err != nil {
 		log.Fatalf("Config error: %v", err)
 	}
 
-	conn, err := grpc.NewClient(cfg.ControlPlaneEndpoint,
-		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
-			InsecureSkipVerify: true,
-		})),
-		grpc.WithUnaryInterceptor(traceparentInterceptor(logger)),
+	// the tls and log flags are needed to create the client before the
+	// commands are executed, so they are parsed here and ignored by the
+	// root command.
+	var (
+		tlsFlags cli.TLSFlags
+		logFlags = cli.LogFlags{
+			Verbose: os.Getenv("EXPLORER_DEBUG") == "true",
+		}
 	)
+
+	fs := pflag.NewFlagSet("explorer", pflag.ContinueOnError)
+	fs.ParseErrorsAllowlist.UnknownFlags = true
+	fs.Usage = func() {}
+	fs.SetOutput(io.Discard)
+	tlsFlags.Register(fs)
+	logFlags.Register(fs)
+	_ = fs.Parse(os.Args[1:])
+
+	logger := cli.NewLogger(os.Stderr, logFlags)
+
+	creds, err := cli.TransportCredentials(cfg, tlsFlags)
 	if err != nil {
-		die("Failed to create gRPC client", err)
+		die("Failed to configure transport security", err)
 	}
 
-	stateData, err := state.New()
+	conn, err := grpcclient.New(cfg.ControlPlaneEndpoint, grpcclient.DefaultConfig,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(
+			logging.UnaryClientInterceptor(),
+			traceparentInterceptor(logger),
+			deprecation.UnaryClientInterceptor(logger),
+		),
+	)
 	if err != nil {
-		die("Failed to read state data", err)
+		die("Failed to create gRPC client", err)
 	}
 
-	userClient := userv1alpha1.NewUserServiceClient(conn)
+	var (
+		userClient    = userv1alpha1.NewUserServiceClient(conn)
+		announcements = cli.NewAnnouncements(logger, announcementv1alpha1.NewAnnouncementServiceClient(conn))
+		stateData     state.Data
+		authSvc       auth.Service
+	)
+
+	// a control plane running in dev mode prints an api token on
+	// startup, which can be passed here to skip the oidc flow.
+	if tok := os.Getenv("EXPLORER_API_TOKEN"); tok != "" {
+		authSvc = auth.NewStatic(tok)
+	} else {
+		stateData, err = state.New(cfg, readPassphrase)
+		if err != nil && !completing() {
+			die("Failed to read state data", err)
+		}
+
+		oidcSvc := auth.NewOIDC(
+			logger,
+			&stateData,
+			cfg.IDPClientID,
+			cfg.IDPIssuerEndpoint,
+			userClient,
+		)
+
+		oidcSvc.OnLogin(func(ctx context.Context) {
+			announcements.Print(ctx, os.Stderr)
+		})
+
+		authSvc = oidcSvc
+	}
 
 	var (
 		cliCtx = cli.Context{
-			Logger:         logger,
-			Config:         cfg,
-			Client:         chunkv1alpha1.NewChunkServiceClient(conn),
-			InstanceClient: instancev1alpha1.NewInstanceServiceClient(conn),
-			UserClient:     userClient,
-			Auth: auth.NewOIDC(
-				logger,
-				&stateData,
-				cfg.IDPClientID,
-				cfg.IDPIssuerEndpoint,
-				userv1alpha1.NewUserServiceClient(conn),
-			),
-			State: stateData,
+			Logger:             logger,
+			Config:             cfg,
+			Client:             chunkv1alpha1.NewChunkServiceClient(conn),
+			InstanceClient:     instancev1alpha1.NewInstanceServiceClient(conn),
+			InstanceBetaClient: instancev1beta1.NewInstanceServiceClient(conn),
+			UserClient:         userClient,
+			AdminClient:        adminv1alpha1.NewAdminServiceClient(conn),
+			Auth:               authSvc,
+			Announcements:      announcements,
+			State:              stateData,
+			API:                client.NewFromConn(conn, client.WithLogger(logger)),
 		}
 	)
 
-	if err := clicmd.Root(ctx, cliCtx).Execute(); err != nil {
+	root := clicmd.Root(ctx, cliCtx)
+	tlsFlags.Register(root.PersistentFlags())
+	logFlags.Register(root.PersistentFlags())
+
+	if err := root.Execute(); err != nil {
 		os.Exit(1)
 	}
 }
@@ -119,14 +179,38 @@ func createOrReadConfig() (state.Config, error) {
 	return cfg, nil
 }
 
-func newLogger() *slog.Logger {
-	level := slog.LevelInfo
-	if os.Getenv("EXPLORER_DEBUG") == "true" {
-		level = slog.LevelDebug
+// readPassphrase returns the passphrase used to encrypt the state file if the
+// os keyring is not available. it is read from EXPLORER_STATE_PASSPHRASE or
+// prompted for if the cli is used interactively.
+func readPassphrase() ([]byte, error) {
+	if pass := os.Getenv("EXPLORER_STATE_PASSPHRASE"); pass != "" {
+		return []byte(pass), nil
+	}
+
+	if completing() {
+		return nil, errors.New("cannot prompt for passphrase while completing")
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return nil, errors.New("os keyring is not available, set EXPLORER_STATE_PASSPHRASE to encrypt the state file")
+	}
+
+	_, _ = fmt.Fprint(os.Stderr, "Passphrase to encrypt the explorer state: ")
+	pass, err := term.ReadPassword(int(os.Stdin.Fd()))
+	_, _ = fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, err
 	}
-	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-		Level: level,
-	}))
+
+	return pass, nil
+}
+
+// completing reports whether the shell requested completions. completions
+// that do not need the api, like sub commands and flags, should still work
+// if the state cannot be read without user interaction.
+func completing() bool {
+	return len(os.Args) > 1 &&
+		(os.Args[1] == cobra.ShellCompRequestCmd || os.Args[1] == cobra.ShellCompNoDescRequestCmd)
 }
 
 func traceparentInterceptor(logger *slog.Logger) grpc.UnaryClientInterceptor {
@@ -141,7 +225,7 @@ func traceparentInterceptor(logger *slog.Logger) grpc.UnaryClientInterceptor {
 		var header metadata.MD
 		opts = append(opts, grpc.Header(&header))
 		err := invoker(ctx, method, req, reply, cc, opts...)
-		logger.Debug("calling "+method, "traceparent", header.Get("traceparent"))
+		logger.DebugContext(ctx, "calling "+method, "traceparent", header.Get("traceparent"))
 		return err
 	}
 }
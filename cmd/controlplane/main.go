@@ -19,94 +19,313 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"flag"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/goccy/go-yaml"
 	"github.com/hashicorp/go-multierror"
 	"github.com/peterbourgon/ff/v3"
 	"github.com/spacechunks/explorer/controlplane"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/dev"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/partition"
 	"github.com/spacechunks/explorer/controlplane/postgres/migrations"
-	"github.com/spacechunks/explorer/internal/instr"
+	"github.com/spacechunks/explorer/controlplane/secret"
+	"github.com/spacechunks/explorer/internal/logging"
 )
 
 func main() {
 	var (
+		levels = logging.NewLevels(slog.LevelInfo)
 		logger = slog.New(
-			&instr.OTelSlogHandler{
-				Handler: slog.NewJSONHandler(os.Stdout, nil),
-			},
+			logging.NewHandler(
+				slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+				levels,
+			),
 		)
 		fs                       = flag.NewFlagSet("controlplane", flag.ContinueOnError)
 		listenAddr               = fs.String("listen-address", ":9012", "address and port the control plane server listens on")                                                                                   //nolint:lll
 		pgConnString             = fs.String("postgres-dsn", "", "connection string in the form of postgres://[user[:password]@][netloc][:port][/dbname][?param1=value1&...]")                                    //nolint:lll
+		pgConnStringFile         = fs.String("postgres-dsn-file", "", "path to a file containing the connection string. re-read when new connections are established")                                            //nolint:lll
+		pgMaxConns               = fs.Int("postgres-max-conns", 20, "maximum number of connections in the database pool")                                                                                         //nolint:lll
+		pgMinConns               = fs.Int("postgres-min-conns", 2, "minimum number of idle connections kept in the database pool")                                                                                //nolint:lll
+		pgMaxConnLifetime        = fs.Duration("postgres-max-conn-lifetime", time.Hour, "duration after which a database connection is closed and replaced")                                                      //nolint:lll
+		pgMaxConnIdleTime        = fs.Duration("postgres-max-conn-idle-time", 30*time.Minute, "duration after which an idle database connection is closed")                                                       //nolint:lll
+		pgStatementTimeout       = fs.Duration("postgres-statement-timeout", 10*time.Second, "maximum duration of a single statement before it is aborted by postgres. 0 disables the timeout")                   //nolint:lll
+		requestTimeout           = fs.Duration("request-timeout", 30*time.Second, "deadline set on api requests that do not carry one already. 0 disables the timeout")                                           //nolint:lll
+		maxRequestSize           = fs.Int("max-request-size", 4194304, "the maximum size in bytes of a single api request message, like thumbnail uploads")                                                       //nolint:lll
+		chunkCacheTTL            = fs.Duration("chunk-cache-ttl", 30*time.Second, "how long chunk metadata is cached in memory. 0 disables the cache")                                                            //nolint:lll
+		replicaID                = fs.String("replica-id", "", "identifies this replica in logs and metrics. defaults to the hostname")                                                                           //nolint:lll
+		leaderElectionInterval   = fs.Duration("leader-election-interval", 5*time.Second, "interval in which replicas try to become the leader for running background tasks")                                     //nolint:lll
 		ociRegistry              = fs.String("oci-registry", "", "registry to use to pull and push images")                                                                                                       //nolint:lll
 		ociRegistryUser          = fs.String("oci-registry-user", "", "oci registry username used for authentication against configured oci registry")                                                            //nolint:lll
 		ociRegistryPass          = fs.String("oci-registry-pass", "", "oci registry password used for authentication against configured oci registry")                                                            //nolint:lll
+		ociRegistryPassFile      = fs.String("oci-registry-pass-file", "", "path to a file containing the oci registry password")                                                                                 //nolint:lll
 		imageCacheDir            = fs.String("image-cache-dir", "/tmp/explorer-images", "directory used to cache base image")                                                                                     //nolint:lll
 		imagePlatform            = fs.String("image-platform", "linux/amd64", "the platform that will be specified when pulling the base image. must match with all configured platformd hosts e.g. linux/amd64") //nolint:lll
 		checkJobTimeout          = fs.Duration("checkpoint-job-timeout", 5*time.Minute, "when to abort the checkpointing job")                                                                                    //nolint:lll
 		checkStatusCheckInterval = fs.Duration("checkpoint-status-check-interval", 3*time.Second, "how often the status check endpoint for a checkpoint should be called")                                        //nolint:lll
+		checkBuilderTimeout      = fs.Duration("checkpoint-builder-timeout", time.Minute, "builder nodes that have not been seen for this long are not used for checkpoint builds")                               //nolint:lll
+		checkVerifyRestore       = fs.Bool("checkpoint-verify-restore", false, "restore checkpoints and wait for the server to respond to a ping before marking builds as completed")                             //nolint:lll
 		bucket                   = fs.String("bucket", "explorer-data", "bucket to use for storing change sets and backend for content-addressable storage")                                                      //nolint:lll
 		accessKey                = fs.String("access-key", "", "access key to use for accessing the bucket")                                                                                                      //nolint:lll
+		accessKeyFile            = fs.String("access-key-file", "", "path to a file containing the access key. re-read when it changes")                                                                          //nolint:lll
 		secretKey                = fs.String("secret-key", "", "secret key to use for accessing the bucket")                                                                                                      //nolint:lll
+		secretKeyFile            = fs.String("secret-key-file", "", "path to a file containing the secret key. re-read when it changes")                                                                          //nolint:lll
 		presignedURLExpiry       = fs.Duration("presigned-url-expiry", 5*time.Minute, "when to expire the presigned URL")                                                                                         //nolint:lll
 		usePathStyle             = fs.Bool("use-path-style", true, "whether to use path style to access the bucket")                                                                                              //nolint:lll
+		s3SSE                    = fs.String("s3-server-side-encryption", "", "server side encryption used for stored objects. one of AES256 (SSE-S3) or aws:kms (SSE-KMS). uses the bucket default if empty")    //nolint:lll
+		s3KMSKeyID               = fs.String("s3-kms-key-id", "", "kms key used for SSE-KMS. uses the aws managed key if empty")                                                                                  //nolint:lll
+		s3StorageClass           = fs.String("s3-storage-class", "", "storage class of stored objects, for example STANDARD_IA. uses the bucket default if empty")                                                //nolint:lll
+		changeSetTransitionDays  = fs.Int("changeset-transition-days", 0, "after how many days change sets are moved to the changeset-transition-storage-class. 0 disables the transition")                       //nolint:lll
+		changeSetTransitionClass = fs.String("changeset-transition-storage-class", "GLACIER_IR", "storage class change sets are moved to")                                                                        //nolint:lll
+		changeSetExpirationDays  = fs.Int("changeset-expiration-days", 0, "after how many days change sets are deleted. 0 keeps them forever")                                                                    //nolint:lll
+		storageBackend           = fs.String("storage-backend", "s3", "object storage backend to use. one of s3, gcs, azure or filesystem")                                                                       //nolint:lll
+		gcsCredentialsFile       = fs.String("gcs-credentials-file", "", "path to a service account key file used to access gcs. uses application default credentials if empty")                                  //nolint:lll
+		azureAccountName         = fs.String("azure-account-name", "", "azure storage account name. the bucket is used as the container name")                                                                    //nolint:lll
+		azureAccountKey          = fs.String("azure-account-key", "", "azure storage account key")                                                                                                                //nolint:lll
+		azureAccountKeyFile      = fs.String("azure-account-key-file", "", "path to a file containing the azure storage account key")                                                                             //nolint:lll
+		azureServiceURL          = fs.String("azure-service-url", "", "azure blob service url. defaults to https://<account-name>.blob.core.windows.net/")                                                        //nolint:lll
+		storageDir               = fs.String("storage-dir", "/var/lib/explorer/blobs", "directory to store objects in when using the filesystem backend")                                                         //nolint:lll
+		storageUploadListenAddr  = fs.String("storage-upload-listen-address", ":9013", "address the upload endpoint listens on when using the filesystem backend")                                                //nolint:lll
+		storageUploadPublicURL   = fs.String("storage-upload-public-url", "http://localhost:9013", "url under which clients can reach the upload endpoint when using the filesystem backend")                     //nolint:lll
+		storageUploadSecret      = fs.String("storage-upload-secret", "", "secret used to sign upload urls when using the filesystem backend. a random one is generated if empty")                                //nolint:lll
+		storageUploadSecretFile  = fs.String("storage-upload-secret-file", "", "path to a file containing the secret used to sign upload urls")                                                                   //nolint:lll
 		idpOAuthClientID         = fs.String("idp-oauth-client-id", "", "oauth client ID to use for authentication")
-		idpOAuthIssuerEndpoint   = fs.String("idp-oauth-issuer-endpoint", "", "issuer endpoint to use for authentication")                                                                          //nolint:lll
-		apiTokenIssuer           = fs.String("api-token-issuer", "", "issuer to use for api tokens issued by the control plane. this value will also be set as the tokens audience.")               //nolint:lll
-		apiTokenExpiry           = fs.Duration("api-token-expiry", 10*time.Minute, "expiry of api tokens issued by the control plane")                                                              //nolint:lll
-		apiTokenSigningKey       = fs.String("api-token-signing-key", "", "key used to sign api tokens issued by the control plane")                                                                //nolint:lll
-		thumbnailMaxSizeKB       = fs.Int("thumbnail-max-size-kb", 1000, "max size a thumbnail can be in kilobytes")                                                                                //nolint:lll
-		packCreateInterval       = fs.Duration("resource-pack-create-interval", 5*time.Minute, "in what interval the resource pack will be built and published")                                    //nolint:lll
-		packWorkingDir           = fs.String("resource-pack-working-dir", "", "the directory where temporary files will be placed when creating the resource pack")                                 //nolint:lll
-		packTemplateKey          = fs.String("resource-pack-template-key", "", "key to the s3 object that is being used as a resource pack basis")                                                  //nolint:lll
-		packItemTemplatePath     = fs.String("resource-pack-item-template-path", "", "path inside the resource pack to an item template. e.g. assets/mynamespace/items/_template.json")             //nolint:lll
-		packModelTemplatePath    = fs.String("resource-pack-model-template-path", "", "path inside the resource pack to a model tempalte. e.g. assets/mynamespace/models/item/_template.json")      //nolint:lll
-		packModelDir             = fs.String("resource-pack-model-dir", "", "path inside the resource pack to the directory where the models will live. e.g. assets/mynamespace/models/item")       //nolint:lll
-		packItemDir              = fs.String("resource-pack-item-dir", "", "path inside the resource pack to the directory where the items will live. e.g. assets/mynamespace/items")               //nolint:lll
-		packTextureDir           = fs.String("resource-pack-texture-dir", "", "path inside the resource pack to the directory where the textures will live. e.g. assets/mynamespace/textures/item") //nolint:lll
-		changeSetTarballMaxSize  = fs.Uint64("change-set-tarball-max-size", 1073741824, "the maximum allowed size in bytes of the change set tarball")                                              //nolint:lll
-		archiveInterval          = fs.Duration("archive-interval", 3*time.Minute, "in what interval the deleted chunks and flavors should be archived")                                             //nolint:lll
-		disableTracing           = fs.Bool("disable-tracing", false, "disable open telemetry tracing")                                                                                              //nolint:lll
+		idpOAuthIssuerEndpoint   = fs.String("idp-oauth-issuer-endpoint", "", "issuer endpoint to use for authentication")                                                                                                         //nolint:lll
+		apiTokenIssuer           = fs.String("api-token-issuer", "", "issuer to use for api tokens issued by the control plane. this value will also be set as the tokens audience.")                                              //nolint:lll
+		apiTokenExpiry           = fs.Duration("api-token-expiry", 10*time.Minute, "expiry of api tokens issued by the control plane")                                                                                             //nolint:lll
+		impersonationMaxDuration = fs.Duration("impersonation-max-duration", time.Hour, "the longest duration admins can impersonate a user with a single token")                                                                  //nolint:lll
+		apiTokenSigningKey       = fs.String("api-token-signing-key", "", "key used to sign api tokens issued by the control plane")                                                                                               //nolint:lll
+		apiTokenSigningKeyFile   = fs.String("api-token-signing-key-file", "", "path to a file containing the key used to sign api tokens")                                                                                        //nolint:lll
+		apiTokenKeyRotation      = fs.Duration("api-token-signing-key-rotation-period", 0, "how often a new key for signing api tokens is generated. 0 disables rotation, so only the configured key is used")                     //nolint:lll
+		apiTokenKeyGrace         = fs.Duration("api-token-signing-key-grace-period", time.Hour, "how long tokens signed with a rotated key are still accepted. has to be longer than the api token expiry")                        //nolint:lll
+		apiTokenKeyRefresh       = fs.Duration("api-token-signing-key-refresh-interval", time.Minute, "how often signing keys are loaded from the database. new keys are used after twice this interval")                          //nolint:lll
+		imageSigningKey          = fs.String("image-signing-key", "", "pem encoded ecdsa key used to sign built images. signing is disabled if unset")                                                                             //nolint:lll
+		imageSigningKeyFile      = fs.String("image-signing-key-file", "", "path to a file containing the key used to sign built images")                                                                                          //nolint:lll
+		jwksListenAddr           = fs.String("jwks-listen-address", "", "address the server serving the public keys used to verify api tokens listens on. disabled if empty")                                                      //nolint:lll
+		pprofListenAddr          = fs.String("pprof-listen-address", "", "address the server serving runtime profiles (pprof) listens on. disabled if empty")                                                                      //nolint:lll
+		pprofTokenFile           = fs.String("pprof-token-file", "", "path to a file containing the bearer token required by the pprof server. required unless it listens on a loopback address")                                  //nolint:lll
+		revocationCacheTTL       = fs.Duration("token-revocation-cache-ttl", 10*time.Second, "how long revocation checks of api tokens are cached. revocations of other replicas apply after this long")                           //nolint:lll
+		adminUserIDs             = fs.String("admin-user-ids", "", "comma separated list of ids of users that are allowed to revoke the api tokens of other users and change the maintenance mode")                                //nolint:lll
+		maintenanceCacheTTL      = fs.Duration("maintenance-cache-ttl", 5*time.Second, "how long the maintenance mode is cached. changes made through other replicas apply after this long")                                       //nolint:lll
+		runtimeSettingsCacheTTL  = fs.Duration("runtime-settings-cache-ttl", 5*time.Second, "how long log levels and feature flags are cached. changes made through other replicas apply after this long")                         //nolint:lll
+		platformdReleaseURL      = fs.String("platformd-release-url", "", "url platformd binaries are downloaded from, {version} is replaced with the rolled out version. platformd updates are disabled if empty")                //nolint:lll
+		integrationTokens        = fs.String("integration-tokens", "", "comma separated list of tokens proxy plugins authenticate with against the integration api. the api is disabled if unset")                                 //nolint:lll
+		integrationTokensFile    = fs.String("integration-tokens-file", "", "path to a file containing integration tokens, one per line. re-read when it changes")                                                                 //nolint:lll
+		thumbnailMaxSizeKB       = fs.Int("thumbnail-max-size-kb", 1000, "max size a thumbnail can be in kilobytes")                                                                                                               //nolint:lll
+		packCreateInterval       = fs.Duration("resource-pack-create-interval", 5*time.Minute, "in what interval the resource pack will be built and published")                                                                   //nolint:lll
+		packWorkingDir           = fs.String("resource-pack-working-dir", "", "the directory where temporary files will be placed when creating the resource pack")                                                                //nolint:lll
+		packTemplateKey          = fs.String("resource-pack-template-key", "", "key to the s3 object that is being used as a resource pack basis")                                                                                 //nolint:lll
+		packItemTemplatePath     = fs.String("resource-pack-item-template-path", "", "path inside the resource pack to an item template. e.g. assets/mynamespace/items/_template.json")                                            //nolint:lll
+		packModelTemplatePath    = fs.String("resource-pack-model-template-path", "", "path inside the resource pack to a model tempalte. e.g. assets/mynamespace/models/item/_template.json")                                     //nolint:lll
+		packModelDir             = fs.String("resource-pack-model-dir", "", "path inside the resource pack to the directory where the models will live. e.g. assets/mynamespace/models/item")                                      //nolint:lll
+		packItemDir              = fs.String("resource-pack-item-dir", "", "path inside the resource pack to the directory where the items will live. e.g. assets/mynamespace/items")                                              //nolint:lll
+		packTextureDir           = fs.String("resource-pack-texture-dir", "", "path inside the resource pack to the directory where the textures will live. e.g. assets/mynamespace/textures/item")                                //nolint:lll
+		changeSetTarballMaxSize  = fs.Uint64("change-set-tarball-max-size", 1073741824, "the maximum allowed size in bytes of the change set tarball")                                                                             //nolint:lll
+		patchMaxFiles            = fs.Uint64("patch-max-files", 20, "the maximum number of files that can be uploaded individually when patching a flavor version")                                                                //nolint:lll
+		patchMaxSize             = fs.Uint64("patch-max-size", 16777216, "the maximum allowed size in bytes of all files uploaded when patching a flavor version")                                                                 //nolint:lll
+		assetMaxSize             = fs.Uint64("asset-max-size", 536870912, "the maximum allowed size in bytes of asset bundles shared between flavor versions")                                                                     //nolint:lll
+		mediaMaxSize             = fs.Uint64("media-max-size", 5242880, "the maximum allowed size in bytes of images shown on the page of a chunk")                                                                                //nolint:lll
+		mediaMaxCount            = fs.Int("media-max-count", 10, "the maximum number of images that can be shown on the page of a chunk")                                                                                          //nolint:lll
+		importArchiveMaxSize     = fs.Uint64("import-archive-max-size", 2147483648, "the maximum allowed size in bytes of server archives uploaded to import a chunk")                                                             //nolint:lll
+		importMaxFileCount       = fs.Uint64("import-max-file-count", 200000, "the maximum number of files an imported server archive can contain, including excluded files. 0 disables the limit")                                //nolint:lll
+		importMaxSize            = fs.Uint64("import-max-size", 8589934592, "the maximum uncompressed size in bytes of all files of an imported server archive, including excluded files. 0 disables the limit")                   //nolint:lll
+		snapshotMaxSize          = fs.Uint64("snapshot-max-size", 1073741824, "the maximum allowed compressed size in bytes of instance snapshots")                                                                                //nolint:lll
+		flavorMaxFileCount       = fs.Uint64("flavor-max-file-count", 50000, "the maximum number of files a flavor version can consist of. 0 disables the limit")                                                                  //nolint:lll
+		flavorMaxSize            = fs.Uint64("flavor-max-size", 4294967296, "the maximum uncompressed size in bytes of all files of a flavor version. 0 disables the limit")                                                       //nolint:lll
+		flavorMaxFileSize        = fs.Uint64("flavor-max-file-size", 1073741824, "the maximum uncompressed size in bytes of a single file of a flavor version. 0 disables the limit")                                              //nolint:lll
+		flavorStartupCommands    = fs.String("flavor-startup-commands", "", "comma separated list of templates flavor versions are allowed to use as startup command")                                                             //nolint:lll
+		imageMaxLayerSize        = fs.Uint64("image-max-layer-size", 2147483648, "the maximum compressed size in bytes of the image layer containing the flavor version files. 0 disables the limit")                              //nolint:lll
+		archiveInterval          = fs.Duration("archive-interval", 3*time.Minute, "in what interval the deleted chunks and flavors should be archived")                                                                            //nolint:lll
+		retentionInterval        = fs.Duration("retention-interval", time.Hour, "in what interval flavor versions are removed according to the retention policies of their chunks. the oci registry has to allow deleting images") //nolint:lll
+		insCleanupInterval       = fs.Duration("instance-cleanup-interval", 30*time.Second, "in what interval instances are checked against the cleanup policies")                                                                 //nolint:lll
+		insWatchResyncInterval   = fs.Duration("instance-watch-resync-interval", 5*time.Second, "in what interval instances watched by nodes are checked for changes made by other replicas")                                      //nolint:lll
+		insRedirectGracePeriod   = fs.Duration("instance-redirect-grace-period", 2*time.Minute, "how long connections to the previous endpoint of an instance are forwarded after its port changed. 0 disables redirects")         //nolint:lll
+		insLogRetention          = fs.Duration("instance-log-retention", 7*24*time.Hour, "how long logs shipped by nodes are kept after the last logs of their instance have been received")                                       //nolint:lll
+		placementWebhookURL      = fs.String("placement-webhook-url", "", "url called with the candidate nodes every time an instance is scheduled. the response can score and veto nodes. disabled if empty")                     //nolint:lll
+		placementWebhookTimeout  = fs.Duration("placement-webhook-timeout", 500*time.Millisecond, "how long the placement webhook has to respond, before the built-in policy is used")                                             //nolint:lll
+		autoscalerInterval       = fs.Duration("autoscaler-interval", 30*time.Second, "in what interval instances of flavors with an autoscaling policy are started or stopped according to their demand. 0 disables autoscaling") //nolint:lll
+		autoscalerDemandWindow   = fs.Duration("autoscaler-demand-window", time.Minute, "how long players that could not join a chunk, because all of its instances were full, are counted as demand")                             //nolint:lll
+		autoscalingMaxInstances  = fs.Uint("autoscaling-max-instances", 20, "the highest number of instances autoscaling policies are allowed to run for a single flavor")                                                         //nolint:lll
+		costCPUHourPrice         = fs.Float64("cost-cpu-hour-price", 0, "price of one cpu core per hour, used to attribute costs to instances")                                                                                    //nolint:lll
+		costMemoryHourPrice      = fs.Float64("cost-memory-gb-hour-price", 0, "price of one GiB of memory per hour, used to attribute costs to instances")                                                                         //nolint:lll
+		costNodeHourPrice        = fs.Float64("cost-node-hour-price", 0, "flat price of running a node per hour, split among the slots of the node")                                                                               //nolint:lll
+		scheduleInterval         = fs.Duration("schedule-interval", time.Minute, "in what interval instance schedules of chunks are evaluated to start or stop their instances. 0 disables schedules")                             //nolint:lll
+		nodeLostAfter            = fs.Duration("node-lost-after", 5*time.Minute, "after how long without heartbeats a node is considered lost and its instances are marked as lost. 0 disables the detection")                     //nolint:lll
+		lostInsCheckInterval     = fs.Duration("lost-instance-check-interval", 30*time.Second, "in what interval nodes are checked for missing heartbeats")                                                                        //nolint:lll
+		rescheduleLostInstances  = fs.Bool("reschedule-lost-instances", false, "start a replacement for lost instances that were not started by the autoscaler or a schedule")                                                     //nolint:lll
+		partitionInterval        = fs.Duration("partition-maintenance-interval", time.Hour, "in what interval monthly partitions of event and audit tables are created ahead of time and expired ones are dropped")                //nolint:lll
+		insEventRetention        = fs.Duration("instance-event-retention", 90*24*time.Hour, "how long instance events are kept. they are dropped a month at a time. 0 keeps them forever")                                         //nolint:lll
+		nodeEventRetention       = fs.Duration("node-event-retention", 30*24*time.Hour, "how long node events are kept. they are dropped a month at a time. 0 keeps them forever")                                                 //nolint:lll
+		impersonationRetention   = fs.Duration("impersonation-audit-retention", 365*24*time.Hour, "how long actions performed while impersonating users are kept. 0 keeps them forever")                                           //nolint:lll
+		insCleanupPolicies       = fs.String("instance-cleanup-policies", "CREATION_FAILED=5m", "comma separated STATE=DURATION pairs. instances staying in STATE for longer than DURATION are removed")                           //nolint:lll
+		eventsListenAddr         = fs.String("events-listen-address", "", "address the server streaming instance state changes to browsers using server-sent events listens on. disabled if empty")                                //nolint:lll
+		webDAVListenAddr         = fs.String("webdav-listen-address", "", "address the read-only webdav gateway serving the files of flavor versions listens on. disabled if empty")                                               //nolint:lll
+		eventsHeartbeatInterval  = fs.Duration("events-heartbeat-interval", 15*time.Second, "interval in which heartbeats are sent to server-sent event clients while nothing changed")                                            //nolint:lll
+		eventsAllowedOrigins     = fs.String("events-allowed-origins", "", "comma separated list of origins browsers are allowed to connect to the server-sent events endpoint from. * allows all origins")                        //nolint:lll
+		statusListenAddr         = fs.String("status-listen-address", "", "address the public status server listens on. the status server is disabled if empty")                                                                   //nolint:lll
+		statusCacheTTL           = fs.Duration("status-cache-ttl", 30*time.Second, "how long the platform status is cached before it is computed again")                                                                           //nolint:lll
+		statusNodeTimeout        = fs.Duration("status-node-timeout", time.Minute, "nodes that have not been seen for this long are reported as offline on the status page")                                                       //nolint:lll
+		statusIncidentsFile      = fs.String("status-incidents-file", "", "path to a json file containing the incidents shown on the status page. re-read every time the status is computed")                                      //nolint:lll
+		statusRateLimit          = fs.Float64("status-rate-limit", 1, "number of requests per second a single client address can make to the status server")                                                                       //nolint:lll
+		statusRateBurst          = fs.Int("status-rate-burst", 10, "number of requests a single client address can make to the status server in a burst")                                                                          //nolint:lll
+		notificationSMTPAddr     = fs.String("notification-smtp-address", "", "host:port of the mail server notifications are sent through. notifications are only logged if empty")                                               //nolint:lll
+		notificationSMTPUser     = fs.String("notification-smtp-username", "", "username used to authenticate against the mail server. authentication is disabled if empty")                                                       //nolint:lll
+		notificationSMTPPass     = fs.String("notification-smtp-password", "", "password used to authenticate against the mail server")                                                                                            //nolint:lll
+		notificationSMTPPassFile = fs.String("notification-smtp-password-file", "", "path to a file containing the mail server password. re-read when it changes")                                                                 //nolint:lll
+		notificationFrom         = fs.String("notification-from", "noreply@chunks.space", "address notification emails are sent from")                                                                                             //nolint:lll
+		disableTracing           = fs.Bool("disable-tracing", false, "disable open telemetry tracing")                                                                                                                             //nolint:lll
+		devMode                  = fs.Bool("dev", false, "run without external dependencies. uses an embedded postgres if no dsn is set, the filesystem blob store and stubs out image and checkpoint builds")                     //nolint:lll
+		devDataDir               = fs.String("dev-data-dir", filepath.Join(os.TempDir(), "explorer-dev"), "directory where all data is stored when running in dev mode")                                                           //nolint:lll
+		_                        = fs.String("config", "", "path to a json or yaml config file. keys are flag names, flags and environment variables take precedence")                                                             //nolint:lll
+		devPostgresPort          = fs.Uint("dev-postgres-port", 5433, "port the embedded postgres listens on when running in dev mode")                                                                                            //nolint:lll
+		logLevel                 = fs.String("log-level", "info", "minimum level of logs. per component levels can be set using a comma separated list, e.g. info,reconciler=debug")                                               //nolint:lll
+		logLevelFile             = fs.String("log-level-file", "", "path to a file containing the log levels in the format of log-level. re-read when it changes")                                                                 //nolint:lll
 	)
+
+	if len(os.Args) > 1 && os.Args[1] == "seed" {
+		seed(logger, os.Args[2:])
+		return
+	}
+
 	if err := ff.Parse(fs, os.Args[1:],
 		ff.WithEnvVarPrefix("CONTROLPLANE"),
+		ff.WithConfigFileFlag("config"),
+		ff.WithConfigFileParser(yamlParser),
 	); err != nil {
 		die(logger, "failed to parse config", err)
 	}
 
-	if s := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"); s == "" {
+	if err := levels.Set(*logLevel); err != nil {
+		die(logger, "failed to parse log-level", err)
+	}
+
+	// secrets that can be rotated at runtime are re-read by the control plane
+	// itself, all others are only read once at startup.
+	dbConnString, err := secret.Resolve(*pgConnString, *pgConnStringFile)
+	if err != nil {
+		die(logger, "failed to read postgres-dsn", err)
+	}
+
+	ociRegistryPassword, err := secret.Resolve(*ociRegistryPass, *ociRegistryPassFile)
+	if err != nil {
+		die(logger, "failed to read oci-registry-pass", err)
+	}
+
+	azureKey, err := secret.Resolve(*azureAccountKey, *azureAccountKeyFile)
+	if err != nil {
+		die(logger, "failed to read azure-account-key", err)
+	}
+
+	uploadSecret, err := secret.Resolve(*storageUploadSecret, *storageUploadSecretFile)
+	if err != nil {
+		die(logger, "failed to read storage-upload-secret", err)
+	}
+
+	signingKey, err := secret.Resolve(*apiTokenSigningKey, *apiTokenSigningKeyFile)
+	if err != nil {
+		die(logger, "failed to read api-token-signing-key", err)
+	}
+
+	imgSigningKey, err := secret.Resolve(*imageSigningKey, *imageSigningKeyFile)
+	if err != nil {
+		die(logger, "failed to read image-signing-key", err)
+	}
+
+	cleanupPolicies, err := instance.ParseCleanupPolicies(*insCleanupPolicies)
+	if err != nil {
+		die(logger, "failed to parse instance cleanup policies", err)
+	}
+
+	partitionedTables := []partition.Table{
+		{Name: partition.TableInstanceEvents, Retention: *insEventRetention},
+		{Name: partition.TableNodeEvents, Retention: *nodeEventRetention},
+		{Name: partition.TableImpersonatedActions, Retention: *impersonationRetention},
+	}
+
+	if s := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"); s == "" && !*devMode {
 		die(logger, "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT has to be set", nil)
 	}
 
 	var (
 		cfg = controlplane.Config{
 			ListenAddr:                    *listenAddr,
-			DBConnString:                  *pgConnString,
+			DBConnString:                  dbConnString,
+			DBConnStringFile:              *pgConnStringFile,
+			DBMaxConns:                    int32(*pgMaxConns),
+			DBMinConns:                    int32(*pgMinConns),
+			DBMaxConnLifetime:             *pgMaxConnLifetime,
+			DBMaxConnIdleTime:             *pgMaxConnIdleTime,
+			DBStatementTimeout:            *pgStatementTimeout,
+			RequestTimeout:                *requestTimeout,
+			MaxRequestSizeBytes:           *maxRequestSize,
+			ChunkCacheTTL:                 *chunkCacheTTL,
+			ReplicaID:                     *replicaID,
+			LeaderElectionInterval:        *leaderElectionInterval,
 			OCIRegistry:                   *ociRegistry,
 			OCIRegistryUser:               *ociRegistryUser,
-			OCIRegistryPass:               *ociRegistryPass,
+			OCIRegistryPass:               ociRegistryPassword,
 			ImageCacheDir:                 *imageCacheDir,
 			ImagePlatform:                 *imagePlatform,
 			CheckpointJobTimeout:          *checkJobTimeout,
 			CheckpointStatusCheckInterval: *checkStatusCheckInterval,
+			CheckpointBuilderTimeout:      *checkBuilderTimeout,
+			CheckpointVerifyRestore:       *checkVerifyRestore,
 			Bucket:                        *bucket,
 			AccessKey:                     *accessKey,
+			AccessKeyFile:                 *accessKeyFile,
 			SecretKey:                     *secretKey,
+			SecretKeyFile:                 *secretKeyFile,
 			PresignedURLExpiry:            *presignedURLExpiry,
 			UsePathStyle:                  *usePathStyle,
+			S3ServerSideEncryption:        *s3SSE,
+			S3KMSKeyID:                    *s3KMSKeyID,
+			S3StorageClass:                *s3StorageClass,
+			ChangeSetTransitionDays:       int32(*changeSetTransitionDays),
+			ChangeSetTransitionClass:      *changeSetTransitionClass,
+			ChangeSetExpirationDays:       int32(*changeSetExpirationDays),
+			StorageBackend:                *storageBackend,
+			GCSCredentialsFile:            *gcsCredentialsFile,
+			AzureAccountName:              *azureAccountName,
+			AzureAccountKey:               azureKey,
+			AzureServiceURL:               *azureServiceURL,
+			StorageDir:                    *storageDir,
+			StorageUploadListenAddr:       *storageUploadListenAddr,
+			StorageUploadPublicURL:        *storageUploadPublicURL,
+			StorageUploadSecret:           uploadSecret,
 			OAuthClientID:                 *idpOAuthClientID,
 			OAuthIssuerURL:                *idpOAuthIssuerEndpoint,
 			APITokenIssuer:                *apiTokenIssuer,
 			APITokenExpiry:                *apiTokenExpiry,
-			APITokenSigningKey:            *apiTokenSigningKey,
+			ImpersonationMaxDuration:      *impersonationMaxDuration,
+			APITokenSigningKey:            signingKey,
+			APITokenKeyRotationPeriod:     *apiTokenKeyRotation,
+			APITokenKeyGracePeriod:        *apiTokenKeyGrace,
+			APITokenKeyRefreshInterval:    *apiTokenKeyRefresh,
+			ImageSigningKey:               imgSigningKey,
+			JWKSListenAddr:                *jwksListenAddr,
+			PprofListenAddr:               *pprofListenAddr,
+			PprofTokenFile:                *pprofTokenFile,
+			RevocationCacheTTL:            *revocationCacheTTL,
+			AdminUserIDs:                  splitList(*adminUserIDs),
+			MaintenanceCacheTTL:           *maintenanceCacheTTL,
+			RuntimeSettingsCacheTTL:       *runtimeSettingsCacheTTL,
+			PlatformdReleaseURL:           *platformdReleaseURL,
+			IntegrationTokens:             *integrationTokens,
+			IntegrationTokensFile:         *integrationTokensFile,
 			ThumbnailMaxSizeKB:            *thumbnailMaxSizeKB,
 			ResourcePackBuildInterval:     *packCreateInterval,
 			ResourcePackWorkingDir:        *packWorkingDir,
@@ -117,13 +336,85 @@ func main() {
 			ResourcePackItemDir:           *packItemDir,
 			ResourcePackTextureDir:        *packTextureDir,
 			ChangeSetTarballMaxSizeBytes:  *changeSetTarballMaxSize,
+			PatchMaxFiles:                 *patchMaxFiles,
+			PatchMaxSizeBytes:             *patchMaxSize,
+			AssetMaxSizeBytes:             *assetMaxSize,
+			MediaMaxSizeBytes:             *mediaMaxSize,
+			MediaMaxCount:                 *mediaMaxCount,
+			ImportArchiveMaxSizeBytes:     *importArchiveMaxSize,
+			ImportMaxFileCount:            *importMaxFileCount,
+			ImportMaxSizeBytes:            *importMaxSize,
+			SnapshotMaxSizeBytes:          *snapshotMaxSize,
+			FlavorMaxFileCount:            *flavorMaxFileCount,
+			FlavorMaxSizeBytes:            *flavorMaxSize,
+			FlavorMaxFileSizeBytes:        *flavorMaxFileSize,
+			FlavorStartupCommands:         splitList(*flavorStartupCommands),
+			ImageMaxLayerSizeBytes:        *imageMaxLayerSize,
 			ArchiveInterval:               *archiveInterval,
+			RetentionInterval:             *retentionInterval,
+			InstanceCleanupInterval:       *insCleanupInterval,
+			InstanceCleanupPolicies:       cleanupPolicies,
+			InstanceWatchResyncInterval:   *insWatchResyncInterval,
+			InstanceRedirectGracePeriod:   *insRedirectGracePeriod,
+			InstanceLogRetention:          *insLogRetention,
+			PlacementWebhookURL:           *placementWebhookURL,
+			PlacementWebhookTimeout:       *placementWebhookTimeout,
+			AutoscalerInterval:            *autoscalerInterval,
+			AutoscalerDemandWindow:        *autoscalerDemandWindow,
+			AutoscalingMaxInstances:       uint32(*autoscalingMaxInstances),
+			CostCPUHourPrice:              *costCPUHourPrice,
+			CostMemoryGBHourPrice:         *costMemoryHourPrice,
+			CostNodeHourPrice:             *costNodeHourPrice,
+			ScheduleInterval:              *scheduleInterval,
+			NodeLostAfter:                 *nodeLostAfter,
+			LostInstanceCheckInterval:     *lostInsCheckInterval,
+			RescheduleLostInstances:       *rescheduleLostInstances,
+			PartitionInterval:             *partitionInterval,
+			PartitionedTables:             partitionedTables,
+			EventsListenAddr:              *eventsListenAddr,
+			WebDAVListenAddr:              *webDAVListenAddr,
+			EventsHeartbeatInterval:       *eventsHeartbeatInterval,
+			EventsAllowedOrigins:          splitList(*eventsAllowedOrigins),
+			StatusListenAddr:              *statusListenAddr,
+			StatusCacheTTL:                *statusCacheTTL,
+			StatusNodeTimeout:             *statusNodeTimeout,
+			StatusIncidentsFile:           *statusIncidentsFile,
+			StatusRateLimit:               *statusRateLimit,
+			StatusRateBurst:               *statusRateBurst,
+			NotificationSMTPAddr:          *notificationSMTPAddr,
+			NotificationSMTPUser:          *notificationSMTPUser,
+			NotificationSMTPPass:          *notificationSMTPPass,
+			NotificationSMTPPassFile:      *notificationSMTPPassFile,
+			NotificationFrom:              *notificationFrom,
+			LogLevels:                     levels,
+			LogLevelSpec:                  *logLevel,
 			DisableTracing:                *disableTracing,
+			DevMode:                       *devMode,
 		}
-		ctx    = context.Background()
-		server = controlplane.NewServer(logger, cfg)
+		ctx     = context.Background()
+		stopDev func() error
 	)
 
+	if cfg.DevMode {
+		var err error
+		stopDev, err = setupDevMode(fs, &cfg, *devDataDir, uint32(*devPostgresPort))
+		if err != nil {
+			if stopDev != nil {
+				_ = stopDev()
+			}
+			die(logger, "failed to setup dev mode", err)
+		}
+	}
+
+	server := controlplane.NewServer(logger, cfg)
+
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	defer stopWatch()
+
+	if *logLevelFile != "" {
+		go levels.Watch(watchCtx, logger, *logLevelFile, 10*time.Second)
+	}
+
 	go func() {
 		c := make(chan os.Signal, 1)
 		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
@@ -136,7 +427,15 @@ func main() {
 		die(logger, "failed to run migrations", err)
 	}
 
-	if err := server.Run(ctx); err != nil {
+	err = server.Run(ctx)
+
+	if stopDev != nil {
+		if err := stopDev(); err != nil {
+			logger.Error("failed to stop embedded postgres", "err", err)
+		}
+	}
+
+	if err != nil {
 		var multi *multierror.Error
 		if errors.As(err, &multi) {
 			errs := make([]string, 0, len(multi.WrappedErrors()))
@@ -150,6 +449,87 @@ func main() {
 	}
 }
 
+// setupDevMode fills in defaults for everything that would otherwise
+// require an external service. explicitly configured values are kept.
+func setupDevMode(fs *flag.FlagSet, cfg *controlplane.Config, dataDir string, pgPort uint32) (func() error, error) {
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+
+	var stop func() error
+	if cfg.DBConnString == "" {
+		dsn, stopPG, err := dev.StartPostgres(dataDir, pgPort, os.Stderr)
+		if err != nil {
+			return nil, fmt.Errorf("embedded postgres: %w", err)
+		}
+		cfg.DBConnString = dsn
+		stop = stopPG
+	}
+
+	if cfg.APITokenSigningKey == "" {
+		key, err := dev.SigningKey(dataDir)
+		if err != nil {
+			return stop, fmt.Errorf("signing key: %w", err)
+		}
+		cfg.APITokenSigningKey = key
+	}
+
+	if cfg.APITokenIssuer == "" {
+		cfg.APITokenIssuer = "explorer-dev"
+	}
+
+	if cfg.OCIRegistry == "" {
+		cfg.OCIRegistry = "registry.dev.local"
+	}
+
+	if !set["storage-backend"] {
+		cfg.StorageBackend = string(blob.BackendFilesystem)
+	}
+
+	if !set["storage-dir"] {
+		cfg.StorageDir = filepath.Join(dataDir, "blobs")
+	}
+
+	if !set["disable-tracing"] {
+		cfg.DisableTracing = true
+	}
+
+	return stop, nil
+}
+
+// yamlParser parses json and yaml config files. yaml is a superset of json,
+// so the file is converted to json and parsed like ff does for json files.
+func yamlParser(r io.Reader, set func(name, value string) error) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	// an empty yaml document is converted to null, which is
+	// not a valid json object.
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return fmt.Errorf("convert yaml: %w", err)
+	}
+
+	return ff.JSONParser(bytes.NewReader(jsonData), set)
+}
+
+func splitList(s string) []string {
+	ret := make([]string, 0)
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
 func die(logger *slog.Logger, msg string, err error) {
 	logger.Error(msg, "err", err)
 	os.Exit(1)
@@ -0,0 +1,80 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/peterbourgon/ff/v3"
+	"github.com/spacechunks/explorer/controlplane/postgres/migrations"
+	"github.com/spacechunks/explorer/controlplane/secret"
+	"github.com/spacechunks/explorer/test/fixture"
+)
+
+// seed populates the database with demo data, so demo environments
+// and frontend development do not require creating everything using
+// the api. the data is always the same, see [fixture.DemoData].
+func seed(logger *slog.Logger, args []string) {
+	var (
+		ctx              = context.Background()
+		fs               = flag.NewFlagSet("seed", flag.ContinueOnError)
+		pgConnString     = fs.String("postgres-dsn", "", "connection string of the database to seed")
+		pgConnStringFile = fs.String("postgres-dsn-file", "", "path to a file containing the connection string")
+	)
+	if err := ff.Parse(fs, args, ff.WithEnvVarPrefix("CONTROLPLANE")); err != nil {
+		die(logger, "failed to parse flags", err)
+	}
+
+	dsn, err := secret.Resolve(*pgConnString, *pgConnStringFile)
+	if err != nil {
+		die(logger, "failed to read postgres-dsn", err)
+	}
+
+	if dsn == "" {
+		die(logger, "postgres-dsn has to be set", nil)
+	}
+
+	if err := migrations.Migrate(dsn); err != nil {
+		die(logger, "failed to run migrations", err)
+	}
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		die(logger, "failed to connect to database", err)
+	}
+
+	d := fixture.DemoData()
+	err = fixture.SeedDemo(ctx, pool, d)
+	pool.Close()
+
+	if err != nil {
+		die(logger, "failed to seed demo data", err)
+	}
+
+	logger.Info(
+		"seeded demo data",
+		"users", len(d.Users),
+		"nodes", len(d.Nodes),
+		"chunks", len(d.Chunks),
+		"instances", len(d.Instances),
+	)
+}
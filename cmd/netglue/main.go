@@ -28,8 +28,6 @@ import (
 	proxyv1alpha1 "github.com/spacechunks/explorer/api/platformd/proxy/v1alpha1"
 	workloadv1alpha2 "github.com/spacechunks/explorer/api/platformd/workload/v1alpha2"
 	"github.com/spacechunks/explorer/cni"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -45,10 +43,7 @@ func main() {
 				return fmt.Errorf("parse config: %v", err)
 			}
 
-			conn, err := grpc.NewClient(
-				conf.PlatformdListenSock,
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-			)
+			conn, err := cni.DialPlatformd(conf.PlatformdListenSock)
 			if err != nil {
 				return fmt.Errorf("failed to create proxy service grpc proxyCLient: %w", err)
 			}
@@ -66,10 +61,7 @@ func main() {
 				return fmt.Errorf("parse config: %v", err)
 			}
 
-			conn, err := grpc.NewClient(
-				conf.PlatformdListenSock,
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-			)
+			conn, err := cni.DialPlatformd(conf.PlatformdListenSock)
 			if err != nil {
 				return fmt.Errorf("failed to create proxy service grpc proxyCLient: %w", err)
 			}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"fmt"
 	"log/slog"
 	"net/url"
 	"os"
@@ -14,44 +15,97 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/peterbourgon/ff/v3"
+	"github.com/spacechunks/explorer/internal/logging"
 	"github.com/spacechunks/explorer/platformd"
 	"github.com/spacechunks/explorer/platformd/checkpoint"
+	"github.com/spacechunks/explorer/platformd/condition"
+	"github.com/spacechunks/explorer/platformd/cri"
+	"github.com/spacechunks/explorer/platformd/system"
+	"github.com/spacechunks/explorer/platformd/update"
+	"github.com/spacechunks/explorer/platformd/workload"
 )
 
 func main() {
 	var (
-		logger                       = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		levels                       = logging.NewLevels(slog.LevelInfo)
+		logger                       = slog.New(logging.NewHandler(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}), levels)) //nolint:lll
 		fs                           = flag.NewFlagSet("platformd", flag.ContinueOnError)
 		mgmtListenSock               = fs.String("management-server-listen-sock", "/run/platformd/platformd.sock", "path to the unix domain socket to listen on") //nolint:lll
 		mgmtSockUID                  = fs.Uint64("management-server-listen-sock-uid", 9012, "unix domain socket uid")
 		mgmtSockGID                  = fs.Uint64("management-server-listen-sock-gid", 9012, "unix domain socket gid")
-		criListenSock                = fs.String("cri-listen-sock", "/var/run/crio/crio.sock", "path to the unix domain socket the CRI is listening on")                       //nolint:lll
-		envoyImage                   = fs.String("envoy-image", "", "container image to use for envoy")                                                                        //nolint:lll
-		coreDNSImage                 = fs.String("coredns-image", "", "container image to use for CoreDNS")                                                                    //nolint:lll
-		getsockoptCgroup             = fs.String("getsockopt-cgroup", "", "container image to use for coredns")                                                                //nolint:lll
-		dnsServer                    = fs.String("dns-server", "", "dns server used by the containers")                                                                        //nolint:lll
-		hostIface                    = fs.String("host-iface", "", "internet-facing network interface for ingress and egress traffic")                                         //nolint:lll
-		maxAttempts                  = fs.Uint("max-attempts", 5, "maximum number of attempts workload creation attempts")                                                     //nolint:lll
-		syncInterval                 = fs.Duration("sync-interval", 200*time.Millisecond, "i")                                                                                 //nolint:lll
-		nodeID                       = fs.String("node-id", "", "unique node id")                                                                                              //nolint:lll
-		minPort                      = fs.Uint("min-port", 30000, "start of the port range")                                                                                   //nolint:lll
-		maxPort                      = fs.Uint("max-port", 40000, "end of the port range")                                                                                     //nolint:lll
-		workloadNamespace            = fs.String("workload-namespace", "", "namespace where the workload is deployed")                                                         //nolint:lll
-		registryEndpoint             = fs.String("registry-endpoint", "", "registry endpoint where base images will be pulled from and checkpoints pushed to")                 //nolint:lll
-		registryUser                 = fs.String("registry-user", "", "user for the registry")                                                                                 //nolint:lll
-		registryPass                 = fs.String("registry-password", "", "password for the registry")                                                                         //nolint:lll
-		controlPlaneEndpoint         = fs.String("control-plane-endpoint", "", "control plane endpoint")                                                                       //nolint:lll
-		checkCPUPeriod               = fs.Uint64("checkpoint-cpu-period", 0, "period of checking CPU period")                                                                  //nolint:lll
-		checkCPUQuota                = fs.Uint64("checkpoint-cpu-quota", 0, "quota of checking CPU quota")                                                                     //nolint:lll
-		checkMemoryLimitInBytes      = fs.Uint64("checkpoint-memory-limit-bytes", 0, "memory limit of the container that will be checkpointed")                                //nolint:lll
-		checkLocationDir             = fs.String("checkpoint-file-dir", "/tmp/platformd", "directory where checkpoint files will be stored")                                   //nolint:lll
-		checkTimeout                 = fs.Uint64("checkpoint-timeout-seconds", 60, "timeout for checkpoint creation")                                                          //nolint:lll
-		checkListenAddr              = fs.String("checkpoint-listen-addr", "", "timeout for checkpoint creation")                                                              //nolint:lll
-		checkStatusRetentionDuration = fs.Duration("checkpoint-status-retention-period", 1*time.Minute, "timeout for checkpoint creation")                                     //nolint:lll
-		checkContainerReadyTimeout   = fs.Duration("checkpoint-container-ready-timeout", 1*time.Minute, "maximum time to wait until the container is ready for checkpointing") //nolint:lll
-		mcServerManagementAPIToken   = fs.String("mc-server-management-api-token", "", "token to use for the minecraft server management api")                                 //nolint:lll
-		serverMonImage               = fs.String("servermon-image", "", "image to use for the servermon container")                                                            //nolint:lll
-		_                            = fs.String("config", "/etc/platformd/config.json", "path to the config file")                                                            //nolint:lll
+		criListenSock                = fs.String("cri-listen-sock", "/var/run/crio/crio.sock", "path to the unix domain socket the CRI is listening on")                                 //nolint:lll
+		envoyImage                   = fs.String("envoy-image", "", "container image to use for envoy")                                                                                  //nolint:lll
+		envoyAdminAddr               = fs.String("envoy-admin-addr", "127.0.0.1:5555", "address of the envoy admin api traffic stats are collected from")                                //nolint:lll
+		coreDNSImage                 = fs.String("coredns-image", "", "container image to use for CoreDNS")                                                                              //nolint:lll
+		getsockoptCgroup             = fs.String("getsockopt-cgroup", "", "container image to use for coredns")                                                                          //nolint:lll
+		dnsServer                    = fs.String("dns-server", "", "dns server used by the containers")                                                                                  //nolint:lll
+		dnsConfigDir                 = fs.String("dns-config-dir", "/etc/platformd/dns", "directory where the generated CoreDNS config and records are stored")                          //nolint:lll
+		dnsBaseConfig                = fs.String("dns-base-config", "/etc/platformd/dns.conf", "path to the CoreDNS config the service zone is appended to")                             //nolint:lll
+		dnsZone                      = fs.String("dns-zone", "svc.chunks.internal", "zone records registered through the dns api are served in")                                         //nolint:lll
+		hostIface                    = fs.String("host-iface", "", "internet-facing network interface for ingress and egress traffic")                                                   //nolint:lll
+		maxAttempts                  = fs.Uint("max-attempts", 5, "maximum number of attempts workload creation attempts")                                                               //nolint:lll
+		syncInterval                 = fs.Duration("sync-interval", 200*time.Millisecond, "i")                                                                                           //nolint:lll
+		fullResyncInterval           = fs.Duration("full-resync-interval", 1*time.Minute, "interval in which all instances are fetched instead of only changed ones")                    //nolint:lll
+		watchInstances               = fs.Bool("watch-instances", true, "receive instance changes pushed by the control plane")                                                          //nolint:lll
+		reportBatchSize              = fs.Int("status-report-batch-size", 500, "maximum number of instance status reports sent to the control plane in a single request. at most 1000")  //nolint:lll
+		dryRun                       = fs.Bool("dry-run", false, "only log and report what the reconciler would create or remove. images are pulled, but no workloads are touched")      //nolint:lll
+		systemCheckInterval          = fs.Duration("system-workload-check-interval", 10*time.Second, "interval in which the health of envoy and coredns is checked")                     //nolint:lll
+		trafficCollectInterval       = fs.Duration("traffic-collect-interval", 10*time.Second, "interval in which traffic stats of the workloads are collected from envoy")              //nolint:lll
+		nodeID                       = fs.String("node-id", "", "unique node id")                                                                                                        //nolint:lll
+		builder                      = fs.Bool("builder", false, "advertise the node as builder, so checkpoint builds are scheduled to it")                                              //nolint:lll
+		minPort                      = fs.Uint("min-port", 30000, "start of the port range")                                                                                             //nolint:lll
+		maxPort                      = fs.Uint("max-port", 40000, "end of the port range")                                                                                               //nolint:lll
+		workloadNamespace            = fs.String("workload-namespace", "", "namespace where the workload is deployed")                                                                   //nolint:lll
+		registryEndpoint             = fs.String("registry-endpoint", "", "registry endpoint where base images will be pulled from and checkpoints pushed to")                           //nolint:lll
+		registryUser                 = fs.String("registry-user", "", "user for the registry")                                                                                           //nolint:lll
+		registryPass                 = fs.String("registry-password", "", "password for the registry")                                                                                   //nolint:lll
+		verifyImages                 = fs.Bool("verify-images", false, "refuse to run base and checkpoint images that have not been signed by the control plane")                        //nolint:lll
+		imgVerificationKeyFile       = fs.String("image-verification-key-file", "", "path to the pem encoded public key image signatures are verified with")                             //nolint:lll
+		updateVerificationKeyFile    = fs.String("update-verification-key-file", "", "path to the pem encoded public key updates are verified with, empty disables updates")             //nolint:lll
+		updateCheckInterval          = fs.Duration("update-check-interval", 1*time.Minute, "interval in which the control plane is asked for platformd updates")                         //nolint:lll
+		conditionCheckInterval       = fs.Duration("condition-check-interval", condition.DefaultCheckInterval, "interval in which node conditions are detected and reported")            //nolint:lll
+		logShippingInterval          = fs.Duration("log-shipping-interval", 0, "interval in which container logs are shipped to the control plane, 0 disables log shipping")             //nolint:lll
+		logArchiveDir                = fs.String("log-archive-dir", "/var/log/platformd/archive", "directory the logs of removed workloads are kept in until they have been shipped")    //nolint:lll
+		bpfMapReconcileInterval      = fs.Duration("bpf-map-reconcile-interval", 1*time.Minute, "interval in which bpf map entries of removed workloads are removed")                    //nolint:lll
+		imagePruneInterval           = fs.Duration("image-prune-interval", 10*time.Minute, "interval in which unused base and checkpoint images are removed, 0 disables pruning")        //nolint:lll
+		imagePruneUnusedFor          = fs.Duration("image-prune-unused-for", 1*time.Hour, "duration images have to be unused by any container before they are removed")                  //nolint:lll
+		registryMirrors              = fs.String("registry-mirrors", "", "comma-separated list of mirrors tried before the registry endpoint")                                           //nolint:lll
+		registryNodeMirrors          = fs.String("registry-node-mirrors", "", "per-node registry-mirrors overrides as <node-id>=<mirror>[|<mirror>...]")                                 //nolint:lll
+		registryMirrorConfigPath     = fs.String("registry-mirror-config-path", cri.DefaultMirrorConfigPath, "registries.conf drop-in the mirrors are written to")                       //nolint:lll
+		controlPlaneEndpoint         = fs.String("control-plane-endpoint", "", "control plane endpoint")                                                                                 //nolint:lll
+		checkCPUPeriod               = fs.Uint64("checkpoint-cpu-period", 0, "period of checking CPU period")                                                                            //nolint:lll
+		checkCPUQuota                = fs.Uint64("checkpoint-cpu-quota", 0, "quota of checking CPU quota")                                                                               //nolint:lll
+		checkMemoryLimitInBytes      = fs.Uint64("checkpoint-memory-limit-bytes", 0, "memory limit of the container that will be checkpointed")                                          //nolint:lll
+		checkMaxCPUQuota             = fs.Uint64("checkpoint-max-cpu-quota", 0, "upper bound for cpu quota overrides of checkpoint requests")                                            //nolint:lll
+		checkMaxMemoryLimitInBytes   = fs.Uint64("checkpoint-max-memory-limit-bytes", 0, "upper bound for memory overrides of checkpoint requests")                                      //nolint:lll
+		checkLocationDir             = fs.String("checkpoint-file-dir", "/tmp/platformd", "directory where checkpoint files will be stored")                                             //nolint:lll
+		checkMaxDiskUsageBytes       = fs.Uint64("checkpoint-max-disk-usage-bytes", 0, "disk space checkpoint files may use, zero disables the limit")                                   //nolint:lll
+		checkTimeout                 = fs.Uint64("checkpoint-timeout-seconds", 60, "timeout for checkpoint creation")                                                                    //nolint:lll
+		checkListenAddr              = fs.String("checkpoint-listen-addr", "", "timeout for checkpoint creation")                                                                        //nolint:lll
+		checkStatusRetentionDuration = fs.Duration("checkpoint-status-retention-period", 1*time.Minute, "timeout for checkpoint creation")                                               //nolint:lll
+		checkContainerReadyTimeout   = fs.Duration("checkpoint-container-ready-timeout", 1*time.Minute, "maximum time to wait until the container is ready for checkpointing")           //nolint:lll
+		checkRestoreVerifyTimeout    = fs.Duration("checkpoint-restore-verify-timeout", 2*time.Minute, "maximum time to wait until a restored checkpoint responds to a ping")            //nolint:lll
+		mcServerManagementAPIToken   = fs.String("mc-server-management-api-token", "", "token to use for the minecraft server management api")                                           //nolint:lll
+		serverMonImage               = fs.String("servermon-image", "", "image to use for the servermon container")                                                                      //nolint:lll
+		probeType                    = fs.String("workload-probe-type", "", "health probe for workloads: tcp, server-list-ping or empty to disable")                                     //nolint:lll
+		probePort                    = fs.Uint("workload-probe-port", workload.DefaultProbePort, "port of the minecraft server inside the pod")                                          //nolint:lll
+		probeTimeout                 = fs.Duration("workload-probe-timeout", 5*time.Second, "timeout of a single workload health probe")                                                 //nolint:lll
+		probeLatencyThreshold        = fs.Duration("workload-probe-latency-threshold", 0, "max server list ping latency, 0 disables the check")                                          //nolint:lll
+		probeFailureThreshold        = fs.Uint("workload-probe-failure-threshold", 3, "consecutive probe failures until a workload is unhealthy")                                        //nolint:lll
+		probeStartupGracePeriod      = fs.Duration("workload-probe-startup-grace-period", 2*time.Minute, "default time workloads have to start before probes count")                     //nolint:lll
+		workloadParamsDir            = fs.String("workload-params-dir", "/var/lib/platformd/params", "directory the runtime parameters of instances are written to")                     //nolint:lll
+		workloadCPUMillis            = fs.Uint64("workload-cpu-millis", 0, "cpu limit of a single workload in millicores, 0 disables the limit")                                         //nolint:lll
+		workloadMemoryLimitBytes     = fs.Uint64("workload-memory-limit-bytes", 0, "memory limit of a single workload, 0 disables the limit")                                            //nolint:lll
+		maxInstances                 = fs.Uint("max-instances", 0, "maximum number of instances scheduled to this node, 0 means unbounded")                                              //nolint:lll
+		cpuOvercommitRatio           = fs.Float64("cpu-overcommit-ratio", 1, "ratio the allocatable cpu is multiplied with when computing the max instances")                            //nolint:lll
+		memoryOvercommitRatio        = fs.Float64("memory-overcommit-ratio", 1, "ratio the allocatable memory is multiplied with when computing the max instances")                      //nolint:lll
+		reservedCPUMillis            = fs.Uint64("reserved-cpu-millis", 500, "cpu in millicores reserved for envoy, coredns and platformd")                                              //nolint:lll
+		reservedMemoryBytes          = fs.Uint64("reserved-memory-bytes", 1<<30, "memory reserved for envoy, coredns and platformd")                                                     //nolint:lll
+		_                            = fs.String("config", "/etc/platformd/config.json", "path to the config file")                                                                      //nolint:lll
+		logLevel                     = fs.String("log-level", "info", "minimum level of logs. per component levels can be set using a comma separated list, e.g. info,reconciler=debug") //nolint:lll
+		logLevelFile                 = fs.String("log-level-file", "", "path to a file containing the log levels in the format of log-level. re-read when it changes")                   //nolint:lll
+		pprofListenAddr              = fs.String("pprof-listen-addr", "", "address the server serving runtime profiles (pprof) listens on, disabled if empty")                           //nolint:lll
+		pprofTokenFile               = fs.String("pprof-token-file", "", "file containing the bearer token for the pprof server. optional on loopback addresses")                        //nolint:lll
 	)
 	if err := ff.Parse(fs, os.Args[1:],
 		ff.WithEnvVarPrefix("PLATFORMD"),
@@ -61,56 +115,124 @@ func main() {
 		die(logger, "failed to parse config", err)
 	}
 
+	if err := levels.Set(*logLevel); err != nil {
+		die(logger, "failed to parse log-level", err)
+	}
+
 	mgmtSockURL, err := url.Parse(*mgmtListenSock)
 	if err != nil {
 		die(logger, "failed to parse management-server-listen-sock", err)
 	}
 
+	nodeMirrors, err := parseNodeMirrors(*registryNodeMirrors)
+	if err != nil {
+		die(logger, "failed to parse registry-node-mirrors", err)
+	}
+
 	var (
 		cfg = platformd.Config{
-			ManagementServerListenSock: mgmtSockURL,
-			CRIListenSock:              *criListenSock,
-			EnvoyImage:                 *envoyImage,
-			CoreDNSImage:               *coreDNSImage,
-			GetsockoptCGroup:           *getsockoptCgroup,
-			DNSServer:                  *dnsServer,
-			HostIface:                  *hostIface,
-			MaxAttempts:                *maxAttempts,
-			SyncInterval:               *syncInterval,
-			NodeID:                     *nodeID,
-			MinPort:                    uint16(*minPort), // TODO: validation
-			MaxPort:                    uint16(*maxPort), // TODO: validation
-			WorkloadNamespace:          *workloadNamespace,
-			RegistryEndpoint:           *registryEndpoint,
-			RegistryUser:               *registryUser,
-			RegistryPass:               *registryPass,
-			ControlPlaneEndpoint:       *controlPlaneEndpoint,
+			ManagementServerListenSock:  mgmtSockURL,
+			CRIListenSock:               *criListenSock,
+			EnvoyImage:                  *envoyImage,
+			EnvoyAdminAddr:              *envoyAdminAddr,
+			CoreDNSImage:                *coreDNSImage,
+			GetsockoptCGroup:            *getsockoptCgroup,
+			DNSServer:                   *dnsServer,
+			DNSConfigDir:                *dnsConfigDir,
+			DNSBaseConfig:               *dnsBaseConfig,
+			DNSZone:                     *dnsZone,
+			HostIface:                   *hostIface,
+			MaxAttempts:                 *maxAttempts,
+			SyncInterval:                *syncInterval,
+			FullResyncInterval:          *fullResyncInterval,
+			WatchInstances:              *watchInstances,
+			DryRun:                      *dryRun,
+			ReportBatchSize:             *reportBatchSize,
+			SystemWorkloadCheckInterval: *systemCheckInterval,
+			TrafficCollectInterval:      *trafficCollectInterval,
+			NodeID:                      *nodeID,
+			Builder:                     *builder,
+			MinPort:                     uint16(*minPort), // TODO: validation
+			MaxPort:                     uint16(*maxPort), // TODO: validation
+			WorkloadNamespace:           *workloadNamespace,
+			RegistryEndpoint:            *registryEndpoint,
+			RegistryUser:                *registryUser,
+			RegistryPass:                *registryPass,
+			RegistryMirrors:             splitList(*registryMirrors, ","),
+			RegistryNodeMirrors:         nodeMirrors,
+			RegistryMirrorConfigPath:    *registryMirrorConfigPath,
+			ControlPlaneEndpoint:        *controlPlaneEndpoint,
+			VerifyImages:                *verifyImages,
+			ImageVerificationKeyFile:    *imgVerificationKeyFile,
+			UpdateVerificationKeyFile:   *updateVerificationKeyFile,
+			UpdateCheckInterval:         *updateCheckInterval,
+			ConditionCheckInterval:      *conditionCheckInterval,
+			LogShippingInterval:         *logShippingInterval,
+			LogArchiveDir:               *logArchiveDir,
+			BPFMapReconcileInterval:     *bpfMapReconcileInterval,
+			ImagePruneInterval:          *imagePruneInterval,
+			ImagePruneUnusedFor:         *imagePruneUnusedFor,
+			PprofListenAddr:             *pprofListenAddr,
+			PprofTokenFile:              *pprofTokenFile,
+			LogLevels:                   levels,
+			LogLevelSpec:                *logLevel,
 			CheckpointConfig: checkpoint.Config{
-				CPUPeriod:                int64(*checkCPUPeriod),          // TODO: validation
-				CPUQuota:                 int64(*checkCPUQuota),           // TODO: validation
-				MemoryLimitBytes:         int64(*checkMemoryLimitInBytes), // TODO: validation
+				CPUPeriod:                int64(*checkCPUPeriod),             // TODO: validation
+				CPUQuota:                 int64(*checkCPUQuota),              // TODO: validation
+				MemoryLimitBytes:         int64(*checkMemoryLimitInBytes),    // TODO: validation
+				MaxCPUQuota:              int64(*checkMaxCPUQuota),           // TODO: validation
+				MaxMemoryLimitBytes:      int64(*checkMaxMemoryLimitInBytes), // TODO: validation
 				CheckpointFileDir:        *checkLocationDir,
+				MaxDiskUsageBytes:        *checkMaxDiskUsageBytes,
 				CheckpointTimeoutSeconds: int64(*checkTimeout), // TODO: validation
 				RegistryUser:             *registryUser,
 				RegistryPass:             *registryPass,
 				ListenAddr:               *checkListenAddr,
 				StatusRetentionPeriod:    *checkStatusRetentionDuration,
 				ContainerReadyTimeout:    *checkContainerReadyTimeout,
+				RestoreVerifyTimeout:     *checkRestoreVerifyTimeout,
+			},
+			NodeCapacity: system.CapacityConfig{
+				MaxInstances:          uint32(*maxInstances),
+				CPUOvercommitRatio:    *cpuOvercommitRatio,
+				MemoryOvercommitRatio: *memoryOvercommitRatio,
+				ReservedCPUMillis:     *reservedCPUMillis,
+				ReservedMemoryBytes:   *reservedMemoryBytes,
+				WorkloadCPUMillis:     *workloadCPUMillis,
+				WorkloadMemoryBytes:   *workloadMemoryLimitBytes,
 			},
 			ManagementSocketUID: *mgmtSockUID,
 			ManagementSocketGID: *mgmtSockGID,
 			WorkloadConfig: struct {
 				MCManagementAPIToken string
 				ServerMonImage       string
+				Probe                workload.ProbeConfig
+				ParamsDir            string
 			}{
 				MCManagementAPIToken: *mcServerManagementAPIToken,
 				ServerMonImage:       *serverMonImage,
+				Probe: workload.ProbeConfig{
+					Type:               workload.ProbeType(*probeType),
+					Port:               uint16(*probePort), // TODO: validation
+					Timeout:            *probeTimeout,
+					LatencyThreshold:   *probeLatencyThreshold,
+					FailureThreshold:   *probeFailureThreshold,
+					StartupGracePeriod: *probeStartupGracePeriod,
+				},
+				ParamsDir: *workloadParamsDir,
 			},
 		}
 		ctx    = context.Background()
 		server = platformd.NewServer(logger)
 	)
 
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	defer stopWatch()
+
+	if *logLevelFile != "" {
+		go levels.Watch(watchCtx, logger, *logLevelFile, 10*time.Second)
+	}
+
 	go func() {
 		c := make(chan os.Signal, 1)
 		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
@@ -131,6 +253,39 @@ func main() {
 		}
 		die(logger, "failed to run server", err)
 	}
+
+	// workloads keep running while platformd is replaced, the new
+	// version recovers their state once it has been started.
+	if executable, ok := server.Handoff(); ok {
+		logger.Info("handing over to updated platformd", "executable", executable)
+		if err := update.Exec(executable); err != nil {
+			die(logger, "failed to execute updated platformd", err)
+		}
+	}
+}
+
+// parseNodeMirrors parses entries in the form of <node-id>=<mirror>[|<mirror>...].
+// an entry without mirrors disables the mirrors for that node.
+func parseNodeMirrors(s string) (map[string][]string, error) {
+	ret := make(map[string][]string)
+	for _, entry := range splitList(s, ",") {
+		nodeID, mirrors, ok := strings.Cut(entry, "=")
+		if !ok || nodeID == "" {
+			return nil, fmt.Errorf("invalid entry %q", entry)
+		}
+		ret[nodeID] = splitList(mirrors, "|")
+	}
+	return ret, nil
+}
+
+func splitList(s string, sep string) []string {
+	ret := make([]string, 0)
+	for _, v := range strings.Split(s, sep) {
+		if v = strings.TrimSpace(v); v != "" {
+			ret = append(ret, v)
+		}
+	}
+	return ret
 }
 
 func die(logger *slog.Logger, msg string, err error) {
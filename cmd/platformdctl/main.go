@@ -0,0 +1,146 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	debugv1alpha1 "github.com/spacechunks/explorer/api/platformd/debug/v1alpha1"
+	nodev1alpha1 "github.com/spacechunks/explorer/api/platformd/node/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const usage = `usage: platformdctl [flags] <command>
+
+commands:
+  reconciler  print the reconcile queue, attempts, port allocations and statuses
+  node        print the health of the system workloads and the node capacity
+  workloads   print the workloads present on the node, filtered by the -type,
+              -chunk, -flavor and -flavor-version flags
+  debug profile
+              download a runtime profile from the pprof server of platformd,
+              see the -profile, -seconds, -out, -pprof-addr and -pprof-token-file
+              flags. platformd has to be started with -pprof-listen-addr.
+
+flags:
+`
+
+func main() {
+	fs := flag.NewFlagSet("platformdctl", flag.ExitOnError)
+	var (
+		sock    = fs.String("sock", "/run/platformd/platformd.sock", "path to the management socket of platformd")
+		timeout = fs.Duration("timeout", 5*time.Second, "timeout of the request")
+
+		wlType        = fs.String("type", "", "only list workloads of this type (instance, system or checkpoint)")
+		chunkID       = fs.String("chunk", "", "only list workloads of this chunk")
+		flavorID      = fs.String("flavor", "", "only list workloads of this flavor")
+		flavorVersion = fs.String("flavor-version", "", "only list workloads of this flavor version")
+
+		pprofAddr      = fs.String("pprof-addr", "127.0.0.1:6060", "address of the pprof server of platformd")
+		pprofTokenFile = fs.String("pprof-token-file", "", "path to a file containing the token of the pprof server")
+		profile        = fs.String("profile", "profile", "profile to download, e.g. profile (cpu), heap, goroutine or trace")
+		seconds        = fs.Int("seconds", 30, "duration of cpu profiles and traces")
+		outFile        = fs.String("out", "", "file the profile is written to. defaults to <profile>.pprof")
+	)
+
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		die("failed to parse flags", err)
+	}
+
+	if fs.NArg() == 2 && fs.Arg(0) == "debug" && fs.Arg(1) == "profile" {
+		if *outFile == "" {
+			*outFile = *profile + ".pprof"
+		}
+
+		// the request takes at least as long as the profile is recorded
+		ctx, cancel := context.WithTimeout(context.Background(), *timeout+time.Duration(*seconds)*time.Second)
+		defer cancel()
+
+		if err := downloadProfile(ctx, *pprofAddr, *pprofTokenFile, *profile, *seconds, *outFile); err != nil {
+			cancel()
+			die("failed to download profile", err)
+		}
+
+		fmt.Println("wrote profile to", *outFile)
+		return
+	}
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	conn, err := grpc.NewClient("unix://"+*sock, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		die("create client", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	var resp proto.Message
+	switch fs.Arg(0) {
+	case "reconciler":
+		resp, err = debugv1alpha1.NewDebugServiceClient(conn).GetReconcilerState(
+			ctx,
+			&debugv1alpha1.GetReconcilerStateRequest{},
+		)
+	case "node":
+		resp, err = nodev1alpha1.NewNodeServiceClient(conn).NodeStatus(ctx, &nodev1alpha1.NodeStatusRequest{})
+	case "workloads":
+		resp, err = debugv1alpha1.NewDebugServiceClient(conn).ListWorkloads(ctx, &debugv1alpha1.ListWorkloadsRequest{
+			Type:            *wlType,
+			ChunkId:         *chunkID,
+			FlavorId:        *flavorID,
+			FlavorVersionId: *flavorVersion,
+		})
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		die("request failed", err)
+	}
+
+	out, err := protojson.MarshalOptions{Multiline: true}.Marshal(resp)
+	if err != nil {
+		die("marshal response", err)
+	}
+
+	fmt.Println(string(out))
+}
+
+func die(msg string, err error) {
+	fmt.Println(msg, err)
+	os.Exit(1)
+}
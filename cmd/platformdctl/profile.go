@@ -0,0 +1,93 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spacechunks/explorer/internal/diagnostics"
+)
+
+// downloadProfile fetches a profile from the pprof server of platformd and writes
+// it to out, so it can be inspected using go tool pprof or go tool trace.
+func downloadProfile(
+	ctx context.Context,
+	addr string,
+	tokenFile string,
+	profile string,
+	seconds int,
+	out string,
+) error {
+	token, err := diagnostics.ReadToken(tokenFile)
+	if err != nil {
+		return err
+	}
+
+	u := url.URL{
+		Scheme: "http",
+		Host:   addr,
+		Path:   "/debug/pprof/" + profile,
+	}
+
+	// cpu profiles and traces are recorded for the given duration,
+	// all other profiles are a snapshot of the current state.
+	if profile == "profile" || profile == "trace" {
+		u.RawQuery = url.Values{"seconds": {strconv.Itoa(seconds)}}.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request profile: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	f, err := os.Create(out)
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write profile: %w", err)
+	}
+
+	return f.Close()
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/peterbourgon/ff/v3"
 	workloadv1alpha2 "github.com/spacechunks/explorer/api/platformd/workload/v1alpha2"
+	"github.com/spacechunks/explorer/internal/grpcclient"
 	"github.com/spacechunks/explorer/mds"
 	"github.com/spacechunks/explorer/servermon"
 	"google.golang.org/grpc"
@@ -40,8 +41,9 @@ func main() {
 		os.Exit(1)
 	}
 
-	conn, err := grpc.NewClient(
+	conn, err := grpcclient.New(
 		*platformdListenSock,
+		grpcclient.DefaultConfig,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/v4/jwa"
 	"github.com/lestrrat-go/jwx/v4/jwt"
 )
@@ -46,6 +47,7 @@ func main() {
 
 	iss := time.Now()
 	apiTok, err := jwt.NewBuilder().
+		JwtID(uuid.NewString()).
 		IssuedAt(iss).
 		Issuer(*issuer).
 		Audience([]string{*issuer}).
@@ -0,0 +1,59 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cni
+
+import (
+	"time"
+
+	"github.com/spacechunks/explorer/internal/grpcclient"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// platformdConnectBackoff is used when (re)connecting to platformd.
+// platformd may be briefly unavailable while it is restarting, so
+// reconnect attempts start out fast and are capped at a couple of seconds.
+var platformdConnectBackoff = backoff.Config{
+	BaseDelay:  100 * time.Millisecond,
+	Multiplier: 1.6,
+	Jitter:     0.2,
+	MaxDelay:   2 * time.Second,
+}
+
+// DialPlatformd creates a client connection to platformd's management socket.
+// RPCs issued on the returned connection wait until platformd is reachable
+// instead of failing immediately, bounded by the per-call timeout.
+func DialPlatformd(sock string) (*grpc.ClientConn, error) {
+	// netglue only lives as long as a single cni invocation,
+	// so there is no need to keep the connection alive.
+	cfg := grpcclient.DefaultConfig
+	cfg.KeepaliveTime = 0
+
+	return grpcclient.New(
+		sock,
+		cfg,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           platformdConnectBackoff,
+			MinConnectTimeout: time.Second,
+		}),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+	)
+}
@@ -20,6 +20,7 @@ package cni
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -46,6 +47,10 @@ var (
 type Conf struct {
 	types.NetConf
 	PlatformdListenSock string `json:"platformdListenSock"`
+
+	// StateDir is where the results of ADD are persisted.
+	// Defaults to DefaultStateDir.
+	StateDir string `json:"stateDir,omitempty"`
 }
 
 type CNI struct {
@@ -92,6 +97,22 @@ func (c *CNI) ExecAdd(
 		return ErrIPAMConfigNotSet
 	}
 
+	store := newStateStore(conf.StateDir)
+
+	// as per cni spec, repeated ADDs for the same container must not
+	// set up the network again, so return the result of the first ADD.
+	st, err := store.load(args.ContainerID)
+	if err == nil {
+		if st.Netns != args.Netns {
+			return fmt.Errorf("container %s already added in netns %s", args.ContainerID, st.Netns)
+		}
+		return printResult(st.Result)
+	}
+
+	if !errors.Is(err, errNoState) {
+		return fmt.Errorf("load state: %w", err)
+	}
+
 	defer func() {
 		if err != nil {
 			if err := c.handler.DeallocIPs(conf.IPAM.Type, args.StdinData); err != nil {
@@ -130,11 +151,23 @@ func (c *CNI) ExecAdd(
 		return fmt.Errorf("add full match route: %w", err)
 	}
 
-	port, err := getHostPort(ctx, wlID, wlClient)
+	status, err := getWorkloadStatus(ctx, wlID, wlClient)
 	if err != nil {
 		return fmt.Errorf("get host port: %w", err)
 	}
 
+	port := uint16(status.GetPort())
+
+	// unless cross-instance traffic has been approved for the workload,
+	// connections to addresses of other workloads are not allowed.
+	var isolated []string
+	if !status.GetCrossInstanceTraffic() {
+		isolated, err = ipamSubnets(args.StdinData)
+		if err != nil {
+			return fmt.Errorf("get ipam subnets: %w", err)
+		}
+	}
+
 	if err := c.handler.AddDNATTarget(veth, port); err != nil {
 		return fmt.Errorf("add dnat target: %w", err)
 	}
@@ -147,8 +180,9 @@ func (c *CNI) ExecAdd(
 	}
 
 	if _, err := proxyClient.CreateListeners(ctx, &proxyv1alpha1.CreateListenersRequest{
-		WorkloadID: wlID,
-		Ip:         veth.HostPeer.Addr.String(),
+		WorkloadID:       wlID,
+		Ip:               veth.HostPeer.Addr.String(),
+		IsolatedPrefixes: isolated,
 	}); err != nil {
 		return fmt.Errorf("create proxy listeners: %w", err)
 	}
@@ -161,15 +195,32 @@ func (c *CNI) ExecAdd(
 				Sandbox: args.Netns,
 			},
 		},
+		IPs: []*current.IPConfig{
+			{
+				Interface: current.Int(0),
+				Address:   ips[1],
+			},
+		},
 	}
 
-	if err := result.PrintTo(os.Stdout); err != nil {
-		return fmt.Errorf("print result: %w", err)
+	if err := store.save(args.ContainerID, containerState{
+		Netns:      args.Netns,
+		WorkloadID: wlID,
+		Veth:       veth,
+		HostPort:   port,
+		BPFPins:    datapath.VethPinPaths(veth),
+		Result:     result,
+	}); err != nil {
+		return fmt.Errorf("save state: %w", err)
 	}
 
-	return nil
+	return printResult(result)
 }
 
+// ExecDel tears down everything set up by ExecAdd. the state persisted
+// during ADD is used, so cleaning up works even if platformd is not
+// reachable. only if there is no state, platformd is asked for the
+// workloads host port to look up the veth pair.
 func (c *CNI) ExecDel(
 	args *skel.CmdArgs,
 	conf Conf,
@@ -179,59 +230,104 @@ func (c *CNI) ExecDel(
 	// as per cni spec, do not fail if we encounter an error here. log the errors instead.
 	// see https://www.cni.dev/docs/spec/#del-remove-container-from-network-or-un-apply-modifications
 
+	var (
+		errs  = make([]error, 0)
+		ctx   = context.Background()
+		store = newStateStore(conf.StateDir)
+	)
+
+	st, err := store.load(args.ContainerID)
+	if err != nil {
+		if !errors.Is(err, errNoState) {
+			errs = append(errs, fmt.Errorf("load state: %w", err))
+		}
+		st, err = c.lookupState(ctx, args, wlClient)
+	}
+
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		if err := c.handler.DelFullMatchRoute(st.Veth); err != nil {
+			errs = append(errs, fmt.Errorf("delete full match route: %w", err))
+		}
+
+		if err := c.handler.DelMapEntries(st.Veth, st.HostPort); err != nil {
+			errs = append(errs, fmt.Errorf("delete map entries: %w", err))
+		}
+
+		if err := c.handler.DeallocVethPair(st.Veth); err != nil {
+			errs = append(errs, fmt.Errorf("deallocate veth pair: %w", err))
+		}
+
+		if err := c.handler.UnpinBPF(st.BPFPins); err != nil {
+			errs = append(errs, fmt.Errorf("unpin bpf: %w", err))
+		}
+
+		// talk to platformd last, so local cleanup is not
+		// delayed in case platformd is not reachable.
+		if _, err := proxyClient.DeleteListeners(ctx, &proxyv1alpha1.DeleteListenersRequest{
+			WorkloadID: st.WorkloadID,
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("delete listeners: %w", err))
+		}
+	}
+
+	if err := c.handler.DeallocIPs(conf.IPAM.Type, args.StdinData); err != nil {
+		errs = append(errs, fmt.Errorf("deallocate ips: %w", err))
+	}
+
+	if err := store.remove(args.ContainerID); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		printErrs(errs...)
+	}
+
+	return nil
+}
+
+// lookupState reconstructs the state of containers for which no state
+// has been persisted, for example because they have been added by an
+// older version of netglue.
+func (c *CNI) lookupState(
+	ctx context.Context,
+	args *skel.CmdArgs,
+	wlClient workloadv1alpha2.WorkloadServiceClient,
+) (containerState, error) {
 	cniArgs, err := parseArgs(args.Args)
 	if err != nil {
-		printErrs(fmt.Errorf("CNI_ARGS parse error: %w", err))
-		return nil
+		return containerState{}, fmt.Errorf("CNI_ARGS parse error: %w", err)
 	}
 
 	// workload service sets the pod uid to the workloads ID
 	wlID, ok := cniArgs["K8S_POD_UID"]
 	if !ok {
-		printErrs(ErrPodUIDMissing)
-		return nil
+		return containerState{}, ErrPodUIDMissing
 	}
 
-	var (
-		errs = make([]error, 0)
-		ctx  = context.Background()
-	)
-
 	port, err := getHostPort(ctx, wlID, wlClient)
 	if err != nil {
-		printErrs(fmt.Errorf("get host port: %w", err))
-		return nil
+		return containerState{}, fmt.Errorf("get host port: %w", err)
 	}
 
 	veth, err := c.handler.GetVethPair(port)
 	if err != nil {
-		printErrs(fmt.Errorf("get veth pair: %w", err))
-		return nil
+		return containerState{}, fmt.Errorf("get veth pair: %w", err)
 	}
 
-	if err := c.handler.DelFullMatchRoute(veth); err != nil {
-		errs = append(errs, fmt.Errorf("delete full match route: %w", err))
-	}
-
-	if _, err := proxyClient.DeleteListeners(ctx, &proxyv1alpha1.DeleteListenersRequest{
+	return containerState{
 		WorkloadID: wlID,
-	}); err != nil {
-		errs = append(errs, fmt.Errorf("delete listeners: %w", err))
-	}
-
-	if err := c.handler.DelMapEntries(veth, port); err != nil {
-		errs = append(errs, fmt.Errorf("delete map entries: %w", err))
-	}
-
-	if err := c.handler.DeallocVethPair(veth); err != nil {
-		errs = append(errs, fmt.Errorf("deallocate veth pair: %w", err))
-	}
+		Veth:       veth,
+		HostPort:   port,
+		BPFPins:    datapath.VethPinPaths(veth),
+	}, nil
+}
 
-	if err := c.handler.DeallocIPs(conf.IPAM.Type, args.StdinData); err != nil {
-		errs = append(errs, fmt.Errorf("deallocate ips: %w", err))
+func printResult(result *current.Result) error {
+	if err := result.PrintTo(os.Stdout); err != nil {
+		return fmt.Errorf("print result: %w", err)
 	}
-
-	printErrs(errs...)
 	return nil
 }
 
@@ -245,18 +341,68 @@ func getHostPort(
 	workloadID string,
 	wlClient workloadv1alpha2.WorkloadServiceClient,
 ) (uint16, error) {
+	status, err := getWorkloadStatus(ctx, workloadID, wlClient)
+	if err != nil {
+		return 0, err
+	}
+	return uint16(status.GetPort()), nil
+}
+
+// getWorkloadStatus returns the status of the workload. an error is
+// returned if no port has been assigned to the workload yet.
+func getWorkloadStatus(
+	ctx context.Context,
+	workloadID string,
+	wlClient workloadv1alpha2.WorkloadServiceClient,
+) (*workloadv1alpha2.WorkloadStatus, error) {
 	resp, err := wlClient.WorkloadStatus(ctx, &workloadv1alpha2.WorkloadStatusRequest{
 		Id: workloadID,
 	})
 	if err != nil {
-		return 0, fmt.Errorf("get workload status: %w", err)
+		return nil, fmt.Errorf("get workload status: %w", err)
 	}
 
 	if resp.Status.GetPort() == 0 {
-		return 0, ErrInvalidPort
+		return nil, ErrInvalidPort
+	}
+
+	return resp.Status, nil
+}
+
+// ipamSubnets returns the subnets configured for the host-local ipam
+// plugin, which are the subnets workload addresses are allocated from.
+func ipamSubnets(stdin []byte) ([]string, error) {
+	if len(stdin) == 0 {
+		return nil, nil
+	}
+
+	var conf struct {
+		IPAM struct {
+			Subnet string `json:"subnet"`
+			Ranges [][]struct {
+				Subnet string `json:"subnet"`
+			} `json:"ranges"`
+		} `json:"ipam"`
+	}
+
+	if err := json.Unmarshal(stdin, &conf); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	subnets := make([]string, 0)
+	if conf.IPAM.Subnet != "" {
+		subnets = append(subnets, conf.IPAM.Subnet)
+	}
+
+	for _, set := range conf.IPAM.Ranges {
+		for _, r := range set {
+			if r.Subnet != "" {
+				subnets = append(subnets, r.Subnet)
+			}
+		}
 	}
 
-	return uint16(resp.Status.GetPort()), nil
+	return subnets, nil
 }
 
 func parseArgs(args string) (map[string]string, error) {
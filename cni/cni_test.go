@@ -32,6 +32,9 @@ import (
 	"github.com/spacechunks/explorer/internal/mock"
 	"github.com/stretchr/testify/assert"
 	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestExecAdd(t *testing.T) {
@@ -222,7 +225,8 @@ func TestExecAdd(t *testing.T) {
 				PlatformdListenSock: "/some/path",
 			},
 			args: &skel.CmdArgs{
-				Args: "K8S_POD_UID=uuidv7",
+				ContainerID: "abc",
+				Args:        "K8S_POD_UID=uuidv7",
 			},
 			err: fmt.Errorf("configure veth pair: some error"),
 			prep: func(
@@ -248,7 +252,8 @@ func TestExecAdd(t *testing.T) {
 				PlatformdListenSock: "/some/path",
 			},
 			args: &skel.CmdArgs{
-				Args: "K8S_POD_UID=uuidv7",
+				ContainerID: "abc",
+				Args:        "K8S_POD_UID=uuidv7",
 			},
 			err: cni.ErrIPAMConfigNotSet,
 			prep: func(
@@ -263,7 +268,8 @@ func TestExecAdd(t *testing.T) {
 			name: "fail if platformd listen sock is not set",
 			conf: cni.Conf{},
 			args: &skel.CmdArgs{
-				Args: "K8S_POD_UID=uuidv7",
+				ContainerID: "abc",
+				Args:        "K8S_POD_UID=uuidv7",
 			},
 			err: cni.ErrPlatformdListenSockNotSet,
 			prep: func(
@@ -329,7 +335,8 @@ func TestExecAdd(t *testing.T) {
 				PlatformdListenSock: "/some/path",
 			},
 			args: &skel.CmdArgs{
-				Args: "K8S_POD_UID=uuidv7",
+				ContainerID: "abc",
+				Args:        "K8S_POD_UID=uuidv7",
 			},
 			err: cni.ErrInsufficientAddresses,
 			prep: func(
@@ -355,6 +362,7 @@ func TestExecAdd(t *testing.T) {
 				wlc = mock.NewMockV1alpha2WorkloadServiceClient(t)
 				c   = cni.NewCNI(h)
 			)
+			tt.conf.StateDir = t.TempDir()
 			tt.prep(h, tt.args, psc, wlc)
 			err := c.ExecAdd(tt.args, tt.conf, psc, wlc)
 			if err != nil && tt.err != nil {
@@ -365,3 +373,324 @@ func TestExecAdd(t *testing.T) {
 		})
 	}
 }
+
+func TestExecAddReturnsCachedResult(t *testing.T) {
+	var (
+		h    = mock.NewMockCniHandler(t)
+		psc  = mock.NewMockV1alpha1ProxyServiceClient(t)
+		wlc  = mock.NewMockV1alpha2WorkloadServiceClient(t)
+		c    = cni.NewCNI(h)
+		conf = testConf(t)
+		args = testArgs()
+	)
+
+	// every expectation is only allowed to be met once,
+	// so the second ADD must not touch anything.
+	expectAdd(h, psc, wlc, args)
+
+	require.NoError(t, c.ExecAdd(args, conf, psc, wlc))
+	require.NoError(t, c.ExecAdd(args, conf, psc, wlc))
+
+	args.Netns = "/path/to/other/netns"
+	require.Error(t, c.ExecAdd(args, conf, psc, wlc))
+}
+
+func TestExecAddIsolation(t *testing.T) {
+	tests := []struct {
+		name                 string
+		crossInstanceTraffic bool
+		expected             []string
+	}{
+		{
+			name:     "isolate workload from ipam subnets",
+			expected: []string{"10.1.0.0/16", "10.2.0.0/16"},
+		},
+		{
+			name:                 "cross-instance traffic allowed",
+			crossInstanceTraffic: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				h    = mock.NewMockCniHandler(t)
+				psc  = mock.NewMockV1alpha1ProxyServiceClient(t)
+				wlc  = mock.NewMockV1alpha2WorkloadServiceClient(t)
+				c    = cni.NewCNI(h)
+				conf = testConf(t)
+				args = testArgs()
+				veth = testVeth()
+				ips  = []net.IPNet{
+					{
+						IP:   net.ParseIP("10.1.0.1"),
+						Mask: net.CIDRMask(16, 32),
+					},
+					{
+						IP:   net.ParseIP("10.2.0.1"),
+						Mask: net.CIDRMask(16, 32),
+					},
+				}
+			)
+
+			args.StdinData = []byte(`{
+  "type": "netglue",
+  "ipam": {
+    "type": "host-local",
+    "ranges": [
+      [{"subnet": "10.1.0.0/16"}],
+      [{"subnet": "10.2.0.0/16"}]
+    ]
+  }
+}`)
+
+			h.EXPECT().AllocIPs("host-local", args.StdinData).Return(ips, nil)
+			h.EXPECT().AllocVethPair(args.Netns, ips[0], ips[1]).Return(veth, nil)
+			h.EXPECT().AttachHostVethBPF(veth).Return(nil)
+			h.EXPECT().AttachCtrVethBPF(veth, args.Netns).Return(nil)
+			h.EXPECT().AddDefaultRoute(veth, args.Netns).Return(nil)
+			h.EXPECT().AddFullMatchRoute(veth).Return(nil)
+			h.EXPECT().AddDNATTarget(veth, uint16(1337)).Return(nil)
+			h.EXPECT().
+				AddNetData(datapath.NetData{
+					Veth:     veth,
+					HostPort: 1337,
+				}).
+				Return(nil)
+
+			wlc.EXPECT().
+				WorkloadStatus(mocky.Anything, &workloadv1alpha2.WorkloadStatusRequest{
+					Id: "uuidv7",
+				}).
+				Return(&workloadv1alpha2.WorkloadStatusResponse{
+					Status: &workloadv1alpha2.WorkloadStatus{
+						Port:                 1337,
+						CrossInstanceTraffic: tt.crossInstanceTraffic,
+					},
+				}, nil)
+
+			psc.EXPECT().
+				CreateListeners(mocky.Anything, &v1alpha1.CreateListenersRequest{
+					WorkloadID:       "uuidv7",
+					Ip:               veth.HostPeer.Addr.String(),
+					IsolatedPrefixes: tt.expected,
+				}).
+				Return(nil, nil)
+
+			require.NoError(t, c.ExecAdd(args, conf, psc, wlc))
+		})
+	}
+}
+
+func TestExecDel(t *testing.T) {
+	tests := []struct {
+		name string
+		prep func(
+			*cni.CNI,
+			cni.Conf,
+			*mock.MockCniHandler,
+			*skel.CmdArgs,
+			*mock.MockV1alpha1ProxyServiceClient,
+			*mock.MockV1alpha2WorkloadServiceClient,
+		)
+	}{
+		{
+			name: "use state persisted during add",
+			prep: func(
+				c *cni.CNI,
+				conf cni.Conf,
+				h *mock.MockCniHandler,
+				args *skel.CmdArgs,
+				psc *mock.MockV1alpha1ProxyServiceClient,
+				wlc *mock.MockV1alpha2WorkloadServiceClient,
+			) {
+				veth := expectAdd(h, psc, wlc, args)
+				require.NoError(t, c.ExecAdd(args, conf, psc, wlc))
+
+				// platformd is not reachable, which must not prevent cleanup
+				expectDel(h, args, veth, psc, status.Error(codes.Unavailable, "unavailable"))
+			},
+		},
+		{
+			name: "ask platformd if there is no state",
+			prep: func(
+				_ *cni.CNI,
+				_ cni.Conf,
+				h *mock.MockCniHandler,
+				args *skel.CmdArgs,
+				psc *mock.MockV1alpha1ProxyServiceClient,
+				wlc *mock.MockV1alpha2WorkloadServiceClient,
+			) {
+				veth := testVeth()
+
+				wlc.EXPECT().
+					WorkloadStatus(mocky.Anything, &workloadv1alpha2.WorkloadStatusRequest{
+						Id: "uuidv7",
+					}).
+					Return(&workloadv1alpha2.WorkloadStatusResponse{
+						Status: &workloadv1alpha2.WorkloadStatus{
+							Port: 1337,
+						},
+					}, nil)
+
+				h.EXPECT().
+					GetVethPair(uint16(1337)).
+					Return(veth, nil)
+
+				expectDel(h, args, veth, psc, nil)
+			},
+		},
+		{
+			name: "dealloc ips if there is no state and platformd is not reachable",
+			prep: func(
+				_ *cni.CNI,
+				_ cni.Conf,
+				h *mock.MockCniHandler,
+				args *skel.CmdArgs,
+				_ *mock.MockV1alpha1ProxyServiceClient,
+				wlc *mock.MockV1alpha2WorkloadServiceClient,
+			) {
+				wlc.EXPECT().
+					WorkloadStatus(mocky.Anything, &workloadv1alpha2.WorkloadStatusRequest{
+						Id: "uuidv7",
+					}).
+					Return(nil, status.Error(codes.Unavailable, "unavailable"))
+
+				h.EXPECT().
+					DeallocIPs("host-local", args.StdinData).
+					Return(nil).
+					Once()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				h    = mock.NewMockCniHandler(t)
+				psc  = mock.NewMockV1alpha1ProxyServiceClient(t)
+				wlc  = mock.NewMockV1alpha2WorkloadServiceClient(t)
+				c    = cni.NewCNI(h)
+				conf = testConf(t)
+				args = testArgs()
+			)
+			tt.prep(c, conf, h, args, psc, wlc)
+			require.NoError(t, c.ExecDel(args, conf, psc, wlc))
+		})
+	}
+}
+
+func testConf(t *testing.T) cni.Conf {
+	return cni.Conf{
+		NetConf: types.NetConf{
+			IPAM: types.IPAM{
+				Type: "host-local",
+			},
+		},
+		PlatformdListenSock: "/some/path",
+		StateDir:            t.TempDir(),
+	}
+}
+
+func testArgs() *skel.CmdArgs {
+	return &skel.CmdArgs{
+		ContainerID: "abc",
+		Args:        "K8S_POD_UID=uuidv7",
+		Netns:       "/path/to/netns",
+	}
+}
+
+func testVeth() datapath.VethPair {
+	return datapath.VethPair{
+		HostPeer: datapath.VethPeer{
+			Iface: &net.Interface{
+				Index: 10,
+				Name:  "host",
+			},
+			Addr: net.ParseIP("10.10.0.0"),
+		},
+		PodPeer: datapath.VethPeer{
+			Iface: &net.Interface{
+				Index: 11,
+				Name:  "pod",
+			},
+			Addr: net.ParseIP("10.20.0.0"),
+		},
+	}
+}
+
+func expectAdd(
+	h *mock.MockCniHandler,
+	psc *mock.MockV1alpha1ProxyServiceClient,
+	wlc *mock.MockV1alpha2WorkloadServiceClient,
+	args *skel.CmdArgs,
+) datapath.VethPair {
+	var (
+		ips = []net.IPNet{
+			{
+				IP:   net.ParseIP("10.10.0.0"),
+				Mask: net.CIDRMask(24, 32),
+			},
+			{
+				IP:   net.ParseIP("10.20.0.0"),
+				Mask: net.CIDRMask(24, 32),
+			},
+		}
+		veth = testVeth()
+	)
+
+	h.EXPECT().AllocIPs("host-local", args.StdinData).Return(ips, nil).Once()
+	h.EXPECT().AllocVethPair(args.Netns, ips[0], ips[1]).Return(veth, nil).Once()
+	h.EXPECT().AttachHostVethBPF(veth).Return(nil).Once()
+	h.EXPECT().AttachCtrVethBPF(veth, args.Netns).Return(nil).Once()
+	h.EXPECT().AddDefaultRoute(veth, args.Netns).Return(nil).Once()
+	h.EXPECT().AddFullMatchRoute(veth).Return(nil).Once()
+	h.EXPECT().AddDNATTarget(veth, uint16(1337)).Return(nil).Once()
+	h.EXPECT().
+		AddNetData(datapath.NetData{
+			Veth:     veth,
+			HostPort: 1337,
+		}).
+		Return(nil).
+		Once()
+
+	wlc.EXPECT().
+		WorkloadStatus(mocky.Anything, &workloadv1alpha2.WorkloadStatusRequest{
+			Id: "uuidv7",
+		}).
+		Return(&workloadv1alpha2.WorkloadStatusResponse{
+			Status: &workloadv1alpha2.WorkloadStatus{
+				Port: 1337,
+			},
+		}, nil).
+		Once()
+
+	psc.EXPECT().
+		CreateListeners(mocky.Anything, &v1alpha1.CreateListenersRequest{
+			WorkloadID: "uuidv7",
+			Ip:         veth.HostPeer.Addr.String(),
+		}).
+		Return(nil, nil).
+		Once()
+
+	return veth
+}
+
+func expectDel(
+	h *mock.MockCniHandler,
+	args *skel.CmdArgs,
+	veth datapath.VethPair,
+	psc *mock.MockV1alpha1ProxyServiceClient,
+	deleteListenersErr error,
+) {
+	h.EXPECT().DelFullMatchRoute(veth).Return(nil).Once()
+	h.EXPECT().DelMapEntries(veth, uint16(1337)).Return(nil).Once()
+	h.EXPECT().DeallocVethPair(veth).Return(nil).Once()
+	h.EXPECT().UnpinBPF(datapath.VethPinPaths(veth)).Return(nil).Once()
+	h.EXPECT().DeallocIPs("host-local", args.StdinData).Return(nil).Once()
+
+	psc.EXPECT().
+		DeleteListeners(mocky.Anything, &v1alpha1.DeleteListenersRequest{
+			WorkloadID: "uuidv7",
+		}).
+		Return(nil, deleteListenersErr).
+		Once()
+}
@@ -20,14 +20,17 @@ package cni
 
 import (
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"net"
+	"os"
 
 	"github.com/spacechunks/explorer/internal/datapath"
 
 	current "github.com/containernetworking/cni/pkg/types/100"
 	"github.com/containernetworking/plugins/pkg/ipam"
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/hashicorp/go-multierror"
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 )
@@ -57,6 +60,10 @@ type Handler interface {
 	DelFullMatchRoute(veth datapath.VethPair) error
 	DelMapEntries(veth datapath.VethPair, hostPort uint16) error
 	DeallocVethPair(veth datapath.VethPair) error
+
+	// UnpinBPF removes the given pinned bpf links. paths
+	// that do not exist anymore are ignored.
+	UnpinBPF(paths []string) error
 }
 
 type cniHandler struct {
@@ -284,6 +291,16 @@ func (h *cniHandler) DelMapEntries(veth datapath.VethPair, hostPort uint16) erro
 	return nil
 }
 
+func (h *cniHandler) UnpinBPF(paths []string) error {
+	var errs *multierror.Error
+	for _, p := range paths {
+		if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = multierror.Append(errs, fmt.Errorf("unpin %s: %w", p, err))
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
 func fullMatchRoute(veth datapath.VethPair) *netlink.Route {
 	cidr := &net.IPNet{
 		IP:   veth.PodPeer.Addr,
@@ -0,0 +1,133 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cni
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	current "github.com/containernetworking/cni/pkg/types/100"
+	"github.com/spacechunks/explorer/internal/datapath"
+)
+
+// DefaultStateDir is used if no state directory is configured.
+const DefaultStateDir = "/var/lib/cni/netglue"
+
+var errNoState = errors.New("no state for container")
+
+// containerState contains everything that has been set up during ADD.
+// it is persisted so repeated ADDs can return the same result and DEL
+// is able to clean up without having to ask platformd.
+type containerState struct {
+	Netns      string            `json:"netns"`
+	WorkloadID string            `json:"workloadID"`
+	Veth       datapath.VethPair `json:"veth"`
+	HostPort   uint16            `json:"hostPort"`
+	BPFPins    []string          `json:"bpfPins"`
+	Result     *current.Result   `json:"result"`
+}
+
+type stateStore struct {
+	dir string
+}
+
+func newStateStore(dir string) stateStore {
+	if dir == "" {
+		dir = DefaultStateDir
+	}
+	return stateStore{
+		dir: dir,
+	}
+}
+
+func (s stateStore) path(containerID string) (string, error) {
+	if containerID == "" || strings.ContainsAny(containerID, `/\`) || containerID == ".." {
+		return "", fmt.Errorf("invalid container id %q", containerID)
+	}
+	return filepath.Join(s.dir, containerID+".json"), nil
+}
+
+func (s stateStore) load(containerID string) (containerState, error) {
+	p, err := s.path(containerID)
+	if err != nil {
+		return containerState{}, err
+	}
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return containerState{}, errNoState
+		}
+		return containerState{}, fmt.Errorf("read state: %w", err)
+	}
+
+	var st containerState
+	if err := json.Unmarshal(data, &st); err != nil {
+		return containerState{}, fmt.Errorf("unmarshal state: %w", err)
+	}
+
+	return st, nil
+}
+
+// save writes the state to a temporary file first and renames
+// it afterward, so a crash never leaves a partially written file.
+func (s stateStore) save(containerID string, st containerState) error {
+	p, err := s.path(containerID)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(st)
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return fmt.Errorf("create state dir: %w", err)
+	}
+
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+
+	if err := os.Rename(tmp, p); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("rename state: %w", err)
+	}
+
+	return nil
+}
+
+func (s stateStore) remove(containerID string) error {
+	p, err := s.path(containerID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove state: %w", err)
+	}
+
+	return nil
+}
@@ -16,39 +16,17 @@
  along with this program.  If not, see <https://www.gnu.org/licenses/>.
 */
 
-package garbage_test
+package announcement
 
 import (
 	"context"
-	"log/slog"
-	"os"
-	"testing"
 	"time"
 
-	"github.com/spacechunks/explorer/platformd/garbage"
-	"github.com/stretchr/testify/assert"
+	"github.com/spacechunks/explorer/internal/resource"
 )
 
-type testCollector struct {
-	called bool
-}
-
-func (tc *testCollector) CollectGarbage(ctx context.Context) error {
-	tc.called = true
-	return nil
-}
-
-func TestGC(t *testing.T) {
-	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
-	tc := &testCollector{}
-	gc := garbage.NewExecutor(logger, 100*time.Millisecond, tc)
-
-	go func() {
-		time.Sleep(200 * time.Millisecond)
-		gc.Stop()
-	}()
-
-	gc.Run(context.Background())
-
-	assert.True(t, tc.called)
+type Repository interface {
+	CreateAnnouncement(ctx context.Context, a resource.Announcement) (resource.Announcement, error)
+	ActiveAnnouncements(ctx context.Context, now time.Time) ([]resource.Announcement, error)
+	DeleteAnnouncement(ctx context.Context, id string) error
 }
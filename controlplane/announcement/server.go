@@ -0,0 +1,101 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package announcement
+
+import (
+	"context"
+
+	announcementv1alpha1 "github.com/spacechunks/explorer/api/announcement/v1alpha1"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/internal/resource/codec"
+)
+
+type Server struct {
+	announcementv1alpha1.UnimplementedAnnouncementServiceServer
+	service Service
+}
+
+func NewServer(service Service) *Server {
+	return &Server{
+		service: service,
+	}
+}
+
+func (s *Server) ListAnnouncements(
+	ctx context.Context,
+	_ *announcementv1alpha1.ListAnnouncementsRequest,
+) (*announcementv1alpha1.ListAnnouncementsResponse, error) {
+	as, err := s.service.ListAnnouncements(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*announcementv1alpha1.Announcement, 0, len(as))
+	for _, a := range as {
+		ret = append(ret, codec.AnnouncementToTransport(a))
+	}
+
+	return &announcementv1alpha1.ListAnnouncementsResponse{
+		Announcements: ret,
+	}, nil
+}
+
+func (s *Server) CreateAnnouncement(
+	ctx context.Context,
+	req *announcementv1alpha1.CreateAnnouncementRequest,
+) (*announcementv1alpha1.CreateAnnouncementResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	a := resource.Announcement{
+		Message:  req.GetMessage(),
+		Severity: resource.AnnouncementSeverity(req.GetSeverity().String()),
+	}
+
+	if req.StartsAt != nil {
+		a.StartsAt = req.GetStartsAt().AsTime()
+	}
+
+	if req.EndsAt != nil {
+		endsAt := req.GetEndsAt().AsTime()
+		a.EndsAt = &endsAt
+	}
+
+	created, err := s.service.CreateAnnouncement(ctx, actorID, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &announcementv1alpha1.CreateAnnouncementResponse{
+		Announcement: codec.AnnouncementToTransport(created),
+	}, nil
+}
+
+func (s *Server) DeleteAnnouncement(
+	ctx context.Context,
+	req *announcementv1alpha1.DeleteAnnouncementRequest,
+) (*announcementv1alpha1.DeleteAnnouncementResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	if err := s.service.DeleteAnnouncement(ctx, actorID, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &announcementv1alpha1.DeleteAnnouncementResponse{}, nil
+}
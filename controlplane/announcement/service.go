@@ -0,0 +1,114 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package announcement
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+type Service interface {
+	// ListAnnouncements returns the announcements that are currently shown.
+	ListAnnouncements(ctx context.Context) ([]resource.Announcement, error)
+
+	// CreateAnnouncement publishes the announcement. if StartsAt is not
+	// set, the announcement is shown right away.
+	CreateAnnouncement(
+		ctx context.Context,
+		actorID string,
+		a resource.Announcement,
+	) (resource.Announcement, error)
+	DeleteAnnouncement(ctx context.Context, actorID string, id string) error
+}
+
+type svc struct {
+	logger       *slog.Logger
+	repo         Repository
+	adminUserIDs []string
+}
+
+func NewService(logger *slog.Logger, repo Repository, adminUserIDs []string) Service {
+	return &svc{
+		logger:       logger,
+		repo:         repo,
+		adminUserIDs: adminUserIDs,
+	}
+}
+
+func (s *svc) ListAnnouncements(ctx context.Context) ([]resource.Announcement, error) {
+	ret, err := s.repo.ActiveAnnouncements(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("active announcements: %w", err)
+	}
+	return ret, nil
+}
+
+func (s *svc) CreateAnnouncement(
+	ctx context.Context,
+	actorID string,
+	a resource.Announcement,
+) (resource.Announcement, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return resource.Announcement{}, apierrs.ErrPermissionDenied
+	}
+
+	if a.StartsAt.IsZero() {
+		a.StartsAt = time.Now()
+	}
+
+	if a.EndsAt != nil && !a.EndsAt.After(a.StartsAt) {
+		return resource.Announcement{}, apierrs.ErrInvalidAnnouncementTimes
+	}
+
+	a.CreatedBy = actorID
+
+	created, err := s.repo.CreateAnnouncement(ctx, a)
+	if err != nil {
+		return resource.Announcement{}, fmt.Errorf("create announcement: %w", err)
+	}
+
+	s.logger.InfoContext(
+		ctx,
+		"announcement created",
+		"announcement_id", created.ID,
+		"severity", created.Severity,
+		"actor_id", actorID,
+	)
+
+	return created, nil
+}
+
+func (s *svc) DeleteAnnouncement(ctx context.Context, actorID string, id string) error {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return apierrs.ErrPermissionDenied
+	}
+
+	if err := s.repo.DeleteAnnouncement(ctx, id); err != nil {
+		return fmt.Errorf("delete announcement: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "announcement deleted", "announcement_id", id, "actor_id", actorID)
+	return nil
+}
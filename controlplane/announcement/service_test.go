@@ -0,0 +1,190 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package announcement_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/announcement"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const adminID = "admin"
+
+func TestCreateAnnouncement(t *testing.T) {
+	var (
+		startsAt = time.Date(2026, 10, 20, 8, 0, 0, 0, time.UTC)
+		endsAt   = startsAt.Add(2 * time.Hour)
+	)
+
+	tests := []struct {
+		name     string
+		actorID  string
+		input    resource.Announcement
+		expected resource.Announcement
+		err      error
+		prep     func(*mock.MockAnnouncementRepository, resource.Announcement)
+	}{
+		{
+			name:    "admin can create announcement",
+			actorID: adminID,
+			input: resource.Announcement{
+				Message:  "scheduled maintenance",
+				Severity: resource.AnnouncementSeverityWarning,
+				StartsAt: startsAt,
+				EndsAt:   &endsAt,
+			},
+			expected: resource.Announcement{
+				ID:        "019df3b1-6c2a-7e4d-9b1f-2a3c4d5e6f70",
+				Message:   "scheduled maintenance",
+				Severity:  resource.AnnouncementSeverityWarning,
+				StartsAt:  startsAt,
+				EndsAt:    &endsAt,
+				CreatedBy: adminID,
+			},
+			prep: func(repo *mock.MockAnnouncementRepository, expected resource.Announcement) {
+				repo.EXPECT().
+					CreateAnnouncement(mocky.Anything, mocky.MatchedBy(func(a resource.Announcement) bool {
+						return a.CreatedBy == adminID && a.StartsAt.Equal(startsAt)
+					})).
+					Return(expected, nil)
+			},
+		},
+		{
+			name:    "starts now if start is not set",
+			actorID: adminID,
+			input: resource.Announcement{
+				Message:  "new feature",
+				Severity: resource.AnnouncementSeverityInfo,
+			},
+			expected: resource.Announcement{
+				ID:        "019df3b1-6c2a-7e4d-9b1f-2a3c4d5e6f71",
+				Message:   "new feature",
+				Severity:  resource.AnnouncementSeverityInfo,
+				CreatedBy: adminID,
+			},
+			prep: func(repo *mock.MockAnnouncementRepository, expected resource.Announcement) {
+				repo.EXPECT().
+					CreateAnnouncement(mocky.Anything, mocky.MatchedBy(func(a resource.Announcement) bool {
+						return time.Since(a.StartsAt) < time.Minute
+					})).
+					Return(expected, nil)
+			},
+		},
+		{
+			name:    "end before start",
+			actorID: adminID,
+			input: resource.Announcement{
+				Message:  "scheduled maintenance",
+				Severity: resource.AnnouncementSeverityWarning,
+				StartsAt: endsAt,
+				EndsAt:   &startsAt,
+			},
+			err:  apierrs.ErrInvalidAnnouncementTimes,
+			prep: func(*mock.MockAnnouncementRepository, resource.Announcement) {},
+		},
+		{
+			name:    "other users are not allowed",
+			actorID: "someone",
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockAnnouncementRepository, resource.Announcement) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockAnnouncementRepository(t)
+				svc  = announcement.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID})
+			)
+
+			tt.prep(repo, tt.expected)
+
+			actual, err := svc.CreateAnnouncement(ctx, tt.actorID, tt.input)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestDeleteAnnouncement(t *testing.T) {
+	const id = "019df3b1-6c2a-7e4d-9b1f-2a3c4d5e6f70"
+
+	tests := []struct {
+		name    string
+		actorID string
+		err     error
+		prep    func(*mock.MockAnnouncementRepository)
+	}{
+		{
+			name:    "admin can delete announcement",
+			actorID: adminID,
+			prep: func(repo *mock.MockAnnouncementRepository) {
+				repo.EXPECT().DeleteAnnouncement(mocky.Anything, id).Return(nil)
+			},
+		},
+		{
+			name:    "not found",
+			actorID: adminID,
+			err:     apierrs.ErrNotFound,
+			prep: func(repo *mock.MockAnnouncementRepository) {
+				repo.EXPECT().DeleteAnnouncement(mocky.Anything, id).Return(apierrs.ErrNotFound)
+			},
+		},
+		{
+			name:    "other users are not allowed",
+			actorID: "someone",
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockAnnouncementRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockAnnouncementRepository(t)
+				svc  = announcement.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID})
+			)
+
+			tt.prep(repo)
+
+			err := svc.DeleteAnnouncement(ctx, tt.actorID, id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,335 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package autoscaler adjusts the number of instances of flavors that have an
+// autoscaling policy to the number of players that want to play them.
+package autoscaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// OrderedBy is set on instances started by the autoscaler,
+// so they can be told apart from instances started by hand.
+const OrderedBy = "autoscaler"
+
+type Config struct {
+	// Interval in which the demand of all autoscaled flavors is checked.
+	Interval time.Duration
+
+	// DemandWindow is the duration players that could not join
+	// a flavor are counted as queued players.
+	DemandWindow time.Duration
+}
+
+type Autoscaler struct {
+	logger    *slog.Logger
+	repo      Repository
+	chunkRepo chunk.Repository
+	instances Instances
+	cfg       Config
+	metrics   metrics
+}
+
+func New(
+	logger *slog.Logger,
+	repo Repository,
+	chunkRepo chunk.Repository,
+	instances Instances,
+	cfg Config,
+) (*Autoscaler, error) {
+	m, err := initMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Autoscaler{
+		logger:    logger,
+		repo:      repo,
+		chunkRepo: chunkRepo,
+		instances: instances,
+		cfg:       cfg,
+		metrics:   m,
+	}, nil
+}
+
+// Run scales all autoscaled flavors in the configured interval until ctx is
+// canceled. it must only run on a single replica, see [leader.Task].
+func (a *Autoscaler) Run(ctx context.Context) {
+	t := time.NewTicker(a.cfg.Interval)
+	defer t.Stop()
+
+	for {
+		if err := a.Reconcile(ctx); err != nil {
+			a.logger.ErrorContext(ctx, "failed to scale flavors", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Reconcile scales all autoscaled flavors once. errors of a single flavor
+// are only logged, so they do not prevent other flavors from being scaled.
+func (a *Autoscaler) Reconcile(ctx context.Context) error {
+	var (
+		now   = time.Now()
+		since = now.Add(-a.cfg.DemandWindow)
+	)
+
+	targets, err := a.repo.AutoscalingTargets(ctx)
+	if err != nil {
+		return fmt.Errorf("autoscaling targets: %w", err)
+	}
+
+	for _, t := range targets {
+		logger := a.logger.With("flavor_id", t.Policy.FlavorID)
+		if err := a.scale(ctx, logger, t, now, since); err != nil {
+			logger.ErrorContext(ctx, "failed to scale flavor", "err", err)
+		}
+	}
+
+	if err := a.repo.DeleteJoinMissesBefore(ctx, since); err != nil {
+		return fmt.Errorf("delete join misses: %w", err)
+	}
+
+	return nil
+}
+
+func (a *Autoscaler) scale(ctx context.Context, logger *slog.Logger, t Target, now time.Time, since time.Time) error {
+	flavorID := t.Policy.FlavorID
+
+	flavor, err := a.chunkRepo.FlavorByID(ctx, flavorID)
+	if err != nil {
+		return fmt.Errorf("flavor: %w", err)
+	}
+
+	demand, err := a.repo.FlavorDemand(ctx, flavorID, since)
+	if err != nil {
+		return fmt.Errorf("demand: %w", err)
+	}
+
+	instances, err := a.repo.AutoscaledInstances(ctx, flavorID)
+	if err != nil {
+		return fmt.Errorf("autoscaled instances: %w", err)
+	}
+
+	version, ok, err := a.scaledVersion(ctx, flavor)
+	if err != nil {
+		return fmt.Errorf("scaled version: %w", err)
+	}
+
+	if !ok {
+		a.record(ctx, logger, flavorID, demand, Decision{
+			Direction: DirectionNone,
+			Reason:    ReasonNoVersion,
+			Current:   uint32(len(instances)),
+		})
+		return nil
+	}
+
+	d := decide(t.Policy, demand, instances, version.MaxPlayers, now)
+	a.record(ctx, logger, flavorID, demand, d)
+
+	switch d.Direction {
+	case DirectionUp:
+		return a.scaleUp(ctx, logger, t, version, d.Start, now)
+	case DirectionDown:
+		return a.scaleDown(ctx, logger, flavorID, instances, d.Stop, now)
+	default:
+		return nil
+	}
+}
+
+func (a *Autoscaler) scaleUp(
+	ctx context.Context,
+	logger *slog.Logger,
+	t Target,
+	version resource.FlavorVersion,
+	count uint32,
+	now time.Time,
+) error {
+	var started int
+	for range count {
+		ins, err := a.instances.RunFlavorVersion(ctx, version.ID, t.OwnerID, OrderedBy, resource.InstanceParams{}, "")
+		if err != nil {
+			// placement failures are likely to fail for the remaining
+			// instances as well, so try again in the next interval.
+			logger.ErrorContext(ctx, "failed to start instance", "flavor_version_id", version.ID, "err", err)
+			break
+		}
+
+		started++
+
+		if err := a.repo.InsertAutoscaledInstance(ctx, t.Policy.FlavorID, ins.ID); err != nil {
+			// the instance is treated like an instance started by
+			// hand, so it keeps serving players, but is never stopped.
+			logger.ErrorContext(ctx, "failed to record autoscaled instance", "instance_id", ins.ID, "err", err)
+		}
+	}
+
+	if started == 0 {
+		return nil
+	}
+
+	a.metrics.scaledInstances.Add(ctx, int64(started), metric.WithAttributes(
+		attribute.String("flavor_id", t.Policy.FlavorID),
+		attribute.String("direction", string(DirectionUp)),
+	))
+
+	if err := a.repo.RecordScaling(ctx, t.Policy.FlavorID, DirectionUp, now); err != nil {
+		return fmt.Errorf("record scaling: %w", err)
+	}
+
+	return nil
+}
+
+func (a *Autoscaler) scaleDown(
+	ctx context.Context,
+	logger *slog.Logger,
+	flavorID string,
+	instances []Instance,
+	stop []string,
+	now time.Time,
+) error {
+	owners := make(map[string]string, len(instances))
+	for _, ins := range instances {
+		owners[ins.ID] = ins.OwnerID
+	}
+
+	var stopped int
+	for _, id := range stop {
+		// the owner of the chunk might have changed since the instance
+		// has been started, so it is stopped on behalf of its own owner.
+		if err := a.instances.StopInstance(ctx, id, owners[id]); err != nil {
+			logger.ErrorContext(ctx, "failed to stop instance", "instance_id", id, "err", err)
+			continue
+		}
+		stopped++
+	}
+
+	if stopped == 0 {
+		return nil
+	}
+
+	a.metrics.scaledInstances.Add(ctx, int64(stopped), metric.WithAttributes(
+		attribute.String("flavor_id", flavorID),
+		attribute.String("direction", string(DirectionDown)),
+	))
+
+	if err := a.repo.RecordScaling(ctx, flavorID, DirectionDown, now); err != nil {
+		return fmt.Errorf("record scaling: %w", err)
+	}
+
+	return nil
+}
+
+// record exposes the decision and the numbers it is based on as metrics. the
+// decision is logged as well, at info level if instances are started or
+// stopped, otherwise at debug level.
+func (a *Autoscaler) record(ctx context.Context, logger *slog.Logger, flavorID string, demand Demand, d Decision) {
+	attrs := metric.WithAttributes(attribute.String("flavor_id", flavorID))
+
+	a.metrics.decisionCount.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("flavor_id", flavorID),
+		attribute.String("direction", string(d.Direction)),
+		attribute.String("reason", string(d.Reason)),
+	))
+	a.metrics.currentInstances.Record(ctx, int64(d.Current), attrs)
+	a.metrics.desiredInstances.Record(ctx, int64(d.Desired), attrs)
+	a.metrics.players.Record(ctx, int64(demand.Players), attrs)
+	a.metrics.queuedPlayers.Record(ctx, int64(demand.QueuedPlayers), attrs)
+
+	level := slog.LevelDebug
+	if d.Direction != DirectionNone {
+		level = slog.LevelInfo
+	}
+
+	logger.Log(
+		ctx,
+		level,
+		"autoscaling decision",
+		"direction", d.Direction,
+		"reason", d.Reason,
+		"current_instances", d.Current,
+		"desired_instances", d.Desired,
+		"manual_instances", demand.ManualInstances,
+		"players", demand.Players,
+		"queued_players", demand.QueuedPlayers,
+		"start", d.Start,
+		"stop", d.Stop,
+	)
+}
+
+// scaledVersion returns the version instances of the flavor are started
+// with. players are served by the stable channel of the flavor, so its
+// version is preferred over the latest built version if one is published.
+func (a *Autoscaler) scaledVersion(ctx context.Context, flavor resource.Flavor) (resource.FlavorVersion, bool, error) {
+	c, err := a.chunkRepo.FlavorChannel(ctx, flavor.ID, resource.FlavorChannelStable)
+	if err != nil && !errors.Is(err, apierrs.ErrNotFound) {
+		return resource.FlavorVersion{}, false, fmt.Errorf("flavor channel: %w", err)
+	}
+
+	if err == nil {
+		idx := slices.IndexFunc(flavor.Versions, func(v resource.FlavorVersion) bool {
+			return v.ID == c.FlavorVersionID
+		})
+		if idx != -1 && flavor.Versions[idx].BuildStatus == resource.FlavorVersionBuildStatusCompleted {
+			return flavor.Versions[idx], true, nil
+		}
+	}
+
+	v, ok := latestBuiltVersion(flavor.Versions)
+	return v, ok, nil
+}
+
+// latestBuiltVersion returns the most recently created version
+// of the flavor that has been built successfully.
+func latestBuiltVersion(versions []resource.FlavorVersion) (resource.FlavorVersion, bool) {
+	var (
+		found  bool
+		latest resource.FlavorVersion
+	)
+
+	for _, v := range versions {
+		if v.BuildStatus != resource.FlavorVersionBuildStatusCompleted {
+			continue
+		}
+
+		if !found || v.CreatedAt.After(latest.CreatedAt) {
+			latest = v
+			found = true
+		}
+	}
+
+	return latest, found
+}
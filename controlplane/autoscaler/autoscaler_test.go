@@ -0,0 +1,217 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package autoscaler_test
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/autoscaler"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcile(t *testing.T) {
+	var (
+		ownerID = "019a88ab-3240-7f61-b560-cc755d5572a1"
+		flavor  = fixture.Flavor(func(f *resource.Flavor) {
+			f.Versions[0].BuildStatus = resource.FlavorVersionBuildStatusPending
+			f.Versions[0].CreatedAt = time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+			f.Versions[1].BuildStatus = resource.FlavorVersionBuildStatusCompleted
+			f.Versions[1].CreatedAt = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+		})
+		target = autoscaler.Target{
+			Policy: resource.AutoscalingPolicy{
+				FlavorID:          flavor.ID,
+				MinInstances:      0,
+				MaxInstances:      3,
+				TargetUtilization: 100,
+				ScaleUpCooldown:   time.Minute,
+				ScaleDownCooldown: 10 * time.Minute,
+			},
+			OwnerID: ownerID,
+		}
+	)
+
+	tests := []struct {
+		name string
+		prep func(*mock.MockAutoscalerRepository, *mock.MockChunkRepository, *mock.MockAutoscalerInstances)
+	}{
+		{
+			name: "start instances of latest built version",
+			prep: func(
+				repo *mock.MockAutoscalerRepository,
+				chunkRepo *mock.MockChunkRepository,
+				instances *mock.MockAutoscalerInstances,
+			) {
+				chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+				chunkRepo.EXPECT().
+					FlavorChannel(mocky.Anything, flavor.ID, resource.FlavorChannelStable).
+					Return(resource.FlavorChannel{}, apierrs.ErrNotFound)
+				repo.EXPECT().
+					FlavorDemand(mocky.Anything, flavor.ID, mocky.Anything).
+					Return(autoscaler.Demand{Players: 10, QueuedPlayers: 5}, nil)
+				repo.EXPECT().
+					AutoscaledInstances(mocky.Anything, flavor.ID).
+					Return([]autoscaler.Instance{{ID: "a", OwnerID: ownerID, Players: 10}}, nil)
+
+				instances.EXPECT().
+					RunFlavorVersion(
+						mocky.Anything,
+						flavor.Versions[1].ID,
+						ownerID,
+						"autoscaler",
+						resource.InstanceParams{},
+						"",
+					).
+					Return(resource.Instance{ID: "b"}, nil)
+				repo.EXPECT().InsertAutoscaledInstance(mocky.Anything, flavor.ID, "b").Return(nil)
+				repo.EXPECT().
+					RecordScaling(mocky.Anything, flavor.ID, autoscaler.DirectionUp, mocky.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name: "start instances of stable channel version",
+			prep: func(
+				repo *mock.MockAutoscalerRepository,
+				chunkRepo *mock.MockChunkRepository,
+				instances *mock.MockAutoscalerInstances,
+			) {
+				// the latest version is built as well, but has only
+				// been published to the beta channel.
+				built := flavor
+				built.Versions = slices.Clone(flavor.Versions)
+				built.Versions[0].BuildStatus = resource.FlavorVersionBuildStatusCompleted
+
+				chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(built, nil)
+				chunkRepo.EXPECT().
+					FlavorChannel(mocky.Anything, flavor.ID, resource.FlavorChannelStable).
+					Return(resource.FlavorChannel{
+						FlavorID:        flavor.ID,
+						Name:            resource.FlavorChannelStable,
+						FlavorVersionID: flavor.Versions[1].ID,
+					}, nil)
+				repo.EXPECT().
+					FlavorDemand(mocky.Anything, flavor.ID, mocky.Anything).
+					Return(autoscaler.Demand{Players: 10, QueuedPlayers: 5}, nil)
+				repo.EXPECT().
+					AutoscaledInstances(mocky.Anything, flavor.ID).
+					Return([]autoscaler.Instance{{ID: "a", OwnerID: ownerID, Players: 10}}, nil)
+
+				instances.EXPECT().
+					RunFlavorVersion(
+						mocky.Anything,
+						flavor.Versions[1].ID,
+						ownerID,
+						"autoscaler",
+						resource.InstanceParams{},
+						"",
+					).
+					Return(resource.Instance{ID: "b"}, nil)
+				repo.EXPECT().InsertAutoscaledInstance(mocky.Anything, flavor.ID, "b").Return(nil)
+				repo.EXPECT().
+					RecordScaling(mocky.Anything, flavor.ID, autoscaler.DirectionUp, mocky.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name: "stop empty instances on behalf of their owner",
+			prep: func(
+				repo *mock.MockAutoscalerRepository,
+				chunkRepo *mock.MockChunkRepository,
+				instances *mock.MockAutoscalerInstances,
+			) {
+				chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+				chunkRepo.EXPECT().
+					FlavorChannel(mocky.Anything, flavor.ID, resource.FlavorChannelStable).
+					Return(resource.FlavorChannel{}, apierrs.ErrNotFound)
+				repo.EXPECT().
+					FlavorDemand(mocky.Anything, flavor.ID, mocky.Anything).
+					Return(autoscaler.Demand{Players: 3}, nil)
+				repo.EXPECT().
+					AutoscaledInstances(mocky.Anything, flavor.ID).
+					Return([]autoscaler.Instance{
+						{ID: "a", OwnerID: "previous-owner"},
+						{ID: "b", OwnerID: ownerID, Players: 3},
+					}, nil)
+
+				instances.EXPECT().StopInstance(mocky.Anything, "a", "previous-owner").Return(nil)
+				repo.EXPECT().
+					RecordScaling(mocky.Anything, flavor.ID, autoscaler.DirectionDown, mocky.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name: "flavor without built version is not scaled",
+			prep: func(
+				repo *mock.MockAutoscalerRepository,
+				chunkRepo *mock.MockChunkRepository,
+				_ *mock.MockAutoscalerInstances,
+			) {
+				unbuilt := fixture.Flavor()
+				chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(unbuilt, nil)
+				chunkRepo.EXPECT().
+					FlavorChannel(mocky.Anything, flavor.ID, resource.FlavorChannelStable).
+					Return(resource.FlavorChannel{}, apierrs.ErrNotFound)
+				repo.EXPECT().
+					FlavorDemand(mocky.Anything, flavor.ID, mocky.Anything).
+					Return(autoscaler.Demand{Players: 10}, nil)
+				repo.EXPECT().AutoscaledInstances(mocky.Anything, flavor.ID).Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx           = context.Background()
+				mockRepo      = mock.NewMockAutoscalerRepository(t)
+				mockChunkRepo = mock.NewMockChunkRepository(t)
+				mockInstances = mock.NewMockAutoscalerInstances(t)
+			)
+
+			a, err := autoscaler.New(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				mockChunkRepo,
+				mockInstances,
+				autoscaler.Config{
+					Interval:     time.Minute,
+					DemandWindow: time.Minute,
+				},
+			)
+			require.NoError(t, err)
+
+			mockRepo.EXPECT().AutoscalingTargets(mocky.Anything).Return([]autoscaler.Target{target}, nil)
+			mockRepo.EXPECT().DeleteJoinMissesBefore(mocky.Anything, mocky.Anything).Return(nil)
+
+			tt.prep(mockRepo, mockChunkRepo, mockInstances)
+
+			require.NoError(t, a.Reconcile(ctx))
+		})
+	}
+}
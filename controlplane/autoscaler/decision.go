@@ -0,0 +1,171 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package autoscaler
+
+import (
+	"time"
+
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+type Direction string
+
+const (
+	DirectionNone Direction = "none"
+	DirectionUp   Direction = "up"
+	DirectionDown Direction = "down"
+)
+
+// Reason explains a scaling decision. it is attached to the
+// metrics and logs, so owners can tell why their flavor has
+// been scaled or not.
+type Reason string
+
+const (
+	// ReasonSteady means the current number of instances
+	// matches the demand.
+	ReasonSteady Reason = "steady"
+	// ReasonBelowMin means instances are started, because there
+	// are fewer than the minimum. cooldowns do not apply.
+	ReasonBelowMin Reason = "below_min"
+	// ReasonAboveMax means instances are stopped, because there
+	// are more than the maximum. cooldowns do not apply.
+	ReasonAboveMax Reason = "above_max"
+	// ReasonHighDemand means instances are started, because there
+	// are not enough player slots for the demand.
+	ReasonHighDemand Reason = "high_demand"
+	// ReasonLowDemand means instances are stopped, because there
+	// are more player slots than needed.
+	ReasonLowDemand Reason = "low_demand"
+	// ReasonCooldown means the number of instances should change,
+	// but the flavor has been scaled too recently.
+	ReasonCooldown Reason = "cooldown"
+	// ReasonInstancesBusy means instances should be stopped, but
+	// none of the instances started by the autoscaler are empty.
+	ReasonInstancesBusy Reason = "instances_busy"
+	// ReasonNoVersion means the flavor has no version that has
+	// been built successfully, so no instances can be started.
+	ReasonNoVersion Reason = "no_version"
+)
+
+// Decision describes how the number of instances of a flavor is changed.
+type Decision struct {
+	Direction Direction
+	Reason    Reason
+
+	// Current is the number of instances started by the autoscaler.
+	Current uint32
+	// Desired is the number of instances the autoscaler should run.
+	Desired uint32
+
+	// Start is the number of instances to start when scaling up.
+	Start uint32
+	// Stop contains the instances to stop when scaling down.
+	Stop []string
+}
+
+// decide calculates how the number of instances of a flavor has to change to
+// serve its demand. each instance should fill targetUtilization percent of its
+// slots. instances that have not been started by the autoscaler are assumed
+// to serve the demand as well. only empty instances are stopped, so players
+// are never kicked.
+func decide(
+	policy resource.AutoscalingPolicy,
+	demand Demand,
+	instances []Instance,
+	slots uint32,
+	now time.Time,
+) Decision {
+	var (
+		current     = uint32(len(instances))
+		perInstance = max(1, slots*policy.TargetUtilization/100)
+		needed      = ceilDiv(demand.Players+demand.QueuedPlayers, perInstance)
+		desired     = uint32(0)
+	)
+
+	if needed > demand.ManualInstances {
+		desired = needed - demand.ManualInstances
+	}
+
+	desired = min(max(desired, policy.MinInstances), policy.MaxInstances)
+
+	d := Decision{
+		Direction: DirectionNone,
+		Reason:    ReasonSteady,
+		Current:   current,
+		Desired:   desired,
+	}
+
+	switch {
+	case desired > current:
+		if current < policy.MinInstances {
+			d.Reason = ReasonBelowMin
+		} else {
+			d.Reason = ReasonHighDemand
+			if cooling(policy.LastScaleUpAt, policy.ScaleUpCooldown, now) {
+				d.Reason = ReasonCooldown
+				return d
+			}
+		}
+
+		d.Direction = DirectionUp
+		d.Start = desired - current
+	case desired < current:
+		if current > policy.MaxInstances {
+			d.Reason = ReasonAboveMax
+		} else {
+			d.Reason = ReasonLowDemand
+			// starting instances also resets the scale down cooldown, so
+			// instances started for queued players are not stopped before
+			// the players had the chance to join.
+			if cooling(policy.LastScaleUpAt, policy.ScaleDownCooldown, now) ||
+				cooling(policy.LastScaleDownAt, policy.ScaleDownCooldown, now) {
+				d.Reason = ReasonCooldown
+				return d
+			}
+		}
+
+		for _, ins := range instances {
+			if uint32(len(d.Stop)) == current-desired {
+				break
+			}
+			if ins.Players > 0 {
+				continue
+			}
+			d.Stop = append(d.Stop, ins.ID)
+		}
+
+		if len(d.Stop) == 0 {
+			d.Reason = ReasonInstancesBusy
+			return d
+		}
+
+		d.Direction = DirectionDown
+	}
+
+	return d
+}
+
+func cooling(last *time.Time, cooldown time.Duration, now time.Time) bool {
+	return last != nil && now.Sub(*last) < cooldown
+}
+
+func ceilDiv(a, b uint32) uint32 {
+	return (a + b - 1) / b
+}
@@ -0,0 +1,194 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package autoscaler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecide(t *testing.T) {
+	var (
+		now    = time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+		recent = now.Add(-30 * time.Second)
+		policy = func(mod ...func(p *resource.AutoscalingPolicy)) resource.AutoscalingPolicy {
+			p := resource.AutoscalingPolicy{
+				FlavorID:          "flavor",
+				MinInstances:      1,
+				MaxInstances:      5,
+				TargetUtilization: 50,
+				ScaleUpCooldown:   time.Minute,
+				ScaleDownCooldown: 10 * time.Minute,
+			}
+			for _, fn := range mod {
+				fn(&p)
+			}
+			return p
+		}
+		instances = func(players ...uint32) []Instance {
+			ret := make([]Instance, 0, len(players))
+			for i, p := range players {
+				ret = append(ret, Instance{
+					ID:      string(rune('a' + i)),
+					State:   resource.InstanceStateRunning,
+					Players: p,
+				})
+			}
+			return ret
+		}
+	)
+
+	tests := []struct {
+		name      string
+		policy    resource.AutoscalingPolicy
+		demand    Demand
+		instances []Instance
+		expected  Decision
+	}{
+		{
+			name:      "steady",
+			policy:    policy(),
+			demand:    Demand{Players: 8},
+			instances: instances(4, 4),
+			expected: Decision{
+				Direction: DirectionNone,
+				Reason:    ReasonSteady,
+				Current:   2,
+				Desired:   2,
+			},
+		},
+		{
+			name:   "start minimum instances",
+			policy: policy(func(p *resource.AutoscalingPolicy) { p.MinInstances = 2; p.LastScaleUpAt = &recent }),
+			expected: Decision{
+				Direction: DirectionUp,
+				Reason:    ReasonBelowMin,
+				Desired:   2,
+				Start:     2,
+			},
+		},
+		{
+			name:      "queued players are part of the demand",
+			policy:    policy(),
+			demand:    Demand{Players: 5, QueuedPlayers: 6},
+			instances: instances(5),
+			expected: Decision{
+				Direction: DirectionUp,
+				Reason:    ReasonHighDemand,
+				Current:   1,
+				Desired:   3,
+				Start:     2,
+			},
+		},
+		{
+			name:      "manual instances serve part of the demand",
+			policy:    policy(),
+			demand:    Demand{Players: 15, ManualInstances: 2},
+			instances: instances(5),
+			expected: Decision{
+				Direction: DirectionNone,
+				Reason:    ReasonSteady,
+				Current:   1,
+				Desired:   1,
+			},
+		},
+		{
+			name:      "desired instances are capped at maximum",
+			policy:    policy(),
+			demand:    Demand{Players: 100},
+			instances: instances(10),
+			expected: Decision{
+				Direction: DirectionUp,
+				Reason:    ReasonHighDemand,
+				Current:   1,
+				Desired:   5,
+				Start:     4,
+			},
+		},
+		{
+			name:      "scale up cooldown",
+			policy:    policy(func(p *resource.AutoscalingPolicy) { p.LastScaleUpAt = &recent }),
+			demand:    Demand{Players: 10},
+			instances: instances(5),
+			expected: Decision{
+				Direction: DirectionNone,
+				Reason:    ReasonCooldown,
+				Current:   1,
+				Desired:   2,
+			},
+		},
+		{
+			name:      "only empty instances are stopped",
+			policy:    policy(),
+			demand:    Demand{Players: 2},
+			instances: instances(0, 2, 0, 0),
+			expected: Decision{
+				Direction: DirectionDown,
+				Reason:    ReasonLowDemand,
+				Current:   4,
+				Desired:   1,
+				Stop:      []string{"a", "c", "d"},
+			},
+		},
+		{
+			name:      "instances busy",
+			policy:    policy(),
+			demand:    Demand{Players: 2},
+			instances: instances(1, 1),
+			expected: Decision{
+				Direction: DirectionNone,
+				Reason:    ReasonInstancesBusy,
+				Current:   2,
+				Desired:   1,
+			},
+		},
+		{
+			name:      "scaling up resets scale down cooldown",
+			policy:    policy(func(p *resource.AutoscalingPolicy) { p.LastScaleUpAt = &recent }),
+			instances: instances(0, 0),
+			expected: Decision{
+				Direction: DirectionNone,
+				Reason:    ReasonCooldown,
+				Current:   2,
+				Desired:   1,
+			},
+		},
+		{
+			name:      "stop instances above maximum regardless of cooldown",
+			policy:    policy(func(p *resource.AutoscalingPolicy) { p.MaxInstances = 1; p.LastScaleDownAt = &recent }),
+			instances: instances(0, 0),
+			expected: Decision{
+				Direction: DirectionDown,
+				Reason:    ReasonAboveMax,
+				Current:   2,
+				Desired:   1,
+				Stop:      []string{"a"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, decide(tt.policy, tt.demand, tt.instances, 10, now))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package autoscaler
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type metrics struct {
+	decisionCount   metric.Int64Counter
+	scaledInstances metric.Int64Counter
+
+	currentInstances metric.Int64Gauge
+	desiredInstances metric.Int64Gauge
+	players          metric.Int64Gauge
+	queuedPlayers    metric.Int64Gauge
+}
+
+func initMetrics() (metrics, error) {
+	meter := otel.Meter("github.com/spacechunks/explorer/controlplane/autoscaler")
+
+	decisionCount, err := meter.Int64Counter(
+		"explorer.control_plane.autoscaler.decision.count",
+		metric.WithDescription("Total number of scaling decisions by direction and reason"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("decision counter: %w", err)
+	}
+
+	scaledInstances, err := meter.Int64Counter(
+		"explorer.control_plane.autoscaler.scaled_instances.count",
+		metric.WithDescription("Total number of instances started or stopped by the autoscaler"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("scaled instances counter: %w", err)
+	}
+
+	currentInstances, err := meter.Int64Gauge(
+		"explorer.control_plane.autoscaler.current_instances",
+		metric.WithDescription("Number of instances started by the autoscaler that are not being deleted"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("current instances gauge: %w", err)
+	}
+
+	desiredInstances, err := meter.Int64Gauge(
+		"explorer.control_plane.autoscaler.desired_instances",
+		metric.WithDescription("Number of instances the autoscaler should run according to the demand"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("desired instances gauge: %w", err)
+	}
+
+	players, err := meter.Int64Gauge(
+		"explorer.control_plane.autoscaler.players",
+		metric.WithDescription("Number of players connected to running instances of the flavor"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("players gauge: %w", err)
+	}
+
+	queuedPlayers, err := meter.Int64Gauge(
+		"explorer.control_plane.autoscaler.queued_players",
+		metric.WithDescription("Number of players that recently could not join the flavor, because it was full"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("queued players gauge: %w", err)
+	}
+
+	return metrics{
+		decisionCount:    decisionCount,
+		scaledInstances:  scaledInstances,
+		currentInstances: currentInstances,
+		desiredInstances: desiredInstances,
+		players:          players,
+		queuedPlayers:    queuedPlayers,
+	}, nil
+}
@@ -0,0 +1,94 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// Target is a flavor that is autoscaled according to its policy.
+type Target struct {
+	Policy resource.AutoscalingPolicy
+	// OwnerID is the owner of the chunk the flavor belongs to. instances
+	// started by the autoscaler are owned by them.
+	OwnerID string
+}
+
+// Demand describes how many players want to play a flavor.
+type Demand struct {
+	// Players is the number of players connected to running
+	// instances of the flavor.
+	Players uint32
+
+	// QueuedPlayers is the number of players that could not join the
+	// flavor recently, because none of its instances had room for them.
+	QueuedPlayers uint32
+
+	// ManualInstances is the number of instances of the flavor that have
+	// not been started by the autoscaler and are not being deleted.
+	ManualInstances uint32
+}
+
+// Instance is an instance that has been started by the autoscaler.
+type Instance struct {
+	ID      string
+	OwnerID string
+	State   resource.InstanceState
+	Players uint32
+}
+
+type Repository interface {
+	// AutoscalingTargets returns all flavors with an autoscaling
+	// policy, except the ones that have been deleted.
+	AutoscalingTargets(ctx context.Context) ([]Target, error)
+
+	// FlavorDemand returns the demand of the flavor. only players that
+	// could not join after since are counted as queued players.
+	FlavorDemand(ctx context.Context, flavorID string, since time.Time) (Demand, error)
+
+	// AutoscaledInstances returns the instances of the flavor started by the
+	// autoscaler, that are not being deleted. newer instances come first.
+	AutoscaledInstances(ctx context.Context, flavorID string) ([]Instance, error)
+
+	InsertAutoscaledInstance(ctx context.Context, flavorID string, instanceID string) error
+
+	// RecordScaling sets the time of the last scaling decision
+	// in the given direction, which the cooldowns are based on.
+	RecordScaling(ctx context.Context, flavorID string, dir Direction, at time.Time) error
+
+	// DeleteJoinMissesBefore removes players that could not join a
+	// chunk before the given time, because they are no longer needed.
+	DeleteJoinMissesBefore(ctx context.Context, t time.Time) error
+}
+
+// Instances starts and stops instances, see [instance.Service].
+type Instances interface {
+	RunFlavorVersion(
+		ctx context.Context,
+		flavorVersionID string,
+		ownerID string,
+		orderedBy string,
+		params resource.InstanceParams,
+		placementGroup string,
+	) (resource.Instance, error)
+	StopInstance(ctx context.Context, id string, actorID string) error
+}
@@ -0,0 +1,175 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+)
+
+// AzureObjectStore stores objects in an Azure Blob Storage container.
+// The client has to be created using a shared key credential, otherwise
+// presigning urls does not work.
+type AzureObjectStore struct {
+	client    *azblob.Client
+	container string
+}
+
+func NewAzureStore(container string, c *azblob.Client) *AzureObjectStore {
+	return &AzureObjectStore{
+		client:    c,
+		container: container,
+	}
+}
+
+// PresignURL returns a SAS url allowing to create the blob. Azure does
+// not support verifying SHA256 checksums on upload, so the content hash
+// is not enforced.
+func (s AzureObjectStore) PresignURL(
+	_ context.Context,
+	key string,
+	_ string,
+	expiry time.Duration,
+	_ uint64,
+) (string, time.Time, error) {
+	expiresAt := time.Now().Add(expiry)
+
+	u, err := s.blobClient(key).GetSASURL(sas.BlobPermissions{
+		Create: true,
+		Write:  true,
+	}, expiresAt, nil)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sas url: %w", err)
+	}
+
+	return u, expiresAt, nil
+}
+
+// UploadHeaders returns the blob type header, because the
+// Put Blob operation requires it to be set.
+func (s AzureObjectStore) UploadHeaders() map[string]string {
+	return map[string]string{
+		"x-ms-blob-type": "BlockBlob",
+	}
+}
+
+func (s AzureObjectStore) PresignDownloadURL(
+	_ context.Context,
+	key string,
+	expiry time.Duration,
+) (string, time.Time, error) {
+	expiresAt := time.Now().Add(expiry)
+
+	u, err := s.blobClient(key).GetSASURL(sas.BlobPermissions{
+		Read: true,
+	}, expiresAt, nil)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sas url: %w", err)
+	}
+
+	return u, expiresAt, nil
+}
+
+func (s AzureObjectStore) ObjectExists(ctx context.Context, key string) (bool, error) {
+	if _, err := s.blobClient(key).GetProperties(ctx, nil); err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get properties: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s AzureObjectStore) Delete(ctx context.Context, key string) error {
+	if _, err := s.client.DeleteBlob(ctx, s.container, key, nil); err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil
+		}
+		return fmt.Errorf("delete blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s AzureObjectStore) WriteTo(ctx context.Context, key string, w io.Writer) error {
+	resp, err := s.client.DownloadStream(ctx, s.container, key, nil)
+	if err != nil {
+		return fmt.Errorf("download: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("copy: %w", err)
+	}
+
+	return nil
+}
+
+// PutBlob uploads all the given objects to Azure. Note that the objects
+// underlying io.ReadSeekCloser will be closed after it has been uploaded.
+func (s AzureObjectStore) PutBlob(ctx context.Context, keyPrefix string, objects []Object) error {
+	for _, obj := range objects {
+		h, err := obj.Hash()
+		if err != nil {
+			return fmt.Errorf("hash: %w", err)
+		}
+
+		found, err := s.ObjectExists(ctx, keyPrefix+"/"+h)
+		if err != nil {
+			return fmt.Errorf("exists: %w", err)
+		}
+
+		if found {
+			continue
+		}
+
+		if err := s.SimplePut(ctx, keyPrefix+"/"+h, obj.Data, nil); err != nil {
+			return fmt.Errorf("upload: %w", err)
+		}
+
+		obj.Data.Close()
+	}
+
+	return nil
+}
+
+func (s AzureObjectStore) SimplePut(ctx context.Context, key string, r io.Reader, metadata map[string]string) error {
+	md := make(map[string]*string, len(metadata))
+	for k, v := range metadata {
+		md[k] = &v
+	}
+
+	_, err := s.client.UploadStream(ctx, s.container, key, r, &azblob.UploadStreamOptions{
+		Metadata: md,
+	})
+	return err
+}
+
+func (s AzureObjectStore) blobClient(key string) *blob.Client {
+	return s.client.ServiceClient().NewContainerClient(s.container).NewBlobClient(key)
+}
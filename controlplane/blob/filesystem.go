@@ -0,0 +1,321 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FilesystemStore stores objects in a local directory. It is meant to be
+// used for development and testing, where running an object storage
+// service is not worth the hassle.
+//
+// Since there is no service clients can upload to or download from directly,
+// presigned URLs point to the handler returned by UploadHandler, which verifies
+// the signature, expiry and checksum embedded into the URL.
+type FilesystemStore struct {
+	root      *os.Root
+	publicURL string
+	secret    []byte
+}
+
+// NewFilesystemStore creates a store that saves objects in dir. publicURL
+// is the base url under which the UploadHandler is reachable by clients.
+// secret is used to sign presigned urls, if empty a random one will be
+// generated, meaning presigned urls do not survive restarts.
+func NewFilesystemStore(dir string, publicURL string, secret string) (*FilesystemStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create dir: %w", err)
+	}
+
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, fmt.Errorf("open root: %w", err)
+	}
+
+	key := []byte(secret)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("generate secret: %w", err)
+		}
+	}
+
+	return &FilesystemStore{
+		root:      root,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+		secret:    key,
+	}, nil
+}
+
+func (s *FilesystemStore) PresignURL(
+	_ context.Context,
+	key string,
+	contentHash string,
+	expiry time.Duration,
+	contentLength uint64,
+) (string, time.Time, error) {
+	var (
+		expiresAt = time.Now().Add(expiry).Truncate(time.Second)
+		exp       = strconv.FormatInt(expiresAt.Unix(), 10)
+		length    = strconv.FormatUint(contentLength, 10)
+		q         = url.Values{}
+	)
+
+	q.Set("expires", exp)
+	q.Set("hash", contentHash)
+	q.Set("length", length)
+	q.Set("signature", s.sign(http.MethodPut, key, exp, contentHash, length))
+
+	return s.publicURL + "/" + key + "?" + q.Encode(), expiresAt, nil
+}
+
+func (s *FilesystemStore) PresignDownloadURL(
+	_ context.Context,
+	key string,
+	expiry time.Duration,
+) (string, time.Time, error) {
+	var (
+		expiresAt = time.Now().Add(expiry).Truncate(time.Second)
+		exp       = strconv.FormatInt(expiresAt.Unix(), 10)
+		q         = url.Values{}
+	)
+
+	q.Set("expires", exp)
+	q.Set("signature", s.sign(http.MethodGet, key, exp, "", ""))
+
+	return s.publicURL + "/" + key + "?" + q.Encode(), expiresAt, nil
+}
+
+func (s *FilesystemStore) UploadHeaders() map[string]string {
+	return nil
+}
+
+func (s *FilesystemStore) WriteTo(_ context.Context, key string, w io.Writer) error {
+	f, err := s.root.Open(key)
+	if err != nil {
+		return fmt.Errorf("open: %w", err)
+	}
+
+	defer f.Close()
+
+	if _, err := io.Copy(w, f); err != nil {
+		return fmt.Errorf("copy: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FilesystemStore) ObjectExists(_ context.Context, key string) (bool, error) {
+	if _, err := s.root.Stat(key); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("stat: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *FilesystemStore) Delete(_ context.Context, key string) error {
+	for _, k := range []string{key, key + ".metadata.json"} {
+		if err := s.root.Remove(k); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("remove: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// PutBlob writes all the given objects to the store directory. Note that the
+// objects underlying io.ReadSeekCloser will be closed after it has been written.
+func (s *FilesystemStore) PutBlob(ctx context.Context, keyPrefix string, objects []Object) error {
+	for _, obj := range objects {
+		h, err := obj.Hash()
+		if err != nil {
+			return fmt.Errorf("hash: %w", err)
+		}
+
+		found, err := s.ObjectExists(ctx, keyPrefix+"/"+h)
+		if err != nil {
+			return fmt.Errorf("exists: %w", err)
+		}
+
+		if found {
+			continue
+		}
+
+		if err := s.write(keyPrefix+"/"+h, obj.Data); err != nil {
+			return fmt.Errorf("write: %w", err)
+		}
+
+		obj.Data.Close()
+	}
+
+	return nil
+}
+
+// SimplePut writes the object to the store directory. If metadata is
+// passed, it is saved next to the object in a file suffixed with
+// .metadata.json.
+func (s *FilesystemStore) SimplePut(_ context.Context, key string, r io.Reader, metadata map[string]string) error {
+	if err := s.write(key, r); err != nil {
+		return fmt.Errorf("write: %w", err)
+	}
+
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("marshal metadata: %w", err)
+	}
+
+	if err := s.write(key+".metadata.json", strings.NewReader(string(data))); err != nil {
+		return fmt.Errorf("write metadata: %w", err)
+	}
+
+	return nil
+}
+
+// UploadHandler returns a handler accepting PUT requests targeting urls
+// returned by PresignURL and GET requests targeting urls returned by
+// PresignDownloadURL.
+func (s *FilesystemStore) UploadHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut && r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var (
+			key    = strings.TrimPrefix(r.URL.Path, "/")
+			q      = r.URL.Query()
+			exp    = q.Get("expires")
+			hash   = q.Get("hash")
+			length = q.Get("length")
+		)
+
+		if !hmac.Equal([]byte(s.sign(r.Method, key, exp, hash, length)), []byte(q.Get("signature"))) {
+			http.Error(w, "invalid signature", http.StatusForbidden)
+			return
+		}
+
+		expUnix, err := strconv.ParseInt(exp, 10, 64)
+		if err != nil || time.Now().After(time.Unix(expUnix, 0)) {
+			http.Error(w, "url expired", http.StatusForbidden)
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			f, err := s.root.Open(key)
+			if err != nil {
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+
+			defer f.Close()
+
+			_, _ = io.Copy(w, f)
+			return
+		}
+
+		size, err := strconv.ParseInt(length, 10, 64)
+		if err != nil || r.ContentLength != size {
+			http.Error(w, "content length mismatch", http.StatusBadRequest)
+			return
+		}
+
+		var (
+			hasher = sha256.New()
+			body   = io.TeeReader(io.LimitReader(r.Body, size), hasher)
+			tmpKey = key + ".upload"
+		)
+
+		if err := s.write(tmpKey, body); err != nil {
+			http.Error(w, "write failed", http.StatusInternalServerError)
+			return
+		}
+
+		if base64.StdEncoding.EncodeToString(hasher.Sum(nil)) != hash {
+			_ = s.root.Remove(tmpKey)
+			http.Error(w, "checksum mismatch", http.StatusBadRequest)
+			return
+		}
+
+		if err := s.root.Rename(tmpKey, key); err != nil {
+			http.Error(w, "write failed", http.StatusInternalServerError)
+			return
+		}
+	})
+}
+
+// write writes the data to a temporary file first and renames it
+// afterward, so readers never observe partially written objects.
+func (s *FilesystemStore) write(key string, r io.Reader) error {
+	if err := s.root.MkdirAll(filepath.Dir(key), 0o755); err != nil {
+		return fmt.Errorf("create dir: %w", err)
+	}
+
+	tmp := key + ".tmp"
+
+	f, err := s.root.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("copy: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close: %w", err)
+	}
+
+	if err := s.root.Rename(tmp, key); err != nil {
+		return fmt.Errorf("rename: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FilesystemStore) sign(method, key, expires, hash, length string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(strings.Join([]string{method, key, expires, hash, length}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
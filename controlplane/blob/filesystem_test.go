@@ -0,0 +1,201 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package blob_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesystemStoreUpload(t *testing.T) {
+	data := []byte("some tarball data")
+	digest := sha256.Sum256(data)
+	validHash := base64.StdEncoding.EncodeToString(digest[:])
+
+	tests := []struct {
+		name       string
+		hash       string
+		body       []byte
+		expiry     time.Duration
+		tamper     func(string) string
+		statusCode int
+	}{
+		{
+			name:       "works",
+			hash:       validHash,
+			body:       data,
+			expiry:     time.Minute,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "checksum mismatch",
+			hash:       validHash,
+			body:       []byte("some other data!!"),
+			expiry:     time.Minute,
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:   "tampered signature",
+			hash:   validHash,
+			body:   data,
+			expiry: time.Minute,
+			tamper: func(u string) string {
+				return strings.Replace(u, "abc.tar.gz", "other.tar.gz", 1)
+			},
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name:       "expired",
+			hash:       validHash,
+			body:       data,
+			expiry:     -time.Minute,
+			statusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx = context.Background()
+				key = blob.ChangeSetKey("abc")
+			)
+
+			store, err := blob.NewFilesystemStore(t.TempDir(), "http://localhost:9013", "secret")
+			require.NoError(t, err)
+
+			u, _, err := store.PresignURL(ctx, key, tt.hash, tt.expiry, uint64(len(data)))
+			require.NoError(t, err)
+
+			if tt.tamper != nil {
+				u = tt.tamper(u)
+			}
+
+			var (
+				req = httptest.NewRequest(http.MethodPut, u, bytes.NewReader(tt.body))
+				rec = httptest.NewRecorder()
+			)
+
+			store.UploadHandler().ServeHTTP(rec, req)
+
+			require.Equal(t, tt.statusCode, rec.Code)
+
+			exists, err := store.ObjectExists(ctx, key)
+			require.NoError(t, err)
+			require.Equal(t, tt.statusCode == http.StatusOK, exists)
+
+			if !exists {
+				return
+			}
+
+			var buf bytes.Buffer
+			require.NoError(t, store.WriteTo(ctx, key, &buf))
+			require.Equal(t, data, buf.Bytes())
+		})
+	}
+}
+
+func TestFilesystemStoreDownload(t *testing.T) {
+	data := []byte("some snapshot data")
+
+	tests := []struct {
+		name       string
+		method     string
+		expiry     time.Duration
+		statusCode int
+	}{
+		{
+			name:       "works",
+			method:     http.MethodGet,
+			expiry:     time.Minute,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "download url cannot be used for uploads",
+			method:     http.MethodPut,
+			expiry:     time.Minute,
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name:       "expired",
+			method:     http.MethodGet,
+			expiry:     -time.Minute,
+			statusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx = context.Background()
+				key = blob.SnapshotKey("abc", "def")
+			)
+
+			store, err := blob.NewFilesystemStore(t.TempDir(), "http://localhost:9013", "secret")
+			require.NoError(t, err)
+
+			require.NoError(t, store.SimplePut(ctx, key, bytes.NewReader(data), nil))
+
+			u, _, err := store.PresignDownloadURL(ctx, key, tt.expiry)
+			require.NoError(t, err)
+
+			var (
+				req = httptest.NewRequest(tt.method, u, bytes.NewReader(data))
+				rec = httptest.NewRecorder()
+			)
+
+			store.UploadHandler().ServeHTTP(rec, req)
+
+			require.Equal(t, tt.statusCode, rec.Code)
+
+			if tt.statusCode == http.StatusOK {
+				require.Equal(t, data, rec.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestFilesystemStoreDelete(t *testing.T) {
+	var (
+		ctx = context.Background()
+		key = blob.InstanceLogKey("abc", "def")
+	)
+
+	store, err := blob.NewFilesystemStore(t.TempDir(), "http://localhost:9013", "secret")
+	require.NoError(t, err)
+
+	require.NoError(t, store.SimplePut(ctx, key, strings.NewReader("log"), map[string]string{"k": "v"}))
+	require.NoError(t, store.Delete(ctx, key))
+
+	for _, k := range []string{key, key + ".metadata.json"} {
+		exists, err := store.ObjectExists(ctx, k)
+		require.NoError(t, err)
+		require.False(t, exists)
+	}
+
+	// deleting a non-existent object is not an error
+	require.NoError(t, store.Delete(ctx, key))
+}
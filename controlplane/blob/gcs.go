@@ -0,0 +1,170 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+// GCSObjectStore stores objects in a Google Cloud Storage bucket.
+type GCSObjectStore struct {
+	client *storage.Client
+	bucket *storage.BucketHandle
+}
+
+func NewGCSStore(bucket string, c *storage.Client) *GCSObjectStore {
+	return &GCSObjectStore{
+		client: c,
+		bucket: c.Bucket(bucket),
+	}
+}
+
+// PresignURL returns a V4 signed url. GCS does not support verifying
+// SHA256 checksums on upload, so the content hash is not enforced.
+func (s GCSObjectStore) PresignURL(
+	_ context.Context,
+	key string,
+	_ string,
+	expiry time.Duration,
+	_ uint64,
+) (string, time.Time, error) {
+	expiresAt := time.Now().Add(expiry)
+
+	u, err := s.bucket.SignedURL(key, &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  http.MethodPut,
+		Expires: expiresAt,
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sign url: %w", err)
+	}
+
+	return u, expiresAt, nil
+}
+
+func (s GCSObjectStore) UploadHeaders() map[string]string {
+	return nil
+}
+
+func (s GCSObjectStore) PresignDownloadURL(
+	_ context.Context,
+	key string,
+	expiry time.Duration,
+) (string, time.Time, error) {
+	expiresAt := time.Now().Add(expiry)
+
+	u, err := s.bucket.SignedURL(key, &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  http.MethodGet,
+		Expires: expiresAt,
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sign url: %w", err)
+	}
+
+	return u, expiresAt, nil
+}
+
+func (s GCSObjectStore) ObjectExists(ctx context.Context, key string) (bool, error) {
+	if _, err := s.bucket.Object(key).Attrs(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("object attrs: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s GCSObjectStore) Delete(ctx context.Context, key string) error {
+	if err := s.bucket.Object(key).Delete(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil
+		}
+		return fmt.Errorf("delete object: %w", err)
+	}
+
+	return nil
+}
+
+func (s GCSObjectStore) WriteTo(ctx context.Context, key string, w io.Writer) error {
+	r, err := s.bucket.Object(key).NewReader(ctx)
+	if err != nil {
+		return fmt.Errorf("new reader: %w", err)
+	}
+
+	defer r.Close()
+
+	if _, err := io.Copy(w, r); err != nil {
+		return fmt.Errorf("copy: %w", err)
+	}
+
+	return nil
+}
+
+// PutBlob uploads all the given objects to GCS. Note that the objects
+// underlying io.ReadSeekCloser will be closed after it has been uploaded.
+func (s GCSObjectStore) PutBlob(ctx context.Context, keyPrefix string, objects []Object) error {
+	for _, obj := range objects {
+		h, err := obj.Hash()
+		if err != nil {
+			return fmt.Errorf("hash: %w", err)
+		}
+
+		found, err := s.ObjectExists(ctx, keyPrefix+"/"+h)
+		if err != nil {
+			return fmt.Errorf("exists: %w", err)
+		}
+
+		if found {
+			continue
+		}
+
+		if err := s.SimplePut(ctx, keyPrefix+"/"+h, obj.Data, nil); err != nil {
+			return fmt.Errorf("upload: %w", err)
+		}
+
+		obj.Data.Close()
+	}
+
+	return nil
+}
+
+func (s GCSObjectStore) SimplePut(ctx context.Context, key string, r io.Reader, metadata map[string]string) error {
+	w := s.bucket.Object(key).NewWriter(ctx)
+	w.Metadata = metadata
+
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return fmt.Errorf("copy: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("close writer: %w", err)
+	}
+
+	return nil
+}
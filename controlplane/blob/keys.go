@@ -22,6 +22,53 @@ import "fmt"
 
 const CASKeyPrefix = "explorer/blobs/cas"
 
+// ChangeSetKeyPrefix is the prefix of all change sets. Their files end up
+// in the CAS once the image has been built, so lifecycle rules can be used
+// to move them to cheaper storage or expire them.
+const ChangeSetKeyPrefix = "explorer/changesets/"
+
 func ChangeSetKey(versionID string) string {
-	return fmt.Sprintf("explorer/blobs/%s/changeset.tar.gz", versionID)
+	return fmt.Sprintf("%s%s.tar.gz", ChangeSetKeyPrefix, versionID)
+}
+
+// PatchKey is the key a file of a patch is staged at, until the image
+// build has verified its content and moved it into the CAS.
+func PatchKey(versionID string, hash string) string {
+	return fmt.Sprintf("explorer/blobs/%s/patch/%s", versionID, hash)
+}
+
+// AssetKey is the key the bundle of the asset with the given hash is stored at.
+// assets are shared between flavor versions, so they are stored only once.
+func AssetKey(hash string) string {
+	return fmt.Sprintf("explorer/assets/%s.tar.gz", hash)
+}
+
+// ChunkMediaKey is the key the image of a chunk with the given hash is stored at.
+func ChunkMediaKey(chunkID string, hash string) string {
+	return fmt.Sprintf("explorer/chunks/%s/media/%s", chunkID, hash)
+}
+
+// PatchManifestKey is the key of the file listing the files of a patch.
+func PatchManifestKey(versionID string) string {
+	return fmt.Sprintf("explorer/blobs/%s/patch/manifest.json", versionID)
+}
+
+// SnapshotKey is the key the snapshot of an instance is stored at.
+func SnapshotKey(instanceID string, snapshotID string) string {
+	return fmt.Sprintf("explorer/snapshots/%s/%s.tar.gz", instanceID, snapshotID)
+}
+
+// InstanceLogKey is the key a log file shipped for an instance is stored at.
+func InstanceLogKey(instanceID string, logID string) string {
+	return fmt.Sprintf("explorer/logs/%s/%s.log", instanceID, logID)
+}
+
+// UserExportKey is the key the data export of a user is stored at.
+func UserExportKey(userID string, exportID string) string {
+	return fmt.Sprintf("explorer/exports/%s/%s.tar.gz", userID, exportID)
+}
+
+// ChunkImportKey is the key the archive uploaded for a chunk import is stored at.
+func ChunkImportKey(importID string) string {
+	return fmt.Sprintf("explorer/imports/%s/archive", importID)
 }
@@ -24,39 +24,58 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/feature/s3/transfermanager"
+	tmtypes "github.com/aws/aws-sdk-go-v2/feature/s3/transfermanager/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
 )
 
-type S3Store interface {
-	PresignURL(
-		ctx context.Context,
-		key string,
-		contentHash string,
-		expiry time.Duration,
-		contentLength uint64,
-	) (string, time.Time, error)
-	WriteTo(ctx context.Context, key string, w io.Writer) error
-	ObjectExists(ctx context.Context, key string) (bool, error)
-	PutBlob(ctx context.Context, keyPrefix string, objects []Object) error
-	SimplePut(ctx context.Context, key string, r io.Reader, metadata map[string]string) error
+// S3Config configures how objects are stored in the bucket.
+// The zero value uses the defaults of the bucket.
+type S3Config struct {
+	// ServerSideEncryption is either AES256 for SSE-S3 or aws:kms for SSE-KMS.
+	ServerSideEncryption types.ServerSideEncryption
+
+	// KMSKeyID is the key used for SSE-KMS. The default key of
+	// the bucket is used if empty.
+	KMSKeyID string
+
+	StorageClass types.StorageClass
+}
+
+func (c S3Config) Validate() error {
+	if c.ServerSideEncryption != "" && !slices.Contains(c.ServerSideEncryption.Values(), c.ServerSideEncryption) {
+		return fmt.Errorf("unsupported server side encryption: %s", c.ServerSideEncryption)
+	}
+
+	if c.KMSKeyID != "" && c.ServerSideEncryption != types.ServerSideEncryptionAwsKms {
+		return fmt.Errorf("kms key requires %s server side encryption", types.ServerSideEncryptionAwsKms)
+	}
+
+	if c.StorageClass != "" && !slices.Contains(c.StorageClass.Values(), c.StorageClass) {
+		return fmt.Errorf("unsupported storage class: %s", c.StorageClass)
+	}
+
+	return nil
 }
 
 type S3ObjectStore struct {
 	client    *s3.Client
 	presigner *s3.PresignClient
 	bucket    string
+	cfg       S3Config
 }
 
-func NewS3Store(bucket string, c *s3.Client, presigner *s3.PresignClient) *S3ObjectStore {
+func NewS3Store(bucket string, c *s3.Client, presigner *s3.PresignClient, cfg S3Config) *S3ObjectStore {
 	return &S3ObjectStore{
 		client:    c,
 		presigner: presigner,
 		bucket:    bucket,
+		cfg:       cfg,
 	}
 }
 
@@ -74,6 +93,62 @@ func (s S3ObjectStore) PresignURL(
 		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
 		ChecksumSHA256:    &contentHash,
 		ContentLength:     new(int64(contentLength)),
+		// these are signed, so uploads without the
+		// headers returned by UploadHeaders are rejected.
+		ServerSideEncryption: s.cfg.ServerSideEncryption,
+		SSEKMSKeyId:          s.kmsKeyID(),
+		StorageClass:         s.cfg.StorageClass,
+	}, s3.WithPresignExpires(expiry))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("presign: %w", err)
+	}
+
+	reqURL, err := url.Parse(req.URL)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("parse url: %w", err)
+	}
+
+	date, err := time.Parse("20060102T150405Z", reqURL.Query().Get("X-Amz-Date"))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("parse date: %w", err)
+	}
+
+	return req.URL, date.Add(expiry), nil
+}
+
+// UploadHeaders returns the configured encryption and storage class headers.
+// Unlike the checksum, they cannot be part of the presigned url, because s3
+// only accepts them as headers.
+func (s S3ObjectStore) UploadHeaders() map[string]string {
+	headers := make(map[string]string)
+
+	if s.cfg.ServerSideEncryption != "" {
+		headers["x-amz-server-side-encryption"] = string(s.cfg.ServerSideEncryption)
+	}
+
+	if s.cfg.KMSKeyID != "" {
+		headers["x-amz-server-side-encryption-aws-kms-key-id"] = s.cfg.KMSKeyID
+	}
+
+	if s.cfg.StorageClass != "" {
+		headers["x-amz-storage-class"] = string(s.cfg.StorageClass)
+	}
+
+	if len(headers) == 0 {
+		return nil
+	}
+
+	return headers
+}
+
+func (s S3ObjectStore) PresignDownloadURL(
+	ctx context.Context,
+	key string,
+	expiry time.Duration,
+) (string, time.Time, error) {
+	req, err := s.presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.bucket,
+		Key:    &key,
 	}, s3.WithPresignExpires(expiry))
 	if err != nil {
 		return "", time.Time{}, fmt.Errorf("presign: %w", err)
@@ -108,6 +183,18 @@ func (s S3ObjectStore) ObjectExists(ctx context.Context, key string) (bool, erro
 	return true, nil
 }
 
+func (s S3ObjectStore) Delete(ctx context.Context, key string) error {
+	// s3 does not report an error if the object does not exist
+	if _, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: &s.bucket,
+		Key:    &key,
+	}); err != nil {
+		return fmt.Errorf("delete object: %w", err)
+	}
+
+	return nil
+}
+
 func (s S3ObjectStore) WriteTo(ctx context.Context, key string, w io.Writer) error {
 	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
 		Bucket: &s.bucket,
@@ -146,9 +233,12 @@ func (s S3ObjectStore) PutBlob(ctx context.Context, keyPrefix string, objects []
 		}
 
 		if _, err := mgr.UploadObject(ctx, &transfermanager.UploadObjectInput{
-			Bucket: &s.bucket,
-			Key:    new(keyPrefix + "/" + h),
-			Body:   obj.Data,
+			Bucket:               &s.bucket,
+			Key:                  new(keyPrefix + "/" + h),
+			Body:                 obj.Data,
+			ServerSideEncryption: tmtypes.ServerSideEncryption(s.cfg.ServerSideEncryption),
+			SSEKMSKeyID:          s.kmsKeyID(),
+			StorageClass:         tmtypes.StorageClass(s.cfg.StorageClass),
 		}); err != nil {
 			return fmt.Errorf("upload: %w", err)
 		}
@@ -161,10 +251,109 @@ func (s S3ObjectStore) PutBlob(ctx context.Context, keyPrefix string, objects []
 
 func (s S3ObjectStore) SimplePut(ctx context.Context, key string, r io.Reader, metadata map[string]string) error {
 	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
-		Bucket:   &s.bucket,
-		Key:      &key,
-		Body:     r,
-		Metadata: metadata,
+		Bucket:               &s.bucket,
+		Key:                  &key,
+		Body:                 r,
+		Metadata:             metadata,
+		ServerSideEncryption: s.cfg.ServerSideEncryption,
+		SSEKMSKeyId:          s.kmsKeyID(),
+		StorageClass:         s.cfg.StorageClass,
 	})
 	return err
 }
+
+func (s S3ObjectStore) kmsKeyID() *string {
+	if s.cfg.KMSKeyID == "" {
+		return nil
+	}
+	return &s.cfg.KMSKeyID
+}
+
+// LifecycleRule expires or transitions objects below Prefix to another
+// storage class after the configured number of days. A value of 0
+// disables the transition or expiration.
+type LifecycleRule struct {
+	ID                     string
+	Prefix                 string
+	TransitionDays         int32
+	TransitionStorageClass types.TransitionStorageClass
+	ExpirationDays         int32
+}
+
+// ApplyLifecycleRules adds the rules to the lifecycle configuration of
+// the bucket. Existing rules with the same id are replaced, all other
+// rules are kept, so rules configured by operators are not lost. Rules
+// that neither transition nor expire objects are removed.
+func (s S3ObjectStore) ApplyLifecycleRules(ctx context.Context, rules []LifecycleRule) error {
+	existing := make([]types.LifecycleRule, 0)
+
+	out, err := s.client.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: &s.bucket,
+	})
+	if err != nil {
+		var s3err smithy.APIError
+		if !errors.As(err, &s3err) || s3err.ErrorCode() != "NoSuchLifecycleConfiguration" {
+			return fmt.Errorf("get lifecycle configuration: %w", err)
+		}
+	} else {
+		existing = out.Rules
+	}
+
+	merged := slices.DeleteFunc(existing, func(r types.LifecycleRule) bool {
+		return r.ID != nil && slices.ContainsFunc(rules, func(rule LifecycleRule) bool {
+			return rule.ID == *r.ID
+		})
+	})
+
+	for _, rule := range rules {
+		if rule.TransitionDays == 0 && rule.ExpirationDays == 0 {
+			continue
+		}
+
+		r := types.LifecycleRule{
+			ID:     &rule.ID,
+			Status: types.ExpirationStatusEnabled,
+			Filter: &types.LifecycleRuleFilter{
+				Prefix: &rule.Prefix,
+			},
+		}
+
+		if rule.TransitionDays > 0 {
+			r.Transitions = []types.Transition{
+				{
+					Days:         &rule.TransitionDays,
+					StorageClass: rule.TransitionStorageClass,
+				},
+			}
+		}
+
+		if rule.ExpirationDays > 0 {
+			r.Expiration = &types.LifecycleExpiration{
+				Days: &rule.ExpirationDays,
+			}
+		}
+
+		merged = append(merged, r)
+	}
+
+	// s3 rejects empty lifecycle configurations.
+	if len(merged) == 0 {
+		if _, err := s.client.DeleteBucketLifecycle(ctx, &s3.DeleteBucketLifecycleInput{
+			Bucket: &s.bucket,
+		}); err != nil {
+			return fmt.Errorf("delete lifecycle configuration: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := s.client.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: &s.bucket,
+		LifecycleConfiguration: &types.BucketLifecycleConfiguration{
+			Rules: merged,
+		},
+	}); err != nil {
+		return fmt.Errorf("put lifecycle configuration: %w", err)
+	}
+
+	return nil
+}
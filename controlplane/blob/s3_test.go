@@ -0,0 +1,171 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package blob_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3ConfigValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   blob.S3Config
+		valid bool
+	}{
+		{
+			name:  "defaults",
+			valid: true,
+		},
+		{
+			name: "sse-kms with key",
+			cfg: blob.S3Config{
+				ServerSideEncryption: types.ServerSideEncryptionAwsKms,
+				KMSKeyID:             "key",
+				StorageClass:         types.StorageClassStandardIa,
+			},
+			valid: true,
+		},
+		{
+			name: "key without sse-kms",
+			cfg: blob.S3Config{
+				ServerSideEncryption: types.ServerSideEncryptionAes256,
+				KMSKeyID:             "key",
+			},
+		},
+		{
+			name: "unknown encryption",
+			cfg:  blob.S3Config{ServerSideEncryption: "rot13"},
+		},
+		{
+			name: "unknown storage class",
+			cfg:  blob.S3Config{StorageClass: "CHEAP"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestS3StorePresignURLSignsHeaders(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		client = newS3Client("http://localhost:9000")
+		store  = blob.NewS3Store("bucket", client, s3.NewPresignClient(client), blob.S3Config{
+			ServerSideEncryption: types.ServerSideEncryptionAwsKms,
+			KMSKeyID:             "key",
+			StorageClass:         types.StorageClassStandardIa,
+		})
+	)
+
+	u, _, err := store.PresignURL(ctx, blob.ChangeSetKey("abc"), "aGFzaA==", time.Minute, 10)
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(u)
+	require.NoError(t, err)
+
+	signed := strings.Split(parsed.Query().Get("X-Amz-SignedHeaders"), ";")
+	for h, v := range store.UploadHeaders() {
+		require.Contains(t, signed, h)
+		require.NotEmpty(t, v)
+	}
+
+	require.Equal(t, map[string]string{
+		"x-amz-server-side-encryption":                "aws:kms",
+		"x-amz-server-side-encryption-aws-kms-key-id": "key",
+		"x-amz-storage-class":                         "STANDARD_IA",
+	}, store.UploadHeaders())
+}
+
+func TestS3StoreApplyLifecycleRules(t *testing.T) {
+	const existing = `<LifecycleConfiguration>
+	<Rule>
+		<ID>operator</ID>
+		<Status>Enabled</Status>
+		<Filter><Prefix>tmp/</Prefix></Filter>
+		<Expiration><Days>1</Days></Expiration>
+	</Rule>
+	<Rule>
+		<ID>explorer-changesets</ID>
+		<Status>Enabled</Status>
+		<Filter><Prefix>explorer/changesets/</Prefix></Filter>
+		<Expiration><Days>7</Days></Expiration>
+	</Rule>
+</LifecycleConfiguration>`
+
+	var put string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(existing))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			put = string(body)
+		}
+	}))
+	defer srv.Close()
+
+	store := blob.NewS3Store("bucket", newS3Client(srv.URL), nil, blob.S3Config{})
+
+	err := store.ApplyLifecycleRules(context.Background(), []blob.LifecycleRule{
+		{
+			ID:                     "explorer-changesets",
+			Prefix:                 blob.ChangeSetKeyPrefix,
+			TransitionDays:         30,
+			TransitionStorageClass: types.TransitionStorageClassGlacierIr,
+		},
+	})
+	require.NoError(t, err)
+
+	// the rule of the operator is kept and ours is replaced.
+	require.Contains(t, put, "<ID>operator</ID>")
+	require.Equal(t, 1, strings.Count(put, "<ID>explorer-changesets</ID>"))
+	require.Contains(t, put, "<Days>30</Days><StorageClass>GLACIER_IR</StorageClass>")
+	require.NotContains(t, put, "<Days>7</Days>")
+}
+
+func newS3Client(endpoint string) *s3.Client {
+	return s3.New(s3.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: &endpoint,
+		UsePathStyle: true,
+		Credentials:  credentials.NewStaticCredentialsProvider("access", "secret", ""),
+	})
+}
@@ -0,0 +1,69 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package blob
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Store abstracts the object storage used for change sets, CAS blobs
+// and resource packs. Which implementation is used is determined by
+// the configured backend.
+type Store interface {
+	// PresignURL returns a URL that can be used by clients to upload an object
+	// with the given key directly to the store, without needing credentials.
+	// The content hash is the base64 encoded SHA256 digest of the object.
+	// Only the S3 and filesystem backends reject uploads not matching it.
+	PresignURL(
+		ctx context.Context,
+		key string,
+		contentHash string,
+		expiry time.Duration,
+		contentLength uint64,
+	) (string, time.Time, error)
+
+	// UploadHeaders returns the headers clients have to send along with
+	// the upload request targeting a URL returned by PresignURL.
+	UploadHeaders() map[string]string
+
+	// PresignDownloadURL returns a URL that can be used by clients to download
+	// the object with the given key directly from the store, without needing
+	// credentials.
+	PresignDownloadURL(ctx context.Context, key string, expiry time.Duration) (string, time.Time, error)
+
+	WriteTo(ctx context.Context, key string, w io.Writer) error
+	ObjectExists(ctx context.Context, key string) (bool, error)
+	PutBlob(ctx context.Context, keyPrefix string, objects []Object) error
+	SimplePut(ctx context.Context, key string, r io.Reader, metadata map[string]string) error
+
+	// Delete removes the object with the given key. Deleting an object
+	// that does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+type Backend string
+
+const (
+	BackendS3         Backend = "s3"
+	BackendGCS        Backend = "gcs"
+	BackendAzure      Backend = "azure"
+	BackendFilesystem Backend = "filesystem"
+)
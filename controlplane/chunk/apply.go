@@ -0,0 +1,632 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/lock"
+	"github.com/spacechunks/explorer/controlplane/schedule"
+	"github.com/spacechunks/explorer/internal/chunkname"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// chunkState is the current state of a chunk a spec is compared to.
+// the chunk id is empty if the chunk does not exist yet.
+type chunkState struct {
+	chunk       resource.Chunk
+	channels    map[string][]resource.FlavorChannel
+	autoscaling map[string]resource.AutoscalingPolicy
+	schedules   []resource.InstanceSchedule
+}
+
+// specPlan contains the changes needed to reach the spec and the steps
+// performing them. steps are run in order and may depend on ids assigned
+// by earlier steps, like the id of a newly created chunk.
+type specPlan struct {
+	changes []resource.ChunkSpecChange
+	steps   []specStep
+
+	chunkID   string
+	flavorIDs map[string]string
+}
+
+// specStep performs one or more changes at once.
+type specStep struct {
+	path string
+	run  func(ctx context.Context) error
+}
+
+func (p *specPlan) add(run func(ctx context.Context) error, changes ...resource.ChunkSpecChange) {
+	p.changes = append(p.changes, changes...)
+	p.steps = append(p.steps, specStep{path: changes[0].Path, run: run})
+}
+
+func (s *svc) ApplyChunkSpec(
+	ctx context.Context,
+	spec resource.ChunkSpec,
+	dryRun bool,
+	prune bool,
+) (resource.Chunk, []resource.ChunkSpecChange, error) {
+	if err := validateChunkName(spec.Name); err != nil {
+		return resource.Chunk{}, nil, err
+	}
+
+	if err := validateChunkFields(resource.Chunk{
+		Name:        spec.Name,
+		Description: spec.Description,
+		Tags:        spec.Tags,
+	}); err != nil {
+		return resource.Chunk{}, nil, err
+	}
+
+	if err := s.validateChunkSpec(spec); err != nil {
+		return resource.Chunk{}, nil, err
+	}
+
+	if dryRun {
+		return s.applyChunkSpec(ctx, spec, true, prune)
+	}
+
+	var (
+		chunk   resource.Chunk
+		changes []resource.ChunkSpecChange
+	)
+
+	// without the lock, concurrent applies would both create
+	// the missing parts, e.g. the same schedule twice.
+	locked, err := s.locker.TryLock(
+		ctx,
+		lock.ChunkSpecApplyKey(chunkname.Slug(spec.Name)),
+		func(ctx context.Context) error {
+			var err error
+			chunk, changes, err = s.applyChunkSpec(ctx, spec, false, prune)
+			return err
+		},
+	)
+	if err != nil {
+		return resource.Chunk{}, nil, err
+	}
+
+	if !locked {
+		return resource.Chunk{}, nil, apierrs.ErrAlreadyApplying
+	}
+
+	return chunk, changes, nil
+}
+
+func (s *svc) applyChunkSpec(
+	ctx context.Context,
+	spec resource.ChunkSpec,
+	dryRun bool,
+	prune bool,
+) (resource.Chunk, []resource.ChunkSpecChange, error) {
+	status, chunkID, err := s.CheckChunkName(ctx, spec.Name)
+	if err != nil {
+		return resource.Chunk{}, nil, fmt.Errorf("check chunk name: %w", err)
+	}
+
+	if status == resource.ChunkNameStatusTaken {
+		return resource.Chunk{}, nil, apierrs.ErrChunkNameTaken
+	}
+
+	st, err := s.chunkState(ctx, chunkID)
+	if err != nil {
+		return resource.Chunk{}, nil, err
+	}
+
+	plan, err := s.planChunkSpec(st, spec, prune)
+	if err != nil {
+		return resource.Chunk{}, nil, err
+	}
+
+	if dryRun {
+		return st.chunk, plan.changes, nil
+	}
+
+	// every step checks the current state again, so applying the
+	// spec after a failed step continues where it stopped.
+	for _, step := range plan.steps {
+		if err := step.run(ctx); err != nil {
+			return resource.Chunk{}, nil, fmt.Errorf("apply %s: %w", step.path, err)
+		}
+	}
+
+	c, err := s.GetChunk(ctx, plan.chunkID)
+	if err != nil {
+		return resource.Chunk{}, nil, fmt.Errorf("get chunk: %w", err)
+	}
+
+	return c, plan.changes, nil
+}
+
+// chunkState returns the state of the chunk with the given id. an
+// empty state is returned if the chunk does not exist yet.
+func (s *svc) chunkState(ctx context.Context, chunkID string) (chunkState, error) {
+	st := chunkState{
+		channels:    make(map[string][]resource.FlavorChannel),
+		autoscaling: make(map[string]resource.AutoscalingPolicy),
+	}
+
+	if chunkID == "" {
+		return st, nil
+	}
+
+	if err := s.authorized(ctx, chunkID); err != nil {
+		return chunkState{}, fmt.Errorf("authorize: %w", err)
+	}
+
+	c, err := s.GetChunk(ctx, chunkID)
+	if err != nil {
+		return chunkState{}, fmt.Errorf("get chunk: %w", err)
+	}
+
+	st.chunk = c
+
+	for _, f := range c.Flavors {
+		channels, err := s.repo.FlavorChannels(ctx, f.ID)
+		if err != nil {
+			return chunkState{}, fmt.Errorf("flavor channels: %w", err)
+		}
+
+		st.channels[f.ID] = channels
+
+		p, err := s.repo.AutoscalingPolicyByFlavorID(ctx, f.ID)
+		if err != nil && !errors.Is(err, apierrs.ErrNotFound) {
+			return chunkState{}, fmt.Errorf("autoscaling policy: %w", err)
+		}
+
+		if err == nil {
+			st.autoscaling[f.ID] = p
+		}
+	}
+
+	st.schedules, err = s.repo.InstanceSchedules(ctx, chunkID)
+	if err != nil {
+		return chunkState{}, fmt.Errorf("instance schedules: %w", err)
+	}
+
+	return st, nil
+}
+
+// validateChunkSpec checks the parts of the spec that do not
+// depend on the current state of the chunk.
+func (s *svc) validateChunkSpec(spec resource.ChunkSpec) error {
+	names := make(map[string]bool, len(spec.Flavors))
+	for i, f := range spec.Flavors {
+		if names[f.Name] {
+			return apierrs.InvalidChunkSpec(
+				fmt.Sprintf("flavors[%d].name", i),
+				fmt.Sprintf("flavor %q is specified more than once", f.Name),
+			)
+		}
+
+		names[f.Name] = true
+
+		a := f.Autoscaling
+		if a != nil && (a.MaxInstances < a.MinInstances || a.MaxInstances > s.cfg.AutoscalingMaxInstances) {
+			return apierrs.ErrInvalidAutoscalingPolicy
+		}
+	}
+
+	if len(spec.Schedules) > maxSchedulesPerChunk {
+		return apierrs.ErrTooManySchedules
+	}
+
+	for i, sched := range spec.Schedules {
+		if !names[sched.Flavor] {
+			return apierrs.InvalidChunkSpec(
+				fmt.Sprintf("schedules[%d].flavor", i),
+				fmt.Sprintf("flavor %q is not part of the spec", sched.Flavor),
+			)
+		}
+
+		if err := schedule.Validate(resource.InstanceSchedule{
+			StartCron: sched.StartCron,
+			StopCron:  sched.StopCron,
+			Timezone:  cmp.Or(sched.Timezone, time.UTC.String()),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// planChunkSpec compares the state to the spec. versions referenced by the
+// spec are resolved while planning, so no changes are made if one of them
+// cannot be used.
+func (s *svc) planChunkSpec(st chunkState, spec resource.ChunkSpec, prune bool) (*specPlan, error) {
+	plan := &specPlan{
+		chunkID:   st.chunk.ID,
+		flavorIDs: make(map[string]string),
+	}
+
+	s.planChunk(plan, st, spec)
+
+	current := make(map[string]resource.Flavor, len(st.chunk.Flavors))
+	for _, f := range st.chunk.Flavors {
+		current[f.Name] = f
+	}
+
+	for _, f := range spec.Flavors {
+		if cur, ok := current[f.Name]; ok {
+			plan.flavorIDs[f.Name] = cur.ID
+			continue
+		}
+
+		plan.add(func(ctx context.Context) error {
+			created, err := s.CreateFlavor(ctx, plan.chunkID, resource.Flavor{Name: f.Name})
+			if err != nil {
+				return err
+			}
+			plan.flavorIDs[f.Name] = created.ID
+			return nil
+		}, resource.ChunkSpecChange{
+			Action: resource.ChunkSpecChangeCreate,
+			Path:   "flavors/" + f.Name,
+		})
+	}
+
+	for i, f := range spec.Flavors {
+		if err := s.planChannels(plan, st, current[f.Name], f, i); err != nil {
+			return nil, err
+		}
+		s.planAutoscaling(plan, st, current[f.Name], f)
+	}
+
+	if err := s.planSchedules(plan, st, current, spec); err != nil {
+		return nil, err
+	}
+
+	if !prune {
+		return plan, nil
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(current)) {
+		if slices.ContainsFunc(spec.Flavors, func(f resource.FlavorSpec) bool { return f.Name == name }) {
+			continue
+		}
+
+		id := current[name].ID
+		plan.add(func(ctx context.Context) error {
+			return s.DeleteFlavor(ctx, id)
+		}, resource.ChunkSpecChange{
+			Action: resource.ChunkSpecChangeDelete,
+			Path:   "flavors/" + name,
+		})
+	}
+
+	return plan, nil
+}
+
+func (s *svc) planChunk(plan *specPlan, st chunkState, spec resource.ChunkSpec) {
+	if st.chunk.ID == "" {
+		plan.add(func(ctx context.Context) error {
+			c, err := s.CreateChunk(ctx, resource.Chunk{
+				Name:        spec.Name,
+				Description: spec.Description,
+				Tags:        spec.Tags,
+			})
+			if err != nil {
+				return err
+			}
+			plan.chunkID = c.ID
+			return nil
+		}, resource.ChunkSpecChange{
+			Action:   resource.ChunkSpecChangeCreate,
+			Path:     "chunk",
+			NewValue: spec.Name,
+		})
+		return
+	}
+
+	var (
+		c       = st.chunk
+		changes []resource.ChunkSpecChange
+	)
+
+	// the spec can refer to the chunk using one of its previous
+	// names, in which case the chunk is renamed back.
+	if c.Name != spec.Name {
+		changes = append(changes, resource.ChunkSpecChange{
+			Action:   resource.ChunkSpecChangeUpdate,
+			Path:     "name",
+			OldValue: c.Name,
+			NewValue: spec.Name,
+		})
+	}
+
+	if c.Description != spec.Description {
+		changes = append(changes, resource.ChunkSpecChange{
+			Action:   resource.ChunkSpecChangeUpdate,
+			Path:     "description",
+			OldValue: c.Description,
+			NewValue: spec.Description,
+		})
+	}
+
+	if !slices.Equal(c.Tags, spec.Tags) {
+		changes = append(changes, resource.ChunkSpecChange{
+			Action:   resource.ChunkSpecChangeUpdate,
+			Path:     "tags",
+			OldValue: strings.Join(c.Tags, ","),
+			NewValue: strings.Join(spec.Tags, ","),
+		})
+	}
+
+	if len(changes) == 0 {
+		return
+	}
+
+	c.Name = spec.Name
+	c.Description = spec.Description
+	c.Tags = spec.Tags
+	if c.Tags == nil {
+		c.Tags = []string{}
+	}
+
+	// UpdateChunk keeps fields that are empty, but the
+	// spec has to be able to clear the description.
+	plan.add(func(ctx context.Context) error {
+		_, err := s.repo.UpdateChunk(ctx, c)
+		return err
+	}, changes...)
+}
+
+func (s *svc) planChannels(
+	plan *specPlan,
+	st chunkState,
+	cur resource.Flavor,
+	spec resource.FlavorSpec,
+	idx int,
+) error {
+	for _, name := range slices.Sorted(maps.Keys(spec.Channels)) {
+		field := fmt.Sprintf("flavors[%d].channels[%s]", idx, name)
+
+		v, err := resolveVersion(cur, spec.Name, spec.Channels[name], field)
+		if err != nil {
+			return err
+		}
+
+		change := resource.ChunkSpecChange{
+			Action:   resource.ChunkSpecChangeCreate,
+			Path:     "flavors/" + spec.Name + "/channels/" + name,
+			NewValue: v.Version,
+		}
+
+		pos := slices.IndexFunc(st.channels[cur.ID], func(c resource.FlavorChannel) bool {
+			return c.Name == name
+		})
+		if pos != -1 {
+			published := st.channels[cur.ID][pos].FlavorVersionID
+			if published == v.ID {
+				continue
+			}
+
+			change.Action = resource.ChunkSpecChangeUpdate
+			change.OldValue = versionName(cur, published)
+		}
+
+		plan.add(func(ctx context.Context) error {
+			_, err := s.PromoteVersion(ctx, v.ID, name)
+			return err
+		}, change)
+	}
+
+	return nil
+}
+
+func (s *svc) planAutoscaling(plan *specPlan, st chunkState, cur resource.Flavor, spec resource.FlavorSpec) {
+	var (
+		path          = "flavors/" + spec.Name + "/autoscaling"
+		policy, found = st.autoscaling[cur.ID]
+	)
+
+	if spec.Autoscaling == nil {
+		if !found {
+			return
+		}
+
+		plan.add(func(ctx context.Context) error {
+			return s.DeleteAutoscalingPolicy(ctx, cur.ID)
+		}, resource.ChunkSpecChange{
+			Action:   resource.ChunkSpecChangeDelete,
+			Path:     path,
+			OldValue: formatAutoscaling(policy.MinInstances, policy.MaxInstances, policy.TargetUtilization),
+		})
+		return
+	}
+
+	var (
+		a      = spec.Autoscaling
+		target = cmp.Or(a.TargetUtilization, defaultTargetUtilization)
+		change = resource.ChunkSpecChange{
+			Action:   resource.ChunkSpecChangeCreate,
+			Path:     path,
+			NewValue: formatAutoscaling(a.MinInstances, a.MaxInstances, target),
+		}
+	)
+
+	if found {
+		if policy.MinInstances == a.MinInstances &&
+			policy.MaxInstances == a.MaxInstances &&
+			policy.TargetUtilization == target {
+			return
+		}
+
+		change.Action = resource.ChunkSpecChangeUpdate
+		change.OldValue = formatAutoscaling(policy.MinInstances, policy.MaxInstances, policy.TargetUtilization)
+	}
+
+	plan.add(func(ctx context.Context) error {
+		// cooldowns are not part of the spec, so the
+		// ones of the current policy are kept.
+		_, err := s.SetAutoscalingPolicy(ctx, resource.AutoscalingPolicy{
+			FlavorID:          plan.flavorIDs[spec.Name],
+			MinInstances:      a.MinInstances,
+			MaxInstances:      a.MaxInstances,
+			TargetUtilization: target,
+			ScaleUpCooldown:   policy.ScaleUpCooldown,
+			ScaleDownCooldown: policy.ScaleDownCooldown,
+		})
+		return err
+	}, change)
+}
+
+// planSchedules matches the schedules of the spec to the existing ones.
+// schedules have no name, so schedules are only kept if all of their
+// fields are the same. other schedules are deleted before the missing
+// ones are created, so the limit of schedules is not exceeded.
+func (s *svc) planSchedules(
+	plan *specPlan,
+	st chunkState,
+	current map[string]resource.Flavor,
+	spec resource.ChunkSpec,
+) error {
+	type key struct {
+		flavorVersionID string
+		startCron       string
+		stopCron        string
+		timezone        string
+	}
+
+	existing := make(map[key][]resource.InstanceSchedule)
+	for _, sched := range st.schedules {
+		k := key{sched.FlavorVersionID, sched.StartCron, sched.StopCron, sched.Timezone}
+		existing[k] = append(existing[k], sched)
+	}
+
+	var missing []resource.InstanceSchedule
+	for i, sched := range spec.Schedules {
+		v, err := resolveVersion(
+			current[sched.Flavor],
+			sched.Flavor,
+			sched.Version,
+			fmt.Sprintf("schedules[%d].version", i),
+		)
+		if err != nil {
+			return err
+		}
+
+		k := key{v.ID, sched.StartCron, sched.StopCron, cmp.Or(sched.Timezone, time.UTC.String())}
+		if len(existing[k]) > 0 {
+			existing[k] = existing[k][1:]
+			continue
+		}
+
+		missing = append(missing, resource.InstanceSchedule{
+			FlavorVersionID: k.flavorVersionID,
+			StartCron:       k.startCron,
+			StopCron:        k.stopCron,
+			Timezone:        k.timezone,
+		})
+	}
+
+	// keep the order of the schedules as returned by the repository.
+	for _, sched := range st.schedules {
+		k := key{sched.FlavorVersionID, sched.StartCron, sched.StopCron, sched.Timezone}
+		if !slices.ContainsFunc(existing[k], func(e resource.InstanceSchedule) bool { return e.ID == sched.ID }) {
+			continue
+		}
+
+		plan.add(func(ctx context.Context) error {
+			return s.DeleteSchedule(ctx, sched.ID)
+		}, resource.ChunkSpecChange{
+			Action:   resource.ChunkSpecChangeDelete,
+			Path:     "schedules/" + scheduleTarget(st.chunk, sched.FlavorVersionID),
+			OldValue: formatSchedule(sched),
+		})
+	}
+
+	for _, sched := range missing {
+		plan.add(func(ctx context.Context) error {
+			sched.ChunkID = plan.chunkID
+			_, err := s.CreateSchedule(ctx, sched)
+			return err
+		}, resource.ChunkSpecChange{
+			Action:   resource.ChunkSpecChangeCreate,
+			Path:     "schedules/" + scheduleTarget(st.chunk, sched.FlavorVersionID),
+			NewValue: formatSchedule(sched),
+		})
+	}
+
+	return nil
+}
+
+// resolveVersion returns the version of the flavor with the given name.
+// versions have to be built, because channels and schedules launch
+// instances of them without further checks.
+func resolveVersion(f resource.Flavor, flavor string, version string, field string) (resource.FlavorVersion, error) {
+	idx := slices.IndexFunc(f.Versions, func(v resource.FlavorVersion) bool {
+		return v.Version == version
+	})
+	if idx == -1 {
+		return resource.FlavorVersion{}, apierrs.InvalidChunkSpec(
+			field,
+			fmt.Sprintf("version %q of flavor %q has not been published", version, flavor),
+		)
+	}
+
+	if f.Versions[idx].BuildStatus != resource.FlavorVersionBuildStatusCompleted {
+		return resource.FlavorVersion{}, apierrs.ErrFlavorVersionNotBuilt
+	}
+
+	return f.Versions[idx], nil
+}
+
+// versionName returns the version with the given id, or the
+// id if the version is not part of the flavor anymore.
+func versionName(f resource.Flavor, versionID string) string {
+	for _, v := range f.Versions {
+		if v.ID == versionID {
+			return v.Version
+		}
+	}
+	return versionID
+}
+
+// scheduleTarget returns flavor/version for the flavor version the schedule
+// launches, or its id if the version is not part of the chunk anymore.
+func scheduleTarget(c resource.Chunk, versionID string) string {
+	for _, f := range c.Flavors {
+		for _, v := range f.Versions {
+			if v.ID == versionID {
+				return f.Name + "/" + v.Version
+			}
+		}
+	}
+	return versionID
+}
+
+func formatAutoscaling(minInstances uint32, maxInstances uint32, target uint32) string {
+	return fmt.Sprintf("min=%d max=%d target=%d%%", minInstances, maxInstances, target)
+}
+
+func formatSchedule(sched resource.InstanceSchedule) string {
+	return fmt.Sprintf("start=%q stop=%q timezone=%s", sched.StartCron, sched.StopCron, sched.Timezone)
+}
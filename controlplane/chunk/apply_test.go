@@ -0,0 +1,361 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestApplyChunkSpecDryRun(t *testing.T) {
+	var (
+		flavor = fixture.Flavor(func(f *resource.Flavor) {
+			f.Name = "flavor1"
+			f.Versions[0].BuildStatus = resource.FlavorVersionBuildStatusCompleted
+			f.Versions[1].BuildStatus = resource.FlavorVersionBuildStatusCompleted
+		})
+		other = fixture.Flavor(func(f *resource.Flavor) {
+			f.ID = "019da1b2-7a4e-7c1d-9b0f-3e2b8c1d4f5a"
+			f.Name = "flavor2"
+			f.Versions = nil
+		})
+		c = fixture.Chunk(func(c *resource.Chunk) {
+			c.Flavors = []resource.Flavor{flavor, other}
+		})
+		v1 = flavor.Versions[1]
+		v2 = flavor.Versions[0]
+
+		// spec describes the current state of the chunk.
+		spec = resource.ChunkSpec{
+			Name:        c.Name,
+			Description: c.Description,
+			Tags:        c.Tags,
+			Flavors: []resource.FlavorSpec{
+				{
+					Name:     flavor.Name,
+					Channels: map[string]string{resource.FlavorChannelStable: v1.Version},
+					Autoscaling: &resource.AutoscalingSpec{
+						MinInstances:      1,
+						MaxInstances:      3,
+						TargetUtilization: 70,
+					},
+				},
+				{
+					Name: other.Name,
+				},
+			},
+			Schedules: []resource.ScheduleSpec{
+				{
+					Flavor:    flavor.Name,
+					Version:   v1.Version,
+					StartCron: "0 18 * * FRI",
+					StopCron:  "0 23 * * FRI",
+				},
+			},
+		}
+	)
+
+	existing := func(repo *mock.MockChunkRepository) {
+		repo.EXPECT().ChunkIDByName(mocky.Anything, c.Name).Return(c.ID, nil)
+		repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+		repo.EXPECT().
+			FlavorChannels(mocky.Anything, flavor.ID).
+			Return([]resource.FlavorChannel{
+				{
+					FlavorID:        flavor.ID,
+					Name:            resource.FlavorChannelStable,
+					FlavorVersionID: v1.ID,
+				},
+			}, nil)
+		repo.EXPECT().FlavorChannels(mocky.Anything, other.ID).Return(nil, nil)
+		repo.EXPECT().
+			AutoscalingPolicyByFlavorID(mocky.Anything, flavor.ID).
+			Return(resource.AutoscalingPolicy{
+				FlavorID:          flavor.ID,
+				MinInstances:      1,
+				MaxInstances:      3,
+				TargetUtilization: 70,
+			}, nil)
+		repo.EXPECT().
+			AutoscalingPolicyByFlavorID(mocky.Anything, other.ID).
+			Return(resource.AutoscalingPolicy{}, apierrs.ErrNotFound)
+		repo.EXPECT().
+			InstanceSchedules(mocky.Anything, c.ID).
+			Return([]resource.InstanceSchedule{
+				{
+					ID:              "019da1b9-0c3e-7f2a-8d41-6b5e9a7c3d10",
+					ChunkID:         c.ID,
+					FlavorVersionID: v1.ID,
+					StartCron:       "0 18 * * FRI",
+					StopCron:        "0 23 * * FRI",
+					Timezone:        "UTC",
+				},
+			}, nil)
+	}
+
+	tests := []struct {
+		name     string
+		spec     func(resource.ChunkSpec) resource.ChunkSpec
+		prune    bool
+		expected []resource.ChunkSpecChange
+		code     codes.Code
+		prep     func(*mock.MockChunkRepository)
+	}{
+		{
+			name: "new chunk",
+			spec: func(s resource.ChunkSpec) resource.ChunkSpec {
+				s.Flavors = []resource.FlavorSpec{
+					{
+						Name: "flavor1",
+						Autoscaling: &resource.AutoscalingSpec{
+							MinInstances: 0,
+							MaxInstances: 2,
+						},
+					},
+				}
+				s.Schedules = nil
+				return s
+			},
+			expected: []resource.ChunkSpecChange{
+				{
+					Action:   resource.ChunkSpecChangeCreate,
+					Path:     "chunk",
+					NewValue: c.Name,
+				},
+				{
+					Action: resource.ChunkSpecChangeCreate,
+					Path:   "flavors/flavor1",
+				},
+				{
+					Action:   resource.ChunkSpecChangeCreate,
+					Path:     "flavors/flavor1/autoscaling",
+					NewValue: "min=0 max=2 target=80%",
+				},
+			},
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().ChunkIDByName(mocky.Anything, c.Name).Return("", apierrs.ErrNotFound)
+			},
+		},
+		{
+			name: "no changes",
+			spec: func(s resource.ChunkSpec) resource.ChunkSpec {
+				return s
+			},
+			prep: existing,
+		},
+		{
+			name: "changes",
+			spec: func(s resource.ChunkSpec) resource.ChunkSpec {
+				s.Description = ""
+				s.Flavors = []resource.FlavorSpec{
+					{
+						Name: flavor.Name,
+						Channels: map[string]string{
+							resource.FlavorChannelStable: v2.Version,
+							"beta":                       v2.Version,
+						},
+						Autoscaling: &resource.AutoscalingSpec{
+							MinInstances:      2,
+							MaxInstances:      3,
+							TargetUtilization: 70,
+						},
+					},
+				}
+				s.Schedules = []resource.ScheduleSpec{
+					{
+						Flavor:    flavor.Name,
+						Version:   v2.Version,
+						StartCron: "0 18 * * FRI",
+						StopCron:  "0 23 * * FRI",
+					},
+				}
+				return s
+			},
+			expected: []resource.ChunkSpecChange{
+				{
+					Action:   resource.ChunkSpecChangeUpdate,
+					Path:     "description",
+					OldValue: c.Description,
+				},
+				{
+					Action:   resource.ChunkSpecChangeCreate,
+					Path:     "flavors/flavor1/channels/beta",
+					NewValue: v2.Version,
+				},
+				{
+					Action:   resource.ChunkSpecChangeUpdate,
+					Path:     "flavors/flavor1/channels/stable",
+					OldValue: v1.Version,
+					NewValue: v2.Version,
+				},
+				{
+					Action:   resource.ChunkSpecChangeUpdate,
+					Path:     "flavors/flavor1/autoscaling",
+					OldValue: "min=1 max=3 target=70%",
+					NewValue: "min=2 max=3 target=70%",
+				},
+				{
+					Action:   resource.ChunkSpecChangeDelete,
+					Path:     "schedules/flavor1/v1",
+					OldValue: `start="0 18 * * FRI" stop="0 23 * * FRI" timezone=UTC`,
+				},
+				{
+					Action:   resource.ChunkSpecChangeCreate,
+					Path:     "schedules/flavor1/v2",
+					NewValue: `start="0 18 * * FRI" stop="0 23 * * FRI" timezone=UTC`,
+				},
+			},
+			prep: existing,
+		},
+		{
+			name: "flavors are only deleted when pruning",
+			spec: func(s resource.ChunkSpec) resource.ChunkSpec {
+				s.Flavors = s.Flavors[:1]
+				return s
+			},
+			prune: true,
+			expected: []resource.ChunkSpecChange{
+				{
+					Action: resource.ChunkSpecChangeDelete,
+					Path:   "flavors/flavor2",
+				},
+			},
+			prep: existing,
+		},
+		{
+			name: "version not published",
+			spec: func(s resource.ChunkSpec) resource.ChunkSpec {
+				s.Flavors[0].Channels = map[string]string{resource.FlavorChannelStable: "v3"}
+				return s
+			},
+			code: codes.InvalidArgument,
+			prep: existing,
+		},
+		{
+			name: "schedule for flavor not in spec",
+			spec: func(s resource.ChunkSpec) resource.ChunkSpec {
+				s.Schedules = []resource.ScheduleSpec{
+					{
+						Flavor:    "flavor3",
+						Version:   v1.Version,
+						StartCron: "0 18 * * FRI",
+					},
+				}
+				return s
+			},
+			code: codes.InvalidArgument,
+			prep: func(*mock.MockChunkRepository) {},
+		},
+		{
+			name: "name taken",
+			spec: func(s resource.ChunkSpec) resource.ChunkSpec {
+				return s
+			},
+			code: codes.AlreadyExists,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().ChunkIDByName(mocky.Anything, c.Name).Return(c.ID, nil)
+				repo.EXPECT().
+					GetChunkByID(mocky.Anything, c.ID).
+					Return(fixture.Chunk(func(c *resource.Chunk) {
+						c.Owner.ID = "019da1c4-5e8b-7a93-b2d6-1f0c4e7a9b38"
+					}), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, c.Owner.ID)
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				mockAccess,
+				chunk.Config{
+					AutoscalingMaxInstances: 10,
+				},
+			)
+			require.NoError(t, err)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil).
+				Maybe()
+
+			tt.prep(mockRepo)
+
+			_, changes, err := svc.ApplyChunkSpec(ctx, tt.spec(spec), true, tt.prune)
+			if tt.code != codes.OK {
+				var apiErr apierrs.Error
+				require.ErrorAs(t, err, &apiErr)
+				require.Equal(t, tt.code, apiErr.GRPCStatus().Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, changes)
+		})
+	}
+}
+
+func TestApplyChunkSpecLocked(t *testing.T) {
+	var (
+		c          = fixture.Chunk()
+		ctx        = context.WithValue(context.Background(), contextkey.ActorID, c.Owner.ID)
+		mockRepo   = mock.NewMockChunkRepository(t)
+		mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+		mockLocker = mock.NewMockLockLocker(t)
+	)
+
+	svc, err := chunk.NewService(
+		slog.New(slog.NewTextHandler(os.Stdout, nil)),
+		mockRepo,
+		nil,
+		mockLocker,
+		nil,
+		mockAccess,
+		chunk.Config{},
+	)
+	require.NoError(t, err)
+
+	mockLocker.EXPECT().
+		TryLock(mocky.Anything, "chunk-spec-apply/chunk-fixture", mocky.Anything).
+		Return(false, nil)
+
+	_, _, err = svc.ApplyChunkSpec(ctx, resource.ChunkSpec{Name: c.Name}, false, false)
+	require.ErrorIs(t, err, apierrs.ErrAlreadyApplying)
+}
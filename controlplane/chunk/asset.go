@@ -0,0 +1,82 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) CreateAsset(
+	ctx context.Context,
+	asset resource.Asset,
+) (resource.Asset, string, map[string]string, error) {
+	// assets are not owned by anyone, because they are shared between
+	// flavor versions. referencing an asset requires knowing its hash,
+	// so only users having the content of the bundle are able to use it.
+	if _, ok := ctx.Value(contextkey.ActorID).(string); !ok {
+		return resource.Asset{}, "", nil, errors.New("actor_id not found in context")
+	}
+
+	digest, err := hex.DecodeString(asset.Hash)
+	if err != nil || len(digest) != 32 {
+		return resource.Asset{}, "", nil, apierrs.ErrInvalidHash
+	}
+
+	if asset.SizeBytes > s.cfg.AssetMaxSizeBytes {
+		return resource.Asset{}, "", nil, apierrs.ErrAssetTooBig
+	}
+
+	created, err := s.repo.CreateAsset(ctx, asset)
+	if err != nil {
+		return resource.Asset{}, "", nil, fmt.Errorf("create asset: %w", err)
+	}
+
+	uploaded, err := s.blobStore.ObjectExists(ctx, blob.AssetKey(asset.Hash))
+	if err != nil {
+		return resource.Asset{}, "", nil, fmt.Errorf("asset exists: %w", err)
+	}
+
+	if uploaded {
+		return created, "", nil, nil
+	}
+
+	// the store verifies the uploaded content against the digest,
+	// so the bundle is guaranteed to match the hash of the asset.
+	url, _, err := s.blobStore.PresignURL(
+		ctx,
+		blob.AssetKey(asset.Hash),
+		base64.StdEncoding.EncodeToString(digest),
+		s.cfg.PresignedURLExpiry,
+		asset.SizeBytes,
+	)
+	if err != nil {
+		return resource.Asset{}, "", nil, fmt.Errorf("presign: %w", err)
+	}
+
+	return created, url, s.blobStore.UploadHeaders(), nil
+}
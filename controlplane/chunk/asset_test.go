@@ -0,0 +1,135 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAsset(t *testing.T) {
+	var (
+		hash = strings.Repeat("ab", 32)
+		cfg  = chunk.Config{
+			PresignedURLExpiry: time.Minute,
+			AssetMaxSizeBytes:  100,
+		}
+	)
+
+	tests := []struct {
+		name        string
+		asset       resource.Asset
+		prep        func(*mock.MockChunkRepository, *mock.MockBlobStore)
+		expectedURL string
+		err         error
+	}{
+		{
+			name:  "presign url if bundle has not been uploaded",
+			asset: resource.Asset{Hash: hash, SizeBytes: 10},
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().
+					CreateAsset(mocky.Anything, resource.Asset{Hash: hash, SizeBytes: 10}).
+					Return(resource.Asset{Hash: hash, SizeBytes: 10}, nil)
+
+				store.EXPECT().ObjectExists(mocky.Anything, blob.AssetKey(hash)).Return(false, nil)
+
+				store.EXPECT().
+					PresignURL(
+						mocky.Anything,
+						blob.AssetKey(hash),
+						"q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s=",
+						cfg.PresignedURLExpiry,
+						uint64(10),
+					).
+					Return("https://example.com/asset", time.Now(), nil)
+
+				store.EXPECT().UploadHeaders().Return(nil)
+			},
+			expectedURL: "https://example.com/asset",
+		},
+		{
+			name:  "no url if bundle has already been uploaded",
+			asset: resource.Asset{Hash: hash, SizeBytes: 10},
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().
+					CreateAsset(mocky.Anything, resource.Asset{Hash: hash, SizeBytes: 10}).
+					Return(resource.Asset{Hash: hash, SizeBytes: 10}, nil)
+
+				store.EXPECT().ObjectExists(mocky.Anything, blob.AssetKey(hash)).Return(true, nil)
+			},
+		},
+		{
+			name:  "invalid hash",
+			asset: resource.Asset{Hash: "abc", SizeBytes: 10},
+			prep:  func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			err:   apierrs.ErrInvalidHash,
+		},
+		{
+			name:  "bundle too big",
+			asset: resource.Asset{Hash: hash, SizeBytes: 101},
+			prep:  func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			err:   apierrs.ErrAssetTooBig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockRepo  = mock.NewMockChunkRepository(t)
+				mockStore = mock.NewMockBlobStore(t)
+			)
+
+			tt.prep(mockRepo, mockStore)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				mockStore,
+				mock.NewMockAuthzAccessEvaluator(t),
+				cfg,
+			)
+			require.NoError(t, err)
+
+			asset, url, _, err := svc.CreateAsset(ctx, tt.asset)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.asset.Hash, asset.Hash)
+			require.Equal(t, tt.expectedURL, url)
+		})
+	}
+}
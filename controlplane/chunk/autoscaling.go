@@ -0,0 +1,100 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+const (
+	defaultTargetUtilization = 80
+	defaultScaleUpCooldown   = time.Minute
+	defaultScaleDownCooldown = 10 * time.Minute
+)
+
+func (s *svc) SetAutoscalingPolicy(
+	ctx context.Context,
+	policy resource.AutoscalingPolicy,
+) (resource.AutoscalingPolicy, error) {
+	if err := s.authorizeFlavor(ctx, policy.FlavorID); err != nil {
+		return resource.AutoscalingPolicy{}, err
+	}
+
+	f, err := s.repo.FlavorByID(ctx, policy.FlavorID)
+	if err != nil {
+		return resource.AutoscalingPolicy{}, fmt.Errorf("flavor by id: %w", err)
+	}
+
+	if f.DeletedAt != nil {
+		return resource.AutoscalingPolicy{}, apierrs.ErrNotFound
+	}
+
+	if policy.MaxInstances < policy.MinInstances || policy.MaxInstances > s.cfg.AutoscalingMaxInstances {
+		return resource.AutoscalingPolicy{}, apierrs.ErrInvalidAutoscalingPolicy
+	}
+
+	if policy.TargetUtilization == 0 {
+		policy.TargetUtilization = defaultTargetUtilization
+	}
+
+	if policy.ScaleUpCooldown == 0 {
+		policy.ScaleUpCooldown = defaultScaleUpCooldown
+	}
+
+	if policy.ScaleDownCooldown == 0 {
+		policy.ScaleDownCooldown = defaultScaleDownCooldown
+	}
+
+	p, err := s.repo.UpsertAutoscalingPolicy(ctx, policy)
+	if err != nil {
+		return resource.AutoscalingPolicy{}, fmt.Errorf("upsert autoscaling policy: %w", err)
+	}
+
+	return p, nil
+}
+
+func (s *svc) GetAutoscalingPolicy(ctx context.Context, flavorID string) (resource.AutoscalingPolicy, error) {
+	if err := s.authorizeFlavor(ctx, flavorID); err != nil {
+		return resource.AutoscalingPolicy{}, err
+	}
+
+	p, err := s.repo.AutoscalingPolicyByFlavorID(ctx, flavorID)
+	if err != nil {
+		return resource.AutoscalingPolicy{}, fmt.Errorf("autoscaling policy: %w", err)
+	}
+
+	return p, nil
+}
+
+func (s *svc) DeleteAutoscalingPolicy(ctx context.Context, flavorID string) error {
+	if err := s.authorizeFlavor(ctx, flavorID); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteAutoscalingPolicy(ctx, flavorID); err != nil {
+		return fmt.Errorf("delete autoscaling policy: %w", err)
+	}
+
+	return nil
+}
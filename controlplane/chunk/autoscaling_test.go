@@ -0,0 +1,138 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAutoscalingPolicy(t *testing.T) {
+	var (
+		f      = fixture.Flavor()
+		policy = resource.AutoscalingPolicy{
+			FlavorID:     f.ID,
+			MinInstances: 1,
+			MaxInstances: 3,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		policy resource.AutoscalingPolicy
+		err    error
+		prep   func(*mock.MockChunkRepository)
+	}{
+		{
+			name:   "unset fields are defaulted",
+			policy: policy,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().FlavorByID(mocky.Anything, f.ID).Return(f, nil)
+
+				expected := policy
+				expected.TargetUtilization = 80
+				expected.ScaleUpCooldown = time.Minute
+				expected.ScaleDownCooldown = 10 * time.Minute
+
+				repo.EXPECT().UpsertAutoscalingPolicy(mocky.Anything, expected).Return(expected, nil)
+			},
+		},
+		{
+			name: "max instances lower than min instances",
+			policy: resource.AutoscalingPolicy{
+				FlavorID:     f.ID,
+				MinInstances: 3,
+				MaxInstances: 2,
+			},
+			err: apierrs.ErrInvalidAutoscalingPolicy,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().FlavorByID(mocky.Anything, f.ID).Return(f, nil)
+			},
+		},
+		{
+			name: "max instances exceed limit",
+			policy: resource.AutoscalingPolicy{
+				FlavorID:     f.ID,
+				MaxInstances: 6,
+			},
+			err: apierrs.ErrInvalidAutoscalingPolicy,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().FlavorByID(mocky.Anything, f.ID).Return(f, nil)
+			},
+		},
+		{
+			name:   "flavor deleted",
+			policy: policy,
+			err:    apierrs.ErrNotFound,
+			prep: func(repo *mock.MockChunkRepository) {
+				deleted := f
+				deleted.DeletedAt = &f.UpdatedAt
+				repo.EXPECT().FlavorByID(mocky.Anything, f.ID).Return(deleted, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				mockAccess,
+				chunk.Config{
+					AutoscalingMaxInstances: 5,
+				},
+			)
+			require.NoError(t, err)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil)
+
+			tt.prep(mockRepo)
+
+			_, err = svc.SetAutoscalingPolicy(ctx, tt.policy)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
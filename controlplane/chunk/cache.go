@@ -0,0 +1,428 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+type cacheEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+// CachedRepository caches chunk, flavor version and minecraft version
+// metadata in memory. writes made through the repository are published
+// to the event bus and cached entries affected by them are invalidated.
+// changes that are made by other processes become visible once the
+// cached entry expires.
+type CachedRepository struct {
+	Repository
+	bus *event.Bus
+	ttl time.Duration
+
+	mu         sync.Mutex
+	generation uint64
+	chunks     map[string]cacheEntry[resource.Chunk]
+	versions   map[string]cacheEntry[resource.FlavorVersion]
+	mcVersions map[string]cacheEntry[resource.MinecraftVersion]
+	supported  *cacheEntry[[]string]
+
+	// used to look up the cached chunk a flavor
+	// or flavor version belongs to.
+	flavorChunks  map[string]string
+	versionChunks map[string]string
+}
+
+func NewCachedRepository(repo Repository, bus *event.Bus, ttl time.Duration) *CachedRepository {
+	r := &CachedRepository{
+		Repository:    repo,
+		bus:           bus,
+		ttl:           ttl,
+		chunks:        make(map[string]cacheEntry[resource.Chunk]),
+		versions:      make(map[string]cacheEntry[resource.FlavorVersion]),
+		mcVersions:    make(map[string]cacheEntry[resource.MinecraftVersion]),
+		flavorChunks:  make(map[string]string),
+		versionChunks: make(map[string]string),
+	}
+	bus.Subscribe(r.invalidate)
+	return r
+}
+
+func (r *CachedRepository) GetChunkByID(ctx context.Context, id string) (resource.Chunk, error) {
+	if c, ok := lookup(r, r.chunks, id); ok {
+		return cloneChunk(c), nil
+	}
+
+	gen := r.currentGeneration()
+
+	c, err := r.Repository.GetChunkByID(ctx, id)
+	if err != nil {
+		return resource.Chunk{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// the chunk could have been changed while we were fetching it,
+	// in which case we do not know if our copy is still up-to-date.
+	if gen == r.generation {
+		r.chunks[id] = cacheEntry[resource.Chunk]{
+			value:     cloneChunk(c),
+			expiresAt: time.Now().Add(r.ttl),
+		}
+		for _, f := range c.Flavors {
+			r.flavorChunks[f.ID] = c.ID
+			for _, v := range f.Versions {
+				r.versionChunks[v.ID] = c.ID
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func (r *CachedRepository) FlavorVersionByID(ctx context.Context, id string) (resource.FlavorVersion, error) {
+	if v, ok := lookup(r, r.versions, id); ok {
+		return cloneFlavorVersion(v), nil
+	}
+
+	gen := r.currentGeneration()
+
+	v, err := r.Repository.FlavorVersionByID(ctx, id)
+	if err != nil {
+		return resource.FlavorVersion{}, err
+	}
+
+	store(r, gen, r.versions, id, cloneFlavorVersion(v))
+	return v, nil
+}
+
+func (r *CachedRepository) GetMinecraftVersionByVersion(
+	ctx context.Context,
+	version string,
+) (resource.MinecraftVersion, error) {
+	if v, ok := lookup(r, r.mcVersions, version); ok {
+		return v, nil
+	}
+
+	gen := r.currentGeneration()
+
+	v, err := r.Repository.GetMinecraftVersionByVersion(ctx, version)
+	if err != nil {
+		return resource.MinecraftVersion{}, err
+	}
+
+	store(r, gen, r.mcVersions, version, v)
+	return v, nil
+}
+
+func (r *CachedRepository) SupportedMinecraftVersions(ctx context.Context) ([]string, error) {
+	r.mu.Lock()
+	if r.supported != nil && time.Now().Before(r.supported.expiresAt) {
+		versions := slices.Clone(r.supported.value)
+		r.mu.Unlock()
+		return versions, nil
+	}
+	r.mu.Unlock()
+
+	versions, err := r.Repository.SupportedMinecraftVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.supported = &cacheEntry[[]string]{
+		value:     slices.Clone(versions),
+		expiresAt: time.Now().Add(r.ttl),
+	}
+
+	return versions, nil
+}
+
+func (r *CachedRepository) UpdateChunk(ctx context.Context, c resource.Chunk) (resource.Chunk, error) {
+	updated, err := r.Repository.UpdateChunk(ctx, c)
+	if err != nil {
+		return resource.Chunk{}, err
+	}
+	r.publishChunk(c.ID)
+	return updated, nil
+}
+
+func (r *CachedRepository) CreateFlavor(
+	ctx context.Context,
+	chunkID string,
+	flavor resource.Flavor,
+) (resource.Flavor, error) {
+	created, err := r.Repository.CreateFlavor(ctx, chunkID, flavor)
+	if err != nil {
+		return resource.Flavor{}, err
+	}
+	r.bus.Publish(event.Event{
+		Type:     event.TypeFlavorChanged,
+		ChunkID:  chunkID,
+		FlavorID: created.ID,
+	})
+	return created, nil
+}
+
+func (r *CachedRepository) CreateFlavorVersion(
+	ctx context.Context,
+	flavorID string,
+	version resource.FlavorVersion,
+	prevVersionID string,
+) (resource.FlavorVersion, error) {
+	created, err := r.Repository.CreateFlavorVersion(ctx, flavorID, version, prevVersionID)
+	if err != nil {
+		return resource.FlavorVersion{}, err
+	}
+	r.bus.Publish(event.Event{
+		Type:            event.TypeFlavorVersionChanged,
+		FlavorID:        flavorID,
+		FlavorVersionID: created.ID,
+	})
+	return created, nil
+}
+
+func (r *CachedRepository) MarkFlavorVersionFilesUploaded(ctx context.Context, flavorVersionID string) error {
+	if err := r.Repository.MarkFlavorVersionFilesUploaded(ctx, flavorVersionID); err != nil {
+		return err
+	}
+	r.publishFlavorVersion(flavorVersionID)
+	return nil
+}
+
+func (r *CachedRepository) UpdateFlavorVersionBuildStatus(
+	ctx context.Context,
+	flavorVersionID string,
+	status resource.FlavorVersionBuildStatus,
+) error {
+	if err := r.Repository.UpdateFlavorVersionBuildStatus(ctx, flavorVersionID, status); err != nil {
+		return err
+	}
+	r.publishFlavorVersion(flavorVersionID)
+	return nil
+}
+
+func (r *CachedRepository) RejectFlavorVersionBuild(
+	ctx context.Context,
+	flavorVersionID string,
+	status resource.FlavorVersionBuildStatus,
+	reason string,
+) error {
+	if err := r.Repository.RejectFlavorVersionBuild(ctx, flavorVersionID, status, reason); err != nil {
+		return err
+	}
+	r.publishFlavorVersion(flavorVersionID)
+	return nil
+}
+
+func (r *CachedRepository) UpdateFlavorVersionBuildProgress(
+	ctx context.Context,
+	flavorVersionID string,
+	percent uint32,
+) error {
+	if err := r.Repository.UpdateFlavorVersionBuildProgress(ctx, flavorVersionID, percent); err != nil {
+		return err
+	}
+	r.publishFlavorVersion(flavorVersionID)
+	return nil
+}
+
+func (r *CachedRepository) UpdateFlavorVersionRestoreLatency(
+	ctx context.Context,
+	flavorVersionID string,
+	latency time.Duration,
+) error {
+	if err := r.Repository.UpdateFlavorVersionRestoreLatency(ctx, flavorVersionID, latency); err != nil {
+		return err
+	}
+	r.publishFlavorVersion(flavorVersionID)
+	return nil
+}
+
+func (r *CachedRepository) UpdateFlavorVersionPresignedURLData(
+	ctx context.Context,
+	flavorVersionID string,
+	date time.Time,
+	url string,
+) error {
+	if err := r.Repository.UpdateFlavorVersionPresignedURLData(ctx, flavorVersionID, date, url); err != nil {
+		return err
+	}
+	r.publishFlavorVersion(flavorVersionID)
+	return nil
+}
+
+func (r *CachedRepository) UpdateFlavorVersionCheckpointResources(
+	ctx context.Context,
+	flavorVersionID string,
+	res *resource.CheckpointResources,
+) error {
+	if err := r.Repository.UpdateFlavorVersionCheckpointResources(ctx, flavorVersionID, res); err != nil {
+		return err
+	}
+	r.publishFlavorVersion(flavorVersionID)
+	return nil
+}
+
+func (r *CachedRepository) UpdateThumbnail(ctx context.Context, chunkID string, imgHash string) error {
+	if err := r.Repository.UpdateThumbnail(ctx, chunkID, imgHash); err != nil {
+		return err
+	}
+	r.publishChunk(chunkID)
+	return nil
+}
+
+func (r *CachedRepository) DeleteFlavor(ctx context.Context, id string) error {
+	if err := r.Repository.DeleteFlavor(ctx, id); err != nil {
+		return err
+	}
+	r.publishFlavor(id)
+	return nil
+}
+
+func (r *CachedRepository) MarkChunkAndFlavorsDeleted(ctx context.Context, id string) error {
+	if err := r.Repository.MarkChunkAndFlavorsDeleted(ctx, id); err != nil {
+		return err
+	}
+	r.publishChunk(id)
+	return nil
+}
+
+func (r *CachedRepository) MarkFlavorDeleted(ctx context.Context, id string) error {
+	if err := r.Repository.MarkFlavorDeleted(ctx, id); err != nil {
+		return err
+	}
+	r.publishFlavor(id)
+	return nil
+}
+
+func (r *CachedRepository) publishChunk(id string) {
+	r.bus.Publish(event.Event{
+		Type:    event.TypeChunkChanged,
+		ChunkID: id,
+	})
+}
+
+func (r *CachedRepository) publishFlavor(id string) {
+	r.bus.Publish(event.Event{
+		Type:     event.TypeFlavorChanged,
+		FlavorID: id,
+	})
+}
+
+func (r *CachedRepository) publishFlavorVersion(id string) {
+	r.bus.Publish(event.Event{
+		Type:            event.TypeFlavorVersionChanged,
+		FlavorVersionID: id,
+	})
+}
+
+func (r *CachedRepository) invalidate(ev event.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+
+	chunkID := ev.ChunkID
+	if chunkID == "" {
+		chunkID = r.flavorChunks[ev.FlavorID]
+	}
+
+	if chunkID == "" {
+		chunkID = r.versionChunks[ev.FlavorVersionID]
+	}
+
+	if ev.FlavorVersionID != "" {
+		delete(r.versions, ev.FlavorVersionID)
+	}
+
+	if chunkID != "" {
+		delete(r.chunks, chunkID)
+	}
+}
+
+func (r *CachedRepository) currentGeneration() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.generation
+}
+
+func lookup[T any](r *CachedRepository, m map[string]cacheEntry[T], key string) (T, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := m[key]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	if time.Now().After(e.expiresAt) {
+		delete(m, key)
+		var zero T
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+func store[T any](r *CachedRepository, gen uint64, m map[string]cacheEntry[T], key string, value T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if gen != r.generation {
+		return
+	}
+
+	m[key] = cacheEntry[T]{
+		value:     value,
+		expiresAt: time.Now().Add(r.ttl),
+	}
+}
+
+func cloneChunk(c resource.Chunk) resource.Chunk {
+	c.Tags = slices.Clone(c.Tags)
+	c.Flavors = slices.Clone(c.Flavors)
+	for i := range c.Flavors {
+		c.Flavors[i].Versions = slices.Clone(c.Flavors[i].Versions)
+		for j := range c.Flavors[i].Versions {
+			c.Flavors[i].Versions[j] = cloneFlavorVersion(c.Flavors[i].Versions[j])
+		}
+	}
+	return c
+}
+
+func cloneFlavorVersion(v resource.FlavorVersion) resource.FlavorVersion {
+	v.FileHashes = slices.Clone(v.FileHashes)
+	if v.CheckpointResources != nil {
+		res := *v.CheckpointResources
+		v.CheckpointResources = &res
+	}
+	return v
+}
@@ -0,0 +1,155 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedRepositoryGetChunkByID(t *testing.T) {
+	var (
+		c          = fixture.Chunk()
+		versionID  = c.Flavors[0].Versions[0].ID
+		otherChunk = "other-chunk"
+	)
+
+	tests := []struct {
+		name  string
+		ttl   time.Duration
+		calls int
+		run   func(context.Context, *chunk.CachedRepository, *event.Bus)
+	}{
+		{
+			name:  "second call is served from cache",
+			ttl:   time.Minute,
+			calls: 1,
+			run:   func(context.Context, *chunk.CachedRepository, *event.Bus) {},
+		},
+		{
+			name:  "expired entries are fetched again",
+			ttl:   time.Nanosecond,
+			calls: 2,
+			run: func(context.Context, *chunk.CachedRepository, *event.Bus) {
+				time.Sleep(time.Millisecond)
+			},
+		},
+		{
+			name:  "flavor version update invalidates chunk",
+			ttl:   time.Minute,
+			calls: 2,
+			run: func(ctx context.Context, repo *chunk.CachedRepository, _ *event.Bus) {
+				err := repo.UpdateFlavorVersionBuildStatus(ctx, versionID, resource.FlavorVersionBuildStatusCompleted)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:  "flavor event invalidates chunk",
+			ttl:   time.Minute,
+			calls: 2,
+			run: func(_ context.Context, _ *chunk.CachedRepository, bus *event.Bus) {
+				bus.Publish(event.Event{
+					Type:     event.TypeFlavorChanged,
+					FlavorID: c.Flavors[0].ID,
+				})
+			},
+		},
+		{
+			name:  "events for other chunks do not invalidate",
+			ttl:   time.Minute,
+			calls: 1,
+			run: func(_ context.Context, _ *chunk.CachedRepository, bus *event.Bus) {
+				bus.Publish(event.Event{
+					Type:    event.TypeChunkChanged,
+					ChunkID: otherChunk,
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx      = context.Background()
+				mockRepo = mock.NewMockChunkRepository(t)
+				bus      = event.NewBus()
+				repo     = chunk.NewCachedRepository(mockRepo, bus, tt.ttl)
+			)
+
+			mockRepo.EXPECT().
+				GetChunkByID(mocky.Anything, c.ID).
+				Return(fixture.Chunk(), nil).
+				Times(tt.calls)
+
+			mockRepo.EXPECT().
+				UpdateFlavorVersionBuildStatus(mocky.Anything, versionID, resource.FlavorVersionBuildStatusCompleted).
+				Return(nil).
+				Maybe()
+
+			_, err := repo.GetChunkByID(ctx, c.ID)
+			require.NoError(t, err)
+
+			tt.run(ctx, repo, bus)
+
+			got, err := repo.GetChunkByID(ctx, c.ID)
+			require.NoError(t, err)
+
+			if d := cmp.Diff(c, got); d != "" {
+				t.Fatalf("diff (-want +got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestCachedRepositoryReturnsCopies(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		c        = fixture.Chunk()
+		mockRepo = mock.NewMockChunkRepository(t)
+		repo     = chunk.NewCachedRepository(mockRepo, event.NewBus(), time.Minute)
+	)
+
+	mockRepo.EXPECT().
+		GetChunkByID(mocky.Anything, c.ID).
+		Return(fixture.Chunk(), nil).
+		Once()
+
+	first, err := repo.GetChunkByID(ctx, c.ID)
+	require.NoError(t, err)
+
+	first.Tags[0] = "changed"
+	first.Flavors[0].Versions[0].FileHashes[0].Hash = "changed"
+
+	second, err := repo.GetChunkByID(ctx, c.ID)
+	require.NoError(t, err)
+
+	if d := cmp.Diff(c, second); d != "" {
+		t.Fatalf("diff (-want +got):\n%s", d)
+	}
+}
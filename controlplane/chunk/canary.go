@@ -0,0 +1,138 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spacechunks/explorer/controlplane/authz"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) StartCanary(
+	ctx context.Context,
+	stableVersionID string,
+	canaryVersionID string,
+	percentage uint32,
+) (resource.Canary, error) {
+	if stableVersionID == canaryVersionID {
+		return resource.Canary{}, apierrs.ErrInvalidCanaryVersions
+	}
+
+	flavorID, err := s.repo.FlavorIDByFlavorVersionID(ctx, canaryVersionID)
+	if err != nil {
+		return resource.Canary{}, fmt.Errorf("flavor id: %w", err)
+	}
+
+	if err := s.authorizeFlavor(ctx, flavorID); err != nil {
+		return resource.Canary{}, err
+	}
+
+	f, err := s.repo.FlavorByID(ctx, flavorID)
+	if err != nil {
+		return resource.Canary{}, fmt.Errorf("flavor by id: %w", err)
+	}
+
+	if f.DeletedAt != nil {
+		return resource.Canary{}, apierrs.ErrNotFound
+	}
+
+	// the stable version has to belong to the same flavor, otherwise
+	// launches would be split between different flavors.
+	for _, id := range []string{stableVersionID, canaryVersionID} {
+		idx := slices.IndexFunc(f.Versions, func(v resource.FlavorVersion) bool {
+			return v.ID == id
+		})
+		if idx == -1 {
+			return resource.Canary{}, apierrs.ErrInvalidCanaryVersions
+		}
+
+		if f.Versions[idx].BuildStatus != resource.FlavorVersionBuildStatusCompleted {
+			return resource.Canary{}, apierrs.ErrFlavorVersionNotBuilt
+		}
+	}
+
+	c, err := s.repo.CreateCanary(ctx, resource.Canary{
+		FlavorID:        flavorID,
+		StableVersionID: stableVersionID,
+		CanaryVersionID: canaryVersionID,
+		Percentage:      percentage,
+	})
+	if err != nil {
+		return resource.Canary{}, fmt.Errorf("create canary: %w", err)
+	}
+
+	return c, nil
+}
+
+func (s *svc) GetCanary(ctx context.Context, flavorID string) (resource.Canary, error) {
+	if err := s.authorizeFlavor(ctx, flavorID); err != nil {
+		return resource.Canary{}, err
+	}
+
+	c, err := s.repo.LatestCanary(ctx, flavorID)
+	if err != nil {
+		return resource.Canary{}, fmt.Errorf("latest canary: %w", err)
+	}
+
+	return c, nil
+}
+
+func (s *svc) PromoteCanary(ctx context.Context, flavorID string) (resource.Canary, error) {
+	return s.endCanary(ctx, flavorID, resource.CanaryStatePromoted)
+}
+
+func (s *svc) AbortCanary(ctx context.Context, flavorID string) (resource.Canary, error) {
+	return s.endCanary(ctx, flavorID, resource.CanaryStateAborted)
+}
+
+func (s *svc) endCanary(ctx context.Context, flavorID string, state resource.CanaryState) (resource.Canary, error) {
+	if err := s.authorizeFlavor(ctx, flavorID); err != nil {
+		return resource.Canary{}, err
+	}
+
+	c, err := s.repo.UpdateActiveCanaryState(ctx, flavorID, state)
+	if err != nil {
+		return resource.Canary{}, fmt.Errorf("update canary state: %w", err)
+	}
+
+	return c, nil
+}
+
+// authorizeFlavor makes sure the actor owns the chunk the flavor belongs to.
+func (s *svc) authorizeFlavor(ctx context.Context, flavorID string) error {
+	actorID, ok := ctx.Value(contextkey.ActorID).(string)
+	if !ok {
+		return errors.New("actor_id not found in context")
+	}
+
+	if err := s.access.AccessAuthorized(
+		ctx,
+		authz.WithOwnershipRule(actorID, authz.FlavorResourceDef(flavorID)),
+	); err != nil {
+		return fmt.Errorf("access: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartCanary(t *testing.T) {
+	builtFlavor := func() resource.Flavor {
+		return fixture.Flavor(func(f *resource.Flavor) {
+			for i := range f.Versions {
+				f.Versions[i].BuildStatus = resource.FlavorVersionBuildStatusCompleted
+			}
+		})
+	}
+
+	var (
+		flavor   = builtFlavor()
+		canaryID = flavor.Versions[0].ID
+		stableID = flavor.Versions[1].ID
+		expected = resource.Canary{
+			FlavorID:        flavor.ID,
+			StableVersionID: stableID,
+			CanaryVersionID: canaryID,
+			Percentage:      10,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		stableID string
+		canaryID string
+		err      error
+		prep     func(*mock.MockChunkRepository, *mock.MockAuthzAccessEvaluator)
+	}{
+		{
+			name:     "works",
+			stableID: stableID,
+			canaryID: canaryID,
+			prep: func(repo *mock.MockChunkRepository, access *mock.MockAuthzAccessEvaluator) {
+				repo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, canaryID).Return(flavor.ID, nil)
+				access.EXPECT().
+					AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+					Return(nil)
+				repo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+				repo.EXPECT().CreateCanary(mocky.Anything, expected).Return(expected, nil)
+			},
+		},
+		{
+			name:     "same version",
+			stableID: canaryID,
+			canaryID: canaryID,
+			err:      apierrs.ErrInvalidCanaryVersions,
+			prep:     func(*mock.MockChunkRepository, *mock.MockAuthzAccessEvaluator) {},
+		},
+		{
+			name:     "stable version belongs to other flavor",
+			stableID: "019da2b4-0d2e-7a5c-9f4d-3e1c2b8a7f60",
+			canaryID: canaryID,
+			err:      apierrs.ErrInvalidCanaryVersions,
+			prep: func(repo *mock.MockChunkRepository, access *mock.MockAuthzAccessEvaluator) {
+				repo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, canaryID).Return(flavor.ID, nil)
+				access.EXPECT().
+					AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+					Return(nil)
+				repo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+			},
+		},
+		{
+			name:     "version not built",
+			stableID: stableID,
+			canaryID: canaryID,
+			err:      apierrs.ErrFlavorVersionNotBuilt,
+			prep: func(repo *mock.MockChunkRepository, access *mock.MockAuthzAccessEvaluator) {
+				f := builtFlavor()
+				f.Versions[0].BuildStatus = resource.FlavorVersionBuildStatusBuildImage
+
+				repo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, canaryID).Return(flavor.ID, nil)
+				access.EXPECT().
+					AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+					Return(nil)
+				repo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(f, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				mockAccess,
+				chunk.Config{},
+			)
+			require.NoError(t, err)
+
+			tt.prep(mockRepo, mockAccess)
+
+			actual, err := svc.StartCanary(ctx, tt.stableID, tt.canaryID, 10)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) PromoteVersion(
+	ctx context.Context,
+	flavorVersionID string,
+	channel string,
+) (resource.FlavorChannel, error) {
+	flavorID, err := s.repo.FlavorIDByFlavorVersionID(ctx, flavorVersionID)
+	if err != nil {
+		return resource.FlavorChannel{}, fmt.Errorf("flavor id: %w", err)
+	}
+
+	if err := s.authorizeFlavor(ctx, flavorID); err != nil {
+		return resource.FlavorChannel{}, err
+	}
+
+	f, err := s.repo.FlavorByID(ctx, flavorID)
+	if err != nil {
+		return resource.FlavorChannel{}, fmt.Errorf("flavor by id: %w", err)
+	}
+
+	if f.DeletedAt != nil {
+		return resource.FlavorChannel{}, apierrs.ErrNotFound
+	}
+
+	idx := slices.IndexFunc(f.Versions, func(v resource.FlavorVersion) bool {
+		return v.ID == flavorVersionID
+	})
+	if idx == -1 {
+		return resource.FlavorChannel{}, apierrs.ErrNotFound
+	}
+
+	// instances of a channel are launched without further checks,
+	// so only versions that can actually be run are published.
+	if f.Versions[idx].BuildStatus != resource.FlavorVersionBuildStatusCompleted {
+		return resource.FlavorChannel{}, apierrs.ErrFlavorVersionNotBuilt
+	}
+
+	c, err := s.repo.UpsertFlavorChannel(ctx, resource.FlavorChannel{
+		FlavorID:        flavorID,
+		Name:            channel,
+		FlavorVersionID: flavorVersionID,
+	})
+	if err != nil {
+		return resource.FlavorChannel{}, fmt.Errorf("upsert flavor channel: %w", err)
+	}
+
+	return c, nil
+}
+
+func (s *svc) ListFlavorChannels(ctx context.Context, flavorID string) ([]resource.FlavorChannel, error) {
+	if err := s.authorizeFlavor(ctx, flavorID); err != nil {
+		return nil, err
+	}
+
+	channels, err := s.repo.FlavorChannels(ctx, flavorID)
+	if err != nil {
+		return nil, fmt.Errorf("flavor channels: %w", err)
+	}
+
+	return channels, nil
+}
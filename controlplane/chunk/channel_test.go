@@ -0,0 +1,121 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromoteVersion(t *testing.T) {
+	var (
+		f = fixture.Flavor(func(f *resource.Flavor) {
+			f.Versions[0].BuildStatus = resource.FlavorVersionBuildStatusCompleted
+		})
+		version = f.Versions[0]
+	)
+
+	tests := []struct {
+		name    string
+		version string
+		err     error
+		prep    func(*mock.MockChunkRepository)
+	}{
+		{
+			name:    "version is published to channel",
+			version: version.ID,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, version.ID).Return(f.ID, nil)
+				repo.EXPECT().FlavorByID(mocky.Anything, f.ID).Return(f, nil)
+
+				expected := resource.FlavorChannel{
+					FlavorID:        f.ID,
+					Name:            resource.FlavorChannelBeta,
+					FlavorVersionID: version.ID,
+				}
+				repo.EXPECT().UpsertFlavorChannel(mocky.Anything, expected).Return(expected, nil)
+			},
+		},
+		{
+			name:    "version not built",
+			version: f.Versions[1].ID,
+			err:     apierrs.ErrFlavorVersionNotBuilt,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, f.Versions[1].ID).Return(f.ID, nil)
+				repo.EXPECT().FlavorByID(mocky.Anything, f.ID).Return(f, nil)
+			},
+		},
+		{
+			name:    "flavor deleted",
+			version: version.ID,
+			err:     apierrs.ErrNotFound,
+			prep: func(repo *mock.MockChunkRepository) {
+				deleted := f
+				deleted.DeletedAt = &f.UpdatedAt
+				repo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, version.ID).Return(f.ID, nil)
+				repo.EXPECT().FlavorByID(mocky.Anything, f.ID).Return(deleted, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				mockAccess,
+				chunk.Config{},
+			)
+			require.NoError(t, err)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil)
+
+			tt.prep(mockRepo)
+
+			_, err = svc.PromoteVersion(ctx, tt.version, resource.FlavorChannelBeta)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
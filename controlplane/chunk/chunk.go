@@ -29,6 +29,7 @@ import (
 
 	_ "image/png"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/spacechunks/explorer/controlplane/authz"
 	"github.com/spacechunks/explorer/controlplane/blob"
 	"github.com/spacechunks/explorer/controlplane/contextkey"
@@ -135,6 +136,17 @@ func (s *svc) GetSupportedMinecraftVersions(ctx context.Context) ([]string, erro
 	return s.repo.SupportedMinecraftVersions(ctx)
 }
 
+func (s *svc) GetMinecraftVersion(ctx context.Context, version string) (resource.MinecraftVersion, error) {
+	v, err := s.repo.GetMinecraftVersionByVersion(ctx, version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return resource.MinecraftVersion{}, apierrs.ErrMinecraftVersionNotSupported
+		}
+		return resource.MinecraftVersion{}, fmt.Errorf("get minecraft version: %w", err)
+	}
+	return v, nil
+}
+
 func (s *svc) UpdateThumbnail(ctx context.Context, chunkID string, imgData []byte) error {
 	if err := s.authorized(ctx, chunkID); err != nil {
 		return fmt.Errorf("authorize: %w", err)
@@ -178,7 +190,7 @@ func (s *svc) UpdateThumbnail(ctx context.Context, chunkID string, imgData []byt
 		return fmt.Errorf("db: %w", err)
 	}
 
-	if err := s.s3Store.PutBlob(ctx, blob.CASKeyPrefix, []blob.Object{obj}); err != nil {
+	if err := s.blobStore.PutBlob(ctx, blob.CASKeyPrefix, []blob.Object{obj}); err != nil {
 		return fmt.Errorf("put image: %w", err)
 	}
 
@@ -214,8 +226,11 @@ func validateChunkFields(chunk resource.Chunk) error {
 		return apierrs.ErrTooManyTags
 	}
 
-	if utf8.RuneCountInString(chunk.Name) > resource.MaxChunkNameChars {
-		return apierrs.ErrNameTooLong
+	// the name is empty if it should not be changed by an update
+	if chunk.Name != "" {
+		if err := validateChunkName(chunk.Name); err != nil {
+			return err
+		}
 	}
 
 	if utf8.RuneCountInString(chunk.Description) > resource.MaxChunkDescriptionChars {
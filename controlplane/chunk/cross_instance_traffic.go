@@ -0,0 +1,134 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) RequestCrossInstanceTraffic(
+	ctx context.Context,
+	chunkID string,
+	reason string,
+) (resource.CrossInstanceTraffic, error) {
+	if err := s.authorizedChunk(ctx, chunkID); err != nil {
+		return resource.CrossInstanceTraffic{}, err
+	}
+
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	t, err := s.repo.RequestCrossInstanceTraffic(ctx, chunkID, reason, actorID)
+	if err != nil {
+		return resource.CrossInstanceTraffic{}, fmt.Errorf("request cross-instance traffic: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "cross-instance traffic requested", "chunk_id", chunkID, "actor_id", actorID)
+
+	return t, nil
+}
+
+func (s *svc) GetCrossInstanceTraffic(ctx context.Context, chunkID string) (resource.CrossInstanceTraffic, error) {
+	if err := s.authorizedChunk(ctx, chunkID); err != nil {
+		return resource.CrossInstanceTraffic{}, err
+	}
+
+	t, err := s.repo.CrossInstanceTrafficByChunkID(ctx, chunkID)
+	if err != nil {
+		// chunks that have not requested anything are isolated,
+		// which is the same as having no state at all.
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return resource.CrossInstanceTraffic{ChunkID: chunkID}, nil
+		}
+		return resource.CrossInstanceTraffic{}, fmt.Errorf("cross-instance traffic: %w", err)
+	}
+
+	return t, nil
+}
+
+func (s *svc) WithdrawCrossInstanceTraffic(ctx context.Context, chunkID string) error {
+	if err := s.authorizedChunk(ctx, chunkID); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteCrossInstanceTraffic(ctx, chunkID); err != nil {
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return apierrs.ErrCrossInstanceTrafficMissing
+		}
+		return fmt.Errorf("delete cross-instance traffic: %w", err)
+	}
+
+	return nil
+}
+
+func (s *svc) ListCrossInstanceTrafficRequests(
+	ctx context.Context,
+	actorID string,
+) ([]resource.CrossInstanceTraffic, error) {
+	if !slices.Contains(s.cfg.AdminUserIDs, actorID) {
+		return nil, apierrs.ErrPermissionDenied
+	}
+
+	l, err := s.repo.CrossInstanceTrafficByState(ctx, resource.CrossInstanceTrafficStateRequested)
+	if err != nil {
+		return nil, fmt.Errorf("cross-instance traffic by state: %w", err)
+	}
+
+	return l, nil
+}
+
+func (s *svc) ReviewCrossInstanceTraffic(
+	ctx context.Context,
+	actorID string,
+	chunkID string,
+	approved bool,
+	message string,
+) (resource.CrossInstanceTraffic, error) {
+	if !slices.Contains(s.cfg.AdminUserIDs, actorID) {
+		return resource.CrossInstanceTraffic{}, apierrs.ErrPermissionDenied
+	}
+
+	state := resource.CrossInstanceTrafficStateRejected
+	if approved {
+		state = resource.CrossInstanceTrafficStateApproved
+	}
+
+	t, err := s.repo.ReviewCrossInstanceTraffic(ctx, chunkID, state, actorID, message)
+	if err != nil {
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return resource.CrossInstanceTraffic{}, apierrs.ErrCrossInstanceTrafficMissing
+		}
+		return resource.CrossInstanceTraffic{}, fmt.Errorf("review cross-instance traffic: %w", err)
+	}
+
+	s.logger.InfoContext(ctx,
+		"cross-instance traffic reviewed",
+		"chunk_id", chunkID,
+		"actor_id", actorID,
+		"state", state,
+	)
+
+	return t, nil
+}
@@ -0,0 +1,247 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCrossInstanceTraffic(t *testing.T) {
+	var (
+		c      = fixture.Chunk()
+		stored = resource.CrossInstanceTraffic{
+			ChunkID:     c.ID,
+			State:       resource.CrossInstanceTrafficStateRequested,
+			Reason:      "lobby hands off players to arenas",
+			RequestedBy: "owner-id",
+			CreatedAt:   time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		}
+	)
+
+	tests := []struct {
+		name     string
+		expected resource.CrossInstanceTraffic
+		err      error
+		prep     func(*mock.MockChunkRepository)
+	}{
+		{
+			name:     "works",
+			expected: stored,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+				repo.EXPECT().CrossInstanceTrafficByChunkID(mocky.Anything, c.ID).Return(stored, nil)
+			},
+		},
+		{
+			name: "nothing requested",
+			expected: resource.CrossInstanceTraffic{
+				ChunkID: c.ID,
+			},
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+				repo.EXPECT().
+					CrossInstanceTrafficByChunkID(mocky.Anything, c.ID).
+					Return(resource.CrossInstanceTraffic{}, apierrs.ErrNotFound)
+			},
+		},
+		{
+			name: "chunk deleted",
+			err:  apierrs.ErrChunkNotFound,
+			prep: func(repo *mock.MockChunkRepository) {
+				deleted := c
+				deleted.DeletedAt = &stored.UpdatedAt
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(deleted, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "owner-id")
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				mockAccess,
+				chunk.Config{},
+			)
+			require.NoError(t, err)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil)
+
+			tt.prep(mockRepo)
+
+			actual, err := svc.GetCrossInstanceTraffic(ctx, c.ID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestReviewCrossInstanceTraffic(t *testing.T) {
+	var (
+		chunkID = "019532a1-7a51-7b4e-9d2a-54b2cb1e4a6f"
+		adminID = "admin-id"
+	)
+
+	tests := []struct {
+		name     string
+		actorID  string
+		approved bool
+		expected resource.CrossInstanceTraffic
+		err      error
+		prep     func(*mock.MockChunkRepository)
+	}{
+		{
+			name:     "approve",
+			actorID:  adminID,
+			approved: true,
+			expected: resource.CrossInstanceTraffic{
+				ChunkID:       chunkID,
+				State:         resource.CrossInstanceTrafficStateApproved,
+				ReviewedBy:    adminID,
+				ReviewMessage: "msg",
+			},
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().
+					ReviewCrossInstanceTraffic(
+						mocky.Anything,
+						chunkID,
+						resource.CrossInstanceTrafficStateApproved,
+						adminID,
+						"msg",
+					).
+					Return(resource.CrossInstanceTraffic{
+						ChunkID:       chunkID,
+						State:         resource.CrossInstanceTrafficStateApproved,
+						ReviewedBy:    adminID,
+						ReviewMessage: "msg",
+					}, nil)
+			},
+		},
+		{
+			name:    "reject",
+			actorID: adminID,
+			expected: resource.CrossInstanceTraffic{
+				ChunkID:       chunkID,
+				State:         resource.CrossInstanceTrafficStateRejected,
+				ReviewedBy:    adminID,
+				ReviewMessage: "msg",
+			},
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().
+					ReviewCrossInstanceTraffic(
+						mocky.Anything,
+						chunkID,
+						resource.CrossInstanceTrafficStateRejected,
+						adminID,
+						"msg",
+					).
+					Return(resource.CrossInstanceTraffic{
+						ChunkID:       chunkID,
+						State:         resource.CrossInstanceTrafficStateRejected,
+						ReviewedBy:    adminID,
+						ReviewMessage: "msg",
+					}, nil)
+			},
+		},
+		{
+			name:    "nothing requested",
+			actorID: adminID,
+			err:     apierrs.ErrCrossInstanceTrafficMissing,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().
+					ReviewCrossInstanceTraffic(
+						mocky.Anything,
+						chunkID,
+						resource.CrossInstanceTrafficStateRejected,
+						adminID,
+						"msg",
+					).
+					Return(resource.CrossInstanceTraffic{}, apierrs.ErrNotFound)
+			},
+		},
+		{
+			name:     "not an admin",
+			actorID:  "owner-id",
+			approved: true,
+			err:      apierrs.ErrPermissionDenied,
+			prep:     func(*mock.MockChunkRepository) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx      = context.Background()
+				mockRepo = mock.NewMockChunkRepository(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				chunk.Config{
+					AdminUserIDs: []string{adminID},
+				},
+			)
+			require.NoError(t, err)
+
+			tt.prep(mockRepo)
+
+			actual, err := svc.ReviewCrossInstanceTraffic(ctx, tt.actorID, chunkID, tt.approved, "msg")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
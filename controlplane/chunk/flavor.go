@@ -34,6 +34,7 @@ import (
 	"github.com/spacechunks/explorer/controlplane/contextkey"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
 	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/lock"
 	"github.com/spacechunks/explorer/internal/file"
 	"github.com/spacechunks/explorer/internal/resource"
 	"go.opentelemetry.io/otel/trace"
@@ -117,7 +118,7 @@ func (s *svc) CreateFlavorVersion(
 		return resource.FlavorVersion{}, resource.FlavorVersionDiff{}, apierrs.ErrFlavorVersionExists
 	}
 
-	_, err = s.repo.GetMinecraftVersionByVersion(ctx, version.MinecraftVersion)
+	mcVersion, err := s.repo.GetMinecraftVersionByVersion(ctx, version.MinecraftVersion)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return resource.FlavorVersion{},
@@ -130,10 +131,32 @@ func (s *svc) CreateFlavorVersion(
 			fmt.Errorf("minecraft version exists: %w", err)
 	}
 
+	if err := s.checkRuntime(mcVersion, version); err != nil {
+		return resource.FlavorVersion{}, resource.FlavorVersionDiff{}, err
+	}
+
 	if err := cleanFileHashPaths(version.FileHashes); err != nil {
 		return resource.FlavorVersion{}, resource.FlavorVersionDiff{}, err
 	}
 
+	// the sizes are reported by the client, so the actual files are checked
+	// again by the build. this only rejects flavor versions early, before any
+	// files have been uploaded.
+	if violations := s.cfg.BuildLimits.FileViolations(version.FileHashes); len(violations) > 0 {
+		return resource.FlavorVersion{}, resource.FlavorVersionDiff{}, apierrs.BuildLimitsExceeded(violations...)
+	}
+
+	if len(version.AssetHashes) > 0 {
+		exists, err := s.repo.AssetsExist(ctx, version.AssetHashes)
+		if err != nil {
+			return resource.FlavorVersion{}, resource.FlavorVersionDiff{}, fmt.Errorf("assets exist: %w", err)
+		}
+
+		if !exists {
+			return resource.FlavorVersion{}, resource.FlavorVersionDiff{}, apierrs.ErrAssetNotFound
+		}
+	}
+
 	prevVersion, err := s.repo.LatestFlavorVersion(ctx, flavorID)
 	if err != nil {
 		return resource.FlavorVersion{},
@@ -258,7 +281,11 @@ func cleanFileHashPaths(hashes []file.Hash) error {
 	return nil
 }
 
-func (s *svc) BuildFlavorVersion(ctx context.Context, versionID string) error {
+func (s *svc) BuildFlavorVersion(
+	ctx context.Context,
+	versionID string,
+	checkpointResources *resource.CheckpointResources,
+) error {
 	actorID, ok := ctx.Value(contextkey.ActorID).(string)
 	if !ok {
 		return errors.New("actor_id not found in context")
@@ -271,6 +298,27 @@ func (s *svc) BuildFlavorVersion(ctx context.Context, versionID string) error {
 		return fmt.Errorf("access: %w", err)
 	}
 
+	// the build status is only updated once the job has been inserted, so
+	// without the lock concurrent calls could each insert their own job.
+	locked, err := s.locker.TryLock(ctx, lock.FlavorVersionBuildKey(versionID), func(ctx context.Context) error {
+		return s.buildFlavorVersion(ctx, versionID, checkpointResources)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !locked {
+		return apierrs.ErrAlreadyBuilding
+	}
+
+	return nil
+}
+
+func (s *svc) buildFlavorVersion(
+	ctx context.Context,
+	versionID string,
+	checkpointResources *resource.CheckpointResources,
+) error {
 	flavorID, err := s.repo.FlavorIDByFlavorVersionID(ctx, versionID)
 	if err != nil {
 		return fmt.Errorf("get flavor id: %w", err)
@@ -290,12 +338,26 @@ func (s *svc) BuildFlavorVersion(ctx context.Context, versionID string) error {
 		return fmt.Errorf("flavor version: %w", err)
 	}
 
+	// the total size is only known once all files have been downloaded
+	// by the image build, but the file count can be checked right away.
+	if violations := s.cfg.BuildLimits.Violations(uint64(len(version.FileHashes)), 0); len(violations) > 0 {
+		return apierrs.BuildLimitsExceeded(violations...)
+	}
+
 	if !version.FilesUploaded {
-		exists, err := s.s3Store.ObjectExists(ctx, blob.ChangeSetKey(versionID))
+		exists, err := s.blobStore.ObjectExists(ctx, blob.ChangeSetKey(versionID))
 		if err != nil {
 			return fmt.Errorf("changeset exists : %w", err)
 		}
 
+		// the files might have been uploaded individually using a patch instead.
+		if !exists {
+			exists, err = s.patchUploaded(ctx, versionID)
+			if err != nil {
+				return fmt.Errorf("patch uploaded: %w", err)
+			}
+		}
+
 		if !exists {
 			return apierrs.ErrFlavorFilesNotUploaded
 		}
@@ -305,6 +367,17 @@ func (s *svc) BuildFlavorVersion(ctx context.Context, versionID string) error {
 		}
 	}
 
+	for _, h := range version.AssetHashes {
+		exists, err := s.blobStore.ObjectExists(ctx, blob.AssetKey(h))
+		if err != nil {
+			return fmt.Errorf("asset exists: %w", err)
+		}
+
+		if !exists {
+			return apierrs.ErrAssetNotUploaded
+		}
+	}
+
 	// do not fail the request if there is already a job running,
 	// or it is already completed, because those states do not
 	// indicate that anything is wrong.
@@ -314,6 +387,12 @@ func (s *svc) BuildFlavorVersion(ctx context.Context, versionID string) error {
 		return nil
 	}
 
+	// record the resources used for this build, so it is possible
+	// to tell which limits the checkpoint has been created with.
+	if err := s.repo.UpdateFlavorVersionCheckpointResources(ctx, versionID, checkpointResources); err != nil {
+		return fmt.Errorf("update checkpoint resources: %w", err)
+	}
+
 	span := trace.SpanFromContext(ctx)
 	spanCtx := job.SpanContext{
 		TraceID: span.SpanContext().TraceID().String(),
@@ -321,10 +400,17 @@ func (s *svc) BuildFlavorVersion(ctx context.Context, versionID string) error {
 	}
 
 	if version.BuildStatus == resource.FlavorVersionBuildStatusBuildCheckpointFailed {
+		command, err := StartupCommand(version)
+		if err != nil {
+			return fmt.Errorf("startup command: %w", err)
+		}
+
 		createCheckpoint := job.CreateCheckpoint{
-			FlavorVersionID: versionID,
-			BaseImageURL:    fmt.Sprintf("%s/%s:base", s.cfg.Registry, versionID),
-			SpanContext:     spanCtx,
+			FlavorVersionID:     versionID,
+			BaseImageURL:        fmt.Sprintf("%s/%s:base", s.cfg.Registry, versionID),
+			CheckpointResources: checkpointResources,
+			Command:             command,
+			SpanContext:         spanCtx,
 		}
 		if err := s.jobClient.InsertJob(
 			ctx,
@@ -342,11 +428,17 @@ func (s *svc) BuildFlavorVersion(ctx context.Context, versionID string) error {
 		return fmt.Errorf("minecraft version: %w", err)
 	}
 
+	baseImage, ok := mcVersion.BaseImage(version.JavaVersion)
+	if !ok {
+		return apierrs.ErrJavaVersionNotSupported
+	}
+
 	if err := s.jobClient.InsertJob(ctx, versionID, string(resource.FlavorVersionBuildStatusBuildImage), job.CreateImage{
-		FlavorVersionID: versionID,
-		BaseImage:       mcVersion.ImageURL,
-		OCIRegistry:     s.cfg.Registry,
-		SpanContext:     spanCtx,
+		FlavorVersionID:     versionID,
+		BaseImage:           baseImage,
+		OCIRegistry:         s.cfg.Registry,
+		CheckpointResources: checkpointResources,
+		SpanContext:         spanCtx,
 	}); err != nil {
 		return fmt.Errorf("insert create image job: %w", err)
 	}
@@ -399,3 +491,50 @@ func (s *svc) GetFlavor(ctx context.Context, id string) (resource.Flavor, error)
 
 	return f, nil
 }
+
+func (s *svc) GetFlavorVersion(
+	ctx context.Context,
+	id string,
+) (resource.FlavorVersion, *resource.BuildProvenance, error) {
+	actorID, ok := ctx.Value(contextkey.ActorID).(string)
+	if !ok {
+		return resource.FlavorVersion{}, nil, errors.New("actor_id not found in context")
+	}
+
+	// the provenance reveals details about our infrastructure,
+	// like the nodes checkpoints have been built on.
+	if err := s.access.AccessAuthorized(
+		ctx,
+		authz.WithOwnershipRule(actorID, authz.FlavorVersionResourceDef(id)),
+	); err != nil {
+		return resource.FlavorVersion{}, nil, fmt.Errorf("access: %w", err)
+	}
+
+	version, err := s.repo.FlavorVersionByID(ctx, id)
+	if err != nil {
+		return resource.FlavorVersion{}, nil, fmt.Errorf("flavor version: %w", err)
+	}
+
+	prov, err := s.repo.FlavorVersionProvenance(ctx, id)
+	if err != nil {
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return version, nil, nil
+		}
+		return resource.FlavorVersion{}, nil, fmt.Errorf("provenance: %w", err)
+	}
+
+	return version, &prov, nil
+}
+
+func (s *svc) PrecheckFlavorVersion(
+	_ context.Context,
+	fileCount uint64,
+	totalSizeBytes uint64,
+	largestFileSizeBytes uint64,
+) ([]string, BuildLimits) {
+	violations := s.cfg.BuildLimits.Violations(fileCount, totalSizeBytes)
+	if v, ok := s.cfg.BuildLimits.FileSizeViolation("the largest file", largestFileSizeBytes); ok {
+		violations = append(violations, v)
+	}
+	return violations, s.cfg.BuildLimits
+}
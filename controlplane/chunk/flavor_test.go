@@ -29,6 +29,7 @@ import (
 	"github.com/spacechunks/explorer/controlplane/chunk"
 	"github.com/spacechunks/explorer/controlplane/contextkey"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/lock"
 	"github.com/spacechunks/explorer/internal/file"
 	"github.com/spacechunks/explorer/internal/mock"
 	"github.com/spacechunks/explorer/internal/ptr"
@@ -102,6 +103,7 @@ func TestCreateFlavor(t *testing.T) {
 				mockRepo,
 				nil,
 				nil,
+				nil,
 				mockAccess,
 				chunk.Config{},
 			)
@@ -171,6 +173,8 @@ func TestCreateFlavorVersion(t *testing.T) {
 		expected     *resource.FlavorVersion
 		expectedDiff resource.FlavorVersionDiff
 		badRequest   *errdetails.BadRequest
+		precondition *errdetails.PreconditionFailure
+		limits       chunk.BuildLimits
 		prep         func(
 			*mock.MockChunkRepository,
 			resource.FlavorVersion,
@@ -556,6 +560,145 @@ func TestCreateFlavorVersion(t *testing.T) {
 			},
 			err: apierrs.ErrMinecraftVersionNotSupported,
 		},
+		{
+			name:        "files exceed build limits",
+			prevVersion: fixture.FlavorVersion(),
+			newVersion: fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+				v.Version = "v2"
+				v.FileHashes = []file.Hash{
+					{
+						Path:      "paper.yml",
+						Hash:      "pppppppppppppppp",
+						SizeBytes: 10,
+					},
+					{
+						Path:      "plugins/myplugin.jar",
+						Hash:      "hash1",
+						SizeBytes: 11,
+					},
+				}
+			}),
+			limits: chunk.BuildLimits{
+				MaxFileSizeBytes: 10,
+			},
+			prep: func(
+				repo *mock.MockChunkRepository,
+				newVersion resource.FlavorVersion,
+				prevVersion resource.FlavorVersion,
+				access *mock.MockAuthzAccessEvaluator,
+			) {
+				access.EXPECT().
+					AccessAuthorized(
+						mocky.Anything,
+						mocky.AnythingOfType("authz.AccessRuleOption"),
+					).
+					Return(nil)
+
+				repo.EXPECT().
+					FlavorByID(mocky.Anything, fixture.Flavor().ID).
+					Return(fixture.Flavor(), nil)
+
+				repo.EXPECT().
+					FlavorVersionExists(mocky.Anything, fixture.Flavor().ID, newVersion.Version).
+					Return(false, nil)
+
+				repo.EXPECT().
+					GetMinecraftVersionByVersion(mocky.Anything, newVersion.MinecraftVersion).
+					Return(resource.MinecraftVersion{
+						Version:  newVersion.MinecraftVersion,
+						ImageURL: "some-url",
+					}, nil)
+			},
+			precondition: &errdetails.PreconditionFailure{
+				Violations: []*errdetails.PreconditionFailure_Violation{
+					{
+						Type:        "BUILD_LIMITS",
+						Description: "plugins/myplugin.jar is 11 bytes, but at most 10 bytes are allowed per file",
+					},
+				},
+			},
+			err: apierrs.BuildLimitsExceeded(),
+		},
+		{
+			name:        "java version not available",
+			prevVersion: fixture.FlavorVersion(),
+			newVersion: fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+				v.Version = "v2"
+				v.JavaVersion = 17
+			}),
+			prep: func(
+				repo *mock.MockChunkRepository,
+				newVersion resource.FlavorVersion,
+				prevVersion resource.FlavorVersion,
+				access *mock.MockAuthzAccessEvaluator,
+			) {
+				access.EXPECT().
+					AccessAuthorized(
+						mocky.Anything,
+						mocky.AnythingOfType("authz.AccessRuleOption"),
+					).
+					Return(nil)
+
+				repo.EXPECT().
+					FlavorByID(mocky.Anything, fixture.Flavor().ID).
+					Return(fixture.Flavor(), nil)
+
+				repo.EXPECT().
+					FlavorVersionExists(mocky.Anything, fixture.Flavor().ID, newVersion.Version).
+					Return(false, nil)
+
+				repo.EXPECT().
+					GetMinecraftVersionByVersion(mocky.Anything, newVersion.MinecraftVersion).
+					Return(resource.MinecraftVersion{
+						Version:  newVersion.MinecraftVersion,
+						ImageURL: "some-url",
+						JavaImageURLs: map[uint32]string{
+							21: "some-url-java21",
+						},
+					}, nil)
+			},
+			err: apierrs.ErrJavaVersionNotSupported,
+		},
+		{
+			name:        "startup command not allowed",
+			prevVersion: fixture.FlavorVersion(),
+			newVersion: fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+				v.Version = "v2"
+				v.StartupCommand = "sh -c {{.MinecraftVersion}}"
+			}),
+			prep: func(
+				repo *mock.MockChunkRepository,
+				newVersion resource.FlavorVersion,
+				prevVersion resource.FlavorVersion,
+				access *mock.MockAuthzAccessEvaluator,
+			) {
+				access.EXPECT().
+					AccessAuthorized(
+						mocky.Anything,
+						mocky.AnythingOfType("authz.AccessRuleOption"),
+					).
+					Return(nil)
+
+				repo.EXPECT().
+					FlavorByID(mocky.Anything, fixture.Flavor().ID).
+					Return(fixture.Flavor(), nil)
+
+				repo.EXPECT().
+					FlavorVersionExists(mocky.Anything, fixture.Flavor().ID, newVersion.Version).
+					Return(false, nil)
+
+				repo.EXPECT().
+					GetMinecraftVersionByVersion(mocky.Anything, newVersion.MinecraftVersion).
+					Return(resource.MinecraftVersion{
+						Version:  newVersion.MinecraftVersion,
+						ImageURL: "some-url",
+						JavaImageURLs: map[uint32]string{
+							21: "some-url-java21",
+						},
+					}, nil)
+			},
+			err: apierrs.ErrStartupCommandNotAllowed,
+		},
 		{
 			name:        "flavor deleted",
 			prevVersion: fixture.FlavorVersion(),
@@ -593,8 +736,11 @@ func TestCreateFlavorVersion(t *testing.T) {
 				mockRepo,
 				nil,
 				nil,
+				nil,
 				mockAccess,
-				chunk.Config{},
+				chunk.Config{
+					BuildLimits: tt.limits,
+				},
 			)
 			require.NoError(t, err)
 
@@ -617,6 +763,17 @@ func TestCreateFlavorVersion(t *testing.T) {
 					require.True(t, ok)
 					require.True(t, proto.Equal(tt.badRequest, badRequest))
 				}
+				if tt.precondition != nil {
+					var apiErr apierrs.Error
+					require.ErrorAs(t, err, &apiErr)
+
+					details := apiErr.GRPCStatus().Details()
+					require.Len(t, details, 1)
+
+					precondition, ok := details[0].(*errdetails.PreconditionFailure)
+					require.True(t, ok)
+					require.True(t, proto.Equal(tt.precondition, precondition))
+				}
 				return
 			}
 
@@ -630,3 +787,130 @@ func TestCreateFlavorVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildFlavorVersionAlreadyBuilding(t *testing.T) {
+	var (
+		ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+		versionID  = "019a0000-0000-7000-8000-000000000001"
+		mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+		mockLocker = mock.NewMockLockLocker(t)
+	)
+
+	mockAccess.EXPECT().
+		AccessAuthorized(mocky.Anything, mocky.Anything).
+		Return(nil)
+
+	// the lock is held by a concurrent call, so the build is not initiated again.
+	mockLocker.EXPECT().
+		TryLock(mocky.Anything, lock.FlavorVersionBuildKey(versionID), mocky.Anything).
+		Return(false, nil)
+
+	svc, err := chunk.NewService(
+		slog.New(slog.DiscardHandler),
+		mock.NewMockChunkRepository(t),
+		nil,
+		mockLocker,
+		nil,
+		mockAccess,
+		chunk.Config{},
+	)
+	require.NoError(t, err)
+
+	err = svc.BuildFlavorVersion(ctx, versionID, nil)
+	require.ErrorIs(t, err, apierrs.ErrAlreadyBuilding)
+}
+
+func TestGetFlavorVersion(t *testing.T) {
+	var (
+		version = fixture.FlavorVersion()
+		prov    = resource.BuildProvenance{
+			BaseImageDigest: "sha256:base",
+			ImageDigest:     "sha256:image",
+			InputHash:       version.Hash,
+			ImageBuiltAt:    time.Now(),
+		}
+	)
+
+	tests := []struct {
+		name     string
+		denied   bool
+		prep     func(*mock.MockChunkRepository)
+		expected *resource.BuildProvenance
+		err      error
+	}{
+		{
+			name: "returns provenance",
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+				repo.EXPECT().FlavorVersionProvenance(mocky.Anything, version.ID).Return(prov, nil)
+			},
+			expected: &prov,
+		},
+		{
+			name: "no provenance recorded",
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+				repo.EXPECT().
+					FlavorVersionProvenance(mocky.Anything, version.ID).
+					Return(resource.BuildProvenance{}, apierrs.ErrNotFound)
+			},
+		},
+		{
+			name: "flavor version does not exist",
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().
+					FlavorVersionByID(mocky.Anything, version.ID).
+					Return(resource.FlavorVersion{}, apierrs.ErrNotFound)
+			},
+			err: apierrs.ErrNotFound,
+		},
+		{
+			name:   "not the owner",
+			denied: true,
+			prep:   func(*mock.MockChunkRepository) {},
+			err:    apierrs.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+				mockRepo   = mock.NewMockChunkRepository(t)
+				accessErr  error
+			)
+
+			if tt.denied {
+				accessErr = apierrs.ErrPermissionDenied
+			}
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(accessErr)
+
+			tt.prep(mockRepo)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				mockAccess,
+				chunk.Config{},
+			)
+			require.NoError(t, err)
+
+			actualVersion, actualProv, err := svc.GetFlavorVersion(ctx, version.ID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, version, actualVersion)
+			require.Equal(t, tt.expected, actualProv)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) ImportChunk(
+	ctx context.Context,
+	chunk resource.Chunk,
+	flavorName string,
+	imp resource.ChunkImport,
+	archiveHash string,
+) (resource.ChunkImport, string, map[string]string, error) {
+	if imp.ArchiveSizeBytes > s.cfg.ImportArchiveMaxSizeBytes {
+		return resource.ChunkImport{}, "", nil, apierrs.ErrImportArchiveTooBig
+	}
+
+	// check the minecraft version before creating anything, so users
+	// do not end up with an empty chunk if it is not supported.
+	if _, err := s.repo.GetMinecraftVersionByVersion(ctx, imp.MinecraftVersion); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return resource.ChunkImport{}, "", nil, apierrs.ErrMinecraftVersionNotSupported
+		}
+		return resource.ChunkImport{}, "", nil, fmt.Errorf("minecraft version: %w", err)
+	}
+
+	c, err := s.CreateChunk(ctx, chunk)
+	if err != nil {
+		return resource.ChunkImport{}, "", nil, fmt.Errorf("create chunk: %w", err)
+	}
+
+	f, err := s.CreateFlavor(ctx, c.ID, resource.Flavor{Name: flavorName})
+	if err != nil {
+		return resource.ChunkImport{}, "", nil, fmt.Errorf("create flavor: %w", err)
+	}
+
+	imp.ChunkID = c.ID
+	imp.FlavorID = f.ID
+
+	created, err := s.repo.CreateChunkImport(ctx, imp)
+	if err != nil {
+		return resource.ChunkImport{}, "", nil, fmt.Errorf("create chunk import: %w", err)
+	}
+
+	url, _, err := s.blobStore.PresignURL(
+		ctx,
+		blob.ChunkImportKey(created.ID),
+		archiveHash,
+		s.cfg.PresignedURLExpiry,
+		imp.ArchiveSizeBytes,
+	)
+	if err != nil {
+		return resource.ChunkImport{}, "", nil, fmt.Errorf("presign: %w", err)
+	}
+
+	return created, url, s.blobStore.UploadHeaders(), nil
+}
+
+func (s *svc) StartChunkImport(ctx context.Context, id string) (resource.ChunkImport, error) {
+	imp, err := s.GetChunkImport(ctx, id)
+	if err != nil {
+		return resource.ChunkImport{}, err
+	}
+
+	if imp.State != resource.ChunkImportStatePendingUpload {
+		return imp, nil
+	}
+
+	uploaded, err := s.blobStore.ObjectExists(ctx, blob.ChunkImportKey(id))
+	if err != nil {
+		return resource.ChunkImport{}, fmt.Errorf("archive exists: %w", err)
+	}
+
+	if !uploaded {
+		return resource.ChunkImport{}, apierrs.ErrImportArchiveNotUploaded
+	}
+
+	ret, err := s.repo.StartChunkImport(ctx, id)
+	if err != nil {
+		return resource.ChunkImport{}, fmt.Errorf("start chunk import: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (s *svc) GetChunkImport(ctx context.Context, id string) (resource.ChunkImport, error) {
+	imp, err := s.repo.ChunkImportByID(ctx, id)
+	if err != nil {
+		return resource.ChunkImport{}, fmt.Errorf("chunk import: %w", err)
+	}
+
+	if err := s.authorized(ctx, imp.ChunkID); err != nil {
+		return resource.ChunkImport{}, fmt.Errorf("authorize: %w", err)
+	}
+
+	return imp, nil
+}
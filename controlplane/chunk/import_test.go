@@ -0,0 +1,256 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const importID = "019df3b1-6c2a-7e4d-9b1f-2a3c4d5e6f72"
+
+func TestImportChunk(t *testing.T) {
+	var (
+		c   = fixture.Chunk()
+		f   = fixture.Flavor()
+		cfg = chunk.Config{
+			PresignedURLExpiry:        time.Minute,
+			ImportArchiveMaxSizeBytes: 100,
+		}
+		imp = resource.ChunkImport{
+			Version:          "v1",
+			MinecraftVersion: fixture.MinecraftVersion,
+			MinPlayers:       1,
+			MaxPlayers:       10,
+			ArchiveSizeBytes: 100,
+		}
+	)
+
+	tests := []struct {
+		name        string
+		imp         resource.ChunkImport
+		prep        func(*mock.MockChunkRepository, *mock.MockBlobStore)
+		expected    resource.ChunkImport
+		expectedURL string
+		err         error
+	}{
+		{
+			name: "create chunk, flavor and import",
+			imp:  imp,
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				expected := imp
+				expected.ChunkID = c.ID
+				expected.FlavorID = f.ID
+
+				created := expected
+				created.ID = importID
+				created.State = resource.ChunkImportStatePendingUpload
+
+				repo.EXPECT().
+					GetMinecraftVersionByVersion(mocky.Anything, imp.MinecraftVersion).
+					Return(resource.MinecraftVersion{Version: imp.MinecraftVersion}, nil)
+				repo.EXPECT().CreateChunk(mocky.Anything, mocky.Anything).Return(c, nil)
+				repo.EXPECT().FlavorNameExists(mocky.Anything, c.ID, f.Name).Return(false, nil)
+				repo.EXPECT().CreateFlavor(mocky.Anything, c.ID, resource.Flavor{Name: f.Name}).Return(f, nil)
+				repo.EXPECT().CreateChunkImport(mocky.Anything, expected).Return(created, nil)
+
+				store.EXPECT().
+					PresignURL(
+						mocky.Anything,
+						blob.ChunkImportKey(importID),
+						"hash",
+						cfg.PresignedURLExpiry,
+						uint64(100),
+					).
+					Return("https://example.com/import", time.Now(), nil)
+				store.EXPECT().UploadHeaders().Return(nil)
+			},
+			expected: resource.ChunkImport{
+				ID:               importID,
+				ChunkID:          c.ID,
+				FlavorID:         f.ID,
+				Version:          imp.Version,
+				MinecraftVersion: imp.MinecraftVersion,
+				MinPlayers:       imp.MinPlayers,
+				MaxPlayers:       imp.MaxPlayers,
+				ArchiveSizeBytes: imp.ArchiveSizeBytes,
+				State:            resource.ChunkImportStatePendingUpload,
+			},
+			expectedURL: "https://example.com/import",
+		},
+		{
+			name: "archive too big",
+			imp: resource.ChunkImport{
+				Version:          "v1",
+				MinecraftVersion: fixture.MinecraftVersion,
+				ArchiveSizeBytes: 101,
+			},
+			prep: func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			err:  apierrs.ErrImportArchiveTooBig,
+		},
+		{
+			name: "minecraft version not supported",
+			imp:  imp,
+			prep: func(repo *mock.MockChunkRepository, _ *mock.MockBlobStore) {
+				repo.EXPECT().
+					GetMinecraftVersionByVersion(mocky.Anything, imp.MinecraftVersion).
+					Return(resource.MinecraftVersion{}, pgx.ErrNoRows)
+			},
+			err: apierrs.ErrMinecraftVersionNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockStore  = mock.NewMockBlobStore(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			tt.prep(mockRepo, mockStore)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil).
+				Maybe()
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				mockStore,
+				mockAccess,
+				cfg,
+			)
+			require.NoError(t, err)
+
+			ret, url, _, err := svc.ImportChunk(ctx, c, f.Name, tt.imp, "hash")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ret)
+			require.Equal(t, tt.expectedURL, url)
+		})
+	}
+}
+
+func TestStartChunkImport(t *testing.T) {
+	var (
+		pending = resource.ChunkImport{
+			ID:       importID,
+			ChunkID:  fixture.Chunk().ID,
+			FlavorID: fixture.Flavor().ID,
+			State:    resource.ChunkImportStatePendingUpload,
+		}
+		importing = resource.ChunkImport{
+			ID:       importID,
+			ChunkID:  fixture.Chunk().ID,
+			FlavorID: fixture.Flavor().ID,
+			State:    resource.ChunkImportStateImporting,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		prep     func(*mock.MockChunkRepository, *mock.MockBlobStore)
+		expected resource.ChunkImport
+		err      error
+	}{
+		{
+			name: "start import once archive has been uploaded",
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().ChunkImportByID(mocky.Anything, importID).Return(pending, nil)
+				repo.EXPECT().StartChunkImport(mocky.Anything, importID).Return(importing, nil)
+				store.EXPECT().ObjectExists(mocky.Anything, blob.ChunkImportKey(importID)).Return(true, nil)
+			},
+			expected: importing,
+		},
+		{
+			name: "archive has not been uploaded",
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().ChunkImportByID(mocky.Anything, importID).Return(pending, nil)
+				store.EXPECT().ObjectExists(mocky.Anything, blob.ChunkImportKey(importID)).Return(false, nil)
+			},
+			err: apierrs.ErrImportArchiveNotUploaded,
+		},
+		{
+			name: "already started import is returned as is",
+			prep: func(repo *mock.MockChunkRepository, _ *mock.MockBlobStore) {
+				repo.EXPECT().ChunkImportByID(mocky.Anything, importID).Return(importing, nil)
+			},
+			expected: importing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockStore  = mock.NewMockBlobStore(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			tt.prep(mockRepo, mockStore)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				mockStore,
+				mockAccess,
+				chunk.Config{},
+			)
+			require.NoError(t, err)
+
+			ret, err := svc.StartChunkImport(ctx, importID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ret)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"fmt"
+
+	"github.com/spacechunks/explorer/internal/file"
+)
+
+// BuildLimits restrict the size of flavor versions that are accepted by
+// the build process. limits that are 0 are not enforced.
+type BuildLimits struct {
+	MaxFileCount      uint64
+	MaxTotalSizeBytes uint64
+	MaxLayerSizeBytes uint64
+	MaxFileSizeBytes  uint64
+}
+
+// Violations returns a human-readable description for each limit that is exceeded
+// by a flavor version consisting of fileCount files with a total size of totalSizeBytes.
+func (l BuildLimits) Violations(fileCount uint64, totalSizeBytes uint64) []string {
+	var ret []string
+
+	if l.MaxFileCount > 0 && fileCount > l.MaxFileCount {
+		ret = append(ret, fmt.Sprintf(
+			"flavor version contains %d files, but at most %d are allowed",
+			fileCount,
+			l.MaxFileCount,
+		))
+	}
+
+	if l.MaxTotalSizeBytes > 0 && totalSizeBytes > l.MaxTotalSizeBytes {
+		ret = append(ret, fmt.Sprintf(
+			"flavor version files are %d bytes in total, but at most %d bytes are allowed",
+			totalSizeBytes,
+			l.MaxTotalSizeBytes,
+		))
+	}
+
+	return ret
+}
+
+// FileSizeViolation returns a human-readable description if a single file
+// exceeds the maximum file size. name identifies the file in the description.
+func (l BuildLimits) FileSizeViolation(name string, sizeBytes uint64) (string, bool) {
+	if l.MaxFileSizeBytes == 0 || sizeBytes <= l.MaxFileSizeBytes {
+		return "", false
+	}
+
+	return fmt.Sprintf(
+		"%s is %d bytes, but at most %d bytes are allowed per file",
+		name,
+		sizeBytes,
+		l.MaxFileSizeBytes,
+	), true
+}
+
+// FileViolations returns a human-readable description for each limit exceeded by
+// the given files. unlike Violations, the size of each file is checked as well.
+// files with an unknown size only count towards the number of files.
+func (l BuildLimits) FileViolations(files []file.Hash) []string {
+	var (
+		totalSize uint64
+		perFile   []string
+	)
+
+	for _, f := range files {
+		totalSize += f.SizeBytes
+		if v, ok := l.FileSizeViolation(f.Path, f.SizeBytes); ok {
+			perFile = append(perFile, v)
+		}
+	}
+
+	return append(l.Violations(uint64(len(files)), totalSize), perFile...)
+}
+
+// LayerViolation returns a human-readable description if the image layer
+// containing the flavor version files exceeds the maximum layer size.
+func (l BuildLimits) LayerViolation(layerSizeBytes uint64) (string, bool) {
+	if l.MaxLayerSizeBytes == 0 || layerSizeBytes <= l.MaxLayerSizeBytes {
+		return "", false
+	}
+
+	return fmt.Sprintf(
+		"image layer is %d bytes, but at most %d bytes are allowed",
+		layerSizeBytes,
+		l.MaxLayerSizeBytes,
+	), true
+}
@@ -0,0 +1,138 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/internal/file"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildLimitsViolations(t *testing.T) {
+	limits := chunk.BuildLimits{
+		MaxFileCount:      10,
+		MaxTotalSizeBytes: 100,
+		MaxLayerSizeBytes: 50,
+	}
+
+	tests := []struct {
+		name           string
+		limits         chunk.BuildLimits
+		fileCount      uint64
+		totalSizeBytes uint64
+		layerSizeBytes uint64
+		violations     int
+		layerViolation bool
+	}{
+		{
+			name:           "within limits",
+			limits:         limits,
+			fileCount:      10,
+			totalSizeBytes: 100,
+			layerSizeBytes: 50,
+		},
+		{
+			name:           "all limits exceeded",
+			limits:         limits,
+			fileCount:      11,
+			totalSizeBytes: 101,
+			layerSizeBytes: 51,
+			violations:     2,
+			layerViolation: true,
+		},
+		{
+			name:           "zero values disable limits",
+			fileCount:      1000,
+			totalSizeBytes: 1000,
+			layerSizeBytes: 1000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Len(t, tt.limits.Violations(tt.fileCount, tt.totalSizeBytes), tt.violations)
+
+			_, ok := tt.limits.LayerViolation(tt.layerSizeBytes)
+			require.Equal(t, tt.layerViolation, ok)
+		})
+	}
+}
+
+func TestBuildLimitsFileViolations(t *testing.T) {
+	limits := chunk.BuildLimits{
+		MaxFileCount:      3,
+		MaxTotalSizeBytes: 100,
+		MaxFileSizeBytes:  40,
+	}
+
+	tests := []struct {
+		name       string
+		limits     chunk.BuildLimits
+		files      []file.Hash
+		violations []string
+	}{
+		{
+			name:   "within limits",
+			limits: limits,
+			files: []file.Hash{
+				{Path: "a", SizeBytes: 40},
+				{Path: "b", SizeBytes: 40},
+				{Path: "c", SizeBytes: 20},
+			},
+		},
+		{
+			name:   "single file too big",
+			limits: limits,
+			files: []file.Hash{
+				{Path: "plugins/huge.jar", SizeBytes: 41},
+				{Path: "b", SizeBytes: 10},
+			},
+			violations: []string{
+				"plugins/huge.jar is 41 bytes, but at most 40 bytes are allowed per file",
+			},
+		},
+		{
+			name:   "all limits exceeded",
+			limits: limits,
+			files: []file.Hash{
+				{Path: "a", SizeBytes: 41},
+				{Path: "b", SizeBytes: 40},
+				{Path: "c", SizeBytes: 40},
+				{Path: "d"},
+			},
+			violations: []string{
+				"flavor version contains 4 files, but at most 3 are allowed",
+				"flavor version files are 121 bytes in total, but at most 100 bytes are allowed",
+				"a is 41 bytes, but at most 40 bytes are allowed per file",
+			},
+		},
+		{
+			name: "zero values disable limits",
+			files: []file.Hash{
+				{Path: "a", SizeBytes: 1000},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.violations, tt.limits.FileViolations(tt.files))
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spacechunks/explorer/controlplane/blob"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) CreateChunkMedia(
+	ctx context.Context,
+	media resource.ChunkMedia,
+) (resource.ChunkMedia, string, map[string]string, error) {
+	digest, err := hex.DecodeString(media.Hash)
+	if err != nil || len(digest) != 32 {
+		return resource.ChunkMedia{}, "", nil, apierrs.ErrInvalidHash
+	}
+
+	if !slices.Contains(resource.ChunkMediaContentTypes, media.ContentType) {
+		return resource.ChunkMedia{}, "", nil, apierrs.ErrUnsupportedMediaType
+	}
+
+	if media.SizeBytes > s.cfg.MediaMaxSizeBytes {
+		return resource.ChunkMedia{}, "", nil, apierrs.ErrMediaTooBig
+	}
+
+	if err := s.authorizedChunk(ctx, media.ChunkID); err != nil {
+		return resource.ChunkMedia{}, "", nil, err
+	}
+
+	existing, err := s.repo.ChunkMediaByChunkID(ctx, media.ChunkID)
+	if err != nil {
+		return resource.ChunkMedia{}, "", nil, fmt.Errorf("chunk media: %w", err)
+	}
+
+	// adding an image the chunk already has returns the existing
+	// media, so it does not count towards the limit.
+	exists := slices.ContainsFunc(existing, func(m resource.ChunkMedia) bool {
+		return m.Hash == media.Hash
+	})
+
+	if !exists && len(existing) >= s.cfg.MediaMaxCount {
+		return resource.ChunkMedia{}, "", nil, apierrs.ErrTooManyMedia
+	}
+
+	created, err := s.repo.CreateChunkMedia(ctx, media)
+	if err != nil {
+		return resource.ChunkMedia{}, "", nil, fmt.Errorf("create chunk media: %w", err)
+	}
+
+	key := blob.ChunkMediaKey(media.ChunkID, media.Hash)
+
+	uploaded, err := s.blobStore.ObjectExists(ctx, key)
+	if err != nil {
+		return resource.ChunkMedia{}, "", nil, fmt.Errorf("media exists: %w", err)
+	}
+
+	if uploaded {
+		return created, "", nil, nil
+	}
+
+	url, _, err := s.blobStore.PresignURL(
+		ctx,
+		key,
+		base64.StdEncoding.EncodeToString(digest),
+		s.cfg.PresignedURLExpiry,
+		media.SizeBytes,
+	)
+	if err != nil {
+		return resource.ChunkMedia{}, "", nil, fmt.Errorf("presign: %w", err)
+	}
+
+	return created, url, s.blobStore.UploadHeaders(), nil
+}
+
+func (s *svc) ListChunkMedia(ctx context.Context, chunkID string) ([]resource.ChunkMedia, error) {
+	if _, err := s.GetChunk(ctx, chunkID); err != nil {
+		return nil, fmt.Errorf("get chunk: %w", err)
+	}
+
+	media, err := s.repo.ChunkMediaByChunkID(ctx, chunkID)
+	if err != nil {
+		return nil, fmt.Errorf("chunk media: %w", err)
+	}
+
+	ret := make([]resource.ChunkMedia, 0, len(media))
+	for _, m := range media {
+		key := blob.ChunkMediaKey(m.ChunkID, m.Hash)
+
+		// media is created before its image is uploaded, so
+		// skip the ones that have nothing to download yet.
+		uploaded, err := s.blobStore.ObjectExists(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("media exists: %w", err)
+		}
+
+		if !uploaded {
+			continue
+		}
+
+		url, expiresAt, err := s.blobStore.PresignDownloadURL(ctx, key, s.cfg.PresignedURLExpiry)
+		if err != nil {
+			return nil, fmt.Errorf("presign download url: %w", err)
+		}
+
+		m.DownloadURL = url
+		m.DownloadURLExpiresAt = expiresAt
+		ret = append(ret, m)
+	}
+
+	return ret, nil
+}
+
+func (s *svc) DeleteChunkMedia(ctx context.Context, id string) error {
+	m, err := s.repo.ChunkMediaByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return apierrs.ErrMediaNotFound
+		}
+		return fmt.Errorf("chunk media: %w", err)
+	}
+
+	if err := s.authorizedChunk(ctx, m.ChunkID); err != nil {
+		return err
+	}
+
+	// the image is left in the bucket, because the store does not support
+	// deleting objects. adding the same image again does not require
+	// uploading it again.
+	if err := s.repo.DeleteChunkMedia(ctx, id); err != nil {
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return apierrs.ErrMediaNotFound
+		}
+		return fmt.Errorf("delete chunk media: %w", err)
+	}
+
+	return nil
+}
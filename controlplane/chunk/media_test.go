@@ -0,0 +1,244 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateChunkMedia(t *testing.T) {
+	var (
+		c    = fixture.Chunk()
+		hash = strings.Repeat("ab", 32)
+		cfg  = chunk.Config{
+			PresignedURLExpiry: time.Minute,
+			MediaMaxSizeBytes:  100,
+			MediaMaxCount:      1,
+		}
+		media = resource.ChunkMedia{
+			ChunkID:     c.ID,
+			Hash:        hash,
+			ContentType: "image/png",
+			SizeBytes:   10,
+		}
+		other = resource.ChunkMedia{
+			ID:          "019df3b1-6c2a-7e4d-9b1f-2a3c4d5e6f70",
+			ChunkID:     c.ID,
+			Hash:        strings.Repeat("cd", 32),
+			ContentType: "image/png",
+			SizeBytes:   10,
+		}
+	)
+
+	tests := []struct {
+		name        string
+		media       resource.ChunkMedia
+		prep        func(*mock.MockChunkRepository, *mock.MockBlobStore)
+		expectedURL string
+		err         error
+	}{
+		{
+			name:  "presign url if image has not been uploaded",
+			media: media,
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+				repo.EXPECT().ChunkMediaByChunkID(mocky.Anything, c.ID).Return(nil, nil)
+				repo.EXPECT().CreateChunkMedia(mocky.Anything, media).Return(media, nil)
+
+				store.EXPECT().ObjectExists(mocky.Anything, blob.ChunkMediaKey(c.ID, hash)).Return(false, nil)
+				store.EXPECT().
+					PresignURL(
+						mocky.Anything,
+						blob.ChunkMediaKey(c.ID, hash),
+						"q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s=",
+						cfg.PresignedURLExpiry,
+						uint64(10),
+					).
+					Return("https://example.com/media", time.Now(), nil)
+				store.EXPECT().UploadHeaders().Return(nil)
+			},
+			expectedURL: "https://example.com/media",
+		},
+		{
+			name:  "existing image does not count towards limit",
+			media: media,
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+				repo.EXPECT().ChunkMediaByChunkID(mocky.Anything, c.ID).Return([]resource.ChunkMedia{media}, nil)
+				repo.EXPECT().CreateChunkMedia(mocky.Anything, media).Return(media, nil)
+
+				store.EXPECT().ObjectExists(mocky.Anything, blob.ChunkMediaKey(c.ID, hash)).Return(true, nil)
+			},
+		},
+		{
+			name:  "too many images",
+			media: media,
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+				repo.EXPECT().ChunkMediaByChunkID(mocky.Anything, c.ID).Return([]resource.ChunkMedia{other}, nil)
+			},
+			err: apierrs.ErrTooManyMedia,
+		},
+		{
+			name: "unsupported content type",
+			media: resource.ChunkMedia{
+				ChunkID:     c.ID,
+				Hash:        hash,
+				ContentType: "image/svg+xml",
+				SizeBytes:   10,
+			},
+			prep: func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			err:  apierrs.ErrUnsupportedMediaType,
+		},
+		{
+			name: "image too big",
+			media: resource.ChunkMedia{
+				ChunkID:     c.ID,
+				Hash:        hash,
+				ContentType: "image/webp",
+				SizeBytes:   101,
+			},
+			prep: func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			err:  apierrs.ErrMediaTooBig,
+		},
+		{
+			name: "invalid hash",
+			media: resource.ChunkMedia{
+				ChunkID:     c.ID,
+				Hash:        "abc",
+				ContentType: "image/png",
+				SizeBytes:   10,
+			},
+			prep: func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			err:  apierrs.ErrInvalidHash,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockStore  = mock.NewMockBlobStore(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			tt.prep(mockRepo, mockStore)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil).
+				Maybe()
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				mockStore,
+				mockAccess,
+				cfg,
+			)
+			require.NoError(t, err)
+
+			m, url, _, err := svc.CreateChunkMedia(ctx, tt.media)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.media, m)
+			require.Equal(t, tt.expectedURL, url)
+		})
+	}
+}
+
+func TestListChunkMedia(t *testing.T) {
+	var (
+		c         = fixture.Chunk()
+		expiresAt = time.Date(2026, 10, 17, 13, 0, 0, 0, time.UTC)
+		uploaded  = resource.ChunkMedia{
+			ID:          "019df3b1-6c2a-7e4d-9b1f-2a3c4d5e6f70",
+			ChunkID:     c.ID,
+			Hash:        strings.Repeat("ab", 32),
+			ContentType: "image/png",
+			SizeBytes:   10,
+		}
+		pending = resource.ChunkMedia{
+			ID:          "019df3b1-6c2a-7e4d-9b1f-2a3c4d5e6f71",
+			ChunkID:     c.ID,
+			Hash:        strings.Repeat("cd", 32),
+			ContentType: "image/jpeg",
+			SizeBytes:   10,
+		}
+		ctx       = context.Background()
+		mockRepo  = mock.NewMockChunkRepository(t)
+		mockStore = mock.NewMockBlobStore(t)
+	)
+
+	mockRepo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+	mockRepo.EXPECT().
+		ChunkMediaByChunkID(mocky.Anything, c.ID).
+		Return([]resource.ChunkMedia{uploaded, pending}, nil)
+
+	mockStore.EXPECT().
+		ObjectExists(mocky.Anything, blob.ChunkMediaKey(c.ID, uploaded.Hash)).
+		Return(true, nil)
+	mockStore.EXPECT().
+		ObjectExists(mocky.Anything, blob.ChunkMediaKey(c.ID, pending.Hash)).
+		Return(false, nil)
+	mockStore.EXPECT().
+		PresignDownloadURL(mocky.Anything, blob.ChunkMediaKey(c.ID, uploaded.Hash), time.Minute).
+		Return("https://example.com/media", expiresAt, nil)
+
+	svc, err := chunk.NewService(
+		slog.New(slog.DiscardHandler),
+		mockRepo,
+		nil,
+		nil,
+		mockStore,
+		nil,
+		chunk.Config{PresignedURLExpiry: time.Minute},
+	)
+	require.NoError(t, err)
+
+	actual, err := svc.ListChunkMedia(ctx, c.ID)
+	require.NoError(t, err)
+
+	expected := uploaded
+	expected.DownloadURL = "https://example.com/media"
+	expected.DownloadURLExpiresAt = expiresAt
+
+	require.Equal(t, []resource.ChunkMedia{expected}, actual)
+}
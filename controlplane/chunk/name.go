@@ -0,0 +1,112 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/chunkname"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) RenameChunk(ctx context.Context, id string, name string) (resource.Chunk, error) {
+	if err := validateChunkName(name); err != nil {
+		return resource.Chunk{}, err
+	}
+
+	old, err := s.repo.GetChunkByID(ctx, id)
+	if err != nil {
+		return resource.Chunk{}, fmt.Errorf("get chunk: %w", err)
+	}
+
+	if old.DeletedAt != nil {
+		return resource.Chunk{}, apierrs.ErrChunkNotFound
+	}
+
+	if err := s.authorized(ctx, id); err != nil {
+		return resource.Chunk{}, fmt.Errorf("authorize: %w", err)
+	}
+
+	old.Name = name
+
+	ret, err := s.repo.UpdateChunk(ctx, old)
+	if err != nil {
+		return resource.Chunk{}, fmt.Errorf("update chunk: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (s *svc) GetChunkByName(ctx context.Context, name string) (resource.Chunk, error) {
+	id, err := s.repo.ChunkIDByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return resource.Chunk{}, apierrs.ErrChunkNotFound
+		}
+		return resource.Chunk{}, fmt.Errorf("chunk id by name: %w", err)
+	}
+
+	return s.GetChunk(ctx, id)
+}
+
+func (s *svc) CheckChunkName(ctx context.Context, name string) (resource.ChunkNameStatus, string, error) {
+	if err := validateChunkName(name); err != nil {
+		return "", "", err
+	}
+
+	actorID, ok := ctx.Value(contextkey.ActorID).(string)
+	if !ok {
+		return "", "", errors.New("actor_id not found in context")
+	}
+
+	id, err := s.repo.ChunkIDByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return resource.ChunkNameStatusAvailable, "", nil
+		}
+		return "", "", fmt.Errorf("chunk id by name: %w", err)
+	}
+
+	c, err := s.repo.GetChunkByID(ctx, id)
+	if err != nil {
+		return "", "", fmt.Errorf("get chunk: %w", err)
+	}
+
+	// names of deleted chunks are released once the chunk has been
+	// archived. until then, not even the owner can use the name.
+	if c.Owner.ID != actorID || c.DeletedAt != nil {
+		return resource.ChunkNameStatusTaken, "", nil
+	}
+
+	return resource.ChunkNameStatusOwned, c.ID, nil
+}
+
+func validateChunkName(name string) error {
+	if err := chunkname.Validate(name); err != nil {
+		if errors.Is(err, chunkname.ErrTooLong) {
+			return apierrs.ErrNameTooLong
+		}
+		return apierrs.ErrInvalidName
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameChunk(t *testing.T) {
+	c := fixture.Chunk()
+
+	tests := []struct {
+		name     string
+		newName  string
+		expected resource.Chunk
+		err      error
+		prep     func(*mock.MockChunkRepository)
+	}{
+		{
+			name:    "works",
+			newName: "Sky Wars",
+			expected: fixture.Chunk(func(c *resource.Chunk) {
+				c.Name = "Sky Wars"
+			}),
+			prep: func(repo *mock.MockChunkRepository) {
+				renamed := fixture.Chunk(func(c *resource.Chunk) {
+					c.Name = "Sky Wars"
+				})
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+				repo.EXPECT().UpdateChunk(mocky.Anything, renamed).Return(renamed, nil)
+			},
+		},
+		{
+			name:    "name taken",
+			newName: "Sky Wars",
+			err:     apierrs.ErrChunkNameTaken,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+				repo.EXPECT().
+					UpdateChunk(mocky.Anything, mocky.Anything).
+					Return(resource.Chunk{}, apierrs.ErrChunkNameTaken)
+			},
+		},
+		{
+			name:    "invalid name",
+			newName: "a/b",
+			err:     apierrs.ErrInvalidName,
+			prep:    func(*mock.MockChunkRepository) {},
+		},
+		{
+			name:    "deleted chunk",
+			newName: "Sky Wars",
+			err:     apierrs.ErrChunkNotFound,
+			prep: func(repo *mock.MockChunkRepository) {
+				now := time.Now()
+				deleted := fixture.Chunk(func(c *resource.Chunk) {
+					c.DeletedAt = &now
+				})
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(deleted, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				mockAccess,
+				chunk.Config{},
+			)
+			require.NoError(t, err)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil).
+				Maybe()
+
+			tt.prep(mockRepo)
+
+			actual, err := svc.RenameChunk(ctx, c.ID, tt.newName)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCheckChunkName(t *testing.T) {
+	c := fixture.Chunk()
+
+	tests := []struct {
+		name    string
+		actorID string
+		status  resource.ChunkNameStatus
+		chunkID string
+		err     error
+		prep    func(*mock.MockChunkRepository)
+	}{
+		{
+			name:    "available",
+			actorID: c.Owner.ID,
+			status:  resource.ChunkNameStatusAvailable,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().ChunkIDByName(mocky.Anything, c.Name).Return("", apierrs.ErrNotFound)
+			},
+		},
+		{
+			name:    "owned by actor",
+			actorID: c.Owner.ID,
+			status:  resource.ChunkNameStatusOwned,
+			chunkID: c.ID,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().ChunkIDByName(mocky.Anything, c.Name).Return(c.ID, nil)
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+			},
+		},
+		{
+			name:    "owned by someone else",
+			actorID: "someone-else",
+			status:  resource.ChunkNameStatusTaken,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().ChunkIDByName(mocky.Anything, c.Name).Return(c.ID, nil)
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+			},
+		},
+		{
+			name:    "owned by actor but deleted",
+			actorID: c.Owner.ID,
+			status:  resource.ChunkNameStatusTaken,
+			prep: func(repo *mock.MockChunkRepository) {
+				now := time.Now()
+				deleted := fixture.Chunk(func(c *resource.Chunk) {
+					c.DeletedAt = &now
+				})
+				repo.EXPECT().ChunkIDByName(mocky.Anything, c.Name).Return(c.ID, nil)
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(deleted, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx      = context.WithValue(context.Background(), contextkey.ActorID, tt.actorID)
+				mockRepo = mock.NewMockChunkRepository(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				chunk.Config{},
+			)
+			require.NoError(t, err)
+
+			tt.prep(mockRepo)
+
+			status, chunkID, err := svc.CheckChunkName(ctx, c.Name)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.status, status)
+			require.Equal(t, tt.chunkID, chunkID)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spacechunks/explorer/controlplane/authz"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/file"
+)
+
+// PatchFile is an added or changed file of a flavor version
+// that is uploaded individually instead of using a tarball.
+type PatchFile struct {
+	Path string
+	Hash string
+
+	// ContentHash is the base64 encoded SHA256 digest of the file.
+	ContentHash string
+	SizeBytes   uint64
+}
+
+// PatchUpload is the presigned URL a file of a patch has to be uploaded to.
+type PatchUpload struct {
+	Path string
+	URL  string
+}
+
+func (s *svc) PatchFlavorVersion(
+	ctx context.Context,
+	versionID string,
+	files []PatchFile,
+) ([]PatchUpload, map[string]string, error) {
+	actorID, ok := ctx.Value(contextkey.ActorID).(string)
+	if !ok {
+		return nil, nil, errors.New("actor_id not found in context")
+	}
+
+	if err := s.access.AccessAuthorized(
+		ctx,
+		authz.WithOwnershipRule(actorID, authz.FlavorVersionResourceDef(versionID)),
+	); err != nil {
+		return nil, nil, fmt.Errorf("access: %w", err)
+	}
+
+	var totalSize uint64
+	for _, f := range files {
+		totalSize += f.SizeBytes
+	}
+
+	if uint64(len(files)) > s.cfg.PatchMaxFiles || totalSize > s.cfg.PatchMaxSizeBytes {
+		return nil, nil, apierrs.ErrPatchTooBig
+	}
+
+	var violations []string
+	for _, f := range files {
+		if v, ok := s.cfg.BuildLimits.FileSizeViolation(f.Path, f.SizeBytes); ok {
+			violations = append(violations, v)
+		}
+	}
+
+	if len(violations) > 0 {
+		return nil, nil, apierrs.BuildLimitsExceeded(violations...)
+	}
+
+	ver, err := s.repo.FlavorVersionByID(ctx, versionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("flavor version: %w", err)
+	}
+
+	if ver.FilesUploaded {
+		return nil, nil, apierrs.ErrFlavorFilesUploaded
+	}
+
+	versionFiles := make(map[string]string, len(ver.FileHashes))
+	for _, fh := range ver.FileHashes {
+		versionFiles[fh.Path] = fh.Hash
+	}
+
+	var (
+		manifest = make([]file.Hash, 0, len(files))
+		uploads  = make([]PatchUpload, 0, len(files))
+		staged   = make(map[string]bool, len(files))
+	)
+
+	for _, f := range files {
+		f.Path = filepath.ToSlash(filepath.Clean(f.Path))
+		if hash, ok := versionFiles[f.Path]; !ok || hash != f.Hash {
+			return nil, nil, apierrs.ErrPatchFileMismatch
+		}
+
+		// files are only moved into the cas by the image build after their
+		// content has been verified, so everything in there can be trusted.
+		exists, err := s.blobStore.ObjectExists(ctx, blob.CASKeyPrefix+"/"+f.Hash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cas object exists: %w", err)
+		}
+
+		if exists {
+			continue
+		}
+
+		manifest = append(manifest, file.Hash{Path: f.Path, Hash: f.Hash})
+
+		// files with the same content only have to be uploaded once
+		if staged[f.Hash] {
+			continue
+		}
+
+		url, _, err := s.blobStore.PresignURL(
+			ctx,
+			blob.PatchKey(versionID, f.Hash),
+			f.ContentHash,
+			s.cfg.PresignedURLExpiry,
+			f.SizeBytes,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("presign: %w", err)
+		}
+
+		staged[f.Hash] = true
+		uploads = append(uploads, PatchUpload{
+			Path: f.Path,
+			URL:  url,
+		})
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+
+	if err := s.blobStore.SimplePut(ctx, blob.PatchManifestKey(versionID), bytes.NewReader(data), nil); err != nil {
+		return nil, nil, fmt.Errorf("put manifest: %w", err)
+	}
+
+	return uploads, s.blobStore.UploadHeaders(), nil
+}
+
+// patchUploaded reports whether a patch has been requested for the flavor
+// version and all of its files have been uploaded.
+func (s *svc) patchUploaded(ctx context.Context, versionID string) (bool, error) {
+	exists, err := s.blobStore.ObjectExists(ctx, blob.PatchManifestKey(versionID))
+	if err != nil {
+		return false, fmt.Errorf("manifest exists: %w", err)
+	}
+
+	if !exists {
+		return false, nil
+	}
+
+	manifest, err := ReadPatchManifest(ctx, s.blobStore, versionID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, f := range manifest {
+		exists, err := s.blobStore.ObjectExists(ctx, blob.PatchKey(versionID, f.Hash))
+		if err != nil {
+			return false, fmt.Errorf("patch file exists: %w", err)
+		}
+
+		if !exists {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// ReadPatchManifest returns the files that have been uploaded for the flavor version using a patch.
+func ReadPatchManifest(ctx context.Context, store blob.Store, versionID string) ([]file.Hash, error) {
+	var buf bytes.Buffer
+	if err := store.WriteTo(ctx, blob.PatchManifestKey(versionID), &buf); err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+
+	var manifest []file.Hash
+	if err := json.Unmarshal(buf.Bytes(), &manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+
+	return manifest, nil
+}
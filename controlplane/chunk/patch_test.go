@@ -0,0 +1,207 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/file"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPatchFlavorVersion(t *testing.T) {
+	version := fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+		v.FileHashes = []file.Hash{
+			{Path: "paper.yml", Hash: "aaaaaaaaaaaaaaaa"},
+			{Path: "plugins/a.yml", Hash: "bbbbbbbbbbbbbbbb"},
+			{Path: "plugins/b.yml", Hash: "bbbbbbbbbbbbbbbb"},
+			{Path: "server.properties", Hash: "cccccccccccccccc"},
+		}
+	})
+
+	cfg := chunk.Config{
+		PresignedURLExpiry: time.Minute,
+		PatchMaxFiles:      3,
+		PatchMaxSizeBytes:  100,
+		BuildLimits: chunk.BuildLimits{
+			MaxFileSizeBytes: 60,
+		},
+	}
+
+	tests := []struct {
+		name             string
+		files            []chunk.PatchFile
+		prep             func(*mock.MockChunkRepository, *mock.MockBlobStore)
+		expected         []chunk.PatchUpload
+		expectedManifest []file.Hash
+		err              error
+	}{
+		{
+			name: "only files missing in cas are uploaded once",
+			files: []chunk.PatchFile{
+				{Path: "paper.yml", Hash: "aaaaaaaaaaaaaaaa", ContentHash: "sha-a", SizeBytes: 10},
+				{Path: "plugins/a.yml", Hash: "bbbbbbbbbbbbbbbb", ContentHash: "sha-b", SizeBytes: 10},
+				{Path: "plugins/../plugins/b.yml", Hash: "bbbbbbbbbbbbbbbb", ContentHash: "sha-b", SizeBytes: 10},
+			},
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+
+				store.EXPECT().
+					ObjectExists(mocky.Anything, blob.CASKeyPrefix+"/aaaaaaaaaaaaaaaa").
+					Return(true, nil)
+
+				store.EXPECT().
+					ObjectExists(mocky.Anything, blob.CASKeyPrefix+"/bbbbbbbbbbbbbbbb").
+					Return(false, nil)
+
+				store.EXPECT().
+					PresignURL(
+						mocky.Anything,
+						blob.PatchKey(version.ID, "bbbbbbbbbbbbbbbb"),
+						"sha-b",
+						cfg.PresignedURLExpiry,
+						uint64(10),
+					).
+					Return("https://example.com/b", time.Now(), nil).
+					Once()
+
+				store.EXPECT().UploadHeaders().Return(nil)
+			},
+			expected: []chunk.PatchUpload{
+				{Path: "plugins/a.yml", URL: "https://example.com/b"},
+			},
+			expectedManifest: []file.Hash{
+				{Path: "plugins/a.yml", Hash: "bbbbbbbbbbbbbbbb"},
+				{Path: "plugins/b.yml", Hash: "bbbbbbbbbbbbbbbb"},
+			},
+		},
+		{
+			name: "too many files",
+			files: []chunk.PatchFile{
+				{Path: "a"}, {Path: "b"}, {Path: "c"}, {Path: "d"},
+			},
+			prep: func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			err:  apierrs.ErrPatchTooBig,
+		},
+		{
+			name: "files too big",
+			files: []chunk.PatchFile{
+				{Path: "paper.yml", Hash: "aaaaaaaaaaaaaaaa", SizeBytes: 101},
+			},
+			prep: func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			err:  apierrs.ErrPatchTooBig,
+		},
+		{
+			name: "single file too big",
+			files: []chunk.PatchFile{
+				{Path: "paper.yml", Hash: "aaaaaaaaaaaaaaaa", SizeBytes: 61},
+			},
+			prep: func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			err: apierrs.BuildLimitsExceeded(
+				"paper.yml is 61 bytes, but at most 60 bytes are allowed per file",
+			),
+		},
+		{
+			name: "hash does not match flavor version",
+			files: []chunk.PatchFile{
+				{Path: "paper.yml", Hash: "dddddddddddddddd", SizeBytes: 10},
+			},
+			prep: func(repo *mock.MockChunkRepository, _ *mock.MockBlobStore) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+			},
+			err: apierrs.ErrPatchFileMismatch,
+		},
+		{
+			name: "files already uploaded",
+			files: []chunk.PatchFile{
+				{Path: "paper.yml", Hash: "aaaaaaaaaaaaaaaa", SizeBytes: 10},
+			},
+			prep: func(repo *mock.MockChunkRepository, _ *mock.MockBlobStore) {
+				uploaded := version
+				uploaded.FilesUploaded = true
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(uploaded, nil)
+			},
+			err: apierrs.ErrFlavorFilesUploaded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockStore  = mock.NewMockBlobStore(t)
+			)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil)
+
+			tt.prep(mockRepo, mockStore)
+
+			if tt.expectedManifest != nil {
+				mockStore.EXPECT().
+					SimplePut(mocky.Anything, blob.PatchManifestKey(version.ID), mocky.Anything, mocky.Anything).
+					RunAndReturn(func(_ context.Context, _ string, r io.Reader, _ map[string]string) error {
+						var actual []file.Hash
+						require.NoError(t, json.NewDecoder(r).Decode(&actual))
+						require.Equal(t, tt.expectedManifest, actual)
+						return nil
+					})
+			}
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				mockStore,
+				mockAccess,
+				cfg,
+			)
+			require.NoError(t, err)
+
+			uploads, _, err := svc.PatchFlavorVersion(ctx, version.ID, tt.files)
+			if tt.err != nil {
+				// errors with details cannot be compared using errors.Is
+				require.True(t, proto.Equal(status.Convert(tt.err).Proto(), status.Convert(err).Proto()))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, uploads)
+		})
+	}
+}
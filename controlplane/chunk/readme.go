@@ -0,0 +1,63 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) SetChunkReadme(ctx context.Context, readme resource.ChunkReadme) (resource.ChunkReadme, error) {
+	if len(readme.Markdown) > resource.MaxChunkReadmeBytes {
+		return resource.ChunkReadme{}, apierrs.ErrReadmeTooBig
+	}
+
+	if err := s.authorizedChunk(ctx, readme.ChunkID); err != nil {
+		return resource.ChunkReadme{}, err
+	}
+
+	ret, err := s.repo.UpsertChunkReadme(ctx, readme)
+	if err != nil {
+		return resource.ChunkReadme{}, fmt.Errorf("upsert readme: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (s *svc) GetChunkReadme(ctx context.Context, chunkID string) (resource.ChunkReadme, error) {
+	// the readme is shown on the page of the chunk, so like
+	// the chunk itself, it can be read by everyone.
+	if _, err := s.GetChunk(ctx, chunkID); err != nil {
+		return resource.ChunkReadme{}, fmt.Errorf("get chunk: %w", err)
+	}
+
+	ret, err := s.repo.ChunkReadmeByChunkID(ctx, chunkID)
+	if err != nil {
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return resource.ChunkReadme{ChunkID: chunkID}, nil
+		}
+		return resource.ChunkReadme{}, fmt.Errorf("readme: %w", err)
+	}
+
+	return ret, nil
+}
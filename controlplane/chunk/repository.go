@@ -29,6 +29,7 @@ type Repository interface {
 	CreateChunk(ctx context.Context, chunk resource.Chunk) (resource.Chunk, error)
 	GetChunkByID(ctx context.Context, id string) (resource.Chunk, error)
 	UpdateChunk(ctx context.Context, chunk resource.Chunk) (resource.Chunk, error)
+	ChunkIDByName(ctx context.Context, name string) (string, error)
 	ListChunks(ctx context.Context, pageSize int, afterID *string) ([]resource.Chunk, error)
 	CreateFlavor(ctx context.Context, chunkID string, flavor resource.Flavor) (resource.Flavor, error)
 	FlavorNameExists(ctx context.Context, chunkID string, name string) (bool, error)
@@ -48,7 +49,28 @@ type Repository interface {
 		flavorVersionID string,
 		status resource.FlavorVersionBuildStatus,
 	) error
+	RejectFlavorVersionBuild(
+		ctx context.Context,
+		flavorVersionID string,
+		status resource.FlavorVersionBuildStatus,
+		reason string,
+	) error
+	UpdateFlavorVersionBuildProgress(ctx context.Context, flavorVersionID string, percent uint32) error
+	UpdateFlavorVersionRestoreLatency(ctx context.Context, flavorVersionID string, latency time.Duration) error
+	RecordFlavorVersionImageProvenance(ctx context.Context, flavorVersionID string, prov resource.BuildProvenance) error
+	RecordFlavorVersionCheckpointProvenance(
+		ctx context.Context,
+		flavorVersionID string,
+		nodeID string,
+		builtAt time.Time,
+	) error
+	FlavorVersionProvenance(ctx context.Context, flavorVersionID string) (resource.BuildProvenance, error)
 	UpdateFlavorVersionPresignedURLData(ctx context.Context, flavorVersionID string, date time.Time, url string) error
+	UpdateFlavorVersionCheckpointResources(
+		ctx context.Context,
+		flavorVersionID string,
+		res *resource.CheckpointResources,
+	) error
 	SupportedMinecraftVersions(ctx context.Context) ([]string, error)
 	GetMinecraftVersionByVersion(context.Context, string) (resource.MinecraftVersion, error)
 	UpdateThumbnail(ctx context.Context, chunkID string, imgHash string) error
@@ -59,6 +81,62 @@ type Repository interface {
 	FlavorIDByFlavorVersionID(ctx context.Context, id string) (string, error)
 	MarkFlavorDeleted(ctx context.Context, id string) error
 	FlavorByID(ctx context.Context, id string) (resource.Flavor, error)
+	CreateAsset(ctx context.Context, asset resource.Asset) (resource.Asset, error)
+	AssetsExist(ctx context.Context, hashes []string) (bool, error)
+	CreateCanary(ctx context.Context, canary resource.Canary) (resource.Canary, error)
+	LatestCanary(ctx context.Context, flavorID string) (resource.Canary, error)
+	CanaryByFlavorVersionID(ctx context.Context, flavorVersionID string) (resource.Canary, error)
+	UpdateActiveCanaryState(ctx context.Context, flavorID string, state resource.CanaryState) (resource.Canary, error)
+	CreateCanaryInstance(ctx context.Context, canaryID string, instanceID string, canary bool) error
+	UpsertFlavorChannel(ctx context.Context, channel resource.FlavorChannel) (resource.FlavorChannel, error)
+	FlavorChannel(ctx context.Context, flavorID string, name string) (resource.FlavorChannel, error)
+	FlavorChannels(ctx context.Context, flavorID string) ([]resource.FlavorChannel, error)
+	UpsertRetentionPolicy(ctx context.Context, policy resource.RetentionPolicy) (resource.RetentionPolicy, error)
+	RetentionPolicyByChunkID(ctx context.Context, chunkID string) (resource.RetentionPolicy, error)
+	AllRetentionPolicies(ctx context.Context) ([]resource.RetentionPolicy, error)
+	UpsertAutoscalingPolicy(ctx context.Context, policy resource.AutoscalingPolicy) (resource.AutoscalingPolicy, error)
+	AutoscalingPolicyByFlavorID(ctx context.Context, flavorID string) (resource.AutoscalingPolicy, error)
+	DeleteAutoscalingPolicy(ctx context.Context, flavorID string) error
+	UpsertChunkReadme(ctx context.Context, readme resource.ChunkReadme) (resource.ChunkReadme, error)
+	ChunkReadmeByChunkID(ctx context.Context, chunkID string) (resource.ChunkReadme, error)
+	CreateChunkMedia(ctx context.Context, media resource.ChunkMedia) (resource.ChunkMedia, error)
+	ChunkMediaByChunkID(ctx context.Context, chunkID string) ([]resource.ChunkMedia, error)
+	ChunkMediaByID(ctx context.Context, id string) (resource.ChunkMedia, error)
+	DeleteChunkMedia(ctx context.Context, id string) error
+	CreateChunkImport(ctx context.Context, imp resource.ChunkImport) (resource.ChunkImport, error)
+	ChunkImportByID(ctx context.Context, id string) (resource.ChunkImport, error)
+	StartChunkImport(ctx context.Context, id string) (resource.ChunkImport, error)
+	UpdateChunkImportState(ctx context.Context, id string, state resource.ChunkImportState, reason string) error
+	UpdateChunkImportFlavorVersion(ctx context.Context, id string, flavorVersionID string) error
+	CreateChunkTemplate(ctx context.Context, tmpl resource.ChunkTemplate) (resource.ChunkTemplate, error)
+	ChunkTemplateByName(ctx context.Context, name string) (resource.ChunkTemplate, error)
+	ChunkTemplates(ctx context.Context) ([]resource.ChunkTemplate, error)
+	UpdateChunkTemplate(ctx context.Context, tmpl resource.ChunkTemplate) (resource.ChunkTemplate, error)
+	DeleteChunkTemplate(ctx context.Context, id string) error
+	RequestCrossInstanceTraffic(
+		ctx context.Context,
+		chunkID string,
+		reason string,
+		requestedBy string,
+	) (resource.CrossInstanceTraffic, error)
+	CrossInstanceTrafficByChunkID(ctx context.Context, chunkID string) (resource.CrossInstanceTraffic, error)
+	ReviewCrossInstanceTraffic(
+		ctx context.Context,
+		chunkID string,
+		state resource.CrossInstanceTrafficState,
+		reviewedBy string,
+		message string,
+	) (resource.CrossInstanceTraffic, error)
+	DeleteCrossInstanceTraffic(ctx context.Context, chunkID string) error
+	CrossInstanceTrafficByState(
+		ctx context.Context,
+		state resource.CrossInstanceTrafficState,
+	) ([]resource.CrossInstanceTraffic, error)
+	CrossInstanceTrafficApproved(ctx context.Context, flavorID string) (bool, error)
+	CreateInstanceSchedule(ctx context.Context, s resource.InstanceSchedule) (resource.InstanceSchedule, error)
+	InstanceSchedules(ctx context.Context, chunkID string) ([]resource.InstanceSchedule, error)
+	InstanceScheduleByID(ctx context.Context, id string) (resource.InstanceSchedule, error)
+	DeleteInstanceSchedule(ctx context.Context, id string) error
 }
 
 type ArchiveRepository interface {
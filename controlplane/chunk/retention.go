@@ -0,0 +1,81 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) SetRetentionPolicy(
+	ctx context.Context,
+	policy resource.RetentionPolicy,
+) (resource.RetentionPolicy, error) {
+	if err := s.authorizedChunk(ctx, policy.ChunkID); err != nil {
+		return resource.RetentionPolicy{}, err
+	}
+
+	p, err := s.repo.UpsertRetentionPolicy(ctx, policy)
+	if err != nil {
+		return resource.RetentionPolicy{}, fmt.Errorf("upsert retention policy: %w", err)
+	}
+
+	return p, nil
+}
+
+func (s *svc) GetRetentionPolicy(ctx context.Context, chunkID string) (resource.RetentionPolicy, error) {
+	if err := s.authorizedChunk(ctx, chunkID); err != nil {
+		return resource.RetentionPolicy{}, err
+	}
+
+	p, err := s.repo.RetentionPolicyByChunkID(ctx, chunkID)
+	if err != nil {
+		// chunks without a policy keep all of their flavor versions,
+		// which is what a policy with all rules disabled does.
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return resource.RetentionPolicy{ChunkID: chunkID}, nil
+		}
+		return resource.RetentionPolicy{}, fmt.Errorf("retention policy: %w", err)
+	}
+
+	return p, nil
+}
+
+// authorizedChunk makes sure the actor owns the chunk and that
+// the chunk has not been deleted.
+func (s *svc) authorizedChunk(ctx context.Context, chunkID string) error {
+	if err := s.authorized(ctx, chunkID); err != nil {
+		return fmt.Errorf("authorize: %w", err)
+	}
+
+	c, err := s.repo.GetChunkByID(ctx, chunkID)
+	if err != nil {
+		return fmt.Errorf("get chunk: %w", err)
+	}
+
+	if c.DeletedAt != nil {
+		return apierrs.ErrChunkNotFound
+	}
+
+	return nil
+}
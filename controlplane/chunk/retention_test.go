@@ -0,0 +1,120 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRetentionPolicy(t *testing.T) {
+	var (
+		c      = fixture.Chunk()
+		stored = resource.RetentionPolicy{
+			ChunkID:          c.ID,
+			KeepLastVersions: 5,
+			KeepDays:         30,
+			UpdatedAt:        time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+		}
+	)
+
+	tests := []struct {
+		name     string
+		expected resource.RetentionPolicy
+		err      error
+		prep     func(*mock.MockChunkRepository)
+	}{
+		{
+			name:     "works",
+			expected: stored,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+				repo.EXPECT().RetentionPolicyByChunkID(mocky.Anything, c.ID).Return(stored, nil)
+			},
+		},
+		{
+			name: "no policy keeps all versions",
+			expected: resource.RetentionPolicy{
+				ChunkID: c.ID,
+			},
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+				repo.EXPECT().
+					RetentionPolicyByChunkID(mocky.Anything, c.ID).
+					Return(resource.RetentionPolicy{}, apierrs.ErrNotFound)
+			},
+		},
+		{
+			name: "chunk deleted",
+			err:  apierrs.ErrChunkNotFound,
+			prep: func(repo *mock.MockChunkRepository) {
+				deleted := c
+				deleted.DeletedAt = &stored.UpdatedAt
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(deleted, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				mockAccess,
+				chunk.Config{},
+			)
+			require.NoError(t, err)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil)
+
+			tt.prep(mockRepo)
+
+			actual, err := svc.GetRetentionPolicy(ctx, c.ID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
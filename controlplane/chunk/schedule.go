@@ -0,0 +1,164 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/schedule"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+const (
+	maxSchedulesPerChunk = 10
+	defaultPreviewRuns   = 10
+)
+
+func (s *svc) CreateSchedule(
+	ctx context.Context,
+	sched resource.InstanceSchedule,
+) (resource.InstanceSchedule, error) {
+	if err := s.authorizedChunk(ctx, sched.ChunkID); err != nil {
+		return resource.InstanceSchedule{}, err
+	}
+
+	if sched.Timezone == "" {
+		sched.Timezone = time.UTC.String()
+	}
+
+	if err := schedule.Validate(sched); err != nil {
+		return resource.InstanceSchedule{}, err
+	}
+
+	if err := s.scheduledVersion(ctx, sched.ChunkID, sched.FlavorVersionID); err != nil {
+		return resource.InstanceSchedule{}, err
+	}
+
+	existing, err := s.repo.InstanceSchedules(ctx, sched.ChunkID)
+	if err != nil {
+		return resource.InstanceSchedule{}, fmt.Errorf("instance schedules: %w", err)
+	}
+
+	if len(existing) >= maxSchedulesPerChunk {
+		return resource.InstanceSchedule{}, apierrs.ErrTooManySchedules
+	}
+
+	sched.CreatedBy = ctx.Value(contextkey.ActorID).(string)
+
+	// runs before the schedule has been created are not performed.
+	sched.EvaluatedAt = time.Now()
+
+	ret, err := s.repo.CreateInstanceSchedule(ctx, sched)
+	if err != nil {
+		return resource.InstanceSchedule{}, fmt.Errorf("create instance schedule: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (s *svc) ListSchedules(ctx context.Context, chunkID string) ([]resource.InstanceSchedule, error) {
+	if err := s.authorizedChunk(ctx, chunkID); err != nil {
+		return nil, err
+	}
+
+	l, err := s.repo.InstanceSchedules(ctx, chunkID)
+	if err != nil {
+		return nil, fmt.Errorf("instance schedules: %w", err)
+	}
+
+	return l, nil
+}
+
+func (s *svc) DeleteSchedule(ctx context.Context, id string) error {
+	sched, err := s.repo.InstanceScheduleByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("instance schedule: %w", err)
+	}
+
+	if err := s.authorizedChunk(ctx, sched.ChunkID); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteInstanceSchedule(ctx, id); err != nil {
+		return fmt.Errorf("delete instance schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (s *svc) PreviewNextRuns(
+	_ context.Context,
+	sched resource.InstanceSchedule,
+	count int,
+) ([]resource.ScheduleRun, error) {
+	if count == 0 {
+		count = defaultPreviewRuns
+	}
+
+	if sched.Timezone == "" {
+		sched.Timezone = time.UTC.String()
+	}
+
+	return schedule.NextRuns(sched, time.Now(), count)
+}
+
+// scheduledVersion makes sure the flavor version belongs to a flavor of the
+// chunk, that has not been deleted, and has been built, because instances
+// of it are started without further checks.
+func (s *svc) scheduledVersion(ctx context.Context, chunkID string, flavorVersionID string) error {
+	flavorID, err := s.repo.FlavorIDByFlavorVersionID(ctx, flavorVersionID)
+	if err != nil {
+		return fmt.Errorf("flavor id: %w", err)
+	}
+
+	c, err := s.repo.GetChunkByID(ctx, chunkID)
+	if err != nil {
+		return fmt.Errorf("get chunk: %w", err)
+	}
+
+	idx := slices.IndexFunc(c.Flavors, func(f resource.Flavor) bool {
+		return f.ID == flavorID && f.DeletedAt == nil
+	})
+	if idx == -1 {
+		return apierrs.ErrNotFound
+	}
+
+	f, err := s.repo.FlavorByID(ctx, flavorID)
+	if err != nil {
+		return fmt.Errorf("flavor by id: %w", err)
+	}
+
+	idx = slices.IndexFunc(f.Versions, func(v resource.FlavorVersion) bool {
+		return v.ID == flavorVersionID
+	})
+	if idx == -1 {
+		return apierrs.ErrNotFound
+	}
+
+	if f.Versions[idx].BuildStatus != resource.FlavorVersionBuildStatusCompleted {
+		return apierrs.ErrFlavorVersionNotBuilt
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateSchedule(t *testing.T) {
+	var (
+		flavor = fixture.Flavor(func(f *resource.Flavor) {
+			f.Versions[0].BuildStatus = resource.FlavorVersionBuildStatusCompleted
+		})
+		c = fixture.Chunk(func(c *resource.Chunk) {
+			c.Flavors = []resource.Flavor{flavor}
+		})
+		versionID = flavor.Versions[0].ID
+	)
+
+	tests := []struct {
+		name     string
+		schedule resource.InstanceSchedule
+		code     codes.Code
+		prep     func(*mock.MockChunkRepository, resource.InstanceSchedule)
+	}{
+		{
+			name: "works",
+			schedule: resource.InstanceSchedule{
+				ChunkID:         c.ID,
+				FlavorVersionID: versionID,
+				StartCron:       "0 18 * * FRI",
+				StopCron:        "0 23 * * FRI",
+			},
+			prep: func(repo *mock.MockChunkRepository, sched resource.InstanceSchedule) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil).Twice()
+				repo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, versionID).Return(flavor.ID, nil)
+				repo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+				repo.EXPECT().InstanceSchedules(mocky.Anything, c.ID).Return(nil, nil)
+				repo.EXPECT().
+					CreateInstanceSchedule(mocky.Anything, mocky.MatchedBy(func(s resource.InstanceSchedule) bool {
+						// the time zone defaults to UTC.
+						return s.Timezone == "UTC" && s.CreatedBy == "owner-id" && !s.EvaluatedAt.IsZero()
+					})).
+					Return(sched, nil)
+			},
+		},
+		{
+			name: "invalid cron expression",
+			schedule: resource.InstanceSchedule{
+				ChunkID:         c.ID,
+				FlavorVersionID: versionID,
+				StartCron:       "0 18 * * FRIDAY",
+			},
+			code: codes.InvalidArgument,
+			prep: func(repo *mock.MockChunkRepository, _ resource.InstanceSchedule) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+			},
+		},
+		{
+			name: "version not built",
+			schedule: resource.InstanceSchedule{
+				ChunkID:         c.ID,
+				FlavorVersionID: flavor.Versions[1].ID,
+				StartCron:       "0 18 * * FRI",
+			},
+			code: codes.FailedPrecondition,
+			prep: func(repo *mock.MockChunkRepository, _ resource.InstanceSchedule) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil).Twice()
+				repo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, flavor.Versions[1].ID).Return(flavor.ID, nil)
+				repo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+			},
+		},
+		{
+			name: "version of other chunk",
+			schedule: resource.InstanceSchedule{
+				ChunkID:         c.ID,
+				FlavorVersionID: versionID,
+				StartCron:       "0 18 * * FRI",
+			},
+			code: codes.NotFound,
+			prep: func(repo *mock.MockChunkRepository, _ resource.InstanceSchedule) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil).Twice()
+				repo.EXPECT().
+					FlavorIDByFlavorVersionID(mocky.Anything, versionID).
+					Return("019a88ab-3240-7f61-b560-cc755d5572a1", nil)
+			},
+		},
+		{
+			name: "too many schedules",
+			schedule: resource.InstanceSchedule{
+				ChunkID:         c.ID,
+				FlavorVersionID: versionID,
+				StartCron:       "0 18 * * FRI",
+			},
+			code: codes.ResourceExhausted,
+			prep: func(repo *mock.MockChunkRepository, _ resource.InstanceSchedule) {
+				repo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil).Twice()
+				repo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, versionID).Return(flavor.ID, nil)
+				repo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+				repo.EXPECT().
+					InstanceSchedules(mocky.Anything, c.ID).
+					Return(make([]resource.InstanceSchedule, 10), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "owner-id")
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				mockAccess,
+				chunk.Config{},
+			)
+			require.NoError(t, err)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil)
+
+			tt.prep(mockRepo, tt.schedule)
+
+			actual, err := svc.CreateSchedule(ctx, tt.schedule)
+			if tt.code != codes.OK {
+				var apiErr apierrs.Error
+				require.ErrorAs(t, err, &apiErr)
+				require.Equal(t, tt.code, apiErr.GRPCStatus().Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.schedule, actual)
+		})
+	}
+}
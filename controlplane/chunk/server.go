@@ -21,22 +21,29 @@ package chunk
 import (
 	"context"
 	"fmt"
+	"time"
 
 	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	"github.com/spacechunks/explorer/controlplane/cost"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
 	"github.com/spacechunks/explorer/controlplane/pagination"
 	"github.com/spacechunks/explorer/internal/resource"
 	"github.com/spacechunks/explorer/internal/resource/codec"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Server implements the ChunkService. the costs of chunks are
+// calculated by the cost service.
 type Server struct {
 	chunkv1alpha1.UnimplementedChunkServiceServer
-	service Service
+	service     Service
+	costService cost.Service
 }
 
-func NewServer(service Service) *Server {
+func NewServer(service Service, costService cost.Service) *Server {
 	return &Server{
-		service: service,
+		service:     service,
+		costService: costService,
 	}
 }
 
@@ -104,6 +111,49 @@ func (s *Server) UpdateChunk(
 	}, nil
 }
 
+func (s *Server) RenameChunk(
+	ctx context.Context,
+	req *chunkv1alpha1.RenameChunkRequest,
+) (*chunkv1alpha1.RenameChunkResponse, error) {
+	c, err := s.service.RenameChunk(ctx, req.GetChunkId(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.RenameChunkResponse{
+		Chunk: codec.ChunkToTransport(c),
+	}, nil
+}
+
+func (s *Server) GetChunkByName(
+	ctx context.Context,
+	req *chunkv1alpha1.GetChunkByNameRequest,
+) (*chunkv1alpha1.GetChunkByNameResponse, error) {
+	c, err := s.service.GetChunkByName(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.GetChunkByNameResponse{
+		Chunk: codec.ChunkToTransport(c),
+	}, nil
+}
+
+func (s *Server) CheckChunkName(
+	ctx context.Context,
+	req *chunkv1alpha1.CheckChunkNameRequest,
+) (*chunkv1alpha1.CheckChunkNameResponse, error) {
+	status, chunkID, err := s.service.CheckChunkName(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.CheckChunkNameResponse{
+		Status:  chunkv1alpha1.ChunkNameStatus(chunkv1alpha1.ChunkNameStatus_value[string(status)]),
+		ChunkId: chunkID,
+	}, nil
+}
+
 func (s *Server) ListChunks(
 	ctx context.Context,
 	req *chunkv1alpha1.ListChunksRequest,
@@ -163,12 +213,18 @@ func (s *Server) CreateFlavorVersion(
 	req *chunkv1alpha1.CreateFlavorVersionRequest,
 ) (*chunkv1alpha1.CreateFlavorVersionResponse, error) {
 	domain := resource.FlavorVersion{
-		Version:          req.GetVersion(),
-		MinecraftVersion: req.GetMinecraftVersion(),
-		Hash:             req.GetHash(),
-		FileHashes:       codec.FileHashSliceToDomain(req.GetFileHashes()),
-		MinPlayers:       req.MinPlayers,
-		MaxPlayers:       req.MaxPlayers,
+		Version:            req.GetVersion(),
+		MinecraftVersion:   req.GetMinecraftVersion(),
+		Hash:               req.GetHash(),
+		FileHashes:         codec.FileHashSliceToDomain(req.GetFileHashes()),
+		MinPlayers:         req.MinPlayers,
+		MaxPlayers:         req.MaxPlayers,
+		StartupGracePeriod: time.Duration(req.GetStartupGracePeriodSeconds()) * time.Second,
+		RestartPolicy:      resource.RestartPolicy(req.GetRestartPolicy().String()),
+		RestartMaxRetries:  req.GetRestartMaxRetries(),
+		AssetHashes:        req.GetAssetHashes(),
+		JavaVersion:        req.GetJavaVersion(),
+		StartupCommand:     req.GetStartupCommand(),
 	}
 
 	version, diff, err := s.service.CreateFlavorVersion(ctx, req.GetFlavorId(), domain)
@@ -188,20 +244,30 @@ func (s *Server) BuildFlavorVersion(
 	ctx context.Context,
 	req *chunkv1alpha1.BuildFlavorVersionRequest,
 ) (*chunkv1alpha1.BuildFlavorVersionResponse, error) {
-	return &chunkv1alpha1.BuildFlavorVersionResponse{}, s.service.BuildFlavorVersion(ctx, req.GetFlavorVersionId())
+	return &chunkv1alpha1.BuildFlavorVersionResponse{}, s.service.BuildFlavorVersion(
+		ctx,
+		req.GetFlavorVersionId(),
+		codec.CheckpointResourcesToDomain(req.GetCheckpointResources()),
+	)
 }
 
 func (s *Server) GetUploadURL(
 	ctx context.Context,
 	req *chunkv1alpha1.GetUploadURLRequest,
 ) (*chunkv1alpha1.GetUploadURLResponse, error) {
-	url, err := s.service.GetUploadURL(ctx, req.GetFlavorVersionId(), req.GetTarballHash(), req.GetTarballSizeBytes())
+	url, headers, err := s.service.GetUploadURL(
+		ctx,
+		req.GetFlavorVersionId(),
+		req.GetTarballHash(),
+		req.GetTarballSizeBytes(),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("upload url: %w", err)
 	}
 
 	return &chunkv1alpha1.GetUploadURLResponse{
-		Url: url,
+		Url:     url,
+		Headers: headers,
 	}, nil
 }
 
@@ -218,6 +284,22 @@ func (s *Server) GetSupportedMinecraftVersions(
 	}, nil
 }
 
+func (s *Server) GetMinecraftVersion(
+	ctx context.Context,
+	req *chunkv1alpha1.GetMinecraftVersionRequest,
+) (*chunkv1alpha1.GetMinecraftVersionResponse, error) {
+	v, err := s.service.GetMinecraftVersion(ctx, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &chunkv1alpha1.GetMinecraftVersionResponse{
+		Version: &chunkv1alpha1.MinecraftVersion{
+			Version:   v.Version,
+			BaseImage: v.ImageURL,
+		},
+	}, nil
+}
+
 func (s *Server) UploadThumbnail(
 	ctx context.Context,
 	req *chunkv1alpha1.UploadThumbnailRequest,
@@ -263,3 +345,640 @@ func (s *Server) GetFlavor(
 		Flavor: codec.FlavorToTransport(f),
 	}, nil
 }
+
+func (s *Server) PrecheckFlavorVersion(
+	ctx context.Context,
+	req *chunkv1alpha1.PrecheckFlavorVersionRequest,
+) (*chunkv1alpha1.PrecheckFlavorVersionResponse, error) {
+	violations, limits := s.service.PrecheckFlavorVersion(
+		ctx,
+		req.GetFileCount(),
+		req.GetTotalSizeBytes(),
+		req.GetLargestFileSizeBytes(),
+	)
+	return &chunkv1alpha1.PrecheckFlavorVersionResponse{
+		Violations: violations,
+		Limits: &chunkv1alpha1.BuildLimits{
+			MaxFileCount:      limits.MaxFileCount,
+			MaxTotalSizeBytes: limits.MaxTotalSizeBytes,
+			MaxLayerSizeBytes: limits.MaxLayerSizeBytes,
+			MaxFileSizeBytes:  limits.MaxFileSizeBytes,
+		},
+	}, nil
+}
+
+func (s *Server) GetFlavorVersion(
+	ctx context.Context,
+	req *chunkv1alpha1.GetFlavorVersionRequest,
+) (*chunkv1alpha1.GetFlavorVersionResponse, error) {
+	version, prov, err := s.service.GetFlavorVersion(ctx, req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("get flavor version: %w", err)
+	}
+
+	resp := &chunkv1alpha1.GetFlavorVersionResponse{
+		FlavorVersion: codec.FlavorVersionToTransport(version),
+	}
+
+	if prov != nil {
+		resp.Provenance = codec.BuildProvenanceToTransport(*prov)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetFlavorVersionFile(
+	ctx context.Context,
+	req *chunkv1alpha1.GetFlavorVersionFileRequest,
+) (*chunkv1alpha1.GetFlavorVersionFileResponse, error) {
+	fh, url, expiresAt, err := s.service.GetFlavorVersionFile(ctx, req.GetFlavorVersionId(), req.GetPath())
+	if err != nil {
+		return nil, fmt.Errorf("get flavor version file: %w", err)
+	}
+
+	return &chunkv1alpha1.GetFlavorVersionFileResponse{
+		File: &chunkv1alpha1.FileHashes{
+			Path:      fh.Path,
+			Hash:      fh.Hash,
+			SizeBytes: fh.SizeBytes,
+		},
+		DownloadUrl:          url,
+		DownloadUrlExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (s *Server) PatchFlavorVersion(
+	ctx context.Context,
+	req *chunkv1alpha1.PatchFlavorVersionRequest,
+) (*chunkv1alpha1.PatchFlavorVersionResponse, error) {
+	files := make([]PatchFile, 0, len(req.GetFiles()))
+	for _, f := range req.GetFiles() {
+		files = append(files, PatchFile{
+			Path:        f.GetPath(),
+			Hash:        f.GetHash(),
+			ContentHash: f.GetContentHash(),
+			SizeBytes:   f.GetSizeBytes(),
+		})
+	}
+
+	uploads, headers, err := s.service.PatchFlavorVersion(ctx, req.GetFlavorVersionId(), files)
+	if err != nil {
+		return nil, fmt.Errorf("patch flavor version: %w", err)
+	}
+
+	ret := make([]*chunkv1alpha1.PatchFileUpload, 0, len(uploads))
+	for _, u := range uploads {
+		ret = append(ret, &chunkv1alpha1.PatchFileUpload{
+			Path: u.Path,
+			Url:  u.URL,
+		})
+	}
+
+	return &chunkv1alpha1.PatchFlavorVersionResponse{
+		Uploads: ret,
+		Headers: headers,
+	}, nil
+}
+
+func (s *Server) CreateAsset(
+	ctx context.Context,
+	req *chunkv1alpha1.CreateAssetRequest,
+) (*chunkv1alpha1.CreateAssetResponse, error) {
+	asset, url, headers, err := s.service.CreateAsset(ctx, resource.Asset{
+		Hash:      req.GetHash(),
+		SizeBytes: req.GetSizeBytes(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create asset: %w", err)
+	}
+
+	return &chunkv1alpha1.CreateAssetResponse{
+		Asset:     codec.AssetToTransport(asset),
+		UploadUrl: url,
+		Headers:   headers,
+	}, nil
+}
+
+func (s *Server) StartCanary(
+	ctx context.Context,
+	req *chunkv1alpha1.StartCanaryRequest,
+) (*chunkv1alpha1.StartCanaryResponse, error) {
+	c, err := s.service.StartCanary(
+		ctx,
+		req.GetStableFlavorVersionId(),
+		req.GetCanaryFlavorVersionId(),
+		req.GetPercentage(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("start canary: %w", err)
+	}
+
+	return &chunkv1alpha1.StartCanaryResponse{
+		Canary: codec.CanaryToTransport(c),
+	}, nil
+}
+
+func (s *Server) GetCanary(
+	ctx context.Context,
+	req *chunkv1alpha1.GetCanaryRequest,
+) (*chunkv1alpha1.GetCanaryResponse, error) {
+	c, err := s.service.GetCanary(ctx, req.GetFlavorId())
+	if err != nil {
+		return nil, fmt.Errorf("get canary: %w", err)
+	}
+
+	return &chunkv1alpha1.GetCanaryResponse{
+		Canary: codec.CanaryToTransport(c),
+	}, nil
+}
+
+func (s *Server) PromoteCanary(
+	ctx context.Context,
+	req *chunkv1alpha1.PromoteCanaryRequest,
+) (*chunkv1alpha1.PromoteCanaryResponse, error) {
+	c, err := s.service.PromoteCanary(ctx, req.GetFlavorId())
+	if err != nil {
+		return nil, fmt.Errorf("promote canary: %w", err)
+	}
+
+	return &chunkv1alpha1.PromoteCanaryResponse{
+		Canary: codec.CanaryToTransport(c),
+	}, nil
+}
+
+func (s *Server) AbortCanary(
+	ctx context.Context,
+	req *chunkv1alpha1.AbortCanaryRequest,
+) (*chunkv1alpha1.AbortCanaryResponse, error) {
+	c, err := s.service.AbortCanary(ctx, req.GetFlavorId())
+	if err != nil {
+		return nil, fmt.Errorf("abort canary: %w", err)
+	}
+
+	return &chunkv1alpha1.AbortCanaryResponse{
+		Canary: codec.CanaryToTransport(c),
+	}, nil
+}
+
+func (s *Server) PromoteVersion(
+	ctx context.Context,
+	req *chunkv1alpha1.PromoteVersionRequest,
+) (*chunkv1alpha1.PromoteVersionResponse, error) {
+	c, err := s.service.PromoteVersion(ctx, req.GetFlavorVersionId(), req.GetChannel())
+	if err != nil {
+		return nil, fmt.Errorf("promote version: %w", err)
+	}
+
+	return &chunkv1alpha1.PromoteVersionResponse{
+		Channel: codec.FlavorChannelToTransport(c),
+	}, nil
+}
+
+func (s *Server) ListFlavorChannels(
+	ctx context.Context,
+	req *chunkv1alpha1.ListFlavorChannelsRequest,
+) (*chunkv1alpha1.ListFlavorChannelsResponse, error) {
+	channels, err := s.service.ListFlavorChannels(ctx, req.GetFlavorId())
+	if err != nil {
+		return nil, fmt.Errorf("list flavor channels: %w", err)
+	}
+
+	ret := make([]*chunkv1alpha1.FlavorChannel, 0, len(channels))
+	for _, c := range channels {
+		ret = append(ret, codec.FlavorChannelToTransport(c))
+	}
+
+	return &chunkv1alpha1.ListFlavorChannelsResponse{
+		Channels: ret,
+	}, nil
+}
+
+func (s *Server) SetRetentionPolicy(
+	ctx context.Context,
+	req *chunkv1alpha1.SetRetentionPolicyRequest,
+) (*chunkv1alpha1.SetRetentionPolicyResponse, error) {
+	p, err := s.service.SetRetentionPolicy(ctx, resource.RetentionPolicy{
+		ChunkID:          req.GetChunkId(),
+		KeepLastVersions: req.GetKeepLastVersions(),
+		KeepDays:         req.GetKeepDays(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set retention policy: %w", err)
+	}
+
+	return &chunkv1alpha1.SetRetentionPolicyResponse{
+		Policy: codec.RetentionPolicyToTransport(p),
+	}, nil
+}
+
+func (s *Server) GetRetentionPolicy(
+	ctx context.Context,
+	req *chunkv1alpha1.GetRetentionPolicyRequest,
+) (*chunkv1alpha1.GetRetentionPolicyResponse, error) {
+	p, err := s.service.GetRetentionPolicy(ctx, req.GetChunkId())
+	if err != nil {
+		return nil, fmt.Errorf("get retention policy: %w", err)
+	}
+
+	return &chunkv1alpha1.GetRetentionPolicyResponse{
+		Policy: codec.RetentionPolicyToTransport(p),
+	}, nil
+}
+
+func (s *Server) SetAutoscalingPolicy(
+	ctx context.Context,
+	req *chunkv1alpha1.SetAutoscalingPolicyRequest,
+) (*chunkv1alpha1.SetAutoscalingPolicyResponse, error) {
+	p, err := s.service.SetAutoscalingPolicy(ctx, resource.AutoscalingPolicy{
+		FlavorID:          req.GetFlavorId(),
+		MinInstances:      req.GetMinInstances(),
+		MaxInstances:      req.GetMaxInstances(),
+		TargetUtilization: req.GetTargetUtilization(),
+		ScaleUpCooldown:   time.Duration(req.GetScaleUpCooldownSeconds()) * time.Second,
+		ScaleDownCooldown: time.Duration(req.GetScaleDownCooldownSeconds()) * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set autoscaling policy: %w", err)
+	}
+
+	return &chunkv1alpha1.SetAutoscalingPolicyResponse{
+		Policy: codec.AutoscalingPolicyToTransport(p),
+	}, nil
+}
+
+func (s *Server) GetAutoscalingPolicy(
+	ctx context.Context,
+	req *chunkv1alpha1.GetAutoscalingPolicyRequest,
+) (*chunkv1alpha1.GetAutoscalingPolicyResponse, error) {
+	p, err := s.service.GetAutoscalingPolicy(ctx, req.GetFlavorId())
+	if err != nil {
+		return nil, fmt.Errorf("get autoscaling policy: %w", err)
+	}
+
+	return &chunkv1alpha1.GetAutoscalingPolicyResponse{
+		Policy: codec.AutoscalingPolicyToTransport(p),
+	}, nil
+}
+
+func (s *Server) DeleteAutoscalingPolicy(
+	ctx context.Context,
+	req *chunkv1alpha1.DeleteAutoscalingPolicyRequest,
+) (*chunkv1alpha1.DeleteAutoscalingPolicyResponse, error) {
+	if err := s.service.DeleteAutoscalingPolicy(ctx, req.GetFlavorId()); err != nil {
+		return nil, fmt.Errorf("delete autoscaling policy: %w", err)
+	}
+
+	return &chunkv1alpha1.DeleteAutoscalingPolicyResponse{}, nil
+}
+
+func (s *Server) SetChunkReadme(
+	ctx context.Context,
+	req *chunkv1alpha1.SetChunkReadmeRequest,
+) (*chunkv1alpha1.SetChunkReadmeResponse, error) {
+	r, err := s.service.SetChunkReadme(ctx, resource.ChunkReadme{
+		ChunkID:  req.GetChunkId(),
+		Markdown: req.GetMarkdown(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set chunk readme: %w", err)
+	}
+
+	return &chunkv1alpha1.SetChunkReadmeResponse{
+		Readme: codec.ChunkReadmeToTransport(r),
+	}, nil
+}
+
+func (s *Server) GetChunkReadme(
+	ctx context.Context,
+	req *chunkv1alpha1.GetChunkReadmeRequest,
+) (*chunkv1alpha1.GetChunkReadmeResponse, error) {
+	r, err := s.service.GetChunkReadme(ctx, req.GetChunkId())
+	if err != nil {
+		return nil, fmt.Errorf("get chunk readme: %w", err)
+	}
+
+	return &chunkv1alpha1.GetChunkReadmeResponse{
+		Readme: codec.ChunkReadmeToTransport(r),
+	}, nil
+}
+
+func (s *Server) CreateChunkMedia(
+	ctx context.Context,
+	req *chunkv1alpha1.CreateChunkMediaRequest,
+) (*chunkv1alpha1.CreateChunkMediaResponse, error) {
+	m, url, headers, err := s.service.CreateChunkMedia(ctx, resource.ChunkMedia{
+		ChunkID:     req.GetChunkId(),
+		Hash:        req.GetHash(),
+		ContentType: req.GetContentType(),
+		SizeBytes:   req.GetSizeBytes(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create chunk media: %w", err)
+	}
+
+	return &chunkv1alpha1.CreateChunkMediaResponse{
+		Media:     codec.ChunkMediaToTransport(m),
+		UploadUrl: url,
+		Headers:   headers,
+	}, nil
+}
+
+func (s *Server) ListChunkMedia(
+	ctx context.Context,
+	req *chunkv1alpha1.ListChunkMediaRequest,
+) (*chunkv1alpha1.ListChunkMediaResponse, error) {
+	media, err := s.service.ListChunkMedia(ctx, req.GetChunkId())
+	if err != nil {
+		return nil, fmt.Errorf("list chunk media: %w", err)
+	}
+
+	ret := make([]*chunkv1alpha1.ChunkMedia, 0, len(media))
+	for _, m := range media {
+		ret = append(ret, codec.ChunkMediaToTransport(m))
+	}
+
+	return &chunkv1alpha1.ListChunkMediaResponse{
+		Media: ret,
+	}, nil
+}
+
+func (s *Server) DeleteChunkMedia(
+	ctx context.Context,
+	req *chunkv1alpha1.DeleteChunkMediaRequest,
+) (*chunkv1alpha1.DeleteChunkMediaResponse, error) {
+	if err := s.service.DeleteChunkMedia(ctx, req.GetId()); err != nil {
+		return nil, fmt.Errorf("delete chunk media: %w", err)
+	}
+
+	return &chunkv1alpha1.DeleteChunkMediaResponse{}, nil
+}
+
+func (s *Server) ImportChunk(
+	ctx context.Context,
+	req *chunkv1alpha1.ImportChunkRequest,
+) (*chunkv1alpha1.ImportChunkResponse, error) {
+	c := resource.Chunk{
+		Name:        req.GetChunkName(),
+		Description: req.GetDescription(),
+		Tags:        req.GetTags(),
+		Flavors:     make([]resource.Flavor, 0),
+	}
+
+	imp, url, headers, err := s.service.ImportChunk(ctx, c, req.GetFlavorName(), resource.ChunkImport{
+		Version:          req.GetVersion(),
+		MinecraftVersion: req.GetMinecraftVersion(),
+		MinPlayers:       req.GetMinPlayers(),
+		MaxPlayers:       req.GetMaxPlayers(),
+		ArchiveSizeBytes: req.GetArchiveSizeBytes(),
+	}, req.GetArchiveHash())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.ImportChunkResponse{
+		Import:    codec.ChunkImportToTransport(imp),
+		UploadUrl: url,
+		Headers:   headers,
+	}, nil
+}
+
+func (s *Server) StartChunkImport(
+	ctx context.Context,
+	req *chunkv1alpha1.StartChunkImportRequest,
+) (*chunkv1alpha1.StartChunkImportResponse, error) {
+	imp, err := s.service.StartChunkImport(ctx, req.GetImportId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.StartChunkImportResponse{
+		Import: codec.ChunkImportToTransport(imp),
+	}, nil
+}
+
+func (s *Server) GetChunkImport(
+	ctx context.Context,
+	req *chunkv1alpha1.GetChunkImportRequest,
+) (*chunkv1alpha1.GetChunkImportResponse, error) {
+	imp, err := s.service.GetChunkImport(ctx, req.GetImportId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.GetChunkImportResponse{
+		Import: codec.ChunkImportToTransport(imp),
+	}, nil
+}
+
+func (s *Server) ListChunkTemplates(
+	ctx context.Context,
+	_ *chunkv1alpha1.ListChunkTemplatesRequest,
+) (*chunkv1alpha1.ListChunkTemplatesResponse, error) {
+	templates, err := s.service.ListChunkTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*chunkv1alpha1.ChunkTemplate, 0, len(templates))
+	for _, t := range templates {
+		ret = append(ret, codec.ChunkTemplateToTransport(t))
+	}
+
+	return &chunkv1alpha1.ListChunkTemplatesResponse{
+		Templates: ret,
+	}, nil
+}
+
+func (s *Server) InstantiateChunkTemplate(
+	ctx context.Context,
+	req *chunkv1alpha1.InstantiateChunkTemplateRequest,
+) (*chunkv1alpha1.InstantiateChunkTemplateResponse, error) {
+	c, version, err := s.service.InstantiateChunkTemplate(ctx, req.GetTemplateName(), resource.Chunk{
+		Name:        req.GetChunkName(),
+		Description: req.GetDescription(),
+		Tags:        req.GetTags(),
+		Flavors:     make([]resource.Flavor, 0),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.InstantiateChunkTemplateResponse{
+		Chunk:   codec.ChunkToTransport(c),
+		Version: codec.FlavorVersionToTransport(version),
+	}, nil
+}
+
+func (s *Server) RequestCrossInstanceTraffic(
+	ctx context.Context,
+	req *chunkv1alpha1.RequestCrossInstanceTrafficRequest,
+) (*chunkv1alpha1.RequestCrossInstanceTrafficResponse, error) {
+	t, err := s.service.RequestCrossInstanceTraffic(ctx, req.GetChunkId(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.RequestCrossInstanceTrafficResponse{
+		Traffic: codec.CrossInstanceTrafficToTransport(t),
+	}, nil
+}
+
+func (s *Server) GetCrossInstanceTraffic(
+	ctx context.Context,
+	req *chunkv1alpha1.GetCrossInstanceTrafficRequest,
+) (*chunkv1alpha1.GetCrossInstanceTrafficResponse, error) {
+	t, err := s.service.GetCrossInstanceTraffic(ctx, req.GetChunkId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.GetCrossInstanceTrafficResponse{
+		Traffic: codec.CrossInstanceTrafficToTransport(t),
+	}, nil
+}
+
+func (s *Server) WithdrawCrossInstanceTraffic(
+	ctx context.Context,
+	req *chunkv1alpha1.WithdrawCrossInstanceTrafficRequest,
+) (*chunkv1alpha1.WithdrawCrossInstanceTrafficResponse, error) {
+	if err := s.service.WithdrawCrossInstanceTraffic(ctx, req.GetChunkId()); err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.WithdrawCrossInstanceTrafficResponse{}, nil
+}
+
+func (s *Server) CreateSchedule(
+	ctx context.Context,
+	req *chunkv1alpha1.CreateScheduleRequest,
+) (*chunkv1alpha1.CreateScheduleResponse, error) {
+	sched, err := s.service.CreateSchedule(ctx, resource.InstanceSchedule{
+		ChunkID:         req.GetChunkId(),
+		FlavorVersionID: req.GetFlavorVersionId(),
+		StartCron:       req.GetStartCron(),
+		StopCron:        req.GetStopCron(),
+		Timezone:        req.GetTimezone(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.CreateScheduleResponse{
+		Schedule: codec.InstanceScheduleToTransport(sched),
+	}, nil
+}
+
+func (s *Server) ListSchedules(
+	ctx context.Context,
+	req *chunkv1alpha1.ListSchedulesRequest,
+) (*chunkv1alpha1.ListSchedulesResponse, error) {
+	l, err := s.service.ListSchedules(ctx, req.GetChunkId())
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*chunkv1alpha1.InstanceSchedule, 0, len(l))
+	for _, sched := range l {
+		ret = append(ret, codec.InstanceScheduleToTransport(sched))
+	}
+
+	return &chunkv1alpha1.ListSchedulesResponse{
+		Schedules: ret,
+	}, nil
+}
+
+func (s *Server) DeleteSchedule(
+	ctx context.Context,
+	req *chunkv1alpha1.DeleteScheduleRequest,
+) (*chunkv1alpha1.DeleteScheduleResponse, error) {
+	if err := s.service.DeleteSchedule(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &chunkv1alpha1.DeleteScheduleResponse{}, nil
+}
+
+func (s *Server) PreviewNextRuns(
+	ctx context.Context,
+	req *chunkv1alpha1.PreviewNextRunsRequest,
+) (*chunkv1alpha1.PreviewNextRunsResponse, error) {
+	runs, err := s.service.PreviewNextRuns(ctx, resource.InstanceSchedule{
+		StartCron: req.GetStartCron(),
+		StopCron:  req.GetStopCron(),
+		Timezone:  req.GetTimezone(),
+	}, int(req.GetCount()))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*chunkv1alpha1.ScheduleRun, 0, len(runs))
+	for _, r := range runs {
+		ret = append(ret, codec.ScheduleRunToTransport(r))
+	}
+
+	return &chunkv1alpha1.PreviewNextRunsResponse{
+		Runs: ret,
+	}, nil
+}
+
+func (s *Server) GetChunkStats(
+	ctx context.Context,
+	req *chunkv1alpha1.GetChunkStatsRequest,
+) (*chunkv1alpha1.GetChunkStatsResponse, error) {
+	var start, end time.Time
+	if req.Start != nil {
+		start = req.GetStart().AsTime()
+	}
+
+	if req.End != nil {
+		end = req.GetEnd().AsTime()
+	}
+
+	stats, err := s.costService.ChunkStats(ctx, req.GetChunkId(), start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*chunkv1alpha1.InstanceCost, 0, len(stats.Instances))
+	for _, c := range stats.Instances {
+		ret = append(ret, codec.InstanceCostToTransport(c))
+	}
+
+	return &chunkv1alpha1.GetChunkStatsResponse{
+		Start:     timestamppb.New(stats.Start),
+		End:       timestamppb.New(stats.End),
+		Total:     codec.ChunkCostToTransport(stats.Total),
+		Instances: ret,
+	}, nil
+}
+
+func (s *Server) ApplyChunkSpec(
+	ctx context.Context,
+	req *chunkv1alpha1.ApplyChunkSpecRequest,
+) (*chunkv1alpha1.ApplyChunkSpecResponse, error) {
+	c, changes, err := s.service.ApplyChunkSpec(
+		ctx,
+		codec.ChunkSpecToDomain(req.GetSpec()),
+		req.GetDryRun(),
+		req.GetPrune(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*chunkv1alpha1.ChunkSpecChange, 0, len(changes))
+	for _, change := range changes {
+		ret = append(ret, codec.ChunkSpecChangeToTransport(change))
+	}
+
+	resp := &chunkv1alpha1.ApplyChunkSpecResponse{
+		Changes: ret,
+	}
+
+	// when doing a dry run, chunks that do not exist yet are not created.
+	if c.ID != "" {
+		resp.Chunk = codec.ChunkToTransport(c)
+	}
+
+	return resp, nil
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/spacechunks/explorer/controlplane/authz"
 	"github.com/spacechunks/explorer/controlplane/blob"
 	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/lock"
+	"github.com/spacechunks/explorer/internal/file"
 	"github.com/spacechunks/explorer/internal/resource"
 )
 
@@ -33,6 +35,18 @@ type Service interface {
 	CreateChunk(ctx context.Context, chunk resource.Chunk) (resource.Chunk, error)
 	GetChunk(ctx context.Context, id string) (resource.Chunk, error)
 	UpdateChunk(ctx context.Context, new resource.Chunk) (resource.Chunk, error)
+
+	// RenameChunk changes the name of the chunk. the previous name stays
+	// reserved for the chunk, so it still resolves to the chunk.
+	RenameChunk(ctx context.Context, id string, name string) (resource.Chunk, error)
+
+	// GetChunkByName returns the chunk using the given name, either as its
+	// current or one of its previous names.
+	GetChunkByName(ctx context.Context, name string) (resource.Chunk, error)
+
+	// CheckChunkName tells whether the name can be used for a new chunk. if
+	// the name is used by one of the chunks of the actor, its id is returned.
+	CheckChunkName(ctx context.Context, name string) (resource.ChunkNameStatus, string, error)
 	ListChunks(ctx context.Context, pageSize int, afterID *string) ([]resource.Chunk, error)
 	CreateFlavor(ctx context.Context, chunkID string, flavor resource.Flavor) (resource.Flavor, error)
 	CreateFlavorVersion(
@@ -40,18 +54,168 @@ type Service interface {
 		flavorID string,
 		version resource.FlavorVersion,
 	) (resource.FlavorVersion, resource.FlavorVersionDiff, error)
-	BuildFlavorVersion(ctx context.Context, versionID string) error
+	BuildFlavorVersion(ctx context.Context, versionID string, res *resource.CheckpointResources) error
 	GetUploadURL(
 		ctx context.Context,
 		flavorVersionID string,
 		tarballHash string,
 		tarballSizeBytes uint64,
-	) (string, error)
+	) (string, map[string]string, error)
 	GetSupportedMinecraftVersions(ctx context.Context) ([]string, error)
+	GetMinecraftVersion(ctx context.Context, version string) (resource.MinecraftVersion, error)
 	UpdateThumbnail(ctx context.Context, chunkID string, imageData []byte) error
 	DeleteFlavor(ctx context.Context, id string) error
 	DeleteChunk(ctx context.Context, id string) error
 	GetFlavor(ctx context.Context, id string) (resource.Flavor, error)
+
+	// PrecheckFlavorVersion returns a human-readable description for each build limit
+	// exceeded by a flavor version with the given properties, along with the limits.
+	PrecheckFlavorVersion(
+		ctx context.Context,
+		fileCount uint64,
+		totalSizeBytes uint64,
+		largestFileSizeBytes uint64,
+	) ([]string, BuildLimits)
+
+	// PatchFlavorVersion returns the URLs the given files have to be uploaded to, for
+	// building the flavor version without uploading a tarball. files already present
+	// in the CAS are skipped.
+	PatchFlavorVersion(
+		ctx context.Context,
+		flavorVersionID string,
+		files []PatchFile,
+	) ([]PatchUpload, map[string]string, error)
+
+	// GetFlavorVersionFile returns the file of the flavor version with the given
+	// path and the URL its content can be downloaded from.
+	GetFlavorVersionFile(
+		ctx context.Context,
+		flavorVersionID string,
+		path string,
+	) (file.Hash, string, time.Time, error)
+
+	// GetFlavorVersion returns the flavor version together with its build
+	// provenance. the provenance is nil, if none has been recorded yet.
+	GetFlavorVersion(ctx context.Context, id string) (resource.FlavorVersion, *resource.BuildProvenance, error)
+
+	// CreateAsset registers the asset and returns the URL its bundle has to be
+	// uploaded to. the URL is empty if the bundle has already been uploaded.
+	CreateAsset(ctx context.Context, asset resource.Asset) (resource.Asset, string, map[string]string, error)
+
+	// StartCanary splits the instance launches of the flavor the versions
+	// belong to between them, see resource.Canary.
+	StartCanary(
+		ctx context.Context,
+		stableVersionID string,
+		canaryVersionID string,
+		percentage uint32,
+	) (resource.Canary, error)
+	GetCanary(ctx context.Context, flavorID string) (resource.Canary, error)
+	PromoteCanary(ctx context.Context, flavorID string) (resource.Canary, error)
+	AbortCanary(ctx context.Context, flavorID string) (resource.Canary, error)
+
+	// PromoteVersion publishes the flavor version to the channel of its flavor,
+	// see resource.FlavorChannel. the version has to be built.
+	PromoteVersion(ctx context.Context, flavorVersionID string, channel string) (resource.FlavorChannel, error)
+	ListFlavorChannels(ctx context.Context, flavorID string) ([]resource.FlavorChannel, error)
+
+	// SetRetentionPolicy replaces the retention policy of the chunk. flavor
+	// versions are removed according to the policy by a background job.
+	SetRetentionPolicy(ctx context.Context, policy resource.RetentionPolicy) (resource.RetentionPolicy, error)
+	GetRetentionPolicy(ctx context.Context, chunkID string) (resource.RetentionPolicy, error)
+
+	// SetAutoscalingPolicy replaces the autoscaling policy of the flavor. unset
+	// fields are defaulted. instances are started and stopped by the autoscaler.
+	SetAutoscalingPolicy(ctx context.Context, policy resource.AutoscalingPolicy) (resource.AutoscalingPolicy, error)
+	GetAutoscalingPolicy(ctx context.Context, flavorID string) (resource.AutoscalingPolicy, error)
+	DeleteAutoscalingPolicy(ctx context.Context, flavorID string) error
+
+	// SetChunkReadme replaces the markdown description shown on the page of the chunk.
+	SetChunkReadme(ctx context.Context, readme resource.ChunkReadme) (resource.ChunkReadme, error)
+	GetChunkReadme(ctx context.Context, chunkID string) (resource.ChunkReadme, error)
+
+	// CreateChunkMedia adds the image to the page of the chunk and returns the URL
+	// it has to be uploaded to. the URL is empty if the image has already been uploaded.
+	CreateChunkMedia(
+		ctx context.Context,
+		media resource.ChunkMedia,
+	) (resource.ChunkMedia, string, map[string]string, error)
+
+	// ListChunkMedia returns the uploaded images of the chunk including
+	// the URLs they can be downloaded from.
+	ListChunkMedia(ctx context.Context, chunkID string) ([]resource.ChunkMedia, error)
+	DeleteChunkMedia(ctx context.Context, id string) error
+
+	// ImportChunk creates the chunk and its flavor for importing an archive of
+	// an existing server and returns the URL the archive has to be uploaded to.
+	// the flavor version is created once the import has been started.
+	ImportChunk(
+		ctx context.Context,
+		chunk resource.Chunk,
+		flavorName string,
+		imp resource.ChunkImport,
+		archiveHash string,
+	) (resource.ChunkImport, string, map[string]string, error)
+
+	// StartChunkImport unpacks the uploaded archive of the import in the background.
+	// imports that have already been started are returned as is.
+	StartChunkImport(ctx context.Context, id string) (resource.ChunkImport, error)
+	GetChunkImport(ctx context.Context, id string) (resource.ChunkImport, error)
+
+	// ListChunkTemplates returns the templates users can create their chunk from.
+	ListChunkTemplates(ctx context.Context) ([]resource.ChunkTemplate, error)
+
+	// InstantiateChunkTemplate creates the chunk with a single flavor, whose first
+	// version contains the files of the template. the version is built right away.
+	InstantiateChunkTemplate(
+		ctx context.Context,
+		templateName string,
+		chunk resource.Chunk,
+	) (resource.Chunk, resource.FlavorVersion, error)
+
+	// CreateChunkTemplate, UpdateChunkTemplate and DeleteChunkTemplate
+	// can only be called by admins.
+	CreateChunkTemplate(ctx context.Context, actorID string, tmpl resource.ChunkTemplate) (resource.ChunkTemplate, error)
+	UpdateChunkTemplate(ctx context.Context, actorID string, tmpl resource.ChunkTemplate) (resource.ChunkTemplate, error)
+	DeleteChunkTemplate(ctx context.Context, actorID string, id string) error
+
+	// RequestCrossInstanceTraffic asks for the instances of the chunk to be allowed
+	// to connect to each other. an admin has to approve the request first.
+	RequestCrossInstanceTraffic(ctx context.Context, chunkID string, reason string) (resource.CrossInstanceTraffic, error)
+	GetCrossInstanceTraffic(ctx context.Context, chunkID string) (resource.CrossInstanceTraffic, error)
+	WithdrawCrossInstanceTraffic(ctx context.Context, chunkID string) error
+
+	// ListCrossInstanceTrafficRequests and ReviewCrossInstanceTraffic
+	// can only be called by admins.
+	ListCrossInstanceTrafficRequests(ctx context.Context, actorID string) ([]resource.CrossInstanceTraffic, error)
+	ReviewCrossInstanceTraffic(
+		ctx context.Context,
+		actorID string,
+		chunkID string,
+		approved bool,
+		message string,
+	) (resource.CrossInstanceTraffic, error)
+
+	// CreateSchedule adds a schedule that starts and stops instances of the
+	// flavor version, see [schedule.Runner]. the time zone defaults to UTC.
+	CreateSchedule(ctx context.Context, sched resource.InstanceSchedule) (resource.InstanceSchedule, error)
+	ListSchedules(ctx context.Context, chunkID string) ([]resource.InstanceSchedule, error)
+	DeleteSchedule(ctx context.Context, id string) error
+
+	// PreviewNextRuns returns the next count runs of a schedule with the
+	// cron expressions and time zone of the given one.
+	PreviewNextRuns(ctx context.Context, sched resource.InstanceSchedule, count int) ([]resource.ScheduleRun, error)
+
+	// ApplyChunkSpec reconciles the chunk with the name of the spec to the spec,
+	// creating the chunk if it does not exist, and returns the changes made.
+	// if dryRun is set, the changes are only returned. flavors that are not
+	// part of the spec are only deleted if prune is set.
+	ApplyChunkSpec(
+		ctx context.Context,
+		spec resource.ChunkSpec,
+		dryRun bool,
+		prune bool,
+	) (resource.Chunk, []resource.ChunkSpecChange, error)
 }
 
 type Config struct {
@@ -60,13 +224,33 @@ type Config struct {
 	PresignedURLExpiry           time.Duration
 	ThumbnailMaxSizeKB           int
 	ChangesetTarballMaxSizeBytes uint64
+	PatchMaxFiles                uint64
+	PatchMaxSizeBytes            uint64
+	AssetMaxSizeBytes            uint64
+	MediaMaxSizeBytes            uint64
+	MediaMaxCount                int
+	ImportArchiveMaxSizeBytes    uint64
+	BuildLimits                  BuildLimits
+
+	// AutoscalingMaxInstances is the highest max_instances
+	// an autoscaling policy is allowed to set.
+	AutoscalingMaxInstances uint32
+
+	// AdminUserIDs are allowed to review cross-instance traffic
+	// requests and to manage chunk templates.
+	AdminUserIDs []string
+
+	// StartupCommands are the templates flavor versions are
+	// allowed to override the startup command with.
+	StartupCommands []string
 }
 
 type svc struct {
 	logger    *slog.Logger
 	repo      Repository
 	jobClient job.Client
-	s3Store   blob.S3Store
+	locker    lock.Locker
+	blobStore blob.Store
 	cfg       Config
 	access    authz.AccessEvaluator
 	metrics   metrics
@@ -76,7 +260,8 @@ func NewService(
 	logger *slog.Logger,
 	repo Repository,
 	jobClient job.Client,
-	s3Store blob.S3Store,
+	locker lock.Locker,
+	blobStore blob.Store,
 	access authz.AccessEvaluator,
 	cfg Config,
 ) (Service, error) {
@@ -89,7 +274,8 @@ func NewService(
 		logger:    logger,
 		repo:      repo,
 		jobClient: jobClient,
-		s3Store:   s3Store,
+		locker:    locker,
+		blobStore: blobStore,
 		access:    access,
 		cfg:       cfg,
 		metrics:   m,
@@ -0,0 +1,88 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// startupCommandData is passed to startup command templates.
+type startupCommandData struct {
+	MinecraftVersion string
+	JavaVersion      uint32
+}
+
+// StartupCommand renders the startup command template of the flavor version and
+// splits it into its arguments. nil is returned if the command of the base image
+// should be used.
+func StartupCommand(version resource.FlavorVersion) ([]string, error) {
+	if version.StartupCommand == "" {
+		return nil, nil
+	}
+
+	tmpl, err := template.New("startup").Option("missingkey=error").Parse(version.StartupCommand)
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, startupCommandData{
+		MinecraftVersion: version.MinecraftVersion,
+		JavaVersion:      version.JavaVersion,
+	}); err != nil {
+		return nil, fmt.Errorf("execute template: %w", err)
+	}
+
+	args := strings.Fields(buf.String())
+	if len(args) == 0 {
+		return nil, errors.New("startup command is empty")
+	}
+
+	return args, nil
+}
+
+// checkRuntime makes sure the base image of the requested java version exists
+// and the startup command is one of the allowed templates.
+func (s *svc) checkRuntime(mcVersion resource.MinecraftVersion, version resource.FlavorVersion) error {
+	if _, ok := mcVersion.BaseImage(version.JavaVersion); !ok {
+		return apierrs.ErrJavaVersionNotSupported
+	}
+
+	if version.StartupCommand == "" {
+		return nil
+	}
+
+	if !slices.Contains(s.cfg.StartupCommands, version.StartupCommand) {
+		return apierrs.ErrStartupCommandNotAllowed
+	}
+
+	if _, err := StartupCommand(version); err != nil {
+		return apierrs.ErrStartupCommandNotAllowed
+	}
+
+	return nil
+}
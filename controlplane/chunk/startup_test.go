@@ -0,0 +1,76 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartupCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  resource.FlavorVersion
+		expected []string
+		err      bool
+	}{
+		{
+			name:    "command of the image is used",
+			version: resource.FlavorVersion{},
+		},
+		{
+			name: "template is rendered",
+			version: resource.FlavorVersion{
+				MinecraftVersion: "1.20.1",
+				JavaVersion:      17,
+				StartupCommand:   "/opt/java/{{.JavaVersion}}/bin/java -jar paper-{{.MinecraftVersion}}.jar  nogui",
+			},
+			expected: []string{"/opt/java/17/bin/java", "-jar", "paper-1.20.1.jar", "nogui"},
+		},
+		{
+			name: "unknown field",
+			version: resource.FlavorVersion{
+				StartupCommand: "java -jar {{.ServerJar}}",
+			},
+			err: true,
+		},
+		{
+			name: "renders to empty command",
+			version: resource.FlavorVersion{
+				StartupCommand: "{{if .JavaVersion}}java{{end}}",
+			},
+			err: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := chunk.StartupCommand(tt.version)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
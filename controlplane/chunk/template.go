@@ -0,0 +1,186 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spacechunks/explorer/controlplane/blob"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+const (
+	// templateFlavorName and templateFlavorVersion are used for the
+	// flavor and version of chunks created from a template, the same
+	// way the cli names them for a single server.
+	templateFlavorName    = "default"
+	templateFlavorVersion = "v1"
+)
+
+func (s *svc) ListChunkTemplates(ctx context.Context) ([]resource.ChunkTemplate, error) {
+	l, err := s.repo.ChunkTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("chunk templates: %w", err)
+	}
+
+	return l, nil
+}
+
+func (s *svc) InstantiateChunkTemplate(
+	ctx context.Context,
+	templateName string,
+	chunk resource.Chunk,
+) (resource.Chunk, resource.FlavorVersion, error) {
+	tmpl, err := s.repo.ChunkTemplateByName(ctx, templateName)
+	if err != nil {
+		return resource.Chunk{}, resource.FlavorVersion{}, fmt.Errorf("chunk template: %w", err)
+	}
+
+	src, err := s.templateVersion(ctx, tmpl.FlavorVersionID)
+	if err != nil {
+		return resource.Chunk{}, resource.FlavorVersion{}, err
+	}
+
+	if chunk.Description == "" {
+		chunk.Description = tmpl.Description
+	}
+
+	c, err := s.CreateChunk(ctx, chunk)
+	if err != nil {
+		return resource.Chunk{}, resource.FlavorVersion{}, fmt.Errorf("create chunk: %w", err)
+	}
+
+	f, err := s.CreateFlavor(ctx, c.ID, resource.Flavor{Name: templateFlavorName})
+	if err != nil {
+		return resource.Chunk{}, resource.FlavorVersion{}, fmt.Errorf("create flavor: %w", err)
+	}
+
+	version, _, err := s.CreateFlavorVersion(ctx, f.ID, resource.FlavorVersion{
+		Version:            templateFlavorVersion,
+		MinecraftVersion:   src.MinecraftVersion,
+		Hash:               src.Hash,
+		FileHashes:         src.FileHashes,
+		MinPlayers:         src.MinPlayers,
+		MaxPlayers:         src.MaxPlayers,
+		AssetHashes:        src.AssetHashes,
+		StartupGracePeriod: src.StartupGracePeriod,
+		RestartPolicy:      src.RestartPolicy,
+		RestartMaxRetries:  src.RestartMaxRetries,
+		JavaVersion:        src.JavaVersion,
+		StartupCommand:     src.StartupCommand,
+	})
+	if err != nil {
+		return resource.Chunk{}, resource.FlavorVersion{}, fmt.Errorf("create flavor version: %w", err)
+	}
+
+	// all files of the template are already in the cas, because its version
+	// has been built. an empty patch tells the image build to take every
+	// file from there, so nothing has to be uploaded.
+	if err := s.blobStore.SimplePut(
+		ctx,
+		blob.PatchManifestKey(version.ID),
+		bytes.NewReader([]byte("[]")),
+		nil,
+	); err != nil {
+		return resource.Chunk{}, resource.FlavorVersion{}, fmt.Errorf("put manifest: %w", err)
+	}
+
+	if err := s.BuildFlavorVersion(ctx, version.ID, src.CheckpointResources); err != nil {
+		return resource.Chunk{}, resource.FlavorVersion{}, fmt.Errorf("build flavor version: %w", err)
+	}
+
+	c.Flavors = []resource.Flavor{f}
+
+	s.logger.InfoContext(ctx, "chunk created from template", "chunk_id", c.ID, "template", tmpl.Name)
+
+	return c, version, nil
+}
+
+func (s *svc) CreateChunkTemplate(
+	ctx context.Context,
+	actorID string,
+	tmpl resource.ChunkTemplate,
+) (resource.ChunkTemplate, error) {
+	if !slices.Contains(s.cfg.AdminUserIDs, actorID) {
+		return resource.ChunkTemplate{}, apierrs.ErrPermissionDenied
+	}
+
+	if _, err := s.templateVersion(ctx, tmpl.FlavorVersionID); err != nil {
+		return resource.ChunkTemplate{}, err
+	}
+
+	ret, err := s.repo.CreateChunkTemplate(ctx, tmpl)
+	if err != nil {
+		return resource.ChunkTemplate{}, fmt.Errorf("create chunk template: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (s *svc) UpdateChunkTemplate(
+	ctx context.Context,
+	actorID string,
+	tmpl resource.ChunkTemplate,
+) (resource.ChunkTemplate, error) {
+	if !slices.Contains(s.cfg.AdminUserIDs, actorID) {
+		return resource.ChunkTemplate{}, apierrs.ErrPermissionDenied
+	}
+
+	if _, err := s.templateVersion(ctx, tmpl.FlavorVersionID); err != nil {
+		return resource.ChunkTemplate{}, err
+	}
+
+	ret, err := s.repo.UpdateChunkTemplate(ctx, tmpl)
+	if err != nil {
+		return resource.ChunkTemplate{}, fmt.Errorf("update chunk template: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (s *svc) DeleteChunkTemplate(ctx context.Context, actorID string, id string) error {
+	if !slices.Contains(s.cfg.AdminUserIDs, actorID) {
+		return apierrs.ErrPermissionDenied
+	}
+
+	if err := s.repo.DeleteChunkTemplate(ctx, id); err != nil {
+		return fmt.Errorf("delete chunk template: %w", err)
+	}
+
+	return nil
+}
+
+// templateVersion returns the flavor version a template is created from.
+// only built versions can be used, because their files are in the cas.
+func (s *svc) templateVersion(ctx context.Context, id string) (resource.FlavorVersion, error) {
+	version, err := s.repo.FlavorVersionByID(ctx, id)
+	if err != nil {
+		return resource.FlavorVersion{}, fmt.Errorf("flavor version: %w", err)
+	}
+
+	if version.BuildStatus != resource.FlavorVersionBuildStatusCompleted {
+		return resource.FlavorVersion{}, apierrs.ErrFlavorVersionNotBuilt
+	}
+
+	return version, nil
+}
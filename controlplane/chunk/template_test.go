@@ -0,0 +1,183 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateChunkTemplate(t *testing.T) {
+	var (
+		adminID = "admin-id"
+		tmpl    = resource.ChunkTemplate{
+			Name:            "minigame",
+			Description:     "a minigame to start with",
+			FlavorVersionID: fixture.FlavorVersion().ID,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		actorID  string
+		expected resource.ChunkTemplate
+		err      error
+		prep     func(*mock.MockChunkRepository)
+	}{
+		{
+			name:    "create template",
+			actorID: adminID,
+			expected: resource.ChunkTemplate{
+				ID:              "019df3c2-1a2b-7c3d-8e4f-5a6b7c8d9e0f",
+				Name:            tmpl.Name,
+				Description:     tmpl.Description,
+				FlavorVersionID: tmpl.FlavorVersionID,
+			},
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().
+					FlavorVersionByID(mocky.Anything, tmpl.FlavorVersionID).
+					Return(fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+						v.BuildStatus = resource.FlavorVersionBuildStatusCompleted
+					}), nil)
+
+				created := tmpl
+				created.ID = "019df3c2-1a2b-7c3d-8e4f-5a6b7c8d9e0f"
+				repo.EXPECT().CreateChunkTemplate(mocky.Anything, tmpl).Return(created, nil)
+			},
+		},
+		{
+			name:    "flavor version not built",
+			actorID: adminID,
+			err:     apierrs.ErrFlavorVersionNotBuilt,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().
+					FlavorVersionByID(mocky.Anything, tmpl.FlavorVersionID).
+					Return(fixture.FlavorVersion(), nil)
+			},
+		},
+		{
+			name:    "not an admin",
+			actorID: "owner-id",
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockChunkRepository) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx      = context.Background()
+				mockRepo = mock.NewMockChunkRepository(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				chunk.Config{
+					AdminUserIDs: []string{adminID},
+				},
+			)
+			require.NoError(t, err)
+
+			tt.prep(mockRepo)
+
+			actual, err := svc.CreateChunkTemplate(ctx, tt.actorID, tmpl)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestInstantiateChunkTemplate(t *testing.T) {
+	tmpl := resource.ChunkTemplate{
+		ID:              "019df3c2-1a2b-7c3d-8e4f-5a6b7c8d9e0f",
+		Name:            "minigame",
+		FlavorVersionID: fixture.FlavorVersion().ID,
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		prep func(*mock.MockChunkRepository)
+	}{
+		{
+			name: "template does not exist",
+			err:  apierrs.ErrTemplateNotFound,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().
+					ChunkTemplateByName(mocky.Anything, tmpl.Name).
+					Return(resource.ChunkTemplate{}, apierrs.ErrTemplateNotFound)
+			},
+		},
+		{
+			name: "flavor version of template not built",
+			err:  apierrs.ErrFlavorVersionNotBuilt,
+			prep: func(repo *mock.MockChunkRepository) {
+				repo.EXPECT().ChunkTemplateByName(mocky.Anything, tmpl.Name).Return(tmpl, nil)
+				repo.EXPECT().
+					FlavorVersionByID(mocky.Anything, tmpl.FlavorVersionID).
+					Return(fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+						v.BuildStatus = resource.FlavorVersionBuildStatusBuildImageFailed
+					}), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx      = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockRepo = mock.NewMockChunkRepository(t)
+			)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				chunk.Config{},
+			)
+			require.NoError(t, err)
+
+			tt.prep(mockRepo)
+
+			_, _, err = svc.InstantiateChunkTemplate(ctx, tmpl.Name, fixture.Chunk())
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -35,37 +35,37 @@ func (s *svc) GetUploadURL(
 	versionID string,
 	tarballHash string,
 	tarballSizeBytes uint64,
-) (string, error) {
+) (string, map[string]string, error) {
 	actorID, ok := ctx.Value(contextkey.ActorID).(string)
 	if !ok {
-		return "", errors.New("actor_id not found in context")
+		return "", nil, errors.New("actor_id not found in context")
 	}
 
 	if err := s.access.AccessAuthorized(
 		ctx,
 		authz.WithOwnershipRule(actorID, authz.FlavorVersionResourceDef(versionID)),
 	); err != nil {
-		return "", fmt.Errorf("access: %w", err)
+		return "", nil, fmt.Errorf("access: %w", err)
 	}
 
 	if tarballSizeBytes > s.cfg.ChangesetTarballMaxSizeBytes {
-		return "", apierrs.ErrChangeSetTarballTooBig
+		return "", nil, apierrs.ErrChangeSetTarballTooBig
 	}
 
 	ver, err := s.repo.FlavorVersionByID(ctx, versionID)
 	if err != nil {
-		return "", fmt.Errorf("flavor version: %w", err)
+		return "", nil, fmt.Errorf("flavor version: %w", err)
 	}
 
 	if ver.FilesUploaded {
-		return "", apierrs.ErrFlavorFilesUploaded
+		return "", nil, apierrs.ErrFlavorFilesUploaded
 	}
 
 	if ver.PresignedURLExpiryDate != nil && time.Now().Before(*ver.PresignedURLExpiryDate) {
-		return *ver.PresignedURL, nil
+		return *ver.PresignedURL, s.blobStore.UploadHeaders(), nil
 	}
 
-	url, expiryDate, err := s.s3Store.PresignURL(
+	url, expiryDate, err := s.blobStore.PresignURL(
 		ctx,
 		blob.ChangeSetKey(versionID),
 		tarballHash,
@@ -73,7 +73,7 @@ func (s *svc) GetUploadURL(
 		tarballSizeBytes,
 	)
 	if err != nil {
-		return "", fmt.Errorf("presign: %w", err)
+		return "", nil, fmt.Errorf("presign: %w", err)
 	}
 
 	if err := s.repo.UpdateFlavorVersionPresignedURLData(
@@ -82,8 +82,8 @@ func (s *svc) GetUploadURL(
 		expiryDate,
 		url,
 	); err != nil {
-		return "", fmt.Errorf("update presigned url data: %w", err)
+		return "", nil, fmt.Errorf("update presigned url data: %w", err)
 	}
 
-	return url, nil
+	return url, s.blobStore.UploadHeaders(), nil
 }
@@ -0,0 +1,97 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/authz"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/file"
+)
+
+func (s *svc) GetFlavorVersionFile(
+	ctx context.Context,
+	versionID string,
+	path string,
+) (file.Hash, string, time.Time, error) {
+	actorID, ok := ctx.Value(contextkey.ActorID).(string)
+	if !ok {
+		return file.Hash{}, "", time.Time{}, errors.New("actor_id not found in context")
+	}
+
+	// files may contain credentials, like database passwords in plugin
+	// configs, so only the owner is allowed to download them.
+	if err := s.access.AccessAuthorized(
+		ctx,
+		authz.WithOwnershipRule(actorID, authz.FlavorVersionResourceDef(versionID)),
+	); err != nil {
+		return file.Hash{}, "", time.Time{}, fmt.Errorf("access: %w", err)
+	}
+
+	ver, err := s.repo.FlavorVersionByID(ctx, versionID)
+	if err != nil {
+		return file.Hash{}, "", time.Time{}, fmt.Errorf("flavor version: %w", err)
+	}
+
+	path = filepath.ToSlash(filepath.Clean(path))
+
+	var (
+		fh    file.Hash
+		found bool
+	)
+
+	for _, f := range ver.FileHashes {
+		if f.Path == path {
+			fh = f
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return file.Hash{}, "", time.Time{}, apierrs.ErrFileNotFound
+	}
+
+	// files are moved into the cas when building the flavor version, so
+	// the content of versions that have not been built yet might be missing.
+	key := blob.CASKeyPrefix + "/" + fh.Hash
+
+	exists, err := s.blobStore.ObjectExists(ctx, key)
+	if err != nil {
+		return file.Hash{}, "", time.Time{}, fmt.Errorf("cas object exists: %w", err)
+	}
+
+	if !exists {
+		return file.Hash{}, "", time.Time{}, apierrs.ErrFlavorVersionNotBuilt
+	}
+
+	url, expiresAt, err := s.blobStore.PresignDownloadURL(ctx, key, s.cfg.PresignedURLExpiry)
+	if err != nil {
+		return file.Hash{}, "", time.Time{}, fmt.Errorf("presign download url: %w", err)
+	}
+
+	return fh, url, expiresAt, nil
+}
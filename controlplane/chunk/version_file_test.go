@@ -0,0 +1,149 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/file"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFlavorVersionFile(t *testing.T) {
+	var (
+		version = fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+			v.FileHashes = []file.Hash{
+				{Path: "paper.yml", Hash: "aaaaaaaaaaaaaaaa", SizeBytes: 10},
+				{Path: "plugins/a.yml", Hash: "bbbbbbbbbbbbbbbb", SizeBytes: 20},
+			}
+		})
+		cfg = chunk.Config{
+			PresignedURLExpiry: time.Minute,
+		}
+		expiresAt = time.Now().Add(time.Minute)
+	)
+
+	tests := []struct {
+		name     string
+		path     string
+		denied   bool
+		prep     func(*mock.MockChunkRepository, *mock.MockBlobStore)
+		expected file.Hash
+		err      error
+	}{
+		{
+			name: "returns download url",
+			path: "plugins/../plugins/a.yml",
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+
+				store.EXPECT().
+					ObjectExists(mocky.Anything, blob.CASKeyPrefix+"/bbbbbbbbbbbbbbbb").
+					Return(true, nil)
+
+				store.EXPECT().
+					PresignDownloadURL(mocky.Anything, blob.CASKeyPrefix+"/bbbbbbbbbbbbbbbb", cfg.PresignedURLExpiry).
+					Return("https://example.com/b", expiresAt, nil)
+			},
+			expected: file.Hash{Path: "plugins/a.yml", Hash: "bbbbbbbbbbbbbbbb", SizeBytes: 20},
+		},
+		{
+			name: "file not part of flavor version",
+			path: "server.properties",
+			prep: func(repo *mock.MockChunkRepository, _ *mock.MockBlobStore) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+			},
+			err: apierrs.ErrFileNotFound,
+		},
+		{
+			name: "content not in cas",
+			path: "paper.yml",
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+
+				store.EXPECT().
+					ObjectExists(mocky.Anything, blob.CASKeyPrefix+"/aaaaaaaaaaaaaaaa").
+					Return(false, nil)
+			},
+			err: apierrs.ErrFlavorVersionNotBuilt,
+		},
+		{
+			name:   "not the owner",
+			path:   "paper.yml",
+			denied: true,
+			prep:   func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			err:    apierrs.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.WithValue(context.Background(), contextkey.ActorID, "blabla")
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockStore  = mock.NewMockBlobStore(t)
+				accessErr  error
+			)
+
+			if tt.denied {
+				accessErr = apierrs.ErrPermissionDenied
+			}
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(accessErr)
+
+			tt.prep(mockRepo, mockStore)
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				mockStore,
+				mockAccess,
+				cfg,
+			)
+			require.NoError(t, err)
+
+			fh, url, actualExpiresAt, err := svc.GetFlavorVersionFile(ctx, version.ID, tt.path)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, fh)
+			require.Equal(t, "https://example.com/b", url)
+			require.Equal(t, expiresAt, actualExpiresAt)
+		})
+	}
+}
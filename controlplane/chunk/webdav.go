@@ -0,0 +1,299 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	"github.com/spacechunks/explorer/internal/file"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// davAllowedMethods are the methods supported by the webdav gateway.
+// it is read-only, so all methods modifying files are rejected.
+const davAllowedMethods = "OPTIONS, GET, HEAD, PROPFIND"
+
+// Authenticator validates the given api token and returns
+// the id of the user it has been issued to.
+type Authenticator func(ctx context.Context, token string) (string, error)
+
+// WebDAVHandler serves the files of flavor versions read-only using webdav, so
+// they can be browsed with standard tools. the files of a flavor version are
+// located at /<flavor version id>/<path>.
+//
+// file contents are not served by the handler itself, instead clients are
+// redirected to the object in the cas. since most webdav clients only support
+// basic auth, the api token can also be passed as password, the username is
+// ignored.
+func WebDAVHandler(logger *slog.Logger, service Service, auth Authenticator) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+
+		switch req.Method {
+		case http.MethodOptions:
+			w.Header().Set("DAV", "1")
+			w.Header().Set("Allow", davAllowedMethods)
+			w.WriteHeader(http.StatusOK)
+			return
+		case http.MethodGet, http.MethodHead, "PROPFIND":
+		default:
+			w.Header().Set("Allow", davAllowedMethods)
+			http.Error(w, "files are read-only", http.StatusMethodNotAllowed)
+			return
+		}
+
+		token := req.Header.Get("Authorization")
+		if _, pass, ok := req.BasicAuth(); ok {
+			token = pass
+		}
+
+		if token == "" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="explorer"`)
+			http.Error(w, "authorization header is missing", http.StatusUnauthorized)
+			return
+		}
+
+		userID, err := auth(ctx, token)
+		if err != nil {
+			logger.DebugContext(ctx, "failed to authenticate", "err", err)
+			w.Header().Set("WWW-Authenticate", `Basic realm="explorer"`)
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+
+		ctx = context.WithValue(ctx, contextkey.ActorID, userID)
+
+		versionID, path, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/"), "/")
+		if _, err := uuid.Parse(versionID); err != nil {
+			http.NotFound(w, req)
+			return
+		}
+
+		path = strings.Trim(path, "/")
+
+		if req.Method == "PROPFIND" {
+			propfind(ctx, logger, w, req, service, versionID, path)
+			return
+		}
+
+		if path == "" {
+			http.Error(w, "directories can only be listed using PROPFIND", http.StatusMethodNotAllowed)
+			return
+		}
+
+		_, downloadURL, _, err := service.GetFlavorVersionFile(ctx, versionID, path)
+		if err != nil {
+			writeDAVError(ctx, logger, w, err)
+			return
+		}
+
+		http.Redirect(w, req, downloadURL, http.StatusTemporaryRedirect)
+	})
+}
+
+func propfind(
+	ctx context.Context,
+	logger *slog.Logger,
+	w http.ResponseWriter,
+	req *http.Request,
+	service Service,
+	versionID string,
+	path string,
+) {
+	// listing the whole tree at once is not supported, which is
+	// allowed by RFC 4918 section 9.1. clients fall back to depth 1.
+	depth := req.Header.Get("Depth")
+	if depth != "0" && depth != "1" {
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(xml.Header + `<D:error xmlns:D="DAV:"><D:propfind-finite-depth/></D:error>`))
+		return
+	}
+
+	version, _, err := service.GetFlavorVersion(ctx, versionID)
+	if err != nil {
+		writeDAVError(ctx, logger, w, err)
+		return
+	}
+
+	self, children, ok := davLookup(version.FileHashes, path)
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+
+	entries := []davEntry{self}
+	if depth == "1" {
+		entries = append(entries, children...)
+	}
+
+	var (
+		lastModified = version.CreatedAt.UTC().Format(http.TimeFormat)
+		ms           = davMultistatus{XMLNS: "DAV:"}
+	)
+
+	for _, e := range entries {
+		href := "/" + versionID
+		if e.path != "" {
+			href += "/" + e.path
+		}
+
+		prop := davProp{
+			DisplayName:  e.path[strings.LastIndex(e.path, "/")+1:],
+			LastModified: lastModified,
+		}
+
+		if e.dir {
+			href += "/"
+			prop.ResourceType.Collection = &struct{}{}
+		} else {
+			size := e.file.SizeBytes
+			prop.ContentLength = &size
+			prop.ETag = `"` + e.file.Hash + `"`
+		}
+
+		ms.Responses = append(ms.Responses, davResponse{
+			Href: (&url.URL{Path: href}).EscapedPath(),
+			Propstat: davPropstat{
+				Prop:   prop,
+				Status: "HTTP/1.1 200 OK",
+			},
+		})
+	}
+
+	data, err := xml.Marshal(ms)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to marshal multistatus", "err", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
+
+// davEntry is a file or directory of a flavor version. path is relative
+// to the root of the flavor version and empty for the root itself.
+type davEntry struct {
+	path string
+	dir  bool
+	file file.Hash
+}
+
+// davLookup returns the entry located at path and its direct children. flavor
+// versions only contain files, so directories are derived from their paths.
+func davLookup(files []file.Hash, path string) (davEntry, []davEntry, bool) {
+	var (
+		prefix   string
+		found    = path == ""
+		children = make([]davEntry, 0)
+	)
+
+	if path != "" {
+		prefix = path + "/"
+	}
+
+	for _, f := range files {
+		if f.Path == path {
+			return davEntry{path: path, file: f}, nil, true
+		}
+
+		rest, ok := strings.CutPrefix(f.Path, prefix)
+		if !ok {
+			continue
+		}
+
+		found = true
+
+		name, _, dir := strings.Cut(rest, "/")
+		if slices.ContainsFunc(children, func(e davEntry) bool { return e.path == prefix+name }) {
+			continue
+		}
+
+		entry := davEntry{path: prefix + name, dir: dir}
+		if !dir {
+			entry.file = f
+		}
+
+		children = append(children, entry)
+	}
+
+	slices.SortFunc(children, func(a, b davEntry) int {
+		return strings.Compare(a.path, b.path)
+	})
+
+	return davEntry{path: path, dir: true}, children, found
+}
+
+func writeDAVError(ctx context.Context, logger *slog.Logger, w http.ResponseWriter, err error) {
+	switch status.Code(err) {
+	case codes.NotFound:
+		http.Error(w, "not found", http.StatusNotFound)
+	case codes.PermissionDenied:
+		http.Error(w, "permission denied", http.StatusForbidden)
+	case codes.FailedPrecondition:
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		logger.ErrorContext(ctx, "failed to serve webdav request", "err", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
+
+type davMultistatus struct {
+	XMLName   xml.Name      `xml:"D:multistatus"`
+	XMLNS     string        `xml:"xmlns:D,attr"`
+	Responses []davResponse `xml:"D:response"`
+}
+
+type davResponse struct {
+	Href     string      `xml:"D:href"`
+	Propstat davPropstat `xml:"D:propstat"`
+}
+
+type davPropstat struct {
+	Prop   davProp `xml:"D:prop"`
+	Status string  `xml:"D:status"`
+}
+
+type davProp struct {
+	DisplayName   string          `xml:"D:displayname"`
+	ResourceType  davResourceType `xml:"D:resourcetype"`
+	ContentLength *uint64         `xml:"D:getcontentlength,omitempty"`
+	LastModified  string          `xml:"D:getlastmodified,omitempty"`
+	ETag          string          `xml:"D:getetag,omitempty"`
+}
+
+type davResourceType struct {
+	Collection *struct{} `xml:"D:collection"`
+}
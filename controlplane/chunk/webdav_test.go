@@ -0,0 +1,247 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunk_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/file"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebDAVHandler(t *testing.T) {
+	var (
+		version = fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+			v.ID = "019df3b1-6c2a-7e4d-9b1f-2a3c4d5e6f73"
+			v.CreatedAt = time.Date(2026, 10, 17, 13, 0, 0, 0, time.UTC)
+			v.FileHashes = []file.Hash{
+				{Path: "paper.yml", Hash: "aaaaaaaaaaaaaaaa", SizeBytes: 10},
+				{Path: "plugins/a.yml", Hash: "bbbbbbbbbbbbbbbb", SizeBytes: 20},
+				{Path: "plugins/b/c.yml", Hash: "cccccccccccccccc", SizeBytes: 30},
+			}
+		})
+		cfg = chunk.Config{
+			PresignedURLExpiry: time.Minute,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		headers  map[string]string
+		prep     func(*mock.MockChunkRepository, *mock.MockBlobStore)
+		status   int
+		location string
+		contains []string
+		excludes []string
+	}{
+		{
+			name:   "missing token",
+			method: http.MethodGet,
+			path:   "/" + version.ID + "/paper.yml",
+			prep:   func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "writes are rejected",
+			method: http.MethodPut,
+			path:   "/" + version.ID + "/paper.yml",
+			prep:   func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			status: http.StatusMethodNotAllowed,
+		},
+		{
+			name:   "get redirects to cas object",
+			method: http.MethodGet,
+			path:   "/" + version.ID + "/plugins/a.yml",
+			headers: map[string]string{
+				"Authorization": "token",
+			},
+			prep: func(repo *mock.MockChunkRepository, store *mock.MockBlobStore) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+				store.EXPECT().
+					ObjectExists(mocky.Anything, blob.CASKeyPrefix+"/bbbbbbbbbbbbbbbb").
+					Return(true, nil)
+				store.EXPECT().
+					PresignDownloadURL(mocky.Anything, blob.CASKeyPrefix+"/bbbbbbbbbbbbbbbb", cfg.PresignedURLExpiry).
+					Return("https://example.com/a.yml", time.Now(), nil)
+			},
+			status:   http.StatusTemporaryRedirect,
+			location: "https://example.com/a.yml",
+		},
+		{
+			name:   "get unknown file",
+			method: http.MethodGet,
+			path:   "/" + version.ID + "/nope.yml",
+			headers: map[string]string{
+				"Authorization": "token",
+			},
+			prep: func(repo *mock.MockChunkRepository, _ *mock.MockBlobStore) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "propfind lists direct children using basic auth",
+			method: "PROPFIND",
+			path:   "/" + version.ID + "/plugins/",
+			headers: map[string]string{
+				"Depth": "1",
+			},
+			prep: func(repo *mock.MockChunkRepository, _ *mock.MockBlobStore) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+				repo.EXPECT().FlavorVersionProvenance(mocky.Anything, version.ID).Return(
+					resource.BuildProvenance{},
+					apierrs.ErrNotFound,
+				)
+			},
+			status: http.StatusMultiStatus,
+			contains: []string{
+				"<D:href>/" + version.ID + "/plugins/</D:href>",
+				"<D:href>/" + version.ID + "/plugins/a.yml</D:href>",
+				"<D:getcontentlength>20</D:getcontentlength>",
+				"<D:getetag>&#34;bbbbbbbbbbbbbbbb&#34;</D:getetag>",
+				"<D:href>/" + version.ID + "/plugins/b/</D:href>",
+				"<D:collection></D:collection>",
+				"<D:getlastmodified>Sat, 17 Oct 2026 13:00:00 GMT</D:getlastmodified>",
+			},
+			excludes: []string{
+				"paper.yml",
+				"c.yml",
+			},
+		},
+		{
+			name:   "propfind with infinite depth",
+			method: "PROPFIND",
+			path:   "/" + version.ID + "/",
+			headers: map[string]string{
+				"Authorization": "token",
+				"Depth":         "infinity",
+			},
+			prep:     func(*mock.MockChunkRepository, *mock.MockBlobStore) {},
+			status:   http.StatusForbidden,
+			contains: []string{"propfind-finite-depth"},
+		},
+		{
+			name:   "propfind unknown directory",
+			method: "PROPFIND",
+			path:   "/" + version.ID + "/nope",
+			headers: map[string]string{
+				"Authorization": "token",
+				"Depth":         "0",
+			},
+			prep: func(repo *mock.MockChunkRepository, _ *mock.MockBlobStore) {
+				repo.EXPECT().FlavorVersionByID(mocky.Anything, version.ID).Return(version, nil)
+				repo.EXPECT().FlavorVersionProvenance(mocky.Anything, version.ID).Return(
+					resource.BuildProvenance{},
+					apierrs.ErrNotFound,
+				)
+			},
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockRepo   = mock.NewMockChunkRepository(t)
+				mockStore  = mock.NewMockBlobStore(t)
+				mockAccess = mock.NewMockAuthzAccessEvaluator(t)
+			)
+
+			tt.prep(mockRepo, mockStore)
+
+			mockAccess.EXPECT().
+				AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+				Return(nil).
+				Maybe()
+
+			svc, err := chunk.NewService(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				nil,
+				nil,
+				mockStore,
+				mockAccess,
+				cfg,
+			)
+			require.NoError(t, err)
+
+			auth := func(_ context.Context, token string) (string, error) {
+				if token != "token" {
+					return "", errors.New("invalid token")
+				}
+				return "user", nil
+			}
+
+			srv := httptest.NewServer(chunk.WebDAVHandler(slog.New(slog.DiscardHandler), svc, auth))
+			defer srv.Close()
+
+			req, err := http.NewRequest(tt.method, srv.URL+tt.path, nil)
+			require.NoError(t, err)
+
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			if tt.method == "PROPFIND" && req.Header.Get("Authorization") == "" {
+				req.SetBasicAuth("ignored", "token")
+			}
+
+			client := &http.Client{
+				CheckRedirect: func(*http.Request, []*http.Request) error {
+					return http.ErrUseLastResponse
+				},
+			}
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.status, resp.StatusCode, string(body))
+			require.Equal(t, tt.location, resp.Header.Get("Location"))
+
+			for _, s := range tt.contains {
+				require.Contains(t, string(body), s)
+			}
+
+			for _, s := range tt.excludes {
+				require.False(t, strings.Contains(string(body), s), "body contains %s", s)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package condition
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/node"
+)
+
+// Type is a local problem detected on a node.
+type Type string
+
+const (
+	TypeDiskPressure       Type = "DISK_PRESSURE"
+	TypeCRIUnresponsive    Type = "CRI_UNRESPONSIVE"
+	TypeBPFMapFull         Type = "BPF_MAP_FULL"
+	TypeConntrackExhausted Type = "CONNTRACK_EXHAUSTED"
+)
+
+// Condition is a problem reported by a node. nodes reporting
+// at least one condition do not get any instances scheduled.
+type Condition struct {
+	NodeID   string
+	NodeName string
+	Type     Type
+	Message  string
+
+	// Since is the time the node started reporting the condition.
+	Since time.Time
+}
+
+type EventType string
+
+const (
+	EventTypeRaised  EventType = "CONDITION_RAISED"
+	EventTypeCleared EventType = "CONDITION_CLEARED"
+)
+
+// Event is raised whenever a node starts or stops reporting a condition.
+type Event struct {
+	ID            string
+	NodeID        string
+	Type          EventType
+	ConditionType Type
+	Message       string
+	CreatedAt     time.Time
+}
+
+type Repository interface {
+	// ReplaceNodeConditions replaces the conditions of the node with the given
+	// ones and records an event for every condition that has been raised or
+	// cleared. the recorded events are returned. returns ErrNodeNotFound if
+	// there is no such node.
+	ReplaceNodeConditions(ctx context.Context, nodeID string, conditions []Condition) ([]Event, error)
+
+	// ListNodeConditions returns the conditions of all nodes.
+	ListNodeConditions(ctx context.Context) ([]Condition, error)
+
+	// ListNodeEvents returns the most recent node events, newest first.
+	ListNodeEvents(ctx context.Context, limit int) ([]Event, error)
+
+	// ListNodes returns all nodes, including the number of slots
+	// that are still available on them.
+	ListNodes(ctx context.Context) ([]node.Node, error)
+}
@@ -0,0 +1,138 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package condition
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/node"
+)
+
+// maxEvents is the number of events returned by ListEvents.
+const maxEvents = 100
+
+type Service interface {
+	// Report replaces the conditions of the node with the reported ones.
+	// nodes that recovered report no conditions at all.
+	Report(ctx context.Context, nodeID string, conditions []Condition) error
+
+	// ListConditions returns the conditions of all nodes.
+	// only admins are allowed to list them.
+	ListConditions(ctx context.Context, actorID string) ([]Condition, error)
+
+	// ListEvents returns the most recent node events, newest
+	// first. only admins are allowed to list them.
+	ListEvents(ctx context.Context, actorID string) ([]Event, error)
+
+	// ListNodeCapacities returns all nodes together with the slots
+	// available to the scheduler. nodes reporting conditions are
+	// unhealthy. only admins are allowed to list them.
+	ListNodeCapacities(ctx context.Context, actorID string) ([]node.Node, error)
+}
+
+type svc struct {
+	logger       *slog.Logger
+	repo         Repository
+	adminUserIDs []string
+}
+
+func NewService(logger *slog.Logger, repo Repository, adminUserIDs []string) Service {
+	return &svc{
+		logger:       logger,
+		repo:         repo,
+		adminUserIDs: adminUserIDs,
+	}
+}
+
+func (s *svc) Report(ctx context.Context, nodeID string, conditions []Condition) error {
+	// a node reporting the same condition twice would
+	// otherwise violate the uniqueness of conditions.
+	deduped := make([]Condition, 0, len(conditions))
+	for _, c := range conditions {
+		if slices.ContainsFunc(deduped, func(d Condition) bool { return d.Type == c.Type }) {
+			continue
+		}
+		deduped = append(deduped, c)
+	}
+
+	events, err := s.repo.ReplaceNodeConditions(ctx, nodeID, deduped)
+	if err != nil {
+		return fmt.Errorf("replace node conditions: %w", err)
+	}
+
+	for _, ev := range events {
+		attrs := []any{
+			"node_id", nodeID,
+			"condition", ev.ConditionType,
+			"message", ev.Message,
+		}
+
+		if ev.Type == EventTypeRaised {
+			s.logger.WarnContext(ctx, "node condition raised, not scheduling instances to node", attrs...)
+			continue
+		}
+
+		s.logger.InfoContext(ctx, "node condition cleared", attrs...)
+	}
+
+	return nil
+}
+
+func (s *svc) ListConditions(ctx context.Context, actorID string) ([]Condition, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return nil, apierrs.ErrPermissionDenied
+	}
+
+	conditions, err := s.repo.ListNodeConditions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list node conditions: %w", err)
+	}
+
+	return conditions, nil
+}
+
+func (s *svc) ListEvents(ctx context.Context, actorID string) ([]Event, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return nil, apierrs.ErrPermissionDenied
+	}
+
+	events, err := s.repo.ListNodeEvents(ctx, maxEvents)
+	if err != nil {
+		return nil, fmt.Errorf("list node events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (s *svc) ListNodeCapacities(ctx context.Context, actorID string) ([]node.Node, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return nil, apierrs.ErrPermissionDenied
+	}
+
+	nodes, err := s.repo.ListNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list nodes: %w", err)
+	}
+
+	return nodes, nil
+}
@@ -0,0 +1,153 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package condition_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/condition"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/mock"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adminID = "admin"
+	nodeID  = "node"
+)
+
+func TestReport(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		repo = mock.NewMockConditionRepository(t)
+		svc  = condition.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID})
+	)
+
+	// the second disk pressure condition is dropped
+	repo.EXPECT().
+		ReplaceNodeConditions(mocky.Anything, nodeID, []condition.Condition{
+			{Type: condition.TypeDiskPressure, Message: "5% free"},
+			{Type: condition.TypeBPFMapFull, Message: "net_data_map is full"},
+		}).
+		Return([]condition.Event{
+			{NodeID: nodeID, Type: condition.EventTypeRaised, ConditionType: condition.TypeBPFMapFull},
+		}, nil)
+
+	require.NoError(t, svc.Report(ctx, nodeID, []condition.Condition{
+		{Type: condition.TypeDiskPressure, Message: "5% free"},
+		{Type: condition.TypeBPFMapFull, Message: "net_data_map is full"},
+		{Type: condition.TypeDiskPressure, Message: "4% free"},
+	}))
+}
+
+func TestListConditions(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID string
+		err     error
+		prep    func(*mock.MockConditionRepository)
+	}{
+		{
+			name:    "admin can list conditions",
+			actorID: adminID,
+			prep: func(repo *mock.MockConditionRepository) {
+				repo.EXPECT().ListNodeConditions(mocky.Anything).Return([]condition.Condition{
+					{NodeID: nodeID, Type: condition.TypeCRIUnresponsive},
+				}, nil)
+			},
+		},
+		{
+			name:    "other users are not allowed",
+			actorID: "someone",
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockConditionRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockConditionRepository(t)
+				svc  = condition.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID})
+			)
+
+			tt.prep(repo)
+
+			conditions, err := svc.ListConditions(ctx, tt.actorID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, conditions, 1)
+		})
+	}
+}
+
+func TestListNodeCapacities(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID string
+		err     error
+		prep    func(*mock.MockConditionRepository)
+	}{
+		{
+			name:    "admin can list node capacities",
+			actorID: adminID,
+			prep: func(repo *mock.MockConditionRepository) {
+				repo.EXPECT().ListNodes(mocky.Anything).Return([]node.Node{
+					{ID: nodeID, Slots: 10, AvailableSlots: 0},
+				}, nil)
+			},
+		},
+		{
+			name:    "other users are not allowed",
+			actorID: "someone",
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockConditionRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockConditionRepository(t)
+				svc  = condition.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID})
+			)
+
+			tt.prep(repo)
+
+			nodes, err := svc.ListNodeCapacities(ctx, tt.actorID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, nodes, 1)
+		})
+	}
+}
@@ -18,11 +18,28 @@
 
 package controlplane
 
-import "time"
+import (
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/partition"
+	"github.com/spacechunks/explorer/internal/logging"
+)
 
 type Config struct {
 	ListenAddr                    string
 	DBConnString                  string
+	DBConnStringFile              string
+	DBMaxConns                    int32
+	DBMinConns                    int32
+	DBMaxConnLifetime             time.Duration
+	DBMaxConnIdleTime             time.Duration
+	DBStatementTimeout            time.Duration
+	RequestTimeout                time.Duration
+	MaxRequestSizeBytes           int
+	ChunkCacheTTL                 time.Duration
+	ReplicaID                     string
+	LeaderElectionInterval        time.Duration
 	OCIRegistry                   string
 	OCIRegistryUser               string
 	OCIRegistryPass               string
@@ -30,16 +47,50 @@ type Config struct {
 	ImagePlatform                 string
 	CheckpointJobTimeout          time.Duration
 	CheckpointStatusCheckInterval time.Duration
+	CheckpointBuilderTimeout      time.Duration
+	CheckpointVerifyRestore       bool
 	Bucket                        string
 	AccessKey                     string
+	AccessKeyFile                 string
 	SecretKey                     string
+	SecretKeyFile                 string
 	PresignedURLExpiry            time.Duration
 	UsePathStyle                  bool
+	S3ServerSideEncryption        string
+	S3KMSKeyID                    string
+	S3StorageClass                string
+	ChangeSetTransitionDays       int32
+	ChangeSetTransitionClass      string
+	ChangeSetExpirationDays       int32
+	StorageBackend                string
+	GCSCredentialsFile            string
+	AzureAccountName              string
+	AzureAccountKey               string
+	AzureServiceURL               string
+	StorageDir                    string
+	StorageUploadListenAddr       string
+	StorageUploadPublicURL        string
+	StorageUploadSecret           string
 	OAuthClientID                 string
 	OAuthIssuerURL                string
 	APITokenIssuer                string
 	APITokenExpiry                time.Duration
+	ImpersonationMaxDuration      time.Duration
 	APITokenSigningKey            string
+	APITokenKeyRotationPeriod     time.Duration
+	APITokenKeyGracePeriod        time.Duration
+	APITokenKeyRefreshInterval    time.Duration
+	ImageSigningKey               string
+	JWKSListenAddr                string
+	PprofListenAddr               string
+	PprofTokenFile                string
+	RevocationCacheTTL            time.Duration
+	AdminUserIDs                  []string
+	MaintenanceCacheTTL           time.Duration
+	RuntimeSettingsCacheTTL       time.Duration
+	PlatformdReleaseURL           string
+	IntegrationTokens             string
+	IntegrationTokensFile         string
 	ThumbnailMaxSizeKB            int
 	ResourcePackBuildInterval     time.Duration
 	ResourcePackWorkingDir        string
@@ -50,6 +101,58 @@ type Config struct {
 	ResourcePackItemDir           string
 	ResourcePackTextureDir        string
 	ChangeSetTarballMaxSizeBytes  uint64
+	PatchMaxFiles                 uint64
+	PatchMaxSizeBytes             uint64
+	AssetMaxSizeBytes             uint64
+	MediaMaxSizeBytes             uint64
+	MediaMaxCount                 int
+	ImportArchiveMaxSizeBytes     uint64
+	ImportMaxFileCount            uint64
+	ImportMaxSizeBytes            uint64
+	SnapshotMaxSizeBytes          uint64
+	FlavorMaxFileCount            uint64
+	FlavorMaxSizeBytes            uint64
+	FlavorMaxFileSizeBytes        uint64
+	FlavorStartupCommands         []string
+	ImageMaxLayerSizeBytes        uint64
 	ArchiveInterval               time.Duration
+	RetentionInterval             time.Duration
+	InstanceCleanupInterval       time.Duration
+	InstanceCleanupPolicies       []instance.CleanupPolicy
+	InstanceWatchResyncInterval   time.Duration
+	InstanceRedirectGracePeriod   time.Duration
+	InstanceLogRetention          time.Duration
+	PlacementWebhookURL           string
+	PlacementWebhookTimeout       time.Duration
+	AutoscalerInterval            time.Duration
+	AutoscalerDemandWindow        time.Duration
+	AutoscalingMaxInstances       uint32
+	CostCPUHourPrice              float64
+	CostMemoryGBHourPrice         float64
+	CostNodeHourPrice             float64
+	ScheduleInterval              time.Duration
+	NodeLostAfter                 time.Duration
+	LostInstanceCheckInterval     time.Duration
+	RescheduleLostInstances       bool
+	PartitionInterval             time.Duration
+	PartitionedTables             []partition.Table
+	EventsListenAddr              string
+	WebDAVListenAddr              string
+	EventsHeartbeatInterval       time.Duration
+	EventsAllowedOrigins          []string
+	StatusListenAddr              string
+	StatusCacheTTL                time.Duration
+	StatusNodeTimeout             time.Duration
+	StatusIncidentsFile           string
+	StatusRateLimit               float64
+	StatusRateBurst               int
+	NotificationSMTPAddr          string
+	NotificationSMTPUser          string
+	NotificationSMTPPass          string
+	NotificationSMTPPassFile      string
+	NotificationFrom              string
+	LogLevels                     *logging.Levels
+	LogLevelSpec                  string
 	DisableTracing                bool
+	DevMode                       bool
 }
@@ -23,4 +23,8 @@ type Key string
 const (
 	APIToken Key = "api_token"
 	ActorID  Key = "actor_id"
+
+	// ImpersonatorID is the id of the admin acting as the actor.
+	// it is only set if the request is made with an impersonation token.
+	ImpersonatorID Key = "impersonator_id"
 )
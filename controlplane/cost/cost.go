@@ -0,0 +1,205 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cost
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+const bytesPerGB = 1 << 30
+
+// Model contains the prices used to attribute costs to instances. prices
+// are in the currency the platform is billed in.
+type Model struct {
+	// CPUHourPrice is the price of one cpu core for an hour.
+	CPUHourPrice float64
+
+	// MemoryGBHourPrice is the price of one GiB of memory for an hour.
+	MemoryGBHourPrice float64
+
+	// NodeHourPrice is the flat price of running a node for an hour. every
+	// instance is attributed the share of a slot of the node it runs on.
+	// the share of free slots is reported as idle node cost.
+	NodeHourPrice float64
+}
+
+type NodeCost struct {
+	NodeID   string
+	NodeName string
+
+	// Hours is the time the node has existed in the window.
+	Hours float64
+
+	// Total is the flat price of the node in the window, Attributed
+	// the part of it that has been attributed to instances.
+	Total      float64
+	Attributed float64
+}
+
+// Idle is the cost of the slots of the node no instance has been using.
+func (n NodeCost) Idle() float64 {
+	return max(n.Total-n.Attributed, 0)
+}
+
+// ChunkStats contains the costs of the instances of a chunk.
+type ChunkStats struct {
+	Start     time.Time
+	End       time.Time
+	Instances []resource.InstanceCost
+	Total     resource.ChunkCost
+}
+
+// Report contains the costs of all chunks and nodes. chunks are
+// sorted by their total cost, the most expensive chunk first.
+type Report struct {
+	Start  time.Time
+	End    time.Time
+	Chunks []resource.ChunkCost
+	Nodes  []NodeCost
+}
+
+// InstanceCost attributes the costs of the instance in the window
+// between start and end. instances that are still running are
+// considered to run until end.
+func (m Model) InstanceCost(u resource.InstanceUsage, start, end time.Time) resource.InstanceCost {
+	stoppedAt := end
+	if u.StoppedAt != nil {
+		stoppedAt = *u.StoppedAt
+	}
+
+	hours := overlap(u.StartedAt, stoppedAt, start, end).Hours()
+	slots := max(u.NodeSlots, 1)
+
+	return resource.InstanceCost{
+		Usage: u,
+		Hours: hours,
+		Cost: resource.Cost{
+			CPU:    hours * float64(u.CPUMillis) / 1000 * m.CPUHourPrice,
+			Memory: hours * float64(u.MemoryBytes) / bytesPerGB * m.MemoryGBHourPrice,
+			Node:   hours * m.NodeHourPrice / float64(slots),
+		},
+	}
+}
+
+// ChunkStats attributes the costs of the given usage, which has
+// to belong to a single chunk, in the window between start and end.
+func (m Model) ChunkStats(chunkID string, usage []resource.InstanceUsage, start, end time.Time) ChunkStats {
+	stats := ChunkStats{
+		Start:     start,
+		End:       end,
+		Instances: make([]resource.InstanceCost, 0, len(usage)),
+		Total: resource.ChunkCost{
+			ChunkID: chunkID,
+		},
+	}
+
+	for _, u := range usage {
+		c := m.InstanceCost(u, start, end)
+		stats.Instances = append(stats.Instances, c)
+		stats.Total.ChunkName = u.ChunkName
+		stats.Total.Instances++
+		stats.Total.InstanceHours += c.Hours
+		stats.Total.Cost = add(stats.Total.Cost, c.Cost)
+	}
+
+	return stats
+}
+
+// Report attributes the costs of the given usage to chunks and the
+// flat price of the given nodes to the instances running on them in
+// the window between start and end.
+func (m Model) Report(usage []resource.InstanceUsage, nodes []node.Node, start, end time.Time) Report {
+	var (
+		chunks     = make(map[string]resource.ChunkCost)
+		attributed = make(map[string]float64)
+	)
+
+	for _, u := range usage {
+		c := m.InstanceCost(u, start, end)
+
+		cc := chunks[u.ChunkID]
+		cc.ChunkID = u.ChunkID
+		cc.ChunkName = u.ChunkName
+		cc.Instances++
+		cc.InstanceHours += c.Hours
+		cc.Cost = add(cc.Cost, c.Cost)
+		chunks[u.ChunkID] = cc
+
+		attributed[u.NodeID] += c.Cost.Node
+	}
+
+	report := Report{
+		Start:  start,
+		End:    end,
+		Chunks: make([]resource.ChunkCost, 0, len(chunks)),
+		Nodes:  make([]NodeCost, 0, len(nodes)),
+	}
+
+	for _, cc := range chunks {
+		report.Chunks = append(report.Chunks, cc)
+	}
+
+	slices.SortFunc(report.Chunks, func(a, b resource.ChunkCost) int {
+		if c := cmp.Compare(b.Cost.Total(), a.Cost.Total()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ChunkID, b.ChunkID)
+	})
+
+	for _, n := range nodes {
+		hours := overlap(n.CreatedAt, end, start, end).Hours()
+		total := hours * m.NodeHourPrice
+		report.Nodes = append(report.Nodes, NodeCost{
+			NodeID:   n.ID,
+			NodeName: n.Name,
+			Hours:    hours,
+			Total:    total,
+			// nodes with fewer slots than before can have more
+			// attributed than the node cost in the window.
+			Attributed: min(attributed[n.ID], total),
+		})
+	}
+
+	return report
+}
+
+func add(a, b resource.Cost) resource.Cost {
+	return resource.Cost{
+		CPU:    a.CPU + b.CPU,
+		Memory: a.Memory + b.Memory,
+		Node:   a.Node + b.Node,
+	}
+}
+
+// overlap returns how long the period between from and to
+// overlaps with the window between start and end.
+func overlap(from, to, start, end time.Time) time.Duration {
+	if from.Before(start) {
+		from = start
+	}
+	if to.After(end) {
+		to = end
+	}
+	return max(to.Sub(from), 0)
+}
@@ -0,0 +1,183 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cost_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/cost"
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	model = cost.Model{
+		CPUHourPrice:      0.04,
+		MemoryGBHourPrice: 0.005,
+		NodeHourPrice:     0.2,
+	}
+	start = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	end   = start.Add(24 * time.Hour)
+)
+
+func TestInstanceCost(t *testing.T) {
+	tests := []struct {
+		name     string
+		usage    resource.InstanceUsage
+		expected resource.InstanceCost
+	}{
+		{
+			name: "running instance is charged until the end of the window",
+			usage: resource.InstanceUsage{
+				CPUMillis:   2000,
+				MemoryBytes: 4 << 30,
+				NodeSlots:   4,
+				StartedAt:   end.Add(-10 * time.Hour),
+			},
+			expected: resource.InstanceCost{
+				Hours: 10,
+				Cost: resource.Cost{
+					CPU:    10 * 2 * 0.04,
+					Memory: 10 * 4 * 0.005,
+					Node:   10 * 0.2 / 4,
+				},
+			},
+		},
+		{
+			name: "only the time within the window is charged",
+			usage: resource.InstanceUsage{
+				CPUMillis: 1000,
+				NodeSlots: 2,
+				StartedAt: start.Add(-5 * time.Hour),
+				StoppedAt: new(start.Add(3 * time.Hour)),
+			},
+			expected: resource.InstanceCost{
+				Hours: 3,
+				Cost: resource.Cost{
+					CPU:  3 * 0.04,
+					Node: 3 * 0.2 / 2,
+				},
+			},
+		},
+		{
+			name: "instances stopped before the window are free",
+			usage: resource.InstanceUsage{
+				CPUMillis: 1000,
+				NodeSlots: 2,
+				StartedAt: start.Add(-5 * time.Hour),
+				StoppedAt: new(start.Add(-time.Hour)),
+			},
+			expected: resource.InstanceCost{},
+		},
+		{
+			name: "nodes without slots are charged as a whole",
+			usage: resource.InstanceUsage{
+				StartedAt: start,
+				StoppedAt: new(start.Add(time.Hour)),
+			},
+			expected: resource.InstanceCost{
+				Hours: 1,
+				Cost: resource.Cost{
+					Node: 0.2,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.expected.Usage = tt.usage
+			actual := model.InstanceCost(tt.usage, start, end)
+			require.InDelta(t, tt.expected.Hours, actual.Hours, 1e-9)
+			require.InDelta(t, tt.expected.Cost.CPU, actual.Cost.CPU, 1e-9)
+			require.InDelta(t, tt.expected.Cost.Memory, actual.Cost.Memory, 1e-9)
+			require.InDelta(t, tt.expected.Cost.Node, actual.Cost.Node, 1e-9)
+			require.Equal(t, tt.expected.Usage, actual.Usage)
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	usage := []resource.InstanceUsage{
+		{
+			InstanceID: "a",
+			ChunkID:    "cheap",
+			ChunkName:  "Cheap",
+			NodeID:     "node1",
+			CPUMillis:  1000,
+			NodeSlots:  4,
+			StartedAt:  start,
+			StoppedAt:  new(start.Add(2 * time.Hour)),
+		},
+		{
+			InstanceID: "b",
+			ChunkID:    "expensive",
+			ChunkName:  "Expensive",
+			NodeID:     "node1",
+			CPUMillis:  2000,
+			NodeSlots:  4,
+			StartedAt:  start,
+		},
+		{
+			InstanceID: "c",
+			ChunkID:    "expensive",
+			ChunkName:  "Expensive",
+			NodeID:     "node2",
+			CPUMillis:  2000,
+			NodeSlots:  1,
+			StartedAt:  start.Add(12 * time.Hour),
+		},
+	}
+
+	nodes := []node.Node{
+		{ID: "node1", Name: "one", CreatedAt: start.Add(-time.Hour)},
+		{ID: "node2", Name: "two", CreatedAt: start.Add(12 * time.Hour)},
+	}
+
+	report := model.Report(usage, nodes, start, end)
+
+	require.Equal(t, start, report.Start)
+	require.Equal(t, end, report.End)
+
+	require.Len(t, report.Chunks, 2)
+	require.Equal(t, "expensive", report.Chunks[0].ChunkID)
+	require.Equal(t, "Expensive", report.Chunks[0].ChunkName)
+	require.Equal(t, 2, report.Chunks[0].Instances)
+	require.InDelta(t, 36, report.Chunks[0].InstanceHours, 1e-9)
+	require.InDelta(t, 36*2*0.04, report.Chunks[0].Cost.CPU, 1e-9)
+	require.InDelta(t, 24*0.2/4+12*0.2, report.Chunks[0].Cost.Node, 1e-9)
+	require.Equal(t, "cheap", report.Chunks[1].ChunkID)
+	require.InDelta(t, 2*0.04+2*0.2/4, report.Chunks[1].Cost.Total(), 1e-9)
+
+	require.Len(t, report.Nodes, 2)
+
+	one := report.Nodes[0]
+	require.Equal(t, "one", one.NodeName)
+	require.InDelta(t, 24, one.Hours, 1e-9)
+	require.InDelta(t, 24*0.2, one.Total, 1e-9)
+	require.InDelta(t, 26*0.2/4, one.Attributed, 1e-9)
+	require.InDelta(t, 24*0.2-26*0.2/4, one.Idle(), 1e-9)
+
+	two := report.Nodes[1]
+	require.InDelta(t, 12, two.Hours, 1e-9)
+	require.InDelta(t, 12*0.2, two.Attributed, 1e-9)
+	require.Zero(t, two.Idle())
+}
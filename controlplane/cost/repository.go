@@ -0,0 +1,38 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cost
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+type Repository interface {
+	// InstanceUsage returns the usage of all instances that have been running
+	// between start and end. if chunkID is not nil, only the usage of the
+	// instances of that chunk is returned.
+	InstanceUsage(ctx context.Context, start, end time.Time, chunkID *string) ([]resource.InstanceUsage, error)
+
+	// ListNodes returns all nodes, including the number of slots
+	// that are still available on them.
+	ListNodes(ctx context.Context) ([]node.Node, error)
+}
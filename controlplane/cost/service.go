@@ -0,0 +1,131 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/authz"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+)
+
+// defaultWindow is used if no start of the window has been requested.
+const defaultWindow = 30 * 24 * time.Hour
+
+type Service interface {
+	// ChunkStats returns the costs of the instances of the chunk that have
+	// been running between start and end. a zero end is now and a zero start
+	// is 30 days before end. only the owner of the chunk is allowed to get
+	// its stats.
+	ChunkStats(ctx context.Context, chunkID string, start, end time.Time) (ChunkStats, error)
+
+	// Report returns the costs of all chunks and nodes between start and end.
+	// the window is defaulted like for ChunkStats. only admins are allowed
+	// to get the report.
+	Report(ctx context.Context, actorID string, start, end time.Time) (Report, error)
+}
+
+type svc struct {
+	repo         Repository
+	access       authz.AccessEvaluator
+	model        Model
+	adminUserIDs []string
+}
+
+func NewService(repo Repository, access authz.AccessEvaluator, model Model, adminUserIDs []string) Service {
+	return &svc{
+		repo:         repo,
+		access:       access,
+		model:        model,
+		adminUserIDs: adminUserIDs,
+	}
+}
+
+func (s *svc) ChunkStats(ctx context.Context, chunkID string, start, end time.Time) (ChunkStats, error) {
+	actorID, ok := ctx.Value(contextkey.ActorID).(string)
+	if !ok {
+		return ChunkStats{}, errors.New("actor_id not found in context")
+	}
+
+	if err := s.access.AccessAuthorized(
+		ctx,
+		authz.WithOwnershipRule(actorID, authz.ChunkResourceDef(chunkID)),
+	); err != nil {
+		return ChunkStats{}, fmt.Errorf("access: %w", err)
+	}
+
+	start, end, err := window(start, end)
+	if err != nil {
+		return ChunkStats{}, err
+	}
+
+	usage, err := s.repo.InstanceUsage(ctx, start, end, &chunkID)
+	if err != nil {
+		return ChunkStats{}, fmt.Errorf("instance usage: %w", err)
+	}
+
+	return s.model.ChunkStats(chunkID, usage, start, end), nil
+}
+
+func (s *svc) Report(ctx context.Context, actorID string, start, end time.Time) (Report, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return Report{}, apierrs.ErrPermissionDenied
+	}
+
+	start, end, err := window(start, end)
+	if err != nil {
+		return Report{}, err
+	}
+
+	usage, err := s.repo.InstanceUsage(ctx, start, end, nil)
+	if err != nil {
+		return Report{}, fmt.Errorf("instance usage: %w", err)
+	}
+
+	nodes, err := s.repo.ListNodes(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("list nodes: %w", err)
+	}
+
+	return s.model.Report(usage, nodes, start, end), nil
+}
+
+// window defaults the requested window and makes sure it does
+// not extend into the future, because no costs are known yet.
+func window(start, end time.Time) (time.Time, time.Time, error) {
+	now := time.Now().UTC()
+	if end.IsZero() || end.After(now) {
+		end = now
+	}
+
+	if start.IsZero() {
+		start = end.Add(-defaultWindow)
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, apierrs.ErrInvalidCostWindow
+	}
+
+	return start.UTC(), end.UTC(), nil
+}
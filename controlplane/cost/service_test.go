@@ -0,0 +1,177 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cost_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	"github.com/spacechunks/explorer/controlplane/cost"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adminID = "admin"
+	chunkID = "chunk"
+)
+
+func TestChunkStats(t *testing.T) {
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		err   error
+		prep  func(*mock.MockCostRepository, *mock.MockAuthzAccessEvaluator)
+	}{
+		{
+			name:  "owner gets stats",
+			start: start,
+			end:   end,
+			prep: func(repo *mock.MockCostRepository, access *mock.MockAuthzAccessEvaluator) {
+				access.EXPECT().
+					AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+					Return(nil)
+
+				repo.EXPECT().
+					InstanceUsage(mocky.Anything, start, end, new(chunkID)).
+					Return([]resource.InstanceUsage{
+						{
+							InstanceID: "ins",
+							ChunkID:    chunkID,
+							ChunkName:  "BedWars",
+							CPUMillis:  1000,
+							NodeSlots:  1,
+							StartedAt:  start,
+						},
+					}, nil)
+			},
+		},
+		{
+			name:  "other users are not allowed",
+			start: start,
+			end:   end,
+			err:   apierrs.ErrPermissionDenied,
+			prep: func(_ *mock.MockCostRepository, access *mock.MockAuthzAccessEvaluator) {
+				access.EXPECT().
+					AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+					Return(apierrs.ErrPermissionDenied)
+			},
+		},
+		{
+			name:  "window has to end after it starts",
+			start: end,
+			end:   start,
+			err:   apierrs.ErrInvalidCostWindow,
+			prep: func(_ *mock.MockCostRepository, access *mock.MockAuthzAccessEvaluator) {
+				access.EXPECT().
+					AccessAuthorized(mocky.Anything, mocky.AnythingOfType("authz.AccessRuleOption")).
+					Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx    = context.WithValue(context.Background(), contextkey.ActorID, "owner")
+				repo   = mock.NewMockCostRepository(t)
+				access = mock.NewMockAuthzAccessEvaluator(t)
+				svc    = cost.NewService(repo, access, model, []string{adminID})
+			)
+
+			tt.prep(repo, access)
+
+			stats, err := svc.ChunkStats(ctx, chunkID, tt.start, tt.end)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, stats.Instances, 1)
+			require.Equal(t, chunkID, stats.Total.ChunkID)
+			require.Equal(t, "BedWars", stats.Total.ChunkName)
+			require.InDelta(t, 24*(0.04+0.2), stats.Total.Cost.Total(), 1e-9)
+		})
+	}
+}
+
+func TestReportWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID string
+		err     error
+		prep    func(*mock.MockCostRepository)
+	}{
+		{
+			name:    "admin gets the last 30 days by default",
+			actorID: adminID,
+			prep: func(repo *mock.MockCostRepository) {
+				repo.EXPECT().
+					InstanceUsage(
+						mocky.Anything,
+						mocky.MatchedBy(func(s time.Time) bool {
+							return time.Since(s) > 30*24*time.Hour-time.Minute
+						}),
+						mocky.MatchedBy(func(e time.Time) bool {
+							return time.Since(e) < time.Minute
+						}),
+						(*string)(nil),
+					).
+					Return(nil, nil)
+				repo.EXPECT().ListNodes(mocky.Anything).Return([]node.Node{{ID: "node"}}, nil)
+			},
+		},
+		{
+			name:    "other users are not allowed",
+			actorID: "user",
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockCostRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockCostRepository(t)
+				svc  = cost.NewService(repo, mock.NewMockAuthzAccessEvaluator(t), model, []string{adminID})
+			)
+
+			tt.prep(repo)
+
+			report, err := svc.Report(ctx, tt.actorID, time.Time{}, time.Time{})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, report.Nodes, 1)
+			require.Equal(t, 30*24*time.Hour, report.End.Sub(report.Start))
+		})
+	}
+}
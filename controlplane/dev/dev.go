@@ -0,0 +1,163 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package dev contains helpers for running the control plane in dev mode.
+// In dev mode the control plane does not depend on any external services,
+// so contributors can run end-to-end flows on their machine.
+package dev
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+const (
+	UserEmail    = "dev@explorer.local"
+	UserNickname = "dev"
+
+	// APITokenExpiry is the expiry of the api token printed on startup.
+	// it is long-lived, so it does not have to be copied after every
+	// restart.
+	APITokenExpiry = 30 * 24 * time.Hour
+)
+
+// MinecraftVersions are seeded into the database, so flavors can be
+// created right away. the image url is never pulled, because builds
+// are stubbed in dev mode.
+var MinecraftVersions = []string{"1.21.10"}
+
+type Repository interface {
+	GetUserByEmail(ctx context.Context, email string) (resource.User, error)
+	CreateUser(ctx context.Context, user resource.User) (resource.User, error)
+	CreateMinecraftVersion(ctx context.Context, version resource.MinecraftVersion) error
+}
+
+// StartPostgres starts an embedded postgres server storing its data in
+// dataDir. data is kept between restarts. the binaries are downloaded on
+// first start and cached in the users home directory.
+func StartPostgres(dataDir string, port uint32, logs io.Writer) (string, func() error, error) {
+	var (
+		pgDir = filepath.Join(dataDir, "postgres")
+		cfg   = embeddedpostgres.DefaultConfig().
+			Port(port).
+			Username("explorer").
+			Password("explorer").
+			Database("explorer").
+			DataPath(filepath.Join(pgDir, "data")).
+			RuntimePath(filepath.Join(pgDir, "runtime")).
+			Logger(logs)
+		pg = embeddedpostgres.NewDatabase(cfg)
+	)
+
+	if err := pg.Start(); err != nil {
+		return "", nil, fmt.Errorf("start postgres: %w", err)
+	}
+
+	return cfg.GetConnectionURL() + "?sslmode=disable", pg.Stop, nil
+}
+
+// SigningKey returns the pem encoded api token signing key stored in
+// dataDir. if there is none, a new key will be generated and written
+// to dataDir, so issued tokens stay valid across restarts.
+func SigningKey(dataDir string) (string, error) {
+	path := filepath.Join(dataDir, "signing-key.pem")
+
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return string(data), nil
+	}
+
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("read key: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("generate key: %w", err)
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", fmt.Errorf("marshal key: %w", err)
+	}
+
+	data = pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: der,
+	})
+
+	if err := os.MkdirAll(dataDir, 0o700); err != nil {
+		return "", fmt.Errorf("create data dir: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return "", fmt.Errorf("write key: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// Seed creates the dev user as well as the supported minecraft
+// versions if they do not exist yet and returns the dev user.
+func Seed(ctx context.Context, repo Repository) (resource.User, error) {
+	for _, v := range MinecraftVersions {
+		if err := repo.CreateMinecraftVersion(ctx, resource.MinecraftVersion{
+			Version:  v,
+			ImageURL: "dev.local/paper:" + v,
+			JavaImageURLs: map[uint32]string{
+				17: "dev.local/paper:" + v + "-java17",
+				21: "dev.local/paper:" + v + "-java21",
+			},
+		}); err != nil {
+			return resource.User{}, fmt.Errorf("create minecraft version: %w", err)
+		}
+	}
+
+	u, err := repo.GetUserByEmail(ctx, UserEmail)
+	if err == nil {
+		return u, nil
+	}
+
+	if !errors.Is(err, apierrs.ErrNotFound) {
+		return resource.User{}, fmt.Errorf("get user: %w", err)
+	}
+
+	u, err = repo.CreateUser(ctx, resource.User{
+		Nickname: UserNickname,
+		Email:    UserEmail,
+	})
+	if err != nil {
+		return resource.User{}, fmt.Errorf("create user: %w", err)
+	}
+
+	return u, nil
+}
@@ -0,0 +1,46 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package dev_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/dev"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningKeyIsReused(t *testing.T) {
+	dir := t.TempDir()
+
+	first, err := dev.SigningKey(dir)
+	require.NoError(t, err)
+
+	block, _ := pem.Decode([]byte(first))
+	require.NotNil(t, block)
+
+	_, err = x509.ParseECPrivateKey(block.Bytes)
+	require.NoError(t, err)
+
+	second, err := dev.SigningKey(dir)
+	require.NoError(t, err)
+
+	require.Equal(t, first, second)
+}
@@ -55,6 +55,7 @@ var (
 
 var (
 	ErrChunkNotFound              = New(codes.NotFound, "chunk does not exist")
+	ErrChunkNameTaken             = New(codes.AlreadyExists, "chunk name is already taken")
 	ErrTooManyTags                = New(codes.InvalidArgument, "too many tags")
 	ErrNameTooLong                = New(codes.InvalidArgument, "name is too long")
 	ErrDescriptionTooLong         = New(codes.InvalidArgument, "description is too long")
@@ -63,6 +64,13 @@ var (
 	ErrInvalidThumbnailFormat     = New(codes.InvalidArgument, "thumbnail image must be png")
 	ErrInvalidThumbnailDimensions = New(codes.InvalidArgument, "thumbnail must be 512x512 pixels")
 	ErrInvalidThumbnailSize       = New(codes.InvalidArgument, "thumbnail size too big")
+	ErrReadmeTooBig               = New(codes.InvalidArgument, "readme size exceeds maximum allowed")
+	ErrMediaTooBig                = New(codes.InvalidArgument, "media size exceeds maximum allowed")
+	ErrUnsupportedMediaType       = New(codes.InvalidArgument, "media must be a png, jpeg or webp image")
+	ErrTooManyMedia               = New(codes.ResourceExhausted, "chunk has the maximum number of media")
+	ErrMediaNotFound              = New(codes.NotFound, "media does not exist")
+	ErrTemplateNotFound           = New(codes.NotFound, "template does not exist")
+	ErrTemplateNameExists         = New(codes.AlreadyExists, "template name already exists")
 )
 
 /*
@@ -78,13 +86,85 @@ var (
 	ErrFlavorFilesNotUploaded       = New(codes.FailedPrecondition, "flavor files have not been uploaded")
 	ErrFlavorFilesUploaded          = New(codes.AlreadyExists, "flavor files have already been uploaded")
 	ErrChangeSetTarballTooBig       = New(codes.InvalidArgument, "tarball size exceeds maximum allowed")
+	ErrPatchTooBig                  = New(codes.InvalidArgument, "patch exceeds maximum allowed files or size")
+	ErrPatchFileMismatch            = New(codes.InvalidArgument, "patch file is not part of the flavor version")
+	ErrAssetTooBig                  = New(codes.InvalidArgument, "asset size exceeds maximum allowed")
+	ErrAssetNotFound                = New(codes.FailedPrecondition, "asset does not exist")
+	ErrAssetNotUploaded             = New(codes.FailedPrecondition, "asset has not been uploaded")
+	ErrCanaryActive                 = New(codes.AlreadyExists, "flavor already has an active canary")
+	ErrNoActiveCanary               = New(codes.FailedPrecondition, "flavor has no active canary")
+	ErrInvalidCanaryVersions        = New(codes.InvalidArgument, "canary and stable must be different versions of the same flavor")
+	ErrFlavorVersionNotBuilt        = New(codes.FailedPrecondition, "flavor version has not been built")
+	ErrFileNotFound                 = New(codes.NotFound, "file is not part of the flavor version")
+	ErrInvalidAutoscalingPolicy     = New(codes.InvalidArgument, "max instances must be between min instances and the limit")
+	ErrFlavorChannelNotFound        = New(codes.NotFound, "no flavor version has been published to the channel")
+	ErrImportArchiveTooBig          = New(codes.InvalidArgument, "archive size exceeds maximum allowed")
+	ErrImportArchiveNotUploaded     = New(codes.FailedPrecondition, "archive has not been uploaded")
+	ErrCrossInstanceTrafficMissing  = New(codes.NotFound, "chunk has not requested cross-instance traffic")
+	ErrJavaVersionNotSupported      = New(codes.FailedPrecondition, "java version is not available for the minecraft version")
+	ErrFlavorVersionConflict        = New(codes.Aborted, "flavor has been changed concurrently, please retry")
+	ErrStartupCommandNotAllowed     = New(codes.InvalidArgument, "startup command is not allowed")
+
+	// ErrAlreadyBuilding is returned if a build of the flavor version
+	// is being initiated concurrently, e.g. because a request has been
+	// retried. the reason allows clients to tell it apart from other
+	// conflicts.
+	ErrAlreadyBuilding = New(
+		codes.Aborted,
+		"flavor version is already being built",
+		&errdetails.ErrorInfo{Reason: "ALREADY_BUILDING"},
+	)
+
+	// ErrAlreadyApplying is returned if a spec is applied to
+	// the same chunk concurrently, e.g. by two pipeline runs.
+	ErrAlreadyApplying = New(
+		codes.Aborted,
+		"a chunk spec is already being applied to the chunk",
+		&errdetails.ErrorInfo{Reason: "ALREADY_APPLYING"},
+	)
 )
 
+// InvalidChunkSpec is returned if the given field of a chunk spec
+// is inconsistent, e.g. refers to a version that does not exist.
+func InvalidChunkSpec(field string, description string) Error {
+	return New(codes.InvalidArgument, "invalid chunk spec", &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       field,
+				Description: description,
+				Reason:      "INVALID_CHUNK_SPEC",
+			},
+		},
+	})
+}
+
+// BuildLimitsExceeded is returned if a flavor version exceeds the limits
+// of the build process. each violation describes one exceeded limit.
+func BuildLimitsExceeded(violations ...string) Error {
+	details := make([]*errdetails.PreconditionFailure_Violation, 0, len(violations))
+	for _, v := range violations {
+		details = append(details, &errdetails.PreconditionFailure_Violation{
+			Type:        "BUILD_LIMITS",
+			Description: v,
+		})
+	}
+
+	return New(codes.FailedPrecondition, "flavor version exceeds build limits", &errdetails.PreconditionFailure{
+		Violations: details,
+	})
+}
+
 /*
  * user related errors
  */
 var (
 	ErrPrivacyPolicyNotAccepted = New(codes.FailedPrecondition, "privacy policy not accepted")
+	ErrIdentityProviderMissing  = New(codes.Unimplemented, "no identity provider configured")
+	ErrTokenNotRevocable        = New(codes.FailedPrecondition, "token has no id and cannot be revoked")
+	ErrUserNotFound             = New(codes.NotFound, "user does not exist")
+	ErrTransferToMissing        = New(codes.InvalidArgument, "user to transfer chunks to is missing")
+	ErrTransferToDeletedUser    = New(codes.InvalidArgument, "chunks cannot be transferred to the deleted user")
+	ErrImpersonationTooLong     = New(codes.InvalidArgument, "impersonation duration exceeds the maximum allowed")
 )
 
 type InvalidPathViolation struct {
@@ -121,6 +201,114 @@ var (
 	ErrInstanceNotFound  = New(codes.NotFound, "instance not found")
 	ErrNodeKeyMissing    = New(codes.InvalidArgument, "node key is missing")
 	ErrNoSlotsAvailable  = New(codes.ResourceExhausted, "no slots available on any node")
+	ErrNoBuilderOnline   = New(codes.Unavailable, "no builder node online")
+	ErrPlacementRejected = New(codes.FailedPrecondition, "all nodes have been rejected by the placement policy")
+
+	ErrInvalidStateTransition = New(
+		codes.FailedPrecondition,
+		"instance cannot be moved to the requested state",
+	)
+
+	ErrInstanceNotRunning     = New(codes.FailedPrecondition, "instance is not running")
+	ErrInstanceInviteNotFound = New(codes.NotFound, "invite not found")
+	ErrSnapshotTooBig         = New(codes.FailedPrecondition, "snapshot exceeds maximum allowed size")
+
+	ErrInvalidCustomParams = New(codes.InvalidArgument, "custom instance params must be valid json")
+)
+
+/*
+ * schedule related errors
+ */
+
+var (
+	ErrScheduleNotFound = New(codes.NotFound, "schedule does not exist")
+	ErrTooManySchedules = New(codes.ResourceExhausted, "chunk has the maximum number of schedules")
+)
+
+// InvalidSchedule is returned if the given field of a schedule,
+// like one of its cron expressions, cannot be parsed.
+func InvalidSchedule(field string, err error) Error {
+	return New(codes.InvalidArgument, "invalid schedule", &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       field,
+				Description: err.Error(),
+				Reason:      "INVALID_SCHEDULE",
+			},
+		},
+	})
+}
+
+/*
+ * maintenance related errors
+ */
+
+// MaintenanceMode is returned for requests that are rejected, because the
+// platform is under maintenance. the message set by the admin is appended.
+func MaintenanceMode(message string) Error {
+	msg := "the platform is under maintenance, please try again later"
+	if message != "" {
+		msg += ": " + message
+	}
+	return New(codes.Unavailable, msg)
+}
+
+/*
+ * runtime settings related errors
+ */
+
+var ErrUnknownFeatureFlag = New(codes.InvalidArgument, "feature flag does not exist")
+
+// InvalidLogLevels is returned if the log levels set
+// by an admin are not in the format of the log-level flag.
+func InvalidLogLevels(err error) Error {
+	return New(codes.InvalidArgument, "invalid log levels", &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       "log_levels",
+				Description: err.Error(),
+				Reason:      "INVALID_LOG_LEVELS",
+			},
+		},
+	})
+}
+
+/*
+ * rollout related errors
+ */
+
+var (
+	ErrNodeNotFound             = New(codes.NotFound, "node does not exist")
+	ErrPlatformdUpdatesDisabled = New(codes.FailedPrecondition, "no platformd release url has been configured")
+	ErrInvalidUpdateStatus      = New(
+		codes.InvalidArgument,
+		"nodes can only report that they are downloading or installing an update, or that it failed",
+	)
+)
+
+/*
+ * announcement related errors
+ */
+
+var ErrInvalidAnnouncementTimes = New(codes.InvalidArgument, "announcement has to end after it starts")
+
+/*
+ * cost related errors
+ */
+
+var ErrInvalidCostWindow = New(codes.InvalidArgument, "cost window has to end after it starts")
+
+/*
+ * integration related errors
+ */
+
+var (
+	ErrNoJoinableInstance = New(codes.NotFound, "no running instance with room for another player")
+	ErrChunkNameAmbiguous = New(codes.FailedPrecondition, "running instances of multiple chunks with this name exist")
+
+	// ErrInstanceInviteInvalid is returned for unknown, expired, revoked and
+	// used up invites alike, so tokens cannot be probed.
+	ErrInstanceInviteInvalid = New(codes.PermissionDenied, "invite is not valid")
 )
 
 type Error struct {
@@ -0,0 +1,88 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package event provides an in-process bus that components can use
+// to get notified about changes made by other components, for example
+// to invalidate cached data.
+package event
+
+import (
+	"sync"
+)
+
+type Type string
+
+const (
+	TypeChunkChanged         Type = "CHUNK_CHANGED"
+	TypeFlavorChanged        Type = "FLAVOR_CHANGED"
+	TypeFlavorVersionChanged Type = "FLAVOR_VERSION_CHANGED"
+	TypeInstancesChanged     Type = "INSTANCES_CHANGED"
+)
+
+// Event describes a change to a resource. depending on the type
+// only some of the ids are set, e.g. TypeFlavorVersionChanged only
+// carries the id of the flavor version. TypeInstancesChanged carries
+// the id of the node the instances are scheduled to, or no id at all
+// if the node is not known.
+type Event struct {
+	Type            Type
+	ChunkID         string
+	FlavorID        string
+	FlavorVersionID string
+	NodeID          string
+}
+
+type Bus struct {
+	mu     sync.RWMutex
+	nextID int
+	subs   map[int]func(Event)
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		subs: make(map[int]func(Event)),
+	}
+}
+
+// Publish synchronously calls all subscribers with the given event.
+// subscribers must not block and must not publish events themselves.
+func (b *Bus) Publish(ev Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, fn := range b.subs {
+		fn(ev)
+	}
+}
+
+// Subscribe registers fn to be called for every published event.
+// calling the returned function removes the subscription.
+func (b *Bus) Subscribe(fn func(Event)) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	b.subs[id] = fn
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs, id)
+	}
+}
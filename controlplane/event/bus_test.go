@@ -0,0 +1,53 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package event_test
+
+import (
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBusDeliversToSubscribers(t *testing.T) {
+	var (
+		bus      = event.NewBus()
+		first    []event.Event
+		second   []event.Event
+		expected = event.Event{
+			Type:    event.TypeChunkChanged,
+			ChunkID: "chunk-id",
+		}
+	)
+
+	unsubFirst := bus.Subscribe(func(ev event.Event) {
+		first = append(first, ev)
+	})
+
+	bus.Subscribe(func(ev event.Event) {
+		second = append(second, ev)
+	})
+
+	bus.Publish(expected)
+	unsubFirst()
+	bus.Publish(expected)
+
+	require.Equal(t, []event.Event{expected}, first)
+	require.Equal(t, []event.Event{expected, expected}, second)
+}
@@ -0,0 +1,201 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/resource"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// EventsPath is the path instance state changes are streamed on
+// using server-sent events.
+const EventsPath = "/v1alpha1/instances/events"
+
+// DefaultEventsHeartbeatInterval is used if EventsConfig.HeartbeatInterval is not set.
+const DefaultEventsHeartbeatInterval = 15 * time.Second
+
+// Authenticator validates the given api token and returns
+// the id of the user it has been issued to.
+type Authenticator func(ctx context.Context, token string) (string, error)
+
+type EventsConfig struct {
+	// HeartbeatInterval is the interval in which comments are sent while
+	// nothing changed, so proxies do not close idle connections and clients
+	// notice broken ones. defaults to DefaultEventsHeartbeatInterval.
+	HeartbeatInterval time.Duration
+
+	// AllowedOrigins are the origins of browser dashboards that are allowed
+	// to connect from another origin.
+	AllowedOrigins []string
+}
+
+// EventsHandler streams the instances of the authenticated user as
+// server-sent events, so browsers can watch them without grpc-web.
+//
+// every event contains a json encoded WatchInstancesResponse, its id is the
+// revision of the contained instances. clients that reconnect with the
+// Last-Event-ID header only receive the instances that changed afterward.
+// since the EventSource api of browsers cannot set headers, the api token
+// can also be passed using the token query parameter.
+func EventsHandler(logger *slog.Logger, service Service, auth Authenticator, cfg EventsConfig) http.Handler {
+	if cfg.HeartbeatInterval <= 0 {
+		cfg.HeartbeatInterval = DefaultEventsHeartbeatInterval
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("OPTIONS "+EventsPath, func(w http.ResponseWriter, req *http.Request) {
+		if !allowOrigin(w, req, cfg.AllowedOrigins) {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Methods", "GET")
+		w.Header().Set("Access-Control-Allow-Headers", "Authorization, Last-Event-ID")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET "+EventsPath, func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+
+		if !allowOrigin(w, req, cfg.AllowedOrigins) {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+
+		token := req.Header.Get("Authorization")
+		if token == "" {
+			token = req.URL.Query().Get("token")
+		}
+
+		if token == "" {
+			http.Error(w, "authorization header is missing", http.StatusUnauthorized)
+			return
+		}
+
+		userID, err := auth(ctx, token)
+		if err != nil {
+			logger.DebugContext(ctx, "failed to authenticate", "err", err)
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+
+		var afterRevision int64
+		if id := req.Header.Get("Last-Event-ID"); id != "" {
+			afterRevision, err = strconv.ParseInt(id, 10, 64)
+			if err != nil || afterRevision < 0 {
+				http.Error(w, "invalid last event id", http.StatusBadRequest)
+				return
+			}
+		}
+
+		var (
+			rc = http.NewResponseController(w)
+			mu sync.Mutex
+		)
+
+		// sending events and heartbeats happens concurrently
+		write := func(msg string) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if _, err := w.Write([]byte(msg)); err != nil {
+				return err
+			}
+			return rc.Flush()
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		// disables response buffering of nginx based proxies
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		if err := write(": connected\n\n"); err != nil {
+			return
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		go func() {
+			ticker := time.NewTicker(cfg.HeartbeatInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if err := write(": heartbeat\n\n"); err != nil {
+						cancel()
+						return
+					}
+				}
+			}
+		}()
+
+		send := func(instances []resource.Instance, revision int64, full bool) error {
+			data, err := protojson.Marshal(&instancev1alpha1.WatchInstancesResponse{
+				Instances: instancesToTransport(instances),
+				Revision:  strconv.FormatInt(revision, 10),
+				Full:      full,
+			})
+			if err != nil {
+				return fmt.Errorf("marshal: %w", err)
+			}
+
+			return write(fmt.Sprintf("id: %d\nevent: instances\ndata: %s\n\n", revision, data))
+		}
+
+		if err := service.WatchOwnInstances(ctx, userID, afterRevision, send); err != nil {
+			logger.ErrorContext(ctx, "failed to watch instances", "user_id", userID, "err", err)
+		}
+	})
+	return mux
+}
+
+// allowOrigin sets the cors headers if the origin of the request is allowed.
+// requests without origin are made from the same origin or by non-browser
+// clients and are always allowed.
+func allowOrigin(w http.ResponseWriter, req *http.Request, allowed []string) bool {
+	origin := req.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	if !slices.ContainsFunc(allowed, func(o string) bool {
+		return o == "*" || strings.EqualFold(o, origin)
+	}) {
+		return false
+	}
+
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Vary", "Origin")
+	return true
+}
@@ -0,0 +1,205 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestEventsHandler(t *testing.T) {
+	const (
+		ownerID = "owner-id"
+		token   = "valid-token"
+	)
+
+	ins := fixture.Instance()
+
+	tests := []struct {
+		name        string
+		header      http.Header
+		query       string
+		status      int
+		prep        func(*mock.MockInstanceRepository)
+		expectedID  string
+		expectedAll bool
+	}{
+		{
+			name:   "missing token",
+			status: http.StatusUnauthorized,
+			prep:   func(*mock.MockInstanceRepository) {},
+		},
+		{
+			name:   "invalid token",
+			header: http.Header{"Authorization": {"invalid"}},
+			status: http.StatusUnauthorized,
+			prep:   func(*mock.MockInstanceRepository) {},
+		},
+		{
+			name:   "origin not allowed",
+			header: http.Header{"Authorization": {token}, "Origin": {"https://evil.example"}},
+			status: http.StatusForbidden,
+			prep:   func(*mock.MockInstanceRepository) {},
+		},
+		{
+			name:   "streams all instances",
+			header: http.Header{"Authorization": {token}, "Origin": {"https://dashboard.example"}},
+			status: http.StatusOK,
+			prep: func(repo *mock.MockInstanceRepository) {
+				repo.EXPECT().
+					GetInstancesByOwnerID(mocky.Anything, ownerID, int64(0)).
+					Return([]resource.Instance{ins}, 3, nil)
+			},
+			expectedID:  "3",
+			expectedAll: true,
+		},
+		{
+			name:   "resumes from last event id using token query parameter",
+			header: http.Header{"Last-Event-ID": {"3"}},
+			query:  "?token=" + token,
+			status: http.StatusOK,
+			prep: func(repo *mock.MockInstanceRepository) {
+				repo.EXPECT().
+					GetInstancesByOwnerID(mocky.Anything, ownerID, int64(3)).
+					Return([]resource.Instance{ins}, 4, nil)
+			},
+			expectedID: "4",
+		},
+		{
+			name:   "invalid last event id",
+			header: http.Header{"Authorization": {token}, "Last-Event-ID": {"abc"}},
+			status: http.StatusBadRequest,
+			prep:   func(*mock.MockInstanceRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx, cancel = context.WithCancel(context.Background())
+				repo        = mock.NewMockInstanceRepository(t)
+				auth        = func(_ context.Context, tok string) (string, error) {
+					if tok != token {
+						return "", errors.New("invalid token")
+					}
+					return ownerID, nil
+				}
+			)
+			defer cancel()
+
+			tt.prep(repo)
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				repo,
+				mock.NewMockNodeRepository(t),
+				mock.NewMockChunkRepository(t),
+				nil,
+				event.NewBus(),
+				nil,
+				instance.Config{
+					WatchResyncInterval: time.Hour,
+				},
+			)
+			require.NoError(t, err)
+
+			srv := httptest.NewServer(instance.EventsHandler(
+				slog.New(slog.DiscardHandler),
+				svc,
+				auth,
+				instance.EventsConfig{
+					AllowedOrigins: []string{"https://dashboard.example"},
+				},
+			))
+			defer srv.Close()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+instance.EventsPath+tt.query, nil)
+			require.NoError(t, err)
+
+			for k, v := range tt.header {
+				req.Header[k] = v
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			require.Equal(t, tt.status, resp.StatusCode)
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+			require.Equal(t, tt.header.Get("Origin"), resp.Header.Get("Access-Control-Allow-Origin"))
+
+			fields := readEvent(t, bufio.NewScanner(resp.Body))
+			require.Equal(t, tt.expectedID, fields["id"])
+			require.Equal(t, "instances", fields["event"])
+
+			var msg instancev1alpha1.WatchInstancesResponse
+			require.NoError(t, protojson.Unmarshal([]byte(fields["data"]), &msg))
+			require.Equal(t, tt.expectedAll, msg.GetFull())
+			require.Equal(t, tt.expectedID, msg.GetRevision())
+			require.Len(t, msg.GetInstances(), 1)
+			require.Equal(t, ins.ID, msg.GetInstances()[0].GetId())
+		})
+	}
+}
+
+// readEvent returns the fields of the next event, skipping comments.
+func readEvent(t *testing.T, scanner *bufio.Scanner) map[string]string {
+	fields := make(map[string]string)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if len(fields) > 0 {
+				return fields
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		k, v, _ := strings.Cut(line, ": ")
+		fields[k] = v
+	}
+
+	require.NoError(t, scanner.Err())
+	t.Fatal("stream ended before an event was received")
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) CreateInstanceInvite(
+	ctx context.Context,
+	instanceID string,
+	actorID string,
+	maxUses uint32,
+	validFor time.Duration,
+) (resource.InstanceInvite, error) {
+	if err := s.authorizeInstance(ctx, instanceID, actorID); err != nil {
+		return resource.InstanceInvite{}, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return resource.InstanceInvite{}, fmt.Errorf("invite id: %w", err)
+	}
+
+	invite, err := s.insRepo.CreateInstanceInvite(ctx, resource.InstanceInvite{
+		ID:         id.String(),
+		InstanceID: instanceID,
+		Token:      rand.Text(),
+		MaxUses:    maxUses,
+		CreatedBy:  actorID,
+		ExpiresAt:  time.Now().Add(validFor),
+	})
+	if err != nil {
+		return resource.InstanceInvite{}, fmt.Errorf("create instance invite: %w", err)
+	}
+
+	return invite, nil
+}
+
+func (s *svc) ListInstanceInvites(
+	ctx context.Context,
+	instanceID string,
+	actorID string,
+) ([]resource.InstanceInvite, error) {
+	if err := s.authorizeInstance(ctx, instanceID, actorID); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.insRepo.InstanceInvites(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("instance invites: %w", err)
+	}
+
+	return invites, nil
+}
+
+func (s *svc) RevokeInstanceInvite(ctx context.Context, inviteID string, actorID string) error {
+	invite, err := s.insRepo.InstanceInviteByID(ctx, inviteID)
+	if err != nil {
+		return fmt.Errorf("instance invite: %w", err)
+	}
+
+	if err := s.authorizeInstance(ctx, invite.InstanceID, actorID); err != nil {
+		return err
+	}
+
+	if err := s.insRepo.RevokeInstanceInvite(ctx, inviteID); err != nil {
+		return fmt.Errorf("revoke instance invite: %w", err)
+	}
+
+	return nil
+}
+
+// authorizeInstance returns ErrPermissionDenied if the actor is not the owner of the instance.
+func (s *svc) authorizeInstance(ctx context.Context, instanceID string, actorID string) error {
+	ins, err := s.insRepo.GetInstanceByID(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("get instance: %w", err)
+	}
+
+	if ins.Owner.ID != actorID {
+		return apierrs.ErrPermissionDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateInstanceInvite(t *testing.T) {
+	ins := fixture.Instance()
+
+	tests := []struct {
+		name    string
+		actorID string
+		err     error
+	}{
+		{
+			name:    "owner creates invite",
+			actorID: ins.Owner.ID,
+		},
+		{
+			name:    "only owner can create invites",
+			actorID: "someone-else",
+			err:     apierrs.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx     = context.Background()
+				insRepo = mock.NewMockInstanceRepository(t)
+				start   = time.Now()
+			)
+
+			insRepo.EXPECT().GetInstanceByID(mocky.Anything, ins.ID).Return(ins, nil)
+
+			if tt.err == nil {
+				insRepo.EXPECT().
+					CreateInstanceInvite(mocky.Anything, mocky.Anything).
+					RunAndReturn(func(_ context.Context, i resource.InstanceInvite) (resource.InstanceInvite, error) {
+						require.NotEmpty(t, i.ID)
+						require.NotEmpty(t, i.Token)
+						require.Equal(t, ins.ID, i.InstanceID)
+						require.Equal(t, uint32(5), i.MaxUses)
+						require.Equal(t, tt.actorID, i.CreatedBy)
+						require.WithinRange(t, i.ExpiresAt, start.Add(time.Hour), time.Now().Add(time.Hour))
+						return i, nil
+					})
+			}
+
+			svc := newInviteService(t, insRepo)
+
+			invite, err := svc.CreateInstanceInvite(ctx, ins.ID, tt.actorID, 5, time.Hour)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEmpty(t, invite.Token)
+		})
+	}
+}
+
+func TestRevokeInstanceInvite(t *testing.T) {
+	var (
+		ins    = fixture.Instance()
+		invite = resource.InstanceInvite{
+			ID:         "019532a0-0000-7000-8000-000000000001",
+			InstanceID: ins.ID,
+		}
+	)
+
+	tests := []struct {
+		name    string
+		actorID string
+		err     error
+	}{
+		{
+			name:    "owner revokes invite",
+			actorID: ins.Owner.ID,
+		},
+		{
+			name:    "only owner can revoke invites",
+			actorID: "someone-else",
+			err:     apierrs.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx     = context.Background()
+				insRepo = mock.NewMockInstanceRepository(t)
+			)
+
+			insRepo.EXPECT().InstanceInviteByID(mocky.Anything, invite.ID).Return(invite, nil)
+			insRepo.EXPECT().GetInstanceByID(mocky.Anything, ins.ID).Return(ins, nil)
+
+			if tt.err == nil {
+				insRepo.EXPECT().RevokeInstanceInvite(mocky.Anything, invite.ID).Return(nil)
+			}
+
+			svc := newInviteService(t, insRepo)
+
+			err := svc.RevokeInstanceInvite(ctx, invite.ID, tt.actorID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func newInviteService(t *testing.T, insRepo *mock.MockInstanceRepository) instance.Service {
+	svc, err := instance.NewService(
+		slog.New(slog.DiscardHandler),
+		insRepo,
+		mock.NewMockNodeRepository(t),
+		mock.NewMockChunkRepository(t),
+		mock.NewMockBlobStore(t),
+		event.NewBus(),
+		nil,
+		instance.Config{},
+	)
+	require.NoError(t, err)
+	return svc
+}
@@ -0,0 +1,153 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (s *svc) ReceiveInstanceLogs(ctx context.Context, batches []resource.InstanceLogBatch) error {
+	for _, b := range batches {
+		if len(b.Lines) == 0 {
+			continue
+		}
+
+		ownerID, ok, err := s.logOwner(ctx, b.InstanceID)
+		if err != nil {
+			return fmt.Errorf("log owner: %w", err)
+		}
+
+		// instances are removed shortly after they have been deleted by their
+		// node. lines logged by an instance without any shipped logs left at that
+		// point cannot be attributed to anyone anymore, so they are dropped.
+		if !ok {
+			s.logger.DebugContext(ctx, "dropping logs of unknown instance", "instance_id", b.InstanceID)
+			continue
+		}
+
+		logID, err := uuid.NewV7()
+		if err != nil {
+			return fmt.Errorf("log id: %w", err)
+		}
+
+		var buf bytes.Buffer
+		for _, l := range b.Lines {
+			fmt.Fprintf(&buf, "%s %s %s\n", l.Time.UTC().Format(time.RFC3339Nano), l.Stream, l.Message)
+		}
+
+		var (
+			key  = blob.InstanceLogKey(b.InstanceID, logID.String())
+			size = uint64(buf.Len())
+		)
+
+		if err := s.blobStore.SimplePut(ctx, key, &buf, nil); err != nil {
+			return fmt.Errorf("put log: %w", err)
+		}
+
+		if err := s.insRepo.InsertInstanceLog(ctx, resource.InstanceLog{
+			ID:          logID.String(),
+			InstanceID:  b.InstanceID,
+			OwnerID:     ownerID,
+			ObjectKey:   key,
+			SizeBytes:   size,
+			FirstLineAt: b.Lines[0].Time,
+			LastLineAt:  b.Lines[len(b.Lines)-1].Time,
+			ExpiresAt:   time.Now().Add(s.cfg.InstanceLogRetention),
+		}); err != nil {
+			return fmt.Errorf("insert instance log: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *svc) GetInstanceLogs(
+	ctx context.Context,
+	instanceID string,
+	actorID string,
+) ([]resource.InstanceLog, error) {
+	logs, err := s.insRepo.InstanceLogs(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("instance logs: %w", err)
+	}
+
+	if len(logs) == 0 {
+		// check the owner of the instance, so callers cannot
+		// tell apart instances of others without any logs.
+		ins, err := s.insRepo.GetInstanceByID(ctx, instanceID)
+		if err != nil {
+			return nil, fmt.Errorf("get instance: %w", err)
+		}
+
+		if ins.Owner.ID != actorID {
+			return nil, apierrs.ErrPermissionDenied
+		}
+
+		return logs, nil
+	}
+
+	if logs[0].OwnerID != actorID {
+		return nil, apierrs.ErrPermissionDenied
+	}
+
+	for i, l := range logs {
+		url, expiresAt, err := s.blobStore.PresignDownloadURL(ctx, l.ObjectKey, s.cfg.PresignedURLExpiry)
+		if err != nil {
+			return nil, fmt.Errorf("presign download url: %w", err)
+		}
+
+		logs[i].DownloadURL = url
+		logs[i].DownloadURLExpiresAt = expiresAt
+	}
+
+	return logs, nil
+}
+
+// logOwner returns the owner of the instance. if the instance does not exist
+// anymore, the owner recorded with its previously shipped logs is returned.
+func (s *svc) logOwner(ctx context.Context, instanceID string) (string, bool, error) {
+	ins, err := s.insRepo.GetInstanceByID(ctx, instanceID)
+	if err == nil {
+		return ins.Owner.ID, true, nil
+	}
+
+	if !errors.Is(err, apierrs.ErrInstanceNotFound) {
+		return "", false, fmt.Errorf("get instance: %w", err)
+	}
+
+	logs, err := s.insRepo.InstanceLogs(ctx, instanceID)
+	if err != nil {
+		return "", false, fmt.Errorf("instance logs: %w", err)
+	}
+
+	if len(logs) == 0 {
+		return "", false, nil
+	}
+
+	return logs[0].OwnerID, true, nil
+}
@@ -0,0 +1,236 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/blob"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiveInstanceLogs(t *testing.T) {
+	var (
+		ins = fixture.Instance()
+		now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		cfg = instance.Config{
+			InstanceLogRetention: time.Hour,
+		}
+		batch = resource.InstanceLogBatch{
+			InstanceID: ins.ID,
+			Lines: []resource.InstanceLogLine{
+				{Time: now, Stream: "stdout", Message: "Starting minecraft server"},
+				{Time: now.Add(time.Second), Stream: "stderr", Message: "Done"},
+			},
+		}
+		expectedLog = "2026-01-01T12:00:00Z stdout Starting minecraft server\n" +
+			"2026-01-01T12:00:01Z stderr Done\n"
+	)
+
+	tests := []struct {
+		name    string
+		prepare func(*mock.MockInstanceRepository)
+		ownerID string
+	}{
+		{
+			name: "owner of the instance is recorded",
+			prepare: func(insRepo *mock.MockInstanceRepository) {
+				insRepo.EXPECT().GetInstanceByID(mocky.Anything, ins.ID).Return(ins, nil)
+			},
+			ownerID: ins.Owner.ID,
+		},
+		{
+			name: "owner of previous logs is used if instance is gone",
+			prepare: func(insRepo *mock.MockInstanceRepository) {
+				insRepo.EXPECT().
+					GetInstanceByID(mocky.Anything, ins.ID).
+					Return(resource.Instance{}, apierrs.ErrInstanceNotFound)
+				insRepo.EXPECT().
+					InstanceLogs(mocky.Anything, ins.ID).
+					Return([]resource.InstanceLog{{OwnerID: "previous-owner"}}, nil)
+			},
+			ownerID: "previous-owner",
+		},
+		{
+			name: "logs of unknown instances are dropped",
+			prepare: func(insRepo *mock.MockInstanceRepository) {
+				insRepo.EXPECT().
+					GetInstanceByID(mocky.Anything, ins.ID).
+					Return(resource.Instance{}, apierrs.ErrInstanceNotFound)
+				insRepo.EXPECT().
+					InstanceLogs(mocky.Anything, ins.ID).
+					Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				insRepo   = mock.NewMockInstanceRepository(t)
+				mockStore = mock.NewMockBlobStore(t)
+				putKey    string
+			)
+
+			tt.prepare(insRepo)
+
+			if tt.ownerID != "" {
+				mockStore.EXPECT().
+					SimplePut(mocky.Anything, mocky.Anything, mocky.Anything, mocky.Anything).
+					RunAndReturn(func(_ context.Context, key string, r io.Reader, _ map[string]string) error {
+						putKey = key
+						data, err := io.ReadAll(r)
+						require.NoError(t, err)
+						require.Equal(t, expectedLog, string(data))
+						return nil
+					})
+
+				insRepo.EXPECT().
+					InsertInstanceLog(mocky.Anything, mocky.Anything).
+					RunAndReturn(func(_ context.Context, l resource.InstanceLog) error {
+						require.Equal(t, ins.ID, l.InstanceID)
+						require.Equal(t, tt.ownerID, l.OwnerID)
+						require.Equal(t, blob.InstanceLogKey(ins.ID, l.ID), l.ObjectKey)
+						require.Equal(t, putKey, l.ObjectKey)
+						require.Equal(t, uint64(len(expectedLog)), l.SizeBytes)
+						require.Equal(t, now, l.FirstLineAt)
+						require.Equal(t, now.Add(time.Second), l.LastLineAt)
+						require.WithinDuration(t, time.Now().Add(cfg.InstanceLogRetention), l.ExpiresAt, time.Minute)
+						return nil
+					})
+			}
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				mock.NewMockNodeRepository(t),
+				mock.NewMockChunkRepository(t),
+				mockStore,
+				event.NewBus(),
+				nil,
+				cfg,
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, svc.ReceiveInstanceLogs(ctx, []resource.InstanceLogBatch{batch}))
+		})
+	}
+}
+
+func TestGetInstanceLogs(t *testing.T) {
+	var (
+		ins  = fixture.Instance()
+		cfg  = instance.Config{PresignedURLExpiry: time.Minute}
+		logs = []resource.InstanceLog{
+			{
+				ID:         "log-1",
+				InstanceID: ins.ID,
+				OwnerID:    ins.Owner.ID,
+				ObjectKey:  blob.InstanceLogKey(ins.ID, "log-1"),
+			},
+		}
+	)
+
+	tests := []struct {
+		name    string
+		actorID string
+		logs    []resource.InstanceLog
+		err     error
+	}{
+		{
+			name:    "owner can access logs",
+			actorID: ins.Owner.ID,
+			logs:    logs,
+		},
+		{
+			name:    "only owner can access logs",
+			actorID: "someone-else",
+			logs:    logs,
+			err:     apierrs.ErrPermissionDenied,
+		},
+		{
+			name:    "owner of instance without logs",
+			actorID: ins.Owner.ID,
+		},
+		{
+			name:    "only owner can see instance has no logs",
+			actorID: "someone-else",
+			err:     apierrs.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				insRepo   = mock.NewMockInstanceRepository(t)
+				mockStore = mock.NewMockBlobStore(t)
+			)
+
+			insRepo.EXPECT().InstanceLogs(mocky.Anything, ins.ID).Return(tt.logs, nil)
+
+			if len(tt.logs) == 0 {
+				insRepo.EXPECT().GetInstanceByID(mocky.Anything, ins.ID).Return(ins, nil)
+			}
+
+			if len(tt.logs) > 0 && tt.err == nil {
+				mockStore.EXPECT().
+					PresignDownloadURL(mocky.Anything, tt.logs[0].ObjectKey, cfg.PresignedURLExpiry).
+					Return("https://example.com/log", time.Now(), nil)
+			}
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				mock.NewMockNodeRepository(t),
+				mock.NewMockChunkRepository(t),
+				mockStore,
+				event.NewBus(),
+				nil,
+				cfg,
+			)
+			require.NoError(t, err)
+
+			got, err := svc.GetInstanceLogs(ctx, ins.ID, tt.actorID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, len(tt.logs))
+
+			for _, l := range got {
+				require.Equal(t, "https://example.com/log", l.DownloadURL)
+			}
+		})
+	}
+}
@@ -26,6 +26,10 @@ import (
 
 type metrics struct {
 	instanceCreatedCount metric.Int64Counter
+	instanceEventCount   metric.Int64Counter
+
+	rejectedTransitionCount metric.Int64Counter
+	placementFallbackCount  metric.Int64Counter
 }
 
 func initMetrics() (metrics, error) {
@@ -39,7 +43,34 @@ func initMetrics() (metrics, error) {
 		return metrics{}, fmt.Errorf("started counter: %w", err)
 	}
 
+	eventCount, err := meter.Int64Counter(
+		"explorer.control_plane.instance.event.count",
+		metric.WithDescription("Total number of instance events reported by nodes"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("event counter: %w", err)
+	}
+
+	rejectedCount, err := meter.Int64Counter(
+		"explorer.control_plane.instance.rejected_transition.count",
+		metric.WithDescription("Total number of status reports rejected because of an invalid state transition"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("rejected transition counter: %w", err)
+	}
+
+	fallbackCount, err := meter.Int64Counter(
+		"explorer.control_plane.instance.placement_fallback.count",
+		metric.WithDescription("Total number of instances placed by the built-in policy after the placement webhook failed"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("placement fallback counter: %w", err)
+	}
+
 	return metrics{
-		instanceCreatedCount: createdCount,
+		instanceCreatedCount:    createdCount,
+		instanceEventCount:      eventCount,
+		rejectedTransitionCount: rejectedCount,
+		placementFallbackCount:  fallbackCount,
 	}, nil
 }
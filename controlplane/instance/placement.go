@@ -0,0 +1,110 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spacechunks/explorer/controlplane/node"
+)
+
+// Placement is the number of instances that would be placed on a node.
+type Placement struct {
+	Node           node.Node
+	Replicas       int
+	RemainingSlots int
+}
+
+// PlacementPlan describes how the scheduler would distribute instances across nodes.
+type PlacementPlan struct {
+	Placements []Placement
+	Unplaced   int
+
+	// RemainingSlots is the number of free slots of
+	// all nodes after placing the instances.
+	RemainingSlots int
+}
+
+func (s *svc) PlanPlacement(ctx context.Context, flavorVersionID string, replicas int) (PlacementPlan, error) {
+	if _, _, err := s.flavorVersion(ctx, flavorVersionID); err != nil {
+		return PlacementPlan{}, err
+	}
+
+	nodes, err := s.nodeRepo.ListNodes(ctx)
+	if err != nil {
+		return PlacementPlan{}, fmt.Errorf("list nodes: %w", err)
+	}
+
+	return planPlacement(nodes, replicas), nil
+}
+
+// planPlacement places the instances one by one on the node with the least
+// instances like BestNode does. ties are broken by the order of the nodes
+// instead of randomly, so the plan is stable across calls. unhealthy and lost
+// nodes are skipped, because BestNode does not select them either.
+func planPlacement(nodes []node.Node, replicas int) PlacementPlan {
+	placements := make([]Placement, 0, len(nodes))
+	for _, n := range nodes {
+		if n.Unhealthy || n.Lost {
+			continue
+		}
+
+		placements = append(placements, Placement{
+			Node:           n,
+			RemainingSlots: n.AvailableSlots,
+		})
+	}
+
+	plan := PlacementPlan{}
+
+	for range replicas {
+		best := -1
+		for i, p := range placements {
+			if p.RemainingSlots == 0 {
+				continue
+			}
+
+			if best == -1 || instanceCount(p) < instanceCount(placements[best]) {
+				best = i
+			}
+		}
+
+		if best == -1 {
+			plan.Unplaced++
+			continue
+		}
+
+		placements[best].Replicas++
+		placements[best].RemainingSlots--
+	}
+
+	for _, p := range placements {
+		plan.RemainingSlots += p.RemainingSlots
+		if p.Replicas > 0 {
+			plan.Placements = append(plan.Placements, p)
+		}
+	}
+
+	return plan
+}
+
+func instanceCount(p Placement) int {
+	return p.Node.Slots - p.RemainingSlots
+}
@@ -0,0 +1,86 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	workloadv1alpha2 "github.com/spacechunks/explorer/api/platformd/workload/v1alpha2"
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// prepareTimeout is the maximum time a node may take to pull the images of a workload.
+const prepareTimeout = 5 * time.Minute
+
+// CreateWorkloadClient creates a client for the workload api of the node reachable at host.
+type CreateWorkloadClient func(host string) (workloadv1alpha2.WorkloadServiceClient, error)
+
+// prepareWorkload asks the node the instance has been scheduled to, to reserve the port
+// and pull the images of the workload, so it does not have to wait for the next time
+// it discovers instances. this is best effort only, because the node creates the
+// workload regardless, so errors are logged instead of being returned.
+func (s *svc) prepareWorkload(ctx context.Context, n node.Node, ins resource.Instance) {
+	if !n.CheckpointAPIEndpoint.IsValid() {
+		return
+	}
+
+	logger := s.logger.With("instance_id", ins.ID, "node_id", n.ID)
+
+	c, err := s.workloadClient(n.CheckpointAPIEndpoint.String())
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to create workload client", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, prepareTimeout)
+	defer cancel()
+
+	resp, err := c.PrepareWorkload(ctx, &workloadv1alpha2.PrepareWorkloadRequest{
+		Id:              ins.ID,
+		FlavorVersionId: ins.FlavorVersion.ID,
+	})
+	if err != nil {
+		logger.WarnContext(ctx, "failed to prepare workload", "err", err)
+		return
+	}
+
+	logger.DebugContext(ctx, "prepared workload", "port", resp.GetPort())
+}
+
+// workloadClient returns a client for the node reachable at host. clients are
+// reused, because creating a new connection for every instance is wasteful.
+func (s *svc) workloadClient(host string) (workloadv1alpha2.WorkloadServiceClient, error) {
+	s.wlClientsMu.Lock()
+	defer s.wlClientsMu.Unlock()
+
+	if c, ok := s.wlClients[host]; ok {
+		return c, nil
+	}
+
+	c, err := s.createWorkloadClient(host)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	s.wlClients[host] = c
+	return c, nil
+}
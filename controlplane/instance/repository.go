@@ -20,18 +20,96 @@ package instance
 
 import (
 	"context"
+	"time"
 
 	"github.com/spacechunks/explorer/internal/resource"
 )
 
+// StatusUpdate is a status report that must only be applied,
+// if the instance is currently in one of the From states.
+type StatusUpdate struct {
+	Report resource.InstanceStatusReport
+	From   []resource.InstanceState
+
+	// RedirectUntil is the time until connections to the previous endpoint
+	// of the instance are redirected to the new one, if the reported port
+	// differs from the current port of the instance. zero disables redirects.
+	RedirectUntil time.Time
+}
+
 type Repository interface {
 	CreateInstance(ctx context.Context, instance resource.Instance, nodeID string) (resource.Instance, error)
 	ListInstances(ctx context.Context, pageSize int, afterID *string) ([]resource.Instance, error)
 	GetInstanceByID(ctx context.Context, id string) (resource.Instance, error)
-	GetInstancesByNodeID(ctx context.Context, id string) ([]resource.Instance, error)
 
-	// ApplyStatusReports updates instances rows that are not in [instance.InstanceStateDeleted] state.
-	// all other instances will be removed from the table.
-	ApplyStatusReports(ctx context.Context, reports []resource.InstanceStatusReport) error
+	// GetInstancesByNodeID returns all instances of the node that changed after
+	// the given revision, as well as the highest revision of the returned
+	// instances. if no instance changed, afterRevision is returned. pass 0 to
+	// get all instances of the node.
+	GetInstancesByNodeID(ctx context.Context, id string, afterRevision int64) ([]resource.Instance, int64, error)
+
+	// GetInstancesByOwnerID is like GetInstancesByNodeID, but returns
+	// the instances owned by the given user.
+	GetInstancesByOwnerID(ctx context.Context, ownerID string, afterRevision int64) ([]resource.Instance, int64, error)
+
+	// ApplyStatusReports updates instances rows that are not in [resource.InstanceStateDeleted] state.
+	// all other instances will be removed from the table. returns the ids of all instances that
+	// have not been updated or removed, because they were not in one of the expected states.
+	ApplyStatusReports(ctx context.Context, updates []StatusUpdate) ([]string, error)
+
+	// UpdateInstanceState moves the instance to the given state if it currently is in one of
+	// the from states. returns ErrInvalidStateTransition if that is not the case.
+	UpdateInstanceState(
+		ctx context.Context,
+		id string,
+		to resource.InstanceState,
+		from []resource.InstanceState,
+	) error
+
+	// TransitionStaleInstances moves all instances that are in the from state since before
+	// changedBefore to the given state and returns their ids.
+	TransitionStaleInstances(
+		ctx context.Context,
+		from resource.InstanceState,
+		to resource.InstanceState,
+		changedBefore time.Time,
+	) ([]string, error)
 	CountInstancesByFlavorVersionID(ctx context.Context, flavorVersionID string) (uint, error)
+
+	// GetInstanceEvents returns the latest events of the given instance, newest first.
+	GetInstanceEvents(ctx context.Context, instanceID string, limit int) ([]resource.InstanceEvent, error)
+
+	// GetInstanceTimeline returns the first events of the given instance,
+	// including lifecycle events, oldest first.
+	GetInstanceTimeline(ctx context.Context, instanceID string, limit int) ([]resource.InstanceEvent, error)
+
+	// GetInstanceRedirectsByNodeID returns all redirects of the node that have not expired yet.
+	GetInstanceRedirectsByNodeID(ctx context.Context, nodeID string) ([]resource.InstanceRedirect, error)
+
+	// DeleteExpiredInstanceRedirects removes all expired redirects.
+	DeleteExpiredInstanceRedirects(ctx context.Context) error
+
+	// InsertInstanceLog records a log file of an instance. the expiry of the
+	// log is applied to all other logs of the instance as well, so the logs
+	// of an instance are retained as a whole.
+	InsertInstanceLog(ctx context.Context, log resource.InstanceLog) error
+
+	// InstanceLogs returns the logs of the instance, oldest first.
+	InstanceLogs(ctx context.Context, instanceID string) ([]resource.InstanceLog, error)
+
+	// ExpiredInstanceLogs returns at most limit logs that expired before the given time.
+	ExpiredInstanceLogs(ctx context.Context, before time.Time, limit int) ([]resource.InstanceLog, error)
+
+	DeleteInstanceLogs(ctx context.Context, ids []string) error
+
+	// CreateInstanceInvite stores the invite including the hash of its token.
+	CreateInstanceInvite(ctx context.Context, invite resource.InstanceInvite) (resource.InstanceInvite, error)
+
+	// InstanceInvites returns the invites of the instance, oldest first.
+	InstanceInvites(ctx context.Context, instanceID string) ([]resource.InstanceInvite, error)
+
+	InstanceInviteByID(ctx context.Context, id string) (resource.InstanceInvite, error)
+
+	// RevokeInstanceInvite revokes the invite, if it has not been revoked yet.
+	RevokeInstanceInvite(ctx context.Context, id string) error
 }
@@ -0,0 +1,275 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// DefaultPlacementWebhookTimeout is used if Config.PlacementWebhookTimeout is not set.
+const DefaultPlacementWebhookTimeout = 500 * time.Millisecond
+
+// maxPlacementResponseSize limits how much of the webhook response is read.
+const maxPlacementResponseSize = 1 << 20
+
+// PlacementRequest is sent to the placement webhook every time an instance
+// is scheduled. it contains every node the instance could be placed on.
+type PlacementRequest struct {
+	Instance   PlacementInstance    `json:"instance"`
+	Candidates []PlacementCandidate `json:"candidates"`
+
+	// PreferredNodeID is the node the built-in policy would select. it is
+	// used if the webhook fails, and wins over other nodes with the same score.
+	PreferredNodeID string `json:"preferredNodeId"`
+}
+
+type PlacementInstance struct {
+	ID               string          `json:"id"`
+	OwnerID          string          `json:"ownerId"`
+	FlavorVersionID  string          `json:"flavorVersionId"`
+	FlavorVersion    string          `json:"flavorVersion"`
+	MinecraftVersion string          `json:"minecraftVersion"`
+	MinPlayers       uint32          `json:"minPlayers"`
+	MaxPlayers       uint32          `json:"maxPlayers"`
+	PlacementGroup   string          `json:"placementGroup,omitempty"`
+	WorldSeed        string          `json:"worldSeed,omitempty"`
+	GameMode         string          `json:"gameMode,omitempty"`
+	Custom           json.RawMessage `json:"custom,omitempty"`
+}
+
+type PlacementCandidate struct {
+	NodeID         string `json:"nodeId"`
+	Name           string `json:"name"`
+	Slots          int    `json:"slots"`
+	AvailableSlots int    `json:"availableSlots"`
+}
+
+// PlacementResponse is returned by the placement webhook. candidates
+// missing from the response have a score of 0 and are not vetoed.
+type PlacementResponse struct {
+	Nodes []PlacementScore `json:"nodes"`
+}
+
+type PlacementScore struct {
+	NodeID string `json:"nodeId"`
+
+	// Score ranks the node. the node with the highest score is selected.
+	Score int `json:"score"`
+
+	// Veto excludes the node from being selected.
+	Veto bool `json:"veto"`
+}
+
+// PlacementAdvisor lets an external service influence on which node an
+// instance is placed, e.g. to enforce rules specific to a deployment.
+type PlacementAdvisor interface {
+	Advise(ctx context.Context, req PlacementRequest) (PlacementResponse, error)
+}
+
+type webhookAdvisor struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookAdvisor returns a PlacementAdvisor posting the placement request
+// as json to the given url. the webhook has to respond with status 200 and a
+// json encoded PlacementResponse.
+func NewWebhookAdvisor(url string, client *http.Client) PlacementAdvisor {
+	return &webhookAdvisor{
+		url:    url,
+		client: client,
+	}
+}
+
+func (a *webhookAdvisor) Advise(ctx context.Context, req PlacementRequest) (PlacementResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return PlacementResponse{}, fmt.Errorf("marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(body))
+	if err != nil {
+		return PlacementResponse{}, fmt.Errorf("create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(httpReq)
+	if err != nil {
+		return PlacementResponse{}, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return PlacementResponse{}, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var ret PlacementResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxPlacementResponseSize)).Decode(&ret); err != nil {
+		return PlacementResponse{}, fmt.Errorf("decode response: %w", err)
+	}
+
+	return ret, nil
+}
+
+// selectNode returns the node the instance should be created on. the node
+// selected by BestNode is used, unless a placement advisor is configured
+// and has not been disabled using the placement-webhook feature flag. if
+// the advisor fails or does not respond in time, we fall back to it.
+func (s *svc) selectNode(ctx context.Context, ins resource.Instance) (node.Node, error) {
+	best, err := s.nodeRepo.BestNode(ctx, ins.PlacementGroup)
+	if err != nil {
+		return node.Node{}, fmt.Errorf("best node: %w", err)
+	}
+
+	if s.advisor == nil || !s.featureEnabled(ctx, resource.FeatureFlagPlacementWebhook) {
+		return best, nil
+	}
+
+	nodes, err := s.nodeRepo.ListNodes(ctx)
+	if err != nil {
+		return node.Node{}, fmt.Errorf("list nodes: %w", err)
+	}
+
+	candidates := make([]node.Node, 0, len(nodes))
+	for _, n := range nodes {
+		if n.Unhealthy || n.Lost || n.AvailableSlots == 0 {
+			continue
+		}
+		candidates = append(candidates, n)
+	}
+
+	// the node could have been filled up in the meantime.
+	if !slices.ContainsFunc(candidates, func(n node.Node) bool { return n.ID == best.ID }) {
+		candidates = append(candidates, best)
+	}
+
+	timeout := cmp.Or(s.cfg.PlacementWebhookTimeout, DefaultPlacementWebhookTimeout)
+	adviseCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp, err := s.advisor.Advise(adviseCtx, placementRequest(ins, candidates, best.ID))
+	if err != nil {
+		s.logger.WarnContext(ctx,
+			"placement advisor failed, using built-in policy",
+			"instance_id", ins.ID,
+			"node_id", best.ID,
+			"err", err,
+		)
+		s.metrics.placementFallbackCount.Add(ctx, 1)
+		return best, nil
+	}
+
+	n, ok := pickNode(candidates, best.ID, resp)
+	if !ok {
+		return node.Node{}, apierrs.ErrPlacementRejected
+	}
+
+	return n, nil
+}
+
+func (s *svc) featureEnabled(ctx context.Context, flag resource.FeatureFlag) bool {
+	if s.cfg.FeatureEnabled == nil {
+		return true
+	}
+	return s.cfg.FeatureEnabled(ctx, flag)
+}
+
+func placementRequest(ins resource.Instance, candidates []node.Node, preferred string) PlacementRequest {
+	req := PlacementRequest{
+		Instance: PlacementInstance{
+			ID:               ins.ID,
+			OwnerID:          ins.Owner.ID,
+			FlavorVersionID:  ins.FlavorVersion.ID,
+			FlavorVersion:    ins.FlavorVersion.Version,
+			MinecraftVersion: ins.FlavorVersion.MinecraftVersion,
+			MinPlayers:       ins.FlavorVersion.MinPlayers,
+			MaxPlayers:       ins.FlavorVersion.MaxPlayers,
+			PlacementGroup:   ins.PlacementGroup,
+			WorldSeed:        ins.Params.WorldSeed,
+			GameMode:         string(ins.Params.GameMode),
+			Custom:           ins.Params.Custom,
+		},
+		Candidates:      make([]PlacementCandidate, 0, len(candidates)),
+		PreferredNodeID: preferred,
+	}
+
+	for _, n := range candidates {
+		req.Candidates = append(req.Candidates, PlacementCandidate{
+			NodeID:         n.ID,
+			Name:           n.Name,
+			Slots:          n.Slots,
+			AvailableSlots: n.AvailableSlots,
+		})
+	}
+
+	return req
+}
+
+// pickNode returns the candidate with the highest score that has not been
+// vetoed. ties are broken in favor of the preferred node, then the node with
+// the least instances. false is returned if all candidates have been vetoed.
+func pickNode(candidates []node.Node, preferred string, resp PlacementResponse) (node.Node, bool) {
+	scores := make(map[string]PlacementScore, len(resp.Nodes))
+	for _, s := range resp.Nodes {
+		scores[s.NodeID] = s
+	}
+
+	var (
+		ret   node.Node
+		found bool
+	)
+
+	for _, n := range candidates {
+		if scores[n.ID].Veto {
+			continue
+		}
+
+		if !found || better(n, ret, scores, preferred) {
+			ret = n
+			found = true
+		}
+	}
+
+	return ret, found
+}
+
+func better(a node.Node, b node.Node, scores map[string]PlacementScore, preferred string) bool {
+	if scores[a.ID].Score != scores[b.ID].Score {
+		return scores[a.ID].Score > scores[b.ID].Score
+	}
+
+	if a.ID == preferred || b.ID == preferred {
+		return a.ID == preferred
+	}
+
+	return a.Slots-a.AvailableSlots < b.Slots-b.AvailableSlots
+}
@@ -21,23 +21,41 @@ package instance
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/controlplane/condition"
 	"github.com/spacechunks/explorer/controlplane/contextkey"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/node"
 	"github.com/spacechunks/explorer/controlplane/pagination"
+	"github.com/spacechunks/explorer/controlplane/rollout"
+	"github.com/spacechunks/explorer/controlplane/settings"
 	"github.com/spacechunks/explorer/internal/resource"
 	"github.com/spacechunks/explorer/internal/resource/codec"
 )
 
 type Server struct {
 	instancev1alpha1.UnimplementedInstanceServiceServer
-	service Service
+	service          Service
+	rolloutService   rollout.Service
+	conditionService condition.Service
+	settingsService  settings.Service
 }
 
-func NewServer(service Service) *Server {
+func NewServer(
+	service Service,
+	rolloutService rollout.Service,
+	conditionService condition.Service,
+	settingsService settings.Service,
+) *Server {
 	return &Server{
-		service: service,
+		service:          service,
+		rolloutService:   rolloutService,
+		conditionService: conditionService,
+		settingsService:  settingsService,
 	}
 }
 
@@ -94,8 +112,34 @@ func (s *Server) RunFlavorVersion(
 	ctx context.Context,
 	req *instancev1alpha1.RunFlavorVersionRequest,
 ) (*instancev1alpha1.RunFlavorVersionResponse, error) {
-	userID := ctx.Value(contextkey.ActorID).(string)
-	ins, err := s.service.RunFlavorVersion(ctx, req.GetFlavorVersionId(), userID, req.OrderedBy)
+	var (
+		userID = ctx.Value(contextkey.ActorID).(string)
+		params = codec.InstanceParamsToDomain(req.GetParams())
+		ins    resource.Instance
+		err    error
+	)
+
+	// validation ensures that either a flavor version or a channel is selected.
+	if req.GetChannel() != "" {
+		ins, err = s.service.RunFlavorChannel(
+			ctx,
+			req.GetFlavorId(),
+			req.GetChannel(),
+			userID,
+			req.GetOrderedBy(),
+			params,
+			req.GetPlacementGroup(),
+		)
+	} else {
+		ins, err = s.service.RunFlavorVersion(
+			ctx,
+			req.GetFlavorVersionId(),
+			userID,
+			req.GetOrderedBy(),
+			params,
+			req.GetPlacementGroup(),
+		)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("run chunk: %w", err)
 	}
@@ -105,6 +149,18 @@ func (s *Server) RunFlavorVersion(
 	}, nil
 }
 
+func (s *Server) StopInstance(
+	ctx context.Context,
+	req *instancev1alpha1.StopInstanceRequest,
+) (*instancev1alpha1.StopInstanceResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+	if err := s.service.StopInstance(ctx, req.GetId(), actorID); err != nil {
+		return nil, fmt.Errorf("stop instance: %w", err)
+	}
+
+	return &instancev1alpha1.StopInstanceResponse{}, nil
+}
+
 func (s *Server) DiscoverInstances(
 	ctx context.Context,
 	req *instancev1alpha1.DiscoverInstanceRequest,
@@ -113,21 +169,74 @@ func (s *Server) DiscoverInstances(
 		return nil, apierrs.ErrNodeKeyMissing
 	}
 
-	instances, err := s.service.DiscoverInstances(ctx, req.GetNodeKey())
-	if err != nil {
-		return nil, fmt.Errorf("discovering instances: %w", err)
+	// an unknown revision token is not an error, the node
+	// simply receives all instances and starts over.
+	afterRevision, err := strconv.ParseInt(req.GetRevision(), 10, 64)
+	if err != nil || afterRevision < 0 {
+		afterRevision = 0
 	}
 
-	ret := make([]*instancev1alpha1.Instance, 0, len(instances))
-	for _, ins := range instances {
-		ret = append(ret, codec.InstanceToTransport(ins))
+	instances, revision, err := s.service.DiscoverInstances(
+		ctx,
+		req.GetNodeKey(),
+		capabilities(req.GetCapabilities()),
+		nodeCapacity(req.GetCapacity()),
+		afterRevision,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("discovering instances: %w", err)
 	}
 
 	return &instancev1alpha1.DiscoverInstanceResponse{
-		Instances: ret,
+		Instances: instancesToTransport(instances),
+		Revision:  strconv.FormatInt(revision, 10),
+		Full:      afterRevision == 0,
+		Settings:  nodeSettings(s.settingsService.Current(ctx)),
 	}, nil
 }
 
+// nodeSettings returns the runtime settings that apply to nodes.
+func nodeSettings(rs resource.RuntimeSettings) *instancev1alpha1.NodeSettings {
+	flags := make(map[string]bool, len(resource.FeatureFlags))
+	for _, f := range resource.FeatureFlags {
+		flags[string(f)] = rs.Enabled(f)
+	}
+
+	return &instancev1alpha1.NodeSettings{
+		LogLevels:    rs.LogLevels[resource.LogTargetPlatformd],
+		FeatureFlags: flags,
+	}
+}
+
+func (s *Server) WatchInstances(
+	req *instancev1alpha1.WatchInstancesRequest,
+	stream instancev1alpha1.InstanceService_WatchInstancesServer,
+) error {
+	if req.GetNodeKey() == "" {
+		return apierrs.ErrNodeKeyMissing
+	}
+
+	send := func(instances []resource.Instance, revision int64, full bool) error {
+		return stream.Send(&instancev1alpha1.WatchInstancesResponse{
+			Instances: instancesToTransport(instances),
+			Revision:  strconv.FormatInt(revision, 10),
+			Full:      full,
+		})
+	}
+
+	if err := s.service.WatchInstances(
+		stream.Context(),
+		req.GetNodeKey(),
+		capabilities(req.GetCapabilities()),
+		nodeCapacity(req.GetCapacity()),
+		send,
+	); err != nil {
+		return fmt.Errorf("watch instances: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Server) ReceiveInstanceStatusReports(
 	ctx context.Context,
 	req *instancev1alpha1.ReceiveInstanceStatusReportsRequest,
@@ -143,3 +252,305 @@ func (s *Server) ReceiveInstanceStatusReports(
 
 	return &instancev1alpha1.ReceiveInstanceStatusReportsResponse{}, nil
 }
+
+func (s *Server) GetPlatformdUpdate(
+	ctx context.Context,
+	req *instancev1alpha1.GetPlatformdUpdateRequest,
+) (*instancev1alpha1.GetPlatformdUpdateResponse, error) {
+	if req.GetNodeKey() == "" {
+		return nil, apierrs.ErrNodeKeyMissing
+	}
+
+	u, ok, err := s.rolloutService.PendingUpdate(ctx, req.GetNodeKey(), req.GetCurrentVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return &instancev1alpha1.GetPlatformdUpdateResponse{}, nil
+	}
+
+	return &instancev1alpha1.GetPlatformdUpdateResponse{
+		Update: &instancev1alpha1.PlatformdUpdate{
+			Version:      u.Version,
+			BinaryUrl:    u.BinaryURL,
+			SignatureUrl: u.SignatureURL,
+		},
+	}, nil
+}
+
+func (s *Server) ReportPlatformdUpdateStatus(
+	ctx context.Context,
+	req *instancev1alpha1.ReportPlatformdUpdateStatusRequest,
+) (*instancev1alpha1.ReportPlatformdUpdateStatusResponse, error) {
+	if req.GetNodeKey() == "" {
+		return nil, apierrs.ErrNodeKeyMissing
+	}
+
+	// the enum values are named after the rollout statuses.
+	status := rollout.Status(strings.TrimPrefix(req.GetStatus().String(), "UPDATE_"))
+
+	if err := s.rolloutService.ReportStatus(
+		ctx,
+		req.GetNodeKey(),
+		req.GetVersion(),
+		status,
+		req.GetMessage(),
+	); err != nil {
+		return nil, err
+	}
+
+	return &instancev1alpha1.ReportPlatformdUpdateStatusResponse{}, nil
+}
+
+func (s *Server) ReportNodeConditions(
+	ctx context.Context,
+	req *instancev1alpha1.ReportNodeConditionsRequest,
+) (*instancev1alpha1.ReportNodeConditionsResponse, error) {
+	if req.GetNodeKey() == "" {
+		return nil, apierrs.ErrNodeKeyMissing
+	}
+
+	conditions := make([]condition.Condition, 0, len(req.GetConditions()))
+	for _, c := range req.GetConditions() {
+		// the enum values are named after the condition types.
+		conditions = append(conditions, condition.Condition{
+			Type:    condition.Type(c.GetType().String()),
+			Message: c.GetMessage(),
+		})
+	}
+
+	if err := s.conditionService.Report(ctx, req.GetNodeKey(), conditions); err != nil {
+		return nil, err
+	}
+
+	return &instancev1alpha1.ReportNodeConditionsResponse{}, nil
+}
+
+func (s *Server) GetInstanceRedirects(
+	ctx context.Context,
+	req *instancev1alpha1.GetInstanceRedirectsRequest,
+) (*instancev1alpha1.GetInstanceRedirectsResponse, error) {
+	if req.GetNodeKey() == "" {
+		return nil, apierrs.ErrNodeKeyMissing
+	}
+
+	redirects, err := s.service.GetInstanceRedirects(ctx, req.GetNodeKey())
+	if err != nil {
+		return nil, fmt.Errorf("get instance redirects: %w", err)
+	}
+
+	ret := make([]*instancev1alpha1.InstanceRedirect, 0, len(redirects))
+	for _, r := range redirects {
+		ret = append(ret, codec.InstanceRedirectToTransport(r))
+	}
+
+	return &instancev1alpha1.GetInstanceRedirectsResponse{
+		Redirects: ret,
+	}, nil
+}
+
+func (s *Server) GetInstanceEvents(
+	ctx context.Context,
+	req *instancev1alpha1.GetInstanceEventsRequest,
+) (*instancev1alpha1.GetInstanceEventsResponse, error) {
+	events, err := s.service.GetInstanceEvents(ctx, req.GetInstanceId())
+	if err != nil {
+		return nil, fmt.Errorf("get instance events: %w", err)
+	}
+
+	ret := make([]*instancev1alpha1.InstanceEvent, 0, len(events))
+	for _, ev := range events {
+		ret = append(ret, codec.InstanceEventToTransport(ev))
+	}
+
+	return &instancev1alpha1.GetInstanceEventsResponse{
+		Events: ret,
+	}, nil
+}
+
+func (s *Server) GetInstanceTimeline(
+	ctx context.Context,
+	req *instancev1alpha1.GetInstanceTimelineRequest,
+) (*instancev1alpha1.GetInstanceTimelineResponse, error) {
+	events, err := s.service.GetInstanceTimeline(ctx, req.GetInstanceId())
+	if err != nil {
+		return nil, fmt.Errorf("get instance timeline: %w", err)
+	}
+
+	ret := make([]*instancev1alpha1.InstanceEvent, 0, len(events))
+	for _, ev := range events {
+		ret = append(ret, codec.InstanceEventToTransport(ev))
+	}
+
+	return &instancev1alpha1.GetInstanceTimelineResponse{
+		Events: ret,
+	}, nil
+}
+
+func (s *Server) CreateInstanceSnapshot(
+	ctx context.Context,
+	req *instancev1alpha1.CreateInstanceSnapshotRequest,
+) (*instancev1alpha1.CreateInstanceSnapshotResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+	snap, err := s.service.CreateInstanceSnapshot(ctx, req.GetInstanceId(), actorID)
+	if err != nil {
+		return nil, fmt.Errorf("create instance snapshot: %w", err)
+	}
+
+	return &instancev1alpha1.CreateInstanceSnapshotResponse{
+		Snapshot: codec.InstanceSnapshotToTransport(snap),
+	}, nil
+}
+
+func (s *Server) ReceiveInstanceLogs(
+	ctx context.Context,
+	req *instancev1alpha1.ReceiveInstanceLogsRequest,
+) (*instancev1alpha1.ReceiveInstanceLogsResponse, error) {
+	if req.GetNodeKey() == "" {
+		return nil, apierrs.ErrNodeKeyMissing
+	}
+
+	batches := make([]resource.InstanceLogBatch, 0, len(req.GetBatches()))
+	for _, b := range req.GetBatches() {
+		batches = append(batches, codec.InstanceLogBatchToDomain(b))
+	}
+
+	if err := s.service.ReceiveInstanceLogs(ctx, batches); err != nil {
+		return nil, fmt.Errorf("receive instance logs: %w", err)
+	}
+
+	return &instancev1alpha1.ReceiveInstanceLogsResponse{}, nil
+}
+
+func (s *Server) GetInstanceLogs(
+	ctx context.Context,
+	req *instancev1alpha1.GetInstanceLogsRequest,
+) (*instancev1alpha1.GetInstanceLogsResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+	logs, err := s.service.GetInstanceLogs(ctx, req.GetInstanceId(), actorID)
+	if err != nil {
+		return nil, fmt.Errorf("get instance logs: %w", err)
+	}
+
+	files := make([]*instancev1alpha1.InstanceLogFile, 0, len(logs))
+	for _, l := range logs {
+		files = append(files, codec.InstanceLogToTransport(l))
+	}
+
+	return &instancev1alpha1.GetInstanceLogsResponse{
+		Files: files,
+	}, nil
+}
+
+func (s *Server) CreateInstanceInvite(
+	ctx context.Context,
+	req *instancev1alpha1.CreateInstanceInviteRequest,
+) (*instancev1alpha1.CreateInstanceInviteResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+	invite, err := s.service.CreateInstanceInvite(
+		ctx,
+		req.GetInstanceId(),
+		actorID,
+		req.GetMaxUses(),
+		time.Duration(req.GetValidForMinutes())*time.Minute,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create instance invite: %w", err)
+	}
+
+	return &instancev1alpha1.CreateInstanceInviteResponse{
+		Invite: codec.InstanceInviteToTransport(invite),
+	}, nil
+}
+
+func (s *Server) ListInstanceInvites(
+	ctx context.Context,
+	req *instancev1alpha1.ListInstanceInvitesRequest,
+) (*instancev1alpha1.ListInstanceInvitesResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+	invites, err := s.service.ListInstanceInvites(ctx, req.GetInstanceId(), actorID)
+	if err != nil {
+		return nil, fmt.Errorf("list instance invites: %w", err)
+	}
+
+	ret := make([]*instancev1alpha1.InstanceInvite, 0, len(invites))
+	for _, i := range invites {
+		ret = append(ret, codec.InstanceInviteToTransport(i))
+	}
+
+	return &instancev1alpha1.ListInstanceInvitesResponse{
+		Invites: ret,
+	}, nil
+}
+
+func (s *Server) RevokeInstanceInvite(
+	ctx context.Context,
+	req *instancev1alpha1.RevokeInstanceInviteRequest,
+) (*instancev1alpha1.RevokeInstanceInviteResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+	if err := s.service.RevokeInstanceInvite(ctx, req.GetInviteId(), actorID); err != nil {
+		return nil, fmt.Errorf("revoke instance invite: %w", err)
+	}
+
+	return &instancev1alpha1.RevokeInstanceInviteResponse{}, nil
+}
+
+func (s *Server) PlanPlacement(
+	ctx context.Context,
+	req *instancev1alpha1.PlanPlacementRequest,
+) (*instancev1alpha1.PlanPlacementResponse, error) {
+	plan, err := s.service.PlanPlacement(ctx, req.GetFlavorVersionId(), int(req.GetReplicas()))
+	if err != nil {
+		return nil, fmt.Errorf("plan placement: %w", err)
+	}
+
+	placements := make([]*instancev1alpha1.NodePlacement, 0, len(plan.Placements))
+	for _, p := range plan.Placements {
+		placements = append(placements, &instancev1alpha1.NodePlacement{
+			NodeId:         p.Node.ID,
+			NodeName:       p.Node.Name,
+			Replicas:       uint32(p.Replicas),
+			Slots:          uint32(p.Node.Slots),
+			RemainingSlots: uint32(p.RemainingSlots),
+		})
+	}
+
+	return &instancev1alpha1.PlanPlacementResponse{
+		Placements:       placements,
+		UnplacedReplicas: uint32(plan.Unplaced),
+		RemainingSlots:   uint32(plan.RemainingSlots),
+	}, nil
+}
+
+func capabilities(caps []instancev1alpha1.NodeCapability) []node.Capability {
+	ret := make([]node.Capability, 0, len(caps))
+	for _, c := range caps {
+		if c == instancev1alpha1.NodeCapability_CAPABILITY_UNKNOWN {
+			continue
+		}
+		ret = append(ret, node.Capability(c.String()))
+	}
+	return ret
+}
+
+// nodeCapacity returns nil if the node did not report its capacity,
+// because older nodes do not bound the number of instances themselves.
+func nodeCapacity(c *instancev1alpha1.NodeCapacity) *node.Capacity {
+	if c == nil {
+		return nil
+	}
+	return &node.Capacity{
+		MaxInstances:        c.GetMaxInstances(),
+		WorkloadCPUMillis:   c.GetWorkloadCpuMillis(),
+		WorkloadMemoryBytes: c.GetWorkloadMemoryBytes(),
+	}
+}
+
+func instancesToTransport(instances []resource.Instance) []*instancev1alpha1.Instance {
+	ret := make([]*instancev1alpha1.Instance, 0, len(instances))
+	for _, ins := range instances {
+		ret = append(ret, codec.InstanceToTransport(ins))
+	}
+	return ret
+}
@@ -20,16 +20,24 @@ package instance
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"net/http"
 	"slices"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	workloadv1alpha2 "github.com/spacechunks/explorer/api/platformd/workload/v1alpha2"
+	"github.com/spacechunks/explorer/controlplane/blob"
 	"github.com/spacechunks/explorer/controlplane/chunk"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/event"
 	"github.com/spacechunks/explorer/controlplane/node"
 	"github.com/spacechunks/explorer/internal/resource"
 	"go.opentelemetry.io/otel/attribute"
@@ -44,17 +52,170 @@ type Service interface {
 		flavorVersionID string,
 		ownerID string,
 		orderedBy string,
+		params resource.InstanceParams,
+		placementGroup string,
 	) (resource.Instance, error)
-	DiscoverInstances(ctx context.Context, nodeID string) ([]resource.Instance, error)
+
+	// RunFlavorChannel runs the flavor version published to the channel of
+	// the flavor, see resource.FlavorChannel.
+	RunFlavorChannel(
+		ctx context.Context,
+		flavorID string,
+		channel string,
+		ownerID string,
+		orderedBy string,
+		params resource.InstanceParams,
+		placementGroup string,
+	) (resource.Instance, error)
+
+	// DiscoverInstances returns the instances of the given node that changed
+	// after the given revision together with the revision that has to be
+	// passed to the next call. pass 0 to discover all instances. the node
+	// is marked as seen with the given capabilities and capacity.
+	DiscoverInstances(
+		ctx context.Context,
+		nodeID string,
+		capabilities []node.Capability,
+		capacity *node.Capacity,
+		afterRevision int64,
+	) ([]resource.Instance, int64, error)
+
+	// WatchInstances calls send with all instances of the given node and
+	// afterward with the instances that changed, whenever instances of the
+	// node are changed by this replica or the watch resync interval elapsed.
+	// it returns once ctx is done or send fails.
+	WatchInstances(
+		ctx context.Context,
+		nodeID string,
+		capabilities []node.Capability,
+		capacity *node.Capacity,
+		send func(instances []resource.Instance, revision int64, full bool) error,
+	) error
+
+	// WatchOwnInstances is like WatchInstances, but for the instances owned by
+	// the given user. if afterRevision is not 0, only instances that changed
+	// after it are passed to the first call of send, so watching can be
+	// resumed after reconnecting.
+	WatchOwnInstances(
+		ctx context.Context,
+		ownerID string,
+		afterRevision int64,
+		send func(instances []resource.Instance, revision int64, full bool) error,
+	) error
 	ReceiveInstanceStatusReports(ctx context.Context, reports []resource.InstanceStatusReport) error
+
+	// GetInstanceRedirects returns the redirects the given node has to
+	// apply, so players connecting to the previous endpoint of an instance
+	// are forwarded to its current one.
+	GetInstanceRedirects(ctx context.Context, nodeID string) ([]resource.InstanceRedirect, error)
+
+	// StopInstance marks the instance for deletion. only the owner of the instance may stop it.
+	StopInstance(ctx context.Context, id string, actorID string) error
+	GetInstanceEvents(ctx context.Context, instanceID string) ([]resource.InstanceEvent, error)
+
+	// GetInstanceTimeline returns all events of the instance oldest first,
+	// which next to the events returned by GetInstanceEvents include
+	// the steps the instance went through while being started.
+	GetInstanceTimeline(ctx context.Context, instanceID string) ([]resource.InstanceEvent, error)
+
+	// CreateInstanceSnapshot uploads a tarball of the server directory of the running
+	// instance to the blob store. only the owner of the instance may create snapshots.
+	CreateInstanceSnapshot(ctx context.Context, instanceID string, actorID string) (resource.InstanceSnapshot, error)
+
+	// ReceiveInstanceLogs stores the log lines shipped by a node in the blob
+	// store. the logs of an instance are retained for the configured retention
+	// after the last batch has been received.
+	ReceiveInstanceLogs(ctx context.Context, batches []resource.InstanceLogBatch) error
+
+	// GetInstanceLogs returns the shipped logs of the instance including download
+	// urls. only the owner of the instance may access its logs, this is still the
+	// case after the instance has been removed.
+	GetInstanceLogs(ctx context.Context, instanceID string, actorID string) ([]resource.InstanceLog, error)
+
+	// PlanPlacement returns how the given number of instances of the flavor
+	// version would be distributed across the nodes, without creating them.
+	PlanPlacement(ctx context.Context, flavorVersionID string, replicas int) (PlacementPlan, error)
+
+	// CreateInstanceInvite creates an invite for the instance that expires after
+	// validFor. maxUses of zero allows an unlimited number of uses. only the
+	// owner of the instance may create invites.
+	CreateInstanceInvite(
+		ctx context.Context,
+		instanceID string,
+		actorID string,
+		maxUses uint32,
+		validFor time.Duration,
+	) (resource.InstanceInvite, error)
+
+	// ListInstanceInvites returns all invites of the instance without their
+	// tokens. only the owner of the instance may list its invites.
+	ListInstanceInvites(ctx context.Context, instanceID string, actorID string) ([]resource.InstanceInvite, error)
+
+	// RevokeInstanceInvite makes sure the invite cannot be used anymore.
+	// only the owner of the instance may revoke its invites.
+	RevokeInstanceInvite(ctx context.Context, inviteID string, actorID string) error
+}
+
+type Config struct {
+	PresignedURLExpiry   time.Duration
+	SnapshotMaxSizeBytes uint64
+
+	// WatchResyncInterval is the interval in which watched instances are
+	// checked for changes. changes made by other replicas are only picked
+	// up this way. defaults to DefaultWatchResyncInterval.
+	WatchResyncInterval time.Duration
+
+	// RedirectGracePeriod is the duration for which connections to the
+	// previous endpoint of an instance are forwarded to its new endpoint,
+	// after the port of the instance changed. zero disables redirects.
+	RedirectGracePeriod time.Duration
+
+	// PlacementWebhookURL is called with the candidate nodes every time an
+	// instance is scheduled, see PlacementAdvisor. disabled if empty.
+	PlacementWebhookURL string
+
+	// PlacementWebhookTimeout is the time the placement webhook has to respond,
+	// before the built-in policy is used. defaults to DefaultPlacementWebhookTimeout.
+	PlacementWebhookTimeout time.Duration
+
+	// InstanceLogRetention is the duration shipped logs of an instance are kept
+	// after the last batch of the instance has been received.
+	InstanceLogRetention time.Duration
+
+	// FeatureEnabled reports whether the feature has been enabled by
+	// admins at runtime. if nil, all features are enabled.
+	FeatureEnabled func(ctx context.Context, flag resource.FeatureFlag) bool
 }
 
+// DefaultWatchResyncInterval is used if Config.WatchResyncInterval is not set.
+const DefaultWatchResyncInterval = 5 * time.Second
+
+// MaxInstanceEvents is the maximum number of events returned for an instance.
+const MaxInstanceEvents = 50
+
+// MaxInstanceTimelineEvents is the maximum number of events
+// returned for the timeline of an instance.
+const MaxInstanceTimelineEvents = 100
+
+// maxScheduleAttempts is the number of nodes we try, before giving up
+// scheduling an instance because other replicas keep taking the slots.
+const maxScheduleAttempts = 3
+
 type svc struct {
 	logger    *slog.Logger
 	insRepo   Repository
 	nodeRepo  node.Repository
 	chunkRepo chunk.Repository
+	blobStore blob.Store
+	bus       *event.Bus
+	cfg       Config
 	metrics   metrics
+	advisor   PlacementAdvisor
+
+	// this factory function allows us to inject a mock client for testing.
+	createWorkloadClient CreateWorkloadClient
+	wlClients            map[string]workloadv1alpha2.WorkloadServiceClient
+	wlClientsMu          sync.Mutex
 }
 
 func NewService(
@@ -62,18 +223,34 @@ func NewService(
 	insRepo Repository,
 	nodeRepo node.Repository,
 	chunkRepo chunk.Repository,
+	blobStore blob.Store,
+	bus *event.Bus,
+	createWorkloadClient CreateWorkloadClient,
+	cfg Config,
 ) (Service, error) {
 	m, err := initMetrics()
 	if err != nil {
 		return nil, err
 	}
 
+	var advisor PlacementAdvisor
+	if cfg.PlacementWebhookURL != "" {
+		advisor = NewWebhookAdvisor(cfg.PlacementWebhookURL, http.DefaultClient)
+	}
+
 	return &svc{
 		logger:    logger,
 		insRepo:   insRepo,
 		nodeRepo:  nodeRepo,
 		chunkRepo: chunkRepo,
+		blobStore: blobStore,
+		bus:       bus,
+		cfg:       cfg,
 		metrics:   m,
+		advisor:   advisor,
+
+		createWorkloadClient: createWorkloadClient,
+		wlClients:            make(map[string]workloadv1alpha2.WorkloadServiceClient),
 	}, nil
 }
 
@@ -98,31 +275,28 @@ func (s *svc) RunFlavorVersion(
 	flavorVersionID string,
 	ownerID string,
 	orderedBy string,
+	params resource.InstanceParams,
+	placementGroup string,
 ) (resource.Instance, error) {
-	n, err := s.nodeRepo.BestNode(ctx)
-	if err != nil {
-		return resource.Instance{}, fmt.Errorf("best node: %w", err)
+	if len(params.Custom) > 0 && !json.Valid(params.Custom) {
+		return resource.Instance{}, apierrs.ErrInvalidCustomParams
 	}
 
-	flavorID, err := s.chunkRepo.FlavorIDByFlavorVersionID(ctx, flavorVersionID)
+	flavor, version, err := s.flavorVersion(ctx, flavorVersionID)
 	if err != nil {
-		return resource.Instance{}, fmt.Errorf("flavor version id: %w", err)
+		return resource.Instance{}, err
 	}
 
-	flavor, err := s.chunkRepo.FlavorByID(ctx, flavorID)
+	// instances are isolated from each other, unless an admin
+	// allowed the chunk to connect its instances.
+	crossTraffic, err := s.chunkRepo.CrossInstanceTrafficApproved(ctx, flavor.ID)
 	if err != nil {
-		return resource.Instance{}, fmt.Errorf("flavor version: %w", err)
-	}
-
-	if flavor.DeletedAt != nil {
-		return resource.Instance{}, apierrs.ErrNotFound
+		return resource.Instance{}, fmt.Errorf("cross-instance traffic: %w", err)
 	}
 
-	idx := slices.IndexFunc(flavor.Versions, func(version resource.FlavorVersion) bool {
-		return version.ID == flavorVersionID
-	})
-	if idx == -1 {
-		return resource.Instance{}, apierrs.ErrNotFound
+	version, canary, err := s.launchVersion(ctx, flavor, version)
+	if err != nil {
+		return resource.Instance{}, err
 	}
 
 	instanceID, err := uuid.NewV7()
@@ -130,21 +304,35 @@ func (s *svc) RunFlavorVersion(
 		return resource.Instance{}, fmt.Errorf("instance id: %w", err)
 	}
 
-	version := flavor.Versions[idx]
-
-	ins, err := s.insRepo.CreateInstance(ctx, resource.Instance{
+	ins, err := s.schedule(ctx, resource.Instance{
 		ID:            instanceID.String(),
-		FlavorVersion: flavor.Versions[idx],
+		FlavorVersion: version,
 		State:         resource.InstanceStatePending,
 		Owner: resource.User{
 			ID: ownerID,
 		},
-		OrderedBy: orderedBy,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}, n.ID)
+		OrderedBy:            orderedBy,
+		Params:               params,
+		PlacementGroup:       placementGroup,
+		CrossInstanceTraffic: crossTraffic,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+	})
 	if err != nil {
-		return resource.Instance{}, fmt.Errorf("create instance: %w", err)
+		return resource.Instance{}, err
+	}
+
+	// failing to record the instance only affects the stats
+	// of the canary, so the launch must not fail because of it.
+	if canary != nil {
+		if err := s.chunkRepo.CreateCanaryInstance(
+			ctx,
+			canary.ID,
+			ins.ID,
+			version.ID == canary.CanaryVersionID,
+		); err != nil {
+			s.logger.ErrorContext(ctx, "failed to record canary instance", "instance_id", ins.ID, "err", err)
+		}
 	}
 
 	s.metrics.instanceCreatedCount.Add(
@@ -160,27 +348,400 @@ func (s *svc) RunFlavorVersion(
 	return ins, nil
 }
 
-func (s *svc) DiscoverInstances(ctx context.Context, nodeID string) ([]resource.Instance, error) {
-	instances, err := s.insRepo.GetInstancesByNodeID(ctx, nodeID)
+func (s *svc) RunFlavorChannel(
+	ctx context.Context,
+	flavorID string,
+	channel string,
+	ownerID string,
+	orderedBy string,
+	params resource.InstanceParams,
+	placementGroup string,
+) (resource.Instance, error) {
+	c, err := s.chunkRepo.FlavorChannel(ctx, flavorID, channel)
+	if err != nil {
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return resource.Instance{}, apierrs.ErrFlavorChannelNotFound
+		}
+		return resource.Instance{}, fmt.Errorf("flavor channel: %w", err)
+	}
+
+	return s.RunFlavorVersion(ctx, c.FlavorVersionID, ownerID, orderedBy, params, placementGroup)
+}
+
+// flavorVersion returns the flavor version together with the flavor it belongs to.
+// returns ErrNotFound if the flavor has been deleted.
+func (s *svc) flavorVersion(
+	ctx context.Context,
+	flavorVersionID string,
+) (resource.Flavor, resource.FlavorVersion, error) {
+	flavorID, err := s.chunkRepo.FlavorIDByFlavorVersionID(ctx, flavorVersionID)
 	if err != nil {
-		return nil, err
+		return resource.Flavor{}, resource.FlavorVersion{}, fmt.Errorf("flavor version id: %w", err)
+	}
+
+	flavor, err := s.chunkRepo.FlavorByID(ctx, flavorID)
+	if err != nil {
+		return resource.Flavor{}, resource.FlavorVersion{}, fmt.Errorf("flavor version: %w", err)
+	}
+
+	if flavor.DeletedAt != nil {
+		return resource.Flavor{}, resource.FlavorVersion{}, apierrs.ErrNotFound
+	}
+
+	idx := slices.IndexFunc(flavor.Versions, func(version resource.FlavorVersion) bool {
+		return version.ID == flavorVersionID
+	})
+	if idx == -1 {
+		return resource.Flavor{}, resource.FlavorVersion{}, apierrs.ErrNotFound
+	}
+
+	return flavor, flavor.Versions[idx], nil
+}
+
+// launchVersion returns the version that has to be launched instead of the
+// requested one, if the flavor has a canary referencing the requested version.
+// the active canary is returned as well, so the launch can be recorded.
+func (s *svc) launchVersion(
+	ctx context.Context,
+	flavor resource.Flavor,
+	requested resource.FlavorVersion,
+) (resource.FlavorVersion, *resource.Canary, error) {
+	c, err := s.chunkRepo.CanaryByFlavorVersionID(ctx, requested.ID)
+	if err != nil {
+		if errors.Is(err, apierrs.ErrNotFound) {
+			return requested, nil, nil
+		}
+		return resource.FlavorVersion{}, nil, fmt.Errorf("canary: %w", err)
+	}
+
+	var (
+		launchID = requested.ID
+		active   *resource.Canary
+	)
+
+	switch c.State {
+	case resource.CanaryStateActive:
+		active = &c
+		launchID = c.StableVersionID
+		if rand.Uint32N(100) < c.Percentage {
+			launchID = c.CanaryVersionID
+		}
+	case resource.CanaryStatePromoted:
+		if requested.ID == c.StableVersionID {
+			launchID = c.CanaryVersionID
+		}
+	case resource.CanaryStateAborted:
+		if requested.ID == c.CanaryVersionID {
+			launchID = c.StableVersionID
+		}
+	}
+
+	idx := slices.IndexFunc(flavor.Versions, func(v resource.FlavorVersion) bool {
+		return v.ID == launchID
+	})
+	if idx == -1 {
+		return requested, nil, nil
+	}
+
+	return flavor.Versions[idx], active, nil
+}
+
+// schedule creates the instance on the node with the most free slots,
+// preferring nodes that already run instances of its placement group,
+// unless the placement advisor selected a different node.
+// the node is selected outside the transaction creating the instance,
+// so other replicas can fill up the node in the meantime. the repository
+// rejects the instance in that case and we try the next best node.
+func (s *svc) schedule(ctx context.Context, ins resource.Instance) (resource.Instance, error) {
+	for attempt := 1; ; attempt++ {
+		n, err := s.selectNode(ctx, ins)
+		if err != nil {
+			return resource.Instance{}, err
+		}
+
+		created, err := s.insRepo.CreateInstance(ctx, ins, n.ID)
+		if err == nil {
+			s.bus.Publish(event.Event{
+				Type:   event.TypeInstancesChanged,
+				NodeID: n.ID,
+			})
+			go s.prepareWorkload(context.WithoutCancel(ctx), n, ins)
+			return created, nil
+		}
+
+		if !errors.Is(err, apierrs.ErrNoSlotsAvailable) || attempt >= maxScheduleAttempts {
+			return resource.Instance{}, fmt.Errorf("create instance: %w", err)
+		}
+
+		s.logger.DebugContext(ctx, "node ran out of slots, retrying", "node_id", n.ID, "attempt", attempt)
+	}
+}
+
+func (s *svc) DiscoverInstances(
+	ctx context.Context,
+	nodeID string,
+	capabilities []node.Capability,
+	capacity *node.Capacity,
+	afterRevision int64,
+) ([]resource.Instance, int64, error) {
+	// the heartbeat is only used for scheduling, so failing
+	// to update it must not break discovery.
+	if err := s.nodeRepo.UpdateNodeHeartbeat(ctx, nodeID, capabilities, capacity); err != nil {
+		s.logger.ErrorContext(ctx, "failed to update node heartbeat", "node_id", nodeID, "err", err)
+	}
+
+	instances, revision, err := s.insRepo.GetInstancesByNodeID(ctx, nodeID, afterRevision)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	s.logger.DebugContext(ctx, "found instances", "instances", len(instances), "node_id", nodeID)
+	s.logger.DebugContext(ctx,
+		"found instances",
+		"instances", len(instances),
+		"node_id", nodeID,
+		"after_revision", afterRevision,
+		"revision", revision,
+	)
 
 	// sort to return consistent output
 	sort.Slice(instances, func(i, j int) bool {
 		return strings.Compare(instances[i].ID, instances[j].ID) < 0
 	})
 
-	return instances, nil
+	return instances, revision, nil
+}
+
+func (s *svc) WatchInstances(
+	ctx context.Context,
+	nodeID string,
+	capabilities []node.Capability,
+	capacity *node.Capacity,
+	send func(instances []resource.Instance, revision int64, full bool) error,
+) error {
+	// a buffer of one is enough, because every notification
+	// causes all changes since the last revision to be fetched.
+	notify := make(chan struct{}, 1)
+
+	unsubscribe := s.bus.Subscribe(func(ev event.Event) {
+		if ev.Type != event.TypeInstancesChanged || (ev.NodeID != "" && ev.NodeID != nodeID) {
+			return
+		}
+
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	})
+	defer unsubscribe()
+
+	interval := s.cfg.WatchResyncInterval
+	if interval <= 0 {
+		interval = DefaultWatchResyncInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	instances, revision, err := s.DiscoverInstances(ctx, nodeID, capabilities, capacity, 0)
+	if err != nil {
+		return err
+	}
+
+	if err := send(instances, revision, true); err != nil {
+		return fmt.Errorf("send: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notify:
+		case <-ticker.C:
+		}
+
+		// discovering also updates the heartbeat of the node,
+		// so it keeps being marked as seen while watching.
+		instances, revision, err = s.DiscoverInstances(ctx, nodeID, capabilities, capacity, revision)
+		if err != nil {
+			return err
+		}
+
+		if len(instances) == 0 {
+			continue
+		}
+
+		if err := send(instances, revision, false); err != nil {
+			return fmt.Errorf("send: %w", err)
+		}
+	}
+}
+
+func (s *svc) WatchOwnInstances(
+	ctx context.Context,
+	ownerID string,
+	afterRevision int64,
+	send func(instances []resource.Instance, revision int64, full bool) error,
+) error {
+	// instances changed events only carry the node, so we
+	// have to check for changes on every one of them.
+	notify := make(chan struct{}, 1)
+
+	unsubscribe := s.bus.Subscribe(func(ev event.Event) {
+		if ev.Type != event.TypeInstancesChanged {
+			return
+		}
+
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	})
+	defer unsubscribe()
+
+	interval := s.cfg.WatchResyncInterval
+	if interval <= 0 {
+		interval = DefaultWatchResyncInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	instances, revision, err := s.insRepo.GetInstancesByOwnerID(ctx, ownerID, afterRevision)
+	if err != nil {
+		return fmt.Errorf("get instances: %w", err)
+	}
+
+	// when resuming, there is nothing to send if nothing changed in the meantime.
+	if full := afterRevision == 0; full || len(instances) > 0 {
+		if err := send(instances, revision, full); err != nil {
+			return fmt.Errorf("send: %w", err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notify:
+		case <-ticker.C:
+		}
+
+		instances, revision, err = s.insRepo.GetInstancesByOwnerID(ctx, ownerID, revision)
+		if err != nil {
+			return fmt.Errorf("get instances: %w", err)
+		}
+
+		if len(instances) == 0 {
+			continue
+		}
+
+		if err := send(instances, revision, false); err != nil {
+			return fmt.Errorf("send: %w", err)
+		}
+	}
 }
 
 func (s *svc) ReceiveInstanceStatusReports(ctx context.Context, reports []resource.InstanceStatusReport) error {
-	if err := s.insRepo.ApplyStatusReports(ctx, reports); err != nil {
+	var redirectUntil time.Time
+	if s.cfg.RedirectGracePeriod > 0 {
+		redirectUntil = time.Now().Add(s.cfg.RedirectGracePeriod)
+	}
+
+	updates := make([]StatusUpdate, 0, len(reports))
+	for _, r := range reports {
+		updates = append(updates, StatusUpdate{
+			Report: r,
+			From: SourceStates(Transition{
+				To:   r.State,
+				Port: r.Port,
+			}),
+			RedirectUntil: redirectUntil,
+		})
+	}
+
+	rejected, err := s.insRepo.ApplyStatusReports(ctx, updates)
+	if err != nil {
 		return fmt.Errorf("apply status reports: %w", err)
 	}
+
+	// reports are rejected if they are outdated, for example platformd
+	// reporting RUNNING for an instance that has just been stopped.
+	// this resolves itself with one of the next reports or latest with
+	// the next full resync of platformd, so it is not treated as an error.
+	if len(rejected) > 0 {
+		s.logger.DebugContext(ctx, "rejected status reports", "instance_ids", rejected)
+		s.metrics.rejectedTransitionCount.Add(ctx, int64(len(rejected)))
+	}
+
+	for _, r := range reports {
+		for _, ev := range r.Events {
+			s.metrics.instanceEventCount.Add(
+				ctx,
+				1,
+				metric.WithAttributes(attribute.String("type", string(ev.Type))),
+			)
+		}
+	}
+
 	return nil
 }
 
+func (s *svc) GetInstanceRedirects(ctx context.Context, nodeID string) ([]resource.InstanceRedirect, error) {
+	redirects, err := s.insRepo.GetInstanceRedirectsByNodeID(ctx, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("get redirects: %w", err)
+	}
+	return redirects, nil
+}
+
+func (s *svc) StopInstance(ctx context.Context, id string, actorID string) error {
+	ins, err := s.insRepo.GetInstanceByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get instance: %w", err)
+	}
+
+	if ins.Owner.ID != actorID {
+		return apierrs.ErrPermissionDenied
+	}
+
+	t := Transition{
+		From: ins.State,
+		To:   resource.InstanceStateDeleting,
+	}
+
+	if err := ValidateTransition(t); err != nil {
+		return err
+	}
+
+	// the state could have changed since we fetched the instance,
+	// so the repository checks the transition again atomically.
+	if err := s.insRepo.UpdateInstanceState(ctx, id, t.To, SourceStates(t)); err != nil {
+		return fmt.Errorf("update instance state: %w", err)
+	}
+
+	// the node of the instance is not known at this point,
+	// so all watchers have to check for changes.
+	s.bus.Publish(event.Event{
+		Type: event.TypeInstancesChanged,
+	})
+
+	return nil
+}
+
+func (s *svc) GetInstanceEvents(ctx context.Context, instanceID string) ([]resource.InstanceEvent, error) {
+	events, err := s.insRepo.GetInstanceEvents(ctx, instanceID, MaxInstanceEvents)
+	if err != nil {
+		return nil, fmt.Errorf("get instance events: %w", err)
+	}
+	return events, nil
+}
+
+func (s *svc) GetInstanceTimeline(ctx context.Context, instanceID string) ([]resource.InstanceEvent, error) {
+	events, err := s.insRepo.GetInstanceTimeline(ctx, instanceID, MaxInstanceTimelineEvents)
+	if err != nil {
+		return nil, fmt.Errorf("get instance timeline: %w", err)
+	}
+	return events, nil
+}
+
 // TODO: tests
@@ -0,0 +1,1223 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"slices"
+	"testing"
+	"time"
+
+	workloadv1alpha2 "github.com/spacechunks/explorer/api/platformd/workload/v1alpha2"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/assert"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestRunFlavorVersionScheduling(t *testing.T) {
+	var (
+		flavor  = fixture.Flavor()
+		version = flavor.Versions[0]
+		full    = node.Node{ID: "full-node"}
+		free    = node.Node{ID: "free-node"}
+	)
+
+	tests := []struct {
+		name string
+		err  error
+		prep func(*mock.MockNodeRepository, *mock.MockInstanceRepository)
+	}{
+		{
+			name: "retries on next node if selected node ran out of slots",
+			prep: func(nodeRepo *mock.MockNodeRepository, insRepo *mock.MockInstanceRepository) {
+				nodeRepo.EXPECT().BestNode(mocky.Anything, "").Return(full, nil).Once()
+				nodeRepo.EXPECT().BestNode(mocky.Anything, "").Return(free, nil).Once()
+
+				insRepo.EXPECT().
+					CreateInstance(mocky.Anything, mocky.Anything, full.ID).
+					Return(resource.Instance{}, apierrs.ErrNoSlotsAvailable).
+					Once()
+
+				insRepo.EXPECT().
+					CreateInstance(mocky.Anything, mocky.Anything, free.ID).
+					Return(fixture.Instance(), nil).
+					Once()
+			},
+		},
+		{
+			name: "gives up after max attempts",
+			err:  apierrs.ErrNoSlotsAvailable,
+			prep: func(nodeRepo *mock.MockNodeRepository, insRepo *mock.MockInstanceRepository) {
+				nodeRepo.EXPECT().BestNode(mocky.Anything, "").Return(full, nil).Times(3)
+
+				insRepo.EXPECT().
+					CreateInstance(mocky.Anything, mocky.Anything, full.ID).
+					Return(resource.Instance{}, apierrs.ErrNoSlotsAvailable).
+					Times(3)
+			},
+		},
+		{
+			name: "no node with free slots",
+			err:  apierrs.ErrNoSlotsAvailable,
+			prep: func(nodeRepo *mock.MockNodeRepository, _ *mock.MockInstanceRepository) {
+				nodeRepo.EXPECT().BestNode(mocky.Anything, "").Return(node.Node{}, apierrs.ErrNoSlotsAvailable).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				nodeRepo  = mock.NewMockNodeRepository(t)
+				insRepo   = mock.NewMockInstanceRepository(t)
+				chunkRepo = mock.NewMockChunkRepository(t)
+			)
+
+			chunkRepo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, version.ID).Return(flavor.ID, nil)
+			chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+			chunkRepo.EXPECT().CrossInstanceTrafficApproved(mocky.Anything, flavor.ID).Return(false, nil)
+			chunkRepo.EXPECT().
+				CanaryByFlavorVersionID(mocky.Anything, version.ID).
+				Return(resource.Canary{}, apierrs.ErrNotFound)
+
+			tt.prep(nodeRepo, insRepo)
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				nodeRepo,
+				chunkRepo,
+				nil,
+				event.NewBus(),
+				nil,
+				instance.Config{},
+			)
+			require.NoError(t, err)
+
+			_, err = svc.RunFlavorVersion(ctx, version.ID, "owner-id", "ordered-by", resource.InstanceParams{}, "")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRunFlavorVersionPreparesWorkload(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		flavor    = fixture.Flavor()
+		version   = flavor.Versions[0]
+		n         = fixture.Node()
+		nodeRepo  = mock.NewMockNodeRepository(t)
+		insRepo   = mock.NewMockInstanceRepository(t)
+		chunkRepo = mock.NewMockChunkRepository(t)
+		wlClient  = mock.NewMockV1alpha2WorkloadServiceClient(t)
+		prepared  = make(chan *workloadv1alpha2.PrepareWorkloadRequest, 1)
+	)
+
+	chunkRepo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, version.ID).Return(flavor.ID, nil)
+	chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+	chunkRepo.EXPECT().CrossInstanceTrafficApproved(mocky.Anything, flavor.ID).Return(false, nil)
+	chunkRepo.EXPECT().
+		CanaryByFlavorVersionID(mocky.Anything, version.ID).
+		Return(resource.Canary{}, apierrs.ErrNotFound)
+	nodeRepo.EXPECT().BestNode(mocky.Anything, "").Return(n, nil)
+	insRepo.EXPECT().CreateInstance(mocky.Anything, mocky.Anything, n.ID).Return(fixture.Instance(), nil)
+
+	wlClient.EXPECT().
+		PrepareWorkload(mocky.Anything, mocky.Anything).
+		RunAndReturn(func(
+			_ context.Context,
+			req *workloadv1alpha2.PrepareWorkloadRequest,
+			_ ...grpc.CallOption,
+		) (*workloadv1alpha2.PrepareWorkloadResponse, error) {
+			prepared <- req
+			return &workloadv1alpha2.PrepareWorkloadResponse{Port: 30000}, nil
+		})
+
+	svc, err := instance.NewService(
+		slog.New(slog.DiscardHandler),
+		insRepo,
+		nodeRepo,
+		chunkRepo,
+		nil,
+		event.NewBus(),
+		func(host string) (workloadv1alpha2.WorkloadServiceClient, error) {
+			require.Equal(t, n.CheckpointAPIEndpoint.String(), host)
+			return wlClient, nil
+		},
+		instance.Config{},
+	)
+	require.NoError(t, err)
+
+	_, err = svc.RunFlavorVersion(ctx, version.ID, "owner-id", "ordered-by", resource.InstanceParams{}, "")
+	require.NoError(t, err)
+
+	select {
+	case req := <-prepared:
+		require.Equal(t, version.ID, req.GetFlavorVersionId())
+		require.NotEmpty(t, req.GetId())
+	case <-time.After(5 * time.Second):
+		t.Fatal("workload has not been prepared")
+	}
+}
+
+func TestRunFlavorVersionParams(t *testing.T) {
+	var (
+		flavor  = fixture.Flavor()
+		version = flavor.Versions[0]
+		n       = node.Node{ID: "node"}
+	)
+
+	tests := []struct {
+		name   string
+		params resource.InstanceParams
+		err    error
+	}{
+		{
+			name: "params are stored on the instance",
+			params: resource.InstanceParams{
+				WorldSeed: "-4172144997902289642",
+				GameMode:  resource.GameModeAdventure,
+				Custom:    []byte(`{"map":"castle","rounds":3}`),
+			},
+		},
+		{
+			name: "invalid custom params",
+			params: resource.InstanceParams{
+				Custom: []byte(`{"map":`),
+			},
+			err: apierrs.ErrInvalidCustomParams,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				nodeRepo  = mock.NewMockNodeRepository(t)
+				insRepo   = mock.NewMockInstanceRepository(t)
+				chunkRepo = mock.NewMockChunkRepository(t)
+			)
+
+			if tt.err == nil {
+				chunkRepo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, version.ID).Return(flavor.ID, nil)
+				chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+				chunkRepo.EXPECT().CrossInstanceTrafficApproved(mocky.Anything, flavor.ID).Return(false, nil)
+				chunkRepo.EXPECT().
+					CanaryByFlavorVersionID(mocky.Anything, version.ID).
+					Return(resource.Canary{}, apierrs.ErrNotFound)
+				nodeRepo.EXPECT().BestNode(mocky.Anything, "").Return(n, nil)
+				insRepo.EXPECT().
+					CreateInstance(
+						mocky.Anything,
+						mocky.MatchedBy(func(ins resource.Instance) bool {
+							return assert.ObjectsAreEqual(tt.params, ins.Params)
+						}),
+						n.ID,
+					).
+					Return(fixture.Instance(), nil)
+			}
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				nodeRepo,
+				chunkRepo,
+				nil,
+				event.NewBus(),
+				nil,
+				instance.Config{},
+			)
+			require.NoError(t, err)
+
+			_, err = svc.RunFlavorVersion(ctx, version.ID, "owner-id", "ordered-by", tt.params, "")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRunFlavorVersionPlacementGroup(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		flavor    = fixture.Flavor()
+		version   = flavor.Versions[0]
+		n         = node.Node{ID: "node"}
+		nodeRepo  = mock.NewMockNodeRepository(t)
+		insRepo   = mock.NewMockInstanceRepository(t)
+		chunkRepo = mock.NewMockChunkRepository(t)
+	)
+
+	chunkRepo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, version.ID).Return(flavor.ID, nil)
+	chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+	chunkRepo.EXPECT().CrossInstanceTrafficApproved(mocky.Anything, flavor.ID).Return(false, nil)
+	chunkRepo.EXPECT().
+		CanaryByFlavorVersionID(mocky.Anything, version.ID).
+		Return(resource.Canary{}, apierrs.ErrNotFound)
+	nodeRepo.EXPECT().BestNode(mocky.Anything, "party").Return(n, nil)
+	insRepo.EXPECT().
+		CreateInstance(
+			mocky.Anything,
+			mocky.MatchedBy(func(ins resource.Instance) bool {
+				return ins.PlacementGroup == "party"
+			}),
+			n.ID,
+		).
+		Return(fixture.Instance(), nil)
+
+	svc, err := instance.NewService(
+		slog.New(slog.DiscardHandler),
+		insRepo,
+		nodeRepo,
+		chunkRepo,
+		nil,
+		event.NewBus(),
+		nil,
+		instance.Config{},
+	)
+	require.NoError(t, err)
+
+	_, err = svc.RunFlavorVersion(ctx, version.ID, "owner-id", "ordered-by", resource.InstanceParams{}, "party")
+	require.NoError(t, err)
+}
+
+func TestRunFlavorVersionCrossInstanceTraffic(t *testing.T) {
+	tests := []struct {
+		name     string
+		approved bool
+	}{
+		{
+			name:     "approved chunks can connect their instances",
+			approved: true,
+		},
+		{
+			name:     "instances are isolated by default",
+			approved: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				flavor    = fixture.Flavor()
+				version   = flavor.Versions[0]
+				n         = node.Node{ID: "node"}
+				nodeRepo  = mock.NewMockNodeRepository(t)
+				insRepo   = mock.NewMockInstanceRepository(t)
+				chunkRepo = mock.NewMockChunkRepository(t)
+			)
+
+			chunkRepo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, version.ID).Return(flavor.ID, nil)
+			chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+			chunkRepo.EXPECT().CrossInstanceTrafficApproved(mocky.Anything, flavor.ID).Return(tt.approved, nil)
+			chunkRepo.EXPECT().
+				CanaryByFlavorVersionID(mocky.Anything, version.ID).
+				Return(resource.Canary{}, apierrs.ErrNotFound)
+			nodeRepo.EXPECT().BestNode(mocky.Anything, "").Return(n, nil)
+			insRepo.EXPECT().
+				CreateInstance(
+					mocky.Anything,
+					mocky.MatchedBy(func(ins resource.Instance) bool {
+						return ins.CrossInstanceTraffic == tt.approved
+					}),
+					n.ID,
+				).
+				Return(fixture.Instance(), nil)
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				nodeRepo,
+				chunkRepo,
+				nil,
+				event.NewBus(),
+				nil,
+				instance.Config{},
+			)
+			require.NoError(t, err)
+
+			_, err = svc.RunFlavorVersion(ctx, version.ID, "owner-id", "ordered-by", resource.InstanceParams{}, "")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRunFlavorVersionCanary(t *testing.T) {
+	var (
+		flavor = fixture.Flavor()
+		canary = flavor.Versions[0]
+		stable = flavor.Versions[1]
+		n      = node.Node{ID: "node"}
+	)
+
+	tests := []struct {
+		name      string
+		requested resource.FlavorVersion
+		state     resource.CanaryState
+		expected  resource.FlavorVersion
+	}{
+		{
+			name:      "active canary launches canary version",
+			requested: stable,
+			state:     resource.CanaryStateActive,
+			expected:  canary,
+		},
+		{
+			name:      "promoted canary launches canary version instead of stable version",
+			requested: stable,
+			state:     resource.CanaryStatePromoted,
+			expected:  canary,
+		},
+		{
+			name:      "aborted canary launches stable version instead of canary version",
+			requested: canary,
+			state:     resource.CanaryStateAborted,
+			expected:  stable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				nodeRepo  = mock.NewMockNodeRepository(t)
+				insRepo   = mock.NewMockInstanceRepository(t)
+				chunkRepo = mock.NewMockChunkRepository(t)
+				created   = fixture.Instance()
+				c         = resource.Canary{
+					ID:              "canary-id",
+					FlavorID:        flavor.ID,
+					StableVersionID: stable.ID,
+					CanaryVersionID: canary.ID,
+					Percentage:      100,
+					State:           tt.state,
+				}
+			)
+
+			chunkRepo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, tt.requested.ID).Return(flavor.ID, nil)
+			chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+			chunkRepo.EXPECT().CrossInstanceTrafficApproved(mocky.Anything, flavor.ID).Return(false, nil)
+			chunkRepo.EXPECT().CanaryByFlavorVersionID(mocky.Anything, tt.requested.ID).Return(c, nil)
+			nodeRepo.EXPECT().BestNode(mocky.Anything, "").Return(n, nil)
+
+			insRepo.EXPECT().
+				CreateInstance(
+					mocky.Anything,
+					mocky.MatchedBy(func(ins resource.Instance) bool {
+						return ins.FlavorVersion.ID == tt.expected.ID
+					}),
+					n.ID,
+				).
+				Return(created, nil)
+
+			// only launches during an active canary count towards its stats
+			if tt.state == resource.CanaryStateActive {
+				chunkRepo.EXPECT().CreateCanaryInstance(mocky.Anything, c.ID, created.ID, true).Return(nil)
+			}
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				nodeRepo,
+				chunkRepo,
+				nil,
+				event.NewBus(),
+				nil,
+				instance.Config{},
+			)
+			require.NoError(t, err)
+
+			_, err = svc.RunFlavorVersion(ctx, tt.requested.ID, "owner-id", "ordered-by", resource.InstanceParams{}, "")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRunFlavorChannel(t *testing.T) {
+	var (
+		flavor = fixture.Flavor()
+		beta   = flavor.Versions[0]
+		n      = node.Node{ID: "node"}
+	)
+
+	tests := []struct {
+		name    string
+		channel string
+		err     error
+		prep    func(*mock.MockChunkRepository, *mock.MockNodeRepository, *mock.MockInstanceRepository)
+	}{
+		{
+			name:    "launches version published to channel",
+			channel: resource.FlavorChannelBeta,
+			prep: func(
+				chunkRepo *mock.MockChunkRepository,
+				nodeRepo *mock.MockNodeRepository,
+				insRepo *mock.MockInstanceRepository,
+			) {
+				chunkRepo.EXPECT().
+					FlavorChannel(mocky.Anything, flavor.ID, resource.FlavorChannelBeta).
+					Return(resource.FlavorChannel{
+						FlavorID:        flavor.ID,
+						Name:            resource.FlavorChannelBeta,
+						FlavorVersionID: beta.ID,
+					}, nil)
+				chunkRepo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, beta.ID).Return(flavor.ID, nil)
+				chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+				chunkRepo.EXPECT().CrossInstanceTrafficApproved(mocky.Anything, flavor.ID).Return(false, nil)
+				chunkRepo.EXPECT().
+					CanaryByFlavorVersionID(mocky.Anything, beta.ID).
+					Return(resource.Canary{}, apierrs.ErrNotFound)
+				nodeRepo.EXPECT().BestNode(mocky.Anything, "").Return(n, nil)
+				insRepo.EXPECT().
+					CreateInstance(
+						mocky.Anything,
+						mocky.MatchedBy(func(ins resource.Instance) bool {
+							return ins.FlavorVersion.ID == beta.ID
+						}),
+						n.ID,
+					).
+					Return(fixture.Instance(), nil)
+			},
+		},
+		{
+			name:    "nothing published to channel",
+			channel: resource.FlavorChannelStable,
+			err:     apierrs.ErrFlavorChannelNotFound,
+			prep: func(chunkRepo *mock.MockChunkRepository, _ *mock.MockNodeRepository, _ *mock.MockInstanceRepository) {
+				chunkRepo.EXPECT().
+					FlavorChannel(mocky.Anything, flavor.ID, resource.FlavorChannelStable).
+					Return(resource.FlavorChannel{}, apierrs.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				nodeRepo  = mock.NewMockNodeRepository(t)
+				insRepo   = mock.NewMockInstanceRepository(t)
+				chunkRepo = mock.NewMockChunkRepository(t)
+			)
+
+			tt.prep(chunkRepo, nodeRepo, insRepo)
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				nodeRepo,
+				chunkRepo,
+				nil,
+				event.NewBus(),
+				nil,
+				instance.Config{},
+			)
+			require.NoError(t, err)
+
+			_, err = svc.RunFlavorChannel(
+				ctx,
+				flavor.ID,
+				tt.channel,
+				"owner-id",
+				"ordered-by",
+				resource.InstanceParams{},
+				"",
+			)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestStopInstance(t *testing.T) {
+	var (
+		ins   = fixture.Instance()
+		owner = ins.Owner.ID
+	)
+
+	tests := []struct {
+		name    string
+		actorID string
+		state   resource.InstanceState
+		err     error
+		prep    func(*mock.MockInstanceRepository)
+	}{
+		{
+			name:    "owner can stop running instance",
+			actorID: owner,
+			state:   resource.InstanceStateRunning,
+			prep: func(repo *mock.MockInstanceRepository) {
+				repo.EXPECT().
+					UpdateInstanceState(
+						mocky.Anything,
+						ins.ID,
+						resource.InstanceStateDeleting,
+						mocky.MatchedBy(func(from []resource.InstanceState) bool {
+							return slices.Contains(from, resource.InstanceStateRunning)
+						}),
+					).
+					Return(nil)
+			},
+		},
+		{
+			name:    "only owner can stop instance",
+			actorID: "someone-else",
+			state:   resource.InstanceStateRunning,
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockInstanceRepository) {},
+		},
+		{
+			name:    "deleted instance cannot be stopped",
+			actorID: owner,
+			state:   resource.InstanceStateDeleted,
+			err:     apierrs.ErrInvalidStateTransition,
+			prep:    func(*mock.MockInstanceRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx     = context.Background()
+				insRepo = mock.NewMockInstanceRepository(t)
+				current = ins
+			)
+
+			current.State = tt.state
+
+			insRepo.EXPECT().GetInstanceByID(mocky.Anything, ins.ID).Return(current, nil)
+			tt.prep(insRepo)
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				mock.NewMockNodeRepository(t),
+				mock.NewMockChunkRepository(t),
+				nil,
+				event.NewBus(),
+				nil,
+				instance.Config{},
+			)
+			require.NoError(t, err)
+
+			err = svc.StopInstance(ctx, ins.ID, tt.actorID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReceiveInstanceStatusReportsRedirects(t *testing.T) {
+	tests := []struct {
+		name        string
+		gracePeriod time.Duration
+		redirect    bool
+	}{
+		{
+			name:        "redirects enabled",
+			gracePeriod: time.Minute,
+			redirect:    true,
+		},
+		{
+			name: "redirects disabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx     = context.Background()
+				insRepo = mock.NewMockInstanceRepository(t)
+				start   = time.Now()
+				report  = resource.InstanceStatusReport{
+					InstanceID: fixture.Instance().ID,
+					Port:       1337,
+					State:      resource.InstanceStateRunning,
+				}
+			)
+
+			insRepo.EXPECT().
+				ApplyStatusReports(mocky.Anything, mocky.MatchedBy(func(updates []instance.StatusUpdate) bool {
+					until := updates[0].RedirectUntil
+					if !tt.redirect {
+						return until.IsZero()
+					}
+					return !until.Before(start.Add(tt.gracePeriod)) && !until.After(time.Now().Add(tt.gracePeriod))
+				})).
+				Return(nil, nil)
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				mock.NewMockNodeRepository(t),
+				mock.NewMockChunkRepository(t),
+				nil,
+				event.NewBus(),
+				nil,
+				instance.Config{
+					RedirectGracePeriod: tt.gracePeriod,
+				},
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, svc.ReceiveInstanceStatusReports(ctx, []resource.InstanceStatusReport{report}))
+		})
+	}
+}
+
+func TestWatchInstances(t *testing.T) {
+	type update struct {
+		instances []resource.Instance
+		revision  int64
+		full      bool
+	}
+
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		nodeID      = "node-id"
+		capacity    = &node.Capacity{MaxInstances: 5, WorkloadCPUMillis: 1000}
+		first       = fixture.Instance()
+		second      = fixture.Instance()
+		bus         = event.NewBus()
+		insRepo     = mock.NewMockInstanceRepository(t)
+		nodeRepo    = mock.NewMockNodeRepository(t)
+		updates     = make(chan update)
+		done        = make(chan error)
+	)
+
+	second.ID = "second"
+
+	nodeRepo.EXPECT().UpdateNodeHeartbeat(mocky.Anything, nodeID, mocky.Anything, capacity).Return(nil)
+	insRepo.EXPECT().
+		GetInstancesByNodeID(mocky.Anything, nodeID, int64(0)).
+		Return([]resource.Instance{first}, 1, nil)
+	insRepo.EXPECT().
+		GetInstancesByNodeID(mocky.Anything, nodeID, int64(1)).
+		Return([]resource.Instance{second}, 2, nil)
+
+	svc, err := instance.NewService(
+		slog.New(slog.DiscardHandler),
+		insRepo,
+		nodeRepo,
+		mock.NewMockChunkRepository(t),
+		nil,
+		bus,
+		nil,
+		instance.Config{
+			WatchResyncInterval: time.Hour,
+		},
+	)
+	require.NoError(t, err)
+
+	go func() {
+		done <- svc.WatchInstances(ctx, nodeID, nil, capacity, func(instances []resource.Instance, revision int64, full bool) error {
+			updates <- update{instances: instances, revision: revision, full: full}
+			return nil
+		})
+	}()
+
+	require.Equal(t, update{instances: []resource.Instance{first}, revision: 1, full: true}, <-updates)
+
+	bus.Publish(event.Event{
+		Type:   event.TypeInstancesChanged,
+		NodeID: nodeID,
+	})
+
+	require.Equal(t, update{instances: []resource.Instance{second}, revision: 2}, <-updates)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestWatchOwnInstancesResumesAfterRevision(t *testing.T) {
+	type update struct {
+		instances []resource.Instance
+		revision  int64
+		full      bool
+	}
+
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		ownerID     = "owner-id"
+		ins         = fixture.Instance()
+		bus         = event.NewBus()
+		insRepo     = mock.NewMockInstanceRepository(t)
+		updates     = make(chan update)
+		done        = make(chan error)
+	)
+
+	// nothing changed since the revision the client has seen last,
+	// so nothing is sent until the instance changes.
+	insRepo.EXPECT().
+		GetInstancesByOwnerID(mocky.Anything, ownerID, int64(5)).
+		Return([]resource.Instance{}, 5, nil).
+		Once()
+	insRepo.EXPECT().
+		GetInstancesByOwnerID(mocky.Anything, ownerID, int64(5)).
+		Return([]resource.Instance{ins}, 6, nil).
+		Once()
+	insRepo.EXPECT().
+		GetInstancesByOwnerID(mocky.Anything, ownerID, int64(6)).
+		Return([]resource.Instance{}, 6, nil).
+		Maybe()
+
+	svc, err := instance.NewService(
+		slog.New(slog.DiscardHandler),
+		insRepo,
+		mock.NewMockNodeRepository(t),
+		mock.NewMockChunkRepository(t),
+		nil,
+		bus,
+		nil,
+		instance.Config{
+			WatchResyncInterval: time.Hour,
+		},
+	)
+	require.NoError(t, err)
+
+	go func() {
+		done <- svc.WatchOwnInstances(ctx, ownerID, 5, func(instances []resource.Instance, revision int64, full bool) error {
+			updates <- update{instances: instances, revision: revision, full: full}
+			return nil
+		})
+	}()
+
+	// instances changed events do not carry the owner,
+	// so any change causes the instances to be checked.
+	require.Eventually(t, func() bool {
+		bus.Publish(event.Event{
+			Type:   event.TypeInstancesChanged,
+			NodeID: "other-node",
+		})
+
+		select {
+		case u := <-updates:
+			require.Equal(t, update{instances: []resource.Instance{ins}, revision: 6}, u)
+			return true
+		case <-time.After(10 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestCreateInstanceSnapshot(t *testing.T) {
+	var (
+		ins   = fixture.Instance()
+		owner = ins.Owner.ID
+		n     = node.Node{
+			ID:                    "node-id",
+			CheckpointAPIEndpoint: netip.MustParseAddrPort("127.0.0.1:9014"),
+		}
+		cfg = instance.Config{
+			PresignedURLExpiry:   time.Minute,
+			SnapshotMaxSizeBytes: 6,
+		}
+	)
+
+	tests := []struct {
+		name    string
+		actorID string
+		state   resource.InstanceState
+		chunks  [][]byte
+		err     error
+	}{
+		{
+			name:    "owner can snapshot running instance",
+			actorID: owner,
+			state:   resource.InstanceStateRunning,
+			chunks:  [][]byte{[]byte("abc"), []byte("def")},
+		},
+		{
+			name:    "only owner can snapshot instance",
+			actorID: "someone-else",
+			state:   resource.InstanceStateRunning,
+			err:     apierrs.ErrPermissionDenied,
+		},
+		{
+			name:    "instance must be running",
+			actorID: owner,
+			state:   resource.InstanceStateCreating,
+			err:     apierrs.ErrInstanceNotRunning,
+		},
+		{
+			name:    "snapshot too big",
+			actorID: owner,
+			state:   resource.InstanceStateRunning,
+			chunks:  [][]byte{[]byte("abc"), []byte("defg")},
+			err:     apierrs.ErrSnapshotTooBig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				insRepo   = mock.NewMockInstanceRepository(t)
+				nodeRepo  = mock.NewMockNodeRepository(t)
+				mockStore = mock.NewMockBlobStore(t)
+				wlClient  = mock.NewMockV1alpha2WorkloadServiceClient(t)
+				current   = ins
+				putKey    string
+			)
+
+			current.State = tt.state
+
+			insRepo.EXPECT().GetInstanceByID(mocky.Anything, ins.ID).Return(current, nil)
+
+			if tt.chunks != nil {
+				nodeRepo.EXPECT().NodeByInstanceID(mocky.Anything, ins.ID).Return(n, nil)
+
+				wlClient.EXPECT().
+					SnapshotWorkload(mocky.Anything, &workloadv1alpha2.SnapshotWorkloadRequest{Id: ins.ID}).
+					Return(&snapshotStream{chunks: tt.chunks}, nil)
+			}
+
+			if tt.err == nil {
+				mockStore.EXPECT().
+					SimplePut(mocky.Anything, mocky.Anything, mocky.Anything, mocky.Anything).
+					RunAndReturn(func(_ context.Context, key string, r io.Reader, _ map[string]string) error {
+						putKey = key
+						data, err := io.ReadAll(r)
+						require.NoError(t, err)
+						require.Equal(t, "abcdef", string(data))
+						return nil
+					})
+
+				mockStore.EXPECT().
+					PresignDownloadURL(mocky.Anything, mocky.Anything, cfg.PresignedURLExpiry).
+					Return("https://example.com/snapshot", time.Now(), nil)
+			}
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				nodeRepo,
+				mock.NewMockChunkRepository(t),
+				mockStore,
+				event.NewBus(),
+				func(host string) (workloadv1alpha2.WorkloadServiceClient, error) {
+					require.Equal(t, n.CheckpointAPIEndpoint.String(), host)
+					return wlClient, nil
+				},
+				cfg,
+			)
+			require.NoError(t, err)
+
+			snap, err := svc.CreateInstanceSnapshot(ctx, ins.ID, tt.actorID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, ins.ID, snap.InstanceID)
+			require.Equal(t, uint64(6), snap.SizeBytes)
+			require.Equal(t, "https://example.com/snapshot", snap.DownloadURL)
+			require.Equal(t, blob.SnapshotKey(ins.ID, snap.ID), putKey)
+		})
+	}
+}
+
+type snapshotStream struct {
+	grpc.ClientStream
+	chunks [][]byte
+}
+
+func (s *snapshotStream) Recv() (*workloadv1alpha2.SnapshotWorkloadResponse, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+
+	data := s.chunks[0]
+	s.chunks = s.chunks[1:]
+
+	return &workloadv1alpha2.SnapshotWorkloadResponse{Data: data}, nil
+}
+
+func TestPlanPlacement(t *testing.T) {
+	var (
+		flavor  = fixture.Flavor()
+		version = flavor.Versions[0]
+		a       = node.Node{ID: "a", Name: "node-a", Slots: 4, AvailableSlots: 1}
+		b       = node.Node{ID: "b", Name: "node-b", Slots: 4, AvailableSlots: 4}
+		c       = node.Node{ID: "c", Name: "node-c", Slots: 2, AvailableSlots: 0}
+
+		// unhealthy nodes are never part of a plan
+		d = node.Node{ID: "d", Name: "node-d", Slots: 4, AvailableSlots: 4, Unhealthy: true}
+	)
+
+	tests := []struct {
+		name     string
+		replicas int
+		expected instance.PlacementPlan
+	}{
+		{
+			name:     "prefers nodes with the least instances",
+			replicas: 3,
+			expected: instance.PlacementPlan{
+				Placements: []instance.Placement{
+					{Node: b, Replicas: 3, RemainingSlots: 1},
+				},
+				RemainingSlots: 2,
+			},
+		},
+		{
+			name:     "spreads instances once nodes are equally loaded",
+			replicas: 4,
+			expected: instance.PlacementPlan{
+				Placements: []instance.Placement{
+					{Node: a, Replicas: 1, RemainingSlots: 0},
+					{Node: b, Replicas: 3, RemainingSlots: 1},
+				},
+				RemainingSlots: 1,
+			},
+		},
+		{
+			name:     "reports instances exceeding capacity",
+			replicas: 7,
+			expected: instance.PlacementPlan{
+				Placements: []instance.Placement{
+					{Node: a, Replicas: 1, RemainingSlots: 0},
+					{Node: b, Replicas: 4, RemainingSlots: 0},
+				},
+				Unplaced: 2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				nodeRepo  = mock.NewMockNodeRepository(t)
+				chunkRepo = mock.NewMockChunkRepository(t)
+			)
+
+			chunkRepo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, version.ID).Return(flavor.ID, nil)
+			chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+			nodeRepo.EXPECT().ListNodes(mocky.Anything).Return([]node.Node{a, b, c, d}, nil)
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				mock.NewMockInstanceRepository(t),
+				nodeRepo,
+				chunkRepo,
+				nil,
+				event.NewBus(),
+				nil,
+				instance.Config{},
+			)
+			require.NoError(t, err)
+
+			plan, err := svc.PlanPlacement(ctx, version.ID, tt.replicas)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, plan)
+		})
+	}
+}
+
+func TestRunFlavorVersionPlacementWebhook(t *testing.T) {
+	var (
+		flavor  = fixture.Flavor()
+		version = flavor.Versions[0]
+		a       = node.Node{ID: "node-a", Slots: 10, AvailableSlots: 5}
+		b       = node.Node{ID: "node-b", Slots: 10, AvailableSlots: 8}
+		c       = node.Node{ID: "node-c", Slots: 10, AvailableSlots: 8, Unhealthy: true}
+	)
+
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		timeout  time.Duration
+		disabled bool
+		expected string
+		err      error
+	}{
+		{
+			name: "highest score wins",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				var req instance.PlacementRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				require.Equal(t, b.ID, req.PreferredNodeID)
+				require.Equal(t, version.ID, req.Instance.FlavorVersionID)
+				require.Equal(t, "group", req.Instance.PlacementGroup)
+				require.ElementsMatch(t, []string{a.ID, b.ID}, candidateIDs(req.Candidates))
+
+				_ = json.NewEncoder(w).Encode(instance.PlacementResponse{
+					Nodes: []instance.PlacementScore{{NodeID: a.ID, Score: 10}},
+				})
+			},
+			expected: a.ID,
+		},
+		{
+			name: "vetoed node is not selected",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(instance.PlacementResponse{
+					Nodes: []instance.PlacementScore{{NodeID: b.ID, Veto: true}},
+				})
+			},
+			expected: a.ID,
+		},
+		{
+			name: "ties are broken in favor of the built-in choice",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(instance.PlacementResponse{})
+			},
+			expected: b.ID,
+		},
+		{
+			name: "all nodes vetoed",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(instance.PlacementResponse{
+					Nodes: []instance.PlacementScore{
+						{NodeID: a.ID, Veto: true},
+						{NodeID: b.ID, Veto: true},
+					},
+				})
+			},
+			err: apierrs.ErrPlacementRejected,
+		},
+		{
+			name: "falls back to built-in policy on error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expected: b.ID,
+		},
+		{
+			name:    "falls back to built-in policy on timeout",
+			timeout: 10 * time.Millisecond,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+			},
+			expected: b.ID,
+		},
+		{
+			name:     "webhook is not called if disabled using the feature flag",
+			disabled: true,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("webhook must not be called")
+			},
+			expected: b.ID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				nodeRepo  = mock.NewMockNodeRepository(t)
+				insRepo   = mock.NewMockInstanceRepository(t)
+				chunkRepo = mock.NewMockChunkRepository(t)
+				srv       = httptest.NewServer(tt.handler)
+			)
+			defer srv.Close()
+
+			chunkRepo.EXPECT().FlavorIDByFlavorVersionID(mocky.Anything, version.ID).Return(flavor.ID, nil)
+			chunkRepo.EXPECT().FlavorByID(mocky.Anything, flavor.ID).Return(flavor, nil)
+			chunkRepo.EXPECT().CrossInstanceTrafficApproved(mocky.Anything, flavor.ID).Return(false, nil)
+			chunkRepo.EXPECT().
+				CanaryByFlavorVersionID(mocky.Anything, version.ID).
+				Return(resource.Canary{}, apierrs.ErrNotFound)
+
+			nodeRepo.EXPECT().BestNode(mocky.Anything, "group").Return(b, nil).Once()
+
+			if !tt.disabled {
+				nodeRepo.EXPECT().ListNodes(mocky.Anything).Return([]node.Node{a, b, c}, nil).Once()
+			}
+
+			if tt.err == nil {
+				insRepo.EXPECT().
+					CreateInstance(mocky.Anything, mocky.Anything, tt.expected).
+					Return(fixture.Instance(), nil).
+					Once()
+			}
+
+			svc, err := instance.NewService(
+				slog.New(slog.DiscardHandler),
+				insRepo,
+				nodeRepo,
+				chunkRepo,
+				nil,
+				event.NewBus(),
+				nil,
+				instance.Config{
+					PlacementWebhookURL:     srv.URL,
+					PlacementWebhookTimeout: tt.timeout,
+					FeatureEnabled: func(_ context.Context, flag resource.FeatureFlag) bool {
+						return !tt.disabled || flag != resource.FeatureFlagPlacementWebhook
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			_, err = svc.RunFlavorVersion(ctx, version.ID, "owner-id", "ordered-by", resource.InstanceParams{}, "group")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func candidateIDs(candidates []instance.PlacementCandidate) []string {
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.NodeID)
+	}
+	return ids
+}
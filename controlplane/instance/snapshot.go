@@ -0,0 +1,161 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	workloadv1alpha2 "github.com/spacechunks/explorer/api/platformd/workload/v1alpha2"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// snapshotTimeout is the maximum time a node may take to stream the snapshot of a workload.
+const snapshotTimeout = 5 * time.Minute
+
+func (s *svc) CreateInstanceSnapshot(
+	ctx context.Context,
+	instanceID string,
+	actorID string,
+) (resource.InstanceSnapshot, error) {
+	ins, err := s.insRepo.GetInstanceByID(ctx, instanceID)
+	if err != nil {
+		return resource.InstanceSnapshot{}, fmt.Errorf("get instance: %w", err)
+	}
+
+	if ins.Owner.ID != actorID {
+		return resource.InstanceSnapshot{}, apierrs.ErrPermissionDenied
+	}
+
+	if ins.State != resource.InstanceStateRunning {
+		return resource.InstanceSnapshot{}, apierrs.ErrInstanceNotRunning
+	}
+
+	n, err := s.nodeRepo.NodeByInstanceID(ctx, instanceID)
+	if err != nil {
+		return resource.InstanceSnapshot{}, fmt.Errorf("node by instance id: %w", err)
+	}
+
+	c, err := s.workloadClient(n.CheckpointAPIEndpoint.String())
+	if err != nil {
+		return resource.InstanceSnapshot{}, fmt.Errorf("workload client: %w", err)
+	}
+
+	snapID, err := uuid.NewV7()
+	if err != nil {
+		return resource.InstanceSnapshot{}, fmt.Errorf("snapshot id: %w", err)
+	}
+
+	// the snapshot is buffered on disk, because not all blob stores
+	// support uploading streams of unknown length.
+	f, err := os.CreateTemp("", "snapshot-*.tar.gz")
+	if err != nil {
+		return resource.InstanceSnapshot{}, fmt.Errorf("create temp file: %w", err)
+	}
+
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	size, err := s.receiveSnapshot(ctx, c, instanceID, f)
+	if err != nil {
+		return resource.InstanceSnapshot{}, err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return resource.InstanceSnapshot{}, fmt.Errorf("seek: %w", err)
+	}
+
+	key := blob.SnapshotKey(instanceID, snapID.String())
+
+	if err := s.blobStore.SimplePut(ctx, key, f, nil); err != nil {
+		return resource.InstanceSnapshot{}, fmt.Errorf("put snapshot: %w", err)
+	}
+
+	url, expiresAt, err := s.blobStore.PresignDownloadURL(ctx, key, s.cfg.PresignedURLExpiry)
+	if err != nil {
+		return resource.InstanceSnapshot{}, fmt.Errorf("presign download url: %w", err)
+	}
+
+	return resource.InstanceSnapshot{
+		ID:                   snapID.String(),
+		InstanceID:           instanceID,
+		SizeBytes:            size,
+		DownloadURL:          url,
+		DownloadURLExpiresAt: expiresAt,
+		CreatedAt:            time.Now(),
+	}, nil
+}
+
+// receiveSnapshot writes the snapshot streamed by the node to w and returns its size.
+// ErrSnapshotTooBig is returned as soon as the snapshot exceeds the configured size.
+func (s *svc) receiveSnapshot(
+	ctx context.Context,
+	c workloadv1alpha2.WorkloadServiceClient,
+	id string,
+	w io.Writer,
+) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, snapshotTimeout)
+	defer cancel()
+
+	stream, err := c.SnapshotWorkload(ctx, &workloadv1alpha2.SnapshotWorkloadRequest{
+		Id: id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("snapshot workload: %w", err)
+	}
+
+	n, err := io.Copy(w, io.LimitReader(&snapshotReader{stream: stream}, int64(s.cfg.SnapshotMaxSizeBytes)+1))
+	if err != nil {
+		return 0, fmt.Errorf("receive snapshot: %w", err)
+	}
+
+	if uint64(n) > s.cfg.SnapshotMaxSizeBytes {
+		return 0, apierrs.ErrSnapshotTooBig
+	}
+
+	return uint64(n), nil
+}
+
+// snapshotReader reads the chunks of a snapshot stream.
+type snapshotReader struct {
+	stream workloadv1alpha2.WorkloadService_SnapshotWorkloadClient
+	buf    []byte
+}
+
+func (r *snapshotReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		resp, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = resp.GetData()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,210 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// Transition describes a change of an instance from one state to another.
+type Transition struct {
+	From resource.InstanceState
+	To   resource.InstanceState
+
+	// Port reported together with the state change. only
+	// set for transitions caused by a status report.
+	Port uint16
+}
+
+// Guard is evaluated before a transition is applied. returning an error
+// rejects the transition, even if it is allowed by the state machine.
+type Guard func(t Transition) error
+
+type transitionRule struct {
+	to    resource.InstanceState
+	guard Guard
+}
+
+// CleanupPolicy declares that instances staying in State for longer than
+// After will be removed. removing means the instance is moved to DELETING,
+// so platformd tears down the workload before the instance is deleted.
+type CleanupPolicy struct {
+	State resource.InstanceState
+	After time.Duration
+}
+
+// DefaultCleanupPolicies lists how long instances are kept in a state, before
+// they are removed. instances that failed to be created are kept around for a
+// while, so users can still look them up and find out what happened.
+var DefaultCleanupPolicies = []CleanupPolicy{
+	{
+		State: resource.InstanceCreationFailed,
+		After: 5 * time.Minute,
+	},
+}
+
+// ParseCleanupPolicies parses a comma separated list of STATE=DURATION pairs,
+// for example "CREATION_FAILED=10m,PENDING=1h". the parsed policies are merged
+// with [DefaultCleanupPolicies], so instances in CREATION_FAILED are always
+// removed eventually.
+func ParseCleanupPolicies(s string) ([]CleanupPolicy, error) {
+	byState := make(map[resource.InstanceState]time.Duration, len(DefaultCleanupPolicies))
+	order := make([]resource.InstanceState, 0, len(DefaultCleanupPolicies))
+
+	for _, p := range DefaultCleanupPolicies {
+		byState[p.State] = p.After
+		order = append(order, p.State)
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		rawState, rawAfter, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid cleanup policy %q: expected STATE=DURATION", pair)
+		}
+
+		state := resource.InstanceState(strings.TrimSpace(rawState))
+		if state == resource.InstanceStateDeleting ||
+			ValidateTransition(Transition{From: state, To: resource.InstanceStateDeleting}) != nil {
+			return nil, fmt.Errorf("invalid cleanup policy %q: instances in %s cannot be removed", pair, state)
+		}
+
+		after, err := time.ParseDuration(strings.TrimSpace(rawAfter))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cleanup policy %q: %w", pair, err)
+		}
+
+		if after < 0 {
+			return nil, fmt.Errorf("invalid cleanup policy %q: duration must not be negative", pair)
+		}
+
+		if _, ok := byState[state]; !ok {
+			order = append(order, state)
+		}
+		byState[state] = after
+	}
+
+	ret := make([]CleanupPolicy, 0, len(order))
+	for _, state := range order {
+		ret = append(ret, CleanupPolicy{
+			State: state,
+			After: byState[state],
+		})
+	}
+
+	return ret, nil
+}
+
+// transitions contains all state changes an instance is allowed to make.
+// staying in the same state is always allowed, because platformd reports
+// the state of all instances on every sync. all states except CREATION_FAILED
+// can directly go to DELETED, because the workload can vanish at any time, for
+// example when the node is restarted. instances that failed to be created are
+// retained until their [CleanupPolicy] moves them to DELETING. instances that
+// have not been stopped become LOST if their node stops sending heartbeats.
+// lost instances never become RUNNING again, they are removed once the node
+// returns.
+var transitions = map[resource.InstanceState][]transitionRule{
+	resource.InstanceStatePending: {
+		{to: resource.InstanceStateCreating},
+		// platformd might create the workload in between two
+		// syncs, so we never observe CREATING in that case.
+		{to: resource.InstanceStateRunning, guard: requirePort},
+		{to: resource.InstanceCreationFailed},
+		{to: resource.InstanceStateDeleting},
+		{to: resource.InstanceStateDeleted},
+		{to: resource.InstanceStateLost},
+	},
+	resource.InstanceStateCreating: {
+		{to: resource.InstanceStateRunning, guard: requirePort},
+		{to: resource.InstanceCreationFailed},
+		{to: resource.InstanceStateDeleting},
+		{to: resource.InstanceStateDeleted},
+		{to: resource.InstanceStateLost},
+	},
+	resource.InstanceStateRunning: {
+		{to: resource.InstanceStateDeleting},
+		{to: resource.InstanceStateDeleted},
+		{to: resource.InstanceStateLost},
+	},
+	resource.InstanceCreationFailed: {
+		{to: resource.InstanceStateDeleting},
+	},
+	resource.InstanceStateLost: {
+		{to: resource.InstanceStateDeleting},
+		{to: resource.InstanceStateDeleted},
+	},
+	resource.InstanceStateDeleting: {
+		{to: resource.InstanceStateDeleted},
+	},
+}
+
+// ValidateTransition returns [apierrs.ErrInvalidStateTransition]
+// if the instance is not allowed to make the given transition.
+func ValidateTransition(t Transition) error {
+	if t.From == t.To {
+		return nil
+	}
+
+	for _, rule := range transitions[t.From] {
+		if rule.to != t.To {
+			continue
+		}
+
+		if rule.guard != nil {
+			if err := rule.guard(t); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return apierrs.ErrInvalidStateTransition
+}
+
+// SourceStates returns all states an instance can be in, in order to be
+// allowed to make the given transition. From is ignored. the target state
+// itself is always part of the returned states.
+func SourceStates(t Transition) []resource.InstanceState {
+	ret := []resource.InstanceState{t.To}
+	for from := range transitions {
+		t.From = from
+		if from != t.To && ValidateTransition(t) == nil {
+			ret = append(ret, from)
+		}
+	}
+	return ret
+}
+
+func requirePort(t Transition) error {
+	if t.Port == 0 {
+		return fmt.Errorf("%w: port is required for state %s", apierrs.ErrInvalidStateTransition, t.To)
+	}
+	return nil
+}
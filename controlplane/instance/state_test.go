@@ -0,0 +1,237 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance_test
+
+import (
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		name string
+		t    instance.Transition
+		err  error
+	}{
+		{
+			name: "same state is always allowed",
+			t: instance.Transition{
+				From: resource.InstanceStateDeleting,
+				To:   resource.InstanceStateDeleting,
+			},
+		},
+		{
+			name: "pending to running with port",
+			t: instance.Transition{
+				From: resource.InstanceStatePending,
+				To:   resource.InstanceStateRunning,
+				Port: 30000,
+			},
+		},
+		{
+			name: "running requires port",
+			t: instance.Transition{
+				From: resource.InstanceStateCreating,
+				To:   resource.InstanceStateRunning,
+			},
+			err: apierrs.ErrInvalidStateTransition,
+		},
+		{
+			name: "deleting cannot go back to running",
+			t: instance.Transition{
+				From: resource.InstanceStateDeleting,
+				To:   resource.InstanceStateRunning,
+				Port: 30000,
+			},
+			err: apierrs.ErrInvalidStateTransition,
+		},
+		{
+			name: "running cannot fail creation",
+			t: instance.Transition{
+				From: resource.InstanceStateRunning,
+				To:   resource.InstanceCreationFailed,
+			},
+			err: apierrs.ErrInvalidStateTransition,
+		},
+		{
+			name: "running can be deleted",
+			t: instance.Transition{
+				From: resource.InstanceStateRunning,
+				To:   resource.InstanceStateDeleted,
+			},
+		},
+		{
+			name: "creation failed is retained until cleanup",
+			t: instance.Transition{
+				From: resource.InstanceCreationFailed,
+				To:   resource.InstanceStateDeleted,
+			},
+			err: apierrs.ErrInvalidStateTransition,
+		},
+		{
+			name: "lost cannot go back to running",
+			t: instance.Transition{
+				From: resource.InstanceStateLost,
+				To:   resource.InstanceStateRunning,
+				Port: 30000,
+			},
+			err: apierrs.ErrInvalidStateTransition,
+		},
+		{
+			name: "deleted is final",
+			t: instance.Transition{
+				From: resource.InstanceStateDeleted,
+				To:   resource.InstanceStatePending,
+			},
+			err: apierrs.ErrInvalidStateTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := instance.ValidateTransition(tt.t)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSourceStates(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        instance.Transition
+		expected []resource.InstanceState
+	}{
+		{
+			name: "running with port",
+			t: instance.Transition{
+				To:   resource.InstanceStateRunning,
+				Port: 30000,
+			},
+			expected: []resource.InstanceState{
+				resource.InstanceStateRunning,
+				resource.InstanceStatePending,
+				resource.InstanceStateCreating,
+			},
+		},
+		{
+			name: "running without port",
+			t: instance.Transition{
+				To: resource.InstanceStateRunning,
+			},
+			expected: []resource.InstanceState{
+				resource.InstanceStateRunning,
+			},
+		},
+		{
+			name: "deleting",
+			t: instance.Transition{
+				To: resource.InstanceStateDeleting,
+			},
+			expected: []resource.InstanceState{
+				resource.InstanceStateDeleting,
+				resource.InstanceStatePending,
+				resource.InstanceStateCreating,
+				resource.InstanceStateRunning,
+				resource.InstanceCreationFailed,
+				resource.InstanceStateLost,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ElementsMatch(t, tt.expected, instance.SourceStates(tt.t))
+		})
+	}
+}
+
+func TestParseCleanupPolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []instance.CleanupPolicy
+		err      bool
+	}{
+		{
+			name:     "empty returns defaults",
+			input:    "",
+			expected: instance.DefaultCleanupPolicies,
+		},
+		{
+			name:  "overrides default and adds states",
+			input: "CREATION_FAILED=10m, PENDING=1h",
+			expected: []instance.CleanupPolicy{
+				{
+					State: resource.InstanceCreationFailed,
+					After: 10 * time.Minute,
+				},
+				{
+					State: resource.InstanceStatePending,
+					After: time.Hour,
+				},
+			},
+		},
+		{
+			name:  "missing duration",
+			input: "PENDING",
+			err:   true,
+		},
+		{
+			name:  "invalid duration",
+			input: "PENDING=soon",
+			err:   true,
+		},
+		{
+			name:  "negative duration",
+			input: "PENDING=-1m",
+			err:   true,
+		},
+		{
+			name:  "deleting cannot be cleaned up",
+			input: "DELETING=1m",
+			err:   true,
+		},
+		{
+			name:  "unknown state",
+			input: "SLEEPING=1m",
+			err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policies, err := instance.ParseCleanupPolicies(tt.input)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, policies)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package instance
+
+import (
+	"context"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	instancev1beta1 "github.com/spacechunks/explorer/api/instance/v1beta1"
+)
+
+// BetaServer serves the v1beta1 instance api. Requests are converted to
+// their v1alpha1 counterparts and passed to Server, so both versions
+// behave the same while they are served side by side. Once v1alpha1 is
+// removed, the conversion can be replaced by calling the service directly.
+type BetaServer struct {
+	instancev1beta1.UnimplementedInstanceServiceServer
+	alpha *Server
+}
+
+func NewBetaServer(alpha *Server) *BetaServer {
+	return &BetaServer{
+		alpha: alpha,
+	}
+}
+
+func (s *BetaServer) GetInstance(
+	ctx context.Context,
+	req *instancev1beta1.GetInstanceRequest,
+) (*instancev1beta1.GetInstanceResponse, error) {
+	resp, err := s.alpha.GetInstance(ctx, &instancev1alpha1.GetInstanceRequest{
+		Id: req.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &instancev1beta1.GetInstanceResponse{
+		Instance: instanceToV1beta1(resp.GetInstance()),
+	}, nil
+}
+
+func (s *BetaServer) ListInstances(
+	ctx context.Context,
+	req *instancev1beta1.ListInstancesRequest,
+) (*instancev1beta1.ListInstancesResponse, error) {
+	resp, err := s.alpha.ListInstances(ctx, &instancev1alpha1.ListInstancesRequest{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]*instancev1beta1.Instance, 0, len(resp.GetInstances()))
+	for _, ins := range resp.GetInstances() {
+		instances = append(instances, instanceToV1beta1(ins))
+	}
+
+	return &instancev1beta1.ListInstancesResponse{
+		Instances:     instances,
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+func (s *BetaServer) RunFlavorVersion(
+	ctx context.Context,
+	req *instancev1beta1.RunFlavorVersionRequest,
+) (*instancev1beta1.RunFlavorVersionResponse, error) {
+	resp, err := s.alpha.RunFlavorVersion(ctx, &instancev1alpha1.RunFlavorVersionRequest{
+		FlavorVersionId: req.GetFlavorVersionId(),
+		OrderedBy:       req.GetOrderedBy(),
+		Params:          paramsToV1alpha1(req.GetParams()),
+		PlacementGroup:  req.GetPlacementGroup(),
+		FlavorId:        req.GetFlavorId(),
+		Channel:         req.GetChannel(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &instancev1beta1.RunFlavorVersionResponse{
+		Instance: instanceToV1beta1(resp.GetInstance()),
+	}, nil
+}
+
+func (s *BetaServer) StopInstance(
+	ctx context.Context,
+	req *instancev1beta1.StopInstanceRequest,
+) (*instancev1beta1.StopInstanceResponse, error) {
+	if _, err := s.alpha.StopInstance(ctx, &instancev1alpha1.StopInstanceRequest{
+		Id: req.GetId(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &instancev1beta1.StopInstanceResponse{}, nil
+}
+
+func instanceToV1beta1(ins *instancev1alpha1.Instance) *instancev1beta1.Instance {
+	if ins == nil {
+		return nil
+	}
+
+	var endpoint *instancev1beta1.Endpoint
+	if ins.GetIp() != "" {
+		endpoint = &instancev1beta1.Endpoint{
+			Ip:   ins.GetIp(),
+			Port: ins.GetPort(),
+		}
+	}
+
+	return &instancev1beta1.Instance{
+		Id:              ins.GetId(),
+		ChunkId:         ins.GetChunk().GetId(),
+		ChunkName:       ins.GetChunk().GetName(),
+		FlavorId:        ins.GetFlavor().GetId(),
+		FlavorName:      ins.GetFlavor().GetName(),
+		FlavorVersionId: ins.GetFlavorVersion().GetId(),
+		FlavorVersion:   ins.GetFlavorVersion().GetVersion(),
+		Endpoint:        endpoint,
+		State:           stateToV1beta1(ins.GetState()),
+		OwnerId:         ins.GetOwner().GetId(),
+		OrderedBy:       ins.GetOrderedBy(),
+		Params:          paramsToV1beta1(ins.GetParams()),
+		PlacementGroup:  ins.GetPlacementGroup(),
+	}
+}
+
+func stateToV1beta1(s instancev1alpha1.InstanceState) instancev1beta1.InstanceState {
+	switch s {
+	case instancev1alpha1.InstanceState_PENDING:
+		return instancev1beta1.InstanceState_INSTANCE_STATE_PENDING
+	case instancev1alpha1.InstanceState_CREATING:
+		return instancev1beta1.InstanceState_INSTANCE_STATE_CREATING
+	case instancev1alpha1.InstanceState_RUNNING:
+		return instancev1beta1.InstanceState_INSTANCE_STATE_RUNNING
+	case instancev1alpha1.InstanceState_DELETING:
+		return instancev1beta1.InstanceState_INSTANCE_STATE_DELETING
+	case instancev1alpha1.InstanceState_DELETED:
+		return instancev1beta1.InstanceState_INSTANCE_STATE_DELETED
+	case instancev1alpha1.InstanceState_CREATION_FAILED:
+		return instancev1beta1.InstanceState_INSTANCE_STATE_CREATION_FAILED
+	case instancev1alpha1.InstanceState_LOST:
+		return instancev1beta1.InstanceState_INSTANCE_STATE_LOST
+	default:
+		return instancev1beta1.InstanceState_INSTANCE_STATE_UNSPECIFIED
+	}
+}
+
+// paramsToV1beta1 converts the params of an instance. the game modes of
+// both versions share the same numbers, only the names are prefixed in
+// v1beta1, so they can be converted directly.
+func paramsToV1beta1(p *instancev1alpha1.InstanceParams) *instancev1beta1.InstanceParams {
+	if p == nil {
+		return nil
+	}
+
+	return &instancev1beta1.InstanceParams{
+		WorldSeed: p.GetWorldSeed(),
+		GameMode:  instancev1beta1.GameMode(p.GetGameMode()),
+		Custom:    p.GetCustom(),
+	}
+}
+
+func paramsToV1alpha1(p *instancev1beta1.InstanceParams) *instancev1alpha1.InstanceParams {
+	if p == nil {
+		return nil
+	}
+
+	return &instancev1alpha1.InstanceParams{
+		WorldSeed: p.GetWorldSeed(),
+		GameMode:  instancev1alpha1.GameMode(p.GetGameMode()),
+		Custom:    p.GetCustom(),
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package integration
+
+import (
+	"context"
+
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+type Repository interface {
+	// Backends returns all running instances. if chunkName or flavorName
+	// are not empty, only instances of matching chunks or flavors are
+	// returned.
+	Backends(ctx context.Context, chunkName string, flavorName string) ([]resource.Backend, error)
+
+	// ApplyPlayerEvents records the given events in order. events
+	// for instances that do not exist are ignored.
+	ApplyPlayerEvents(ctx context.Context, events []resource.PlayerEvent) error
+
+	// RecordJoinMiss remembers that a player could not join the chunk,
+	// because none of its instances had room for them. the autoscaler
+	// counts these players as demand that has not been served yet.
+	RecordJoinMiss(ctx context.Context, chunkName string, flavorName string) error
+
+	// InstanceBackend returns the backend of the instance, if it is running.
+	InstanceBackend(ctx context.Context, instanceID string) (resource.Backend, error)
+
+	// InstanceInviteByToken returns the invite the given token belongs to.
+	InstanceInviteByToken(ctx context.Context, token string) (resource.InstanceInvite, error)
+
+	// UseInstanceInvite counts a use of the invite, unless it has been
+	// revoked, expired or used up in the meantime. returns whether the
+	// use has been counted.
+	UseInstanceInvite(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,110 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package integration
+
+import (
+	"context"
+
+	integrationv1alpha1 "github.com/spacechunks/explorer/api/integration/v1alpha1"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/internal/resource/codec"
+)
+
+type Server struct {
+	integrationv1alpha1.UnimplementedIntegrationServiceServer
+	service Service
+}
+
+func NewServer(service Service) *Server {
+	return &Server{
+		service: service,
+	}
+}
+
+func (s *Server) ResolveChunk(
+	ctx context.Context,
+	req *integrationv1alpha1.ResolveChunkRequest,
+) (*integrationv1alpha1.ResolveChunkResponse, error) {
+	b, err := s.service.ResolveChunk(ctx, req.GetChunkName(), req.GetFlavorName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &integrationv1alpha1.ResolveChunkResponse{
+		Backend: codec.BackendToTransport(b),
+	}, nil
+}
+
+func (s *Server) ListBackends(
+	ctx context.Context,
+	_ *integrationv1alpha1.ListBackendsRequest,
+) (*integrationv1alpha1.ListBackendsResponse, error) {
+	backends, err := s.service.ListBackends(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*integrationv1alpha1.Backend, 0, len(backends))
+	for _, b := range backends {
+		ret = append(ret, codec.BackendToTransport(b))
+	}
+
+	return &integrationv1alpha1.ListBackendsResponse{
+		Backends: ret,
+	}, nil
+}
+
+func (s *Server) ReportPlayerEvents(
+	ctx context.Context,
+	req *integrationv1alpha1.ReportPlayerEventsRequest,
+) (*integrationv1alpha1.ReportPlayerEventsResponse, error) {
+	events := make([]resource.PlayerEvent, 0, len(req.GetEvents()))
+	for _, e := range req.GetEvents() {
+		typ := resource.PlayerEventTypeJoin
+		if e.GetType() == integrationv1alpha1.PlayerEventType_LEAVE {
+			typ = resource.PlayerEventTypeLeave
+		}
+
+		events = append(events, resource.PlayerEvent{
+			InstanceID: e.GetInstanceId(),
+			PlayerID:   e.GetPlayerId(),
+			Type:       typ,
+		})
+	}
+
+	if err := s.service.ReportPlayerEvents(ctx, events); err != nil {
+		return nil, err
+	}
+
+	return &integrationv1alpha1.ReportPlayerEventsResponse{}, nil
+}
+
+func (s *Server) RedeemInstanceInvite(
+	ctx context.Context,
+	req *integrationv1alpha1.RedeemInstanceInviteRequest,
+) (*integrationv1alpha1.RedeemInstanceInviteResponse, error) {
+	b, err := s.service.RedeemInstanceInvite(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &integrationv1alpha1.RedeemInstanceInviteResponse{
+		Backend: codec.BackendToTransport(b),
+	}, nil
+}
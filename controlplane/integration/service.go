@@ -0,0 +1,166 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// Service is used by proxy plugins, like Velocity or BungeeCord,
+// to route players to instances and report players joining or
+// leaving them.
+type Service interface {
+	// ResolveChunk returns the instance of the chunk with the given name
+	// that has the fewest players, but still has room for another one.
+	ResolveChunk(ctx context.Context, chunkName string, flavorName string) (resource.Backend, error)
+	ListBackends(ctx context.Context) ([]resource.Backend, error)
+	ReportPlayerEvents(ctx context.Context, events []resource.PlayerEvent) error
+
+	// RedeemInstanceInvite returns the instance the invite with the given
+	// token belongs to and counts a use of the invite. the invite is only
+	// used, if the instance is running and has room for another player.
+	RedeemInstanceInvite(ctx context.Context, token string) (resource.Backend, error)
+}
+
+type svc struct {
+	logger *slog.Logger
+	repo   Repository
+}
+
+func NewService(logger *slog.Logger, repo Repository) Service {
+	return &svc{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+func (s *svc) ResolveChunk(ctx context.Context, chunkName string, flavorName string) (resource.Backend, error) {
+	backends, err := s.repo.Backends(ctx, chunkName, flavorName)
+	if err != nil {
+		return resource.Backend{}, fmt.Errorf("backends: %w", err)
+	}
+
+	var (
+		found bool
+		best  resource.Backend
+	)
+
+	for _, b := range backends {
+		// chunk names are reserved globally, so this should never
+		// happen. guard against it anyway rather than picking a
+		// random chunk.
+		if b.ChunkID != backends[0].ChunkID {
+			return resource.Backend{}, apierrs.ErrChunkNameAmbiguous
+		}
+
+		if b.Players >= b.MaxPlayers {
+			continue
+		}
+
+		if !found || b.Players < best.Players {
+			best = b
+			found = true
+		}
+	}
+
+	if !found {
+		// the player is still rejected if recording fails, so
+		// there is no reason to fail the request because of it.
+		if err := s.repo.RecordJoinMiss(ctx, chunkName, flavorName); err != nil {
+			s.logger.ErrorContext(ctx, "failed to record join miss", "chunk_name", chunkName, "err", err)
+		}
+		return resource.Backend{}, apierrs.ErrNoJoinableInstance
+	}
+
+	return best, nil
+}
+
+func (s *svc) ListBackends(ctx context.Context) ([]resource.Backend, error) {
+	backends, err := s.repo.Backends(ctx, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("backends: %w", err)
+	}
+	return backends, nil
+}
+
+func (s *svc) ReportPlayerEvents(ctx context.Context, events []resource.PlayerEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err := s.repo.ApplyPlayerEvents(ctx, events); err != nil {
+		return fmt.Errorf("apply player events: %w", err)
+	}
+
+	return nil
+}
+
+func (s *svc) RedeemInstanceInvite(ctx context.Context, token string) (resource.Backend, error) {
+	invite, err := s.repo.InstanceInviteByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, apierrs.ErrInstanceInviteNotFound) {
+			return resource.Backend{}, apierrs.ErrInstanceInviteInvalid
+		}
+		return resource.Backend{}, fmt.Errorf("instance invite by token: %w", err)
+	}
+
+	if !inviteUsable(invite, time.Now()) {
+		return resource.Backend{}, apierrs.ErrInstanceInviteInvalid
+	}
+
+	b, err := s.repo.InstanceBackend(ctx, invite.InstanceID)
+	if err != nil {
+		if errors.Is(err, apierrs.ErrInstanceNotRunning) {
+			return resource.Backend{}, apierrs.ErrNoJoinableInstance
+		}
+		return resource.Backend{}, fmt.Errorf("instance backend: %w", err)
+	}
+
+	if b.Players >= b.MaxPlayers {
+		return resource.Backend{}, apierrs.ErrNoJoinableInstance
+	}
+
+	// the invite might have been used up by another
+	// player since we looked it up.
+	used, err := s.repo.UseInstanceInvite(ctx, invite.ID)
+	if err != nil {
+		return resource.Backend{}, fmt.Errorf("use instance invite: %w", err)
+	}
+
+	if !used {
+		return resource.Backend{}, apierrs.ErrInstanceInviteInvalid
+	}
+
+	return b, nil
+}
+
+// inviteUsable reports whether the invite has not been revoked, expired or used up.
+func inviteUsable(invite resource.InstanceInvite, now time.Time) bool {
+	if invite.RevokedAt != nil || !now.Before(invite.ExpiresAt) {
+		return false
+	}
+	return invite.MaxUses == 0 || invite.Uses < invite.MaxUses
+}
@@ -0,0 +1,243 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/netip"
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/integration"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveChunk(t *testing.T) {
+	backend := func(instanceID string, chunkID string, players uint32, maxPlayers uint32) resource.Backend {
+		return resource.Backend{
+			InstanceID: instanceID,
+			ChunkID:    chunkID,
+			ChunkName:  "lobby",
+			FlavorName: "default",
+			Address:    netip.MustParseAddrPort("10.0.0.1:30000"),
+			Players:    players,
+			MaxPlayers: maxPlayers,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		backends []resource.Backend
+		expected string
+		err      error
+	}{
+		{
+			name: "instance with fewest players is chosen",
+			backends: []resource.Backend{
+				backend("a", "chunk", 5, 10),
+				backend("b", "chunk", 2, 10),
+				backend("c", "chunk", 7, 10),
+			},
+			expected: "b",
+		},
+		{
+			name: "full instances are skipped",
+			backends: []resource.Backend{
+				backend("a", "chunk", 1, 1),
+				backend("b", "chunk", 9, 10),
+			},
+			expected: "b",
+		},
+		{
+			name: "all instances full",
+			backends: []resource.Backend{
+				backend("a", "chunk", 1, 1),
+			},
+			err: apierrs.ErrNoJoinableInstance,
+		},
+		{
+			name: "no instances",
+			err:  apierrs.ErrNoJoinableInstance,
+		},
+		{
+			name: "chunk name is ambiguous",
+			backends: []resource.Backend{
+				backend("a", "chunk1", 0, 10),
+				backend("b", "chunk2", 0, 10),
+			},
+			err: apierrs.ErrChunkNameAmbiguous,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockIntegrationRepository(t)
+				svc  = integration.NewService(slog.New(slog.DiscardHandler), repo)
+			)
+
+			repo.EXPECT().
+				Backends(mocky.Anything, "lobby", "default").
+				Return(tt.backends, nil)
+
+			if errors.Is(tt.err, apierrs.ErrNoJoinableInstance) {
+				repo.EXPECT().
+					RecordJoinMiss(mocky.Anything, "lobby", "default").
+					Return(nil)
+			}
+
+			b, err := svc.ResolveChunk(ctx, "lobby", "default")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, b.InstanceID)
+		})
+	}
+}
+
+func TestRedeemInstanceInvite(t *testing.T) {
+	var (
+		now     = time.Now()
+		revoked = now.Add(-time.Minute)
+		valid   = resource.InstanceInvite{
+			ID:         "invite",
+			InstanceID: "instance",
+			MaxUses:    2,
+			Uses:       1,
+			ExpiresAt:  now.Add(time.Hour),
+		}
+		backend = resource.Backend{
+			InstanceID: "instance",
+			Address:    netip.MustParseAddrPort("10.0.0.1:30000"),
+			Players:    1,
+			MaxPlayers: 10,
+		}
+	)
+
+	tests := []struct {
+		name    string
+		prepare func(*mock.MockIntegrationRepository)
+		err     error
+	}{
+		{
+			name: "invite is used",
+			prepare: func(repo *mock.MockIntegrationRepository) {
+				repo.EXPECT().InstanceInviteByToken(mocky.Anything, "token").Return(valid, nil)
+				repo.EXPECT().InstanceBackend(mocky.Anything, "instance").Return(backend, nil)
+				repo.EXPECT().UseInstanceInvite(mocky.Anything, "invite").Return(true, nil)
+			},
+		},
+		{
+			name: "unknown token",
+			prepare: func(repo *mock.MockIntegrationRepository) {
+				repo.EXPECT().
+					InstanceInviteByToken(mocky.Anything, "token").
+					Return(resource.InstanceInvite{}, apierrs.ErrInstanceInviteNotFound)
+			},
+			err: apierrs.ErrInstanceInviteInvalid,
+		},
+		{
+			name: "expired invite",
+			prepare: func(repo *mock.MockIntegrationRepository) {
+				i := valid
+				i.ExpiresAt = now.Add(-time.Minute)
+				repo.EXPECT().InstanceInviteByToken(mocky.Anything, "token").Return(i, nil)
+			},
+			err: apierrs.ErrInstanceInviteInvalid,
+		},
+		{
+			name: "revoked invite",
+			prepare: func(repo *mock.MockIntegrationRepository) {
+				i := valid
+				i.RevokedAt = &revoked
+				repo.EXPECT().InstanceInviteByToken(mocky.Anything, "token").Return(i, nil)
+			},
+			err: apierrs.ErrInstanceInviteInvalid,
+		},
+		{
+			name: "used up invite",
+			prepare: func(repo *mock.MockIntegrationRepository) {
+				i := valid
+				i.Uses = i.MaxUses
+				repo.EXPECT().InstanceInviteByToken(mocky.Anything, "token").Return(i, nil)
+			},
+			err: apierrs.ErrInstanceInviteInvalid,
+		},
+		{
+			name: "invite used up concurrently",
+			prepare: func(repo *mock.MockIntegrationRepository) {
+				repo.EXPECT().InstanceInviteByToken(mocky.Anything, "token").Return(valid, nil)
+				repo.EXPECT().InstanceBackend(mocky.Anything, "instance").Return(backend, nil)
+				repo.EXPECT().UseInstanceInvite(mocky.Anything, "invite").Return(false, nil)
+			},
+			err: apierrs.ErrInstanceInviteInvalid,
+		},
+		{
+			name: "instance not running does not use invite",
+			prepare: func(repo *mock.MockIntegrationRepository) {
+				repo.EXPECT().InstanceInviteByToken(mocky.Anything, "token").Return(valid, nil)
+				repo.EXPECT().
+					InstanceBackend(mocky.Anything, "instance").
+					Return(resource.Backend{}, apierrs.ErrInstanceNotRunning)
+			},
+			err: apierrs.ErrNoJoinableInstance,
+		},
+		{
+			name: "full instance does not use invite",
+			prepare: func(repo *mock.MockIntegrationRepository) {
+				b := backend
+				b.Players = b.MaxPlayers
+				repo.EXPECT().InstanceInviteByToken(mocky.Anything, "token").Return(valid, nil)
+				repo.EXPECT().InstanceBackend(mocky.Anything, "instance").Return(b, nil)
+			},
+			err: apierrs.ErrNoJoinableInstance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockIntegrationRepository(t)
+				svc  = integration.NewService(slog.New(slog.DiscardHandler), repo)
+			)
+
+			tt.prepare(repo)
+
+			b, err := svc.RedeemInstanceInvite(ctx, "token")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, backend, b)
+		})
+	}
+}
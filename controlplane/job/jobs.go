@@ -21,6 +21,8 @@ package job
 import (
 	"github.com/google/uuid"
 	"github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/notification"
+	"github.com/spacechunks/explorer/internal/resource"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -28,6 +30,7 @@ var (
 	ErrInvalidFlavorVersionID = errors.New("invalid flavor version id")
 	ErrInvalidBaseImage       = errors.New("invalid base image")
 	ErrInvalidOCIRegistry     = errors.New("invalid registry")
+	ErrInvalidImportID        = errors.New("invalid import id")
 )
 
 type SpanContext struct {
@@ -52,6 +55,9 @@ type CreateImage struct {
 	BaseImage       string      `json:"baseImage"`
 	OCIRegistry     string      `json:"registry"`
 	SpanContext     SpanContext `json:"spanContext,omitempty"`
+
+	// passed on to the CreateCheckpoint job once the image has been built.
+	CheckpointResources *resource.CheckpointResources `json:"checkpointResources,omitempty"`
 }
 
 func (CreateImage) Kind() string {
@@ -75,9 +81,16 @@ func (c CreateImage) Validate() error {
 }
 
 type CreateCheckpoint struct {
-	FlavorVersionID string      `json:"flavorVersionId"`
-	BaseImageURL    string      `json:"baseImageUrl"`
-	SpanContext     SpanContext `json:"spanContext,omitempty"`
+	FlavorVersionID     string                        `json:"flavorVersionId"`
+	BaseImageURL        string                        `json:"baseImageUrl"`
+	SpanContext         SpanContext                   `json:"spanContext,omitempty"`
+	CheckpointResources *resource.CheckpointResources `json:"checkpointResources,omitempty"`
+	// ReuseKey identifies checkpoints with the same content.
+	// if empty, a new checkpoint is always created.
+	ReuseKey string `json:"reuseKey,omitempty"`
+	// Command overrides the command of the base image
+	// the server is started with.
+	Command []string `json:"command,omitempty"`
 }
 
 func (c CreateCheckpoint) Validate() error {
@@ -96,6 +109,24 @@ func (CreateCheckpoint) Kind() string {
 	return "create_checkpoint"
 }
 
+// ImportChunk unpacks the uploaded archive of a chunk import
+// and creates the flavor version from its files.
+type ImportChunk struct {
+	ImportID string `json:"importId"`
+}
+
+func (ImportChunk) Kind() string {
+	return "import_chunk"
+}
+
+func (c ImportChunk) Validate() error {
+	if _, err := uuid.Parse(c.ImportID); err != nil {
+		return ErrInvalidImportID
+	}
+
+	return nil
+}
+
 type CreateResourcePack struct {
 }
 
@@ -109,3 +140,69 @@ type Archive struct {
 func (Archive) Kind() string {
 	return "archive"
 }
+
+type CleanupInstances struct {
+}
+
+func (CleanupInstances) Kind() string {
+	return "cleanup_instances"
+}
+
+type ExpireInstanceLogs struct {
+}
+
+func (ExpireInstanceLogs) Kind() string {
+	return "expire_instance_logs"
+}
+
+type EnforceRetention struct {
+}
+
+func (EnforceRetention) Kind() string {
+	return "enforce_retention"
+}
+
+type MaintainPartitions struct {
+}
+
+func (MaintainPartitions) Kind() string {
+	return "maintain_partitions"
+}
+
+var ErrInvalidNotification = errors.New("invalid notification")
+
+// SendNotification sends a notification to the user affected by an event.
+// depending on the kind either FlavorVersionID or InstanceID is set.
+type SendNotification struct {
+	Type            notification.Kind `json:"type"`
+	FlavorVersionID string            `json:"flavorVersionId,omitempty"`
+	InstanceID      string            `json:"instanceId,omitempty"`
+
+	// EventID is used to make sure every instance event
+	// results in its own notification.
+	EventID string `json:"eventId,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+func (SendNotification) Kind() string {
+	return "send_notification"
+}
+
+func (n SendNotification) Validate() error {
+	if n.Type == "" {
+		return ErrInvalidNotification
+	}
+
+	if n.FlavorVersionID == "" && n.InstanceID == "" {
+		return ErrInvalidNotification
+	}
+
+	return nil
+}
+
+type RotateSigningKey struct {
+}
+
+func (RotateSigningKey) Kind() string {
+	return "rotate_signing_key"
+}
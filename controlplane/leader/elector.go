@@ -0,0 +1,230 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package leader implements leader election between control plane replicas.
+//
+// Leadership is bound to a postgres session level advisory lock. The replica
+// holding the lock runs all registered tasks, all other replicas periodically
+// try to acquire it. If the connection holding the lock breaks, postgres
+// releases the lock and another replica takes over.
+//
+// Periodic river jobs are not registered here, because river elects its own
+// leader for enqueueing them.
+package leader
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultLockID is the advisory lock key used by the control plane.
+// spells "expl" in ascii.
+const DefaultLockID int64 = 0x6578706c
+
+// DefaultRetryInterval is used if no retry interval is configured.
+const DefaultRetryInterval = 5 * time.Second
+
+// Task is a background subsystem that must only run on a single replica.
+// The passed context is canceled once leadership is lost.
+type Task func(ctx context.Context)
+
+type Config struct {
+	// ReplicaID identifies this replica in logs and metrics.
+	ReplicaID string
+
+	// LockID is the key of the advisory lock that is used for the election.
+	LockID int64
+
+	// RetryInterval is the interval in which followers try to acquire
+	// leadership and the leader checks that it still holds the lock.
+	RetryInterval time.Duration
+}
+
+type namedTask struct {
+	name string
+	fn   Task
+}
+
+type Elector struct {
+	logger  *slog.Logger
+	pool    *pgxpool.Pool
+	cfg     Config
+	metrics metrics
+
+	mu     sync.Mutex
+	tasks  []namedTask
+	leader atomic.Bool
+}
+
+func NewElector(logger *slog.Logger, pool *pgxpool.Pool, cfg Config) (*Elector, error) {
+	if cfg.RetryInterval <= 0 {
+		cfg.RetryInterval = DefaultRetryInterval
+	}
+
+	e := &Elector{
+		logger: logger,
+		pool:   pool,
+		cfg:    cfg,
+	}
+
+	m, err := initMetrics(e)
+	if err != nil {
+		return nil, err
+	}
+
+	e.metrics = m
+	return e, nil
+}
+
+// Register adds a task that is started every time this replica becomes
+// the leader. Tasks registered while already being the leader will be
+// started on the next election.
+func (e *Elector) Register(name string, task Task) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.tasks = append(e.tasks, namedTask{
+		name: name,
+		fn:   task,
+	})
+}
+
+// IsLeader reports whether this replica currently holds leadership.
+func (e *Elector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Run takes part in the election until ctx is canceled.
+func (e *Elector) Run(ctx context.Context) {
+	t := time.NewTicker(e.cfg.RetryInterval)
+	defer t.Stop()
+
+	for {
+		conn, err := e.tryAcquire(ctx)
+		if err != nil {
+			e.logger.WarnContext(ctx, "failed to acquire leadership", "err", err)
+		}
+
+		if conn != nil {
+			e.lead(ctx, conn, t)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// tryAcquire returns the connection holding the advisory lock if it
+// could be obtained. the connection has to be kept until leadership
+// is given up, because the lock is bound to the session.
+func (e *Elector) tryAcquire(ctx context.Context) (*pgxpool.Conn, error) {
+	conn, err := e.pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("acquire conn: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", e.cfg.LockID).Scan(&locked); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("try lock: %w", err)
+	}
+
+	if !locked {
+		conn.Release()
+		return nil, nil
+	}
+
+	return conn, nil
+}
+
+func (e *Elector) lead(ctx context.Context, conn *pgxpool.Conn, t *time.Ticker) {
+	e.logger.InfoContext(ctx, "acquired leadership", "replica_id", e.cfg.ReplicaID)
+	e.leader.Store(true)
+	e.metrics.transitionCount.Add(ctx, 1)
+
+	var (
+		taskCtx, cancel = context.WithCancel(ctx)
+		wg              sync.WaitGroup
+	)
+
+	e.mu.Lock()
+	for _, task := range e.tasks {
+		wg.Go(func() {
+			e.logger.InfoContext(ctx, "starting task", "task", task.name)
+			task.fn(taskCtx)
+		})
+	}
+	e.mu.Unlock()
+
+	e.hold(ctx, conn, t)
+
+	cancel()
+	wg.Wait()
+
+	e.leader.Store(false)
+	e.metrics.transitionCount.Add(ctx, 1)
+	e.release(conn)
+
+	e.logger.InfoContext(ctx, "gave up leadership", "replica_id", e.cfg.ReplicaID)
+}
+
+// hold blocks until ctx is canceled or the connection holding
+// the lock is broken.
+func (e *Elector) hold(ctx context.Context, conn *pgxpool.Conn, t *time.Ticker) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := conn.Ping(ctx); err != nil {
+				e.logger.ErrorContext(ctx, "lost connection holding leadership", "err", err)
+				// make sure the session is really gone, so the lock is freed
+				// and the broken connection is not returned to the pool.
+				_ = conn.Conn().Close(context.Background())
+				return
+			}
+		}
+	}
+}
+
+func (e *Elector) release(conn *pgxpool.Conn) {
+	defer conn.Release()
+
+	if conn.Conn().IsClosed() {
+		return
+	}
+
+	// ctx passed to Run is most likely canceled at this point
+	// so we use a separate one to unlock.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", e.cfg.LockID); err != nil {
+		e.logger.ErrorContext(ctx, "failed to release advisory lock", "err", err)
+		// closing the session releases the lock as well
+		_ = conn.Conn().Close(ctx)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package leader
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type metrics struct {
+	transitionCount metric.Int64Counter
+}
+
+func initMetrics(e *Elector) (metrics, error) {
+	meter := otel.Meter("github.com/spacechunks/explorer/controlplane/leader")
+
+	_, err := meter.Int64ObservableGauge(
+		"explorer.control_plane.leader",
+		metric.WithDescription("Whether this replica is the leader (1) or not (0)"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			var v int64
+			if e.IsLeader() {
+				v = 1
+			}
+			o.Observe(v, metric.WithAttributes(attribute.String("replica_id", e.cfg.ReplicaID)))
+			return nil
+		}),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("leader gauge: %w", err)
+	}
+
+	transitionCount, err := meter.Int64Counter(
+		"explorer.control_plane.leader.transitions.count",
+		metric.WithDescription("Total number of times this replica acquired or gave up leadership"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("leader transitions counter: %w", err)
+	}
+
+	return metrics{
+		transitionCount: transitionCount,
+	}, nil
+}
@@ -0,0 +1,43 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package lock provides locks that are shared between all control plane
+// replicas. they are used to prevent the same operation from being
+// performed concurrently, for example because a request has been retried.
+package lock
+
+import "context"
+
+type Locker interface {
+	// TryLock calls fn while holding the lock with the given key and
+	// reports true. if the lock is already held, fn is not called and
+	// false is returned. the lock is released once fn returns.
+	TryLock(ctx context.Context, key string, fn func(ctx context.Context) error) (bool, error)
+}
+
+// FlavorVersionBuildKey is the key of the lock held while a build
+// of the flavor version is being initiated.
+func FlavorVersionBuildKey(versionID string) string {
+	return "flavor-version-build/" + versionID
+}
+
+// ChunkSpecApplyKey is the key of the lock held while a spec is
+// applied to the chunk with the given slug.
+func ChunkSpecApplyKey(slug string) string {
+	return "chunk-spec-apply/" + slug
+}
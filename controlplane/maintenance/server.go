@@ -0,0 +1,500 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	adminv1alpha1 "github.com/spacechunks/explorer/api/admin/v1alpha1"
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/condition"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	"github.com/spacechunks/explorer/controlplane/cost"
+	"github.com/spacechunks/explorer/controlplane/rollout"
+	"github.com/spacechunks/explorer/controlplane/settings"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/internal/resource/codec"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements the AdminService. platformd rollouts are handled
+// by the rollout service, node conditions by the condition service,
+// cross-instance traffic requests and chunk templates by the chunk
+// service, cost reports by the cost service and runtime settings by
+// the settings service.
+type Server struct {
+	adminv1alpha1.UnimplementedAdminServiceServer
+	service          Service
+	rolloutService   rollout.Service
+	conditionService condition.Service
+	chunkService     chunk.Service
+	costService      cost.Service
+	settingsService  settings.Service
+}
+
+func NewServer(
+	service Service,
+	rolloutService rollout.Service,
+	conditionService condition.Service,
+	chunkService chunk.Service,
+	costService cost.Service,
+	settingsService settings.Service,
+) *Server {
+	return &Server{
+		service:          service,
+		rolloutService:   rolloutService,
+		conditionService: conditionService,
+		chunkService:     chunkService,
+		costService:      costService,
+		settingsService:  settingsService,
+	}
+}
+
+func (s *Server) GetMaintenanceMode(
+	ctx context.Context,
+	_ *adminv1alpha1.GetMaintenanceModeRequest,
+) (*adminv1alpha1.GetMaintenanceModeResponse, error) {
+	m, err := s.service.MaintenanceMode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.GetMaintenanceModeResponse{
+		MaintenanceMode: codec.MaintenanceModeToTransport(m),
+	}, nil
+}
+
+func (s *Server) SetMaintenanceMode(
+	ctx context.Context,
+	req *adminv1alpha1.SetMaintenanceModeRequest,
+) (*adminv1alpha1.SetMaintenanceModeResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	m, err := s.service.SetMaintenanceMode(ctx, actorID, req.GetEnabled(), req.GetMessage())
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.SetMaintenanceModeResponse{
+		MaintenanceMode: codec.MaintenanceModeToTransport(m),
+	}, nil
+}
+
+func (s *Server) SetDesiredPlatformdVersion(
+	ctx context.Context,
+	req *adminv1alpha1.SetDesiredPlatformdVersionRequest,
+) (*adminv1alpha1.SetDesiredPlatformdVersionResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	rollouts, err := s.rolloutService.SetDesiredVersion(ctx, actorID, req.GetNodeIds(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.SetDesiredPlatformdVersionResponse{
+		Rollouts: rolloutsToTransport(rollouts),
+	}, nil
+}
+
+func (s *Server) ListPlatformdRollouts(
+	ctx context.Context,
+	_ *adminv1alpha1.ListPlatformdRolloutsRequest,
+) (*adminv1alpha1.ListPlatformdRolloutsResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	rollouts, err := s.rolloutService.ListRollouts(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.ListPlatformdRolloutsResponse{
+		Rollouts: rolloutsToTransport(rollouts),
+	}, nil
+}
+
+func (s *Server) ListNodeConditions(
+	ctx context.Context,
+	_ *adminv1alpha1.ListNodeConditionsRequest,
+) (*adminv1alpha1.ListNodeConditionsResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	conditions, err := s.conditionService.ListConditions(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*adminv1alpha1.NodeCondition, 0, len(conditions))
+	for _, c := range conditions {
+		ret = append(ret, &adminv1alpha1.NodeCondition{
+			NodeId:   c.NodeID,
+			NodeName: c.NodeName,
+			Type:     adminv1alpha1.NodeConditionType(adminv1alpha1.NodeConditionType_value[string(c.Type)]),
+			Message:  c.Message,
+			Since:    timestamppb.New(c.Since),
+		})
+	}
+
+	return &adminv1alpha1.ListNodeConditionsResponse{
+		Conditions: ret,
+	}, nil
+}
+
+func (s *Server) ListNodeEvents(
+	ctx context.Context,
+	_ *adminv1alpha1.ListNodeEventsRequest,
+) (*adminv1alpha1.ListNodeEventsResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	events, err := s.conditionService.ListEvents(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*adminv1alpha1.NodeEvent, 0, len(events))
+	for _, ev := range events {
+		// the enum values are named after the event and condition types.
+		ret = append(ret, &adminv1alpha1.NodeEvent{
+			Id:            ev.ID,
+			NodeId:        ev.NodeID,
+			Type:          adminv1alpha1.NodeEventType(adminv1alpha1.NodeEventType_value[string(ev.Type)]),
+			ConditionType: adminv1alpha1.NodeConditionType(adminv1alpha1.NodeConditionType_value[string(ev.ConditionType)]),
+			Message:       ev.Message,
+			CreatedAt:     timestamppb.New(ev.CreatedAt),
+		})
+	}
+
+	return &adminv1alpha1.ListNodeEventsResponse{
+		Events: ret,
+	}, nil
+}
+
+func (s *Server) ListNodeCapacities(
+	ctx context.Context,
+	_ *adminv1alpha1.ListNodeCapacitiesRequest,
+) (*adminv1alpha1.ListNodeCapacitiesResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	nodes, err := s.conditionService.ListNodeCapacities(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*adminv1alpha1.NodeCapacity, 0, len(nodes))
+	for _, n := range nodes {
+		caps := make([]string, 0, len(n.Capabilities))
+		for _, c := range n.Capabilities {
+			caps = append(caps, string(c))
+		}
+
+		c := &adminv1alpha1.NodeCapacity{
+			NodeId:         n.ID,
+			NodeName:       n.Name,
+			Slots:          uint32(n.Slots),
+			AvailableSlots: uint32(n.AvailableSlots),
+			Unhealthy:      n.Unhealthy,
+			Capabilities:   caps,
+			Lost:           n.Lost,
+		}
+
+		if !n.LastSeenAt.IsZero() {
+			c.LastSeenAt = timestamppb.New(n.LastSeenAt)
+		}
+
+		ret = append(ret, c)
+	}
+
+	return &adminv1alpha1.ListNodeCapacitiesResponse{
+		Nodes: ret,
+	}, nil
+}
+
+func (s *Server) ListCrossInstanceTrafficRequests(
+	ctx context.Context,
+	_ *adminv1alpha1.ListCrossInstanceTrafficRequestsRequest,
+) (*adminv1alpha1.ListCrossInstanceTrafficRequestsResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	requests, err := s.chunkService.ListCrossInstanceTrafficRequests(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*chunkv1alpha1.CrossInstanceTraffic, 0, len(requests))
+	for _, r := range requests {
+		ret = append(ret, codec.CrossInstanceTrafficToTransport(r))
+	}
+
+	return &adminv1alpha1.ListCrossInstanceTrafficRequestsResponse{
+		Requests: ret,
+	}, nil
+}
+
+func (s *Server) ReviewCrossInstanceTraffic(
+	ctx context.Context,
+	req *adminv1alpha1.ReviewCrossInstanceTrafficRequest,
+) (*adminv1alpha1.ReviewCrossInstanceTrafficResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	t, err := s.chunkService.ReviewCrossInstanceTraffic(
+		ctx,
+		actorID,
+		req.GetChunkId(),
+		req.GetApproved(),
+		req.GetMessage(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.ReviewCrossInstanceTrafficResponse{
+		Traffic: codec.CrossInstanceTrafficToTransport(t),
+	}, nil
+}
+
+func (s *Server) CreateChunkTemplate(
+	ctx context.Context,
+	req *adminv1alpha1.CreateChunkTemplateRequest,
+) (*adminv1alpha1.CreateChunkTemplateResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	t, err := s.chunkService.CreateChunkTemplate(ctx, actorID, resource.ChunkTemplate{
+		Name:            req.GetName(),
+		Description:     req.GetDescription(),
+		FlavorVersionID: req.GetFlavorVersionId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.CreateChunkTemplateResponse{
+		Template: codec.ChunkTemplateToTransport(t),
+	}, nil
+}
+
+func (s *Server) UpdateChunkTemplate(
+	ctx context.Context,
+	req *adminv1alpha1.UpdateChunkTemplateRequest,
+) (*adminv1alpha1.UpdateChunkTemplateResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	t, err := s.chunkService.UpdateChunkTemplate(ctx, actorID, resource.ChunkTemplate{
+		ID:              req.GetId(),
+		Description:     req.GetDescription(),
+		FlavorVersionID: req.GetFlavorVersionId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.UpdateChunkTemplateResponse{
+		Template: codec.ChunkTemplateToTransport(t),
+	}, nil
+}
+
+func (s *Server) DeleteChunkTemplate(
+	ctx context.Context,
+	req *adminv1alpha1.DeleteChunkTemplateRequest,
+) (*adminv1alpha1.DeleteChunkTemplateResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	if err := s.chunkService.DeleteChunkTemplate(ctx, actorID, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.DeleteChunkTemplateResponse{}, nil
+}
+
+func (s *Server) GetCostReport(
+	ctx context.Context,
+	req *adminv1alpha1.GetCostReportRequest,
+) (*adminv1alpha1.GetCostReportResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	var start, end time.Time
+	if req.Start != nil {
+		start = req.GetStart().AsTime()
+	}
+
+	if req.End != nil {
+		end = req.GetEnd().AsTime()
+	}
+
+	report, err := s.costService.Report(ctx, actorID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make([]*chunkv1alpha1.ChunkCost, 0, len(report.Chunks))
+	for _, c := range report.Chunks {
+		chunks = append(chunks, codec.ChunkCostToTransport(c))
+	}
+
+	nodes := make([]*adminv1alpha1.NodeCost, 0, len(report.Nodes))
+	for _, n := range report.Nodes {
+		nodes = append(nodes, &adminv1alpha1.NodeCost{
+			NodeId:     n.NodeID,
+			NodeName:   n.NodeName,
+			Hours:      n.Hours,
+			Total:      n.Total,
+			Attributed: n.Attributed,
+			Idle:       n.Idle(),
+		})
+	}
+
+	return &adminv1alpha1.GetCostReportResponse{
+		Start:  timestamppb.New(report.Start),
+		End:    timestamppb.New(report.End),
+		Chunks: chunks,
+		Nodes:  nodes,
+	}, nil
+}
+
+func (s *Server) GetRuntimeSettings(
+	ctx context.Context,
+	_ *adminv1alpha1.GetRuntimeSettingsRequest,
+) (*adminv1alpha1.GetRuntimeSettingsResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	rs, err := s.settingsService.Settings(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.GetRuntimeSettingsResponse{
+		Settings: runtimeSettingsToTransport(rs),
+	}, nil
+}
+
+func (s *Server) SetLogLevels(
+	ctx context.Context,
+	req *adminv1alpha1.SetLogLevelsRequest,
+) (*adminv1alpha1.SetLogLevelsResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	var target resource.LogTarget
+	switch req.GetTarget() {
+	case adminv1alpha1.LogTarget_LOG_TARGET_CONTROLPLANE:
+		target = resource.LogTargetControlPlane
+	case adminv1alpha1.LogTarget_LOG_TARGET_PLATFORMD:
+		target = resource.LogTargetPlatformd
+	}
+
+	rs, err := s.settingsService.SetLogLevels(ctx, actorID, target, req.GetLogLevels())
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.SetLogLevelsResponse{
+		Settings: runtimeSettingsToTransport(rs),
+	}, nil
+}
+
+func (s *Server) SetFeatureFlag(
+	ctx context.Context,
+	req *adminv1alpha1.SetFeatureFlagRequest,
+) (*adminv1alpha1.SetFeatureFlagResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	rs, err := s.settingsService.SetFeatureFlag(
+		ctx,
+		actorID,
+		resource.FeatureFlag(req.GetName()),
+		req.GetEnabled(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1alpha1.SetFeatureFlagResponse{
+		Settings: runtimeSettingsToTransport(rs),
+	}, nil
+}
+
+func (s *Server) ListRuntimeSettingChanges(
+	ctx context.Context,
+	_ *adminv1alpha1.ListRuntimeSettingChangesRequest,
+) (*adminv1alpha1.ListRuntimeSettingChangesResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	changes, err := s.settingsService.ListChanges(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*adminv1alpha1.RuntimeSettingChange, 0, len(changes))
+	for _, c := range changes {
+		ret = append(ret, &adminv1alpha1.RuntimeSettingChange{
+			Id:        c.ID,
+			Setting:   c.Setting,
+			OldValue:  c.OldValue,
+			NewValue:  c.NewValue,
+			ActorId:   c.ActorID,
+			CreatedAt: timestamppb.New(c.CreatedAt),
+		})
+	}
+
+	return &adminv1alpha1.ListRuntimeSettingChangesResponse{
+		Changes: ret,
+	}, nil
+}
+
+// runtimeSettingsToTransport lists all known feature flags,
+// so admins can see which ones can be changed.
+func runtimeSettingsToTransport(rs resource.RuntimeSettings) *adminv1alpha1.RuntimeSettings {
+	flags := make([]*adminv1alpha1.FeatureFlag, 0, len(resource.FeatureFlags))
+	for _, f := range resource.FeatureFlags {
+		flags = append(flags, &adminv1alpha1.FeatureFlag{
+			Name:    string(f),
+			Enabled: rs.Enabled(f),
+		})
+	}
+
+	return &adminv1alpha1.RuntimeSettings{
+		ControlplaneLogLevels: rs.LogLevels[resource.LogTargetControlPlane],
+		PlatformdLogLevels:    rs.LogLevels[resource.LogTargetPlatformd],
+		FeatureFlags:          flags,
+	}
+}
+
+func rolloutsToTransport(rollouts []rollout.Rollout) []*adminv1alpha1.PlatformdRollout {
+	ret := make([]*adminv1alpha1.PlatformdRollout, 0, len(rollouts))
+	for _, r := range rollouts {
+		// the enum values are named after the rollout statuses.
+		// nodes without a rollout map to UPDATE_NONE.
+		status := adminv1alpha1.PlatformdUpdateStatus_value["UPDATE_"+string(r.Status)]
+
+		t := &adminv1alpha1.PlatformdRollout{
+			NodeId:         r.NodeID,
+			NodeName:       r.NodeName,
+			CurrentVersion: r.CurrentVersion,
+			DesiredVersion: r.DesiredVersion,
+			Status:         adminv1alpha1.PlatformdUpdateStatus(status),
+			Message:        r.Message,
+		}
+
+		if !r.UpdatedAt.IsZero() {
+			t.UpdatedAt = timestamppb.New(r.UpdatedAt)
+		}
+
+		ret = append(ret, t)
+	}
+	return ret
+}
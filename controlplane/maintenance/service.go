@@ -0,0 +1,148 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+type Service interface {
+	MaintenanceMode(ctx context.Context) (resource.MaintenanceMode, error)
+	SetMaintenanceMode(
+		ctx context.Context,
+		actorID string,
+		enabled bool,
+		message string,
+	) (resource.MaintenanceMode, error)
+
+	// Check returns an error telling the user that the platform is under
+	// maintenance if the maintenance mode is enabled.
+	Check(ctx context.Context) error
+}
+
+type Config struct {
+	// CacheTTL is how long the maintenance mode is cached before it is
+	// queried again. changes made by other replicas apply after this long.
+	CacheTTL time.Duration
+}
+
+type svc struct {
+	logger       *slog.Logger
+	repo         Repository
+	adminUserIDs []string
+	cfg          Config
+
+	mu       sync.Mutex
+	cached   *resource.MaintenanceMode
+	cachedAt time.Time
+}
+
+func NewService(logger *slog.Logger, repo Repository, adminUserIDs []string, cfg Config) Service {
+	return &svc{
+		logger:       logger,
+		repo:         repo,
+		adminUserIDs: adminUserIDs,
+		cfg:          cfg,
+	}
+}
+
+func (s *svc) MaintenanceMode(ctx context.Context) (resource.MaintenanceMode, error) {
+	m, err := s.repo.MaintenanceMode(ctx)
+	if err != nil {
+		return resource.MaintenanceMode{}, fmt.Errorf("maintenance mode: %w", err)
+	}
+
+	s.cache(m)
+	return m, nil
+}
+
+func (s *svc) SetMaintenanceMode(
+	ctx context.Context,
+	actorID string,
+	enabled bool,
+	message string,
+) (resource.MaintenanceMode, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return resource.MaintenanceMode{}, apierrs.ErrPermissionDenied
+	}
+
+	m, err := s.repo.SetMaintenanceMode(ctx, enabled, message, actorID)
+	if err != nil {
+		return resource.MaintenanceMode{}, fmt.Errorf("set maintenance mode: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "maintenance mode changed", "enabled", enabled, "actor_id", actorID)
+
+	s.cache(m)
+	return m, nil
+}
+
+func (s *svc) Check(ctx context.Context) error {
+	m, err := s.current(ctx)
+	if err != nil {
+		return err
+	}
+
+	if m.Enabled {
+		return apierrs.MaintenanceMode(m.Message)
+	}
+
+	return nil
+}
+
+// current returns the cached maintenance mode if it has not expired yet. if
+// querying the database fails, the last known maintenance mode is used, so
+// a database that is unavailable during maintenance does not fail requests
+// that would have been rejected anyway.
+func (s *svc) current(ctx context.Context) (resource.MaintenanceMode, error) {
+	s.mu.Lock()
+	cached, cachedAt := s.cached, s.cachedAt
+	s.mu.Unlock()
+
+	if cached != nil && time.Since(cachedAt) < s.cfg.CacheTTL {
+		return *cached, nil
+	}
+
+	m, err := s.repo.MaintenanceMode(ctx)
+	if err != nil {
+		if cached != nil {
+			s.logger.WarnContext(ctx, "failed to query maintenance mode, using last known state", "err", err)
+			return *cached, nil
+		}
+		return resource.MaintenanceMode{}, fmt.Errorf("maintenance mode: %w", err)
+	}
+
+	s.cache(m)
+	return m, nil
+}
+
+func (s *svc) cache(m resource.MaintenanceMode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cached = &m
+	s.cachedAt = time.Now()
+}
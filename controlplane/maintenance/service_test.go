@@ -0,0 +1,166 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package maintenance_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/maintenance"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+const adminID = "admin"
+
+func TestSetMaintenanceMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID string
+		err     error
+		prep    func(*mock.MockMaintenanceRepository)
+	}{
+		{
+			name:    "admin can enable maintenance mode",
+			actorID: adminID,
+			prep: func(repo *mock.MockMaintenanceRepository) {
+				repo.EXPECT().
+					SetMaintenanceMode(mocky.Anything, true, "db upgrade", adminID).
+					Return(resource.MaintenanceMode{Enabled: true, Message: "db upgrade", UpdatedBy: adminID}, nil)
+			},
+		},
+		{
+			name:    "other users are not allowed",
+			actorID: "someone",
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockMaintenanceRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockMaintenanceRepository(t)
+				svc  = maintenance.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID}, maintenance.Config{})
+			)
+
+			tt.prep(repo)
+
+			m, err := svc.SetMaintenanceMode(ctx, tt.actorID, true, "db upgrade")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, m.Enabled)
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		code codes.Code
+		prep func(*mock.MockMaintenanceRepository)
+	}{
+		{
+			name: "disabled",
+			code: codes.OK,
+			prep: func(repo *mock.MockMaintenanceRepository) {
+				repo.EXPECT().MaintenanceMode(mocky.Anything).Return(resource.MaintenanceMode{}, nil).Twice()
+			},
+		},
+		{
+			name: "enabled",
+			code: codes.Unavailable,
+			prep: func(repo *mock.MockMaintenanceRepository) {
+				repo.EXPECT().
+					MaintenanceMode(mocky.Anything).
+					Return(resource.MaintenanceMode{Enabled: true}, nil).
+					Twice()
+			},
+		},
+		{
+			name: "uses last known state if database is unavailable",
+			code: codes.Unavailable,
+			prep: func(repo *mock.MockMaintenanceRepository) {
+				repo.EXPECT().
+					MaintenanceMode(mocky.Anything).
+					Return(resource.MaintenanceMode{Enabled: true}, nil).
+					Once()
+				repo.EXPECT().
+					MaintenanceMode(mocky.Anything).
+					Return(resource.MaintenanceMode{}, errors.New("connection refused")).
+					Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockMaintenanceRepository(t)
+				svc  = maintenance.NewService(slog.New(slog.DiscardHandler), repo, nil, maintenance.Config{
+					// expire immediately, so every check queries the repository
+					CacheTTL: time.Nanosecond,
+				})
+			)
+
+			tt.prep(repo)
+
+			for range 2 {
+				err := svc.Check(ctx)
+				if tt.code == codes.OK {
+					require.NoError(t, err)
+					continue
+				}
+
+				var apiErr apierrs.Error
+				require.ErrorAs(t, err, &apiErr)
+				require.Equal(t, tt.code, apiErr.Code)
+			}
+		})
+	}
+}
+
+func TestCheckIsCached(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		repo = mock.NewMockMaintenanceRepository(t)
+		svc  = maintenance.NewService(slog.New(slog.DiscardHandler), repo, nil, maintenance.Config{
+			CacheTTL: time.Hour,
+		})
+	)
+
+	repo.EXPECT().MaintenanceMode(mocky.Anything).Return(resource.MaintenanceMode{}, nil).Once()
+
+	for range 3 {
+		require.NoError(t, svc.Check(ctx))
+	}
+}
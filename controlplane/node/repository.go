@@ -21,6 +21,15 @@ package node
 import (
 	"context"
 	"net/netip"
+	"time"
+)
+
+// Capability is a feature advertised by a node.
+type Capability string
+
+const (
+	// CapabilityBuilder is advertised by nodes that accept checkpoint builds.
+	CapabilityBuilder Capability = "BUILDER"
 )
 
 type Node struct {
@@ -28,11 +37,64 @@ type Node struct {
 	Name                  string
 	Addr                  netip.Addr
 	CheckpointAPIEndpoint netip.AddrPort
-	Slots                 int
-	AvailableSlots        int
+
+	// Slots is the number of instances the node can run. if the node
+	// reported a lower number of max instances, that number is used.
+	Slots          int
+	AvailableSlots int
+
+	// Unhealthy is set if the node reports at least one condition, like
+	// disk pressure. no instances are scheduled to unhealthy nodes.
+	Unhealthy bool
+
+	// Lost is set if the node stopped sending heartbeats. no instances are
+	// scheduled to lost nodes. only set by ListNodes.
+	Lost bool
+
+	// Capabilities, LastSeenAt and CreatedAt are only set by ListNodes.
+	// LastSeenAt is zero if the node has not sent a heartbeat yet.
+	Capabilities []Capability
+	LastSeenAt   time.Time
+	CreatedAt    time.Time
+}
+
+// Capacity is reported by nodes with every heartbeat.
+type Capacity struct {
+	// MaxInstances bounds the number of instances the scheduler
+	// places on the node.
+	MaxInstances uint32
+
+	// WorkloadCPUMillis and WorkloadMemoryBytes are the resources
+	// assigned to each instance on the node. 0 if unlimited.
+	WorkloadCPUMillis   uint64
+	WorkloadMemoryBytes uint64
 }
 
 type Repository interface {
 	RandomNode(ctx context.Context) (Node, error)
-	BestNode(ctx context.Context) (Node, error)
+
+	// BestNode returns the node with the least instances that still has free
+	// slots. nodes already running instances of the placement group are
+	// preferred, so instances of a party end up on the same node. unhealthy
+	// nodes are never returned.
+	BestNode(ctx context.Context, placementGroup string) (Node, error)
+
+	// ListNodes returns all nodes, including the number of slots
+	// that are still available on them.
+	ListNodes(ctx context.Context) ([]Node, error)
+
+	// NodeByInstanceID returns the node the instance has been scheduled to.
+	// returns ErrInstanceNotFound if there is no such instance.
+	NodeByInstanceID(ctx context.Context, instanceID string) (Node, error)
+
+	// UpdateNodeHeartbeat marks the node as seen and replaces the capabilities
+	// and capacity it advertises. nil capacity means the node does not bound
+	// the number of instances itself.
+	UpdateNodeHeartbeat(ctx context.Context, id string, capabilities []Capability, capacity *Capacity) error
+
+	// ScheduleCheckpointBuild selects the builder node that has been seen after
+	// seenAfter and has the least load, and records that the checkpoint of the
+	// flavor version is built on it. returns ErrNoBuilderOnline if there is
+	// no such node.
+	ScheduleCheckpointBuild(ctx context.Context, flavorVersionID string, seenAfter time.Time) (Node, error)
 }
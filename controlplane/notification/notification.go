@@ -0,0 +1,123 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package notification sends emails to users about events they opted into,
+// for example when a build of one of their flavor versions has finished.
+//
+// Notifications are queued as jobs, so sending them does not slow down the
+// component that caused the notification and failed deliveries are retried.
+package notification
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// ErrRecipientNotFound is returned by the repository if the resource the
+// notification is about, and therefore its recipient, does not exist anymore.
+var ErrRecipientNotFound = errors.New("recipient not found")
+
+type Kind string
+
+const (
+	KindBuildCompleted  Kind = "BUILD_COMPLETED"
+	KindBuildFailed     Kind = "BUILD_FAILED"
+	KindInstanceCrashed Kind = "INSTANCE_CRASHED"
+	KindInstanceLost    Kind = "INSTANCE_LOST"
+)
+
+// Recipient is the user a notification is addressed to.
+type Recipient struct {
+	Email       string
+	Preferences resource.NotificationPreferences
+}
+
+// Wants returns whether the recipient opted into notifications of the given kind.
+func (r Recipient) Wants(kind Kind) bool {
+	switch kind {
+	case KindBuildCompleted:
+		return r.Preferences.BuildCompleted
+	case KindBuildFailed:
+		return r.Preferences.BuildFailed
+	// losing an instance affects players the same way a crash
+	// does, so users opting into crashes are told about both.
+	case KindInstanceCrashed, KindInstanceLost:
+		return r.Preferences.InstanceCrashed
+	}
+	return false
+}
+
+// Data is passed to the templates of the notifications.
+// depending on the kind of notification only some fields are set.
+type Data struct {
+	ChunkName     string
+	FlavorName    string
+	FlavorVersion string
+	InstanceID    string
+	Reason        string
+}
+
+type Repository interface {
+	// BuildNotificationData returns the owner of the chunk the flavor version belongs to
+	// and the data describing the build. returns ErrRecipientNotFound if there is no such
+	// flavor version.
+	BuildNotificationData(ctx context.Context, flavorVersionID string) (Recipient, Data, error)
+
+	// InstanceNotificationData returns the owner of the instance and the data describing
+	// it. returns ErrRecipientNotFound if there is no such instance.
+	InstanceNotificationData(ctx context.Context, instanceID string) (Recipient, Data, error)
+}
+
+// BuildKind returns the kind of notification that has to be sent if the build
+// of a flavor version reached the given status. false is returned if the build
+// is still in progress.
+func BuildKind(status resource.FlavorVersionBuildStatus) (Kind, bool) {
+	switch status {
+	case resource.FlavorVersionBuildStatusCompleted:
+		return KindBuildCompleted, true
+	case resource.FlavorVersionBuildStatusBuildImageFailed,
+		resource.FlavorVersionBuildStatusBuildCheckpointFailed:
+		return KindBuildFailed, true
+	}
+	return "", false
+}
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers messages to their recipients.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogSender logs messages instead of delivering them.
+// it is used if no mail server has been configured.
+type LogSender struct {
+	Logger *slog.Logger
+}
+
+func (s LogSender) Send(ctx context.Context, msg Message) error {
+	s.Logger.InfoContext(ctx, "notification", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/secret"
+)
+
+// SMTPConfig configures the mail server notifications are delivered through.
+// providers like Amazon SES can be used via their SMTP interface.
+type SMTPConfig struct {
+	// Addr is the host:port of the mail server. the connection is
+	// upgraded using STARTTLS if the server supports it.
+	Addr     string
+	Username string
+	Password secret.Source
+	From     string
+}
+
+// SMTPSender delivers messages using a mail server.
+type SMTPSender struct {
+	cfg SMTPConfig
+}
+
+func NewSMTPSender(cfg SMTPConfig) *SMTPSender {
+	return &SMTPSender{
+		cfg: cfg,
+	}
+}
+
+func (s *SMTPSender) Send(_ context.Context, msg Message) error {
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		host, _, err := net.SplitHostPort(s.cfg.Addr)
+		if err != nil {
+			return fmt.Errorf("parse smtp addr: %w", err)
+		}
+
+		// read the password every time, so rotated passwords are picked up.
+		pass, err := s.cfg.Password.Value()
+		if err != nil {
+			return fmt.Errorf("smtp password: %w", err)
+		}
+
+		auth = smtp.PlainAuth("", s.cfg.Username, pass, host)
+	}
+
+	if err := smtp.SendMail(s.cfg.Addr, auth, s.cfg.From, []string{msg.To}, s.format(msg)); err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SMTPSender) format(msg Message) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", s.cfg.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return buf.Bytes()
+}
@@ -0,0 +1,75 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// templateNames maps each kind of notification to the template
+// defining its subject and body.
+var templateNames = map[Kind]string{
+	KindBuildCompleted:  "build_completed",
+	KindBuildFailed:     "build_failed",
+	KindInstanceCrashed: "instance_crashed",
+	KindInstanceLost:    "instance_lost",
+}
+
+// Render returns the message for the notification addressed to the given recipient.
+func Render(kind Kind, to string, data Data) (Message, error) {
+	name, ok := templateNames[kind]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown notification kind %s", kind)
+	}
+
+	subject, err := execute(name+"_subject", data)
+	if err != nil {
+		return Message{}, fmt.Errorf("subject: %w", err)
+	}
+
+	body, err := execute(name+"_body", data)
+	if err != nil {
+		return Message{}, fmt.Errorf("body: %w", err)
+	}
+
+	return Message{
+		To: to,
+		// subjects end up in mail headers, so make sure names chosen by
+		// users cannot be used to inject additional headers.
+		Subject: strings.Join(strings.Fields(subject), " "),
+		Body:    strings.TrimSpace(body) + "\n",
+	}, nil
+}
+
+func execute(name string, data Data) (string, error) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,42 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package notification_test
+
+import (
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/notification"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderRemovesLineBreaksFromSubject(t *testing.T) {
+	msg, err := notification.Render(notification.KindBuildCompleted, "user@example.com", notification.Data{
+		ChunkName:     "chunk\r\nBcc: attacker@example.com",
+		FlavorName:    "flavor",
+		FlavorVersion: "v1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "chunk Bcc: attacker@example.com: flavor v1 is ready", msg.Subject)
+	require.Equal(t, "user@example.com", msg.To)
+}
+
+func TestRenderUnknownKind(t *testing.T) {
+	_, err := notification.Render("UNKNOWN", "user@example.com", notification.Data{})
+	require.Error(t, err)
+}
@@ -0,0 +1,169 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package orphan detects instances whose node stopped sending heartbeats.
+//
+// Without it, instances of nodes that died permanently would stay RUNNING
+// forever. Such instances are moved to LOST instead, and optionally replaced
+// by instances on other nodes. Lost instances are removed once their node
+// sends heartbeats again.
+package orphan
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/autoscaler"
+	"github.com/spacechunks/explorer/controlplane/schedule"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// managedBy contains who started instances that are replaced by the
+// component that started them. the autoscaler keeps the number of instances
+// up by itself and schedules start a new instance on their next run.
+var managedBy = []string{autoscaler.OrderedBy, schedule.OrderedBy}
+
+type Config struct {
+	// Interval in which nodes are checked for missed heartbeats.
+	Interval time.Duration
+
+	// LostAfter is the time a node can go without sending a
+	// heartbeat, before its instances are considered lost.
+	LostAfter time.Duration
+
+	// Reschedule starts a replacement on another node for every lost
+	// instance, except the ones started by the autoscaler or a schedule.
+	Reschedule bool
+}
+
+type Detector struct {
+	logger    *slog.Logger
+	repo      Repository
+	instances Instances
+	cfg       Config
+	metrics   metrics
+}
+
+func New(logger *slog.Logger, repo Repository, instances Instances, cfg Config) (*Detector, error) {
+	m, err := initMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Detector{
+		logger:    logger,
+		repo:      repo,
+		instances: instances,
+		cfg:       cfg,
+		metrics:   m,
+	}, nil
+}
+
+// Run checks for lost instances in the configured interval until ctx
+// is canceled. it must only run on a single replica, see [leader.Task].
+func (d *Detector) Run(ctx context.Context) {
+	t := time.NewTicker(d.cfg.Interval)
+	defer t.Stop()
+
+	for {
+		if err := d.Reconcile(ctx); err != nil {
+			d.logger.ErrorContext(ctx, "failed to detect lost instances", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Reconcile moves the instances of nodes that missed their heartbeats to LOST
+// and reschedules them if enabled. instances that fail to be rescheduled are
+// not tried again, because their owners have been notified about the loss.
+func (d *Detector) Reconcile(ctx context.Context) error {
+	ids, err := d.repo.MarkInstancesLost(ctx, time.Now().Add(-d.cfg.LostAfter))
+	if err != nil {
+		return fmt.Errorf("mark instances lost: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	d.metrics.lostCount.Add(ctx, int64(len(ids)))
+	d.logger.WarnContext(ctx, "instances lost", "instance_ids", ids)
+
+	if !d.cfg.Reschedule {
+		return nil
+	}
+
+	for _, id := range ids {
+		logger := d.logger.With("instance_id", id)
+
+		replacement, err := d.reschedule(ctx, id)
+		if err != nil {
+			d.metrics.rescheduleCount.Add(ctx, 1, metric.WithAttributes(attribute.String("result", "failed")))
+			logger.ErrorContext(ctx, "failed to reschedule lost instance", "err", err)
+			continue
+		}
+
+		if replacement == "" {
+			continue
+		}
+
+		d.metrics.rescheduleCount.Add(ctx, 1, metric.WithAttributes(attribute.String("result", "rescheduled")))
+		logger.InfoContext(ctx, "rescheduled lost instance", "replacement_id", replacement)
+	}
+
+	return nil
+}
+
+// reschedule starts a replacement for the lost instance with the same
+// params and returns its id. an empty id is returned if the instance
+// is replaced by the component that started it.
+func (d *Detector) reschedule(ctx context.Context, id string) (string, error) {
+	ins, err := d.instances.GetInstance(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("get instance: %w", err)
+	}
+
+	if slices.Contains(managedBy, ins.OrderedBy) {
+		return "", nil
+	}
+
+	// lost nodes are not selected by the scheduler,
+	// so the replacement ends up on another node.
+	replacement, err := d.instances.RunFlavorVersion(
+		ctx,
+		ins.FlavorVersion.ID,
+		ins.Owner.ID,
+		ins.OrderedBy,
+		ins.Params,
+		ins.PlacementGroup,
+	)
+	if err != nil {
+		return "", fmt.Errorf("run flavor version: %w", err)
+	}
+
+	return replacement.ID, nil
+}
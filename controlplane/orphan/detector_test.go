@@ -0,0 +1,128 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package orphan_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/orphan"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcile(t *testing.T) {
+	var (
+		ownerID   = "019a88ab-3240-7f61-b560-cc755d5572a1"
+		versionID = "019a88ab-3240-7f61-b560-cc755d5572a2"
+	)
+
+	lost := func(id string, orderedBy string) resource.Instance {
+		return resource.Instance{
+			ID:             id,
+			State:          resource.InstanceStateLost,
+			FlavorVersion:  resource.FlavorVersion{ID: versionID},
+			Owner:          resource.User{ID: ownerID},
+			OrderedBy:      orderedBy,
+			PlacementGroup: "group",
+		}
+	}
+
+	tests := []struct {
+		name       string
+		reschedule bool
+		ids        []string
+		prep       func(*mock.MockOrphanInstances)
+	}{
+		{
+			name:       "no lost instances",
+			reschedule: true,
+			prep:       func(*mock.MockOrphanInstances) {},
+		},
+		{
+			name: "do not reschedule if disabled",
+			ids:  []string{"a"},
+			prep: func(*mock.MockOrphanInstances) {},
+		},
+		{
+			name:       "reschedule instance with same params",
+			reschedule: true,
+			ids:        []string{"a"},
+			prep: func(instances *mock.MockOrphanInstances) {
+				instances.EXPECT().GetInstance(mocky.Anything, "a").Return(lost("a", "user"), nil)
+				instances.EXPECT().
+					RunFlavorVersion(mocky.Anything, versionID, ownerID, "user", resource.InstanceParams{}, "group").
+					Return(resource.Instance{ID: "b"}, nil)
+			},
+		},
+		{
+			name:       "skip instances replaced by autoscaler and schedules",
+			reschedule: true,
+			ids:        []string{"a", "b"},
+			prep: func(instances *mock.MockOrphanInstances) {
+				instances.EXPECT().GetInstance(mocky.Anything, "a").Return(lost("a", "autoscaler"), nil)
+				instances.EXPECT().GetInstance(mocky.Anything, "b").Return(lost("b", "schedule"), nil)
+			},
+		},
+		{
+			name:       "failed reschedule does not stop others",
+			reschedule: true,
+			ids:        []string{"a", "b"},
+			prep: func(instances *mock.MockOrphanInstances) {
+				instances.EXPECT().GetInstance(mocky.Anything, "a").Return(resource.Instance{}, errors.New("boom"))
+				instances.EXPECT().GetInstance(mocky.Anything, "b").Return(lost("b", "user"), nil)
+				instances.EXPECT().
+					RunFlavorVersion(mocky.Anything, versionID, ownerID, "user", resource.InstanceParams{}, "group").
+					Return(resource.Instance{ID: "c"}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx           = context.Background()
+				mockRepo      = mock.NewMockOrphanRepository(t)
+				mockInstances = mock.NewMockOrphanInstances(t)
+			)
+
+			mockRepo.EXPECT().MarkInstancesLost(mocky.Anything, mocky.Anything).Return(tt.ids, nil)
+			tt.prep(mockInstances)
+
+			d, err := orphan.New(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				mockInstances,
+				orphan.Config{
+					Interval:   time.Minute,
+					LostAfter:  5 * time.Minute,
+					Reschedule: tt.reschedule,
+				},
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, d.Reconcile(ctx))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package orphan
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type metrics struct {
+	lostCount       metric.Int64Counter
+	rescheduleCount metric.Int64Counter
+}
+
+func initMetrics() (metrics, error) {
+	meter := otel.Meter("github.com/spacechunks/explorer/controlplane/orphan")
+
+	lostCount, err := meter.Int64Counter(
+		"explorer.control_plane.instance.lost.count",
+		metric.WithDescription("Total number of instances lost because their node stopped sending heartbeats"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("lost counter: %w", err)
+	}
+
+	rescheduleCount, err := meter.Int64Counter(
+		"explorer.control_plane.instance.reschedule.count",
+		metric.WithDescription("Total number of lost instances that have been rescheduled by result"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("reschedule counter: %w", err)
+	}
+
+	return metrics{
+		lostCount:       lostCount,
+		rescheduleCount: rescheduleCount,
+	}, nil
+}
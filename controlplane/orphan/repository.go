@@ -0,0 +1,47 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package orphan
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+type Repository interface {
+	// MarkInstancesLost marks nodes that have not been seen since seenBefore
+	// as lost and moves their instances that have not been stopped to LOST.
+	// the owners of the instances are notified. returns the ids of the
+	// instances that have been lost.
+	MarkInstancesLost(ctx context.Context, seenBefore time.Time) ([]string, error)
+}
+
+// Instances starts instances, see [instance.Service].
+type Instances interface {
+	GetInstance(ctx context.Context, id string) (resource.Instance, error)
+	RunFlavorVersion(
+		ctx context.Context,
+		flavorVersionID string,
+		ownerID string,
+		orderedBy string,
+		params resource.InstanceParams,
+		placementGroup string,
+	) (resource.Instance, error)
+}
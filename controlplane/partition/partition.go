@@ -0,0 +1,52 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package partition contains the types needed to maintain the monthly
+// partitions of high-churn tables. Partitions themselves are created and
+// dropped by functions defined in the database schema.
+package partition
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	TableInstanceEvents      = "instance_events"
+	TableNodeEvents          = "node_events"
+	TableImpersonatedActions = "impersonated_actions"
+)
+
+// Table is a table that is partitioned by month.
+type Table struct {
+	Name string
+	// Retention is the duration after which a partition is dropped.
+	// a partition is only dropped once all of its rows are older than
+	// the retention. zero keeps partitions forever.
+	Retention time.Duration
+}
+
+type Repository interface {
+	// CreateMonthlyPartition creates the partition holding the rows of the
+	// month the given time falls into. nothing happens if it already exists.
+	CreateMonthlyPartition(ctx context.Context, table string, month time.Time) error
+
+	// DropMonthlyPartitionsBefore drops all partitions of the table only
+	// containing rows created before cutoff and returns their names.
+	DropMonthlyPartitionsBefore(ctx context.Context, table string, cutoff time.Time) ([]string, error)
+}
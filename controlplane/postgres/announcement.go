@@ -0,0 +1,122 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (db *DB) CreateAnnouncement(ctx context.Context, a resource.Announcement) (resource.Announcement, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return resource.Announcement{}, fmt.Errorf("announcement id: %w", err)
+	}
+
+	params := query.CreateAnnouncementParams{
+		ID:        id.String(),
+		Message:   a.Message,
+		Severity:  query.AnnouncementSeverity(a.Severity),
+		StartsAt:  a.StartsAt,
+		CreatedBy: a.CreatedBy,
+		CreatedAt: time.Now(),
+	}
+
+	if a.EndsAt != nil {
+		params.EndsAt = pgtype.Timestamptz{
+			Time:  *a.EndsAt,
+			Valid: true,
+		}
+	}
+
+	if err := db.do(ctx, func(q *query.Queries) error {
+		return q.CreateAnnouncement(ctx, params)
+	}); err != nil {
+		return resource.Announcement{}, fmt.Errorf("create announcement: %w", err)
+	}
+
+	a.ID = params.ID
+	a.CreatedAt = params.CreatedAt
+	return a, nil
+}
+
+// ActiveAnnouncements returns the announcements shown at the given time,
+// ordered by their severity starting with the most severe one.
+func (db *DB) ActiveAnnouncements(ctx context.Context, now time.Time) ([]resource.Announcement, error) {
+	var ret []resource.Announcement
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.ActiveAnnouncements(ctx, now)
+		if err != nil {
+			return fmt.Errorf("active announcements: %w", err)
+		}
+
+		ret = make([]resource.Announcement, 0, len(rows))
+		for _, r := range rows {
+			ret = append(ret, announcementToDomain(r))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// DeleteAnnouncement deletes the announcement. returns
+// [apierrs.ErrNotFound] if the announcement does not exist.
+func (db *DB) DeleteAnnouncement(ctx context.Context, id string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		n, err := q.DeleteAnnouncement(ctx, id)
+		if err != nil {
+			return fmt.Errorf("delete announcement: %w", err)
+		}
+
+		if n == 0 {
+			return apierrs.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+func announcementToDomain(r query.Announcement) resource.Announcement {
+	var endsAt *time.Time
+	if r.EndsAt.Valid {
+		t := r.EndsAt.Time.UTC()
+		endsAt = &t
+	}
+
+	return resource.Announcement{
+		ID:        r.ID,
+		Message:   r.Message,
+		Severity:  resource.AnnouncementSeverity(r.Severity),
+		StartsAt:  r.StartsAt.UTC(),
+		EndsAt:    endsAt,
+		CreatedBy: r.CreatedBy,
+		CreatedAt: r.CreatedAt.UTC(),
+	}
+}
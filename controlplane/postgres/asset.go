@@ -0,0 +1,80 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// CreateAsset creates the asset if it does not exist yet and returns
+// the stored one. assets are content addressed, so creating an asset
+// that already exists is not considered an error.
+func (db *DB) CreateAsset(ctx context.Context, asset resource.Asset) (resource.Asset, error) {
+	var ret resource.Asset
+	if err := db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+		if err := q.CreateAsset(ctx, query.CreateAssetParams{
+			Hash:      asset.Hash,
+			SizeBytes: int64(asset.SizeBytes),
+			CreatedAt: time.Now(),
+		}); err != nil {
+			return fmt.Errorf("create asset: %w", err)
+		}
+
+		row, err := q.AssetByHash(ctx, asset.Hash)
+		if err != nil {
+			return fmt.Errorf("asset by hash: %w", err)
+		}
+
+		ret = resource.Asset{
+			Hash:      row.Hash,
+			SizeBytes: uint64(row.SizeBytes),
+			CreatedAt: row.CreatedAt,
+		}
+
+		return nil
+	}); err != nil {
+		return resource.Asset{}, err
+	}
+
+	return ret, nil
+}
+
+// AssetsExist reports whether all assets with the given hashes exist.
+func (db *DB) AssetsExist(ctx context.Context, hashes []string) (bool, error) {
+	var ret bool
+	if err := db.do(ctx, func(q *query.Queries) error {
+		count, err := q.CountAssets(ctx, hashes)
+		if err != nil {
+			return err
+		}
+
+		ret = count == int64(len(hashes))
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return ret, nil
+}
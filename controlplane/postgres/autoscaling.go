@@ -0,0 +1,245 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spacechunks/explorer/controlplane/autoscaler"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// UpsertAutoscalingPolicy creates the autoscaling policy of the flavor or
+// replaces it, if the flavor already has one.
+func (db *DB) UpsertAutoscalingPolicy(
+	ctx context.Context,
+	policy resource.AutoscalingPolicy,
+) (resource.AutoscalingPolicy, error) {
+	var ret resource.AutoscalingPolicy
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.UpsertAutoscalingPolicy(ctx, query.UpsertAutoscalingPolicyParams{
+			FlavorID:                 policy.FlavorID,
+			MinInstances:             int32(policy.MinInstances),
+			MaxInstances:             int32(policy.MaxInstances),
+			TargetUtilization:        int32(policy.TargetUtilization),
+			ScaleUpCooldownSeconds:   int32(policy.ScaleUpCooldown.Seconds()),
+			ScaleDownCooldownSeconds: int32(policy.ScaleDownCooldown.Seconds()),
+			UpdatedAt:                time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("upsert autoscaling policy: %w", err)
+		}
+
+		ret = autoscalingPolicyToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.AutoscalingPolicy{}, err
+	}
+
+	return ret, nil
+}
+
+// AutoscalingPolicyByFlavorID returns the autoscaling policy of the flavor.
+// returns [apierrs.ErrNotFound] if the flavor has no autoscaling policy.
+func (db *DB) AutoscalingPolicyByFlavorID(ctx context.Context, flavorID string) (resource.AutoscalingPolicy, error) {
+	var ret resource.AutoscalingPolicy
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.AutoscalingPolicyByFlavorID(ctx, flavorID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("autoscaling policy: %w", err)
+		}
+
+		ret = autoscalingPolicyToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.AutoscalingPolicy{}, err
+	}
+
+	return ret, nil
+}
+
+// DeleteAutoscalingPolicy removes the autoscaling policy of the flavor.
+// instances started by the autoscaler keep running.
+func (db *DB) DeleteAutoscalingPolicy(ctx context.Context, flavorID string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.DeleteAutoscalingPolicy(ctx, flavorID); err != nil {
+			return fmt.Errorf("delete autoscaling policy: %w", err)
+		}
+		return nil
+	})
+}
+
+func (db *DB) AutoscalingTargets(ctx context.Context) ([]autoscaler.Target, error) {
+	var ret []autoscaler.Target
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.AutoscalingTargets(ctx)
+		if err != nil {
+			return fmt.Errorf("autoscaling targets: %w", err)
+		}
+
+		ret = make([]autoscaler.Target, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, autoscaler.Target{
+				Policy:  autoscalingPolicyToDomain(row.AutoscalingPolicy),
+				OwnerID: row.OwnerID,
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) FlavorDemand(ctx context.Context, flavorID string, since time.Time) (autoscaler.Demand, error) {
+	var ret autoscaler.Demand
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.FlavorDemand(ctx, query.FlavorDemandParams{
+			Since:    since,
+			FlavorID: flavorID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("flavor demand: %w", err)
+		}
+
+		ret = autoscaler.Demand{
+			Players:         uint32(row.Players),
+			QueuedPlayers:   uint32(row.QueuedPlayers),
+			ManualInstances: uint32(row.ManualInstances),
+		}
+		return nil
+	}); err != nil {
+		return autoscaler.Demand{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) AutoscaledInstances(ctx context.Context, flavorID string) ([]autoscaler.Instance, error) {
+	var ret []autoscaler.Instance
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.AutoscaledInstances(ctx, flavorID)
+		if err != nil {
+			return fmt.Errorf("autoscaled instances: %w", err)
+		}
+
+		ret = make([]autoscaler.Instance, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, autoscaler.Instance{
+				ID:      row.ID,
+				OwnerID: row.OwnerID,
+				State:   resource.InstanceState(row.State),
+				Players: uint32(row.Players),
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) InsertAutoscaledInstance(ctx context.Context, flavorID string, instanceID string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.InsertAutoscaledInstance(ctx, query.InsertAutoscaledInstanceParams{
+			InstanceID: instanceID,
+			FlavorID:   flavorID,
+		}); err != nil {
+			return fmt.Errorf("insert autoscaled instance: %w", err)
+		}
+		return nil
+	})
+}
+
+func (db *DB) RecordScaling(ctx context.Context, flavorID string, dir autoscaler.Direction, at time.Time) error {
+	params := query.UpdateAutoscalingPolicyScaledAtParams{
+		FlavorID: flavorID,
+	}
+
+	ts := pgtype.Timestamptz{
+		Time:  at,
+		Valid: true,
+	}
+
+	switch dir {
+	case autoscaler.DirectionUp:
+		params.LastScaleUpAt = ts
+	case autoscaler.DirectionDown:
+		params.LastScaleDownAt = ts
+	default:
+		return nil
+	}
+
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.UpdateAutoscalingPolicyScaledAt(ctx, params); err != nil {
+			return fmt.Errorf("update autoscaling policy: %w", err)
+		}
+		return nil
+	})
+}
+
+func (db *DB) DeleteJoinMissesBefore(ctx context.Context, t time.Time) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.DeleteJoinMissesBefore(ctx, t); err != nil {
+			return fmt.Errorf("delete join misses: %w", err)
+		}
+		return nil
+	})
+}
+
+func autoscalingPolicyToDomain(row query.AutoscalingPolicy) resource.AutoscalingPolicy {
+	ret := resource.AutoscalingPolicy{
+		FlavorID:          row.FlavorID,
+		MinInstances:      uint32(row.MinInstances),
+		MaxInstances:      uint32(row.MaxInstances),
+		TargetUtilization: uint32(row.TargetUtilization),
+		ScaleUpCooldown:   time.Duration(row.ScaleUpCooldownSeconds) * time.Second,
+		ScaleDownCooldown: time.Duration(row.ScaleDownCooldownSeconds) * time.Second,
+		UpdatedAt:         row.UpdatedAt.UTC(),
+	}
+
+	if row.LastScaleUpAt.Valid {
+		t := row.LastScaleUpAt.Time.UTC()
+		ret.LastScaleUpAt = &t
+	}
+
+	if row.LastScaleDownAt.Valid {
+		t := row.LastScaleDownAt.Time.UTC()
+		ret.LastScaleDownAt = &t
+	}
+
+	return ret
+}
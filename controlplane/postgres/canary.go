@@ -0,0 +1,198 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// CreateCanary creates an active canary. returns [apierrs.ErrCanaryActive]
+// if the flavor already has an active canary.
+func (db *DB) CreateCanary(ctx context.Context, c resource.Canary) (resource.Canary, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return resource.Canary{}, fmt.Errorf("canary id: %w", err)
+	}
+
+	now := time.Now()
+
+	err = db.do(ctx, func(q *query.Queries) error {
+		return q.CreateCanary(ctx, query.CreateCanaryParams{
+			ID:              id.String(),
+			FlavorID:        c.FlavorID,
+			StableVersionID: c.StableVersionID,
+			CanaryVersionID: c.CanaryVersionID,
+			Percentage:      int16(c.Percentage),
+			State:           query.CanaryStateACTIVE,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		})
+	})
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return resource.Canary{}, apierrs.ErrCanaryActive
+	}
+
+	if err != nil {
+		return resource.Canary{}, fmt.Errorf("create canary: %w", err)
+	}
+
+	c.ID = id.String()
+	c.State = resource.CanaryStateActive
+	c.CreatedAt = now
+	c.UpdatedAt = now
+	return c, nil
+}
+
+// LatestCanary returns the most recently created canary of the flavor
+// including its stats, regardless of its state.
+func (db *DB) LatestCanary(ctx context.Context, flavorID string) (resource.Canary, error) {
+	var ret resource.Canary
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.LatestCanaryByFlavorID(ctx, flavorID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("latest canary: %w", err)
+		}
+
+		ret, err = canaryWithStats(ctx, q, row)
+		return err
+	}); err != nil {
+		return resource.Canary{}, err
+	}
+
+	return ret, nil
+}
+
+// CanaryByFlavorVersionID returns the most recently created canary the flavor
+// version is either the stable or canary version of. stats are not populated.
+func (db *DB) CanaryByFlavorVersionID(ctx context.Context, flavorVersionID string) (resource.Canary, error) {
+	var ret resource.Canary
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.LatestCanaryByFlavorVersionID(ctx, flavorVersionID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("latest canary: %w", err)
+		}
+
+		ret = canaryToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.Canary{}, err
+	}
+
+	return ret, nil
+}
+
+// UpdateActiveCanaryState sets the state of the active canary of the flavor.
+// returns [apierrs.ErrNoActiveCanary] if the flavor has no active canary.
+func (db *DB) UpdateActiveCanaryState(
+	ctx context.Context,
+	flavorID string,
+	state resource.CanaryState,
+) (resource.Canary, error) {
+	var ret resource.Canary
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.UpdateActiveCanaryState(ctx, query.UpdateActiveCanaryStateParams{
+			FlavorID:  flavorID,
+			State:     query.CanaryState(state),
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNoActiveCanary
+			}
+			return fmt.Errorf("update canary state: %w", err)
+		}
+
+		ret, err = canaryWithStats(ctx, q, row)
+		return err
+	}); err != nil {
+		return resource.Canary{}, err
+	}
+
+	return ret, nil
+}
+
+// CreateCanaryInstance records that the instance has been launched while
+// the canary was active. canary reports whether the canary version is run.
+func (db *DB) CreateCanaryInstance(ctx context.Context, canaryID string, instanceID string, canary bool) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.CreateCanaryInstance(ctx, query.CreateCanaryInstanceParams{
+			InstanceID: instanceID,
+			CanaryID:   canaryID,
+			Canary:     canary,
+			CreatedAt:  time.Now(),
+		})
+	})
+}
+
+func canaryWithStats(ctx context.Context, q *query.Queries, row query.Canary) (resource.Canary, error) {
+	c := canaryToDomain(row)
+
+	rows, err := q.CanaryStats(ctx, row.ID)
+	if err != nil {
+		return resource.Canary{}, fmt.Errorf("canary stats: %w", err)
+	}
+
+	for _, r := range rows {
+		stats := resource.CanaryStats{
+			Launches:         uint64(r.Launches),
+			CrashedInstances: uint64(r.CrashedInstances),
+			Connections:      uint64(r.Connections),
+		}
+
+		if r.Canary {
+			c.CanaryStats = stats
+			continue
+		}
+
+		c.StableStats = stats
+	}
+
+	return c, nil
+}
+
+func canaryToDomain(row query.Canary) resource.Canary {
+	return resource.Canary{
+		ID:              row.ID,
+		FlavorID:        row.FlavorID,
+		StableVersionID: row.StableVersionID,
+		CanaryVersionID: row.CanaryVersionID,
+		Percentage:      uint32(row.Percentage),
+		State:           resource.CanaryState(row.State),
+		CreatedAt:       row.CreatedAt.UTC(),
+		UpdatedAt:       row.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,114 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// UpsertFlavorChannel points the channel of the flavor to the flavor version
+// of the passed channel. the channel is created if it does not exist yet.
+func (db *DB) UpsertFlavorChannel(
+	ctx context.Context,
+	channel resource.FlavorChannel,
+) (resource.FlavorChannel, error) {
+	var ret resource.FlavorChannel
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.UpsertFlavorChannel(ctx, query.UpsertFlavorChannelParams{
+			FlavorID:        channel.FlavorID,
+			Name:            channel.Name,
+			FlavorVersionID: channel.FlavorVersionID,
+			UpdatedAt:       time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("upsert flavor channel: %w", err)
+		}
+
+		ret = flavorChannelToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.FlavorChannel{}, err
+	}
+
+	return ret, nil
+}
+
+// FlavorChannel returns the channel of the flavor with the given name.
+// returns [apierrs.ErrNotFound] if no version has been published to it.
+func (db *DB) FlavorChannel(ctx context.Context, flavorID string, name string) (resource.FlavorChannel, error) {
+	var ret resource.FlavorChannel
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.FlavorChannel(ctx, query.FlavorChannelParams{
+			FlavorID: flavorID,
+			Name:     name,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("flavor channel: %w", err)
+		}
+
+		ret = flavorChannelToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.FlavorChannel{}, err
+	}
+
+	return ret, nil
+}
+
+// FlavorChannels returns all channels of the flavor ordered by their name.
+func (db *DB) FlavorChannels(ctx context.Context, flavorID string) ([]resource.FlavorChannel, error) {
+	var ret []resource.FlavorChannel
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.FlavorChannelsByFlavorID(ctx, flavorID)
+		if err != nil {
+			return fmt.Errorf("flavor channels: %w", err)
+		}
+
+		ret = make([]resource.FlavorChannel, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, flavorChannelToDomain(row))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func flavorChannelToDomain(row query.FlavorChannel) resource.FlavorChannel {
+	return resource.FlavorChannel{
+		FlavorID:        row.FlavorID,
+		Name:            row.Name,
+		FlavorVersionID: row.FlavorVersionID,
+		UpdatedAt:       row.UpdatedAt.UTC(),
+	}
+}
@@ -20,6 +20,7 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"slices"
@@ -32,10 +33,13 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
 	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/chunkname"
 	"github.com/spacechunks/explorer/internal/file"
 	"github.com/spacechunks/explorer/internal/resource"
 )
 
+// CreateChunk returns [apierrs.ErrChunkNameTaken] if the slug of the
+// name has already been reserved by another chunk.
 func (db *DB) CreateChunk(ctx context.Context, c resource.Chunk) (resource.Chunk, error) {
 	id, err := uuid.NewV7()
 	if err != nil {
@@ -58,6 +62,10 @@ func (db *DB) CreateChunk(ctx context.Context, c resource.Chunk) (resource.Chunk
 			return fmt.Errorf("create chunk: %w", err)
 		}
 
+		if err := reserveChunkSlug(ctx, q, params.ID, params.Name); err != nil {
+			return err
+		}
+
 		created, err := db.getChunkByID(ctx, q, id.String())
 		if err != nil {
 			return fmt.Errorf("get chunk: %w", err)
@@ -72,10 +80,13 @@ func (db *DB) CreateChunk(ctx context.Context, c resource.Chunk) (resource.Chunk
 	return ret, nil
 }
 
+// GetChunkByID reads the chunk including all of its flavors and versions from a
+// single snapshot, so flavor versions that are being created concurrently are
+// either returned including all of their files or not at all.
 func (db *DB) GetChunkByID(ctx context.Context, id string) (resource.Chunk, error) {
 	// FIXME: allow fetching multiple chunks at once
 	var ret resource.Chunk
-	if err := db.do(ctx, func(q *query.Queries) error {
+	if err := db.doSnapshot(ctx, func(q *query.Queries) error {
 		c, err := db.getChunkByID(ctx, q, id)
 		if err != nil {
 			return err
@@ -89,6 +100,9 @@ func (db *DB) GetChunkByID(ctx context.Context, id string) (resource.Chunk, erro
 	return ret, nil
 }
 
+// UpdateChunk returns [apierrs.ErrChunkNameTaken] if the slug of the new
+// name has already been reserved by another chunk. the slug of the old
+// name stays reserved for the chunk.
 func (db *DB) UpdateChunk(ctx context.Context, c resource.Chunk) (resource.Chunk, error) {
 	params := query.UpdateChunkParams{
 		Name:        c.Name,
@@ -103,6 +117,10 @@ func (db *DB) UpdateChunk(ctx context.Context, c resource.Chunk) (resource.Chunk
 			return fmt.Errorf("update chunk: %w", err)
 		}
 
+		if err := reserveChunkSlug(ctx, q, params.ID, params.Name); err != nil {
+			return err
+		}
+
 		c, err := db.getChunkByID(ctx, q, params.ID)
 		if err != nil {
 			return fmt.Errorf("get chunk: %w", err)
@@ -117,6 +135,46 @@ func (db *DB) UpdateChunk(ctx context.Context, c resource.Chunk) (resource.Chunk
 	return ret, nil
 }
 
+// ChunkIDByName returns the id of the chunk that reserved the slug of the
+// name. this includes previous names of the chunk. returns [apierrs.ErrNotFound]
+// if no chunk reserved the slug.
+func (db *DB) ChunkIDByName(ctx context.Context, name string) (string, error) {
+	var ret string
+	if err := db.do(ctx, func(q *query.Queries) error {
+		id, err := q.ChunkIDBySlug(ctx, chunkname.Slug(name))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("chunk id by slug: %w", err)
+		}
+
+		ret = id
+		return nil
+	}); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func reserveChunkSlug(ctx context.Context, q *query.Queries, chunkID string, name string) error {
+	n, err := q.ReserveChunkSlug(ctx, query.ReserveChunkSlugParams{
+		Slug:      chunkname.Slug(name),
+		ChunkID:   chunkID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("reserve slug: %w", err)
+	}
+
+	if n == 0 {
+		return apierrs.ErrChunkNameTaken
+	}
+
+	return nil
+}
+
 func (db *DB) ListChunks(ctx context.Context, pageSize int, afterID *string) ([]resource.Chunk, error) {
 	ret := make([]resource.Chunk, 0)
 	if err := db.do(ctx, func(q *query.Queries) error {
@@ -155,9 +213,18 @@ func (db *DB) ListChunks(ctx context.Context, pageSize int, afterID *string) ([]
 				FlavorVersionCreatedAt: r.CreatedAt_3.Time,
 				MinPlayers:             uint32(r.MinPlayers.Int32),
 				MaxPlayers:             uint32(r.MaxPlayers.Int32),
-
-				FilePath: r.FilePath.String,
-				FileHash: r.FileHash.String,
+				StartupGracePeriod:     time.Duration(r.StartupGracePeriodSeconds.Int32) * time.Second,
+				RestartPolicy:          resource.RestartPolicy(r.RestartPolicy.RestartPolicy),
+				RestartMaxRetries:      uint32(r.RestartMaxRetries.Int32),
+				BuildError:             r.BuildError.String,
+				BuildProgress:          uint32(r.BuildProgress.Int16),
+				RestoreLatency:         time.Duration(r.RestoreLatencyMs.Int32) * time.Millisecond,
+				JavaVersion:            uint32(r.JavaVersion.Int32),
+				StartupCommand:         r.StartupCommand.String,
+
+				FilePath:      r.FilePath.String,
+				FileHash:      r.FileHash.String,
+				FileSizeBytes: uint64(r.SizeBytes.Int64),
 
 				UserID:        *r.ID_4,
 				UserNickname:  r.Nickname.String,
@@ -246,6 +313,18 @@ func (db *DB) GetMinecraftVersionByVersion(ctx context.Context, version string)
 			CreatedAt: v.CreatedAt.Time,
 		}
 
+		imgs, err := q.MinecraftVersionJavaImages(ctx, version)
+		if err != nil {
+			return fmt.Errorf("java images: %w", err)
+		}
+
+		if len(imgs) > 0 {
+			ret.JavaImageURLs = make(map[uint32]string, len(imgs))
+			for _, img := range imgs {
+				ret.JavaImageURLs[uint32(img.JavaVersion)] = img.ImageUrl
+			}
+		}
+
 		return nil
 	}); err != nil {
 		return resource.MinecraftVersion{}, err
@@ -253,6 +332,32 @@ func (db *DB) GetMinecraftVersionByVersion(ctx context.Context, version string)
 	return ret, nil
 }
 
+func (db *DB) CreateMinecraftVersion(ctx context.Context, version resource.MinecraftVersion) error {
+	if err := db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+		if err := q.CreateMinecraftVersion(ctx, query.CreateMinecraftVersionParams{
+			Version:  version.Version,
+			ImageUrl: version.ImageURL,
+		}); err != nil {
+			return fmt.Errorf("create version: %w", err)
+		}
+
+		for javaVersion, url := range version.JavaImageURLs {
+			if err := q.CreateMinecraftVersionJavaImage(ctx, query.CreateMinecraftVersionJavaImageParams{
+				MinecraftVersion: version.Version,
+				JavaVersion:      int32(javaVersion),
+				ImageUrl:         url,
+			}); err != nil {
+				return fmt.Errorf("create java image: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (db *DB) UpdateThumbnail(ctx context.Context, chunkID string, imgHash string) error {
 	if err := db.do(ctx, func(q *query.Queries) error {
 		return q.UpdateChunkThumbnail(ctx, query.UpdateChunkThumbnailParams{
@@ -346,9 +451,18 @@ func (db *DB) getChunkByID(ctx context.Context, q *query.Queries, id string) (re
 			FlavorVersionCreatedAt: r.CreatedAt_3.Time.UTC(),
 			MinPlayers:             uint32(r.MinPlayers.Int32),
 			MaxPlayers:             uint32(r.MaxPlayers.Int32),
-
-			FilePath: r.FilePath.String,
-			FileHash: r.FileHash.String,
+			StartupGracePeriod:     time.Duration(r.StartupGracePeriodSeconds.Int32) * time.Second,
+			RestartPolicy:          resource.RestartPolicy(r.RestartPolicy.RestartPolicy),
+			RestartMaxRetries:      uint32(r.RestartMaxRetries.Int32),
+			BuildError:             r.BuildError.String,
+			BuildProgress:          uint32(r.BuildProgress.Int16),
+			RestoreLatency:         time.Duration(r.RestoreLatencyMs.Int32) * time.Millisecond,
+			JavaVersion:            uint32(r.JavaVersion.Int32),
+			StartupCommand:         r.StartupCommand.String,
+
+			FilePath:      r.FilePath.String,
+			FileHash:      r.FileHash.String,
+			FileSizeBytes: uint64(r.SizeBytes.Int64),
 
 			UserID:        *r.ID_4,
 			UserNickname:  r.Nickname.String,
@@ -423,9 +537,18 @@ type chunkRelationsRow struct {
 	PresignedURL           *string
 	MinPlayers             uint32
 	MaxPlayers             uint32
-
-	FilePath string
-	FileHash string
+	StartupGracePeriod     time.Duration
+	RestartPolicy          resource.RestartPolicy
+	RestartMaxRetries      uint32
+	BuildError             string
+	BuildProgress          uint32
+	RestoreLatency         time.Duration
+	JavaVersion            uint32
+	StartupCommand         string
+
+	FilePath      string
+	FileHash      string
+	FileSizeBytes uint64
 
 	UserID        string
 	UserNickname  string
@@ -501,6 +624,14 @@ func collectChunks(rows []chunkRelationsRow) resource.Chunk {
 					PresignedURL:           r.PresignedURL,
 					MinPlayers:             r.MinPlayers,
 					MaxPlayers:             r.MaxPlayers,
+					StartupGracePeriod:     r.StartupGracePeriod,
+					RestartPolicy:          r.RestartPolicy,
+					RestartMaxRetries:      r.RestartMaxRetries,
+					BuildError:             r.BuildError,
+					BuildProgress:          r.BuildProgress,
+					RestoreLatency:         r.RestoreLatency,
+					JavaVersion:            r.JavaVersion,
+					StartupCommand:         r.StartupCommand,
 				}
 			}
 		}
@@ -512,8 +643,9 @@ func collectChunks(rows []chunkRelationsRow) resource.Chunk {
 
 			if !contains {
 				fhMap[*r.FlavorVersionID] = append(fhMap[*r.FlavorVersionID], file.Hash{
-					Path: r.FilePath,
-					Hash: r.FileHash,
+					Path:      r.FilePath,
+					Hash:      r.FileHash,
+					SizeBytes: r.FileSizeBytes,
 				})
 			}
 		}
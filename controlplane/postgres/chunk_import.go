@@ -0,0 +1,187 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/riverqueue/river"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// CreateChunkImport creates an import waiting for its archive to be uploaded.
+func (db *DB) CreateChunkImport(ctx context.Context, imp resource.ChunkImport) (resource.ChunkImport, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return resource.ChunkImport{}, fmt.Errorf("import id: %w", err)
+	}
+
+	now := time.Now()
+
+	if err := db.do(ctx, func(q *query.Queries) error {
+		return q.CreateChunkImport(ctx, query.CreateChunkImportParams{
+			ID:               id.String(),
+			ChunkID:          imp.ChunkID,
+			FlavorID:         imp.FlavorID,
+			Version:          imp.Version,
+			MinecraftVersion: imp.MinecraftVersion,
+			MinPlayers:       int32(imp.MinPlayers),
+			MaxPlayers:       int32(imp.MaxPlayers),
+			ArchiveSizeBytes: int64(imp.ArchiveSizeBytes),
+			State:            query.ChunkImportStatePENDINGUPLOAD,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		})
+	}); err != nil {
+		return resource.ChunkImport{}, fmt.Errorf("create chunk import: %w", err)
+	}
+
+	imp.ID = id.String()
+	imp.State = resource.ChunkImportStatePendingUpload
+	imp.CreatedAt = now
+	imp.UpdatedAt = now
+	return imp, nil
+}
+
+func (db *DB) ChunkImportByID(ctx context.Context, id string) (resource.ChunkImport, error) {
+	var ret resource.ChunkImport
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.ChunkImportByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("chunk import: %w", err)
+		}
+
+		ret = chunkImportToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.ChunkImport{}, err
+	}
+
+	return ret, nil
+}
+
+// StartChunkImport moves the import to the importing state and inserts the
+// job unpacking its archive. imports that are not pending are returned as is,
+// so the job is only inserted once.
+func (db *DB) StartChunkImport(ctx context.Context, id string) (resource.ChunkImport, error) {
+	var ret resource.ChunkImport
+	if err := db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+		row, err := q.StartChunkImport(ctx, query.StartChunkImportParams{
+			ID:        id,
+			UpdatedAt: time.Now(),
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			row, err = q.ChunkImportByID(ctx, id)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			if err != nil {
+				return fmt.Errorf("chunk import: %w", err)
+			}
+
+			ret = chunkImportToDomain(row)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("start chunk import: %w", err)
+		}
+
+		if _, err := db.riverClient.InsertTx(ctx, tx, job.ImportChunk{
+			ImportID: id,
+		}, &river.InsertOpts{
+			UniqueOpts: river.UniqueOpts{
+				ByArgs: true,
+			},
+		}); err != nil {
+			return fmt.Errorf("insert job: %w", err)
+		}
+
+		ret = chunkImportToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.ChunkImport{}, err
+	}
+
+	return ret, nil
+}
+
+// UpdateChunkImportState sets the state of the import. reason is
+// only recorded for failed imports and should be empty otherwise.
+func (db *DB) UpdateChunkImportState(
+	ctx context.Context,
+	id string,
+	state resource.ChunkImportState,
+	reason string,
+) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.UpdateChunkImportState(ctx, query.UpdateChunkImportStateParams{
+			ID:        id,
+			State:     query.ChunkImportState(state),
+			Error:     pgtype.Text{String: reason, Valid: reason != ""},
+			UpdatedAt: time.Now(),
+		})
+	})
+}
+
+// UpdateChunkImportFlavorVersion records the flavor version created by the import.
+func (db *DB) UpdateChunkImportFlavorVersion(ctx context.Context, id string, flavorVersionID string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.UpdateChunkImportFlavorVersion(ctx, query.UpdateChunkImportFlavorVersionParams{
+			ID:              id,
+			FlavorVersionID: &flavorVersionID,
+			UpdatedAt:       time.Now(),
+		})
+	})
+}
+
+func chunkImportToDomain(row query.ChunkImport) resource.ChunkImport {
+	ret := resource.ChunkImport{
+		ID:               row.ID,
+		ChunkID:          row.ChunkID,
+		FlavorID:         row.FlavorID,
+		Version:          row.Version,
+		MinecraftVersion: row.MinecraftVersion,
+		MinPlayers:       uint32(row.MinPlayers),
+		MaxPlayers:       uint32(row.MaxPlayers),
+		ArchiveSizeBytes: uint64(row.ArchiveSizeBytes),
+		State:            resource.ChunkImportState(row.State),
+		Error:            row.Error.String,
+		CreatedAt:        row.CreatedAt.UTC(),
+		UpdatedAt:        row.UpdatedAt.UTC(),
+	}
+
+	if row.FlavorVersionID != nil {
+		ret.FlavorVersionID = *row.FlavorVersionID
+	}
+
+	return ret
+}
@@ -0,0 +1,186 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// UpsertChunkReadme creates the readme of the chunk or replaces
+// it, if the chunk already has one.
+func (db *DB) UpsertChunkReadme(ctx context.Context, readme resource.ChunkReadme) (resource.ChunkReadme, error) {
+	var ret resource.ChunkReadme
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.UpsertChunkReadme(ctx, query.UpsertChunkReadmeParams{
+			ChunkID:   readme.ChunkID,
+			Markdown:  readme.Markdown,
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("upsert chunk readme: %w", err)
+		}
+
+		ret = chunkReadmeToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.ChunkReadme{}, err
+	}
+
+	return ret, nil
+}
+
+// ChunkReadmeByChunkID returns the readme of the chunk.
+// returns [apierrs.ErrNotFound] if the chunk has no readme.
+func (db *DB) ChunkReadmeByChunkID(ctx context.Context, chunkID string) (resource.ChunkReadme, error) {
+	var ret resource.ChunkReadme
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.ChunkReadmeByChunkID(ctx, chunkID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("chunk readme: %w", err)
+		}
+
+		ret = chunkReadmeToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.ChunkReadme{}, err
+	}
+
+	return ret, nil
+}
+
+// CreateChunkMedia adds the media to the chunk. if the chunk already has
+// media with the same hash, the existing media is returned instead.
+func (db *DB) CreateChunkMedia(ctx context.Context, media resource.ChunkMedia) (resource.ChunkMedia, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return resource.ChunkMedia{}, fmt.Errorf("media id: %w", err)
+	}
+
+	var ret resource.ChunkMedia
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.CreateChunkMedia(ctx, query.CreateChunkMediaParams{
+			ID:          id.String(),
+			ChunkID:     media.ChunkID,
+			Hash:        media.Hash,
+			ContentType: media.ContentType,
+			SizeBytes:   int64(media.SizeBytes),
+			CreatedAt:   time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("create chunk media: %w", err)
+		}
+
+		ret = chunkMediaToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.ChunkMedia{}, err
+	}
+
+	return ret, nil
+}
+
+// ChunkMediaByChunkID returns the media of the chunk in the order it has been added.
+func (db *DB) ChunkMediaByChunkID(ctx context.Context, chunkID string) ([]resource.ChunkMedia, error) {
+	var ret []resource.ChunkMedia
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.ChunkMediaByChunkID(ctx, chunkID)
+		if err != nil {
+			return fmt.Errorf("chunk media: %w", err)
+		}
+
+		ret = make([]resource.ChunkMedia, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, chunkMediaToDomain(row))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// ChunkMediaByID returns [apierrs.ErrNotFound] if the media does not exist.
+func (db *DB) ChunkMediaByID(ctx context.Context, id string) (resource.ChunkMedia, error) {
+	var ret resource.ChunkMedia
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.ChunkMediaByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("chunk media: %w", err)
+		}
+
+		ret = chunkMediaToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.ChunkMedia{}, err
+	}
+
+	return ret, nil
+}
+
+// DeleteChunkMedia returns [apierrs.ErrNotFound] if the media does not exist.
+func (db *DB) DeleteChunkMedia(ctx context.Context, id string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		n, err := q.DeleteChunkMedia(ctx, id)
+		if err != nil {
+			return fmt.Errorf("delete chunk media: %w", err)
+		}
+
+		if n == 0 {
+			return apierrs.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+func chunkReadmeToDomain(row query.ChunkReadme) resource.ChunkReadme {
+	return resource.ChunkReadme{
+		ChunkID:   row.ChunkID,
+		Markdown:  row.Markdown,
+		UpdatedAt: row.UpdatedAt.UTC(),
+	}
+}
+
+func chunkMediaToDomain(row query.ChunkMedium) resource.ChunkMedia {
+	return resource.ChunkMedia{
+		ID:          row.ID,
+		ChunkID:     row.ChunkID,
+		Hash:        row.Hash,
+		ContentType: row.ContentType,
+		SizeBytes:   uint64(row.SizeBytes),
+		CreatedAt:   row.CreatedAt.UTC(),
+	}
+}
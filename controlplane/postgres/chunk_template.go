@@ -0,0 +1,191 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// CreateChunkTemplate creates the template. returns [apierrs.ErrTemplateNameExists]
+// if another template already uses the name.
+func (db *DB) CreateChunkTemplate(ctx context.Context, tmpl resource.ChunkTemplate) (resource.ChunkTemplate, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return resource.ChunkTemplate{}, fmt.Errorf("template id: %w", err)
+	}
+
+	var ret resource.ChunkTemplate
+	err = db.do(ctx, func(q *query.Queries) error {
+		row, err := q.CreateChunkTemplate(ctx, query.CreateChunkTemplateParams{
+			ID:              id.String(),
+			Name:            tmpl.Name,
+			Description:     tmpl.Description,
+			FlavorVersionID: tmpl.FlavorVersionID,
+			CreatedAt:       time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		ret = chunkTemplateToDomain(row)
+		return nil
+	})
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return resource.ChunkTemplate{}, apierrs.ErrTemplateNameExists
+	}
+
+	if err != nil {
+		return resource.ChunkTemplate{}, fmt.Errorf("create chunk template: %w", err)
+	}
+
+	return ret, nil
+}
+
+// ChunkTemplateByID returns the template. returns
+// [apierrs.ErrTemplateNotFound] if it does not exist.
+func (db *DB) ChunkTemplateByID(ctx context.Context, id string) (resource.ChunkTemplate, error) {
+	var ret resource.ChunkTemplate
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.ChunkTemplateByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrTemplateNotFound
+			}
+			return fmt.Errorf("chunk template: %w", err)
+		}
+
+		ret = chunkTemplateToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.ChunkTemplate{}, err
+	}
+
+	return ret, nil
+}
+
+// ChunkTemplateByName returns the template with the given name. returns
+// [apierrs.ErrTemplateNotFound] if it does not exist.
+func (db *DB) ChunkTemplateByName(ctx context.Context, name string) (resource.ChunkTemplate, error) {
+	var ret resource.ChunkTemplate
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.ChunkTemplateByName(ctx, name)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrTemplateNotFound
+			}
+			return fmt.Errorf("chunk template: %w", err)
+		}
+
+		ret = chunkTemplateToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.ChunkTemplate{}, err
+	}
+
+	return ret, nil
+}
+
+// ChunkTemplates returns all templates ordered by their name.
+func (db *DB) ChunkTemplates(ctx context.Context) ([]resource.ChunkTemplate, error) {
+	var ret []resource.ChunkTemplate
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.ChunkTemplates(ctx)
+		if err != nil {
+			return fmt.Errorf("chunk templates: %w", err)
+		}
+
+		ret = make([]resource.ChunkTemplate, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, chunkTemplateToDomain(row))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// UpdateChunkTemplate replaces the description and flavor version of the
+// template. returns [apierrs.ErrTemplateNotFound] if it does not exist.
+func (db *DB) UpdateChunkTemplate(ctx context.Context, tmpl resource.ChunkTemplate) (resource.ChunkTemplate, error) {
+	var ret resource.ChunkTemplate
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.UpdateChunkTemplate(ctx, query.UpdateChunkTemplateParams{
+			ID:              tmpl.ID,
+			Description:     tmpl.Description,
+			FlavorVersionID: tmpl.FlavorVersionID,
+			UpdatedAt:       time.Now(),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrTemplateNotFound
+			}
+			return fmt.Errorf("update chunk template: %w", err)
+		}
+
+		ret = chunkTemplateToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.ChunkTemplate{}, err
+	}
+
+	return ret, nil
+}
+
+// DeleteChunkTemplate removes the template. chunks created from it are not
+// affected. returns [apierrs.ErrTemplateNotFound] if it does not exist.
+func (db *DB) DeleteChunkTemplate(ctx context.Context, id string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		n, err := q.DeleteChunkTemplate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("delete chunk template: %w", err)
+		}
+
+		if n == 0 {
+			return apierrs.ErrTemplateNotFound
+		}
+
+		return nil
+	})
+}
+
+func chunkTemplateToDomain(row query.ChunkTemplate) resource.ChunkTemplate {
+	return resource.ChunkTemplate{
+		ID:              row.ID,
+		Name:            row.Name,
+		Description:     row.Description,
+		FlavorVersionID: row.FlavorVersionID,
+		CreatedAt:       row.CreatedAt.UTC(),
+		UpdatedAt:       row.UpdatedAt.UTC(),
+	}
+}
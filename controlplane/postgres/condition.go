@@ -0,0 +1,190 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/spacechunks/explorer/controlplane/condition"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+)
+
+func (db *DB) ReplaceNodeConditions(
+	ctx context.Context,
+	nodeID string,
+	conditions []condition.Condition,
+) ([]condition.Event, error) {
+	var ret []condition.Event
+	if err := db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+		// locking the node serializes concurrent reports of
+		// the same node, so events are not raised twice.
+		if _, err := q.LockNode(ctx, nodeID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNodeNotFound
+			}
+			return fmt.Errorf("lock node: %w", err)
+		}
+
+		existing, err := q.GetNodeConditions(ctx, nodeID)
+		if err != nil {
+			return fmt.Errorf("get node conditions: %w", err)
+		}
+
+		now := time.Now()
+
+		ret = make([]condition.Event, 0)
+		insertEvent := func(typ condition.EventType, condType condition.Type, msg string) error {
+			id, err := uuid.NewV7()
+			if err != nil {
+				return fmt.Errorf("event id: %w", err)
+			}
+
+			ev := condition.Event{
+				ID:            id.String(),
+				NodeID:        nodeID,
+				Type:          typ,
+				ConditionType: condType,
+				Message:       msg,
+				CreatedAt:     now,
+			}
+
+			if err := q.InsertNodeEvent(ctx, query.InsertNodeEventParams{
+				ID:            ev.ID,
+				NodeID:        ev.NodeID,
+				Type:          query.NodeEventType(ev.Type),
+				ConditionType: query.NodeConditionType(ev.ConditionType),
+				Message:       ev.Message,
+				CreatedAt:     ev.CreatedAt,
+			}); err != nil {
+				return fmt.Errorf("insert node event: %w", err)
+			}
+
+			ret = append(ret, ev)
+			return nil
+		}
+
+		for _, c := range conditions {
+			if err := q.UpsertNodeCondition(ctx, query.UpsertNodeConditionParams{
+				NodeID:  nodeID,
+				Type:    query.NodeConditionType(c.Type),
+				Message: c.Message,
+				Since:   now,
+			}); err != nil {
+				return fmt.Errorf("upsert node condition: %w", err)
+			}
+
+			if slices.ContainsFunc(existing, func(e query.NodeCondition) bool {
+				return condition.Type(e.Type) == c.Type
+			}) {
+				continue
+			}
+
+			if err := insertEvent(condition.EventTypeRaised, c.Type, c.Message); err != nil {
+				return err
+			}
+		}
+
+		// all conditions that have not been reported again are cleared
+		for _, c := range existing {
+			typ := condition.Type(c.Type)
+			if slices.ContainsFunc(conditions, func(r condition.Condition) bool { return r.Type == typ }) {
+				continue
+			}
+
+			if err := q.DeleteNodeCondition(ctx, query.DeleteNodeConditionParams{
+				NodeID: nodeID,
+				Type:   c.Type,
+			}); err != nil {
+				return fmt.Errorf("delete node condition: %w", err)
+			}
+
+			if err := insertEvent(condition.EventTypeCleared, typ, c.Message); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) ListNodeConditions(ctx context.Context) ([]condition.Condition, error) {
+	var ret []condition.Condition
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.ListNodeConditions(ctx)
+		if err != nil {
+			return fmt.Errorf("list node conditions: %w", err)
+		}
+
+		ret = make([]condition.Condition, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, condition.Condition{
+				NodeID:   row.NodeID,
+				NodeName: row.NodeName,
+				Type:     condition.Type(row.Type),
+				Message:  row.Message,
+				Since:    row.Since.UTC(),
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) ListNodeEvents(ctx context.Context, limit int) ([]condition.Event, error) {
+	var ret []condition.Event
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.ListNodeEvents(ctx, int32(limit))
+		if err != nil {
+			return fmt.Errorf("list node events: %w", err)
+		}
+
+		ret = make([]condition.Event, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, condition.Event{
+				ID:            row.ID,
+				NodeID:        row.NodeID,
+				Type:          condition.EventType(row.Type),
+				ConditionType: condition.Type(row.ConditionType),
+				Message:       row.Message,
+				CreatedAt:     row.CreatedAt.UTC(),
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
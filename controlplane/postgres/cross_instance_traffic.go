@@ -0,0 +1,199 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// RequestCrossInstanceTraffic creates a request in REQUESTED state for the
+// chunk. an existing request is replaced, which resets its review.
+func (db *DB) RequestCrossInstanceTraffic(
+	ctx context.Context,
+	chunkID string,
+	reason string,
+	requestedBy string,
+) (resource.CrossInstanceTraffic, error) {
+	var ret resource.CrossInstanceTraffic
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.RequestCrossInstanceTraffic(ctx, query.RequestCrossInstanceTrafficParams{
+			ChunkID:     chunkID,
+			Reason:      reason,
+			RequestedBy: requestedBy,
+			CreatedAt:   time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("request cross-instance traffic: %w", err)
+		}
+
+		ret = crossInstanceTrafficToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.CrossInstanceTraffic{}, err
+	}
+
+	return ret, nil
+}
+
+// CrossInstanceTrafficByChunkID returns the cross-instance traffic request
+// of the chunk. returns [apierrs.ErrNotFound] if the chunk has none.
+func (db *DB) CrossInstanceTrafficByChunkID(
+	ctx context.Context,
+	chunkID string,
+) (resource.CrossInstanceTraffic, error) {
+	var ret resource.CrossInstanceTraffic
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.CrossInstanceTrafficByChunkID(ctx, chunkID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("cross-instance traffic: %w", err)
+		}
+
+		ret = crossInstanceTrafficToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.CrossInstanceTraffic{}, err
+	}
+
+	return ret, nil
+}
+
+// ReviewCrossInstanceTraffic moves the request of the chunk to the given state.
+// returns [apierrs.ErrNotFound] if the chunk has no request.
+func (db *DB) ReviewCrossInstanceTraffic(
+	ctx context.Context,
+	chunkID string,
+	state resource.CrossInstanceTrafficState,
+	reviewedBy string,
+	message string,
+) (resource.CrossInstanceTraffic, error) {
+	var ret resource.CrossInstanceTraffic
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.ReviewCrossInstanceTraffic(ctx, query.ReviewCrossInstanceTrafficParams{
+			ChunkID:       chunkID,
+			State:         query.CrossInstanceTrafficState(state),
+			ReviewedBy:    &reviewedBy,
+			ReviewMessage: message,
+			UpdatedAt:     time.Now(),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("review cross-instance traffic: %w", err)
+		}
+
+		ret = crossInstanceTrafficToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.CrossInstanceTraffic{}, err
+	}
+
+	return ret, nil
+}
+
+// DeleteCrossInstanceTraffic removes the request of the chunk, regardless
+// of its state. returns [apierrs.ErrNotFound] if the chunk has no request.
+func (db *DB) DeleteCrossInstanceTraffic(ctx context.Context, chunkID string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		n, err := q.DeleteCrossInstanceTraffic(ctx, chunkID)
+		if err != nil {
+			return fmt.Errorf("delete cross-instance traffic: %w", err)
+		}
+
+		if n == 0 {
+			return apierrs.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// CrossInstanceTrafficByState returns the requests in the given state of all
+// chunks that have not been deleted, least recently updated first.
+func (db *DB) CrossInstanceTrafficByState(
+	ctx context.Context,
+	state resource.CrossInstanceTrafficState,
+) ([]resource.CrossInstanceTraffic, error) {
+	var ret []resource.CrossInstanceTraffic
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.CrossInstanceTrafficByState(ctx, query.CrossInstanceTrafficState(state))
+		if err != nil {
+			return fmt.Errorf("cross-instance traffic by state: %w", err)
+		}
+
+		ret = make([]resource.CrossInstanceTraffic, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, crossInstanceTrafficToDomain(row))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// CrossInstanceTrafficApproved reports whether the chunk of the given flavor
+// has an approved cross-instance traffic request.
+func (db *DB) CrossInstanceTrafficApproved(ctx context.Context, flavorID string) (bool, error) {
+	var ret bool
+	if err := db.do(ctx, func(q *query.Queries) error {
+		approved, err := q.CrossInstanceTrafficApprovedByFlavorID(ctx, flavorID)
+		if err != nil {
+			return fmt.Errorf("cross-instance traffic approved: %w", err)
+		}
+
+		ret = approved
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return ret, nil
+}
+
+func crossInstanceTrafficToDomain(row query.ChunkCrossInstanceTraffic) resource.CrossInstanceTraffic {
+	ret := resource.CrossInstanceTraffic{
+		ChunkID:       row.ChunkID,
+		State:         resource.CrossInstanceTrafficState(row.State),
+		Reason:        row.Reason,
+		RequestedBy:   row.RequestedBy,
+		ReviewMessage: row.ReviewMessage,
+		CreatedAt:     row.CreatedAt.UTC(),
+		UpdatedAt:     row.UpdatedAt.UTC(),
+	}
+
+	if row.ReviewedBy != nil {
+		ret.ReviewedBy = *row.ReviewedBy
+	}
+
+	return ret
+}
@@ -25,6 +25,7 @@ import (
 	"log/slog"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/riverqueue/river"
 	"github.com/spacechunks/explorer/controlplane/postgres/query"
@@ -70,8 +71,28 @@ func (db *DB) do(ctx context.Context, fn func(q *query.Queries) error) error {
 }
 
 func (db *DB) doTX(ctx context.Context, fn func(tx pgx.Tx, q *query.Queries) error) error {
+	return db.doTXWithOptions(ctx, pgx.TxOptions{}, fn)
+}
+
+// doSnapshot runs fn in a read-only repeatable read transaction, so all
+// statements observe the same snapshot of the database, even if other
+// transactions commit in between.
+func (db *DB) doSnapshot(ctx context.Context, fn func(q *query.Queries) error) error {
+	return db.doTXWithOptions(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	}, func(_ pgx.Tx, q *query.Queries) error {
+		return fn(q)
+	})
+}
+
+func (db *DB) doTXWithOptions(
+	ctx context.Context,
+	opts pgx.TxOptions,
+	fn func(tx pgx.Tx, q *query.Queries) error,
+) error {
 	if err := db.pool.AcquireFunc(ctx, func(conn *pgxpool.Conn) error {
-		tx, err := conn.Begin(ctx)
+		tx, err := conn.BeginTx(ctx, opts)
 		if err != nil {
 			return fmt.Errorf("begin tx: %w", err)
 		}
@@ -93,6 +114,13 @@ func (db *DB) doTX(ctx context.Context, fn func(tx pgx.Tx, q *query.Queries) err
 	return nil
 }
 
+// isSerializationFailure reports whether a serializable transaction has been
+// aborted, because it conflicted with a concurrent transaction.
+func isSerializationFailure(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "40001"
+}
+
 func (db *DB) bulkExecAndClose(execer bulkExecer) error {
 	var err error
 	execer.Exec(func(i int, e error) {
@@ -20,6 +20,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -28,11 +29,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/riverqueue/river"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/notification"
 	"github.com/spacechunks/explorer/controlplane/postgres/query"
 	"github.com/spacechunks/explorer/internal/file"
+	"github.com/spacechunks/explorer/internal/ptr"
 	"github.com/spacechunks/explorer/internal/resource"
 )
 
@@ -113,7 +118,7 @@ func (db *DB) FlavorVersionExists(ctx context.Context, flavorID string, version
 
 func (db *DB) LatestFlavorVersion(ctx context.Context, flavorID string) (resource.FlavorVersion, error) {
 	var ret resource.FlavorVersion
-	if err := db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+	if err := db.doSnapshot(ctx, func(q *query.Queries) error {
 		// FIXME: at some point join with flavors table to return the complete
 		// FlavorVersion object, right now there is no need so skip this
 
@@ -133,8 +138,9 @@ func (db *DB) LatestFlavorVersion(ctx context.Context, flavorID string) (resourc
 		hashes := make([]file.Hash, 0, len(files))
 		for _, f := range files {
 			hashes = append(hashes, file.Hash{
-				Path: f.FilePath,
-				Hash: f.FileHash,
+				Path:      f.FilePath,
+				Hash:      f.FileHash,
+				SizeBytes: uint64(f.SizeBytes),
 			})
 		}
 
@@ -143,13 +149,18 @@ func (db *DB) LatestFlavorVersion(ctx context.Context, flavorID string) (resourc
 		})
 
 		ret = resource.FlavorVersion{
-			ID:         latest.ID,
-			Version:    latest.Version,
-			Hash:       latest.Hash,
-			FileHashes: hashes,
-			CreatedAt:  latest.CreatedAt,
-			MinPlayers: uint32(latest.MinPlayers),
-			MaxPlayers: uint32(latest.MaxPlayers),
+			ID:                 latest.ID,
+			Version:            latest.Version,
+			Hash:               latest.Hash,
+			FileHashes:         hashes,
+			CreatedAt:          latest.CreatedAt,
+			MinPlayers:         uint32(latest.MinPlayers),
+			MaxPlayers:         uint32(latest.MaxPlayers),
+			StartupGracePeriod: time.Duration(latest.StartupGracePeriodSeconds) * time.Second,
+			RestartPolicy:      resource.RestartPolicy(latest.RestartPolicy),
+			RestartMaxRetries:  uint32(latest.RestartMaxRetries),
+			JavaVersion:        uint32(latest.JavaVersion),
+			StartupCommand:     latest.StartupCommand,
 		}
 
 		return nil
@@ -173,16 +184,43 @@ func (db *DB) CreateFlavorVersion(
 
 	now := time.Now()
 
-	if err := db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+	restartPolicy := version.RestartPolicy
+	if restartPolicy == "" {
+		restartPolicy = resource.RestartPolicyNever
+	}
+
+	// the transaction is serializable, so concurrently created versions of the
+	// same flavor cannot both be based on the same previous version. readers
+	// only ever observe the version including all of its files and assets.
+	err = db.doTXWithOptions(ctx, pgx.TxOptions{IsoLevel: pgx.Serializable}, func(tx pgx.Tx, q *query.Queries) error {
+		var latestID string
+		latest, err := q.LatestFlavorVersionByFlavorID(ctx, flavorID)
+		if err == nil {
+			latestID = latest.ID
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("latest flavor version: %w", err)
+		}
+
+		// another version has been created since the
+		// diff to the previous version has been computed.
+		if latestID != prevVersionID {
+			return apierrs.ErrFlavorVersionConflict
+		}
+
 		createParams := query.CreateFlavorVersionParams{
-			ID:               id.String(),
-			FlavorID:         flavorID,
-			Hash:             version.Hash,
-			Version:          version.Version,
-			MinecraftVersion: version.MinecraftVersion,
-			CreatedAt:        now,
-			MinPlayers:       int32(version.MinPlayers),
-			MaxPlayers:       int32(version.MaxPlayers),
+			ID:                        id.String(),
+			FlavorID:                  flavorID,
+			Hash:                      version.Hash,
+			Version:                   version.Version,
+			MinecraftVersion:          version.MinecraftVersion,
+			CreatedAt:                 now,
+			MinPlayers:                int32(version.MinPlayers),
+			MaxPlayers:                int32(version.MaxPlayers),
+			StartupGracePeriodSeconds: int32(version.StartupGracePeriod / time.Second),
+			RestartPolicy:             query.RestartPolicy(restartPolicy),
+			RestartMaxRetries:         int32(version.RestartMaxRetries),
+			JavaVersion:               int32(version.JavaVersion),
+			StartupCommand:            version.StartupCommand,
 		}
 
 		if prevVersionID != "" {
@@ -199,6 +237,7 @@ func (db *DB) CreateFlavorVersion(
 				FlavorVersionID: id.String(),
 				FileHash:        f.Hash,
 				FilePath:        f.Path,
+				SizeBytes:       int64(f.SizeBytes),
 			})
 		}
 
@@ -206,8 +245,36 @@ func (db *DB) CreateFlavorVersion(
 			return fmt.Errorf("bulk insert flavor files: %w", err)
 		}
 
+		if len(version.AssetHashes) == 0 {
+			return nil
+		}
+
+		dbAssets := make([]query.BulkInsertFlavorVersionAssetsParams, 0, len(version.AssetHashes))
+		for i, h := range version.AssetHashes {
+			dbAssets = append(dbAssets, query.BulkInsertFlavorVersionAssetsParams{
+				FlavorVersionID: id.String(),
+				AssetHash:       h,
+				Position:        int16(i),
+			})
+		}
+
+		if err := db.bulkExecAndClose(q.BulkInsertFlavorVersionAssets(ctx, dbAssets)); err != nil {
+			return fmt.Errorf("bulk insert flavor version assets: %w", err)
+		}
+
 		return nil
-	}); err != nil {
+	})
+
+	if isSerializationFailure(err) {
+		return resource.FlavorVersion{}, apierrs.ErrFlavorVersionConflict
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return resource.FlavorVersion{}, apierrs.ErrFlavorVersionExists
+	}
+
+	if err != nil {
 		return resource.FlavorVersion{}, err
 	}
 
@@ -243,7 +310,7 @@ func (db *DB) MarkFlavorVersionFilesUploaded(ctx context.Context, flavorVersionI
 func (db *DB) FlavorVersionByID(ctx context.Context, id string) (resource.FlavorVersion, error) {
 	var ret resource.FlavorVersion
 
-	if err := db.do(ctx, func(q *query.Queries) error {
+	if err := db.doSnapshot(ctx, func(q *query.Queries) error {
 		rows, err := q.FlavorVersionByID(ctx, id)
 		if err != nil {
 			return err
@@ -257,15 +324,27 @@ func (db *DB) FlavorVersionByID(ctx context.Context, id string) (resource.Flavor
 
 		row := rows[0]
 		ret = resource.FlavorVersion{
-			ID:               row.ID,
-			Version:          row.Version,
-			MinecraftVersion: row.MinecraftVersion,
-			Hash:             row.Hash,
-			BuildStatus:      resource.FlavorVersionBuildStatus(row.BuildStatus),
-			FilesUploaded:    row.FilesUploaded,
-			CreatedAt:        row.CreatedAt,
-			MinPlayers:       uint32(row.MinPlayers),
-			MaxPlayers:       uint32(row.MaxPlayers),
+			ID:                 row.ID,
+			Version:            row.Version,
+			MinecraftVersion:   row.MinecraftVersion,
+			Hash:               row.Hash,
+			BuildStatus:        resource.FlavorVersionBuildStatus(row.BuildStatus),
+			FilesUploaded:      row.FilesUploaded,
+			CreatedAt:          row.CreatedAt,
+			MinPlayers:         uint32(row.MinPlayers),
+			MaxPlayers:         uint32(row.MaxPlayers),
+			StartupGracePeriod: time.Duration(row.StartupGracePeriodSeconds) * time.Second,
+			RestartPolicy:      resource.RestartPolicy(row.RestartPolicy),
+			RestartMaxRetries:  uint32(row.RestartMaxRetries),
+			CheckpointResources: checkpointResources(
+				row.CheckpointCpuMillis,
+				row.CheckpointMemoryLimitBytes,
+			),
+			BuildError:     row.BuildError.String,
+			BuildProgress:  uint32(row.BuildProgress),
+			RestoreLatency: time.Duration(row.RestoreLatencyMs.Int32) * time.Millisecond,
+			JavaVersion:    uint32(row.JavaVersion),
+			StartupCommand: row.StartupCommand,
 		}
 
 		var expiryDate *time.Time
@@ -283,13 +362,23 @@ func (db *DB) FlavorVersionByID(ctx context.Context, id string) (resource.Flavor
 
 		for _, r := range rows {
 			hashes = append(hashes, file.Hash{
-				Path: r.FilePath,
-				Hash: r.FileHash,
+				Path:      r.FilePath,
+				Hash:      r.FileHash,
+				SizeBytes: uint64(r.SizeBytes),
 			})
 		}
 
 		ret.FileHashes = hashes
 
+		assets, err := q.FlavorVersionAssetHashes(ctx, id)
+		if err != nil {
+			return fmt.Errorf("asset hashes: %w", err)
+		}
+
+		if len(assets) > 0 {
+			ret.AssetHashes = assets
+		}
+
 		return nil
 	}); err != nil {
 		return resource.FlavorVersion{}, err
@@ -303,14 +392,208 @@ func (db *DB) UpdateFlavorVersionBuildStatus(
 	flavorVersionID string,
 	status resource.FlavorVersionBuildStatus,
 ) error {
-	return db.do(ctx, func(q *query.Queries) error {
-		return q.UpdateFlavorVersionBuildStatus(ctx, query.UpdateFlavorVersionBuildStatusParams{
+	return db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+		if err := q.UpdateFlavorVersionBuildStatus(ctx, query.UpdateFlavorVersionBuildStatusParams{
 			BuildStatus: query.BuildStatus(status),
 			ID:          flavorVersionID,
+		}); err != nil {
+			return err
+		}
+		return db.insertBuildNotification(ctx, tx, flavorVersionID, status)
+	})
+}
+
+// RejectFlavorVersionBuild sets the build status of the flavor version to the
+// given failed status and records the reason, so it can be shown to the user.
+func (db *DB) RejectFlavorVersionBuild(
+	ctx context.Context,
+	flavorVersionID string,
+	status resource.FlavorVersionBuildStatus,
+	reason string,
+) error {
+	return db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+		if err := q.RejectFlavorVersionBuild(ctx, query.RejectFlavorVersionBuildParams{
+			BuildStatus: query.BuildStatus(status),
+			BuildError: pgtype.Text{
+				String: reason,
+				Valid:  true,
+			},
+			ID: flavorVersionID,
+		}); err != nil {
+			return err
+		}
+		return db.insertBuildNotification(ctx, tx, flavorVersionID, status)
+	})
+}
+
+// insertBuildNotification queues a notification about the build of the flavor
+// version, if the given status indicates that the build has finished. the job is
+// inserted in the same transaction the status is updated in, so notifications
+// are only sent if the status change has actually been persisted.
+func (db *DB) insertBuildNotification(
+	ctx context.Context,
+	tx pgx.Tx,
+	flavorVersionID string,
+	status resource.FlavorVersionBuildStatus,
+) error {
+	kind, ok := notification.BuildKind(status)
+	if !ok {
+		return nil
+	}
+
+	if _, err := db.riverClient.InsertTx(ctx, tx, job.SendNotification{
+		Type:            kind,
+		FlavorVersionID: flavorVersionID,
+	}, &river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+		},
+	}); err != nil {
+		return fmt.Errorf("insert notification job: %w", err)
+	}
+
+	return nil
+}
+
+func (db *DB) UpdateFlavorVersionBuildProgress(ctx context.Context, flavorVersionID string, percent uint32) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.UpdateFlavorVersionBuildProgress(ctx, query.UpdateFlavorVersionBuildProgressParams{
+			BuildProgress: int16(percent),
+			ID:            flavorVersionID,
 		})
 	})
 }
 
+// UpdateFlavorVersionRestoreLatency records the time it took until the restored
+// checkpoint of the flavor version responded, when verifying it during the build.
+func (db *DB) UpdateFlavorVersionRestoreLatency(
+	ctx context.Context,
+	flavorVersionID string,
+	latency time.Duration,
+) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.UpdateFlavorVersionRestoreLatency(ctx, query.UpdateFlavorVersionRestoreLatencyParams{
+			RestoreLatencyMs: pgtype.Int4{
+				Int32: int32(latency.Milliseconds()),
+				Valid: true,
+			},
+			ID: flavorVersionID,
+		})
+	})
+}
+
+// RecordFlavorVersionImageProvenance records what the image of the flavor version
+// has been built from. the provenance of a previously built checkpoint is reset.
+func (db *DB) RecordFlavorVersionImageProvenance(
+	ctx context.Context,
+	flavorVersionID string,
+	prov resource.BuildProvenance,
+) error {
+	plugins, err := json.Marshal(prov.Plugins)
+	if err != nil {
+		return fmt.Errorf("marshal plugins: %w", err)
+	}
+
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.UpsertFlavorVersionImageProvenance(ctx, query.UpsertFlavorVersionImageProvenanceParams{
+			FlavorVersionID: flavorVersionID,
+			BaseImageDigest: prov.BaseImageDigest,
+			ImageDigest:     prov.ImageDigest,
+			BuilderVersion:  prov.BuilderVersion,
+			InputHash:       prov.InputHash,
+			Plugins:         plugins,
+			ImageBuiltAt:    prov.ImageBuiltAt,
+		})
+	})
+}
+
+// RecordFlavorVersionCheckpointProvenance records the node the checkpoint of the
+// flavor version has been built on, together with the platformd version it runs.
+func (db *DB) RecordFlavorVersionCheckpointProvenance(
+	ctx context.Context,
+	flavorVersionID string,
+	nodeID string,
+	builtAt time.Time,
+) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.UpdateFlavorVersionCheckpointProvenance(ctx, query.UpdateFlavorVersionCheckpointProvenanceParams{
+			NodeID: nodeID,
+			BuiltAt: pgtype.Timestamptz{
+				Time:  builtAt,
+				Valid: true,
+			},
+			FlavorVersionID: flavorVersionID,
+		})
+	})
+}
+
+// FlavorVersionProvenance returns the build provenance of the flavor version.
+// returns ErrNotFound if the image of the flavor version has not been built
+// since provenance is recorded.
+func (db *DB) FlavorVersionProvenance(ctx context.Context, flavorVersionID string) (resource.BuildProvenance, error) {
+	var ret resource.BuildProvenance
+
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.FlavorVersionProvenance(ctx, flavorVersionID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("flavor version provenance: %w", err)
+		}
+
+		var plugins []resource.PluginHash
+		if err := json.Unmarshal(row.Plugins, &plugins); err != nil {
+			return fmt.Errorf("unmarshal plugins: %w", err)
+		}
+
+		ret = resource.BuildProvenance{
+			BaseImageDigest:  row.BaseImageDigest,
+			ImageDigest:      row.ImageDigest,
+			BuilderVersion:   row.BuilderVersion,
+			InputHash:        row.InputHash,
+			Plugins:          plugins,
+			ImageBuiltAt:     row.ImageBuiltAt,
+			PlatformdVersion: row.PlatformdVersion,
+		}
+
+		if row.CheckpointNodeID != nil {
+			ret.CheckpointNodeID = *row.CheckpointNodeID
+		}
+
+		if row.CheckpointBuiltAt.Valid {
+			ret.CheckpointBuiltAt = &row.CheckpointBuiltAt.Time
+		}
+
+		return nil
+	}); err != nil {
+		return resource.BuildProvenance{}, err
+	}
+
+	return ret, nil
+}
+
+// UpdateFlavorVersionCheckpointResources records the resources requested for
+// building the checkpoint of the flavor version. nil resets them to the defaults.
+func (db *DB) UpdateFlavorVersionCheckpointResources(
+	ctx context.Context,
+	flavorVersionID string,
+	res *resource.CheckpointResources,
+) error {
+	params := query.UpdateFlavorVersionCheckpointResourcesParams{
+		ID: flavorVersionID,
+	}
+
+	if res != nil {
+		params.CpuMillis = ptr.Pointer(int32(res.CPUMillis))
+		params.MemoryLimitBytes = ptr.Pointer(int64(res.MemoryLimitBytes))
+	}
+
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.UpdateFlavorVersionCheckpointResources(ctx, params)
+	})
+}
+
 func (db *DB) UpdateFlavorVersionPresignedURLData(
 	ctx context.Context,
 	flavorVersionID string,
@@ -381,7 +664,7 @@ func (db *DB) MarkFlavorDeleted(ctx context.Context, id string) error {
 
 func (db *DB) FlavorByID(ctx context.Context, id string) (resource.Flavor, error) {
 	var ret resource.Flavor
-	if err := db.do(ctx, func(q *query.Queries) error {
+	if err := db.doSnapshot(ctx, func(q *query.Queries) error {
 		rows, err := q.GetFlavorByID(ctx, id)
 		if err != nil {
 			return err
@@ -437,6 +720,15 @@ func (db *DB) FlavorByID(ctx context.Context, id string) (resource.Flavor, error
 				PresignedURL:           presignedURL,
 				MinPlayers:             uint32(r.MinPlayers.Int32),
 				MaxPlayers:             uint32(r.MaxPlayers.Int32),
+				StartupGracePeriod:     time.Duration(r.StartupGracePeriodSeconds.Int32) * time.Second,
+				RestartPolicy:          resource.RestartPolicy(r.RestartPolicy.RestartPolicy),
+				RestartMaxRetries:      uint32(r.RestartMaxRetries.Int32),
+				CheckpointResources:    checkpointResources(r.CheckpointCpuMillis, r.CheckpointMemoryLimitBytes),
+				BuildError:             r.BuildError.String,
+				BuildProgress:          uint32(r.BuildProgress.Int16),
+				RestoreLatency:         time.Duration(r.RestoreLatencyMs.Int32) * time.Millisecond,
+				JavaVersion:            uint32(r.JavaVersion.Int32),
+				StartupCommand:         r.StartupCommand.String,
 			})
 		}
 
@@ -472,3 +764,14 @@ func (db *DB) InsertJob(ctx context.Context, flavorVersionID string, status stri
 		return nil
 	})
 }
+
+// checkpointResources returns nil if no resources have been recorded.
+func checkpointResources(cpuMillis *int32, memoryLimitBytes *int64) *resource.CheckpointResources {
+	if cpuMillis == nil || memoryLimitBytes == nil {
+		return nil
+	}
+	return &resource.CheckpointResources{
+		CPUMillis:        uint32(*cpuMillis),
+		MemoryLimitBytes: uint64(*memoryLimitBytes),
+	}
+}
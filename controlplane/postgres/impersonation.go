@@ -0,0 +1,121 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (db *DB) CreateImpersonation(
+	ctx context.Context,
+	imp resource.Impersonation,
+) (resource.Impersonation, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return resource.Impersonation{}, fmt.Errorf("impersonation id: %w", err)
+	}
+
+	var ret resource.Impersonation
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.CreateImpersonation(ctx, query.CreateImpersonationParams{
+			ID:        id.String(),
+			UserID:    imp.UserID,
+			AdminID:   imp.AdminID,
+			Reason:    imp.Reason,
+			CreatedAt: time.Now(),
+			ExpiresAt: imp.ExpiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("create impersonation: %w", err)
+		}
+
+		ret = impersonationToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.Impersonation{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) RecordImpersonatedAction(ctx context.Context, impersonationID string, method string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.InsertImpersonatedAction(ctx, query.InsertImpersonatedActionParams{
+			ImpersonationID: impersonationID,
+			Method:          method,
+			CreatedAt:       time.Now(),
+		}); err != nil {
+			return fmt.Errorf("insert impersonated action: %w", err)
+		}
+		return nil
+	})
+}
+
+func (db *DB) ImpersonationsByUserID(ctx context.Context, userID string) ([]resource.Impersonation, error) {
+	var ret []resource.Impersonation
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.ImpersonationsByUserID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("impersonations: %w", err)
+		}
+
+		actionRows, err := q.ImpersonatedActionsByUserID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("impersonated actions: %w", err)
+		}
+
+		actions := make(map[string][]resource.ImpersonatedAction, len(rows))
+		for _, a := range actionRows {
+			actions[a.ImpersonationID] = append(actions[a.ImpersonationID], resource.ImpersonatedAction{
+				Method:    a.Method,
+				CreatedAt: a.CreatedAt.UTC(),
+			})
+		}
+
+		ret = make([]resource.Impersonation, 0, len(rows))
+		for _, row := range rows {
+			imp := impersonationToDomain(row)
+			imp.Actions = actions[row.ID]
+			ret = append(ret, imp)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func impersonationToDomain(row query.Impersonation) resource.Impersonation {
+	return resource.Impersonation{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		AdminID:   row.AdminID,
+		Reason:    row.Reason,
+		CreatedAt: row.CreatedAt.UTC(),
+		ExpiresAt: row.ExpiresAt.UTC(),
+	}
+}
@@ -20,11 +20,19 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/netip"
 	"sort"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/notification"
 	"github.com/spacechunks/explorer/controlplane/postgres/query"
 	"github.com/spacechunks/explorer/internal/ptr"
 	"github.com/spacechunks/explorer/internal/resource"
@@ -32,22 +40,68 @@ import (
 
 func (db *DB) CreateInstance(ctx context.Context, ins resource.Instance, nodeID string) (resource.Instance, error) {
 	params := query.CreateInstanceParams{
-		ID:              ins.ID,
-		FlavorVersionID: ins.FlavorVersion.ID,
-		NodeID:          nodeID,
-		State:           query.InstanceState(ins.State),
-		OwnerID:         ins.Owner.ID,
-		CreatedAt:       ins.CreatedAt,
-		UpdatedAt:       ins.UpdatedAt,
-		OrderedBy:       ins.OrderedBy,
+		ID:                   ins.ID,
+		FlavorVersionID:      ins.FlavorVersion.ID,
+		NodeID:               nodeID,
+		State:                query.InstanceState(ins.State),
+		OwnerID:              ins.Owner.ID,
+		CreatedAt:            ins.CreatedAt,
+		UpdatedAt:            ins.UpdatedAt,
+		OrderedBy:            ins.OrderedBy,
+		WorldSeed:            ins.Params.WorldSeed,
+		GameMode:             string(ins.Params.GameMode),
+		CustomParams:         ins.Params.Custom,
+		PlacementGroup:       ins.PlacementGroup,
+		CrossInstanceTraffic: ins.CrossInstanceTraffic,
 	}
 
 	var ret resource.Instance
 	if err := db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+		// the node row stays locked until the transaction ends, so
+		// replicas scheduling instances at the same time have to wait
+		// for us and will see the instance we are about to create.
+		slots, err := q.LockNode(ctx, nodeID)
+		if err != nil {
+			return fmt.Errorf("lock node: %w", err)
+		}
+
+		count, err := q.CountInstancesByNodeID(ctx, nodeID)
+		if err != nil {
+			return fmt.Errorf("count instances: %w", err)
+		}
+
+		if count >= int64(slots) {
+			return apierrs.ErrNoSlotsAvailable
+		}
+
 		if err := q.CreateInstance(ctx, params); err != nil {
 			return fmt.Errorf("create instance: %w", err)
 		}
 
+		if err := q.CreateInstanceUsage(ctx, query.CreateInstanceUsageParams{
+			InstanceID:      params.ID,
+			StartedAt:       params.CreatedAt,
+			NodeID:          nodeID,
+			FlavorVersionID: params.FlavorVersionID,
+		}); err != nil {
+			return fmt.Errorf("create instance usage: %w", err)
+		}
+
+		evID, err := uuid.NewV7()
+		if err != nil {
+			return fmt.Errorf("generate event id: %w", err)
+		}
+
+		if err := q.InsertInstanceEvent(ctx, query.InsertInstanceEventParams{
+			ID:         evID.String(),
+			InstanceID: params.ID,
+			Type:       query.InstanceEventTypeSCHEDULED,
+			Message:    fmt.Sprintf("scheduled to node %s", nodeID),
+			CreatedAt:  params.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("insert scheduled event: %w", err)
+		}
+
 		ins, err := db.getInstanceByID(ctx, q, params.ID)
 		if err != nil {
 			return fmt.Errorf("get instance: %w", err)
@@ -98,12 +152,16 @@ func (db *DB) ListInstances(ctx context.Context, pageSize int, afterID *string)
 			// instance port is intentionally left out, because it will not be
 			// known beforehand atm, thus it will always be nil when creating.
 			i := resource.Instance{
-				ID:        row.Instance.ID,
-				Address:   row.Node.Address,
-				State:     resource.InstanceState(row.Instance.State),
-				CreatedAt: row.Instance.CreatedAt.UTC(),
-				UpdatedAt: row.Instance.UpdatedAt.UTC(),
-				OrderedBy: row.Instance.OrderedBy,
+				ID:                   row.Instance.ID,
+				Address:              row.Node.Address,
+				State:                resource.InstanceState(row.Instance.State),
+				CreatedAt:            row.Instance.CreatedAt.UTC(),
+				UpdatedAt:            row.Instance.UpdatedAt.UTC(),
+				OrderedBy:            row.Instance.OrderedBy,
+				Traffic:              instanceTraffic(row.Instance),
+				Params:               instanceParams(row.Instance),
+				PlacementGroup:       row.Instance.PlacementGroup,
+				CrossInstanceTraffic: row.Instance.CrossInstanceTraffic,
 				Chunk: resource.Chunk{
 					ID:          row.Chunk.ID,
 					Name:        row.Chunk.Name,
@@ -126,11 +184,14 @@ func (db *DB) ListInstances(ctx context.Context, pageSize int, afterID *string)
 					// FIXME: for now those are not needed anywhere, so they are not included in the query
 					FileHashes: nil,
 
-					FilesUploaded: row.FlavorVersion.FilesUploaded,
-					BuildStatus:   resource.FlavorVersionBuildStatus(row.FlavorVersion.BuildStatus),
-					CreatedAt:     row.FlavorVersion.CreatedAt.UTC(),
-					MinPlayers:    uint32(row.FlavorVersion.MinPlayers),
-					MaxPlayers:    uint32(row.FlavorVersion.MaxPlayers),
+					FilesUploaded:      row.FlavorVersion.FilesUploaded,
+					BuildStatus:        resource.FlavorVersionBuildStatus(row.FlavorVersion.BuildStatus),
+					CreatedAt:          row.FlavorVersion.CreatedAt.UTC(),
+					MinPlayers:         uint32(row.FlavorVersion.MinPlayers),
+					MaxPlayers:         uint32(row.FlavorVersion.MaxPlayers),
+					StartupGracePeriod: time.Duration(row.FlavorVersion.StartupGracePeriodSeconds) * time.Second,
+					RestartPolicy:      resource.RestartPolicy(row.FlavorVersion.RestartPolicy),
+					RestartMaxRetries:  uint32(row.FlavorVersion.RestartMaxRetries),
 				},
 				Owner: resource.User{
 					ID:        row.User.ID,
@@ -189,126 +250,389 @@ func (db *DB) GetInstanceByID(ctx context.Context, id string) (resource.Instance
 	return ret, nil
 }
 
-func (db *DB) GetInstancesByNodeID(ctx context.Context, nodeID string) ([]resource.Instance, error) {
-	ret := make([]resource.Instance, 0)
+func (db *DB) GetInstancesByNodeID(
+	ctx context.Context,
+	nodeID string,
+	afterRevision int64,
+) ([]resource.Instance, int64, error) {
+	var (
+		ret      = make([]resource.Instance, 0)
+		revision = afterRevision
+	)
 	if err := db.do(ctx, func(q *query.Queries) error {
-		rows, err := q.GetInstancesByNodeID(ctx, nodeID)
+		rows, err := q.GetInstancesByNodeID(ctx, query.GetInstancesByNodeIDParams{
+			NodeID:        nodeID,
+			AfterRevision: afterRevision,
+		})
 		if err != nil {
 			return err
 		}
 
-		// FIXME: for now it is okay to not return the full chunk object
-		// with all flavors, because we don't need it atm. but for consistency
-		// purposes it should be considered.
-
 		for _, row := range rows {
-			var port *uint16
-			if row.Instance.Port != nil {
-				port = new(uint16(*row.Instance.Port))
-			}
+			revision = max(revision, row.Instance.Revision)
+			ret = append(ret, revisionedInstanceToDomain(
+				row.FlavorVersion,
+				row.Chunk,
+				row.Flavor,
+				row.Node,
+				row.User,
+				row.Instance,
+			))
+		}
 
-			ret = append(ret, resource.Instance{
-				ID: row.Instance.ID,
-				Chunk: resource.Chunk{
-					ID:          row.Chunk.ID,
-					Name:        row.Chunk.Name,
-					Description: row.Chunk.Description,
-					Tags:        row.Chunk.Tags,
-					CreatedAt:   row.Chunk.CreatedAt.UTC(),
-					UpdatedAt:   row.Chunk.UpdatedAt.UTC(),
-					DeletedAt:   nil, // not needed atm
-				},
-				Flavor: resource.Flavor{
-					ID:        row.Flavor.ID,
-					Name:      row.Flavor.Name,
-					Versions:  nil, // not needed atm
-					CreatedAt: row.Flavor.CreatedAt.UTC(),
-					UpdatedAt: row.Flavor.UpdatedAt.UTC(),
-					DeletedAt: nil, // not needed atm
-				},
-				FlavorVersion: resource.FlavorVersion{
-					ID:               row.FlavorVersion.ID,
-					Version:          row.FlavorVersion.Version,
-					MinecraftVersion: row.FlavorVersion.MinecraftVersion,
-					Hash:             row.FlavorVersion.Hash,
-					FileHashes:       nil,
-					FilesUploaded:    row.FlavorVersion.FilesUploaded,
-					BuildStatus:      resource.FlavorVersionBuildStatus(row.FlavorVersion.BuildStatus),
-					CreatedAt:        row.FlavorVersion.CreatedAt.UTC(),
-					MinPlayers:       uint32(row.FlavorVersion.MinPlayers),
-					MaxPlayers:       uint32(row.FlavorVersion.MaxPlayers),
-				},
-				Owner: resource.User{
-					ID:        row.User.ID,
-					Nickname:  row.User.Nickname,
-					Email:     "", // we don't want to leak emails in calls everyone can do
-					CreatedAt: row.User.CreatedAt,
-					UpdatedAt: row.User.UpdatedAt,
-				},
-				Address:   row.Node.Address,
-				State:     resource.InstanceState(row.Instance.State),
-				Port:      port,
-				CreatedAt: row.Instance.CreatedAt.UTC(),
-				UpdatedAt: row.Instance.UpdatedAt.UTC(),
-				OrderedBy: row.Instance.OrderedBy,
-			})
+		return nil
+	}); err != nil {
+		return nil, 0, err
+	}
+
+	return ret, revision, nil
+}
+
+func (db *DB) GetInstancesByOwnerID(
+	ctx context.Context,
+	ownerID string,
+	afterRevision int64,
+) ([]resource.Instance, int64, error) {
+	var (
+		ret      = make([]resource.Instance, 0)
+		revision = afterRevision
+	)
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.GetInstancesByOwnerID(ctx, query.GetInstancesByOwnerIDParams{
+			OwnerID:       ownerID,
+			AfterRevision: afterRevision,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			revision = max(revision, row.Instance.Revision)
+			ret = append(ret, revisionedInstanceToDomain(
+				row.FlavorVersion,
+				row.Chunk,
+				row.Flavor,
+				row.Node,
+				row.User,
+				row.Instance,
+			))
 		}
 
 		return nil
 	}); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return ret, nil
+	return ret, revision, nil
 }
 
-// ApplyStatusReports updates instances rows that are not in [instance.InstanceStateDeleted] state.
+// revisionedInstanceToDomain converts the rows returned by the queries used
+// to get instances that changed after a revision.
+func revisionedInstanceToDomain(
+	v query.FlavorVersion,
+	c query.Chunk,
+	f query.Flavor,
+	n query.Node,
+	u query.User,
+	i query.Instance,
+) resource.Instance {
+	// FIXME: for now it is okay to not return the full chunk object
+	// with all flavors, because we don't need it atm. but for consistency
+	// purposes it should be considered.
+
+	var port *uint16
+	if i.Port != nil {
+		port = new(uint16(*i.Port))
+	}
+
+	return resource.Instance{
+		ID: i.ID,
+		Chunk: resource.Chunk{
+			ID:          c.ID,
+			Name:        c.Name,
+			Description: c.Description,
+			Tags:        c.Tags,
+			CreatedAt:   c.CreatedAt.UTC(),
+			UpdatedAt:   c.UpdatedAt.UTC(),
+			DeletedAt:   nil, // not needed atm
+		},
+		Flavor: resource.Flavor{
+			ID:        f.ID,
+			Name:      f.Name,
+			Versions:  nil, // not needed atm
+			CreatedAt: f.CreatedAt.UTC(),
+			UpdatedAt: f.UpdatedAt.UTC(),
+			DeletedAt: nil, // not needed atm
+		},
+		FlavorVersion: resource.FlavorVersion{
+			ID:                 v.ID,
+			Version:            v.Version,
+			MinecraftVersion:   v.MinecraftVersion,
+			Hash:               v.Hash,
+			FileHashes:         nil,
+			FilesUploaded:      v.FilesUploaded,
+			BuildStatus:        resource.FlavorVersionBuildStatus(v.BuildStatus),
+			CreatedAt:          v.CreatedAt.UTC(),
+			MinPlayers:         uint32(v.MinPlayers),
+			MaxPlayers:         uint32(v.MaxPlayers),
+			StartupGracePeriod: time.Duration(v.StartupGracePeriodSeconds) * time.Second,
+			RestartPolicy:      resource.RestartPolicy(v.RestartPolicy),
+			RestartMaxRetries:  uint32(v.RestartMaxRetries),
+		},
+		Owner: resource.User{
+			ID:        u.ID,
+			Nickname:  u.Nickname,
+			Email:     "", // we don't want to leak emails in calls everyone can do
+			CreatedAt: u.CreatedAt,
+			UpdatedAt: u.UpdatedAt,
+		},
+		Address:              n.Address,
+		State:                resource.InstanceState(i.State),
+		Port:                 port,
+		CreatedAt:            i.CreatedAt.UTC(),
+		UpdatedAt:            i.UpdatedAt.UTC(),
+		OrderedBy:            i.OrderedBy,
+		Params:               instanceParams(i),
+		PlacementGroup:       i.PlacementGroup,
+		CrossInstanceTraffic: i.CrossInstanceTraffic,
+	}
+}
+
+// ApplyStatusReports updates instances rows that are not in [resource.InstanceStateDeleted] state.
 // all other instances will be removed from the table.
-func (db *DB) ApplyStatusReports(ctx context.Context, reports []resource.InstanceStatusReport) error {
+func (db *DB) ApplyStatusReports(ctx context.Context, updates []instance.StatusUpdate) ([]string, error) {
 	var (
-		toUpdate = make([]query.BulkUpdateInstanceStateAndPortParams, 0, len(reports))
-		toRemove = make([]string, 0)
+		toUpdate      = make([]query.BulkUpdateInstanceStateAndPortParams, 0, len(updates))
+		redirects     = make([]query.BulkUpsertInstanceRedirectsParams, 0)
+		toRemove      = make([]query.BulkDeleteInstancesParams, 0)
+		events        = make([]query.BulkInsertInstanceEventsParams, 0)
+		notifications = make([]river.InsertManyParams, 0)
+		rejected      = make([]string, 0)
 	)
 
-	for _, report := range reports {
+	for _, u := range updates {
+		report := u.Report
+		for _, ev := range report.Events {
+			events = append(events, query.BulkInsertInstanceEventsParams{
+				ID:         ev.ID,
+				InstanceID: report.InstanceID,
+				Type:       query.InstanceEventType(ev.Type),
+				ExitCode:   ev.ExitCode,
+				Message:    ev.Message,
+				CreatedAt:  ev.CreatedAt,
+			})
+
+			if ev.Type != resource.InstanceEventTypeCrashed && ev.Type != resource.InstanceEventTypeOOMKilled {
+				continue
+			}
+
+			// events can be reported more than once, for example if sending the
+			// report failed, so make sure we only send one notification per event.
+			notifications = append(notifications, river.InsertManyParams{
+				Args: job.SendNotification{
+					Type:       notification.KindInstanceCrashed,
+					InstanceID: report.InstanceID,
+					EventID:    ev.ID,
+					Reason:     ev.Message,
+				},
+				InsertOpts: &river.InsertOpts{
+					UniqueOpts: river.UniqueOpts{
+						ByArgs: true,
+					},
+				},
+			})
+		}
+
 		if report.State == resource.InstanceStateDeleted {
-			toRemove = append(toRemove, report.InstanceID)
+			toRemove = append(toRemove, query.BulkDeleteInstancesParams{
+				ID:         report.InstanceID,
+				FromStates: statesToStrings(u.From),
+			})
 			continue
 		}
-		toUpdate = append(toUpdate, query.BulkUpdateInstanceStateAndPortParams{
-			ID:    report.InstanceID,
-			State: query.InstanceState(report.State),
-			Port:  ptr.Pointer(int32(report.Port)),
-		})
+
+		if !u.RedirectUntil.IsZero() && report.Port != 0 {
+			redirects = append(redirects, query.BulkUpsertInstanceRedirectsParams{
+				InstanceID: report.InstanceID,
+				ToPort:     int32(report.Port),
+				ExpiresAt:  u.RedirectUntil,
+				FromStates: statesToStrings(u.From),
+			})
+		}
+
+		params := query.BulkUpdateInstanceStateAndPortParams{
+			ID:         report.InstanceID,
+			State:      query.InstanceState(report.State),
+			Port:       ptr.Pointer(int32(report.Port)),
+			FromStates: statesToStrings(u.From),
+		}
+
+		if t := report.Traffic; t != nil {
+			params.TrafficConnections = ptr.Pointer(int64(t.Connections))
+			params.TrafficRxBytes = ptr.Pointer(int64(t.RxBytes))
+			params.TrafficTxBytes = ptr.Pointer(int64(t.TxBytes))
+		}
+
+		toUpdate = append(toUpdate, params)
 	}
 
 	// don't even attempt to open a connection to the db
 	if len(toRemove) == 0 && len(toUpdate) == 0 {
-		return nil
+		return rejected, nil
 	}
 
-	if err := db.do(context.Background(), func(q *query.Queries) error {
+	if err := db.do(ctx, func(q *query.Queries) error {
+		// redirects have to be recorded before the update,
+		// because they are created from the current port.
+		if len(redirects) > 0 {
+			bulkRedirects := q.BulkUpsertInstanceRedirects(ctx, redirects)
+			if err := db.bulkExecAndClose(bulkRedirects); err != nil {
+				return fmt.Errorf("bulk upsert redirects: %w", err)
+			}
+		}
+
 		// always update all rows, even those that will be deleted
 		// afterward. when the database or service dies after the
 		// update we still have recorded the last state we observed.
-		bulkUpdate := q.BulkUpdateInstanceStateAndPort(ctx, toUpdate)
-		if err := db.bulkExecAndClose(bulkUpdate); err != nil {
-			return fmt.Errorf("bulk update: %w", err)
+		var updateErr error
+		q.BulkUpdateInstanceStateAndPort(ctx, toUpdate).QueryRow(func(i int, _ string, err error) {
+			if errors.Is(err, pgx.ErrNoRows) {
+				rejected = append(rejected, toUpdate[i].ID)
+				return
+			}
+			if err != nil {
+				updateErr = err
+			}
+		})
+
+		if updateErr != nil {
+			return fmt.Errorf("bulk update: %w", updateErr)
 		}
 
-		if len(toRemove) > 0 {
-			bulkDel := q.BulkDeleteInstances(ctx, toRemove)
-			if err := db.bulkExecAndClose(bulkDel); err != nil {
-				return fmt.Errorf("bulk delete: %w", err)
+		// insert events before removing instances, so we do not lose
+		// them in case the removal fails.
+		if len(events) > 0 {
+			bulkEvents := q.BulkInsertInstanceEvents(ctx, events)
+			if err := db.bulkExecAndClose(bulkEvents); err != nil {
+				return fmt.Errorf("bulk insert events: %w", err)
+			}
+		}
+
+		var (
+			delErr        error
+			removed       = make([]string, 0, len(toRemove))
+			removedEvents = make([]query.BulkInsertInstanceEventsParams, 0, len(toRemove))
+		)
+		q.BulkDeleteInstances(ctx, toRemove).QueryRow(func(i int, row query.BulkDeleteInstancesRow, err error) {
+			if errors.Is(err, pgx.ErrNoRows) {
+				rejected = append(rejected, toRemove[i].ID)
+				return
+			}
+			if err != nil {
+				delErr = err
+				return
+			}
+
+			evID, err := uuid.NewV7()
+			if err != nil {
+				delErr = fmt.Errorf("generate event id: %w", err)
+				return
+			}
+
+			removed = append(removed, row.ID)
+			removedEvents = append(removedEvents, query.BulkInsertInstanceEventsParams{
+				ID:         evID.String(),
+				InstanceID: row.ID,
+				Type:       query.InstanceEventTypeREMOVED,
+				Message:    removedReason(resource.InstanceState(row.State)),
+				CreatedAt:  time.Now(),
+			})
+		})
+
+		if delErr != nil {
+			return fmt.Errorf("bulk delete: %w", delErr)
+		}
+
+		if len(removed) > 0 {
+			if err := q.StopInstanceUsage(ctx, removed); err != nil {
+				return fmt.Errorf("stop instance usage: %w", err)
+			}
+
+			bulkEvents := q.BulkInsertInstanceEvents(ctx, removedEvents)
+			if err := db.bulkExecAndClose(bulkEvents); err != nil {
+				return fmt.Errorf("bulk insert removed events: %w", err)
 			}
 		}
 
 		return nil
 	}); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	if len(notifications) > 0 {
+		if _, err := db.riverClient.InsertMany(ctx, notifications); err != nil {
+			return nil, fmt.Errorf("insert notification jobs: %w", err)
+		}
+	}
+
+	return rejected, nil
+}
+
+// removedReason describes why an instance that has been in the given
+// state before it got removed from the node is gone.
+func removedReason(prev resource.InstanceState) string {
+	switch prev {
+	case resource.InstanceStateDeleting:
+		return "instance has been stopped"
+	case resource.InstanceCreationFailed:
+		return "workload could not be created"
+	default:
+		return fmt.Sprintf("workload stopped while instance was %s", prev)
+	}
+}
+
+func (db *DB) UpdateInstanceState(
+	ctx context.Context,
+	id string,
+	to resource.InstanceState,
+	from []resource.InstanceState,
+) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if _, err := q.UpdateInstanceState(ctx, query.UpdateInstanceStateParams{
+			ID:         id,
+			State:      query.InstanceState(to),
+			FromStates: statesToStrings(from),
+		}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrInvalidStateTransition
+			}
+			return fmt.Errorf("update instance state: %w", err)
+		}
+		return nil
+	})
+}
+
+func (db *DB) TransitionStaleInstances(
+	ctx context.Context,
+	from resource.InstanceState,
+	to resource.InstanceState,
+	changedBefore time.Time,
+) ([]string, error) {
+	var ret []string
+	err := db.do(ctx, func(q *query.Queries) error {
+		ids, err := q.TransitionStaleInstances(ctx, query.TransitionStaleInstancesParams{
+			FromState:     query.InstanceState(from),
+			ToState:       query.InstanceState(to),
+			ChangedBefore: changedBefore,
+		})
+		if err != nil {
+			return fmt.Errorf("transition stale instances: %w", err)
+		}
+		ret = ids
+		return nil
+	})
+
+	return ret, err
 }
 
 func (db *DB) CountInstancesByFlavorVersionID(ctx context.Context, flavorVersionID string) (uint, error) {
@@ -322,6 +646,114 @@ func (db *DB) CountInstancesByFlavorVersionID(ctx context.Context, flavorVersion
 	return ret, err
 }
 
+func (db *DB) GetInstanceEvents(ctx context.Context, instanceID string, limit int) ([]resource.InstanceEvent, error) {
+	ret := make([]resource.InstanceEvent, 0)
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.GetInstanceEvents(ctx, query.GetInstanceEventsParams{
+			InstanceID: instanceID,
+			Since:      instanceEventsSince(instanceID),
+			Limit:      int32(limit),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			ret = append(ret, resource.InstanceEvent{
+				ID:         row.ID,
+				InstanceID: row.InstanceID,
+				Type:       resource.InstanceEventType(row.Type),
+				ExitCode:   row.ExitCode,
+				Message:    row.Message,
+				CreatedAt:  row.CreatedAt.UTC(),
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) GetInstanceTimeline(ctx context.Context, instanceID string, limit int) ([]resource.InstanceEvent, error) {
+	ret := make([]resource.InstanceEvent, 0)
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.GetInstanceTimeline(ctx, query.GetInstanceTimelineParams{
+			InstanceID: instanceID,
+			Since:      instanceEventsSince(instanceID),
+			Limit:      int32(limit),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			ret = append(ret, resource.InstanceEvent{
+				ID:         row.ID,
+				InstanceID: row.InstanceID,
+				Type:       resource.InstanceEventType(row.Type),
+				ExitCode:   row.ExitCode,
+				Message:    row.Message,
+				CreatedAt:  row.CreatedAt.UTC(),
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// instanceEventsSince returns the time events of the instance can be
+// created at the earliest, which is the creation time encoded in its
+// UUIDv7 id. events are timestamped by nodes, whose clocks might be off,
+// so a day of slack is given. the zero time is returned for other ids.
+func instanceEventsSince(instanceID string) time.Time {
+	id, err := uuid.Parse(instanceID)
+	if err != nil || id.Version() != 7 {
+		return time.Time{}
+	}
+
+	sec, nsec := id.Time().UnixTime()
+	return time.Unix(sec, nsec).Add(-24 * time.Hour)
+}
+
+func (db *DB) GetInstanceRedirectsByNodeID(ctx context.Context, nodeID string) ([]resource.InstanceRedirect, error) {
+	ret := make([]resource.InstanceRedirect, 0)
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.GetInstanceRedirectsByNodeID(ctx, nodeID)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			ret = append(ret, resource.InstanceRedirect{
+				InstanceID: row.InstanceID,
+				NodeID:     row.NodeID,
+				FromPort:   uint16(row.FromPort),
+				To:         netip.AddrPortFrom(row.ToAddress, uint16(row.ToPort)),
+				ExpiresAt:  row.ExpiresAt.UTC(),
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) DeleteExpiredInstanceRedirects(ctx context.Context) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.DeleteExpiredInstanceRedirects(ctx)
+	})
+}
+
 func (db *DB) getInstanceByID(ctx context.Context, q *query.Queries, id string) (resource.Instance, error) {
 	rows, err := q.GetInstance(ctx, id)
 	if err != nil {
@@ -344,12 +776,16 @@ func (db *DB) getInstanceByID(ctx context.Context, q *query.Queries, id string)
 	// instance port is intentionally left out, because it will not be
 	// known beforehand atm, thus it will always be nil when creating.
 	ret := resource.Instance{
-		ID:        row.Instance.ID,
-		Address:   row.Node.Address,
-		State:     resource.InstanceState(row.Instance.State),
-		CreatedAt: row.Instance.CreatedAt.UTC(),
-		UpdatedAt: row.Instance.UpdatedAt.UTC(),
-		OrderedBy: row.Instance.OrderedBy,
+		ID:                   row.Instance.ID,
+		Address:              row.Node.Address,
+		State:                resource.InstanceState(row.Instance.State),
+		CreatedAt:            row.Instance.CreatedAt.UTC(),
+		UpdatedAt:            row.Instance.UpdatedAt.UTC(),
+		OrderedBy:            row.Instance.OrderedBy,
+		Traffic:              instanceTraffic(row.Instance),
+		Params:               instanceParams(row.Instance),
+		PlacementGroup:       row.Instance.PlacementGroup,
+		CrossInstanceTraffic: row.Instance.CrossInstanceTraffic,
 		Chunk: resource.Chunk{
 			ID:          row.Chunk.ID,
 			Name:        row.Chunk.Name,
@@ -359,16 +795,19 @@ func (db *DB) getInstanceByID(ctx context.Context, q *query.Queries, id string)
 			UpdatedAt:   row.Chunk.UpdatedAt.UTC(),
 		},
 		FlavorVersion: resource.FlavorVersion{
-			ID:               row.FlavorVersion.ID,
-			Version:          row.FlavorVersion.Version,
-			MinecraftVersion: row.FlavorVersion.MinecraftVersion,
-			Hash:             row.FlavorVersion.Hash,
-			FileHashes:       nil,
-			FilesUploaded:    row.FlavorVersion.FilesUploaded,
-			BuildStatus:      resource.FlavorVersionBuildStatus(row.FlavorVersion.BuildStatus),
-			CreatedAt:        row.FlavorVersion.CreatedAt.UTC(),
-			MinPlayers:       uint32(row.FlavorVersion.MinPlayers),
-			MaxPlayers:       uint32(row.FlavorVersion.MaxPlayers),
+			ID:                 row.FlavorVersion.ID,
+			Version:            row.FlavorVersion.Version,
+			MinecraftVersion:   row.FlavorVersion.MinecraftVersion,
+			Hash:               row.FlavorVersion.Hash,
+			FileHashes:         nil,
+			FilesUploaded:      row.FlavorVersion.FilesUploaded,
+			BuildStatus:        resource.FlavorVersionBuildStatus(row.FlavorVersion.BuildStatus),
+			CreatedAt:          row.FlavorVersion.CreatedAt.UTC(),
+			MinPlayers:         uint32(row.FlavorVersion.MinPlayers),
+			MaxPlayers:         uint32(row.FlavorVersion.MaxPlayers),
+			StartupGracePeriod: time.Duration(row.FlavorVersion.StartupGracePeriodSeconds) * time.Second,
+			RestartPolicy:      resource.RestartPolicy(row.FlavorVersion.RestartPolicy),
+			RestartMaxRetries:  uint32(row.FlavorVersion.RestartMaxRetries),
 		},
 		Owner: resource.User{
 			ID:        row.User.ID,
@@ -409,3 +848,31 @@ func (db *DB) getInstanceByID(ctx context.Context, q *query.Queries, id string)
 	ret.Chunk.Flavors = flavors
 	return ret, nil
 }
+
+// instanceTraffic returns nil if no traffic has been reported for the instance yet.
+func instanceTraffic(ins query.Instance) *resource.InstanceTraffic {
+	if ins.TrafficConnections == nil || ins.TrafficRxBytes == nil || ins.TrafficTxBytes == nil {
+		return nil
+	}
+	return &resource.InstanceTraffic{
+		Connections: uint64(*ins.TrafficConnections),
+		RxBytes:     uint64(*ins.TrafficRxBytes),
+		TxBytes:     uint64(*ins.TrafficTxBytes),
+	}
+}
+
+func instanceParams(ins query.Instance) resource.InstanceParams {
+	return resource.InstanceParams{
+		WorldSeed: ins.WorldSeed,
+		GameMode:  resource.GameMode(ins.GameMode),
+		Custom:    ins.CustomParams,
+	}
+}
+
+func statesToStrings(states []resource.InstanceState) []string {
+	ret := make([]string, 0, len(states))
+	for _, s := range states {
+		ret = append(ret, string(s))
+	}
+	return ret
+}
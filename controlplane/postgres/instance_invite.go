@@ -0,0 +1,168 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// CreateInstanceInvite stores the invite. only the hash of its token is
+// persisted, so the returned invite is the only place the token is known.
+func (db *DB) CreateInstanceInvite(
+	ctx context.Context,
+	invite resource.InstanceInvite,
+) (resource.InstanceInvite, error) {
+	var ret resource.InstanceInvite
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.CreateInstanceInvite(ctx, query.CreateInstanceInviteParams{
+			ID:         invite.ID,
+			InstanceID: invite.InstanceID,
+			Token:      invite.Token,
+			MaxUses:    int32(invite.MaxUses),
+			CreatedBy:  invite.CreatedBy,
+			ExpiresAt:  invite.ExpiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("create instance invite: %w", err)
+		}
+
+		ret = instanceInviteToDomain(row)
+		ret.Token = invite.Token
+		return nil
+	}); err != nil {
+		return resource.InstanceInvite{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) InstanceInvites(ctx context.Context, instanceID string) ([]resource.InstanceInvite, error) {
+	var ret []resource.InstanceInvite
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.InstanceInvitesByInstanceID(ctx, instanceID)
+		if err != nil {
+			return fmt.Errorf("instance invites: %w", err)
+		}
+
+		ret = make([]resource.InstanceInvite, 0, len(rows))
+		for _, r := range rows {
+			ret = append(ret, instanceInviteToDomain(r))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) InstanceInviteByID(ctx context.Context, id string) (resource.InstanceInvite, error) {
+	var ret resource.InstanceInvite
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.InstanceInviteByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrInstanceInviteNotFound
+			}
+			return fmt.Errorf("instance invite by id: %w", err)
+		}
+
+		ret = instanceInviteToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.InstanceInvite{}, err
+	}
+
+	return ret, nil
+}
+
+// InstanceInviteByToken looks up the invite by the hash of the given token.
+func (db *DB) InstanceInviteByToken(ctx context.Context, token string) (resource.InstanceInvite, error) {
+	var ret resource.InstanceInvite
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.InstanceInviteByToken(ctx, token)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrInstanceInviteNotFound
+			}
+			return fmt.Errorf("instance invite by token: %w", err)
+		}
+
+		ret = instanceInviteToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.InstanceInvite{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) RevokeInstanceInvite(ctx context.Context, id string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.RevokeInstanceInvite(ctx, id); err != nil {
+			return fmt.Errorf("revoke instance invite: %w", err)
+		}
+		return nil
+	})
+}
+
+// UseInstanceInvite increments the uses of the invite and reports whether
+// it could be used, i.e. it has not been revoked, expired or used up.
+func (db *DB) UseInstanceInvite(ctx context.Context, id string) (bool, error) {
+	var used bool
+	if err := db.do(ctx, func(q *query.Queries) error {
+		n, err := q.UseInstanceInvite(ctx, id)
+		if err != nil {
+			return fmt.Errorf("use instance invite: %w", err)
+		}
+		used = n > 0
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return used, nil
+}
+
+func instanceInviteToDomain(r query.InstanceInvite) resource.InstanceInvite {
+	ret := resource.InstanceInvite{
+		ID:         r.ID,
+		InstanceID: r.InstanceID,
+		MaxUses:    uint32(r.MaxUses),
+		Uses:       uint32(r.Uses),
+		CreatedBy:  r.CreatedBy,
+		ExpiresAt:  r.ExpiresAt,
+		CreatedAt:  r.CreatedAt,
+	}
+
+	if r.RevokedAt.Valid {
+		t := r.RevokedAt.Time.UTC()
+		ret.RevokedAt = &t
+	}
+
+	return ret
+}
@@ -0,0 +1,123 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (db *DB) InsertInstanceLog(ctx context.Context, log resource.InstanceLog) error {
+	return db.doTX(ctx, func(_ pgx.Tx, q *query.Queries) error {
+		if err := q.InsertInstanceLog(ctx, query.InsertInstanceLogParams{
+			ID:          log.ID,
+			InstanceID:  log.InstanceID,
+			OwnerID:     log.OwnerID,
+			ObjectKey:   log.ObjectKey,
+			SizeBytes:   int64(log.SizeBytes),
+			FirstLineAt: log.FirstLineAt,
+			LastLineAt:  log.LastLineAt,
+			ExpiresAt:   log.ExpiresAt,
+		}); err != nil {
+			return fmt.Errorf("insert instance log: %w", err)
+		}
+
+		if err := q.ExtendInstanceLogRetention(ctx, query.ExtendInstanceLogRetentionParams{
+			InstanceID: log.InstanceID,
+			ExpiresAt:  log.ExpiresAt,
+		}); err != nil {
+			return fmt.Errorf("extend instance log retention: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (db *DB) InstanceLogs(ctx context.Context, instanceID string) ([]resource.InstanceLog, error) {
+	var ret []resource.InstanceLog
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.InstanceLogsByInstanceID(ctx, instanceID)
+		if err != nil {
+			return fmt.Errorf("instance logs: %w", err)
+		}
+
+		ret = make([]resource.InstanceLog, 0, len(rows))
+		for _, r := range rows {
+			ret = append(ret, instanceLogToDomain(r))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) ExpiredInstanceLogs(ctx context.Context, before time.Time, limit int) ([]resource.InstanceLog, error) {
+	var ret []resource.InstanceLog
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.ExpiredInstanceLogs(ctx, query.ExpiredInstanceLogsParams{
+			ExpiresAt: before,
+			Limit:     int32(limit),
+		})
+		if err != nil {
+			return fmt.Errorf("expired instance logs: %w", err)
+		}
+
+		ret = make([]resource.InstanceLog, 0, len(rows))
+		for _, r := range rows {
+			ret = append(ret, instanceLogToDomain(r))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) DeleteInstanceLogs(ctx context.Context, ids []string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.DeleteInstanceLogs(ctx, ids); err != nil {
+			return fmt.Errorf("delete instance logs: %w", err)
+		}
+		return nil
+	})
+}
+
+func instanceLogToDomain(r query.InstanceLog) resource.InstanceLog {
+	return resource.InstanceLog{
+		ID:          r.ID,
+		InstanceID:  r.InstanceID,
+		OwnerID:     r.OwnerID,
+		ObjectKey:   r.ObjectKey,
+		SizeBytes:   uint64(r.SizeBytes),
+		FirstLineAt: r.FirstLineAt,
+		LastLineAt:  r.LastLineAt,
+		CreatedAt:   r.CreatedAt,
+		ExpiresAt:   r.ExpiresAt,
+	}
+}
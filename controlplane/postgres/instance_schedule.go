@@ -0,0 +1,193 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/controlplane/schedule"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (db *DB) CreateInstanceSchedule(
+	ctx context.Context,
+	s resource.InstanceSchedule,
+) (resource.InstanceSchedule, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return resource.InstanceSchedule{}, fmt.Errorf("schedule id: %w", err)
+	}
+
+	var ret resource.InstanceSchedule
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.CreateInstanceSchedule(ctx, query.CreateInstanceScheduleParams{
+			ID:              id.String(),
+			ChunkID:         s.ChunkID,
+			FlavorVersionID: s.FlavorVersionID,
+			StartCron:       s.StartCron,
+			StopCron:        s.StopCron,
+			Timezone:        s.Timezone,
+			EvaluatedAt:     s.EvaluatedAt,
+			CreatedBy:       s.CreatedBy,
+		})
+		if err != nil {
+			return fmt.Errorf("create instance schedule: %w", err)
+		}
+
+		ret = instanceScheduleToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.InstanceSchedule{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) InstanceSchedules(ctx context.Context, chunkID string) ([]resource.InstanceSchedule, error) {
+	var ret []resource.InstanceSchedule
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.InstanceSchedulesByChunkID(ctx, chunkID)
+		if err != nil {
+			return fmt.Errorf("instance schedules: %w", err)
+		}
+
+		ret = make([]resource.InstanceSchedule, 0, len(rows))
+		for _, r := range rows {
+			ret = append(ret, instanceScheduleToDomain(r))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) InstanceScheduleByID(ctx context.Context, id string) (resource.InstanceSchedule, error) {
+	var ret resource.InstanceSchedule
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.InstanceScheduleByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrScheduleNotFound
+			}
+			return fmt.Errorf("instance schedule by id: %w", err)
+		}
+
+		ret = instanceScheduleToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.InstanceSchedule{}, err
+	}
+
+	return ret, nil
+}
+
+// DeleteInstanceSchedule removes the schedule. the instance it
+// has started, if any, is left running.
+func (db *DB) DeleteInstanceSchedule(ctx context.Context, id string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.DeleteInstanceSchedule(ctx, id); err != nil {
+			return fmt.Errorf("delete instance schedule: %w", err)
+		}
+		return nil
+	})
+}
+
+func (db *DB) ActiveInstanceSchedules(ctx context.Context) ([]schedule.Target, error) {
+	var ret []schedule.Target
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.ActiveInstanceSchedules(ctx)
+		if err != nil {
+			return fmt.Errorf("active instance schedules: %w", err)
+		}
+
+		ret = make([]schedule.Target, 0, len(rows))
+		for _, r := range rows {
+			ret = append(ret, schedule.Target{
+				Schedule: instanceScheduleToDomain(r.InstanceSchedule),
+				OwnerID:  r.OwnerID,
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) MarkInstanceScheduleEvaluated(ctx context.Context, id string, at time.Time) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.MarkInstanceScheduleEvaluated(ctx, query.MarkInstanceScheduleEvaluatedParams{
+			ID:          id,
+			EvaluatedAt: at,
+		}); err != nil {
+			return fmt.Errorf("mark instance schedule evaluated: %w", err)
+		}
+		return nil
+	})
+}
+
+func (db *DB) RecordInstanceScheduleRun(ctx context.Context, id string, instanceID string, at time.Time) error {
+	var insID *string
+	if instanceID != "" {
+		insID = &instanceID
+	}
+
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.RecordInstanceScheduleRun(ctx, query.RecordInstanceScheduleRunParams{
+			EvaluatedAt: at,
+			InstanceID:  insID,
+			ID:          id,
+		}); err != nil {
+			return fmt.Errorf("record instance schedule run: %w", err)
+		}
+		return nil
+	})
+}
+
+func instanceScheduleToDomain(r query.InstanceSchedule) resource.InstanceSchedule {
+	ret := resource.InstanceSchedule{
+		ID:              r.ID,
+		ChunkID:         r.ChunkID,
+		FlavorVersionID: r.FlavorVersionID,
+		StartCron:       r.StartCron,
+		StopCron:        r.StopCron,
+		Timezone:        r.Timezone,
+		EvaluatedAt:     r.EvaluatedAt.UTC(),
+		CreatedBy:       r.CreatedBy,
+		CreatedAt:       r.CreatedAt.UTC(),
+	}
+
+	if r.InstanceID != nil {
+		ret.InstanceID = *r.InstanceID
+	}
+
+	return ret
+}
@@ -0,0 +1,78 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (db *DB) InstanceUsage(ctx context.Context, start, end time.Time, chunkID *string) ([]resource.InstanceUsage, error) {
+	var ret []resource.InstanceUsage
+
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.InstanceUsage(ctx, query.InstanceUsageParams{
+			StartAt: pgtype.Timestamptz{
+				Time:  start,
+				Valid: true,
+			},
+			EndAt:   end,
+			ChunkID: chunkID,
+		})
+		if err != nil {
+			return fmt.Errorf("instance usage: %w", err)
+		}
+
+		ret = make([]resource.InstanceUsage, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, instanceUsageToDomain(row))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func instanceUsageToDomain(row query.InstanceUsageRow) resource.InstanceUsage {
+	u := resource.InstanceUsage{
+		InstanceID:  row.InstanceID,
+		ChunkID:     row.ChunkID,
+		ChunkName:   row.ChunkName,
+		NodeID:      row.NodeID,
+		CPUMillis:   uint64(max(row.CpuMillis, 0)),
+		MemoryBytes: uint64(max(row.MemoryBytes, 0)),
+		NodeSlots:   int(row.NodeSlots),
+		StartedAt:   row.StartedAt.UTC(),
+	}
+
+	if row.StoppedAt.Valid {
+		stoppedAt := row.StoppedAt.Time.UTC()
+		u.StoppedAt = &stoppedAt
+	}
+
+	return u
+}
@@ -0,0 +1,160 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/chunkname"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// Backends returns the running instances. chunks are looked up by their
+// slug, so instances of renamed chunks are found using their old names.
+func (db *DB) Backends(ctx context.Context, chunkName string, flavorName string) ([]resource.Backend, error) {
+	var ret []resource.Backend
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.IntegrationBackends(ctx, query.IntegrationBackendsParams{
+			ChunkSlug: pgtype.Text{
+				String: chunkname.Slug(chunkName),
+				Valid:  chunkName != "",
+			},
+			FlavorName: pgtype.Text{
+				String: flavorName,
+				Valid:  flavorName != "",
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		ret = make([]resource.Backend, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, backendToDomain(row))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// InstanceBackend returns the backend of the given instance. [apierrs.ErrInstanceNotRunning]
+// is returned if the instance does not exist or is not running.
+func (db *DB) InstanceBackend(ctx context.Context, instanceID string) (resource.Backend, error) {
+	var ret resource.Backend
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.IntegrationBackends(ctx, query.IntegrationBackendsParams{
+			InstanceID: &instanceID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(rows) == 0 {
+			return apierrs.ErrInstanceNotRunning
+		}
+
+		ret = backendToDomain(rows[0])
+		return nil
+	}); err != nil {
+		return resource.Backend{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) ApplyPlayerEvents(ctx context.Context, events []resource.PlayerEvent) error {
+	return db.doTX(ctx, func(_ pgx.Tx, q *query.Queries) error {
+		for _, e := range events {
+			switch e.Type {
+			case resource.PlayerEventTypeJoin:
+				if err := q.InsertInstancePlayer(ctx, query.InsertInstancePlayerParams{
+					InstanceID: e.InstanceID,
+					PlayerID:   e.PlayerID,
+				}); err != nil {
+					return fmt.Errorf("insert player: %w", err)
+				}
+
+				evID, err := uuid.NewV7()
+				if err != nil {
+					return fmt.Errorf("generate event id: %w", err)
+				}
+
+				if err := q.InsertPlayerJoinedEvent(ctx, query.InsertPlayerJoinedEventParams{
+					ID:         evID.String(),
+					InstanceID: e.InstanceID,
+					Message:    fmt.Sprintf("player %s joined", e.PlayerID),
+					Since:      instanceEventsSince(e.InstanceID),
+				}); err != nil {
+					return fmt.Errorf("insert player joined event: %w", err)
+				}
+			case resource.PlayerEventTypeLeave:
+				if err := q.DeleteInstancePlayer(ctx, query.DeleteInstancePlayerParams{
+					InstanceID: e.InstanceID,
+					PlayerID:   e.PlayerID,
+				}); err != nil {
+					return fmt.Errorf("delete player: %w", err)
+				}
+			default:
+				return fmt.Errorf("unknown player event type %s", e.Type)
+			}
+		}
+		return nil
+	})
+}
+
+// RecordJoinMiss looks up the chunk by its slug like [DB.Backends] does.
+// misses for chunks that do not exist are ignored.
+func (db *DB) RecordJoinMiss(ctx context.Context, chunkName string, flavorName string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.InsertJoinMiss(ctx, query.InsertJoinMissParams{
+			ChunkSlug: chunkname.Slug(chunkName),
+			FlavorName: pgtype.Text{
+				String: flavorName,
+				Valid:  flavorName != "",
+			},
+		}); err != nil {
+			return fmt.Errorf("insert join miss: %w", err)
+		}
+		return nil
+	})
+}
+
+func backendToDomain(row query.IntegrationBackendsRow) resource.Backend {
+	return resource.Backend{
+		InstanceID: row.InstanceID,
+		ChunkID:    row.ChunkID,
+		ChunkName:  row.ChunkName,
+		FlavorName: row.FlavorName,
+		// port is never nil, because the query filters instances without one
+		Address:    netip.AddrPortFrom(row.Address, uint16(*row.Port)),
+		Players:    uint32(row.Players),
+		MaxPlayers: uint32(row.MaxPlayers),
+	}
+}
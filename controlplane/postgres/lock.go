@@ -0,0 +1,60 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+)
+
+// lockNamespace is the first key of the two key advisory locks taken by
+// TryLock. locks using two keys never conflict with locks using a single
+// key, like the one used for leader election.
+const lockNamespace = 1
+
+// TryLock uses a transaction level advisory lock, so the lock is released
+// even if the connection is returned to the pool in an unexpected state.
+// the key is hashed to 32 bits, so different keys may collide, in which
+// case the lock is reported as held even though it is not.
+func (db *DB) TryLock(ctx context.Context, key string, fn func(ctx context.Context) error) (bool, error) {
+	var locked bool
+	if err := db.doTX(ctx, func(_ pgx.Tx, q *query.Queries) error {
+		ok, err := q.TryAdvisoryXactLock(ctx, query.TryAdvisoryXactLockParams{
+			Namespace: lockNamespace,
+			Key:       key,
+		})
+		if err != nil {
+			return fmt.Errorf("try lock: %w", err)
+		}
+
+		if !ok {
+			return nil
+		}
+
+		locked = true
+		return fn(ctx)
+	}); err != nil {
+		return locked, err
+	}
+
+	return locked, nil
+}
@@ -0,0 +1,90 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (db *DB) MaintenanceMode(ctx context.Context) (resource.MaintenanceMode, error) {
+	var ret resource.MaintenanceMode
+	if err := db.do(ctx, func(q *query.Queries) error {
+		m, err := q.MaintenanceMode(ctx)
+		if errors.Is(err, pgx.ErrNoRows) {
+			// the maintenance mode has never been enabled
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		ret = maintenanceModeToDomain(m)
+		return nil
+	}); err != nil {
+		return resource.MaintenanceMode{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) SetMaintenanceMode(
+	ctx context.Context,
+	enabled bool,
+	message string,
+	actorID string,
+) (resource.MaintenanceMode, error) {
+	var ret resource.MaintenanceMode
+	if err := db.do(ctx, func(q *query.Queries) error {
+		m, err := q.SetMaintenanceMode(ctx, query.SetMaintenanceModeParams{
+			Enabled:   enabled,
+			Message:   message,
+			UpdatedBy: &actorID,
+		})
+		if err != nil {
+			return err
+		}
+
+		ret = maintenanceModeToDomain(m)
+		return nil
+	}); err != nil {
+		return resource.MaintenanceMode{}, err
+	}
+
+	return ret, nil
+}
+
+func maintenanceModeToDomain(m query.MaintenanceMode) resource.MaintenanceMode {
+	var updatedBy string
+	if m.UpdatedBy != nil {
+		updatedBy = *m.UpdatedBy
+	}
+
+	return resource.MaintenanceMode{
+		Enabled:   m.Enabled,
+		Message:   m.Message,
+		UpdatedBy: updatedBy,
+		UpdatedAt: m.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,22 @@
+-- migrate:up
+CREATE TYPE instance_event_type AS ENUM (
+    'OOM_KILLED',
+    'CRASHED',
+    'EXITED'
+);
+
+-- instance_id intentionally does not reference the instances table,
+-- because events should outlive the instance they belong to. otherwise
+-- users would not be able to find out why their instance is gone.
+CREATE TABLE instance_events(
+    id          UUID PRIMARY KEY    NOT NULL,
+    instance_id UUID                NOT NULL,
+    type        instance_event_type NOT NULL,
+    exit_code   INTEGER             NOT NULL DEFAULT 0,
+    message     VARCHAR(1024)       NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ         NOT NULL
+);
+
+CREATE INDEX instance_events_instance_id_created_at_idx ON instance_events(instance_id, created_at);
+
+-- migrate:down
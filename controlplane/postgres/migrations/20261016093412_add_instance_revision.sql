@@ -0,0 +1,11 @@
+-- migrate:up
+
+-- every change to an instance row is assigned a new revision, so nodes
+-- can ask for all instances that changed since the last revision they saw.
+CREATE SEQUENCE instance_revision_seq;
+
+ALTER TABLE instances ADD COLUMN revision BIGINT NOT NULL DEFAULT nextval('instance_revision_seq');
+
+CREATE INDEX instances_node_id_revision_idx ON instances(node_id, revision);
+
+-- migrate:down
@@ -0,0 +1,4 @@
+-- migrate:up
+ALTER TABLE instances ADD COLUMN state_changed_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- migrate:down
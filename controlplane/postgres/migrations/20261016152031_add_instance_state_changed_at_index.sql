@@ -0,0 +1,6 @@
+-- migrate:up
+
+-- used by the cleanup job to find instances that stayed in a state for too long.
+CREATE INDEX instances_state_state_changed_at_idx ON instances(state, state_changed_at);
+
+-- migrate:down
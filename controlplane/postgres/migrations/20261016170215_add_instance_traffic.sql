@@ -0,0 +1,10 @@
+-- migrate:up
+
+-- traffic counters scraped from envoy by platformd. they are
+-- NULL until the first report containing traffic stats arrives.
+ALTER TABLE instances
+    ADD COLUMN traffic_connections BIGINT,
+    ADD COLUMN traffic_rx_bytes BIGINT,
+    ADD COLUMN traffic_tx_bytes BIGINT;
+
+-- migrate:down
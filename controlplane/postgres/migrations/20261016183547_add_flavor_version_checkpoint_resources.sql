@@ -0,0 +1,9 @@
+-- migrate:up
+
+-- resource overrides requested for the last checkpoint build.
+-- NULL means the defaults of the node have been used.
+ALTER TABLE flavor_versions
+    ADD COLUMN checkpoint_cpu_millis INTEGER,
+    ADD COLUMN checkpoint_memory_limit_bytes BIGINT;
+
+-- migrate:down
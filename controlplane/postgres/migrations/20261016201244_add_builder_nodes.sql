@@ -0,0 +1,14 @@
+-- migrate:up
+
+-- capabilities advertised by platformd, like BUILDER for nodes
+-- that accept checkpoint builds. last_seen_at is updated every
+-- time platformd contacts the control plane.
+ALTER TABLE nodes
+    ADD COLUMN capabilities TEXT[] DEFAULT '{}' NOT NULL,
+    ADD COLUMN last_seen_at TIMESTAMPTZ;
+
+-- node the checkpoint of the flavor version is being built on.
+ALTER TABLE flavor_versions
+    ADD COLUMN checkpoint_node_id UUID REFERENCES nodes(id) ON DELETE SET NULL;
+
+-- migrate:down
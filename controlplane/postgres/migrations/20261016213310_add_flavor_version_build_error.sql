@@ -0,0 +1,8 @@
+-- migrate:up
+
+-- human-readable reason why the build of the flavor version
+-- has been rejected, for example because it exceeds limits.
+ALTER TABLE flavor_versions
+    ADD COLUMN build_error TEXT;
+
+-- migrate:down
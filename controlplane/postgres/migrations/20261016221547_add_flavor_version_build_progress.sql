@@ -0,0 +1,8 @@
+-- migrate:up
+
+-- progress of the current build step in percent. currently only
+-- reported while the image of the flavor version is being pushed.
+ALTER TABLE flavor_versions
+    ADD COLUMN build_progress SMALLINT DEFAULT 0 NOT NULL;
+
+-- migrate:down
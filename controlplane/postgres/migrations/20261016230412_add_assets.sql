@@ -0,0 +1,21 @@
+-- migrate:up
+
+-- asset bundles, like resource packs, that are shared between
+-- flavor versions. bundles are addressed by the sha256 hash of
+-- their tarball, so each one is only stored once.
+CREATE TABLE assets (
+    hash CHAR(64) PRIMARY KEY,
+    size_bytes BIGINT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- assets referenced by a flavor version. position defines the
+-- order the assets are layered in, later assets take precedence.
+CREATE TABLE flavor_version_assets (
+    flavor_version_id UUID NOT NULL REFERENCES flavor_versions(id) ON DELETE CASCADE,
+    asset_hash CHAR(64) NOT NULL REFERENCES assets(hash),
+    position SMALLINT NOT NULL,
+    PRIMARY KEY (flavor_version_id, asset_hash)
+);
+
+-- migrate:down
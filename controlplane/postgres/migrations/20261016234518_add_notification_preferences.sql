@@ -0,0 +1,13 @@
+-- migrate:up
+
+-- notifications users opted into. users without a row
+-- do not receive any notifications.
+CREATE TABLE notification_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    build_completed BOOLEAN DEFAULT false NOT NULL,
+    build_failed BOOLEAN DEFAULT false NOT NULL,
+    instance_crashed BOOLEAN DEFAULT false NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- migrate:down
@@ -0,0 +1,11 @@
+-- migrate:up
+
+-- keys used to sign api tokens once key rotation is enabled.
+-- private keys are stored as PEM encoded EC private keys.
+CREATE TABLE signing_keys (
+    id TEXT PRIMARY KEY,
+    private_key TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- migrate:down
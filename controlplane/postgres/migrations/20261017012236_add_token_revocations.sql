@@ -0,0 +1,20 @@
+-- migrate:up
+
+-- api tokens that have been revoked before they expired. rows
+-- are removed once the token expired, because it is rejected anyway.
+CREATE TABLE revoked_tokens (
+    id TEXT PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX revoked_tokens_expires_at_idx ON revoked_tokens (expires_at);
+
+-- all api tokens of a user issued before revoked_before are rejected.
+CREATE TABLE user_token_revocations (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    revoked_before TIMESTAMPTZ NOT NULL
+);
+
+-- migrate:down
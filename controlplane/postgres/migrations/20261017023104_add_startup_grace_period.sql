@@ -0,0 +1,4 @@
+-- migrate:up
+ALTER TABLE flavor_versions ADD COLUMN startup_grace_period_seconds INTEGER NOT NULL DEFAULT 0;
+
+-- migrate:down
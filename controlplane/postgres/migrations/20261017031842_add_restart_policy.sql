@@ -0,0 +1,11 @@
+-- migrate:up
+CREATE TYPE restart_policy AS ENUM (
+    'NEVER',
+    'ALWAYS',
+    'ON_FAILURE'
+);
+
+ALTER TABLE flavor_versions ADD COLUMN restart_policy restart_policy NOT NULL DEFAULT 'NEVER';
+ALTER TABLE flavor_versions ADD COLUMN restart_max_retries INTEGER NOT NULL DEFAULT 0;
+
+-- migrate:down
@@ -0,0 +1,39 @@
+-- migrate:up
+CREATE TYPE canary_state AS ENUM (
+    'ACTIVE',
+    'PROMOTED',
+    'ABORTED'
+);
+
+-- canaries split the instance launches of a flavor between its stable
+-- version and a new canary version. they are kept after they have been
+-- promoted or aborted, because launches are redirected based on the outcome.
+CREATE TABLE canaries (
+    id UUID PRIMARY KEY,
+    flavor_id UUID NOT NULL REFERENCES flavors(id) ON DELETE CASCADE,
+    stable_version_id UUID NOT NULL REFERENCES flavor_versions(id) ON DELETE CASCADE,
+    canary_version_id UUID NOT NULL REFERENCES flavor_versions(id) ON DELETE CASCADE,
+    percentage SMALLINT NOT NULL,
+    state canary_state DEFAULT 'ACTIVE' NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- only one canary per flavor can be active at a time.
+CREATE UNIQUE INDEX canaries_flavor_id_active_idx ON canaries (flavor_id) WHERE state = 'ACTIVE';
+CREATE INDEX canaries_stable_version_id_idx ON canaries (stable_version_id);
+CREATE INDEX canaries_canary_version_id_idx ON canaries (canary_version_id);
+
+-- instances launched while a canary was active. instances are removed
+-- once they have been deleted, but their events are kept and still count
+-- towards the canary, so there is no foreign key on instances.
+CREATE TABLE canary_instances (
+    instance_id UUID PRIMARY KEY,
+    canary_id UUID NOT NULL REFERENCES canaries(id) ON DELETE CASCADE,
+    canary BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX canary_instances_canary_id_idx ON canary_instances (canary_id);
+
+-- migrate:down
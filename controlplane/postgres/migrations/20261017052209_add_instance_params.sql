@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER TABLE instances ADD COLUMN world_seed VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE instances ADD COLUMN game_mode VARCHAR(16) NOT NULL DEFAULT '';
+ALTER TABLE instances ADD COLUMN custom_params JSON;
+
+-- migrate:down
@@ -0,0 +1,12 @@
+-- migrate:up
+-- the check constraint makes sure there is only a single row.
+CREATE TABLE maintenance_mode (
+    id         BOOLEAN PRIMARY KEY DEFAULT TRUE,
+    enabled    BOOLEAN NOT NULL DEFAULT FALSE,
+    message    VARCHAR(256) NOT NULL DEFAULT '',
+    updated_by UUID,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT maintenance_mode_singleton CHECK (id)
+);
+
+-- migrate:down
@@ -0,0 +1,17 @@
+-- migrate:up
+-- connections to the previous endpoint of an instance are forwarded
+-- to its current endpoint until the redirect expires. the previous
+-- endpoint is identified by the node and port, because ports are
+-- unique per node.
+CREATE TABLE instance_redirects (
+    node_id     UUID        NOT NULL REFERENCES nodes(id) ON DELETE CASCADE,
+    from_port   INTEGER     NOT NULL,
+    instance_id UUID        NOT NULL REFERENCES instances(id) ON DELETE CASCADE,
+    to_address  INET        NOT NULL,
+    to_port     INTEGER     NOT NULL,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (node_id, from_port)
+);
+
+-- migrate:down
@@ -0,0 +1,12 @@
+-- migrate:up
+-- players currently connected to an instance, as reported by proxy
+-- plugins. rows are removed when the player leaves or the instance
+-- is deleted.
+CREATE TABLE instance_players (
+    instance_id UUID        NOT NULL REFERENCES instances(id) ON DELETE CASCADE,
+    player_id   UUID        NOT NULL,
+    joined_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (instance_id, player_id)
+);
+
+-- migrate:down
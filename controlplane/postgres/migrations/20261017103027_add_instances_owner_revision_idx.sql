@@ -0,0 +1,5 @@
+-- migrate:up
+-- used to stream the instances of a user that changed after a revision.
+CREATE INDEX instances_owner_id_revision_idx ON instances(owner_id, revision);
+
+-- migrate:down
@@ -0,0 +1,9 @@
+-- migrate:up
+
+-- time it took until the restored checkpoint of the flavor version
+-- responded to a server list ping. only set if the restore of the
+-- checkpoint has been verified during the build.
+ALTER TABLE flavor_versions
+    ADD COLUMN restore_latency_ms INTEGER;
+
+-- migrate:down
@@ -0,0 +1,12 @@
+-- migrate:up
+-- retention policies decide which flavor versions of a chunk are
+-- removed by the retention job. a chunk without a policy keeps all
+-- of its flavor versions.
+CREATE TABLE chunk_retention_policies (
+    chunk_id UUID PRIMARY KEY REFERENCES chunks(id) ON DELETE CASCADE,
+    keep_last_versions INTEGER NOT NULL,
+    keep_days INTEGER NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- migrate:down
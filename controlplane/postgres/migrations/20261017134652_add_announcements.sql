@@ -0,0 +1,20 @@
+-- migrate:up
+CREATE TYPE announcement_severity AS ENUM (
+    'INFO',
+    'WARNING',
+    'CRITICAL'
+);
+
+-- announcements are shown to users between starts_at and ends_at.
+-- announcements without ends_at are shown until they are deleted.
+CREATE TABLE announcements (
+    id UUID PRIMARY KEY,
+    message VARCHAR(512) NOT NULL,
+    severity announcement_severity NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- migrate:down
@@ -0,0 +1,21 @@
+-- migrate:up
+-- the long-form markdown description shown on the page of a chunk.
+CREATE TABLE chunk_readmes (
+    chunk_id UUID PRIMARY KEY REFERENCES chunks(id) ON DELETE CASCADE,
+    markdown TEXT NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- images, like screenshots, shown on the page of a chunk. the
+-- images themselves are stored in the object storage bucket.
+CREATE TABLE chunk_media (
+    id UUID PRIMARY KEY,
+    chunk_id UUID NOT NULL REFERENCES chunks(id) ON DELETE CASCADE,
+    hash VARCHAR(64) NOT NULL,
+    content_type VARCHAR(32) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    UNIQUE (chunk_id, hash)
+);
+
+-- migrate:down
@@ -0,0 +1,29 @@
+-- migrate:up
+-- slugs reserve chunk names globally. chunks keep the slugs of their
+-- previous names, so old names still resolve to the renamed chunk and
+-- cannot be taken over by other chunks. slugs are released once the
+-- chunk has been archived.
+CREATE TABLE chunk_slugs (
+    slug VARCHAR(50) PRIMARY KEY,
+    chunk_id UUID NOT NULL REFERENCES chunks(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX chunk_slugs_chunk_id_idx ON chunk_slugs (chunk_id);
+
+-- names have not been unique before, so only the oldest chunk of
+-- chunks sharing a slug gets to keep it. the others have to be
+-- renamed in order to be resolvable by name.
+INSERT INTO chunk_slugs (slug, chunk_id, created_at)
+SELECT DISTINCT ON (slug) slug, id, created_at FROM (
+    SELECT
+        trim(BOTH '-' FROM regexp_replace(lower(name), '[^a-z0-9]+', '-', 'g')) AS slug,
+        id,
+        created_at
+    FROM chunks
+    WHERE deleted_at IS NULL
+) s
+WHERE slug <> ''
+ORDER BY slug, created_at;
+
+-- migrate:down
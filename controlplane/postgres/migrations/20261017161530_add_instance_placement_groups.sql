@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE instances ADD COLUMN placement_group VARCHAR(64) NOT NULL DEFAULT '';
+CREATE INDEX instances_placement_group_idx ON instances (placement_group) WHERE placement_group <> '';
+
+-- migrate:down
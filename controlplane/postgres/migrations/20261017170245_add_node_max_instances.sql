@@ -0,0 +1,6 @@
+-- migrate:up
+-- reported by platformd based on the resources and overcommit policy of the
+-- node. null if the node does not bound the number of instances itself.
+ALTER TABLE nodes ADD COLUMN max_instances INTEGER;
+
+-- migrate:down
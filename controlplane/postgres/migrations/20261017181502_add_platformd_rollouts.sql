@@ -0,0 +1,20 @@
+-- migrate:up
+CREATE TYPE platformd_update_status AS ENUM (
+    'PENDING',
+    'DOWNLOADING',
+    'INSTALLING',
+    'SUCCEEDED',
+    'FAILED'
+);
+
+-- platformd_version is reported by the node, desired_platformd_version is set
+-- by admins. the update status tracks the rollout of the desired version and
+-- is null if no version has ever been desired for the node.
+ALTER TABLE nodes
+    ADD COLUMN platformd_version VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN desired_platformd_version VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN platformd_update_status platformd_update_status,
+    ADD COLUMN platformd_update_message VARCHAR(512) NOT NULL DEFAULT '',
+    ADD COLUMN platformd_updated_at TIMESTAMPTZ;
+
+-- migrate:down
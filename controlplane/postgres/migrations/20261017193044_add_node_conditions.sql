@@ -0,0 +1,36 @@
+-- migrate:up
+CREATE TYPE node_condition_type AS ENUM (
+    'DISK_PRESSURE',
+    'CRI_UNRESPONSIVE',
+    'BPF_MAP_FULL',
+    'CONNTRACK_EXHAUSTED'
+);
+
+CREATE TYPE node_event_type AS ENUM (
+    'CONDITION_RAISED',
+    'CONDITION_CLEARED'
+);
+
+-- conditions are replaced whenever the node reports them. since is
+-- kept as long as the node keeps reporting the condition.
+CREATE TABLE node_conditions(
+    node_id UUID                NOT NULL REFERENCES nodes(id) ON DELETE CASCADE,
+    type    node_condition_type NOT NULL,
+    message VARCHAR(512)        NOT NULL DEFAULT '',
+    since   TIMESTAMPTZ         NOT NULL,
+    PRIMARY KEY (node_id, type)
+);
+
+-- like instance events, node events intentionally outlive the node.
+CREATE TABLE node_events(
+    id             UUID PRIMARY KEY    NOT NULL,
+    node_id        UUID                NOT NULL,
+    type           node_event_type     NOT NULL,
+    condition_type node_condition_type NOT NULL,
+    message        VARCHAR(512)        NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ         NOT NULL
+);
+
+CREATE INDEX node_events_created_at_idx ON node_events(created_at);
+
+-- migrate:down
@@ -0,0 +1,6 @@
+-- migrate:up
+-- sizes are reported by clients when creating a flavor version. files
+-- of versions created before have a size of 0, which means unknown.
+ALTER TABLE flavor_version_files ADD COLUMN size_bytes BIGINT NOT NULL DEFAULT 0;
+
+-- migrate:down
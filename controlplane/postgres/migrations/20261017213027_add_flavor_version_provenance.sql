@@ -0,0 +1,18 @@
+-- migrate:up
+
+-- records what a flavor version has been built from. the node and the
+-- platformd version are copied, so they outlive the node.
+CREATE TABLE flavor_version_provenance(
+    flavor_version_id   UUID PRIMARY KEY NOT NULL REFERENCES flavor_versions(id) ON DELETE CASCADE,
+    base_image_digest   VARCHAR(255)     NOT NULL,
+    image_digest        VARCHAR(255)     NOT NULL,
+    builder_version     VARCHAR(64)      NOT NULL DEFAULT '',
+    input_hash          VARCHAR(64)      NOT NULL,
+    plugins             JSONB            NOT NULL DEFAULT '[]',
+    image_built_at      TIMESTAMPTZ      NOT NULL,
+    checkpoint_node_id  UUID,
+    platformd_version   VARCHAR(64)      NOT NULL DEFAULT '',
+    checkpoint_built_at TIMESTAMPTZ
+);
+
+-- migrate:down
@@ -0,0 +1,34 @@
+-- migrate:up
+-- autoscaling policies keep enough instances of a flavor running to
+-- serve its players. flavors without a policy are not autoscaled.
+CREATE TABLE autoscaling_policies (
+    flavor_id UUID PRIMARY KEY REFERENCES flavors(id) ON DELETE CASCADE,
+    min_instances INTEGER NOT NULL,
+    max_instances INTEGER NOT NULL,
+    target_utilization INTEGER NOT NULL,
+    scale_up_cooldown_seconds INTEGER NOT NULL,
+    scale_down_cooldown_seconds INTEGER NOT NULL,
+    last_scale_up_at TIMESTAMPTZ,
+    last_scale_down_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- instances started by the autoscaler. only these
+-- are stopped by it when scaling down.
+CREATE TABLE autoscaled_instances (
+    instance_id UUID PRIMARY KEY REFERENCES instances(id) ON DELETE CASCADE,
+    flavor_id UUID NOT NULL REFERENCES flavors(id) ON DELETE CASCADE
+);
+
+-- players that could not join a chunk, because none of its instances had
+-- room for them. flavor_name is only set if the player asked for a specific
+-- flavor. rows are removed by the autoscaler once they are no longer needed.
+CREATE TABLE join_misses (
+    chunk_id UUID NOT NULL REFERENCES chunks(id) ON DELETE CASCADE,
+    flavor_name TEXT,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX join_misses_chunk_id_created_at_idx ON join_misses (chunk_id, created_at);
+
+-- migrate:down
@@ -0,0 +1,25 @@
+-- migrate:up
+-- admins impersonating users are recorded, so users can see who acted as
+-- them, when and why. the records are kept after the token expired. admins
+-- are configured by id, so like for announcements there is no foreign key.
+CREATE TABLE impersonations (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id),
+    admin_id UUID NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX impersonations_user_id_created_at_idx ON impersonations (user_id, created_at);
+
+-- every call made with the token of an impersonation.
+CREATE TABLE impersonated_actions (
+    impersonation_id UUID NOT NULL REFERENCES impersonations(id) ON DELETE CASCADE,
+    method TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX impersonated_actions_impersonation_id_idx ON impersonated_actions (impersonation_id);
+
+-- migrate:down
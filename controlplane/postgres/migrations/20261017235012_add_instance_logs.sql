@@ -0,0 +1,21 @@
+-- migrate:up
+-- log files shipped by platformd. they are kept after the instance has been
+-- removed, so there is no foreign key to instances. the owner is recorded
+-- to check who is allowed to read them once the instance is gone.
+CREATE TABLE instance_logs (
+    id UUID PRIMARY KEY,
+    instance_id UUID NOT NULL,
+    owner_id UUID NOT NULL REFERENCES users(id),
+    object_key TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    first_line_at TIMESTAMPTZ NOT NULL,
+    last_line_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX instance_logs_instance_id_first_line_at_idx ON instance_logs (instance_id, first_line_at);
+CREATE INDEX instance_logs_expires_at_idx ON instance_logs (expires_at);
+
+-- migrate:down
+
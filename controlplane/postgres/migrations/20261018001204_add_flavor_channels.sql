@@ -0,0 +1,14 @@
+-- migrate:up
+-- release channels point to the version of a flavor that is launched for
+-- the channel, e.g. "beta" for test instances and "stable" for players.
+CREATE TABLE flavor_channels (
+    flavor_id UUID NOT NULL REFERENCES flavors(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    flavor_version_id UUID NOT NULL REFERENCES flavor_versions(id) ON DELETE CASCADE,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    PRIMARY KEY (flavor_id, name)
+);
+
+CREATE INDEX flavor_channels_flavor_version_id_idx ON flavor_channels (flavor_version_id);
+
+-- migrate:down
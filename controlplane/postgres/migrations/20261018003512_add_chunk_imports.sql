@@ -0,0 +1,33 @@
+-- migrate:up
+CREATE TYPE chunk_import_state AS ENUM (
+    'PENDING_UPLOAD',
+    'IMPORTING',
+    'COMPLETED',
+    'FAILED'
+);
+
+-- chunk imports create the first version of a flavor from an archive of an
+-- existing server, which is uploaded by the user and unpacked by the control
+-- plane. the chunk and flavor are created right away, the flavor version once
+-- the archive has been unpacked.
+CREATE TABLE chunk_imports (
+    id UUID PRIMARY KEY,
+    chunk_id UUID NOT NULL REFERENCES chunks(id) ON DELETE CASCADE,
+    flavor_id UUID NOT NULL REFERENCES flavors(id) ON DELETE CASCADE,
+    flavor_version_id UUID REFERENCES flavor_versions(id) ON DELETE SET NULL,
+    version VARCHAR(25) NOT NULL,
+    minecraft_version VARCHAR NOT NULL,
+    min_players INTEGER NOT NULL,
+    max_players INTEGER NOT NULL,
+    archive_size_bytes BIGINT NOT NULL,
+    state chunk_import_state DEFAULT 'PENDING_UPLOAD' NOT NULL,
+    error TEXT,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX chunk_imports_chunk_id_idx ON chunk_imports (chunk_id);
+CREATE INDEX chunk_imports_flavor_id_idx ON chunk_imports (flavor_id);
+CREATE INDEX chunk_imports_flavor_version_id_idx ON chunk_imports (flavor_version_id);
+
+-- migrate:down
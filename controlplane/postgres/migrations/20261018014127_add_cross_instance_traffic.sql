@@ -0,0 +1,22 @@
+-- migrate:up
+CREATE TYPE cross_instance_traffic_state AS ENUM ('REQUESTED', 'APPROVED', 'REJECTED');
+
+-- instances are isolated from each other by default. chunks that need
+-- to connect their instances, e.g. for multi-arena setups, have to
+-- request it and an admin has to approve the request.
+CREATE TABLE chunk_cross_instance_traffic (
+    chunk_id UUID PRIMARY KEY REFERENCES chunks(id) ON DELETE CASCADE,
+    state cross_instance_traffic_state NOT NULL,
+    reason TEXT NOT NULL,
+    requested_by UUID NOT NULL,
+    reviewed_by UUID,
+    review_message TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- whether the chunk was allowed cross-instance traffic when the instance
+-- was created. approvals and revocations only affect new instances.
+ALTER TABLE instances ADD COLUMN cross_instance_traffic BOOLEAN NOT NULL DEFAULT false;
+
+-- migrate:down
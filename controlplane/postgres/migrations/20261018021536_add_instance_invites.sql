@@ -0,0 +1,20 @@
+-- migrate:up
+-- invites allow players to join an instance without it being listed,
+-- e.g. to share it with friends. only the sha256 hash of the token is
+-- stored, the token itself is only returned once, when creating the invite.
+CREATE TABLE instance_invites (
+    id UUID PRIMARY KEY,
+    instance_id UUID NOT NULL REFERENCES instances(id) ON DELETE CASCADE,
+    token_hash BYTEA NOT NULL UNIQUE,
+    -- 0 means the invite can be used an unlimited number of times.
+    max_uses INTEGER NOT NULL,
+    uses INTEGER NOT NULL DEFAULT 0,
+    created_by UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX instance_invites_instance_id_idx ON instance_invites (instance_id);
+
+-- migrate:down
@@ -0,0 +1,23 @@
+-- migrate:up
+-- schedules start an instance of a flavor version at the times matching
+-- start_cron and stop it at the times matching stop_cron. instance_id is
+-- the instance currently running because of the schedule. it is reset once
+-- the instance is gone, so at most one instance is running per schedule.
+CREATE TABLE instance_schedules (
+    id UUID PRIMARY KEY,
+    chunk_id UUID NOT NULL REFERENCES chunks(id) ON DELETE CASCADE,
+    flavor_version_id UUID NOT NULL REFERENCES flavor_versions(id) ON DELETE CASCADE,
+    start_cron TEXT NOT NULL,
+    -- empty if instances are not stopped by the schedule.
+    stop_cron TEXT NOT NULL,
+    timezone TEXT NOT NULL,
+    instance_id UUID REFERENCES instances(id) ON DELETE SET NULL,
+    -- runs are evaluated for the time after this point.
+    evaluated_at TIMESTAMPTZ NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX instance_schedules_chunk_id_idx ON instance_schedules (chunk_id);
+
+-- migrate:down
@@ -0,0 +1,163 @@
+-- migrate:up
+-- append-only tables that grow with every instance and node are partitioned
+-- by month, so old months can be dropped as a whole instead of deleting rows.
+-- the instances table itself is not partitioned, because many tables
+-- reference it and unique constraints of partitioned tables have to
+-- include the partition key.
+--
+-- partitions are named <table>_<YYYYMM> and cover a month in UTC. rows
+-- outside of all monthly partitions, e.g. because the clock of a node is
+-- off, end up in the default partition <table>_default.
+
+-- create_monthly_partition creates the partition of parent_table covering
+-- the month of the given time, unless it already exists. months are in UTC,
+-- so partitions do not depend on the time zone of the session.
+CREATE FUNCTION create_monthly_partition(parent_table text, month_of timestamptz) RETURNS text
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    first_day timestamp := date_trunc('month', month_of AT TIME ZONE 'UTC');
+    part text := parent_table || '_' || to_char(first_day, 'YYYYMM');
+BEGIN
+    EXECUTE format(
+        'CREATE TABLE IF NOT EXISTS %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)',
+        part,
+        parent_table,
+        first_day AT TIME ZONE 'UTC',
+        (first_day + interval '1 month') AT TIME ZONE 'UTC'
+    );
+    RETURN part;
+END;
+$$;
+
+-- drop_monthly_partitions_before drops the monthly partitions of
+-- parent_table that only contain rows created before cutoff and returns
+-- their names. the default partition is never dropped.
+CREATE FUNCTION drop_monthly_partitions_before(parent_table text, cutoff timestamptz) RETURNS SETOF text
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    part text;
+BEGIN
+    FOR part IN
+        SELECT c.relname::text FROM pg_inherits i
+        JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = parent_table::regclass
+          AND c.relname ~ ('^' || parent_table || '_[0-9]{6}$')
+          AND (to_date(right(c.relname, 6), 'YYYYMM') + interval '1 month') AT TIME ZONE 'UTC' <= cutoff
+        ORDER BY c.relname
+    LOOP
+        EXECUTE format('DROP TABLE %I', part);
+        RETURN NEXT part;
+    END LOOP;
+END;
+$$;
+
+-- only used by this migration to create partitions for every month that already has
+-- rows and the next two months. newer months are created by the
+-- partition maintenance job of the control plane.
+CREATE FUNCTION create_monthly_partitions_since(parent_table text, since timestamptz) RETURNS void
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    m timestamp;
+BEGIN
+    FOR m IN
+        SELECT generate_series(
+            date_trunc('month', coalesce(since, now()) AT TIME ZONE 'UTC'),
+            (now() AT TIME ZONE 'UTC') + interval '2 months',
+            interval '1 month'
+        )
+    LOOP
+        PERFORM create_monthly_partition(parent_table, m AT TIME ZONE 'UTC');
+    END LOOP;
+END;
+$$;
+
+/*
+ * INSTANCE EVENTS
+ */
+
+ALTER TABLE instance_events RENAME TO instance_events_unpartitioned;
+ALTER TABLE instance_events_unpartitioned RENAME CONSTRAINT instance_events_pkey TO instance_events_unpartitioned_pkey;
+DROP INDEX instance_events_instance_id_created_at_idx;
+
+-- platformd reports events at-least-once with the same id and creation
+-- time, so including created_at in the primary key still detects duplicates.
+CREATE TABLE instance_events(
+    id          UUID                NOT NULL,
+    instance_id UUID                NOT NULL,
+    type        instance_event_type NOT NULL,
+    exit_code   INTEGER             NOT NULL DEFAULT 0,
+    message     VARCHAR(1024)       NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ         NOT NULL,
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE instance_events_default PARTITION OF instance_events DEFAULT;
+SELECT create_monthly_partitions_since('instance_events', (SELECT min(created_at) FROM instance_events_unpartitioned));
+
+INSERT INTO instance_events (id, instance_id, type, exit_code, message, created_at)
+SELECT id, instance_id, type, exit_code, message, created_at FROM instance_events_unpartitioned;
+
+DROP TABLE instance_events_unpartitioned;
+
+CREATE INDEX instance_events_instance_id_created_at_idx ON instance_events(instance_id, created_at);
+
+/*
+ * NODE EVENTS
+ */
+
+ALTER TABLE node_events RENAME TO node_events_unpartitioned;
+ALTER TABLE node_events_unpartitioned RENAME CONSTRAINT node_events_pkey TO node_events_unpartitioned_pkey;
+DROP INDEX node_events_created_at_idx;
+
+CREATE TABLE node_events(
+    id             UUID                NOT NULL,
+    node_id        UUID                NOT NULL,
+    type           node_event_type     NOT NULL,
+    condition_type node_condition_type NOT NULL,
+    message        VARCHAR(512)        NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ         NOT NULL,
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE node_events_default PARTITION OF node_events DEFAULT;
+SELECT create_monthly_partitions_since('node_events', (SELECT min(created_at) FROM node_events_unpartitioned));
+
+INSERT INTO node_events (id, node_id, type, condition_type, message, created_at)
+SELECT id, node_id, type, condition_type, message, created_at FROM node_events_unpartitioned;
+
+DROP TABLE node_events_unpartitioned;
+
+CREATE INDEX node_events_created_at_idx ON node_events(created_at);
+
+/*
+ * IMPERSONATED ACTIONS
+ */
+
+ALTER TABLE impersonated_actions RENAME TO impersonated_actions_unpartitioned;
+DROP INDEX impersonated_actions_impersonation_id_idx;
+
+CREATE TABLE impersonated_actions (
+    impersonation_id UUID NOT NULL REFERENCES impersonations(id) ON DELETE CASCADE,
+    method TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE impersonated_actions_default PARTITION OF impersonated_actions DEFAULT;
+SELECT create_monthly_partitions_since(
+    'impersonated_actions',
+    (SELECT min(created_at) FROM impersonated_actions_unpartitioned)
+);
+
+INSERT INTO impersonated_actions (impersonation_id, method, created_at)
+SELECT impersonation_id, method, created_at FROM impersonated_actions_unpartitioned;
+
+DROP TABLE impersonated_actions_unpartitioned;
+
+CREATE INDEX impersonated_actions_impersonation_id_idx ON impersonated_actions (impersonation_id);
+
+DROP FUNCTION create_monthly_partitions_since(text, timestamptz);
+
+-- migrate:down
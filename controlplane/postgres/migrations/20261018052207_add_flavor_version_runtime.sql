@@ -0,0 +1,12 @@
+-- migrate:up
+ALTER TABLE flavor_versions ADD COLUMN java_version INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE flavor_versions ADD COLUMN startup_command TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS minecraft_version_java_images (
+    minecraft_version VARCHAR      NOT NULL REFERENCES minecraft_versions(version) ON DELETE CASCADE,
+    java_version      INTEGER      NOT NULL,
+    image_url         VARCHAR(256) NOT NULL,
+    PRIMARY KEY (minecraft_version, java_version)
+);
+
+-- migrate:down
@@ -0,0 +1,13 @@
+-- migrate:up
+-- curated starter chunks users can create their own chunk from. the files
+-- of the flavor version are copied into the new chunk by referencing them.
+CREATE TABLE IF NOT EXISTS chunk_templates (
+    id                UUID         PRIMARY KEY,
+    name              VARCHAR(50)  NOT NULL UNIQUE,
+    description       VARCHAR(100) NOT NULL,
+    flavor_version_id UUID         NOT NULL REFERENCES flavor_versions(id) ON DELETE CASCADE,
+    created_at        TIMESTAMPTZ  DEFAULT now() NOT NULL,
+    updated_at        TIMESTAMPTZ  DEFAULT now() NOT NULL
+);
+
+-- migrate:down
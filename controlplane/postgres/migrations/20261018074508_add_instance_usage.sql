@@ -0,0 +1,25 @@
+-- migrate:up
+-- resources assigned to each instance running on the node, as reported
+-- by platformd. 0 if the resources of instances are not limited.
+ALTER TABLE nodes ADD COLUMN workload_cpu_millis BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE nodes ADD COLUMN workload_memory_bytes BIGINT NOT NULL DEFAULT 0;
+
+-- rows outlive the instances they belong to, because instances
+-- are removed as soon as they have been deleted on the node. the
+-- resources and slots of the node are copied when the instance is
+-- created, so changes to the node do not alter past usage.
+CREATE TABLE IF NOT EXISTS instance_usage (
+    instance_id  UUID        PRIMARY KEY,
+    chunk_id     UUID        NOT NULL REFERENCES chunks(id) ON DELETE CASCADE,
+    node_id      UUID        NOT NULL,
+    cpu_millis   BIGINT      NOT NULL,
+    memory_bytes BIGINT      NOT NULL,
+    node_slots   INTEGER     NOT NULL,
+    started_at   TIMESTAMPTZ NOT NULL,
+    stopped_at   TIMESTAMPTZ
+);
+
+CREATE INDEX instance_usage_chunk_id_idx ON instance_usage(chunk_id);
+CREATE INDEX instance_usage_started_at_idx ON instance_usage(started_at);
+
+-- migrate:down
@@ -0,0 +1,11 @@
+-- migrate:up
+-- lifecycle events make up the timeline of an instance, which
+-- helps finding out where an instance spent its time while starting.
+ALTER TYPE instance_event_type ADD VALUE IF NOT EXISTS 'SCHEDULED';
+ALTER TYPE instance_event_type ADD VALUE IF NOT EXISTS 'IMAGE_PULLED';
+ALTER TYPE instance_event_type ADD VALUE IF NOT EXISTS 'CONTAINER_STARTED';
+ALTER TYPE instance_event_type ADD VALUE IF NOT EXISTS 'READY';
+ALTER TYPE instance_event_type ADD VALUE IF NOT EXISTS 'PLAYER_JOINED';
+ALTER TYPE instance_event_type ADD VALUE IF NOT EXISTS 'REMOVED';
+
+-- migrate:down
@@ -0,0 +1,25 @@
+-- migrate:up
+-- settings changed by admins while the platform is running, like log
+-- levels and feature flags. settings that have never been changed have
+-- no row, so their defaults apply.
+CREATE TABLE runtime_settings (
+    name       VARCHAR(128) PRIMARY KEY,
+    value      VARCHAR(1024) NOT NULL,
+    updated_by UUID NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- every change of a setting is recorded, so admins can see who changed
+-- what and when. admins are configured by id, so there is no foreign key.
+CREATE TABLE runtime_setting_changes (
+    id         UUID PRIMARY KEY,
+    setting    VARCHAR(128) NOT NULL,
+    old_value  VARCHAR(1024) NOT NULL,
+    new_value  VARCHAR(1024) NOT NULL,
+    actor_id   UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX runtime_setting_changes_created_at_idx ON runtime_setting_changes (created_at);
+
+-- migrate:down
@@ -0,0 +1,14 @@
+-- migrate:up
+
+-- instances are lost if the node they have been scheduled to
+-- stopped sending heartbeats. they are removed once the node
+-- returns, because they might have been replaced in the meantime.
+ALTER TYPE instance_state ADD VALUE IF NOT EXISTS 'LOST';
+ALTER TYPE instance_event_type ADD VALUE IF NOT EXISTS 'NODE_LOST';
+
+-- set while the node is considered lost. no instances
+-- are scheduled to lost nodes.
+ALTER TABLE nodes
+    ADD COLUMN lost_at TIMESTAMPTZ;
+
+-- migrate:down
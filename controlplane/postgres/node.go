@@ -22,9 +22,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/netip"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
 
 	"github.com/spacechunks/explorer/controlplane/node"
@@ -59,11 +62,11 @@ func (db *DB) RandomNode(ctx context.Context) (node.Node, error) {
 	return ret, nil
 }
 
-func (db *DB) BestNode(ctx context.Context) (node.Node, error) {
+func (db *DB) BestNode(ctx context.Context, placementGroup string) (node.Node, error) {
 	var ret node.Node
 
 	if err := db.do(ctx, func(q *query.Queries) error {
-		n, err := q.BestNode(ctx)
+		n, err := q.BestNode(ctx, placementGroup)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				return apierrs.ErrNoSlotsAvailable
@@ -76,9 +79,186 @@ func (db *DB) BestNode(ctx context.Context) (node.Node, error) {
 			return fmt.Errorf("invalid address port: %w", err)
 		}
 
-		available := int(n.Slots) - int(n.InstanceCount)
-		if available < 0 {
-			available = 0
+		slots := nodeSlots(n.Slots, n.MaxInstances)
+
+		ret = node.Node{
+			ID:                    n.ID,
+			Name:                  n.Name,
+			Addr:                  n.Address,
+			CheckpointAPIEndpoint: addrPort,
+			Slots:                 slots,
+			AvailableSlots:        max(slots-int(n.InstanceCount), 0),
+		}
+
+		return nil
+	}); err != nil {
+		return ret, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) ListNodes(ctx context.Context) ([]node.Node, error) {
+	var ret []node.Node
+
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.ListNodes(ctx)
+		if err != nil {
+			return fmt.Errorf("list nodes: %w", err)
+		}
+
+		ret = make([]node.Node, 0, len(rows))
+		for _, n := range rows {
+			addrPort, err := netip.ParseAddrPort(n.CheckpointApiEndpoint)
+			if err != nil {
+				return fmt.Errorf("invalid address port: %w", err)
+			}
+
+			slots := nodeSlots(n.Slots, n.MaxInstances)
+
+			caps := make([]node.Capability, 0, len(n.Capabilities))
+			for _, c := range n.Capabilities {
+				caps = append(caps, node.Capability(c))
+			}
+
+			ret = append(ret, node.Node{
+				ID:                    n.ID,
+				Name:                  n.Name,
+				Addr:                  n.Address,
+				CheckpointAPIEndpoint: addrPort,
+				Slots:                 slots,
+				AvailableSlots:        max(slots-int(n.InstanceCount), 0),
+				Unhealthy:             n.HasConditions,
+				Lost:                  n.LostAt.Valid,
+				Capabilities:          caps,
+				LastSeenAt:            n.LastSeenAt.Time,
+				CreatedAt:             n.CreatedAt.UTC(),
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) NodeByInstanceID(ctx context.Context, instanceID string) (node.Node, error) {
+	var ret node.Node
+
+	if err := db.do(ctx, func(q *query.Queries) error {
+		n, err := q.NodeByInstanceID(ctx, instanceID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrInstanceNotFound
+			}
+			return fmt.Errorf("node by instance id: %w", err)
+		}
+
+		addrPort, err := netip.ParseAddrPort(n.CheckpointApiEndpoint)
+		if err != nil {
+			return fmt.Errorf("invalid address port: %w", err)
+		}
+
+		ret = node.Node{
+			ID:                    n.ID,
+			Name:                  n.Name,
+			Addr:                  n.Address,
+			CheckpointAPIEndpoint: addrPort,
+			Slots:                 nodeSlots(n.Slots, n.MaxInstances),
+		}
+
+		return nil
+	}); err != nil {
+		return ret, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) UpdateNodeHeartbeat(
+	ctx context.Context,
+	id string,
+	capabilities []node.Capability,
+	capacity *node.Capacity,
+) error {
+	caps := make([]string, 0, len(capabilities))
+	for _, c := range capabilities {
+		caps = append(caps, string(c))
+	}
+
+	params := query.UpdateNodeHeartbeatParams{
+		ID:           id,
+		Capabilities: caps,
+	}
+
+	if capacity != nil {
+		params.MaxInstances = pgtype.Int4{
+			Int32: int32(min(capacity.MaxInstances, math.MaxInt32)),
+			Valid: true,
+		}
+		params.WorkloadCpuMillis = int64(min(capacity.WorkloadCPUMillis, math.MaxInt64))
+		params.WorkloadMemoryBytes = int64(min(capacity.WorkloadMemoryBytes, math.MaxInt64))
+	}
+
+	return db.doTX(ctx, func(_ pgx.Tx, q *query.Queries) error {
+		if err := q.UpdateNodeHeartbeat(ctx, params); err != nil {
+			return fmt.Errorf("update heartbeat: %w", err)
+		}
+
+		// a returning node gets its lost instances back as DELETING
+		// with the same call, so they are stopped right away.
+		if err := q.RemoveLostInstances(ctx, id); err != nil {
+			return fmt.Errorf("remove lost instances: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// nodeSlots returns the number of instances the node can run. the max
+// instances reported by the node take precedence if they are lower than
+// the configured slots.
+func nodeSlots(slots int32, maxInstances pgtype.Int4) int {
+	if maxInstances.Valid && maxInstances.Int32 < slots {
+		return int(max(maxInstances.Int32, 0))
+	}
+	return int(slots)
+}
+
+func (db *DB) ScheduleCheckpointBuild(
+	ctx context.Context,
+	flavorVersionID string,
+	seenAfter time.Time,
+) (node.Node, error) {
+	var ret node.Node
+
+	if err := db.doTX(ctx, func(_ pgx.Tx, q *query.Queries) error {
+		n, err := q.BestBuilderNode(ctx, query.BestBuilderNodeParams{
+			FlavorVersionID: flavorVersionID,
+			SeenAfter: pgtype.Timestamptz{
+				Time:  seenAfter,
+				Valid: true,
+			},
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNoBuilderOnline
+			}
+			return fmt.Errorf("best builder node: %w", err)
+		}
+
+		addrPort, err := netip.ParseAddrPort(n.CheckpointApiEndpoint)
+		if err != nil {
+			return fmt.Errorf("invalid address port: %w", err)
+		}
+
+		if err := q.UpdateFlavorVersionCheckpointNode(ctx, query.UpdateFlavorVersionCheckpointNodeParams{
+			ID:               flavorVersionID,
+			CheckpointNodeID: &n.ID,
+		}); err != nil {
+			return fmt.Errorf("update checkpoint node: %w", err)
 		}
 
 		ret = node.Node{
@@ -86,8 +266,7 @@ func (db *DB) BestNode(ctx context.Context) (node.Node, error) {
 			Name:                  n.Name,
 			Addr:                  n.Address,
 			CheckpointAPIEndpoint: addrPort,
-			Slots:                 int(n.Slots),
-			AvailableSlots:        available,
+			Slots:                 nodeSlots(n.Slots, n.MaxInstances),
 		}
 
 		return nil
@@ -0,0 +1,113 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spacechunks/explorer/controlplane/notification"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+func (db *DB) BuildNotificationData(
+	ctx context.Context,
+	flavorVersionID string,
+) (notification.Recipient, notification.Data, error) {
+	var (
+		rcpt notification.Recipient
+		data notification.Data
+	)
+
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.BuildNotificationData(ctx, flavorVersionID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return notification.ErrRecipientNotFound
+		}
+
+		if err != nil {
+			return fmt.Errorf("build notification data: %w", err)
+		}
+
+		rcpt = notification.Recipient{
+			Email: row.Email,
+			Preferences: resource.NotificationPreferences{
+				BuildCompleted: row.BuildCompleted,
+				BuildFailed:    row.BuildFailed,
+			},
+		}
+
+		data = notification.Data{
+			ChunkName:     row.ChunkName,
+			FlavorName:    row.FlavorName,
+			FlavorVersion: row.Version,
+			Reason:        row.BuildError.String,
+		}
+
+		return nil
+	}); err != nil {
+		return notification.Recipient{}, notification.Data{}, err
+	}
+
+	return rcpt, data, nil
+}
+
+func (db *DB) InstanceNotificationData(
+	ctx context.Context,
+	instanceID string,
+) (notification.Recipient, notification.Data, error) {
+	var (
+		rcpt notification.Recipient
+		data notification.Data
+	)
+
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.InstanceNotificationData(ctx, instanceID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return notification.ErrRecipientNotFound
+		}
+
+		if err != nil {
+			return fmt.Errorf("instance notification data: %w", err)
+		}
+
+		rcpt = notification.Recipient{
+			Email: row.Email,
+			Preferences: resource.NotificationPreferences{
+				InstanceCrashed: row.InstanceCrashed,
+			},
+		}
+
+		data = notification.Data{
+			ChunkName:     row.ChunkName,
+			FlavorName:    row.FlavorName,
+			FlavorVersion: row.Version,
+			InstanceID:    instanceID,
+		}
+
+		return nil
+	}); err != nil {
+		return notification.Recipient{}, notification.Data{}, err
+	}
+
+	return rcpt, data, nil
+}
@@ -0,0 +1,109 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/notification"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+)
+
+func (db *DB) MarkInstancesLost(ctx context.Context, seenBefore time.Time) ([]string, error) {
+	var ret []string
+	if err := db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+		if err := q.MarkNodesLost(ctx, pgtype.Timestamptz{
+			Time:  seenBefore,
+			Valid: true,
+		}); err != nil {
+			return fmt.Errorf("mark nodes lost: %w", err)
+		}
+
+		// instances of nodes that have been lost before are moved as well,
+		// in case they have been created right before the node got lost.
+		rows, err := q.MarkInstancesLost(ctx)
+		if err != nil {
+			return fmt.Errorf("mark instances lost: %w", err)
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+
+		var (
+			ids           = make([]string, 0, len(rows))
+			events        = make([]query.BulkInsertInstanceEventsParams, 0, len(rows))
+			notifications = make([]river.InsertManyParams, 0, len(rows))
+		)
+
+		for _, row := range rows {
+			evID, err := uuid.NewV7()
+			if err != nil {
+				return fmt.Errorf("generate event id: %w", err)
+			}
+
+			reason := fmt.Sprintf("node %s stopped sending heartbeats", row.NodeName)
+
+			ids = append(ids, row.ID)
+			events = append(events, query.BulkInsertInstanceEventsParams{
+				ID:         evID.String(),
+				InstanceID: row.ID,
+				Type:       query.InstanceEventTypeNODELOST,
+				Message:    reason,
+				CreatedAt:  time.Now(),
+			})
+			notifications = append(notifications, river.InsertManyParams{
+				Args: job.SendNotification{
+					Type:       notification.KindInstanceLost,
+					InstanceID: row.ID,
+					Reason:     reason,
+				},
+			})
+		}
+
+		// lost instances do not run anymore as far as we know,
+		// so they must not be attributed any more costs.
+		if err := q.StopInstanceUsage(ctx, ids); err != nil {
+			return fmt.Errorf("stop instance usage: %w", err)
+		}
+
+		if err := db.bulkExecAndClose(q.BulkInsertInstanceEvents(ctx, events)); err != nil {
+			return fmt.Errorf("bulk insert lost events: %w", err)
+		}
+
+		if _, err := db.riverClient.InsertManyTx(ctx, tx, notifications); err != nil {
+			return fmt.Errorf("insert notification jobs: %w", err)
+		}
+
+		ret = ids
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,57 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+)
+
+func (db *DB) CreateMonthlyPartition(ctx context.Context, table string, month time.Time) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		if err := q.CreateMonthlyPartition(ctx, query.CreateMonthlyPartitionParams{
+			ParentTable: table,
+			MonthOf:     month,
+		}); err != nil {
+			return fmt.Errorf("create monthly partition: %w", err)
+		}
+		return nil
+	})
+}
+
+func (db *DB) DropMonthlyPartitionsBefore(ctx context.Context, table string, cutoff time.Time) ([]string, error) {
+	var dropped []string
+	if err := db.do(ctx, func(q *query.Queries) error {
+		names, err := q.DropMonthlyPartitionsBefore(ctx, query.DropMonthlyPartitionsBeforeParams{
+			ParentTable: table,
+			Cutoff:      cutoff,
+		})
+		if err != nil {
+			return fmt.Errorf("drop monthly partitions: %w", err)
+		}
+		dropped = names
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return dropped, nil
+}
@@ -1,17 +1,178 @@
 /*
  * NODES
  */
+-- nodes reporting conditions or being lost are only
+-- selected if there is no other node.
 -- name: RandomNode :one
-SELECT * FROM nodes ORDER BY random() LIMIT 1;
+SELECT n.* FROM nodes n
+ORDER BY
+    (EXISTS (SELECT 1 FROM node_conditions c WHERE c.node_id = n.id) OR n.lost_at IS NOT NULL) ASC,
+    random()
+LIMIT 1;
 
+-- nodes never get more instances than the lower of their slots and the
+-- max instances they reported themselves.
+--
+-- nodes already running instances of the given placement group are
+-- preferred, as long as they have free slots. an empty placement group
+-- does not match any instance. nodes reporting conditions and lost
+-- nodes are skipped.
 -- name: BestNode :one
 SELECT n.*, COUNT(i.id) AS instance_count FROM nodes n
 LEFT JOIN instances i ON i.node_id = n.id
-WHERE n.slots > (SELECT COUNT(*) FROM instances WHERE node_id = n.id)
+WHERE LEAST(n.slots, COALESCE(n.max_instances, n.slots)) > (SELECT COUNT(*) FROM instances WHERE node_id = n.id)
+    AND NOT EXISTS (SELECT 1 FROM node_conditions c WHERE c.node_id = n.id)
+    AND n.lost_at IS NULL
 GROUP BY n.id
-ORDER BY instance_count ASC, random() ASC
+ORDER BY
+    COUNT(i.id) FILTER (
+        WHERE sqlc.arg('placement_group')::text <> '' AND i.placement_group = sqlc.arg('placement_group')::text
+    ) DESC,
+    instance_count ASC,
+    random() ASC
 LIMIT 1;
 
+-- counts flavor versions waiting for their build as queued,
+-- but not the ones whose files have not been uploaded yet.
+-- name: PlatformStatus :one
+SELECT
+    (SELECT COUNT(*) FROM nodes) AS nodes_total,
+    (SELECT COUNT(*) FROM nodes n WHERE n.last_seen_at >= sqlc.arg('seen_after')) AS nodes_online,
+    (SELECT COUNT(*) FROM instances i WHERE i.state = 'RUNNING') AS instances_running,
+    (SELECT COUNT(*) FROM flavor_versions fv
+     WHERE (fv.build_status = 'PENDING' AND fv.files_uploaded)
+        OR fv.build_status IN ('IMAGE_BUILD', 'CHECKPOINT_BUILD')) AS builds_queued;
+
+-- name: ListNodes :many
+SELECT n.*,
+    (SELECT COUNT(*) FROM instances i WHERE i.node_id = n.id) AS instance_count,
+    EXISTS (SELECT 1 FROM node_conditions c WHERE c.node_id = n.id) AS has_conditions
+FROM nodes n
+ORDER BY n.id;
+
+-- name: NodeByInstanceID :one
+SELECT n.* FROM nodes n
+JOIN instances i ON i.node_id = n.id
+WHERE i.id = $1;
+
+-- locks the node row until the end of the transaction, so concurrent
+-- transactions cannot schedule instances to the node while its
+-- remaining capacity is being checked.
+-- name: LockNode :one
+SELECT LEAST(slots, COALESCE(max_instances, slots))::integer AS slots FROM nodes WHERE id = $1 FOR UPDATE;
+
+-- name: UpdateNodeHeartbeat :exec
+UPDATE nodes SET
+    capabilities = $2,
+    max_instances = $3,
+    workload_cpu_millis = $4,
+    workload_memory_bytes = $5,
+    last_seen_at = now(),
+    lost_at = NULL
+WHERE id = $1;
+
+-- nodes that have not been seen since seen_before are marked as lost.
+-- nodes that never sent a heartbeat are left alone, because they might
+-- run an old version of platformd that does not send heartbeats.
+-- name: MarkNodesLost :exec
+UPDATE nodes SET lost_at = now()
+WHERE last_seen_at < sqlc.arg('seen_before') AND lost_at IS NULL;
+
+-- nodes already running the version are done right away. an empty
+-- list of node ids rolls out the version to all nodes.
+-- name: SetDesiredPlatformdVersion :many
+UPDATE nodes SET
+    desired_platformd_version = sqlc.arg('version'),
+    platformd_update_status = CASE
+        WHEN platformd_version = sqlc.arg('version') THEN 'SUCCEEDED'
+        ELSE 'PENDING'
+    END::platformd_update_status,
+    platformd_update_message = '',
+    platformd_updated_at = now()
+WHERE cardinality(sqlc.arg('node_ids')::uuid[]) = 0 OR id = ANY(sqlc.arg('node_ids')::uuid[])
+RETURNING *;
+
+-- name: ListPlatformdRollouts :many
+SELECT * FROM nodes ORDER BY name, id;
+
+-- records the version the node is running. the rollout is completed
+-- once the node runs the desired version.
+-- name: ReportPlatformdVersion :one
+UPDATE nodes SET
+    platformd_version = sqlc.arg('version'),
+    platformd_update_status = CASE
+        WHEN desired_platformd_version = sqlc.arg('version') THEN 'SUCCEEDED'
+        ELSE platformd_update_status
+    END::platformd_update_status,
+    platformd_update_message = CASE
+        WHEN desired_platformd_version = sqlc.arg('version') THEN ''
+        ELSE platformd_update_message
+    END,
+    platformd_updated_at = CASE
+        WHEN desired_platformd_version = sqlc.arg('version')
+            AND platformd_update_status IS DISTINCT FROM 'SUCCEEDED' THEN now()
+        ELSE platformd_updated_at
+    END
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- reports for versions that are no longer desired are ignored.
+-- name: UpdatePlatformdUpdateStatus :exec
+UPDATE nodes SET
+    platformd_update_status = sqlc.arg('status'),
+    platformd_update_message = sqlc.arg('message'),
+    platformd_updated_at = now()
+WHERE id = sqlc.arg('id') AND desired_platformd_version = sqlc.arg('version');
+
+-- selects the online builder with the least checkpoint builds in progress.
+-- ties are broken by the number of instances running on the node. builders
+-- reporting conditions are skipped.
+-- name: BestBuilderNode :one
+SELECT n.*,
+    (SELECT COUNT(*) FROM flavor_versions fv
+     WHERE fv.checkpoint_node_id = n.id
+        AND fv.build_status = 'CHECKPOINT_BUILD'
+        AND fv.id != sqlc.arg('flavor_version_id')) AS build_count,
+    (SELECT COUNT(*) FROM instances i WHERE i.node_id = n.id) AS instance_count
+FROM nodes n
+WHERE 'BUILDER' = ANY(n.capabilities) AND n.last_seen_at >= sqlc.arg('seen_after')
+    AND NOT EXISTS (SELECT 1 FROM node_conditions c WHERE c.node_id = n.id)
+ORDER BY build_count ASC, instance_count ASC, random() ASC
+LIMIT 1;
+
+-- name: UpdateFlavorVersionCheckpointNode :exec
+UPDATE flavor_versions SET checkpoint_node_id = $2 WHERE id = $1;
+
+-- name: GetNodeConditions :many
+SELECT * FROM node_conditions WHERE node_id = $1;
+
+-- since is kept if the node already reported the condition.
+-- name: UpsertNodeCondition :exec
+INSERT INTO node_conditions
+    (node_id, type, message, since)
+VALUES
+    ($1, $2, $3, $4)
+ON CONFLICT (node_id, type) DO UPDATE SET message = EXCLUDED.message;
+
+-- name: DeleteNodeCondition :exec
+DELETE FROM node_conditions WHERE node_id = $1 AND type = $2;
+
+-- name: ListNodeConditions :many
+SELECT c.*, n.name AS node_name FROM node_conditions c
+JOIN nodes n ON n.id = c.node_id
+ORDER BY n.name, c.node_id, c.type;
+
+-- name: InsertNodeEvent :exec
+INSERT INTO node_events
+    (id, node_id, type, condition_type, message, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6);
+
+-- name: ListNodeEvents :many
+SELECT * FROM node_events
+ORDER BY created_at DESC
+LIMIT $1;
+
 /*
  * CHUNKS
  */
@@ -40,6 +201,20 @@ SET
     updated_at = now()
 WHERE id = $4;
 
+-- reserves the slug for the chunk. no rows are affected
+-- if the slug has been reserved by another chunk.
+-- name: ReserveChunkSlug :execrows
+INSERT INTO chunk_slugs
+    (slug, chunk_id, created_at)
+VALUES
+    ($1, $2, $3)
+ON CONFLICT (slug) DO UPDATE SET
+    chunk_id = EXCLUDED.chunk_id
+WHERE chunk_slugs.chunk_id = EXCLUDED.chunk_id;
+
+-- name: ChunkIDBySlug :one
+SELECT chunk_id FROM chunk_slugs WHERE slug = $1;
+
 -- name: ListChunks :many
 SELECT * FROM chunks c
     LEFT JOIN flavors f ON f.chunk_id = c.id AND f.deleted_at IS NULL
@@ -122,16 +297,28 @@ SELECT * FROM flavor_version_files WHERE flavor_version_id = $1;
 
 -- name: CreateFlavorVersion :exec
 INSERT INTO flavor_versions
-    (id, flavor_id, hash, version, prev_version_id, minecraft_version, created_at, min_players, max_players)
+    (id, flavor_id, hash, version, prev_version_id, minecraft_version, created_at, min_players, max_players,
+     startup_grace_period_seconds, restart_policy, restart_max_retries, java_version, startup_command)
 VALUES
-    ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14);
 
 -- name: BulkInsertFlavorFileHashes :batchexec
 INSERT INTO flavor_version_files
-    (flavor_version_id, file_hash, file_path)
+    (flavor_version_id, file_hash, file_path, size_bytes)
+VALUES
+    ($1, $2, $3, $4);
+
+-- name: BulkInsertFlavorVersionAssets :batchexec
+INSERT INTO flavor_version_assets
+    (flavor_version_id, asset_hash, position)
 VALUES
     ($1, $2, $3);
 
+-- name: FlavorVersionAssetHashes :many
+SELECT asset_hash FROM flavor_version_assets
+WHERE flavor_version_id = $1
+ORDER BY position;
+
 -- name: FlavorVersionHashByID :one
 SELECT hash FROM flavor_versions WHERE id = $1;
 
@@ -139,7 +326,49 @@ SELECT hash FROM flavor_versions WHERE id = $1;
 UPDATE flavor_versions SET files_uploaded = TRUE WHERE id = $1;
 
 -- name: UpdateFlavorVersionBuildStatus :exec
-UPDATE flavor_versions SET build_status = $1 WHERE id = $2;
+UPDATE flavor_versions SET build_status = $1, build_error = NULL, build_progress = 0 WHERE id = $2;
+
+-- name: RejectFlavorVersionBuild :exec
+UPDATE flavor_versions SET build_status = $1, build_error = $2, build_progress = 0 WHERE id = $3;
+
+-- name: UpdateFlavorVersionBuildProgress :exec
+UPDATE flavor_versions SET build_progress = $1 WHERE id = $2;
+
+-- name: UpdateFlavorVersionRestoreLatency :exec
+UPDATE flavor_versions SET restore_latency_ms = $1 WHERE id = $2;
+
+-- rebuilding the image invalidates the checkpoint, so its provenance is reset.
+-- name: UpsertFlavorVersionImageProvenance :exec
+INSERT INTO flavor_version_provenance
+    (flavor_version_id, base_image_digest, image_digest, builder_version, input_hash, plugins, image_built_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (flavor_version_id) DO UPDATE SET
+    base_image_digest = EXCLUDED.base_image_digest,
+    image_digest = EXCLUDED.image_digest,
+    builder_version = EXCLUDED.builder_version,
+    input_hash = EXCLUDED.input_hash,
+    plugins = EXCLUDED.plugins,
+    image_built_at = EXCLUDED.image_built_at,
+    checkpoint_node_id = NULL,
+    platformd_version = '',
+    checkpoint_built_at = NULL;
+
+-- name: UpdateFlavorVersionCheckpointProvenance :exec
+UPDATE flavor_version_provenance SET
+    checkpoint_node_id = sqlc.arg('node_id')::UUID,
+    platformd_version = COALESCE((SELECT platformd_version FROM nodes WHERE id = sqlc.arg('node_id')::UUID), ''),
+    checkpoint_built_at = sqlc.arg('built_at')
+WHERE flavor_version_id = sqlc.arg('flavor_version_id');
+
+-- name: FlavorVersionProvenance :one
+SELECT * FROM flavor_version_provenance WHERE flavor_version_id = $1;
+
+-- name: UpdateFlavorVersionCheckpointResources :exec
+UPDATE flavor_versions SET
+    checkpoint_cpu_millis = sqlc.narg('cpu_millis'),
+    checkpoint_memory_limit_bytes = sqlc.narg('memory_limit_bytes')
+WHERE id = sqlc.arg('id');
 
 -- name: UpdateFlavorVersionPresignedURLData :exec
 UPDATE flavor_versions SET
@@ -182,6 +411,380 @@ SELECT * FROM flavors f
     LEFT JOIN flavor_versions fv ON fv.flavor_id = $1
 WHERE f.id = $1;
 
+/*
+ * ASSETS
+ */
+
+-- name: CreateAsset :exec
+INSERT INTO assets
+    (hash, size_bytes, created_at)
+VALUES
+    ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: AssetByHash :one
+SELECT * FROM assets WHERE hash = $1;
+
+-- name: CountAssets :one
+SELECT count(*) FROM assets WHERE hash = ANY(@hashes::text[]);
+
+/*
+ * CANARIES
+ */
+
+-- name: CreateCanary :exec
+INSERT INTO canaries
+    (id, flavor_id, stable_version_id, canary_version_id, percentage, state, created_at, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: LatestCanaryByFlavorID :one
+SELECT * FROM canaries
+WHERE flavor_id = $1
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: LatestCanaryByFlavorVersionID :one
+SELECT * FROM canaries
+WHERE stable_version_id = @flavor_version_id OR canary_version_id = @flavor_version_id
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: UpdateActiveCanaryState :one
+UPDATE canaries SET
+    state = $2,
+    updated_at = $3
+WHERE flavor_id = $1 AND state = 'ACTIVE'
+RETURNING *;
+
+-- name: CreateCanaryInstance :exec
+INSERT INTO canary_instances
+    (instance_id, canary_id, canary, created_at)
+VALUES
+    ($1, $2, $3, $4);
+
+-- crashes are counted using the events of the instances, because
+-- they are kept after the instances have been removed. connections
+-- are only known for instances that have not been removed yet. events
+-- are timestamped by nodes, whose clocks might be off, so a day of slack
+-- is given when limiting them to the time after the canary started.
+-- name: CanaryStats :many
+SELECT
+    ci.canary,
+    count(*)::bigint AS launches,
+    count(*) FILTER (WHERE EXISTS (
+        SELECT 1 FROM instance_events e
+        WHERE e.instance_id = ci.instance_id
+          AND e.created_at >= ci.created_at - interval '1 day'
+          AND e.type IN ('CRASHED', 'OOM_KILLED')
+    ))::bigint AS crashed_instances,
+    coalesce(sum(i.traffic_connections), 0)::bigint AS connections
+FROM canary_instances ci
+LEFT JOIN instances i ON i.id = ci.instance_id
+WHERE ci.canary_id = $1
+GROUP BY ci.canary;
+
+/*
+ * FLAVOR CHANNELS
+ */
+
+-- name: UpsertFlavorChannel :one
+INSERT INTO flavor_channels
+    (flavor_id, name, flavor_version_id, updated_at)
+VALUES
+    ($1, $2, $3, $4)
+ON CONFLICT (flavor_id, name) DO UPDATE SET
+    flavor_version_id = EXCLUDED.flavor_version_id,
+    updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+-- name: FlavorChannel :one
+SELECT * FROM flavor_channels WHERE flavor_id = $1 AND name = $2;
+
+-- name: FlavorChannelsByFlavorID :many
+SELECT * FROM flavor_channels WHERE flavor_id = $1 ORDER BY name;
+
+/*
+ * CHUNK IMPORTS
+ */
+
+-- name: CreateChunkImport :exec
+INSERT INTO chunk_imports
+    (id, chunk_id, flavor_id, version, minecraft_version, min_players,
+     max_players, archive_size_bytes, state, created_at, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: ChunkImportByID :one
+SELECT * FROM chunk_imports WHERE id = $1;
+
+-- name: StartChunkImport :one
+UPDATE chunk_imports SET
+    state = 'IMPORTING',
+    updated_at = $2
+WHERE id = $1 AND state = 'PENDING_UPLOAD'
+RETURNING *;
+
+-- name: UpdateChunkImportState :exec
+UPDATE chunk_imports SET
+    state = $2,
+    error = $3,
+    updated_at = $4
+WHERE id = $1;
+
+-- name: UpdateChunkImportFlavorVersion :exec
+UPDATE chunk_imports SET
+    flavor_version_id = $2,
+    updated_at = $3
+WHERE id = $1;
+
+/*
+ * CHUNK TEMPLATES
+ */
+
+-- name: CreateChunkTemplate :one
+INSERT INTO chunk_templates
+    (id, name, description, flavor_version_id, created_at, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5, $5)
+RETURNING *;
+
+-- name: ChunkTemplateByID :one
+SELECT * FROM chunk_templates WHERE id = $1;
+
+-- name: ChunkTemplateByName :one
+SELECT * FROM chunk_templates WHERE name = $1;
+
+-- name: ChunkTemplates :many
+SELECT * FROM chunk_templates ORDER BY name;
+
+-- name: UpdateChunkTemplate :one
+UPDATE chunk_templates SET
+    description = $2,
+    flavor_version_id = $3,
+    updated_at = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteChunkTemplate :execrows
+DELETE FROM chunk_templates WHERE id = $1;
+
+/*
+ * ANNOUNCEMENTS
+ */
+
+-- name: CreateAnnouncement :exec
+INSERT INTO announcements
+    (id, message, severity, starts_at, ends_at, created_by, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7);
+
+-- severity is an enum, so announcements are ordered by the
+-- position of their severity in the enum definition.
+-- name: ActiveAnnouncements :many
+SELECT * FROM announcements
+WHERE starts_at <= @now AND (ends_at IS NULL OR ends_at > @now)
+ORDER BY severity DESC, starts_at DESC;
+
+-- name: DeleteAnnouncement :execrows
+DELETE FROM announcements WHERE id = $1;
+
+/*
+ * RETENTION POLICIES
+ */
+
+-- name: UpsertRetentionPolicy :one
+INSERT INTO chunk_retention_policies
+    (chunk_id, keep_last_versions, keep_days, updated_at)
+VALUES
+    ($1, $2, $3, $4)
+ON CONFLICT (chunk_id) DO UPDATE SET
+    keep_last_versions = EXCLUDED.keep_last_versions,
+    keep_days = EXCLUDED.keep_days,
+    updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+-- name: RetentionPolicyByChunkID :one
+SELECT * FROM chunk_retention_policies WHERE chunk_id = $1;
+
+-- deleted chunks are archived including all of their
+-- flavor versions, so their policies are skipped.
+-- name: AllRetentionPolicies :many
+SELECT p.* FROM chunk_retention_policies p
+JOIN chunks c ON c.id = p.chunk_id
+WHERE c.deleted_at IS NULL;
+
+/*
+ * CROSS-INSTANCE TRAFFIC
+ */
+
+-- requesting again resets a previous review, so changing
+-- the reason always requires another approval.
+-- name: RequestCrossInstanceTraffic :one
+INSERT INTO chunk_cross_instance_traffic
+    (chunk_id, state, reason, requested_by, created_at, updated_at)
+VALUES
+    ($1, 'REQUESTED', $2, $3, $4, $4)
+ON CONFLICT (chunk_id) DO UPDATE SET
+    state = 'REQUESTED',
+    reason = EXCLUDED.reason,
+    requested_by = EXCLUDED.requested_by,
+    reviewed_by = NULL,
+    review_message = '',
+    updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+-- name: CrossInstanceTrafficByChunkID :one
+SELECT * FROM chunk_cross_instance_traffic WHERE chunk_id = $1;
+
+-- name: ReviewCrossInstanceTraffic :one
+UPDATE chunk_cross_instance_traffic SET
+    state = $2,
+    reviewed_by = $3,
+    review_message = $4,
+    updated_at = $5
+WHERE chunk_id = $1
+RETURNING *;
+
+-- name: DeleteCrossInstanceTraffic :execrows
+DELETE FROM chunk_cross_instance_traffic WHERE chunk_id = $1;
+
+-- name: CrossInstanceTrafficByState :many
+SELECT t.* FROM chunk_cross_instance_traffic t
+JOIN chunks c ON c.id = t.chunk_id
+WHERE t.state = $1 AND c.deleted_at IS NULL
+ORDER BY t.updated_at;
+
+-- name: CrossInstanceTrafficApprovedByFlavorID :one
+SELECT EXISTS(
+    SELECT 1 FROM chunk_cross_instance_traffic t
+    JOIN flavors f ON f.chunk_id = t.chunk_id
+    WHERE f.id = $1 AND t.state = 'APPROVED'
+);
+
+/*
+ * AUTOSCALING
+ */
+
+-- the times of the last scaling decisions are kept,
+-- so replacing a policy does not reset its cooldowns.
+-- name: UpsertAutoscalingPolicy :one
+INSERT INTO autoscaling_policies
+    (flavor_id, min_instances, max_instances, target_utilization,
+     scale_up_cooldown_seconds, scale_down_cooldown_seconds, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (flavor_id) DO UPDATE SET
+    min_instances = EXCLUDED.min_instances,
+    max_instances = EXCLUDED.max_instances,
+    target_utilization = EXCLUDED.target_utilization,
+    scale_up_cooldown_seconds = EXCLUDED.scale_up_cooldown_seconds,
+    scale_down_cooldown_seconds = EXCLUDED.scale_down_cooldown_seconds,
+    updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+-- name: AutoscalingPolicyByFlavorID :one
+SELECT * FROM autoscaling_policies WHERE flavor_id = $1;
+
+-- name: DeleteAutoscalingPolicy :exec
+DELETE FROM autoscaling_policies WHERE flavor_id = $1;
+
+-- policies of deleted flavors or chunks are skipped,
+-- because their instances cannot be run anymore.
+-- name: AutoscalingTargets :many
+SELECT sqlc.embed(p), c.owner_id FROM autoscaling_policies p
+JOIN flavors f ON f.id = p.flavor_id
+JOIN chunks c ON c.id = f.chunk_id
+WHERE f.deleted_at IS NULL AND c.deleted_at IS NULL
+ORDER BY p.flavor_id;
+
+-- players are counted for running instances only, while instances
+-- started by hand are counted until they are being deleted, because
+-- they are going to serve players once they are running.
+-- name: FlavorDemand :one
+SELECT
+    (SELECT count(*) FROM instance_players ip
+        JOIN instances i ON i.id = ip.instance_id
+        JOIN flavor_versions v ON v.id = i.flavor_version_id
+        WHERE v.flavor_id = f.id AND i.state = 'RUNNING')::integer AS players,
+    (SELECT count(*) FROM join_misses m
+        WHERE m.chunk_id = f.chunk_id
+          AND m.created_at >= sqlc.arg('since')
+          AND (m.flavor_name IS NULL OR m.flavor_name = f.name))::integer AS queued_players,
+    (SELECT count(*) FROM instances i
+        JOIN flavor_versions v ON v.id = i.flavor_version_id
+        WHERE v.flavor_id = f.id
+          AND i.state IN ('PENDING', 'CREATING', 'RUNNING')
+          AND NOT EXISTS (SELECT 1 FROM autoscaled_instances a WHERE a.instance_id = i.id))::integer AS manual_instances
+FROM flavors f WHERE f.id = sqlc.arg('flavor_id');
+
+-- name: AutoscaledInstances :many
+SELECT
+    i.id,
+    i.owner_id,
+    i.state,
+    (SELECT count(*) FROM instance_players p WHERE p.instance_id = i.id)::integer AS players
+FROM autoscaled_instances a
+JOIN instances i ON i.id = a.instance_id
+WHERE a.flavor_id = $1 AND i.state IN ('PENDING', 'CREATING', 'RUNNING')
+ORDER BY i.created_at DESC;
+
+-- name: InsertAutoscaledInstance :exec
+INSERT INTO autoscaled_instances (instance_id, flavor_id) VALUES ($1, $2);
+
+-- name: UpdateAutoscalingPolicyScaledAt :exec
+UPDATE autoscaling_policies SET
+    last_scale_up_at = COALESCE(sqlc.narg('last_scale_up_at'), last_scale_up_at),
+    last_scale_down_at = COALESCE(sqlc.narg('last_scale_down_at'), last_scale_down_at)
+WHERE flavor_id = sqlc.arg('flavor_id');
+
+-- the select makes sure misses for chunks that do not
+-- exist are ignored, instead of violating the foreign key.
+-- name: InsertJoinMiss :exec
+INSERT INTO join_misses (chunk_id, flavor_name)
+SELECT s.chunk_id, sqlc.narg('flavor_name') FROM chunk_slugs s WHERE s.slug = sqlc.arg('chunk_slug');
+
+-- name: DeleteJoinMissesBefore :exec
+DELETE FROM join_misses WHERE created_at < $1;
+
+/*
+ * CHUNK READMES AND MEDIA
+ */
+
+-- name: UpsertChunkReadme :one
+INSERT INTO chunk_readmes
+    (chunk_id, markdown, updated_at)
+VALUES
+    ($1, $2, $3)
+ON CONFLICT (chunk_id) DO UPDATE SET
+    markdown = EXCLUDED.markdown,
+    updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+-- name: ChunkReadmeByChunkID :one
+SELECT * FROM chunk_readmes WHERE chunk_id = $1;
+
+-- media is identified by its content, so uploading the
+-- same image again returns the already existing media.
+-- name: CreateChunkMedia :one
+INSERT INTO chunk_media
+    (id, chunk_id, hash, content_type, size_bytes, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (chunk_id, hash) DO UPDATE SET
+    content_type = EXCLUDED.content_type
+RETURNING *;
+
+-- name: ChunkMediaByChunkID :many
+SELECT * FROM chunk_media WHERE chunk_id = $1
+ORDER BY created_at, id;
+
+-- name: ChunkMediaByID :one
+SELECT * FROM chunk_media WHERE id = $1;
+
+-- name: DeleteChunkMedia :execrows
+DELETE FROM chunk_media WHERE id = $1;
+
 /*
  * BLOB STORE
  */
@@ -201,9 +804,10 @@ SELECT * FROM blobs WHERE hash = $1;
 
 -- name: CreateInstance :exec
 INSERT INTO instances
-    (id, flavor_version_id, node_id, state, owner_id, created_at, updated_at, ordered_by)
+    (id, flavor_version_id, node_id, state, owner_id, created_at, updated_at, ordered_by,
+     world_seed, game_mode, custom_params, placement_group, cross_instance_traffic)
 VALUES
-    ($1, $2, $3, $4, $5, $6, $7, $8);
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
 
 -- name: ListInstancesWithPagination :many
 WITH paged_instances AS (
@@ -258,21 +862,342 @@ FROM instances i
     JOIN chunks c ON c.id = f.chunk_id
     JOIN nodes n ON i.node_id = n.id
     JOIN users u ON u.id = i.owner_id
-WHERE i.node_id = $1;
+WHERE i.node_id = $1 AND i.revision > sqlc.arg('after_revision');
+
+-- name: GetInstancesByOwnerID :many
+SELECT
+    sqlc.embed(v),
+    sqlc.embed(c),
+    sqlc.embed(f),
+    sqlc.embed(n),
+    sqlc.embed(u),
+    sqlc.embed(i)
+FROM instances i
+    JOIN flavor_versions v ON i.flavor_version_id = v.id
+    JOIN flavors f ON f.id = v.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN nodes n ON i.node_id = n.id
+    JOIN users u ON u.id = i.owner_id
+WHERE i.owner_id = $1 AND i.revision > sqlc.arg('after_revision')
+ORDER BY i.revision;
 
--- name: BulkUpdateInstanceStateAndPort :batchexec
+-- platformd reports the status of all instances on every sync,
+-- so only assign a new revision if something actually changed.
+-- otherwise every instance would be part of each delta.
+--
+-- rows are only updated if the instance is in one of the given
+-- states, so a stale report cannot overwrite a state change that
+-- happened concurrently. no row is returned in that case.
+--
+-- traffic counters change on almost every report, so they do not
+-- bump the revision and are only overwritten if they were reported.
+-- name: BulkUpdateInstanceStateAndPort :batchone
 UPDATE instances SET
-    state = $1,
-    port = $2,
+    revision = CASE
+        WHEN state IS DISTINCT FROM sqlc.arg('state') OR port IS DISTINCT FROM sqlc.arg('port')
+            THEN nextval('instance_revision_seq')
+        ELSE revision
+    END,
+    state_changed_at = CASE
+        WHEN state IS DISTINCT FROM sqlc.arg('state') THEN now()
+        ELSE state_changed_at
+    END,
+    state = sqlc.arg('state'),
+    port = sqlc.arg('port'),
+    traffic_connections = COALESCE(sqlc.narg('traffic_connections'), traffic_connections),
+    traffic_rx_bytes = COALESCE(sqlc.narg('traffic_rx_bytes'), traffic_rx_bytes),
+    traffic_tx_bytes = COALESCE(sqlc.narg('traffic_tx_bytes'), traffic_tx_bytes),
     updated_at = now()
-WHERE id = $3;
+WHERE id = sqlc.arg('id') AND state::text = ANY(sqlc.arg('from_states')::text[])
+RETURNING id;
 
--- name: BulkDeleteInstances :batchexec
-DELETE FROM instances WHERE id = $1;
+-- records a redirect from the current endpoint of the instance to the
+-- given port, if the port of the instance is about to change. has to be
+-- executed before BulkUpdateInstanceStateAndPort with the same from_states.
+-- name: BulkUpsertInstanceRedirects :batchexec
+INSERT INTO instance_redirects (node_id, from_port, instance_id, to_address, to_port, expires_at)
+SELECT i.node_id, i.port, i.id, n.address, sqlc.arg('to_port'), sqlc.arg('expires_at')
+FROM instances i
+JOIN nodes n ON n.id = i.node_id
+WHERE i.id = sqlc.arg('instance_id')
+  AND i.port IS NOT NULL
+  AND i.port <> sqlc.arg('to_port')
+  AND i.state::text = ANY(sqlc.arg('from_states')::text[])
+ON CONFLICT (node_id, from_port) DO UPDATE SET
+    instance_id = excluded.instance_id,
+    to_address = excluded.to_address,
+    to_port = excluded.to_port,
+    expires_at = excluded.expires_at;
+
+-- name: GetInstanceRedirectsByNodeID :many
+SELECT * FROM instance_redirects
+WHERE node_id = $1 AND expires_at > now()
+ORDER BY from_port;
+
+-- name: DeleteExpiredInstanceRedirects :exec
+DELETE FROM instance_redirects WHERE expires_at <= now();
+
+-- same as BulkUpdateInstanceStateAndPort, but for a single instance
+-- and without touching the port.
+-- name: UpdateInstanceState :one
+UPDATE instances SET
+    revision = CASE
+        WHEN state IS DISTINCT FROM sqlc.arg('state') THEN nextval('instance_revision_seq')
+        ELSE revision
+    END,
+    state_changed_at = CASE
+        WHEN state IS DISTINCT FROM sqlc.arg('state') THEN now()
+        ELSE state_changed_at
+    END,
+    state = sqlc.arg('state'),
+    updated_at = now()
+WHERE id = sqlc.arg('id') AND state::text = ANY(sqlc.arg('from_states')::text[])
+RETURNING id;
+
+-- name: BulkDeleteInstances :batchone
+DELETE FROM instances
+WHERE id = sqlc.arg('id') AND state::text = ANY(sqlc.arg('from_states')::text[])
+RETURNING id, state;
+
+-- moves all instances that are in from_state since before
+-- changed_before to to_state.
+-- name: TransitionStaleInstances :many
+UPDATE instances SET
+    revision = nextval('instance_revision_seq'),
+    state_changed_at = now(),
+    state = sqlc.arg('to_state'),
+    updated_at = now()
+WHERE state = sqlc.arg('from_state') AND state_changed_at < sqlc.arg('changed_before')
+RETURNING id;
+
+-- moves the instances of lost nodes that have not been stopped to LOST.
+-- name: MarkInstancesLost :many
+UPDATE instances i SET
+    revision = nextval('instance_revision_seq'),
+    state_changed_at = now(),
+    state = 'LOST',
+    updated_at = now()
+FROM nodes n
+WHERE n.id = i.node_id
+  AND n.lost_at IS NOT NULL
+  AND i.state IN ('PENDING', 'CREATING', 'RUNNING')
+RETURNING i.id, n.name AS node_name;
+
+-- lost instances are removed once their node returns, because they
+-- could have been replaced in the meantime. platformd stops their
+-- workloads, if they are still running.
+-- name: RemoveLostInstances :exec
+UPDATE instances SET
+    revision = nextval('instance_revision_seq'),
+    state_changed_at = now(),
+    state = 'DELETING',
+    updated_at = now()
+WHERE node_id = $1 AND state = 'LOST';
 
 -- name: CountInstancesByFlavorID :one
 SELECT COUNT(*) FROM instances WHERE flavor_version_id = $1;
 
+-- name: CountInstancesByNodeID :one
+SELECT COUNT(*) FROM instances WHERE node_id = $1;
+
+/*
+ * INSTANCE EVENTS
+ */
+
+-- events are reported at-least-once by platformd, so
+-- duplicates are ignored.
+-- name: BulkInsertInstanceEvents :batchexec
+INSERT INTO instance_events
+    (id, instance_id, type, exit_code, message, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6)
+ON CONFLICT DO NOTHING;
+
+-- name: InsertInstanceEvent :exec
+INSERT INTO instance_events
+    (id, instance_id, type, exit_code, message, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6);
+
+-- only the first player joining is part of the timeline, so
+-- the event is not inserted if the instance already has one.
+-- name: InsertPlayerJoinedEvent :exec
+INSERT INTO instance_events
+    (id, instance_id, type, exit_code, message, created_at)
+SELECT sqlc.arg('id')::uuid, sqlc.arg('instance_id')::uuid, 'PLAYER_JOINED', 0, sqlc.arg('message')::text, now()
+WHERE NOT EXISTS (
+    SELECT 1 FROM instance_events e
+    WHERE e.instance_id = sqlc.arg('instance_id')
+      AND e.type = 'PLAYER_JOINED'
+      AND e.created_at >= sqlc.arg('since')
+);
+
+-- events cannot be older than their instance, so since allows
+-- skipping the partitions of the months before it had been created.
+-- only events describing why the instance stopped are returned.
+-- name: GetInstanceEvents :many
+SELECT * FROM instance_events
+WHERE instance_id = sqlc.arg('instance_id')
+  AND created_at >= sqlc.arg('since')
+  AND type IN ('OOM_KILLED', 'CRASHED', 'EXITED')
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit');
+
+-- name: GetInstanceTimeline :many
+SELECT * FROM instance_events
+WHERE instance_id = sqlc.arg('instance_id') AND created_at >= sqlc.arg('since')
+ORDER BY created_at, id
+LIMIT sqlc.arg('limit');
+
+/*
+ * INSTANCE LOGS
+ */
+
+-- name: InsertInstanceLog :exec
+INSERT INTO instance_logs
+    (id, instance_id, owner_id, object_key, size_bytes, first_line_at, last_line_at, expires_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- logs are retained per instance, so all files of the instance
+-- expire together once it did not log anything for a while.
+-- name: ExtendInstanceLogRetention :exec
+UPDATE instance_logs SET expires_at = $2 WHERE instance_id = $1;
+
+-- name: InstanceLogsByInstanceID :many
+SELECT * FROM instance_logs
+WHERE instance_id = $1
+ORDER BY first_line_at, created_at;
+
+-- name: ExpiredInstanceLogs :many
+SELECT * FROM instance_logs
+WHERE expires_at <= $1
+ORDER BY expires_at
+LIMIT $2;
+
+-- name: DeleteInstanceLogs :exec
+DELETE FROM instance_logs WHERE id = ANY(sqlc.arg('ids')::uuid[]);
+
+/*
+ * INSTANCE INVITES
+ */
+
+-- only the hash of the token is stored, see InstanceInviteByToken.
+-- name: CreateInstanceInvite :one
+INSERT INTO instance_invites
+    (id, instance_id, token_hash, max_uses, created_by, expires_at)
+VALUES
+    (
+        sqlc.arg('id'),
+        sqlc.arg('instance_id'),
+        sha256(convert_to(sqlc.arg('token')::text, 'UTF8')),
+        sqlc.arg('max_uses'),
+        sqlc.arg('created_by'),
+        sqlc.arg('expires_at')
+    )
+RETURNING *;
+
+-- name: InstanceInvitesByInstanceID :many
+SELECT * FROM instance_invites
+WHERE instance_id = $1
+ORDER BY created_at, id;
+
+-- name: InstanceInviteByID :one
+SELECT * FROM instance_invites WHERE id = $1;
+
+-- name: InstanceInviteByToken :one
+SELECT * FROM instance_invites
+WHERE token_hash = sha256(convert_to(sqlc.arg('token')::text, 'UTF8'));
+
+-- name: RevokeInstanceInvite :exec
+UPDATE instance_invites SET revoked_at = now()
+WHERE id = $1 AND revoked_at IS NULL;
+
+-- the conditions are checked again, so concurrent joins
+-- cannot use the invite more often than allowed.
+-- name: UseInstanceInvite :execrows
+UPDATE instance_invites SET uses = uses + 1
+WHERE id = $1
+  AND revoked_at IS NULL
+  AND expires_at > now()
+  AND (max_uses = 0 OR uses < max_uses);
+
+/*
+ * INSTANCE SCHEDULES
+ */
+
+-- name: CreateInstanceSchedule :one
+INSERT INTO instance_schedules
+    (id, chunk_id, flavor_version_id, start_cron, stop_cron, timezone, evaluated_at, created_by)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: InstanceSchedulesByChunkID :many
+SELECT * FROM instance_schedules
+WHERE chunk_id = $1
+ORDER BY created_at, id;
+
+-- name: InstanceScheduleByID :one
+SELECT * FROM instance_schedules WHERE id = $1;
+
+-- name: DeleteInstanceSchedule :exec
+DELETE FROM instance_schedules WHERE id = $1;
+
+-- schedules of deleted chunks or flavors are skipped,
+-- because their instances cannot be run anymore.
+-- name: ActiveInstanceSchedules :many
+SELECT sqlc.embed(s), c.owner_id FROM instance_schedules s
+JOIN chunks c ON c.id = s.chunk_id
+JOIN flavor_versions v ON v.id = s.flavor_version_id
+JOIN flavors f ON f.id = v.flavor_id
+WHERE c.deleted_at IS NULL AND f.deleted_at IS NULL
+ORDER BY s.id;
+
+-- name: MarkInstanceScheduleEvaluated :exec
+UPDATE instance_schedules SET evaluated_at = $2 WHERE id = $1;
+
+-- name: RecordInstanceScheduleRun :exec
+UPDATE instance_schedules
+SET evaluated_at = sqlc.arg('evaluated_at'), instance_id = sqlc.narg('instance_id')
+WHERE id = sqlc.arg('id');
+
+/*
+ * INSTANCE USAGE
+ */
+
+-- copies the resources and slots of the node the instance is scheduled to,
+-- so the usage does not change if the node is reconfigured later on.
+-- name: CreateInstanceUsage :exec
+INSERT INTO instance_usage
+    (instance_id, chunk_id, node_id, cpu_millis, memory_bytes, node_slots, started_at)
+SELECT
+    sqlc.arg('instance_id'),
+    f.chunk_id,
+    n.id,
+    n.workload_cpu_millis,
+    n.workload_memory_bytes,
+    LEAST(n.slots, COALESCE(n.max_instances, n.slots)),
+    sqlc.arg('started_at')
+FROM flavor_versions v
+JOIN flavors f ON f.id = v.flavor_id
+JOIN nodes n ON n.id = sqlc.arg('node_id')
+WHERE v.id = sqlc.arg('flavor_version_id');
+
+-- name: StopInstanceUsage :exec
+UPDATE instance_usage SET stopped_at = now()
+WHERE instance_id = ANY(sqlc.arg('instance_ids')::uuid[]) AND stopped_at IS NULL;
+
+-- returns the usage of all instances that ran between start and end.
+-- a null chunk id returns the usage of all chunks.
+-- name: InstanceUsage :many
+SELECT u.*, c.name AS chunk_name FROM instance_usage u
+JOIN chunks c ON c.id = u.chunk_id
+WHERE u.started_at < sqlc.arg('end_at')
+    AND (u.stopped_at IS NULL OR u.stopped_at > sqlc.arg('start_at'))
+    AND (sqlc.narg('chunk_id')::uuid IS NULL OR u.chunk_id = sqlc.narg('chunk_id')::uuid)
+ORDER BY u.started_at, u.instance_id;
+
 /*
  * MINECRAFT VERSIONS
  */
@@ -283,6 +1208,23 @@ SELECT version FROM minecraft_versions;
 -- name: GetMinecraftVersionByVersionName :one
 SELECT * FROM minecraft_versions WHERE version = $1;
 
+-- name: CreateMinecraftVersion :exec
+INSERT INTO minecraft_versions
+    (version, image_url)
+VALUES
+    ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: MinecraftVersionJavaImages :many
+SELECT * FROM minecraft_version_java_images WHERE minecraft_version = $1;
+
+-- name: CreateMinecraftVersionJavaImage :exec
+INSERT INTO minecraft_version_java_images
+    (minecraft_version, java_version, image_url)
+VALUES
+    ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
 /*
  * USERS
  */
@@ -296,6 +1238,167 @@ INSERT INTO users
 VALUES
     ($1, $2, $3, $4, $5);
 
+-- name: UserByID :one
+SELECT * FROM users WHERE id = $1;
+
+-- name: AnonymizeUser :exec
+UPDATE users SET
+    nickname = $1,
+    email = $2,
+    updated_at = now()
+WHERE id = $3;
+
+-- name: ChunkIDsByOwnerID :many
+SELECT id FROM chunks WHERE owner_id = $1 ORDER BY id;
+
+-- name: TransferChunks :exec
+UPDATE chunks SET
+    owner_id = sqlc.arg('new_owner_id'),
+    updated_at = now()
+WHERE owner_id = sqlc.arg('owner_id');
+
+-- name: MarkChunksDeletedByOwnerID :exec
+UPDATE chunks SET deleted_at = now()
+WHERE owner_id = $1 AND deleted_at IS NULL;
+
+-- name: MarkFlavorsDeletedByOwnerID :exec
+UPDATE flavors f SET deleted_at = now()
+FROM chunks c
+WHERE c.id = f.chunk_id AND c.owner_id = $1 AND f.deleted_at IS NULL;
+
+-- name: DeleteArchivedChunksByOwnerID :exec
+DELETE FROM chunk_archive WHERE owner_id = $1;
+
+-- name: InstanceIDsByOwnerID :many
+SELECT id FROM instances WHERE owner_id = $1 ORDER BY id;
+
+-- events are timestamped by nodes, whose clocks might be off, so a
+-- day of slack is given when limiting them to the partitions after
+-- the creation of the instance.
+-- name: InstanceEventsByOwnerID :many
+SELECT e.* FROM instance_events e
+    JOIN instances i ON i.id = e.instance_id
+WHERE i.owner_id = $1 AND e.created_at >= i.created_at - interval '1 day'
+ORDER BY e.created_at;
+
+-- name: DeleteNotificationPreferences :exec
+DELETE FROM notification_preferences WHERE user_id = $1;
+
+-- name: NotificationPreferences :one
+SELECT * FROM notification_preferences WHERE user_id = $1;
+
+-- name: UpsertNotificationPreferences :exec
+INSERT INTO notification_preferences
+    (user_id, build_completed, build_failed, instance_crashed, updated_at)
+VALUES
+    ($1, $2, $3, $4, now())
+ON CONFLICT (user_id) DO UPDATE SET
+    build_completed = EXCLUDED.build_completed,
+    build_failed = EXCLUDED.build_failed,
+    instance_crashed = EXCLUDED.instance_crashed,
+    updated_at = now();
+
+-- name: RevokeToken :exec
+INSERT INTO revoked_tokens
+    (id, user_id, expires_at)
+VALUES
+    ($1, $2, $3)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: DeleteExpiredRevokedTokens :exec
+DELETE FROM revoked_tokens WHERE expires_at < now();
+
+-- name: RevokeUserTokens :exec
+INSERT INTO user_token_revocations
+    (user_id, revoked_before)
+VALUES
+    ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET
+    revoked_before = GREATEST(user_token_revocations.revoked_before, EXCLUDED.revoked_before);
+
+-- name: TokenRevoked :one
+SELECT (
+    EXISTS(SELECT 1 FROM revoked_tokens WHERE id = @token_id)
+    OR EXISTS(
+        SELECT 1 FROM user_token_revocations utr
+        WHERE utr.user_id = @user_id AND utr.revoked_before > @issued_at
+    )
+)::boolean AS revoked;
+
+/*
+ * IMPERSONATIONS
+ */
+
+-- name: CreateImpersonation :one
+INSERT INTO impersonations
+    (id, user_id, admin_id, reason, created_at, expires_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: InsertImpersonatedAction :exec
+INSERT INTO impersonated_actions (impersonation_id, method, created_at) VALUES ($1, $2, $3);
+
+-- name: ImpersonationsByUserID :many
+SELECT * FROM impersonations WHERE user_id = $1 ORDER BY created_at DESC;
+
+-- name: ImpersonatedActionsByUserID :many
+SELECT a.* FROM impersonated_actions a
+JOIN impersonations i ON i.id = a.impersonation_id
+WHERE i.user_id = $1 AND a.created_at >= i.created_at
+ORDER BY a.created_at;
+
+/*
+ * SIGNING KEYS
+ */
+
+-- name: SigningKeys :many
+SELECT * FROM signing_keys ORDER BY created_at;
+
+-- name: CreateSigningKey :exec
+INSERT INTO signing_keys
+    (id, private_key, created_at)
+VALUES
+    ($1, $2, $3);
+
+-- name: DeleteSigningKeys :exec
+DELETE FROM signing_keys WHERE id = ANY(@ids::text[]);
+
+/*
+ * NOTIFICATIONS
+ */
+
+-- name: BuildNotificationData :one
+SELECT
+    u.email,
+    c.name AS chunk_name,
+    f.name AS flavor_name,
+    fv.version,
+    fv.build_error,
+    COALESCE(np.build_completed, false)::boolean AS build_completed,
+    COALESCE(np.build_failed, false)::boolean AS build_failed
+FROM flavor_versions fv
+    JOIN flavors f ON f.id = fv.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN users u ON u.id = c.owner_id
+    LEFT JOIN notification_preferences np ON np.user_id = u.id
+WHERE fv.id = $1;
+
+-- name: InstanceNotificationData :one
+SELECT
+    u.email,
+    c.name AS chunk_name,
+    f.name AS flavor_name,
+    fv.version,
+    COALESCE(np.instance_crashed, false)::boolean AS instance_crashed
+FROM instances i
+    JOIN flavor_versions fv ON fv.id = i.flavor_version_id
+    JOIN flavors f ON f.id = fv.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN users u ON u.id = i.owner_id
+    LEFT JOIN notification_preferences np ON np.user_id = u.id
+WHERE i.id = $1;
+
 /*
  * ARCHIVE
  */
@@ -317,3 +1420,112 @@ INSERT INTO flavor_version_archive
     (id, flavor_id, data, created_at)
 VALUES
     ($1, $2, $3, $4);
+
+/*
+ * MAINTENANCE
+ */
+
+-- name: MaintenanceMode :one
+SELECT * FROM maintenance_mode;
+
+-- name: SetMaintenanceMode :one
+INSERT INTO maintenance_mode
+    (enabled, message, updated_by, updated_at)
+VALUES
+    ($1, $2, $3, now())
+ON CONFLICT (id) DO UPDATE SET
+    enabled = EXCLUDED.enabled,
+    message = EXCLUDED.message,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+/*
+ * RUNTIME SETTINGS
+ */
+
+-- name: RuntimeSettings :many
+SELECT * FROM runtime_settings;
+
+-- locks the setting, so concurrent changes record the correct old value.
+-- name: RuntimeSettingForUpdate :one
+SELECT value FROM runtime_settings WHERE name = $1 FOR UPDATE;
+
+-- name: UpsertRuntimeSetting :exec
+INSERT INTO runtime_settings
+    (name, value, updated_by, updated_at)
+VALUES
+    ($1, $2, $3, now())
+ON CONFLICT (name) DO UPDATE SET
+    value = EXCLUDED.value,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: InsertRuntimeSettingChange :exec
+INSERT INTO runtime_setting_changes
+    (id, setting, old_value, new_value, actor_id, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, now());
+
+-- name: RuntimeSettingChanges :many
+SELECT * FROM runtime_setting_changes
+ORDER BY created_at DESC, id DESC
+LIMIT $1;
+
+/*
+ * INTEGRATION
+ */
+
+-- returns running instances together with the number of players
+-- connected to them. chunk_slug, flavor_name and instance_id are optional filters.
+-- name: IntegrationBackends :many
+SELECT
+    i.id AS instance_id,
+    i.port,
+    n.address,
+    c.id AS chunk_id,
+    c.name AS chunk_name,
+    f.name AS flavor_name,
+    v.max_players,
+    (SELECT count(*) FROM instance_players p WHERE p.instance_id = i.id)::integer AS players
+FROM instances i
+    JOIN flavor_versions v ON i.flavor_version_id = v.id
+    JOIN flavors f ON f.id = v.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN nodes n ON i.node_id = n.id
+WHERE i.state = 'RUNNING'
+  AND i.port IS NOT NULL
+  AND (
+      sqlc.narg('chunk_slug')::text IS NULL OR
+      c.id = (SELECT s.chunk_id FROM chunk_slugs s WHERE s.slug = sqlc.narg('chunk_slug')::text)
+  )
+  AND (sqlc.narg('flavor_name')::text IS NULL OR f.name = sqlc.narg('flavor_name')::text)
+  AND (sqlc.narg('instance_id')::uuid IS NULL OR i.id = sqlc.narg('instance_id')::uuid)
+ORDER BY i.id;
+
+-- the select makes sure joins for instances that do not exist
+-- (anymore) are ignored, instead of violating the foreign key.
+-- name: InsertInstancePlayer :exec
+INSERT INTO instance_players (instance_id, player_id)
+SELECT id, sqlc.arg('player_id') FROM instances WHERE id = sqlc.arg('instance_id')
+ON CONFLICT (instance_id, player_id) DO NOTHING;
+
+-- name: DeleteInstancePlayer :exec
+DELETE FROM instance_players WHERE instance_id = $1 AND player_id = $2;
+
+/*
+ * LOCKS
+ */
+
+-- name: TryAdvisoryXactLock :one
+SELECT pg_try_advisory_xact_lock(sqlc.arg('namespace')::int, hashtext(sqlc.arg('key')::text))::boolean AS locked;
+
+/*
+ * PARTITIONS
+ */
+
+-- name: CreateMonthlyPartition :exec
+SELECT create_monthly_partition(sqlc.arg('parent_table'), sqlc.arg('month_of'));
+
+-- name: DropMonthlyPartitionsBefore :many
+SELECT drop_monthly_partitions_before(sqlc.arg('parent_table'), sqlc.arg('cutoff'))::text AS name;
@@ -8,6 +8,7 @@ package query
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 )
@@ -16,8 +17,10 @@ var (
 	ErrBatchAlreadyClosed = errors.New("batch already closed")
 )
 
-const bulkDeleteInstances = `-- name: BulkDeleteInstances :batchexec
-DELETE FROM instances WHERE id = $1
+const bulkDeleteInstances = `-- name: BulkDeleteInstances :batchone
+DELETE FROM instances
+WHERE id = $1 AND state::text = ANY($2::text[])
+RETURNING id, state
 `
 
 type BulkDeleteInstancesBatchResults struct {
@@ -26,30 +29,43 @@ type BulkDeleteInstancesBatchResults struct {
 	closed bool
 }
 
-func (q *Queries) BulkDeleteInstances(ctx context.Context, id []string) *BulkDeleteInstancesBatchResults {
+type BulkDeleteInstancesParams struct {
+	ID         string
+	FromStates []string
+}
+
+type BulkDeleteInstancesRow struct {
+	ID    string
+	State InstanceState
+}
+
+func (q *Queries) BulkDeleteInstances(ctx context.Context, arg []BulkDeleteInstancesParams) *BulkDeleteInstancesBatchResults {
 	batch := &pgx.Batch{}
-	for _, a := range id {
+	for _, a := range arg {
 		vals := []interface{}{
-			a,
+			a.ID,
+			a.FromStates,
 		}
 		batch.Queue(bulkDeleteInstances, vals...)
 	}
 	br := q.db.SendBatch(ctx, batch)
-	return &BulkDeleteInstancesBatchResults{br, len(id), false}
+	return &BulkDeleteInstancesBatchResults{br, len(arg), false}
 }
 
-func (b *BulkDeleteInstancesBatchResults) Exec(f func(int, error)) {
+func (b *BulkDeleteInstancesBatchResults) QueryRow(f func(int, BulkDeleteInstancesRow, error)) {
 	defer b.br.Close()
 	for t := 0; t < b.tot; t++ {
+		var i BulkDeleteInstancesRow
 		if b.closed {
 			if f != nil {
-				f(t, ErrBatchAlreadyClosed)
+				f(t, i, ErrBatchAlreadyClosed)
 			}
 			continue
 		}
-		_, err := b.br.Exec()
+		row := b.br.QueryRow()
+		err := row.Scan(&i.ID, &i.State)
 		if f != nil {
-			f(t, err)
+			f(t, i, err)
 		}
 	}
 }
@@ -175,9 +191,9 @@ func (b *BulkInsertBlobDataBatchResults) Close() error {
 
 const bulkInsertFlavorFileHashes = `-- name: BulkInsertFlavorFileHashes :batchexec
 INSERT INTO flavor_version_files
-    (flavor_version_id, file_hash, file_path)
+    (flavor_version_id, file_hash, file_path, size_bytes)
 VALUES
-    ($1, $2, $3)
+    ($1, $2, $3, $4)
 `
 
 type BulkInsertFlavorFileHashesBatchResults struct {
@@ -190,6 +206,7 @@ type BulkInsertFlavorFileHashesParams struct {
 	FlavorVersionID string
 	FileHash        string
 	FilePath        string
+	SizeBytes       int64
 }
 
 func (q *Queries) BulkInsertFlavorFileHashes(ctx context.Context, arg []BulkInsertFlavorFileHashesParams) *BulkInsertFlavorFileHashesBatchResults {
@@ -199,6 +216,7 @@ func (q *Queries) BulkInsertFlavorFileHashes(ctx context.Context, arg []BulkInse
 			a.FlavorVersionID,
 			a.FileHash,
 			a.FilePath,
+			a.SizeBytes,
 		}
 		batch.Queue(bulkInsertFlavorFileHashes, vals...)
 	}
@@ -227,12 +245,146 @@ func (b *BulkInsertFlavorFileHashesBatchResults) Close() error {
 	return b.br.Close()
 }
 
-const bulkUpdateInstanceStateAndPort = `-- name: BulkUpdateInstanceStateAndPort :batchexec
+const bulkInsertFlavorVersionAssets = `-- name: BulkInsertFlavorVersionAssets :batchexec
+INSERT INTO flavor_version_assets
+    (flavor_version_id, asset_hash, position)
+VALUES
+    ($1, $2, $3)
+`
+
+type BulkInsertFlavorVersionAssetsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BulkInsertFlavorVersionAssetsParams struct {
+	FlavorVersionID string
+	AssetHash       string
+	Position        int16
+}
+
+func (q *Queries) BulkInsertFlavorVersionAssets(ctx context.Context, arg []BulkInsertFlavorVersionAssetsParams) *BulkInsertFlavorVersionAssetsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.FlavorVersionID,
+			a.AssetHash,
+			a.Position,
+		}
+		batch.Queue(bulkInsertFlavorVersionAssets, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BulkInsertFlavorVersionAssetsBatchResults{br, len(arg), false}
+}
+
+func (b *BulkInsertFlavorVersionAssetsBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *BulkInsertFlavorVersionAssetsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const bulkInsertInstanceEvents = `-- name: BulkInsertInstanceEvents :batchexec
+/*
+ * INSTANCE EVENTS
+ */
+
+INSERT INTO instance_events
+    (id, instance_id, type, exit_code, message, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6)
+ON CONFLICT DO NOTHING
+`
+
+type BulkInsertInstanceEventsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BulkInsertInstanceEventsParams struct {
+	ID         string
+	InstanceID string
+	Type       InstanceEventType
+	ExitCode   int32
+	Message    string
+	CreatedAt  time.Time
+}
+
+// events are reported at-least-once by platformd, so
+// duplicates are ignored.
+func (q *Queries) BulkInsertInstanceEvents(ctx context.Context, arg []BulkInsertInstanceEventsParams) *BulkInsertInstanceEventsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.ID,
+			a.InstanceID,
+			a.Type,
+			a.ExitCode,
+			a.Message,
+			a.CreatedAt,
+		}
+		batch.Queue(bulkInsertInstanceEvents, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BulkInsertInstanceEventsBatchResults{br, len(arg), false}
+}
+
+func (b *BulkInsertInstanceEventsBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *BulkInsertInstanceEventsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const bulkUpdateInstanceStateAndPort = `-- name: BulkUpdateInstanceStateAndPort :batchone
 UPDATE instances SET
+    revision = CASE
+        WHEN state IS DISTINCT FROM $1 OR port IS DISTINCT FROM $2
+            THEN nextval('instance_revision_seq')
+        ELSE revision
+    END,
+    state_changed_at = CASE
+        WHEN state IS DISTINCT FROM $1 THEN now()
+        ELSE state_changed_at
+    END,
     state = $1,
     port = $2,
+    traffic_connections = COALESCE($3, traffic_connections),
+    traffic_rx_bytes = COALESCE($4, traffic_rx_bytes),
+    traffic_tx_bytes = COALESCE($5, traffic_tx_bytes),
     updated_at = now()
-WHERE id = $3
+WHERE id = $6 AND state::text = ANY($7::text[])
+RETURNING id
 `
 
 type BulkUpdateInstanceStateAndPortBatchResults struct {
@@ -242,18 +394,36 @@ type BulkUpdateInstanceStateAndPortBatchResults struct {
 }
 
 type BulkUpdateInstanceStateAndPortParams struct {
-	State InstanceState
-	Port  *int32
-	ID    string
+	State              InstanceState
+	Port               *int32
+	TrafficConnections *int64
+	TrafficRxBytes     *int64
+	TrafficTxBytes     *int64
+	ID                 string
+	FromStates         []string
 }
 
+// platformd reports the status of all instances on every sync,
+// so only assign a new revision if something actually changed.
+// otherwise every instance would be part of each delta.
+//
+// rows are only updated if the instance is in one of the given
+// states, so a stale report cannot overwrite a state change that
+// happened concurrently. no row is returned in that case.
+//
+// traffic counters change on almost every report, so they do not
+// bump the revision and are only overwritten if they were reported.
 func (q *Queries) BulkUpdateInstanceStateAndPort(ctx context.Context, arg []BulkUpdateInstanceStateAndPortParams) *BulkUpdateInstanceStateAndPortBatchResults {
 	batch := &pgx.Batch{}
 	for _, a := range arg {
 		vals := []interface{}{
 			a.State,
 			a.Port,
+			a.TrafficConnections,
+			a.TrafficRxBytes,
+			a.TrafficTxBytes,
 			a.ID,
+			a.FromStates,
 		}
 		batch.Queue(bulkUpdateInstanceStateAndPort, vals...)
 	}
@@ -261,7 +431,77 @@ func (q *Queries) BulkUpdateInstanceStateAndPort(ctx context.Context, arg []Bulk
 	return &BulkUpdateInstanceStateAndPortBatchResults{br, len(arg), false}
 }
 
-func (b *BulkUpdateInstanceStateAndPortBatchResults) Exec(f func(int, error)) {
+func (b *BulkUpdateInstanceStateAndPortBatchResults) QueryRow(f func(int, string, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var id string
+		if b.closed {
+			if f != nil {
+				f(t, id, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(&id)
+		if f != nil {
+			f(t, id, err)
+		}
+	}
+}
+
+func (b *BulkUpdateInstanceStateAndPortBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const bulkUpsertInstanceRedirects = `-- name: BulkUpsertInstanceRedirects :batchexec
+INSERT INTO instance_redirects (node_id, from_port, instance_id, to_address, to_port, expires_at)
+SELECT i.node_id, i.port, i.id, n.address, $1, $2
+FROM instances i
+JOIN nodes n ON n.id = i.node_id
+WHERE i.id = $3
+  AND i.port IS NOT NULL
+  AND i.port <> $1
+  AND i.state::text = ANY($4::text[])
+ON CONFLICT (node_id, from_port) DO UPDATE SET
+    instance_id = excluded.instance_id,
+    to_address = excluded.to_address,
+    to_port = excluded.to_port,
+    expires_at = excluded.expires_at
+`
+
+type BulkUpsertInstanceRedirectsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BulkUpsertInstanceRedirectsParams struct {
+	ToPort     int32
+	ExpiresAt  time.Time
+	InstanceID string
+	FromStates []string
+}
+
+// records a redirect from the current endpoint of the instance to the
+// given port, if the port of the instance is about to change. has to be
+// executed before BulkUpdateInstanceStateAndPort with the same from_states.
+func (q *Queries) BulkUpsertInstanceRedirects(ctx context.Context, arg []BulkUpsertInstanceRedirectsParams) *BulkUpsertInstanceRedirectsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.ToPort,
+			a.ExpiresAt,
+			a.InstanceID,
+			a.FromStates,
+		}
+		batch.Queue(bulkUpsertInstanceRedirects, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BulkUpsertInstanceRedirectsBatchResults{br, len(arg), false}
+}
+
+func (b *BulkUpsertInstanceRedirectsBatchResults) Exec(f func(int, error)) {
 	defer b.br.Close()
 	for t := 0; t < b.tot; t++ {
 		if b.closed {
@@ -277,7 +517,7 @@ func (b *BulkUpdateInstanceStateAndPortBatchResults) Exec(f func(int, error)) {
 	}
 }
 
-func (b *BulkUpdateInstanceStateAndPortBatchResults) Close() error {
+func (b *BulkUpsertInstanceRedirectsBatchResults) Close() error {
 	b.closed = true
 	return b.br.Close()
 }
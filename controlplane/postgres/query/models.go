@@ -13,6 +13,49 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AnnouncementSeverity string
+
+const (
+	AnnouncementSeverityINFO     AnnouncementSeverity = "INFO"
+	AnnouncementSeverityWARNING  AnnouncementSeverity = "WARNING"
+	AnnouncementSeverityCRITICAL AnnouncementSeverity = "CRITICAL"
+)
+
+func (e *AnnouncementSeverity) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AnnouncementSeverity(s)
+	case string:
+		*e = AnnouncementSeverity(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AnnouncementSeverity: %T", src)
+	}
+	return nil
+}
+
+type NullAnnouncementSeverity struct {
+	AnnouncementSeverity AnnouncementSeverity
+	Valid                bool // Valid is true if AnnouncementSeverity is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAnnouncementSeverity) Scan(value interface{}) error {
+	if value == nil {
+		ns.AnnouncementSeverity, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AnnouncementSeverity.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAnnouncementSeverity) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AnnouncementSeverity), nil
+}
+
 type BuildStatus string
 
 const (
@@ -59,6 +102,186 @@ func (ns NullBuildStatus) Value() (driver.Value, error) {
 	return string(ns.BuildStatus), nil
 }
 
+type CanaryState string
+
+const (
+	CanaryStateACTIVE   CanaryState = "ACTIVE"
+	CanaryStatePROMOTED CanaryState = "PROMOTED"
+	CanaryStateABORTED  CanaryState = "ABORTED"
+)
+
+func (e *CanaryState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CanaryState(s)
+	case string:
+		*e = CanaryState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CanaryState: %T", src)
+	}
+	return nil
+}
+
+type NullCanaryState struct {
+	CanaryState CanaryState
+	Valid       bool // Valid is true if CanaryState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCanaryState) Scan(value interface{}) error {
+	if value == nil {
+		ns.CanaryState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CanaryState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCanaryState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CanaryState), nil
+}
+
+type ChunkImportState string
+
+const (
+	ChunkImportStatePENDINGUPLOAD ChunkImportState = "PENDING_UPLOAD"
+	ChunkImportStateIMPORTING     ChunkImportState = "IMPORTING"
+	ChunkImportStateCOMPLETED     ChunkImportState = "COMPLETED"
+	ChunkImportStateFAILED        ChunkImportState = "FAILED"
+)
+
+func (e *ChunkImportState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ChunkImportState(s)
+	case string:
+		*e = ChunkImportState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ChunkImportState: %T", src)
+	}
+	return nil
+}
+
+type NullChunkImportState struct {
+	ChunkImportState ChunkImportState
+	Valid            bool // Valid is true if ChunkImportState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullChunkImportState) Scan(value interface{}) error {
+	if value == nil {
+		ns.ChunkImportState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ChunkImportState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullChunkImportState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ChunkImportState), nil
+}
+
+type CrossInstanceTrafficState string
+
+const (
+	CrossInstanceTrafficStateREQUESTED CrossInstanceTrafficState = "REQUESTED"
+	CrossInstanceTrafficStateAPPROVED  CrossInstanceTrafficState = "APPROVED"
+	CrossInstanceTrafficStateREJECTED  CrossInstanceTrafficState = "REJECTED"
+)
+
+func (e *CrossInstanceTrafficState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CrossInstanceTrafficState(s)
+	case string:
+		*e = CrossInstanceTrafficState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CrossInstanceTrafficState: %T", src)
+	}
+	return nil
+}
+
+type NullCrossInstanceTrafficState struct {
+	CrossInstanceTrafficState CrossInstanceTrafficState
+	Valid                     bool // Valid is true if CrossInstanceTrafficState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCrossInstanceTrafficState) Scan(value interface{}) error {
+	if value == nil {
+		ns.CrossInstanceTrafficState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CrossInstanceTrafficState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCrossInstanceTrafficState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CrossInstanceTrafficState), nil
+}
+
+type InstanceEventType string
+
+const (
+	InstanceEventTypeOOMKILLED        InstanceEventType = "OOM_KILLED"
+	InstanceEventTypeCRASHED          InstanceEventType = "CRASHED"
+	InstanceEventTypeEXITED           InstanceEventType = "EXITED"
+	InstanceEventTypeSCHEDULED        InstanceEventType = "SCHEDULED"
+	InstanceEventTypeIMAGEPULLED      InstanceEventType = "IMAGE_PULLED"
+	InstanceEventTypeCONTAINERSTARTED InstanceEventType = "CONTAINER_STARTED"
+	InstanceEventTypeREADY            InstanceEventType = "READY"
+	InstanceEventTypePLAYERJOINED     InstanceEventType = "PLAYER_JOINED"
+	InstanceEventTypeREMOVED          InstanceEventType = "REMOVED"
+	InstanceEventTypeNODELOST         InstanceEventType = "NODE_LOST"
+)
+
+func (e *InstanceEventType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InstanceEventType(s)
+	case string:
+		*e = InstanceEventType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InstanceEventType: %T", src)
+	}
+	return nil
+}
+
+type NullInstanceEventType struct {
+	InstanceEventType InstanceEventType
+	Valid             bool // Valid is true if InstanceEventType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInstanceEventType) Scan(value interface{}) error {
+	if value == nil {
+		ns.InstanceEventType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InstanceEventType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInstanceEventType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InstanceEventType), nil
+}
+
 type InstanceState string
 
 const (
@@ -68,6 +291,7 @@ const (
 	InstanceStateDELETING       InstanceState = "DELETING"
 	InstanceStateDELETED        InstanceState = "DELETED"
 	InstanceStateCREATIONFAILED InstanceState = "CREATION_FAILED"
+	InstanceStateLOST           InstanceState = "LOST"
 )
 
 func (e *InstanceState) Scan(src interface{}) error {
@@ -105,6 +329,180 @@ func (ns NullInstanceState) Value() (driver.Value, error) {
 	return string(ns.InstanceState), nil
 }
 
+type NodeConditionType string
+
+const (
+	NodeConditionTypeDISKPRESSURE       NodeConditionType = "DISK_PRESSURE"
+	NodeConditionTypeCRIUNRESPONSIVE    NodeConditionType = "CRI_UNRESPONSIVE"
+	NodeConditionTypeBPFMAPFULL         NodeConditionType = "BPF_MAP_FULL"
+	NodeConditionTypeCONNTRACKEXHAUSTED NodeConditionType = "CONNTRACK_EXHAUSTED"
+)
+
+func (e *NodeConditionType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NodeConditionType(s)
+	case string:
+		*e = NodeConditionType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NodeConditionType: %T", src)
+	}
+	return nil
+}
+
+type NullNodeConditionType struct {
+	NodeConditionType NodeConditionType
+	Valid             bool // Valid is true if NodeConditionType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNodeConditionType) Scan(value interface{}) error {
+	if value == nil {
+		ns.NodeConditionType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NodeConditionType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNodeConditionType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NodeConditionType), nil
+}
+
+type NodeEventType string
+
+const (
+	NodeEventTypeCONDITIONRAISED  NodeEventType = "CONDITION_RAISED"
+	NodeEventTypeCONDITIONCLEARED NodeEventType = "CONDITION_CLEARED"
+)
+
+func (e *NodeEventType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NodeEventType(s)
+	case string:
+		*e = NodeEventType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NodeEventType: %T", src)
+	}
+	return nil
+}
+
+type NullNodeEventType struct {
+	NodeEventType NodeEventType
+	Valid         bool // Valid is true if NodeEventType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNodeEventType) Scan(value interface{}) error {
+	if value == nil {
+		ns.NodeEventType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NodeEventType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNodeEventType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NodeEventType), nil
+}
+
+type PlatformdUpdateStatus string
+
+const (
+	PlatformdUpdateStatusPENDING     PlatformdUpdateStatus = "PENDING"
+	PlatformdUpdateStatusDOWNLOADING PlatformdUpdateStatus = "DOWNLOADING"
+	PlatformdUpdateStatusINSTALLING  PlatformdUpdateStatus = "INSTALLING"
+	PlatformdUpdateStatusSUCCEEDED   PlatformdUpdateStatus = "SUCCEEDED"
+	PlatformdUpdateStatusFAILED      PlatformdUpdateStatus = "FAILED"
+)
+
+func (e *PlatformdUpdateStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PlatformdUpdateStatus(s)
+	case string:
+		*e = PlatformdUpdateStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PlatformdUpdateStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPlatformdUpdateStatus struct {
+	PlatformdUpdateStatus PlatformdUpdateStatus
+	Valid                 bool // Valid is true if PlatformdUpdateStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPlatformdUpdateStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PlatformdUpdateStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PlatformdUpdateStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPlatformdUpdateStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PlatformdUpdateStatus), nil
+}
+
+type RestartPolicy string
+
+const (
+	RestartPolicyNEVER     RestartPolicy = "NEVER"
+	RestartPolicyALWAYS    RestartPolicy = "ALWAYS"
+	RestartPolicyONFAILURE RestartPolicy = "ON_FAILURE"
+)
+
+func (e *RestartPolicy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RestartPolicy(s)
+	case string:
+		*e = RestartPolicy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RestartPolicy: %T", src)
+	}
+	return nil
+}
+
+type NullRestartPolicy struct {
+	RestartPolicy RestartPolicy
+	Valid         bool // Valid is true if RestartPolicy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRestartPolicy) Scan(value interface{}) error {
+	if value == nil {
+		ns.RestartPolicy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RestartPolicy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRestartPolicy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RestartPolicy), nil
+}
+
 type RiverJobState string
 
 const (
@@ -153,12 +551,63 @@ func (ns NullRiverJobState) Value() (driver.Value, error) {
 	return string(ns.RiverJobState), nil
 }
 
+type Announcement struct {
+	ID        string
+	Message   string
+	Severity  AnnouncementSeverity
+	StartsAt  time.Time
+	EndsAt    pgtype.Timestamptz
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+type Asset struct {
+	Hash      string
+	SizeBytes int64
+	CreatedAt time.Time
+}
+
+type AutoscaledInstance struct {
+	InstanceID string
+	FlavorID   string
+}
+
+type AutoscalingPolicy struct {
+	FlavorID                 string
+	MinInstances             int32
+	MaxInstances             int32
+	TargetUtilization        int32
+	ScaleUpCooldownSeconds   int32
+	ScaleDownCooldownSeconds int32
+	LastScaleUpAt            pgtype.Timestamptz
+	LastScaleDownAt          pgtype.Timestamptz
+	UpdatedAt                time.Time
+}
+
 type Blob struct {
 	Hash      string
 	Data      []byte
 	CreatedAt time.Time
 }
 
+type Canary struct {
+	ID              string
+	FlavorID        string
+	StableVersionID string
+	CanaryVersionID string
+	Percentage      int16
+	State           CanaryState
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type CanaryInstance struct {
+	InstanceID string
+	CanaryID   string
+	Canary     bool
+	CreatedAt  time.Time
+}
+
 type Chunk struct {
 	ID                 string
 	Name               string
@@ -179,6 +628,70 @@ type ChunkArchive struct {
 	CreatedAt time.Time
 }
 
+type ChunkCrossInstanceTraffic struct {
+	ChunkID       string
+	State         CrossInstanceTrafficState
+	Reason        string
+	RequestedBy   string
+	ReviewedBy    *string
+	ReviewMessage string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type ChunkImport struct {
+	ID               string
+	ChunkID          string
+	FlavorID         string
+	FlavorVersionID  *string
+	Version          string
+	MinecraftVersion string
+	MinPlayers       int32
+	MaxPlayers       int32
+	ArchiveSizeBytes int64
+	State            ChunkImportState
+	Error            pgtype.Text
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type ChunkMedium struct {
+	ID          string
+	ChunkID     string
+	Hash        string
+	ContentType string
+	SizeBytes   int64
+	CreatedAt   time.Time
+}
+
+type ChunkReadme struct {
+	ChunkID   string
+	Markdown  string
+	UpdatedAt time.Time
+}
+
+type ChunkRetentionPolicy struct {
+	ChunkID          string
+	KeepLastVersions int32
+	KeepDays         int32
+	UpdatedAt        time.Time
+}
+
+type ChunkSlug struct {
+	Slug      string
+	ChunkID   string
+	CreatedAt time.Time
+}
+
+type ChunkTemplate struct {
+	ID              string
+	Name            string
+	Description     string
+	FlavorVersionID string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
 type Flavor struct {
 	ID        string
 	ChunkID   string
@@ -195,20 +708,38 @@ type FlavorArchive struct {
 	CreatedAt time.Time
 }
 
+type FlavorChannel struct {
+	FlavorID        string
+	Name            string
+	FlavorVersionID string
+	UpdatedAt       time.Time
+}
+
 type FlavorVersion struct {
-	ID                     string
-	FlavorID               string
-	Hash                   string
-	BuildStatus            BuildStatus
-	Version                string
-	FilesUploaded          bool
-	PrevVersionID          *string
-	CreatedAt              time.Time
-	PresignedUrlExpiryDate pgtype.Timestamptz
-	PresignedUrl           pgtype.Text
-	MinecraftVersion       string
-	MinPlayers             int32
-	MaxPlayers             int32
+	ID                         string
+	FlavorID                   string
+	Hash                       string
+	BuildStatus                BuildStatus
+	Version                    string
+	FilesUploaded              bool
+	PrevVersionID              *string
+	CreatedAt                  time.Time
+	PresignedUrlExpiryDate     pgtype.Timestamptz
+	PresignedUrl               pgtype.Text
+	MinecraftVersion           string
+	MinPlayers                 int32
+	MaxPlayers                 int32
+	CheckpointCpuMillis        *int32
+	CheckpointMemoryLimitBytes *int64
+	CheckpointNodeID           *string
+	BuildError                 pgtype.Text
+	BuildProgress              int16
+	StartupGracePeriodSeconds  int32
+	RestartPolicy              RestartPolicy
+	RestartMaxRetries          int32
+	RestoreLatencyMs           pgtype.Int4
+	JavaVersion                int32
+	StartupCommand             string
 }
 
 type FlavorVersionArchive struct {
@@ -218,23 +749,170 @@ type FlavorVersionArchive struct {
 	CreatedAt time.Time
 }
 
+type FlavorVersionAsset struct {
+	FlavorVersionID string
+	AssetHash       string
+	Position        int16
+}
+
 type FlavorVersionFile struct {
 	FlavorVersionID string
 	FileHash        string
 	FilePath        string
 	CreatedAt       time.Time
+	SizeBytes       int64
+}
+
+type FlavorVersionProvenance struct {
+	FlavorVersionID   string
+	BaseImageDigest   string
+	ImageDigest       string
+	BuilderVersion    string
+	InputHash         string
+	Plugins           []byte
+	ImageBuiltAt      time.Time
+	CheckpointNodeID  *string
+	PlatformdVersion  string
+	CheckpointBuiltAt pgtype.Timestamptz
+}
+
+type ImpersonatedAction struct {
+	ImpersonationID string
+	Method          string
+	CreatedAt       time.Time
+}
+
+type ImpersonatedActionsDefault struct {
+	ImpersonationID string
+	Method          string
+	CreatedAt       time.Time
+}
+
+type Impersonation struct {
+	ID        string
+	UserID    string
+	AdminID   string
+	Reason    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
 }
 
 type Instance struct {
+	ID                   string
+	FlavorVersionID      string
+	NodeID               string
+	Port                 *int32
+	State                InstanceState
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	OwnerID              string
+	OrderedBy            string
+	Revision             int64
+	StateChangedAt       time.Time
+	TrafficConnections   *int64
+	TrafficRxBytes       *int64
+	TrafficTxBytes       *int64
+	WorldSeed            string
+	GameMode             string
+	CustomParams         []byte
+	PlacementGroup       string
+	CrossInstanceTraffic bool
+}
+
+type InstanceEvent struct {
+	ID         string
+	InstanceID string
+	Type       InstanceEventType
+	ExitCode   int32
+	Message    string
+	CreatedAt  time.Time
+}
+
+type InstanceEventsDefault struct {
+	ID         string
+	InstanceID string
+	Type       InstanceEventType
+	ExitCode   int32
+	Message    string
+	CreatedAt  time.Time
+}
+
+type InstanceInvite struct {
+	ID         string
+	InstanceID string
+	TokenHash  []byte
+	MaxUses    int32
+	Uses       int32
+	CreatedBy  string
+	ExpiresAt  time.Time
+	RevokedAt  pgtype.Timestamptz
+	CreatedAt  time.Time
+}
+
+type InstanceLog struct {
+	ID          string
+	InstanceID  string
+	OwnerID     string
+	ObjectKey   string
+	SizeBytes   int64
+	FirstLineAt time.Time
+	LastLineAt  time.Time
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+type InstancePlayer struct {
+	InstanceID string
+	PlayerID   string
+	JoinedAt   time.Time
+}
+
+type InstanceRedirect struct {
+	NodeID     string
+	FromPort   int32
+	InstanceID string
+	ToAddress  netip.Addr
+	ToPort     int32
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+type InstanceSchedule struct {
 	ID              string
+	ChunkID         string
 	FlavorVersionID string
-	NodeID          string
-	Port            *int32
-	State           InstanceState
+	StartCron       string
+	StopCron        string
+	Timezone        string
+	InstanceID      *string
+	EvaluatedAt     time.Time
+	CreatedBy       string
 	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	OwnerID         string
-	OrderedBy       string
+}
+
+type InstanceUsage struct {
+	InstanceID  string
+	ChunkID     string
+	NodeID      string
+	CpuMillis   int64
+	MemoryBytes int64
+	NodeSlots   int32
+	StartedAt   time.Time
+	StoppedAt   pgtype.Timestamptz
+}
+
+type JoinMiss struct {
+	ChunkID    string
+	FlavorName pgtype.Text
+	CreatedAt  time.Time
+}
+
+type MaintenanceMode struct {
+	ID        bool
+	Enabled   bool
+	Message   string
+	UpdatedBy *string
+	UpdatedAt time.Time
 }
 
 type MinecraftVersion struct {
@@ -243,13 +921,70 @@ type MinecraftVersion struct {
 	ImageUrl  string
 }
 
+type MinecraftVersionJavaImage struct {
+	MinecraftVersion string
+	JavaVersion      int32
+	ImageUrl         string
+}
+
 type Node struct {
-	ID                    string
-	Name                  string
-	Address               netip.Addr
-	CheckpointApiEndpoint string
-	CreatedAt             time.Time
-	Slots                 int32
+	ID                      string
+	Name                    string
+	Address                 netip.Addr
+	CheckpointApiEndpoint   string
+	CreatedAt               time.Time
+	Slots                   int32
+	Capabilities            []string
+	LastSeenAt              pgtype.Timestamptz
+	MaxInstances            pgtype.Int4
+	PlatformdVersion        string
+	DesiredPlatformdVersion string
+	PlatformdUpdateStatus   NullPlatformdUpdateStatus
+	PlatformdUpdateMessage  string
+	PlatformdUpdatedAt      pgtype.Timestamptz
+	WorkloadCpuMillis       int64
+	WorkloadMemoryBytes     int64
+	LostAt                  pgtype.Timestamptz
+}
+
+type NodeCondition struct {
+	NodeID  string
+	Type    NodeConditionType
+	Message string
+	Since   time.Time
+}
+
+type NodeEvent struct {
+	ID            string
+	NodeID        string
+	Type          NodeEventType
+	ConditionType NodeConditionType
+	Message       string
+	CreatedAt     time.Time
+}
+
+type NodeEventsDefault struct {
+	ID            string
+	NodeID        string
+	Type          NodeEventType
+	ConditionType NodeConditionType
+	Message       string
+	CreatedAt     time.Time
+}
+
+type NotificationPreference struct {
+	UserID          string
+	BuildCompleted  bool
+	BuildFailed     bool
+	InstanceCrashed bool
+	UpdatedAt       time.Time
+}
+
+type RevokedToken struct {
+	ID        string
+	UserID    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
 }
 
 type RiverClient struct {
@@ -307,10 +1042,32 @@ type RiverQueue struct {
 	UpdatedAt time.Time
 }
 
+type RuntimeSetting struct {
+	Name      string
+	Value     string
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+type RuntimeSettingChange struct {
+	ID        string
+	Setting   string
+	OldValue  string
+	NewValue  string
+	ActorID   string
+	CreatedAt time.Time
+}
+
 type SchemaMigration struct {
 	Version string
 }
 
+type SigningKey struct {
+	ID         string
+	PrivateKey string
+	CreatedAt  time.Time
+}
+
 type User struct {
 	ID        string
 	Nickname  string
@@ -318,3 +1075,8 @@ type User struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+type UserTokenRevocation struct {
+	UserID        string
+	RevokedBefore time.Time
+}
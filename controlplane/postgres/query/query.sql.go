@@ -13,6 +13,90 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const activeAnnouncements = `-- name: ActiveAnnouncements :many
+SELECT id, message, severity, starts_at, ends_at, created_by, created_at FROM announcements
+WHERE starts_at <= $1 AND (ends_at IS NULL OR ends_at > $1)
+ORDER BY severity DESC, starts_at DESC
+`
+
+// severity is an enum, so announcements are ordered by the
+// position of their severity in the enum definition.
+func (q *Queries) ActiveAnnouncements(ctx context.Context, now time.Time) ([]Announcement, error) {
+	rows, err := q.db.Query(ctx, activeAnnouncements, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Announcement
+	for rows.Next() {
+		var i Announcement
+		if err := rows.Scan(
+			&i.ID,
+			&i.Message,
+			&i.Severity,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const activeInstanceSchedules = `-- name: ActiveInstanceSchedules :many
+SELECT s.id, s.chunk_id, s.flavor_version_id, s.start_cron, s.stop_cron, s.timezone, s.instance_id, s.evaluated_at, s.created_by, s.created_at, c.owner_id FROM instance_schedules s
+JOIN chunks c ON c.id = s.chunk_id
+JOIN flavor_versions v ON v.id = s.flavor_version_id
+JOIN flavors f ON f.id = v.flavor_id
+WHERE c.deleted_at IS NULL AND f.deleted_at IS NULL
+ORDER BY s.id
+`
+
+type ActiveInstanceSchedulesRow struct {
+	InstanceSchedule InstanceSchedule
+	OwnerID          string
+}
+
+// schedules of deleted chunks or flavors are skipped,
+// because their instances cannot be run anymore.
+func (q *Queries) ActiveInstanceSchedules(ctx context.Context) ([]ActiveInstanceSchedulesRow, error) {
+	rows, err := q.db.Query(ctx, activeInstanceSchedules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActiveInstanceSchedulesRow
+	for rows.Next() {
+		var i ActiveInstanceSchedulesRow
+		if err := rows.Scan(
+			&i.InstanceSchedule.ID,
+			&i.InstanceSchedule.ChunkID,
+			&i.InstanceSchedule.FlavorVersionID,
+			&i.InstanceSchedule.StartCron,
+			&i.InstanceSchedule.StopCron,
+			&i.InstanceSchedule.Timezone,
+			&i.InstanceSchedule.InstanceID,
+			&i.InstanceSchedule.EvaluatedAt,
+			&i.InstanceSchedule.CreatedBy,
+			&i.InstanceSchedule.CreatedAt,
+			&i.OwnerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const allChunkThumbnailHashes = `-- name: AllChunkThumbnailHashes :many
 SELECT id, thumbnail_hash FROM chunks
 WHERE thumbnail_hash IS NOT NULL
@@ -100,6 +184,58 @@ func (q *Queries) AllMinecraftVersions(ctx context.Context) ([]string, error) {
 	return items, nil
 }
 
+const allRetentionPolicies = `-- name: AllRetentionPolicies :many
+SELECT p.chunk_id, p.keep_last_versions, p.keep_days, p.updated_at FROM chunk_retention_policies p
+JOIN chunks c ON c.id = p.chunk_id
+WHERE c.deleted_at IS NULL
+`
+
+// deleted chunks are archived including all of their
+// flavor versions, so their policies are skipped.
+func (q *Queries) AllRetentionPolicies(ctx context.Context) ([]ChunkRetentionPolicy, error) {
+	rows, err := q.db.Query(ctx, allRetentionPolicies)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChunkRetentionPolicy
+	for rows.Next() {
+		var i ChunkRetentionPolicy
+		if err := rows.Scan(
+			&i.ChunkID,
+			&i.KeepLastVersions,
+			&i.KeepDays,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const anonymizeUser = `-- name: AnonymizeUser :exec
+UPDATE users SET
+    nickname = $1,
+    email = $2,
+    updated_at = now()
+WHERE id = $3
+`
+
+type AnonymizeUserParams struct {
+	Nickname string
+	Email    string
+	ID       string
+}
+
+func (q *Queries) AnonymizeUser(ctx context.Context, arg AnonymizeUserParams) error {
+	_, err := q.db.Exec(ctx, anonymizeUser, arg.Nickname, arg.Email, arg.ID)
+	return err
+}
+
 const archiveChunk = `-- name: ArchiveChunk :exec
 /*
  * ARCHIVE
@@ -176,388 +312,397 @@ func (q *Queries) ArchiveFlavorVersion(ctx context.Context, arg ArchiveFlavorVer
 	return err
 }
 
-const bestNode = `-- name: BestNode :one
-SELECT n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots, COUNT(i.id) AS instance_count FROM nodes n
-LEFT JOIN instances i ON i.node_id = n.id
-WHERE n.slots > (SELECT COUNT(*) FROM instances WHERE node_id = n.id)
-GROUP BY n.id
-ORDER BY instance_count ASC, random() ASC
-LIMIT 1
+const assetByHash = `-- name: AssetByHash :one
+SELECT hash, size_bytes, created_at FROM assets WHERE hash = $1
 `
 
-type BestNodeRow struct {
-	ID                    string
-	Name                  string
-	Address               netip.Addr
-	CheckpointApiEndpoint string
-	CreatedAt             time.Time
-	Slots                 int32
-	InstanceCount         int64
+func (q *Queries) AssetByHash(ctx context.Context, hash string) (Asset, error) {
+	row := q.db.QueryRow(ctx, assetByHash, hash)
+	var i Asset
+	err := row.Scan(&i.Hash, &i.SizeBytes, &i.CreatedAt)
+	return i, err
 }
 
-func (q *Queries) BestNode(ctx context.Context) (BestNodeRow, error) {
-	row := q.db.QueryRow(ctx, bestNode)
-	var i BestNodeRow
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.Address,
-		&i.CheckpointApiEndpoint,
-		&i.CreatedAt,
-		&i.Slots,
-		&i.InstanceCount,
-	)
-	return i, err
+const autoscaledInstances = `-- name: AutoscaledInstances :many
+SELECT
+    i.id,
+    i.owner_id,
+    i.state,
+    (SELECT count(*) FROM instance_players p WHERE p.instance_id = i.id)::integer AS players
+FROM autoscaled_instances a
+JOIN instances i ON i.id = a.instance_id
+WHERE a.flavor_id = $1 AND i.state IN ('PENDING', 'CREATING', 'RUNNING')
+ORDER BY i.created_at DESC
+`
+
+type AutoscaledInstancesRow struct {
+	ID      string
+	OwnerID string
+	State   InstanceState
+	Players int32
 }
 
-const chunkOwnerByChunkID = `-- name: ChunkOwnerByChunkID :one
-SELECT u.id, u.nickname, u.email, u.created_at, u.updated_at FROM users u
-    LEFT JOIN chunks c ON c.owner_id = u.id
-WHERE c.id = $1
+func (q *Queries) AutoscaledInstances(ctx context.Context, flavorID string) ([]AutoscaledInstancesRow, error) {
+	rows, err := q.db.Query(ctx, autoscaledInstances, flavorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AutoscaledInstancesRow
+	for rows.Next() {
+		var i AutoscaledInstancesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.State,
+			&i.Players,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const autoscalingPolicyByFlavorID = `-- name: AutoscalingPolicyByFlavorID :one
+SELECT flavor_id, min_instances, max_instances, target_utilization, scale_up_cooldown_seconds, scale_down_cooldown_seconds, last_scale_up_at, last_scale_down_at, updated_at FROM autoscaling_policies WHERE flavor_id = $1
 `
 
-func (q *Queries) ChunkOwnerByChunkID(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRow(ctx, chunkOwnerByChunkID, id)
-	var i User
+func (q *Queries) AutoscalingPolicyByFlavorID(ctx context.Context, flavorID string) (AutoscalingPolicy, error) {
+	row := q.db.QueryRow(ctx, autoscalingPolicyByFlavorID, flavorID)
+	var i AutoscalingPolicy
 	err := row.Scan(
-		&i.ID,
-		&i.Nickname,
-		&i.Email,
-		&i.CreatedAt,
+		&i.FlavorID,
+		&i.MinInstances,
+		&i.MaxInstances,
+		&i.TargetUtilization,
+		&i.ScaleUpCooldownSeconds,
+		&i.ScaleDownCooldownSeconds,
+		&i.LastScaleUpAt,
+		&i.LastScaleDownAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const chunkOwnerByFlavorID = `-- name: ChunkOwnerByFlavorID :one
-SELECT u.id, u.nickname, u.email, u.created_at, u.updated_at FROM users u
-    JOIN flavors f ON f.id = $1
-    JOIN chunks c ON c.id = f.chunk_id
-    JOIN users ON u.id = c.owner_id
+const autoscalingTargets = `-- name: AutoscalingTargets :many
+SELECT p.flavor_id, p.min_instances, p.max_instances, p.target_utilization, p.scale_up_cooldown_seconds, p.scale_down_cooldown_seconds, p.last_scale_up_at, p.last_scale_down_at, p.updated_at, c.owner_id FROM autoscaling_policies p
+JOIN flavors f ON f.id = p.flavor_id
+JOIN chunks c ON c.id = f.chunk_id
+WHERE f.deleted_at IS NULL AND c.deleted_at IS NULL
+ORDER BY p.flavor_id
+`
+
+type AutoscalingTargetsRow struct {
+	AutoscalingPolicy AutoscalingPolicy
+	OwnerID           string
+}
+
+// policies of deleted flavors or chunks are skipped,
+// because their instances cannot be run anymore.
+func (q *Queries) AutoscalingTargets(ctx context.Context) ([]AutoscalingTargetsRow, error) {
+	rows, err := q.db.Query(ctx, autoscalingTargets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AutoscalingTargetsRow
+	for rows.Next() {
+		var i AutoscalingTargetsRow
+		if err := rows.Scan(
+			&i.AutoscalingPolicy.FlavorID,
+			&i.AutoscalingPolicy.MinInstances,
+			&i.AutoscalingPolicy.MaxInstances,
+			&i.AutoscalingPolicy.TargetUtilization,
+			&i.AutoscalingPolicy.ScaleUpCooldownSeconds,
+			&i.AutoscalingPolicy.ScaleDownCooldownSeconds,
+			&i.AutoscalingPolicy.LastScaleUpAt,
+			&i.AutoscalingPolicy.LastScaleDownAt,
+			&i.AutoscalingPolicy.UpdatedAt,
+			&i.OwnerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const bestBuilderNode = `-- name: BestBuilderNode :one
+SELECT n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots, n.capabilities, n.last_seen_at, n.max_instances, n.platformd_version, n.desired_platformd_version, n.platformd_update_status, n.platformd_update_message, n.platformd_updated_at, n.workload_cpu_millis, n.workload_memory_bytes, n.lost_at,
+    (SELECT COUNT(*) FROM flavor_versions fv
+     WHERE fv.checkpoint_node_id = n.id
+        AND fv.build_status = 'CHECKPOINT_BUILD'
+        AND fv.id != $1) AS build_count,
+    (SELECT COUNT(*) FROM instances i WHERE i.node_id = n.id) AS instance_count
+FROM nodes n
+WHERE 'BUILDER' = ANY(n.capabilities) AND n.last_seen_at >= $2
+    AND NOT EXISTS (SELECT 1 FROM node_conditions c WHERE c.node_id = n.id)
+ORDER BY build_count ASC, instance_count ASC, random() ASC
 LIMIT 1
 `
 
-func (q *Queries) ChunkOwnerByFlavorID(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRow(ctx, chunkOwnerByFlavorID, id)
-	var i User
+type BestBuilderNodeParams struct {
+	FlavorVersionID string
+	SeenAfter       pgtype.Timestamptz
+}
+
+type BestBuilderNodeRow struct {
+	ID                      string
+	Name                    string
+	Address                 netip.Addr
+	CheckpointApiEndpoint   string
+	CreatedAt               time.Time
+	Slots                   int32
+	Capabilities            []string
+	LastSeenAt              pgtype.Timestamptz
+	MaxInstances            pgtype.Int4
+	PlatformdVersion        string
+	DesiredPlatformdVersion string
+	PlatformdUpdateStatus   NullPlatformdUpdateStatus
+	PlatformdUpdateMessage  string
+	PlatformdUpdatedAt      pgtype.Timestamptz
+	WorkloadCpuMillis       int64
+	WorkloadMemoryBytes     int64
+	LostAt                  pgtype.Timestamptz
+	BuildCount              int64
+	InstanceCount           int64
+}
+
+// selects the online builder with the least checkpoint builds in progress.
+// ties are broken by the number of instances running on the node. builders
+// reporting conditions are skipped.
+func (q *Queries) BestBuilderNode(ctx context.Context, arg BestBuilderNodeParams) (BestBuilderNodeRow, error) {
+	row := q.db.QueryRow(ctx, bestBuilderNode, arg.FlavorVersionID, arg.SeenAfter)
+	var i BestBuilderNodeRow
 	err := row.Scan(
 		&i.ID,
-		&i.Nickname,
-		&i.Email,
+		&i.Name,
+		&i.Address,
+		&i.CheckpointApiEndpoint,
 		&i.CreatedAt,
-		&i.UpdatedAt,
+		&i.Slots,
+		&i.Capabilities,
+		&i.LastSeenAt,
+		&i.MaxInstances,
+		&i.PlatformdVersion,
+		&i.DesiredPlatformdVersion,
+		&i.PlatformdUpdateStatus,
+		&i.PlatformdUpdateMessage,
+		&i.PlatformdUpdatedAt,
+		&i.WorkloadCpuMillis,
+		&i.WorkloadMemoryBytes,
+		&i.LostAt,
+		&i.BuildCount,
+		&i.InstanceCount,
 	)
 	return i, err
 }
 
-const chunkOwnerByFlavorVersionID = `-- name: ChunkOwnerByFlavorVersionID :one
-SELECT u.id, u.nickname, u.email, u.created_at, u.updated_at FROM users u
-    JOIN flavor_versions fv ON fv.id = $1
-    JOIN flavors f ON f.id = fv.flavor_id
-    JOIN chunks c ON c.id = f.chunk_id
-    JOIN users ON u.id = c.owner_id
+const bestNode = `-- name: BestNode :one
+SELECT n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots, n.capabilities, n.last_seen_at, n.max_instances, n.platformd_version, n.desired_platformd_version, n.platformd_update_status, n.platformd_update_message, n.platformd_updated_at, n.workload_cpu_millis, n.workload_memory_bytes, n.lost_at, COUNT(i.id) AS instance_count FROM nodes n
+LEFT JOIN instances i ON i.node_id = n.id
+WHERE LEAST(n.slots, COALESCE(n.max_instances, n.slots)) > (SELECT COUNT(*) FROM instances WHERE node_id = n.id)
+    AND NOT EXISTS (SELECT 1 FROM node_conditions c WHERE c.node_id = n.id)
+    AND n.lost_at IS NULL
+GROUP BY n.id
+ORDER BY
+    COUNT(i.id) FILTER (
+        WHERE $1::text <> '' AND i.placement_group = $1::text
+    ) DESC,
+    instance_count ASC,
+    random() ASC
 LIMIT 1
 `
 
-func (q *Queries) ChunkOwnerByFlavorVersionID(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRow(ctx, chunkOwnerByFlavorVersionID, id)
-	var i User
+type BestNodeRow struct {
+	ID                      string
+	Name                    string
+	Address                 netip.Addr
+	CheckpointApiEndpoint   string
+	CreatedAt               time.Time
+	Slots                   int32
+	Capabilities            []string
+	LastSeenAt              pgtype.Timestamptz
+	MaxInstances            pgtype.Int4
+	PlatformdVersion        string
+	DesiredPlatformdVersion string
+	PlatformdUpdateStatus   NullPlatformdUpdateStatus
+	PlatformdUpdateMessage  string
+	PlatformdUpdatedAt      pgtype.Timestamptz
+	WorkloadCpuMillis       int64
+	WorkloadMemoryBytes     int64
+	LostAt                  pgtype.Timestamptz
+	InstanceCount           int64
+}
+
+// nodes never get more instances than the lower of their slots and the
+// max instances they reported themselves.
+//
+// nodes already running instances of the given placement group are
+// preferred, as long as they have free slots. an empty placement group
+// does not match any instance. nodes reporting conditions and lost
+// nodes are skipped.
+func (q *Queries) BestNode(ctx context.Context, placementGroup string) (BestNodeRow, error) {
+	row := q.db.QueryRow(ctx, bestNode, placementGroup)
+	var i BestNodeRow
 	err := row.Scan(
 		&i.ID,
-		&i.Nickname,
-		&i.Email,
+		&i.Name,
+		&i.Address,
+		&i.CheckpointApiEndpoint,
 		&i.CreatedAt,
-		&i.UpdatedAt,
+		&i.Slots,
+		&i.Capabilities,
+		&i.LastSeenAt,
+		&i.MaxInstances,
+		&i.PlatformdVersion,
+		&i.DesiredPlatformdVersion,
+		&i.PlatformdUpdateStatus,
+		&i.PlatformdUpdateMessage,
+		&i.PlatformdUpdatedAt,
+		&i.WorkloadCpuMillis,
+		&i.WorkloadMemoryBytes,
+		&i.LostAt,
+		&i.InstanceCount,
 	)
 	return i, err
 }
 
-const countInstancesByFlavorID = `-- name: CountInstancesByFlavorID :one
-SELECT COUNT(*) FROM instances WHERE flavor_version_id = $1
-`
-
-func (q *Queries) CountInstancesByFlavorID(ctx context.Context, flavorVersionID string) (int64, error) {
-	row := q.db.QueryRow(ctx, countInstancesByFlavorID, flavorVersionID)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
-}
-
-const createChunk = `-- name: CreateChunk :exec
+const buildNotificationData = `-- name: BuildNotificationData :one
 /*
- * CHUNKS
+ * NOTIFICATIONS
  */
 
-INSERT INTO chunks
-    (id, name, description, tags, owner_id, created_at, updated_at)
-VALUES
-    ($1, $2, $3, $4, $5, $6, $7)
+SELECT
+    u.email,
+    c.name AS chunk_name,
+    f.name AS flavor_name,
+    fv.version,
+    fv.build_error,
+    COALESCE(np.build_completed, false)::boolean AS build_completed,
+    COALESCE(np.build_failed, false)::boolean AS build_failed
+FROM flavor_versions fv
+    JOIN flavors f ON f.id = fv.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN users u ON u.id = c.owner_id
+    LEFT JOIN notification_preferences np ON np.user_id = u.id
+WHERE fv.id = $1
 `
 
-type CreateChunkParams struct {
-	ID          string
-	Name        string
-	Description string
-	Tags        []string
-	OwnerID     string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+type BuildNotificationDataRow struct {
+	Email          string
+	ChunkName      string
+	FlavorName     string
+	Version        string
+	BuildError     pgtype.Text
+	BuildCompleted bool
+	BuildFailed    bool
 }
 
-func (q *Queries) CreateChunk(ctx context.Context, arg CreateChunkParams) error {
-	_, err := q.db.Exec(ctx, createChunk,
-		arg.ID,
-		arg.Name,
-		arg.Description,
-		arg.Tags,
-		arg.OwnerID,
-		arg.CreatedAt,
-		arg.UpdatedAt,
+func (q *Queries) BuildNotificationData(ctx context.Context, id string) (BuildNotificationDataRow, error) {
+	row := q.db.QueryRow(ctx, buildNotificationData, id)
+	var i BuildNotificationDataRow
+	err := row.Scan(
+		&i.Email,
+		&i.ChunkName,
+		&i.FlavorName,
+		&i.Version,
+		&i.BuildError,
+		&i.BuildCompleted,
+		&i.BuildFailed,
 	)
-	return err
+	return i, err
 }
 
-const createFlavor = `-- name: CreateFlavor :exec
-/*
- * FLAVORS
- */
-
-INSERT INTO flavors
-    (id, chunk_id, name, created_at, updated_at)
-VALUES
-    ($1, $2, $3, $4, $5)
+const canaryStats = `-- name: CanaryStats :many
+SELECT
+    ci.canary,
+    count(*)::bigint AS launches,
+    count(*) FILTER (WHERE EXISTS (
+        SELECT 1 FROM instance_events e
+        WHERE e.instance_id = ci.instance_id
+          AND e.created_at >= ci.created_at - interval '1 day'
+          AND e.type IN ('CRASHED', 'OOM_KILLED')
+    ))::bigint AS crashed_instances,
+    coalesce(sum(i.traffic_connections), 0)::bigint AS connections
+FROM canary_instances ci
+LEFT JOIN instances i ON i.id = ci.instance_id
+WHERE ci.canary_id = $1
+GROUP BY ci.canary
 `
 
-type CreateFlavorParams struct {
-	ID        string
-	ChunkID   string
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+type CanaryStatsRow struct {
+	Canary           bool
+	Launches         int64
+	CrashedInstances int64
+	Connections      int64
 }
 
-// TODO: insert multiple (aka :batchmany)
-func (q *Queries) CreateFlavor(ctx context.Context, arg CreateFlavorParams) error {
-	_, err := q.db.Exec(ctx, createFlavor,
-		arg.ID,
-		arg.ChunkID,
-		arg.Name,
-		arg.CreatedAt,
-		arg.UpdatedAt,
-	)
-	return err
-}
-
-const createFlavorVersion = `-- name: CreateFlavorVersion :exec
-INSERT INTO flavor_versions
-    (id, flavor_id, hash, version, prev_version_id, minecraft_version, created_at, min_players, max_players)
-VALUES
-    ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-`
-
-type CreateFlavorVersionParams struct {
-	ID               string
-	FlavorID         string
-	Hash             string
-	Version          string
-	PrevVersionID    *string
-	MinecraftVersion string
-	CreatedAt        time.Time
-	MinPlayers       int32
-	MaxPlayers       int32
-}
-
-func (q *Queries) CreateFlavorVersion(ctx context.Context, arg CreateFlavorVersionParams) error {
-	_, err := q.db.Exec(ctx, createFlavorVersion,
-		arg.ID,
-		arg.FlavorID,
-		arg.Hash,
-		arg.Version,
-		arg.PrevVersionID,
-		arg.MinecraftVersion,
-		arg.CreatedAt,
-		arg.MinPlayers,
-		arg.MaxPlayers,
-	)
-	return err
-}
-
-const createInstance = `-- name: CreateInstance :exec
-/*
- * INSTANCES
- */
-
-INSERT INTO instances
-    (id, flavor_version_id, node_id, state, owner_id, created_at, updated_at, ordered_by)
-VALUES
-    ($1, $2, $3, $4, $5, $6, $7, $8)
-`
-
-type CreateInstanceParams struct {
-	ID              string
-	FlavorVersionID string
-	NodeID          string
-	State           InstanceState
-	OwnerID         string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	OrderedBy       string
-}
-
-func (q *Queries) CreateInstance(ctx context.Context, arg CreateInstanceParams) error {
-	_, err := q.db.Exec(ctx, createInstance,
-		arg.ID,
-		arg.FlavorVersionID,
-		arg.NodeID,
-		arg.State,
-		arg.OwnerID,
-		arg.CreatedAt,
-		arg.UpdatedAt,
-		arg.OrderedBy,
-	)
-	return err
-}
-
-const createUser = `-- name: CreateUser :exec
-INSERT INTO users
-    (id, nickname, email, created_at, updated_at)
-VALUES
-    ($1, $2, $3, $4, $5)
-`
-
-type CreateUserParams struct {
-	ID        string
-	Nickname  string
-	Email     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-}
-
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
-	_, err := q.db.Exec(ctx, createUser,
-		arg.ID,
-		arg.Nickname,
-		arg.Email,
-		arg.CreatedAt,
-		arg.UpdatedAt,
-	)
-	return err
-}
-
-const deleteChunk = `-- name: DeleteChunk :exec
-DELETE FROM chunks WHERE id = $1
-`
-
-func (q *Queries) DeleteChunk(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, deleteChunk, id)
-	return err
-}
-
-const deleteFlavor = `-- name: DeleteFlavor :exec
-DELETE FROM flavors WHERE id = $1
-`
-
-func (q *Queries) DeleteFlavor(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, deleteFlavor, id)
-	return err
-}
-
-const deleteFlavorVersion = `-- name: DeleteFlavorVersion :exec
-DELETE FROM flavor_versions WHERE id = $1
-`
-
-func (q *Queries) DeleteFlavorVersion(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, deleteFlavorVersion, id)
-	return err
-}
-
-const flavorIDByFlavorVersionID = `-- name: FlavorIDByFlavorVersionID :one
-SELECT flavor_id FROM flavor_versions WHERE id = $1
-`
-
-func (q *Queries) FlavorIDByFlavorVersionID(ctx context.Context, id string) (string, error) {
-	row := q.db.QueryRow(ctx, flavorIDByFlavorVersionID, id)
-	var flavor_id string
-	err := row.Scan(&flavor_id)
-	return flavor_id, err
+// crashes are counted using the events of the instances, because
+// they are kept after the instances have been removed. connections
+// are only known for instances that have not been removed yet. events
+// are timestamped by nodes, whose clocks might be off, so a day of slack
+// is given when limiting them to the time after the canary started.
+func (q *Queries) CanaryStats(ctx context.Context, canaryID string) ([]CanaryStatsRow, error) {
+	rows, err := q.db.Query(ctx, canaryStats, canaryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CanaryStatsRow
+	for rows.Next() {
+		var i CanaryStatsRow
+		if err := rows.Scan(
+			&i.Canary,
+			&i.Launches,
+			&i.CrashedInstances,
+			&i.Connections,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const flavorNameExists = `-- name: FlavorNameExists :one
-SELECT EXISTS(
-    SELECT 1 FROM flavors
-    WHERE name = $1 AND chunk_id = $2
-)
+const chunkIDBySlug = `-- name: ChunkIDBySlug :one
+SELECT chunk_id FROM chunk_slugs WHERE slug = $1
 `
 
-type FlavorNameExistsParams struct {
-	Name    string
-	ChunkID string
-}
-
-func (q *Queries) FlavorNameExists(ctx context.Context, arg FlavorNameExistsParams) (bool, error) {
-	row := q.db.QueryRow(ctx, flavorNameExists, arg.Name, arg.ChunkID)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+func (q *Queries) ChunkIDBySlug(ctx context.Context, slug string) (string, error) {
+	row := q.db.QueryRow(ctx, chunkIDBySlug, slug)
+	var chunk_id string
+	err := row.Scan(&chunk_id)
+	return chunk_id, err
 }
 
-const flavorVersionByID = `-- name: FlavorVersionByID :many
-SELECT id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id, v.created_at, presigned_url_expiry_date, presigned_url, minecraft_version, min_players, max_players, flavor_version_id, file_hash, file_path, f.created_at FROM flavor_versions v
-    JOIN flavor_version_files f ON f.flavor_version_id = v.id
-WHERE id = $1
+const chunkIDsByOwnerID = `-- name: ChunkIDsByOwnerID :many
+SELECT id FROM chunks WHERE owner_id = $1 ORDER BY id
 `
 
-type FlavorVersionByIDRow struct {
-	ID                     string
-	FlavorID               string
-	Hash                   string
-	BuildStatus            BuildStatus
-	Version                string
-	FilesUploaded          bool
-	PrevVersionID          *string
-	CreatedAt              time.Time
-	PresignedUrlExpiryDate pgtype.Timestamptz
-	PresignedUrl           pgtype.Text
-	MinecraftVersion       string
-	MinPlayers             int32
-	MaxPlayers             int32
-	FlavorVersionID        string
-	FileHash               string
-	FilePath               string
-	CreatedAt_2            time.Time
-}
-
-func (q *Queries) FlavorVersionByID(ctx context.Context, id string) ([]FlavorVersionByIDRow, error) {
-	rows, err := q.db.Query(ctx, flavorVersionByID, id)
+func (q *Queries) ChunkIDsByOwnerID(ctx context.Context, ownerID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, chunkIDsByOwnerID, ownerID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []FlavorVersionByIDRow
+	var items []string
 	for rows.Next() {
-		var i FlavorVersionByIDRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.FlavorID,
-			&i.Hash,
-			&i.BuildStatus,
-			&i.Version,
-			&i.FilesUploaded,
-			&i.PrevVersionID,
-			&i.CreatedAt,
-			&i.PresignedUrlExpiryDate,
-			&i.PresignedUrl,
-			&i.MinecraftVersion,
-			&i.MinPlayers,
-			&i.MaxPlayers,
-			&i.FlavorVersionID,
-			&i.FileHash,
-			&i.FilePath,
-			&i.CreatedAt_2,
-		); err != nil {
+		var id string
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -565,42 +710,51 @@ func (q *Queries) FlavorVersionByID(ctx context.Context, id string) ([]FlavorVer
 	return items, nil
 }
 
-const flavorVersionExists = `-- name: FlavorVersionExists :one
-SELECT EXISTS(
-    SELECT 1 FROM flavor_versions
-    WHERE version = $1 AND flavor_id = $2
-)
+const chunkImportByID = `-- name: ChunkImportByID :one
+SELECT id, chunk_id, flavor_id, flavor_version_id, version, minecraft_version, min_players, max_players, archive_size_bytes, state, error, created_at, updated_at FROM chunk_imports WHERE id = $1
 `
 
-type FlavorVersionExistsParams struct {
-	Version  string
-	FlavorID string
-}
-
-func (q *Queries) FlavorVersionExists(ctx context.Context, arg FlavorVersionExistsParams) (bool, error) {
-	row := q.db.QueryRow(ctx, flavorVersionExists, arg.Version, arg.FlavorID)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+func (q *Queries) ChunkImportByID(ctx context.Context, id string) (ChunkImport, error) {
+	row := q.db.QueryRow(ctx, chunkImportByID, id)
+	var i ChunkImport
+	err := row.Scan(
+		&i.ID,
+		&i.ChunkID,
+		&i.FlavorID,
+		&i.FlavorVersionID,
+		&i.Version,
+		&i.MinecraftVersion,
+		&i.MinPlayers,
+		&i.MaxPlayers,
+		&i.ArchiveSizeBytes,
+		&i.State,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
-const flavorVersionFileHashes = `-- name: FlavorVersionFileHashes :many
-SELECT flavor_version_id, file_hash, file_path, created_at FROM flavor_version_files WHERE flavor_version_id = $1
+const chunkMediaByChunkID = `-- name: ChunkMediaByChunkID :many
+SELECT id, chunk_id, hash, content_type, size_bytes, created_at FROM chunk_media WHERE chunk_id = $1
+ORDER BY created_at, id
 `
 
-func (q *Queries) FlavorVersionFileHashes(ctx context.Context, flavorVersionID string) ([]FlavorVersionFile, error) {
-	rows, err := q.db.Query(ctx, flavorVersionFileHashes, flavorVersionID)
+func (q *Queries) ChunkMediaByChunkID(ctx context.Context, chunkID string) ([]ChunkMedium, error) {
+	rows, err := q.db.Query(ctx, chunkMediaByChunkID, chunkID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []FlavorVersionFile
+	var items []ChunkMedium
 	for rows.Next() {
-		var i FlavorVersionFile
+		var i ChunkMedium
 		if err := rows.Scan(
-			&i.FlavorVersionID,
-			&i.FileHash,
-			&i.FilePath,
+			&i.ID,
+			&i.ChunkID,
+			&i.Hash,
+			&i.ContentType,
+			&i.SizeBytes,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -613,77 +767,3148 @@ func (q *Queries) FlavorVersionFileHashes(ctx context.Context, flavorVersionID s
 	return items, nil
 }
 
-const flavorVersionHashByID = `-- name: FlavorVersionHashByID :one
-SELECT hash FROM flavor_versions WHERE id = $1
+const chunkMediaByID = `-- name: ChunkMediaByID :one
+SELECT id, chunk_id, hash, content_type, size_bytes, created_at FROM chunk_media WHERE id = $1
 `
 
-func (q *Queries) FlavorVersionHashByID(ctx context.Context, id string) (string, error) {
-	row := q.db.QueryRow(ctx, flavorVersionHashByID, id)
-	var hash string
-	err := row.Scan(&hash)
-	return hash, err
+func (q *Queries) ChunkMediaByID(ctx context.Context, id string) (ChunkMedium, error) {
+	row := q.db.QueryRow(ctx, chunkMediaByID, id)
+	var i ChunkMedium
+	err := row.Scan(
+		&i.ID,
+		&i.ChunkID,
+		&i.Hash,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.CreatedAt,
+	)
+	return i, err
 }
 
-const getChunkByID = `-- name: GetChunkByID :many
-SELECT c.id, c.name, description, tags, c.created_at, c.updated_at, owner_id, thumbnail_hash, thumbnail_updated_at, c.deleted_at, f.id, chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at, v.id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id, v.created_at, presigned_url_expiry_date, presigned_url, minecraft_version, min_players, max_players, flavor_version_id, file_hash, file_path, vf.created_at, u.id, nickname, email, u.created_at, u.updated_at FROM chunks c
-    LEFT JOIN flavors f ON f.chunk_id = c.id
-    LEFT JOIN flavor_versions v ON v.flavor_id = f.id
-    LEFT JOIN flavor_version_files vf ON vf.flavor_version_id = v.id
-    LEFT JOIN users u ON u.id = c.owner_id
+const chunkOwnerByChunkID = `-- name: ChunkOwnerByChunkID :one
+SELECT u.id, u.nickname, u.email, u.created_at, u.updated_at FROM users u
+    LEFT JOIN chunks c ON c.owner_id = u.id
 WHERE c.id = $1
 `
 
-type GetChunkByIDRow struct {
-	ID                     string
-	Name                   string
-	Description            string
-	Tags                   []string
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
-	OwnerID                string
-	ThumbnailHash          pgtype.Text
-	ThumbnailUpdatedAt     time.Time
-	DeletedAt              pgtype.Timestamptz
-	ID_2                   *string
-	ChunkID                *string
-	Name_2                 pgtype.Text
-	CreatedAt_2            pgtype.Timestamptz
-	UpdatedAt_2            pgtype.Timestamptz
-	DeletedAt_2            pgtype.Timestamptz
-	ID_3                   *string
-	FlavorID               *string
-	Hash                   pgtype.Text
-	BuildStatus            NullBuildStatus
-	Version                pgtype.Text
-	FilesUploaded          pgtype.Bool
-	PrevVersionID          *string
-	CreatedAt_3            pgtype.Timestamptz
-	PresignedUrlExpiryDate pgtype.Timestamptz
-	PresignedUrl           pgtype.Text
-	MinecraftVersion       pgtype.Text
-	MinPlayers             pgtype.Int4
-	MaxPlayers             pgtype.Int4
-	FlavorVersionID        *string
-	FileHash               pgtype.Text
-	FilePath               pgtype.Text
-	CreatedAt_4            pgtype.Timestamptz
-	ID_4                   *string
-	Nickname               pgtype.Text
-	Email                  pgtype.Text
-	CreatedAt_5            pgtype.Timestamptz
-	UpdatedAt_3            pgtype.Timestamptz
+func (q *Queries) ChunkOwnerByChunkID(ctx context.Context, id string) (User, error) {
+	row := q.db.QueryRow(ctx, chunkOwnerByChunkID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Nickname,
+		&i.Email,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const chunkOwnerByFlavorID = `-- name: ChunkOwnerByFlavorID :one
+SELECT u.id, u.nickname, u.email, u.created_at, u.updated_at FROM users u
+    JOIN flavors f ON f.id = $1
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN users ON u.id = c.owner_id
+LIMIT 1
+`
+
+func (q *Queries) ChunkOwnerByFlavorID(ctx context.Context, id string) (User, error) {
+	row := q.db.QueryRow(ctx, chunkOwnerByFlavorID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Nickname,
+		&i.Email,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const chunkOwnerByFlavorVersionID = `-- name: ChunkOwnerByFlavorVersionID :one
+SELECT u.id, u.nickname, u.email, u.created_at, u.updated_at FROM users u
+    JOIN flavor_versions fv ON fv.id = $1
+    JOIN flavors f ON f.id = fv.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN users ON u.id = c.owner_id
+LIMIT 1
+`
+
+func (q *Queries) ChunkOwnerByFlavorVersionID(ctx context.Context, id string) (User, error) {
+	row := q.db.QueryRow(ctx, chunkOwnerByFlavorVersionID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Nickname,
+		&i.Email,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const chunkReadmeByChunkID = `-- name: ChunkReadmeByChunkID :one
+SELECT chunk_id, markdown, updated_at FROM chunk_readmes WHERE chunk_id = $1
+`
+
+func (q *Queries) ChunkReadmeByChunkID(ctx context.Context, chunkID string) (ChunkReadme, error) {
+	row := q.db.QueryRow(ctx, chunkReadmeByChunkID, chunkID)
+	var i ChunkReadme
+	err := row.Scan(&i.ChunkID, &i.Markdown, &i.UpdatedAt)
+	return i, err
+}
+
+const chunkTemplateByID = `-- name: ChunkTemplateByID :one
+SELECT id, name, description, flavor_version_id, created_at, updated_at FROM chunk_templates WHERE id = $1
+`
+
+func (q *Queries) ChunkTemplateByID(ctx context.Context, id string) (ChunkTemplate, error) {
+	row := q.db.QueryRow(ctx, chunkTemplateByID, id)
+	var i ChunkTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.FlavorVersionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const chunkTemplateByName = `-- name: ChunkTemplateByName :one
+SELECT id, name, description, flavor_version_id, created_at, updated_at FROM chunk_templates WHERE name = $1
+`
+
+func (q *Queries) ChunkTemplateByName(ctx context.Context, name string) (ChunkTemplate, error) {
+	row := q.db.QueryRow(ctx, chunkTemplateByName, name)
+	var i ChunkTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.FlavorVersionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const chunkTemplates = `-- name: ChunkTemplates :many
+SELECT id, name, description, flavor_version_id, created_at, updated_at FROM chunk_templates ORDER BY name
+`
+
+func (q *Queries) ChunkTemplates(ctx context.Context) ([]ChunkTemplate, error) {
+	rows, err := q.db.Query(ctx, chunkTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChunkTemplate
+	for rows.Next() {
+		var i ChunkTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.FlavorVersionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countAssets = `-- name: CountAssets :one
+SELECT count(*) FROM assets WHERE hash = ANY($1::text[])
+`
+
+func (q *Queries) CountAssets(ctx context.Context, hashes []string) (int64, error) {
+	row := q.db.QueryRow(ctx, countAssets, hashes)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countInstancesByFlavorID = `-- name: CountInstancesByFlavorID :one
+SELECT COUNT(*) FROM instances WHERE flavor_version_id = $1
+`
+
+func (q *Queries) CountInstancesByFlavorID(ctx context.Context, flavorVersionID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countInstancesByFlavorID, flavorVersionID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countInstancesByNodeID = `-- name: CountInstancesByNodeID :one
+SELECT COUNT(*) FROM instances WHERE node_id = $1
+`
+
+func (q *Queries) CountInstancesByNodeID(ctx context.Context, nodeID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countInstancesByNodeID, nodeID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAnnouncement = `-- name: CreateAnnouncement :exec
+/*
+ * ANNOUNCEMENTS
+ */
+
+INSERT INTO announcements
+    (id, message, severity, starts_at, ends_at, created_by, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateAnnouncementParams struct {
+	ID        string
+	Message   string
+	Severity  AnnouncementSeverity
+	StartsAt  time.Time
+	EndsAt    pgtype.Timestamptz
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+func (q *Queries) CreateAnnouncement(ctx context.Context, arg CreateAnnouncementParams) error {
+	_, err := q.db.Exec(ctx, createAnnouncement,
+		arg.ID,
+		arg.Message,
+		arg.Severity,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.CreatedBy,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createAsset = `-- name: CreateAsset :exec
+/*
+ * ASSETS
+ */
+
+INSERT INTO assets
+    (hash, size_bytes, created_at)
+VALUES
+    ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type CreateAssetParams struct {
+	Hash      string
+	SizeBytes int64
+	CreatedAt time.Time
+}
+
+func (q *Queries) CreateAsset(ctx context.Context, arg CreateAssetParams) error {
+	_, err := q.db.Exec(ctx, createAsset, arg.Hash, arg.SizeBytes, arg.CreatedAt)
+	return err
+}
+
+const createCanary = `-- name: CreateCanary :exec
+/*
+ * CANARIES
+ */
+
+INSERT INTO canaries
+    (id, flavor_id, stable_version_id, canary_version_id, percentage, state, created_at, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateCanaryParams struct {
+	ID              string
+	FlavorID        string
+	StableVersionID string
+	CanaryVersionID string
+	Percentage      int16
+	State           CanaryState
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (q *Queries) CreateCanary(ctx context.Context, arg CreateCanaryParams) error {
+	_, err := q.db.Exec(ctx, createCanary,
+		arg.ID,
+		arg.FlavorID,
+		arg.StableVersionID,
+		arg.CanaryVersionID,
+		arg.Percentage,
+		arg.State,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const createCanaryInstance = `-- name: CreateCanaryInstance :exec
+INSERT INTO canary_instances
+    (instance_id, canary_id, canary, created_at)
+VALUES
+    ($1, $2, $3, $4)
+`
+
+type CreateCanaryInstanceParams struct {
+	InstanceID string
+	CanaryID   string
+	Canary     bool
+	CreatedAt  time.Time
+}
+
+func (q *Queries) CreateCanaryInstance(ctx context.Context, arg CreateCanaryInstanceParams) error {
+	_, err := q.db.Exec(ctx, createCanaryInstance,
+		arg.InstanceID,
+		arg.CanaryID,
+		arg.Canary,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createChunk = `-- name: CreateChunk :exec
+/*
+ * CHUNKS
+ */
+
+INSERT INTO chunks
+    (id, name, description, tags, owner_id, created_at, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateChunkParams struct {
+	ID          string
+	Name        string
+	Description string
+	Tags        []string
+	OwnerID     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (q *Queries) CreateChunk(ctx context.Context, arg CreateChunkParams) error {
+	_, err := q.db.Exec(ctx, createChunk,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.Tags,
+		arg.OwnerID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const createChunkImport = `-- name: CreateChunkImport :exec
+/*
+ * CHUNK IMPORTS
+ */
+
+INSERT INTO chunk_imports
+    (id, chunk_id, flavor_id, version, minecraft_version, min_players,
+     max_players, archive_size_bytes, state, created_at, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+
+type CreateChunkImportParams struct {
+	ID               string
+	ChunkID          string
+	FlavorID         string
+	Version          string
+	MinecraftVersion string
+	MinPlayers       int32
+	MaxPlayers       int32
+	ArchiveSizeBytes int64
+	State            ChunkImportState
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (q *Queries) CreateChunkImport(ctx context.Context, arg CreateChunkImportParams) error {
+	_, err := q.db.Exec(ctx, createChunkImport,
+		arg.ID,
+		arg.ChunkID,
+		arg.FlavorID,
+		arg.Version,
+		arg.MinecraftVersion,
+		arg.MinPlayers,
+		arg.MaxPlayers,
+		arg.ArchiveSizeBytes,
+		arg.State,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const createChunkMedia = `-- name: CreateChunkMedia :one
+INSERT INTO chunk_media
+    (id, chunk_id, hash, content_type, size_bytes, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (chunk_id, hash) DO UPDATE SET
+    content_type = EXCLUDED.content_type
+RETURNING id, chunk_id, hash, content_type, size_bytes, created_at
+`
+
+type CreateChunkMediaParams struct {
+	ID          string
+	ChunkID     string
+	Hash        string
+	ContentType string
+	SizeBytes   int64
+	CreatedAt   time.Time
+}
+
+// media is identified by its content, so uploading the
+// same image again returns the already existing media.
+func (q *Queries) CreateChunkMedia(ctx context.Context, arg CreateChunkMediaParams) (ChunkMedium, error) {
+	row := q.db.QueryRow(ctx, createChunkMedia,
+		arg.ID,
+		arg.ChunkID,
+		arg.Hash,
+		arg.ContentType,
+		arg.SizeBytes,
+		arg.CreatedAt,
+	)
+	var i ChunkMedium
+	err := row.Scan(
+		&i.ID,
+		&i.ChunkID,
+		&i.Hash,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createChunkTemplate = `-- name: CreateChunkTemplate :one
+/*
+ * CHUNK TEMPLATES
+ */
+
+INSERT INTO chunk_templates
+    (id, name, description, flavor_version_id, created_at, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5, $5)
+RETURNING id, name, description, flavor_version_id, created_at, updated_at
+`
+
+type CreateChunkTemplateParams struct {
+	ID              string
+	Name            string
+	Description     string
+	FlavorVersionID string
+	CreatedAt       time.Time
+}
+
+func (q *Queries) CreateChunkTemplate(ctx context.Context, arg CreateChunkTemplateParams) (ChunkTemplate, error) {
+	row := q.db.QueryRow(ctx, createChunkTemplate,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.FlavorVersionID,
+		arg.CreatedAt,
+	)
+	var i ChunkTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.FlavorVersionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createFlavor = `-- name: CreateFlavor :exec
+/*
+ * FLAVORS
+ */
+
+INSERT INTO flavors
+    (id, chunk_id, name, created_at, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5)
+`
+
+type CreateFlavorParams struct {
+	ID        string
+	ChunkID   string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TODO: insert multiple (aka :batchmany)
+func (q *Queries) CreateFlavor(ctx context.Context, arg CreateFlavorParams) error {
+	_, err := q.db.Exec(ctx, createFlavor,
+		arg.ID,
+		arg.ChunkID,
+		arg.Name,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const createFlavorVersion = `-- name: CreateFlavorVersion :exec
+INSERT INTO flavor_versions
+    (id, flavor_id, hash, version, prev_version_id, minecraft_version, created_at, min_players, max_players,
+     startup_grace_period_seconds, restart_policy, restart_max_retries, java_version, startup_command)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+`
+
+type CreateFlavorVersionParams struct {
+	ID                        string
+	FlavorID                  string
+	Hash                      string
+	Version                   string
+	PrevVersionID             *string
+	MinecraftVersion          string
+	CreatedAt                 time.Time
+	MinPlayers                int32
+	MaxPlayers                int32
+	StartupGracePeriodSeconds int32
+	RestartPolicy             RestartPolicy
+	RestartMaxRetries         int32
+	JavaVersion               int32
+	StartupCommand            string
+}
+
+func (q *Queries) CreateFlavorVersion(ctx context.Context, arg CreateFlavorVersionParams) error {
+	_, err := q.db.Exec(ctx, createFlavorVersion,
+		arg.ID,
+		arg.FlavorID,
+		arg.Hash,
+		arg.Version,
+		arg.PrevVersionID,
+		arg.MinecraftVersion,
+		arg.CreatedAt,
+		arg.MinPlayers,
+		arg.MaxPlayers,
+		arg.StartupGracePeriodSeconds,
+		arg.RestartPolicy,
+		arg.RestartMaxRetries,
+		arg.JavaVersion,
+		arg.StartupCommand,
+	)
+	return err
+}
+
+const createImpersonation = `-- name: CreateImpersonation :one
+/*
+ * IMPERSONATIONS
+ */
+
+INSERT INTO impersonations
+    (id, user_id, admin_id, reason, created_at, expires_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, admin_id, reason, created_at, expires_at
+`
+
+type CreateImpersonationParams struct {
+	ID        string
+	UserID    string
+	AdminID   string
+	Reason    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreateImpersonation(ctx context.Context, arg CreateImpersonationParams) (Impersonation, error) {
+	row := q.db.QueryRow(ctx, createImpersonation,
+		arg.ID,
+		arg.UserID,
+		arg.AdminID,
+		arg.Reason,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i Impersonation
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AdminID,
+		&i.Reason,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const createInstance = `-- name: CreateInstance :exec
+/*
+ * INSTANCES
+ */
+
+INSERT INTO instances
+    (id, flavor_version_id, node_id, state, owner_id, created_at, updated_at, ordered_by,
+     world_seed, game_mode, custom_params, placement_group, cross_instance_traffic)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+`
+
+type CreateInstanceParams struct {
+	ID                   string
+	FlavorVersionID      string
+	NodeID               string
+	State                InstanceState
+	OwnerID              string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	OrderedBy            string
+	WorldSeed            string
+	GameMode             string
+	CustomParams         []byte
+	PlacementGroup       string
+	CrossInstanceTraffic bool
+}
+
+func (q *Queries) CreateInstance(ctx context.Context, arg CreateInstanceParams) error {
+	_, err := q.db.Exec(ctx, createInstance,
+		arg.ID,
+		arg.FlavorVersionID,
+		arg.NodeID,
+		arg.State,
+		arg.OwnerID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.OrderedBy,
+		arg.WorldSeed,
+		arg.GameMode,
+		arg.CustomParams,
+		arg.PlacementGroup,
+		arg.CrossInstanceTraffic,
+	)
+	return err
+}
+
+const createInstanceInvite = `-- name: CreateInstanceInvite :one
+/*
+ * INSTANCE INVITES
+ */
+
+INSERT INTO instance_invites
+    (id, instance_id, token_hash, max_uses, created_by, expires_at)
+VALUES
+    (
+        $1,
+        $2,
+        sha256(convert_to($3::text, 'UTF8')),
+        $4,
+        $5,
+        $6
+    )
+RETURNING id, instance_id, token_hash, max_uses, uses, created_by, expires_at, revoked_at, created_at
+`
+
+type CreateInstanceInviteParams struct {
+	ID         string
+	InstanceID string
+	Token      string
+	MaxUses    int32
+	CreatedBy  string
+	ExpiresAt  time.Time
+}
+
+// only the hash of the token is stored, see InstanceInviteByToken.
+func (q *Queries) CreateInstanceInvite(ctx context.Context, arg CreateInstanceInviteParams) (InstanceInvite, error) {
+	row := q.db.QueryRow(ctx, createInstanceInvite,
+		arg.ID,
+		arg.InstanceID,
+		arg.Token,
+		arg.MaxUses,
+		arg.CreatedBy,
+		arg.ExpiresAt,
+	)
+	var i InstanceInvite
+	err := row.Scan(
+		&i.ID,
+		&i.InstanceID,
+		&i.TokenHash,
+		&i.MaxUses,
+		&i.Uses,
+		&i.CreatedBy,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createInstanceSchedule = `-- name: CreateInstanceSchedule :one
+/*
+ * INSTANCE SCHEDULES
+ */
+
+INSERT INTO instance_schedules
+    (id, chunk_id, flavor_version_id, start_cron, stop_cron, timezone, evaluated_at, created_by)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, chunk_id, flavor_version_id, start_cron, stop_cron, timezone, instance_id, evaluated_at, created_by, created_at
+`
+
+type CreateInstanceScheduleParams struct {
+	ID              string
+	ChunkID         string
+	FlavorVersionID string
+	StartCron       string
+	StopCron        string
+	Timezone        string
+	EvaluatedAt     time.Time
+	CreatedBy       string
+}
+
+func (q *Queries) CreateInstanceSchedule(ctx context.Context, arg CreateInstanceScheduleParams) (InstanceSchedule, error) {
+	row := q.db.QueryRow(ctx, createInstanceSchedule,
+		arg.ID,
+		arg.ChunkID,
+		arg.FlavorVersionID,
+		arg.StartCron,
+		arg.StopCron,
+		arg.Timezone,
+		arg.EvaluatedAt,
+		arg.CreatedBy,
+	)
+	var i InstanceSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.ChunkID,
+		&i.FlavorVersionID,
+		&i.StartCron,
+		&i.StopCron,
+		&i.Timezone,
+		&i.InstanceID,
+		&i.EvaluatedAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createInstanceUsage = `-- name: CreateInstanceUsage :exec
+/*
+ * INSTANCE USAGE
+ */
+
+INSERT INTO instance_usage
+    (instance_id, chunk_id, node_id, cpu_millis, memory_bytes, node_slots, started_at)
+SELECT
+    $1,
+    f.chunk_id,
+    n.id,
+    n.workload_cpu_millis,
+    n.workload_memory_bytes,
+    LEAST(n.slots, COALESCE(n.max_instances, n.slots)),
+    $2
+FROM flavor_versions v
+JOIN flavors f ON f.id = v.flavor_id
+JOIN nodes n ON n.id = $3
+WHERE v.id = $4
+`
+
+type CreateInstanceUsageParams struct {
+	InstanceID      string
+	StartedAt       time.Time
+	NodeID          string
+	FlavorVersionID string
+}
+
+// copies the resources and slots of the node the instance is scheduled to,
+// so the usage does not change if the node is reconfigured later on.
+func (q *Queries) CreateInstanceUsage(ctx context.Context, arg CreateInstanceUsageParams) error {
+	_, err := q.db.Exec(ctx, createInstanceUsage,
+		arg.InstanceID,
+		arg.StartedAt,
+		arg.NodeID,
+		arg.FlavorVersionID,
+	)
+	return err
+}
+
+const createMinecraftVersion = `-- name: CreateMinecraftVersion :exec
+INSERT INTO minecraft_versions
+    (version, image_url)
+VALUES
+    ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type CreateMinecraftVersionParams struct {
+	Version  string
+	ImageUrl string
+}
+
+func (q *Queries) CreateMinecraftVersion(ctx context.Context, arg CreateMinecraftVersionParams) error {
+	_, err := q.db.Exec(ctx, createMinecraftVersion, arg.Version, arg.ImageUrl)
+	return err
+}
+
+const createMinecraftVersionJavaImage = `-- name: CreateMinecraftVersionJavaImage :exec
+INSERT INTO minecraft_version_java_images
+    (minecraft_version, java_version, image_url)
+VALUES
+    ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type CreateMinecraftVersionJavaImageParams struct {
+	MinecraftVersion string
+	JavaVersion      int32
+	ImageUrl         string
+}
+
+func (q *Queries) CreateMinecraftVersionJavaImage(ctx context.Context, arg CreateMinecraftVersionJavaImageParams) error {
+	_, err := q.db.Exec(ctx, createMinecraftVersionJavaImage, arg.MinecraftVersion, arg.JavaVersion, arg.ImageUrl)
+	return err
+}
+
+const createMonthlyPartition = `-- name: CreateMonthlyPartition :exec
+/*
+ * PARTITIONS
+ */
+
+SELECT create_monthly_partition($1, $2)
+`
+
+type CreateMonthlyPartitionParams struct {
+	ParentTable string
+	MonthOf     time.Time
+}
+
+func (q *Queries) CreateMonthlyPartition(ctx context.Context, arg CreateMonthlyPartitionParams) error {
+	_, err := q.db.Exec(ctx, createMonthlyPartition, arg.ParentTable, arg.MonthOf)
+	return err
+}
+
+const createSigningKey = `-- name: CreateSigningKey :exec
+INSERT INTO signing_keys
+    (id, private_key, created_at)
+VALUES
+    ($1, $2, $3)
+`
+
+type CreateSigningKeyParams struct {
+	ID         string
+	PrivateKey string
+	CreatedAt  time.Time
+}
+
+func (q *Queries) CreateSigningKey(ctx context.Context, arg CreateSigningKeyParams) error {
+	_, err := q.db.Exec(ctx, createSigningKey, arg.ID, arg.PrivateKey, arg.CreatedAt)
+	return err
+}
+
+const createUser = `-- name: CreateUser :exec
+INSERT INTO users
+    (id, nickname, email, created_at, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5)
+`
+
+type CreateUserParams struct {
+	ID        string
+	Nickname  string
+	Email     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
+	_, err := q.db.Exec(ctx, createUser,
+		arg.ID,
+		arg.Nickname,
+		arg.Email,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const crossInstanceTrafficApprovedByFlavorID = `-- name: CrossInstanceTrafficApprovedByFlavorID :one
+SELECT EXISTS(
+    SELECT 1 FROM chunk_cross_instance_traffic t
+    JOIN flavors f ON f.chunk_id = t.chunk_id
+    WHERE f.id = $1 AND t.state = 'APPROVED'
+)
+`
+
+func (q *Queries) CrossInstanceTrafficApprovedByFlavorID(ctx context.Context, id string) (bool, error) {
+	row := q.db.QueryRow(ctx, crossInstanceTrafficApprovedByFlavorID, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const crossInstanceTrafficByChunkID = `-- name: CrossInstanceTrafficByChunkID :one
+SELECT chunk_id, state, reason, requested_by, reviewed_by, review_message, created_at, updated_at FROM chunk_cross_instance_traffic WHERE chunk_id = $1
+`
+
+func (q *Queries) CrossInstanceTrafficByChunkID(ctx context.Context, chunkID string) (ChunkCrossInstanceTraffic, error) {
+	row := q.db.QueryRow(ctx, crossInstanceTrafficByChunkID, chunkID)
+	var i ChunkCrossInstanceTraffic
+	err := row.Scan(
+		&i.ChunkID,
+		&i.State,
+		&i.Reason,
+		&i.RequestedBy,
+		&i.ReviewedBy,
+		&i.ReviewMessage,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const crossInstanceTrafficByState = `-- name: CrossInstanceTrafficByState :many
+SELECT t.chunk_id, t.state, t.reason, t.requested_by, t.reviewed_by, t.review_message, t.created_at, t.updated_at FROM chunk_cross_instance_traffic t
+JOIN chunks c ON c.id = t.chunk_id
+WHERE t.state = $1 AND c.deleted_at IS NULL
+ORDER BY t.updated_at
+`
+
+func (q *Queries) CrossInstanceTrafficByState(ctx context.Context, state CrossInstanceTrafficState) ([]ChunkCrossInstanceTraffic, error) {
+	rows, err := q.db.Query(ctx, crossInstanceTrafficByState, state)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChunkCrossInstanceTraffic
+	for rows.Next() {
+		var i ChunkCrossInstanceTraffic
+		if err := rows.Scan(
+			&i.ChunkID,
+			&i.State,
+			&i.Reason,
+			&i.RequestedBy,
+			&i.ReviewedBy,
+			&i.ReviewMessage,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteAnnouncement = `-- name: DeleteAnnouncement :execrows
+DELETE FROM announcements WHERE id = $1
+`
+
+func (q *Queries) DeleteAnnouncement(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAnnouncement, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteArchivedChunksByOwnerID = `-- name: DeleteArchivedChunksByOwnerID :exec
+DELETE FROM chunk_archive WHERE owner_id = $1
+`
+
+func (q *Queries) DeleteArchivedChunksByOwnerID(ctx context.Context, ownerID string) error {
+	_, err := q.db.Exec(ctx, deleteArchivedChunksByOwnerID, ownerID)
+	return err
+}
+
+const deleteAutoscalingPolicy = `-- name: DeleteAutoscalingPolicy :exec
+DELETE FROM autoscaling_policies WHERE flavor_id = $1
+`
+
+func (q *Queries) DeleteAutoscalingPolicy(ctx context.Context, flavorID string) error {
+	_, err := q.db.Exec(ctx, deleteAutoscalingPolicy, flavorID)
+	return err
+}
+
+const deleteChunk = `-- name: DeleteChunk :exec
+DELETE FROM chunks WHERE id = $1
+`
+
+func (q *Queries) DeleteChunk(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteChunk, id)
+	return err
+}
+
+const deleteChunkMedia = `-- name: DeleteChunkMedia :execrows
+DELETE FROM chunk_media WHERE id = $1
+`
+
+func (q *Queries) DeleteChunkMedia(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteChunkMedia, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteChunkTemplate = `-- name: DeleteChunkTemplate :execrows
+DELETE FROM chunk_templates WHERE id = $1
+`
+
+func (q *Queries) DeleteChunkTemplate(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteChunkTemplate, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCrossInstanceTraffic = `-- name: DeleteCrossInstanceTraffic :execrows
+DELETE FROM chunk_cross_instance_traffic WHERE chunk_id = $1
+`
+
+func (q *Queries) DeleteCrossInstanceTraffic(ctx context.Context, chunkID string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCrossInstanceTraffic, chunkID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExpiredInstanceRedirects = `-- name: DeleteExpiredInstanceRedirects :exec
+DELETE FROM instance_redirects WHERE expires_at <= now()
+`
+
+func (q *Queries) DeleteExpiredInstanceRedirects(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredInstanceRedirects)
+	return err
+}
+
+const deleteExpiredRevokedTokens = `-- name: DeleteExpiredRevokedTokens :exec
+DELETE FROM revoked_tokens WHERE expires_at < now()
+`
+
+func (q *Queries) DeleteExpiredRevokedTokens(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredRevokedTokens)
+	return err
+}
+
+const deleteFlavor = `-- name: DeleteFlavor :exec
+DELETE FROM flavors WHERE id = $1
+`
+
+func (q *Queries) DeleteFlavor(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteFlavor, id)
+	return err
+}
+
+const deleteFlavorVersion = `-- name: DeleteFlavorVersion :exec
+DELETE FROM flavor_versions WHERE id = $1
+`
+
+func (q *Queries) DeleteFlavorVersion(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteFlavorVersion, id)
+	return err
+}
+
+const deleteInstanceLogs = `-- name: DeleteInstanceLogs :exec
+DELETE FROM instance_logs WHERE id = ANY($1::uuid[])
+`
+
+func (q *Queries) DeleteInstanceLogs(ctx context.Context, ids []string) error {
+	_, err := q.db.Exec(ctx, deleteInstanceLogs, ids)
+	return err
+}
+
+const deleteInstancePlayer = `-- name: DeleteInstancePlayer :exec
+DELETE FROM instance_players WHERE instance_id = $1 AND player_id = $2
+`
+
+type DeleteInstancePlayerParams struct {
+	InstanceID string
+	PlayerID   string
+}
+
+func (q *Queries) DeleteInstancePlayer(ctx context.Context, arg DeleteInstancePlayerParams) error {
+	_, err := q.db.Exec(ctx, deleteInstancePlayer, arg.InstanceID, arg.PlayerID)
+	return err
+}
+
+const deleteInstanceSchedule = `-- name: DeleteInstanceSchedule :exec
+DELETE FROM instance_schedules WHERE id = $1
+`
+
+func (q *Queries) DeleteInstanceSchedule(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteInstanceSchedule, id)
+	return err
+}
+
+const deleteJoinMissesBefore = `-- name: DeleteJoinMissesBefore :exec
+DELETE FROM join_misses WHERE created_at < $1
+`
+
+func (q *Queries) DeleteJoinMissesBefore(ctx context.Context, createdAt time.Time) error {
+	_, err := q.db.Exec(ctx, deleteJoinMissesBefore, createdAt)
+	return err
+}
+
+const deleteNodeCondition = `-- name: DeleteNodeCondition :exec
+DELETE FROM node_conditions WHERE node_id = $1 AND type = $2
+`
+
+type DeleteNodeConditionParams struct {
+	NodeID string
+	Type   NodeConditionType
+}
+
+func (q *Queries) DeleteNodeCondition(ctx context.Context, arg DeleteNodeConditionParams) error {
+	_, err := q.db.Exec(ctx, deleteNodeCondition, arg.NodeID, arg.Type)
+	return err
+}
+
+const deleteNotificationPreferences = `-- name: DeleteNotificationPreferences :exec
+DELETE FROM notification_preferences WHERE user_id = $1
+`
+
+func (q *Queries) DeleteNotificationPreferences(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, deleteNotificationPreferences, userID)
+	return err
+}
+
+const deleteSigningKeys = `-- name: DeleteSigningKeys :exec
+DELETE FROM signing_keys WHERE id = ANY($1::text[])
+`
+
+func (q *Queries) DeleteSigningKeys(ctx context.Context, ids []string) error {
+	_, err := q.db.Exec(ctx, deleteSigningKeys, ids)
+	return err
+}
+
+const dropMonthlyPartitionsBefore = `-- name: DropMonthlyPartitionsBefore :many
+SELECT drop_monthly_partitions_before($1, $2)::text AS name
+`
+
+type DropMonthlyPartitionsBeforeParams struct {
+	ParentTable string
+	Cutoff      time.Time
+}
+
+func (q *Queries) DropMonthlyPartitionsBefore(ctx context.Context, arg DropMonthlyPartitionsBeforeParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, dropMonthlyPartitionsBefore, arg.ParentTable, arg.Cutoff)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const expiredInstanceLogs = `-- name: ExpiredInstanceLogs :many
+SELECT id, instance_id, owner_id, object_key, size_bytes, first_line_at, last_line_at, created_at, expires_at FROM instance_logs
+WHERE expires_at <= $1
+ORDER BY expires_at
+LIMIT $2
+`
+
+type ExpiredInstanceLogsParams struct {
+	ExpiresAt time.Time
+	Limit     int32
+}
+
+func (q *Queries) ExpiredInstanceLogs(ctx context.Context, arg ExpiredInstanceLogsParams) ([]InstanceLog, error) {
+	rows, err := q.db.Query(ctx, expiredInstanceLogs, arg.ExpiresAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceLog
+	for rows.Next() {
+		var i InstanceLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.InstanceID,
+			&i.OwnerID,
+			&i.ObjectKey,
+			&i.SizeBytes,
+			&i.FirstLineAt,
+			&i.LastLineAt,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const extendInstanceLogRetention = `-- name: ExtendInstanceLogRetention :exec
+UPDATE instance_logs SET expires_at = $2 WHERE instance_id = $1
+`
+
+type ExtendInstanceLogRetentionParams struct {
+	InstanceID string
+	ExpiresAt  time.Time
+}
+
+// logs are retained per instance, so all files of the instance
+// expire together once it did not log anything for a while.
+func (q *Queries) ExtendInstanceLogRetention(ctx context.Context, arg ExtendInstanceLogRetentionParams) error {
+	_, err := q.db.Exec(ctx, extendInstanceLogRetention, arg.InstanceID, arg.ExpiresAt)
+	return err
+}
+
+const flavorChannel = `-- name: FlavorChannel :one
+SELECT flavor_id, name, flavor_version_id, updated_at FROM flavor_channels WHERE flavor_id = $1 AND name = $2
+`
+
+type FlavorChannelParams struct {
+	FlavorID string
+	Name     string
+}
+
+func (q *Queries) FlavorChannel(ctx context.Context, arg FlavorChannelParams) (FlavorChannel, error) {
+	row := q.db.QueryRow(ctx, flavorChannel, arg.FlavorID, arg.Name)
+	var i FlavorChannel
+	err := row.Scan(
+		&i.FlavorID,
+		&i.Name,
+		&i.FlavorVersionID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const flavorChannelsByFlavorID = `-- name: FlavorChannelsByFlavorID :many
+SELECT flavor_id, name, flavor_version_id, updated_at FROM flavor_channels WHERE flavor_id = $1 ORDER BY name
+`
+
+func (q *Queries) FlavorChannelsByFlavorID(ctx context.Context, flavorID string) ([]FlavorChannel, error) {
+	rows, err := q.db.Query(ctx, flavorChannelsByFlavorID, flavorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FlavorChannel
+	for rows.Next() {
+		var i FlavorChannel
+		if err := rows.Scan(
+			&i.FlavorID,
+			&i.Name,
+			&i.FlavorVersionID,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const flavorDemand = `-- name: FlavorDemand :one
+SELECT
+    (SELECT count(*) FROM instance_players ip
+        JOIN instances i ON i.id = ip.instance_id
+        JOIN flavor_versions v ON v.id = i.flavor_version_id
+        WHERE v.flavor_id = f.id AND i.state = 'RUNNING')::integer AS players,
+    (SELECT count(*) FROM join_misses m
+        WHERE m.chunk_id = f.chunk_id
+          AND m.created_at >= $1
+          AND (m.flavor_name IS NULL OR m.flavor_name = f.name))::integer AS queued_players,
+    (SELECT count(*) FROM instances i
+        JOIN flavor_versions v ON v.id = i.flavor_version_id
+        WHERE v.flavor_id = f.id
+          AND i.state IN ('PENDING', 'CREATING', 'RUNNING')
+          AND NOT EXISTS (SELECT 1 FROM autoscaled_instances a WHERE a.instance_id = i.id))::integer AS manual_instances
+FROM flavors f WHERE f.id = $2
+`
+
+type FlavorDemandParams struct {
+	Since    time.Time
+	FlavorID string
+}
+
+type FlavorDemandRow struct {
+	Players         int32
+	QueuedPlayers   int32
+	ManualInstances int32
+}
+
+// players are counted for running instances only, while instances
+// started by hand are counted until they are being deleted, because
+// they are going to serve players once they are running.
+func (q *Queries) FlavorDemand(ctx context.Context, arg FlavorDemandParams) (FlavorDemandRow, error) {
+	row := q.db.QueryRow(ctx, flavorDemand, arg.Since, arg.FlavorID)
+	var i FlavorDemandRow
+	err := row.Scan(&i.Players, &i.QueuedPlayers, &i.ManualInstances)
+	return i, err
+}
+
+const flavorIDByFlavorVersionID = `-- name: FlavorIDByFlavorVersionID :one
+SELECT flavor_id FROM flavor_versions WHERE id = $1
+`
+
+func (q *Queries) FlavorIDByFlavorVersionID(ctx context.Context, id string) (string, error) {
+	row := q.db.QueryRow(ctx, flavorIDByFlavorVersionID, id)
+	var flavor_id string
+	err := row.Scan(&flavor_id)
+	return flavor_id, err
+}
+
+const flavorNameExists = `-- name: FlavorNameExists :one
+SELECT EXISTS(
+    SELECT 1 FROM flavors
+    WHERE name = $1 AND chunk_id = $2
+)
+`
+
+type FlavorNameExistsParams struct {
+	Name    string
+	ChunkID string
+}
+
+func (q *Queries) FlavorNameExists(ctx context.Context, arg FlavorNameExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, flavorNameExists, arg.Name, arg.ChunkID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const flavorVersionAssetHashes = `-- name: FlavorVersionAssetHashes :many
+SELECT asset_hash FROM flavor_version_assets
+WHERE flavor_version_id = $1
+ORDER BY position
+`
+
+func (q *Queries) FlavorVersionAssetHashes(ctx context.Context, flavorVersionID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, flavorVersionAssetHashes, flavorVersionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var asset_hash string
+		if err := rows.Scan(&asset_hash); err != nil {
+			return nil, err
+		}
+		items = append(items, asset_hash)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const flavorVersionByID = `-- name: FlavorVersionByID :many
+SELECT id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id, v.created_at, presigned_url_expiry_date, presigned_url, minecraft_version, min_players, max_players, checkpoint_cpu_millis, checkpoint_memory_limit_bytes, checkpoint_node_id, build_error, build_progress, startup_grace_period_seconds, restart_policy, restart_max_retries, restore_latency_ms, java_version, startup_command, flavor_version_id, file_hash, file_path, f.created_at, size_bytes FROM flavor_versions v
+    JOIN flavor_version_files f ON f.flavor_version_id = v.id
+WHERE id = $1
+`
+
+type FlavorVersionByIDRow struct {
+	ID                         string
+	FlavorID                   string
+	Hash                       string
+	BuildStatus                BuildStatus
+	Version                    string
+	FilesUploaded              bool
+	PrevVersionID              *string
+	CreatedAt                  time.Time
+	PresignedUrlExpiryDate     pgtype.Timestamptz
+	PresignedUrl               pgtype.Text
+	MinecraftVersion           string
+	MinPlayers                 int32
+	MaxPlayers                 int32
+	CheckpointCpuMillis        *int32
+	CheckpointMemoryLimitBytes *int64
+	CheckpointNodeID           *string
+	BuildError                 pgtype.Text
+	BuildProgress              int16
+	StartupGracePeriodSeconds  int32
+	RestartPolicy              RestartPolicy
+	RestartMaxRetries          int32
+	RestoreLatencyMs           pgtype.Int4
+	JavaVersion                int32
+	StartupCommand             string
+	FlavorVersionID            string
+	FileHash                   string
+	FilePath                   string
+	CreatedAt_2                time.Time
+	SizeBytes                  int64
+}
+
+func (q *Queries) FlavorVersionByID(ctx context.Context, id string) ([]FlavorVersionByIDRow, error) {
+	rows, err := q.db.Query(ctx, flavorVersionByID, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FlavorVersionByIDRow
+	for rows.Next() {
+		var i FlavorVersionByIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FlavorID,
+			&i.Hash,
+			&i.BuildStatus,
+			&i.Version,
+			&i.FilesUploaded,
+			&i.PrevVersionID,
+			&i.CreatedAt,
+			&i.PresignedUrlExpiryDate,
+			&i.PresignedUrl,
+			&i.MinecraftVersion,
+			&i.MinPlayers,
+			&i.MaxPlayers,
+			&i.CheckpointCpuMillis,
+			&i.CheckpointMemoryLimitBytes,
+			&i.CheckpointNodeID,
+			&i.BuildError,
+			&i.BuildProgress,
+			&i.StartupGracePeriodSeconds,
+			&i.RestartPolicy,
+			&i.RestartMaxRetries,
+			&i.RestoreLatencyMs,
+			&i.JavaVersion,
+			&i.StartupCommand,
+			&i.FlavorVersionID,
+			&i.FileHash,
+			&i.FilePath,
+			&i.CreatedAt_2,
+			&i.SizeBytes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const flavorVersionExists = `-- name: FlavorVersionExists :one
+SELECT EXISTS(
+    SELECT 1 FROM flavor_versions
+    WHERE version = $1 AND flavor_id = $2
+)
+`
+
+type FlavorVersionExistsParams struct {
+	Version  string
+	FlavorID string
+}
+
+func (q *Queries) FlavorVersionExists(ctx context.Context, arg FlavorVersionExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, flavorVersionExists, arg.Version, arg.FlavorID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const flavorVersionFileHashes = `-- name: FlavorVersionFileHashes :many
+SELECT flavor_version_id, file_hash, file_path, created_at, size_bytes FROM flavor_version_files WHERE flavor_version_id = $1
+`
+
+func (q *Queries) FlavorVersionFileHashes(ctx context.Context, flavorVersionID string) ([]FlavorVersionFile, error) {
+	rows, err := q.db.Query(ctx, flavorVersionFileHashes, flavorVersionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FlavorVersionFile
+	for rows.Next() {
+		var i FlavorVersionFile
+		if err := rows.Scan(
+			&i.FlavorVersionID,
+			&i.FileHash,
+			&i.FilePath,
+			&i.CreatedAt,
+			&i.SizeBytes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const flavorVersionHashByID = `-- name: FlavorVersionHashByID :one
+SELECT hash FROM flavor_versions WHERE id = $1
+`
+
+func (q *Queries) FlavorVersionHashByID(ctx context.Context, id string) (string, error) {
+	row := q.db.QueryRow(ctx, flavorVersionHashByID, id)
+	var hash string
+	err := row.Scan(&hash)
+	return hash, err
+}
+
+const flavorVersionProvenance = `-- name: FlavorVersionProvenance :one
+SELECT flavor_version_id, base_image_digest, image_digest, builder_version, input_hash, plugins, image_built_at, checkpoint_node_id, platformd_version, checkpoint_built_at FROM flavor_version_provenance WHERE flavor_version_id = $1
+`
+
+func (q *Queries) FlavorVersionProvenance(ctx context.Context, flavorVersionID string) (FlavorVersionProvenance, error) {
+	row := q.db.QueryRow(ctx, flavorVersionProvenance, flavorVersionID)
+	var i FlavorVersionProvenance
+	err := row.Scan(
+		&i.FlavorVersionID,
+		&i.BaseImageDigest,
+		&i.ImageDigest,
+		&i.BuilderVersion,
+		&i.InputHash,
+		&i.Plugins,
+		&i.ImageBuiltAt,
+		&i.CheckpointNodeID,
+		&i.PlatformdVersion,
+		&i.CheckpointBuiltAt,
+	)
+	return i, err
+}
+
+const getChunkByID = `-- name: GetChunkByID :many
+SELECT c.id, c.name, description, tags, c.created_at, c.updated_at, owner_id, thumbnail_hash, thumbnail_updated_at, c.deleted_at, f.id, chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at, v.id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id, v.created_at, presigned_url_expiry_date, presigned_url, minecraft_version, min_players, max_players, checkpoint_cpu_millis, checkpoint_memory_limit_bytes, checkpoint_node_id, build_error, build_progress, startup_grace_period_seconds, restart_policy, restart_max_retries, restore_latency_ms, java_version, startup_command, flavor_version_id, file_hash, file_path, vf.created_at, size_bytes, u.id, nickname, email, u.created_at, u.updated_at FROM chunks c
+    LEFT JOIN flavors f ON f.chunk_id = c.id
+    LEFT JOIN flavor_versions v ON v.flavor_id = f.id
+    LEFT JOIN flavor_version_files vf ON vf.flavor_version_id = v.id
+    LEFT JOIN users u ON u.id = c.owner_id
+WHERE c.id = $1
+`
+
+type GetChunkByIDRow struct {
+	ID                         string
+	Name                       string
+	Description                string
+	Tags                       []string
+	CreatedAt                  time.Time
+	UpdatedAt                  time.Time
+	OwnerID                    string
+	ThumbnailHash              pgtype.Text
+	ThumbnailUpdatedAt         time.Time
+	DeletedAt                  pgtype.Timestamptz
+	ID_2                       *string
+	ChunkID                    *string
+	Name_2                     pgtype.Text
+	CreatedAt_2                pgtype.Timestamptz
+	UpdatedAt_2                pgtype.Timestamptz
+	DeletedAt_2                pgtype.Timestamptz
+	ID_3                       *string
+	FlavorID                   *string
+	Hash                       pgtype.Text
+	BuildStatus                NullBuildStatus
+	Version                    pgtype.Text
+	FilesUploaded              pgtype.Bool
+	PrevVersionID              *string
+	CreatedAt_3                pgtype.Timestamptz
+	PresignedUrlExpiryDate     pgtype.Timestamptz
+	PresignedUrl               pgtype.Text
+	MinecraftVersion           pgtype.Text
+	MinPlayers                 pgtype.Int4
+	MaxPlayers                 pgtype.Int4
+	CheckpointCpuMillis        *int32
+	CheckpointMemoryLimitBytes *int64
+	CheckpointNodeID           *string
+	BuildError                 pgtype.Text
+	BuildProgress              pgtype.Int2
+	StartupGracePeriodSeconds  pgtype.Int4
+	RestartPolicy              NullRestartPolicy
+	RestartMaxRetries          pgtype.Int4
+	RestoreLatencyMs           pgtype.Int4
+	JavaVersion                pgtype.Int4
+	StartupCommand             pgtype.Text
+	FlavorVersionID            *string
+	FileHash                   pgtype.Text
+	FilePath                   pgtype.Text
+	CreatedAt_4                pgtype.Timestamptz
+	SizeBytes                  pgtype.Int8
+	ID_4                       *string
+	Nickname                   pgtype.Text
+	Email                      pgtype.Text
+	CreatedAt_5                pgtype.Timestamptz
+	UpdatedAt_3                pgtype.Timestamptz
+}
+
+// TODO: read multiple
+func (q *Queries) GetChunkByID(ctx context.Context, id string) ([]GetChunkByIDRow, error) {
+	rows, err := q.db.Query(ctx, getChunkByID, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetChunkByIDRow
+	for rows.Next() {
+		var i GetChunkByIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Tags,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OwnerID,
+			&i.ThumbnailHash,
+			&i.ThumbnailUpdatedAt,
+			&i.DeletedAt,
+			&i.ID_2,
+			&i.ChunkID,
+			&i.Name_2,
+			&i.CreatedAt_2,
+			&i.UpdatedAt_2,
+			&i.DeletedAt_2,
+			&i.ID_3,
+			&i.FlavorID,
+			&i.Hash,
+			&i.BuildStatus,
+			&i.Version,
+			&i.FilesUploaded,
+			&i.PrevVersionID,
+			&i.CreatedAt_3,
+			&i.PresignedUrlExpiryDate,
+			&i.PresignedUrl,
+			&i.MinecraftVersion,
+			&i.MinPlayers,
+			&i.MaxPlayers,
+			&i.CheckpointCpuMillis,
+			&i.CheckpointMemoryLimitBytes,
+			&i.CheckpointNodeID,
+			&i.BuildError,
+			&i.BuildProgress,
+			&i.StartupGracePeriodSeconds,
+			&i.RestartPolicy,
+			&i.RestartMaxRetries,
+			&i.RestoreLatencyMs,
+			&i.JavaVersion,
+			&i.StartupCommand,
+			&i.FlavorVersionID,
+			&i.FileHash,
+			&i.FilePath,
+			&i.CreatedAt_4,
+			&i.SizeBytes,
+			&i.ID_4,
+			&i.Nickname,
+			&i.Email,
+			&i.CreatedAt_5,
+			&i.UpdatedAt_3,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFlavorByID = `-- name: GetFlavorByID :many
+SELECT f.id, chunk_id, name, f.created_at, updated_at, deleted_at, fv.id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id, fv.created_at, presigned_url_expiry_date, presigned_url, minecraft_version, min_players, max_players, checkpoint_cpu_millis, checkpoint_memory_limit_bytes, checkpoint_node_id, build_error, build_progress, startup_grace_period_seconds, restart_policy, restart_max_retries, restore_latency_ms, java_version, startup_command FROM flavors f
+    LEFT JOIN flavor_versions fv ON fv.flavor_id = $1
+WHERE f.id = $1
+`
+
+type GetFlavorByIDRow struct {
+	ID                         string
+	ChunkID                    string
+	Name                       string
+	CreatedAt                  time.Time
+	UpdatedAt                  time.Time
+	DeletedAt                  pgtype.Timestamptz
+	ID_2                       *string
+	FlavorID                   *string
+	Hash                       pgtype.Text
+	BuildStatus                NullBuildStatus
+	Version                    pgtype.Text
+	FilesUploaded              pgtype.Bool
+	PrevVersionID              *string
+	CreatedAt_2                pgtype.Timestamptz
+	PresignedUrlExpiryDate     pgtype.Timestamptz
+	PresignedUrl               pgtype.Text
+	MinecraftVersion           pgtype.Text
+	MinPlayers                 pgtype.Int4
+	MaxPlayers                 pgtype.Int4
+	CheckpointCpuMillis        *int32
+	CheckpointMemoryLimitBytes *int64
+	CheckpointNodeID           *string
+	BuildError                 pgtype.Text
+	BuildProgress              pgtype.Int2
+	StartupGracePeriodSeconds  pgtype.Int4
+	RestartPolicy              NullRestartPolicy
+	RestartMaxRetries          pgtype.Int4
+	RestoreLatencyMs           pgtype.Int4
+	JavaVersion                pgtype.Int4
+	StartupCommand             pgtype.Text
+}
+
+func (q *Queries) GetFlavorByID(ctx context.Context, flavorID string) ([]GetFlavorByIDRow, error) {
+	rows, err := q.db.Query(ctx, getFlavorByID, flavorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFlavorByIDRow
+	for rows.Next() {
+		var i GetFlavorByIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChunkID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ID_2,
+			&i.FlavorID,
+			&i.Hash,
+			&i.BuildStatus,
+			&i.Version,
+			&i.FilesUploaded,
+			&i.PrevVersionID,
+			&i.CreatedAt_2,
+			&i.PresignedUrlExpiryDate,
+			&i.PresignedUrl,
+			&i.MinecraftVersion,
+			&i.MinPlayers,
+			&i.MaxPlayers,
+			&i.CheckpointCpuMillis,
+			&i.CheckpointMemoryLimitBytes,
+			&i.CheckpointNodeID,
+			&i.BuildError,
+			&i.BuildProgress,
+			&i.StartupGracePeriodSeconds,
+			&i.RestartPolicy,
+			&i.RestartMaxRetries,
+			&i.RestoreLatencyMs,
+			&i.JavaVersion,
+			&i.StartupCommand,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInstance = `-- name: GetInstance :many
+SELECT
+    v.id, v.flavor_id, v.hash, v.build_status, v.version, v.files_uploaded, v.prev_version_id, v.created_at, v.presigned_url_expiry_date, v.presigned_url, v.minecraft_version, v.min_players, v.max_players, v.checkpoint_cpu_millis, v.checkpoint_memory_limit_bytes, v.checkpoint_node_id, v.build_error, v.build_progress, v.startup_grace_period_seconds, v.restart_policy, v.restart_max_retries, v.restore_latency_ms, v.java_version, v.startup_command,
+    c.id, c.name, c.description, c.tags, c.created_at, c.updated_at, c.owner_id, c.thumbnail_hash, c.thumbnail_updated_at, c.deleted_at,
+    f.id, f.chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at,
+    n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots, n.capabilities, n.last_seen_at, n.max_instances, n.platformd_version, n.desired_platformd_version, n.platformd_update_status, n.platformd_update_message, n.platformd_updated_at, n.workload_cpu_millis, n.workload_memory_bytes, n.lost_at,
+    u.id, u.nickname, u.email, u.created_at, u.updated_at,
+    i.id, i.flavor_version_id, i.node_id, i.port, i.state, i.created_at, i.updated_at, i.owner_id, i.ordered_by, i.revision, i.state_changed_at, i.traffic_connections, i.traffic_rx_bytes, i.traffic_tx_bytes, i.world_seed, i.game_mode, i.custom_params, i.placement_group, i.cross_instance_traffic
+FROM instances i
+    JOIN flavor_versions v ON i.flavor_version_id = v.id
+    JOIN flavors f ON f.id = v.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN nodes n ON i.node_id = n.id
+    JOIN users u ON u.id = i.owner_id
+WHERE i.id = $1
+`
+
+type GetInstanceRow struct {
+	FlavorVersion FlavorVersion
+	Chunk         Chunk
+	Flavor        Flavor
+	Node          Node
+	User          User
+	Instance      Instance
+}
+
+func (q *Queries) GetInstance(ctx context.Context, id string) ([]GetInstanceRow, error) {
+	rows, err := q.db.Query(ctx, getInstance, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetInstanceRow
+	for rows.Next() {
+		var i GetInstanceRow
+		if err := rows.Scan(
+			&i.FlavorVersion.ID,
+			&i.FlavorVersion.FlavorID,
+			&i.FlavorVersion.Hash,
+			&i.FlavorVersion.BuildStatus,
+			&i.FlavorVersion.Version,
+			&i.FlavorVersion.FilesUploaded,
+			&i.FlavorVersion.PrevVersionID,
+			&i.FlavorVersion.CreatedAt,
+			&i.FlavorVersion.PresignedUrlExpiryDate,
+			&i.FlavorVersion.PresignedUrl,
+			&i.FlavorVersion.MinecraftVersion,
+			&i.FlavorVersion.MinPlayers,
+			&i.FlavorVersion.MaxPlayers,
+			&i.FlavorVersion.CheckpointCpuMillis,
+			&i.FlavorVersion.CheckpointMemoryLimitBytes,
+			&i.FlavorVersion.CheckpointNodeID,
+			&i.FlavorVersion.BuildError,
+			&i.FlavorVersion.BuildProgress,
+			&i.FlavorVersion.StartupGracePeriodSeconds,
+			&i.FlavorVersion.RestartPolicy,
+			&i.FlavorVersion.RestartMaxRetries,
+			&i.FlavorVersion.RestoreLatencyMs,
+			&i.FlavorVersion.JavaVersion,
+			&i.FlavorVersion.StartupCommand,
+			&i.Chunk.ID,
+			&i.Chunk.Name,
+			&i.Chunk.Description,
+			&i.Chunk.Tags,
+			&i.Chunk.CreatedAt,
+			&i.Chunk.UpdatedAt,
+			&i.Chunk.OwnerID,
+			&i.Chunk.ThumbnailHash,
+			&i.Chunk.ThumbnailUpdatedAt,
+			&i.Chunk.DeletedAt,
+			&i.Flavor.ID,
+			&i.Flavor.ChunkID,
+			&i.Flavor.Name,
+			&i.Flavor.CreatedAt,
+			&i.Flavor.UpdatedAt,
+			&i.Flavor.DeletedAt,
+			&i.Node.ID,
+			&i.Node.Name,
+			&i.Node.Address,
+			&i.Node.CheckpointApiEndpoint,
+			&i.Node.CreatedAt,
+			&i.Node.Slots,
+			&i.Node.Capabilities,
+			&i.Node.LastSeenAt,
+			&i.Node.MaxInstances,
+			&i.Node.PlatformdVersion,
+			&i.Node.DesiredPlatformdVersion,
+			&i.Node.PlatformdUpdateStatus,
+			&i.Node.PlatformdUpdateMessage,
+			&i.Node.PlatformdUpdatedAt,
+			&i.Node.WorkloadCpuMillis,
+			&i.Node.WorkloadMemoryBytes,
+			&i.Node.LostAt,
+			&i.User.ID,
+			&i.User.Nickname,
+			&i.User.Email,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.Instance.ID,
+			&i.Instance.FlavorVersionID,
+			&i.Instance.NodeID,
+			&i.Instance.Port,
+			&i.Instance.State,
+			&i.Instance.CreatedAt,
+			&i.Instance.UpdatedAt,
+			&i.Instance.OwnerID,
+			&i.Instance.OrderedBy,
+			&i.Instance.Revision,
+			&i.Instance.StateChangedAt,
+			&i.Instance.TrafficConnections,
+			&i.Instance.TrafficRxBytes,
+			&i.Instance.TrafficTxBytes,
+			&i.Instance.WorldSeed,
+			&i.Instance.GameMode,
+			&i.Instance.CustomParams,
+			&i.Instance.PlacementGroup,
+			&i.Instance.CrossInstanceTraffic,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInstanceEvents = `-- name: GetInstanceEvents :many
+SELECT id, instance_id, type, exit_code, message, created_at FROM instance_events
+WHERE instance_id = $1
+  AND created_at >= $2
+  AND type IN ('OOM_KILLED', 'CRASHED', 'EXITED')
+ORDER BY created_at DESC
+LIMIT $3
+`
+
+type GetInstanceEventsParams struct {
+	InstanceID string
+	Since      time.Time
+	Limit      int32
+}
+
+// events cannot be older than their instance, so since allows
+// skipping the partitions of the months before it had been created.
+// only events describing why the instance stopped are returned.
+func (q *Queries) GetInstanceEvents(ctx context.Context, arg GetInstanceEventsParams) ([]InstanceEvent, error) {
+	rows, err := q.db.Query(ctx, getInstanceEvents, arg.InstanceID, arg.Since, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceEvent
+	for rows.Next() {
+		var i InstanceEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.InstanceID,
+			&i.Type,
+			&i.ExitCode,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInstanceRedirectsByNodeID = `-- name: GetInstanceRedirectsByNodeID :many
+SELECT node_id, from_port, instance_id, to_address, to_port, expires_at, created_at FROM instance_redirects
+WHERE node_id = $1 AND expires_at > now()
+ORDER BY from_port
+`
+
+func (q *Queries) GetInstanceRedirectsByNodeID(ctx context.Context, nodeID string) ([]InstanceRedirect, error) {
+	rows, err := q.db.Query(ctx, getInstanceRedirectsByNodeID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceRedirect
+	for rows.Next() {
+		var i InstanceRedirect
+		if err := rows.Scan(
+			&i.NodeID,
+			&i.FromPort,
+			&i.InstanceID,
+			&i.ToAddress,
+			&i.ToPort,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInstanceTimeline = `-- name: GetInstanceTimeline :many
+SELECT id, instance_id, type, exit_code, message, created_at FROM instance_events
+WHERE instance_id = $1 AND created_at >= $2
+ORDER BY created_at, id
+LIMIT $3
+`
+
+type GetInstanceTimelineParams struct {
+	InstanceID string
+	Since      time.Time
+	Limit      int32
+}
+
+func (q *Queries) GetInstanceTimeline(ctx context.Context, arg GetInstanceTimelineParams) ([]InstanceEvent, error) {
+	rows, err := q.db.Query(ctx, getInstanceTimeline, arg.InstanceID, arg.Since, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceEvent
+	for rows.Next() {
+		var i InstanceEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.InstanceID,
+			&i.Type,
+			&i.ExitCode,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInstancesByNodeID = `-- name: GetInstancesByNodeID :many
+SELECT
+    v.id, v.flavor_id, v.hash, v.build_status, v.version, v.files_uploaded, v.prev_version_id, v.created_at, v.presigned_url_expiry_date, v.presigned_url, v.minecraft_version, v.min_players, v.max_players, v.checkpoint_cpu_millis, v.checkpoint_memory_limit_bytes, v.checkpoint_node_id, v.build_error, v.build_progress, v.startup_grace_period_seconds, v.restart_policy, v.restart_max_retries, v.restore_latency_ms, v.java_version, v.startup_command,
+    c.id, c.name, c.description, c.tags, c.created_at, c.updated_at, c.owner_id, c.thumbnail_hash, c.thumbnail_updated_at, c.deleted_at,
+    f.id, f.chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at,
+    n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots, n.capabilities, n.last_seen_at, n.max_instances, n.platformd_version, n.desired_platformd_version, n.platformd_update_status, n.platformd_update_message, n.platformd_updated_at, n.workload_cpu_millis, n.workload_memory_bytes, n.lost_at,
+    u.id, u.nickname, u.email, u.created_at, u.updated_at,
+    i.id, i.flavor_version_id, i.node_id, i.port, i.state, i.created_at, i.updated_at, i.owner_id, i.ordered_by, i.revision, i.state_changed_at, i.traffic_connections, i.traffic_rx_bytes, i.traffic_tx_bytes, i.world_seed, i.game_mode, i.custom_params, i.placement_group, i.cross_instance_traffic
+FROM instances i
+    JOIN flavor_versions v ON i.flavor_version_id = v.id
+    JOIN flavors f ON f.id = v.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN nodes n ON i.node_id = n.id
+    JOIN users u ON u.id = i.owner_id
+WHERE i.node_id = $1 AND i.revision > $2
+`
+
+type GetInstancesByNodeIDParams struct {
+	NodeID        string
+	AfterRevision int64
+}
+
+type GetInstancesByNodeIDRow struct {
+	FlavorVersion FlavorVersion
+	Chunk         Chunk
+	Flavor        Flavor
+	Node          Node
+	User          User
+	Instance      Instance
+}
+
+func (q *Queries) GetInstancesByNodeID(ctx context.Context, arg GetInstancesByNodeIDParams) ([]GetInstancesByNodeIDRow, error) {
+	rows, err := q.db.Query(ctx, getInstancesByNodeID, arg.NodeID, arg.AfterRevision)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetInstancesByNodeIDRow
+	for rows.Next() {
+		var i GetInstancesByNodeIDRow
+		if err := rows.Scan(
+			&i.FlavorVersion.ID,
+			&i.FlavorVersion.FlavorID,
+			&i.FlavorVersion.Hash,
+			&i.FlavorVersion.BuildStatus,
+			&i.FlavorVersion.Version,
+			&i.FlavorVersion.FilesUploaded,
+			&i.FlavorVersion.PrevVersionID,
+			&i.FlavorVersion.CreatedAt,
+			&i.FlavorVersion.PresignedUrlExpiryDate,
+			&i.FlavorVersion.PresignedUrl,
+			&i.FlavorVersion.MinecraftVersion,
+			&i.FlavorVersion.MinPlayers,
+			&i.FlavorVersion.MaxPlayers,
+			&i.FlavorVersion.CheckpointCpuMillis,
+			&i.FlavorVersion.CheckpointMemoryLimitBytes,
+			&i.FlavorVersion.CheckpointNodeID,
+			&i.FlavorVersion.BuildError,
+			&i.FlavorVersion.BuildProgress,
+			&i.FlavorVersion.StartupGracePeriodSeconds,
+			&i.FlavorVersion.RestartPolicy,
+			&i.FlavorVersion.RestartMaxRetries,
+			&i.FlavorVersion.RestoreLatencyMs,
+			&i.FlavorVersion.JavaVersion,
+			&i.FlavorVersion.StartupCommand,
+			&i.Chunk.ID,
+			&i.Chunk.Name,
+			&i.Chunk.Description,
+			&i.Chunk.Tags,
+			&i.Chunk.CreatedAt,
+			&i.Chunk.UpdatedAt,
+			&i.Chunk.OwnerID,
+			&i.Chunk.ThumbnailHash,
+			&i.Chunk.ThumbnailUpdatedAt,
+			&i.Chunk.DeletedAt,
+			&i.Flavor.ID,
+			&i.Flavor.ChunkID,
+			&i.Flavor.Name,
+			&i.Flavor.CreatedAt,
+			&i.Flavor.UpdatedAt,
+			&i.Flavor.DeletedAt,
+			&i.Node.ID,
+			&i.Node.Name,
+			&i.Node.Address,
+			&i.Node.CheckpointApiEndpoint,
+			&i.Node.CreatedAt,
+			&i.Node.Slots,
+			&i.Node.Capabilities,
+			&i.Node.LastSeenAt,
+			&i.Node.MaxInstances,
+			&i.Node.PlatformdVersion,
+			&i.Node.DesiredPlatformdVersion,
+			&i.Node.PlatformdUpdateStatus,
+			&i.Node.PlatformdUpdateMessage,
+			&i.Node.PlatformdUpdatedAt,
+			&i.Node.WorkloadCpuMillis,
+			&i.Node.WorkloadMemoryBytes,
+			&i.Node.LostAt,
+			&i.User.ID,
+			&i.User.Nickname,
+			&i.User.Email,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.Instance.ID,
+			&i.Instance.FlavorVersionID,
+			&i.Instance.NodeID,
+			&i.Instance.Port,
+			&i.Instance.State,
+			&i.Instance.CreatedAt,
+			&i.Instance.UpdatedAt,
+			&i.Instance.OwnerID,
+			&i.Instance.OrderedBy,
+			&i.Instance.Revision,
+			&i.Instance.StateChangedAt,
+			&i.Instance.TrafficConnections,
+			&i.Instance.TrafficRxBytes,
+			&i.Instance.TrafficTxBytes,
+			&i.Instance.WorldSeed,
+			&i.Instance.GameMode,
+			&i.Instance.CustomParams,
+			&i.Instance.PlacementGroup,
+			&i.Instance.CrossInstanceTraffic,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInstancesByOwnerID = `-- name: GetInstancesByOwnerID :many
+SELECT
+    v.id, v.flavor_id, v.hash, v.build_status, v.version, v.files_uploaded, v.prev_version_id, v.created_at, v.presigned_url_expiry_date, v.presigned_url, v.minecraft_version, v.min_players, v.max_players, v.checkpoint_cpu_millis, v.checkpoint_memory_limit_bytes, v.checkpoint_node_id, v.build_error, v.build_progress, v.startup_grace_period_seconds, v.restart_policy, v.restart_max_retries, v.restore_latency_ms, v.java_version, v.startup_command,
+    c.id, c.name, c.description, c.tags, c.created_at, c.updated_at, c.owner_id, c.thumbnail_hash, c.thumbnail_updated_at, c.deleted_at,
+    f.id, f.chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at,
+    n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots, n.capabilities, n.last_seen_at, n.max_instances, n.platformd_version, n.desired_platformd_version, n.platformd_update_status, n.platformd_update_message, n.platformd_updated_at, n.workload_cpu_millis, n.workload_memory_bytes, n.lost_at,
+    u.id, u.nickname, u.email, u.created_at, u.updated_at,
+    i.id, i.flavor_version_id, i.node_id, i.port, i.state, i.created_at, i.updated_at, i.owner_id, i.ordered_by, i.revision, i.state_changed_at, i.traffic_connections, i.traffic_rx_bytes, i.traffic_tx_bytes, i.world_seed, i.game_mode, i.custom_params, i.placement_group, i.cross_instance_traffic
+FROM instances i
+    JOIN flavor_versions v ON i.flavor_version_id = v.id
+    JOIN flavors f ON f.id = v.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN nodes n ON i.node_id = n.id
+    JOIN users u ON u.id = i.owner_id
+WHERE i.owner_id = $1 AND i.revision > $2
+ORDER BY i.revision
+`
+
+type GetInstancesByOwnerIDParams struct {
+	OwnerID       string
+	AfterRevision int64
+}
+
+type GetInstancesByOwnerIDRow struct {
+	FlavorVersion FlavorVersion
+	Chunk         Chunk
+	Flavor        Flavor
+	Node          Node
+	User          User
+	Instance      Instance
+}
+
+func (q *Queries) GetInstancesByOwnerID(ctx context.Context, arg GetInstancesByOwnerIDParams) ([]GetInstancesByOwnerIDRow, error) {
+	rows, err := q.db.Query(ctx, getInstancesByOwnerID, arg.OwnerID, arg.AfterRevision)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetInstancesByOwnerIDRow
+	for rows.Next() {
+		var i GetInstancesByOwnerIDRow
+		if err := rows.Scan(
+			&i.FlavorVersion.ID,
+			&i.FlavorVersion.FlavorID,
+			&i.FlavorVersion.Hash,
+			&i.FlavorVersion.BuildStatus,
+			&i.FlavorVersion.Version,
+			&i.FlavorVersion.FilesUploaded,
+			&i.FlavorVersion.PrevVersionID,
+			&i.FlavorVersion.CreatedAt,
+			&i.FlavorVersion.PresignedUrlExpiryDate,
+			&i.FlavorVersion.PresignedUrl,
+			&i.FlavorVersion.MinecraftVersion,
+			&i.FlavorVersion.MinPlayers,
+			&i.FlavorVersion.MaxPlayers,
+			&i.FlavorVersion.CheckpointCpuMillis,
+			&i.FlavorVersion.CheckpointMemoryLimitBytes,
+			&i.FlavorVersion.CheckpointNodeID,
+			&i.FlavorVersion.BuildError,
+			&i.FlavorVersion.BuildProgress,
+			&i.FlavorVersion.StartupGracePeriodSeconds,
+			&i.FlavorVersion.RestartPolicy,
+			&i.FlavorVersion.RestartMaxRetries,
+			&i.FlavorVersion.RestoreLatencyMs,
+			&i.FlavorVersion.JavaVersion,
+			&i.FlavorVersion.StartupCommand,
+			&i.Chunk.ID,
+			&i.Chunk.Name,
+			&i.Chunk.Description,
+			&i.Chunk.Tags,
+			&i.Chunk.CreatedAt,
+			&i.Chunk.UpdatedAt,
+			&i.Chunk.OwnerID,
+			&i.Chunk.ThumbnailHash,
+			&i.Chunk.ThumbnailUpdatedAt,
+			&i.Chunk.DeletedAt,
+			&i.Flavor.ID,
+			&i.Flavor.ChunkID,
+			&i.Flavor.Name,
+			&i.Flavor.CreatedAt,
+			&i.Flavor.UpdatedAt,
+			&i.Flavor.DeletedAt,
+			&i.Node.ID,
+			&i.Node.Name,
+			&i.Node.Address,
+			&i.Node.CheckpointApiEndpoint,
+			&i.Node.CreatedAt,
+			&i.Node.Slots,
+			&i.Node.Capabilities,
+			&i.Node.LastSeenAt,
+			&i.Node.MaxInstances,
+			&i.Node.PlatformdVersion,
+			&i.Node.DesiredPlatformdVersion,
+			&i.Node.PlatformdUpdateStatus,
+			&i.Node.PlatformdUpdateMessage,
+			&i.Node.PlatformdUpdatedAt,
+			&i.Node.WorkloadCpuMillis,
+			&i.Node.WorkloadMemoryBytes,
+			&i.Node.LostAt,
+			&i.User.ID,
+			&i.User.Nickname,
+			&i.User.Email,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.Instance.ID,
+			&i.Instance.FlavorVersionID,
+			&i.Instance.NodeID,
+			&i.Instance.Port,
+			&i.Instance.State,
+			&i.Instance.CreatedAt,
+			&i.Instance.UpdatedAt,
+			&i.Instance.OwnerID,
+			&i.Instance.OrderedBy,
+			&i.Instance.Revision,
+			&i.Instance.StateChangedAt,
+			&i.Instance.TrafficConnections,
+			&i.Instance.TrafficRxBytes,
+			&i.Instance.TrafficTxBytes,
+			&i.Instance.WorldSeed,
+			&i.Instance.GameMode,
+			&i.Instance.CustomParams,
+			&i.Instance.PlacementGroup,
+			&i.Instance.CrossInstanceTraffic,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMinecraftVersionByVersionName = `-- name: GetMinecraftVersionByVersionName :one
+SELECT version, created_at, image_url FROM minecraft_versions WHERE version = $1
+`
+
+func (q *Queries) GetMinecraftVersionByVersionName(ctx context.Context, version string) (MinecraftVersion, error) {
+	row := q.db.QueryRow(ctx, getMinecraftVersionByVersionName, version)
+	var i MinecraftVersion
+	err := row.Scan(&i.Version, &i.CreatedAt, &i.ImageUrl)
+	return i, err
+}
+
+const getNodeConditions = `-- name: GetNodeConditions :many
+SELECT node_id, type, message, since FROM node_conditions WHERE node_id = $1
+`
+
+func (q *Queries) GetNodeConditions(ctx context.Context, nodeID string) ([]NodeCondition, error) {
+	rows, err := q.db.Query(ctx, getNodeConditions, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NodeCondition
+	for rows.Next() {
+		var i NodeCondition
+		if err := rows.Scan(
+			&i.NodeID,
+			&i.Type,
+			&i.Message,
+			&i.Since,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const impersonatedActionsByUserID = `-- name: ImpersonatedActionsByUserID :many
+SELECT a.impersonation_id, a.method, a.created_at FROM impersonated_actions a
+JOIN impersonations i ON i.id = a.impersonation_id
+WHERE i.user_id = $1 AND a.created_at >= i.created_at
+ORDER BY a.created_at
+`
+
+func (q *Queries) ImpersonatedActionsByUserID(ctx context.Context, userID string) ([]ImpersonatedAction, error) {
+	rows, err := q.db.Query(ctx, impersonatedActionsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ImpersonatedAction
+	for rows.Next() {
+		var i ImpersonatedAction
+		if err := rows.Scan(&i.ImpersonationID, &i.Method, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const impersonationsByUserID = `-- name: ImpersonationsByUserID :many
+SELECT id, user_id, admin_id, reason, created_at, expires_at FROM impersonations WHERE user_id = $1 ORDER BY created_at DESC
+`
+
+func (q *Queries) ImpersonationsByUserID(ctx context.Context, userID string) ([]Impersonation, error) {
+	rows, err := q.db.Query(ctx, impersonationsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Impersonation
+	for rows.Next() {
+		var i Impersonation
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.AdminID,
+			&i.Reason,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertAutoscaledInstance = `-- name: InsertAutoscaledInstance :exec
+INSERT INTO autoscaled_instances (instance_id, flavor_id) VALUES ($1, $2)
+`
+
+type InsertAutoscaledInstanceParams struct {
+	InstanceID string
+	FlavorID   string
+}
+
+func (q *Queries) InsertAutoscaledInstance(ctx context.Context, arg InsertAutoscaledInstanceParams) error {
+	_, err := q.db.Exec(ctx, insertAutoscaledInstance, arg.InstanceID, arg.FlavorID)
+	return err
+}
+
+const insertImpersonatedAction = `-- name: InsertImpersonatedAction :exec
+INSERT INTO impersonated_actions (impersonation_id, method, created_at) VALUES ($1, $2, $3)
+`
+
+type InsertImpersonatedActionParams struct {
+	ImpersonationID string
+	Method          string
+	CreatedAt       time.Time
+}
+
+func (q *Queries) InsertImpersonatedAction(ctx context.Context, arg InsertImpersonatedActionParams) error {
+	_, err := q.db.Exec(ctx, insertImpersonatedAction, arg.ImpersonationID, arg.Method, arg.CreatedAt)
+	return err
+}
+
+const insertInstanceEvent = `-- name: InsertInstanceEvent :exec
+INSERT INTO instance_events
+    (id, instance_id, type, exit_code, message, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertInstanceEventParams struct {
+	ID         string
+	InstanceID string
+	Type       InstanceEventType
+	ExitCode   int32
+	Message    string
+	CreatedAt  time.Time
+}
+
+func (q *Queries) InsertInstanceEvent(ctx context.Context, arg InsertInstanceEventParams) error {
+	_, err := q.db.Exec(ctx, insertInstanceEvent,
+		arg.ID,
+		arg.InstanceID,
+		arg.Type,
+		arg.ExitCode,
+		arg.Message,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertInstanceLog = `-- name: InsertInstanceLog :exec
+/*
+ * INSTANCE LOGS
+ */
+
+INSERT INTO instance_logs
+    (id, instance_id, owner_id, object_key, size_bytes, first_line_at, last_line_at, expires_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type InsertInstanceLogParams struct {
+	ID          string
+	InstanceID  string
+	OwnerID     string
+	ObjectKey   string
+	SizeBytes   int64
+	FirstLineAt time.Time
+	LastLineAt  time.Time
+	ExpiresAt   time.Time
+}
+
+func (q *Queries) InsertInstanceLog(ctx context.Context, arg InsertInstanceLogParams) error {
+	_, err := q.db.Exec(ctx, insertInstanceLog,
+		arg.ID,
+		arg.InstanceID,
+		arg.OwnerID,
+		arg.ObjectKey,
+		arg.SizeBytes,
+		arg.FirstLineAt,
+		arg.LastLineAt,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const insertInstancePlayer = `-- name: InsertInstancePlayer :exec
+INSERT INTO instance_players (instance_id, player_id)
+SELECT id, $1 FROM instances WHERE id = $2
+ON CONFLICT (instance_id, player_id) DO NOTHING
+`
+
+type InsertInstancePlayerParams struct {
+	PlayerID   string
+	InstanceID string
+}
+
+// the select makes sure joins for instances that do not exist
+// (anymore) are ignored, instead of violating the foreign key.
+func (q *Queries) InsertInstancePlayer(ctx context.Context, arg InsertInstancePlayerParams) error {
+	_, err := q.db.Exec(ctx, insertInstancePlayer, arg.PlayerID, arg.InstanceID)
+	return err
+}
+
+const insertJoinMiss = `-- name: InsertJoinMiss :exec
+INSERT INTO join_misses (chunk_id, flavor_name)
+SELECT s.chunk_id, $1 FROM chunk_slugs s WHERE s.slug = $2
+`
+
+type InsertJoinMissParams struct {
+	FlavorName pgtype.Text
+	ChunkSlug  string
+}
+
+// the select makes sure misses for chunks that do not
+// exist are ignored, instead of violating the foreign key.
+func (q *Queries) InsertJoinMiss(ctx context.Context, arg InsertJoinMissParams) error {
+	_, err := q.db.Exec(ctx, insertJoinMiss, arg.FlavorName, arg.ChunkSlug)
+	return err
+}
+
+const insertNodeEvent = `-- name: InsertNodeEvent :exec
+INSERT INTO node_events
+    (id, node_id, type, condition_type, message, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertNodeEventParams struct {
+	ID            string
+	NodeID        string
+	Type          NodeEventType
+	ConditionType NodeConditionType
+	Message       string
+	CreatedAt     time.Time
+}
+
+func (q *Queries) InsertNodeEvent(ctx context.Context, arg InsertNodeEventParams) error {
+	_, err := q.db.Exec(ctx, insertNodeEvent,
+		arg.ID,
+		arg.NodeID,
+		arg.Type,
+		arg.ConditionType,
+		arg.Message,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertPlayerJoinedEvent = `-- name: InsertPlayerJoinedEvent :exec
+INSERT INTO instance_events
+    (id, instance_id, type, exit_code, message, created_at)
+SELECT $1::uuid, $2::uuid, 'PLAYER_JOINED', 0, $3::text, now()
+WHERE NOT EXISTS (
+    SELECT 1 FROM instance_events e
+    WHERE e.instance_id = $2
+      AND e.type = 'PLAYER_JOINED'
+      AND e.created_at >= $4
+)
+`
+
+type InsertPlayerJoinedEventParams struct {
+	ID         string
+	InstanceID string
+	Message    string
+	Since      time.Time
+}
+
+// only the first player joining is part of the timeline, so
+// the event is not inserted if the instance already has one.
+func (q *Queries) InsertPlayerJoinedEvent(ctx context.Context, arg InsertPlayerJoinedEventParams) error {
+	_, err := q.db.Exec(ctx, insertPlayerJoinedEvent,
+		arg.ID,
+		arg.InstanceID,
+		arg.Message,
+		arg.Since,
+	)
+	return err
+}
+
+const insertRuntimeSettingChange = `-- name: InsertRuntimeSettingChange :exec
+INSERT INTO runtime_setting_changes
+    (id, setting, old_value, new_value, actor_id, created_at)
+VALUES
+    ($1, $2, $3, $4, $5, now())
+`
+
+type InsertRuntimeSettingChangeParams struct {
+	ID       string
+	Setting  string
+	OldValue string
+	NewValue string
+	ActorID  string
+}
+
+func (q *Queries) InsertRuntimeSettingChange(ctx context.Context, arg InsertRuntimeSettingChangeParams) error {
+	_, err := q.db.Exec(ctx, insertRuntimeSettingChange,
+		arg.ID,
+		arg.Setting,
+		arg.OldValue,
+		arg.NewValue,
+		arg.ActorID,
+	)
+	return err
+}
+
+const instanceEventsByOwnerID = `-- name: InstanceEventsByOwnerID :many
+SELECT e.id, e.instance_id, e.type, e.exit_code, e.message, e.created_at FROM instance_events e
+    JOIN instances i ON i.id = e.instance_id
+WHERE i.owner_id = $1 AND e.created_at >= i.created_at - interval '1 day'
+ORDER BY e.created_at
+`
+
+// events are timestamped by nodes, whose clocks might be off, so a
+// day of slack is given when limiting them to the partitions after
+// the creation of the instance.
+func (q *Queries) InstanceEventsByOwnerID(ctx context.Context, ownerID string) ([]InstanceEvent, error) {
+	rows, err := q.db.Query(ctx, instanceEventsByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceEvent
+	for rows.Next() {
+		var i InstanceEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.InstanceID,
+			&i.Type,
+			&i.ExitCode,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const instanceIDsByOwnerID = `-- name: InstanceIDsByOwnerID :many
+SELECT id FROM instances WHERE owner_id = $1 ORDER BY id
+`
+
+func (q *Queries) InstanceIDsByOwnerID(ctx context.Context, ownerID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, instanceIDsByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const instanceInviteByID = `-- name: InstanceInviteByID :one
+SELECT id, instance_id, token_hash, max_uses, uses, created_by, expires_at, revoked_at, created_at FROM instance_invites WHERE id = $1
+`
+
+func (q *Queries) InstanceInviteByID(ctx context.Context, id string) (InstanceInvite, error) {
+	row := q.db.QueryRow(ctx, instanceInviteByID, id)
+	var i InstanceInvite
+	err := row.Scan(
+		&i.ID,
+		&i.InstanceID,
+		&i.TokenHash,
+		&i.MaxUses,
+		&i.Uses,
+		&i.CreatedBy,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const instanceInviteByToken = `-- name: InstanceInviteByToken :one
+SELECT id, instance_id, token_hash, max_uses, uses, created_by, expires_at, revoked_at, created_at FROM instance_invites
+WHERE token_hash = sha256(convert_to($1::text, 'UTF8'))
+`
+
+func (q *Queries) InstanceInviteByToken(ctx context.Context, token string) (InstanceInvite, error) {
+	row := q.db.QueryRow(ctx, instanceInviteByToken, token)
+	var i InstanceInvite
+	err := row.Scan(
+		&i.ID,
+		&i.InstanceID,
+		&i.TokenHash,
+		&i.MaxUses,
+		&i.Uses,
+		&i.CreatedBy,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const instanceInvitesByInstanceID = `-- name: InstanceInvitesByInstanceID :many
+SELECT id, instance_id, token_hash, max_uses, uses, created_by, expires_at, revoked_at, created_at FROM instance_invites
+WHERE instance_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) InstanceInvitesByInstanceID(ctx context.Context, instanceID string) ([]InstanceInvite, error) {
+	rows, err := q.db.Query(ctx, instanceInvitesByInstanceID, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceInvite
+	for rows.Next() {
+		var i InstanceInvite
+		if err := rows.Scan(
+			&i.ID,
+			&i.InstanceID,
+			&i.TokenHash,
+			&i.MaxUses,
+			&i.Uses,
+			&i.CreatedBy,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-// TODO: read multiple
-func (q *Queries) GetChunkByID(ctx context.Context, id string) ([]GetChunkByIDRow, error) {
-	rows, err := q.db.Query(ctx, getChunkByID, id)
+const instanceLogsByInstanceID = `-- name: InstanceLogsByInstanceID :many
+SELECT id, instance_id, owner_id, object_key, size_bytes, first_line_at, last_line_at, created_at, expires_at FROM instance_logs
+WHERE instance_id = $1
+ORDER BY first_line_at, created_at
+`
+
+func (q *Queries) InstanceLogsByInstanceID(ctx context.Context, instanceID string) ([]InstanceLog, error) {
+	rows, err := q.db.Query(ctx, instanceLogsByInstanceID, instanceID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetChunkByIDRow
+	var items []InstanceLog
 	for rows.Next() {
-		var i GetChunkByIDRow
+		var i InstanceLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.InstanceID,
+			&i.OwnerID,
+			&i.ObjectKey,
+			&i.SizeBytes,
+			&i.FirstLineAt,
+			&i.LastLineAt,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const instanceNotificationData = `-- name: InstanceNotificationData :one
+SELECT
+    u.email,
+    c.name AS chunk_name,
+    f.name AS flavor_name,
+    fv.version,
+    COALESCE(np.instance_crashed, false)::boolean AS instance_crashed
+FROM instances i
+    JOIN flavor_versions fv ON fv.id = i.flavor_version_id
+    JOIN flavors f ON f.id = fv.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN users u ON u.id = i.owner_id
+    LEFT JOIN notification_preferences np ON np.user_id = u.id
+WHERE i.id = $1
+`
+
+type InstanceNotificationDataRow struct {
+	Email           string
+	ChunkName       string
+	FlavorName      string
+	Version         string
+	InstanceCrashed bool
+}
+
+func (q *Queries) InstanceNotificationData(ctx context.Context, id string) (InstanceNotificationDataRow, error) {
+	row := q.db.QueryRow(ctx, instanceNotificationData, id)
+	var i InstanceNotificationDataRow
+	err := row.Scan(
+		&i.Email,
+		&i.ChunkName,
+		&i.FlavorName,
+		&i.Version,
+		&i.InstanceCrashed,
+	)
+	return i, err
+}
+
+const instanceScheduleByID = `-- name: InstanceScheduleByID :one
+SELECT id, chunk_id, flavor_version_id, start_cron, stop_cron, timezone, instance_id, evaluated_at, created_by, created_at FROM instance_schedules WHERE id = $1
+`
+
+func (q *Queries) InstanceScheduleByID(ctx context.Context, id string) (InstanceSchedule, error) {
+	row := q.db.QueryRow(ctx, instanceScheduleByID, id)
+	var i InstanceSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.ChunkID,
+		&i.FlavorVersionID,
+		&i.StartCron,
+		&i.StopCron,
+		&i.Timezone,
+		&i.InstanceID,
+		&i.EvaluatedAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const instanceSchedulesByChunkID = `-- name: InstanceSchedulesByChunkID :many
+SELECT id, chunk_id, flavor_version_id, start_cron, stop_cron, timezone, instance_id, evaluated_at, created_by, created_at FROM instance_schedules
+WHERE chunk_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) InstanceSchedulesByChunkID(ctx context.Context, chunkID string) ([]InstanceSchedule, error) {
+	rows, err := q.db.Query(ctx, instanceSchedulesByChunkID, chunkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceSchedule
+	for rows.Next() {
+		var i InstanceSchedule
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChunkID,
+			&i.FlavorVersionID,
+			&i.StartCron,
+			&i.StopCron,
+			&i.Timezone,
+			&i.InstanceID,
+			&i.EvaluatedAt,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const instanceUsage = `-- name: InstanceUsage :many
+SELECT u.instance_id, u.chunk_id, u.node_id, u.cpu_millis, u.memory_bytes, u.node_slots, u.started_at, u.stopped_at, c.name AS chunk_name FROM instance_usage u
+JOIN chunks c ON c.id = u.chunk_id
+WHERE u.started_at < $1
+    AND (u.stopped_at IS NULL OR u.stopped_at > $2)
+    AND ($3::uuid IS NULL OR u.chunk_id = $3::uuid)
+ORDER BY u.started_at, u.instance_id
+`
+
+type InstanceUsageParams struct {
+	EndAt   time.Time
+	StartAt pgtype.Timestamptz
+	ChunkID *string
+}
+
+type InstanceUsageRow struct {
+	InstanceID  string
+	ChunkID     string
+	NodeID      string
+	CpuMillis   int64
+	MemoryBytes int64
+	NodeSlots   int32
+	StartedAt   time.Time
+	StoppedAt   pgtype.Timestamptz
+	ChunkName   string
+}
+
+// returns the usage of all instances that ran between start and end.
+// a null chunk id returns the usage of all chunks.
+func (q *Queries) InstanceUsage(ctx context.Context, arg InstanceUsageParams) ([]InstanceUsageRow, error) {
+	rows, err := q.db.Query(ctx, instanceUsage, arg.EndAt, arg.StartAt, arg.ChunkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstanceUsageRow
+	for rows.Next() {
+		var i InstanceUsageRow
+		if err := rows.Scan(
+			&i.InstanceID,
+			&i.ChunkID,
+			&i.NodeID,
+			&i.CpuMillis,
+			&i.MemoryBytes,
+			&i.NodeSlots,
+			&i.StartedAt,
+			&i.StoppedAt,
+			&i.ChunkName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const integrationBackends = `-- name: IntegrationBackends :many
+/*
+ * INTEGRATION
+ */
+
+SELECT
+    i.id AS instance_id,
+    i.port,
+    n.address,
+    c.id AS chunk_id,
+    c.name AS chunk_name,
+    f.name AS flavor_name,
+    v.max_players,
+    (SELECT count(*) FROM instance_players p WHERE p.instance_id = i.id)::integer AS players
+FROM instances i
+    JOIN flavor_versions v ON i.flavor_version_id = v.id
+    JOIN flavors f ON f.id = v.flavor_id
+    JOIN chunks c ON c.id = f.chunk_id
+    JOIN nodes n ON i.node_id = n.id
+WHERE i.state = 'RUNNING'
+  AND i.port IS NOT NULL
+  AND (
+      $1::text IS NULL OR
+      c.id = (SELECT s.chunk_id FROM chunk_slugs s WHERE s.slug = $1::text)
+  )
+  AND ($2::text IS NULL OR f.name = $2::text)
+  AND ($3::uuid IS NULL OR i.id = $3::uuid)
+ORDER BY i.id
+`
+
+type IntegrationBackendsParams struct {
+	ChunkSlug  pgtype.Text
+	FlavorName pgtype.Text
+	InstanceID *string
+}
+
+type IntegrationBackendsRow struct {
+	InstanceID string
+	Port       *int32
+	Address    netip.Addr
+	ChunkID    string
+	ChunkName  string
+	FlavorName string
+	MaxPlayers int32
+	Players    int32
+}
+
+// returns running instances together with the number of players
+// connected to them. chunk_slug, flavor_name and instance_id are optional filters.
+func (q *Queries) IntegrationBackends(ctx context.Context, arg IntegrationBackendsParams) ([]IntegrationBackendsRow, error) {
+	rows, err := q.db.Query(ctx, integrationBackends, arg.ChunkSlug, arg.FlavorName, arg.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IntegrationBackendsRow
+	for rows.Next() {
+		var i IntegrationBackendsRow
+		if err := rows.Scan(
+			&i.InstanceID,
+			&i.Port,
+			&i.Address,
+			&i.ChunkID,
+			&i.ChunkName,
+			&i.FlavorName,
+			&i.MaxPlayers,
+			&i.Players,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const latestCanaryByFlavorID = `-- name: LatestCanaryByFlavorID :one
+SELECT id, flavor_id, stable_version_id, canary_version_id, percentage, state, created_at, updated_at FROM canaries
+WHERE flavor_id = $1
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) LatestCanaryByFlavorID(ctx context.Context, flavorID string) (Canary, error) {
+	row := q.db.QueryRow(ctx, latestCanaryByFlavorID, flavorID)
+	var i Canary
+	err := row.Scan(
+		&i.ID,
+		&i.FlavorID,
+		&i.StableVersionID,
+		&i.CanaryVersionID,
+		&i.Percentage,
+		&i.State,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const latestCanaryByFlavorVersionID = `-- name: LatestCanaryByFlavorVersionID :one
+SELECT id, flavor_id, stable_version_id, canary_version_id, percentage, state, created_at, updated_at FROM canaries
+WHERE stable_version_id = $1 OR canary_version_id = $1
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) LatestCanaryByFlavorVersionID(ctx context.Context, flavorVersionID string) (Canary, error) {
+	row := q.db.QueryRow(ctx, latestCanaryByFlavorVersionID, flavorVersionID)
+	var i Canary
+	err := row.Scan(
+		&i.ID,
+		&i.FlavorID,
+		&i.StableVersionID,
+		&i.CanaryVersionID,
+		&i.Percentage,
+		&i.State,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const latestFlavorVersionByFlavorID = `-- name: LatestFlavorVersionByFlavorID :one
+SELECT id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id, created_at, presigned_url_expiry_date, presigned_url, minecraft_version, min_players, max_players, checkpoint_cpu_millis, checkpoint_memory_limit_bytes, checkpoint_node_id, build_error, build_progress, startup_grace_period_seconds, restart_policy, restart_max_retries, restore_latency_ms, java_version, startup_command FROM flavor_versions WHERE flavor_id = $1
+ORDER BY created_at DESC LIMIT 1
+`
+
+func (q *Queries) LatestFlavorVersionByFlavorID(ctx context.Context, flavorID string) (FlavorVersion, error) {
+	row := q.db.QueryRow(ctx, latestFlavorVersionByFlavorID, flavorID)
+	var i FlavorVersion
+	err := row.Scan(
+		&i.ID,
+		&i.FlavorID,
+		&i.Hash,
+		&i.BuildStatus,
+		&i.Version,
+		&i.FilesUploaded,
+		&i.PrevVersionID,
+		&i.CreatedAt,
+		&i.PresignedUrlExpiryDate,
+		&i.PresignedUrl,
+		&i.MinecraftVersion,
+		&i.MinPlayers,
+		&i.MaxPlayers,
+		&i.CheckpointCpuMillis,
+		&i.CheckpointMemoryLimitBytes,
+		&i.CheckpointNodeID,
+		&i.BuildError,
+		&i.BuildProgress,
+		&i.StartupGracePeriodSeconds,
+		&i.RestartPolicy,
+		&i.RestartMaxRetries,
+		&i.RestoreLatencyMs,
+		&i.JavaVersion,
+		&i.StartupCommand,
+	)
+	return i, err
+}
+
+const listChunks = `-- name: ListChunks :many
+SELECT c.id, c.name, description, tags, c.created_at, c.updated_at, owner_id, thumbnail_hash, thumbnail_updated_at, c.deleted_at, f.id, chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at, v.id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id, v.created_at, presigned_url_expiry_date, presigned_url, minecraft_version, min_players, max_players, checkpoint_cpu_millis, checkpoint_memory_limit_bytes, checkpoint_node_id, build_error, build_progress, startup_grace_period_seconds, restart_policy, restart_max_retries, restore_latency_ms, java_version, startup_command, flavor_version_id, file_hash, file_path, vf.created_at, size_bytes, u.id, nickname, email, u.created_at, u.updated_at FROM chunks c
+    LEFT JOIN flavors f ON f.chunk_id = c.id AND f.deleted_at IS NULL
+    LEFT JOIN flavor_versions v ON v.flavor_id = f.id
+    LEFT JOIN flavor_version_files vf ON vf.flavor_version_id = v.id
+    LEFT JOIN users u ON u.id = c.owner_id
+`
+
+type ListChunksRow struct {
+	ID                         string
+	Name                       string
+	Description                string
+	Tags                       []string
+	CreatedAt                  time.Time
+	UpdatedAt                  time.Time
+	OwnerID                    string
+	ThumbnailHash              pgtype.Text
+	ThumbnailUpdatedAt         time.Time
+	DeletedAt                  pgtype.Timestamptz
+	ID_2                       *string
+	ChunkID                    *string
+	Name_2                     pgtype.Text
+	CreatedAt_2                pgtype.Timestamptz
+	UpdatedAt_2                pgtype.Timestamptz
+	DeletedAt_2                pgtype.Timestamptz
+	ID_3                       *string
+	FlavorID                   *string
+	Hash                       pgtype.Text
+	BuildStatus                NullBuildStatus
+	Version                    pgtype.Text
+	FilesUploaded              pgtype.Bool
+	PrevVersionID              *string
+	CreatedAt_3                pgtype.Timestamptz
+	PresignedUrlExpiryDate     pgtype.Timestamptz
+	PresignedUrl               pgtype.Text
+	MinecraftVersion           pgtype.Text
+	MinPlayers                 pgtype.Int4
+	MaxPlayers                 pgtype.Int4
+	CheckpointCpuMillis        *int32
+	CheckpointMemoryLimitBytes *int64
+	CheckpointNodeID           *string
+	BuildError                 pgtype.Text
+	BuildProgress              pgtype.Int2
+	StartupGracePeriodSeconds  pgtype.Int4
+	RestartPolicy              NullRestartPolicy
+	RestartMaxRetries          pgtype.Int4
+	RestoreLatencyMs           pgtype.Int4
+	JavaVersion                pgtype.Int4
+	StartupCommand             pgtype.Text
+	FlavorVersionID            *string
+	FileHash                   pgtype.Text
+	FilePath                   pgtype.Text
+	CreatedAt_4                pgtype.Timestamptz
+	SizeBytes                  pgtype.Int8
+	ID_4                       *string
+	Nickname                   pgtype.Text
+	Email                      pgtype.Text
+	CreatedAt_5                pgtype.Timestamptz
+	UpdatedAt_3                pgtype.Timestamptz
+}
+
+func (q *Queries) ListChunks(ctx context.Context) ([]ListChunksRow, error) {
+	rows, err := q.db.Query(ctx, listChunks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListChunksRow
+	for rows.Next() {
+		var i ListChunksRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
@@ -714,10 +3939,22 @@ func (q *Queries) GetChunkByID(ctx context.Context, id string) ([]GetChunkByIDRo
 			&i.MinecraftVersion,
 			&i.MinPlayers,
 			&i.MaxPlayers,
+			&i.CheckpointCpuMillis,
+			&i.CheckpointMemoryLimitBytes,
+			&i.CheckpointNodeID,
+			&i.BuildError,
+			&i.BuildProgress,
+			&i.StartupGracePeriodSeconds,
+			&i.RestartPolicy,
+			&i.RestartMaxRetries,
+			&i.RestoreLatencyMs,
+			&i.JavaVersion,
+			&i.StartupCommand,
 			&i.FlavorVersionID,
 			&i.FileHash,
 			&i.FilePath,
 			&i.CreatedAt_4,
+			&i.SizeBytes,
 			&i.ID_4,
 			&i.Nickname,
 			&i.Email,
@@ -734,63 +3971,141 @@ func (q *Queries) GetChunkByID(ctx context.Context, id string) ([]GetChunkByIDRo
 	return items, nil
 }
 
-const getFlavorByID = `-- name: GetFlavorByID :many
-SELECT f.id, chunk_id, name, f.created_at, updated_at, deleted_at, fv.id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id, fv.created_at, presigned_url_expiry_date, presigned_url, minecraft_version, min_players, max_players FROM flavors f
-    LEFT JOIN flavor_versions fv ON fv.flavor_id = $1
-WHERE f.id = $1
+const listChunksWithPaginationIgnoreDeleted = `-- name: ListChunksWithPaginationIgnoreDeleted :many
+WITH paged_chunks AS (
+    SELECT id FROM chunks
+    WHERE deleted_at IS NULL
+      AND ($1::uuid IS NULL OR id > $1::uuid)
+    ORDER BY id
+    LIMIT $2
+)
+SELECT c.id, c.name, c.description, c.tags, c.created_at, c.updated_at, c.owner_id, c.thumbnail_hash, c.thumbnail_updated_at, c.deleted_at, f.id, f.chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at, v.id, v.flavor_id, v.hash, v.build_status, v.version, v.files_uploaded, v.prev_version_id, v.created_at, v.presigned_url_expiry_date, v.presigned_url, v.minecraft_version, v.min_players, v.max_players, v.checkpoint_cpu_millis, v.checkpoint_memory_limit_bytes, v.checkpoint_node_id, v.build_error, v.build_progress, v.startup_grace_period_seconds, v.restart_policy, v.restart_max_retries, v.restore_latency_ms, v.java_version, v.startup_command, vf.flavor_version_id, vf.file_hash, vf.file_path, vf.created_at, vf.size_bytes, u.id, u.nickname, u.email, u.created_at, u.updated_at FROM chunks c
+    JOIN paged_chunks pc ON pc.id = c.id
+    LEFT JOIN flavors f ON f.chunk_id = c.id AND f.deleted_at IS NULL
+    LEFT JOIN flavor_versions v ON v.flavor_id = f.id
+    LEFT JOIN flavor_version_files vf ON vf.flavor_version_id = v.id
+    LEFT JOIN users u ON u.id = c.owner_id
+ORDER BY c.id
 `
 
-type GetFlavorByIDRow struct {
-	ID                     string
-	ChunkID                string
-	Name                   string
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
-	DeletedAt              pgtype.Timestamptz
-	ID_2                   *string
-	FlavorID               *string
-	Hash                   pgtype.Text
-	BuildStatus            NullBuildStatus
-	Version                pgtype.Text
-	FilesUploaded          pgtype.Bool
-	PrevVersionID          *string
-	CreatedAt_2            pgtype.Timestamptz
-	PresignedUrlExpiryDate pgtype.Timestamptz
-	PresignedUrl           pgtype.Text
-	MinecraftVersion       pgtype.Text
-	MinPlayers             pgtype.Int4
-	MaxPlayers             pgtype.Int4
+type ListChunksWithPaginationIgnoreDeletedParams struct {
+	AfterID *string
+	Limit   int32
 }
 
-func (q *Queries) GetFlavorByID(ctx context.Context, flavorID string) ([]GetFlavorByIDRow, error) {
-	rows, err := q.db.Query(ctx, getFlavorByID, flavorID)
+type ListChunksWithPaginationIgnoreDeletedRow struct {
+	ID                         string
+	Name                       string
+	Description                string
+	Tags                       []string
+	CreatedAt                  time.Time
+	UpdatedAt                  time.Time
+	OwnerID                    string
+	ThumbnailHash              pgtype.Text
+	ThumbnailUpdatedAt         time.Time
+	DeletedAt                  pgtype.Timestamptz
+	ID_2                       *string
+	ChunkID                    *string
+	Name_2                     pgtype.Text
+	CreatedAt_2                pgtype.Timestamptz
+	UpdatedAt_2                pgtype.Timestamptz
+	DeletedAt_2                pgtype.Timestamptz
+	ID_3                       *string
+	FlavorID                   *string
+	Hash                       pgtype.Text
+	BuildStatus                NullBuildStatus
+	Version                    pgtype.Text
+	FilesUploaded              pgtype.Bool
+	PrevVersionID              *string
+	CreatedAt_3                pgtype.Timestamptz
+	PresignedUrlExpiryDate     pgtype.Timestamptz
+	PresignedUrl               pgtype.Text
+	MinecraftVersion           pgtype.Text
+	MinPlayers                 pgtype.Int4
+	MaxPlayers                 pgtype.Int4
+	CheckpointCpuMillis        *int32
+	CheckpointMemoryLimitBytes *int64
+	CheckpointNodeID           *string
+	BuildError                 pgtype.Text
+	BuildProgress              pgtype.Int2
+	StartupGracePeriodSeconds  pgtype.Int4
+	RestartPolicy              NullRestartPolicy
+	RestartMaxRetries          pgtype.Int4
+	RestoreLatencyMs           pgtype.Int4
+	JavaVersion                pgtype.Int4
+	StartupCommand             pgtype.Text
+	FlavorVersionID            *string
+	FileHash                   pgtype.Text
+	FilePath                   pgtype.Text
+	CreatedAt_4                pgtype.Timestamptz
+	SizeBytes                  pgtype.Int8
+	ID_4                       *string
+	Nickname                   pgtype.Text
+	Email                      pgtype.Text
+	CreatedAt_5                pgtype.Timestamptz
+	UpdatedAt_3                pgtype.Timestamptz
+}
+
+func (q *Queries) ListChunksWithPaginationIgnoreDeleted(ctx context.Context, arg ListChunksWithPaginationIgnoreDeletedParams) ([]ListChunksWithPaginationIgnoreDeletedRow, error) {
+	rows, err := q.db.Query(ctx, listChunksWithPaginationIgnoreDeleted, arg.AfterID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetFlavorByIDRow
+	var items []ListChunksWithPaginationIgnoreDeletedRow
 	for rows.Next() {
-		var i GetFlavorByIDRow
+		var i ListChunksWithPaginationIgnoreDeletedRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.ChunkID,
 			&i.Name,
+			&i.Description,
+			&i.Tags,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.OwnerID,
+			&i.ThumbnailHash,
+			&i.ThumbnailUpdatedAt,
 			&i.DeletedAt,
 			&i.ID_2,
+			&i.ChunkID,
+			&i.Name_2,
+			&i.CreatedAt_2,
+			&i.UpdatedAt_2,
+			&i.DeletedAt_2,
+			&i.ID_3,
 			&i.FlavorID,
 			&i.Hash,
 			&i.BuildStatus,
 			&i.Version,
 			&i.FilesUploaded,
 			&i.PrevVersionID,
-			&i.CreatedAt_2,
+			&i.CreatedAt_3,
 			&i.PresignedUrlExpiryDate,
 			&i.PresignedUrl,
 			&i.MinecraftVersion,
 			&i.MinPlayers,
 			&i.MaxPlayers,
+			&i.CheckpointCpuMillis,
+			&i.CheckpointMemoryLimitBytes,
+			&i.CheckpointNodeID,
+			&i.BuildError,
+			&i.BuildProgress,
+			&i.StartupGracePeriodSeconds,
+			&i.RestartPolicy,
+			&i.RestartMaxRetries,
+			&i.RestoreLatencyMs,
+			&i.JavaVersion,
+			&i.StartupCommand,
+			&i.FlavorVersionID,
+			&i.FileHash,
+			&i.FilePath,
+			&i.CreatedAt_4,
+			&i.SizeBytes,
+			&i.ID_4,
+			&i.Nickname,
+			&i.Email,
+			&i.CreatedAt_5,
+			&i.UpdatedAt_3,
 		); err != nil {
 			return nil, err
 		}
@@ -802,24 +4117,36 @@ func (q *Queries) GetFlavorByID(ctx context.Context, flavorID string) ([]GetFlav
 	return items, nil
 }
 
-const getInstance = `-- name: GetInstance :many
+const listInstancesWithPagination = `-- name: ListInstancesWithPagination :many
+WITH paged_instances AS (
+    SELECT id FROM instances
+    WHERE $1::uuid IS NULL OR id > $1::uuid
+    ORDER BY id
+    LIMIT $2
+)
 SELECT
-    v.id, v.flavor_id, v.hash, v.build_status, v.version, v.files_uploaded, v.prev_version_id, v.created_at, v.presigned_url_expiry_date, v.presigned_url, v.minecraft_version, v.min_players, v.max_players,
+    v.id, v.flavor_id, v.hash, v.build_status, v.version, v.files_uploaded, v.prev_version_id, v.created_at, v.presigned_url_expiry_date, v.presigned_url, v.minecraft_version, v.min_players, v.max_players, v.checkpoint_cpu_millis, v.checkpoint_memory_limit_bytes, v.checkpoint_node_id, v.build_error, v.build_progress, v.startup_grace_period_seconds, v.restart_policy, v.restart_max_retries, v.restore_latency_ms, v.java_version, v.startup_command,
     c.id, c.name, c.description, c.tags, c.created_at, c.updated_at, c.owner_id, c.thumbnail_hash, c.thumbnail_updated_at, c.deleted_at,
     f.id, f.chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at,
-    n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots,
+    n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots, n.capabilities, n.last_seen_at, n.max_instances, n.platformd_version, n.desired_platformd_version, n.platformd_update_status, n.platformd_update_message, n.platformd_updated_at, n.workload_cpu_millis, n.workload_memory_bytes, n.lost_at,
     u.id, u.nickname, u.email, u.created_at, u.updated_at,
-    i.id, i.flavor_version_id, i.node_id, i.port, i.state, i.created_at, i.updated_at, i.owner_id, i.ordered_by
+    i.id, i.flavor_version_id, i.node_id, i.port, i.state, i.created_at, i.updated_at, i.owner_id, i.ordered_by, i.revision, i.state_changed_at, i.traffic_connections, i.traffic_rx_bytes, i.traffic_tx_bytes, i.world_seed, i.game_mode, i.custom_params, i.placement_group, i.cross_instance_traffic
 FROM instances i
+    JOIN paged_instances pi ON pi.id = i.id
     JOIN flavor_versions v ON i.flavor_version_id = v.id
     JOIN flavors f ON f.id = v.flavor_id
     JOIN chunks c ON c.id = f.chunk_id
     JOIN nodes n ON i.node_id = n.id
     JOIN users u ON u.id = i.owner_id
-WHERE i.id = $1
+ORDER BY i.id
 `
 
-type GetInstanceRow struct {
+type ListInstancesWithPaginationParams struct {
+	AfterID *string
+	Limit   int32
+}
+
+type ListInstancesWithPaginationRow struct {
 	FlavorVersion FlavorVersion
 	Chunk         Chunk
 	Flavor        Flavor
@@ -828,15 +4155,15 @@ type GetInstanceRow struct {
 	Instance      Instance
 }
 
-func (q *Queries) GetInstance(ctx context.Context, id string) ([]GetInstanceRow, error) {
-	rows, err := q.db.Query(ctx, getInstance, id)
+func (q *Queries) ListInstancesWithPagination(ctx context.Context, arg ListInstancesWithPaginationParams) ([]ListInstancesWithPaginationRow, error) {
+	rows, err := q.db.Query(ctx, listInstancesWithPagination, arg.AfterID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetInstanceRow
+	var items []ListInstancesWithPaginationRow
 	for rows.Next() {
-		var i GetInstanceRow
+		var i ListInstancesWithPaginationRow
 		if err := rows.Scan(
 			&i.FlavorVersion.ID,
 			&i.FlavorVersion.FlavorID,
@@ -851,6 +4178,17 @@ func (q *Queries) GetInstance(ctx context.Context, id string) ([]GetInstanceRow,
 			&i.FlavorVersion.MinecraftVersion,
 			&i.FlavorVersion.MinPlayers,
 			&i.FlavorVersion.MaxPlayers,
+			&i.FlavorVersion.CheckpointCpuMillis,
+			&i.FlavorVersion.CheckpointMemoryLimitBytes,
+			&i.FlavorVersion.CheckpointNodeID,
+			&i.FlavorVersion.BuildError,
+			&i.FlavorVersion.BuildProgress,
+			&i.FlavorVersion.StartupGracePeriodSeconds,
+			&i.FlavorVersion.RestartPolicy,
+			&i.FlavorVersion.RestartMaxRetries,
+			&i.FlavorVersion.RestoreLatencyMs,
+			&i.FlavorVersion.JavaVersion,
+			&i.FlavorVersion.StartupCommand,
 			&i.Chunk.ID,
 			&i.Chunk.Name,
 			&i.Chunk.Description,
@@ -873,6 +4211,17 @@ func (q *Queries) GetInstance(ctx context.Context, id string) ([]GetInstanceRow,
 			&i.Node.CheckpointApiEndpoint,
 			&i.Node.CreatedAt,
 			&i.Node.Slots,
+			&i.Node.Capabilities,
+			&i.Node.LastSeenAt,
+			&i.Node.MaxInstances,
+			&i.Node.PlatformdVersion,
+			&i.Node.DesiredPlatformdVersion,
+			&i.Node.PlatformdUpdateStatus,
+			&i.Node.PlatformdUpdateMessage,
+			&i.Node.PlatformdUpdatedAt,
+			&i.Node.WorkloadCpuMillis,
+			&i.Node.WorkloadMemoryBytes,
+			&i.Node.LostAt,
 			&i.User.ID,
 			&i.User.Nickname,
 			&i.User.Email,
@@ -887,6 +4236,16 @@ func (q *Queries) GetInstance(ctx context.Context, id string) ([]GetInstanceRow,
 			&i.Instance.UpdatedAt,
 			&i.Instance.OwnerID,
 			&i.Instance.OrderedBy,
+			&i.Instance.Revision,
+			&i.Instance.StateChangedAt,
+			&i.Instance.TrafficConnections,
+			&i.Instance.TrafficRxBytes,
+			&i.Instance.TrafficTxBytes,
+			&i.Instance.WorldSeed,
+			&i.Instance.GameMode,
+			&i.Instance.CustomParams,
+			&i.Instance.PlacementGroup,
+			&i.Instance.CrossInstanceTraffic,
 		); err != nil {
 			return nil, err
 		}
@@ -898,91 +4257,180 @@ func (q *Queries) GetInstance(ctx context.Context, id string) ([]GetInstanceRow,
 	return items, nil
 }
 
-const getInstancesByNodeID = `-- name: GetInstancesByNodeID :many
-SELECT
-    v.id, v.flavor_id, v.hash, v.build_status, v.version, v.files_uploaded, v.prev_version_id, v.created_at, v.presigned_url_expiry_date, v.presigned_url, v.minecraft_version, v.min_players, v.max_players,
-    c.id, c.name, c.description, c.tags, c.created_at, c.updated_at, c.owner_id, c.thumbnail_hash, c.thumbnail_updated_at, c.deleted_at,
-    f.id, f.chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at,
-    n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots,
-    u.id, u.nickname, u.email, u.created_at, u.updated_at,
-    i.id, i.flavor_version_id, i.node_id, i.port, i.state, i.created_at, i.updated_at, i.owner_id, i.ordered_by
-FROM instances i
-    JOIN flavor_versions v ON i.flavor_version_id = v.id
-    JOIN flavors f ON f.id = v.flavor_id
-    JOIN chunks c ON c.id = f.chunk_id
-    JOIN nodes n ON i.node_id = n.id
-    JOIN users u ON u.id = i.owner_id
-WHERE i.node_id = $1
+const listNodeConditions = `-- name: ListNodeConditions :many
+SELECT c.node_id, c.type, c.message, c.since, n.name AS node_name FROM node_conditions c
+JOIN nodes n ON n.id = c.node_id
+ORDER BY n.name, c.node_id, c.type
 `
 
-type GetInstancesByNodeIDRow struct {
-	FlavorVersion FlavorVersion
-	Chunk         Chunk
-	Flavor        Flavor
-	Node          Node
-	User          User
-	Instance      Instance
+type ListNodeConditionsRow struct {
+	NodeID   string
+	Type     NodeConditionType
+	Message  string
+	Since    time.Time
+	NodeName string
 }
 
-func (q *Queries) GetInstancesByNodeID(ctx context.Context, nodeID string) ([]GetInstancesByNodeIDRow, error) {
-	rows, err := q.db.Query(ctx, getInstancesByNodeID, nodeID)
+func (q *Queries) ListNodeConditions(ctx context.Context) ([]ListNodeConditionsRow, error) {
+	rows, err := q.db.Query(ctx, listNodeConditions)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetInstancesByNodeIDRow
+	var items []ListNodeConditionsRow
 	for rows.Next() {
-		var i GetInstancesByNodeIDRow
+		var i ListNodeConditionsRow
 		if err := rows.Scan(
-			&i.FlavorVersion.ID,
-			&i.FlavorVersion.FlavorID,
-			&i.FlavorVersion.Hash,
-			&i.FlavorVersion.BuildStatus,
-			&i.FlavorVersion.Version,
-			&i.FlavorVersion.FilesUploaded,
-			&i.FlavorVersion.PrevVersionID,
-			&i.FlavorVersion.CreatedAt,
-			&i.FlavorVersion.PresignedUrlExpiryDate,
-			&i.FlavorVersion.PresignedUrl,
-			&i.FlavorVersion.MinecraftVersion,
-			&i.FlavorVersion.MinPlayers,
-			&i.FlavorVersion.MaxPlayers,
-			&i.Chunk.ID,
-			&i.Chunk.Name,
-			&i.Chunk.Description,
-			&i.Chunk.Tags,
-			&i.Chunk.CreatedAt,
-			&i.Chunk.UpdatedAt,
-			&i.Chunk.OwnerID,
-			&i.Chunk.ThumbnailHash,
-			&i.Chunk.ThumbnailUpdatedAt,
-			&i.Chunk.DeletedAt,
-			&i.Flavor.ID,
-			&i.Flavor.ChunkID,
-			&i.Flavor.Name,
-			&i.Flavor.CreatedAt,
-			&i.Flavor.UpdatedAt,
-			&i.Flavor.DeletedAt,
-			&i.Node.ID,
-			&i.Node.Name,
-			&i.Node.Address,
-			&i.Node.CheckpointApiEndpoint,
-			&i.Node.CreatedAt,
-			&i.Node.Slots,
-			&i.User.ID,
-			&i.User.Nickname,
-			&i.User.Email,
-			&i.User.CreatedAt,
-			&i.User.UpdatedAt,
-			&i.Instance.ID,
-			&i.Instance.FlavorVersionID,
-			&i.Instance.NodeID,
-			&i.Instance.Port,
-			&i.Instance.State,
-			&i.Instance.CreatedAt,
-			&i.Instance.UpdatedAt,
-			&i.Instance.OwnerID,
-			&i.Instance.OrderedBy,
+			&i.NodeID,
+			&i.Type,
+			&i.Message,
+			&i.Since,
+			&i.NodeName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNodeEvents = `-- name: ListNodeEvents :many
+SELECT id, node_id, type, condition_type, message, created_at FROM node_events
+ORDER BY created_at DESC
+LIMIT $1
+`
+
+func (q *Queries) ListNodeEvents(ctx context.Context, limit int32) ([]NodeEvent, error) {
+	rows, err := q.db.Query(ctx, listNodeEvents, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NodeEvent
+	for rows.Next() {
+		var i NodeEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.NodeID,
+			&i.Type,
+			&i.ConditionType,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNodes = `-- name: ListNodes :many
+SELECT n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots, n.capabilities, n.last_seen_at, n.max_instances, n.platformd_version, n.desired_platformd_version, n.platformd_update_status, n.platformd_update_message, n.platformd_updated_at, n.workload_cpu_millis, n.workload_memory_bytes, n.lost_at,
+    (SELECT COUNT(*) FROM instances i WHERE i.node_id = n.id) AS instance_count,
+    EXISTS (SELECT 1 FROM node_conditions c WHERE c.node_id = n.id) AS has_conditions
+FROM nodes n
+ORDER BY n.id
+`
+
+type ListNodesRow struct {
+	ID                      string
+	Name                    string
+	Address                 netip.Addr
+	CheckpointApiEndpoint   string
+	CreatedAt               time.Time
+	Slots                   int32
+	Capabilities            []string
+	LastSeenAt              pgtype.Timestamptz
+	MaxInstances            pgtype.Int4
+	PlatformdVersion        string
+	DesiredPlatformdVersion string
+	PlatformdUpdateStatus   NullPlatformdUpdateStatus
+	PlatformdUpdateMessage  string
+	PlatformdUpdatedAt      pgtype.Timestamptz
+	WorkloadCpuMillis       int64
+	WorkloadMemoryBytes     int64
+	LostAt                  pgtype.Timestamptz
+	InstanceCount           int64
+	HasConditions           bool
+}
+
+func (q *Queries) ListNodes(ctx context.Context) ([]ListNodesRow, error) {
+	rows, err := q.db.Query(ctx, listNodes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNodesRow
+	for rows.Next() {
+		var i ListNodesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Address,
+			&i.CheckpointApiEndpoint,
+			&i.CreatedAt,
+			&i.Slots,
+			&i.Capabilities,
+			&i.LastSeenAt,
+			&i.MaxInstances,
+			&i.PlatformdVersion,
+			&i.DesiredPlatformdVersion,
+			&i.PlatformdUpdateStatus,
+			&i.PlatformdUpdateMessage,
+			&i.PlatformdUpdatedAt,
+			&i.WorkloadCpuMillis,
+			&i.WorkloadMemoryBytes,
+			&i.LostAt,
+			&i.InstanceCount,
+			&i.HasConditions,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlatformdRollouts = `-- name: ListPlatformdRollouts :many
+SELECT id, name, address, checkpoint_api_endpoint, created_at, slots, capabilities, last_seen_at, max_instances, platformd_version, desired_platformd_version, platformd_update_status, platformd_update_message, platformd_updated_at, workload_cpu_millis, workload_memory_bytes, lost_at FROM nodes ORDER BY name, id
+`
+
+func (q *Queries) ListPlatformdRollouts(ctx context.Context) ([]Node, error) {
+	rows, err := q.db.Query(ctx, listPlatformdRollouts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Node
+	for rows.Next() {
+		var i Node
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Address,
+			&i.CheckpointApiEndpoint,
+			&i.CreatedAt,
+			&i.Slots,
+			&i.Capabilities,
+			&i.LastSeenAt,
+			&i.MaxInstances,
+			&i.PlatformdVersion,
+			&i.DesiredPlatformdVersion,
+			&i.PlatformdUpdateStatus,
+			&i.PlatformdUpdateMessage,
+			&i.PlatformdUpdatedAt,
+			&i.WorkloadCpuMillis,
+			&i.WorkloadMemoryBytes,
+			&i.LostAt,
 		); err != nil {
 			return nil, err
 		}
@@ -994,140 +4442,598 @@ func (q *Queries) GetInstancesByNodeID(ctx context.Context, nodeID string) ([]Ge
 	return items, nil
 }
 
-const getMinecraftVersionByVersionName = `-- name: GetMinecraftVersionByVersionName :one
-SELECT version, created_at, image_url FROM minecraft_versions WHERE version = $1
+const lockNode = `-- name: LockNode :one
+SELECT LEAST(slots, COALESCE(max_instances, slots))::integer AS slots FROM nodes WHERE id = $1 FOR UPDATE
+`
+
+// locks the node row until the end of the transaction, so concurrent
+// transactions cannot schedule instances to the node while its
+// remaining capacity is being checked.
+func (q *Queries) LockNode(ctx context.Context, id string) (int32, error) {
+	row := q.db.QueryRow(ctx, lockNode, id)
+	var slots int32
+	err := row.Scan(&slots)
+	return slots, err
+}
+
+const maintenanceMode = `-- name: MaintenanceMode :one
+/*
+ * MAINTENANCE
+ */
+
+SELECT id, enabled, message, updated_by, updated_at FROM maintenance_mode
+`
+
+func (q *Queries) MaintenanceMode(ctx context.Context) (MaintenanceMode, error) {
+	row := q.db.QueryRow(ctx, maintenanceMode)
+	var i MaintenanceMode
+	err := row.Scan(
+		&i.ID,
+		&i.Enabled,
+		&i.Message,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const markChunkDeleted = `-- name: MarkChunkDeleted :exec
+UPDATE chunks SET deleted_at = now() WHERE id = $1
+`
+
+func (q *Queries) MarkChunkDeleted(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, markChunkDeleted, id)
+	return err
+}
+
+const markChunksDeletedByOwnerID = `-- name: MarkChunksDeletedByOwnerID :exec
+UPDATE chunks SET deleted_at = now()
+WHERE owner_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) MarkChunksDeletedByOwnerID(ctx context.Context, ownerID string) error {
+	_, err := q.db.Exec(ctx, markChunksDeletedByOwnerID, ownerID)
+	return err
+}
+
+const markFlavorDeleted = `-- name: MarkFlavorDeleted :exec
+UPDATE flavors SET deleted_at = now() WHERE id = $1
+`
+
+func (q *Queries) MarkFlavorDeleted(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, markFlavorDeleted, id)
+	return err
+}
+
+const markFlavorVersionFilesUploaded = `-- name: MarkFlavorVersionFilesUploaded :exec
+UPDATE flavor_versions SET files_uploaded = TRUE WHERE id = $1
+`
+
+func (q *Queries) MarkFlavorVersionFilesUploaded(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, markFlavorVersionFilesUploaded, id)
+	return err
+}
+
+const markFlavorsDeletedByOwnerID = `-- name: MarkFlavorsDeletedByOwnerID :exec
+UPDATE flavors f SET deleted_at = now()
+FROM chunks c
+WHERE c.id = f.chunk_id AND c.owner_id = $1 AND f.deleted_at IS NULL
+`
+
+func (q *Queries) MarkFlavorsDeletedByOwnerID(ctx context.Context, ownerID string) error {
+	_, err := q.db.Exec(ctx, markFlavorsDeletedByOwnerID, ownerID)
+	return err
+}
+
+const markInstanceScheduleEvaluated = `-- name: MarkInstanceScheduleEvaluated :exec
+UPDATE instance_schedules SET evaluated_at = $2 WHERE id = $1
+`
+
+type MarkInstanceScheduleEvaluatedParams struct {
+	ID          string
+	EvaluatedAt time.Time
+}
+
+func (q *Queries) MarkInstanceScheduleEvaluated(ctx context.Context, arg MarkInstanceScheduleEvaluatedParams) error {
+	_, err := q.db.Exec(ctx, markInstanceScheduleEvaluated, arg.ID, arg.EvaluatedAt)
+	return err
+}
+
+const markInstancesLost = `-- name: MarkInstancesLost :many
+UPDATE instances i SET
+    revision = nextval('instance_revision_seq'),
+    state_changed_at = now(),
+    state = 'LOST',
+    updated_at = now()
+FROM nodes n
+WHERE n.id = i.node_id
+  AND n.lost_at IS NOT NULL
+  AND i.state IN ('PENDING', 'CREATING', 'RUNNING')
+RETURNING i.id, n.name AS node_name
+`
+
+type MarkInstancesLostRow struct {
+	ID       string
+	NodeName string
+}
+
+// moves the instances of lost nodes that have not been stopped to LOST.
+func (q *Queries) MarkInstancesLost(ctx context.Context) ([]MarkInstancesLostRow, error) {
+	rows, err := q.db.Query(ctx, markInstancesLost)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MarkInstancesLostRow
+	for rows.Next() {
+		var i MarkInstancesLostRow
+		if err := rows.Scan(&i.ID, &i.NodeName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markNodesLost = `-- name: MarkNodesLost :exec
+UPDATE nodes SET lost_at = now()
+WHERE last_seen_at < $1 AND lost_at IS NULL
+`
+
+// nodes that have not been seen since seen_before are marked as lost.
+// nodes that never sent a heartbeat are left alone, because they might
+// run an old version of platformd that does not send heartbeats.
+func (q *Queries) MarkNodesLost(ctx context.Context, seenBefore pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, markNodesLost, seenBefore)
+	return err
+}
+
+const minecraftVersionJavaImages = `-- name: MinecraftVersionJavaImages :many
+SELECT minecraft_version, java_version, image_url FROM minecraft_version_java_images WHERE minecraft_version = $1
+`
+
+func (q *Queries) MinecraftVersionJavaImages(ctx context.Context, minecraftVersion string) ([]MinecraftVersionJavaImage, error) {
+	rows, err := q.db.Query(ctx, minecraftVersionJavaImages, minecraftVersion)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MinecraftVersionJavaImage
+	for rows.Next() {
+		var i MinecraftVersionJavaImage
+		if err := rows.Scan(&i.MinecraftVersion, &i.JavaVersion, &i.ImageUrl); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nodeByInstanceID = `-- name: NodeByInstanceID :one
+SELECT n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots, n.capabilities, n.last_seen_at, n.max_instances, n.platformd_version, n.desired_platformd_version, n.platformd_update_status, n.platformd_update_message, n.platformd_updated_at, n.workload_cpu_millis, n.workload_memory_bytes, n.lost_at FROM nodes n
+JOIN instances i ON i.node_id = n.id
+WHERE i.id = $1
 `
 
-func (q *Queries) GetMinecraftVersionByVersionName(ctx context.Context, version string) (MinecraftVersion, error) {
-	row := q.db.QueryRow(ctx, getMinecraftVersionByVersionName, version)
-	var i MinecraftVersion
-	err := row.Scan(&i.Version, &i.CreatedAt, &i.ImageUrl)
+func (q *Queries) NodeByInstanceID(ctx context.Context, id string) (Node, error) {
+	row := q.db.QueryRow(ctx, nodeByInstanceID, id)
+	var i Node
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Address,
+		&i.CheckpointApiEndpoint,
+		&i.CreatedAt,
+		&i.Slots,
+		&i.Capabilities,
+		&i.LastSeenAt,
+		&i.MaxInstances,
+		&i.PlatformdVersion,
+		&i.DesiredPlatformdVersion,
+		&i.PlatformdUpdateStatus,
+		&i.PlatformdUpdateMessage,
+		&i.PlatformdUpdatedAt,
+		&i.WorkloadCpuMillis,
+		&i.WorkloadMemoryBytes,
+		&i.LostAt,
+	)
 	return i, err
 }
 
-const latestFlavorVersionByFlavorID = `-- name: LatestFlavorVersionByFlavorID :one
-SELECT id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id, created_at, presigned_url_expiry_date, presigned_url, minecraft_version, min_players, max_players FROM flavor_versions WHERE flavor_id = $1
-ORDER BY created_at DESC LIMIT 1
+const notificationPreferences = `-- name: NotificationPreferences :one
+SELECT user_id, build_completed, build_failed, instance_crashed, updated_at FROM notification_preferences WHERE user_id = $1
 `
 
-func (q *Queries) LatestFlavorVersionByFlavorID(ctx context.Context, flavorID string) (FlavorVersion, error) {
-	row := q.db.QueryRow(ctx, latestFlavorVersionByFlavorID, flavorID)
-	var i FlavorVersion
+func (q *Queries) NotificationPreferences(ctx context.Context, userID string) (NotificationPreference, error) {
+	row := q.db.QueryRow(ctx, notificationPreferences, userID)
+	var i NotificationPreference
+	err := row.Scan(
+		&i.UserID,
+		&i.BuildCompleted,
+		&i.BuildFailed,
+		&i.InstanceCrashed,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const platformStatus = `-- name: PlatformStatus :one
+SELECT
+    (SELECT COUNT(*) FROM nodes) AS nodes_total,
+    (SELECT COUNT(*) FROM nodes n WHERE n.last_seen_at >= $1) AS nodes_online,
+    (SELECT COUNT(*) FROM instances i WHERE i.state = 'RUNNING') AS instances_running,
+    (SELECT COUNT(*) FROM flavor_versions fv
+     WHERE (fv.build_status = 'PENDING' AND fv.files_uploaded)
+        OR fv.build_status IN ('IMAGE_BUILD', 'CHECKPOINT_BUILD')) AS builds_queued
+`
+
+type PlatformStatusRow struct {
+	NodesTotal       int64
+	NodesOnline      int64
+	InstancesRunning int64
+	BuildsQueued     int64
+}
+
+// counts flavor versions waiting for their build as queued,
+// but not the ones whose files have not been uploaded yet.
+func (q *Queries) PlatformStatus(ctx context.Context, seenAfter pgtype.Timestamptz) (PlatformStatusRow, error) {
+	row := q.db.QueryRow(ctx, platformStatus, seenAfter)
+	var i PlatformStatusRow
+	err := row.Scan(
+		&i.NodesTotal,
+		&i.NodesOnline,
+		&i.InstancesRunning,
+		&i.BuildsQueued,
+	)
+	return i, err
+}
+
+const randomNode = `-- name: RandomNode :one
+/*
+ * NODES
+ */
+SELECT n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots, n.capabilities, n.last_seen_at, n.max_instances, n.platformd_version, n.desired_platformd_version, n.platformd_update_status, n.platformd_update_message, n.platformd_updated_at, n.workload_cpu_millis, n.workload_memory_bytes, n.lost_at FROM nodes n
+ORDER BY
+    (EXISTS (SELECT 1 FROM node_conditions c WHERE c.node_id = n.id) OR n.lost_at IS NOT NULL) ASC,
+    random()
+LIMIT 1
+`
+
+// nodes reporting conditions or being lost are only
+// selected if there is no other node.
+func (q *Queries) RandomNode(ctx context.Context) (Node, error) {
+	row := q.db.QueryRow(ctx, randomNode)
+	var i Node
 	err := row.Scan(
 		&i.ID,
-		&i.FlavorID,
-		&i.Hash,
-		&i.BuildStatus,
-		&i.Version,
-		&i.FilesUploaded,
-		&i.PrevVersionID,
+		&i.Name,
+		&i.Address,
+		&i.CheckpointApiEndpoint,
 		&i.CreatedAt,
-		&i.PresignedUrlExpiryDate,
-		&i.PresignedUrl,
-		&i.MinecraftVersion,
-		&i.MinPlayers,
-		&i.MaxPlayers,
+		&i.Slots,
+		&i.Capabilities,
+		&i.LastSeenAt,
+		&i.MaxInstances,
+		&i.PlatformdVersion,
+		&i.DesiredPlatformdVersion,
+		&i.PlatformdUpdateStatus,
+		&i.PlatformdUpdateMessage,
+		&i.PlatformdUpdatedAt,
+		&i.WorkloadCpuMillis,
+		&i.WorkloadMemoryBytes,
+		&i.LostAt,
 	)
 	return i, err
 }
 
-const listChunks = `-- name: ListChunks :many
-SELECT c.id, c.name, description, tags, c.created_at, c.updated_at, owner_id, thumbnail_hash, thumbnail_updated_at, c.deleted_at, f.id, chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at, v.id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id, v.created_at, presigned_url_expiry_date, presigned_url, minecraft_version, min_players, max_players, flavor_version_id, file_hash, file_path, vf.created_at, u.id, nickname, email, u.created_at, u.updated_at FROM chunks c
-    LEFT JOIN flavors f ON f.chunk_id = c.id AND f.deleted_at IS NULL
-    LEFT JOIN flavor_versions v ON v.flavor_id = f.id
-    LEFT JOIN flavor_version_files vf ON vf.flavor_version_id = v.id
-    LEFT JOIN users u ON u.id = c.owner_id
+const recordInstanceScheduleRun = `-- name: RecordInstanceScheduleRun :exec
+UPDATE instance_schedules
+SET evaluated_at = $1, instance_id = $2
+WHERE id = $3
 `
 
-type ListChunksRow struct {
-	ID                     string
-	Name                   string
-	Description            string
-	Tags                   []string
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
-	OwnerID                string
-	ThumbnailHash          pgtype.Text
-	ThumbnailUpdatedAt     time.Time
-	DeletedAt              pgtype.Timestamptz
-	ID_2                   *string
-	ChunkID                *string
-	Name_2                 pgtype.Text
-	CreatedAt_2            pgtype.Timestamptz
-	UpdatedAt_2            pgtype.Timestamptz
-	DeletedAt_2            pgtype.Timestamptz
-	ID_3                   *string
-	FlavorID               *string
-	Hash                   pgtype.Text
-	BuildStatus            NullBuildStatus
-	Version                pgtype.Text
-	FilesUploaded          pgtype.Bool
-	PrevVersionID          *string
-	CreatedAt_3            pgtype.Timestamptz
-	PresignedUrlExpiryDate pgtype.Timestamptz
-	PresignedUrl           pgtype.Text
-	MinecraftVersion       pgtype.Text
-	MinPlayers             pgtype.Int4
-	MaxPlayers             pgtype.Int4
-	FlavorVersionID        *string
-	FileHash               pgtype.Text
-	FilePath               pgtype.Text
-	CreatedAt_4            pgtype.Timestamptz
-	ID_4                   *string
-	Nickname               pgtype.Text
-	Email                  pgtype.Text
-	CreatedAt_5            pgtype.Timestamptz
-	UpdatedAt_3            pgtype.Timestamptz
+type RecordInstanceScheduleRunParams struct {
+	EvaluatedAt time.Time
+	InstanceID  *string
+	ID          string
 }
 
-func (q *Queries) ListChunks(ctx context.Context) ([]ListChunksRow, error) {
-	rows, err := q.db.Query(ctx, listChunks)
+func (q *Queries) RecordInstanceScheduleRun(ctx context.Context, arg RecordInstanceScheduleRunParams) error {
+	_, err := q.db.Exec(ctx, recordInstanceScheduleRun, arg.EvaluatedAt, arg.InstanceID, arg.ID)
+	return err
+}
+
+const rejectFlavorVersionBuild = `-- name: RejectFlavorVersionBuild :exec
+UPDATE flavor_versions SET build_status = $1, build_error = $2, build_progress = 0 WHERE id = $3
+`
+
+type RejectFlavorVersionBuildParams struct {
+	BuildStatus BuildStatus
+	BuildError  pgtype.Text
+	ID          string
+}
+
+func (q *Queries) RejectFlavorVersionBuild(ctx context.Context, arg RejectFlavorVersionBuildParams) error {
+	_, err := q.db.Exec(ctx, rejectFlavorVersionBuild, arg.BuildStatus, arg.BuildError, arg.ID)
+	return err
+}
+
+const removeLostInstances = `-- name: RemoveLostInstances :exec
+UPDATE instances SET
+    revision = nextval('instance_revision_seq'),
+    state_changed_at = now(),
+    state = 'DELETING',
+    updated_at = now()
+WHERE node_id = $1 AND state = 'LOST'
+`
+
+// lost instances are removed once their node returns, because they
+// could have been replaced in the meantime. platformd stops their
+// workloads, if they are still running.
+func (q *Queries) RemoveLostInstances(ctx context.Context, nodeID string) error {
+	_, err := q.db.Exec(ctx, removeLostInstances, nodeID)
+	return err
+}
+
+const reportPlatformdVersion = `-- name: ReportPlatformdVersion :one
+UPDATE nodes SET
+    platformd_version = $1,
+    platformd_update_status = CASE
+        WHEN desired_platformd_version = $1 THEN 'SUCCEEDED'
+        ELSE platformd_update_status
+    END::platformd_update_status,
+    platformd_update_message = CASE
+        WHEN desired_platformd_version = $1 THEN ''
+        ELSE platformd_update_message
+    END,
+    platformd_updated_at = CASE
+        WHEN desired_platformd_version = $1
+            AND platformd_update_status IS DISTINCT FROM 'SUCCEEDED' THEN now()
+        ELSE platformd_updated_at
+    END
+WHERE id = $2
+RETURNING id, name, address, checkpoint_api_endpoint, created_at, slots, capabilities, last_seen_at, max_instances, platformd_version, desired_platformd_version, platformd_update_status, platformd_update_message, platformd_updated_at, workload_cpu_millis, workload_memory_bytes, lost_at
+`
+
+type ReportPlatformdVersionParams struct {
+	Version string
+	ID      string
+}
+
+// records the version the node is running. the rollout is completed
+// once the node runs the desired version.
+func (q *Queries) ReportPlatformdVersion(ctx context.Context, arg ReportPlatformdVersionParams) (Node, error) {
+	row := q.db.QueryRow(ctx, reportPlatformdVersion, arg.Version, arg.ID)
+	var i Node
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Address,
+		&i.CheckpointApiEndpoint,
+		&i.CreatedAt,
+		&i.Slots,
+		&i.Capabilities,
+		&i.LastSeenAt,
+		&i.MaxInstances,
+		&i.PlatformdVersion,
+		&i.DesiredPlatformdVersion,
+		&i.PlatformdUpdateStatus,
+		&i.PlatformdUpdateMessage,
+		&i.PlatformdUpdatedAt,
+		&i.WorkloadCpuMillis,
+		&i.WorkloadMemoryBytes,
+		&i.LostAt,
+	)
+	return i, err
+}
+
+const requestCrossInstanceTraffic = `-- name: RequestCrossInstanceTraffic :one
+/*
+ * CROSS-INSTANCE TRAFFIC
+ */
+
+INSERT INTO chunk_cross_instance_traffic
+    (chunk_id, state, reason, requested_by, created_at, updated_at)
+VALUES
+    ($1, 'REQUESTED', $2, $3, $4, $4)
+ON CONFLICT (chunk_id) DO UPDATE SET
+    state = 'REQUESTED',
+    reason = EXCLUDED.reason,
+    requested_by = EXCLUDED.requested_by,
+    reviewed_by = NULL,
+    review_message = '',
+    updated_at = EXCLUDED.updated_at
+RETURNING chunk_id, state, reason, requested_by, reviewed_by, review_message, created_at, updated_at
+`
+
+type RequestCrossInstanceTrafficParams struct {
+	ChunkID     string
+	Reason      string
+	RequestedBy string
+	CreatedAt   time.Time
+}
+
+// requesting again resets a previous review, so changing
+// the reason always requires another approval.
+func (q *Queries) RequestCrossInstanceTraffic(ctx context.Context, arg RequestCrossInstanceTrafficParams) (ChunkCrossInstanceTraffic, error) {
+	row := q.db.QueryRow(ctx, requestCrossInstanceTraffic,
+		arg.ChunkID,
+		arg.Reason,
+		arg.RequestedBy,
+		arg.CreatedAt,
+	)
+	var i ChunkCrossInstanceTraffic
+	err := row.Scan(
+		&i.ChunkID,
+		&i.State,
+		&i.Reason,
+		&i.RequestedBy,
+		&i.ReviewedBy,
+		&i.ReviewMessage,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const reserveChunkSlug = `-- name: ReserveChunkSlug :execrows
+INSERT INTO chunk_slugs
+    (slug, chunk_id, created_at)
+VALUES
+    ($1, $2, $3)
+ON CONFLICT (slug) DO UPDATE SET
+    chunk_id = EXCLUDED.chunk_id
+WHERE chunk_slugs.chunk_id = EXCLUDED.chunk_id
+`
+
+type ReserveChunkSlugParams struct {
+	Slug      string
+	ChunkID   string
+	CreatedAt time.Time
+}
+
+// reserves the slug for the chunk. no rows are affected
+// if the slug has been reserved by another chunk.
+func (q *Queries) ReserveChunkSlug(ctx context.Context, arg ReserveChunkSlugParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reserveChunkSlug, arg.Slug, arg.ChunkID, arg.CreatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const retentionPolicyByChunkID = `-- name: RetentionPolicyByChunkID :one
+SELECT chunk_id, keep_last_versions, keep_days, updated_at FROM chunk_retention_policies WHERE chunk_id = $1
+`
+
+func (q *Queries) RetentionPolicyByChunkID(ctx context.Context, chunkID string) (ChunkRetentionPolicy, error) {
+	row := q.db.QueryRow(ctx, retentionPolicyByChunkID, chunkID)
+	var i ChunkRetentionPolicy
+	err := row.Scan(
+		&i.ChunkID,
+		&i.KeepLastVersions,
+		&i.KeepDays,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const reviewCrossInstanceTraffic = `-- name: ReviewCrossInstanceTraffic :one
+UPDATE chunk_cross_instance_traffic SET
+    state = $2,
+    reviewed_by = $3,
+    review_message = $4,
+    updated_at = $5
+WHERE chunk_id = $1
+RETURNING chunk_id, state, reason, requested_by, reviewed_by, review_message, created_at, updated_at
+`
+
+type ReviewCrossInstanceTrafficParams struct {
+	ChunkID       string
+	State         CrossInstanceTrafficState
+	ReviewedBy    *string
+	ReviewMessage string
+	UpdatedAt     time.Time
+}
+
+func (q *Queries) ReviewCrossInstanceTraffic(ctx context.Context, arg ReviewCrossInstanceTrafficParams) (ChunkCrossInstanceTraffic, error) {
+	row := q.db.QueryRow(ctx, reviewCrossInstanceTraffic,
+		arg.ChunkID,
+		arg.State,
+		arg.ReviewedBy,
+		arg.ReviewMessage,
+		arg.UpdatedAt,
+	)
+	var i ChunkCrossInstanceTraffic
+	err := row.Scan(
+		&i.ChunkID,
+		&i.State,
+		&i.Reason,
+		&i.RequestedBy,
+		&i.ReviewedBy,
+		&i.ReviewMessage,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const revokeInstanceInvite = `-- name: RevokeInstanceInvite :exec
+UPDATE instance_invites SET revoked_at = now()
+WHERE id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeInstanceInvite(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, revokeInstanceInvite, id)
+	return err
+}
+
+const revokeToken = `-- name: RevokeToken :exec
+INSERT INTO revoked_tokens
+    (id, user_id, expires_at)
+VALUES
+    ($1, $2, $3)
+ON CONFLICT (id) DO NOTHING
+`
+
+type RevokeTokenParams struct {
+	ID        string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) RevokeToken(ctx context.Context, arg RevokeTokenParams) error {
+	_, err := q.db.Exec(ctx, revokeToken, arg.ID, arg.UserID, arg.ExpiresAt)
+	return err
+}
+
+const revokeUserTokens = `-- name: RevokeUserTokens :exec
+INSERT INTO user_token_revocations
+    (user_id, revoked_before)
+VALUES
+    ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET
+    revoked_before = GREATEST(user_token_revocations.revoked_before, EXCLUDED.revoked_before)
+`
+
+type RevokeUserTokensParams struct {
+	UserID        string
+	RevokedBefore time.Time
+}
+
+func (q *Queries) RevokeUserTokens(ctx context.Context, arg RevokeUserTokensParams) error {
+	_, err := q.db.Exec(ctx, revokeUserTokens, arg.UserID, arg.RevokedBefore)
+	return err
+}
+
+const runtimeSettingChanges = `-- name: RuntimeSettingChanges :many
+SELECT id, setting, old_value, new_value, actor_id, created_at FROM runtime_setting_changes
+ORDER BY created_at DESC, id DESC
+LIMIT $1
+`
+
+func (q *Queries) RuntimeSettingChanges(ctx context.Context, limit int32) ([]RuntimeSettingChange, error) {
+	rows, err := q.db.Query(ctx, runtimeSettingChanges, limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListChunksRow
+	var items []RuntimeSettingChange
 	for rows.Next() {
-		var i ListChunksRow
+		var i RuntimeSettingChange
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Description,
-			&i.Tags,
+			&i.Setting,
+			&i.OldValue,
+			&i.NewValue,
+			&i.ActorID,
 			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.OwnerID,
-			&i.ThumbnailHash,
-			&i.ThumbnailUpdatedAt,
-			&i.DeletedAt,
-			&i.ID_2,
-			&i.ChunkID,
-			&i.Name_2,
-			&i.CreatedAt_2,
-			&i.UpdatedAt_2,
-			&i.DeletedAt_2,
-			&i.ID_3,
-			&i.FlavorID,
-			&i.Hash,
-			&i.BuildStatus,
-			&i.Version,
-			&i.FilesUploaded,
-			&i.PrevVersionID,
-			&i.CreatedAt_3,
-			&i.PresignedUrlExpiryDate,
-			&i.PresignedUrl,
-			&i.MinecraftVersion,
-			&i.MinPlayers,
-			&i.MaxPlayers,
-			&i.FlavorVersionID,
-			&i.FileHash,
-			&i.FilePath,
-			&i.CreatedAt_4,
-			&i.ID_4,
-			&i.Nickname,
-			&i.Email,
-			&i.CreatedAt_5,
-			&i.UpdatedAt_3,
 		); err != nil {
 			return nil, err
 		}
@@ -1139,117 +5045,98 @@ func (q *Queries) ListChunks(ctx context.Context) ([]ListChunksRow, error) {
 	return items, nil
 }
 
-const listChunksWithPaginationIgnoreDeleted = `-- name: ListChunksWithPaginationIgnoreDeleted :many
-WITH paged_chunks AS (
-    SELECT id FROM chunks
-    WHERE deleted_at IS NULL
-      AND ($1::uuid IS NULL OR id > $1::uuid)
-    ORDER BY id
-    LIMIT $2
-)
-SELECT c.id, c.name, c.description, c.tags, c.created_at, c.updated_at, c.owner_id, c.thumbnail_hash, c.thumbnail_updated_at, c.deleted_at, f.id, f.chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at, v.id, v.flavor_id, v.hash, v.build_status, v.version, v.files_uploaded, v.prev_version_id, v.created_at, v.presigned_url_expiry_date, v.presigned_url, v.minecraft_version, v.min_players, v.max_players, vf.flavor_version_id, vf.file_hash, vf.file_path, vf.created_at, u.id, u.nickname, u.email, u.created_at, u.updated_at FROM chunks c
-    JOIN paged_chunks pc ON pc.id = c.id
-    LEFT JOIN flavors f ON f.chunk_id = c.id AND f.deleted_at IS NULL
-    LEFT JOIN flavor_versions v ON v.flavor_id = f.id
-    LEFT JOIN flavor_version_files vf ON vf.flavor_version_id = v.id
-    LEFT JOIN users u ON u.id = c.owner_id
-ORDER BY c.id
+const runtimeSettingForUpdate = `-- name: RuntimeSettingForUpdate :one
+SELECT value FROM runtime_settings WHERE name = $1 FOR UPDATE
+`
+
+// locks the setting, so concurrent changes record the correct old value.
+func (q *Queries) RuntimeSettingForUpdate(ctx context.Context, name string) (string, error) {
+	row := q.db.QueryRow(ctx, runtimeSettingForUpdate, name)
+	var value string
+	err := row.Scan(&value)
+	return value, err
+}
+
+const runtimeSettings = `-- name: RuntimeSettings :many
+/*
+ * RUNTIME SETTINGS
+ */
+
+SELECT name, value, updated_by, updated_at FROM runtime_settings
+`
+
+func (q *Queries) RuntimeSettings(ctx context.Context) ([]RuntimeSetting, error) {
+	rows, err := q.db.Query(ctx, runtimeSettings)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RuntimeSetting
+	for rows.Next() {
+		var i RuntimeSetting
+		if err := rows.Scan(
+			&i.Name,
+			&i.Value,
+			&i.UpdatedBy,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setDesiredPlatformdVersion = `-- name: SetDesiredPlatformdVersion :many
+UPDATE nodes SET
+    desired_platformd_version = $1,
+    platformd_update_status = CASE
+        WHEN platformd_version = $1 THEN 'SUCCEEDED'
+        ELSE 'PENDING'
+    END::platformd_update_status,
+    platformd_update_message = '',
+    platformd_updated_at = now()
+WHERE cardinality($2::uuid[]) = 0 OR id = ANY($2::uuid[])
+RETURNING id, name, address, checkpoint_api_endpoint, created_at, slots, capabilities, last_seen_at, max_instances, platformd_version, desired_platformd_version, platformd_update_status, platformd_update_message, platformd_updated_at, workload_cpu_millis, workload_memory_bytes, lost_at
 `
 
-type ListChunksWithPaginationIgnoreDeletedParams struct {
-	AfterID *string
-	Limit   int32
-}
-
-type ListChunksWithPaginationIgnoreDeletedRow struct {
-	ID                     string
-	Name                   string
-	Description            string
-	Tags                   []string
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
-	OwnerID                string
-	ThumbnailHash          pgtype.Text
-	ThumbnailUpdatedAt     time.Time
-	DeletedAt              pgtype.Timestamptz
-	ID_2                   *string
-	ChunkID                *string
-	Name_2                 pgtype.Text
-	CreatedAt_2            pgtype.Timestamptz
-	UpdatedAt_2            pgtype.Timestamptz
-	DeletedAt_2            pgtype.Timestamptz
-	ID_3                   *string
-	FlavorID               *string
-	Hash                   pgtype.Text
-	BuildStatus            NullBuildStatus
-	Version                pgtype.Text
-	FilesUploaded          pgtype.Bool
-	PrevVersionID          *string
-	CreatedAt_3            pgtype.Timestamptz
-	PresignedUrlExpiryDate pgtype.Timestamptz
-	PresignedUrl           pgtype.Text
-	MinecraftVersion       pgtype.Text
-	MinPlayers             pgtype.Int4
-	MaxPlayers             pgtype.Int4
-	FlavorVersionID        *string
-	FileHash               pgtype.Text
-	FilePath               pgtype.Text
-	CreatedAt_4            pgtype.Timestamptz
-	ID_4                   *string
-	Nickname               pgtype.Text
-	Email                  pgtype.Text
-	CreatedAt_5            pgtype.Timestamptz
-	UpdatedAt_3            pgtype.Timestamptz
+type SetDesiredPlatformdVersionParams struct {
+	Version string
+	NodeIds []string
 }
 
-func (q *Queries) ListChunksWithPaginationIgnoreDeleted(ctx context.Context, arg ListChunksWithPaginationIgnoreDeletedParams) ([]ListChunksWithPaginationIgnoreDeletedRow, error) {
-	rows, err := q.db.Query(ctx, listChunksWithPaginationIgnoreDeleted, arg.AfterID, arg.Limit)
+// nodes already running the version are done right away. an empty
+// list of node ids rolls out the version to all nodes.
+func (q *Queries) SetDesiredPlatformdVersion(ctx context.Context, arg SetDesiredPlatformdVersionParams) ([]Node, error) {
+	rows, err := q.db.Query(ctx, setDesiredPlatformdVersion, arg.Version, arg.NodeIds)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListChunksWithPaginationIgnoreDeletedRow
+	var items []Node
 	for rows.Next() {
-		var i ListChunksWithPaginationIgnoreDeletedRow
+		var i Node
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
-			&i.Description,
-			&i.Tags,
+			&i.Address,
+			&i.CheckpointApiEndpoint,
 			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.OwnerID,
-			&i.ThumbnailHash,
-			&i.ThumbnailUpdatedAt,
-			&i.DeletedAt,
-			&i.ID_2,
-			&i.ChunkID,
-			&i.Name_2,
-			&i.CreatedAt_2,
-			&i.UpdatedAt_2,
-			&i.DeletedAt_2,
-			&i.ID_3,
-			&i.FlavorID,
-			&i.Hash,
-			&i.BuildStatus,
-			&i.Version,
-			&i.FilesUploaded,
-			&i.PrevVersionID,
-			&i.CreatedAt_3,
-			&i.PresignedUrlExpiryDate,
-			&i.PresignedUrl,
-			&i.MinecraftVersion,
-			&i.MinPlayers,
-			&i.MaxPlayers,
-			&i.FlavorVersionID,
-			&i.FileHash,
-			&i.FilePath,
-			&i.CreatedAt_4,
-			&i.ID_4,
-			&i.Nickname,
-			&i.Email,
-			&i.CreatedAt_5,
-			&i.UpdatedAt_3,
+			&i.Slots,
+			&i.Capabilities,
+			&i.LastSeenAt,
+			&i.MaxInstances,
+			&i.PlatformdVersion,
+			&i.DesiredPlatformdVersion,
+			&i.PlatformdUpdateStatus,
+			&i.PlatformdUpdateMessage,
+			&i.PlatformdUpdatedAt,
+			&i.WorkloadCpuMillis,
+			&i.WorkloadMemoryBytes,
+			&i.LostAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1261,104 +5148,56 @@ func (q *Queries) ListChunksWithPaginationIgnoreDeleted(ctx context.Context, arg
 	return items, nil
 }
 
-const listInstancesWithPagination = `-- name: ListInstancesWithPagination :many
-WITH paged_instances AS (
-    SELECT id FROM instances
-    WHERE $1::uuid IS NULL OR id > $1::uuid
-    ORDER BY id
-    LIMIT $2
-)
-SELECT
-    v.id, v.flavor_id, v.hash, v.build_status, v.version, v.files_uploaded, v.prev_version_id, v.created_at, v.presigned_url_expiry_date, v.presigned_url, v.minecraft_version, v.min_players, v.max_players,
-    c.id, c.name, c.description, c.tags, c.created_at, c.updated_at, c.owner_id, c.thumbnail_hash, c.thumbnail_updated_at, c.deleted_at,
-    f.id, f.chunk_id, f.name, f.created_at, f.updated_at, f.deleted_at,
-    n.id, n.name, n.address, n.checkpoint_api_endpoint, n.created_at, n.slots,
-    u.id, u.nickname, u.email, u.created_at, u.updated_at,
-    i.id, i.flavor_version_id, i.node_id, i.port, i.state, i.created_at, i.updated_at, i.owner_id, i.ordered_by
-FROM instances i
-    JOIN paged_instances pi ON pi.id = i.id
-    JOIN flavor_versions v ON i.flavor_version_id = v.id
-    JOIN flavors f ON f.id = v.flavor_id
-    JOIN chunks c ON c.id = f.chunk_id
-    JOIN nodes n ON i.node_id = n.id
-    JOIN users u ON u.id = i.owner_id
-ORDER BY i.id
+const setMaintenanceMode = `-- name: SetMaintenanceMode :one
+INSERT INTO maintenance_mode
+    (enabled, message, updated_by, updated_at)
+VALUES
+    ($1, $2, $3, now())
+ON CONFLICT (id) DO UPDATE SET
+    enabled = EXCLUDED.enabled,
+    message = EXCLUDED.message,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+RETURNING id, enabled, message, updated_by, updated_at
 `
 
-type ListInstancesWithPaginationParams struct {
-	AfterID *string
-	Limit   int32
+type SetMaintenanceModeParams struct {
+	Enabled   bool
+	Message   string
+	UpdatedBy *string
 }
 
-type ListInstancesWithPaginationRow struct {
-	FlavorVersion FlavorVersion
-	Chunk         Chunk
-	Flavor        Flavor
-	Node          Node
-	User          User
-	Instance      Instance
+func (q *Queries) SetMaintenanceMode(ctx context.Context, arg SetMaintenanceModeParams) (MaintenanceMode, error) {
+	row := q.db.QueryRow(ctx, setMaintenanceMode, arg.Enabled, arg.Message, arg.UpdatedBy)
+	var i MaintenanceMode
+	err := row.Scan(
+		&i.ID,
+		&i.Enabled,
+		&i.Message,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
-func (q *Queries) ListInstancesWithPagination(ctx context.Context, arg ListInstancesWithPaginationParams) ([]ListInstancesWithPaginationRow, error) {
-	rows, err := q.db.Query(ctx, listInstancesWithPagination, arg.AfterID, arg.Limit)
+const signingKeys = `-- name: SigningKeys :many
+/*
+ * SIGNING KEYS
+ */
+
+SELECT id, private_key, created_at FROM signing_keys ORDER BY created_at
+`
+
+func (q *Queries) SigningKeys(ctx context.Context) ([]SigningKey, error) {
+	rows, err := q.db.Query(ctx, signingKeys)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListInstancesWithPaginationRow
+	var items []SigningKey
 	for rows.Next() {
-		var i ListInstancesWithPaginationRow
-		if err := rows.Scan(
-			&i.FlavorVersion.ID,
-			&i.FlavorVersion.FlavorID,
-			&i.FlavorVersion.Hash,
-			&i.FlavorVersion.BuildStatus,
-			&i.FlavorVersion.Version,
-			&i.FlavorVersion.FilesUploaded,
-			&i.FlavorVersion.PrevVersionID,
-			&i.FlavorVersion.CreatedAt,
-			&i.FlavorVersion.PresignedUrlExpiryDate,
-			&i.FlavorVersion.PresignedUrl,
-			&i.FlavorVersion.MinecraftVersion,
-			&i.FlavorVersion.MinPlayers,
-			&i.FlavorVersion.MaxPlayers,
-			&i.Chunk.ID,
-			&i.Chunk.Name,
-			&i.Chunk.Description,
-			&i.Chunk.Tags,
-			&i.Chunk.CreatedAt,
-			&i.Chunk.UpdatedAt,
-			&i.Chunk.OwnerID,
-			&i.Chunk.ThumbnailHash,
-			&i.Chunk.ThumbnailUpdatedAt,
-			&i.Chunk.DeletedAt,
-			&i.Flavor.ID,
-			&i.Flavor.ChunkID,
-			&i.Flavor.Name,
-			&i.Flavor.CreatedAt,
-			&i.Flavor.UpdatedAt,
-			&i.Flavor.DeletedAt,
-			&i.Node.ID,
-			&i.Node.Name,
-			&i.Node.Address,
-			&i.Node.CheckpointApiEndpoint,
-			&i.Node.CreatedAt,
-			&i.Node.Slots,
-			&i.User.ID,
-			&i.User.Nickname,
-			&i.User.Email,
-			&i.User.CreatedAt,
-			&i.User.UpdatedAt,
-			&i.Instance.ID,
-			&i.Instance.FlavorVersionID,
-			&i.Instance.NodeID,
-			&i.Instance.Port,
-			&i.Instance.State,
-			&i.Instance.CreatedAt,
-			&i.Instance.UpdatedAt,
-			&i.Instance.OwnerID,
-			&i.Instance.OrderedBy,
-		); err != nil {
+		var i SigningKey
+		if err := rows.Scan(&i.ID, &i.PrivateKey, &i.CreatedAt); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1369,54 +5208,196 @@ func (q *Queries) ListInstancesWithPagination(ctx context.Context, arg ListInsta
 	return items, nil
 }
 
-const markChunkDeleted = `-- name: MarkChunkDeleted :exec
-UPDATE chunks SET deleted_at = now() WHERE id = $1
+const startChunkImport = `-- name: StartChunkImport :one
+UPDATE chunk_imports SET
+    state = 'IMPORTING',
+    updated_at = $2
+WHERE id = $1 AND state = 'PENDING_UPLOAD'
+RETURNING id, chunk_id, flavor_id, flavor_version_id, version, minecraft_version, min_players, max_players, archive_size_bytes, state, error, created_at, updated_at
 `
 
-func (q *Queries) MarkChunkDeleted(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, markChunkDeleted, id)
-	return err
+type StartChunkImportParams struct {
+	ID        string
+	UpdatedAt time.Time
 }
 
-const markFlavorDeleted = `-- name: MarkFlavorDeleted :exec
-UPDATE flavors SET deleted_at = now() WHERE id = $1
+func (q *Queries) StartChunkImport(ctx context.Context, arg StartChunkImportParams) (ChunkImport, error) {
+	row := q.db.QueryRow(ctx, startChunkImport, arg.ID, arg.UpdatedAt)
+	var i ChunkImport
+	err := row.Scan(
+		&i.ID,
+		&i.ChunkID,
+		&i.FlavorID,
+		&i.FlavorVersionID,
+		&i.Version,
+		&i.MinecraftVersion,
+		&i.MinPlayers,
+		&i.MaxPlayers,
+		&i.ArchiveSizeBytes,
+		&i.State,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const stopInstanceUsage = `-- name: StopInstanceUsage :exec
+UPDATE instance_usage SET stopped_at = now()
+WHERE instance_id = ANY($1::uuid[]) AND stopped_at IS NULL
 `
 
-func (q *Queries) MarkFlavorDeleted(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, markFlavorDeleted, id)
+func (q *Queries) StopInstanceUsage(ctx context.Context, instanceIds []string) error {
+	_, err := q.db.Exec(ctx, stopInstanceUsage, instanceIds)
 	return err
 }
 
-const markFlavorVersionFilesUploaded = `-- name: MarkFlavorVersionFilesUploaded :exec
-UPDATE flavor_versions SET files_uploaded = TRUE WHERE id = $1
+const tokenRevoked = `-- name: TokenRevoked :one
+SELECT (
+    EXISTS(SELECT 1 FROM revoked_tokens WHERE id = $1)
+    OR EXISTS(
+        SELECT 1 FROM user_token_revocations utr
+        WHERE utr.user_id = $2 AND utr.revoked_before > $3
+    )
+)::boolean AS revoked
 `
 
-func (q *Queries) MarkFlavorVersionFilesUploaded(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, markFlavorVersionFilesUploaded, id)
+type TokenRevokedParams struct {
+	TokenID  string
+	UserID   string
+	IssuedAt time.Time
+}
+
+func (q *Queries) TokenRevoked(ctx context.Context, arg TokenRevokedParams) (bool, error) {
+	row := q.db.QueryRow(ctx, tokenRevoked, arg.TokenID, arg.UserID, arg.IssuedAt)
+	var revoked bool
+	err := row.Scan(&revoked)
+	return revoked, err
+}
+
+const transferChunks = `-- name: TransferChunks :exec
+UPDATE chunks SET
+    owner_id = $1,
+    updated_at = now()
+WHERE owner_id = $2
+`
+
+type TransferChunksParams struct {
+	NewOwnerID string
+	OwnerID    string
+}
+
+func (q *Queries) TransferChunks(ctx context.Context, arg TransferChunksParams) error {
+	_, err := q.db.Exec(ctx, transferChunks, arg.NewOwnerID, arg.OwnerID)
 	return err
 }
 
-const randomNode = `-- name: RandomNode :one
+const transitionStaleInstances = `-- name: TransitionStaleInstances :many
+UPDATE instances SET
+    revision = nextval('instance_revision_seq'),
+    state_changed_at = now(),
+    state = $1,
+    updated_at = now()
+WHERE state = $2 AND state_changed_at < $3
+RETURNING id
+`
+
+type TransitionStaleInstancesParams struct {
+	ToState       InstanceState
+	FromState     InstanceState
+	ChangedBefore time.Time
+}
+
+// moves all instances that are in from_state since before
+// changed_before to to_state.
+func (q *Queries) TransitionStaleInstances(ctx context.Context, arg TransitionStaleInstancesParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, transitionStaleInstances, arg.ToState, arg.FromState, arg.ChangedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tryAdvisoryXactLock = `-- name: TryAdvisoryXactLock :one
 /*
- * NODES
+ * LOCKS
  */
-SELECT id, name, address, checkpoint_api_endpoint, created_at, slots FROM nodes ORDER BY random() LIMIT 1
+
+SELECT pg_try_advisory_xact_lock($1::int, hashtext($2::text))::boolean AS locked
 `
 
-func (q *Queries) RandomNode(ctx context.Context) (Node, error) {
-	row := q.db.QueryRow(ctx, randomNode)
-	var i Node
+type TryAdvisoryXactLockParams struct {
+	Namespace int32
+	Key       string
+}
+
+func (q *Queries) TryAdvisoryXactLock(ctx context.Context, arg TryAdvisoryXactLockParams) (bool, error) {
+	row := q.db.QueryRow(ctx, tryAdvisoryXactLock, arg.Namespace, arg.Key)
+	var locked bool
+	err := row.Scan(&locked)
+	return locked, err
+}
+
+const updateActiveCanaryState = `-- name: UpdateActiveCanaryState :one
+UPDATE canaries SET
+    state = $2,
+    updated_at = $3
+WHERE flavor_id = $1 AND state = 'ACTIVE'
+RETURNING id, flavor_id, stable_version_id, canary_version_id, percentage, state, created_at, updated_at
+`
+
+type UpdateActiveCanaryStateParams struct {
+	FlavorID  string
+	State     CanaryState
+	UpdatedAt time.Time
+}
+
+func (q *Queries) UpdateActiveCanaryState(ctx context.Context, arg UpdateActiveCanaryStateParams) (Canary, error) {
+	row := q.db.QueryRow(ctx, updateActiveCanaryState, arg.FlavorID, arg.State, arg.UpdatedAt)
+	var i Canary
 	err := row.Scan(
 		&i.ID,
-		&i.Name,
-		&i.Address,
-		&i.CheckpointApiEndpoint,
+		&i.FlavorID,
+		&i.StableVersionID,
+		&i.CanaryVersionID,
+		&i.Percentage,
+		&i.State,
 		&i.CreatedAt,
-		&i.Slots,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const updateAutoscalingPolicyScaledAt = `-- name: UpdateAutoscalingPolicyScaledAt :exec
+UPDATE autoscaling_policies SET
+    last_scale_up_at = COALESCE($1, last_scale_up_at),
+    last_scale_down_at = COALESCE($2, last_scale_down_at)
+WHERE flavor_id = $3
+`
+
+type UpdateAutoscalingPolicyScaledAtParams struct {
+	LastScaleUpAt   pgtype.Timestamptz
+	LastScaleDownAt pgtype.Timestamptz
+	FlavorID        string
+}
+
+func (q *Queries) UpdateAutoscalingPolicyScaledAt(ctx context.Context, arg UpdateAutoscalingPolicyScaledAtParams) error {
+	_, err := q.db.Exec(ctx, updateAutoscalingPolicyScaledAt, arg.LastScaleUpAt, arg.LastScaleDownAt, arg.FlavorID)
+	return err
+}
+
 const updateChunk = `-- name: UpdateChunk :exec
 UPDATE chunks
 SET
@@ -1444,6 +5425,84 @@ func (q *Queries) UpdateChunk(ctx context.Context, arg UpdateChunkParams) error
 	return err
 }
 
+const updateChunkImportFlavorVersion = `-- name: UpdateChunkImportFlavorVersion :exec
+UPDATE chunk_imports SET
+    flavor_version_id = $2,
+    updated_at = $3
+WHERE id = $1
+`
+
+type UpdateChunkImportFlavorVersionParams struct {
+	ID              string
+	FlavorVersionID *string
+	UpdatedAt       time.Time
+}
+
+func (q *Queries) UpdateChunkImportFlavorVersion(ctx context.Context, arg UpdateChunkImportFlavorVersionParams) error {
+	_, err := q.db.Exec(ctx, updateChunkImportFlavorVersion, arg.ID, arg.FlavorVersionID, arg.UpdatedAt)
+	return err
+}
+
+const updateChunkImportState = `-- name: UpdateChunkImportState :exec
+UPDATE chunk_imports SET
+    state = $2,
+    error = $3,
+    updated_at = $4
+WHERE id = $1
+`
+
+type UpdateChunkImportStateParams struct {
+	ID        string
+	State     ChunkImportState
+	Error     pgtype.Text
+	UpdatedAt time.Time
+}
+
+func (q *Queries) UpdateChunkImportState(ctx context.Context, arg UpdateChunkImportStateParams) error {
+	_, err := q.db.Exec(ctx, updateChunkImportState,
+		arg.ID,
+		arg.State,
+		arg.Error,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const updateChunkTemplate = `-- name: UpdateChunkTemplate :one
+UPDATE chunk_templates SET
+    description = $2,
+    flavor_version_id = $3,
+    updated_at = $4
+WHERE id = $1
+RETURNING id, name, description, flavor_version_id, created_at, updated_at
+`
+
+type UpdateChunkTemplateParams struct {
+	ID              string
+	Description     string
+	FlavorVersionID string
+	UpdatedAt       time.Time
+}
+
+func (q *Queries) UpdateChunkTemplate(ctx context.Context, arg UpdateChunkTemplateParams) (ChunkTemplate, error) {
+	row := q.db.QueryRow(ctx, updateChunkTemplate,
+		arg.ID,
+		arg.Description,
+		arg.FlavorVersionID,
+		arg.UpdatedAt,
+	)
+	var i ChunkTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.FlavorVersionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateChunkThumbnail = `-- name: UpdateChunkThumbnail :exec
 UPDATE chunks SET
     thumbnail_hash = $1,
@@ -1461,8 +5520,22 @@ func (q *Queries) UpdateChunkThumbnail(ctx context.Context, arg UpdateChunkThumb
 	return err
 }
 
+const updateFlavorVersionBuildProgress = `-- name: UpdateFlavorVersionBuildProgress :exec
+UPDATE flavor_versions SET build_progress = $1 WHERE id = $2
+`
+
+type UpdateFlavorVersionBuildProgressParams struct {
+	BuildProgress int16
+	ID            string
+}
+
+func (q *Queries) UpdateFlavorVersionBuildProgress(ctx context.Context, arg UpdateFlavorVersionBuildProgressParams) error {
+	_, err := q.db.Exec(ctx, updateFlavorVersionBuildProgress, arg.BuildProgress, arg.ID)
+	return err
+}
+
 const updateFlavorVersionBuildStatus = `-- name: UpdateFlavorVersionBuildStatus :exec
-UPDATE flavor_versions SET build_status = $1 WHERE id = $2
+UPDATE flavor_versions SET build_status = $1, build_error = NULL, build_progress = 0 WHERE id = $2
 `
 
 type UpdateFlavorVersionBuildStatusParams struct {
@@ -1475,6 +5548,57 @@ func (q *Queries) UpdateFlavorVersionBuildStatus(ctx context.Context, arg Update
 	return err
 }
 
+const updateFlavorVersionCheckpointNode = `-- name: UpdateFlavorVersionCheckpointNode :exec
+UPDATE flavor_versions SET checkpoint_node_id = $2 WHERE id = $1
+`
+
+type UpdateFlavorVersionCheckpointNodeParams struct {
+	ID               string
+	CheckpointNodeID *string
+}
+
+func (q *Queries) UpdateFlavorVersionCheckpointNode(ctx context.Context, arg UpdateFlavorVersionCheckpointNodeParams) error {
+	_, err := q.db.Exec(ctx, updateFlavorVersionCheckpointNode, arg.ID, arg.CheckpointNodeID)
+	return err
+}
+
+const updateFlavorVersionCheckpointProvenance = `-- name: UpdateFlavorVersionCheckpointProvenance :exec
+UPDATE flavor_version_provenance SET
+    checkpoint_node_id = $1::UUID,
+    platformd_version = COALESCE((SELECT platformd_version FROM nodes WHERE id = $1::UUID), ''),
+    checkpoint_built_at = $2
+WHERE flavor_version_id = $3
+`
+
+type UpdateFlavorVersionCheckpointProvenanceParams struct {
+	NodeID          string
+	BuiltAt         pgtype.Timestamptz
+	FlavorVersionID string
+}
+
+func (q *Queries) UpdateFlavorVersionCheckpointProvenance(ctx context.Context, arg UpdateFlavorVersionCheckpointProvenanceParams) error {
+	_, err := q.db.Exec(ctx, updateFlavorVersionCheckpointProvenance, arg.NodeID, arg.BuiltAt, arg.FlavorVersionID)
+	return err
+}
+
+const updateFlavorVersionCheckpointResources = `-- name: UpdateFlavorVersionCheckpointResources :exec
+UPDATE flavor_versions SET
+    checkpoint_cpu_millis = $1,
+    checkpoint_memory_limit_bytes = $2
+WHERE id = $3
+`
+
+type UpdateFlavorVersionCheckpointResourcesParams struct {
+	CpuMillis        *int32
+	MemoryLimitBytes *int64
+	ID               string
+}
+
+func (q *Queries) UpdateFlavorVersionCheckpointResources(ctx context.Context, arg UpdateFlavorVersionCheckpointResourcesParams) error {
+	_, err := q.db.Exec(ctx, updateFlavorVersionCheckpointResources, arg.CpuMillis, arg.MemoryLimitBytes, arg.ID)
+	return err
+}
+
 const updateFlavorVersionPresignedURLData = `-- name: UpdateFlavorVersionPresignedURLData :exec
 UPDATE flavor_versions SET
     presigned_url_expiry_date = $1,
@@ -1493,6 +5617,407 @@ func (q *Queries) UpdateFlavorVersionPresignedURLData(ctx context.Context, arg U
 	return err
 }
 
+const updateFlavorVersionRestoreLatency = `-- name: UpdateFlavorVersionRestoreLatency :exec
+UPDATE flavor_versions SET restore_latency_ms = $1 WHERE id = $2
+`
+
+type UpdateFlavorVersionRestoreLatencyParams struct {
+	RestoreLatencyMs pgtype.Int4
+	ID               string
+}
+
+func (q *Queries) UpdateFlavorVersionRestoreLatency(ctx context.Context, arg UpdateFlavorVersionRestoreLatencyParams) error {
+	_, err := q.db.Exec(ctx, updateFlavorVersionRestoreLatency, arg.RestoreLatencyMs, arg.ID)
+	return err
+}
+
+const updateInstanceState = `-- name: UpdateInstanceState :one
+UPDATE instances SET
+    revision = CASE
+        WHEN state IS DISTINCT FROM $1 THEN nextval('instance_revision_seq')
+        ELSE revision
+    END,
+    state_changed_at = CASE
+        WHEN state IS DISTINCT FROM $1 THEN now()
+        ELSE state_changed_at
+    END,
+    state = $1,
+    updated_at = now()
+WHERE id = $2 AND state::text = ANY($3::text[])
+RETURNING id
+`
+
+type UpdateInstanceStateParams struct {
+	State      InstanceState
+	ID         string
+	FromStates []string
+}
+
+// same as BulkUpdateInstanceStateAndPort, but for a single instance
+// and without touching the port.
+func (q *Queries) UpdateInstanceState(ctx context.Context, arg UpdateInstanceStateParams) (string, error) {
+	row := q.db.QueryRow(ctx, updateInstanceState, arg.State, arg.ID, arg.FromStates)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateNodeHeartbeat = `-- name: UpdateNodeHeartbeat :exec
+UPDATE nodes SET
+    capabilities = $2,
+    max_instances = $3,
+    workload_cpu_millis = $4,
+    workload_memory_bytes = $5,
+    last_seen_at = now(),
+    lost_at = NULL
+WHERE id = $1
+`
+
+type UpdateNodeHeartbeatParams struct {
+	ID                  string
+	Capabilities        []string
+	MaxInstances        pgtype.Int4
+	WorkloadCpuMillis   int64
+	WorkloadMemoryBytes int64
+}
+
+func (q *Queries) UpdateNodeHeartbeat(ctx context.Context, arg UpdateNodeHeartbeatParams) error {
+	_, err := q.db.Exec(ctx, updateNodeHeartbeat,
+		arg.ID,
+		arg.Capabilities,
+		arg.MaxInstances,
+		arg.WorkloadCpuMillis,
+		arg.WorkloadMemoryBytes,
+	)
+	return err
+}
+
+const updatePlatformdUpdateStatus = `-- name: UpdatePlatformdUpdateStatus :exec
+UPDATE nodes SET
+    platformd_update_status = $1,
+    platformd_update_message = $2,
+    platformd_updated_at = now()
+WHERE id = $3 AND desired_platformd_version = $4
+`
+
+type UpdatePlatformdUpdateStatusParams struct {
+	Status  NullPlatformdUpdateStatus
+	Message string
+	ID      string
+	Version string
+}
+
+// reports for versions that are no longer desired are ignored.
+func (q *Queries) UpdatePlatformdUpdateStatus(ctx context.Context, arg UpdatePlatformdUpdateStatusParams) error {
+	_, err := q.db.Exec(ctx, updatePlatformdUpdateStatus,
+		arg.Status,
+		arg.Message,
+		arg.ID,
+		arg.Version,
+	)
+	return err
+}
+
+const upsertAutoscalingPolicy = `-- name: UpsertAutoscalingPolicy :one
+/*
+ * AUTOSCALING
+ */
+
+INSERT INTO autoscaling_policies
+    (flavor_id, min_instances, max_instances, target_utilization,
+     scale_up_cooldown_seconds, scale_down_cooldown_seconds, updated_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (flavor_id) DO UPDATE SET
+    min_instances = EXCLUDED.min_instances,
+    max_instances = EXCLUDED.max_instances,
+    target_utilization = EXCLUDED.target_utilization,
+    scale_up_cooldown_seconds = EXCLUDED.scale_up_cooldown_seconds,
+    scale_down_cooldown_seconds = EXCLUDED.scale_down_cooldown_seconds,
+    updated_at = EXCLUDED.updated_at
+RETURNING flavor_id, min_instances, max_instances, target_utilization, scale_up_cooldown_seconds, scale_down_cooldown_seconds, last_scale_up_at, last_scale_down_at, updated_at
+`
+
+type UpsertAutoscalingPolicyParams struct {
+	FlavorID                 string
+	MinInstances             int32
+	MaxInstances             int32
+	TargetUtilization        int32
+	ScaleUpCooldownSeconds   int32
+	ScaleDownCooldownSeconds int32
+	UpdatedAt                time.Time
+}
+
+// the times of the last scaling decisions are kept,
+// so replacing a policy does not reset its cooldowns.
+func (q *Queries) UpsertAutoscalingPolicy(ctx context.Context, arg UpsertAutoscalingPolicyParams) (AutoscalingPolicy, error) {
+	row := q.db.QueryRow(ctx, upsertAutoscalingPolicy,
+		arg.FlavorID,
+		arg.MinInstances,
+		arg.MaxInstances,
+		arg.TargetUtilization,
+		arg.ScaleUpCooldownSeconds,
+		arg.ScaleDownCooldownSeconds,
+		arg.UpdatedAt,
+	)
+	var i AutoscalingPolicy
+	err := row.Scan(
+		&i.FlavorID,
+		&i.MinInstances,
+		&i.MaxInstances,
+		&i.TargetUtilization,
+		&i.ScaleUpCooldownSeconds,
+		&i.ScaleDownCooldownSeconds,
+		&i.LastScaleUpAt,
+		&i.LastScaleDownAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertChunkReadme = `-- name: UpsertChunkReadme :one
+/*
+ * CHUNK READMES AND MEDIA
+ */
+
+INSERT INTO chunk_readmes
+    (chunk_id, markdown, updated_at)
+VALUES
+    ($1, $2, $3)
+ON CONFLICT (chunk_id) DO UPDATE SET
+    markdown = EXCLUDED.markdown,
+    updated_at = EXCLUDED.updated_at
+RETURNING chunk_id, markdown, updated_at
+`
+
+type UpsertChunkReadmeParams struct {
+	ChunkID   string
+	Markdown  string
+	UpdatedAt time.Time
+}
+
+func (q *Queries) UpsertChunkReadme(ctx context.Context, arg UpsertChunkReadmeParams) (ChunkReadme, error) {
+	row := q.db.QueryRow(ctx, upsertChunkReadme, arg.ChunkID, arg.Markdown, arg.UpdatedAt)
+	var i ChunkReadme
+	err := row.Scan(&i.ChunkID, &i.Markdown, &i.UpdatedAt)
+	return i, err
+}
+
+const upsertFlavorChannel = `-- name: UpsertFlavorChannel :one
+/*
+ * FLAVOR CHANNELS
+ */
+
+INSERT INTO flavor_channels
+    (flavor_id, name, flavor_version_id, updated_at)
+VALUES
+    ($1, $2, $3, $4)
+ON CONFLICT (flavor_id, name) DO UPDATE SET
+    flavor_version_id = EXCLUDED.flavor_version_id,
+    updated_at = EXCLUDED.updated_at
+RETURNING flavor_id, name, flavor_version_id, updated_at
+`
+
+type UpsertFlavorChannelParams struct {
+	FlavorID        string
+	Name            string
+	FlavorVersionID string
+	UpdatedAt       time.Time
+}
+
+func (q *Queries) UpsertFlavorChannel(ctx context.Context, arg UpsertFlavorChannelParams) (FlavorChannel, error) {
+	row := q.db.QueryRow(ctx, upsertFlavorChannel,
+		arg.FlavorID,
+		arg.Name,
+		arg.FlavorVersionID,
+		arg.UpdatedAt,
+	)
+	var i FlavorChannel
+	err := row.Scan(
+		&i.FlavorID,
+		&i.Name,
+		&i.FlavorVersionID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertFlavorVersionImageProvenance = `-- name: UpsertFlavorVersionImageProvenance :exec
+INSERT INTO flavor_version_provenance
+    (flavor_version_id, base_image_digest, image_digest, builder_version, input_hash, plugins, image_built_at)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (flavor_version_id) DO UPDATE SET
+    base_image_digest = EXCLUDED.base_image_digest,
+    image_digest = EXCLUDED.image_digest,
+    builder_version = EXCLUDED.builder_version,
+    input_hash = EXCLUDED.input_hash,
+    plugins = EXCLUDED.plugins,
+    image_built_at = EXCLUDED.image_built_at,
+    checkpoint_node_id = NULL,
+    platformd_version = '',
+    checkpoint_built_at = NULL
+`
+
+type UpsertFlavorVersionImageProvenanceParams struct {
+	FlavorVersionID string
+	BaseImageDigest string
+	ImageDigest     string
+	BuilderVersion  string
+	InputHash       string
+	Plugins         []byte
+	ImageBuiltAt    time.Time
+}
+
+// rebuilding the image invalidates the checkpoint, so its provenance is reset.
+func (q *Queries) UpsertFlavorVersionImageProvenance(ctx context.Context, arg UpsertFlavorVersionImageProvenanceParams) error {
+	_, err := q.db.Exec(ctx, upsertFlavorVersionImageProvenance,
+		arg.FlavorVersionID,
+		arg.BaseImageDigest,
+		arg.ImageDigest,
+		arg.BuilderVersion,
+		arg.InputHash,
+		arg.Plugins,
+		arg.ImageBuiltAt,
+	)
+	return err
+}
+
+const upsertNodeCondition = `-- name: UpsertNodeCondition :exec
+INSERT INTO node_conditions
+    (node_id, type, message, since)
+VALUES
+    ($1, $2, $3, $4)
+ON CONFLICT (node_id, type) DO UPDATE SET message = EXCLUDED.message
+`
+
+type UpsertNodeConditionParams struct {
+	NodeID  string
+	Type    NodeConditionType
+	Message string
+	Since   time.Time
+}
+
+// since is kept if the node already reported the condition.
+func (q *Queries) UpsertNodeCondition(ctx context.Context, arg UpsertNodeConditionParams) error {
+	_, err := q.db.Exec(ctx, upsertNodeCondition,
+		arg.NodeID,
+		arg.Type,
+		arg.Message,
+		arg.Since,
+	)
+	return err
+}
+
+const upsertNotificationPreferences = `-- name: UpsertNotificationPreferences :exec
+INSERT INTO notification_preferences
+    (user_id, build_completed, build_failed, instance_crashed, updated_at)
+VALUES
+    ($1, $2, $3, $4, now())
+ON CONFLICT (user_id) DO UPDATE SET
+    build_completed = EXCLUDED.build_completed,
+    build_failed = EXCLUDED.build_failed,
+    instance_crashed = EXCLUDED.instance_crashed,
+    updated_at = now()
+`
+
+type UpsertNotificationPreferencesParams struct {
+	UserID          string
+	BuildCompleted  bool
+	BuildFailed     bool
+	InstanceCrashed bool
+}
+
+func (q *Queries) UpsertNotificationPreferences(ctx context.Context, arg UpsertNotificationPreferencesParams) error {
+	_, err := q.db.Exec(ctx, upsertNotificationPreferences,
+		arg.UserID,
+		arg.BuildCompleted,
+		arg.BuildFailed,
+		arg.InstanceCrashed,
+	)
+	return err
+}
+
+const upsertRetentionPolicy = `-- name: UpsertRetentionPolicy :one
+/*
+ * RETENTION POLICIES
+ */
+
+INSERT INTO chunk_retention_policies
+    (chunk_id, keep_last_versions, keep_days, updated_at)
+VALUES
+    ($1, $2, $3, $4)
+ON CONFLICT (chunk_id) DO UPDATE SET
+    keep_last_versions = EXCLUDED.keep_last_versions,
+    keep_days = EXCLUDED.keep_days,
+    updated_at = EXCLUDED.updated_at
+RETURNING chunk_id, keep_last_versions, keep_days, updated_at
+`
+
+type UpsertRetentionPolicyParams struct {
+	ChunkID          string
+	KeepLastVersions int32
+	KeepDays         int32
+	UpdatedAt        time.Time
+}
+
+func (q *Queries) UpsertRetentionPolicy(ctx context.Context, arg UpsertRetentionPolicyParams) (ChunkRetentionPolicy, error) {
+	row := q.db.QueryRow(ctx, upsertRetentionPolicy,
+		arg.ChunkID,
+		arg.KeepLastVersions,
+		arg.KeepDays,
+		arg.UpdatedAt,
+	)
+	var i ChunkRetentionPolicy
+	err := row.Scan(
+		&i.ChunkID,
+		&i.KeepLastVersions,
+		&i.KeepDays,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertRuntimeSetting = `-- name: UpsertRuntimeSetting :exec
+INSERT INTO runtime_settings
+    (name, value, updated_by, updated_at)
+VALUES
+    ($1, $2, $3, now())
+ON CONFLICT (name) DO UPDATE SET
+    value = EXCLUDED.value,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+`
+
+type UpsertRuntimeSettingParams struct {
+	Name      string
+	Value     string
+	UpdatedBy string
+}
+
+func (q *Queries) UpsertRuntimeSetting(ctx context.Context, arg UpsertRuntimeSettingParams) error {
+	_, err := q.db.Exec(ctx, upsertRuntimeSetting, arg.Name, arg.Value, arg.UpdatedBy)
+	return err
+}
+
+const useInstanceInvite = `-- name: UseInstanceInvite :execrows
+UPDATE instance_invites SET uses = uses + 1
+WHERE id = $1
+  AND revoked_at IS NULL
+  AND expires_at > now()
+  AND (max_uses = 0 OR uses < max_uses)
+`
+
+// the conditions are checked again, so concurrent joins
+// cannot use the invite more often than allowed.
+func (q *Queries) UseInstanceInvite(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, useInstanceInvite, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const userByEmail = `-- name: UserByEmail :one
 /*
  * USERS
@@ -1513,3 +6038,20 @@ func (q *Queries) UserByEmail(ctx context.Context, email string) (User, error) {
 	)
 	return i, err
 }
+
+const userByID = `-- name: UserByID :one
+SELECT id, nickname, email, created_at, updated_at FROM users WHERE id = $1
+`
+
+func (q *Queries) UserByID(ctx context.Context, id string) (User, error) {
+	row := q.db.QueryRow(ctx, userByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Nickname,
+		&i.Email,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
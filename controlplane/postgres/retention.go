@@ -0,0 +1,112 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// UpsertRetentionPolicy creates the retention policy of the chunk or
+// replaces it, if the chunk already has one.
+func (db *DB) UpsertRetentionPolicy(
+	ctx context.Context,
+	policy resource.RetentionPolicy,
+) (resource.RetentionPolicy, error) {
+	var ret resource.RetentionPolicy
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.UpsertRetentionPolicy(ctx, query.UpsertRetentionPolicyParams{
+			ChunkID:          policy.ChunkID,
+			KeepLastVersions: int32(policy.KeepLastVersions),
+			KeepDays:         int32(policy.KeepDays),
+			UpdatedAt:        time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("upsert retention policy: %w", err)
+		}
+
+		ret = retentionPolicyToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.RetentionPolicy{}, err
+	}
+
+	return ret, nil
+}
+
+// RetentionPolicyByChunkID returns the retention policy of the chunk.
+// returns [apierrs.ErrNotFound] if the chunk has no retention policy.
+func (db *DB) RetentionPolicyByChunkID(ctx context.Context, chunkID string) (resource.RetentionPolicy, error) {
+	var ret resource.RetentionPolicy
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.RetentionPolicyByChunkID(ctx, chunkID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNotFound
+			}
+			return fmt.Errorf("retention policy: %w", err)
+		}
+
+		ret = retentionPolicyToDomain(row)
+		return nil
+	}); err != nil {
+		return resource.RetentionPolicy{}, err
+	}
+
+	return ret, nil
+}
+
+// AllRetentionPolicies returns the retention policies of all chunks that
+// have not been deleted.
+func (db *DB) AllRetentionPolicies(ctx context.Context) ([]resource.RetentionPolicy, error) {
+	var ret []resource.RetentionPolicy
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.AllRetentionPolicies(ctx)
+		if err != nil {
+			return fmt.Errorf("all retention policies: %w", err)
+		}
+
+		ret = make([]resource.RetentionPolicy, 0, len(rows))
+		for _, row := range rows {
+			ret = append(ret, retentionPolicyToDomain(row))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func retentionPolicyToDomain(row query.ChunkRetentionPolicy) resource.RetentionPolicy {
+	return resource.RetentionPolicy{
+		ChunkID:          row.ChunkID,
+		KeepLastVersions: uint32(row.KeepLastVersions),
+		KeepDays:         uint32(row.KeepDays),
+		UpdatedAt:        row.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,153 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/controlplane/rollout"
+)
+
+func (db *DB) SetDesiredPlatformdVersion(
+	ctx context.Context,
+	nodeIDs []string,
+	version string,
+) ([]rollout.Rollout, error) {
+	ids := slices.Clone(nodeIDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	var ret []rollout.Rollout
+	if err := db.doTX(ctx, func(tx pgx.Tx, q *query.Queries) error {
+		rows, err := q.SetDesiredPlatformdVersion(ctx, query.SetDesiredPlatformdVersionParams{
+			Version: version,
+			NodeIds: ids,
+		})
+		if err != nil {
+			return fmt.Errorf("set desired platformd version: %w", err)
+		}
+
+		// returning an error rolls back the transaction, so the
+		// version is not rolled out to the other nodes either.
+		if len(ids) > 0 && len(rows) != len(ids) {
+			return apierrs.ErrNodeNotFound
+		}
+
+		ret = make([]rollout.Rollout, 0, len(rows))
+		for _, n := range rows {
+			ret = append(ret, rolloutToDomain(n))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) ListPlatformdRollouts(ctx context.Context) ([]rollout.Rollout, error) {
+	var ret []rollout.Rollout
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.ListPlatformdRollouts(ctx)
+		if err != nil {
+			return fmt.Errorf("list platformd rollouts: %w", err)
+		}
+
+		ret = make([]rollout.Rollout, 0, len(rows))
+		for _, n := range rows {
+			ret = append(ret, rolloutToDomain(n))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) ReportPlatformdVersion(ctx context.Context, nodeID string, version string) (rollout.Rollout, error) {
+	var ret rollout.Rollout
+	if err := db.do(ctx, func(q *query.Queries) error {
+		n, err := q.ReportPlatformdVersion(ctx, query.ReportPlatformdVersionParams{
+			Version: version,
+			ID:      nodeID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apierrs.ErrNodeNotFound
+			}
+			return fmt.Errorf("report platformd version: %w", err)
+		}
+
+		ret = rolloutToDomain(n)
+		return nil
+	}); err != nil {
+		return rollout.Rollout{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) UpdatePlatformdUpdateStatus(
+	ctx context.Context,
+	nodeID string,
+	version string,
+	status rollout.Status,
+	message string,
+) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.UpdatePlatformdUpdateStatus(ctx, query.UpdatePlatformdUpdateStatusParams{
+			Status: query.NullPlatformdUpdateStatus{
+				PlatformdUpdateStatus: query.PlatformdUpdateStatus(status),
+				Valid:                 true,
+			},
+			Message: message,
+			ID:      nodeID,
+			Version: version,
+		})
+	})
+}
+
+func rolloutToDomain(n query.Node) rollout.Rollout {
+	ret := rollout.Rollout{
+		NodeID:         n.ID,
+		NodeName:       n.Name,
+		CurrentVersion: n.PlatformdVersion,
+		DesiredVersion: n.DesiredPlatformdVersion,
+		Message:        n.PlatformdUpdateMessage,
+	}
+
+	if n.PlatformdUpdateStatus.Valid {
+		ret.Status = rollout.Status(n.PlatformdUpdateStatus.PlatformdUpdateStatus)
+	}
+
+	if n.PlatformdUpdatedAt.Valid {
+		ret.UpdatedAt = n.PlatformdUpdatedAt.Time
+	}
+
+	return ret
+}
@@ -15,6 +15,17 @@ SET xmloption = content;
 SET client_min_messages = warning;
 SET row_security = off;
 
+--
+-- Name: announcement_severity; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.announcement_severity AS ENUM (
+    'INFO',
+    'WARNING',
+    'CRITICAL'
+);
+
+
 --
 -- Name: build_status; Type: TYPE; Schema: public; Owner: -
 --
@@ -29,6 +40,58 @@ CREATE TYPE public.build_status AS ENUM (
 );
 
 
+--
+-- Name: canary_state; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.canary_state AS ENUM (
+    'ACTIVE',
+    'PROMOTED',
+    'ABORTED'
+);
+
+
+--
+-- Name: chunk_import_state; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.chunk_import_state AS ENUM (
+    'PENDING_UPLOAD',
+    'IMPORTING',
+    'COMPLETED',
+    'FAILED'
+);
+
+
+--
+-- Name: cross_instance_traffic_state; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.cross_instance_traffic_state AS ENUM (
+    'REQUESTED',
+    'APPROVED',
+    'REJECTED'
+);
+
+
+--
+-- Name: instance_event_type; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.instance_event_type AS ENUM (
+    'OOM_KILLED',
+    'CRASHED',
+    'EXITED',
+    'SCHEDULED',
+    'IMAGE_PULLED',
+    'CONTAINER_STARTED',
+    'READY',
+    'PLAYER_JOINED',
+    'REMOVED',
+    'NODE_LOST'
+);
+
+
 --
 -- Name: instance_state; Type: TYPE; Schema: public; Owner: -
 --
@@ -39,7 +102,54 @@ CREATE TYPE public.instance_state AS ENUM (
     'RUNNING',
     'DELETING',
     'DELETED',
-    'CREATION_FAILED'
+    'CREATION_FAILED',
+    'LOST'
+);
+
+
+--
+-- Name: node_condition_type; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.node_condition_type AS ENUM (
+    'DISK_PRESSURE',
+    'CRI_UNRESPONSIVE',
+    'BPF_MAP_FULL',
+    'CONNTRACK_EXHAUSTED'
+);
+
+
+--
+-- Name: node_event_type; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.node_event_type AS ENUM (
+    'CONDITION_RAISED',
+    'CONDITION_CLEARED'
+);
+
+
+--
+-- Name: platformd_update_status; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.platformd_update_status AS ENUM (
+    'PENDING',
+    'DOWNLOADING',
+    'INSTALLING',
+    'SUCCEEDED',
+    'FAILED'
+);
+
+
+--
+-- Name: restart_policy; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.restart_policy AS ENUM (
+    'NEVER',
+    'ALWAYS',
+    'ON_FAILURE'
 );
 
 
@@ -59,6 +169,54 @@ CREATE TYPE public.river_job_state AS ENUM (
 );
 
 
+--
+-- Name: create_monthly_partition(text, timestamp with time zone); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.create_monthly_partition(parent_table text, month_of timestamp with time zone) RETURNS text
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    first_day timestamp := date_trunc('month', month_of AT TIME ZONE 'UTC');
+    part text := parent_table || '_' || to_char(first_day, 'YYYYMM');
+BEGIN
+    EXECUTE format(
+        'CREATE TABLE IF NOT EXISTS %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)',
+        part,
+        parent_table,
+        first_day AT TIME ZONE 'UTC',
+        (first_day + interval '1 month') AT TIME ZONE 'UTC'
+    );
+    RETURN part;
+END;
+$$;
+
+
+--
+-- Name: drop_monthly_partitions_before(text, timestamp with time zone); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.drop_monthly_partitions_before(parent_table text, cutoff timestamp with time zone) RETURNS SETOF text
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    part text;
+BEGIN
+    FOR part IN
+        SELECT c.relname::text FROM pg_inherits i
+        JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = parent_table::regclass
+          AND c.relname ~ ('^' || parent_table || '_[0-9]{6}$')
+          AND (to_date(right(c.relname, 6), 'YYYYMM') + interval '1 month') AT TIME ZONE 'UTC' <= cutoff
+        ORDER BY c.relname
+    LOOP
+        EXECUTE format('DROP TABLE %I', part);
+        RETURN NEXT part;
+    END LOOP;
+END;
+$$;
+
+
 --
 -- Name: river_job_state_in_bitmask(bit, public.river_job_state); Type: FUNCTION; Schema: public; Owner: -
 --
@@ -84,6 +242,59 @@ SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+--
+-- Name: announcements; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.announcements (
+    id uuid NOT NULL,
+    message character varying(512) NOT NULL,
+    severity public.announcement_severity NOT NULL,
+    starts_at timestamp with time zone NOT NULL,
+    ends_at timestamp with time zone,
+    created_by uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.assets (
+    hash character(64) NOT NULL,
+    size_bytes bigint NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: autoscaled_instances; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.autoscaled_instances (
+    instance_id uuid NOT NULL,
+    flavor_id uuid NOT NULL
+);
+
+
+--
+-- Name: autoscaling_policies; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.autoscaling_policies (
+    flavor_id uuid NOT NULL,
+    min_instances integer NOT NULL,
+    max_instances integer NOT NULL,
+    target_utilization integer NOT NULL,
+    scale_up_cooldown_seconds integer NOT NULL,
+    scale_down_cooldown_seconds integer NOT NULL,
+    last_scale_up_at timestamp with time zone,
+    last_scale_down_at timestamp with time zone,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: blobs; Type: TABLE; Schema: public; Owner: -
 --
@@ -95,6 +306,34 @@ CREATE TABLE public.blobs (
 );
 
 
+--
+-- Name: canaries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.canaries (
+    id uuid NOT NULL,
+    flavor_id uuid NOT NULL,
+    stable_version_id uuid NOT NULL,
+    canary_version_id uuid NOT NULL,
+    percentage smallint NOT NULL,
+    state public.canary_state DEFAULT 'ACTIVE'::public.canary_state NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: canary_instances; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.canary_instances (
+    instance_id uuid NOT NULL,
+    canary_id uuid NOT NULL,
+    canary boolean NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: chunk_archive; Type: TABLE; Schema: public; Owner: -
 --
@@ -107,6 +346,105 @@ CREATE TABLE public.chunk_archive (
 );
 
 
+--
+-- Name: chunk_cross_instance_traffic; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.chunk_cross_instance_traffic (
+    chunk_id uuid NOT NULL,
+    state public.cross_instance_traffic_state NOT NULL,
+    reason text NOT NULL,
+    requested_by uuid NOT NULL,
+    reviewed_by uuid,
+    review_message text DEFAULT ''::text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: chunk_imports; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.chunk_imports (
+    id uuid NOT NULL,
+    chunk_id uuid NOT NULL,
+    flavor_id uuid NOT NULL,
+    flavor_version_id uuid,
+    version character varying(25) NOT NULL,
+    minecraft_version character varying NOT NULL,
+    min_players integer NOT NULL,
+    max_players integer NOT NULL,
+    archive_size_bytes bigint NOT NULL,
+    state public.chunk_import_state DEFAULT 'PENDING_UPLOAD'::public.chunk_import_state NOT NULL,
+    error text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: chunk_media; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.chunk_media (
+    id uuid NOT NULL,
+    chunk_id uuid NOT NULL,
+    hash character varying(64) NOT NULL,
+    content_type character varying(32) NOT NULL,
+    size_bytes bigint NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: chunk_readmes; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.chunk_readmes (
+    chunk_id uuid NOT NULL,
+    markdown text NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: chunk_retention_policies; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.chunk_retention_policies (
+    chunk_id uuid NOT NULL,
+    keep_last_versions integer NOT NULL,
+    keep_days integer NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: chunk_slugs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.chunk_slugs (
+    slug character varying(50) NOT NULL,
+    chunk_id uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: chunk_templates; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.chunk_templates (
+    id uuid NOT NULL,
+    name character varying(50) NOT NULL,
+    description character varying(100) NOT NULL,
+    flavor_version_id uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: chunks; Type: TABLE; Schema: public; Owner: -
 --
@@ -137,6 +475,18 @@ CREATE TABLE public.flavor_archive (
 );
 
 
+--
+-- Name: flavor_channels; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.flavor_channels (
+    flavor_id uuid NOT NULL,
+    name text NOT NULL,
+    flavor_version_id uuid NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: flavor_version_archive; Type: TABLE; Schema: public; Owner: -
 --
@@ -149,6 +499,17 @@ CREATE TABLE public.flavor_version_archive (
 );
 
 
+--
+-- Name: flavor_version_assets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.flavor_version_assets (
+    flavor_version_id uuid NOT NULL,
+    asset_hash character(64) NOT NULL,
+    "position" smallint NOT NULL
+);
+
+
 --
 -- Name: flavor_version_files; Type: TABLE; Schema: public; Owner: -
 --
@@ -157,7 +518,26 @@ CREATE TABLE public.flavor_version_files (
     flavor_version_id uuid NOT NULL,
     file_hash character varying(16) NOT NULL,
     file_path character varying(4096) NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    size_bytes bigint DEFAULT 0 NOT NULL
+);
+
+
+--
+-- Name: flavor_version_provenance; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.flavor_version_provenance (
+    flavor_version_id uuid NOT NULL,
+    base_image_digest character varying(255) NOT NULL,
+    image_digest character varying(255) NOT NULL,
+    builder_version character varying(64) DEFAULT ''::character varying NOT NULL,
+    input_hash character varying(64) NOT NULL,
+    plugins jsonb DEFAULT '[]'::jsonb NOT NULL,
+    image_built_at timestamp with time zone NOT NULL,
+    checkpoint_node_id uuid,
+    platformd_version character varying(64) DEFAULT ''::character varying NOT NULL,
+    checkpoint_built_at timestamp with time zone
 );
 
 
@@ -178,7 +558,18 @@ CREATE TABLE public.flavor_versions (
     presigned_url character varying,
     minecraft_version character varying NOT NULL,
     min_players integer DEFAULT 1 NOT NULL,
-    max_players integer DEFAULT 1 NOT NULL
+    max_players integer DEFAULT 1 NOT NULL,
+    checkpoint_cpu_millis integer,
+    checkpoint_memory_limit_bytes bigint,
+    checkpoint_node_id uuid,
+    build_error text,
+    build_progress smallint DEFAULT 0 NOT NULL,
+    startup_grace_period_seconds integer DEFAULT 0 NOT NULL,
+    restart_policy public.restart_policy DEFAULT 'NEVER'::public.restart_policy NOT NULL,
+    restart_max_retries integer DEFAULT 0 NOT NULL,
+    restore_latency_ms integer,
+    java_version integer DEFAULT 0 NOT NULL,
+    startup_command text DEFAULT ''::text NOT NULL
 );
 
 
@@ -197,462 +588,1647 @@ CREATE TABLE public.flavors (
 
 
 --
--- Name: instances; Type: TABLE; Schema: public; Owner: -
+-- Name: impersonated_actions; Type: TABLE; Schema: public; Owner: -
 --
 
-CREATE TABLE public.instances (
+CREATE TABLE public.impersonated_actions (
+    impersonation_id uuid NOT NULL,
+    method text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+)
+PARTITION BY RANGE (created_at);
+
+
+--
+-- Name: impersonated_actions_default; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.impersonated_actions_default (
+    impersonation_id uuid NOT NULL,
+    method text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: impersonations; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.impersonations (
     id uuid NOT NULL,
-    flavor_version_id uuid NOT NULL,
-    node_id uuid NOT NULL,
-    port integer,
-    state public.instance_state DEFAULT 'PENDING'::public.instance_state NOT NULL,
+    user_id uuid NOT NULL,
+    admin_id uuid NOT NULL,
+    reason text NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
-    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    expires_at timestamp with time zone NOT NULL
+);
+
+
+--
+-- Name: instance_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.instance_events (
+    id uuid NOT NULL,
+    instance_id uuid NOT NULL,
+    type public.instance_event_type NOT NULL,
+    exit_code integer DEFAULT 0 NOT NULL,
+    message character varying(1024) DEFAULT ''::character varying NOT NULL,
+    created_at timestamp with time zone NOT NULL
+)
+PARTITION BY RANGE (created_at);
+
+
+--
+-- Name: instance_events_default; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.instance_events_default (
+    id uuid NOT NULL,
+    instance_id uuid NOT NULL,
+    type public.instance_event_type NOT NULL,
+    exit_code integer DEFAULT 0 NOT NULL,
+    message character varying(1024) DEFAULT ''::character varying NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+
+
+--
+-- Name: instance_invites; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.instance_invites (
+    id uuid NOT NULL,
+    instance_id uuid NOT NULL,
+    token_hash bytea NOT NULL,
+    max_uses integer NOT NULL,
+    uses integer DEFAULT 0 NOT NULL,
+    created_by uuid NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    revoked_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: instance_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.instance_logs (
+    id uuid NOT NULL,
+    instance_id uuid NOT NULL,
     owner_id uuid NOT NULL,
-    ordered_by character varying(100) DEFAULT ''::character varying NOT NULL
+    object_key text NOT NULL,
+    size_bytes bigint NOT NULL,
+    first_line_at timestamp with time zone NOT NULL,
+    last_line_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    expires_at timestamp with time zone NOT NULL
 );
 
 
 --
--- Name: minecraft_versions; Type: TABLE; Schema: public; Owner: -
+-- Name: instance_revision_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.instance_revision_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: instance_players; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.instance_players (
+    instance_id uuid NOT NULL,
+    player_id uuid NOT NULL,
+    joined_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: instance_redirects; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.instance_redirects (
+    node_id uuid NOT NULL,
+    from_port integer NOT NULL,
+    instance_id uuid NOT NULL,
+    to_address inet NOT NULL,
+    to_port integer NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: instance_schedules; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.instance_schedules (
+    id uuid NOT NULL,
+    chunk_id uuid NOT NULL,
+    flavor_version_id uuid NOT NULL,
+    start_cron text NOT NULL,
+    stop_cron text NOT NULL,
+    timezone text NOT NULL,
+    instance_id uuid,
+    evaluated_at timestamp with time zone NOT NULL,
+    created_by uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: instance_usage; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.instance_usage (
+    instance_id uuid NOT NULL,
+    chunk_id uuid NOT NULL,
+    node_id uuid NOT NULL,
+    cpu_millis bigint NOT NULL,
+    memory_bytes bigint NOT NULL,
+    node_slots integer NOT NULL,
+    started_at timestamp with time zone NOT NULL,
+    stopped_at timestamp with time zone
+);
+
+
+--
+-- Name: instances; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.instances (
+    id uuid NOT NULL,
+    flavor_version_id uuid NOT NULL,
+    node_id uuid NOT NULL,
+    port integer,
+    state public.instance_state DEFAULT 'PENDING'::public.instance_state NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    owner_id uuid NOT NULL,
+    ordered_by character varying(100) DEFAULT ''::character varying NOT NULL,
+    revision bigint DEFAULT nextval('public.instance_revision_seq'::regclass) NOT NULL,
+    state_changed_at timestamp with time zone DEFAULT now() NOT NULL,
+    traffic_connections bigint,
+    traffic_rx_bytes bigint,
+    traffic_tx_bytes bigint,
+    world_seed character varying(64) DEFAULT ''::character varying NOT NULL,
+    game_mode character varying(16) DEFAULT ''::character varying NOT NULL,
+    custom_params json,
+    placement_group character varying(64) DEFAULT ''::character varying NOT NULL,
+    cross_instance_traffic boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: join_misses; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.join_misses (
+    chunk_id uuid NOT NULL,
+    flavor_name text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: maintenance_mode; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.maintenance_mode (
+    id boolean DEFAULT true NOT NULL,
+    enabled boolean DEFAULT false NOT NULL,
+    message character varying(256) DEFAULT ''::character varying NOT NULL,
+    updated_by uuid,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT maintenance_mode_singleton CHECK (id)
+);
+
+
+--
+-- Name: minecraft_version_java_images; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.minecraft_version_java_images (
+    minecraft_version character varying NOT NULL,
+    java_version integer NOT NULL,
+    image_url character varying(256) NOT NULL
+);
+
+
+--
+-- Name: minecraft_versions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.minecraft_versions (
+    version character varying NOT NULL,
+    created_at timestamp with time zone DEFAULT now(),
+    image_url character varying(256) NOT NULL
+);
+
+
+--
+-- Name: node_conditions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.node_conditions (
+    node_id uuid NOT NULL,
+    type public.node_condition_type NOT NULL,
+    message character varying(512) DEFAULT ''::character varying NOT NULL,
+    since timestamp with time zone NOT NULL
+);
+
+
+--
+-- Name: node_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.node_events (
+    id uuid NOT NULL,
+    node_id uuid NOT NULL,
+    type public.node_event_type NOT NULL,
+    condition_type public.node_condition_type NOT NULL,
+    message character varying(512) DEFAULT ''::character varying NOT NULL,
+    created_at timestamp with time zone NOT NULL
+)
+PARTITION BY RANGE (created_at);
+
+
+--
+-- Name: node_events_default; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.node_events_default (
+    id uuid NOT NULL,
+    node_id uuid NOT NULL,
+    type public.node_event_type NOT NULL,
+    condition_type public.node_condition_type NOT NULL,
+    message character varying(512) DEFAULT ''::character varying NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+
+
+--
+-- Name: nodes; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.nodes (
+    id uuid NOT NULL,
+    name text NOT NULL,
+    address inet NOT NULL,
+    checkpoint_api_endpoint text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    slots integer DEFAULT 1 NOT NULL,
+    capabilities text[] DEFAULT '{}'::text[] NOT NULL,
+    last_seen_at timestamp with time zone,
+    max_instances integer,
+    platformd_version character varying(64) DEFAULT ''::character varying NOT NULL,
+    desired_platformd_version character varying(64) DEFAULT ''::character varying NOT NULL,
+    platformd_update_status public.platformd_update_status,
+    platformd_update_message character varying(512) DEFAULT ''::character varying NOT NULL,
+    platformd_updated_at timestamp with time zone,
+    workload_cpu_millis bigint DEFAULT 0 NOT NULL,
+    workload_memory_bytes bigint DEFAULT 0 NOT NULL,
+    lost_at timestamp with time zone
+);
+
+
+--
+-- Name: notification_preferences; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.notification_preferences (
+    user_id uuid NOT NULL,
+    build_completed boolean DEFAULT false NOT NULL,
+    build_failed boolean DEFAULT false NOT NULL,
+    instance_crashed boolean DEFAULT false NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: revoked_tokens; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.revoked_tokens (
+    id text NOT NULL,
+    user_id uuid NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: river_client; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE UNLOGGED TABLE public.river_client (
+    id text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+    paused_at timestamp with time zone,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT name_length CHECK (((char_length(id) > 0) AND (char_length(id) < 128)))
+);
+
+
+--
+-- Name: river_client_queue; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE UNLOGGED TABLE public.river_client_queue (
+    river_client_id text NOT NULL,
+    name text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    max_workers bigint DEFAULT 0 NOT NULL,
+    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+    num_jobs_completed bigint DEFAULT 0 NOT NULL,
+    num_jobs_running bigint DEFAULT 0 NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT name_length CHECK (((char_length(name) > 0) AND (char_length(name) < 128))),
+    CONSTRAINT num_jobs_completed_zero_or_positive CHECK ((num_jobs_completed >= 0)),
+    CONSTRAINT num_jobs_running_zero_or_positive CHECK ((num_jobs_running >= 0))
+);
+
+
+--
+-- Name: river_job; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.river_job (
+    id bigint NOT NULL,
+    state public.river_job_state DEFAULT 'available'::public.river_job_state NOT NULL,
+    attempt smallint DEFAULT 0 NOT NULL,
+    max_attempts smallint NOT NULL,
+    attempted_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    finalized_at timestamp with time zone,
+    scheduled_at timestamp with time zone DEFAULT now() NOT NULL,
+    priority smallint DEFAULT 1 NOT NULL,
+    args jsonb NOT NULL,
+    attempted_by text[],
+    errors jsonb[],
+    kind text NOT NULL,
+    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+    queue text DEFAULT 'default'::text NOT NULL,
+    tags character varying(255)[] DEFAULT '{}'::character varying[] NOT NULL,
+    unique_key bytea,
+    unique_states bit(8),
+    CONSTRAINT finalized_or_finalized_at_null CHECK ((((finalized_at IS NULL) AND (state <> ALL (ARRAY['cancelled'::public.river_job_state, 'completed'::public.river_job_state, 'discarded'::public.river_job_state]))) OR ((finalized_at IS NOT NULL) AND (state = ANY (ARRAY['cancelled'::public.river_job_state, 'completed'::public.river_job_state, 'discarded'::public.river_job_state]))))),
+    CONSTRAINT kind_length CHECK (((char_length(kind) > 0) AND (char_length(kind) < 128))),
+    CONSTRAINT max_attempts_is_positive CHECK ((max_attempts > 0)),
+    CONSTRAINT priority_in_range CHECK (((priority >= 1) AND (priority <= 4))),
+    CONSTRAINT queue_length CHECK (((char_length(queue) > 0) AND (char_length(queue) < 128)))
+);
+
+
+--
+-- Name: river_job_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.river_job_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: river_job_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.river_job_id_seq OWNED BY public.river_job.id;
+
+
+--
+-- Name: river_leader; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE UNLOGGED TABLE public.river_leader (
+    elected_at timestamp with time zone NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    leader_id text NOT NULL,
+    name text DEFAULT 'default'::text NOT NULL,
+    CONSTRAINT leader_id_length CHECK (((char_length(leader_id) > 0) AND (char_length(leader_id) < 128))),
+    CONSTRAINT name_length CHECK ((name = 'default'::text))
+);
+
+
+--
+-- Name: river_queue; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.river_queue (
+    name text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+    paused_at timestamp with time zone,
+    updated_at timestamp with time zone NOT NULL
+);
+
+
+--
+-- Name: runtime_setting_changes; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.runtime_setting_changes (
+    id uuid NOT NULL,
+    setting character varying(128) NOT NULL,
+    old_value character varying(1024) NOT NULL,
+    new_value character varying(1024) NOT NULL,
+    actor_id uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: runtime_settings; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.runtime_settings (
+    name character varying(128) NOT NULL,
+    value character varying(1024) NOT NULL,
+    updated_by uuid NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: schema_migrations; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.schema_migrations (
+    version character varying NOT NULL
+);
+
+
+--
+-- Name: signing_keys; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.signing_keys (
+    id text NOT NULL,
+    private_key text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: users; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.users (
+    id uuid NOT NULL,
+    nickname character varying(16) NOT NULL,
+    email character varying NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+
+--
+-- Name: user_token_revocations; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.user_token_revocations (
+    user_id uuid NOT NULL,
+    revoked_before timestamp with time zone NOT NULL
+);
+
+
+--
+-- Name: impersonated_actions_default; Type: TABLE ATTACH; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.impersonated_actions ATTACH PARTITION public.impersonated_actions_default DEFAULT;
+
+
+--
+-- Name: instance_events_default; Type: TABLE ATTACH; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_events ATTACH PARTITION public.instance_events_default DEFAULT;
+
+
+--
+-- Name: node_events_default; Type: TABLE ATTACH; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.node_events ATTACH PARTITION public.node_events_default DEFAULT;
+
+
+--
+-- Name: river_job id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.river_job ALTER COLUMN id SET DEFAULT nextval('public.river_job_id_seq'::regclass);
+
+
+--
+-- Name: announcements announcements_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.announcements
+    ADD CONSTRAINT announcements_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: assets assets_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.assets
+    ADD CONSTRAINT assets_pkey PRIMARY KEY (hash);
+
+
+--
+-- Name: autoscaled_instances autoscaled_instances_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.autoscaled_instances
+    ADD CONSTRAINT autoscaled_instances_pkey PRIMARY KEY (instance_id);
+
+
+--
+-- Name: autoscaling_policies autoscaling_policies_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.autoscaling_policies
+    ADD CONSTRAINT autoscaling_policies_pkey PRIMARY KEY (flavor_id);
+
+
+--
+-- Name: blobs blobs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.blobs
+    ADD CONSTRAINT blobs_pkey PRIMARY KEY (hash);
+
+
+--
+-- Name: canaries canaries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canaries
+    ADD CONSTRAINT canaries_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: canary_instances canary_instances_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canary_instances
+    ADD CONSTRAINT canary_instances_pkey PRIMARY KEY (instance_id);
+
+
+--
+-- Name: chunk_archive chunk_archive_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunk_archive
+    ADD CONSTRAINT chunk_archive_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chunk_cross_instance_traffic chunk_cross_instance_traffic_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunk_cross_instance_traffic
+    ADD CONSTRAINT chunk_cross_instance_traffic_pkey PRIMARY KEY (chunk_id);
+
+
+--
+-- Name: chunk_imports chunk_imports_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunk_imports
+    ADD CONSTRAINT chunk_imports_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chunk_media chunk_media_chunk_id_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunk_media
+    ADD CONSTRAINT chunk_media_chunk_id_hash_key UNIQUE (chunk_id, hash);
+
+
+--
+-- Name: chunk_media chunk_media_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunk_media
+    ADD CONSTRAINT chunk_media_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chunk_readmes chunk_readmes_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunk_readmes
+    ADD CONSTRAINT chunk_readmes_pkey PRIMARY KEY (chunk_id);
+
+
+--
+-- Name: chunk_retention_policies chunk_retention_policies_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunk_retention_policies
+    ADD CONSTRAINT chunk_retention_policies_pkey PRIMARY KEY (chunk_id);
+
+
+--
+-- Name: chunk_slugs chunk_slugs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunk_slugs
+    ADD CONSTRAINT chunk_slugs_pkey PRIMARY KEY (slug);
+
+
+--
+-- Name: chunk_templates chunk_templates_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunk_templates
+    ADD CONSTRAINT chunk_templates_name_key UNIQUE (name);
+
+
+--
+-- Name: chunk_templates chunk_templates_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunk_templates
+    ADD CONSTRAINT chunk_templates_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: chunks chunks_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.chunks
+    ADD CONSTRAINT chunks_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flavor_archive flavor_archive_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.flavor_archive
+    ADD CONSTRAINT flavor_archive_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flavor_channels flavor_channels_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.flavor_channels
+    ADD CONSTRAINT flavor_channels_pkey PRIMARY KEY (flavor_id, name);
+
+
+--
+-- Name: flavor_version_archive flavor_version_archive_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.flavor_version_archive
+    ADD CONSTRAINT flavor_version_archive_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flavor_version_assets flavor_version_assets_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.flavor_version_assets
+    ADD CONSTRAINT flavor_version_assets_pkey PRIMARY KEY (flavor_version_id, asset_hash);
+
+
+--
+-- Name: flavor_version_provenance flavor_version_provenance_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.flavor_version_provenance
+    ADD CONSTRAINT flavor_version_provenance_pkey PRIMARY KEY (flavor_version_id);
+
+
+--
+-- Name: flavor_versions flavor_versions_flavor_id_version_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.flavor_versions
+    ADD CONSTRAINT flavor_versions_flavor_id_version_key UNIQUE (flavor_id, version);
+
+
+--
+-- Name: flavor_versions flavor_versions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.flavor_versions
+    ADD CONSTRAINT flavor_versions_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: flavors flavors_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.flavors
+    ADD CONSTRAINT flavors_id_name_key UNIQUE (id, name);
+
+
+--
+-- Name: flavors flavors_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.flavors
+    ADD CONSTRAINT flavors_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: impersonations impersonations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.impersonations
+    ADD CONSTRAINT impersonations_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: instance_events instance_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_events
+    ADD CONSTRAINT instance_events_pkey PRIMARY KEY (id, created_at);
+
+
+--
+-- Name: instance_events_default instance_events_default_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_events_default
+    ADD CONSTRAINT instance_events_default_pkey PRIMARY KEY (id, created_at);
+
+
+--
+-- Name: instance_invites instance_invites_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_invites
+    ADD CONSTRAINT instance_invites_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: instance_invites instance_invites_token_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_invites
+    ADD CONSTRAINT instance_invites_token_hash_key UNIQUE (token_hash);
+
+
+--
+-- Name: instance_logs instance_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_logs
+    ADD CONSTRAINT instance_logs_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: instance_players instance_players_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_players
+    ADD CONSTRAINT instance_players_pkey PRIMARY KEY (instance_id, player_id);
+
+
+--
+-- Name: instance_redirects instance_redirects_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_redirects
+    ADD CONSTRAINT instance_redirects_pkey PRIMARY KEY (node_id, from_port);
+
+
+--
+-- Name: instance_schedules instance_schedules_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_schedules
+    ADD CONSTRAINT instance_schedules_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: instance_usage instance_usage_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_usage
+    ADD CONSTRAINT instance_usage_pkey PRIMARY KEY (instance_id);
+
+
+--
+-- Name: instances instances_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instances
+    ADD CONSTRAINT instances_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: maintenance_mode maintenance_mode_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.maintenance_mode
+    ADD CONSTRAINT maintenance_mode_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: minecraft_version_java_images minecraft_version_java_images_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.minecraft_version_java_images
+    ADD CONSTRAINT minecraft_version_java_images_pkey PRIMARY KEY (minecraft_version, java_version);
+
+
+--
+-- Name: minecraft_versions minecraft_versions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.minecraft_versions
+    ADD CONSTRAINT minecraft_versions_pkey PRIMARY KEY (version);
+
+
+--
+-- Name: node_conditions node_conditions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.node_conditions
+    ADD CONSTRAINT node_conditions_pkey PRIMARY KEY (node_id, type);
+
+
+--
+-- Name: node_events node_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.node_events
+    ADD CONSTRAINT node_events_pkey PRIMARY KEY (id, created_at);
+
+
+--
+-- Name: node_events_default node_events_default_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.node_events_default
+    ADD CONSTRAINT node_events_default_pkey PRIMARY KEY (id, created_at);
+
+
+--
+-- Name: nodes nodes_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.nodes
+    ADD CONSTRAINT nodes_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: notification_preferences notification_preferences_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notification_preferences
+    ADD CONSTRAINT notification_preferences_pkey PRIMARY KEY (user_id);
+
+
+--
+-- Name: revoked_tokens revoked_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.revoked_tokens
+    ADD CONSTRAINT revoked_tokens_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: river_client river_client_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.river_client
+    ADD CONSTRAINT river_client_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: river_client_queue river_client_queue_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.river_client_queue
+    ADD CONSTRAINT river_client_queue_pkey PRIMARY KEY (river_client_id, name);
+
+
+--
+-- Name: river_job river_job_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.river_job
+    ADD CONSTRAINT river_job_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: river_leader river_leader_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.river_leader
+    ADD CONSTRAINT river_leader_pkey PRIMARY KEY (name);
+
+
+--
+-- Name: river_queue river_queue_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.river_queue
+    ADD CONSTRAINT river_queue_pkey PRIMARY KEY (name);
+
+
+--
+-- Name: runtime_setting_changes runtime_setting_changes_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.runtime_setting_changes
+    ADD CONSTRAINT runtime_setting_changes_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: runtime_settings runtime_settings_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.runtime_settings
+    ADD CONSTRAINT runtime_settings_pkey PRIMARY KEY (name);
+
+
+--
+-- Name: schema_migrations schema_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.schema_migrations
+    ADD CONSTRAINT schema_migrations_pkey PRIMARY KEY (version);
+
+
+--
+-- Name: signing_keys signing_keys_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.signing_keys
+    ADD CONSTRAINT signing_keys_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: users users_email_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.users
+    ADD CONSTRAINT users_email_key UNIQUE (email);
+
+
+--
+-- Name: users users_nickname_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.users
+    ADD CONSTRAINT users_nickname_key UNIQUE (nickname);
+
+
+--
+-- Name: users users_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.users
+    ADD CONSTRAINT users_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: user_token_revocations user_token_revocations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.user_token_revocations
+    ADD CONSTRAINT user_token_revocations_pkey PRIMARY KEY (user_id);
+
+
+--
+-- Name: archived_chunk_owner_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX archived_chunk_owner_idx ON public.chunk_archive USING btree (owner_id);
+
+
+--
+-- Name: archived_flavor_chunk_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX archived_flavor_chunk_id_idx ON public.flavor_archive USING btree (chunk_id);
+
+
+--
+-- Name: archived_flavor_version_flavor_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX archived_flavor_version_flavor_id_idx ON public.flavor_version_archive USING btree (flavor_id);
+
+
+--
+-- Name: canaries_canary_version_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX canaries_canary_version_id_idx ON public.canaries USING btree (canary_version_id);
+
+
+--
+-- Name: canaries_flavor_id_active_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX canaries_flavor_id_active_idx ON public.canaries USING btree (flavor_id) WHERE (state = 'ACTIVE'::public.canary_state);
+
+
+--
+-- Name: canaries_stable_version_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX canaries_stable_version_id_idx ON public.canaries USING btree (stable_version_id);
+
+
+--
+-- Name: canary_instances_canary_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX canary_instances_canary_id_idx ON public.canary_instances USING btree (canary_id);
+
+
+--
+-- Name: chunk_imports_chunk_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX chunk_imports_chunk_id_idx ON public.chunk_imports USING btree (chunk_id);
+
+
+--
+-- Name: chunk_imports_flavor_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX chunk_imports_flavor_id_idx ON public.chunk_imports USING btree (flavor_id);
+
+
+--
+-- Name: chunk_imports_flavor_version_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX chunk_imports_flavor_version_id_idx ON public.chunk_imports USING btree (flavor_version_id);
+
+
+--
+-- Name: chunk_slugs_chunk_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX chunk_slugs_chunk_id_idx ON public.chunk_slugs USING btree (chunk_id);
+
+
+--
+-- Name: flavor_channels_flavor_version_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX flavor_channels_flavor_version_id_idx ON public.flavor_channels USING btree (flavor_version_id);
+
+
+--
+-- Name: flavor_version_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX flavor_version_idx ON public.flavor_versions USING btree (version);
+
+
+--
+-- Name: impersonated_actions_default_impersonation_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX impersonated_actions_default_impersonation_id_idx ON public.impersonated_actions_default USING btree (impersonation_id);
+
+
+--
+-- Name: impersonated_actions_impersonation_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX impersonated_actions_impersonation_id_idx ON ONLY public.impersonated_actions USING btree (impersonation_id);
+
+
+--
+-- Name: impersonations_user_id_created_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX impersonations_user_id_created_at_idx ON public.impersonations USING btree (user_id, created_at);
+
+
+--
+-- Name: instance_events_default_instance_id_created_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instance_events_default_instance_id_created_at_idx ON public.instance_events_default USING btree (instance_id, created_at);
+
+
+--
+-- Name: instance_events_instance_id_created_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instance_events_instance_id_created_at_idx ON ONLY public.instance_events USING btree (instance_id, created_at);
+
+
+--
+-- Name: instance_invites_instance_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instance_invites_instance_id_idx ON public.instance_invites USING btree (instance_id);
+
+
+--
+-- Name: instance_logs_expires_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instance_logs_expires_at_idx ON public.instance_logs USING btree (expires_at);
+
+
+--
+-- Name: instance_logs_instance_id_first_line_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instance_logs_instance_id_first_line_at_idx ON public.instance_logs USING btree (instance_id, first_line_at);
+
+
+--
+-- Name: instance_schedules_chunk_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instance_schedules_chunk_id_idx ON public.instance_schedules USING btree (chunk_id);
+
+
+--
+-- Name: instance_usage_chunk_id_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instance_usage_chunk_id_idx ON public.instance_usage USING btree (chunk_id);
+
+
+--
+-- Name: instance_usage_started_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instance_usage_started_at_idx ON public.instance_usage USING btree (started_at);
+
+
+--
+-- Name: instances_node_id_revision_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instances_node_id_revision_idx ON public.instances USING btree (node_id, revision);
+
+
+--
+-- Name: instances_owner_id_revision_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instances_owner_id_revision_idx ON public.instances USING btree (owner_id, revision);
+
+
+--
+-- Name: instances_placement_group_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instances_placement_group_idx ON public.instances USING btree (placement_group) WHERE ((placement_group)::text <> ''::text);
+
+
+--
+-- Name: instances_state_state_changed_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX instances_state_state_changed_at_idx ON public.instances USING btree (state, state_changed_at);
+
+
+--
+-- Name: join_misses_chunk_id_created_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX join_misses_chunk_id_created_at_idx ON public.join_misses USING btree (chunk_id, created_at);
+
+
+--
+-- Name: node_events_created_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX node_events_created_at_idx ON ONLY public.node_events USING btree (created_at);
+
+
+--
+-- Name: node_events_default_created_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX node_events_default_created_at_idx ON public.node_events_default USING btree (created_at);
+
+
+--
+-- Name: revoked_tokens_expires_at_idx; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX revoked_tokens_expires_at_idx ON public.revoked_tokens USING btree (expires_at);
+
+
+--
+-- Name: river_job_args_index; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX river_job_args_index ON public.river_job USING gin (args);
+
+
+--
+-- Name: river_job_kind; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX river_job_kind ON public.river_job USING btree (kind);
+
+
+--
+-- Name: river_job_metadata_index; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE TABLE public.minecraft_versions (
-    version character varying NOT NULL,
-    created_at timestamp with time zone DEFAULT now(),
-    image_url character varying(256) NOT NULL
-);
+CREATE INDEX river_job_metadata_index ON public.river_job USING gin (metadata);
 
 
 --
--- Name: nodes; Type: TABLE; Schema: public; Owner: -
+-- Name: river_job_prioritized_fetching_index; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE TABLE public.nodes (
-    id uuid NOT NULL,
-    name text NOT NULL,
-    address inet NOT NULL,
-    checkpoint_api_endpoint text NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL,
-    slots integer DEFAULT 1 NOT NULL
-);
+CREATE INDEX river_job_prioritized_fetching_index ON public.river_job USING btree (state, queue, priority, scheduled_at, id);
 
 
 --
--- Name: river_client; Type: TABLE; Schema: public; Owner: -
+-- Name: river_job_state_and_finalized_at_index; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE UNLOGGED TABLE public.river_client (
-    id text NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL,
-    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
-    paused_at timestamp with time zone,
-    updated_at timestamp with time zone NOT NULL,
-    CONSTRAINT name_length CHECK (((char_length(id) > 0) AND (char_length(id) < 128)))
-);
+CREATE INDEX river_job_state_and_finalized_at_index ON public.river_job USING btree (state, finalized_at) WHERE (finalized_at IS NOT NULL);
 
 
 --
--- Name: river_client_queue; Type: TABLE; Schema: public; Owner: -
+-- Name: river_job_unique_idx; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE UNLOGGED TABLE public.river_client_queue (
-    river_client_id text NOT NULL,
-    name text NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL,
-    max_workers bigint DEFAULT 0 NOT NULL,
-    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
-    num_jobs_completed bigint DEFAULT 0 NOT NULL,
-    num_jobs_running bigint DEFAULT 0 NOT NULL,
-    updated_at timestamp with time zone NOT NULL,
-    CONSTRAINT name_length CHECK (((char_length(name) > 0) AND (char_length(name) < 128))),
-    CONSTRAINT num_jobs_completed_zero_or_positive CHECK ((num_jobs_completed >= 0)),
-    CONSTRAINT num_jobs_running_zero_or_positive CHECK ((num_jobs_running >= 0))
-);
+CREATE UNIQUE INDEX river_job_unique_idx ON public.river_job USING btree (unique_key) WHERE ((unique_key IS NOT NULL) AND (unique_states IS NOT NULL) AND public.river_job_state_in_bitmask(unique_states, state));
 
 
 --
--- Name: river_job; Type: TABLE; Schema: public; Owner: -
+-- Name: runtime_setting_changes_created_at_idx; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE TABLE public.river_job (
-    id bigint NOT NULL,
-    state public.river_job_state DEFAULT 'available'::public.river_job_state NOT NULL,
-    attempt smallint DEFAULT 0 NOT NULL,
-    max_attempts smallint NOT NULL,
-    attempted_at timestamp with time zone,
-    created_at timestamp with time zone DEFAULT now() NOT NULL,
-    finalized_at timestamp with time zone,
-    scheduled_at timestamp with time zone DEFAULT now() NOT NULL,
-    priority smallint DEFAULT 1 NOT NULL,
-    args jsonb NOT NULL,
-    attempted_by text[],
-    errors jsonb[],
-    kind text NOT NULL,
-    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
-    queue text DEFAULT 'default'::text NOT NULL,
-    tags character varying(255)[] DEFAULT '{}'::character varying[] NOT NULL,
-    unique_key bytea,
-    unique_states bit(8),
-    CONSTRAINT finalized_or_finalized_at_null CHECK ((((finalized_at IS NULL) AND (state <> ALL (ARRAY['cancelled'::public.river_job_state, 'completed'::public.river_job_state, 'discarded'::public.river_job_state]))) OR ((finalized_at IS NOT NULL) AND (state = ANY (ARRAY['cancelled'::public.river_job_state, 'completed'::public.river_job_state, 'discarded'::public.river_job_state]))))),
-    CONSTRAINT kind_length CHECK (((char_length(kind) > 0) AND (char_length(kind) < 128))),
-    CONSTRAINT max_attempts_is_positive CHECK ((max_attempts > 0)),
-    CONSTRAINT priority_in_range CHECK (((priority >= 1) AND (priority <= 4))),
-    CONSTRAINT queue_length CHECK (((char_length(queue) > 0) AND (char_length(queue) < 128)))
-);
+CREATE INDEX runtime_setting_changes_created_at_idx ON public.runtime_setting_changes USING btree (created_at);
 
 
 --
--- Name: river_job_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+-- Name: impersonated_actions_default_impersonation_id_idx; Type: INDEX ATTACH; Schema: public; Owner: -
 --
 
-CREATE SEQUENCE public.river_job_id_seq
-    START WITH 1
-    INCREMENT BY 1
-    NO MINVALUE
-    NO MAXVALUE
-    CACHE 1;
+ALTER INDEX public.impersonated_actions_impersonation_id_idx ATTACH PARTITION public.impersonated_actions_default_impersonation_id_idx;
 
 
 --
--- Name: river_job_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+-- Name: instance_events_default_instance_id_created_at_idx; Type: INDEX ATTACH; Schema: public; Owner: -
 --
 
-ALTER SEQUENCE public.river_job_id_seq OWNED BY public.river_job.id;
+ALTER INDEX public.instance_events_instance_id_created_at_idx ATTACH PARTITION public.instance_events_default_instance_id_created_at_idx;
 
 
 --
--- Name: river_leader; Type: TABLE; Schema: public; Owner: -
+-- Name: instance_events_default_pkey; Type: INDEX ATTACH; Schema: public; Owner: -
 --
 
-CREATE UNLOGGED TABLE public.river_leader (
-    elected_at timestamp with time zone NOT NULL,
-    expires_at timestamp with time zone NOT NULL,
-    leader_id text NOT NULL,
-    name text DEFAULT 'default'::text NOT NULL,
-    CONSTRAINT leader_id_length CHECK (((char_length(leader_id) > 0) AND (char_length(leader_id) < 128))),
-    CONSTRAINT name_length CHECK ((name = 'default'::text))
-);
+ALTER INDEX public.instance_events_pkey ATTACH PARTITION public.instance_events_default_pkey;
 
 
 --
--- Name: river_queue; Type: TABLE; Schema: public; Owner: -
+-- Name: node_events_default_created_at_idx; Type: INDEX ATTACH; Schema: public; Owner: -
 --
 
-CREATE TABLE public.river_queue (
-    name text NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL,
-    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
-    paused_at timestamp with time zone,
-    updated_at timestamp with time zone NOT NULL
-);
+ALTER INDEX public.node_events_created_at_idx ATTACH PARTITION public.node_events_default_created_at_idx;
 
 
 --
--- Name: schema_migrations; Type: TABLE; Schema: public; Owner: -
+-- Name: node_events_default_pkey; Type: INDEX ATTACH; Schema: public; Owner: -
 --
 
-CREATE TABLE public.schema_migrations (
-    version character varying NOT NULL
-);
+ALTER INDEX public.node_events_pkey ATTACH PARTITION public.node_events_default_pkey;
 
 
 --
--- Name: users; Type: TABLE; Schema: public; Owner: -
+-- Name: autoscaled_instances autoscaled_instances_flavor_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE TABLE public.users (
-    id uuid NOT NULL,
-    nickname character varying(16) NOT NULL,
-    email character varying NOT NULL,
-    created_at timestamp with time zone NOT NULL,
-    updated_at timestamp with time zone NOT NULL
-);
+ALTER TABLE ONLY public.autoscaled_instances
+    ADD CONSTRAINT autoscaled_instances_flavor_id_fkey FOREIGN KEY (flavor_id) REFERENCES public.flavors(id) ON DELETE CASCADE;
 
 
 --
--- Name: river_job id; Type: DEFAULT; Schema: public; Owner: -
+-- Name: autoscaled_instances autoscaled_instances_instance_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.river_job ALTER COLUMN id SET DEFAULT nextval('public.river_job_id_seq'::regclass);
+ALTER TABLE ONLY public.autoscaled_instances
+    ADD CONSTRAINT autoscaled_instances_instance_id_fkey FOREIGN KEY (instance_id) REFERENCES public.instances(id) ON DELETE CASCADE;
 
 
 --
--- Name: blobs blobs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: autoscaling_policies autoscaling_policies_flavor_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.blobs
-    ADD CONSTRAINT blobs_pkey PRIMARY KEY (hash);
+ALTER TABLE ONLY public.autoscaling_policies
+    ADD CONSTRAINT autoscaling_policies_flavor_id_fkey FOREIGN KEY (flavor_id) REFERENCES public.flavors(id) ON DELETE CASCADE;
 
 
 --
--- Name: chunk_archive chunk_archive_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: canaries canaries_canary_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.chunk_archive
-    ADD CONSTRAINT chunk_archive_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.canaries
+    ADD CONSTRAINT canaries_canary_version_id_fkey FOREIGN KEY (canary_version_id) REFERENCES public.flavor_versions(id) ON DELETE CASCADE;
 
 
 --
--- Name: chunks chunks_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: canaries canaries_flavor_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.chunks
-    ADD CONSTRAINT chunks_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.canaries
+    ADD CONSTRAINT canaries_flavor_id_fkey FOREIGN KEY (flavor_id) REFERENCES public.flavors(id) ON DELETE CASCADE;
 
 
 --
--- Name: flavor_archive flavor_archive_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: canaries canaries_stable_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.flavor_archive
-    ADD CONSTRAINT flavor_archive_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.canaries
+    ADD CONSTRAINT canaries_stable_version_id_fkey FOREIGN KEY (stable_version_id) REFERENCES public.flavor_versions(id) ON DELETE CASCADE;
 
 
 --
--- Name: flavor_version_archive flavor_version_archive_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: canary_instances canary_instances_canary_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.flavor_version_archive
-    ADD CONSTRAINT flavor_version_archive_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.canary_instances
+    ADD CONSTRAINT canary_instances_canary_id_fkey FOREIGN KEY (canary_id) REFERENCES public.canaries(id) ON DELETE CASCADE;
 
 
 --
--- Name: flavor_versions flavor_versions_flavor_id_version_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: chunk_cross_instance_traffic chunk_cross_instance_traffic_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.flavor_versions
-    ADD CONSTRAINT flavor_versions_flavor_id_version_key UNIQUE (flavor_id, version);
+ALTER TABLE ONLY public.chunk_cross_instance_traffic
+    ADD CONSTRAINT chunk_cross_instance_traffic_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flavor_versions flavor_versions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: chunk_imports chunk_imports_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.flavor_versions
-    ADD CONSTRAINT flavor_versions_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.chunk_imports
+    ADD CONSTRAINT chunk_imports_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flavors flavors_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: chunk_imports chunk_imports_flavor_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.flavors
-    ADD CONSTRAINT flavors_id_name_key UNIQUE (id, name);
+ALTER TABLE ONLY public.chunk_imports
+    ADD CONSTRAINT chunk_imports_flavor_id_fkey FOREIGN KEY (flavor_id) REFERENCES public.flavors(id) ON DELETE CASCADE;
 
 
 --
--- Name: flavors flavors_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: chunk_imports chunk_imports_flavor_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.flavors
-    ADD CONSTRAINT flavors_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.chunk_imports
+    ADD CONSTRAINT chunk_imports_flavor_version_id_fkey FOREIGN KEY (flavor_version_id) REFERENCES public.flavor_versions(id) ON DELETE SET NULL;
 
 
 --
--- Name: instances instances_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: chunk_media chunk_media_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.instances
-    ADD CONSTRAINT instances_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.chunk_media
+    ADD CONSTRAINT chunk_media_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id) ON DELETE CASCADE;
 
 
 --
--- Name: minecraft_versions minecraft_versions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: chunk_readmes chunk_readmes_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.minecraft_versions
-    ADD CONSTRAINT minecraft_versions_pkey PRIMARY KEY (version);
+ALTER TABLE ONLY public.chunk_readmes
+    ADD CONSTRAINT chunk_readmes_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id) ON DELETE CASCADE;
 
 
 --
--- Name: nodes nodes_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: chunk_retention_policies chunk_retention_policies_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.nodes
-    ADD CONSTRAINT nodes_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.chunk_retention_policies
+    ADD CONSTRAINT chunk_retention_policies_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id) ON DELETE CASCADE;
 
 
 --
--- Name: river_client river_client_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: chunk_slugs chunk_slugs_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.river_client
-    ADD CONSTRAINT river_client_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.chunk_slugs
+    ADD CONSTRAINT chunk_slugs_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id) ON DELETE CASCADE;
 
 
 --
--- Name: river_client_queue river_client_queue_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: chunk_templates chunk_templates_flavor_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.river_client_queue
-    ADD CONSTRAINT river_client_queue_pkey PRIMARY KEY (river_client_id, name);
+ALTER TABLE ONLY public.chunk_templates
+    ADD CONSTRAINT chunk_templates_flavor_version_id_fkey FOREIGN KEY (flavor_version_id) REFERENCES public.flavor_versions(id) ON DELETE CASCADE;
 
 
 --
--- Name: river_job river_job_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: chunks chunks_owner_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.river_job
-    ADD CONSTRAINT river_job_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.chunks
+    ADD CONSTRAINT chunks_owner_fkey FOREIGN KEY (owner_id) REFERENCES public.users(id);
 
 
 --
--- Name: river_leader river_leader_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: flavor_channels flavor_channels_flavor_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.river_leader
-    ADD CONSTRAINT river_leader_pkey PRIMARY KEY (name);
+ALTER TABLE ONLY public.flavor_channels
+    ADD CONSTRAINT flavor_channels_flavor_id_fkey FOREIGN KEY (flavor_id) REFERENCES public.flavors(id) ON DELETE CASCADE;
 
 
 --
--- Name: river_queue river_queue_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: flavor_channels flavor_channels_flavor_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.river_queue
-    ADD CONSTRAINT river_queue_pkey PRIMARY KEY (name);
+ALTER TABLE ONLY public.flavor_channels
+    ADD CONSTRAINT flavor_channels_flavor_version_id_fkey FOREIGN KEY (flavor_version_id) REFERENCES public.flavor_versions(id) ON DELETE CASCADE;
 
 
 --
--- Name: schema_migrations schema_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: flavor_version_assets flavor_version_assets_asset_hash_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.schema_migrations
-    ADD CONSTRAINT schema_migrations_pkey PRIMARY KEY (version);
+ALTER TABLE ONLY public.flavor_version_assets
+    ADD CONSTRAINT flavor_version_assets_asset_hash_fkey FOREIGN KEY (asset_hash) REFERENCES public.assets(hash);
 
 
 --
--- Name: users users_email_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: flavor_version_assets flavor_version_assets_flavor_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.users
-    ADD CONSTRAINT users_email_key UNIQUE (email);
+ALTER TABLE ONLY public.flavor_version_assets
+    ADD CONSTRAINT flavor_version_assets_flavor_version_id_fkey FOREIGN KEY (flavor_version_id) REFERENCES public.flavor_versions(id) ON DELETE CASCADE;
 
 
 --
--- Name: users users_nickname_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: flavor_version_files flavor_version_files_flavor_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.users
-    ADD CONSTRAINT users_nickname_key UNIQUE (nickname);
+ALTER TABLE ONLY public.flavor_version_files
+    ADD CONSTRAINT flavor_version_files_flavor_version_id_fkey FOREIGN KEY (flavor_version_id) REFERENCES public.flavor_versions(id) ON DELETE CASCADE;
 
 
 --
--- Name: users users_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: flavor_version_provenance flavor_version_provenance_flavor_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.users
-    ADD CONSTRAINT users_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.flavor_version_provenance
+    ADD CONSTRAINT flavor_version_provenance_flavor_version_id_fkey FOREIGN KEY (flavor_version_id) REFERENCES public.flavor_versions(id) ON DELETE CASCADE;
 
 
 --
--- Name: archived_chunk_owner_idx; Type: INDEX; Schema: public; Owner: -
+-- Name: flavor_versions flavor_versions_checkpoint_node_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX archived_chunk_owner_idx ON public.chunk_archive USING btree (owner_id);
+ALTER TABLE ONLY public.flavor_versions
+    ADD CONSTRAINT flavor_versions_checkpoint_node_id_fkey FOREIGN KEY (checkpoint_node_id) REFERENCES public.nodes(id) ON DELETE SET NULL;
 
 
 --
--- Name: archived_flavor_chunk_id_idx; Type: INDEX; Schema: public; Owner: -
+-- Name: flavor_versions flavor_versions_flavor_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX archived_flavor_chunk_id_idx ON public.flavor_archive USING btree (chunk_id);
+ALTER TABLE ONLY public.flavor_versions
+    ADD CONSTRAINT flavor_versions_flavor_id_fkey FOREIGN KEY (flavor_id) REFERENCES public.flavors(id) ON DELETE CASCADE;
 
 
 --
--- Name: archived_flavor_version_flavor_id_idx; Type: INDEX; Schema: public; Owner: -
+-- Name: flavor_versions flavor_versions_minecraft_version_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX archived_flavor_version_flavor_id_idx ON public.flavor_version_archive USING btree (flavor_id);
+ALTER TABLE ONLY public.flavor_versions
+    ADD CONSTRAINT flavor_versions_minecraft_version_fkey FOREIGN KEY (minecraft_version) REFERENCES public.minecraft_versions(version);
 
 
 --
--- Name: flavor_version_idx; Type: INDEX; Schema: public; Owner: -
+-- Name: minecraft_version_java_images minecraft_version_java_images_minecraft_version_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX flavor_version_idx ON public.flavor_versions USING btree (version);
+ALTER TABLE ONLY public.minecraft_version_java_images
+    ADD CONSTRAINT minecraft_version_java_images_minecraft_version_fkey FOREIGN KEY (minecraft_version) REFERENCES public.minecraft_versions(version) ON DELETE CASCADE;
 
 
 --
--- Name: river_job_args_index; Type: INDEX; Schema: public; Owner: -
+-- Name: flavors flavors_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX river_job_args_index ON public.river_job USING gin (args);
+ALTER TABLE ONLY public.flavors
+    ADD CONSTRAINT flavors_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id);
 
 
 --
--- Name: river_job_kind; Type: INDEX; Schema: public; Owner: -
+-- Name: impersonated_actions impersonated_actions_impersonation_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX river_job_kind ON public.river_job USING btree (kind);
+ALTER TABLE public.impersonated_actions
+    ADD CONSTRAINT impersonated_actions_impersonation_id_fkey FOREIGN KEY (impersonation_id) REFERENCES public.impersonations(id) ON DELETE CASCADE;
 
 
 --
--- Name: river_job_metadata_index; Type: INDEX; Schema: public; Owner: -
+-- Name: impersonations impersonations_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX river_job_metadata_index ON public.river_job USING gin (metadata);
+ALTER TABLE ONLY public.impersonations
+    ADD CONSTRAINT impersonations_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id);
 
 
 --
--- Name: river_job_prioritized_fetching_index; Type: INDEX; Schema: public; Owner: -
+-- Name: instance_invites instance_invites_instance_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX river_job_prioritized_fetching_index ON public.river_job USING btree (state, queue, priority, scheduled_at, id);
+ALTER TABLE ONLY public.instance_invites
+    ADD CONSTRAINT instance_invites_instance_id_fkey FOREIGN KEY (instance_id) REFERENCES public.instances(id) ON DELETE CASCADE;
 
 
 --
--- Name: river_job_state_and_finalized_at_index; Type: INDEX; Schema: public; Owner: -
+-- Name: instance_logs instance_logs_owner_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX river_job_state_and_finalized_at_index ON public.river_job USING btree (state, finalized_at) WHERE (finalized_at IS NOT NULL);
+ALTER TABLE ONLY public.instance_logs
+    ADD CONSTRAINT instance_logs_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES public.users(id);
 
 
 --
--- Name: river_job_unique_idx; Type: INDEX; Schema: public; Owner: -
+-- Name: instance_players instance_players_instance_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE UNIQUE INDEX river_job_unique_idx ON public.river_job USING btree (unique_key) WHERE ((unique_key IS NOT NULL) AND (unique_states IS NOT NULL) AND public.river_job_state_in_bitmask(unique_states, state));
+ALTER TABLE ONLY public.instance_players
+    ADD CONSTRAINT instance_players_instance_id_fkey FOREIGN KEY (instance_id) REFERENCES public.instances(id) ON DELETE CASCADE;
 
 
 --
--- Name: chunks chunks_owner_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: instance_redirects instance_redirects_instance_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.chunks
-    ADD CONSTRAINT chunks_owner_fkey FOREIGN KEY (owner_id) REFERENCES public.users(id);
+ALTER TABLE ONLY public.instance_redirects
+    ADD CONSTRAINT instance_redirects_instance_id_fkey FOREIGN KEY (instance_id) REFERENCES public.instances(id) ON DELETE CASCADE;
 
 
 --
--- Name: flavor_version_files flavor_version_files_flavor_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: instance_redirects instance_redirects_node_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.flavor_version_files
-    ADD CONSTRAINT flavor_version_files_flavor_version_id_fkey FOREIGN KEY (flavor_version_id) REFERENCES public.flavor_versions(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.instance_redirects
+    ADD CONSTRAINT instance_redirects_node_id_fkey FOREIGN KEY (node_id) REFERENCES public.nodes(id) ON DELETE CASCADE;
 
 
 --
--- Name: flavor_versions flavor_versions_flavor_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: instance_schedules instance_schedules_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.flavor_versions
-    ADD CONSTRAINT flavor_versions_flavor_id_fkey FOREIGN KEY (flavor_id) REFERENCES public.flavors(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.instance_schedules
+    ADD CONSTRAINT instance_schedules_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id) ON DELETE CASCADE;
 
 
 --
--- Name: flavor_versions flavor_versions_minecraft_version_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: instance_schedules instance_schedules_flavor_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.flavor_versions
-    ADD CONSTRAINT flavor_versions_minecraft_version_fkey FOREIGN KEY (minecraft_version) REFERENCES public.minecraft_versions(version);
+ALTER TABLE ONLY public.instance_schedules
+    ADD CONSTRAINT instance_schedules_flavor_version_id_fkey FOREIGN KEY (flavor_version_id) REFERENCES public.flavor_versions(id) ON DELETE CASCADE;
 
 
 --
--- Name: flavors flavors_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: instance_schedules instance_schedules_instance_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.flavors
-    ADD CONSTRAINT flavors_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id);
+ALTER TABLE ONLY public.instance_schedules
+    ADD CONSTRAINT instance_schedules_instance_id_fkey FOREIGN KEY (instance_id) REFERENCES public.instances(id) ON DELETE SET NULL;
+
+
+--
+-- Name: instance_usage instance_usage_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.instance_usage
+    ADD CONSTRAINT instance_usage_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id) ON DELETE CASCADE;
 
 
 --
@@ -679,6 +2255,38 @@ ALTER TABLE ONLY public.instances
     ADD CONSTRAINT instances_owner_fkey FOREIGN KEY (owner_id) REFERENCES public.users(id);
 
 
+--
+-- Name: join_misses join_misses_chunk_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.join_misses
+    ADD CONSTRAINT join_misses_chunk_id_fkey FOREIGN KEY (chunk_id) REFERENCES public.chunks(id) ON DELETE CASCADE;
+
+
+--
+-- Name: node_conditions node_conditions_node_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.node_conditions
+    ADD CONSTRAINT node_conditions_node_id_fkey FOREIGN KEY (node_id) REFERENCES public.nodes(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notification_preferences notification_preferences_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notification_preferences
+    ADD CONSTRAINT notification_preferences_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: revoked_tokens revoked_tokens_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.revoked_tokens
+    ADD CONSTRAINT revoked_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: river_client_queue river_client_queue_river_client_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -687,6 +2295,14 @@ ALTER TABLE ONLY public.river_client_queue
     ADD CONSTRAINT river_client_queue_river_client_id_fkey FOREIGN KEY (river_client_id) REFERENCES public.river_client(id) ON DELETE CASCADE;
 
 
+--
+-- Name: user_token_revocations user_token_revocations_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.user_token_revocations
+    ADD CONSTRAINT user_token_revocations_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- PostgreSQL database dump complete
 --
@@ -719,4 +2335,51 @@ INSERT INTO public.schema_migrations (version) VALUES
     ('20260507140844'),
     ('20260525101218'),
     ('20260610165709'),
-    ('20260610211305');
+    ('20260610211305'),
+    ('20261002101512'),
+    ('20261016093412'),
+    ('20261016141808'),
+    ('20261016152031'),
+    ('20261016170215'),
+    ('20261016183547'),
+    ('20261016201244'),
+    ('20261016213310'),
+    ('20261016221547'),
+    ('20261016230412'),
+    ('20261016234518'),
+    ('20261017001204'),
+    ('20261017012236'),
+    ('20261017023104'),
+    ('20261017031842'),
+    ('20261017040517'),
+    ('20261017052209'),
+    ('20261017063351'),
+    ('20261017074512'),
+    ('20261017091538'),
+    ('20261017103027'),
+    ('20261017114208'),
+    ('20261017125311'),
+    ('20261017134652'),
+    ('20261017143120'),
+    ('20261017152408'),
+    ('20261017161530'),
+    ('20261017170245'),
+    ('20261017181502'),
+    ('20261017193044'),
+    ('20261017204512'),
+    ('20261017213027'),
+    ('20261017224518'),
+    ('20261017233117'),
+    ('20261017235012'),
+    ('20261018001204'),
+    ('20261018003512'),
+    ('20261018014127'),
+    ('20261018021536'),
+    ('20261018030412'),
+    ('20261018041503'),
+    ('20261018052207'),
+    ('20261018063012'),
+    ('20261018074508'),
+    ('20261018083216'),
+    ('20261018091045'),
+    ('20261018102317');
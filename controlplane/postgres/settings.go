@@ -0,0 +1,173 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// runtime settings are stored by name, which is prefixed with the
+// kind of the setting, e.g. log-levels/platformd.
+const (
+	logLevelsSettingPrefix   = "log-levels/"
+	featureFlagSettingPrefix = "feature-flags/"
+)
+
+func (db *DB) RuntimeSettings(ctx context.Context) (resource.RuntimeSettings, error) {
+	var ret resource.RuntimeSettings
+	if err := db.do(ctx, func(q *query.Queries) error {
+		var err error
+		ret, err = runtimeSettings(ctx, q)
+		return err
+	}); err != nil {
+		return resource.RuntimeSettings{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) SetLogLevels(
+	ctx context.Context,
+	target resource.LogTarget,
+	spec string,
+	actorID string,
+) (resource.RuntimeSettings, error) {
+	return db.setRuntimeSetting(ctx, logLevelsSettingPrefix+string(target), spec, actorID)
+}
+
+func (db *DB) SetFeatureFlag(
+	ctx context.Context,
+	flag resource.FeatureFlag,
+	enabled bool,
+	actorID string,
+) (resource.RuntimeSettings, error) {
+	return db.setRuntimeSetting(ctx, featureFlagSettingPrefix+string(flag), strconv.FormatBool(enabled), actorID)
+}
+
+// setRuntimeSetting stores the value of the setting and records the
+// change in the same transaction, so no change goes unrecorded.
+func (db *DB) setRuntimeSetting(
+	ctx context.Context,
+	name string,
+	value string,
+	actorID string,
+) (resource.RuntimeSettings, error) {
+	var ret resource.RuntimeSettings
+	if err := db.doTX(ctx, func(_ pgx.Tx, q *query.Queries) error {
+		old, err := q.RuntimeSettingForUpdate(ctx, name)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("get setting: %w", err)
+		}
+
+		if err := q.UpsertRuntimeSetting(ctx, query.UpsertRuntimeSettingParams{
+			Name:      name,
+			Value:     value,
+			UpdatedBy: actorID,
+		}); err != nil {
+			return fmt.Errorf("upsert setting: %w", err)
+		}
+
+		id, err := uuid.NewV7()
+		if err != nil {
+			return fmt.Errorf("generate id: %w", err)
+		}
+
+		if err := q.InsertRuntimeSettingChange(ctx, query.InsertRuntimeSettingChangeParams{
+			ID:       id.String(),
+			Setting:  name,
+			OldValue: old,
+			NewValue: value,
+			ActorID:  actorID,
+		}); err != nil {
+			return fmt.Errorf("insert change: %w", err)
+		}
+
+		ret, err = runtimeSettings(ctx, q)
+		return err
+	}); err != nil {
+		return resource.RuntimeSettings{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) RuntimeSettingChanges(ctx context.Context, limit int) ([]resource.RuntimeSettingChange, error) {
+	ret := make([]resource.RuntimeSettingChange, 0)
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.RuntimeSettingChanges(ctx, int32(limit))
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			ret = append(ret, resource.RuntimeSettingChange{
+				ID:        row.ID,
+				Setting:   row.Setting,
+				OldValue:  row.OldValue,
+				NewValue:  row.NewValue,
+				ActorID:   row.ActorID,
+				CreatedAt: row.CreatedAt.UTC(),
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func runtimeSettings(ctx context.Context, q *query.Queries) (resource.RuntimeSettings, error) {
+	rows, err := q.RuntimeSettings(ctx)
+	if err != nil {
+		return resource.RuntimeSettings{}, fmt.Errorf("runtime settings: %w", err)
+	}
+
+	ret := resource.RuntimeSettings{
+		LogLevels:    make(map[resource.LogTarget]string),
+		FeatureFlags: make(map[resource.FeatureFlag]bool),
+	}
+
+	for _, row := range rows {
+		if target, ok := strings.CutPrefix(row.Name, logLevelsSettingPrefix); ok {
+			ret.LogLevels[resource.LogTarget(target)] = row.Value
+			continue
+		}
+
+		if flag, ok := strings.CutPrefix(row.Name, featureFlagSettingPrefix); ok {
+			enabled, err := strconv.ParseBool(row.Value)
+			if err != nil {
+				return resource.RuntimeSettings{}, fmt.Errorf("parse feature flag %s: %w", flag, err)
+			}
+			ret.FeatureFlags[resource.FeatureFlag(flag)] = enabled
+		}
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,79 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/controlplane/signing"
+)
+
+func (db *DB) SigningKeys(ctx context.Context) ([]signing.Key, error) {
+	var ret []signing.Key
+
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.SigningKeys(ctx)
+		if err != nil {
+			return fmt.Errorf("signing keys: %w", err)
+		}
+
+		ret = make([]signing.Key, 0, len(rows))
+		for _, row := range rows {
+			key, err := signing.ParsePrivateKey([]byte(row.PrivateKey))
+			if err != nil {
+				return fmt.Errorf("key %s: %w", row.ID, err)
+			}
+
+			ret = append(ret, signing.Key{
+				ID:         row.ID,
+				PrivateKey: key,
+				CreatedAt:  row.CreatedAt,
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) CreateSigningKey(ctx context.Context, key signing.Key) error {
+	data, err := signing.EncodePrivateKey(key.PrivateKey)
+	if err != nil {
+		return fmt.Errorf("encode key: %w", err)
+	}
+
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.CreateSigningKey(ctx, query.CreateSigningKeyParams{
+			ID:         key.ID,
+			PrivateKey: string(data),
+			CreatedAt:  key.CreatedAt,
+		})
+	})
+}
+
+func (db *DB) DeleteSigningKeys(ctx context.Context, ids []string) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.DeleteSigningKeys(ctx, ids)
+	})
+}
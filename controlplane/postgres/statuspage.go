@@ -0,0 +1,56 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spacechunks/explorer/controlplane/postgres/query"
+	"github.com/spacechunks/explorer/controlplane/statuspage"
+)
+
+func (db *DB) PlatformStatus(ctx context.Context, seenAfter time.Time) (statuspage.Status, error) {
+	var ret statuspage.Status
+
+	if err := db.do(ctx, func(q *query.Queries) error {
+		row, err := q.PlatformStatus(ctx, pgtype.Timestamptz{
+			Time:  seenAfter,
+			Valid: true,
+		})
+		if err != nil {
+			return fmt.Errorf("platform status: %w", err)
+		}
+
+		ret = statuspage.Status{
+			NodesOnline:      int(row.NodesOnline),
+			NodesTotal:       int(row.NodesTotal),
+			InstancesRunning: int(row.InstancesRunning),
+			BuildsQueued:     int(row.BuildsQueued),
+		}
+
+		return nil
+	}); err != nil {
+		return ret, err
+	}
+
+	return ret, nil
+}
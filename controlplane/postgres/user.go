@@ -60,6 +60,34 @@ func (db *DB) GetUserByEmail(ctx context.Context, email string) (resource.User,
 	return ret, nil
 }
 
+func (db *DB) GetUserByID(ctx context.Context, id string) (resource.User, error) {
+	var ret resource.User
+	if err := db.do(ctx, func(q *query.Queries) error {
+		u, err := q.UserByID(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apierrs.ErrUserNotFound
+		}
+
+		if err != nil {
+			return err
+		}
+
+		ret = resource.User{
+			ID:        u.ID,
+			Nickname:  u.Nickname,
+			Email:     u.Email,
+			CreatedAt: u.CreatedAt.UTC(),
+			UpdatedAt: u.UpdatedAt.UTC(),
+		}
+
+		return nil
+	}); err != nil {
+		return resource.User{}, err
+	}
+
+	return ret, nil
+}
+
 func (db *DB) CreateUser(ctx context.Context, u resource.User) (resource.User, error) {
 	id, err := uuid.NewV7()
 	if err != nil {
@@ -80,7 +108,6 @@ func (db *DB) CreateUser(ctx context.Context, u resource.User) (resource.User, e
 
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
-		fmt.Println(err)
 		if pgErr.Code == "23505" {
 			return resource.User{}, apierrs.ErrAlreadyExists
 		}
@@ -95,3 +122,229 @@ func (db *DB) CreateUser(ctx context.Context, u resource.User) (resource.User, e
 	u.UpdatedAt = now
 	return u, nil
 }
+
+func (db *DB) NotificationPreferences(ctx context.Context, userID string) (resource.NotificationPreferences, error) {
+	var ret resource.NotificationPreferences
+	if err := db.do(ctx, func(q *query.Queries) error {
+		p, err := q.NotificationPreferences(ctx, userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			// users that never updated their preferences
+			// did not opt into any notifications.
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		ret = resource.NotificationPreferences{
+			BuildCompleted:  p.BuildCompleted,
+			BuildFailed:     p.BuildFailed,
+			InstanceCrashed: p.InstanceCrashed,
+		}
+
+		return nil
+	}); err != nil {
+		return resource.NotificationPreferences{}, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) UpdateNotificationPreferences(
+	ctx context.Context,
+	userID string,
+	prefs resource.NotificationPreferences,
+) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.UpsertNotificationPreferences(ctx, query.UpsertNotificationPreferencesParams{
+			UserID:          userID,
+			BuildCompleted:  prefs.BuildCompleted,
+			BuildFailed:     prefs.BuildFailed,
+			InstanceCrashed: prefs.InstanceCrashed,
+		})
+	})
+}
+
+// RevokeToken marks the token as revoked. revocations of tokens that
+// already expired are removed in the same step, because expired tokens
+// are rejected anyway.
+func (db *DB) RevokeToken(ctx context.Context, tokenID string, userID string, expiresAt time.Time) error {
+	return db.doTX(ctx, func(_ pgx.Tx, q *query.Queries) error {
+		if err := q.DeleteExpiredRevokedTokens(ctx); err != nil {
+			return fmt.Errorf("delete expired revocations: %w", err)
+		}
+
+		if err := q.RevokeToken(ctx, query.RevokeTokenParams{
+			ID:        tokenID,
+			UserID:    userID,
+			ExpiresAt: expiresAt,
+		}); err != nil {
+			return fmt.Errorf("revoke token: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (db *DB) RevokeUserTokens(ctx context.Context, userID string, before time.Time) error {
+	return db.do(ctx, func(q *query.Queries) error {
+		return q.RevokeUserTokens(ctx, query.RevokeUserTokensParams{
+			UserID:        userID,
+			RevokedBefore: before,
+		})
+	})
+}
+
+func (db *DB) TokenRevoked(ctx context.Context, tokenID string, userID string, issuedAt time.Time) (bool, error) {
+	var revoked bool
+	if err := db.do(ctx, func(q *query.Queries) error {
+		r, err := q.TokenRevoked(ctx, query.TokenRevokedParams{
+			TokenID:  tokenID,
+			UserID:   userID,
+			IssuedAt: issuedAt,
+		})
+		if err != nil {
+			return err
+		}
+		revoked = r
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	return revoked, nil
+}
+
+// ChunksByOwnerID returns all chunks owned by the user, including
+// chunks that have been deleted, but not archived yet.
+func (db *DB) ChunksByOwnerID(ctx context.Context, ownerID string) ([]resource.Chunk, error) {
+	ret := make([]resource.Chunk, 0)
+	if err := db.do(ctx, func(q *query.Queries) error {
+		ids, err := q.ChunkIDsByOwnerID(ctx, ownerID)
+		if err != nil {
+			return fmt.Errorf("chunk ids: %w", err)
+		}
+
+		for _, id := range ids {
+			c, err := db.getChunkByID(ctx, q, id)
+			if err != nil {
+				return fmt.Errorf("get chunk %s: %w", id, err)
+			}
+			ret = append(ret, c)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) InstancesByOwnerID(ctx context.Context, ownerID string) ([]resource.Instance, error) {
+	ret := make([]resource.Instance, 0)
+	if err := db.do(ctx, func(q *query.Queries) error {
+		ids, err := q.InstanceIDsByOwnerID(ctx, ownerID)
+		if err != nil {
+			return fmt.Errorf("instance ids: %w", err)
+		}
+
+		for _, id := range ids {
+			ins, err := db.getInstanceByID(ctx, q, id)
+			if err != nil {
+				return fmt.Errorf("get instance %s: %w", id, err)
+			}
+			ret = append(ret, ins)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (db *DB) InstanceEventsByOwnerID(ctx context.Context, ownerID string) ([]resource.InstanceEvent, error) {
+	ret := make([]resource.InstanceEvent, 0)
+	if err := db.do(ctx, func(q *query.Queries) error {
+		rows, err := q.InstanceEventsByOwnerID(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			ret = append(ret, resource.InstanceEvent{
+				ID:         row.ID,
+				InstanceID: row.InstanceID,
+				Type:       resource.InstanceEventType(row.Type),
+				ExitCode:   row.ExitCode,
+				Message:    row.Message,
+				CreatedAt:  row.CreatedAt.UTC(),
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// DeleteUser anonymizes the user and removes its notification preferences
+// and archived chunks. if transferTo is set, the chunks of the user are
+// transferred to that user, otherwise they are marked as deleted. the ids
+// of all affected chunks are returned.
+func (db *DB) DeleteUser(ctx context.Context, userID string, transferTo string) ([]string, error) {
+	var chunkIDs []string
+	if err := db.doTX(ctx, func(_ pgx.Tx, q *query.Queries) error {
+		ids, err := q.ChunkIDsByOwnerID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("chunk ids: %w", err)
+		}
+
+		if transferTo != "" {
+			if err := q.TransferChunks(ctx, query.TransferChunksParams{
+				NewOwnerID: transferTo,
+				OwnerID:    userID,
+			}); err != nil {
+				return fmt.Errorf("transfer chunks: %w", err)
+			}
+		} else {
+			if err := q.MarkFlavorsDeletedByOwnerID(ctx, userID); err != nil {
+				return fmt.Errorf("mark flavors deleted: %w", err)
+			}
+
+			if err := q.MarkChunksDeletedByOwnerID(ctx, userID); err != nil {
+				return fmt.Errorf("mark chunks deleted: %w", err)
+			}
+		}
+
+		// archived chunks contain a copy of the owner, including its email.
+		if err := q.DeleteArchivedChunksByOwnerID(ctx, userID); err != nil {
+			return fmt.Errorf("delete archived chunks: %w", err)
+		}
+
+		if err := q.DeleteNotificationPreferences(ctx, userID); err != nil {
+			return fmt.Errorf("delete notification preferences: %w", err)
+		}
+
+		// nicknames are limited to 16 characters and have to be unique,
+		// so a random suffix is used instead of the user id.
+		if err := q.AnonymizeUser(ctx, query.AnonymizeUserParams{
+			ID:       userID,
+			Nickname: "deleted-" + uuid.NewString()[:8],
+			Email:    userID + "@deleted.invalid",
+		}); err != nil {
+			return fmt.Errorf("anonymize user: %w", err)
+		}
+
+		chunkIDs = ids
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return chunkIDs, nil
+}
@@ -0,0 +1,75 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package rollout
+
+import (
+	"context"
+	"time"
+)
+
+// Status is the status of the rollout of a platformd version to a node.
+type Status string
+
+const (
+	// StatusNone is used for nodes no version has been rolled out to.
+	StatusNone        Status = ""
+	StatusPending     Status = "PENDING"
+	StatusDownloading Status = "DOWNLOADING"
+	StatusInstalling  Status = "INSTALLING"
+	StatusSucceeded   Status = "SUCCEEDED"
+	StatusFailed      Status = "FAILED"
+)
+
+// Rollout tracks the rollout of the desired platformd version to a node.
+// CurrentVersion is the version the node reported to be running.
+type Rollout struct {
+	NodeID         string
+	NodeName       string
+	CurrentVersion string
+	DesiredVersion string
+	Status         Status
+	Message        string
+	UpdatedAt      time.Time
+}
+
+type Repository interface {
+	// SetDesiredPlatformdVersion rolls out the version to the given nodes, or
+	// all nodes if nodeIDs is empty. returns ErrNodeNotFound if one of the
+	// nodes does not exist, in which case no rollout is started.
+	SetDesiredPlatformdVersion(ctx context.Context, nodeIDs []string, version string) ([]Rollout, error)
+
+	// ListPlatformdRollouts returns the rollouts of all nodes.
+	ListPlatformdRollouts(ctx context.Context) ([]Rollout, error)
+
+	// ReportPlatformdVersion records the version the node is running and
+	// completes the rollout if it is the desired version. returns
+	// ErrNodeNotFound if there is no such node.
+	ReportPlatformdVersion(ctx context.Context, nodeID string, version string) (Rollout, error)
+
+	// UpdatePlatformdUpdateStatus updates the status of the rollout of the
+	// version to the node. nothing happens if the version is no longer
+	// rolled out to the node.
+	UpdatePlatformdUpdateStatus(
+		ctx context.Context,
+		nodeID string,
+		version string,
+		status Status,
+		message string,
+	) error
+}
@@ -0,0 +1,170 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+)
+
+// versionPlaceholder is replaced with the version in the release url.
+const versionPlaceholder = "{version}"
+
+type Service interface {
+	// SetDesiredVersion rolls out the platformd version to the given nodes,
+	// or all nodes if nodeIDs is empty. only admins are allowed to do so.
+	SetDesiredVersion(ctx context.Context, actorID string, nodeIDs []string, version string) ([]Rollout, error)
+
+	// ListRollouts returns the rollouts of all nodes. only
+	// admins are allowed to list them.
+	ListRollouts(ctx context.Context, actorID string) ([]Rollout, error)
+
+	// PendingUpdate records the version the node is running and returns the
+	// update it should install. false is returned if there is none.
+	PendingUpdate(ctx context.Context, nodeID string, currentVersion string) (Update, bool, error)
+
+	// ReportStatus updates the status of the rollout of the version to the
+	// node. nodes can only report that they are downloading or installing
+	// the version, or that the update failed.
+	ReportStatus(ctx context.Context, nodeID string, version string, status Status, message string) error
+}
+
+// Update is a platformd release a node has to install. the signature
+// is a base64 encoded ecdsa signature of the sha256 digest of the
+// binary, as created by cosign sign-blob.
+type Update struct {
+	Version      string
+	BinaryURL    string
+	SignatureURL string
+}
+
+type Config struct {
+	// ReleaseURL is the url platformd binaries are downloaded from. the
+	// {version} placeholder is replaced with the version that is rolled
+	// out. signatures are expected at the same url with a .sig suffix.
+	// updates are disabled if empty.
+	ReleaseURL string
+}
+
+type svc struct {
+	logger       *slog.Logger
+	repo         Repository
+	adminUserIDs []string
+	cfg          Config
+}
+
+func NewService(logger *slog.Logger, repo Repository, adminUserIDs []string, cfg Config) Service {
+	return &svc{
+		logger:       logger,
+		repo:         repo,
+		adminUserIDs: adminUserIDs,
+		cfg:          cfg,
+	}
+}
+
+func (s *svc) SetDesiredVersion(
+	ctx context.Context,
+	actorID string,
+	nodeIDs []string,
+	version string,
+) ([]Rollout, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return nil, apierrs.ErrPermissionDenied
+	}
+
+	if s.cfg.ReleaseURL == "" {
+		return nil, apierrs.ErrPlatformdUpdatesDisabled
+	}
+
+	rollouts, err := s.repo.SetDesiredPlatformdVersion(ctx, nodeIDs, version)
+	if err != nil {
+		return nil, fmt.Errorf("set desired platformd version: %w", err)
+	}
+
+	s.logger.InfoContext(ctx,
+		"platformd rollout started",
+		"version", version,
+		"nodes", len(rollouts),
+		"actor_id", actorID,
+	)
+
+	return rollouts, nil
+}
+
+func (s *svc) ListRollouts(ctx context.Context, actorID string) ([]Rollout, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return nil, apierrs.ErrPermissionDenied
+	}
+
+	rollouts, err := s.repo.ListPlatformdRollouts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list platformd rollouts: %w", err)
+	}
+
+	return rollouts, nil
+}
+
+func (s *svc) PendingUpdate(ctx context.Context, nodeID string, currentVersion string) (Update, bool, error) {
+	r, err := s.repo.ReportPlatformdVersion(ctx, nodeID, currentVersion)
+	if err != nil {
+		return Update{}, false, fmt.Errorf("report platformd version: %w", err)
+	}
+
+	// updates that are in progress or failed are not handed out again,
+	// because the node might already have replaced its binary. they have
+	// to be retried by rolling out the version again.
+	if s.cfg.ReleaseURL == "" || r.Status != StatusPending || r.DesiredVersion == currentVersion {
+		return Update{}, false, nil
+	}
+
+	binURL := strings.ReplaceAll(s.cfg.ReleaseURL, versionPlaceholder, r.DesiredVersion)
+
+	return Update{
+		Version:      r.DesiredVersion,
+		BinaryURL:    binURL,
+		SignatureURL: binURL + ".sig",
+	}, true, nil
+}
+
+func (s *svc) ReportStatus(
+	ctx context.Context,
+	nodeID string,
+	version string,
+	status Status,
+	message string,
+) error {
+	if status != StatusDownloading && status != StatusInstalling && status != StatusFailed {
+		return apierrs.ErrInvalidUpdateStatus
+	}
+
+	if err := s.repo.UpdatePlatformdUpdateStatus(ctx, nodeID, version, status, message); err != nil {
+		return fmt.Errorf("update platformd update status: %w", err)
+	}
+
+	if status == StatusFailed {
+		s.logger.WarnContext(ctx, "platformd update failed", "node_id", nodeID, "version", version, "message", message)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package rollout_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/rollout"
+	"github.com/spacechunks/explorer/internal/mock"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adminID    = "admin"
+	nodeID     = "node"
+	releaseURL = "https://example.com/platformd/{version}/platformd"
+)
+
+func TestSetDesiredVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		actorID    string
+		releaseURL string
+		err        error
+		prep       func(*mock.MockRolloutRepository)
+	}{
+		{
+			name:       "admin can roll out a version",
+			actorID:    adminID,
+			releaseURL: releaseURL,
+			prep: func(repo *mock.MockRolloutRepository) {
+				repo.EXPECT().
+					SetDesiredPlatformdVersion(mocky.Anything, []string{nodeID}, "v2").
+					Return([]rollout.Rollout{
+						{NodeID: nodeID, DesiredVersion: "v2", Status: rollout.StatusPending},
+					}, nil)
+			},
+		},
+		{
+			name:       "other users are not allowed",
+			actorID:    "someone",
+			releaseURL: releaseURL,
+			err:        apierrs.ErrPermissionDenied,
+			prep:       func(*mock.MockRolloutRepository) {},
+		},
+		{
+			name:    "fails if no release url is configured",
+			actorID: adminID,
+			err:     apierrs.ErrPlatformdUpdatesDisabled,
+			prep:    func(*mock.MockRolloutRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockRolloutRepository(t)
+				svc  = rollout.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID}, rollout.Config{
+					ReleaseURL: tt.releaseURL,
+				})
+			)
+
+			tt.prep(repo)
+
+			rollouts, err := svc.SetDesiredVersion(ctx, tt.actorID, []string{nodeID}, "v2")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, rollouts, 1)
+		})
+	}
+}
+
+func TestPendingUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		rollout  rollout.Rollout
+		expected rollout.Update
+		ok       bool
+	}{
+		{
+			name:    "pending rollout is handed out",
+			current: "v1",
+			rollout: rollout.Rollout{CurrentVersion: "v1", DesiredVersion: "v2", Status: rollout.StatusPending},
+			expected: rollout.Update{
+				Version:      "v2",
+				BinaryURL:    "https://example.com/platformd/v2/platformd",
+				SignatureURL: "https://example.com/platformd/v2/platformd.sig",
+			},
+			ok: true,
+		},
+		{
+			name:    "rollouts in progress are not handed out again",
+			current: "v1",
+			rollout: rollout.Rollout{CurrentVersion: "v1", DesiredVersion: "v2", Status: rollout.StatusInstalling},
+		},
+		{
+			name:    "failed rollouts are not retried",
+			current: "v1",
+			rollout: rollout.Rollout{CurrentVersion: "v1", DesiredVersion: "v2", Status: rollout.StatusFailed},
+		},
+		{
+			name:    "nothing to do if the desired version is running",
+			current: "v2",
+			rollout: rollout.Rollout{CurrentVersion: "v2", DesiredVersion: "v2", Status: rollout.StatusSucceeded},
+		},
+		{
+			name:    "nothing to do if no version has been rolled out",
+			current: "v1",
+			rollout: rollout.Rollout{CurrentVersion: "v1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockRolloutRepository(t)
+				svc  = rollout.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID}, rollout.Config{
+					ReleaseURL: releaseURL,
+				})
+			)
+
+			repo.EXPECT().
+				ReportPlatformdVersion(mocky.Anything, nodeID, tt.current).
+				Return(tt.rollout, nil)
+
+			u, ok, err := svc.PendingUpdate(ctx, nodeID, tt.current)
+			require.NoError(t, err)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.expected, u)
+		})
+	}
+}
+
+func TestReportStatus(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		repo = mock.NewMockRolloutRepository(t)
+		svc  = rollout.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID}, rollout.Config{
+			ReleaseURL: releaseURL,
+		})
+	)
+
+	repo.EXPECT().
+		UpdatePlatformdUpdateStatus(mocky.Anything, nodeID, "v2", rollout.StatusFailed, "bad signature").
+		Return(nil)
+
+	require.NoError(t, svc.ReportStatus(ctx, nodeID, "v2", rollout.StatusFailed, "bad signature"))
+
+	// only the control plane decides that an update succeeded
+	err := svc.ReportStatus(ctx, nodeID, "v2", rollout.StatusSucceeded, "")
+	require.ErrorIs(t, err, apierrs.ErrInvalidUpdateStatus)
+}
@@ -0,0 +1,63 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// Target is a schedule that is evaluated by the [Runner].
+type Target struct {
+	Schedule resource.InstanceSchedule
+	// OwnerID is the owner of the chunk the schedule belongs to.
+	// instances started by the schedule are owned by them.
+	OwnerID string
+}
+
+type Repository interface {
+	// ActiveInstanceSchedules returns all schedules, except the ones of
+	// chunks and flavors that have been deleted.
+	ActiveInstanceSchedules(ctx context.Context) ([]Target, error)
+
+	// MarkInstanceScheduleEvaluated sets the time up to which
+	// runs of the schedule have been handled.
+	MarkInstanceScheduleEvaluated(ctx context.Context, id string, at time.Time) error
+
+	// RecordInstanceScheduleRun sets the instance the schedule is
+	// responsible for and the time up to which runs have been handled.
+	// an empty instance id means that the schedule has no instance.
+	RecordInstanceScheduleRun(ctx context.Context, id string, instanceID string, at time.Time) error
+}
+
+// Instances starts and stops instances, see [instance.Service].
+type Instances interface {
+	GetInstance(ctx context.Context, id string) (resource.Instance, error)
+	RunFlavorVersion(
+		ctx context.Context,
+		flavorVersionID string,
+		ownerID string,
+		orderedBy string,
+		params resource.InstanceParams,
+		placementGroup string,
+	) (resource.Instance, error)
+	StopInstance(ctx context.Context, id string, actorID string) error
+}
@@ -0,0 +1,249 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// OrderedBy is set on instances started by a schedule,
+// so they can be told apart from instances started by hand.
+const OrderedBy = "schedule"
+
+type runResult string
+
+const (
+	runResultStarted runResult = "started"
+	runResultStopped runResult = "stopped"
+	runResultSkipped runResult = "skipped"
+	runResultFailed  runResult = "failed"
+)
+
+type Config struct {
+	// Interval in which all schedules are evaluated.
+	Interval time.Duration
+}
+
+type Runner struct {
+	logger    *slog.Logger
+	repo      Repository
+	instances Instances
+	cfg       Config
+	metrics   metrics
+}
+
+func New(logger *slog.Logger, repo Repository, instances Instances, cfg Config) (*Runner, error) {
+	m, err := initMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Runner{
+		logger:    logger,
+		repo:      repo,
+		instances: instances,
+		cfg:       cfg,
+		metrics:   m,
+	}, nil
+}
+
+// Run evaluates all schedules in the configured interval until ctx is
+// canceled. it must only run on a single replica, see [leader.Task].
+func (r *Runner) Run(ctx context.Context) {
+	t := time.NewTicker(r.cfg.Interval)
+	defer t.Stop()
+
+	for {
+		if err := r.Reconcile(ctx); err != nil {
+			r.logger.ErrorContext(ctx, "failed to evaluate schedules", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Reconcile evaluates all schedules once. errors of a single schedule are
+// only logged, so they do not prevent other schedules from being evaluated.
+func (r *Runner) Reconcile(ctx context.Context) error {
+	now := time.Now()
+
+	targets, err := r.repo.ActiveInstanceSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("active schedules: %w", err)
+	}
+
+	for _, t := range targets {
+		logger := r.logger.With("schedule_id", t.Schedule.ID, "chunk_id", t.Schedule.ChunkID)
+		if err := r.evaluate(ctx, logger, t, now); err != nil {
+			logger.ErrorContext(ctx, "failed to evaluate schedule", "err", err)
+		}
+	}
+
+	return nil
+}
+
+// evaluate performs the latest run of the schedule that is due. runs that
+// have been missed before are not caught up on, because only the latest
+// one determines whether the instance should be running.
+func (r *Runner) evaluate(ctx context.Context, logger *slog.Logger, t Target, now time.Time) error {
+	sp, err := parse(t.Schedule)
+	if err != nil {
+		return fmt.Errorf("parse: %w", err)
+	}
+
+	action, ok := sp.due(t.Schedule.EvaluatedAt, now)
+	if !ok {
+		// evaluated_at is left as is, because there is nothing
+		// to record. the next run will be found nonetheless.
+		return nil
+	}
+
+	var res runResult
+	switch action {
+	case resource.ScheduleActionStart:
+		res, err = r.start(ctx, t, now)
+	case resource.ScheduleActionStop:
+		res, err = r.stop(ctx, t, now)
+	}
+
+	r.metrics.runCount.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("action", string(action)),
+		attribute.String("result", string(res)),
+	))
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", action, err)
+	}
+
+	logger.InfoContext(ctx, "evaluated schedule", "action", action, "result", res)
+	return nil
+}
+
+func (r *Runner) start(ctx context.Context, t Target, now time.Time) (runResult, error) {
+	s := t.Schedule
+
+	if s.InstanceID != "" {
+		active, err := r.active(ctx, s.InstanceID)
+		if err != nil {
+			return runResultFailed, fmt.Errorf("instance: %w", err)
+		}
+
+		// the instance of the previous run is still there, so starting
+		// another one would lead to two instances running at once.
+		if active {
+			if err := r.repo.MarkInstanceScheduleEvaluated(ctx, s.ID, now); err != nil {
+				return runResultFailed, fmt.Errorf("mark evaluated: %w", err)
+			}
+			return runResultSkipped, nil
+		}
+	}
+
+	// evaluated_at is only advanced if the instance has been started,
+	// so starting it is tried again in the next interval.
+	ins, err := r.instances.RunFlavorVersion(
+		ctx,
+		s.FlavorVersionID,
+		t.OwnerID,
+		OrderedBy,
+		resource.InstanceParams{},
+		"",
+	)
+	if err != nil {
+		return runResultFailed, fmt.Errorf("run flavor version: %w", err)
+	}
+
+	if err := r.repo.RecordInstanceScheduleRun(ctx, s.ID, ins.ID, now); err != nil {
+		return runResultFailed, fmt.Errorf("record run: %w", err)
+	}
+
+	return runResultStarted, nil
+}
+
+func (r *Runner) stop(ctx context.Context, t Target, now time.Time) (runResult, error) {
+	s := t.Schedule
+
+	if s.InstanceID == "" {
+		if err := r.repo.MarkInstanceScheduleEvaluated(ctx, s.ID, now); err != nil {
+			return runResultFailed, fmt.Errorf("mark evaluated: %w", err)
+		}
+		return runResultSkipped, nil
+	}
+
+	ins, err := r.instances.GetInstance(ctx, s.InstanceID)
+	if err != nil && !errors.Is(err, apierrs.ErrInstanceNotFound) {
+		return runResultFailed, fmt.Errorf("instance: %w", err)
+	}
+
+	res := runResultSkipped
+	if err == nil && isActive(ins.State) {
+		// the owner of the chunk might have changed since the instance
+		// has been started, so it is stopped on behalf of its own owner.
+		if err := r.instances.StopInstance(ctx, ins.ID, ins.Owner.ID); err != nil {
+			return runResultFailed, fmt.Errorf("stop instance: %w", err)
+		}
+		res = runResultStopped
+	}
+
+	if err := r.repo.RecordInstanceScheduleRun(ctx, s.ID, "", now); err != nil {
+		return runResultFailed, fmt.Errorf("record run: %w", err)
+	}
+
+	return res, nil
+}
+
+// active reports whether the instance exists and is neither being deleted,
+// failed to be created nor has been lost. lost instances are not replaced
+// by the orphan detector, so the schedule starts a new one.
+func (r *Runner) active(ctx context.Context, id string) (bool, error) {
+	ins, err := r.instances.GetInstance(ctx, id)
+	if errors.Is(err, apierrs.ErrInstanceNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return isActive(ins.State), nil
+}
+
+func isActive(state resource.InstanceState) bool {
+	switch state {
+	case resource.InstanceStateDeleting,
+		resource.InstanceStateDeleted,
+		resource.InstanceCreationFailed,
+		resource.InstanceStateLost:
+		return false
+	default:
+		return true
+	}
+}
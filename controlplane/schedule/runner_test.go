@@ -0,0 +1,200 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package schedule_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/schedule"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcile(t *testing.T) {
+	var (
+		ownerID   = "019a88ab-3240-7f61-b560-cc755d5572a1"
+		versionID = "019a88ab-3240-7f61-b560-cc755d5572a2"
+		// matches rarely enough to never be due in the tests.
+		never = "0 0 29 2 *"
+	)
+
+	target := func(start string, stop string, instanceID string) schedule.Target {
+		return schedule.Target{
+			Schedule: resource.InstanceSchedule{
+				ID:              "019a88ab-3240-7f61-b560-cc755d5572a3",
+				ChunkID:         "019a88ab-3240-7f61-b560-cc755d5572a4",
+				FlavorVersionID: versionID,
+				StartCron:       start,
+				StopCron:        stop,
+				Timezone:        "Europe/Berlin",
+				InstanceID:      instanceID,
+				EvaluatedAt:     time.Now().Add(-2 * time.Minute),
+			},
+			OwnerID: ownerID,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		target schedule.Target
+		prep   func(*mock.MockScheduleRepository, *mock.MockScheduleInstances, schedule.Target)
+	}{
+		{
+			name:   "start instance",
+			target: target("* * * * *", never, ""),
+			prep: func(repo *mock.MockScheduleRepository, instances *mock.MockScheduleInstances, tgt schedule.Target) {
+				instances.EXPECT().
+					RunFlavorVersion(mocky.Anything, versionID, ownerID, "schedule", resource.InstanceParams{}, "").
+					Return(resource.Instance{ID: "a"}, nil)
+				repo.EXPECT().
+					RecordInstanceScheduleRun(mocky.Anything, tgt.Schedule.ID, "a", mocky.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:   "skip start while previous instance is running",
+			target: target("* * * * *", never, "a"),
+			prep: func(repo *mock.MockScheduleRepository, instances *mock.MockScheduleInstances, tgt schedule.Target) {
+				instances.EXPECT().
+					GetInstance(mocky.Anything, "a").
+					Return(resource.Instance{ID: "a", State: resource.InstanceStateRunning}, nil)
+				repo.EXPECT().
+					MarkInstanceScheduleEvaluated(mocky.Anything, tgt.Schedule.ID, mocky.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:   "start instance if previous instance is gone",
+			target: target("* * * * *", never, "a"),
+			prep: func(repo *mock.MockScheduleRepository, instances *mock.MockScheduleInstances, tgt schedule.Target) {
+				instances.EXPECT().
+					GetInstance(mocky.Anything, "a").
+					Return(resource.Instance{}, apierrs.ErrInstanceNotFound)
+				instances.EXPECT().
+					RunFlavorVersion(mocky.Anything, versionID, ownerID, "schedule", resource.InstanceParams{}, "").
+					Return(resource.Instance{ID: "b"}, nil)
+				repo.EXPECT().
+					RecordInstanceScheduleRun(mocky.Anything, tgt.Schedule.ID, "b", mocky.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:   "start instance if previous instance has been lost",
+			target: target("* * * * *", never, "a"),
+			prep: func(repo *mock.MockScheduleRepository, instances *mock.MockScheduleInstances, tgt schedule.Target) {
+				instances.EXPECT().
+					GetInstance(mocky.Anything, "a").
+					Return(resource.Instance{ID: "a", State: resource.InstanceStateLost}, nil)
+				instances.EXPECT().
+					RunFlavorVersion(mocky.Anything, versionID, ownerID, "schedule", resource.InstanceParams{}, "").
+					Return(resource.Instance{ID: "b"}, nil)
+				repo.EXPECT().
+					RecordInstanceScheduleRun(mocky.Anything, tgt.Schedule.ID, "b", mocky.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:   "failed start is retried",
+			target: target("* * * * *", never, ""),
+			prep: func(repo *mock.MockScheduleRepository, instances *mock.MockScheduleInstances, tgt schedule.Target) {
+				// evaluated_at must not be advanced, so nothing is recorded.
+				instances.EXPECT().
+					RunFlavorVersion(mocky.Anything, versionID, ownerID, "schedule", resource.InstanceParams{}, "").
+					Return(resource.Instance{}, errors.New("no free node"))
+			},
+		},
+		{
+			name:   "stop instance on behalf of its owner",
+			target: target(never, "* * * * *", "a"),
+			prep: func(repo *mock.MockScheduleRepository, instances *mock.MockScheduleInstances, tgt schedule.Target) {
+				instances.EXPECT().
+					GetInstance(mocky.Anything, "a").
+					Return(resource.Instance{
+						ID:    "a",
+						State: resource.InstanceStateRunning,
+						Owner: resource.User{ID: "other"},
+					}, nil)
+				instances.EXPECT().StopInstance(mocky.Anything, "a", "other").Return(nil)
+				repo.EXPECT().
+					RecordInstanceScheduleRun(mocky.Anything, tgt.Schedule.ID, "", mocky.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:   "do not stop instance that is being deleted",
+			target: target(never, "* * * * *", "a"),
+			prep: func(repo *mock.MockScheduleRepository, instances *mock.MockScheduleInstances, tgt schedule.Target) {
+				instances.EXPECT().
+					GetInstance(mocky.Anything, "a").
+					Return(resource.Instance{ID: "a", State: resource.InstanceStateDeleting}, nil)
+				repo.EXPECT().
+					RecordInstanceScheduleRun(mocky.Anything, tgt.Schedule.ID, "", mocky.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:   "stop wins if both are due at the same time",
+			target: target("* * * * *", "*/1 * * * *", ""),
+			prep: func(repo *mock.MockScheduleRepository, instances *mock.MockScheduleInstances, tgt schedule.Target) {
+				repo.EXPECT().
+					MarkInstanceScheduleEvaluated(mocky.Anything, tgt.Schedule.ID, mocky.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name: "nothing due",
+			target: func() schedule.Target {
+				tgt := target(never, "", "")
+				tgt.Schedule.EvaluatedAt = time.Now()
+				return tgt
+			}(),
+			prep: func(*mock.MockScheduleRepository, *mock.MockScheduleInstances, schedule.Target) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx           = context.Background()
+				mockRepo      = mock.NewMockScheduleRepository(t)
+				mockInstances = mock.NewMockScheduleInstances(t)
+			)
+
+			mockRepo.EXPECT().ActiveInstanceSchedules(mocky.Anything).Return([]schedule.Target{tt.target}, nil)
+			tt.prep(mockRepo, mockInstances, tt.target)
+
+			r, err := schedule.New(
+				slog.New(slog.DiscardHandler),
+				mockRepo,
+				mockInstances,
+				schedule.Config{Interval: time.Minute},
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, r.Reconcile(ctx))
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package schedule starts and stops instances according to the instance
+// schedules of chunks, see [resource.InstanceSchedule].
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/cron"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// maxCatchUp limits how far back runs are looked for, e.g. if the
+// control plane has not been running for a while.
+const maxCatchUp = 31 * 24 * time.Hour
+
+// spec is a parsed instance schedule.
+type spec struct {
+	start cron.Schedule
+	// nil if the schedule does not stop its instance.
+	stop *cron.Schedule
+	loc  *time.Location
+}
+
+// Validate returns an [apierrs.InvalidSchedule] error if the cron
+// expressions or the time zone of the schedule are invalid.
+func Validate(s resource.InstanceSchedule) error {
+	_, err := parse(s)
+	return err
+}
+
+func parse(s resource.InstanceSchedule) (spec, error) {
+	start, err := cron.Parse(s.StartCron)
+	if err != nil {
+		return spec{}, apierrs.InvalidSchedule("start_cron", err)
+	}
+
+	ret := spec{
+		start: start,
+	}
+
+	if s.StopCron != "" {
+		if s.StopCron == s.StartCron {
+			return spec{}, apierrs.InvalidSchedule("stop_cron", errors.New("must differ from start_cron"))
+		}
+
+		stop, err := cron.Parse(s.StopCron)
+		if err != nil {
+			return spec{}, apierrs.InvalidSchedule("stop_cron", err)
+		}
+		ret.stop = &stop
+	}
+
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return spec{}, apierrs.InvalidSchedule("timezone", fmt.Errorf("unknown time zone %q", s.Timezone))
+	}
+	ret.loc = loc
+
+	return ret, nil
+}
+
+// NextRuns returns the next n runs of the schedule after the given time.
+func NextRuns(s resource.InstanceSchedule, after time.Time, n int) ([]resource.ScheduleRun, error) {
+	sp, err := parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ret       = make([]resource.ScheduleRun, 0, n)
+		nextStart = sp.start.Next(after.In(sp.loc))
+		nextStop  time.Time
+	)
+
+	if sp.stop != nil {
+		nextStop = sp.stop.Next(after.In(sp.loc))
+	}
+
+	for len(ret) < n {
+		switch {
+		case nextStart.IsZero() && nextStop.IsZero():
+			return ret, nil
+		case nextStop.IsZero() || (!nextStart.IsZero() && nextStart.Before(nextStop)):
+			ret = append(ret, resource.ScheduleRun{Time: nextStart, Action: resource.ScheduleActionStart})
+			nextStart = sp.start.Next(nextStart)
+		default:
+			ret = append(ret, resource.ScheduleRun{Time: nextStop, Action: resource.ScheduleActionStop})
+			nextStop = sp.stop.Next(nextStop)
+		}
+	}
+
+	return ret, nil
+}
+
+// due returns the action of the latest run after from, that is not after
+// now. if the instance is started and stopped at the same time, stopping
+// it wins.
+func (sp spec) due(from time.Time, now time.Time) (resource.ScheduleAction, bool) {
+	if earliest := now.Add(-maxCatchUp); from.Before(earliest) {
+		from = earliest
+	}
+
+	start := latest(sp.start, from.In(sp.loc), now)
+
+	var stop time.Time
+	if sp.stop != nil {
+		stop = latest(*sp.stop, from.In(sp.loc), now)
+	}
+
+	switch {
+	case start.IsZero() && stop.IsZero():
+		return "", false
+	case start.After(stop):
+		return resource.ScheduleActionStart, true
+	default:
+		return resource.ScheduleActionStop, true
+	}
+}
+
+// latest returns the last time after from the cron schedule matches, that
+// is not after now. the zero time is returned if there is no such time.
+func latest(s cron.Schedule, from time.Time, now time.Time) time.Time {
+	var ret time.Time
+	for t := s.Next(from); !t.IsZero() && !t.After(now); t = s.Next(t) {
+		ret = t
+	}
+	return ret
+}
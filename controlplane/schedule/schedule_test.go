@@ -0,0 +1,142 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/schedule"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+func TestNextRuns(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule resource.InstanceSchedule
+		after    time.Time
+		n        int
+		expected []resource.ScheduleRun
+	}{
+		{
+			name: "start and stop are merged in time zone",
+			schedule: resource.InstanceSchedule{
+				StartCron: "0 18 * * FRI",
+				StopCron:  "0 23 * * FRI",
+				Timezone:  "Europe/Berlin",
+			},
+			after: time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+			n:     3,
+			expected: []resource.ScheduleRun{
+				{Time: time.Date(2026, 10, 16, 16, 0, 0, 0, time.UTC), Action: resource.ScheduleActionStart},
+				{Time: time.Date(2026, 10, 16, 21, 0, 0, 0, time.UTC), Action: resource.ScheduleActionStop},
+				// daylight saving time ends on the 25th.
+				{Time: time.Date(2026, 10, 23, 16, 0, 0, 0, time.UTC), Action: resource.ScheduleActionStart},
+			},
+		},
+		{
+			name: "never stopped",
+			schedule: resource.InstanceSchedule{
+				StartCron: "@daily",
+				Timezone:  "UTC",
+			},
+			after: time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+			n:     2,
+			expected: []resource.ScheduleRun{
+				{Time: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), Action: resource.ScheduleActionStart},
+				{Time: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), Action: resource.ScheduleActionStart},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs, err := schedule.NextRuns(tt.schedule, tt.after, tt.n)
+			require.NoError(t, err)
+
+			for i := range runs {
+				runs[i].Time = runs[i].Time.UTC()
+			}
+
+			require.Equal(t, tt.expected, runs)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule resource.InstanceSchedule
+		field    string
+	}{
+		{
+			name: "invalid start",
+			schedule: resource.InstanceSchedule{
+				StartCron: "0 25 * * *",
+				Timezone:  "UTC",
+			},
+			field: "start_cron",
+		},
+		{
+			name: "invalid stop",
+			schedule: resource.InstanceSchedule{
+				StartCron: "0 18 * * *",
+				StopCron:  "0 18 *",
+				Timezone:  "UTC",
+			},
+			field: "stop_cron",
+		},
+		{
+			name: "stop equals start",
+			schedule: resource.InstanceSchedule{
+				StartCron: "0 18 * * *",
+				StopCron:  "0 18 * * *",
+				Timezone:  "UTC",
+			},
+			field: "stop_cron",
+		},
+		{
+			name: "unknown time zone",
+			schedule: resource.InstanceSchedule{
+				StartCron: "0 18 * * *",
+				Timezone:  "Mars/Olympus_Mons",
+			},
+			field: "timezone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var apiErr apierrs.Error
+			require.ErrorAs(t, schedule.Validate(tt.schedule), &apiErr)
+			require.Equal(t, codes.InvalidArgument, apiErr.GRPCStatus().Code())
+
+			details := apiErr.GRPCStatus().Details()
+			require.Len(t, details, 1)
+
+			badRequest, ok := details[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			require.Equal(t, tt.field, badRequest.GetFieldViolations()[0].GetField())
+		})
+	}
+}
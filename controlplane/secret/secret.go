@@ -0,0 +1,117 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package secret provides access to secrets that are either configured
+// directly or read from files, for example those mounted by kubernetes.
+// secrets read from files are reloaded once the file changes, so rotated
+// secrets are picked up without having to restart the control plane.
+package secret
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Source returns the current value of a secret.
+type Source interface {
+	Value() (string, error)
+}
+
+// Static is a secret that never changes.
+type Static string
+
+func (s Static) Value() (string, error) {
+	return string(s), nil
+}
+
+// File is a secret stored in a file. the file is only read again
+// if its modification time or size changed since it was last read.
+type File struct {
+	path string
+
+	mu      sync.Mutex
+	value   string
+	modTime time.Time
+	size    int64
+}
+
+// NewFile returns a secret read from the file at the given path.
+// the file is read once, so missing files are detected right away.
+func NewFile(path string) (*File, error) {
+	f := &File{
+		path: path,
+	}
+
+	if _, err := f.Value(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *File) Value() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return "", fmt.Errorf("stat secret file: %w", err)
+	}
+
+	if info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.value, nil
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return "", fmt.Errorf("read secret file: %w", err)
+	}
+
+	f.value = strings.TrimRight(string(data), "\r\n")
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+
+	return f.value, nil
+}
+
+// New returns a [File] if path is not empty, otherwise the
+// given value is returned as [Static] secret.
+func New(value string, path string) (Source, error) {
+	if path == "" {
+		return Static(value), nil
+	}
+
+	if value != "" {
+		return nil, fmt.Errorf("secret is set directly and via file %s", path)
+	}
+
+	return NewFile(path)
+}
+
+// Resolve returns the current value of the secret described by value and path.
+// see [New] for details.
+func Resolve(value string, path string) (string, error) {
+	src, err := New(value, path)
+	if err != nil {
+		return "", err
+	}
+	return src.Value()
+}
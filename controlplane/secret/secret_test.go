@@ -0,0 +1,98 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package secret_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/secret"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileReloadsChangedSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+
+	f, err := secret.NewFile(path)
+	require.NoError(t, err)
+
+	v, err := f.Value()
+	require.NoError(t, err)
+	require.Equal(t, "first", v)
+
+	require.NoError(t, os.WriteFile(path, []byte("second\n"), 0o600))
+	// make sure the modification time changes, even on
+	// file systems with a coarse timestamp resolution.
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, future, future))
+
+	v, err = f.Value()
+	require.NoError(t, err)
+	require.Equal(t, "second", v)
+}
+
+func TestNew(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(path, []byte("from-file"), 0o600))
+
+	tests := []struct {
+		name     string
+		value    string
+		path     string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "static value",
+			value:    "static",
+			expected: "static",
+		},
+		{
+			name:     "value from file",
+			path:     path,
+			expected: "from-file",
+		},
+		{
+			name:  "value and file set",
+			value: "static",
+			path:  path,
+			err:   true,
+		},
+		{
+			name: "file does not exist",
+			path: filepath.Join(t.TempDir(), "missing"),
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := secret.Resolve(tt.value, tt.path)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
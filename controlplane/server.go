@@ -19,50 +19,88 @@
 package controlplane
 
 import (
+	"cmp"
 	"context"
-	"crypto/ecdsa"
-	"crypto/x509"
-	"encoding/pem"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
+	"cloud.google.com/go/storage"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awscfg "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/hashicorp/go-multierror"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/lestrrat-go/jwx/v4/jwa"
+	"github.com/lestrrat-go/jwx/v4/jws"
 	"github.com/lestrrat-go/jwx/v4/jwt"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 	"github.com/riverqueue/river/rivertype"
+	adminv1alpha1 "github.com/spacechunks/explorer/api/admin/v1alpha1"
+	announcementv1alpha1 "github.com/spacechunks/explorer/api/announcement/v1alpha1"
 	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	instancev1beta1 "github.com/spacechunks/explorer/api/instance/v1beta1"
+	integrationv1alpha1 "github.com/spacechunks/explorer/api/integration/v1alpha1"
 	checkpointv1alpha1 "github.com/spacechunks/explorer/api/platformd/checkpoint/v1alpha1"
+	workloadv1alpha2 "github.com/spacechunks/explorer/api/platformd/workload/v1alpha2"
 	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
+	"github.com/spacechunks/explorer/controlplane/announcement"
 	"github.com/spacechunks/explorer/controlplane/authz"
+	"github.com/spacechunks/explorer/controlplane/autoscaler"
 	"github.com/spacechunks/explorer/controlplane/blob"
 	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/condition"
 	"github.com/spacechunks/explorer/controlplane/contextkey"
+	"github.com/spacechunks/explorer/controlplane/cost"
+	"github.com/spacechunks/explorer/controlplane/dev"
 	cperrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/event"
 	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/integration"
 	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/leader"
+	"github.com/spacechunks/explorer/controlplane/maintenance"
 	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/controlplane/notification"
+	"github.com/spacechunks/explorer/controlplane/orphan"
+	"github.com/spacechunks/explorer/controlplane/partition"
 	"github.com/spacechunks/explorer/controlplane/postgres"
+	"github.com/spacechunks/explorer/controlplane/rollout"
+	"github.com/spacechunks/explorer/controlplane/schedule"
+	"github.com/spacechunks/explorer/controlplane/secret"
+	"github.com/spacechunks/explorer/controlplane/settings"
+	"github.com/spacechunks/explorer/controlplane/signing"
+	"github.com/spacechunks/explorer/controlplane/statuspage"
 	"github.com/spacechunks/explorer/controlplane/user"
 	"github.com/spacechunks/explorer/controlplane/worker"
+	"github.com/spacechunks/explorer/internal/buildinfo"
+	"github.com/spacechunks/explorer/internal/deprecation"
+	"github.com/spacechunks/explorer/internal/diagnostics"
+	"github.com/spacechunks/explorer/internal/grpcclient"
 	"github.com/spacechunks/explorer/internal/image"
 	"github.com/spacechunks/explorer/internal/instr"
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/internal/tarhelper"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/api/option"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip" // platformd compresses its status reports
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
@@ -75,6 +113,10 @@ import (
 	protovalidatemw "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/protovalidate"
 )
 
+// defaultMaxRequestSizeBytes is used if no maximum request size has
+// been configured. it matches the default of grpc.
+const defaultMaxRequestSizeBytes = 4 * 1024 * 1024
+
 type Server struct {
 	logger *slog.Logger
 	cfg    Config
@@ -101,45 +143,110 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	}()
 
-	oidcProvider, err := oidc.NewProvider(ctx, s.cfg.OAuthIssuerURL)
-	if err != nil {
-		return fmt.Errorf("oidc provider: %w", err)
+	// profiles are served on a separate listener, so they are never
+	// exposed by accident on the public api address.
+	var pprofServer *http.Server
+	if s.cfg.PprofListenAddr != "" {
+		pprofServer, err = diagnostics.NewServer(s.cfg.PprofListenAddr, s.cfg.PprofTokenFile)
+		if err != nil {
+			return fmt.Errorf("pprof server: %w", err)
+		}
+	}
+
+	// in dev mode no identity provider is required,
+	// because the dev user receives a token on startup.
+	var oidcProvider *oidc.Provider
+	if !s.cfg.DevMode || s.cfg.OAuthIssuerURL != "" {
+		oidcProvider, err = oidc.NewProvider(ctx, s.cfg.OAuthIssuerURL)
+		if err != nil {
+			return fmt.Errorf("oidc provider: %w", err)
+		}
 	}
 
-	pgxCfg, err := pgxpool.ParseConfig(s.cfg.DBConnString)
+	pgxCfg, err := poolConfig(s.cfg)
 	if err != nil {
 		return fmt.Errorf("parse pgx cfg: %w", err)
 	}
 
-	pgxCfg.ConnConfig.Tracer = otelpgx.NewTracer()
-
 	pool, err := pgxpool.NewWithConfig(ctx, pgxCfg)
 	if err != nil {
 		return fmt.Errorf("connect to database: %w", err)
 	}
 
-	s3cfg, err := awscfg.LoadDefaultConfig(
-		ctx,
-		awscfg.WithCredentialsProvider(
-			credentials.NewStaticCredentialsProvider(s.cfg.AccessKey, s.cfg.SecretKey, ""),
-		),
-	)
+	blobStore, err := s.createBlobStore(ctx)
+	if err != nil {
+		return fmt.Errorf("create blob store: %w", err)
+	}
+
+	replicaID := s.cfg.ReplicaID
+	if replicaID == "" {
+		if replicaID, err = os.Hostname(); err != nil {
+			return fmt.Errorf("get hostname: %w", err)
+		}
+	}
+
+	elector, err := leader.NewElector(s.logger.With("component", "leader-election"), pool, leader.Config{
+		ReplicaID:     replicaID,
+		LockID:        leader.DefaultLockID,
+		RetryInterval: s.cfg.LeaderElectionInterval,
+	})
 	if err != nil {
-		return fmt.Errorf("aws config: %w", err)
+		return fmt.Errorf("create leader elector: %w", err)
 	}
 
 	var (
-		s3client = s3.NewFromConfig(s3cfg, func(o *s3.Options) {
-			o.UsePathStyle = s.cfg.UsePathStyle
-		})
-		db         = postgres.NewDB(s.logger, pool)
-		blobStore  = blob.NewS3Store(s.cfg.Bucket, s3client, s3.NewPresignClient(s3client))
-		imgService = image.NewService(s.logger, s.cfg.OCIRegistryUser, s.cfg.OCIRegistryPass, s.cfg.ImageCacheDir)
+		db                          = postgres.NewDB(s.logger, pool)
+		imgService                  = image.NewService(s.logger, s.cfg.OCIRegistryUser, s.cfg.OCIRegistryPass, s.cfg.ImageCacheDir)
+		bus                         = event.NewBus()
+		chunkRepo  chunk.Repository = db
+		userRepo   user.Repository  = db
 	)
 
+	if s.cfg.ChunkCacheTTL > 0 {
+		chunkRepo = chunk.NewCachedRepository(db, bus, s.cfg.ChunkCacheTTL)
+	}
+
+	if s.cfg.RevocationCacheTTL > 0 {
+		userRepo = user.NewCachedRevocations(db, s.cfg.RevocationCacheTTL)
+	}
+
+	key, err := signing.ParsePrivateKey([]byte(s.cfg.APITokenSigningKey))
+	if err != nil {
+		return fmt.Errorf("signing key: %w", err)
+	}
+
+	keyRing, err := signing.NewKeyRing(s.logger.With("component", "key-ring"), key, db, signing.Config{
+		RotationPeriod:  s.cfg.APITokenKeyRotationPeriod,
+		GracePeriod:     s.cfg.APITokenKeyGracePeriod,
+		RefreshInterval: s.cfg.APITokenKeyRefreshInterval,
+	})
+	if err != nil {
+		return fmt.Errorf("create key ring: %w", err)
+	}
+
+	if keyRing.RotationEnabled() {
+		if err := keyRing.Refresh(ctx); err != nil {
+			return fmt.Errorf("load signing keys: %w", err)
+		}
+	}
+
+	var imgSigner *image.Signer
+	if s.cfg.ImageSigningKey != "" {
+		imgKey, err := signing.ParsePrivateKey([]byte(s.cfg.ImageSigningKey))
+		if err != nil {
+			return fmt.Errorf("image signing key: %w", err)
+		}
+		imgSigner = image.NewSigner(imgService, imgKey)
+	}
+
+	notificationSender, err := s.notificationSender()
+	if err != nil {
+		return fmt.Errorf("create notification sender: %w", err)
+	}
+
 	riverClient, err := CreateRiverClient(
 		s.logger,
-		db,
+		chunkRepo,
 		imgService,
 		blobStore,
 		pool,
@@ -147,12 +254,23 @@ func (s *Server) Run(ctx context.Context) error {
 		db,
 		s.cfg.ResourcePackBuildInterval,
 		s.cfg.ArchiveInterval,
+		s.cfg.InstanceCleanupInterval,
+		s.cfg.InstanceCleanupPolicies,
+		s.cfg.RetentionInterval,
+		s.cfg.PartitionInterval,
+		s.cfg.OCIRegistry,
 		worker.CreateImageWorkerConfig{
-			ImagePlatform: s.cfg.ImagePlatform,
+			ImagePlatform:  s.cfg.ImagePlatform,
+			BuildLimits:    s.buildLimits(),
+			Signer:         imgSigner,
+			BuilderVersion: buildinfo.Revision(),
 		},
 		worker.CreateCheckpointWorkerConfig{
 			Timeout:             s.cfg.CheckpointJobTimeout,
 			StatusCheckInterval: s.cfg.CheckpointStatusCheckInterval,
+			BuilderTimeout:      s.cfg.CheckpointBuilderTimeout,
+			VerifyRestore:       s.cfg.CheckpointVerifyRestore,
+			Signer:              imgSigner,
 		},
 		worker.CreateResourcePackWorkerConfig{
 			WorkingDir:        s.cfg.ResourcePackWorkingDir,
@@ -163,8 +281,24 @@ func (s *Server) Run(ctx context.Context) error {
 			ItemDir:           s.cfg.ResourcePackItemDir,
 			TextureDir:        s.cfg.ResourcePackTextureDir,
 		},
+		worker.ImportChunkWorkerConfig{
+			Registry:    s.cfg.OCIRegistry,
+			BuildLimits: s.buildLimits(),
+			ExtractLimits: tarhelper.Limits{
+				MaxFiles: s.cfg.ImportMaxFileCount,
+				MaxBytes: s.cfg.ImportMaxSizeBytes,
+			},
+		},
+		worker.PartitionWorkerConfig{
+			Tables: s.cfg.PartitionedTables,
+		},
 		db,
 		db,
+		db,
+		db,
+		notificationSender,
+		keyRing,
+		s.cfg.DevMode,
 	)
 	if err != nil {
 		return fmt.Errorf("create river client: %w", err)
@@ -172,11 +306,23 @@ func (s *Server) Run(ctx context.Context) error {
 
 	db.SetRiverClient(riverClient)
 
-	pemBlock, _ := pem.Decode([]byte(s.cfg.APITokenSigningKey))
+	if s.cfg.DevMode {
+		u, err := dev.Seed(ctx, db)
+		if err != nil {
+			return fmt.Errorf("seed dev data: %w", err)
+		}
 
-	key, err := x509.ParseECPrivateKey(pemBlock.Bytes)
-	if err != nil {
-		return fmt.Errorf("parse ec private key: %w", err)
+		tok, err := user.NewAPIToken(keyRing, s.cfg.APITokenIssuer, dev.APITokenExpiry, u)
+		if err != nil {
+			return fmt.Errorf("dev api token: %w", err)
+		}
+
+		s.logger.InfoContext(
+			ctx,
+			"running in dev mode. set EXPLORER_API_TOKEN to the token below to use the cli",
+			"email", u.Email,
+			"token", string(tok),
+		)
 	}
 
 	validator, err := protovalidate.New()
@@ -184,25 +330,180 @@ func (s *Server) Run(ctx context.Context) error {
 		return fmt.Errorf("create validator: %w", err)
 	}
 
-	insService, err := instance.NewService(s.logger, db, db, db)
+	// log levels of the control plane are only changed at runtime,
+	// if the levels it has been started with are known.
+	var levelOverride *logging.Override
+	if s.cfg.LogLevels != nil {
+		levelOverride = logging.NewOverride(s.cfg.LogLevels, s.cfg.LogLevelSpec)
+	}
+
+	settingsService := settings.NewService(
+		s.logger.With("component", "settings-service"),
+		db,
+		s.cfg.AdminUserIDs,
+		levelOverride,
+		settings.Config{
+			CacheTTL: s.cfg.RuntimeSettingsCacheTTL,
+		},
+	)
+
+	insService, err := instance.NewService(
+		s.logger,
+		db,
+		db,
+		chunkRepo,
+		blobStore,
+		bus,
+		createWorkloadClient,
+		instance.Config{
+			PresignedURLExpiry:   s.cfg.PresignedURLExpiry,
+			SnapshotMaxSizeBytes: s.cfg.SnapshotMaxSizeBytes,
+			WatchResyncInterval:  s.cfg.InstanceWatchResyncInterval,
+			RedirectGracePeriod:  s.cfg.InstanceRedirectGracePeriod,
+			InstanceLogRetention: s.cfg.InstanceLogRetention,
+
+			PlacementWebhookURL:     s.cfg.PlacementWebhookURL,
+			PlacementWebhookTimeout: s.cfg.PlacementWebhookTimeout,
+
+			FeatureEnabled: func(ctx context.Context, flag resource.FeatureFlag) bool {
+				return settingsService.Current(ctx).Enabled(flag)
+			},
+		},
+	)
 	if err != nil {
 		return fmt.Errorf("instance service: %w", err)
 	}
 
+	if s.cfg.AutoscalerInterval > 0 {
+		scaler, err := autoscaler.New(
+			s.logger.With("component", "autoscaler"),
+			db,
+			chunkRepo,
+			insService,
+			autoscaler.Config{
+				Interval:     s.cfg.AutoscalerInterval,
+				DemandWindow: s.cfg.AutoscalerDemandWindow,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("autoscaler: %w", err)
+		}
+
+		// instances must only be started and stopped by a single
+		// replica, otherwise each replica would scale on its own.
+		elector.Register("autoscaler", scaler.Run)
+	}
+
+	if s.cfg.ScheduleInterval > 0 {
+		runner, err := schedule.New(
+			s.logger.With("component", "schedule"),
+			db,
+			insService,
+			schedule.Config{
+				Interval: s.cfg.ScheduleInterval,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("schedule runner: %w", err)
+		}
+
+		// like the autoscaler, a single replica has to evaluate the
+		// schedules, otherwise instances would be started multiple times.
+		elector.Register("schedules", runner.Run)
+	}
+
+	if s.cfg.NodeLostAfter > 0 {
+		detector, err := orphan.New(
+			s.logger.With("component", "orphan"),
+			db,
+			insService,
+			orphan.Config{
+				Interval:   s.cfg.LostInstanceCheckInterval,
+				LostAfter:  s.cfg.NodeLostAfter,
+				Reschedule: s.cfg.RescheduleLostInstances,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("orphan detector: %w", err)
+		}
+
+		// lost instances must only be rescheduled once.
+		elector.Register("orphans", detector.Run)
+	}
+
 	userService, err := user.NewService(
-		db,
+		userRepo,
 		oidcProvider,
 		s.cfg.OAuthClientID,
 		s.cfg.APITokenIssuer,
 		s.cfg.APITokenExpiry,
-		key,
+		keyRing,
+		s.cfg.AdminUserIDs,
+		blobStore,
+		bus,
+		user.Config{
+			PresignedURLExpiry:       s.cfg.PresignedURLExpiry,
+			ImpersonationMaxDuration: s.cfg.ImpersonationMaxDuration,
+		},
 	)
 	if err != nil {
 		return fmt.Errorf("user service: %w", err)
 	}
 
+	maintenanceService := maintenance.NewService(
+		s.logger.With("component", "maintenance-service"),
+		db,
+		s.cfg.AdminUserIDs,
+		maintenance.Config{
+			CacheTTL: s.cfg.MaintenanceCacheTTL,
+		},
+	)
+
+	rolloutService := rollout.NewService(
+		s.logger.With("component", "rollout-service"),
+		db,
+		s.cfg.AdminUserIDs,
+		rollout.Config{
+			ReleaseURL: s.cfg.PlatformdReleaseURL,
+		},
+	)
+
+	conditionService := condition.NewService(
+		s.logger.With("component", "condition-service"),
+		db,
+		s.cfg.AdminUserIDs,
+	)
+
+	costService := cost.NewService(
+		db,
+		authz.NewRuleEvaluator(db),
+		cost.Model{
+			CPUHourPrice:      s.cfg.CostCPUHourPrice,
+			MemoryGBHourPrice: s.cfg.CostMemoryGBHourPrice,
+			NodeHourPrice:     s.cfg.CostNodeHourPrice,
+		},
+		s.cfg.AdminUserIDs,
+	)
+
+	announcementService := announcement.NewService(
+		s.logger.With("component", "announcement-service"),
+		db,
+		s.cfg.AdminUserIDs,
+	)
+
+	integrationService := integration.NewService(
+		s.logger.With("component", "integration-service"),
+		db,
+	)
+
+	integrationTokens, err := secret.New(s.cfg.IntegrationTokens, s.cfg.IntegrationTokensFile)
+	if err != nil {
+		return fmt.Errorf("integration tokens: %w", err)
+	}
+
 	chunkService, err := chunk.NewService(
 		s.logger.With("component", "chunk-service"),
+		chunkRepo,
 		db,
 		db,
 		blobStore,
@@ -213,31 +514,77 @@ func (s *Server) Run(ctx context.Context) error {
 			PresignedURLExpiry:           s.cfg.PresignedURLExpiry,
 			ThumbnailMaxSizeKB:           s.cfg.ThumbnailMaxSizeKB,
 			ChangesetTarballMaxSizeBytes: s.cfg.ChangeSetTarballMaxSizeBytes,
+			PatchMaxFiles:                s.cfg.PatchMaxFiles,
+			PatchMaxSizeBytes:            s.cfg.PatchMaxSizeBytes,
+			AssetMaxSizeBytes:            s.cfg.AssetMaxSizeBytes,
+			MediaMaxSizeBytes:            s.cfg.MediaMaxSizeBytes,
+			MediaMaxCount:                s.cfg.MediaMaxCount,
+			ImportArchiveMaxSizeBytes:    s.cfg.ImportArchiveMaxSizeBytes,
+			BuildLimits:                  s.buildLimits(),
+			AutoscalingMaxInstances:      s.cfg.AutoscalingMaxInstances,
+			AdminUserIDs:                 s.cfg.AdminUserIDs,
+			StartupCommands:              s.cfg.FlavorStartupCommands,
 		})
 	if err != nil {
 		return fmt.Errorf("chunk service: %w", err)
 	}
 
 	var (
+		// v1alpha1 calls that have been carried over to v1beta1 are still
+		// served, but clients are told to migrate to the new version.
+		deprecated = deprecation.Replaced(
+			instancev1alpha1.InstanceService_ServiceDesc,
+			instancev1beta1.InstanceService_ServiceDesc,
+		)
+
 		grpcServer = grpc.NewServer(
 			grpc.Creds(insecure.NewCredentials()),
+			grpc.MaxRecvMsgSize(cmp.Or(s.cfg.MaxRequestSizeBytes, defaultMaxRequestSizeBytes)),
+			grpc.KeepaliveEnforcementPolicy(grpcclient.KeepaliveEnforcementPolicy),
 			grpc.StatsHandler(otelgrpc.NewServerHandler()),
 			grpc.ChainUnaryInterceptor(
+				logging.UnaryServerInterceptor(),
+				timeoutInterceptor(s.cfg.RequestTimeout),
 				protovalidatemw.UnaryServerInterceptor(validator),
 				errorInterceptor(s.logger),
-				authInterceptor(s.logger, key, s.cfg.APITokenIssuer),
+				authInterceptor(s.logger, keyRing, s.cfg.APITokenIssuer, userRepo, userRepo),
+				integrationAuthInterceptor(integrationTokens),
+				maintenanceInterceptor(maintenanceService.Check),
 				traceParentInterceptor(s.logger),
+				deprecation.UnaryServerInterceptor(deprecated),
+			),
+			// streaming endpoints are only used by nodes and do not
+			// need authn/authz (as of now), see authInterceptor.
+			grpc.ChainStreamInterceptor(
+				logging.StreamServerInterceptor(),
+				protovalidatemw.StreamServerInterceptor(validator),
+				streamErrorInterceptor(s.logger),
+				deprecation.StreamServerInterceptor(deprecated),
 			),
 		)
 
 		userServer  = user.NewServer(userService)
-		chunkServer = chunk.NewServer(chunkService)
-		insServer   = instance.NewServer(insService)
+		chunkServer = chunk.NewServer(chunkService, costService)
+		insServer   = instance.NewServer(insService, rolloutService, conditionService, settingsService)
+		adminServer = maintenance.NewServer(
+			maintenanceService,
+			rolloutService,
+			conditionService,
+			chunkService,
+			costService,
+			settingsService,
+		)
+		intServer = integration.NewServer(integrationService)
+		annServer = announcement.NewServer(announcementService)
 	)
 
 	instancev1alpha1.RegisterInstanceServiceServer(grpcServer, insServer)
+	instancev1beta1.RegisterInstanceServiceServer(grpcServer, instance.NewBetaServer(insServer))
 	chunkv1alpha1.RegisterChunkServiceServer(grpcServer, chunkServer)
 	userv1alpha1.RegisterUserServiceServer(grpcServer, userServer)
+	adminv1alpha1.RegisterAdminServiceServer(grpcServer, adminServer)
+	integrationv1alpha1.RegisterIntegrationServiceServer(grpcServer, intServer)
+	announcementv1alpha1.RegisterAnnouncementServiceServer(grpcServer, annServer)
 
 	if err := riverClient.Start(ctx); err != nil {
 		return fmt.Errorf("start river client: %w", err)
@@ -248,7 +595,21 @@ func (s *Server) Run(ctx context.Context) error {
 		return fmt.Errorf("listen: %w", err)
 	}
 
+	electionCtx, stopElection := context.WithCancel(ctx)
+
 	g := multierror.Group{}
+	g.Go(func() error {
+		elector.Run(electionCtx)
+		return nil
+	})
+	g.Go(func() error {
+		keyRing.Run(electionCtx)
+		return nil
+	})
+	g.Go(func() error {
+		settingsService.Run(electionCtx)
+		return nil
+	})
 	g.Go(func() error {
 		if err := grpcServer.Serve(lis); err != nil {
 			s.Stop()
@@ -257,11 +618,171 @@ func (s *Server) Run(ctx context.Context) error {
 		return nil
 	})
 
+	// the filesystem backend has no service clients could upload
+	// to directly, so we have to serve the upload endpoint ourselves.
+	var uploadServer *http.Server
+	if fsStore, ok := blobStore.(*blob.FilesystemStore); ok {
+		uploadServer = &http.Server{
+			Addr:              s.cfg.StorageUploadListenAddr,
+			Handler:           fsStore.UploadHandler(),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		g.Go(func() error {
+			if err := uploadServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.Stop()
+				return fmt.Errorf("failed to serve upload server: %w", err)
+			}
+			return nil
+		})
+	}
+
+	var jwksServer *http.Server
+	if s.cfg.JWKSListenAddr != "" {
+		jwksServer = &http.Server{
+			Addr:              s.cfg.JWKSListenAddr,
+			Handler:           keyRing.JWKSHandler(s.logger.With("component", "jwks-server")),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		g.Go(func() error {
+			if err := jwksServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.Stop()
+				return fmt.Errorf("failed to serve jwks server: %w", err)
+			}
+			return nil
+		})
+	}
+
+	if pprofServer != nil {
+		g.Go(func() error {
+			if err := pprofServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.Stop()
+				return fmt.Errorf("failed to serve pprof server: %w", err)
+			}
+			return nil
+		})
+	}
+
+	// streams are long-lived, so they are ended by canceling
+	// their context before shutting down the events server.
+	eventsCtx, stopEvents := context.WithCancel(ctx)
+	defer stopEvents()
+
+	auth := func(ctx context.Context, token string) (string, error) {
+		_, userID, err := authenticateToken(ctx, s.logger, keyRing, s.cfg.APITokenIssuer, userRepo, token)
+		return userID, err
+	}
+
+	var eventsServer *http.Server
+	if s.cfg.EventsListenAddr != "" {
+		eventsServer = &http.Server{
+			Addr: s.cfg.EventsListenAddr,
+			Handler: instance.EventsHandler(
+				s.logger.With("component", "events-server"),
+				insService,
+				auth,
+				instance.EventsConfig{
+					HeartbeatInterval: s.cfg.EventsHeartbeatInterval,
+					AllowedOrigins:    s.cfg.EventsAllowedOrigins,
+				},
+			),
+			ReadHeaderTimeout: 10 * time.Second,
+			BaseContext: func(net.Listener) context.Context {
+				return eventsCtx
+			},
+		}
+		g.Go(func() error {
+			if err := eventsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.Stop()
+				return fmt.Errorf("failed to serve events server: %w", err)
+			}
+			return nil
+		})
+	}
+
+	var webDAVServer *http.Server
+	if s.cfg.WebDAVListenAddr != "" {
+		webDAVServer = &http.Server{
+			Addr:              s.cfg.WebDAVListenAddr,
+			Handler:           chunk.WebDAVHandler(s.logger.With("component", "webdav-server"), chunkService, auth),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		g.Go(func() error {
+			if err := webDAVServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.Stop()
+				return fmt.Errorf("failed to serve webdav server: %w", err)
+			}
+			return nil
+		})
+	}
+
+	// the status server is meant to be exposed publicly,
+	// so it is only started if explicitly configured.
+	var statusServer *statuspage.Server
+	if s.cfg.StatusListenAddr != "" {
+		statusServer = statuspage.NewServer(
+			s.logger.With("component", "status-server"),
+			db,
+			statuspage.Config{
+				ListenAddr:    s.cfg.StatusListenAddr,
+				CacheTTL:      s.cfg.StatusCacheTTL,
+				NodeTimeout:   s.cfg.StatusNodeTimeout,
+				IncidentsFile: s.cfg.StatusIncidentsFile,
+				RateLimit:     s.cfg.StatusRateLimit,
+				RateBurst:     s.cfg.StatusRateBurst,
+			},
+		)
+		g.Go(func() error {
+			if err := statusServer.Run(); err != nil {
+				s.Stop()
+				return fmt.Errorf("failed to serve status server: %w", err)
+			}
+			return nil
+		})
+	}
+
 	<-s.stopCh
 
 	// add stop-related code below
 
 	grpcServer.GracefulStop()
+	stopElection()
+
+	if uploadServer != nil {
+		if err := uploadServer.Shutdown(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "failed to stop upload server", "err", err)
+		}
+	}
+
+	if jwksServer != nil {
+		if err := jwksServer.Shutdown(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "failed to stop jwks server", "err", err)
+		}
+	}
+
+	if pprofServer != nil {
+		if err := pprofServer.Shutdown(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "failed to stop pprof server", "err", err)
+		}
+	}
+
+	if eventsServer != nil {
+		stopEvents()
+		if err := eventsServer.Shutdown(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "failed to stop events server", "err", err)
+		}
+	}
+
+	if webDAVServer != nil {
+		if err := webDAVServer.Shutdown(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "failed to stop webdav server", "err", err)
+		}
+	}
+
+	if statusServer != nil {
+		if err := statusServer.Shutdown(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "failed to stop status server", "err", err)
+		}
+	}
 
 	if err := riverClient.Stop(ctx); err != nil {
 		s.logger.ErrorContext(ctx, "failed to stop river client", "err", err)
@@ -274,7 +795,249 @@ func (s *Server) Stop() {
 	s.stopCh <- struct{}{}
 }
 
-func authInterceptor(logger *slog.Logger, signingKey *ecdsa.PrivateKey, issuer string) grpc.UnaryServerInterceptor {
+func (s *Server) buildLimits() chunk.BuildLimits {
+	return chunk.BuildLimits{
+		MaxFileCount:      s.cfg.FlavorMaxFileCount,
+		MaxTotalSizeBytes: s.cfg.FlavorMaxSizeBytes,
+		MaxLayerSizeBytes: s.cfg.ImageMaxLayerSizeBytes,
+		MaxFileSizeBytes:  s.cfg.FlavorMaxFileSizeBytes,
+	}
+}
+
+// notificationSender returns the sender used to deliver notifications.
+// if no mail server has been configured, notifications are only logged.
+func (s *Server) notificationSender() (notification.Sender, error) {
+	if s.cfg.NotificationSMTPAddr == "" {
+		return notification.LogSender{
+			Logger: s.logger.With("component", "notification-sender"),
+		}, nil
+	}
+
+	pass, err := secret.New(s.cfg.NotificationSMTPPass, s.cfg.NotificationSMTPPassFile)
+	if err != nil {
+		return nil, fmt.Errorf("smtp password: %w", err)
+	}
+
+	return notification.NewSMTPSender(notification.SMTPConfig{
+		Addr:     s.cfg.NotificationSMTPAddr,
+		Username: s.cfg.NotificationSMTPUser,
+		Password: pass,
+		From:     s.cfg.NotificationFrom,
+	}), nil
+}
+
+// s3Credentials returns the credentials used to access the bucket. if they are
+// read from files, they expire after a minute, so the sdk retrieves them again
+// and picks up rotated keys.
+func (s *Server) s3Credentials() (aws.CredentialsProvider, error) {
+	if s.cfg.AccessKeyFile == "" && s.cfg.SecretKeyFile == "" {
+		return credentials.NewStaticCredentialsProvider(s.cfg.AccessKey, s.cfg.SecretKey, ""), nil
+	}
+
+	accessKey, err := secret.New(s.cfg.AccessKey, s.cfg.AccessKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("access key: %w", err)
+	}
+
+	secretKey, err := secret.New(s.cfg.SecretKey, s.cfg.SecretKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("secret key: %w", err)
+	}
+
+	return aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		id, err := accessKey.Value()
+		if err != nil {
+			return aws.Credentials{}, fmt.Errorf("access key: %w", err)
+		}
+
+		key, err := secretKey.Value()
+		if err != nil {
+			return aws.Credentials{}, fmt.Errorf("secret key: %w", err)
+		}
+
+		return aws.Credentials{
+			AccessKeyID:     id,
+			SecretAccessKey: key,
+			CanExpire:       true,
+			Expires:         time.Now().Add(time.Minute),
+		}, nil
+	}), nil
+}
+
+func (s *Server) createBlobStore(ctx context.Context) (blob.Store, error) {
+	switch blob.Backend(s.cfg.StorageBackend) {
+	case blob.BackendS3, "":
+		creds, err := s.s3Credentials()
+		if err != nil {
+			return nil, fmt.Errorf("s3 credentials: %w", err)
+		}
+
+		s3cfg, err := awscfg.LoadDefaultConfig(ctx, awscfg.WithCredentialsProvider(creds))
+		if err != nil {
+			return nil, fmt.Errorf("aws config: %w", err)
+		}
+
+		s3client := s3.NewFromConfig(s3cfg, func(o *s3.Options) {
+			o.UsePathStyle = s.cfg.UsePathStyle
+		})
+
+		storeCfg := blob.S3Config{
+			ServerSideEncryption: types.ServerSideEncryption(s.cfg.S3ServerSideEncryption),
+			KMSKeyID:             s.cfg.S3KMSKeyID,
+			StorageClass:         types.StorageClass(s.cfg.S3StorageClass),
+		}
+
+		if err := storeCfg.Validate(); err != nil {
+			return nil, fmt.Errorf("s3 config: %w", err)
+		}
+
+		store := blob.NewS3Store(s.cfg.Bucket, s3client, s3.NewPresignClient(s3client), storeCfg)
+
+		// lifecycle rules are only touched if configured, because the
+		// credentials are not required to be able to manage them otherwise.
+		if s.cfg.ChangeSetTransitionDays > 0 || s.cfg.ChangeSetExpirationDays > 0 {
+			if err := store.ApplyLifecycleRules(ctx, []blob.LifecycleRule{
+				{
+					ID:                     "explorer-changesets",
+					Prefix:                 blob.ChangeSetKeyPrefix,
+					TransitionDays:         s.cfg.ChangeSetTransitionDays,
+					TransitionStorageClass: types.TransitionStorageClass(s.cfg.ChangeSetTransitionClass),
+					ExpirationDays:         s.cfg.ChangeSetExpirationDays,
+				},
+			}); err != nil {
+				return nil, fmt.Errorf("apply lifecycle rules: %w", err)
+			}
+		}
+
+		return store, nil
+	case blob.BackendGCS:
+		var opts []option.ClientOption
+		if s.cfg.GCSCredentialsFile != "" {
+			opts = append(opts, option.WithAuthCredentialsFile(option.ServiceAccount, s.cfg.GCSCredentialsFile))
+		}
+
+		client, err := storage.NewClient(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("gcs client: %w", err)
+		}
+
+		return blob.NewGCSStore(s.cfg.Bucket, client), nil
+	case blob.BackendAzure:
+		cred, err := azblob.NewSharedKeyCredential(s.cfg.AzureAccountName, s.cfg.AzureAccountKey)
+		if err != nil {
+			return nil, fmt.Errorf("azure credential: %w", err)
+		}
+
+		serviceURL := s.cfg.AzureServiceURL
+		if serviceURL == "" {
+			serviceURL = fmt.Sprintf("https://%s.blob.core.windows.net/", s.cfg.AzureAccountName)
+		}
+
+		client, err := azblob.NewClientWithSharedKeyCredential(serviceURL, cred, nil)
+		if err != nil {
+			return nil, fmt.Errorf("azure client: %w", err)
+		}
+
+		return blob.NewAzureStore(s.cfg.Bucket, client), nil
+	case blob.BackendFilesystem:
+		store, err := blob.NewFilesystemStore(
+			s.cfg.StorageDir,
+			s.cfg.StorageUploadPublicURL,
+			s.cfg.StorageUploadSecret,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("filesystem store: %w", err)
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unsupported storage backend: %s", s.cfg.StorageBackend)
+	}
+}
+
+// poolConfig creates the pgx pool configuration. settings that are
+// not configured keep the defaults of pgx or the connection string.
+func poolConfig(cfg Config) (*pgxpool.Config, error) {
+	pgxCfg, err := pgxpool.ParseConfig(cfg.DBConnString)
+	if err != nil {
+		return nil, err
+	}
+
+	pgxCfg.ConnConfig.Tracer = otelpgx.NewTracer()
+
+	if cfg.DBConnStringFile != "" {
+		dsnFile, err := secret.NewFile(cfg.DBConnStringFile)
+		if err != nil {
+			return nil, fmt.Errorf("connection string: %w", err)
+		}
+
+		// re-read the credentials for every new connection, so rotated
+		// passwords are used once existing connections have been replaced.
+		pgxCfg.BeforeConnect = func(_ context.Context, connCfg *pgx.ConnConfig) error {
+			dsn, err := dsnFile.Value()
+			if err != nil {
+				return fmt.Errorf("connection string: %w", err)
+			}
+
+			parsed, err := pgx.ParseConfig(dsn)
+			if err != nil {
+				return fmt.Errorf("parse connection string: %w", err)
+			}
+
+			connCfg.User = parsed.User
+			connCfg.Password = parsed.Password
+			return nil
+		}
+	}
+
+	if cfg.DBMaxConns > 0 {
+		pgxCfg.MaxConns = cfg.DBMaxConns
+	}
+
+	if cfg.DBMinConns > 0 {
+		pgxCfg.MinConns = cfg.DBMinConns
+	}
+
+	if cfg.DBMaxConnLifetime > 0 {
+		pgxCfg.MaxConnLifetime = cfg.DBMaxConnLifetime
+	}
+
+	if cfg.DBMaxConnIdleTime > 0 {
+		pgxCfg.MaxConnIdleTime = cfg.DBMaxConnIdleTime
+	}
+
+	// enforced by postgres itself, so a slow query is aborted
+	// even if the caller does not cancel its context.
+	if cfg.DBStatementTimeout > 0 {
+		pgxCfg.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.DBStatementTimeout.Milliseconds(), 10)
+	}
+
+	return pgxCfg, nil
+}
+
+// timeoutInterceptor sets a deadline on requests that do not already
+// have one. the deadline is passed down to all database queries, so
+// waiting for a connection from the pool or running a query does not
+// block the request indefinitely.
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+			return handler(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return handler(ctx, req)
+	}
+}
+
+func authInterceptor(
+	logger *slog.Logger,
+	keys jws.KeyProvider,
+	issuer string,
+	revocations user.RevocationChecker,
+	impersonations user.ImpersonationRecorder,
+) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		// these endpoints do not need authn/authz (as of now)
 		if strings.HasSuffix(info.FullMethod, "UserService/Register") ||
@@ -282,7 +1045,19 @@ func authInterceptor(logger *slog.Logger, signingKey *ecdsa.PrivateKey, issuer s
 			strings.HasSuffix(info.FullMethod, "InstanceService/GetInstance") ||
 			strings.HasSuffix(info.FullMethod, "InstanceService/ListInstances") ||
 			strings.HasSuffix(info.FullMethod, "InstanceService/DiscoverInstances") ||
-			strings.HasSuffix(info.FullMethod, "InstanceService/ReceiveInstanceStatusReports") {
+			strings.HasSuffix(info.FullMethod, "InstanceService/GetInstanceRedirects") ||
+			strings.HasSuffix(info.FullMethod, "InstanceService/ReceiveInstanceStatusReports") ||
+			strings.HasSuffix(info.FullMethod, "InstanceService/ReceiveInstanceLogs") ||
+			strings.HasSuffix(info.FullMethod, "InstanceService/GetPlatformdUpdate") ||
+			strings.HasSuffix(info.FullMethod, "InstanceService/ReportPlatformdUpdateStatus") ||
+			strings.HasSuffix(info.FullMethod, "InstanceService/ReportNodeConditions") ||
+			strings.HasSuffix(info.FullMethod, "AnnouncementService/ListAnnouncements") {
+			return handler(ctx, req)
+		}
+
+		// proxy plugins authenticate using integration tokens instead,
+		// see integrationAuthInterceptor.
+		if strings.Contains(info.FullMethod, "IntegrationService/") {
 			return handler(ctx, req)
 		}
 
@@ -296,25 +1071,141 @@ func authInterceptor(logger *slog.Logger, signingKey *ecdsa.PrivateKey, issuer s
 			return nil, cperrs.ErrAuthHeaderMissing
 		}
 
-		tok, err := jwt.Parse([]byte(vals[0]), jwt.WithKey(jwa.ES256(), signingKey))
+		tok, userID, err := authenticateToken(ctx, logger, keys, issuer, revocations, vals[0])
 		if err != nil {
-			logger.Error("failed to parse token", "err", err)
-			return nil, cperrs.ErrInvalidToken
+			return nil, err
+		}
+
+		ctx = context.WithValue(ctx, contextkey.ActorID, userID)
+		ctx = context.WithValue(ctx, contextkey.APIToken, tok)
+
+		// calls made by admins impersonating a user are recorded before they
+		// are handled, so the user can see everything done on their behalf.
+		if impersonationID, err := jwt.Get[string](tok, user.ImpersonationIDClaim); err == nil {
+			impersonatorID, _ := jwt.Get[string](tok, user.ImpersonatorIDClaim)
+
+			if err := impersonations.RecordImpersonatedAction(ctx, impersonationID, info.FullMethod); err != nil {
+				return nil, fmt.Errorf("record impersonated action: %w", err)
+			}
+
+			logger.InfoContext(
+				ctx,
+				"impersonated call",
+				"method", info.FullMethod,
+				"user_id", userID,
+				"impersonator_id", impersonatorID,
+				"impersonation_id", impersonationID,
+			)
+
+			ctx = context.WithValue(ctx, contextkey.ImpersonatorID, impersonatorID)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// authenticateToken validates the given api token and returns it
+// together with the id of the user it has been issued to.
+func authenticateToken(
+	ctx context.Context,
+	logger *slog.Logger,
+	keys jws.KeyProvider,
+	issuer string,
+	revocations user.RevocationChecker,
+	raw string,
+) (jwt.Token, string, error) {
+	tok, err := jwt.Parse([]byte(raw), jwt.WithKeyProvider(keys))
+	if err != nil {
+		logger.Error("failed to parse token", "err", err)
+		return nil, "", cperrs.ErrInvalidToken
+	}
+
+	if err := jwt.Validate(tok, jwt.WithIssuer(issuer), jwt.WithAudience(issuer)); err != nil {
+		logger.Error("failed to validate token", "err", err)
+		return nil, "", cperrs.ErrInvalidToken
+	}
+
+	var userID string
+	userID, err = jwt.Get[string](tok, "user_id")
+	if err != nil {
+		logger.Error("failed to get user id", "err", err)
+		return nil, "", cperrs.ErrInvalidToken
+	}
+
+	var (
+		tokenID, _  = tok.JwtID()
+		issuedAt, _ = tok.IssuedAt()
+	)
+
+	revoked, err := revocations.TokenRevoked(ctx, tokenID, userID, issuedAt)
+	if err != nil {
+		return nil, "", fmt.Errorf("check token revocation: %w", err)
+	}
+
+	if revoked {
+		return nil, "", cperrs.ErrInvalidToken
+	}
+
+	return tok, userID, nil
+}
+
+// integrationAuthInterceptor makes sure requests to the integration api
+// carry one of the configured integration tokens. tokens are separated
+// by commas or newlines, so they can be rotated by adding the new token
+// before removing the old one. if no tokens are configured, all requests
+// are rejected.
+func integrationAuthInterceptor(tokens secret.Source) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.Contains(info.FullMethod, "IntegrationService/") {
+			return handler(ctx, req)
 		}
 
-		if err := jwt.Validate(tok, jwt.WithIssuer(issuer), jwt.WithAudience(issuer)); err != nil {
-			logger.Error("failed to validate token", "err", err)
-			return nil, cperrs.ErrInvalidToken
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing metadata")
+		}
+
+		vals := md.Get("authorization")
+		if len(vals) == 0 {
+			return nil, cperrs.ErrAuthHeaderMissing
 		}
 
-		var userID string
-		userID, err = jwt.Get[string](tok, "user_id")
+		val, err := tokens.Value()
 		if err != nil {
-			logger.Error("failed to get user id", "err", err)
-			return nil, cperrs.ErrInvalidToken
+			return nil, fmt.Errorf("integration tokens: %w", err)
 		}
 
-		ctx = context.WithValue(ctx, contextkey.ActorID, userID)
+		for tok := range strings.FieldsFuncSeq(val, func(r rune) bool {
+			return r == ',' || r == '\n'
+		}) {
+			tok = strings.TrimSpace(tok)
+			if tok == "" {
+				continue
+			}
+
+			if subtle.ConstantTimeCompare([]byte(tok), []byte(vals[0])) == 1 {
+				return handler(ctx, req)
+			}
+		}
+
+		return nil, cperrs.ErrInvalidToken
+	}
+}
+
+// maintenanceInterceptor rejects requests that would create new work for the
+// platform, while the maintenance mode is enabled. requests made by nodes,
+// like status reports, are still served, so instances can be removed.
+func maintenanceInterceptor(check func(context.Context) error) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if info.FullMethod != instancev1alpha1.InstanceService_RunFlavorVersion_FullMethodName &&
+			info.FullMethod != instancev1beta1.InstanceService_RunFlavorVersion_FullMethodName &&
+			info.FullMethod != chunkv1alpha1.ChunkService_BuildFlavorVersion_FullMethodName {
+			return handler(ctx, req)
+		}
+
+		if err := check(ctx); err != nil {
+			return nil, err
+		}
 
 		return handler(ctx, req)
 	}
@@ -341,6 +1232,28 @@ func errorInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
+func streamErrorInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err == nil {
+			return nil
+		}
+
+		if e, ok := errors.AsType[cperrs.Error](err); ok {
+			return e.GRPCStatus().Err()
+		}
+
+		logger.ErrorContext(
+			ss.Context(),
+			"internal service error occurred",
+			"method", info.FullMethod,
+			"err", err,
+		)
+
+		return status.Error(codes.Internal, "internal service error occurred")
+	}
+}
+
 func traceParentInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		spanCtx := trace.SpanFromContext(ctx).SpanContext()
@@ -375,42 +1288,104 @@ func CreateRiverClient(
 	logger *slog.Logger,
 	chunkRepo chunk.Repository,
 	imgService image.Service,
-	blobStore blob.S3Store,
+	blobStore blob.Store,
 	pool *pgxpool.Pool,
 	nodeRepo node.Repository,
 	jobClient job.Client,
 	packBuildInterval time.Duration,
 	archiveInterval time.Duration,
+	instanceCleanupInterval time.Duration,
+	instanceCleanupPolicies []instance.CleanupPolicy,
+	retentionInterval time.Duration,
+	partitionInterval time.Duration,
+	registry string,
 	imgWorkerCfg worker.CreateImageWorkerConfig,
 	checkWorkerCfg worker.CreateCheckpointWorkerConfig,
 	packWorkerCfg worker.CreateResourcePackWorkerConfig,
+	importWorkerCfg worker.ImportChunkWorkerConfig,
+	partitionWorkerCfg worker.PartitionWorkerConfig,
 	insRepo instance.Repository,
 	archiveRepo chunk.ArchiveRepository,
+	notificationRepo notification.Repository,
+	partitionRepo partition.Repository,
+	notificationSender notification.Sender,
+	keyRing *signing.KeyRing,
+	stubBuilds bool,
 ) (*river.Client[pgx.Tx], error) {
-	workers := river.NewWorkers()
-
-	imgWorker := worker.NewCreateImageWorker(
-		logger.With("component", "image-worker"),
-		chunkRepo,
-		imgService,
-		jobClient,
-		blobStore,
-		imgWorkerCfg,
+	var (
+		imgWorker   river.Worker[job.CreateImage]
+		checkWorker river.Worker[job.CreateCheckpoint]
+		workers     = river.NewWorkers()
+		periodic    = []*river.PeriodicJob{
+			river.NewPeriodicJob(river.PeriodicInterval(archiveInterval), func() (river.JobArgs, *river.InsertOpts) {
+				return job.Archive{}, nil
+			}, &river.PeriodicJobOpts{RunOnStart: true}),
+			river.NewPeriodicJob(
+				river.PeriodicInterval(instanceCleanupInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return job.CleanupInstances{}, nil
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+			river.NewPeriodicJob(
+				river.PeriodicInterval(instanceCleanupInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return job.ExpireInstanceLogs{}, nil
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+			river.NewPeriodicJob(
+				river.PeriodicInterval(retentionInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return job.EnforceRetention{}, nil
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+			river.NewPeriodicJob(
+				river.PeriodicInterval(partitionInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return job.MaintainPartitions{}, nil
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+		}
 	)
 
-	if err := river.AddWorkerSafely[job.CreateImage](workers, imgWorker); err != nil {
-		return nil, fmt.Errorf("add create image worker: %w", err)
+	if stubBuilds {
+		imgWorker = worker.NewStubCreateImageWorker(logger.With("component", "image-worker"), jobClient)
+		checkWorker = worker.NewStubCreateCheckpointWorker(logger.With("component", "checkpoint-worker"), chunkRepo)
+	} else {
+		imgWorker = worker.NewCreateImageWorker(
+			logger.With("component", "image-worker"),
+			chunkRepo,
+			imgService,
+			jobClient,
+			blobStore,
+			imgWorkerCfg,
+		)
+		checkWorker = worker.NewCheckpointWorker(
+			logger.With("component", "checkpoint-worker"),
+			createCheckpointClient,
+			nodeRepo,
+			chunkRepo,
+			checkWorkerCfg,
+		)
+		// building the resource pack requires a pack template to be present
+		// in the blob store, which is not the case when running with stubs.
+		periodic = append(periodic, river.NewPeriodicJob(
+			river.PeriodicInterval(packBuildInterval),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return job.CreateResourcePack{}, nil
+			},
+			&river.PeriodicJobOpts{RunOnStart: true},
+		))
 	}
 
-	checkWorker := worker.NewCheckpointWorker(
-		logger.With("component", "checkpoint-worker"),
-		createCheckpointClient,
-		nodeRepo,
-		chunkRepo,
-		checkWorkerCfg,
-	)
+	if err := river.AddWorkerSafely(workers, imgWorker); err != nil {
+		return nil, fmt.Errorf("add create image worker: %w", err)
+	}
 
-	if err := river.AddWorkerSafely[job.CreateCheckpoint](workers, checkWorker); err != nil {
+	if err := river.AddWorkerSafely(workers, checkWorker); err != nil {
 		return nil, fmt.Errorf("add create checkpoint worker: %w", err)
 	}
 
@@ -436,23 +1411,101 @@ func CreateRiverClient(
 		return nil, fmt.Errorf("add create archive worker: %w", err)
 	}
 
+	importWorker := worker.NewImportChunkWorker(
+		logger.With("component", "import-chunk-worker"),
+		chunkRepo,
+		blobStore,
+		jobClient,
+		importWorkerCfg,
+	)
+
+	if err := river.AddWorkerSafely[job.ImportChunk](workers, importWorker); err != nil {
+		return nil, fmt.Errorf("add import chunk worker: %w", err)
+	}
+
+	retentionWorker := worker.NewRetentionWorker(
+		logger.With("component", "retention-worker"),
+		chunkRepo,
+		insRepo,
+		archiveRepo,
+		imgService,
+		registry,
+	)
+
+	if err := river.AddWorkerSafely[job.EnforceRetention](workers, retentionWorker); err != nil {
+		return nil, fmt.Errorf("add retention worker: %w", err)
+	}
+
+	cleanupWorker, err := worker.NewInstanceCleanupWorker(
+		logger.With("component", "instance-cleanup-worker"),
+		insRepo,
+		instanceCleanupPolicies,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create instance cleanup worker: %w", err)
+	}
+
+	if err := river.AddWorkerSafely[job.CleanupInstances](workers, cleanupWorker); err != nil {
+		return nil, fmt.Errorf("add instance cleanup worker: %w", err)
+	}
+
+	logRetentionWorker := worker.NewInstanceLogRetentionWorker(
+		logger.With("component", "instance-log-retention-worker"),
+		insRepo,
+		blobStore,
+	)
+
+	if err := river.AddWorkerSafely[job.ExpireInstanceLogs](workers, logRetentionWorker); err != nil {
+		return nil, fmt.Errorf("add instance log retention worker: %w", err)
+	}
+
+	partitionWorker := worker.NewPartitionWorker(
+		logger.With("component", "partition-worker"),
+		partitionRepo,
+		partitionWorkerCfg,
+	)
+
+	if err := river.AddWorkerSafely[job.MaintainPartitions](workers, partitionWorker); err != nil {
+		return nil, fmt.Errorf("add partition worker: %w", err)
+	}
+
+	notificationWorker := worker.NewSendNotificationWorker(
+		logger.With("component", "notification-worker"),
+		notificationRepo,
+		notificationSender,
+	)
+
+	if err := river.AddWorkerSafely[job.SendNotification](workers, notificationWorker); err != nil {
+		return nil, fmt.Errorf("add send notification worker: %w", err)
+	}
+
+	if keyRing != nil && keyRing.RotationEnabled() {
+		if err := river.AddWorkerSafely[job.RotateSigningKey](
+			workers,
+			worker.NewRotateSigningKeyWorker(keyRing),
+		); err != nil {
+			return nil, fmt.Errorf("add rotate signing key worker: %w", err)
+		}
+
+		periodic = append(periodic, river.NewPeriodicJob(
+			river.PeriodicInterval(keyRing.RefreshInterval()),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return job.RotateSigningKey{}, nil
+			},
+			&river.PeriodicJobOpts{RunOnStart: true},
+		))
+	}
+
 	riverClient, err := river.NewClient(riverpgxv5.New(pool), &river.Config{
 		Queues: map[string]river.QueueConfig{
 			river.QueueDefault: {
 				MaxWorkers: 10,
 			},
 		},
-		PeriodicJobs: []*river.PeriodicJob{
-			river.NewPeriodicJob(river.PeriodicInterval(packBuildInterval), func() (river.JobArgs, *river.InsertOpts) {
-				return job.CreateResourcePack{}, nil
-			}, &river.PeriodicJobOpts{RunOnStart: true}),
-			river.NewPeriodicJob(river.PeriodicInterval(archiveInterval), func() (river.JobArgs, *river.InsertOpts) {
-				return job.Archive{}, nil
-			}, &river.PeriodicJobOpts{RunOnStart: true}),
-		},
-		Workers:     workers,
-		Logger:      logger.With("component", "river"),
-		MaxAttempts: 5, // TODO: configurable
+		PeriodicJobs: periodic,
+		Workers:      workers,
+		Logger:       logger.With("component", "river"),
+		MaxAttempts:  5, // TODO: configurable
 		RetryPolicy: &fixedRetryPolicy{
 			delay: time.Second * 5, // TODO: configurable
 		},
@@ -476,3 +1529,11 @@ func createCheckpointClient(host string) (checkpointv1alpha1.CheckpointServiceCl
 	}
 	return checkpointv1alpha1.NewCheckpointServiceClient(conn), nil
 }
+
+func createWorkloadClient(host string) (workloadv1alpha2.WorkloadServiceClient, error) {
+	conn, err := grpc.NewClient(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return workloadv1alpha2.NewWorkloadServiceClient(conn), nil
+}
@@ -0,0 +1,50 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package settings
+
+import (
+	"context"
+
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+type Repository interface {
+	// RuntimeSettings returns the persisted runtime settings. settings
+	// that have never been changed are missing from the result.
+	RuntimeSettings(ctx context.Context) (resource.RuntimeSettings, error)
+
+	// SetLogLevels stores the log levels of the target and records the change.
+	SetLogLevels(
+		ctx context.Context,
+		target resource.LogTarget,
+		spec string,
+		actorID string,
+	) (resource.RuntimeSettings, error)
+
+	// SetFeatureFlag stores whether the feature is enabled and records the change.
+	SetFeatureFlag(
+		ctx context.Context,
+		flag resource.FeatureFlag,
+		enabled bool,
+		actorID string,
+	) (resource.RuntimeSettings, error)
+
+	// RuntimeSettingChanges returns the latest changes, newest first.
+	RuntimeSettingChanges(ctx context.Context, limit int) ([]resource.RuntimeSettingChange, error)
+}
@@ -0,0 +1,259 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package settings
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// MaxRuntimeSettingChanges is the maximum number of changes returned.
+const MaxRuntimeSettingChanges = 100
+
+// DefaultCacheTTL is used if Config.CacheTTL is not set.
+const DefaultCacheTTL = 5 * time.Second
+
+type Service interface {
+	// Settings returns the runtime settings. only admins may query them.
+	Settings(ctx context.Context, actorID string) (resource.RuntimeSettings, error)
+
+	// SetLogLevels changes the log levels of the target. spec is in the
+	// format of the log-level flag. an empty spec restores the levels
+	// the target has been started with.
+	SetLogLevels(
+		ctx context.Context,
+		actorID string,
+		target resource.LogTarget,
+		spec string,
+	) (resource.RuntimeSettings, error)
+
+	SetFeatureFlag(
+		ctx context.Context,
+		actorID string,
+		flag resource.FeatureFlag,
+		enabled bool,
+	) (resource.RuntimeSettings, error)
+
+	// ListChanges returns the latest changes of the runtime settings, newest first.
+	ListChanges(ctx context.Context, actorID string) ([]resource.RuntimeSettingChange, error)
+
+	// Current returns the cached runtime settings, which are used to
+	// check feature flags and sent to nodes. if they cannot be queried,
+	// the last known settings are used, or the defaults if there are none.
+	Current(ctx context.Context) resource.RuntimeSettings
+
+	// Run applies the log levels of the control plane every time the
+	// cache expires, so changes made through other replicas apply as
+	// well. it blocks until ctx is done.
+	Run(ctx context.Context)
+}
+
+type Config struct {
+	// CacheTTL is how long the runtime settings are cached before they
+	// are queried again. changes made through other replicas apply after
+	// this long.
+	CacheTTL time.Duration
+}
+
+type svc struct {
+	logger       *slog.Logger
+	repo         Repository
+	adminUserIDs []string
+	levels       *logging.Override
+	cfg          Config
+
+	mu       sync.Mutex
+	cached   *resource.RuntimeSettings
+	cachedAt time.Time
+}
+
+// NewService creates the settings service. the log levels of the control
+// plane are applied to levels, which may be nil if they should not be changed.
+func NewService(
+	logger *slog.Logger,
+	repo Repository,
+	adminUserIDs []string,
+	levels *logging.Override,
+	cfg Config,
+) Service {
+	return &svc{
+		logger:       logger,
+		repo:         repo,
+		adminUserIDs: adminUserIDs,
+		levels:       levels,
+		cfg:          cfg,
+	}
+}
+
+func (s *svc) Settings(ctx context.Context, actorID string) (resource.RuntimeSettings, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return resource.RuntimeSettings{}, apierrs.ErrPermissionDenied
+	}
+
+	settings, err := s.repo.RuntimeSettings(ctx)
+	if err != nil {
+		return resource.RuntimeSettings{}, fmt.Errorf("runtime settings: %w", err)
+	}
+
+	s.cache(settings)
+	return settings, nil
+}
+
+func (s *svc) SetLogLevels(
+	ctx context.Context,
+	actorID string,
+	target resource.LogTarget,
+	spec string,
+) (resource.RuntimeSettings, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return resource.RuntimeSettings{}, apierrs.ErrPermissionDenied
+	}
+
+	if target != resource.LogTargetControlPlane && target != resource.LogTargetPlatformd {
+		return resource.RuntimeSettings{}, apierrs.InvalidLogLevels(fmt.Errorf("unknown target %q", target))
+	}
+
+	// platformd parses the levels the same way, so
+	// validating them here covers both targets.
+	if _, err := logging.ParseLevels(spec); err != nil {
+		return resource.RuntimeSettings{}, apierrs.InvalidLogLevels(err)
+	}
+
+	settings, err := s.repo.SetLogLevels(ctx, target, spec, actorID)
+	if err != nil {
+		return resource.RuntimeSettings{}, fmt.Errorf("set log levels: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "log levels changed", "target", target, "levels", spec, "actor_id", actorID)
+
+	s.cache(settings)
+	s.apply(ctx, settings)
+	return settings, nil
+}
+
+func (s *svc) SetFeatureFlag(
+	ctx context.Context,
+	actorID string,
+	flag resource.FeatureFlag,
+	enabled bool,
+) (resource.RuntimeSettings, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return resource.RuntimeSettings{}, apierrs.ErrPermissionDenied
+	}
+
+	if !slices.Contains(resource.FeatureFlags, flag) {
+		return resource.RuntimeSettings{}, apierrs.ErrUnknownFeatureFlag
+	}
+
+	settings, err := s.repo.SetFeatureFlag(ctx, flag, enabled, actorID)
+	if err != nil {
+		return resource.RuntimeSettings{}, fmt.Errorf("set feature flag: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "feature flag changed", "flag", flag, "enabled", enabled, "actor_id", actorID)
+
+	s.cache(settings)
+	return settings, nil
+}
+
+func (s *svc) ListChanges(ctx context.Context, actorID string) ([]resource.RuntimeSettingChange, error) {
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return nil, apierrs.ErrPermissionDenied
+	}
+
+	changes, err := s.repo.RuntimeSettingChanges(ctx, MaxRuntimeSettingChanges)
+	if err != nil {
+		return nil, fmt.Errorf("runtime setting changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+func (s *svc) Current(ctx context.Context) resource.RuntimeSettings {
+	s.mu.Lock()
+	cached, cachedAt := s.cached, s.cachedAt
+	s.mu.Unlock()
+
+	if cached != nil && time.Since(cachedAt) < cmp.Or(s.cfg.CacheTTL, DefaultCacheTTL) {
+		return *cached
+	}
+
+	settings, err := s.repo.RuntimeSettings(ctx)
+	if err != nil {
+		if cached != nil {
+			s.logger.WarnContext(ctx, "failed to query runtime settings, using last known settings", "err", err)
+			return *cached
+		}
+		s.logger.WarnContext(ctx, "failed to query runtime settings, using defaults", "err", err)
+		return resource.RuntimeSettings{}
+	}
+
+	s.cache(settings)
+	return settings
+}
+
+func (s *svc) Run(ctx context.Context) {
+	ticker := time.NewTicker(cmp.Or(s.cfg.CacheTTL, DefaultCacheTTL))
+	defer ticker.Stop()
+
+	for {
+		s.apply(ctx, s.Current(ctx))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// apply sets the log levels of the control plane.
+func (s *svc) apply(ctx context.Context, settings resource.RuntimeSettings) {
+	if s.levels == nil {
+		return
+	}
+
+	spec := settings.LogLevels[resource.LogTargetControlPlane]
+
+	changed, err := s.levels.Apply(spec)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to apply log levels", "levels", spec, "err", err)
+		return
+	}
+
+	if changed {
+		s.logger.InfoContext(ctx, "applied log levels", "levels", spec)
+	}
+}
+
+func (s *svc) cache(settings resource.RuntimeSettings) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cached = &settings
+	s.cachedAt = time.Now()
+}
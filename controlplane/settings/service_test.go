@@ -0,0 +1,228 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package settings_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/settings"
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+const adminID = "admin"
+
+func TestSetLogLevels(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID string
+		target  resource.LogTarget
+		spec    string
+		code    codes.Code
+		prep    func(*mock.MockSettingsRepository)
+	}{
+		{
+			name:    "admin can change the log levels of platformd",
+			actorID: adminID,
+			target:  resource.LogTargetPlatformd,
+			spec:    "info,reconciler=debug",
+			code:    codes.OK,
+			prep: func(repo *mock.MockSettingsRepository) {
+				repo.EXPECT().
+					SetLogLevels(mocky.Anything, resource.LogTargetPlatformd, "info,reconciler=debug", adminID).
+					Return(resource.RuntimeSettings{
+						LogLevels: map[resource.LogTarget]string{
+							resource.LogTargetPlatformd: "info,reconciler=debug",
+						},
+					}, nil)
+			},
+		},
+		{
+			name:    "invalid levels are rejected",
+			actorID: adminID,
+			target:  resource.LogTargetPlatformd,
+			spec:    "info,reconciler=loud",
+			code:    codes.InvalidArgument,
+			prep:    func(*mock.MockSettingsRepository) {},
+		},
+		{
+			name:    "unknown targets are rejected",
+			actorID: adminID,
+			target:  "envoy",
+			spec:    "info",
+			code:    codes.InvalidArgument,
+			prep:    func(*mock.MockSettingsRepository) {},
+		},
+		{
+			name:    "other users are not allowed",
+			actorID: "someone",
+			target:  resource.LogTargetPlatformd,
+			spec:    "info",
+			code:    codes.PermissionDenied,
+			prep:    func(*mock.MockSettingsRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockSettingsRepository(t)
+				svc  = settings.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID}, nil, settings.Config{})
+			)
+
+			tt.prep(repo)
+
+			s, err := svc.SetLogLevels(ctx, tt.actorID, tt.target, tt.spec)
+			if tt.code != codes.OK {
+				var apiErr apierrs.Error
+				require.ErrorAs(t, err, &apiErr)
+				require.Equal(t, tt.code, apiErr.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.spec, s.LogLevels[tt.target])
+		})
+	}
+}
+
+func TestSetLogLevelsAppliesControlPlaneLevels(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		repo   = mock.NewMockSettingsRepository(t)
+		levels = logging.NewLevels(slog.LevelInfo)
+		svc    = settings.NewService(
+			slog.New(slog.DiscardHandler),
+			repo,
+			[]string{adminID},
+			logging.NewOverride(levels, "info"),
+			settings.Config{},
+		)
+	)
+
+	repo.EXPECT().
+		SetLogLevels(mocky.Anything, resource.LogTargetControlPlane, "info,chunk-service=debug", adminID).
+		Return(resource.RuntimeSettings{
+			LogLevels: map[resource.LogTarget]string{
+				resource.LogTargetControlPlane: "info,chunk-service=debug",
+			},
+		}, nil)
+
+	_, err := svc.SetLogLevels(ctx, adminID, resource.LogTargetControlPlane, "info,chunk-service=debug")
+	require.NoError(t, err)
+	require.Equal(t, slog.LevelDebug, levels.Level("chunk-service"))
+}
+
+func TestSetFeatureFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID string
+		flag    resource.FeatureFlag
+		err     error
+		prep    func(*mock.MockSettingsRepository)
+	}{
+		{
+			name:    "admin can disable a feature",
+			actorID: adminID,
+			flag:    resource.FeatureFlagDeltaDiscovery,
+			prep: func(repo *mock.MockSettingsRepository) {
+				repo.EXPECT().
+					SetFeatureFlag(mocky.Anything, resource.FeatureFlagDeltaDiscovery, false, adminID).
+					Return(resource.RuntimeSettings{
+						FeatureFlags: map[resource.FeatureFlag]bool{
+							resource.FeatureFlagDeltaDiscovery: false,
+						},
+					}, nil)
+			},
+		},
+		{
+			name:    "unknown flags are rejected",
+			actorID: adminID,
+			flag:    "new-thing",
+			err:     apierrs.ErrUnknownFeatureFlag,
+			prep:    func(*mock.MockSettingsRepository) {},
+		},
+		{
+			name:    "other users are not allowed",
+			actorID: "someone",
+			flag:    resource.FeatureFlagDeltaDiscovery,
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockSettingsRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				repo = mock.NewMockSettingsRepository(t)
+				svc  = settings.NewService(slog.New(slog.DiscardHandler), repo, []string{adminID}, nil, settings.Config{})
+			)
+
+			tt.prep(repo)
+
+			s, err := svc.SetFeatureFlag(ctx, tt.actorID, tt.flag, false)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, s.Enabled(tt.flag))
+		})
+	}
+}
+
+func TestCurrent(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		repo = mock.NewMockSettingsRepository(t)
+		svc  = settings.NewService(slog.New(slog.DiscardHandler), repo, nil, nil, settings.Config{
+			// expire immediately, so every call queries the repository
+			CacheTTL: time.Nanosecond,
+		})
+		disabled = resource.RuntimeSettings{
+			FeatureFlags: map[resource.FeatureFlag]bool{
+				resource.FeatureFlagPlacementWebhook: false,
+			},
+		}
+	)
+
+	repo.EXPECT().RuntimeSettings(mocky.Anything).Return(resource.RuntimeSettings{}, errors.New("connection refused")).Once()
+	repo.EXPECT().RuntimeSettings(mocky.Anything).Return(disabled, nil).Once()
+	repo.EXPECT().RuntimeSettings(mocky.Anything).Return(resource.RuntimeSettings{}, errors.New("connection refused")).Once()
+
+	// nothing is known yet, so the defaults are used
+	require.True(t, svc.Current(ctx).Enabled(resource.FeatureFlagPlacementWebhook))
+
+	require.Equal(t, disabled, svc.Current(ctx))
+
+	// the last known settings are used
+	require.Equal(t, disabled, svc.Current(ctx))
+}
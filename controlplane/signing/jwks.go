@@ -0,0 +1,59 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package signing
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// JWKSPath is the path the key set is served under.
+const JWKSPath = "/.well-known/jwks.json"
+
+// JWKSHandler serves the public keys of all accepted keys as JSON Web Key Set,
+// so services can verify api tokens without having access to the private keys.
+func (r *KeyRing) JWKSHandler(logger *slog.Logger) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+JWKSPath, func(w http.ResponseWriter, req *http.Request) {
+		set, err := r.PublicKeys()
+		if err != nil {
+			logger.ErrorContext(req.Context(), "failed to get public keys", "err", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		data, err := json.Marshal(set)
+		if err != nil {
+			logger.ErrorContext(req.Context(), "failed to marshal key set", "err", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/jwk-set+json")
+		// new keys are published before they are used, so verifiers
+		// can safely cache the key set until they are refreshed.
+		if r.RotationEnabled() {
+			w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(r.cfg.RefreshInterval.Seconds())))
+		}
+		_, _ = w.Write(data)
+	})
+	return mux
+}
@@ -0,0 +1,354 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package signing manages the keys api tokens are signed with.
+//
+// The key configured by the operator is always accepted. If rotation is enabled,
+// new keys are generated periodically and stored in the database, so all replicas
+// use the same keys. Newly generated keys are only used for signing once all
+// replicas had the chance to load them, and retired keys are accepted for a grace
+// period, so tokens issued before the rotation stay valid until they expire.
+package signing
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v4/jwa"
+	"github.com/lestrrat-go/jwx/v4/jwk"
+	"github.com/lestrrat-go/jwx/v4/jws"
+	"github.com/lestrrat-go/jwx/v4/jwt"
+)
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// Key is a key api tokens can be signed with. the id is used as
+// kid header of signed tokens and identifies the key in the key set.
+type Key struct {
+	ID         string
+	PrivateKey *ecdsa.PrivateKey
+	CreatedAt  time.Time
+}
+
+type Repository interface {
+	// SigningKeys returns all stored keys ordered by their creation date, oldest first.
+	SigningKeys(ctx context.Context) ([]Key, error)
+	CreateSigningKey(ctx context.Context, key Key) error
+	DeleteSigningKeys(ctx context.Context, ids []string) error
+}
+
+// Signer signs api tokens.
+type Signer interface {
+	Sign(tok jwt.Token) ([]byte, error)
+}
+
+type Config struct {
+	// RotationPeriod defines after how long a new key is generated.
+	// 0 disables rotation, so only the configured key is used.
+	RotationPeriod time.Duration
+
+	// GracePeriod defines for how long tokens signed with a key are
+	// still accepted after the key has been replaced. should be at least
+	// as long as the expiry of api tokens.
+	GracePeriod time.Duration
+
+	// RefreshInterval defines how often keys are loaded from the database.
+	// new keys are used for signing after twice the refresh interval.
+	RefreshInterval time.Duration
+}
+
+// KeyRing holds all keys that are currently accepted and
+// signs tokens using the currently active key.
+type KeyRing struct {
+	logger *slog.Logger
+	repo   Repository
+	cfg    Config
+	static Key
+
+	mu   sync.RWMutex
+	keys []Key
+}
+
+func NewKeyRing(logger *slog.Logger, key *ecdsa.PrivateKey, repo Repository, cfg Config) (*KeyRing, error) {
+	id, err := keyID(key)
+	if err != nil {
+		return nil, fmt.Errorf("key id: %w", err)
+	}
+
+	return &KeyRing{
+		logger: logger,
+		repo:   repo,
+		cfg:    cfg,
+		static: Key{
+			ID:         id,
+			PrivateKey: key,
+		},
+	}, nil
+}
+
+// RotationEnabled returns whether keys are rotated.
+func (r *KeyRing) RotationEnabled() bool {
+	return r.cfg.RotationPeriod > 0 && r.repo != nil
+}
+
+// RefreshInterval returns how often keys are loaded and rotated.
+func (r *KeyRing) RefreshInterval() time.Duration {
+	return r.cfg.RefreshInterval
+}
+
+// Run refreshes the keys until the context is cancelled.
+func (r *KeyRing) Run(ctx context.Context) {
+	if !r.RotationEnabled() {
+		return
+	}
+
+	t := time.NewTicker(r.cfg.RefreshInterval)
+	defer t.Stop()
+
+	for {
+		if err := r.Refresh(ctx); err != nil {
+			r.logger.ErrorContext(ctx, "failed to refresh signing keys", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Refresh loads the stored keys from the database.
+func (r *KeyRing) Refresh(ctx context.Context) error {
+	keys, err := r.repo.SigningKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("signing keys: %w", err)
+	}
+
+	r.mu.Lock()
+	r.keys = keys
+	r.mu.Unlock()
+	return nil
+}
+
+// Rotate generates a new key if the newest key is older than the rotation period
+// and removes keys that are not accepted anymore. it is intended to be called
+// periodically by a single replica only.
+func (r *KeyRing) Rotate(ctx context.Context) error {
+	keys, err := r.repo.SigningKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("signing keys: %w", err)
+	}
+
+	now := time.Now()
+
+	var expired []string
+	for i, k := range keys {
+		if !r.accepted(keys, i, now) {
+			expired = append(expired, k.ID)
+		}
+	}
+
+	if len(expired) > 0 {
+		if err := r.repo.DeleteSigningKeys(ctx, expired); err != nil {
+			return fmt.Errorf("delete signing keys: %w", err)
+		}
+		r.logger.InfoContext(ctx, "deleted expired signing keys", "key_ids", expired)
+	}
+
+	if len(keys) > 0 && now.Sub(keys[len(keys)-1].CreatedAt) < r.cfg.RotationPeriod {
+		return nil
+	}
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("generate key: %w", err)
+	}
+
+	id, err := keyID(priv)
+	if err != nil {
+		return fmt.Errorf("key id: %w", err)
+	}
+
+	if err := r.repo.CreateSigningKey(ctx, Key{
+		ID:         id,
+		PrivateKey: priv,
+		CreatedAt:  now,
+	}); err != nil {
+		return fmt.Errorf("create signing key: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "generated new signing key", "key_id", id)
+	return nil
+}
+
+// Sign signs the token using the active key.
+func (r *KeyRing) Sign(tok jwt.Token) ([]byte, error) {
+	key := r.active(time.Now())
+
+	hdrs := jws.NewHeaders()
+	if err := hdrs.Set(jws.KeyIDKey, key.ID); err != nil {
+		return nil, fmt.Errorf("set kid: %w", err)
+	}
+
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.ES256(), key.PrivateKey, jws.WithProtectedHeaders(hdrs)))
+	if err != nil {
+		return nil, fmt.Errorf("sign token: %w", err)
+	}
+
+	return signed, nil
+}
+
+// FetchKeys implements [jws.KeyProvider]. tokens without kid header
+// are verified using the configured key.
+func (r *KeyRing) FetchKeys(_ context.Context, sink jws.KeySink, sig *jws.Signature, _ *jws.Message) error {
+	kid, ok := sig.ProtectedHeaders().KeyID()
+	if !ok || kid == r.static.ID {
+		sink.Key(jwa.ES256(), &r.static.PrivateKey.PublicKey)
+		return nil
+	}
+
+	for _, k := range r.acceptedKeys(time.Now()) {
+		if k.ID == kid {
+			sink.Key(jwa.ES256(), &k.PrivateKey.PublicKey)
+			return nil
+		}
+	}
+
+	return ErrUnknownKey
+}
+
+// PublicKeys returns the public keys of all accepted keys, including keys
+// that are not used for signing yet, so verifiers know about them in advance.
+func (r *KeyRing) PublicKeys() (jwk.Set, error) {
+	set := jwk.NewSet()
+	for _, k := range append([]Key{r.static}, r.acceptedKeys(time.Now())...) {
+		pub, err := jwk.Import[jwk.Key](&k.PrivateKey.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("import key: %w", err)
+		}
+
+		for name, val := range map[string]any{
+			jwk.KeyIDKey:     k.ID,
+			jwk.AlgorithmKey: jwa.ES256(),
+			jwk.KeyUsageKey:  jwk.ForSignature,
+		} {
+			if err := pub.Set(name, val); err != nil {
+				return nil, fmt.Errorf("set %s: %w", name, err)
+			}
+		}
+
+		if err := set.AddKey(pub); err != nil {
+			return nil, fmt.Errorf("add key: %w", err)
+		}
+	}
+	return set, nil
+}
+
+// active returns the newest key that has been created long enough ago,
+// so all replicas know about it. falls back to the configured key.
+func (r *KeyRing) active(now time.Time) Key {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for i := len(r.keys) - 1; i >= 0; i-- {
+		if r.activeSince(r.keys[i]).Before(now) {
+			return r.keys[i]
+		}
+	}
+
+	return r.static
+}
+
+func (r *KeyRing) acceptedKeys(now time.Time) []Key {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ret := make([]Key, 0, len(r.keys))
+	for i, k := range r.keys {
+		if r.accepted(r.keys, i, now) {
+			ret = append(ret, k)
+		}
+	}
+	return ret
+}
+
+// accepted returns whether tokens signed by keys[i] are still accepted. this
+// is the case until the grace period has passed after the next key became active.
+func (r *KeyRing) accepted(keys []Key, i int, now time.Time) bool {
+	if i == len(keys)-1 {
+		return true
+	}
+	return now.Before(r.activeSince(keys[i+1]).Add(r.cfg.GracePeriod))
+}
+
+func (r *KeyRing) activeSince(k Key) time.Time {
+	return k.CreatedAt.Add(2 * r.cfg.RefreshInterval)
+}
+
+// keyID returns the base64 url encoded jwk thumbprint of the public key.
+func keyID(key *ecdsa.PrivateKey) (string, error) {
+	pub, err := jwk.Import[jwk.Key](&key.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("import key: %w", err)
+	}
+
+	if err := jwk.AssignKeyID(pub); err != nil {
+		return "", fmt.Errorf("assign key id: %w", err)
+	}
+
+	id, _ := pub.KeyID()
+	return id, nil
+}
+
+// ParsePrivateKey parses a PEM encoded EC private key.
+func ParsePrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no pem block found")
+	}
+
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse ec private key: %w", err)
+	}
+
+	return key, nil
+}
+
+// EncodePrivateKey returns the PEM encoded private key.
+func EncodePrivateKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("marshal key: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: der,
+	}), nil
+}
@@ -0,0 +1,176 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package signing_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v4/jwa"
+	"github.com/lestrrat-go/jwx/v4/jws"
+	"github.com/lestrrat-go/jwx/v4/jwt"
+	"github.com/spacechunks/explorer/controlplane/signing"
+	"github.com/spacechunks/explorer/internal/mock"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var cfg = signing.Config{
+	RotationPeriod:  24 * time.Hour,
+	GracePeriod:     time.Hour,
+	RefreshInterval: time.Minute,
+}
+
+func TestKeyRingSignsWithActiveKey(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		static   = genKey(t)
+		now      = time.Now()
+		old      = signing.Key{ID: "old", PrivateKey: genKey(t), CreatedAt: now.Add(-48 * time.Hour)}
+		active   = signing.Key{ID: "active", PrivateKey: genKey(t), CreatedAt: now.Add(-30 * time.Minute)}
+		upcoming = signing.Key{ID: "upcoming", PrivateKey: genKey(t), CreatedAt: now.Add(-30 * time.Second)}
+		repo     = mock.NewMockSigningRepository(t)
+	)
+
+	repo.EXPECT().
+		SigningKeys(mocky.Anything).
+		Return([]signing.Key{old, active, upcoming}, nil)
+
+	ring, err := signing.NewKeyRing(slog.New(slog.DiscardHandler), static, repo, cfg)
+	require.NoError(t, err)
+	require.NoError(t, ring.Refresh(ctx))
+
+	signed := sign(t, ring)
+
+	// upcoming has been created too recently, so not all
+	// replicas might know about it yet.
+	msg, err := jws.Parse(signed)
+	require.NoError(t, err)
+	kid, _ := msg.Signatures()[0].ProtectedHeaders().KeyID()
+	require.Equal(t, "active", kid)
+
+	_, err = jwt.Parse(signed, jwt.WithKeyProvider(ring))
+	require.NoError(t, err)
+
+	// old is still accepted, because active replaced it less than
+	// the grace period ago.
+	set, err := ring.PublicKeys()
+	require.NoError(t, err)
+	require.Equal(t, 4, set.Len())
+}
+
+func TestKeyRingAcceptsTokensWithoutKeyID(t *testing.T) {
+	static := genKey(t)
+
+	ring, err := signing.NewKeyRing(slog.New(slog.DiscardHandler), static, nil, signing.Config{})
+	require.NoError(t, err)
+
+	tok, err := jwt.NewBuilder().Issuer("test").Build()
+	require.NoError(t, err)
+
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.ES256(), static))
+	require.NoError(t, err)
+
+	_, err = jwt.Parse(signed, jwt.WithKeyProvider(ring))
+	require.NoError(t, err)
+
+	// tokens signed by unknown keys must be rejected
+	other, err := jwt.Sign(tok, jwt.WithKey(jwa.ES256(), genKey(t)))
+	require.NoError(t, err)
+
+	_, err = jwt.Parse(other, jwt.WithKeyProvider(ring))
+	require.Error(t, err)
+}
+
+func TestKeyRingRotate(t *testing.T) {
+	var (
+		now    = time.Now()
+		static = genKey(t)
+	)
+
+	tests := []struct {
+		name string
+		keys []signing.Key
+		prep func(*mock.MockSigningRepository)
+	}{
+		{
+			name: "generate first key",
+			prep: func(repo *mock.MockSigningRepository) {
+				repo.EXPECT().CreateSigningKey(mocky.Anything, mocky.Anything).Return(nil)
+			},
+		},
+		{
+			name: "do nothing if newest key is not due",
+			keys: []signing.Key{
+				{ID: "a", PrivateKey: genKey(t), CreatedAt: now.Add(-time.Hour)},
+			},
+		},
+		{
+			name: "generate new key and delete expired ones",
+			keys: []signing.Key{
+				{ID: "a", PrivateKey: genKey(t), CreatedAt: now.Add(-72 * time.Hour)},
+				{ID: "b", PrivateKey: genKey(t), CreatedAt: now.Add(-48 * time.Hour)},
+			},
+			prep: func(repo *mock.MockSigningRepository) {
+				repo.EXPECT().DeleteSigningKeys(mocky.Anything, []string{"a"}).Return(nil)
+				repo.EXPECT().
+					CreateSigningKey(mocky.Anything, mocky.MatchedBy(func(k signing.Key) bool {
+						return k.ID != "" && k.PrivateKey != nil
+					})).
+					Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mock.NewMockSigningRepository(t)
+			repo.EXPECT().SigningKeys(mocky.Anything).Return(tt.keys, nil)
+
+			if tt.prep != nil {
+				tt.prep(repo)
+			}
+
+			ring, err := signing.NewKeyRing(slog.New(slog.DiscardHandler), static, repo, cfg)
+			require.NoError(t, err)
+
+			require.NoError(t, ring.Rotate(context.Background()))
+		})
+	}
+}
+
+func sign(t *testing.T, ring *signing.KeyRing) []byte {
+	tok, err := jwt.NewBuilder().Issuer("test").Build()
+	require.NoError(t, err)
+
+	signed, err := ring.Sign(tok)
+	require.NoError(t, err)
+	return signed
+}
+
+func genKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
@@ -0,0 +1,153 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// limiterIdleTimeout is the duration after which the limiter of a
+// client address is forgotten if it did not make any requests.
+const limiterIdleTimeout = 10 * time.Minute
+
+type Server struct {
+	logger *slog.Logger
+	cfg    Config
+	cache  *cache
+	http   *http.Server
+
+	limitersMu    sync.Mutex
+	limiters      map[string]*clientLimiter
+	limitersSwept time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func NewServer(logger *slog.Logger, repo Repository, cfg Config) *Server {
+	s := &Server{
+		logger: logger,
+		cfg:    cfg,
+		cache: &cache{
+			repo: repo,
+			cfg:  cfg,
+		},
+		limiters:      make(map[string]*clientLimiter),
+		limitersSwept: time.Now(),
+	}
+
+	s.http = &http.Server{
+		Addr:              cfg.ListenAddr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return s
+}
+
+// Run serves the status until Shutdown is called.
+func (s *Server) Run() error {
+	if err := s.http.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.http.Shutdown(ctx)
+}
+
+// Handler returns the handler serving the status at /v1/status.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/status", s.handleStatus)
+	return mux
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if !s.allow(clientAddr(r)) {
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return
+	}
+
+	st, err := s.cache.get(r.Context())
+	if err != nil {
+		s.logger.ErrorContext(r.Context(), "failed to get platform status", "err", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(s.cfg.CacheTTL.Seconds())))
+
+	if err := json.NewEncoder(w).Encode(st); err != nil {
+		s.logger.ErrorContext(r.Context(), "failed to encode platform status", "err", err)
+	}
+}
+
+func (s *Server) allow(addr string) bool {
+	s.limitersMu.Lock()
+	defer s.limitersMu.Unlock()
+
+	now := time.Now()
+
+	// forget clients that went away, so the map does not grow forever.
+	if now.Sub(s.limitersSwept) > limiterIdleTimeout {
+		for k, l := range s.limiters {
+			if now.Sub(l.lastSeen) > limiterIdleTimeout {
+				delete(s.limiters, k)
+			}
+		}
+		s.limitersSwept = now
+	}
+
+	l, ok := s.limiters[addr]
+	if !ok {
+		l = &clientLimiter{
+			limiter: rate.NewLimiter(rate.Limit(s.cfg.RateLimit), s.cfg.RateBurst),
+		}
+		s.limiters[addr] = l
+	}
+
+	l.lastSeen = now
+	return l.limiter.AllowN(now, 1)
+}
+
+// clientAddr returns the ip address of the client. forwarding headers
+// are ignored, because they can be set by clients to evade the limits.
+func clientAddr(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
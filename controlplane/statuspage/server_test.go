@@ -0,0 +1,113 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package statuspage_test
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/statuspage"
+	"github.com/spacechunks/explorer/internal/mock"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusIsCached(t *testing.T) {
+	var (
+		repo          = mock.NewMockStatuspageRepository(t)
+		incidentsFile = filepath.Join(t.TempDir(), "incidents.json")
+		startedAt     = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+		incidents     = []statuspage.Incident{
+			{
+				Title:       "Degraded builds",
+				Description: "Builds take longer than usual.",
+				StartedAt:   startedAt,
+			},
+		}
+	)
+
+	data, err := json.Marshal(incidents)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(incidentsFile, data, 0o644))
+
+	repo.EXPECT().
+		PlatformStatus(mocky.Anything, mocky.Anything).
+		Return(statuspage.Status{
+			NodesOnline:      2,
+			NodesTotal:       3,
+			InstancesRunning: 10,
+			BuildsQueued:     1,
+		}, nil).
+		Once()
+
+	s := statuspage.NewServer(slog.New(slog.DiscardHandler), repo, statuspage.Config{
+		CacheTTL:      time.Minute,
+		NodeTimeout:   time.Minute,
+		IncidentsFile: incidentsFile,
+		RateLimit:     100,
+		RateBurst:     100,
+	})
+
+	for range 2 {
+		rec := httptest.NewRecorder()
+		s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/status", nil))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+
+		var actual statuspage.Status
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&actual))
+
+		require.Equal(t, 2, actual.NodesOnline)
+		require.Equal(t, 3, actual.NodesTotal)
+		require.Equal(t, 10, actual.InstancesRunning)
+		require.Equal(t, 1, actual.BuildsQueued)
+		require.Equal(t, incidents, actual.Incidents)
+	}
+}
+
+func TestStatusIsRateLimitedPerClient(t *testing.T) {
+	repo := mock.NewMockStatuspageRepository(t)
+	repo.EXPECT().PlatformStatus(mocky.Anything, mocky.Anything).Return(statuspage.Status{}, nil)
+
+	s := statuspage.NewServer(slog.New(slog.DiscardHandler), repo, statuspage.Config{
+		CacheTTL:  time.Minute,
+		RateLimit: 0.001,
+		RateBurst: 1,
+	})
+
+	do := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, "/v1/status", nil)
+		req.RemoteAddr = remoteAddr
+
+		rec := httptest.NewRecorder()
+		s.Handler().ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusOK, do("198.51.100.1:1234"))
+	require.Equal(t, http.StatusTooManyRequests, do("198.51.100.1:5678"))
+	require.Equal(t, http.StatusOK, do("198.51.100.2:1234"))
+}
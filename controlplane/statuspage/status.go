@@ -0,0 +1,144 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package statuspage serves aggregate platform health for a public status page.
+//
+// The status is served by a separate read-only http server, so it can be
+// exposed publicly without exposing the control plane api. Callers are not
+// authenticated, so the status is cached and requests are rate limited per
+// client address to keep the load on the database constant.
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Status is the aggregate health of the platform.
+type Status struct {
+	NodesOnline      int        `json:"nodesOnline"`
+	NodesTotal       int        `json:"nodesTotal"`
+	InstancesRunning int        `json:"instancesRunning"`
+	BuildsQueued     int        `json:"buildsQueued"`
+	Incidents        []Incident `json:"incidents"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+// Incident is an ongoing disruption operators want to inform users about.
+type Incident struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	StartedAt   time.Time `json:"startedAt"`
+}
+
+type Repository interface {
+	// PlatformStatus returns the number of nodes, the number of nodes that
+	// have been seen after seenAfter, the number of running instances and
+	// the number of flavor versions waiting for or being built.
+	PlatformStatus(ctx context.Context, seenAfter time.Time) (Status, error)
+}
+
+type Config struct {
+	ListenAddr string
+
+	// CacheTTL is the duration the status is served from the cache
+	// before it is computed again.
+	CacheTTL time.Duration
+
+	// NodeTimeout is the duration after which nodes that have not
+	// contacted the control plane are considered offline.
+	NodeTimeout time.Duration
+
+	// IncidentsFile is the path to a json file containing a list of
+	// incidents. the file is read every time the status is computed,
+	// so incidents can be updated without restarting the control plane.
+	// optional.
+	IncidentsFile string
+
+	// RateLimit is the number of requests per second a single client
+	// address is allowed to make, with bursts of up to RateBurst requests.
+	RateLimit float64
+	RateBurst int
+}
+
+// cache computes the status at most once per ttl. concurrent callers
+// wait for the computation in progress instead of hitting the database.
+type cache struct {
+	repo Repository
+	cfg  Config
+
+	mu      sync.Mutex
+	current Status
+	expiry  time.Time
+}
+
+func (c *cache) get(ctx context.Context) (Status, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Now().Before(c.expiry) {
+		return c.current, nil
+	}
+
+	st, err := c.repo.PlatformStatus(ctx, time.Now().Add(-c.cfg.NodeTimeout))
+	if err != nil {
+		return Status{}, fmt.Errorf("platform status: %w", err)
+	}
+
+	incidents, err := readIncidents(c.cfg.IncidentsFile)
+	if err != nil {
+		return Status{}, fmt.Errorf("read incidents: %w", err)
+	}
+
+	st.Incidents = incidents
+	st.UpdatedAt = time.Now()
+
+	c.current = st
+	c.expiry = st.UpdatedAt.Add(c.cfg.CacheTTL)
+
+	return st, nil
+}
+
+func readIncidents(path string) ([]Incident, error) {
+	incidents := make([]Incident, 0)
+
+	if path == "" {
+		return incidents, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		// no file means no incidents, so operators can
+		// simply remove the file once incidents are resolved.
+		if errors.Is(err, os.ErrNotExist) {
+			return incidents, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &incidents); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	return incidents, nil
+}
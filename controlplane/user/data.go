@@ -0,0 +1,310 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package user
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// exportedUser is the content of user.json in a data export.
+type exportedUser struct {
+	User                    resource.User                   `json:"user"`
+	NotificationPreferences exportedNotificationPreferences `json:"notificationPreferences"`
+}
+
+type exportedNotificationPreferences struct {
+	BuildCompleted  bool `json:"buildCompleted"`
+	BuildFailed     bool `json:"buildFailed"`
+	InstanceCrashed bool `json:"instanceCrashed"`
+}
+
+// exportedInstance is an entry of instances.json in a data export.
+type exportedInstance struct {
+	ID              string                  `json:"id"`
+	ChunkID         string                  `json:"chunkId"`
+	ChunkName       string                  `json:"chunkName"`
+	FlavorID        string                  `json:"flavorId"`
+	FlavorName      string                  `json:"flavorName"`
+	FlavorVersionID string                  `json:"flavorVersionId"`
+	FlavorVersion   string                  `json:"flavorVersion"`
+	State           resource.InstanceState  `json:"state"`
+	WorldSeed       string                  `json:"worldSeed"`
+	GameMode        resource.GameMode       `json:"gameMode"`
+	CustomParams    json.RawMessage         `json:"customParams,omitempty"`
+	CreatedAt       time.Time               `json:"createdAt"`
+	UpdatedAt       time.Time               `json:"updatedAt"`
+	Events          []exportedInstanceEvent `json:"events"`
+}
+
+type exportedInstanceEvent struct {
+	ID        string                     `json:"id"`
+	Type      resource.InstanceEventType `json:"type"`
+	ExitCode  int32                      `json:"exitCode"`
+	Message   string                     `json:"message"`
+	CreatedAt time.Time                  `json:"createdAt"`
+}
+
+// ExportUserData writes all data stored about the user into a tarball
+// and uploads it to the blob store. the returned download url can be
+// used by the user to retrieve it. users can export their own data,
+// while exporting the data of other users is reserved to admins.
+func (s *service) ExportUserData(
+	ctx context.Context,
+	actorID string,
+	userID string,
+) (resource.UserDataExport, error) {
+	if actorID != userID && !slices.Contains(s.adminUserIDs, actorID) {
+		return resource.UserDataExport{}, apierrs.ErrPermissionDenied
+	}
+
+	u, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("get user: %w", err)
+	}
+
+	prefs, err := s.repo.NotificationPreferences(ctx, userID)
+	if err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("get notification preferences: %w", err)
+	}
+
+	chunks, err := s.repo.ChunksByOwnerID(ctx, userID)
+	if err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("get chunks: %w", err)
+	}
+
+	instances, err := s.repo.InstancesByOwnerID(ctx, userID)
+	if err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("get instances: %w", err)
+	}
+
+	events, err := s.repo.InstanceEventsByOwnerID(ctx, userID)
+	if err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("get instance events: %w", err)
+	}
+
+	exportID, err := uuid.NewV7()
+	if err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("export id: %w", err)
+	}
+
+	// the export is buffered on disk, because users
+	// can own an arbitrary amount of chunks and instances.
+	f, err := os.CreateTemp("", "user-export-*.tar.gz")
+	if err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("create temp file: %w", err)
+	}
+
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	if err := writeExport(f, map[string]any{
+		"user.json": exportedUser{
+			User: u,
+			NotificationPreferences: exportedNotificationPreferences{
+				BuildCompleted:  prefs.BuildCompleted,
+				BuildFailed:     prefs.BuildFailed,
+				InstanceCrashed: prefs.InstanceCrashed,
+			},
+		},
+		"chunks.json":    chunks,
+		"instances.json": exportInstances(instances, events),
+	}); err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("write export: %w", err)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("seek: %w", err)
+	}
+
+	key := blob.UserExportKey(userID, exportID.String())
+
+	if err := s.blobStore.SimplePut(ctx, key, f, nil); err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("put export: %w", err)
+	}
+
+	url, expiresAt, err := s.blobStore.PresignDownloadURL(ctx, key, s.cfg.PresignedURLExpiry)
+	if err != nil {
+		return resource.UserDataExport{}, fmt.Errorf("presign download url: %w", err)
+	}
+
+	return resource.UserDataExport{
+		ID:                   exportID.String(),
+		UserID:               userID,
+		DownloadURL:          url,
+		DownloadURLExpiresAt: expiresAt,
+	}, nil
+}
+
+// DeleteUser removes the personal data of the user and revokes all of its
+// api tokens. depending on the policy the chunks of the user are deleted or
+// transferred to another user. users can delete themselves, while deleting
+// other users and transferring chunks is reserved to admins.
+func (s *service) DeleteUser(
+	ctx context.Context,
+	actorID string,
+	userID string,
+	policy resource.ChunkPolicy,
+	transferTo string,
+) error {
+	isAdmin := slices.Contains(s.adminUserIDs, actorID)
+
+	if actorID != userID && !isAdmin {
+		return apierrs.ErrPermissionDenied
+	}
+
+	if _, err := s.repo.GetUserByID(ctx, userID); err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	if policy == resource.ChunkPolicyTransfer {
+		if !isAdmin {
+			return apierrs.ErrPermissionDenied
+		}
+
+		if transferTo == "" {
+			return apierrs.ErrTransferToMissing
+		}
+
+		if transferTo == userID {
+			return apierrs.ErrTransferToDeletedUser
+		}
+
+		if _, err := s.repo.GetUserByID(ctx, transferTo); err != nil {
+			return fmt.Errorf("get user to transfer chunks to: %w", err)
+		}
+	} else {
+		transferTo = ""
+	}
+
+	chunkIDs, err := s.repo.DeleteUser(ctx, userID, transferTo)
+	if err != nil {
+		return fmt.Errorf("delete user: %w", err)
+	}
+
+	for _, id := range chunkIDs {
+		s.bus.Publish(event.Event{
+			Type:    event.TypeChunkChanged,
+			ChunkID: id,
+		})
+	}
+
+	if err := s.repo.RevokeUserTokens(ctx, userID, time.Now()); err != nil {
+		return fmt.Errorf("revoke user tokens: %w", err)
+	}
+
+	return nil
+}
+
+func exportInstances(instances []resource.Instance, events []resource.InstanceEvent) []exportedInstance {
+	byInstance := make(map[string][]exportedInstanceEvent, len(instances))
+	for _, ev := range events {
+		byInstance[ev.InstanceID] = append(byInstance[ev.InstanceID], exportedInstanceEvent{
+			ID:        ev.ID,
+			Type:      ev.Type,
+			ExitCode:  ev.ExitCode,
+			Message:   ev.Message,
+			CreatedAt: ev.CreatedAt,
+		})
+	}
+
+	ret := make([]exportedInstance, 0, len(instances))
+	for _, ins := range instances {
+		evs := byInstance[ins.ID]
+		if evs == nil {
+			evs = make([]exportedInstanceEvent, 0)
+		}
+
+		ret = append(ret, exportedInstance{
+			ID:              ins.ID,
+			ChunkID:         ins.Chunk.ID,
+			ChunkName:       ins.Chunk.Name,
+			FlavorID:        ins.Flavor.ID,
+			FlavorName:      ins.Flavor.Name,
+			FlavorVersionID: ins.FlavorVersion.ID,
+			FlavorVersion:   ins.FlavorVersion.Version,
+			State:           ins.State,
+			WorldSeed:       ins.Params.WorldSeed,
+			GameMode:        ins.Params.GameMode,
+			CustomParams:    ins.Params.Custom,
+			CreatedAt:       ins.CreatedAt,
+			UpdatedAt:       ins.UpdatedAt,
+			Events:          evs,
+		})
+	}
+	return ret
+}
+
+// writeExport writes a gzip compressed tarball to w, that contains one
+// json document per entry of docs. documents are sorted by their name.
+func writeExport(w io.Writer, docs map[string]any) error {
+	var (
+		gzw = gzip.NewWriter(w)
+		tw  = tar.NewWriter(gzw)
+	)
+
+	names := make([]string, 0, len(docs))
+	for name := range docs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	now := time.Now()
+	for _, name := range names {
+		data, err := json.MarshalIndent(docs[name], "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal %s: %w", name, err)
+		}
+
+		if err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Size:     int64(len(data)),
+			Mode:     0o644,
+			ModTime:  now,
+		}); err != nil {
+			return fmt.Errorf("tar header: %w", err)
+		}
+
+		if _, err := tw.Write(data); err != nil {
+			return fmt.Errorf("write %s: %w", name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("close tar: %w", err)
+	}
+
+	return gzw.Close()
+}
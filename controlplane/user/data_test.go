@@ -0,0 +1,263 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package user_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/spacechunks/explorer/controlplane/user"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adminID = "019a0000-0000-7000-8000-00000000000a"
+	otherID = "019a0000-0000-7000-8000-00000000000b"
+)
+
+func TestExportUserData(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		u     = fixture.User()
+		c     = fixture.Chunk()
+		ins   = fixture.Instance()
+		repo  = mock.NewMockUserRepository(t)
+		store = mock.NewMockBlobStore(t)
+		cfg   = user.Config{PresignedURLExpiry: time.Minute}
+		files = make(map[string][]byte)
+		insEv = resource.InstanceEvent{
+			ID:         "019a0000-0000-7000-8000-000000000001",
+			InstanceID: ins.ID,
+			Type:       resource.InstanceEventTypeCrashed,
+			ExitCode:   1,
+		}
+	)
+
+	repo.EXPECT().GetUserByID(mocky.Anything, u.ID).Return(u, nil)
+	repo.EXPECT().
+		NotificationPreferences(mocky.Anything, u.ID).
+		Return(resource.NotificationPreferences{BuildFailed: true}, nil)
+	repo.EXPECT().ChunksByOwnerID(mocky.Anything, u.ID).Return([]resource.Chunk{c}, nil)
+	repo.EXPECT().InstancesByOwnerID(mocky.Anything, u.ID).Return([]resource.Instance{ins}, nil)
+	repo.EXPECT().InstanceEventsByOwnerID(mocky.Anything, u.ID).Return([]resource.InstanceEvent{insEv}, nil)
+
+	store.EXPECT().
+		SimplePut(mocky.Anything, mocky.Anything, mocky.Anything, mocky.Anything).
+		RunAndReturn(func(_ context.Context, _ string, r io.Reader, _ map[string]string) error {
+			gzr, err := gzip.NewReader(r)
+			require.NoError(t, err)
+
+			tr := tar.NewReader(gzr)
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+
+				data, err := io.ReadAll(tr)
+				require.NoError(t, err)
+				files[hdr.Name] = data
+			}
+			return nil
+		})
+
+	store.EXPECT().
+		PresignDownloadURL(mocky.Anything, mocky.Anything, cfg.PresignedURLExpiry).
+		Return("https://example.com/export", time.Now(), nil)
+
+	svc, err := user.NewService(repo, nil, "", "", time.Hour, nil, nil, store, event.NewBus(), cfg)
+	require.NoError(t, err)
+
+	export, err := svc.ExportUserData(ctx, u.ID, u.ID)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/export", export.DownloadURL)
+
+	require.Len(t, files, 3)
+
+	var chunks []resource.Chunk
+	require.NoError(t, json.Unmarshal(files["chunks.json"], &chunks))
+	require.Len(t, chunks, 1)
+	require.Equal(t, c.ID, chunks[0].ID)
+
+	var instances []struct {
+		ID     string `json:"id"`
+		Events []struct {
+			ID string `json:"id"`
+		} `json:"events"`
+	}
+	require.NoError(t, json.Unmarshal(files["instances.json"], &instances))
+	require.Len(t, instances, 1)
+	require.Equal(t, ins.ID, instances[0].ID)
+	require.Len(t, instances[0].Events, 1)
+	require.Equal(t, insEv.ID, instances[0].Events[0].ID)
+
+	var exported struct {
+		User struct {
+			Email string `json:"email"`
+		} `json:"user"`
+		NotificationPreferences struct {
+			BuildFailed bool `json:"buildFailed"`
+		} `json:"notificationPreferences"`
+	}
+	require.NoError(t, json.Unmarshal(files["user.json"], &exported))
+	require.Equal(t, u.Email, exported.User.Email)
+	require.True(t, exported.NotificationPreferences.BuildFailed)
+}
+
+func TestExportUserDataOfOtherUserFails(t *testing.T) {
+	svc, err := user.NewService(
+		mock.NewMockUserRepository(t),
+		nil,
+		"",
+		"",
+		time.Hour,
+		nil,
+		[]string{adminID},
+		mock.NewMockBlobStore(t),
+		event.NewBus(),
+		user.Config{},
+	)
+	require.NoError(t, err)
+
+	_, err = svc.ExportUserData(context.Background(), otherID, fixture.User().ID)
+	require.ErrorIs(t, err, apierrs.ErrPermissionDenied)
+}
+
+func TestDeleteUser(t *testing.T) {
+	u := fixture.User()
+
+	tests := []struct {
+		name       string
+		actorID    string
+		policy     resource.ChunkPolicy
+		transferTo string
+		expected   string
+		err        error
+	}{
+		{
+			name:    "user deletes itself",
+			actorID: u.ID,
+			policy:  resource.ChunkPolicyDelete,
+		},
+		{
+			name:       "transfer_to is ignored if chunks are deleted",
+			actorID:    u.ID,
+			policy:     resource.ChunkPolicyDelete,
+			transferTo: otherID,
+		},
+		{
+			name:       "admin transfers chunks",
+			actorID:    adminID,
+			policy:     resource.ChunkPolicyTransfer,
+			transferTo: otherID,
+			expected:   otherID,
+		},
+		{
+			name:    "other user is not allowed to delete the user",
+			actorID: otherID,
+			policy:  resource.ChunkPolicyDelete,
+			err:     apierrs.ErrPermissionDenied,
+		},
+		{
+			name:       "user is not allowed to transfer chunks",
+			actorID:    u.ID,
+			policy:     resource.ChunkPolicyTransfer,
+			transferTo: otherID,
+			err:        apierrs.ErrPermissionDenied,
+		},
+		{
+			name:    "transfer_to is missing",
+			actorID: adminID,
+			policy:  resource.ChunkPolicyTransfer,
+			err:     apierrs.ErrTransferToMissing,
+		},
+		{
+			name:       "chunks cannot be transferred to the deleted user",
+			actorID:    adminID,
+			policy:     resource.ChunkPolicyTransfer,
+			transferTo: u.ID,
+			err:        apierrs.ErrTransferToDeletedUser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				repo      = mock.NewMockUserRepository(t)
+				bus       = event.NewBus()
+				chunkID   = "019a0000-0000-7000-8000-000000000001"
+				published []event.Event
+			)
+
+			bus.Subscribe(func(ev event.Event) {
+				published = append(published, ev)
+			})
+
+			// not called if the actor is not allowed to delete the user.
+			repo.EXPECT().GetUserByID(mocky.Anything, u.ID).Return(u, nil).Maybe()
+
+			if tt.expected != "" {
+				repo.EXPECT().
+					GetUserByID(mocky.Anything, tt.expected).
+					Return(resource.User{ID: tt.expected}, nil)
+			}
+
+			if tt.err == nil {
+				repo.EXPECT().DeleteUser(mocky.Anything, u.ID, tt.expected).Return([]string{chunkID}, nil)
+				repo.EXPECT().RevokeUserTokens(mocky.Anything, u.ID, mocky.Anything).Return(nil)
+			}
+
+			svc, err := user.NewService(
+				repo,
+				nil,
+				"",
+				"",
+				time.Hour,
+				nil,
+				[]string{adminID},
+				mock.NewMockBlobStore(t),
+				bus,
+				user.Config{},
+			)
+			require.NoError(t, err)
+
+			err = svc.DeleteUser(ctx, tt.actorID, u.ID, tt.policy, tt.transferTo)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, []event.Event{{Type: event.TypeChunkChanged, ChunkID: chunkID}}, published)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// claims added to impersonation tokens in addition to the claims of
+// regular api tokens. they are used to tag the calls made with them.
+const (
+	ImpersonationIDClaim = "impersonation_id"
+	ImpersonatorIDClaim  = "impersonator_id"
+)
+
+// ImpersonateUser records the impersonation before the token is issued, so
+// there is no way to act as a user without the user being able to see it.
+func (s *service) ImpersonateUser(
+	ctx context.Context,
+	actorID string,
+	userID string,
+	reason string,
+	duration time.Duration,
+) (resource.Impersonation, []byte, error) {
+	// admins acting as another admin must not be able
+	// to hide their tracks by impersonating someone else.
+	if _, ok := ctx.Value(contextkey.ImpersonatorID).(string); ok {
+		return resource.Impersonation{}, nil, apierrs.ErrPermissionDenied
+	}
+
+	if !slices.Contains(s.adminUserIDs, actorID) {
+		return resource.Impersonation{}, nil, apierrs.ErrPermissionDenied
+	}
+
+	if duration == 0 {
+		duration = s.cfg.ImpersonationMaxDuration
+	}
+
+	if duration > s.cfg.ImpersonationMaxDuration {
+		return resource.Impersonation{}, nil, apierrs.ErrImpersonationTooLong
+	}
+
+	u, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return resource.Impersonation{}, nil, fmt.Errorf("get user: %w", err)
+	}
+
+	now := time.Now()
+
+	imp, err := s.repo.CreateImpersonation(ctx, resource.Impersonation{
+		UserID:    userID,
+		AdminID:   actorID,
+		Reason:    reason,
+		ExpiresAt: now.Add(duration),
+	})
+	if err != nil {
+		return resource.Impersonation{}, nil, fmt.Errorf("create impersonation: %w", err)
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return resource.Impersonation{}, nil, fmt.Errorf("token id: %w", err)
+	}
+
+	tok, err := apiTokenBuilder(id.String(), s.issuer, now, imp.ExpiresAt, u).
+		Claim(ImpersonationIDClaim, imp.ID).
+		Claim(ImpersonatorIDClaim, actorID).
+		Build()
+	if err != nil {
+		return resource.Impersonation{}, nil, fmt.Errorf("create token: %w", err)
+	}
+
+	signed, err := s.signer.Sign(tok)
+	if err != nil {
+		return resource.Impersonation{}, nil, err
+	}
+
+	s.metrics.impersonationCount.Add(ctx, 1)
+	return imp, signed, nil
+}
+
+// ListImpersonations returns the impersonations of the user. users can list
+// their own impersonations, while listing the impersonations of other users
+// is reserved to admins.
+func (s *service) ListImpersonations(
+	ctx context.Context,
+	actorID string,
+	userID string,
+) ([]resource.Impersonation, error) {
+	if actorID != userID && !slices.Contains(s.adminUserIDs, actorID) {
+		return nil, apierrs.ErrPermissionDenied
+	}
+
+	imps, err := s.repo.ImpersonationsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("impersonations: %w", err)
+	}
+
+	return imps, nil
+}
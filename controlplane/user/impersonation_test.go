@@ -0,0 +1,197 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package user_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v4/jwa"
+	"github.com/lestrrat-go/jwx/v4/jwt"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/spacechunks/explorer/controlplane/user"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type keySigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s keySigner) Sign(tok jwt.Token) ([]byte, error) {
+	return jwt.Sign(tok, jwt.WithKey(jwa.ES256(), s.key))
+}
+
+func TestImpersonateUser(t *testing.T) {
+	var (
+		u   = fixture.User()
+		imp = resource.Impersonation{
+			ID:      "019a0000-0000-7000-8000-000000000001",
+			UserID:  u.ID,
+			AdminID: adminID,
+			Reason:  "ticket 1337",
+		}
+	)
+
+	tests := []struct {
+		name     string
+		actorID  string
+		duration time.Duration
+		ctx      context.Context
+		err      error
+		prep     func(*mock.MockUserRepository)
+	}{
+		{
+			name:     "works",
+			actorID:  adminID,
+			duration: 10 * time.Minute,
+			ctx:      context.Background(),
+			prep: func(repo *mock.MockUserRepository) {
+				repo.EXPECT().GetUserByID(mocky.Anything, u.ID).Return(u, nil)
+				repo.EXPECT().
+					CreateImpersonation(mocky.Anything, mocky.MatchedBy(func(in resource.Impersonation) bool {
+						until := time.Until(in.ExpiresAt)
+						return in.UserID == u.ID &&
+							in.AdminID == adminID &&
+							in.Reason == imp.Reason &&
+							until > 9*time.Minute && until <= 10*time.Minute
+					})).
+					RunAndReturn(func(_ context.Context, in resource.Impersonation) (resource.Impersonation, error) {
+						in.ID = imp.ID
+						return in, nil
+					})
+			},
+		},
+		{
+			name:    "caller is not an admin",
+			actorID: otherID,
+			ctx:     context.Background(),
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockUserRepository) {},
+		},
+		{
+			name:    "impersonation tokens cannot impersonate",
+			actorID: adminID,
+			ctx:     context.WithValue(context.Background(), contextkey.ImpersonatorID, adminID),
+			err:     apierrs.ErrPermissionDenied,
+			prep:    func(*mock.MockUserRepository) {},
+		},
+		{
+			name:     "duration exceeds maximum",
+			actorID:  adminID,
+			duration: 2 * time.Hour,
+			ctx:      context.Background(),
+			err:      apierrs.ErrImpersonationTooLong,
+			prep:     func(*mock.MockUserRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				repo = mock.NewMockUserRepository(t)
+				key  = genKey(t)
+				cfg  = user.Config{ImpersonationMaxDuration: time.Hour}
+			)
+
+			tt.prep(repo)
+
+			svc, err := user.NewService(
+				repo,
+				nil,
+				"",
+				"issuer",
+				time.Hour,
+				keySigner{key: key},
+				[]string{adminID},
+				nil,
+				event.NewBus(),
+				cfg,
+			)
+			require.NoError(t, err)
+
+			actual, signed, err := svc.ImpersonateUser(tt.ctx, tt.actorID, u.ID, imp.Reason, tt.duration)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, imp.ID, actual.ID)
+
+			tok, err := jwt.Parse(signed, jwt.WithKey(jwa.ES256(), &key.PublicKey))
+			require.NoError(t, err)
+
+			userID, err := jwt.Get[string](tok, "user_id")
+			require.NoError(t, err)
+			require.Equal(t, u.ID, userID)
+
+			impersonationID, err := jwt.Get[string](tok, user.ImpersonationIDClaim)
+			require.NoError(t, err)
+			require.Equal(t, imp.ID, impersonationID)
+
+			impersonatorID, err := jwt.Get[string](tok, user.ImpersonatorIDClaim)
+			require.NoError(t, err)
+			require.Equal(t, adminID, impersonatorID)
+
+			exp, ok := tok.Expiration()
+			require.True(t, ok)
+			require.WithinDuration(t, actual.ExpiresAt, exp, time.Second)
+		})
+	}
+}
+
+func TestListImpersonationsOfOtherUsersRequiresAdmin(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		repo = mock.NewMockUserRepository(t)
+		imps = []resource.Impersonation{{ID: "019a0000-0000-7000-8000-000000000001"}}
+	)
+
+	repo.EXPECT().ImpersonationsByUserID(mocky.Anything, otherID).Return(imps, nil)
+
+	svc, err := user.NewService(repo, nil, "", "", time.Hour, nil, []string{adminID}, nil, event.NewBus(), user.Config{})
+	require.NoError(t, err)
+
+	_, err = svc.ListImpersonations(ctx, "019a0000-0000-7000-8000-00000000000c", otherID)
+	require.ErrorIs(t, err, apierrs.ErrPermissionDenied)
+
+	actual, err := svc.ListImpersonations(ctx, otherID, otherID)
+	require.NoError(t, err)
+	require.Equal(t, imps, actual)
+
+	actual, err = svc.ListImpersonations(ctx, adminID, otherID)
+	require.NoError(t, err)
+	require.Equal(t, imps, actual)
+}
+
+func genKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
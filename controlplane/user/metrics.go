@@ -25,7 +25,8 @@ import (
 )
 
 type metrics struct {
-	registeredCount metric.Int64Counter
+	registeredCount    metric.Int64Counter
+	impersonationCount metric.Int64Counter
 }
 
 func initMetrics() (metrics, error) {
@@ -39,7 +40,16 @@ func initMetrics() (metrics, error) {
 		return metrics{}, fmt.Errorf("user registered counter: %w", err)
 	}
 
+	impersonationCount, err := meter.Int64Counter(
+		"explorer.control_plane.user.impersonation.count",
+		metric.WithDescription("Total number of users impersonated by admins"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("user impersonation counter: %w", err)
+	}
+
 	return metrics{
-		registeredCount: registeredCount,
+		registeredCount:    registeredCount,
+		impersonationCount: impersonationCount,
 	}, nil
 }
@@ -20,6 +20,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/spacechunks/explorer/internal/resource"
 )
@@ -27,4 +28,45 @@ import (
 type Repository interface {
 	GetUserByEmail(ctx context.Context, id string) (resource.User, error)
 	CreateUser(ctx context.Context, user resource.User) (resource.User, error)
+
+	// GetUserByID returns apierrs.ErrUserNotFound if the user does not exist.
+	GetUserByID(ctx context.Context, id string) (resource.User, error)
+
+	// DeleteUser anonymizes the user and removes its personal data. if transferTo
+	// is set, the chunks of the user are transferred to that user, otherwise they
+	// are marked as deleted. returns the ids of all chunks owned by the user.
+	DeleteUser(ctx context.Context, userID string, transferTo string) ([]string, error)
+
+	// ChunksByOwnerID returns all chunks owned by the user, including all of their flavor versions.
+	ChunksByOwnerID(ctx context.Context, ownerID string) ([]resource.Chunk, error)
+	InstancesByOwnerID(ctx context.Context, ownerID string) ([]resource.Instance, error)
+	InstanceEventsByOwnerID(ctx context.Context, ownerID string) ([]resource.InstanceEvent, error)
+
+	// NotificationPreferences returns the notification preferences of the user.
+	// if the user never updated them, all notifications are disabled.
+	NotificationPreferences(ctx context.Context, userID string) (resource.NotificationPreferences, error)
+	UpdateNotificationPreferences(ctx context.Context, userID string, prefs resource.NotificationPreferences) error
+
+	// RevokeToken revokes the api token with the given id until it expires.
+	RevokeToken(ctx context.Context, tokenID string, userID string, expiresAt time.Time) error
+
+	// RevokeUserTokens revokes all api tokens of the user that have been issued before the given time.
+	RevokeUserTokens(ctx context.Context, userID string, before time.Time) error
+
+	// TokenRevoked reports whether the api token has been revoked, either
+	// by itself or because all tokens of the user have been revoked.
+	TokenRevoked(ctx context.Context, tokenID string, userID string, issuedAt time.Time) (bool, error)
+
+	CreateImpersonation(ctx context.Context, imp resource.Impersonation) (resource.Impersonation, error)
+
+	// ImpersonationsByUserID returns the impersonations of the user including
+	// their actions. the most recent impersonation comes first.
+	ImpersonationsByUserID(ctx context.Context, userID string) ([]resource.Impersonation, error)
+
+	ImpersonationRecorder
+}
+
+// ImpersonationRecorder records the calls made with impersonation tokens.
+type ImpersonationRecorder interface {
+	RecordImpersonatedAction(ctx context.Context, impersonationID string, method string) error
 }
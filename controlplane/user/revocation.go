@@ -0,0 +1,148 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package user
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RevocationChecker reports whether an api token has been revoked.
+type RevocationChecker interface {
+	TokenRevoked(ctx context.Context, tokenID string, userID string, issuedAt time.Time) (bool, error)
+}
+
+type revocationEntry struct {
+	tokenID   string
+	userID    string
+	revoked   bool
+	expiresAt time.Time
+}
+
+// CachedRevocations caches the results of revocation checks in memory,
+// so the database does not have to be queried on every request.
+// revocations made through it take effect immediately, while revocations
+// made by other replicas become visible once the cached entry expires.
+type CachedRevocations struct {
+	Repository
+	ttl time.Duration
+
+	mu         sync.Mutex
+	generation uint64
+	entries    map[string]revocationEntry
+	lastSweep  time.Time
+}
+
+func NewCachedRevocations(repo Repository, ttl time.Duration) *CachedRevocations {
+	return &CachedRevocations{
+		Repository: repo,
+		ttl:        ttl,
+		entries:    make(map[string]revocationEntry),
+		lastSweep:  time.Now(),
+	}
+}
+
+func (r *CachedRevocations) TokenRevoked(
+	ctx context.Context,
+	tokenID string,
+	userID string,
+	issuedAt time.Time,
+) (bool, error) {
+	key := tokenID + "/" + userID + "/" + strconv.FormatInt(issuedAt.Unix(), 10)
+
+	r.mu.Lock()
+	e, ok := r.entries[key]
+	gen := r.generation
+	r.mu.Unlock()
+
+	if ok && time.Now().Before(e.expiresAt) {
+		return e.revoked, nil
+	}
+
+	revoked, err := r.Repository.TokenRevoked(ctx, tokenID, userID, issuedAt)
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	// a revocation could have happened while we were querying the
+	// database, in which case our result might already be outdated.
+	if gen == r.generation {
+		r.entries[key] = revocationEntry{
+			tokenID:   tokenID,
+			userID:    userID,
+			revoked:   revoked,
+			expiresAt: now.Add(r.ttl),
+		}
+	}
+
+	if now.Sub(r.lastSweep) > r.ttl {
+		for k, e := range r.entries {
+			if now.After(e.expiresAt) {
+				delete(r.entries, k)
+			}
+		}
+		r.lastSweep = now
+	}
+
+	return revoked, nil
+}
+
+func (r *CachedRevocations) RevokeToken(
+	ctx context.Context,
+	tokenID string,
+	userID string,
+	expiresAt time.Time,
+) error {
+	if err := r.Repository.RevokeToken(ctx, tokenID, userID, expiresAt); err != nil {
+		return err
+	}
+	r.invalidate(func(e revocationEntry) bool {
+		return e.tokenID == tokenID
+	})
+	return nil
+}
+
+func (r *CachedRevocations) RevokeUserTokens(ctx context.Context, userID string, before time.Time) error {
+	if err := r.Repository.RevokeUserTokens(ctx, userID, before); err != nil {
+		return err
+	}
+	r.invalidate(func(e revocationEntry) bool {
+		return e.userID == userID
+	})
+	return nil
+}
+
+func (r *CachedRevocations) invalidate(match func(e revocationEntry) bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	for k, e := range r.entries {
+		if match(e) {
+			delete(r.entries, k)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/user"
+	"github.com/spacechunks/explorer/internal/mock"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedRevocationsTokenRevoked(t *testing.T) {
+	var (
+		tokenID  = "019a0000-0000-7000-8000-000000000001"
+		userID   = "019a0000-0000-7000-8000-000000000002"
+		issuedAt = time.Unix(1760000000, 0)
+	)
+
+	tests := []struct {
+		name    string
+		ttl     time.Duration
+		calls   int
+		revoked bool
+		run     func(context.Context, *user.CachedRevocations)
+	}{
+		{
+			name:  "second check is served from cache",
+			ttl:   time.Minute,
+			calls: 1,
+			run:   func(context.Context, *user.CachedRevocations) {},
+		},
+		{
+			name:  "expired entries are checked again",
+			ttl:   time.Nanosecond,
+			calls: 2,
+			run: func(context.Context, *user.CachedRevocations) {
+				time.Sleep(time.Millisecond)
+			},
+		},
+		{
+			name:    "revoking the token invalidates entry",
+			ttl:     time.Minute,
+			calls:   2,
+			revoked: true,
+			run: func(ctx context.Context, r *user.CachedRevocations) {
+				require.NoError(t, r.RevokeToken(ctx, tokenID, userID, issuedAt.Add(time.Hour)))
+			},
+		},
+		{
+			name:    "revoking user tokens invalidates entry",
+			ttl:     time.Minute,
+			calls:   2,
+			revoked: true,
+			run: func(ctx context.Context, r *user.CachedRevocations) {
+				require.NoError(t, r.RevokeUserTokens(ctx, userID, time.Now()))
+			},
+		},
+		{
+			name:  "revoking other tokens does not invalidate entry",
+			ttl:   time.Minute,
+			calls: 1,
+			run: func(ctx context.Context, r *user.CachedRevocations) {
+				require.NoError(t, r.RevokeToken(ctx, "other-token", "other-user", issuedAt.Add(time.Hour)))
+				require.NoError(t, r.RevokeUserTokens(ctx, "other-user", time.Now()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx      = context.Background()
+				mockRepo = mock.NewMockUserRepository(t)
+				repo     = user.NewCachedRevocations(mockRepo, tt.ttl)
+				calls    = 0
+			)
+
+			mockRepo.EXPECT().
+				TokenRevoked(mocky.Anything, tokenID, userID, issuedAt).
+				RunAndReturn(func(context.Context, string, string, time.Time) (bool, error) {
+					calls++
+					// only the check after the revocation reports the token as revoked
+					return tt.revoked && calls > 1, nil
+				}).
+				Times(tt.calls)
+
+			mockRepo.EXPECT().
+				RevokeToken(mocky.Anything, mocky.Anything, mocky.Anything, mocky.Anything).
+				Return(nil).
+				Maybe()
+
+			mockRepo.EXPECT().
+				RevokeUserTokens(mocky.Anything, mocky.Anything, mocky.Anything).
+				Return(nil).
+				Maybe()
+
+			revoked, err := repo.TokenRevoked(ctx, tokenID, userID, issuedAt)
+			require.NoError(t, err)
+			require.False(t, revoked)
+
+			tt.run(ctx, repo)
+
+			revoked, err = repo.TokenRevoked(ctx, tokenID, userID, issuedAt)
+			require.NoError(t, err)
+			require.Equal(t, tt.revoked, revoked)
+		})
+	}
+}
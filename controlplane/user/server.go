@@ -20,8 +20,13 @@ package user
 
 import (
 	"context"
+	"time"
 
+	"github.com/lestrrat-go/jwx/v4/jwt"
 	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
+	"github.com/spacechunks/explorer/controlplane/contextkey"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/internal/resource/codec"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -52,12 +57,156 @@ func (s Server) Login(ctx context.Context, req *userv1alpha1.LoginRequest) (*use
 		return nil, err
 	}
 	return &userv1alpha1.LoginResponse{
-		User: &userv1alpha1.User{
-			Id:        user.ID,
-			Nickname:  user.Nickname,
-			CreatedAt: timestamppb.New(user.CreatedAt),
-			UpdatedAt: timestamppb.New(user.UpdatedAt),
-		},
+		User:     codec.UserToTransport(user),
 		ApiToken: string(apiKey),
 	}, nil
 }
+
+func (s Server) GetNotificationPreferences(
+	ctx context.Context,
+	_ *userv1alpha1.GetNotificationPreferencesRequest,
+) (*userv1alpha1.GetNotificationPreferencesResponse, error) {
+	userID := ctx.Value(contextkey.ActorID).(string)
+
+	prefs, err := s.service.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1alpha1.GetNotificationPreferencesResponse{
+		Preferences: codec.NotificationPreferencesToTransport(prefs),
+	}, nil
+}
+
+func (s Server) UpdateNotificationPreferences(
+	ctx context.Context,
+	req *userv1alpha1.UpdateNotificationPreferencesRequest,
+) (*userv1alpha1.UpdateNotificationPreferencesResponse, error) {
+	userID := ctx.Value(contextkey.ActorID).(string)
+
+	prefs, err := s.service.UpdateNotificationPreferences(ctx, userID, resource.NotificationPreferences{
+		BuildCompleted:  req.GetPreferences().GetBuildCompleted(),
+		BuildFailed:     req.GetPreferences().GetBuildFailed(),
+		InstanceCrashed: req.GetPreferences().GetInstanceCrashed(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1alpha1.UpdateNotificationPreferencesResponse{
+		Preferences: codec.NotificationPreferencesToTransport(prefs),
+	}, nil
+}
+
+func (s Server) Logout(
+	ctx context.Context,
+	_ *userv1alpha1.LogoutRequest,
+) (*userv1alpha1.LogoutResponse, error) {
+	var (
+		userID = ctx.Value(contextkey.ActorID).(string)
+		tok    = ctx.Value(contextkey.APIToken).(jwt.Token)
+	)
+
+	var (
+		tokenID, _ = tok.JwtID()
+		expiry, _  = tok.Expiration()
+	)
+
+	if err := s.service.RevokeToken(ctx, userID, tokenID, expiry); err != nil {
+		return nil, err
+	}
+
+	return &userv1alpha1.LogoutResponse{}, nil
+}
+
+func (s Server) RevokeUserTokens(
+	ctx context.Context,
+	req *userv1alpha1.RevokeUserTokensRequest,
+) (*userv1alpha1.RevokeUserTokensResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	if err := s.service.RevokeUserTokens(ctx, actorID, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &userv1alpha1.RevokeUserTokensResponse{}, nil
+}
+
+func (s Server) ExportUserData(
+	ctx context.Context,
+	req *userv1alpha1.ExportUserDataRequest,
+) (*userv1alpha1.ExportUserDataResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	export, err := s.service.ExportUserData(ctx, actorID, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1alpha1.ExportUserDataResponse{
+		DownloadUrl:          export.DownloadURL,
+		DownloadUrlExpiresAt: timestamppb.New(export.DownloadURLExpiresAt),
+	}, nil
+}
+
+func (s Server) DeleteUser(
+	ctx context.Context,
+	req *userv1alpha1.DeleteUserRequest,
+) (*userv1alpha1.DeleteUserResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	policy := resource.ChunkPolicyDelete
+	if req.ChunkPolicy == userv1alpha1.ChunkPolicy_TRANSFER_CHUNKS {
+		policy = resource.ChunkPolicyTransfer
+	}
+
+	if err := s.service.DeleteUser(ctx, actorID, req.UserId, policy, req.TransferTo); err != nil {
+		return nil, err
+	}
+
+	return &userv1alpha1.DeleteUserResponse{}, nil
+}
+
+func (s Server) ImpersonateUser(
+	ctx context.Context,
+	req *userv1alpha1.ImpersonateUserRequest,
+) (*userv1alpha1.ImpersonateUserResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	imp, tok, err := s.service.ImpersonateUser(
+		ctx,
+		actorID,
+		req.GetUserId(),
+		req.GetReason(),
+		time.Duration(req.GetDurationSeconds())*time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1alpha1.ImpersonateUserResponse{
+		Impersonation: codec.ImpersonationToTransport(imp),
+		ApiToken:      string(tok),
+	}, nil
+}
+
+func (s Server) ListImpersonations(
+	ctx context.Context,
+	req *userv1alpha1.ListImpersonationsRequest,
+) (*userv1alpha1.ListImpersonationsResponse, error) {
+	actorID := ctx.Value(contextkey.ActorID).(string)
+
+	imps, err := s.service.ListImpersonations(ctx, actorID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	transport := make([]*userv1alpha1.Impersonation, 0, len(imps))
+	for _, imp := range imps {
+		transport = append(transport, codec.ImpersonationToTransport(imp))
+	}
+
+	return &userv1alpha1.ListImpersonationsResponse{
+		Impersonations: transport,
+	}, nil
+}
@@ -20,20 +20,51 @@ package user
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
-	"github.com/lestrrat-go/jwx/v4/jwa"
+	"github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/v4/jwt"
+	"github.com/spacechunks/explorer/controlplane/blob"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/event"
+	"github.com/spacechunks/explorer/controlplane/signing"
 	"github.com/spacechunks/explorer/internal/resource"
 )
 
 type Service interface {
 	Register(ctx context.Context, nickname string, rawIDToken string, acceptPrivacyPolicy bool) error
 	Login(ctx context.Context, rawIDToken string) (resource.User, []byte, error)
+	GetNotificationPreferences(ctx context.Context, userID string) (resource.NotificationPreferences, error)
+	UpdateNotificationPreferences(
+		ctx context.Context,
+		userID string,
+		prefs resource.NotificationPreferences,
+	) (resource.NotificationPreferences, error)
+	RevokeToken(ctx context.Context, userID string, tokenID string, expiresAt time.Time) error
+	RevokeUserTokens(ctx context.Context, actorID string, userID string) error
+	ExportUserData(ctx context.Context, actorID string, userID string) (resource.UserDataExport, error)
+	DeleteUser(
+		ctx context.Context,
+		actorID string,
+		userID string,
+		policy resource.ChunkPolicy,
+		transferTo string,
+	) error
+
+	// ImpersonateUser issues an api token acting as the user on behalf of the
+	// admin. the token is valid for the given duration or the maximum allowed
+	// duration if it is 0.
+	ImpersonateUser(
+		ctx context.Context,
+		actorID string,
+		userID string,
+		reason string,
+		duration time.Duration,
+	) (resource.Impersonation, []byte, error)
+	ListImpersonations(ctx context.Context, actorID string, userID string) ([]resource.Impersonation, error)
 }
 
 type service struct {
@@ -42,10 +73,22 @@ type service struct {
 	clientID       string
 	issuer         string
 	apiTokenExpiry time.Duration
-	signingKey     *ecdsa.PrivateKey
+	signer         signing.Signer
+	adminUserIDs   []string
+	blobStore      blob.Store
+	bus            *event.Bus
+	cfg            Config
 	metrics        metrics
 }
 
+type Config struct {
+	// PresignedURLExpiry is the duration download urls of data exports are valid for.
+	PresignedURLExpiry time.Duration
+
+	// ImpersonationMaxDuration is the longest duration impersonation tokens are valid for.
+	ImpersonationMaxDuration time.Duration
+}
+
 type idTokenClaims struct {
 	Email string `json:"email"`
 }
@@ -56,7 +99,11 @@ func NewService(
 	oauthClientID string,
 	issuer string,
 	apiTokenExpiry time.Duration,
-	signingKey *ecdsa.PrivateKey,
+	signer signing.Signer,
+	adminUserIDs []string,
+	blobStore blob.Store,
+	bus *event.Bus,
+	cfg Config,
 ) (Service, error) {
 	m, err := initMetrics()
 	if err != nil {
@@ -69,7 +116,11 @@ func NewService(
 		clientID:       oauthClientID,
 		issuer:         issuer,
 		apiTokenExpiry: apiTokenExpiry,
-		signingKey:     signingKey,
+		signer:         signer,
+		adminUserIDs:   adminUserIDs,
+		blobStore:      blobStore,
+		bus:            bus,
+		cfg:            cfg,
 		metrics:        m,
 	}, nil
 }
@@ -79,6 +130,10 @@ func (s *service) Register(ctx context.Context, nickname string, rawIDToken stri
 		return apierrs.ErrPrivacyPolicyNotAccepted
 	}
 
+	if s.provider == nil {
+		return apierrs.ErrIdentityProviderMissing
+	}
+
 	verifier := s.provider.Verifier(&oidc.Config{
 		ClientID: s.clientID,
 	})
@@ -105,6 +160,10 @@ func (s *service) Register(ctx context.Context, nickname string, rawIDToken stri
 }
 
 func (s *service) Login(ctx context.Context, rawIDToken string) (resource.User, []byte, error) {
+	if s.provider == nil {
+		return resource.User{}, nil, apierrs.ErrIdentityProviderMissing
+	}
+
 	verifier := s.provider.Verifier(&oidc.Config{
 		ClientID: s.clientID,
 	})
@@ -124,23 +183,92 @@ func (s *service) Login(ctx context.Context, rawIDToken string) (resource.User,
 		return resource.User{}, nil, fmt.Errorf("get user: %w", err)
 	}
 
+	signed, err := NewAPIToken(s.signer, s.issuer, s.apiTokenExpiry, u)
+	if err != nil {
+		return resource.User{}, nil, err
+	}
+
+	return u, signed, nil
+}
+
+func (s *service) GetNotificationPreferences(
+	ctx context.Context,
+	userID string,
+) (resource.NotificationPreferences, error) {
+	prefs, err := s.repo.NotificationPreferences(ctx, userID)
+	if err != nil {
+		return resource.NotificationPreferences{}, fmt.Errorf("get notification preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+func (s *service) UpdateNotificationPreferences(
+	ctx context.Context,
+	userID string,
+	prefs resource.NotificationPreferences,
+) (resource.NotificationPreferences, error) {
+	if err := s.repo.UpdateNotificationPreferences(ctx, userID, prefs); err != nil {
+		return resource.NotificationPreferences{}, fmt.Errorf("update notification preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+// RevokeToken revokes a single api token of the user. tokens
+// without an id cannot be revoked individually.
+func (s *service) RevokeToken(ctx context.Context, userID string, tokenID string, expiresAt time.Time) error {
+	if tokenID == "" {
+		return apierrs.ErrTokenNotRevocable
+	}
+
+	if err := s.repo.RevokeToken(ctx, tokenID, userID, expiresAt); err != nil {
+		return fmt.Errorf("revoke token: %w", err)
+	}
+	return nil
+}
+
+// RevokeUserTokens revokes all api tokens that have been issued to the user
+// so far. users can revoke their own tokens, while revoking the tokens of
+// other users is reserved to admins.
+func (s *service) RevokeUserTokens(ctx context.Context, actorID string, userID string) error {
+	if actorID != userID && !slices.Contains(s.adminUserIDs, actorID) {
+		return apierrs.ErrPermissionDenied
+	}
+
+	if err := s.repo.RevokeUserTokens(ctx, userID, time.Now()); err != nil {
+		return fmt.Errorf("revoke user tokens: %w", err)
+	}
+	return nil
+}
+
+// NewAPIToken issues a signed api token for the given user
+// that is valid for the given duration.
+func NewAPIToken(signer signing.Signer, issuer string, expiry time.Duration, u resource.User) ([]byte, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("token id: %w", err)
+	}
+
 	iss := time.Now()
-	apiTok, err := jwt.NewBuilder().
-		IssuedAt(iss).
-		Issuer(s.issuer).
-		Audience([]string{s.issuer}).
-		Expiration(iss.Add(s.apiTokenExpiry)).
-		Claim("user_id", u.ID).
-		Claim("email", claims.Email).
-		Build()
+	apiTok, err := apiTokenBuilder(id.String(), issuer, iss, iss.Add(expiry), u).Build()
 	if err != nil {
-		return resource.User{}, nil, fmt.Errorf("create token: %w", err)
+		return nil, fmt.Errorf("create token: %w", err)
 	}
 
-	signed, err := jwt.Sign(apiTok, jwt.WithKey(jwa.ES256(), s.signingKey))
+	signed, err := signer.Sign(apiTok)
 	if err != nil {
-		return resource.User{}, nil, fmt.Errorf("sign token: %w", err)
+		return nil, err
 	}
 
-	return u, signed, nil
+	return signed, nil
+}
+
+func apiTokenBuilder(id string, issuer string, issuedAt time.Time, expiresAt time.Time, u resource.User) *jwt.Builder {
+	return jwt.NewBuilder().
+		JwtID(id).
+		IssuedAt(issuedAt).
+		Issuer(issuer).
+		Audience([]string{issuer}).
+		Expiration(expiresAt).
+		Claim("user_id", u.ID).
+		Claim("email", u.Email)
 }
@@ -139,10 +139,7 @@ func (w *ArchiveWorker) tryArchiveFlavorVersion(
 		return nil
 	}
 
-	if version.BuildStatus != resource.FlavorVersionBuildStatusBuildCheckpointFailed &&
-		version.BuildStatus != resource.FlavorVersionBuildStatusBuildImageFailed &&
-		version.BuildStatus != resource.FlavorVersionBuildStatusCompleted &&
-		version.BuildStatus != resource.FlavorVersionBuildStatusPending {
+	if !buildFinished(version.BuildStatus) {
 		logger.InfoContext(
 			ctx,
 			"flavor version is still building. waiting for build to fail or finish",
@@ -160,3 +157,11 @@ func (w *ArchiveWorker) tryArchiveFlavorVersion(
 
 	return nil
 }
+
+// buildFinished reports whether the flavor version is currently not being built.
+func buildFinished(status resource.FlavorVersionBuildStatus) bool {
+	return status == resource.FlavorVersionBuildStatusBuildCheckpointFailed ||
+		status == resource.FlavorVersionBuildStatusBuildImageFailed ||
+		status == resource.FlavorVersionBuildStatusCompleted ||
+		status == resource.FlavorVersionBuildStatusPending
+}
@@ -0,0 +1,105 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/internal/resource"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// InstanceCleanupWorker removes instances that stayed in a state for longer
+// than allowed by their [instance.CleanupPolicy]. the time an instance entered
+// its current state is persisted, so the retention window is honored even if
+// the control plane restarts in between. expired instance redirects are
+// removed as well.
+type InstanceCleanupWorker struct {
+	river.WorkerDefaults[job.CleanupInstances]
+
+	logger       *slog.Logger
+	insRepo      instance.Repository
+	policies     []instance.CleanupPolicy
+	cleanupCount metric.Int64Counter
+}
+
+func NewInstanceCleanupWorker(
+	logger *slog.Logger,
+	insRepo instance.Repository,
+	policies []instance.CleanupPolicy,
+) (*InstanceCleanupWorker, error) {
+	meter := otel.Meter("github.com/spacechunks/explorer/controlplane/worker")
+
+	cleanupCount, err := meter.Int64Counter(
+		"explorer.control_plane.instance.cleanup.count",
+		metric.WithDescription("Total number of instances removed by a cleanup policy"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cleanup counter: %w", err)
+	}
+
+	return &InstanceCleanupWorker{
+		logger:       logger,
+		insRepo:      insRepo,
+		policies:     policies,
+		cleanupCount: cleanupCount,
+	}, nil
+}
+
+func (w *InstanceCleanupWorker) Work(ctx context.Context, _ *river.Job[job.CleanupInstances]) error {
+	now := time.Now()
+
+	for _, p := range w.policies {
+		logger := w.logger.With("state", p.State, "after", p.After)
+
+		ids, err := w.insRepo.TransitionStaleInstances(
+			ctx,
+			p.State,
+			resource.InstanceStateDeleting,
+			now.Add(-p.After),
+		)
+		if err != nil {
+			// try the remaining policies, the failed one will
+			// be retried the next time the job runs.
+			logger.ErrorContext(ctx, "failed to clean up instances", "err", err)
+			continue
+		}
+
+		if len(ids) == 0 {
+			continue
+		}
+
+		w.cleanupCount.Add(ctx, int64(len(ids)), metric.WithAttributes(attribute.String("state", string(p.State))))
+		logger.InfoContext(ctx, "cleaning up instances", "instance_ids", ids)
+	}
+
+	if err := w.insRepo.DeleteExpiredInstanceRedirects(ctx); err != nil {
+		w.logger.ErrorContext(ctx, "failed to delete expired instance redirects", "err", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/worker"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceCleanupWorker(t *testing.T) {
+	var (
+		logger      = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		mockInsRepo = mock.NewMockInstanceRepository(t)
+		policies    = []instance.CleanupPolicy{
+			{
+				State: resource.InstanceCreationFailed,
+				After: 5 * time.Minute,
+			},
+			{
+				State: resource.InstanceStatePending,
+				After: time.Hour,
+			},
+		}
+		start = time.Now()
+	)
+
+	// cutoff returns a matcher checking that the instances have to be in the
+	// state since at least the given duration, relative to when the job ran.
+	cutoff := func(after time.Duration) any {
+		return mocky.MatchedBy(func(before time.Time) bool {
+			return !before.Before(start.Add(-after)) && !before.After(time.Now().Add(-after))
+		})
+	}
+
+	mockInsRepo.
+		EXPECT().
+		TransitionStaleInstances(
+			mocky.Anything,
+			resource.InstanceCreationFailed,
+			resource.InstanceStateDeleting,
+			cutoff(5*time.Minute),
+		).
+		Return(nil, errors.New("some error"))
+
+	// a failing policy must not prevent the other policies from being applied
+	mockInsRepo.
+		EXPECT().
+		TransitionStaleInstances(
+			mocky.Anything,
+			resource.InstanceStatePending,
+			resource.InstanceStateDeleting,
+			cutoff(time.Hour),
+		).
+		Return([]string{"019532e8-6d95-7a0e-a6c7-a7b4a3d6c1f2"}, nil)
+
+	mockInsRepo.
+		EXPECT().
+		DeleteExpiredInstanceRedirects(mocky.Anything).
+		Return(nil)
+
+	w, err := worker.NewInstanceCleanupWorker(logger, mockInsRepo, policies)
+	require.NoError(t, err)
+
+	require.NoError(t, w.Work(context.Background(), nil))
+}
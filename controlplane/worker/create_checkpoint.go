@@ -20,15 +20,19 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/riverqueue/river"
 	checkpointv1alpha1 "github.com/spacechunks/explorer/api/platformd/checkpoint/v1alpha1"
 	"github.com/spacechunks/explorer/controlplane/chunk"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
 	"github.com/spacechunks/explorer/controlplane/job"
 	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/image"
 	"github.com/spacechunks/explorer/internal/resource"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
@@ -38,6 +42,18 @@ import (
 type CreateCheckpointWorkerConfig struct {
 	Timeout             time.Duration
 	StatusCheckInterval time.Duration
+	// BuilderTimeout is the duration after which builder nodes
+	// that have not contacted the control plane are considered offline.
+	BuilderTimeout time.Duration
+
+	// VerifyRestore makes the node restore the checkpoint and wait until
+	// the server responds, before the build is marked as completed. the
+	// time this took is recorded as restore latency of the flavor version.
+	VerifyRestore bool
+
+	// Signer is used to sign checkpoint images once they have been
+	// pushed by the builder node. nil disables signing.
+	Signer *image.Signer
 }
 
 type CreateCheckpointClient func(host string) (checkpointv1alpha1.CheckpointServiceClient, error)
@@ -113,20 +129,57 @@ func (w *CreateCheckpointWorker) Work(ctx context.Context, riverJob *river.Job[j
 	//       wait for completion. => requires saving checkpoint id
 	//       and node id.
 
-	// TODO: choose only nodes that are available for running checkpoint workloads
-	n, err := w.nodeRepo.RandomNode(ctx)
+	n, err := w.nodeRepo.ScheduleCheckpointBuild(
+		ctx,
+		riverJob.Args.FlavorVersionID,
+		time.Now().Add(-w.cfg.BuilderTimeout),
+	)
 	if err != nil {
-		return fmt.Errorf("random node: %w", err)
+		if !errors.Is(err, apierrs.ErrNoBuilderOnline) {
+			return fmt.Errorf("schedule checkpoint build: %w", err)
+		}
+
+		// do not block builds if no node advertises the builder capability
+		// or all of them are offline, but fall back to any node instead.
+		w.logger.WarnContext(
+			ctx,
+			"no builder node online, falling back to random node",
+			"flavor_version_id", riverJob.Args.FlavorVersionID,
+		)
+
+		n, err = w.nodeRepo.RandomNode(ctx)
+		if err != nil {
+			return fmt.Errorf("random node: %w", err)
+		}
 	}
 
+	w.logger.InfoContext(
+		ctx,
+		"scheduled checkpoint build",
+		"flavor_version_id", riverJob.Args.FlavorVersionID,
+		"node_id", n.ID,
+	)
+
 	c, err := w.createCheckpointClient(n.CheckpointAPIEndpoint.String())
 	if err != nil {
 		return fmt.Errorf("create checkpoint client: %w", err)
 	}
 
-	resp, err := c.CreateCheckpoint(ctx, &checkpointv1alpha1.CreateCheckpointRequest{
-		BaseImageUrl: riverJob.Args.BaseImageURL,
-	})
+	req := &checkpointv1alpha1.CreateCheckpointRequest{
+		BaseImageUrl:  riverJob.Args.BaseImageURL,
+		ReuseKey:      riverJob.Args.ReuseKey,
+		VerifyRestore: w.cfg.VerifyRestore,
+		Command:       riverJob.Args.Command,
+	}
+
+	if res := riverJob.Args.CheckpointResources; res != nil {
+		req.Resources = &checkpointv1alpha1.CheckpointResources{
+			CpuMillis:        res.CPUMillis,
+			MemoryLimitBytes: res.MemoryLimitBytes,
+		}
+	}
+
+	resp, err := c.CreateCheckpoint(ctx, req)
 	if err != nil {
 		return fmt.Errorf("create checkpoint: %w", err)
 	}
@@ -155,6 +208,32 @@ func (w *CreateCheckpointWorker) Work(ctx context.Context, riverJob *river.Job[j
 
 			if statusResp.Status.State == checkpointv1alpha1.CheckpointState_COMPLETED {
 				w.logger.InfoContext(ctx, "checkpointing completed", "checkpoint_id", resp.CheckpointId)
+
+				if w.cfg.Signer != nil {
+					if err := w.signCheckpoint(ctx, riverJob.Args.BaseImageURL); err != nil {
+						return fmt.Errorf("sign checkpoint image: %w", err)
+					}
+				}
+
+				if latency := statusResp.Status.RestoreLatencyMs; w.cfg.VerifyRestore && latency > 0 {
+					if err := w.chunkRepo.UpdateFlavorVersionRestoreLatency(
+						ctx,
+						riverJob.Args.FlavorVersionID,
+						time.Duration(latency)*time.Millisecond,
+					); err != nil {
+						return fmt.Errorf("flavor version restore latency: %w", err)
+					}
+				}
+
+				if err := w.chunkRepo.RecordFlavorVersionCheckpointProvenance(
+					ctx,
+					riverJob.Args.FlavorVersionID,
+					n.ID,
+					time.Now(),
+				); err != nil {
+					return fmt.Errorf("record provenance: %w", err)
+				}
+
 				if err := w.chunkRepo.UpdateFlavorVersionBuildStatus(
 					ctx,
 					riverJob.Args.FlavorVersionID,
@@ -183,6 +262,17 @@ func (w *CreateCheckpointWorker) Work(ctx context.Context, riverJob *river.Job[j
 	}
 }
 
+// signCheckpoint signs the checkpoint image, which is
+// located in the same repository as the base image.
+func (w *CreateCheckpointWorker) signCheckpoint(ctx context.Context, baseImageURL string) error {
+	baseRef, err := name.ParseReference(baseImageURL)
+	if err != nil {
+		return fmt.Errorf("parse base image url: %w", err)
+	}
+
+	return w.cfg.Signer.Sign(ctx, baseRef.Context().Tag("checkpoint").String())
+}
+
 func (w *CreateCheckpointWorker) Timeout(*river.Job[job.CreateCheckpoint]) time.Duration {
 	return w.cfg.Timeout
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
 	checkpointv1alpha1 "github.com/spacechunks/explorer/api/platformd/checkpoint/v1alpha1"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
 	"github.com/spacechunks/explorer/controlplane/job"
 	"github.com/spacechunks/explorer/controlplane/node"
 	"github.com/spacechunks/explorer/controlplane/worker"
@@ -49,6 +50,12 @@ func TestCreateCheckpointWorker(t *testing.T) {
 		err         error
 		attempt     int
 		maxAttempts int
+		resources   *resource.CheckpointResources
+		reuseKey    string
+		noBuilder   bool
+
+		verifyRestore    bool
+		restoreLatencyMs uint32
 	}{
 		{
 			name:        "works",
@@ -56,6 +63,38 @@ func TestCreateCheckpointWorker(t *testing.T) {
 			state:       checkpointv1alpha1.CheckpointState_COMPLETED,
 			buildStatus: resource.FlavorVersionBuildStatusCompleted,
 		},
+		{
+			name:        "resource overrides are passed to platformd",
+			timeout:     10 * time.Second,
+			state:       checkpointv1alpha1.CheckpointState_COMPLETED,
+			buildStatus: resource.FlavorVersionBuildStatusCompleted,
+			resources: &resource.CheckpointResources{
+				CPUMillis:        4000,
+				MemoryLimitBytes: 8 << 30,
+			},
+		},
+		{
+			name:        "reuse key is passed to platformd",
+			timeout:     10 * time.Second,
+			state:       checkpointv1alpha1.CheckpointState_COMPLETED,
+			buildStatus: resource.FlavorVersionBuildStatusCompleted,
+			reuseKey:    "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3a94a8fe5ccb19ba61c4c0873",
+		},
+		{
+			name:             "restore latency is recorded if restore is verified",
+			timeout:          10 * time.Second,
+			state:            checkpointv1alpha1.CheckpointState_COMPLETED,
+			buildStatus:      resource.FlavorVersionBuildStatusCompleted,
+			verifyRestore:    true,
+			restoreLatencyMs: 1500,
+		},
+		{
+			name:        "falls back to random node if no builder is online",
+			timeout:     10 * time.Second,
+			state:       checkpointv1alpha1.CheckpointState_COMPLETED,
+			buildStatus: resource.FlavorVersionBuildStatusCompleted,
+			noBuilder:   true,
+		},
 		{
 			name:        "job timeout exceeded",
 			timeout:     30 * time.Millisecond,
@@ -84,14 +123,38 @@ func TestCreateCheckpointWorker(t *testing.T) {
 				flavorVersionID = test.NewUUIDv7(t)
 			)
 
-			mockNodeRepo.EXPECT().
-				RandomNode(mocky.Anything).
-				Return(node.Node{}, nil) // return value doesn't matter
+			// the node is only used for recording the provenance
+			n := node.Node{ID: test.NewUUIDv7(t)}
+
+			if tt.noBuilder {
+				mockNodeRepo.EXPECT().
+					ScheduleCheckpointBuild(mocky.Anything, flavorVersionID, mocky.Anything).
+					Return(node.Node{}, apierrs.ErrNoBuilderOnline)
+
+				mockNodeRepo.EXPECT().
+					RandomNode(mocky.Anything).
+					Return(n, nil)
+			} else {
+				mockNodeRepo.EXPECT().
+					ScheduleCheckpointBuild(mocky.Anything, flavorVersionID, mocky.Anything).
+					Return(n, nil)
+			}
+
+			expectedReq := &checkpointv1alpha1.CreateCheckpointRequest{
+				BaseImageUrl:  baseImgURL,
+				ReuseKey:      tt.reuseKey,
+				VerifyRestore: tt.verifyRestore,
+			}
+
+			if tt.resources != nil {
+				expectedReq.Resources = &checkpointv1alpha1.CheckpointResources{
+					CpuMillis:        tt.resources.CPUMillis,
+					MemoryLimitBytes: tt.resources.MemoryLimitBytes,
+				}
+			}
 
 			mockClient.EXPECT().
-				CreateCheckpoint(mocky.Anything, &checkpointv1alpha1.CreateCheckpointRequest{
-					BaseImageUrl: baseImgURL,
-				}).
+				CreateCheckpoint(mocky.Anything, expectedReq).
 				Return(&checkpointv1alpha1.CreateCheckpointResponse{
 					CheckpointId: checkID,
 				}, nil)
@@ -102,10 +165,27 @@ func TestCreateCheckpointWorker(t *testing.T) {
 				}).
 				Return(&checkpointv1alpha1.CheckpointStatusResponse{
 					Status: &checkpointv1alpha1.CheckpointStatus{
-						State: tt.state,
+						State:            tt.state,
+						RestoreLatencyMs: tt.restoreLatencyMs,
 					},
 				}, nil)
 
+			if tt.restoreLatencyMs > 0 {
+				mockChunkRepo.EXPECT().
+					UpdateFlavorVersionRestoreLatency(
+						mocky.Anything,
+						flavorVersionID,
+						time.Duration(tt.restoreLatencyMs)*time.Millisecond,
+					).
+					Return(nil)
+			}
+
+			if tt.state == checkpointv1alpha1.CheckpointState_COMPLETED {
+				mockChunkRepo.EXPECT().
+					RecordFlavorVersionCheckpointProvenance(mocky.Anything, flavorVersionID, n.ID, mocky.Anything).
+					Return(nil)
+			}
+
 			mockChunkRepo.EXPECT().
 				UpdateFlavorVersionBuildStatus(mocky.Anything, flavorVersionID, tt.buildStatus).
 				Return(nil)
@@ -118,6 +198,7 @@ func TestCreateCheckpointWorker(t *testing.T) {
 				worker.CreateCheckpointWorkerConfig{
 					Timeout:             tt.timeout,
 					StatusCheckInterval: 5 * time.Millisecond,
+					VerifyRestore:       tt.verifyRestore,
 				},
 			)
 
@@ -127,8 +208,10 @@ func TestCreateCheckpointWorker(t *testing.T) {
 					MaxAttempts: tt.maxAttempts,
 				},
 				Args: job.CreateCheckpoint{
-					FlavorVersionID: flavorVersionID,
-					BaseImageURL:    baseImgURL,
+					FlavorVersionID:     flavorVersionID,
+					BaseImageURL:        baseImgURL,
+					CheckpointResources: tt.resources,
+					ReuseKey:            tt.reuseKey,
 				},
 			}
 
@@ -160,7 +243,7 @@ func TestCheckpointWorkerReturnsIfNotFound(t *testing.T) {
 	)
 
 	mockNodeRepo.EXPECT().
-		RandomNode(mocky.Anything).
+		ScheduleCheckpointBuild(mocky.Anything, flavorVersionID, mocky.Anything).
 		Return(node.Node{}, nil) // return value doesn't matter
 
 	mockClient.EXPECT().
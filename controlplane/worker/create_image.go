@@ -20,34 +20,56 @@ package worker
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	ociv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/riverqueue/river"
 	"github.com/spacechunks/explorer/controlplane/blob"
 	"github.com/spacechunks/explorer/controlplane/chunk"
 	"github.com/spacechunks/explorer/controlplane/job"
-	"github.com/spacechunks/explorer/controlplane/serverconfig"
 	"github.com/spacechunks/explorer/internal/file"
 	"github.com/spacechunks/explorer/internal/image"
 	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/internal/serverconfig"
 	"github.com/spacechunks/explorer/internal/tarhelper"
 	"go.opentelemetry.io/otel/trace"
 )
 
+// progressUpdateInterval is the minimum time between two build progress updates.
+const progressUpdateInterval = 2 * time.Second
+
+// errPatchHashMismatch is returned if the content of the files uploaded
+// using a patch does not match the hashes of the flavor version.
+var errPatchHashMismatch = errors.New("file content does not match hash")
+
 type CreateImageWorkerConfig struct {
 	ImagePlatform string
+	BuildLimits   chunk.BuildLimits
+
+	// Signer is used to sign built images. nil disables signing.
+	Signer *image.Signer
+
+	// BuilderVersion is recorded in the provenance of every built image.
+	BuilderVersion string
 }
 
 type CreateImageWorker struct {
 	logger *slog.Logger
 	river.WorkerDefaults[job.CreateImage]
 	repo       chunk.Repository
-	store      blob.S3Store
+	store      blob.Store
 	imgService image.Service
 	jobClient  job.Client
 	cfg        CreateImageWorkerConfig
@@ -58,7 +80,7 @@ func NewCreateImageWorker(
 	repo chunk.Repository,
 	imgSvc image.Service,
 	jobClient job.Client,
-	store blob.S3Store,
+	store blob.Store,
 	cfg CreateImageWorkerConfig,
 ) *CreateImageWorker {
 	return &CreateImageWorker{
@@ -77,8 +99,28 @@ func (w *CreateImageWorker) Work(ctx context.Context, riverJob *river.Job[job.Cr
 		SpanContext: riverJob.Args.SpanContext.OTel(),
 	})
 
+	// set if the flavor version has been rejected, because it exceeds
+	// the build limits. in this case the build status and the reason
+	// have already been recorded and must not be overwritten.
+	var rejected bool
+
+	reject := func(violations ...string) error {
+		reason := strings.Join(violations, "; ")
+		if err := w.repo.RejectFlavorVersionBuild(
+			ctx,
+			riverJob.Args.FlavorVersionID,
+			resource.FlavorVersionBuildStatusBuildImageFailed,
+			reason,
+		); err != nil {
+			return fmt.Errorf("reject build: %w", err)
+		}
+		rejected = true
+		// retrying will not change the outcome, so cancel the job right away.
+		return river.JobCancel(fmt.Errorf("build limits exceeded: %s", reason))
+	}
+
 	defer func() {
-		if ret == nil {
+		if ret == nil || rejected {
 			return
 		}
 
@@ -133,26 +175,38 @@ func (w *CreateImageWorker) Work(ctx context.Context, riverJob *river.Job[job.Cr
 		return fmt.Errorf("create root dir: %w", err)
 	}
 
-	tb, err := os.Create(rootDir + "/changeset.tar.gz")
+	// flavor versions with only a few small changes are uploaded as patch,
+	// where each file is uploaded individually instead of using a tarball.
+	patched, err := w.store.ObjectExists(ctx, blob.PatchManifestKey(version.ID))
 	if err != nil {
-		return fmt.Errorf("open file: %w", err)
+		return fmt.Errorf("patch manifest exists: %w", err)
 	}
 
-	defer tb.Close()
+	var paths []string
+	if patched {
+		paths, err = w.downloadPatch(ctx, serverRootDir, version.ID)
+		if errors.Is(err, errPatchHashMismatch) {
+			return reject(err.Error())
+		}
 
-	if err := w.store.WriteTo(ctx, blob.ChangeSetKey(version.ID), tb); err != nil {
-		return fmt.Errorf("write tarball: %w", err)
-	}
+		if errors.Is(err, tarhelper.ErrLimitExceeded) {
+			return reject(fmt.Sprintf("uploaded files exceed build limits: %v", err))
+		}
 
-	if _, err := tb.Seek(0, io.SeekStart); err != nil {
-		return fmt.Errorf("seek: %w", err)
-	}
+		if err != nil {
+			return fmt.Errorf("download patch: %w", err)
+		}
+	} else {
+		// the server root dir we use in our base image is /opt/paper
+		// so all files should be located right there.
+		paths, err = w.extractChangeSet(ctx, rootDir, serverRootDir, version.ID)
+		if errors.Is(err, tarhelper.ErrLimitExceeded) {
+			return reject(fmt.Sprintf("uploaded files exceed build limits: %v", err))
+		}
 
-	// the server root dir we use in our base image is /opt/paper
-	// so all files should be located right there.
-	paths, err := tarhelper.Untar(tb, serverRootDir)
-	if err != nil {
-		return fmt.Errorf("untar files: %w", err)
+		if err != nil {
+			return fmt.Errorf("extract change set: %w", err)
+		}
 	}
 
 	if err := w.upload(ctx, paths); err != nil {
@@ -163,6 +217,26 @@ func (w *CreateImageWorker) Work(ctx context.Context, riverJob *river.Job[job.Cr
 		return fmt.Errorf("download missing: %w", err)
 	}
 
+	// patch files are not verified by the store when uploading them, so
+	// make sure the assembled files are exactly the ones of the version.
+	if patched {
+		if err := verifyFiles(serverRootDir, version.FileHashes, version.Hash); err != nil {
+			if errors.Is(err, errPatchHashMismatch) {
+				return reject(err.Error())
+			}
+			return fmt.Errorf("verify files: %w", err)
+		}
+	}
+
+	fileCount, totalSize, err := dirStats(serverRootDir)
+	if err != nil {
+		return fmt.Errorf("dir stats: %w", err)
+	}
+
+	if violations := w.cfg.BuildLimits.Violations(fileCount, totalSize); len(violations) > 0 {
+		return reject(violations...)
+	}
+
 	rt, err := os.OpenRoot(serverRootDir)
 	if err != nil {
 		return fmt.Errorf("open root: %w", err)
@@ -172,28 +246,122 @@ func (w *CreateImageWorker) Work(ctx context.Context, riverJob *river.Job[job.Cr
 		return fmt.Errorf("sanitize configs: %w", err)
 	}
 
+	// each asset is added as a separate layer below the layer containing the
+	// files of the flavor version, so files of the flavor version take precedence.
+	// this also means that the sanitized configs cannot be overridden by assets.
+	img := baseImg
+	layerDirs := make([]string, 0, len(version.AssetHashes)+1)
+	for i, h := range version.AssetHashes {
+		assetDir := fmt.Sprintf("%s/assets/%d", rootDir, i)
+
+		if err := w.extractAsset(ctx, assetDir, h); err != nil {
+			if errors.Is(err, tarhelper.ErrLimitExceeded) {
+				return reject(fmt.Sprintf("asset %s exceeds build limits: %v", h, err))
+			}
+			return fmt.Errorf("extract asset %s: %w", h, err)
+		}
+
+		img, err = image.AppendLayer(img, assetDir+"/files")
+		if err != nil {
+			return fmt.Errorf("append asset layer: %w", err)
+		}
+		layerDirs = append(layerDirs, assetDir+"/files")
+	}
+
 	// it is VERY important we specify the parent of the server root directory,
 	// because only paths starting INSIDE the passed directory are preserved.
 	// so, in our case we specify files/opt/paper to keep the /opt/paper prefix.
-	img, err := image.AppendLayer(baseImg, filesDir)
+	img, err = image.AppendLayer(img, filesDir)
 	if err != nil {
 		return fmt.Errorf("append layer: %w", err)
 	}
+	layerDirs = append(layerDirs, filesDir)
+
+	layers, err := img.Layers()
+	if err != nil {
+		return fmt.Errorf("layers: %w", err)
+	}
+
+	layerSize, err := layers[len(layers)-1].Size()
+	if err != nil {
+		return fmt.Errorf("layer size: %w", err)
+	}
+
+	if violation, ok := w.cfg.BuildLimits.LayerViolation(uint64(layerSize)); ok {
+		return reject(violation)
+	}
 
 	ref := fmt.Sprintf("%s/%s:base", riverJob.Args.OCIRegistry, riverJob.Args.FlavorVersionID)
 
-	if err := w.imgService.Push(ctx, img, ref); err != nil {
+	baseDigest, err := baseImg.Digest()
+	if err != nil {
+		return fmt.Errorf("base image digest: %w", err)
+	}
+
+	imgDigest, err := img.Digest()
+	if err != nil {
+		return fmt.Errorf("image digest: %w", err)
+	}
+
+	pkgs, err := jarPackages(layerDirs)
+	if err != nil {
+		return fmt.Errorf("find jars: %w", err)
+	}
+
+	if err := w.imgService.Push(ctx, img, ref, image.WithProgress(w.pushProgress(ctx, version.ID))); err != nil {
 		return fmt.Errorf("push image: %w", err)
 	}
 
+	if err := w.pushSBOM(ctx, ref, imgDigest, pkgs); err != nil {
+		return fmt.Errorf("push sbom: %w", err)
+	}
+
+	if w.cfg.Signer != nil {
+		if err := w.cfg.Signer.Sign(ctx, ref); err != nil {
+			return fmt.Errorf("sign image: %w", err)
+		}
+	}
+
+	plugins := make([]resource.PluginHash, 0, len(pkgs))
+	for _, p := range pkgs {
+		plugins = append(plugins, resource.PluginHash{
+			Path:   p.Path,
+			SHA256: p.SHA256,
+		})
+	}
+
+	if err := w.repo.RecordFlavorVersionImageProvenance(ctx, version.ID, resource.BuildProvenance{
+		BaseImageDigest: baseDigest.String(),
+		ImageDigest:     imgDigest.String(),
+		BuilderVersion:  w.cfg.BuilderVersion,
+		InputHash:       contentHash(version),
+		Plugins:         plugins,
+		ImageBuiltAt:    time.Now(),
+	}); err != nil {
+		return fmt.Errorf("record provenance: %w", err)
+	}
+
+	command, err := chunk.StartupCommand(version)
+	if err != nil {
+		return fmt.Errorf("startup command: %w", err)
+	}
+
 	if err := w.jobClient.InsertJob(
 		ctx,
 		riverJob.Args.FlavorVersionID,
 		string(resource.FlavorVersionBuildStatusBuildCheckpoint),
 		job.CreateCheckpoint{
-			FlavorVersionID: riverJob.Args.FlavorVersionID,
-			BaseImageURL:    ref,
-			SpanContext:     riverJob.Args.SpanContext,
+			FlavorVersionID:     riverJob.Args.FlavorVersionID,
+			BaseImageURL:        ref,
+			SpanContext:         riverJob.Args.SpanContext,
+			CheckpointResources: riverJob.Args.CheckpointResources,
+			Command:             command,
+			ReuseKey: checkpointReuseKey(
+				baseDigest.String(),
+				contentHash(version),
+				riverJob.Args.CheckpointResources,
+				command,
+			),
 		}); err != nil {
 		return fmt.Errorf("insert create checkpoint job: %w", err)
 	}
@@ -201,6 +369,378 @@ func (w *CreateImageWorker) Work(ctx context.Context, riverJob *river.Job[job.Cr
 	return nil
 }
 
+// checkpointReuseKey computes a key that is the same for all flavor versions
+// whose checkpoints are interchangeable, meaning they have been built using the
+// same base image, contain the same files and get the same resources assigned.
+// the latter is important, because the jvm sizes its heap based on the memory
+// limit of the container. the server also has to be started with the same command.
+func checkpointReuseKey(
+	baseImageDigest string,
+	contentHash string,
+	res *resource.CheckpointResources,
+	command []string,
+) string {
+	var cpu, mem uint64
+	if res != nil {
+		cpu, mem = uint64(res.CPUMillis), res.MemoryLimitBytes
+	}
+
+	data := fmt.Appendf(nil, "%s\n%s\n%d\n%d", baseImageDigest, contentHash, cpu, mem)
+
+	// only added if the command is overridden, so existing reuse keys stay
+	// valid. the arguments never contain whitespace, so joining is unambiguous.
+	if len(command) > 0 {
+		data = fmt.Appendf(data, "\n%s", strings.Join(command, " "))
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// contentHash returns a hash identifying the content of the image built for the
+// flavor version. if the version does not reference any assets, this is the hash
+// of the version itself, so existing reuse keys stay valid.
+func contentHash(version resource.FlavorVersion) string {
+	if len(version.AssetHashes) == 0 {
+		return version.Hash
+	}
+
+	sum := sha256.Sum256([]byte(version.Hash + "\n" + strings.Join(version.AssetHashes, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// pushProgress returns a function that reports the push progress into the build status
+// of the flavor version. updates are throttled to not hammer the database for each chunk.
+func (w *CreateImageWorker) pushProgress(ctx context.Context, flavorVersionID string) image.ProgressFunc {
+	var (
+		lastPercent uint32
+		lastUpdate  time.Time
+	)
+
+	return func(complete int64, total int64) {
+		if total <= 0 {
+			return
+		}
+
+		percent := uint32(min(max(complete*100/total, 0), 100))
+		if percent == lastPercent || (percent < 100 && time.Since(lastUpdate) < progressUpdateInterval) {
+			return
+		}
+
+		lastPercent, lastUpdate = percent, time.Now()
+
+		if err := w.repo.UpdateFlavorVersionBuildProgress(ctx, flavorVersionID, percent); err != nil {
+			w.logger.WarnContext(
+				ctx,
+				"failed to update build progress",
+				"flavor_version_id", flavorVersionID,
+				"err", err,
+			)
+		}
+	}
+}
+
+// pushSBOM pushes a sbom listing the jar files added to the
+// base image next to the image with the given digest pushed to ref.
+func (w *CreateImageWorker) pushSBOM(
+	ctx context.Context,
+	ref string,
+	digest ociv1.Hash,
+	pkgs []image.SBOMPackage,
+) error {
+	parsed, err := name.ParseReference(ref)
+	if err != nil {
+		return fmt.Errorf("parse ref: %w", err)
+	}
+
+	sbom, err := image.NewSBOM(
+		parsed.Context().RepositoryStr(),
+		fmt.Sprintf("https://chunks.space/spdx/%s/%s", parsed.Context().RepositoryStr(), digest.Hex),
+		time.Now(),
+		pkgs,
+	)
+	if err != nil {
+		return err
+	}
+
+	sbomImg, err := image.NewSBOMImage(sbom)
+	if err != nil {
+		return fmt.Errorf("sbom image: %w", err)
+	}
+
+	return w.imgService.Push(ctx, sbomImg, image.SBOMRef(parsed.Context(), digest))
+}
+
+// jarPackages returns the jar files contained in the given layer directories.
+// jars of later layers replace jars at the same path in earlier layers, the
+// same way the layers of the image override each other.
+func jarPackages(layerDirs []string) ([]image.SBOMPackage, error) {
+	hashes := make(map[string]string)
+	for _, dir := range layerDirs {
+		if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !d.Type().IsRegular() || !strings.EqualFold(filepath.Ext(path), ".jar") {
+				return nil
+			}
+
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+
+			sum, err := sha256File(path)
+			if err != nil {
+				return fmt.Errorf("hash %s: %w", rel, err)
+			}
+
+			hashes["/"+filepath.ToSlash(rel)] = sum
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	pkgs := make([]image.SBOMPackage, 0, len(hashes))
+	for path, sum := range hashes {
+		pkgs = append(pkgs, image.SBOMPackage{
+			Path:   path,
+			SHA256: sum,
+		})
+	}
+
+	slices.SortFunc(pkgs, func(a, b image.SBOMPackage) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return pkgs, nil
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// dirStats returns the number of regular files and their total size in bytes.
+func dirStats(dir string) (uint64, uint64, error) {
+	var count, size uint64
+	if err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		count++
+		size += uint64(info.Size())
+		return nil
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	return count, size, nil
+}
+
+func (w *CreateImageWorker) extractChangeSet(
+	ctx context.Context,
+	rootDir string,
+	dest string,
+	versionID string,
+) ([]string, error) {
+	tb, err := os.Create(rootDir + "/changeset.tar.gz")
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+
+	defer tb.Close()
+
+	if err := w.store.WriteTo(ctx, blob.ChangeSetKey(versionID), tb); err != nil {
+		return nil, fmt.Errorf("write tarball: %w", err)
+	}
+
+	if _, err := tb.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("seek: %w", err)
+	}
+
+	paths, err := tarhelper.UntarLimited(tb, dest, tarhelper.Limits{
+		MaxFiles:     w.cfg.BuildLimits.MaxFileCount,
+		MaxBytes:     w.cfg.BuildLimits.MaxTotalSizeBytes,
+		MaxFileBytes: w.cfg.BuildLimits.MaxFileSizeBytes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("untar files: %w", err)
+	}
+
+	return paths, nil
+}
+
+// extractAsset downloads the bundle of the asset with the given hash and extracts
+// it to $dir/files/opt/paper. bundles are stored only once, no matter how many
+// flavor versions reference them.
+func (w *CreateImageWorker) extractAsset(ctx context.Context, dir string, hash string) error {
+	dest := dir + "/files/opt/paper"
+	if err := os.MkdirAll(dest, os.ModePerm); err != nil {
+		return fmt.Errorf("create dest: %w", err)
+	}
+
+	tb, err := os.Create(dir + "/asset.tar.gz")
+	if err != nil {
+		return fmt.Errorf("open file: %w", err)
+	}
+
+	defer tb.Close()
+
+	if err := w.store.WriteTo(ctx, blob.AssetKey(hash), tb); err != nil {
+		return fmt.Errorf("write tarball: %w", err)
+	}
+
+	if _, err := tb.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("seek: %w", err)
+	}
+
+	if _, err := tarhelper.UntarLimited(tb, dest, tarhelper.Limits{
+		MaxFiles:     w.cfg.BuildLimits.MaxFileCount,
+		MaxBytes:     w.cfg.BuildLimits.MaxTotalSizeBytes,
+		MaxFileBytes: w.cfg.BuildLimits.MaxFileSizeBytes,
+	}); err != nil {
+		return fmt.Errorf("untar files: %w", err)
+	}
+
+	return nil
+}
+
+// downloadPatch downloads the files uploaded using a patch to dest. each file is
+// verified against the hash it has been uploaded for, because unlike the files
+// of a tarball, these are stored in the CAS as-is by upload afterwards. files are
+// hashed while they are being downloaded, and the download is aborted as soon as
+// a file exceeds the maximum file size.
+func (w *CreateImageWorker) downloadPatch(ctx context.Context, dest string, versionID string) ([]string, error) {
+	manifest, err := chunk.ReadPatchManifest(ctx, w.store, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(manifest))
+	for _, fh := range manifest {
+		path := filepath.Join(dest, fh.Path)
+
+		if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			return nil, fmt.Errorf("mkdir %s: %w", path, err)
+		}
+
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, fmt.Errorf("create file: %w", err)
+		}
+
+		var (
+			hasher = file.NewHashWriter()
+			out    = &limitedWriter{
+				w:     io.MultiWriter(f, hasher),
+				name:  fh.Path,
+				limit: w.cfg.BuildLimits.MaxFileSizeBytes,
+			}
+		)
+
+		if err := w.store.WriteTo(ctx, blob.PatchKey(versionID, fh.Hash), out); err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("write file (%s/%s): %w", fh.Path, fh.Hash, err)
+		}
+
+		if err := f.Close(); err != nil {
+			return nil, fmt.Errorf("close file: %w", err)
+		}
+
+		if actual := hasher.Sum(); actual != fh.Hash {
+			return nil, fmt.Errorf("%s: %w: expected %s, got %s", fh.Path, errPatchHashMismatch, fh.Hash, actual)
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// verifyFiles checks that the files in dir match the given hashes
+// and that the hash tree built from them has the expected root.
+func verifyFiles(dir string, hashes []file.Hash, rootHash string) error {
+	for _, fh := range hashes {
+		if err := verifyFile(filepath.Join(dir, fh.Path), fh.Hash); err != nil {
+			return fmt.Errorf("%s: %w", fh.Path, err)
+		}
+	}
+
+	tree, err := file.HashTree(hashes)
+	if err != nil {
+		return fmt.Errorf("hash tree: %w", err)
+	}
+
+	if file.HashTreeRootString(tree) != rootHash {
+		return fmt.Errorf("%w: hash tree root does not match flavor version hash", errPatchHashMismatch)
+	}
+
+	return nil
+}
+
+func verifyFile(path string, hash string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open file: %w", err)
+	}
+
+	defer f.Close()
+
+	actual, err := file.ComputeHashStr(f)
+	if err != nil {
+		return fmt.Errorf("compute hash: %w", err)
+	}
+
+	if actual != hash {
+		return fmt.Errorf("%w: expected %s, got %s", errPatchHashMismatch, hash, actual)
+	}
+
+	return nil
+}
+
+// limitedWriter fails with tarhelper.ErrLimitExceeded once more than
+// limit bytes have been written. a limit of 0 disables the check.
+type limitedWriter struct {
+	w       io.Writer
+	name    string
+	limit   uint64
+	written uint64
+}
+
+func (lw *limitedWriter) Write(p []byte) (int, error) {
+	if lw.limit > 0 && lw.written+uint64(len(p)) > lw.limit {
+		return 0, fmt.Errorf("%w: %s is larger than %d bytes", tarhelper.ErrLimitExceeded, lw.name, lw.limit)
+	}
+
+	n, err := lw.w.Write(p)
+	lw.written += uint64(n)
+	return n, err
+}
+
 func (w *CreateImageWorker) upload(ctx context.Context, filePaths []string) error {
 	objs := make([]blob.Object, 0)
 
@@ -57,23 +57,23 @@ type CreateResourcePackWorkerConfig struct {
 type CreateResourcePackWorker struct {
 	river.WorkerDefaults[job.CreateResourcePack]
 
-	logger  *slog.Logger
-	s3store blob.S3Store
-	repo    chunk.Repository
-	cfg     CreateResourcePackWorkerConfig
+	logger *slog.Logger
+	store  blob.Store
+	repo   chunk.Repository
+	cfg    CreateResourcePackWorkerConfig
 }
 
 func NewCreateResourcePackWorker(
 	logger *slog.Logger,
-	s3store blob.S3Store,
+	store blob.Store,
 	repo chunk.Repository,
 	cfg CreateResourcePackWorkerConfig,
 ) *CreateResourcePackWorker {
 	return &CreateResourcePackWorker{
-		logger:  logger,
-		s3store: s3store,
-		repo:    repo,
-		cfg:     cfg,
+		logger: logger,
+		store:  store,
+		repo:   repo,
+		cfg:    cfg,
 	}
 }
 
@@ -179,7 +179,7 @@ func (w *CreateResourcePackWorker) Work(ctx context.Context, riverJob *river.Job
 
 		defer texture.Close()
 
-		if err := w.s3store.WriteTo(ctx, blob.CASKeyPrefix+"/"+h, texture); err != nil {
+		if err := w.store.WriteTo(ctx, blob.CASKeyPrefix+"/"+h, texture); err != nil {
 			return fmt.Errorf("write texture file: %w", err)
 		}
 
@@ -273,7 +273,7 @@ func (w *CreateResourcePackWorker) Work(ctx context.Context, riverJob *river.Job
 
 	w.logger.InfoContext(ctx, "upload pack", "sha1", hash)
 
-	if err := w.s3store.SimplePut(ctx, uploadKey, pack, map[string]string{
+	if err := w.store.SimplePut(ctx, uploadKey, pack, map[string]string{
 		"sha1": hash,
 	}); err != nil {
 		return fmt.Errorf("put latest: %w", err)
@@ -290,7 +290,7 @@ func (w *CreateResourcePackWorker) fetchAndUnzipBasePackTo(ctx context.Context,
 
 	defer basePackFile.Close()
 
-	if err := w.s3store.WriteTo(ctx, w.cfg.PackTemplateKey, basePackFile); err != nil {
+	if err := w.store.WriteTo(ctx, w.cfg.PackTemplateKey, basePackFile); err != nil {
 		return fmt.Errorf("write base pack: %w", err)
 	}
 
@@ -59,7 +59,7 @@ const (
 func TestBuildResourcePackWorkerSuccessfullyBuildsPack(t *testing.T) {
 	var (
 		ctx      = context.Background()
-		mockS3   = mock.NewMockBlobS3Store(t)
+		mockS3   = mock.NewMockBlobStore(t)
 		mockRepo = mock.NewMockChunkRepository(t)
 		logger   = slog.New(slog.NewTextHandler(os.Stdout, nil))
 		cfg      = worker.CreateResourcePackWorkerConfig{
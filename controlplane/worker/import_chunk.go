@@ -0,0 +1,381 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/internal/file"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/internal/tarhelper"
+)
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+type ImportChunkWorkerConfig struct {
+	Registry    string
+	BuildLimits chunk.BuildLimits
+
+	// ExtractLimits restricts the contents of the uploaded archive. it also
+	// covers files that are excluded later on, like the server jar and its
+	// libraries, so it should be more generous than the build limits.
+	ExtractLimits tarhelper.Limits
+}
+
+// ImportChunkWorker unpacks the server archive uploaded for a chunk import
+// and creates the first flavor version of the imported flavor from it. the
+// same exclusion rules as for flavor versions created using the cli apply.
+type ImportChunkWorker struct {
+	river.WorkerDefaults[job.ImportChunk]
+
+	logger    *slog.Logger
+	repo      chunk.Repository
+	store     blob.Store
+	jobClient job.Client
+	cfg       ImportChunkWorkerConfig
+}
+
+func NewImportChunkWorker(
+	logger *slog.Logger,
+	repo chunk.Repository,
+	store blob.Store,
+	jobClient job.Client,
+	cfg ImportChunkWorkerConfig,
+) *ImportChunkWorker {
+	return &ImportChunkWorker{
+		logger:    logger,
+		repo:      repo,
+		store:     store,
+		jobClient: jobClient,
+		cfg:       cfg,
+	}
+}
+
+func (w *ImportChunkWorker) Work(ctx context.Context, riverJob *river.Job[job.ImportChunk]) (ret error) {
+	if err := riverJob.Args.Validate(); err != nil {
+		return fmt.Errorf("validate args: %w", err)
+	}
+
+	imp, err := w.repo.ChunkImportByID(ctx, riverJob.Args.ImportID)
+	if err != nil {
+		return fmt.Errorf("chunk import: %w", err)
+	}
+
+	if imp.State != resource.ChunkImportStateImporting {
+		w.logger.InfoContext(ctx, "chunk import is not importing, skipping", "import_id", imp.ID, "state", imp.State)
+		return nil
+	}
+
+	// set if the import has been marked as failed already,
+	// so the reason is not overwritten.
+	var failed bool
+
+	fail := func(reason string) error {
+		if err := w.repo.UpdateChunkImportState(ctx, imp.ID, resource.ChunkImportStateFailed, reason); err != nil {
+			return fmt.Errorf("update import state: %w", err)
+		}
+		failed = true
+		// the archive will not change, so retrying will not change the outcome.
+		return river.JobCancel(errors.New(reason))
+	}
+
+	defer func() {
+		if ret == nil || failed {
+			return
+		}
+
+		// we only want to mark the import as failed
+		// once we exhausted all attempts.
+		if riverJob.Attempt < riverJob.MaxAttempts {
+			return
+		}
+
+		if err := w.repo.UpdateChunkImportState(
+			ctx,
+			imp.ID,
+			resource.ChunkImportStateFailed,
+			ret.Error(),
+		); err != nil {
+			w.logger.ErrorContext(ctx, "failed to update chunk import state", "import_id", imp.ID, "err", err)
+		}
+	}()
+
+	rootDir, err := os.MkdirTemp("", "import-"+imp.ID+"-")
+	if err != nil {
+		return fmt.Errorf("create root dir: %w", err)
+	}
+
+	defer func() {
+		if err := os.RemoveAll(rootDir); err != nil {
+			w.logger.ErrorContext(ctx, "failed to remove files", "import_id", imp.ID, "err", err)
+		}
+	}()
+
+	filesDir := filepath.Join(rootDir, "files")
+
+	if err := w.extractArchive(ctx, imp.ID, filepath.Join(rootDir, "archive"), filesDir); err != nil {
+		if errors.Is(err, tarhelper.ErrLimitExceeded) {
+			return fail(fmt.Sprintf("archive exceeds limits: %v", err))
+		}
+
+		if errors.Is(err, errUnknownArchiveFormat) {
+			return fail(err.Error())
+		}
+
+		return fmt.Errorf("extract archive: %w", err)
+	}
+
+	serverRoot, err := findServerRoot(filesDir)
+	if err != nil {
+		return fmt.Errorf("find server root: %w", err)
+	}
+
+	_, hashes, err := file.HashDir(w.logger, serverRoot)
+	if err != nil {
+		return fmt.Errorf("hash files: %w", err)
+	}
+
+	if len(hashes) == 0 {
+		return fail("archive does not contain any files")
+	}
+
+	if violations := w.cfg.BuildLimits.FileViolations(hashes); len(violations) > 0 {
+		return fail(strings.Join(violations, "; "))
+	}
+
+	version, err := w.flavorVersion(ctx, imp, serverRoot, hashes)
+	if err != nil {
+		return fmt.Errorf("flavor version: %w", err)
+	}
+
+	if !version.FilesUploaded {
+		if err := w.uploadChangeSet(ctx, rootDir, serverRoot, version.ID, hashes); err != nil {
+			return fmt.Errorf("upload change set: %w", err)
+		}
+	}
+
+	// the build status changes once the job has been inserted, so
+	// only insert it if a previous attempt did not already do this.
+	if version.BuildStatus == resource.FlavorVersionBuildStatusPending {
+		mcVersion, err := w.repo.GetMinecraftVersionByVersion(ctx, version.MinecraftVersion)
+		if err != nil {
+			return fmt.Errorf("minecraft version: %w", err)
+		}
+
+		if err := w.jobClient.InsertJob(
+			ctx,
+			version.ID,
+			string(resource.FlavorVersionBuildStatusBuildImage),
+			job.CreateImage{
+				FlavorVersionID: version.ID,
+				BaseImage:       mcVersion.ImageURL,
+				OCIRegistry:     w.cfg.Registry,
+			},
+		); err != nil {
+			return fmt.Errorf("insert create image job: %w", err)
+		}
+	}
+
+	if err := w.repo.UpdateChunkImportState(ctx, imp.ID, resource.ChunkImportStateCompleted, ""); err != nil {
+		return fmt.Errorf("update import state: %w", err)
+	}
+
+	// the archive is not needed anymore, so failing to delete
+	// it should not cause the import to be retried.
+	if err := w.store.Delete(ctx, blob.ChunkImportKey(imp.ID)); err != nil {
+		w.logger.ErrorContext(ctx, "failed to delete import archive", "import_id", imp.ID, "err", err)
+	}
+
+	return nil
+}
+
+var errUnknownArchiveFormat = errors.New("archive is neither a zip file nor a gzip compressed tarball")
+
+func (w *ImportChunkWorker) extractArchive(ctx context.Context, importID string, archivePath string, dest string) error {
+	f, err := os.Create(archivePath)
+	if err != nil {
+		return fmt.Errorf("create archive: %w", err)
+	}
+
+	defer f.Close()
+
+	if err := w.store.WriteTo(ctx, blob.ChunkImportKey(importID), f); err != nil {
+		return fmt.Errorf("download archive: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("stat archive: %w", err)
+	}
+
+	magic := make([]byte, len(zipMagic))
+	if _, err := f.ReadAt(magic, 0); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("read magic: %w", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, zipMagic):
+		_, err = tarhelper.UnzipLimited(f, info.Size(), dest, w.cfg.ExtractLimits)
+	case bytes.HasPrefix(magic, gzipMagic):
+		_, err = tarhelper.UntarLimited(io.NewSectionReader(f, 0, info.Size()), dest, w.cfg.ExtractLimits)
+	default:
+		return errUnknownArchiveFormat
+	}
+
+	return err
+}
+
+// flavorVersion returns the flavor version of the import. if it does not
+// exist yet, it will be created from the given hashes.
+func (w *ImportChunkWorker) flavorVersion(
+	ctx context.Context,
+	imp resource.ChunkImport,
+	serverRoot string,
+	hashes []file.Hash,
+) (resource.FlavorVersion, error) {
+	if imp.FlavorVersionID != "" {
+		return w.repo.FlavorVersionByID(ctx, imp.FlavorVersionID)
+	}
+
+	// a previous attempt might have created the version, but failed
+	// before it could be recorded in the import. the flavor has been
+	// created together with the import, so the only version it can
+	// have is the one created by the import.
+	latest, err := w.repo.LatestFlavorVersion(ctx, imp.FlavorID)
+	if err != nil {
+		return resource.FlavorVersion{}, fmt.Errorf("latest flavor version: %w", err)
+	}
+
+	version := latest
+	if latest.ID == "" || latest.Version != imp.Version {
+		rel := make([]file.Hash, 0, len(hashes))
+		for _, h := range hashes {
+			p, err := filepath.Rel(serverRoot, h.Path)
+			if err != nil {
+				return resource.FlavorVersion{}, fmt.Errorf("relative path: %w", err)
+			}
+			h.Path = filepath.ToSlash(p)
+			rel = append(rel, h)
+		}
+
+		file.SortHashes(rel)
+
+		tree, err := file.HashTree(rel)
+		if err != nil {
+			return resource.FlavorVersion{}, fmt.Errorf("hash tree: %w", err)
+		}
+
+		version, err = w.repo.CreateFlavorVersion(ctx, imp.FlavorID, resource.FlavorVersion{
+			Version:          imp.Version,
+			MinecraftVersion: imp.MinecraftVersion,
+			Hash:             file.HashTreeRootString(tree),
+			FileHashes:       rel,
+			MinPlayers:       imp.MinPlayers,
+			MaxPlayers:       imp.MaxPlayers,
+		}, latest.ID)
+		if err != nil {
+			return resource.FlavorVersion{}, fmt.Errorf("create flavor version: %w", err)
+		}
+	}
+
+	if err := w.repo.UpdateChunkImportFlavorVersion(ctx, imp.ID, version.ID); err != nil {
+		return resource.FlavorVersion{}, fmt.Errorf("update import flavor version: %w", err)
+	}
+
+	return version, nil
+}
+
+// uploadChangeSet uploads all hashed files as the change set of the flavor version,
+// so they are picked up by the image build like files uploaded using the cli.
+func (w *ImportChunkWorker) uploadChangeSet(
+	ctx context.Context,
+	rootDir string,
+	serverRoot string,
+	versionID string,
+	hashes []file.Hash,
+) error {
+	files := make([]*os.File, 0, len(hashes))
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+
+	for _, h := range hashes {
+		f, err := os.Open(h.Path)
+		if err != nil {
+			return fmt.Errorf("open %s: %w", h.Path, err)
+		}
+		files = append(files, f)
+	}
+
+	changeSetPath := filepath.Join(rootDir, "changeset.tar.gz")
+
+	if err := tarhelper.TarFiles(serverRoot, files, changeSetPath); err != nil {
+		return fmt.Errorf("tar files: %w", err)
+	}
+
+	changeSet, err := os.Open(changeSetPath)
+	if err != nil {
+		return fmt.Errorf("open change set: %w", err)
+	}
+
+	defer changeSet.Close()
+
+	if err := w.store.SimplePut(ctx, blob.ChangeSetKey(versionID), changeSet, nil); err != nil {
+		return fmt.Errorf("put change set: %w", err)
+	}
+
+	if err := w.repo.MarkFlavorVersionFilesUploaded(ctx, versionID); err != nil {
+		return fmt.Errorf("mark files: %w", err)
+	}
+
+	return nil
+}
+
+// findServerRoot returns the directory containing the server files. archives
+// are often created by compressing the server directory itself, in which case
+// all files are located in a single top level directory.
+func findServerRoot(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	if len(entries) == 1 && entries[0].IsDir() {
+		return filepath.Join(dir, entries[0].Name()), nil
+	}
+
+	return dir, nil
+}
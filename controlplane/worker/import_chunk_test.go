@@ -0,0 +1,223 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/worker"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportChunkWorkerCreatesFlavorVersion(t *testing.T) {
+	var (
+		ctx           = context.Background()
+		logger        = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		mockRepo      = mock.NewMockChunkRepository(t)
+		mockStore     = mock.NewMockBlobStore(t)
+		mockJobClient = mock.NewMockJobClient(t)
+		versionID     = test.NewUUIDv7(t)
+		imp           = resource.ChunkImport{
+			ID:               test.NewUUIDv7(t),
+			ChunkID:          fixture.Chunk().ID,
+			FlavorID:         fixture.FlavorID,
+			Version:          "v1",
+			MinecraftVersion: fixture.MinecraftVersion,
+			MinPlayers:       1,
+			MaxPlayers:       10,
+			State:            resource.ChunkImportStateImporting,
+		}
+		// everything is located in a single top level directory,
+		// which should be treated as the server root.
+		archive = zipArchive(t, map[string]string{
+			"server/server.properties":    "motd=hello",
+			"server/plugins/myplugin.jar": "plugin",
+			"server/paper-1.21.10-12.jar": "paper",
+			"server/libraries/lib.jar":    "lib",
+			"server/logs/latest.log":      "log",
+		})
+		expectedPaths = []string{"plugins/myplugin.jar", "server.properties"}
+	)
+
+	mockRepo.EXPECT().ChunkImportByID(mocky.Anything, imp.ID).Return(imp, nil)
+	mockRepo.EXPECT().LatestFlavorVersion(mocky.Anything, imp.FlavorID).Return(resource.FlavorVersion{}, nil)
+	mockRepo.EXPECT().
+		CreateFlavorVersion(
+			mocky.Anything,
+			imp.FlavorID,
+			mocky.MatchedBy(func(v resource.FlavorVersion) bool {
+				paths := make([]string, 0, len(v.FileHashes))
+				for _, h := range v.FileHashes {
+					paths = append(paths, h.Path)
+				}
+				return slices.Equal(expectedPaths, paths) &&
+					v.Hash != "" &&
+					v.Version == imp.Version &&
+					v.MinecraftVersion == imp.MinecraftVersion
+			}),
+			"",
+		).
+		Return(resource.FlavorVersion{
+			ID:               versionID,
+			Version:          imp.Version,
+			MinecraftVersion: imp.MinecraftVersion,
+			BuildStatus:      resource.FlavorVersionBuildStatusPending,
+		}, nil)
+	mockRepo.EXPECT().UpdateChunkImportFlavorVersion(mocky.Anything, imp.ID, versionID).Return(nil)
+	mockRepo.EXPECT().MarkFlavorVersionFilesUploaded(mocky.Anything, versionID).Return(nil)
+	mockRepo.EXPECT().
+		GetMinecraftVersionByVersion(mocky.Anything, imp.MinecraftVersion).
+		Return(resource.MinecraftVersion{Version: imp.MinecraftVersion, ImageURL: "base-image"}, nil)
+	mockRepo.EXPECT().
+		UpdateChunkImportState(mocky.Anything, imp.ID, resource.ChunkImportStateCompleted, "").
+		Return(nil)
+
+	mockStore.EXPECT().
+		WriteTo(mocky.Anything, blob.ChunkImportKey(imp.ID), mocky.Anything).
+		RunAndReturn(func(_ context.Context, _ string, w io.Writer) error {
+			_, err := w.Write(archive)
+			return err
+		})
+	mockStore.EXPECT().
+		SimplePut(mocky.Anything, blob.ChangeSetKey(versionID), mocky.Anything, map[string]string(nil)).
+		RunAndReturn(func(_ context.Context, _ string, r io.Reader, _ map[string]string) error {
+			require.Equal(t, expectedPaths, tarPaths(t, r))
+			return nil
+		})
+	mockStore.EXPECT().Delete(mocky.Anything, blob.ChunkImportKey(imp.ID)).Return(nil)
+
+	mockJobClient.EXPECT().
+		InsertJob(
+			mocky.Anything,
+			versionID,
+			string(resource.FlavorVersionBuildStatusBuildImage),
+			job.CreateImage{
+				FlavorVersionID: versionID,
+				BaseImage:       "base-image",
+				OCIRegistry:     "registry.local",
+			},
+		).
+		Return(nil)
+
+	w := worker.NewImportChunkWorker(logger, mockRepo, mockStore, mockJobClient, worker.ImportChunkWorkerConfig{
+		Registry: "registry.local",
+	})
+
+	err := w.Work(ctx, &river.Job[job.ImportChunk]{
+		Args: job.ImportChunk{
+			ImportID: imp.ID,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestImportChunkWorkerFailsOnUnknownArchiveFormat(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger    = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		mockRepo  = mock.NewMockChunkRepository(t)
+		mockStore = mock.NewMockBlobStore(t)
+		imp       = resource.ChunkImport{
+			ID:       test.NewUUIDv7(t),
+			ChunkID:  fixture.Chunk().ID,
+			FlavorID: fixture.FlavorID,
+			State:    resource.ChunkImportStateImporting,
+		}
+	)
+
+	mockRepo.EXPECT().ChunkImportByID(mocky.Anything, imp.ID).Return(imp, nil)
+	mockRepo.EXPECT().
+		UpdateChunkImportState(mocky.Anything, imp.ID, resource.ChunkImportStateFailed, mocky.Anything).
+		Return(nil)
+
+	mockStore.EXPECT().
+		WriteTo(mocky.Anything, blob.ChunkImportKey(imp.ID), mocky.Anything).
+		RunAndReturn(func(_ context.Context, _ string, w io.Writer) error {
+			_, err := w.Write([]byte("definitely not an archive"))
+			return err
+		})
+
+	w := worker.NewImportChunkWorker(logger, mockRepo, mockStore, nil, worker.ImportChunkWorkerConfig{})
+
+	err := w.Work(ctx, &river.Job[job.ImportChunk]{
+		Args: job.ImportChunk{
+			ImportID: imp.ID,
+		},
+	})
+
+	var cancelErr *river.JobCancelError
+	require.ErrorAs(t, err, &cancelErr)
+}
+
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	var (
+		buf = bytes.Buffer{}
+		zw  = zip.NewWriter(&buf)
+	)
+
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func tarPaths(t *testing.T, r io.Reader) []string {
+	gzr, err := gzip.NewReader(r)
+	require.NoError(t, err)
+
+	var (
+		tr    = tar.NewReader(gzr)
+		paths = make([]string, 0)
+	)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		paths = append(paths, header.Name)
+	}
+
+	slices.Sort(paths)
+	return paths
+}
@@ -0,0 +1,91 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/job"
+)
+
+// expiredLogsBatchSize is the number of expired logs removed at once.
+const expiredLogsBatchSize = 100
+
+// InstanceLogRetentionWorker removes shipped instance logs, whose retention
+// has expired, from the blob store and the database.
+type InstanceLogRetentionWorker struct {
+	river.WorkerDefaults[job.ExpireInstanceLogs]
+
+	logger    *slog.Logger
+	insRepo   instance.Repository
+	blobStore blob.Store
+}
+
+func NewInstanceLogRetentionWorker(
+	logger *slog.Logger,
+	insRepo instance.Repository,
+	blobStore blob.Store,
+) *InstanceLogRetentionWorker {
+	return &InstanceLogRetentionWorker{
+		logger:    logger,
+		insRepo:   insRepo,
+		blobStore: blobStore,
+	}
+}
+
+func (w *InstanceLogRetentionWorker) Work(ctx context.Context, _ *river.Job[job.ExpireInstanceLogs]) error {
+	now := time.Now()
+
+	for {
+		logs, err := w.insRepo.ExpiredInstanceLogs(ctx, now, expiredLogsBatchSize)
+		if err != nil {
+			return fmt.Errorf("expired instance logs: %w", err)
+		}
+
+		if len(logs) == 0 {
+			return nil
+		}
+
+		// objects are removed before their rows, so a failure in between
+		// results in the deletion being retried the next time the job runs.
+		ids := make([]string, 0, len(logs))
+		for _, l := range logs {
+			if err := w.blobStore.Delete(ctx, l.ObjectKey); err != nil {
+				return fmt.Errorf("delete log %s: %w", l.ID, err)
+			}
+			ids = append(ids, l.ID)
+		}
+
+		if err := w.insRepo.DeleteInstanceLogs(ctx, ids); err != nil {
+			return fmt.Errorf("delete instance logs: %w", err)
+		}
+
+		w.logger.InfoContext(ctx, "removed expired instance logs", "count", len(ids))
+
+		if len(logs) < expiredLogsBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/worker"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceLogRetentionWorker(t *testing.T) {
+	var (
+		logger      = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		mockInsRepo = mock.NewMockInstanceRepository(t)
+		mockStore   = mock.NewMockBlobStore(t)
+		logs        = []resource.InstanceLog{
+			{ID: "log-1", ObjectKey: "explorer/logs/ins/log-1.log"},
+			{ID: "log-2", ObjectKey: "explorer/logs/ins/log-2.log"},
+		}
+		start = time.Now()
+	)
+
+	mockInsRepo.
+		EXPECT().
+		ExpiredInstanceLogs(
+			mocky.Anything,
+			mocky.MatchedBy(func(before time.Time) bool {
+				return !before.Before(start) && !before.After(time.Now())
+			}),
+			mocky.Anything,
+		).
+		Return(logs, nil)
+
+	for _, l := range logs {
+		mockStore.EXPECT().Delete(mocky.Anything, l.ObjectKey).Return(nil)
+	}
+
+	mockInsRepo.
+		EXPECT().
+		DeleteInstanceLogs(mocky.Anything, []string{"log-1", "log-2"}).
+		Return(nil)
+
+	w := worker.NewInstanceLogRetentionWorker(logger, mockInsRepo, mockStore)
+	require.NoError(t, w.Work(context.Background(), nil))
+}
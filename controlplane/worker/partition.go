@@ -0,0 +1,103 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/partition"
+)
+
+// partitionsAhead is the number of months partitions are created
+// for in advance, so inserts never end up in the default partition
+// if the job did not run for some time.
+const partitionsAhead = 2
+
+type PartitionWorkerConfig struct {
+	Tables []partition.Table
+}
+
+// PartitionWorker creates upcoming monthly partitions and drops the
+// ones whose rows are all older than the retention of their table.
+type PartitionWorker struct {
+	river.WorkerDefaults[job.MaintainPartitions]
+
+	logger *slog.Logger
+	repo   partition.Repository
+	cfg    PartitionWorkerConfig
+}
+
+func NewPartitionWorker(
+	logger *slog.Logger,
+	repo partition.Repository,
+	cfg PartitionWorkerConfig,
+) *PartitionWorker {
+	return &PartitionWorker{
+		logger: logger,
+		repo:   repo,
+		cfg:    cfg,
+	}
+}
+
+func (w *PartitionWorker) Work(ctx context.Context, _ *river.Job[job.MaintainPartitions]) error {
+	var (
+		now  = time.Now().UTC()
+		errs []error
+	)
+
+	// a failure for one table should not prevent the others from being maintained.
+	for _, t := range w.cfg.Tables {
+		if err := w.maintain(ctx, t, now); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", t.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (w *PartitionWorker) maintain(ctx context.Context, t partition.Table, now time.Time) error {
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i <= partitionsAhead; i++ {
+		if err := w.repo.CreateMonthlyPartition(ctx, t.Name, month.AddDate(0, i, 0)); err != nil {
+			return fmt.Errorf("create partition: %w", err)
+		}
+	}
+
+	if t.Retention <= 0 {
+		return nil
+	}
+
+	dropped, err := w.repo.DropMonthlyPartitionsBefore(ctx, t.Name, now.Add(-t.Retention))
+	if err != nil {
+		return fmt.Errorf("drop partitions: %w", err)
+	}
+
+	if len(dropped) > 0 {
+		w.logger.InfoContext(ctx, "dropped expired partitions", "table", t.Name, "partitions", dropped)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/partition"
+	"github.com/spacechunks/explorer/controlplane/worker"
+	"github.com/spacechunks/explorer/internal/mock"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionWorker(t *testing.T) {
+	var (
+		logger   = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		now      = time.Now().UTC()
+		month    = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		upcoming = []time.Time{month, month.AddDate(0, 1, 0), month.AddDate(0, 2, 0)}
+	)
+
+	tests := []struct {
+		name   string
+		tables []partition.Table
+		prep   func(*mock.MockPartitionRepository)
+		err    bool
+	}{
+		{
+			name: "creates upcoming partitions and drops expired ones",
+			tables: []partition.Table{
+				{Name: partition.TableInstanceEvents, Retention: 24 * time.Hour},
+			},
+			prep: func(repo *mock.MockPartitionRepository) {
+				for _, m := range upcoming {
+					repo.EXPECT().
+						CreateMonthlyPartition(mocky.Anything, partition.TableInstanceEvents, m).
+						Return(nil)
+				}
+				repo.EXPECT().
+					DropMonthlyPartitionsBefore(
+						mocky.Anything,
+						partition.TableInstanceEvents,
+						mocky.MatchedBy(func(cutoff time.Time) bool {
+							return !cutoff.Before(now.Add(-24*time.Hour)) && cutoff.Before(now)
+						}),
+					).
+					Return([]string{"instance_events_202401"}, nil)
+			},
+		},
+		{
+			name: "keeps partitions without retention",
+			tables: []partition.Table{
+				{Name: partition.TableImpersonatedActions},
+			},
+			prep: func(repo *mock.MockPartitionRepository) {
+				for _, m := range upcoming {
+					repo.EXPECT().
+						CreateMonthlyPartition(mocky.Anything, partition.TableImpersonatedActions, m).
+						Return(nil)
+				}
+			},
+		},
+		{
+			name: "failing table does not stop others",
+			tables: []partition.Table{
+				{Name: partition.TableNodeEvents, Retention: time.Hour},
+				{Name: partition.TableImpersonatedActions},
+			},
+			prep: func(repo *mock.MockPartitionRepository) {
+				repo.EXPECT().
+					CreateMonthlyPartition(mocky.Anything, partition.TableNodeEvents, month).
+					Return(errors.New("boom"))
+				for _, m := range upcoming {
+					repo.EXPECT().
+						CreateMonthlyPartition(mocky.Anything, partition.TableImpersonatedActions, m).
+						Return(nil)
+				}
+			},
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mock.NewMockPartitionRepository(t)
+			tt.prep(repo)
+
+			w := worker.NewPartitionWorker(logger, repo, worker.PartitionWorkerConfig{
+				Tables: tt.tables,
+			})
+
+			err := w.Work(context.Background(), nil)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/internal/image"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// RetentionWorker removes the flavor versions of chunks that are no longer
+// retained by the [resource.RetentionPolicy] of their chunk. the base and
+// checkpoint images of a version are deleted from the registry, before the
+// version itself is archived, so images are never left behind without a
+// version referencing them.
+type RetentionWorker struct {
+	river.WorkerDefaults[job.EnforceRetention]
+
+	logger      *slog.Logger
+	chunkRepo   chunk.Repository
+	insRepo     instance.Repository
+	archiveRepo chunk.ArchiveRepository
+	imgService  image.Service
+	registry    string
+}
+
+func NewRetentionWorker(
+	logger *slog.Logger,
+	chunkRepo chunk.Repository,
+	insRepo instance.Repository,
+	archiveRepo chunk.ArchiveRepository,
+	imgService image.Service,
+	registry string,
+) *RetentionWorker {
+	return &RetentionWorker{
+		logger:      logger,
+		chunkRepo:   chunkRepo,
+		insRepo:     insRepo,
+		archiveRepo: archiveRepo,
+		imgService:  imgService,
+		registry:    registry,
+	}
+}
+
+func (w *RetentionWorker) Work(ctx context.Context, _ *river.Job[job.EnforceRetention]) error {
+	policies, err := w.chunkRepo.AllRetentionPolicies(ctx)
+	if err != nil {
+		return fmt.Errorf("get all retention policies: %w", err)
+	}
+
+	for _, p := range policies {
+		if p.KeepLastVersions == 0 && p.KeepDays == 0 {
+			continue
+		}
+
+		logger := w.logger.With("chunk_id", p.ChunkID)
+
+		c, err := w.chunkRepo.GetChunkByID(ctx, p.ChunkID)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to get chunk", "err", err)
+			continue
+		}
+
+		for _, f := range c.Flavors {
+			// versions of deleted flavors are taken care of by the archive worker
+			if f.DeletedAt != nil {
+				continue
+			}
+
+			w.enforceFlavor(ctx, logger.With("flavor_id", f.ID), p, f)
+		}
+	}
+
+	return nil
+}
+
+func (w *RetentionWorker) enforceFlavor(
+	ctx context.Context,
+	logger *slog.Logger,
+	policy resource.RetentionPolicy,
+	flavor resource.Flavor,
+) {
+	versions := slices.Clone(flavor.Versions)
+	slices.SortFunc(versions, func(a, b resource.FlavorVersion) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	// versions published to a channel are launched by players and test
+	// instances, so they are kept until they are replaced by another version.
+	channels, err := w.chunkRepo.FlavorChannels(ctx, flavor.ID)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to get flavor channels", "err", err)
+		return
+	}
+
+	published := make(map[string]bool, len(channels))
+	for _, c := range channels {
+		published[c.FlavorVersionID] = true
+	}
+
+	maxAge := time.Duration(policy.KeepDays) * 24 * time.Hour
+
+	for i, v := range versions {
+		if policy.KeepLastVersions > 0 && i < int(policy.KeepLastVersions) {
+			continue
+		}
+
+		if published[v.ID] {
+			continue
+		}
+
+		if policy.KeepDays > 0 && time.Since(v.CreatedAt) < maxAge {
+			continue
+		}
+
+		if err := w.tryRemoveFlavorVersion(ctx, logger, flavor.ID, v); err != nil {
+			logger.ErrorContext(
+				ctx,
+				"failed to remove flavor version",
+				"flavor_version_id", v.ID,
+				"err", err,
+			)
+		}
+	}
+}
+
+func (w *RetentionWorker) tryRemoveFlavorVersion(
+	ctx context.Context,
+	logger *slog.Logger,
+	flavorID string,
+	version resource.FlavorVersion,
+) error {
+	if !buildFinished(version.BuildStatus) {
+		return nil
+	}
+
+	c, err := w.insRepo.CountInstancesByFlavorVersionID(ctx, version.ID)
+	if err != nil {
+		return fmt.Errorf("count instances: %w", err)
+	}
+
+	if c > 0 {
+		return nil
+	}
+
+	canary, err := w.chunkRepo.CanaryByFlavorVersionID(ctx, version.ID)
+	if err != nil && !errors.Is(err, apierrs.ErrNotFound) {
+		return fmt.Errorf("canary: %w", err)
+	}
+
+	if err == nil && canary.State == resource.CanaryStateActive {
+		return nil
+	}
+
+	logger.InfoContext(ctx, "removing flavor version", "flavor_version_id", version.ID)
+
+	for _, tag := range []string{"base", "checkpoint"} {
+		if err := w.deleteImage(ctx, fmt.Sprintf("%s/%s:%s", w.registry, version.ID, tag)); err != nil {
+			return fmt.Errorf("delete %s image: %w", tag, err)
+		}
+	}
+
+	if err := w.archiveRepo.ArchiveFlavorVersion(ctx, flavorID, version); err != nil {
+		return fmt.Errorf("archive flavor version: %w", err)
+	}
+
+	return nil
+}
+
+// deleteImage deletes the image including its signature and sbom. images
+// that do not exist are skipped, because builds might have failed before
+// pushing them or a previous attempt already deleted them.
+func (w *RetentionWorker) deleteImage(ctx context.Context, imgRef string) error {
+	img, err := w.imgService.Fetch(ctx, imgRef)
+	if err != nil {
+		if errors.Is(err, image.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return fmt.Errorf("digest: %w", err)
+	}
+
+	ref, err := name.ParseReference(imgRef)
+	if err != nil {
+		return fmt.Errorf("parse ref: %w", err)
+	}
+
+	// the image itself is deleted last, otherwise its
+	// signature and sbom could not be found anymore if
+	// deleting them fails.
+	refs := []string{
+		image.SignatureRef(ref.Context(), digest),
+		image.SBOMRef(ref.Context(), digest),
+		imgRef,
+	}
+
+	for _, r := range refs {
+		if err := w.imgService.Delete(ctx, r); err != nil && !errors.Is(err, image.ErrNotFound) {
+			return fmt.Errorf("delete %s: %w", r, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/riverqueue/river"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/worker"
+	"github.com/spacechunks/explorer/internal/image"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test/fixture"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionWorker(t *testing.T) {
+	const registry = "registry.example.com"
+
+	var (
+		now    = time.Now()
+		policy = resource.RetentionPolicy{
+			ChunkID:          fixture.Chunk().ID,
+			KeepLastVersions: 1,
+			KeepDays:         30,
+		}
+		latest = fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+			v.ID = "019df2a4-1b4e-7c1a-8d2f-1f0b7c5e2a01"
+			v.BuildStatus = resource.FlavorVersionBuildStatusCompleted
+			v.CreatedAt = now.Add(-5 * 24 * time.Hour)
+		})
+		young = fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+			v.ID = "019df2a4-1b4e-7c1a-8d2f-1f0b7c5e2a02"
+			v.BuildStatus = resource.FlavorVersionBuildStatusCompleted
+			v.CreatedAt = now.Add(-10 * 24 * time.Hour)
+		})
+		expired = fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+			v.ID = "019df2a4-1b4e-7c1a-8d2f-1f0b7c5e2a03"
+			v.BuildStatus = resource.FlavorVersionBuildStatusCompleted
+			v.CreatedAt = now.Add(-90 * 24 * time.Hour)
+		})
+	)
+
+	// only expired is a candidate for being removed. latest is kept,
+	// because it is the most recent version and young, because it
+	// has been created less than 30 days ago.
+	tests := []struct {
+		name     string
+		policy   resource.RetentionPolicy
+		version  func(v *resource.FlavorVersion)
+		channels []resource.FlavorChannel
+		prep     func(*mock.MockChunkRepository, *mock.MockInstanceRepository, *mock.MockImageService)
+		removed  bool
+	}{
+		{
+			name:   "removes expired version",
+			policy: policy,
+			prep: func(
+				chunkRepo *mock.MockChunkRepository,
+				insRepo *mock.MockInstanceRepository,
+				imgService *mock.MockImageService,
+			) {
+				insRepo.EXPECT().CountInstancesByFlavorVersionID(mocky.Anything, expired.ID).Return(uint(0), nil)
+				chunkRepo.EXPECT().
+					CanaryByFlavorVersionID(mocky.Anything, expired.ID).
+					Return(resource.Canary{}, apierrs.ErrNotFound)
+
+				digest, err := empty.Image.Digest()
+				require.NoError(t, err)
+
+				for _, tag := range []string{"base", "checkpoint"} {
+					ref := registry + "/" + expired.ID + ":" + tag
+					repo, err := name.NewRepository(registry + "/" + expired.ID)
+					require.NoError(t, err)
+
+					imgService.EXPECT().Fetch(mocky.Anything, ref).Return(empty.Image, nil)
+					imgService.EXPECT().
+						Delete(mocky.Anything, image.SignatureRef(repo, digest)).
+						Return(image.ErrNotFound)
+					imgService.EXPECT().Delete(mocky.Anything, image.SBOMRef(repo, digest)).Return(nil)
+					imgService.EXPECT().Delete(mocky.Anything, ref).Return(nil)
+				}
+			},
+			removed: true,
+		},
+		{
+			name:   "skips missing images",
+			policy: policy,
+			prep: func(
+				chunkRepo *mock.MockChunkRepository,
+				insRepo *mock.MockInstanceRepository,
+				imgService *mock.MockImageService,
+			) {
+				insRepo.EXPECT().CountInstancesByFlavorVersionID(mocky.Anything, expired.ID).Return(uint(0), nil)
+				chunkRepo.EXPECT().
+					CanaryByFlavorVersionID(mocky.Anything, expired.ID).
+					Return(resource.Canary{}, apierrs.ErrNotFound)
+				imgService.EXPECT().Fetch(mocky.Anything, mocky.Anything).Return(nil, image.ErrNotFound).Twice()
+			},
+			removed: true,
+		},
+		{
+			name:   "keeps version referenced by instances",
+			policy: policy,
+			prep: func(
+				_ *mock.MockChunkRepository,
+				insRepo *mock.MockInstanceRepository,
+				_ *mock.MockImageService,
+			) {
+				insRepo.EXPECT().CountInstancesByFlavorVersionID(mocky.Anything, expired.ID).Return(uint(1), nil)
+			},
+		},
+		{
+			name:   "keeps version of active canary",
+			policy: policy,
+			prep: func(
+				chunkRepo *mock.MockChunkRepository,
+				insRepo *mock.MockInstanceRepository,
+				_ *mock.MockImageService,
+			) {
+				insRepo.EXPECT().CountInstancesByFlavorVersionID(mocky.Anything, expired.ID).Return(uint(0), nil)
+				chunkRepo.EXPECT().
+					CanaryByFlavorVersionID(mocky.Anything, expired.ID).
+					Return(resource.Canary{State: resource.CanaryStateActive}, nil)
+			},
+		},
+		{
+			name:   "keeps version published to a channel",
+			policy: policy,
+			channels: []resource.FlavorChannel{
+				{
+					Name:            resource.FlavorChannelStable,
+					FlavorVersionID: expired.ID,
+				},
+			},
+			prep: func(*mock.MockChunkRepository, *mock.MockInstanceRepository, *mock.MockImageService) {},
+		},
+		{
+			name:   "keeps version that is being built",
+			policy: policy,
+			version: func(v *resource.FlavorVersion) {
+				v.BuildStatus = resource.FlavorVersionBuildStatusBuildCheckpoint
+			},
+			prep: func(*mock.MockChunkRepository, *mock.MockInstanceRepository, *mock.MockImageService) {},
+		},
+		{
+			name: "keeps all versions if policy is disabled",
+			policy: resource.RetentionPolicy{
+				ChunkID: policy.ChunkID,
+			},
+			prep: func(*mock.MockChunkRepository, *mock.MockInstanceRepository, *mock.MockImageService) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx             = context.Background()
+				logger          = slog.New(slog.NewTextHandler(os.Stdout, nil))
+				mockChunkRepo   = mock.NewMockChunkRepository(t)
+				mockInsRepo     = mock.NewMockInstanceRepository(t)
+				mockArchiveRepo = mock.NewMockChunkArchiveRepository(t)
+				mockImgService  = mock.NewMockImageService(t)
+				exp             = expired
+			)
+
+			if tt.version != nil {
+				tt.version(&exp)
+			}
+
+			flavor := fixture.Flavor(func(f *resource.Flavor) {
+				// versions are intentionally out of order, to make
+				// sure they are sorted by their creation date.
+				f.Versions = []resource.FlavorVersion{exp, latest, young}
+			})
+
+			c := fixture.Chunk(func(c *resource.Chunk) {
+				c.Flavors = []resource.Flavor{flavor}
+			})
+
+			mockChunkRepo.EXPECT().AllRetentionPolicies(mocky.Anything).Return([]resource.RetentionPolicy{tt.policy}, nil)
+
+			if tt.policy.KeepLastVersions > 0 || tt.policy.KeepDays > 0 {
+				mockChunkRepo.EXPECT().GetChunkByID(mocky.Anything, c.ID).Return(c, nil)
+				mockChunkRepo.EXPECT().FlavorChannels(mocky.Anything, flavor.ID).Return(tt.channels, nil)
+			}
+
+			tt.prep(mockChunkRepo, mockInsRepo, mockImgService)
+
+			if tt.removed {
+				mockArchiveRepo.EXPECT().ArchiveFlavorVersion(mocky.Anything, flavor.ID, exp).Return(nil)
+			}
+
+			w := worker.NewRetentionWorker(
+				logger,
+				mockChunkRepo,
+				mockInsRepo,
+				mockArchiveRepo,
+				mockImgService,
+				registry,
+			)
+
+			err := w.Work(ctx, &river.Job[job.EnforceRetention]{})
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/signing"
+)
+
+// RotateSigningKeyWorker generates new signing keys once the active key is
+// older than the rotation period and removes keys that are not accepted anymore.
+// running it as job ensures only a single replica rotates keys at a time.
+type RotateSigningKeyWorker struct {
+	river.WorkerDefaults[job.RotateSigningKey]
+
+	keyRing *signing.KeyRing
+}
+
+func NewRotateSigningKeyWorker(keyRing *signing.KeyRing) *RotateSigningKeyWorker {
+	return &RotateSigningKeyWorker{
+		keyRing: keyRing,
+	}
+}
+
+func (w *RotateSigningKeyWorker) Work(ctx context.Context, _ *river.Job[job.RotateSigningKey]) error {
+	if err := w.keyRing.Rotate(ctx); err != nil {
+		return fmt.Errorf("rotate signing key: %w", err)
+	}
+	return nil
+}
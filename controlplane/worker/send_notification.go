@@ -0,0 +1,106 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/notification"
+)
+
+// SendNotificationWorker sends notifications to users that opted into them.
+// preferences are checked when the job runs, so users that opt out stop
+// receiving notifications that have not been sent yet.
+type SendNotificationWorker struct {
+	river.WorkerDefaults[job.SendNotification]
+
+	logger *slog.Logger
+	repo   notification.Repository
+	sender notification.Sender
+}
+
+func NewSendNotificationWorker(
+	logger *slog.Logger,
+	repo notification.Repository,
+	sender notification.Sender,
+) *SendNotificationWorker {
+	return &SendNotificationWorker{
+		logger: logger,
+		repo:   repo,
+		sender: sender,
+	}
+}
+
+func (w *SendNotificationWorker) Work(ctx context.Context, riverJob *river.Job[job.SendNotification]) error {
+	args := riverJob.Args
+
+	if err := args.Validate(); err != nil {
+		return river.JobCancel(fmt.Errorf("validate args: %w", err))
+	}
+
+	var (
+		rcpt notification.Recipient
+		data notification.Data
+		err  error
+	)
+
+	if args.InstanceID != "" {
+		rcpt, data, err = w.repo.InstanceNotificationData(ctx, args.InstanceID)
+	} else {
+		rcpt, data, err = w.repo.BuildNotificationData(ctx, args.FlavorVersionID)
+	}
+
+	if errors.Is(err, notification.ErrRecipientNotFound) {
+		// the resource has been deleted in the meantime, there
+		// is nobody we could send the notification to anymore.
+		return river.JobCancel(err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("notification data: %w", err)
+	}
+
+	logger := w.logger.With("type", args.Type, "flavor_version_id", args.FlavorVersionID, "instance_id", args.InstanceID)
+
+	if !rcpt.Wants(args.Type) {
+		logger.DebugContext(ctx, "recipient did not opt into notification")
+		return nil
+	}
+
+	if args.Reason != "" {
+		data.Reason = args.Reason
+	}
+
+	msg, err := notification.Render(args.Type, rcpt.Email, data)
+	if err != nil {
+		return river.JobCancel(fmt.Errorf("render notification: %w", err))
+	}
+
+	if err := w.sender.Send(ctx, msg); err != nil {
+		return fmt.Errorf("send notification: %w", err)
+	}
+
+	logger.InfoContext(ctx, "notification sent")
+	return nil
+}
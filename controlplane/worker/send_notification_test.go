@@ -0,0 +1,171 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/notification"
+	"github.com/spacechunks/explorer/controlplane/worker"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendNotificationWorker(t *testing.T) {
+	var (
+		flavorVersionID = "019532e8-6d95-7a0e-a6c7-a7b4a3d6c1f2"
+		instanceID      = "019532e8-6d95-7a0e-a6c7-a7b4a3d6c1f3"
+		data            = notification.Data{
+			ChunkName:     "chunk",
+			FlavorName:    "flavor",
+			FlavorVersion: "v1",
+		}
+	)
+
+	tests := []struct {
+		name  string
+		args  job.SendNotification
+		prep  func(*mock.MockNotificationRepository, *mock.MockNotificationSender)
+		check func(*testing.T, error)
+	}{
+		{
+			name: "send build failed notification",
+			args: job.SendNotification{
+				Type:            notification.KindBuildFailed,
+				FlavorVersionID: flavorVersionID,
+			},
+			prep: func(repo *mock.MockNotificationRepository, sender *mock.MockNotificationSender) {
+				d := data
+				d.Reason = "too many files"
+				repo.EXPECT().
+					BuildNotificationData(mocky.Anything, flavorVersionID).
+					Return(notification.Recipient{
+						Email: "user@example.com",
+						Preferences: resource.NotificationPreferences{
+							BuildFailed: true,
+						},
+					}, d, nil)
+				sender.EXPECT().
+					Send(mocky.Anything, mocky.MatchedBy(func(msg notification.Message) bool {
+						return msg.To == "user@example.com" &&
+							msg.Subject == "chunk: building flavor v1 failed" &&
+							strings.Contains(msg.Body, "Reason: too many files")
+					})).
+					Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "send instance crashed notification",
+			args: job.SendNotification{
+				Type:       notification.KindInstanceCrashed,
+				InstanceID: instanceID,
+				EventID:    "019532e8-6d95-7a0e-a6c7-a7b4a3d6c1f4",
+				Reason:     "container abc exited with code 1",
+			},
+			prep: func(repo *mock.MockNotificationRepository, sender *mock.MockNotificationSender) {
+				d := data
+				d.InstanceID = instanceID
+				repo.EXPECT().
+					InstanceNotificationData(mocky.Anything, instanceID).
+					Return(notification.Recipient{
+						Email: "user@example.com",
+						Preferences: resource.NotificationPreferences{
+							InstanceCrashed: true,
+						},
+					}, d, nil)
+				sender.EXPECT().
+					Send(mocky.Anything, mocky.MatchedBy(func(msg notification.Message) bool {
+						return msg.Subject == "chunk: instance "+instanceID+" crashed" &&
+							strings.Contains(msg.Body, "container abc exited with code 1")
+					})).
+					Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "do not send if recipient did not opt in",
+			args: job.SendNotification{
+				Type:            notification.KindBuildCompleted,
+				FlavorVersionID: flavorVersionID,
+			},
+			prep: func(repo *mock.MockNotificationRepository, _ *mock.MockNotificationSender) {
+				repo.EXPECT().
+					BuildNotificationData(mocky.Anything, flavorVersionID).
+					Return(notification.Recipient{
+						Email: "user@example.com",
+						Preferences: resource.NotificationPreferences{
+							BuildFailed: true,
+						},
+					}, data, nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "cancel if recipient does not exist anymore",
+			args: job.SendNotification{
+				Type:            notification.KindBuildCompleted,
+				FlavorVersionID: flavorVersionID,
+			},
+			prep: func(repo *mock.MockNotificationRepository, _ *mock.MockNotificationSender) {
+				repo.EXPECT().
+					BuildNotificationData(mocky.Anything, flavorVersionID).
+					Return(notification.Recipient{}, notification.Data{}, notification.ErrRecipientNotFound)
+			},
+			check: func(t *testing.T, err error) {
+				var cancelErr *river.JobCancelError
+				require.ErrorAs(t, err, &cancelErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				logger     = slog.New(slog.NewTextHandler(os.Stdout, nil))
+				mockRepo   = mock.NewMockNotificationRepository(t)
+				mockSender = mock.NewMockNotificationSender(t)
+			)
+
+			tt.prep(mockRepo, mockSender)
+
+			w := worker.NewSendNotificationWorker(logger, mockRepo, mockSender)
+
+			err := w.Work(context.Background(), &river.Job[job.SendNotification]{
+				Args: tt.args,
+			})
+
+			tt.check(t, err)
+		})
+	}
+}
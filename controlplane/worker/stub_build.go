@@ -0,0 +1,102 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/chunk"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// StubCreateImageWorker is used in dev mode in place of CreateImageWorker.
+// it does not pull or push any images, but only advances the build status
+// of the flavor version, so the build pipeline can be exercised without
+// an oci registry.
+type StubCreateImageWorker struct {
+	logger *slog.Logger
+	river.WorkerDefaults[job.CreateImage]
+	jobClient job.Client
+}
+
+func NewStubCreateImageWorker(logger *slog.Logger, jobClient job.Client) *StubCreateImageWorker {
+	return &StubCreateImageWorker{
+		logger:    logger,
+		jobClient: jobClient,
+	}
+}
+
+func (w *StubCreateImageWorker) Work(ctx context.Context, riverJob *river.Job[job.CreateImage]) error {
+	if err := riverJob.Args.Validate(); err != nil {
+		return fmt.Errorf("validate args: %w", err)
+	}
+
+	ref := fmt.Sprintf("%s/%s:base", riverJob.Args.OCIRegistry, riverJob.Args.FlavorVersionID)
+
+	w.logger.InfoContext(ctx, "skipping image build", "flavor_version_id", riverJob.Args.FlavorVersionID, "ref", ref)
+
+	if err := w.jobClient.InsertJob(
+		ctx,
+		riverJob.Args.FlavorVersionID,
+		string(resource.FlavorVersionBuildStatusBuildCheckpoint),
+		job.CreateCheckpoint{
+			FlavorVersionID:     riverJob.Args.FlavorVersionID,
+			BaseImageURL:        ref,
+			SpanContext:         riverJob.Args.SpanContext,
+			CheckpointResources: riverJob.Args.CheckpointResources,
+		}); err != nil {
+		return fmt.Errorf("insert create checkpoint job: %w", err)
+	}
+
+	return nil
+}
+
+// StubCreateCheckpointWorker is used in dev mode in place of
+// CreateCheckpointWorker. it marks the flavor version as completed
+// without contacting any platformd node.
+type StubCreateCheckpointWorker struct {
+	logger *slog.Logger
+	river.WorkerDefaults[job.CreateCheckpoint]
+	chunkRepo chunk.Repository
+}
+
+func NewStubCreateCheckpointWorker(logger *slog.Logger, chunkRepo chunk.Repository) *StubCreateCheckpointWorker {
+	return &StubCreateCheckpointWorker{
+		logger:    logger,
+		chunkRepo: chunkRepo,
+	}
+}
+
+func (w *StubCreateCheckpointWorker) Work(ctx context.Context, riverJob *river.Job[job.CreateCheckpoint]) error {
+	w.logger.InfoContext(ctx, "skipping checkpoint", "flavor_version_id", riverJob.Args.FlavorVersionID)
+
+	if err := w.chunkRepo.UpdateFlavorVersionBuildStatus(
+		ctx,
+		riverJob.Args.FlavorVersionID,
+		resource.FlavorVersionBuildStatusCompleted,
+	); err != nil {
+		return fmt.Errorf("flavor version build status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package worker_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/riverqueue/river"
+	"github.com/spacechunks/explorer/controlplane/job"
+	"github.com/spacechunks/explorer/controlplane/worker"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStubCreateImageWorkerInsertsCheckpointJob(t *testing.T) {
+	var (
+		ctx             = context.Background()
+		logger          = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		mockJobClient   = mock.NewMockJobClient(t)
+		flavorVersionID = test.NewUUIDv7(t)
+	)
+
+	mockJobClient.EXPECT().
+		InsertJob(
+			mocky.Anything,
+			flavorVersionID,
+			string(resource.FlavorVersionBuildStatusBuildCheckpoint),
+			job.CreateCheckpoint{
+				FlavorVersionID: flavorVersionID,
+				BaseImageURL:    "registry.local/" + flavorVersionID + ":base",
+			},
+		).
+		Return(nil)
+
+	w := worker.NewStubCreateImageWorker(logger, mockJobClient)
+
+	err := w.Work(ctx, &river.Job[job.CreateImage]{
+		Args: job.CreateImage{
+			FlavorVersionID: flavorVersionID,
+			BaseImage:       "base-image",
+			OCIRegistry:     "registry.local",
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestStubCreateCheckpointWorkerCompletesBuild(t *testing.T) {
+	var (
+		ctx             = context.Background()
+		logger          = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		mockChunkRepo   = mock.NewMockChunkRepository(t)
+		flavorVersionID = test.NewUUIDv7(t)
+	)
+
+	mockChunkRepo.EXPECT().
+		UpdateFlavorVersionBuildStatus(mocky.Anything, flavorVersionID, resource.FlavorVersionBuildStatusCompleted).
+		Return(nil)
+
+	w := worker.NewStubCreateCheckpointWorker(logger, mockChunkRepo)
+
+	err := w.Work(ctx, &river.Job[job.CreateCheckpoint]{
+		Args: job.CreateCheckpoint{
+			FlavorVersionID: flavorVersionID,
+			BaseImageURL:    "registry.local/" + flavorVersionID + ":base",
+		},
+	})
+	require.NoError(t, err)
+}
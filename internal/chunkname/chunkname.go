@@ -0,0 +1,88 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package chunkname implements the rules chunk names have to follow. The
+// rules are shared between the cli and the control plane, so publishing a
+// chunk with an invalid name fails before anything is uploaded.
+package chunkname
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+var (
+	ErrEmpty   = errors.New("name must not be empty")
+	ErrTooLong = errors.New("name can be up to 50 characters")
+	ErrInvalid = errors.New(
+		"names cannot start or end with a space, underscore or slash. " +
+			"they cannot contain linux path operators (.. or ../ or /)",
+	)
+	ErrNoLetters = errors.New("name has to contain at least one letter or number")
+)
+
+// Validate returns an error if the name cannot be used for a chunk.
+func Validate(name string) error {
+	if name == "" {
+		return ErrEmpty
+	}
+
+	if utf8.RuneCountInString(name) > resource.MaxChunkNameChars {
+		return ErrTooLong
+	}
+
+	if strings.ContainsAny(name[:1], "._ /") ||
+		strings.ContainsAny(name[len(name)-1:], "._ /") ||
+		strings.Contains(name, "/") {
+		return ErrInvalid
+	}
+
+	if Slug(name) == "" {
+		return ErrNoLetters
+	}
+
+	return nil
+}
+
+// Slug returns the normalized form of the name chunk names are reserved
+// by. it consists of lower-case ascii letters and numbers separated by
+// dashes, so names only differing in case or punctuation, like "Sky Wars"
+// and "sky-wars", are considered the same.
+func Slug(name string) string {
+	var (
+		b    strings.Builder
+		dash bool
+	)
+
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+
+	return b.String()
+}
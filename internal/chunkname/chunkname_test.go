@@ -0,0 +1,67 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package chunkname_test
+
+import (
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/chunkname"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "skywars", expected: "skywars"},
+		{name: "Sky Wars", expected: "sky-wars"},
+		{name: "sky--wars", expected: "sky-wars"},
+		{name: "Sky_Wars 2!", expected: "sky-wars-2"},
+		{name: "-- Büßer --", expected: "b-er"},
+		{name: "!!!", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, chunkname.Slug(tt.name))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "Sky Wars"},
+		{name: "", err: chunkname.ErrEmpty},
+		{name: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", err: chunkname.ErrTooLong},
+		{name: " skywars", err: chunkname.ErrInvalid},
+		{name: "skywars_", err: chunkname.ErrInvalid},
+		{name: "sky/wars", err: chunkname.ErrInvalid},
+		{name: "!!!", err: chunkname.ErrNoLetters},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, chunkname.Validate(tt.name), tt.err)
+		})
+	}
+}
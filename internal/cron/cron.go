@@ -0,0 +1,264 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package cron parses standard 5-field cron expressions and computes the
+// times they match. The fields are minute, hour, day of month, month and
+// day of week. Each field supports *, lists (1,2), ranges (1-5) and steps
+// (*/15 or 1-30/5). Months and weekdays can also be given by their three
+// letter names. Like in most cron implementations, if both day of month
+// and day of week are restricted, a day matches if either of them does.
+// The macros @yearly, @monthly, @weekly, @daily and @hourly are supported
+// as well.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearchYears bounds the search for the next matching time, so
+// expressions that never match, like 0 0 30 2 *, do not loop forever.
+const maxSearchYears = 5
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	monthNames = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+	dayNames = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+)
+
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: monthNames},
+	// 7 is accepted as sunday as well and folded into 0 after parsing.
+	{name: "day of week", min: 0, max: 7, names: dayNames},
+}
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// whether day of month or day of week are *, see [Schedule.dayMatches].
+	domStar bool
+	dowStar bool
+}
+
+// Parse parses the cron expression.
+func Parse(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if m, ok := macros[strings.ToLower(expr)]; ok {
+		expr = m
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return Schedule{}, fmt.Errorf("expected %d fields, got %d", len(fields), len(parts))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, p := range parts {
+		b, err := parseField(p, fields[i])
+		if err != nil {
+			return Schedule{}, fmt.Errorf("%s: %w", fields[i].name, err)
+		}
+		bits[i] = b
+	}
+
+	// sunday can be given as 0 or 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	return Schedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: parts[2] == "*" || parts[2] == "?",
+		dowStar: parts[4] == "*" || parts[4] == "?",
+	}, nil
+}
+
+func parseField(s string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(s, ",") {
+		b, err := parseItem(item, f)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func parseItem(item string, f field) (uint64, error) {
+	var (
+		rng, stepStr, hasStep = strings.Cut(item, "/")
+		lo, hi                = f.min, f.max
+		step                  = 1
+	)
+
+	if hasStep {
+		n, err := strconv.Atoi(stepStr)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid step %q", stepStr)
+		}
+		step = n
+	}
+
+	switch {
+	case rng == "*" || rng == "?":
+	case strings.Contains(rng, "-"):
+		from, to, _ := strings.Cut(rng, "-")
+
+		var err error
+		if lo, err = parseValue(from, f); err != nil {
+			return 0, err
+		}
+
+		if hi, err = parseValue(to, f); err != nil {
+			return 0, err
+		}
+
+		if lo > hi {
+			return 0, fmt.Errorf("invalid range %q", rng)
+		}
+	default:
+		v, err := parseValue(rng, f)
+		if err != nil {
+			return 0, err
+		}
+
+		lo = v
+		// 5/10 means every 10 starting at 5, like 5-max/10
+		if !hasStep {
+			hi = v
+		}
+	}
+
+	var bits uint64
+	for v := lo; v <= hi; v += step {
+		bits |= 1 << v
+	}
+	return bits, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// Next returns the first time after t the schedule matches, in the location
+// of t. the zero time is returned if the schedule does not match within
+// the next years, e.g. because it names a day that does not exist.
+func (s Schedule) Next(t time.Time) time.Time {
+	var (
+		loc   = t.Location()
+		limit = t.AddDate(maxSearchYears, 0, 0)
+	)
+
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			// during daylight saving time changes adding an hour to
+			// the wall clock can yield the same instant again.
+			if !next.After(t) {
+				next = t.Add(time.Hour).Truncate(time.Hour)
+			}
+			t = next
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// dayMatches reports whether the day of t matches. if both day of month and
+// day of week are restricted, it is enough if one of them matches.
+func (s Schedule) dayMatches(t time.Time) bool {
+	var (
+		dom = s.dom&(1<<uint(t.Day())) != 0
+		dow = s.dow&(1<<uint(t.Weekday())) != 0
+	)
+
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
@@ -0,0 +1,167 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/cron"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNext(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		expr     string
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			name: "every 15 minutes",
+			expr: "*/15 * * * *",
+			from: time.Date(2026, 1, 1, 10, 7, 30, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2026, 1, 1, 10, 15, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 10, 45, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "exact time is skipped",
+			expr: "0 10 * * *",
+			from: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "weekends with names",
+			expr: "0 18 * * sat,SUN",
+			// thursday
+			from: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2026, 1, 3, 18, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 4, 18, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 10, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 0 * * 7",
+			from: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "day of month or day of week",
+			expr: "0 0 13 * fri",
+			from: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2026, 2, 6, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 2, 13, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "ranges and steps",
+			expr: "5/20 9-17/4 1-2 jan-feb *",
+			from: time.Date(2026, 1, 2, 17, 50, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2026, 2, 1, 9, 5, 0, 0, time.UTC),
+				time.Date(2026, 2, 1, 9, 25, 0, 0, time.UTC),
+				time.Date(2026, 2, 1, 9, 45, 0, 0, time.UTC),
+				time.Date(2026, 2, 1, 13, 5, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "macro",
+			expr: "@monthly",
+			from: time.Date(2026, 12, 15, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "time zone",
+			expr: "0 10 * * *",
+			from: time.Date(2026, 1, 1, 0, 0, 0, 0, berlin),
+			expected: []time.Time{
+				time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "hour skipped by daylight saving time",
+			expr: "30 2 * * *",
+			from: time.Date(2026, 3, 28, 12, 0, 0, 0, berlin),
+			expected: []time.Time{
+				// 2:30 does not exist on march 29th
+				time.Date(2026, 3, 30, 2, 30, 0, 0, berlin),
+			},
+		},
+		{
+			name: "day that does not exist",
+			expr: "0 0 30 2 *",
+			from: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := cron.Parse(tt.expr)
+			require.NoError(t, err)
+
+			next := tt.from
+			for _, expected := range tt.expected {
+				next = s.Next(next)
+				require.True(t, expected.Equal(next), "expected %s, got %s", expected, next)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"* * * foo *",
+		"@every",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := cron.Parse(expr)
+			require.Error(t, err)
+		})
+	}
+}
@@ -42,6 +42,33 @@ const (
 	mapPinPath  = "/sys/fs/bpf/maps"
 )
 
+// VethPinPaths returns the paths of all links pinned when
+// attaching programs to the given veth pair.
+func VethPinPaths(veth VethPair) []string {
+	return []string{
+		snatPinPath(veth.HostPeer.Iface.Name),
+		arpPinPath(veth.HostPeer.Iface.Name),
+		hostPeerEgressPinPath(veth.HostPeer.Iface.Name),
+		ctrPeerEgressPinPath(veth.PodPeer.Iface.Name),
+	}
+}
+
+func snatPinPath(iface string) string {
+	return fmt.Sprintf("%s/snat_%s", ProgPinPath, iface)
+}
+
+func arpPinPath(iface string) string {
+	return fmt.Sprintf("%s/arp_%s", ProgPinPath, iface)
+}
+
+func hostPeerEgressPinPath(iface string) string {
+	return fmt.Sprintf("%s/host_peer_egress_%s", ProgPinPath, iface)
+}
+
+func ctrPeerEgressPinPath(iface string) string {
+	return fmt.Sprintf("%s/ctr_peer_egress_%s", ProgPinPath, iface)
+}
+
 type Objects struct {
 	snatObjs   snatObjects
 	dnatObjs   dnatObjects
@@ -156,7 +183,7 @@ func (o *Objects) AttachAndPinSNAT(iface *net.Interface) error {
 	}
 
 	// pin because cni is short-lived
-	if err := l.Pin(fmt.Sprintf("%s/snat_%s", ProgPinPath, iface.Name)); err != nil {
+	if err := l.Pin(snatPinPath(iface.Name)); err != nil {
 		return fmt.Errorf("pin: %w", err)
 	}
 
@@ -196,7 +223,7 @@ func (o *Objects) AttachAndPinARP(iface *net.Interface) error {
 	}
 
 	// pin because cni is short-lived
-	if err := l.Pin(fmt.Sprintf("%s/arp_%s", ProgPinPath, iface.Name)); err != nil {
+	if err := l.Pin(arpPinPath(iface.Name)); err != nil {
 		return fmt.Errorf("pin: %w", err)
 	}
 
@@ -233,7 +260,7 @@ func (o *Objects) AttachTProxyHostEgress(hostPeer *net.Interface) error {
 		return fmt.Errorf("attach: %w", err)
 	}
 
-	if err := l.Pin(fmt.Sprintf("%s/host_peer_egress_%s", ProgPinPath, hostPeer.Name)); err != nil {
+	if err := l.Pin(hostPeerEgressPinPath(hostPeer.Name)); err != nil {
 		return fmt.Errorf("pin: %w", err)
 	}
 
@@ -250,7 +277,7 @@ func (o *Objects) AttachTProxyCtrEgress(ctrPeer *net.Interface) error {
 		return fmt.Errorf("attach: %w", err)
 	}
 
-	if err := l.Pin(fmt.Sprintf("%s/ctr_peer_egress_%s", ProgPinPath, ctrPeer.Name)); err != nil {
+	if err := l.Pin(ctrPeerEgressPinPath(ctrPeer.Name)); err != nil {
 		return fmt.Errorf("pin: %w", err)
 	}
 
@@ -380,6 +407,166 @@ func (o *Objects) DelVethPairEntry(veth VethPair) error {
 	return nil
 }
 
+// names of the maps storing the veth pairs of workloads.
+const (
+	MapDNATTargets = "ptp_dnat_targets"
+	MapNetData     = "net_data_map"
+	MapVethPairs   = "veth_pair_map"
+)
+
+// MapEntry is an entry of one of the maps storing the veth pairs of workloads.
+type MapEntry struct {
+	Map string
+	Key uint32
+
+	// HostIfIndex is the index of the host peer of the veth pair. it is not
+	// set for dnat targets, because they only store the lower byte of it.
+	HostIfIndex uint32
+
+	// HostPort is the port the workload is reachable on. it is
+	// not set for entries of the veth pair map.
+	HostPort uint16
+}
+
+// WorkloadMapEntries returns the entries of all maps storing the veth pairs
+// of workloads. they are removed by the cni plugin once a workload is deleted,
+// but are left behind if it fails to do so.
+func (o *Objects) WorkloadMapEntries() ([]MapEntry, error) {
+	var ret []MapEntry
+
+	var (
+		port   uint16
+		target dnatDnatTarget
+		it     = o.dnatObjs.PtpDnatTargets.Iterate()
+	)
+	for it.Next(&port, &target) {
+		ret = append(ret, MapEntry{
+			Map:      MapDNATTargets,
+			Key:      uint32(port),
+			HostPort: port,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("iterate %s: %w", MapDNATTargets, err)
+	}
+
+	var (
+		key  uint32
+		data dnatNetData
+	)
+	it = o.dnatObjs.NetDataMap.Iterate()
+	for it.Next(&key, &data) {
+		ret = append(ret, MapEntry{
+			Map:         MapNetData,
+			Key:         key,
+			HostIfIndex: data.HostPeer.IfIndex,
+			HostPort:    data.HostPort,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("iterate %s: %w", MapNetData, err)
+	}
+
+	var veth tproxyVethPair
+	it = o.tproxyObjs.VethPairMap.Iterate()
+	for it.Next(&key, &veth) {
+		ret = append(ret, MapEntry{
+			Map:         MapVethPairs,
+			Key:         key,
+			HostIfIndex: veth.HostIfIndex,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("iterate %s: %w", MapVethPairs, err)
+	}
+
+	return ret, nil
+}
+
+// DelMapEntry removes the entry returned by WorkloadMapEntries.
+// entries that have already been removed are ignored.
+func (o *Objects) DelMapEntry(e MapEntry) error {
+	var err error
+	switch e.Map {
+	case MapDNATTargets:
+		err = o.dnatObjs.PtpDnatTargets.Delete(uint16(e.Key))
+	case MapNetData:
+		err = o.dnatObjs.NetDataMap.Delete(e.Key)
+	case MapVethPairs:
+		err = o.tproxyObjs.VethPairMap.Delete(e.Key)
+	default:
+		return fmt.Errorf("unknown map %s", e.Map)
+	}
+
+	if err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("delete from %s: %w", e.Map, err)
+	}
+
+	return nil
+}
+
+// MapUsage is the number of entries stored in a bpf map.
+type MapUsage struct {
+	Name       string
+	Entries    uint32
+	MaxEntries uint32
+}
+
+// MapUsage returns the usage of all maps that do not evict entries on their
+// own. once one of them is full, new workloads cannot be wired up anymore.
+func (o *Objects) MapUsage() ([]MapUsage, error) {
+	maps := []struct {
+		name string
+		m    *ebpf.Map
+	}{
+		{name: MapDNATTargets, m: o.dnatObjs.PtpDnatTargets},
+		{name: MapNetData, m: o.dnatObjs.NetDataMap},
+		{name: MapVethPairs, m: o.tproxyObjs.VethPairMap},
+	}
+
+	ret := make([]MapUsage, 0, len(maps))
+	for _, m := range maps {
+		n, err := countEntries(m.m)
+		if err != nil {
+			return nil, fmt.Errorf("count %s entries: %w", m.name, err)
+		}
+
+		ret = append(ret, MapUsage{
+			Name:       m.name,
+			Entries:    n,
+			MaxEntries: m.m.MaxEntries(),
+		})
+	}
+
+	return ret, nil
+}
+
+// countEntries walks the keys of the map. if a key is deleted while walking,
+// the kernel starts over from the first key, so the count is capped at the
+// maximum number of entries.
+func countEntries(m *ebpf.Map) (uint32, error) {
+	var (
+		n   uint32
+		key any
+	)
+
+	for n < m.MaxEntries() {
+		next, err := m.NextKeyBytes(key)
+		if err != nil {
+			return 0, err
+		}
+
+		if next == nil {
+			break
+		}
+
+		n++
+		key = next
+	}
+
+	return n, nil
+}
+
 func (o *Objects) destroySocks(prog *ebpf.Program) error {
 	iter, err := link.AttachIter(link.IterOptions{
 		Program: prog,
@@ -0,0 +1,133 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package deprecation tells clients that an api call they are using has been
+// replaced. the control plane sends a notice in the response metadata of
+// deprecated calls, which is printed by clients, so users know they have to
+// update before the call is removed.
+package deprecation
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Header is the metadata key the deprecation notice is transmitted with.
+const Header = "explorer-deprecation"
+
+// Replacements maps the full method names of deprecated calls to the full
+// method names of the calls replacing them.
+type Replacements map[string]string
+
+// Replaced returns the replacements of all calls of the deprecated service
+// that are also part of the new service. calls that have not been carried
+// over are left out, because there is nothing to migrate to yet.
+func Replaced(deprecated, replacement grpc.ServiceDesc) Replacements {
+	names := make(map[string]bool)
+	for _, m := range replacement.Methods {
+		names[m.MethodName] = true
+	}
+	for _, s := range replacement.Streams {
+		names[s.StreamName] = true
+	}
+
+	r := make(Replacements)
+	add := func(name string) {
+		if !names[name] {
+			return
+		}
+		r[fullMethod(deprecated, name)] = fullMethod(replacement, name)
+	}
+
+	for _, m := range deprecated.Methods {
+		add(m.MethodName)
+	}
+	for _, s := range deprecated.Streams {
+		add(s.StreamName)
+	}
+
+	return r
+}
+
+// Notice returns the message sent to clients calling method or an empty
+// string if method is not deprecated.
+func (r Replacements) Notice(method string) string {
+	replacement, ok := r[method]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s is deprecated and will be removed in a future release, use %s instead", method, replacement)
+}
+
+// UnaryServerInterceptor sets the deprecation notice on responses of
+// deprecated calls. the call itself is served as usual.
+func UnaryServerInterceptor(r Replacements) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if notice := r.Notice(info.FullMethod); notice != "" {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(Header, notice))
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming equivalent of UnaryServerInterceptor.
+func StreamServerInterceptor(r Replacements) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if notice := r.Notice(info.FullMethod); notice != "" {
+			_ = ss.SetHeader(metadata.Pairs(Header, notice))
+		}
+		return handler(srv, ss)
+	}
+}
+
+// UnaryClientInterceptor logs the deprecation notices sent by the server as
+// warnings. each notice is only logged once, so commands making the same
+// call multiple times, like when polling, do not repeat it.
+func UnaryClientInterceptor(logger *slog.Logger) grpc.UnaryClientInterceptor {
+	var seen sync.Map
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		var header metadata.MD
+		opts = append(opts, grpc.Header(&header))
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		for _, notice := range header.Get(Header) {
+			if _, loaded := seen.LoadOrStore(notice, struct{}{}); loaded {
+				continue
+			}
+			logger.WarnContext(ctx, notice)
+		}
+
+		return err
+	}
+}
+
+func fullMethod(desc grpc.ServiceDesc, name string) string {
+	return "/" + desc.ServiceName + "/" + name
+}
@@ -0,0 +1,99 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package deprecation_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	instancev1beta1 "github.com/spacechunks/explorer/api/instance/v1beta1"
+	"github.com/spacechunks/explorer/internal/deprecation"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestReplaced(t *testing.T) {
+	r := deprecation.Replaced(
+		instancev1alpha1.InstanceService_ServiceDesc,
+		instancev1beta1.InstanceService_ServiceDesc,
+	)
+
+	const (
+		alpha = "/instance.v1alpha1.InstanceService/"
+		beta  = "/instance.v1beta1.InstanceService/"
+	)
+
+	// calls only used by nodes have not been carried over
+	require.Equal(t, deprecation.Replacements{
+		alpha + "GetInstance":      beta + "GetInstance",
+		alpha + "ListInstances":    beta + "ListInstances",
+		alpha + "RunFlavorVersion": beta + "RunFlavorVersion",
+		alpha + "StopInstance":     beta + "StopInstance",
+	}, r)
+
+	require.Empty(t, r.Notice(instancev1alpha1.InstanceService_DiscoverInstances_FullMethodName))
+	require.Contains(
+		t,
+		r.Notice(instancev1alpha1.InstanceService_GetInstance_FullMethodName),
+		"use "+instancev1beta1.InstanceService_GetInstance_FullMethodName+" instead",
+	)
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	var (
+		buf         bytes.Buffer
+		logger      = slog.New(slog.NewTextHandler(&buf, nil))
+		interceptor = deprecation.UnaryClientInterceptor(logger)
+	)
+
+	invoker := func(notices ...string) grpc.UnaryInvoker {
+		return func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+			for _, opt := range opts {
+				if h, ok := opt.(grpc.HeaderCallOption); ok {
+					md := metadata.MD{}
+					for _, n := range notices {
+						md.Append(deprecation.Header, n)
+					}
+					*h.HeaderAddr = md
+				}
+			}
+			return nil
+		}
+	}
+
+	ctx := context.Background()
+
+	// the same notice is only logged once
+	for range 3 {
+		require.NoError(t, interceptor(ctx, "/a", nil, nil, nil, invoker("a is deprecated")))
+	}
+	require.NoError(t, interceptor(ctx, "/b", nil, nil, nil, invoker()))
+	require.NoError(t, interceptor(ctx, "/c", nil, nil, nil, invoker("c is deprecated")))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], "level=WARN")
+	require.Contains(t, lines[0], "a is deprecated")
+	require.Contains(t, lines[1], "c is deprecated")
+}
@@ -0,0 +1,127 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package diagnostics serves the runtime profiles of net/http/pprof, so cpu
+// spikes, leaks and lock contention can be investigated in production.
+package diagnostics
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"net/netip"
+	"os"
+	"strings"
+	"time"
+)
+
+// ErrTokenRequired is returned by CheckListenAddr if profiles would be
+// exposed to the network without requiring a token.
+var ErrTokenRequired = errors.New("a token is required for non-loopback listen addresses")
+
+// Handler serves the profiles below /debug/pprof/. if token is not empty,
+// requests have to pass it as bearer token in the authorization header.
+func Handler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	if token == "" {
+		return mux
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		actual, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(actual), []byte(token)) != 1 {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, req)
+	})
+}
+
+// CheckListenAddr makes sure profiles are only served without a token
+// if the listen address is not reachable from other hosts.
+func CheckListenAddr(addr string, token string) error {
+	if token != "" {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("split host port: %w", err)
+	}
+
+	if host == "localhost" {
+		return nil
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil || !ip.IsLoopback() {
+		return ErrTokenRequired
+	}
+
+	return nil
+}
+
+// ReadToken returns the token stored in the file located at path.
+// surrounding whitespace is removed. if path is empty, the token is
+// empty as well.
+func ReadToken(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// NewServer returns a server serving the profiles on addr. the token is read from
+// tokenFile, see ReadToken. write timeouts are not set, because cpu profiles and
+// traces are recorded for the duration requested by the client.
+func NewServer(addr string, tokenFile string) (*http.Server, error) {
+	token, err := ReadToken(tokenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckListenAddr(addr, token); err != nil {
+		return nil, err
+	}
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           Handler(token),
+		ReadHeaderTimeout: 10 * time.Second,
+	}, nil
+}
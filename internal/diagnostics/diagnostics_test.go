@@ -0,0 +1,121 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package diagnostics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/diagnostics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		header   string
+		expected int
+	}{
+		{
+			name:     "no token configured",
+			expected: http.StatusOK,
+		},
+		{
+			name:     "valid token",
+			token:    "secret",
+			header:   "Bearer secret",
+			expected: http.StatusOK,
+		},
+		{
+			name:     "invalid token",
+			token:    "secret",
+			header:   "Bearer nope",
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "token missing",
+			token:    "secret",
+			expected: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				rec = httptest.NewRecorder()
+				req = httptest.NewRequest(http.MethodGet, "/debug/pprof/goroutine?debug=1", nil)
+			)
+
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			diagnostics.Handler(tt.token).ServeHTTP(rec, req)
+			require.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestCheckListenAddr(t *testing.T) {
+	tests := []struct {
+		name  string
+		addr  string
+		token string
+		err   error
+	}{
+		{
+			name: "loopback address",
+			addr: "127.0.0.1:6060",
+		},
+		{
+			name: "loopback ipv6 address",
+			addr: "[::1]:6060",
+		},
+		{
+			name: "localhost",
+			addr: "localhost:6060",
+		},
+		{
+			name: "all interfaces",
+			addr: ":6060",
+			err:  diagnostics.ErrTokenRequired,
+		},
+		{
+			name: "public address",
+			addr: "203.0.113.1:6060",
+			err:  diagnostics.ErrTokenRequired,
+		},
+		{
+			name:  "public address with token",
+			addr:  "203.0.113.1:6060",
+			token: "secret",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := diagnostics.CheckListenAddr(tt.addr, tt.token)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
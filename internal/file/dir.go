@@ -0,0 +1,135 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package file
+
+import (
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// HashDir computes the hashes of all files in the given flavor directory, as
+// well as the root of their hash tree. files that are generated by the server
+// at runtime, like caches, logs and the server jar, are excluded. the returned
+// paths include dir.
+func HashDir(logger *slog.Logger, dir string) (string, []Hash, error) {
+	return HashDirWithProgress(logger, dir, nil)
+}
+
+// HashDirWithProgress is like HashDir, but calls progress with the number
+// of files hashed so far every time a file has been hashed. progress may be nil.
+func HashDirWithProgress(logger *slog.Logger, dir string, progress func(hashed int)) (string, []Hash, error) {
+	var (
+		fileHashes = make([]Hash, 0)
+		excluded   = []string{
+			filepath.Join("cache", ".*"),
+			filepath.Join("versions", ".*"),
+			filepath.Join("libraries", ".*"),
+			filepath.Join("logs", ".*"),
+			filepath.Join("plugins", ".paper-remapped", ".*"),
+			// matches paper jars in the format of paper-<mc-version>-<build>.jar
+			// or just plain paper.jar
+			"(?:^|[\\\\/])paper(?:-\\d+(?:\\.\\d+)*-\\d+)?\\.jar$",
+		}
+	)
+
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if d.IsDir() {
+			return nil
+		}
+
+		if err != nil {
+			logger.Warn("could not walk into directory", "path", path, "err", err)
+			return nil
+		}
+
+		for _, p := range excluded {
+			matched, err := regexp.Match(p, []byte(path))
+			if err != nil {
+				return fmt.Errorf("error while matching pattern %s: %w", p, err)
+			}
+			if matched {
+				logger.Debug("skipping excluded file", "path", path, "regex", p)
+				return nil
+			}
+		}
+
+		// the containers that are being built by the controlplane
+		// are linux only, so use the linux path separator, if we are
+		// using the cli on windows or any other platform that does not
+		// use "/".
+		//tmp := strings.ReplaceAll(path, string(os.PathSeparator), "/")
+
+		// exclude the user specific portion of the path so we are left with
+		// the path relative to the server root. for example if a plugin in the
+		// flavor is located at /home/some_user/my_chunk/flavor1/plugins/myplugin.jar
+		// we remove everything so we are left with only plugins/myplugin.jar
+		rel := strings.ReplaceAll(filepath.ToSlash(path), filepath.ToSlash(filepath.Clean(dir))+"/", "")
+
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("error while opening file: %w", err)
+		}
+
+		defer f.Close()
+
+		hash, err := ComputeHashStr(f)
+		if err != nil {
+			return fmt.Errorf("error while computing hash: %w", err)
+		}
+
+		info, err := f.Stat()
+		if err != nil {
+			return fmt.Errorf("error while reading file info: %w", err)
+		}
+
+		logger.Debug("using file", "path", path, "rel", rel, "hash", hash)
+
+		// use file hashes here, so we don't have to keep the whole files content in ram.
+		// we'll read the content later again, when uploading the files to the server.
+		// drawback here is that if files change in between, the server will reject the
+		// uploaded files, but the chances of this happening should be quite small.
+		fileHashes = append(fileHashes, Hash{
+			Path:      path,
+			Hash:      hash,
+			SizeBytes: uint64(info.Size()),
+		})
+
+		if progress != nil {
+			progress(len(fileHashes))
+		}
+
+		return nil
+	}); err != nil {
+		return "", nil, err
+	}
+
+	SortHashes(fileHashes)
+
+	tree, err := HashTree(fileHashes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return HashTreeRootString(tree), fileHashes, nil
+}
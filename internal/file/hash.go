@@ -34,6 +34,10 @@ import (
 type Hash struct {
 	Path string
 	Hash string
+
+	// SizeBytes is not part of the hash tree. it is
+	// 0 if the size of the file is not known.
+	SizeBytes uint64
 }
 
 func (f Hash) CalculateHash() ([]byte, error) {
@@ -52,7 +56,7 @@ func (f Hash) Equals(other merkletree.Content) (bool, error) {
 // this is designed to be able to be used with large amounts of data.
 func ComputeHashStr(file io.ReadSeekCloser) (string, error) {
 	// in case we have a large file, we don't want to read the whole thing into memory.
-	hasher := xxh3.New()
+	hasher := NewHashWriter()
 	if _, err := io.Copy(hasher, file); err != nil {
 		return "", fmt.Errorf("copy: %w", err)
 	}
@@ -61,7 +65,28 @@ func ComputeHashStr(file io.ReadSeekCloser) (string, error) {
 		return "", fmt.Errorf("seek: %w", err)
 	}
 
-	return fmt.Sprintf("%x", hasher.Sum(nil)), nil
+	return hasher.Sum(), nil
+}
+
+// HashWriter computes the same hash as ComputeHashStr from all data
+// written to it, so files can be hashed while they are being streamed.
+type HashWriter struct {
+	hasher *xxh3.Hasher
+}
+
+func NewHashWriter() *HashWriter {
+	return &HashWriter{
+		hasher: xxh3.New(),
+	}
+}
+
+func (w *HashWriter) Write(p []byte) (int, error) {
+	return w.hasher.Write(p)
+}
+
+// Sum returns the hash of all data written so far.
+func (w *HashWriter) Sum() string {
+	return fmt.Sprintf("%x", w.hasher.Sum(nil))
 }
 
 func HashTreeRootString(tree *merkletree.MerkleTree) string {
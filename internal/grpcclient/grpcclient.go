@@ -0,0 +1,174 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package grpcclient creates grpc client connections with a common policy
+// for deadlines, retries and keepalive, so transient errors like a restarting
+// control plane do not immediately surface as failures.
+package grpcclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+)
+
+type Config struct {
+	// Timeout is the deadline applied to rpcs that do not have
+	// a shorter one set by the caller. 0 disables the timeout.
+	Timeout time.Duration
+
+	// MethodTimeouts overrides Timeout for single methods. Methods are
+	// identified by their full name, e.g. /user.v1alpha1.UserService/Login.
+	MethodTimeouts map[string]time.Duration
+
+	// MaxAttempts is the maximum number of attempts for an rpc including
+	// the original one. grpc caps this at 5. 1 disables retries.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// RetryableCodes are the status codes an rpc is retried on.
+	RetryableCodes []codes.Code
+
+	// KeepaliveTime is the interval in which the connection is pinged
+	// to detect broken connections. 0 disables keepalive pings.
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+}
+
+// DefaultConfig only retries rpcs that failed with UNAVAILABLE, which is
+// returned if the server could not be reached or is shutting down.
+var DefaultConfig = Config{
+	Timeout:          30 * time.Second,
+	MaxAttempts:      4,
+	InitialBackoff:   200 * time.Millisecond,
+	MaxBackoff:       5 * time.Second,
+	RetryableCodes:   []codes.Code{codes.Unavailable},
+	KeepaliveTime:    time.Minute,
+	KeepaliveTimeout: 20 * time.Second,
+}
+
+// KeepaliveEnforcementPolicy has to be set on servers that are called by clients
+// created with this package. otherwise the keepalive pings are considered abusive
+// and the server closes the connection.
+var KeepaliveEnforcementPolicy = keepalive.EnforcementPolicy{
+	MinTime:             30 * time.Second,
+	PermitWithoutStream: true,
+}
+
+// New creates a client connection applying the policy defined by cfg.
+// additional dial options are applied afterward.
+func New(target string, cfg Config, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	sc, err := serviceConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("service config: %w", err)
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(sc),
+	}
+
+	if cfg.KeepaliveTime > 0 {
+		dialOpts = append(dialOpts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                cfg.KeepaliveTime,
+			Timeout:             cfg.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+
+	return grpc.NewClient(target, append(dialOpts, opts...)...)
+}
+
+type methodName struct {
+	Service string `json:"service,omitempty"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int          `json:"maxAttempts"`
+	InitialBackoff       string       `json:"initialBackoff"`
+	MaxBackoff           string       `json:"maxBackoff"`
+	BackoffMultiplier    float64      `json:"backoffMultiplier"`
+	RetryableStatusCodes []codes.Code `json:"retryableStatusCodes"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	Timeout     string       `json:"timeout,omitempty"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// serviceConfig builds the json representation of the grpc service config, see
+// https://github.com/grpc/grpc/blob/master/doc/service_config.md for details.
+func serviceConfig(cfg Config) (string, error) {
+	var policy *retryPolicy
+	if cfg.MaxAttempts > 1 && len(cfg.RetryableCodes) > 0 {
+		policy = &retryPolicy{
+			MaxAttempts:          cfg.MaxAttempts,
+			InitialBackoff:       duration(cfg.InitialBackoff),
+			MaxBackoff:           duration(cfg.MaxBackoff),
+			BackoffMultiplier:    2,
+			RetryableStatusCodes: cfg.RetryableCodes,
+		}
+	}
+
+	configs := []methodConfig{
+		{
+			// an empty name matches all methods
+			Name:        []methodName{{}},
+			Timeout:     duration(cfg.Timeout),
+			RetryPolicy: policy,
+		},
+	}
+
+	for fullName, timeout := range cfg.MethodTimeouts {
+		service, method, ok := strings.Cut(strings.TrimPrefix(fullName, "/"), "/")
+		if !ok || service == "" || method == "" {
+			return "", fmt.Errorf("invalid method name %q", fullName)
+		}
+
+		configs = append(configs, methodConfig{
+			Name:        []methodName{{Service: service, Method: method}},
+			Timeout:     duration(timeout),
+			RetryPolicy: policy,
+		})
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"methodConfig": configs,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// duration formats d the way the service config expects it. zero
+// durations are omitted, because they are not allowed as timeout.
+func duration(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.9fs", d.Seconds())
+}
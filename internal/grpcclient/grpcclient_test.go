@@ -0,0 +1,160 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package grpcclient_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/grpcclient"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type flakyHealthServer struct {
+	healthv1.UnimplementedHealthServer
+	failures int32
+	code     codes.Code
+	delay    time.Duration
+	calls    atomic.Int32
+}
+
+func (s *flakyHealthServer) Check(
+	ctx context.Context,
+	_ *healthv1.HealthCheckRequest,
+) (*healthv1.HealthCheckResponse, error) {
+	if s.calls.Add(1) <= s.failures {
+		return nil, status.Error(s.code, "flaky")
+	}
+
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return &healthv1.HealthCheckResponse{
+		Status: healthv1.HealthCheckResponse_SERVING,
+	}, nil
+}
+
+func TestClientPolicy(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   grpcclient.Config
+		srv   *flakyHealthServer
+		calls int32
+		code  codes.Code
+	}{
+		{
+			name: "unavailable is retried",
+			cfg:  grpcclient.DefaultConfig,
+			srv: &flakyHealthServer{
+				failures: 2,
+				code:     codes.Unavailable,
+			},
+			calls: 3,
+			code:  codes.OK,
+		},
+		{
+			name: "other codes are not retried",
+			cfg:  grpcclient.DefaultConfig,
+			srv: &flakyHealthServer{
+				failures: 2,
+				code:     codes.InvalidArgument,
+			},
+			calls: 1,
+			code:  codes.InvalidArgument,
+		},
+		{
+			name: "gives up after max attempts",
+			cfg:  grpcclient.DefaultConfig,
+			srv: &flakyHealthServer{
+				failures: 10,
+				code:     codes.Unavailable,
+			},
+			calls: 4,
+			code:  codes.Unavailable,
+		},
+		{
+			name: "method timeout is applied",
+			cfg: func() grpcclient.Config {
+				cfg := grpcclient.DefaultConfig
+				cfg.MethodTimeouts = map[string]time.Duration{
+					"/grpc.health.v1.Health/Check": 50 * time.Millisecond,
+				}
+				return cfg
+			}(),
+			srv: &flakyHealthServer{
+				delay: time.Second,
+			},
+			calls: 1,
+			code:  codes.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+
+			srv := grpc.NewServer(grpc.KeepaliveEnforcementPolicy(grpcclient.KeepaliveEnforcementPolicy))
+			healthv1.RegisterHealthServer(srv, tt.srv)
+
+			go func() {
+				_ = srv.Serve(lis)
+			}()
+			t.Cleanup(srv.Stop)
+
+			tt.cfg.InitialBackoff = time.Millisecond
+			tt.cfg.MaxBackoff = time.Millisecond
+
+			conn, err := grpcclient.New(
+				lis.Addr().String(),
+				tt.cfg,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				_ = conn.Close()
+			})
+
+			_, err = healthv1.NewHealthClient(conn).Check(context.Background(), &healthv1.HealthCheckRequest{})
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.calls, tt.srv.calls.Load())
+		})
+	}
+}
+
+func TestInvalidMethodTimeout(t *testing.T) {
+	cfg := grpcclient.DefaultConfig
+	cfg.MethodTimeouts = map[string]time.Duration{
+		"not-a-method": time.Second,
+	}
+
+	_, err := grpcclient.New("localhost:1", cfg, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.Error(t, err)
+}
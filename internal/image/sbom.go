@@ -0,0 +1,119 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package image
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ociv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const SBOMMediaType types.MediaType = "text/spdx+json"
+
+// SBOMRef returns the reference the sbom of the
+// image with the given digest is stored at.
+func SBOMRef(repo name.Repository, digest ociv1.Hash) string {
+	return repo.Tag(fmt.Sprintf("%s-%s.sbom", digest.Algorithm, digest.Hex)).String()
+}
+
+// SBOMPackage is a file contained in an image, that is listed in its sbom.
+type SBOMPackage struct {
+	// Path is the absolute path of the file inside the image.
+	Path   string
+	SHA256 string
+}
+
+type spdxDocument struct {
+	SPDXVersion       string           `json:"spdxVersion"`
+	DataLicense       string           `json:"dataLicense"`
+	SPDXID            string           `json:"SPDXID"`
+	Name              string           `json:"name"`
+	DocumentNamespace string           `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo `json:"creationInfo"`
+	Packages          []spdxPackage    `json:"packages"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string         `json:"name"`
+	SPDXID           string         `json:"SPDXID"`
+	DownloadLocation string         `json:"downloadLocation"`
+	FilesAnalyzed    bool           `json:"filesAnalyzed"`
+	PackageFileName  string         `json:"packageFileName"`
+	Checksums        []spdxChecksum `json:"checksums"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+// NewSBOM returns a SPDX document listing the given packages. the namespace
+// has to uniquely identify the document, e.g. by including the image digest.
+func NewSBOM(docName string, namespace string, created time.Time, pkgs []SBOMPackage) ([]byte, error) {
+	doc := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              docName,
+		DocumentNamespace: namespace,
+		CreationInfo: spdxCreationInfo{
+			Created:  created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: explorer-controlplane"},
+		},
+		Packages: make([]spdxPackage, 0, len(pkgs)),
+	}
+
+	for i, p := range pkgs {
+		doc.Packages = append(doc.Packages, spdxPackage{
+			Name:             path.Base(p.Path),
+			SPDXID:           fmt.Sprintf("SPDXRef-Package-%d", i),
+			DownloadLocation: "NOASSERTION",
+			FilesAnalyzed:    false,
+			PackageFileName:  p.Path,
+			Checksums: []spdxChecksum{
+				{
+					Algorithm:     "SHA256",
+					ChecksumValue: p.SHA256,
+				},
+			},
+		})
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("marshal sbom: %w", err)
+	}
+
+	return data, nil
+}
+
+// NewSBOMImage returns an image containing the given sbom.
+func NewSBOMImage(sbom []byte) (ociv1.Image, error) {
+	return newArtifact(sbom, SBOMMediaType, nil)
+}
@@ -0,0 +1,61 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package image_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/image"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSBOM(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	sbom, err := image.NewSBOM("chunks/flavor", "https://example.com/spdx/abc", created, []image.SBOMPackage{
+		{
+			Path:   "/opt/paper/plugins/worldedit.jar",
+			SHA256: "deadbeef",
+		},
+	})
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{
+		"spdxVersion": "SPDX-2.3",
+		"dataLicense": "CC0-1.0",
+		"SPDXID": "SPDXRef-DOCUMENT",
+		"name": "chunks/flavor",
+		"documentNamespace": "https://example.com/spdx/abc",
+		"creationInfo": {
+			"created": "2026-01-02T03:04:05Z",
+			"creators": ["Tool: explorer-controlplane"]
+		},
+		"packages": [
+			{
+				"name": "worldedit.jar",
+				"SPDXID": "SPDXRef-Package-0",
+				"downloadLocation": "NOASSERTION",
+				"filesAnalyzed": false,
+				"packageFileName": "/opt/paper/plugins/worldedit.jar",
+				"checksums": [{"algorithm": "SHA256", "checksumValue": "deadbeef"}]
+			}
+		]
+	}`, string(sbom))
+}
@@ -32,12 +32,26 @@ import (
 	"github.com/google/go-containerregistry/pkg/name"
 	ociv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 	"github.com/google/go-containerregistry/pkg/v1/tarball"
 )
 
+var ErrNotFound = errors.New("image not found")
+
 type Service interface {
-	Push(ctx context.Context, img ociv1.Image, imgRef string) error
+	Push(ctx context.Context, img ociv1.Image, imgRef string, opts ...PushOption) error
 	Pull(ctx context.Context, imgRef string, platform string) (ociv1.Image, error)
+	// Tag makes the image referenced by srcRef available under dstRef without
+	// downloading it. If srcRef does not exist, ErrNotFound is returned.
+	Tag(ctx context.Context, srcRef string, dstRef string) error
+	// Fetch returns the image referenced by imgRef. Unlike Pull, images are
+	// never cached and only the manifest is fetched right away. If imgRef
+	// does not exist, ErrNotFound is returned.
+	Fetch(ctx context.Context, imgRef string) (ociv1.Image, error)
+	// Delete removes the manifest referenced by imgRef from the registry,
+	// including all tags pointing to it. If imgRef does not exist,
+	// ErrNotFound is returned.
+	Delete(ctx context.Context, imgRef string) error
 }
 
 type service struct {
@@ -57,11 +71,25 @@ func NewService(logger *slog.Logger, registryUser string, registryPass string, c
 	}
 }
 
-func (s *service) Push(ctx context.Context, img ociv1.Image, imgRef string) error {
+// Push uploads all layers of the image in chunks, retrying failed chunks with backoff,
+// before writing the manifest. layers already present in the registry are skipped, so
+// calling Push again after it failed only uploads what is missing.
+func (s *service) Push(ctx context.Context, img ociv1.Image, imgRef string, opts ...PushOption) error {
 	ref, err := name.ParseReference(imgRef)
 	if err != nil {
 		return fmt.Errorf("push: parse image ref: %w", err)
 	}
+
+	o := pushOptions{
+		chunkSize:   DefaultChunkSize,
+		maxAttempts: DefaultPushMaxAttempts,
+		backoff:     DefaultPushBackoff,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	// TODO: view remote.DefaultTransport
 	tp := &http.Transport{
 		TLSClientConfig: &tls.Config{
@@ -76,18 +104,182 @@ func (s *service) Push(ctx context.Context, img ociv1.Image, imgRef string) erro
 
 	s.logger.InfoContext(ctx, "pushing image", "ref", ref.String())
 
+	rt, err := transport.NewWithContext(
+		ctx,
+		ref.Context().Registry,
+		auth,
+		tp,
+		[]string{ref.Context().Scope(transport.PushScope)},
+	)
+	if err != nil {
+		return fmt.Errorf("push: registry transport: %w", err)
+	}
+
+	up := &uploader{
+		logger: s.logger,
+		client: &http.Client{Transport: rt},
+		repo:   ref.Context(),
+		opts:   o,
+	}
+
+	if err := up.uploadLayers(ctx, img); err != nil {
+		return fmt.Errorf("upload layers: %w", err)
+	}
+
+	// the layers we uploaded ourselves are present at this point, so only
+	// mountable layers, the config and the manifest are left to be written.
 	if err := remote.Write(
 		ref,
 		img,
 		remote.WithAuth(auth),
 		remote.WithTransport(tp),
 		remote.WithContext(ctx),
+		remote.WithRetryBackoff(remote.Backoff{
+			Duration: o.backoff,
+			Factor:   2,
+			Jitter:   0.1,
+			Steps:    o.maxAttempts,
+		}),
 	); err != nil {
 		return fmt.Errorf("push image: %w", err)
 	}
 	return nil
 }
 
+func (s *service) Tag(ctx context.Context, srcRef string, dstRef string) error {
+	src, err := name.ParseReference(srcRef)
+	if err != nil {
+		return fmt.Errorf("tag: parse source ref: %w", err)
+	}
+
+	dst, err := name.NewTag(dstRef)
+	if err != nil {
+		return fmt.Errorf("tag: parse destination tag: %w", err)
+	}
+
+	opts := []remote.Option{
+		remote.WithAuth(Auth{
+			Username: s.registryUser,
+			Password: s.registryPass,
+		}),
+		// TODO: view remote.DefaultTransport
+		remote.WithTransport(&http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: true,
+			},
+			ForceAttemptHTTP2: true,
+		}),
+		remote.WithContext(ctx),
+	}
+
+	desc, err := remote.Get(src, opts...)
+	if err != nil {
+		var terr *transport.Error
+		if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+			return ErrNotFound
+		}
+		return fmt.Errorf("get image: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "tagging image", "src", src.String(), "dst", dst.String())
+
+	// if source and destination are located in different repositories,
+	// the blobs are mounted from the source repository by the registry,
+	// so no layers have to be transferred.
+	if src.Context() != dst.Context() {
+		img, err := desc.Image()
+		if err != nil {
+			return fmt.Errorf("image: %w", err)
+		}
+
+		if err := remote.Write(dst, img, opts...); err != nil {
+			return fmt.Errorf("write image: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := remote.Tag(dst, desc, opts...); err != nil {
+		return fmt.Errorf("tag image: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) Fetch(ctx context.Context, imgRef string) (ociv1.Image, error) {
+	ref, err := name.ParseReference(imgRef)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: parse image ref: %w", err)
+	}
+
+	img, err := remote.Image(
+		ref,
+		remote.WithAuth(Auth{
+			Username: s.registryUser,
+			Password: s.registryPass,
+		}),
+		// TODO: view remote.DefaultTransport
+		remote.WithTransport(&http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: true,
+			},
+			ForceAttemptHTTP2: true,
+		}),
+		remote.WithContext(ctx),
+	)
+	if err != nil {
+		var terr *transport.Error
+		if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("remote image: %w", err)
+	}
+
+	return img, nil
+}
+
+func (s *service) Delete(ctx context.Context, imgRef string) error {
+	ref, err := name.ParseReference(imgRef)
+	if err != nil {
+		return fmt.Errorf("delete: parse image ref: %w", err)
+	}
+
+	opts := []remote.Option{
+		remote.WithAuth(Auth{
+			Username: s.registryUser,
+			Password: s.registryPass,
+		}),
+		// TODO: view remote.DefaultTransport
+		remote.WithTransport(&http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: true,
+			},
+			ForceAttemptHTTP2: true,
+		}),
+		remote.WithContext(ctx),
+	}
+
+	desc, err := remote.Head(ref, opts...)
+	if err != nil {
+		var terr *transport.Error
+		if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+			return ErrNotFound
+		}
+		return fmt.Errorf("head image: %w", err)
+	}
+
+	// registries only support deleting manifests by digest.
+	dgst := ref.Context().Digest(desc.Digest.String())
+
+	s.logger.InfoContext(ctx, "deleting image", "ref", ref.String(), "digest", dgst.String())
+
+	if err := remote.Delete(dgst, opts...); err != nil {
+		return fmt.Errorf("delete image: %w", err)
+	}
+
+	return nil
+}
+
 func (s *service) Pull(ctx context.Context, imgRef string, platform string) (img ociv1.Image, err error) {
 	ref, err := name.ParseReference(imgRef)
 	if err != nil {
@@ -0,0 +1,343 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package image
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ociv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+// signatures and sboms are stored the same way cosign stores them, so images
+// signed by the control plane can also be verified using cosign. both are
+// single layer images located in the repository of the image they belong to
+// and are tagged with its digest.
+
+const (
+	SignatureMediaType  types.MediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	SignatureAnnotation                 = "dev.cosignproject.cosign/signature"
+	signatureType                       = "cosign container image signature"
+
+	// maxPayloadSize limits the size of signature payloads read from the registry.
+	maxPayloadSize = 1 << 20
+)
+
+var (
+	ErrNotSigned        = errors.New("image is not signed")
+	ErrSignatureInvalid = errors.New("image signature is invalid")
+)
+
+// SignatureRef returns the reference the signature of the
+// image with the given digest is stored at.
+func SignatureRef(repo name.Repository, digest ociv1.Hash) string {
+	return repo.Tag(fmt.Sprintf("%s-%s.sig", digest.Algorithm, digest.Hex)).String()
+}
+
+// simpleSigningPayload is the document that is signed. it binds
+// the signature to the digest of the image.
+type simpleSigningPayload struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+	Optional map[string]string `json:"optional"`
+}
+
+// NewSignature returns an image containing the signature
+// of the image with the given digest created with key.
+func NewSignature(key *ecdsa.PrivateKey, repo name.Repository, digest ociv1.Hash) (ociv1.Image, error) {
+	var p simpleSigningPayload
+	p.Critical.Identity.DockerReference = repo.Name()
+	p.Critical.Image.DockerManifestDigest = digest.String()
+	p.Critical.Type = signatureType
+
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	sum := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+	if err != nil {
+		return nil, fmt.Errorf("sign payload: %w", err)
+	}
+
+	return newArtifact(payload, SignatureMediaType, map[string]string{
+		SignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+	})
+}
+
+// VerifySignature returns nil if sig contains a signature of the image with
+// the given digest, that has been created with the private key of key. the
+// repository the image has been signed in is not checked, because nodes may
+// reach the registry using a different address than the control plane.
+func VerifySignature(sig ociv1.Image, digest ociv1.Hash, key *ecdsa.PublicKey) error {
+	m, err := sig.Manifest()
+	if err != nil {
+		return fmt.Errorf("manifest: %w", err)
+	}
+
+	for _, desc := range m.Layers {
+		if desc.MediaType != SignatureMediaType {
+			continue
+		}
+
+		encoded, ok := desc.Annotations[SignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		rawSig, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+
+		payload, err := layerData(sig, desc.Digest)
+		if err != nil {
+			return err
+		}
+
+		sum := sha256.Sum256(payload)
+		if !ecdsa.VerifyASN1(key, sum[:], rawSig) {
+			continue
+		}
+
+		var p simpleSigningPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			continue
+		}
+
+		if p.Critical.Type == signatureType && p.Critical.Image.DockerManifestDigest == digest.String() {
+			return nil
+		}
+	}
+
+	return ErrSignatureInvalid
+}
+
+// Signer signs images and pushes their signatures to the registry.
+type Signer struct {
+	svc Service
+	key *ecdsa.PrivateKey
+}
+
+func NewSigner(svc Service, key *ecdsa.PrivateKey) *Signer {
+	return &Signer{
+		svc: svc,
+		key: key,
+	}
+}
+
+// Sign signs the image currently referenced by imgRef.
+func (s *Signer) Sign(ctx context.Context, imgRef string) error {
+	ref, err := name.ParseReference(imgRef)
+	if err != nil {
+		return fmt.Errorf("parse image ref: %w", err)
+	}
+
+	img, err := s.svc.Fetch(ctx, imgRef)
+	if err != nil {
+		return fmt.Errorf("fetch image: %w", err)
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return fmt.Errorf("digest: %w", err)
+	}
+
+	sig, err := NewSignature(s.key, ref.Context(), digest)
+	if err != nil {
+		return err
+	}
+
+	if err := s.svc.Push(ctx, sig, SignatureRef(ref.Context(), digest)); err != nil {
+		return fmt.Errorf("push signature: %w", err)
+	}
+
+	return nil
+}
+
+// Verifier checks that images have been signed by the control plane.
+type Verifier struct {
+	svc Service
+	key *ecdsa.PublicKey
+}
+
+func NewVerifier(svc Service, key *ecdsa.PublicKey) *Verifier {
+	return &Verifier{
+		svc: svc,
+		key: key,
+	}
+}
+
+// ParsePublicKey parses a pem encoded PKIX ecdsa public key,
+// as expected by NewVerifier.
+func ParsePublicKey(data []byte) (*ecdsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no pem block found")
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key: %w", err)
+	}
+
+	key, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not an ecdsa key")
+	}
+
+	return key, nil
+}
+
+// Verify returns nil if the image currently referenced by imgRef has a valid
+// signature. ErrNotSigned is returned if there is no signature at all.
+func (v *Verifier) Verify(ctx context.Context, imgRef string) error {
+	ref, err := name.ParseReference(imgRef)
+	if err != nil {
+		return fmt.Errorf("parse image ref: %w", err)
+	}
+
+	img, err := v.svc.Fetch(ctx, imgRef)
+	if err != nil {
+		return fmt.Errorf("fetch image: %w", err)
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return fmt.Errorf("digest: %w", err)
+	}
+
+	sig, err := v.svc.Fetch(ctx, SignatureRef(ref.Context(), digest))
+	if errors.Is(err, ErrNotFound) {
+		return ErrNotSigned
+	}
+
+	if err != nil {
+		return fmt.Errorf("fetch signature: %w", err)
+	}
+
+	return VerifySignature(sig, digest, v.key)
+}
+
+func layerData(img ociv1.Image, digest ociv1.Hash) ([]byte, error) {
+	l, err := img.LayerByDigest(digest)
+	if err != nil {
+		return nil, fmt.Errorf("layer: %w", err)
+	}
+
+	// artifact layers are not compressed, so the
+	// compressed form is the content as is.
+	rc, err := l.Compressed()
+	if err != nil {
+		return nil, fmt.Errorf("read layer: %w", err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxPayloadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read layer: %w", err)
+	}
+
+	if len(data) > maxPayloadSize {
+		return nil, fmt.Errorf("layer exceeds %d bytes", maxPayloadSize)
+	}
+
+	return data, nil
+}
+
+// newArtifact returns an image with a single layer containing data as is.
+func newArtifact(data []byte, mediaType types.MediaType, annotations map[string]string) (ociv1.Image, error) {
+	img := mutate.MediaType(empty.Image, types.OCIManifestSchema1)
+	img = mutate.ConfigMediaType(img, types.OCIConfigJSON)
+
+	img, err := mutate.Append(img, mutate.Addendum{
+		Layer:       newBlobLayer(data, mediaType),
+		Annotations: annotations,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("append layer: %w", err)
+	}
+
+	return img, nil
+}
+
+// blobLayer is a layer whose content is stored as is, without compression.
+type blobLayer struct {
+	data      []byte
+	digest    ociv1.Hash
+	mediaType types.MediaType
+}
+
+func newBlobLayer(data []byte, mediaType types.MediaType) *blobLayer {
+	sum := sha256.Sum256(data)
+	return &blobLayer{
+		data: data,
+		digest: ociv1.Hash{
+			Algorithm: "sha256",
+			Hex:       fmt.Sprintf("%x", sum),
+		},
+		mediaType: mediaType,
+	}
+}
+
+func (l *blobLayer) Digest() (ociv1.Hash, error) {
+	return l.digest, nil
+}
+
+func (l *blobLayer) DiffID() (ociv1.Hash, error) {
+	return l.digest, nil
+}
+
+func (l *blobLayer) Compressed() (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(l.data)), nil
+}
+
+func (l *blobLayer) Uncompressed() (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(l.data)), nil
+}
+
+func (l *blobLayer) Size() (int64, error) {
+	return int64(len(l.data)), nil
+}
+
+func (l *blobLayer) MediaType() (types.MediaType, error) {
+	return l.mediaType, nil
+}
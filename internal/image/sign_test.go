@@ -0,0 +1,145 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package image_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ociv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/spacechunks/explorer/internal/image"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignature(t *testing.T) {
+	var (
+		key      = genKey(t)
+		repo     = mustRepo(t, "registry.local/chunks/flavor")
+		digest   = mustDigest(t, empty.Image)
+		otherKey = genKey(t)
+	)
+
+	sig, err := image.NewSignature(key, repo, digest)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		digest ociv1.Hash
+		key    *ecdsa.PublicKey
+		err    error
+	}{
+		{
+			name:   "valid signature",
+			digest: digest,
+			key:    &key.PublicKey,
+		},
+		{
+			name:   "signed with different key",
+			digest: digest,
+			key:    &otherKey.PublicKey,
+			err:    image.ErrSignatureInvalid,
+		},
+		{
+			name: "signature for different digest",
+			digest: ociv1.Hash{
+				Algorithm: "sha256",
+				Hex:       "0000000000000000000000000000000000000000000000000000000000000000",
+			},
+			key: &key.PublicKey,
+			err: image.ErrSignatureInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := image.VerifySignature(sig, tt.digest, tt.key)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestVerifier(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		key    = genKey(t)
+		imgRef = "registry.local/chunks/flavor:base"
+		repo   = mustRepo(t, "registry.local/chunks/flavor")
+		digest = mustDigest(t, empty.Image)
+		sigRef = image.SignatureRef(repo, digest)
+	)
+
+	sig, err := image.NewSignature(key, repo, digest)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		prep func(*mock.MockImageService)
+		err  error
+	}{
+		{
+			name: "signed image",
+			prep: func(svc *mock.MockImageService) {
+				svc.EXPECT().Fetch(ctx, imgRef).Return(empty.Image, nil)
+				svc.EXPECT().Fetch(ctx, sigRef).Return(sig, nil)
+			},
+		},
+		{
+			name: "unsigned image",
+			prep: func(svc *mock.MockImageService) {
+				svc.EXPECT().Fetch(ctx, imgRef).Return(empty.Image, nil)
+				svc.EXPECT().Fetch(ctx, sigRef).Return(nil, image.ErrNotFound)
+			},
+			err: image.ErrNotSigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mock.NewMockImageService(t)
+			tt.prep(svc)
+
+			err := image.NewVerifier(svc, &key.PublicKey).Verify(ctx, imgRef)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func genKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func mustRepo(t *testing.T, repo string) name.Repository {
+	r, err := name.NewRepository(repo)
+	require.NoError(t, err)
+	return r
+}
+
+func mustDigest(t *testing.T, img ociv1.Image) ociv1.Hash {
+	h, err := img.Digest()
+	require.NoError(t, err)
+	return h
+}
@@ -0,0 +1,424 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package image
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ociv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+const (
+	DefaultChunkSize       = 16 << 20
+	DefaultPushMaxAttempts = 5
+	DefaultPushBackoff     = time.Second
+
+	maxPushBackoff = 30 * time.Second
+)
+
+// ProgressFunc is called while an image is being pushed. complete and total are in bytes
+// and only account for layers that are not mounted from another repository by the registry.
+type ProgressFunc func(complete int64, total int64)
+
+type PushOption func(*pushOptions)
+
+type pushOptions struct {
+	chunkSize   int64
+	maxAttempts int
+	backoff     time.Duration
+	progress    ProgressFunc
+}
+
+// WithProgress sets a function that is called every time a chunk has been uploaded.
+func WithProgress(fn ProgressFunc) PushOption {
+	return func(o *pushOptions) {
+		o.progress = fn
+	}
+}
+
+// WithChunkSize sets the size of the chunks layers are uploaded in.
+func WithChunkSize(size int64) PushOption {
+	return func(o *pushOptions) {
+		o.chunkSize = size
+	}
+}
+
+// WithRetry sets how often uploading a chunk is attempted and how long to wait
+// before the first retry. the wait time is doubled after each failed attempt.
+func WithRetry(maxAttempts int, backoff time.Duration) PushOption {
+	return func(o *pushOptions) {
+		o.maxAttempts = maxAttempts
+		o.backoff = backoff
+	}
+}
+
+// uploader uploads blobs in chunks using the chunked upload flow of the oci distribution spec.
+// if uploading a chunk fails, the upload is resumed from the offset the registry has received
+// so far, so flaky connections do not require uploading the whole layer again.
+type uploader struct {
+	logger *slog.Logger
+	client *http.Client
+	repo   name.Repository
+	opts   pushOptions
+
+	complete int64
+	total    int64
+}
+
+// uploadLayers uploads all layers of the image that are not already present in the registry.
+// layers that have been pulled from a registry are skipped, because remote.Write is able to
+// mount them from their source repository.
+func (u *uploader) uploadLayers(ctx context.Context, img ociv1.Image) error {
+	layers, err := img.Layers()
+	if err != nil {
+		return fmt.Errorf("layers: %w", err)
+	}
+
+	toUpload := make([]ociv1.Layer, 0, len(layers))
+	for _, l := range layers {
+		if _, ok := l.(*remote.MountableLayer); ok {
+			continue
+		}
+
+		size, err := l.Size()
+		if err != nil {
+			return fmt.Errorf("layer size: %w", err)
+		}
+
+		u.total += size
+		toUpload = append(toUpload, l)
+	}
+
+	for _, l := range toUpload {
+		if err := u.uploadLayer(ctx, l); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *uploader) uploadLayer(ctx context.Context, l ociv1.Layer) error {
+	digest, err := l.Digest()
+	if err != nil {
+		return fmt.Errorf("layer digest: %w", err)
+	}
+
+	size, err := l.Size()
+	if err != nil {
+		return fmt.Errorf("layer size: %w", err)
+	}
+
+	exists, err := u.blobExists(ctx, digest)
+	if err != nil {
+		// not being able to tell does not prevent us from uploading it.
+		u.logger.WarnContext(ctx, "could not check if layer exists", "digest", digest.String(), "err", err)
+	}
+
+	if exists {
+		u.addProgress(size)
+		return nil
+	}
+
+	var (
+		up      = &blobUpload{}
+		attempt = 1
+		backoff = u.opts.backoff
+	)
+
+	for {
+		err := u.tryUpload(ctx, l, up, digest, size)
+		if err == nil {
+			return nil
+		}
+
+		if ctx.Err() != nil || attempt >= u.opts.maxAttempts {
+			return fmt.Errorf("upload layer %s: %w", digest, err)
+		}
+
+		u.logger.WarnContext(
+			ctx,
+			"uploading layer failed, retrying",
+			"digest", digest.String(),
+			"offset", up.offset,
+			"attempt", attempt,
+			"err", err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		attempt++
+		backoff = min(backoff*2, maxPushBackoff)
+
+		if up.location == "" {
+			continue
+		}
+
+		// ask the registry how much data it has received. if it is unable to tell
+		// us, for example because the upload session expired, we start over.
+		received, err := u.uploadStatus(ctx, up.location)
+		if err != nil {
+			u.logger.WarnContext(ctx, "could not get upload status, restarting upload", "err", err)
+			u.addProgress(-up.offset)
+			up.location, up.offset = "", 0
+			continue
+		}
+
+		u.addProgress(received - up.offset)
+		up.offset = received
+	}
+}
+
+// blobUpload tracks the state of an upload session.
+type blobUpload struct {
+	location string
+	offset   int64
+}
+
+func (u *uploader) tryUpload(
+	ctx context.Context,
+	l ociv1.Layer,
+	up *blobUpload,
+	digest ociv1.Hash,
+	size int64,
+) error {
+	if up.location == "" {
+		loc, err := u.startUpload(ctx)
+		if err != nil {
+			return err
+		}
+		up.location = loc
+	}
+
+	if err := u.uploadChunks(ctx, l, up, size); err != nil {
+		return err
+	}
+
+	return u.finishUpload(ctx, up.location, digest)
+}
+
+func (u *uploader) uploadChunks(ctx context.Context, l ociv1.Layer, up *blobUpload, size int64) error {
+	rc, err := l.Compressed()
+	if err != nil {
+		return fmt.Errorf("compressed: %w", err)
+	}
+
+	defer rc.Close()
+
+	if _, err := io.CopyN(io.Discard, rc, up.offset); err != nil {
+		return fmt.Errorf("skip uploaded data: %w", err)
+	}
+
+	buf := make([]byte, u.opts.chunkSize)
+	for up.offset < size {
+		n, err := io.ReadFull(rc, buf)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("read chunk: %w", err)
+		}
+
+		if n == 0 {
+			return fmt.Errorf("layer is smaller than announced size %d", size)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPatch, up.location, bytes.NewReader(buf[:n]))
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		req.ContentLength = int64(n)
+		req.Header.Set("Content-Type", "application/octet-stream")
+		req.Header.Set("Content-Range", fmt.Sprintf("%d-%d", up.offset, up.offset+int64(n)-1))
+
+		resp, err := u.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("upload chunk: %w", err)
+		}
+
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNoContent {
+			return fmt.Errorf("upload chunk: unexpected status code %d", resp.StatusCode)
+		}
+
+		next, err := resolveLocation(resp, up.location)
+		if err != nil {
+			return err
+		}
+
+		up.location = next
+		up.offset += int64(n)
+		u.addProgress(int64(n))
+	}
+
+	return nil
+}
+
+func (u *uploader) blobExists(ctx context.Context, digest ociv1.Hash) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, u.url("blobs/"+digest.String()), nil)
+	if err != nil {
+		return false, fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+}
+
+func (u *uploader) startUpload(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.url("blobs/uploads/"), nil)
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("start upload: %w", err)
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return "", fmt.Errorf("start upload: unexpected status code %d", resp.StatusCode)
+	}
+
+	return resolveLocation(resp, req.URL.String())
+}
+
+// uploadStatus returns the number of bytes the registry has received for the upload.
+func (u *uploader) uploadStatus(ctx context.Context, location string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return 0, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	// the range header has the form 0-<last received byte>
+	_, end, ok := strings.Cut(resp.Header.Get("Range"), "-")
+	if !ok {
+		return 0, fmt.Errorf("invalid range header %q", resp.Header.Get("Range"))
+	}
+
+	last, err := strconv.ParseInt(end, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse range: %w", err)
+	}
+
+	return last + 1, nil
+}
+
+func (u *uploader) finishUpload(ctx context.Context, location string, digest ociv1.Hash) error {
+	loc, err := url.Parse(location)
+	if err != nil {
+		return fmt.Errorf("parse location: %w", err)
+	}
+
+	q := loc.Query()
+	q.Set("digest", digest.String())
+	loc.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, loc.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("finish upload: %w", err)
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("finish upload: unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (u *uploader) url(path string) string {
+	return fmt.Sprintf("%s://%s/v2/%s/%s", u.repo.Scheme(), u.repo.RegistryStr(), u.repo.RepositoryStr(), path)
+}
+
+func (u *uploader) addProgress(n int64) {
+	if u.opts.progress == nil {
+		return
+	}
+
+	u.complete += n
+	u.opts.progress(u.complete, u.total)
+}
+
+// resolveLocation returns the location header of the response. registries
+// are allowed to return relative locations, so they are resolved against base.
+func resolveLocation(resp *http.Response, base string) (string, error) {
+	loc := resp.Header.Get("Location")
+	if loc == "" {
+		return "", errors.New("location header missing")
+	}
+
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("parse base url: %w", err)
+	}
+
+	l, err := url.Parse(loc)
+	if err != nil {
+		return "", fmt.Errorf("parse location: %w", err)
+	}
+
+	return b.ResolveReference(l).String(), nil
+}
@@ -0,0 +1,263 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package image
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ociv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploaderResumesFailedChunks(t *testing.T) {
+	tests := []struct {
+		name string
+		// fail the patch request with the given index once.
+		failPatch int
+		// whether the registry is able to report the upload status.
+		noStatus bool
+	}{
+		{
+			name:      "no failures",
+			failPatch: -1,
+		},
+		{
+			name:      "upload is resumed",
+			failPatch: 1,
+		},
+		{
+			name:      "upload is restarted if status is unavailable",
+			failPatch: 2,
+			noStatus:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx   = context.Background()
+				reg   = newFakeRegistry(tt.failPatch, tt.noStatus)
+				srv   = httptest.NewServer(reg)
+				layer = randomLayer(t)
+			)
+
+			t.Cleanup(srv.Close)
+
+			repo, err := name.NewRepository(strings.TrimPrefix(srv.URL, "http://")+"/test", name.Insecure)
+			require.NoError(t, err)
+
+			var lastComplete int64
+
+			up := &uploader{
+				logger: slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				client: srv.Client(),
+				repo:   repo,
+				opts: pushOptions{
+					chunkSize:   1024,
+					maxAttempts: 3,
+					backoff:     time.Millisecond,
+					progress: func(complete int64, _ int64) {
+						lastComplete = complete
+					},
+				},
+			}
+
+			err = up.uploadLayer(ctx, layer)
+			require.NoError(t, err)
+
+			digest, err := layer.Digest()
+			require.NoError(t, err)
+
+			rc, err := layer.Compressed()
+			require.NoError(t, err)
+
+			expected, err := io.ReadAll(rc)
+			require.NoError(t, err)
+
+			require.Equal(t, expected, reg.blob(digest.String()))
+
+			size, err := layer.Size()
+			require.NoError(t, err)
+			require.Equal(t, size, lastComplete)
+
+			// uploading an existing layer is a noop
+			require.NoError(t, up.uploadLayer(ctx, layer))
+		})
+	}
+}
+
+func randomLayer(t *testing.T) ociv1.Layer {
+	data := make([]byte, 4096)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     "data",
+		Size:     int64(len(data)),
+	}))
+
+	_, err = tw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	l, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	require.NoError(t, err)
+
+	return l
+}
+
+// fakeRegistry implements the parts of the oci distribution spec needed for chunked uploads.
+type fakeRegistry struct {
+	mu        sync.Mutex
+	failPatch int
+	noStatus  bool
+	patches   int
+	nextID    int
+	uploads   map[string][]byte
+	blobs     map[string][]byte
+}
+
+func newFakeRegistry(failPatch int, noStatus bool) *fakeRegistry {
+	return &fakeRegistry{
+		failPatch: failPatch,
+		noStatus:  noStatus,
+		uploads:   make(map[string][]byte),
+		blobs:     make(map[string][]byte),
+	}
+}
+
+func (r *fakeRegistry) blob(digest string) []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.blobs[digest]
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	const (
+		blobsPrefix   = "/v2/test/blobs/"
+		uploadsPrefix = "/v2/test/blobs/uploads/"
+	)
+
+	switch {
+	case req.URL.Path == "/v2/":
+		w.WriteHeader(http.StatusOK)
+	case req.Method == http.MethodHead && strings.HasPrefix(req.URL.Path, blobsPrefix):
+		if _, ok := r.blobs[strings.TrimPrefix(req.URL.Path, blobsPrefix)]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case req.Method == http.MethodPost && req.URL.Path == uploadsPrefix:
+		r.nextID++
+		id := fmt.Sprint(r.nextID)
+		r.uploads[id] = nil
+		w.Header().Set("Location", uploadsPrefix+id)
+		w.WriteHeader(http.StatusAccepted)
+	case req.Method == http.MethodPatch && strings.HasPrefix(req.URL.Path, uploadsPrefix):
+		id := strings.TrimPrefix(req.URL.Path, uploadsPrefix)
+		data, ok := r.uploads[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		idx := r.patches
+		r.patches++
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if idx == r.failPatch {
+			// the upload session is lost if the registry cannot report its status
+			if r.noStatus {
+				delete(r.uploads, id)
+			}
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		var start, end int
+		if _, err := fmt.Sscanf(req.Header.Get("Content-Range"), "%d-%d", &start, &end); err != nil ||
+			start != len(data) || end-start+1 != len(body) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+
+		r.uploads[id] = append(data, body...)
+		w.Header().Set("Location", uploadsPrefix+id)
+		w.Header().Set("Range", fmt.Sprintf("0-%d", len(r.uploads[id])-1))
+		w.WriteHeader(http.StatusAccepted)
+	case req.Method == http.MethodGet && strings.HasPrefix(req.URL.Path, uploadsPrefix):
+		data, ok := r.uploads[strings.TrimPrefix(req.URL.Path, uploadsPrefix)]
+		if r.noStatus || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Range", fmt.Sprintf("0-%d", len(data)-1))
+		w.WriteHeader(http.StatusNoContent)
+	case req.Method == http.MethodPut && strings.HasPrefix(req.URL.Path, uploadsPrefix):
+		id := strings.TrimPrefix(req.URL.Path, uploadsPrefix)
+		data, ok := r.uploads[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		sum := sha256.Sum256(data)
+		digest := "sha256:" + hex.EncodeToString(sum[:])
+		if digest != req.URL.Query().Get("digest") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		delete(r.uploads, id)
+		r.blobs[digest] = data
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,171 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package loadgen generates load against a control plane by simulating
+// nodes discovering instances and reporting their status, as well as
+// users publishing and running chunks. latency and throughput of every
+// operation are recorded, so regressions in the database layer or the
+// reconciliation protocol can be spotted before releasing.
+//
+// Simulated nodes do not serve the checkpoint api, so the control plane
+// should run in dev mode, where checkpoint builds are stubbed out.
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/grpcclient"
+	"github.com/spacechunks/explorer/pkg/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type Config struct {
+	// Addr is the address of the control plane.
+	Addr  string
+	Creds credentials.TransportCredentials
+
+	// NodeKeys are the keys of the simulated nodes. nodes have
+	// to be registered already, see RegisterNodes.
+	NodeKeys []string
+
+	// Users are the simulated users. each one continuously
+	// publishes and runs chunks, see Publisher.
+	Users []User
+
+	// Duration is the time load is generated for. iterations that
+	// are in progress when it is reached are completed nonetheless.
+	Duration time.Duration
+
+	// SyncInterval is the interval in which nodes discover
+	// instances and report their status.
+	SyncInterval time.Duration
+
+	// PollInterval is the interval in which users check
+	// the state of builds and instances.
+	PollInterval     time.Duration
+	MinecraftVersion string
+
+	// WorkDir is the directory flavor files are written to.
+	WorkDir string
+	Logger  *slog.Logger
+}
+
+// User is a user chunks are published and run as.
+type User struct {
+	ID    string
+	Token string
+}
+
+// Run generates load until the configured duration elapsed or ctx is
+// cancelled and returns a report of all operations that have been issued.
+func Run(ctx context.Context, cfg Config) (Report, error) {
+	var (
+		rec        = NewRecorder()
+		nodes      = make([]*Node, 0, len(cfg.NodeKeys))
+		publishers = make([]*Publisher, 0, len(cfg.Users))
+		closers    = make([]func() error, 0, len(cfg.NodeKeys)+len(cfg.Users))
+	)
+
+	defer func() {
+		for _, c := range closers {
+			_ = c()
+		}
+	}()
+
+	for _, key := range cfg.NodeKeys {
+		conn, err := grpcclient.New(cfg.Addr, grpcclient.DefaultConfig, grpc.WithTransportCredentials(cfg.Creds))
+		if err != nil {
+			return Report{}, fmt.Errorf("create node client: %w", err)
+		}
+		closers = append(closers, conn.Close)
+		nodes = append(nodes, NewNode(key, instancev1alpha1.NewInstanceServiceClient(conn), rec))
+	}
+
+	for _, u := range cfg.Users {
+		c, err := client.New(
+			cfg.Addr,
+			client.WithToken(u.Token),
+			client.WithTransportCredentials(cfg.Creds),
+			client.WithPollInterval(cfg.PollInterval),
+		)
+		if err != nil {
+			return Report{}, fmt.Errorf("create user client: %w", err)
+		}
+		closers = append(closers, c.Close)
+		publishers = append(publishers, NewPublisher(c, rec, cfg.MinecraftVersion, cfg.WorkDir))
+	}
+
+	var (
+		nodeCtx, stopNodes = context.WithCancel(ctx)
+		pubCtx, stopPubs   = context.WithTimeout(ctx, cfg.Duration)
+		nodeWG             sync.WaitGroup
+		pubWG              sync.WaitGroup
+		start              = time.Now()
+	)
+
+	defer stopNodes()
+	defer stopPubs()
+
+	for _, n := range nodes {
+		nodeWG.Go(func() {
+			ticker := time.NewTicker(cfg.SyncInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-nodeCtx.Done():
+					return
+				case <-ticker.C:
+					if err := n.Sync(ctx); err != nil {
+						cfg.Logger.ErrorContext(ctx, "node sync failed", "node_key", n.Key, "err", err)
+					}
+				}
+			}
+		})
+	}
+
+	for _, p := range publishers {
+		pubWG.Go(func() {
+			for pubCtx.Err() == nil {
+				if err := p.Iterate(ctx); err != nil {
+					cfg.Logger.ErrorContext(ctx, "publisher iteration failed", "err", err)
+				}
+			}
+		})
+	}
+
+	// users are waiting for their instances to be reported as
+	// running, so nodes have to be stopped after all users.
+	if len(publishers) > 0 {
+		pubWG.Wait()
+	} else {
+		<-pubCtx.Done()
+	}
+
+	elapsed := time.Since(start)
+
+	stopNodes()
+	nodeWG.Wait()
+
+	return rec.Report(elapsed), nil
+}
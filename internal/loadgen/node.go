@@ -0,0 +1,157 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+)
+
+const (
+	OpDiscoverInstances = "discover_instances"
+	OpReportStatus      = "report_status"
+)
+
+// Node simulates a platformd instance. it discovers the instances scheduled to
+// it and reports them as running right away, without starting any workloads.
+// like platformd, only instances that changed since the last discovery are
+// requested and only statuses that changed since the last report are sent.
+type Node struct {
+	Key string
+
+	client instancev1alpha1.InstanceServiceClient
+	rec    *Recorder
+
+	revision  string
+	nextPort  uint32
+	instances map[string]uint32
+	pending   map[string]*instancev1alpha1.InstanceStatusReport
+}
+
+func NewNode(key string, client instancev1alpha1.InstanceServiceClient, rec *Recorder) *Node {
+	return &Node{
+		Key:       key,
+		client:    client,
+		rec:       rec,
+		nextPort:  30000,
+		instances: make(map[string]uint32),
+		pending:   make(map[string]*instancev1alpha1.InstanceStatusReport),
+	}
+}
+
+// Sync discovers instances and reports their status once.
+// it must not be called concurrently.
+func (n *Node) Sync(ctx context.Context) error {
+	start := time.Now()
+	resp, err := n.client.DiscoverInstances(ctx, &instancev1alpha1.DiscoverInstanceRequest{
+		NodeKey:  n.Key,
+		Revision: n.revision,
+	})
+	n.rec.Observe(OpDiscoverInstances, start, err)
+	if err != nil {
+		return fmt.Errorf("discover instances: %w", err)
+	}
+
+	if resp.GetFull() {
+		n.replace(resp.GetInstances())
+	}
+
+	for _, ins := range resp.GetInstances() {
+		n.reconcile(ins)
+	}
+
+	n.revision = resp.GetRevision()
+
+	if len(n.pending) == 0 {
+		return nil
+	}
+
+	reports := make([]*instancev1alpha1.InstanceStatusReport, 0, len(n.pending))
+	for _, r := range n.pending {
+		reports = append(reports, r)
+	}
+
+	start = time.Now()
+	_, err = n.client.ReceiveInstanceStatusReports(ctx, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+		Reports: reports,
+	})
+	n.rec.Observe(OpReportStatus, start, err)
+	if err != nil {
+		return fmt.Errorf("report status: %w", err)
+	}
+
+	clear(n.pending)
+	return nil
+}
+
+// Instances returns the number of instances currently running on the node.
+func (n *Node) Instances() int {
+	return len(n.instances)
+}
+
+// replace forgets about all instances that are not part of a full discovery.
+func (n *Node) replace(instances []*instancev1alpha1.Instance) {
+	known := make(map[string]bool, len(instances))
+	for _, ins := range instances {
+		known[ins.GetId()] = true
+	}
+
+	for id := range n.instances {
+		if !known[id] {
+			delete(n.instances, id)
+		}
+	}
+}
+
+func (n *Node) reconcile(ins *instancev1alpha1.Instance) {
+	id := ins.GetId()
+
+	switch ins.GetState() {
+	case instancev1alpha1.InstanceState_PENDING, instancev1alpha1.InstanceState_CREATING:
+		if _, ok := n.instances[id]; ok {
+			return
+		}
+		n.instances[id] = n.nextPort
+		n.report(id, instancev1alpha1.InstanceState_RUNNING, n.nextPort)
+		n.nextPort++
+	case instancev1alpha1.InstanceState_RUNNING:
+		// the node has been restarted or the instance has been
+		// scheduled before the load generator started.
+		if _, ok := n.instances[id]; !ok {
+			n.report(id, instancev1alpha1.InstanceState_DELETED, 0)
+		}
+	case instancev1alpha1.InstanceState_DELETING:
+		delete(n.instances, id)
+		n.report(id, instancev1alpha1.InstanceState_DELETED, 0)
+	default:
+		// instances in other states do not
+		// require any action by the node.
+	}
+}
+
+func (n *Node) report(id string, state instancev1alpha1.InstanceState, port uint32) {
+	n.pending[id] = &instancev1alpha1.InstanceStatusReport{
+		InstanceId: id,
+		State:      state,
+		Port:       port,
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package loadgen_test
+
+import (
+	"context"
+	"testing"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/loadgen"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/test"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeSync(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		insClient = mock.NewMockV1alpha1InstanceServiceClient(t)
+		rec       = loadgen.NewRecorder()
+		node      = loadgen.NewNode(test.NewUUIDv7(t), insClient, rec)
+		insID     = test.NewUUIDv7(t)
+	)
+
+	insClient.EXPECT().
+		DiscoverInstances(mocky.Anything, &instancev1alpha1.DiscoverInstanceRequest{
+			NodeKey: node.Key,
+		}).
+		Return(&instancev1alpha1.DiscoverInstanceResponse{
+			Instances: []*instancev1alpha1.Instance{
+				{
+					Id:    insID,
+					State: instancev1alpha1.InstanceState_PENDING,
+				},
+			},
+			Revision: "1",
+			Full:     true,
+		}, nil).
+		Once()
+
+	insClient.EXPECT().
+		ReceiveInstanceStatusReports(mocky.Anything, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+			Reports: []*instancev1alpha1.InstanceStatusReport{
+				{
+					InstanceId: insID,
+					State:      instancev1alpha1.InstanceState_RUNNING,
+					Port:       30000,
+				},
+			},
+		}).
+		Return(&instancev1alpha1.ReceiveInstanceStatusReportsResponse{}, nil).
+		Once()
+
+	require.NoError(t, node.Sync(ctx))
+	require.Equal(t, 1, node.Instances())
+
+	// nothing changed, so no status reports are sent.
+	insClient.EXPECT().
+		DiscoverInstances(mocky.Anything, &instancev1alpha1.DiscoverInstanceRequest{
+			NodeKey:  node.Key,
+			Revision: "1",
+		}).
+		Return(&instancev1alpha1.DiscoverInstanceResponse{
+			Revision: "1",
+		}, nil).
+		Once()
+
+	require.NoError(t, node.Sync(ctx))
+
+	insClient.EXPECT().
+		DiscoverInstances(mocky.Anything, &instancev1alpha1.DiscoverInstanceRequest{
+			NodeKey:  node.Key,
+			Revision: "1",
+		}).
+		Return(&instancev1alpha1.DiscoverInstanceResponse{
+			Instances: []*instancev1alpha1.Instance{
+				{
+					Id:    insID,
+					State: instancev1alpha1.InstanceState_DELETING,
+				},
+			},
+			Revision: "2",
+		}, nil).
+		Once()
+
+	insClient.EXPECT().
+		ReceiveInstanceStatusReports(mocky.Anything, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+			Reports: []*instancev1alpha1.InstanceStatusReport{
+				{
+					InstanceId: insID,
+					State:      instancev1alpha1.InstanceState_DELETED,
+				},
+			},
+		}).
+		Return(&instancev1alpha1.ReceiveInstanceStatusReportsResponse{}, nil).
+		Once()
+
+	require.NoError(t, node.Sync(ctx))
+	require.Equal(t, 0, node.Instances())
+
+	rep := rec.Report(0)
+
+	discover, ok := rep.Op(loadgen.OpDiscoverInstances)
+	require.True(t, ok)
+	require.Equal(t, 3, discover.Count)
+
+	report, ok := rep.Op(loadgen.OpReportStatus)
+	require.True(t, ok)
+	require.Equal(t, 2, report.Count)
+}
@@ -0,0 +1,147 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package loadgen
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/pkg/client"
+)
+
+const (
+	OpCreateChunk   = "create_chunk"
+	OpPublishFlavor = "publish_flavor"
+	OpRunInstance   = "run_instance"
+	OpStopInstance  = "stop_instance"
+
+	flavorName = "default"
+)
+
+// Publisher simulates a user publishing chunks and running them.
+type Publisher struct {
+	client           *client.Client
+	rec              *Recorder
+	minecraftVersion string
+	workDir          string
+}
+
+// NewPublisher creates a publisher issuing requests using the given client,
+// which has to be authenticated as the simulated user. flavor files are
+// written to workDir.
+func NewPublisher(c *client.Client, rec *Recorder, minecraftVersion string, workDir string) *Publisher {
+	return &Publisher{
+		client:           c,
+		rec:              rec,
+		minecraftVersion: minecraftVersion,
+		workDir:          workDir,
+	}
+}
+
+// Iterate publishes a new chunk, runs the flavor and
+// stops the instance again once it is running.
+func (p *Publisher) Iterate(ctx context.Context) error {
+	chunkID, _, err := p.Publish(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	ins, err := p.client.RunAndWait(ctx, chunkID, flavorName, nil)
+	p.rec.Observe(OpRunInstance, start, err)
+	if err != nil {
+		return fmt.Errorf("run instance: %w", err)
+	}
+
+	start = time.Now()
+	_, err = p.client.Instance.StopInstance(ctx, &instancev1alpha1.StopInstanceRequest{
+		Id: ins.GetId(),
+	})
+	p.rec.Observe(OpStopInstance, start, err)
+	if err != nil {
+		return fmt.Errorf("stop instance: %w", err)
+	}
+
+	return nil
+}
+
+// Publish creates a new chunk and publishes a flavor for it. the flavor
+// files are random, so each call builds a new flavor version. the id of
+// the chunk and the built flavor version are returned.
+func (p *Publisher) Publish(ctx context.Context) (string, *chunkv1alpha1.FlavorVersion, error) {
+	name := "loadgen-" + rand.Text()[:10]
+
+	start := time.Now()
+	resp, err := p.client.Chunk.CreateChunk(ctx, &chunkv1alpha1.CreateChunkRequest{
+		Name:        name,
+		Description: "created by loadgen",
+		Tags:        []string{"pvp"},
+	})
+	p.rec.Observe(OpCreateChunk, start, err)
+	if err != nil {
+		return "", nil, fmt.Errorf("create chunk: %w", err)
+	}
+
+	dir, err := p.flavorFiles(name)
+	if err != nil {
+		return "", nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	chunkID := resp.GetChunk().GetId()
+
+	start = time.Now()
+	version, err := p.client.PublishFlavor(ctx, chunkID, dir, client.FlavorSpec{
+		Name:             flavorName,
+		Version:          "v1",
+		MinecraftVersion: p.minecraftVersion,
+		MinPlayers:       1,
+		MaxPlayers:       10,
+	})
+	p.rec.Observe(OpPublishFlavor, start, err)
+	if err != nil {
+		return "", nil, fmt.Errorf("publish flavor: %w", err)
+	}
+
+	return chunkID, version, nil
+}
+
+func (p *Publisher) flavorFiles(name string) (string, error) {
+	dir := filepath.Join(p.workDir, name)
+
+	if err := os.MkdirAll(filepath.Join(dir, "plugins"), 0o755); err != nil {
+		return "", fmt.Errorf("create flavor dir: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "server.properties"), []byte("motd="+name), 0o644); err != nil {
+		return "", fmt.Errorf("write server.properties: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "plugins", "plugin.jar"), []byte(rand.Text()), 0o644); err != nil {
+		return "", fmt.Errorf("write plugin: %w", err)
+	}
+
+	return dir, nil
+}
@@ -0,0 +1,206 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package loadgen
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Recorder collects the latency and outcome of operations issued against
+// the control plane. it is safe for concurrent use.
+type Recorder struct {
+	mu  sync.Mutex
+	ops map[string]*samples
+}
+
+type samples struct {
+	latencies []time.Duration
+	errors    int
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		ops: make(map[string]*samples),
+	}
+}
+
+// Observe records an operation that started at the given time. failed
+// operations are counted, but their latency is not taken into account.
+func (r *Recorder) Observe(op string, start time.Time, err error) {
+	d := time.Since(start)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.ops[op]
+	if !ok {
+		s = &samples{}
+		r.ops[op] = s
+	}
+
+	if err != nil {
+		s.errors++
+		return
+	}
+
+	s.latencies = append(s.latencies, d)
+}
+
+// Reset discards all operations recorded so far.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clear(r.ops)
+}
+
+// Report summarizes all operations recorded so far. elapsed is the
+// time the load has been generated for and is used to calculate the
+// throughput.
+func (r *Recorder) Report(elapsed time.Duration) Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rep := Report{
+		Elapsed: elapsed,
+		Ops:     make([]OpStats, 0, len(r.ops)),
+	}
+
+	for name, s := range r.ops {
+		sorted := slices.Clone(s.latencies)
+		slices.Sort(sorted)
+
+		stats := OpStats{
+			Name:   name,
+			Count:  len(sorted),
+			Errors: s.errors,
+			P50:    percentile(sorted, 50),
+			P95:    percentile(sorted, 95),
+			P99:    percentile(sorted, 99),
+		}
+
+		if len(sorted) > 0 {
+			stats.Max = sorted[len(sorted)-1]
+		}
+
+		if elapsed > 0 {
+			stats.Throughput = float64(stats.Count) / elapsed.Seconds()
+		}
+
+		rep.Ops = append(rep.Ops, stats)
+	}
+
+	sort.Slice(rep.Ops, func(i, j int) bool {
+		return rep.Ops[i].Name < rep.Ops[j].Name
+	})
+
+	return rep
+}
+
+// Report contains the latency and throughput of each operation.
+type Report struct {
+	Elapsed time.Duration
+	Ops     []OpStats
+}
+
+// OpStats summarizes the successful executions of a single operation.
+type OpStats struct {
+	Name   string
+	Count  int
+	Errors int
+
+	// Throughput is the number of successful operations per second.
+	Throughput float64
+
+	P50 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+	Max time.Duration
+}
+
+// Op returns the stats of the operation with the given name.
+func (r Report) Op(name string) (OpStats, bool) {
+	for _, op := range r.Ops {
+		if op.Name == name {
+			return op, true
+		}
+	}
+	return OpStats{}, false
+}
+
+// Errors returns the number of failed operations.
+func (r Report) Errors() int {
+	var n int
+	for _, op := range r.Ops {
+		n += op.Errors
+	}
+	return n
+}
+
+// Write prints the report as table to w.
+func (r Report) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	if _, err := fmt.Fprintf(tw, "OPERATION\tCOUNT\tERRORS\tOPS/S\tP50\tP95\tP99\tMAX\n"); err != nil {
+		return err
+	}
+
+	for _, op := range r.Ops {
+		if _, err := fmt.Fprintf(
+			tw,
+			"%s\t%d\t%d\t%.2f\t%s\t%s\t%s\t%s\n",
+			op.Name,
+			op.Count,
+			op.Errors,
+			op.Throughput,
+			op.P50.Round(time.Microsecond),
+			op.P95.Round(time.Microsecond),
+			op.P99.Round(time.Microsecond),
+			op.Max.Round(time.Microsecond),
+		); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(tw, "\nelapsed: %s\n", r.Elapsed.Round(time.Millisecond)); err != nil {
+		return err
+	}
+
+	return tw.Flush()
+}
+
+// percentile uses the nearest-rank method. sorted has to be in ascending order.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
@@ -0,0 +1,65 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package loadgen_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/loadgen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorderReport(t *testing.T) {
+	rec := loadgen.NewRecorder()
+
+	// observe 1ms to 100ms, so percentiles map to the index.
+	for i := 100; i > 0; i-- {
+		rec.Observe("op", time.Now().Add(-time.Duration(i)*time.Millisecond), nil)
+	}
+
+	rec.Observe("op", time.Now(), errors.New("some error"))
+	rec.Observe("failing", time.Now(), errors.New("some error"))
+
+	rep := rec.Report(10 * time.Second)
+
+	op, ok := rep.Op("op")
+	require.True(t, ok)
+
+	require.Equal(t, 100, op.Count)
+	require.Equal(t, 1, op.Errors)
+	require.InDelta(t, 10, op.Throughput, 0.001)
+	require.InDelta(t, 50*time.Millisecond, op.P50, float64(time.Millisecond))
+	require.InDelta(t, 95*time.Millisecond, op.P95, float64(time.Millisecond))
+	require.InDelta(t, 99*time.Millisecond, op.P99, float64(time.Millisecond))
+	require.InDelta(t, 100*time.Millisecond, op.Max, float64(time.Millisecond))
+
+	failing, ok := rep.Op("failing")
+	require.True(t, ok)
+	require.Equal(t, loadgen.OpStats{Name: "failing", Errors: 1}, failing)
+
+	require.Equal(t, 2, rep.Errors())
+
+	var buf bytes.Buffer
+	require.NoError(t, rep.Write(&buf))
+	require.Contains(t, buf.String(), "OPERATION")
+	require.Contains(t, buf.String(), "failing")
+}
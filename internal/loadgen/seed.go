@@ -0,0 +1,102 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package loadgen
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/spacechunks/explorer/controlplane/postgres"
+	"github.com/spacechunks/explorer/controlplane/signing"
+	"github.com/spacechunks/explorer/controlplane/user"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// RegisterNodes inserts n nodes with the given number of slots into the
+// database of the control plane and returns their keys. nodes are not
+// removed afterward, so a dedicated database should be used for load tests.
+func RegisterNodes(ctx context.Context, pool *pgxpool.Pool, n int, slots int) ([]string, error) {
+	keys := make([]string, 0, n)
+	for range n {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("node id: %w", err)
+		}
+
+		if _, err := pool.Exec(
+			ctx,
+			`INSERT INTO nodes (id, name, address, checkpoint_api_endpoint, slots) VALUES ($1, $2, $3, $4, $5)`,
+			id.String(),
+			"loadgen-"+id.String(),
+			"127.0.0.1",
+			"127.0.0.1:3011",
+			slots,
+		); err != nil {
+			return nil, fmt.Errorf("insert node: %w", err)
+		}
+
+		keys = append(keys, id.String())
+	}
+	return keys, nil
+}
+
+// CreateUsers creates n users and issues an api token for each of them
+// using signer. the tokens expire after the given duration.
+func CreateUsers(
+	ctx context.Context,
+	logger *slog.Logger,
+	pool *pgxpool.Pool,
+	signer signing.Signer,
+	issuer string,
+	expiry time.Duration,
+	n int,
+) ([]User, error) {
+	var (
+		db    = postgres.NewDB(logger, pool)
+		users = make([]User, 0, n)
+	)
+
+	for range n {
+		name := "loadgen-" + rand.Text()[:10]
+
+		u, err := db.CreateUser(ctx, resource.User{
+			Nickname: name,
+			Email:    name + "@loadgen.local",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create user: %w", err)
+		}
+
+		tok, err := user.NewAPIToken(signer, issuer, expiry, u)
+		if err != nil {
+			return nil, fmt.Errorf("issue api token: %w", err)
+		}
+
+		users = append(users, User{
+			ID:    u.ID,
+			Token: string(tok),
+		})
+	}
+	return users, nil
+}
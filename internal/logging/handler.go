@@ -0,0 +1,91 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package logging provides a slog handler that adds correlation ids, like
+// the id of the request and the trace being handled, to all records and
+// filters records using levels that can be configured per component.
+// components are identified by the component attribute of a logger, e.g.
+// logger.With("component", "reconciler").
+package logging
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ComponentKey is the attribute key identifying the component a logger belongs to.
+const ComponentKey = "component"
+
+// Handler wraps another handler, which should not filter any records itself,
+// because records are already filtered using the level of their component.
+type Handler struct {
+	next      slog.Handler
+	levels    *Levels
+	component string
+}
+
+func NewHandler(next slog.Handler, levels *Levels) *Handler {
+	return &Handler{
+		next:   next,
+		levels: levels,
+	}
+}
+
+func (h *Handler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.levels.Level(h.component)
+}
+
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsValid() {
+		r.AddAttrs(
+			slog.String("trace_id", spanCtx.TraceID().String()),
+			slog.String("span_id", spanCtx.SpanID().String()),
+		)
+	}
+
+	return h.next.Handle(ctx, r)
+}
+
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	component := h.component
+	for _, a := range attrs {
+		if a.Key == ComponentKey {
+			component = a.Value.String()
+		}
+	}
+
+	return &Handler{
+		next:      h.next.WithAttrs(attrs),
+		levels:    h.levels,
+		component: component,
+	}
+}
+
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{
+		next:      h.next.WithGroup(name),
+		levels:    h.levels,
+		component: h.component,
+	}
+}
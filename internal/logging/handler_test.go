@@ -0,0 +1,101 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHandlerComponentLevels(t *testing.T) {
+	var (
+		buf    bytes.Buffer
+		levels = logging.NewLevels(slog.LevelInfo)
+		logger = slog.New(logging.NewHandler(
+			slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			levels,
+		))
+		reconciler = logger.With("component", "reconciler")
+	)
+
+	require.NoError(t, levels.Set("info,reconciler=debug"))
+
+	logger.Debug("dropped")
+	reconciler.Debug("kept")
+	reconciler.WithGroup("group").Debug("kept in group")
+
+	lines := decodeLines(t, &buf)
+	require.Len(t, lines, 2)
+	require.Equal(t, "kept", lines[0]["msg"])
+	require.Equal(t, "kept in group", lines[1]["msg"])
+
+	// changes apply to existing loggers
+	require.NoError(t, levels.Set("info"))
+	reconciler.Debug("dropped")
+	require.Empty(t, decodeLines(t, &buf))
+}
+
+func TestHandlerAddsCorrelationIDs(t *testing.T) {
+	var (
+		buf    bytes.Buffer
+		logger = slog.New(logging.NewHandler(
+			slog.NewJSONHandler(&buf, nil),
+			logging.NewLevels(slog.LevelInfo),
+		))
+		traceID = trace.TraceID{1}
+		spanID  = trace.SpanID{2}
+		ctx     = trace.ContextWithSpanContext(
+			logging.WithRequestID(context.Background(), "abc"),
+			trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: traceID,
+				SpanID:  spanID,
+			}),
+		)
+	)
+
+	logger.InfoContext(ctx, "msg")
+
+	lines := decodeLines(t, &buf)
+	require.Len(t, lines, 1)
+	require.Equal(t, "abc", lines[0]["request_id"])
+	require.Equal(t, traceID.String(), lines[0]["trace_id"])
+	require.Equal(t, spanID.String(), lines[0]["span_id"])
+}
+
+func decodeLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var (
+		dec   = json.NewDecoder(buf)
+		lines []map[string]any
+	)
+
+	for dec.More() {
+		var line map[string]any
+		require.NoError(t, dec.Decode(&line))
+		lines = append(lines, line)
+	}
+
+	return lines
+}
@@ -0,0 +1,213 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Levels holds the minimum level of each component. components
+// without a level of their own use the default level. levels can
+// be changed at any time and apply to all loggers right away.
+type Levels struct {
+	mu         sync.RWMutex
+	def        slog.Level
+	components map[string]slog.Level
+}
+
+func NewLevels(def slog.Level) *Levels {
+	return &Levels{
+		def:        def,
+		components: make(map[string]slog.Level),
+	}
+}
+
+// ParseLevels creates levels from the given spec. see Levels.Set.
+func ParseLevels(spec string) (*Levels, error) {
+	l := NewLevels(slog.LevelInfo)
+	if err := l.Set(spec); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Level returns the minimum level of the given component.
+func (l *Levels) Level(component string) slog.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if lvl, ok := l.components[component]; ok {
+		return lvl
+	}
+	return l.def
+}
+
+// Set replaces all levels with the ones from spec. spec is a comma separated
+// list of levels, where entries without a component set the default level,
+// for example "info,reconciler=debug,chunk-service=warn". levels of
+// components that are not part of spec are reset to the default level.
+// an empty spec sets the default level to info.
+func (l *Levels) Set(spec string) error {
+	var (
+		def        = slog.LevelInfo
+		components = make(map[string]slog.Level)
+	)
+
+	for entry := range strings.SplitSeq(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		component, level, ok := strings.Cut(entry, "=")
+		if !ok {
+			level = component
+		}
+
+		var lvl slog.Level
+		if err := lvl.UnmarshalText([]byte(strings.TrimSpace(level))); err != nil {
+			return fmt.Errorf("invalid level %q: %w", entry, err)
+		}
+
+		if !ok {
+			def = lvl
+			continue
+		}
+
+		components[strings.TrimSpace(component)] = lvl
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.def = def
+	l.components = components
+	return nil
+}
+
+// String returns the spec of the current levels.
+func (l *Levels) String() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	entries := []string{strings.ToLower(l.def.String())}
+	for _, c := range slices.Sorted(maps.Keys(l.components)) {
+		entries = append(entries, c+"="+strings.ToLower(l.components[c].String()))
+	}
+
+	return strings.Join(entries, ",")
+}
+
+// Watch applies the spec stored in the file at path every time the file
+// changes, until ctx is cancelled. the file is checked in the given interval.
+// invalid specs are logged and the current levels are kept.
+func (l *Levels) Watch(ctx context.Context, logger *slog.Logger, path string, interval time.Duration) {
+	var (
+		modTime time.Time
+		size    int64
+		ticker  = time.NewTicker(interval)
+	)
+	defer ticker.Stop()
+
+	for {
+		info, err := os.Stat(path)
+		if err != nil {
+			logger.WarnContext(ctx, "failed to stat log level file", "path", path, "err", err)
+		}
+
+		if err == nil && (!info.ModTime().Equal(modTime) || info.Size() != size) {
+			modTime = info.ModTime()
+			size = info.Size()
+
+			if err := l.apply(path); err != nil {
+				logger.ErrorContext(ctx, "failed to apply log levels", "path", path, "err", err)
+			} else {
+				logger.InfoContext(ctx, "applied log levels", "levels", l.String())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *Levels) apply(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read file: %w", err)
+	}
+	return l.Set(strings.TrimSpace(string(data)))
+}
+
+// Override applies levels that are changed at runtime, e.g. by admins,
+// on top of the levels a program has been started with. a spec is only
+// applied when it changed, so levels set in the meantime, e.g. using a
+// log level file, are not overwritten every time the spec is checked.
+type Override struct {
+	levels  *Levels
+	startup string
+
+	mu      sync.Mutex
+	current string
+}
+
+// NewOverride returns an override for levels, which
+// have been set to the given startup spec.
+func NewOverride(levels *Levels, startup string) *Override {
+	return &Override{
+		levels:  levels,
+		startup: startup,
+	}
+}
+
+// Apply sets the levels to spec, if it differs from the spec passed
+// the last time. an empty spec restores the startup levels. reports
+// whether the levels have been changed.
+func (o *Override) Apply(spec string) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if spec == o.current {
+		return false, nil
+	}
+
+	// remember invalid specs as well, so they are only reported once.
+	o.current = spec
+
+	if spec == "" {
+		spec = o.startup
+	}
+
+	if err := o.levels.Set(spec); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
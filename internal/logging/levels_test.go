@@ -0,0 +1,163 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package logging_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevelsSet(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       string
+		expected   map[string]slog.Level
+		expectSpec string
+		err        bool
+	}{
+		{
+			name: "empty spec uses info",
+			spec: "",
+			expected: map[string]slog.Level{
+				"": slog.LevelInfo,
+			},
+			expectSpec: "info",
+		},
+		{
+			name: "default and components",
+			spec: "warn, reconciler=debug,chunk-service = error",
+			expected: map[string]slog.Level{
+				"":              slog.LevelWarn,
+				"other":         slog.LevelWarn,
+				"reconciler":    slog.LevelDebug,
+				"chunk-service": slog.LevelError,
+			},
+			expectSpec: "warn,chunk-service=error,reconciler=debug",
+		},
+		{
+			name: "only components",
+			spec: "reconciler=debug",
+			expected: map[string]slog.Level{
+				"":           slog.LevelInfo,
+				"reconciler": slog.LevelDebug,
+			},
+			expectSpec: "info,reconciler=debug",
+		},
+		{
+			name: "invalid level",
+			spec: "info,reconciler=loud",
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := logging.ParseLevels(tt.spec)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			for component, expected := range tt.expected {
+				require.Equal(t, expected, l.Level(component), component)
+			}
+
+			require.Equal(t, tt.expectSpec, l.String())
+		})
+	}
+}
+
+func TestLevelsSetReplacesLevels(t *testing.T) {
+	l, err := logging.ParseLevels("warn,reconciler=debug")
+	require.NoError(t, err)
+
+	require.NoError(t, l.Set("error"))
+	require.Equal(t, slog.LevelError, l.Level("reconciler"))
+
+	// invalid specs keep the current levels
+	require.Error(t, l.Set("nope"))
+	require.Equal(t, "error", l.String())
+}
+
+func TestLevelsWatch(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		path        = filepath.Join(t.TempDir(), "levels")
+		l           = logging.NewLevels(slog.LevelInfo)
+		logger      = slog.New(slog.DiscardHandler)
+	)
+	defer cancel()
+
+	require.NoError(t, os.WriteFile(path, []byte("reconciler=debug\n"), 0o644))
+
+	go l.Watch(ctx, logger, path, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return l.Level("reconciler") == slog.LevelDebug
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, os.WriteFile(path, []byte("error"), 0o644))
+
+	require.Eventually(t, func() bool {
+		return l.Level("reconciler") == slog.LevelError
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestOverrideApply(t *testing.T) {
+	l, err := logging.ParseLevels("warn")
+	require.NoError(t, err)
+
+	o := logging.NewOverride(l, "warn")
+
+	changed, err := o.Apply("info,reconciler=debug")
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, slog.LevelDebug, l.Level("reconciler"))
+
+	// levels changed in the meantime are kept, as long
+	// as the same spec is applied again.
+	require.NoError(t, l.Set("error"))
+
+	changed, err = o.Apply("info,reconciler=debug")
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Equal(t, "error", l.String())
+
+	// invalid specs are only reported once
+	_, err = o.Apply("nope")
+	require.Error(t, err)
+
+	_, err = o.Apply("nope")
+	require.NoError(t, err)
+
+	// an empty spec restores the startup levels
+	changed, err = o.Apply("")
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, "warn", l.String())
+}
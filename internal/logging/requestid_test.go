@@ -0,0 +1,130 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package logging_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming string
+		keep     bool
+	}{
+		{
+			name:     "id sent by client is used",
+			incoming: "a1b2-c3_d4",
+			keep:     true,
+		},
+		{
+			name: "id is generated if missing",
+		},
+		{
+			name:     "invalid id is replaced",
+			incoming: "abc\ninjected",
+		},
+		{
+			name:     "too long id is replaced",
+			incoming: strings.Repeat("a", 65),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.incoming != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(logging.RequestIDHeader, tt.incoming))
+			}
+
+			var got string
+			_, err := logging.UnaryServerInterceptor()(
+				ctx,
+				nil,
+				&grpc.UnaryServerInfo{},
+				func(ctx context.Context, _ any) (any, error) {
+					got = logging.RequestID(ctx)
+					return nil, nil
+				},
+			)
+			require.NoError(t, err)
+
+			if tt.keep {
+				require.Equal(t, tt.incoming, got)
+				return
+			}
+
+			require.NotEmpty(t, got)
+			require.NotEqual(t, tt.incoming, got)
+		})
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+	}{
+		{
+			name: "id from context is sent",
+			id:   "abc",
+		},
+		{
+			name: "id is generated if missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.id != "" {
+				ctx = logging.WithRequestID(ctx, tt.id)
+			}
+
+			err := logging.UnaryClientInterceptor()(
+				ctx,
+				"/method",
+				nil,
+				nil,
+				nil,
+				func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+					md, ok := metadata.FromOutgoingContext(ctx)
+					require.True(t, ok)
+
+					sent := md.Get(logging.RequestIDHeader)
+					require.Len(t, sent, 1)
+					require.Equal(t, logging.RequestID(ctx), sent[0])
+
+					if tt.id != "" {
+						require.Equal(t, tt.id, sent[0])
+					}
+					return nil
+				},
+			)
+			require.NoError(t, err)
+		})
+	}
+}
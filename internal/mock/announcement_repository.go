@@ -0,0 +1,202 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	resource "github.com/spacechunks/explorer/internal/resource"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockAnnouncementRepository is an autogenerated mock type for the Repository type
+type MockAnnouncementRepository struct {
+	mock.Mock
+}
+
+type MockAnnouncementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnnouncementRepository) EXPECT() *MockAnnouncementRepository_Expecter {
+	return &MockAnnouncementRepository_Expecter{mock: &_m.Mock}
+}
+
+// ActiveAnnouncements provides a mock function with given fields: ctx, now
+func (_m *MockAnnouncementRepository) ActiveAnnouncements(ctx context.Context, now time.Time) ([]resource.Announcement, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActiveAnnouncements")
+	}
+
+	var r0 []resource.Announcement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]resource.Announcement, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []resource.Announcement); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Announcement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnnouncementRepository_ActiveAnnouncements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActiveAnnouncements'
+type MockAnnouncementRepository_ActiveAnnouncements_Call struct {
+	*mock.Call
+}
+
+// ActiveAnnouncements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockAnnouncementRepository_Expecter) ActiveAnnouncements(ctx interface{}, now interface{}) *MockAnnouncementRepository_ActiveAnnouncements_Call {
+	return &MockAnnouncementRepository_ActiveAnnouncements_Call{Call: _e.mock.On("ActiveAnnouncements", ctx, now)}
+}
+
+func (_c *MockAnnouncementRepository_ActiveAnnouncements_Call) Run(run func(ctx context.Context, now time.Time)) *MockAnnouncementRepository_ActiveAnnouncements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAnnouncementRepository_ActiveAnnouncements_Call) Return(_a0 []resource.Announcement, _a1 error) *MockAnnouncementRepository_ActiveAnnouncements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnnouncementRepository_ActiveAnnouncements_Call) RunAndReturn(run func(context.Context, time.Time) ([]resource.Announcement, error)) *MockAnnouncementRepository_ActiveAnnouncements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAnnouncement provides a mock function with given fields: ctx, a
+func (_m *MockAnnouncementRepository) CreateAnnouncement(ctx context.Context, a resource.Announcement) (resource.Announcement, error) {
+	ret := _m.Called(ctx, a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAnnouncement")
+	}
+
+	var r0 resource.Announcement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Announcement) (resource.Announcement, error)); ok {
+		return rf(ctx, a)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Announcement) resource.Announcement); ok {
+		r0 = rf(ctx, a)
+	} else {
+		r0 = ret.Get(0).(resource.Announcement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Announcement) error); ok {
+		r1 = rf(ctx, a)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnnouncementRepository_CreateAnnouncement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAnnouncement'
+type MockAnnouncementRepository_CreateAnnouncement_Call struct {
+	*mock.Call
+}
+
+// CreateAnnouncement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - a resource.Announcement
+func (_e *MockAnnouncementRepository_Expecter) CreateAnnouncement(ctx interface{}, a interface{}) *MockAnnouncementRepository_CreateAnnouncement_Call {
+	return &MockAnnouncementRepository_CreateAnnouncement_Call{Call: _e.mock.On("CreateAnnouncement", ctx, a)}
+}
+
+func (_c *MockAnnouncementRepository_CreateAnnouncement_Call) Run(run func(ctx context.Context, a resource.Announcement)) *MockAnnouncementRepository_CreateAnnouncement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.Announcement))
+	})
+	return _c
+}
+
+func (_c *MockAnnouncementRepository_CreateAnnouncement_Call) Return(_a0 resource.Announcement, _a1 error) *MockAnnouncementRepository_CreateAnnouncement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnnouncementRepository_CreateAnnouncement_Call) RunAndReturn(run func(context.Context, resource.Announcement) (resource.Announcement, error)) *MockAnnouncementRepository_CreateAnnouncement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAnnouncement provides a mock function with given fields: ctx, id
+func (_m *MockAnnouncementRepository) DeleteAnnouncement(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAnnouncement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAnnouncementRepository_DeleteAnnouncement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAnnouncement'
+type MockAnnouncementRepository_DeleteAnnouncement_Call struct {
+	*mock.Call
+}
+
+// DeleteAnnouncement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockAnnouncementRepository_Expecter) DeleteAnnouncement(ctx interface{}, id interface{}) *MockAnnouncementRepository_DeleteAnnouncement_Call {
+	return &MockAnnouncementRepository_DeleteAnnouncement_Call{Call: _e.mock.On("DeleteAnnouncement", ctx, id)}
+}
+
+func (_c *MockAnnouncementRepository_DeleteAnnouncement_Call) Run(run func(ctx context.Context, id string)) *MockAnnouncementRepository_DeleteAnnouncement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAnnouncementRepository_DeleteAnnouncement_Call) Return(_a0 error) *MockAnnouncementRepository_DeleteAnnouncement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAnnouncementRepository_DeleteAnnouncement_Call) RunAndReturn(run func(context.Context, string) error) *MockAnnouncementRepository_DeleteAnnouncement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAnnouncementRepository creates a new instance of MockAnnouncementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnnouncementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnnouncementRepository {
+	mock := &MockAnnouncementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
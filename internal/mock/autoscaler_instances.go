@@ -0,0 +1,146 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	resource "github.com/spacechunks/explorer/internal/resource"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAutoscalerInstances is an autogenerated mock type for the Instances type
+type MockAutoscalerInstances struct {
+	mock.Mock
+}
+
+type MockAutoscalerInstances_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAutoscalerInstances) EXPECT() *MockAutoscalerInstances_Expecter {
+	return &MockAutoscalerInstances_Expecter{mock: &_m.Mock}
+}
+
+// RunFlavorVersion provides a mock function with given fields: ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup
+func (_m *MockAutoscalerInstances) RunFlavorVersion(ctx context.Context, flavorVersionID string, ownerID string, orderedBy string, params resource.InstanceParams, placementGroup string) (resource.Instance, error) {
+	ret := _m.Called(ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunFlavorVersion")
+	}
+
+	var r0 resource.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, resource.InstanceParams, string) (resource.Instance, error)); ok {
+		return rf(ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, resource.InstanceParams, string) resource.Instance); ok {
+		r0 = rf(ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup)
+	} else {
+		r0 = ret.Get(0).(resource.Instance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, resource.InstanceParams, string) error); ok {
+		r1 = rf(ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAutoscalerInstances_RunFlavorVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunFlavorVersion'
+type MockAutoscalerInstances_RunFlavorVersion_Call struct {
+	*mock.Call
+}
+
+// RunFlavorVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorVersionID string
+//   - ownerID string
+//   - orderedBy string
+//   - params resource.InstanceParams
+//   - placementGroup string
+func (_e *MockAutoscalerInstances_Expecter) RunFlavorVersion(ctx interface{}, flavorVersionID interface{}, ownerID interface{}, orderedBy interface{}, params interface{}, placementGroup interface{}) *MockAutoscalerInstances_RunFlavorVersion_Call {
+	return &MockAutoscalerInstances_RunFlavorVersion_Call{Call: _e.mock.On("RunFlavorVersion", ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup)}
+}
+
+func (_c *MockAutoscalerInstances_RunFlavorVersion_Call) Run(run func(ctx context.Context, flavorVersionID string, ownerID string, orderedBy string, params resource.InstanceParams, placementGroup string)) *MockAutoscalerInstances_RunFlavorVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(resource.InstanceParams), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockAutoscalerInstances_RunFlavorVersion_Call) Return(_a0 resource.Instance, _a1 error) *MockAutoscalerInstances_RunFlavorVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAutoscalerInstances_RunFlavorVersion_Call) RunAndReturn(run func(context.Context, string, string, string, resource.InstanceParams, string) (resource.Instance, error)) *MockAutoscalerInstances_RunFlavorVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopInstance provides a mock function with given fields: ctx, id, actorID
+func (_m *MockAutoscalerInstances) StopInstance(ctx context.Context, id string, actorID string) error {
+	ret := _m.Called(ctx, id, actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, actorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAutoscalerInstances_StopInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopInstance'
+type MockAutoscalerInstances_StopInstance_Call struct {
+	*mock.Call
+}
+
+// StopInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - actorID string
+func (_e *MockAutoscalerInstances_Expecter) StopInstance(ctx interface{}, id interface{}, actorID interface{}) *MockAutoscalerInstances_StopInstance_Call {
+	return &MockAutoscalerInstances_StopInstance_Call{Call: _e.mock.On("StopInstance", ctx, id, actorID)}
+}
+
+func (_c *MockAutoscalerInstances_StopInstance_Call) Run(run func(ctx context.Context, id string, actorID string)) *MockAutoscalerInstances_StopInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAutoscalerInstances_StopInstance_Call) Return(_a0 error) *MockAutoscalerInstances_StopInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAutoscalerInstances_StopInstance_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAutoscalerInstances_StopInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAutoscalerInstances creates a new instance of MockAutoscalerInstances. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAutoscalerInstances(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAutoscalerInstances {
+	mock := &MockAutoscalerInstances{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
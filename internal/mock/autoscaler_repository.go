@@ -0,0 +1,359 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	autoscaler "github.com/spacechunks/explorer/controlplane/autoscaler"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockAutoscalerRepository is an autogenerated mock type for the Repository type
+type MockAutoscalerRepository struct {
+	mock.Mock
+}
+
+type MockAutoscalerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAutoscalerRepository) EXPECT() *MockAutoscalerRepository_Expecter {
+	return &MockAutoscalerRepository_Expecter{mock: &_m.Mock}
+}
+
+// AutoscaledInstances provides a mock function with given fields: ctx, flavorID
+func (_m *MockAutoscalerRepository) AutoscaledInstances(ctx context.Context, flavorID string) ([]autoscaler.Instance, error) {
+	ret := _m.Called(ctx, flavorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutoscaledInstances")
+	}
+
+	var r0 []autoscaler.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]autoscaler.Instance, error)); ok {
+		return rf(ctx, flavorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []autoscaler.Instance); ok {
+		r0 = rf(ctx, flavorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]autoscaler.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, flavorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAutoscalerRepository_AutoscaledInstances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoscaledInstances'
+type MockAutoscalerRepository_AutoscaledInstances_Call struct {
+	*mock.Call
+}
+
+// AutoscaledInstances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+func (_e *MockAutoscalerRepository_Expecter) AutoscaledInstances(ctx interface{}, flavorID interface{}) *MockAutoscalerRepository_AutoscaledInstances_Call {
+	return &MockAutoscalerRepository_AutoscaledInstances_Call{Call: _e.mock.On("AutoscaledInstances", ctx, flavorID)}
+}
+
+func (_c *MockAutoscalerRepository_AutoscaledInstances_Call) Run(run func(ctx context.Context, flavorID string)) *MockAutoscalerRepository_AutoscaledInstances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_AutoscaledInstances_Call) Return(_a0 []autoscaler.Instance, _a1 error) *MockAutoscalerRepository_AutoscaledInstances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_AutoscaledInstances_Call) RunAndReturn(run func(context.Context, string) ([]autoscaler.Instance, error)) *MockAutoscalerRepository_AutoscaledInstances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AutoscalingTargets provides a mock function with given fields: ctx
+func (_m *MockAutoscalerRepository) AutoscalingTargets(ctx context.Context) ([]autoscaler.Target, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutoscalingTargets")
+	}
+
+	var r0 []autoscaler.Target
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]autoscaler.Target, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []autoscaler.Target); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]autoscaler.Target)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAutoscalerRepository_AutoscalingTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoscalingTargets'
+type MockAutoscalerRepository_AutoscalingTargets_Call struct {
+	*mock.Call
+}
+
+// AutoscalingTargets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAutoscalerRepository_Expecter) AutoscalingTargets(ctx interface{}) *MockAutoscalerRepository_AutoscalingTargets_Call {
+	return &MockAutoscalerRepository_AutoscalingTargets_Call{Call: _e.mock.On("AutoscalingTargets", ctx)}
+}
+
+func (_c *MockAutoscalerRepository_AutoscalingTargets_Call) Run(run func(ctx context.Context)) *MockAutoscalerRepository_AutoscalingTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_AutoscalingTargets_Call) Return(_a0 []autoscaler.Target, _a1 error) *MockAutoscalerRepository_AutoscalingTargets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_AutoscalingTargets_Call) RunAndReturn(run func(context.Context) ([]autoscaler.Target, error)) *MockAutoscalerRepository_AutoscalingTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteJoinMissesBefore provides a mock function with given fields: ctx, t
+func (_m *MockAutoscalerRepository) DeleteJoinMissesBefore(ctx context.Context, t time.Time) error {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJoinMissesBefore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAutoscalerRepository_DeleteJoinMissesBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJoinMissesBefore'
+type MockAutoscalerRepository_DeleteJoinMissesBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteJoinMissesBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t time.Time
+func (_e *MockAutoscalerRepository_Expecter) DeleteJoinMissesBefore(ctx interface{}, t interface{}) *MockAutoscalerRepository_DeleteJoinMissesBefore_Call {
+	return &MockAutoscalerRepository_DeleteJoinMissesBefore_Call{Call: _e.mock.On("DeleteJoinMissesBefore", ctx, t)}
+}
+
+func (_c *MockAutoscalerRepository_DeleteJoinMissesBefore_Call) Run(run func(ctx context.Context, t time.Time)) *MockAutoscalerRepository_DeleteJoinMissesBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_DeleteJoinMissesBefore_Call) Return(_a0 error) *MockAutoscalerRepository_DeleteJoinMissesBefore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_DeleteJoinMissesBefore_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockAutoscalerRepository_DeleteJoinMissesBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlavorDemand provides a mock function with given fields: ctx, flavorID, since
+func (_m *MockAutoscalerRepository) FlavorDemand(ctx context.Context, flavorID string, since time.Time) (autoscaler.Demand, error) {
+	ret := _m.Called(ctx, flavorID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlavorDemand")
+	}
+
+	var r0 autoscaler.Demand
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (autoscaler.Demand, error)); ok {
+		return rf(ctx, flavorID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) autoscaler.Demand); ok {
+		r0 = rf(ctx, flavorID, since)
+	} else {
+		r0 = ret.Get(0).(autoscaler.Demand)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, flavorID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAutoscalerRepository_FlavorDemand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorDemand'
+type MockAutoscalerRepository_FlavorDemand_Call struct {
+	*mock.Call
+}
+
+// FlavorDemand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+//   - since time.Time
+func (_e *MockAutoscalerRepository_Expecter) FlavorDemand(ctx interface{}, flavorID interface{}, since interface{}) *MockAutoscalerRepository_FlavorDemand_Call {
+	return &MockAutoscalerRepository_FlavorDemand_Call{Call: _e.mock.On("FlavorDemand", ctx, flavorID, since)}
+}
+
+func (_c *MockAutoscalerRepository_FlavorDemand_Call) Run(run func(ctx context.Context, flavorID string, since time.Time)) *MockAutoscalerRepository_FlavorDemand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_FlavorDemand_Call) Return(_a0 autoscaler.Demand, _a1 error) *MockAutoscalerRepository_FlavorDemand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_FlavorDemand_Call) RunAndReturn(run func(context.Context, string, time.Time) (autoscaler.Demand, error)) *MockAutoscalerRepository_FlavorDemand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertAutoscaledInstance provides a mock function with given fields: ctx, flavorID, instanceID
+func (_m *MockAutoscalerRepository) InsertAutoscaledInstance(ctx context.Context, flavorID string, instanceID string) error {
+	ret := _m.Called(ctx, flavorID, instanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertAutoscaledInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, flavorID, instanceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAutoscalerRepository_InsertAutoscaledInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertAutoscaledInstance'
+type MockAutoscalerRepository_InsertAutoscaledInstance_Call struct {
+	*mock.Call
+}
+
+// InsertAutoscaledInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+//   - instanceID string
+func (_e *MockAutoscalerRepository_Expecter) InsertAutoscaledInstance(ctx interface{}, flavorID interface{}, instanceID interface{}) *MockAutoscalerRepository_InsertAutoscaledInstance_Call {
+	return &MockAutoscalerRepository_InsertAutoscaledInstance_Call{Call: _e.mock.On("InsertAutoscaledInstance", ctx, flavorID, instanceID)}
+}
+
+func (_c *MockAutoscalerRepository_InsertAutoscaledInstance_Call) Run(run func(ctx context.Context, flavorID string, instanceID string)) *MockAutoscalerRepository_InsertAutoscaledInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_InsertAutoscaledInstance_Call) Return(_a0 error) *MockAutoscalerRepository_InsertAutoscaledInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_InsertAutoscaledInstance_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAutoscalerRepository_InsertAutoscaledInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordScaling provides a mock function with given fields: ctx, flavorID, dir, at
+func (_m *MockAutoscalerRepository) RecordScaling(ctx context.Context, flavorID string, dir autoscaler.Direction, at time.Time) error {
+	ret := _m.Called(ctx, flavorID, dir, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordScaling")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, autoscaler.Direction, time.Time) error); ok {
+		r0 = rf(ctx, flavorID, dir, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAutoscalerRepository_RecordScaling_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordScaling'
+type MockAutoscalerRepository_RecordScaling_Call struct {
+	*mock.Call
+}
+
+// RecordScaling is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+//   - dir autoscaler.Direction
+//   - at time.Time
+func (_e *MockAutoscalerRepository_Expecter) RecordScaling(ctx interface{}, flavorID interface{}, dir interface{}, at interface{}) *MockAutoscalerRepository_RecordScaling_Call {
+	return &MockAutoscalerRepository_RecordScaling_Call{Call: _e.mock.On("RecordScaling", ctx, flavorID, dir, at)}
+}
+
+func (_c *MockAutoscalerRepository_RecordScaling_Call) Run(run func(ctx context.Context, flavorID string, dir autoscaler.Direction, at time.Time)) *MockAutoscalerRepository_RecordScaling_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(autoscaler.Direction), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_RecordScaling_Call) Return(_a0 error) *MockAutoscalerRepository_RecordScaling_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAutoscalerRepository_RecordScaling_Call) RunAndReturn(run func(context.Context, string, autoscaler.Direction, time.Time) error) *MockAutoscalerRepository_RecordScaling_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAutoscalerRepository creates a new instance of MockAutoscalerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAutoscalerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAutoscalerRepository {
+	mock := &MockAutoscalerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
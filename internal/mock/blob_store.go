@@ -0,0 +1,470 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	blob "github.com/spacechunks/explorer/controlplane/blob"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockBlobStore is an autogenerated mock type for the Store type
+type MockBlobStore struct {
+	mock.Mock
+}
+
+type MockBlobStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBlobStore) EXPECT() *MockBlobStore_Expecter {
+	return &MockBlobStore_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, key
+func (_m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBlobStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockBlobStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockBlobStore_Expecter) Delete(ctx interface{}, key interface{}) *MockBlobStore_Delete_Call {
+	return &MockBlobStore_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockBlobStore_Delete_Call) Run(run func(ctx context.Context, key string)) *MockBlobStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_Delete_Call) Return(_a0 error) *MockBlobStore_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlobStore_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockBlobStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ObjectExists provides a mock function with given fields: ctx, key
+func (_m *MockBlobStore) ObjectExists(ctx context.Context, key string) (bool, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ObjectExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBlobStore_ObjectExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObjectExists'
+type MockBlobStore_ObjectExists_Call struct {
+	*mock.Call
+}
+
+// ObjectExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockBlobStore_Expecter) ObjectExists(ctx interface{}, key interface{}) *MockBlobStore_ObjectExists_Call {
+	return &MockBlobStore_ObjectExists_Call{Call: _e.mock.On("ObjectExists", ctx, key)}
+}
+
+func (_c *MockBlobStore_ObjectExists_Call) Run(run func(ctx context.Context, key string)) *MockBlobStore_ObjectExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_ObjectExists_Call) Return(_a0 bool, _a1 error) *MockBlobStore_ObjectExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBlobStore_ObjectExists_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockBlobStore_ObjectExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresignDownloadURL provides a mock function with given fields: ctx, key, expiry
+func (_m *MockBlobStore) PresignDownloadURL(ctx context.Context, key string, expiry time.Duration) (string, time.Time, error) {
+	ret := _m.Called(ctx, key, expiry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresignDownloadURL")
+	}
+
+	var r0 string
+	var r1 time.Time
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (string, time.Time, error)); ok {
+		return rf(ctx, key, expiry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) string); ok {
+		r0 = rf(ctx, key, expiry)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) time.Time); ok {
+		r1 = rf(ctx, key, expiry)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, time.Duration) error); ok {
+		r2 = rf(ctx, key, expiry)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockBlobStore_PresignDownloadURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresignDownloadURL'
+type MockBlobStore_PresignDownloadURL_Call struct {
+	*mock.Call
+}
+
+// PresignDownloadURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - expiry time.Duration
+func (_e *MockBlobStore_Expecter) PresignDownloadURL(ctx interface{}, key interface{}, expiry interface{}) *MockBlobStore_PresignDownloadURL_Call {
+	return &MockBlobStore_PresignDownloadURL_Call{Call: _e.mock.On("PresignDownloadURL", ctx, key, expiry)}
+}
+
+func (_c *MockBlobStore_PresignDownloadURL_Call) Run(run func(ctx context.Context, key string, expiry time.Duration)) *MockBlobStore_PresignDownloadURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_PresignDownloadURL_Call) Return(_a0 string, _a1 time.Time, _a2 error) *MockBlobStore_PresignDownloadURL_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockBlobStore_PresignDownloadURL_Call) RunAndReturn(run func(context.Context, string, time.Duration) (string, time.Time, error)) *MockBlobStore_PresignDownloadURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresignURL provides a mock function with given fields: ctx, key, contentHash, expiry, contentLength
+func (_m *MockBlobStore) PresignURL(ctx context.Context, key string, contentHash string, expiry time.Duration, contentLength uint64) (string, time.Time, error) {
+	ret := _m.Called(ctx, key, contentHash, expiry, contentLength)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresignURL")
+	}
+
+	var r0 string
+	var r1 time.Time
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration, uint64) (string, time.Time, error)); ok {
+		return rf(ctx, key, contentHash, expiry, contentLength)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration, uint64) string); ok {
+		r0 = rf(ctx, key, contentHash, expiry, contentLength)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration, uint64) time.Time); ok {
+		r1 = rf(ctx, key, contentHash, expiry, contentLength)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, time.Duration, uint64) error); ok {
+		r2 = rf(ctx, key, contentHash, expiry, contentLength)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockBlobStore_PresignURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresignURL'
+type MockBlobStore_PresignURL_Call struct {
+	*mock.Call
+}
+
+// PresignURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - contentHash string
+//   - expiry time.Duration
+//   - contentLength uint64
+func (_e *MockBlobStore_Expecter) PresignURL(ctx interface{}, key interface{}, contentHash interface{}, expiry interface{}, contentLength interface{}) *MockBlobStore_PresignURL_Call {
+	return &MockBlobStore_PresignURL_Call{Call: _e.mock.On("PresignURL", ctx, key, contentHash, expiry, contentLength)}
+}
+
+func (_c *MockBlobStore_PresignURL_Call) Run(run func(ctx context.Context, key string, contentHash string, expiry time.Duration, contentLength uint64)) *MockBlobStore_PresignURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_PresignURL_Call) Return(_a0 string, _a1 time.Time, _a2 error) *MockBlobStore_PresignURL_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockBlobStore_PresignURL_Call) RunAndReturn(run func(context.Context, string, string, time.Duration, uint64) (string, time.Time, error)) *MockBlobStore_PresignURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBlob provides a mock function with given fields: ctx, keyPrefix, objects
+func (_m *MockBlobStore) PutBlob(ctx context.Context, keyPrefix string, objects []blob.Object) error {
+	ret := _m.Called(ctx, keyPrefix, objects)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBlob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []blob.Object) error); ok {
+		r0 = rf(ctx, keyPrefix, objects)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBlobStore_PutBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBlob'
+type MockBlobStore_PutBlob_Call struct {
+	*mock.Call
+}
+
+// PutBlob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyPrefix string
+//   - objects []blob.Object
+func (_e *MockBlobStore_Expecter) PutBlob(ctx interface{}, keyPrefix interface{}, objects interface{}) *MockBlobStore_PutBlob_Call {
+	return &MockBlobStore_PutBlob_Call{Call: _e.mock.On("PutBlob", ctx, keyPrefix, objects)}
+}
+
+func (_c *MockBlobStore_PutBlob_Call) Run(run func(ctx context.Context, keyPrefix string, objects []blob.Object)) *MockBlobStore_PutBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]blob.Object))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_PutBlob_Call) Return(_a0 error) *MockBlobStore_PutBlob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlobStore_PutBlob_Call) RunAndReturn(run func(context.Context, string, []blob.Object) error) *MockBlobStore_PutBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SimplePut provides a mock function with given fields: ctx, key, r, metadata
+func (_m *MockBlobStore) SimplePut(ctx context.Context, key string, r io.Reader, metadata map[string]string) error {
+	ret := _m.Called(ctx, key, r, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimplePut")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, map[string]string) error); ok {
+		r0 = rf(ctx, key, r, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBlobStore_SimplePut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimplePut'
+type MockBlobStore_SimplePut_Call struct {
+	*mock.Call
+}
+
+// SimplePut is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - r io.Reader
+//   - metadata map[string]string
+func (_e *MockBlobStore_Expecter) SimplePut(ctx interface{}, key interface{}, r interface{}, metadata interface{}) *MockBlobStore_SimplePut_Call {
+	return &MockBlobStore_SimplePut_Call{Call: _e.mock.On("SimplePut", ctx, key, r, metadata)}
+}
+
+func (_c *MockBlobStore_SimplePut_Call) Run(run func(ctx context.Context, key string, r io.Reader, metadata map[string]string)) *MockBlobStore_SimplePut_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader), args[3].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_SimplePut_Call) Return(_a0 error) *MockBlobStore_SimplePut_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlobStore_SimplePut_Call) RunAndReturn(run func(context.Context, string, io.Reader, map[string]string) error) *MockBlobStore_SimplePut_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadHeaders provides a mock function with no fields
+func (_m *MockBlobStore) UploadHeaders() map[string]string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadHeaders")
+	}
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// MockBlobStore_UploadHeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadHeaders'
+type MockBlobStore_UploadHeaders_Call struct {
+	*mock.Call
+}
+
+// UploadHeaders is a helper method to define mock.On call
+func (_e *MockBlobStore_Expecter) UploadHeaders() *MockBlobStore_UploadHeaders_Call {
+	return &MockBlobStore_UploadHeaders_Call{Call: _e.mock.On("UploadHeaders")}
+}
+
+func (_c *MockBlobStore_UploadHeaders_Call) Run(run func()) *MockBlobStore_UploadHeaders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_UploadHeaders_Call) Return(_a0 map[string]string) *MockBlobStore_UploadHeaders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlobStore_UploadHeaders_Call) RunAndReturn(run func() map[string]string) *MockBlobStore_UploadHeaders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteTo provides a mock function with given fields: ctx, key, w
+func (_m *MockBlobStore) WriteTo(ctx context.Context, key string, w io.Writer) error {
+	ret := _m.Called(ctx, key, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Writer) error); ok {
+		r0 = rf(ctx, key, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBlobStore_WriteTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteTo'
+type MockBlobStore_WriteTo_Call struct {
+	*mock.Call
+}
+
+// WriteTo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - w io.Writer
+func (_e *MockBlobStore_Expecter) WriteTo(ctx interface{}, key interface{}, w interface{}) *MockBlobStore_WriteTo_Call {
+	return &MockBlobStore_WriteTo_Call{Call: _e.mock.On("WriteTo", ctx, key, w)}
+}
+
+func (_c *MockBlobStore_WriteTo_Call) Run(run func(ctx context.Context, key string, w io.Writer)) *MockBlobStore_WriteTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_WriteTo_Call) Return(_a0 error) *MockBlobStore_WriteTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBlobStore_WriteTo_Call) RunAndReturn(run func(context.Context, string, io.Writer) error) *MockBlobStore_WriteTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBlobStore creates a new instance of MockBlobStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBlobStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBlobStore {
+	mock := &MockBlobStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -140,27 +140,29 @@ func (_c *MockChunkRepository_AllDeletedFlavors_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// CreateChunk provides a mock function with given fields: ctx, _a1
-func (_m *MockChunkRepository) CreateChunk(ctx context.Context, _a1 resource.Chunk) (resource.Chunk, error) {
-	ret := _m.Called(ctx, _a1)
+// AllRetentionPolicies provides a mock function with given fields: ctx
+func (_m *MockChunkRepository) AllRetentionPolicies(ctx context.Context) ([]resource.RetentionPolicy, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateChunk")
+		panic("no return value specified for AllRetentionPolicies")
 	}
 
-	var r0 resource.Chunk
+	var r0 []resource.RetentionPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Chunk) (resource.Chunk, error)); ok {
-		return rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]resource.RetentionPolicy, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Chunk) resource.Chunk); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context) []resource.RetentionPolicy); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(resource.Chunk)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.RetentionPolicy)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, resource.Chunk) error); ok {
-		r1 = rf(ctx, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,56 +170,55 @@ func (_m *MockChunkRepository) CreateChunk(ctx context.Context, _a1 resource.Chu
 	return r0, r1
 }
 
-// MockChunkRepository_CreateChunk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChunk'
-type MockChunkRepository_CreateChunk_Call struct {
+// MockChunkRepository_AllRetentionPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllRetentionPolicies'
+type MockChunkRepository_AllRetentionPolicies_Call struct {
 	*mock.Call
 }
 
-// CreateChunk is a helper method to define mock.On call
+// AllRetentionPolicies is a helper method to define mock.On call
 //   - ctx context.Context
-//   - _a1 resource.Chunk
-func (_e *MockChunkRepository_Expecter) CreateChunk(ctx interface{}, _a1 interface{}) *MockChunkRepository_CreateChunk_Call {
-	return &MockChunkRepository_CreateChunk_Call{Call: _e.mock.On("CreateChunk", ctx, _a1)}
+func (_e *MockChunkRepository_Expecter) AllRetentionPolicies(ctx interface{}) *MockChunkRepository_AllRetentionPolicies_Call {
+	return &MockChunkRepository_AllRetentionPolicies_Call{Call: _e.mock.On("AllRetentionPolicies", ctx)}
 }
 
-func (_c *MockChunkRepository_CreateChunk_Call) Run(run func(ctx context.Context, _a1 resource.Chunk)) *MockChunkRepository_CreateChunk_Call {
+func (_c *MockChunkRepository_AllRetentionPolicies_Call) Run(run func(ctx context.Context)) *MockChunkRepository_AllRetentionPolicies_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(resource.Chunk))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_CreateChunk_Call) Return(_a0 resource.Chunk, _a1 error) *MockChunkRepository_CreateChunk_Call {
+func (_c *MockChunkRepository_AllRetentionPolicies_Call) Return(_a0 []resource.RetentionPolicy, _a1 error) *MockChunkRepository_AllRetentionPolicies_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_CreateChunk_Call) RunAndReturn(run func(context.Context, resource.Chunk) (resource.Chunk, error)) *MockChunkRepository_CreateChunk_Call {
+func (_c *MockChunkRepository_AllRetentionPolicies_Call) RunAndReturn(run func(context.Context) ([]resource.RetentionPolicy, error)) *MockChunkRepository_AllRetentionPolicies_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateFlavor provides a mock function with given fields: ctx, chunkID, flavor
-func (_m *MockChunkRepository) CreateFlavor(ctx context.Context, chunkID string, flavor resource.Flavor) (resource.Flavor, error) {
-	ret := _m.Called(ctx, chunkID, flavor)
+// AssetsExist provides a mock function with given fields: ctx, hashes
+func (_m *MockChunkRepository) AssetsExist(ctx context.Context, hashes []string) (bool, error) {
+	ret := _m.Called(ctx, hashes)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateFlavor")
+		panic("no return value specified for AssetsExist")
 	}
 
-	var r0 resource.Flavor
+	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, resource.Flavor) (resource.Flavor, error)); ok {
-		return rf(ctx, chunkID, flavor)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (bool, error)); ok {
+		return rf(ctx, hashes)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, resource.Flavor) resource.Flavor); ok {
-		r0 = rf(ctx, chunkID, flavor)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) bool); ok {
+		r0 = rf(ctx, hashes)
 	} else {
-		r0 = ret.Get(0).(resource.Flavor)
+		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, resource.Flavor) error); ok {
-		r1 = rf(ctx, chunkID, flavor)
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, hashes)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -225,57 +226,56 @@ func (_m *MockChunkRepository) CreateFlavor(ctx context.Context, chunkID string,
 	return r0, r1
 }
 
-// MockChunkRepository_CreateFlavor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFlavor'
-type MockChunkRepository_CreateFlavor_Call struct {
+// MockChunkRepository_AssetsExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssetsExist'
+type MockChunkRepository_AssetsExist_Call struct {
 	*mock.Call
 }
 
-// CreateFlavor is a helper method to define mock.On call
+// AssetsExist is a helper method to define mock.On call
 //   - ctx context.Context
-//   - chunkID string
-//   - flavor resource.Flavor
-func (_e *MockChunkRepository_Expecter) CreateFlavor(ctx interface{}, chunkID interface{}, flavor interface{}) *MockChunkRepository_CreateFlavor_Call {
-	return &MockChunkRepository_CreateFlavor_Call{Call: _e.mock.On("CreateFlavor", ctx, chunkID, flavor)}
+//   - hashes []string
+func (_e *MockChunkRepository_Expecter) AssetsExist(ctx interface{}, hashes interface{}) *MockChunkRepository_AssetsExist_Call {
+	return &MockChunkRepository_AssetsExist_Call{Call: _e.mock.On("AssetsExist", ctx, hashes)}
 }
 
-func (_c *MockChunkRepository_CreateFlavor_Call) Run(run func(ctx context.Context, chunkID string, flavor resource.Flavor)) *MockChunkRepository_CreateFlavor_Call {
+func (_c *MockChunkRepository_AssetsExist_Call) Run(run func(ctx context.Context, hashes []string)) *MockChunkRepository_AssetsExist_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(resource.Flavor))
+		run(args[0].(context.Context), args[1].([]string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_CreateFlavor_Call) Return(_a0 resource.Flavor, _a1 error) *MockChunkRepository_CreateFlavor_Call {
+func (_c *MockChunkRepository_AssetsExist_Call) Return(_a0 bool, _a1 error) *MockChunkRepository_AssetsExist_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_CreateFlavor_Call) RunAndReturn(run func(context.Context, string, resource.Flavor) (resource.Flavor, error)) *MockChunkRepository_CreateFlavor_Call {
+func (_c *MockChunkRepository_AssetsExist_Call) RunAndReturn(run func(context.Context, []string) (bool, error)) *MockChunkRepository_AssetsExist_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateFlavorVersion provides a mock function with given fields: ctx, flavorID, version, prevVersionID
-func (_m *MockChunkRepository) CreateFlavorVersion(ctx context.Context, flavorID string, version resource.FlavorVersion, prevVersionID string) (resource.FlavorVersion, error) {
-	ret := _m.Called(ctx, flavorID, version, prevVersionID)
+// AutoscalingPolicyByFlavorID provides a mock function with given fields: ctx, flavorID
+func (_m *MockChunkRepository) AutoscalingPolicyByFlavorID(ctx context.Context, flavorID string) (resource.AutoscalingPolicy, error) {
+	ret := _m.Called(ctx, flavorID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateFlavorVersion")
+		panic("no return value specified for AutoscalingPolicyByFlavorID")
 	}
 
-	var r0 resource.FlavorVersion
+	var r0 resource.AutoscalingPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, resource.FlavorVersion, string) (resource.FlavorVersion, error)); ok {
-		return rf(ctx, flavorID, version, prevVersionID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.AutoscalingPolicy, error)); ok {
+		return rf(ctx, flavorID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, resource.FlavorVersion, string) resource.FlavorVersion); ok {
-		r0 = rf(ctx, flavorID, version, prevVersionID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.AutoscalingPolicy); ok {
+		r0 = rf(ctx, flavorID)
 	} else {
-		r0 = ret.Get(0).(resource.FlavorVersion)
+		r0 = ret.Get(0).(resource.AutoscalingPolicy)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, resource.FlavorVersion, string) error); ok {
-		r1 = rf(ctx, flavorID, version, prevVersionID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, flavorID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -283,105 +283,113 @@ func (_m *MockChunkRepository) CreateFlavorVersion(ctx context.Context, flavorID
 	return r0, r1
 }
 
-// MockChunkRepository_CreateFlavorVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFlavorVersion'
-type MockChunkRepository_CreateFlavorVersion_Call struct {
+// MockChunkRepository_AutoscalingPolicyByFlavorID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoscalingPolicyByFlavorID'
+type MockChunkRepository_AutoscalingPolicyByFlavorID_Call struct {
 	*mock.Call
 }
 
-// CreateFlavorVersion is a helper method to define mock.On call
+// AutoscalingPolicyByFlavorID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - flavorID string
-//   - version resource.FlavorVersion
-//   - prevVersionID string
-func (_e *MockChunkRepository_Expecter) CreateFlavorVersion(ctx interface{}, flavorID interface{}, version interface{}, prevVersionID interface{}) *MockChunkRepository_CreateFlavorVersion_Call {
-	return &MockChunkRepository_CreateFlavorVersion_Call{Call: _e.mock.On("CreateFlavorVersion", ctx, flavorID, version, prevVersionID)}
+func (_e *MockChunkRepository_Expecter) AutoscalingPolicyByFlavorID(ctx interface{}, flavorID interface{}) *MockChunkRepository_AutoscalingPolicyByFlavorID_Call {
+	return &MockChunkRepository_AutoscalingPolicyByFlavorID_Call{Call: _e.mock.On("AutoscalingPolicyByFlavorID", ctx, flavorID)}
 }
 
-func (_c *MockChunkRepository_CreateFlavorVersion_Call) Run(run func(ctx context.Context, flavorID string, version resource.FlavorVersion, prevVersionID string)) *MockChunkRepository_CreateFlavorVersion_Call {
+func (_c *MockChunkRepository_AutoscalingPolicyByFlavorID_Call) Run(run func(ctx context.Context, flavorID string)) *MockChunkRepository_AutoscalingPolicyByFlavorID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(resource.FlavorVersion), args[3].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_CreateFlavorVersion_Call) Return(_a0 resource.FlavorVersion, _a1 error) *MockChunkRepository_CreateFlavorVersion_Call {
+func (_c *MockChunkRepository_AutoscalingPolicyByFlavorID_Call) Return(_a0 resource.AutoscalingPolicy, _a1 error) *MockChunkRepository_AutoscalingPolicyByFlavorID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_CreateFlavorVersion_Call) RunAndReturn(run func(context.Context, string, resource.FlavorVersion, string) (resource.FlavorVersion, error)) *MockChunkRepository_CreateFlavorVersion_Call {
+func (_c *MockChunkRepository_AutoscalingPolicyByFlavorID_Call) RunAndReturn(run func(context.Context, string) (resource.AutoscalingPolicy, error)) *MockChunkRepository_AutoscalingPolicyByFlavorID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteFlavor provides a mock function with given fields: ctx, id
-func (_m *MockChunkRepository) DeleteFlavor(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// CanaryByFlavorVersionID provides a mock function with given fields: ctx, flavorVersionID
+func (_m *MockChunkRepository) CanaryByFlavorVersionID(ctx context.Context, flavorVersionID string) (resource.Canary, error) {
+	ret := _m.Called(ctx, flavorVersionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteFlavor")
+		panic("no return value specified for CanaryByFlavorVersionID")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	var r0 resource.Canary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.Canary, error)); ok {
+		return rf(ctx, flavorVersionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.Canary); ok {
+		r0 = rf(ctx, flavorVersionID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(resource.Canary)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, flavorVersionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockChunkRepository_DeleteFlavor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFlavor'
-type MockChunkRepository_DeleteFlavor_Call struct {
+// MockChunkRepository_CanaryByFlavorVersionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanaryByFlavorVersionID'
+type MockChunkRepository_CanaryByFlavorVersionID_Call struct {
 	*mock.Call
 }
 
-// DeleteFlavor is a helper method to define mock.On call
+// CanaryByFlavorVersionID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockChunkRepository_Expecter) DeleteFlavor(ctx interface{}, id interface{}) *MockChunkRepository_DeleteFlavor_Call {
-	return &MockChunkRepository_DeleteFlavor_Call{Call: _e.mock.On("DeleteFlavor", ctx, id)}
+//   - flavorVersionID string
+func (_e *MockChunkRepository_Expecter) CanaryByFlavorVersionID(ctx interface{}, flavorVersionID interface{}) *MockChunkRepository_CanaryByFlavorVersionID_Call {
+	return &MockChunkRepository_CanaryByFlavorVersionID_Call{Call: _e.mock.On("CanaryByFlavorVersionID", ctx, flavorVersionID)}
 }
 
-func (_c *MockChunkRepository_DeleteFlavor_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_DeleteFlavor_Call {
+func (_c *MockChunkRepository_CanaryByFlavorVersionID_Call) Run(run func(ctx context.Context, flavorVersionID string)) *MockChunkRepository_CanaryByFlavorVersionID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_DeleteFlavor_Call) Return(_a0 error) *MockChunkRepository_DeleteFlavor_Call {
-	_c.Call.Return(_a0)
+func (_c *MockChunkRepository_CanaryByFlavorVersionID_Call) Return(_a0 resource.Canary, _a1 error) *MockChunkRepository_CanaryByFlavorVersionID_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_DeleteFlavor_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_DeleteFlavor_Call {
+func (_c *MockChunkRepository_CanaryByFlavorVersionID_Call) RunAndReturn(run func(context.Context, string) (resource.Canary, error)) *MockChunkRepository_CanaryByFlavorVersionID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FlavorByID provides a mock function with given fields: ctx, id
-func (_m *MockChunkRepository) FlavorByID(ctx context.Context, id string) (resource.Flavor, error) {
-	ret := _m.Called(ctx, id)
+// ChunkIDByName provides a mock function with given fields: ctx, name
+func (_m *MockChunkRepository) ChunkIDByName(ctx context.Context, name string) (string, error) {
+	ret := _m.Called(ctx, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FlavorByID")
+		panic("no return value specified for ChunkIDByName")
 	}
 
-	var r0 resource.Flavor
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.Flavor, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, name)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) resource.Flavor); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, name)
 	} else {
-		r0 = ret.Get(0).(resource.Flavor)
+		r0 = ret.Get(0).(string)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+		r1 = rf(ctx, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -389,52 +397,52 @@ func (_m *MockChunkRepository) FlavorByID(ctx context.Context, id string) (resou
 	return r0, r1
 }
 
-// MockChunkRepository_FlavorByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorByID'
-type MockChunkRepository_FlavorByID_Call struct {
+// MockChunkRepository_ChunkIDByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChunkIDByName'
+type MockChunkRepository_ChunkIDByName_Call struct {
 	*mock.Call
 }
 
-// FlavorByID is a helper method to define mock.On call
+// ChunkIDByName is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockChunkRepository_Expecter) FlavorByID(ctx interface{}, id interface{}) *MockChunkRepository_FlavorByID_Call {
-	return &MockChunkRepository_FlavorByID_Call{Call: _e.mock.On("FlavorByID", ctx, id)}
+//   - name string
+func (_e *MockChunkRepository_Expecter) ChunkIDByName(ctx interface{}, name interface{}) *MockChunkRepository_ChunkIDByName_Call {
+	return &MockChunkRepository_ChunkIDByName_Call{Call: _e.mock.On("ChunkIDByName", ctx, name)}
 }
 
-func (_c *MockChunkRepository_FlavorByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_FlavorByID_Call {
+func (_c *MockChunkRepository_ChunkIDByName_Call) Run(run func(ctx context.Context, name string)) *MockChunkRepository_ChunkIDByName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorByID_Call) Return(_a0 resource.Flavor, _a1 error) *MockChunkRepository_FlavorByID_Call {
+func (_c *MockChunkRepository_ChunkIDByName_Call) Return(_a0 string, _a1 error) *MockChunkRepository_ChunkIDByName_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorByID_Call) RunAndReturn(run func(context.Context, string) (resource.Flavor, error)) *MockChunkRepository_FlavorByID_Call {
+func (_c *MockChunkRepository_ChunkIDByName_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockChunkRepository_ChunkIDByName_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FlavorIDByFlavorVersionID provides a mock function with given fields: ctx, id
-func (_m *MockChunkRepository) FlavorIDByFlavorVersionID(ctx context.Context, id string) (string, error) {
+// ChunkImportByID provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) ChunkImportByID(ctx context.Context, id string) (resource.ChunkImport, error) {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FlavorIDByFlavorVersionID")
+		panic("no return value specified for ChunkImportByID")
 	}
 
-	var r0 string
+	var r0 resource.ChunkImport
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.ChunkImport, error)); ok {
 		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.ChunkImport); ok {
 		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(resource.ChunkImport)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
@@ -446,56 +454,58 @@ func (_m *MockChunkRepository) FlavorIDByFlavorVersionID(ctx context.Context, id
 	return r0, r1
 }
 
-// MockChunkRepository_FlavorIDByFlavorVersionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorIDByFlavorVersionID'
-type MockChunkRepository_FlavorIDByFlavorVersionID_Call struct {
+// MockChunkRepository_ChunkImportByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChunkImportByID'
+type MockChunkRepository_ChunkImportByID_Call struct {
 	*mock.Call
 }
 
-// FlavorIDByFlavorVersionID is a helper method to define mock.On call
+// ChunkImportByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockChunkRepository_Expecter) FlavorIDByFlavorVersionID(ctx interface{}, id interface{}) *MockChunkRepository_FlavorIDByFlavorVersionID_Call {
-	return &MockChunkRepository_FlavorIDByFlavorVersionID_Call{Call: _e.mock.On("FlavorIDByFlavorVersionID", ctx, id)}
+func (_e *MockChunkRepository_Expecter) ChunkImportByID(ctx interface{}, id interface{}) *MockChunkRepository_ChunkImportByID_Call {
+	return &MockChunkRepository_ChunkImportByID_Call{Call: _e.mock.On("ChunkImportByID", ctx, id)}
 }
 
-func (_c *MockChunkRepository_FlavorIDByFlavorVersionID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_FlavorIDByFlavorVersionID_Call {
+func (_c *MockChunkRepository_ChunkImportByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_ChunkImportByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorIDByFlavorVersionID_Call) Return(_a0 string, _a1 error) *MockChunkRepository_FlavorIDByFlavorVersionID_Call {
+func (_c *MockChunkRepository_ChunkImportByID_Call) Return(_a0 resource.ChunkImport, _a1 error) *MockChunkRepository_ChunkImportByID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorIDByFlavorVersionID_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockChunkRepository_FlavorIDByFlavorVersionID_Call {
+func (_c *MockChunkRepository_ChunkImportByID_Call) RunAndReturn(run func(context.Context, string) (resource.ChunkImport, error)) *MockChunkRepository_ChunkImportByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FlavorNameExists provides a mock function with given fields: ctx, chunkID, name
-func (_m *MockChunkRepository) FlavorNameExists(ctx context.Context, chunkID string, name string) (bool, error) {
-	ret := _m.Called(ctx, chunkID, name)
+// ChunkMediaByChunkID provides a mock function with given fields: ctx, chunkID
+func (_m *MockChunkRepository) ChunkMediaByChunkID(ctx context.Context, chunkID string) ([]resource.ChunkMedia, error) {
+	ret := _m.Called(ctx, chunkID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FlavorNameExists")
+		panic("no return value specified for ChunkMediaByChunkID")
 	}
 
-	var r0 bool
+	var r0 []resource.ChunkMedia
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
-		return rf(ctx, chunkID, name)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.ChunkMedia, error)); ok {
+		return rf(ctx, chunkID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
-		r0 = rf(ctx, chunkID, name)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.ChunkMedia); ok {
+		r0 = rf(ctx, chunkID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.ChunkMedia)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, chunkID, name)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, chunkID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -503,53 +513,52 @@ func (_m *MockChunkRepository) FlavorNameExists(ctx context.Context, chunkID str
 	return r0, r1
 }
 
-// MockChunkRepository_FlavorNameExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorNameExists'
-type MockChunkRepository_FlavorNameExists_Call struct {
+// MockChunkRepository_ChunkMediaByChunkID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChunkMediaByChunkID'
+type MockChunkRepository_ChunkMediaByChunkID_Call struct {
 	*mock.Call
 }
 
-// FlavorNameExists is a helper method to define mock.On call
+// ChunkMediaByChunkID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - chunkID string
-//   - name string
-func (_e *MockChunkRepository_Expecter) FlavorNameExists(ctx interface{}, chunkID interface{}, name interface{}) *MockChunkRepository_FlavorNameExists_Call {
-	return &MockChunkRepository_FlavorNameExists_Call{Call: _e.mock.On("FlavorNameExists", ctx, chunkID, name)}
+func (_e *MockChunkRepository_Expecter) ChunkMediaByChunkID(ctx interface{}, chunkID interface{}) *MockChunkRepository_ChunkMediaByChunkID_Call {
+	return &MockChunkRepository_ChunkMediaByChunkID_Call{Call: _e.mock.On("ChunkMediaByChunkID", ctx, chunkID)}
 }
 
-func (_c *MockChunkRepository_FlavorNameExists_Call) Run(run func(ctx context.Context, chunkID string, name string)) *MockChunkRepository_FlavorNameExists_Call {
+func (_c *MockChunkRepository_ChunkMediaByChunkID_Call) Run(run func(ctx context.Context, chunkID string)) *MockChunkRepository_ChunkMediaByChunkID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorNameExists_Call) Return(_a0 bool, _a1 error) *MockChunkRepository_FlavorNameExists_Call {
+func (_c *MockChunkRepository_ChunkMediaByChunkID_Call) Return(_a0 []resource.ChunkMedia, _a1 error) *MockChunkRepository_ChunkMediaByChunkID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorNameExists_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockChunkRepository_FlavorNameExists_Call {
+func (_c *MockChunkRepository_ChunkMediaByChunkID_Call) RunAndReturn(run func(context.Context, string) ([]resource.ChunkMedia, error)) *MockChunkRepository_ChunkMediaByChunkID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FlavorVersionByID provides a mock function with given fields: ctx, id
-func (_m *MockChunkRepository) FlavorVersionByID(ctx context.Context, id string) (resource.FlavorVersion, error) {
+// ChunkMediaByID provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) ChunkMediaByID(ctx context.Context, id string) (resource.ChunkMedia, error) {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FlavorVersionByID")
+		panic("no return value specified for ChunkMediaByID")
 	}
 
-	var r0 resource.FlavorVersion
+	var r0 resource.ChunkMedia
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.FlavorVersion, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.ChunkMedia, error)); ok {
 		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) resource.FlavorVersion); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.ChunkMedia); ok {
 		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(resource.FlavorVersion)
+		r0 = ret.Get(0).(resource.ChunkMedia)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
@@ -561,56 +570,56 @@ func (_m *MockChunkRepository) FlavorVersionByID(ctx context.Context, id string)
 	return r0, r1
 }
 
-// MockChunkRepository_FlavorVersionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorVersionByID'
-type MockChunkRepository_FlavorVersionByID_Call struct {
+// MockChunkRepository_ChunkMediaByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChunkMediaByID'
+type MockChunkRepository_ChunkMediaByID_Call struct {
 	*mock.Call
 }
 
-// FlavorVersionByID is a helper method to define mock.On call
+// ChunkMediaByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockChunkRepository_Expecter) FlavorVersionByID(ctx interface{}, id interface{}) *MockChunkRepository_FlavorVersionByID_Call {
-	return &MockChunkRepository_FlavorVersionByID_Call{Call: _e.mock.On("FlavorVersionByID", ctx, id)}
+func (_e *MockChunkRepository_Expecter) ChunkMediaByID(ctx interface{}, id interface{}) *MockChunkRepository_ChunkMediaByID_Call {
+	return &MockChunkRepository_ChunkMediaByID_Call{Call: _e.mock.On("ChunkMediaByID", ctx, id)}
 }
 
-func (_c *MockChunkRepository_FlavorVersionByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_FlavorVersionByID_Call {
+func (_c *MockChunkRepository_ChunkMediaByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_ChunkMediaByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorVersionByID_Call) Return(_a0 resource.FlavorVersion, _a1 error) *MockChunkRepository_FlavorVersionByID_Call {
+func (_c *MockChunkRepository_ChunkMediaByID_Call) Return(_a0 resource.ChunkMedia, _a1 error) *MockChunkRepository_ChunkMediaByID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorVersionByID_Call) RunAndReturn(run func(context.Context, string) (resource.FlavorVersion, error)) *MockChunkRepository_FlavorVersionByID_Call {
+func (_c *MockChunkRepository_ChunkMediaByID_Call) RunAndReturn(run func(context.Context, string) (resource.ChunkMedia, error)) *MockChunkRepository_ChunkMediaByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FlavorVersionExists provides a mock function with given fields: ctx, flavorID, version
-func (_m *MockChunkRepository) FlavorVersionExists(ctx context.Context, flavorID string, version string) (bool, error) {
-	ret := _m.Called(ctx, flavorID, version)
+// ChunkReadmeByChunkID provides a mock function with given fields: ctx, chunkID
+func (_m *MockChunkRepository) ChunkReadmeByChunkID(ctx context.Context, chunkID string) (resource.ChunkReadme, error) {
+	ret := _m.Called(ctx, chunkID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FlavorVersionExists")
+		panic("no return value specified for ChunkReadmeByChunkID")
 	}
 
-	var r0 bool
+	var r0 resource.ChunkReadme
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
-		return rf(ctx, flavorID, version)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.ChunkReadme, error)); ok {
+		return rf(ctx, chunkID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
-		r0 = rf(ctx, flavorID, version)
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.ChunkReadme); ok {
+		r0 = rf(ctx, chunkID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(resource.ChunkReadme)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, flavorID, version)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, chunkID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -618,57 +627,56 @@ func (_m *MockChunkRepository) FlavorVersionExists(ctx context.Context, flavorID
 	return r0, r1
 }
 
-// MockChunkRepository_FlavorVersionExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorVersionExists'
-type MockChunkRepository_FlavorVersionExists_Call struct {
+// MockChunkRepository_ChunkReadmeByChunkID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChunkReadmeByChunkID'
+type MockChunkRepository_ChunkReadmeByChunkID_Call struct {
 	*mock.Call
 }
 
-// FlavorVersionExists is a helper method to define mock.On call
+// ChunkReadmeByChunkID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - flavorID string
-//   - version string
-func (_e *MockChunkRepository_Expecter) FlavorVersionExists(ctx interface{}, flavorID interface{}, version interface{}) *MockChunkRepository_FlavorVersionExists_Call {
-	return &MockChunkRepository_FlavorVersionExists_Call{Call: _e.mock.On("FlavorVersionExists", ctx, flavorID, version)}
+//   - chunkID string
+func (_e *MockChunkRepository_Expecter) ChunkReadmeByChunkID(ctx interface{}, chunkID interface{}) *MockChunkRepository_ChunkReadmeByChunkID_Call {
+	return &MockChunkRepository_ChunkReadmeByChunkID_Call{Call: _e.mock.On("ChunkReadmeByChunkID", ctx, chunkID)}
 }
 
-func (_c *MockChunkRepository_FlavorVersionExists_Call) Run(run func(ctx context.Context, flavorID string, version string)) *MockChunkRepository_FlavorVersionExists_Call {
+func (_c *MockChunkRepository_ChunkReadmeByChunkID_Call) Run(run func(ctx context.Context, chunkID string)) *MockChunkRepository_ChunkReadmeByChunkID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorVersionExists_Call) Return(_a0 bool, _a1 error) *MockChunkRepository_FlavorVersionExists_Call {
+func (_c *MockChunkRepository_ChunkReadmeByChunkID_Call) Return(_a0 resource.ChunkReadme, _a1 error) *MockChunkRepository_ChunkReadmeByChunkID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorVersionExists_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockChunkRepository_FlavorVersionExists_Call {
+func (_c *MockChunkRepository_ChunkReadmeByChunkID_Call) RunAndReturn(run func(context.Context, string) (resource.ChunkReadme, error)) *MockChunkRepository_ChunkReadmeByChunkID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FlavorVersionHashByID provides a mock function with given fields: ctx, id
-func (_m *MockChunkRepository) FlavorVersionHashByID(ctx context.Context, id string) (string, error) {
-	ret := _m.Called(ctx, id)
+// ChunkTemplateByName provides a mock function with given fields: ctx, name
+func (_m *MockChunkRepository) ChunkTemplateByName(ctx context.Context, name string) (resource.ChunkTemplate, error) {
+	ret := _m.Called(ctx, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FlavorVersionHashByID")
+		panic("no return value specified for ChunkTemplateByName")
 	}
 
-	var r0 string
+	var r0 resource.ChunkTemplate
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.ChunkTemplate, error)); ok {
+		return rf(ctx, name)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.ChunkTemplate); ok {
+		r0 = rf(ctx, name)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(resource.ChunkTemplate)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+		r1 = rf(ctx, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -676,56 +684,2578 @@ func (_m *MockChunkRepository) FlavorVersionHashByID(ctx context.Context, id str
 	return r0, r1
 }
 
-// MockChunkRepository_FlavorVersionHashByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorVersionHashByID'
-type MockChunkRepository_FlavorVersionHashByID_Call struct {
+// MockChunkRepository_ChunkTemplateByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChunkTemplateByName'
+type MockChunkRepository_ChunkTemplateByName_Call struct {
 	*mock.Call
 }
 
-// FlavorVersionHashByID is a helper method to define mock.On call
+// ChunkTemplateByName is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockChunkRepository_Expecter) FlavorVersionHashByID(ctx interface{}, id interface{}) *MockChunkRepository_FlavorVersionHashByID_Call {
-	return &MockChunkRepository_FlavorVersionHashByID_Call{Call: _e.mock.On("FlavorVersionHashByID", ctx, id)}
+//   - name string
+func (_e *MockChunkRepository_Expecter) ChunkTemplateByName(ctx interface{}, name interface{}) *MockChunkRepository_ChunkTemplateByName_Call {
+	return &MockChunkRepository_ChunkTemplateByName_Call{Call: _e.mock.On("ChunkTemplateByName", ctx, name)}
 }
 
-func (_c *MockChunkRepository_FlavorVersionHashByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_FlavorVersionHashByID_Call {
+func (_c *MockChunkRepository_ChunkTemplateByName_Call) Run(run func(ctx context.Context, name string)) *MockChunkRepository_ChunkTemplateByName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorVersionHashByID_Call) Return(_a0 string, _a1 error) *MockChunkRepository_FlavorVersionHashByID_Call {
+func (_c *MockChunkRepository_ChunkTemplateByName_Call) Return(_a0 resource.ChunkTemplate, _a1 error) *MockChunkRepository_ChunkTemplateByName_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_FlavorVersionHashByID_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockChunkRepository_FlavorVersionHashByID_Call {
+func (_c *MockChunkRepository_ChunkTemplateByName_Call) RunAndReturn(run func(context.Context, string) (resource.ChunkTemplate, error)) *MockChunkRepository_ChunkTemplateByName_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetChunkByID provides a mock function with given fields: ctx, id
-func (_m *MockChunkRepository) GetChunkByID(ctx context.Context, id string) (resource.Chunk, error) {
-	ret := _m.Called(ctx, id)
+// ChunkTemplates provides a mock function with given fields: ctx
+func (_m *MockChunkRepository) ChunkTemplates(ctx context.Context) ([]resource.ChunkTemplate, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetChunkByID")
+		panic("no return value specified for ChunkTemplates")
 	}
 
-	var r0 resource.Chunk
+	var r0 []resource.ChunkTemplate
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.Chunk, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]resource.ChunkTemplate, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) resource.Chunk); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context) []resource.ChunkTemplate); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(resource.Chunk)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.ChunkTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_ChunkTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChunkTemplates'
+type MockChunkRepository_ChunkTemplates_Call struct {
+	*mock.Call
+}
+
+// ChunkTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockChunkRepository_Expecter) ChunkTemplates(ctx interface{}) *MockChunkRepository_ChunkTemplates_Call {
+	return &MockChunkRepository_ChunkTemplates_Call{Call: _e.mock.On("ChunkTemplates", ctx)}
+}
+
+func (_c *MockChunkRepository_ChunkTemplates_Call) Run(run func(ctx context.Context)) *MockChunkRepository_ChunkTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_ChunkTemplates_Call) Return(_a0 []resource.ChunkTemplate, _a1 error) *MockChunkRepository_ChunkTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_ChunkTemplates_Call) RunAndReturn(run func(context.Context) ([]resource.ChunkTemplate, error)) *MockChunkRepository_ChunkTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAsset provides a mock function with given fields: ctx, asset
+func (_m *MockChunkRepository) CreateAsset(ctx context.Context, asset resource.Asset) (resource.Asset, error) {
+	ret := _m.Called(ctx, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAsset")
+	}
+
+	var r0 resource.Asset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Asset) (resource.Asset, error)); ok {
+		return rf(ctx, asset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Asset) resource.Asset); ok {
+		r0 = rf(ctx, asset)
+	} else {
+		r0 = ret.Get(0).(resource.Asset)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Asset) error); ok {
+		r1 = rf(ctx, asset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CreateAsset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAsset'
+type MockChunkRepository_CreateAsset_Call struct {
+	*mock.Call
+}
+
+// CreateAsset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asset resource.Asset
+func (_e *MockChunkRepository_Expecter) CreateAsset(ctx interface{}, asset interface{}) *MockChunkRepository_CreateAsset_Call {
+	return &MockChunkRepository_CreateAsset_Call{Call: _e.mock.On("CreateAsset", ctx, asset)}
+}
+
+func (_c *MockChunkRepository_CreateAsset_Call) Run(run func(ctx context.Context, asset resource.Asset)) *MockChunkRepository_CreateAsset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.Asset))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateAsset_Call) Return(_a0 resource.Asset, _a1 error) *MockChunkRepository_CreateAsset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateAsset_Call) RunAndReturn(run func(context.Context, resource.Asset) (resource.Asset, error)) *MockChunkRepository_CreateAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCanary provides a mock function with given fields: ctx, canary
+func (_m *MockChunkRepository) CreateCanary(ctx context.Context, canary resource.Canary) (resource.Canary, error) {
+	ret := _m.Called(ctx, canary)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCanary")
+	}
+
+	var r0 resource.Canary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Canary) (resource.Canary, error)); ok {
+		return rf(ctx, canary)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Canary) resource.Canary); ok {
+		r0 = rf(ctx, canary)
+	} else {
+		r0 = ret.Get(0).(resource.Canary)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Canary) error); ok {
+		r1 = rf(ctx, canary)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CreateCanary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCanary'
+type MockChunkRepository_CreateCanary_Call struct {
+	*mock.Call
+}
+
+// CreateCanary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - canary resource.Canary
+func (_e *MockChunkRepository_Expecter) CreateCanary(ctx interface{}, canary interface{}) *MockChunkRepository_CreateCanary_Call {
+	return &MockChunkRepository_CreateCanary_Call{Call: _e.mock.On("CreateCanary", ctx, canary)}
+}
+
+func (_c *MockChunkRepository_CreateCanary_Call) Run(run func(ctx context.Context, canary resource.Canary)) *MockChunkRepository_CreateCanary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.Canary))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateCanary_Call) Return(_a0 resource.Canary, _a1 error) *MockChunkRepository_CreateCanary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateCanary_Call) RunAndReturn(run func(context.Context, resource.Canary) (resource.Canary, error)) *MockChunkRepository_CreateCanary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCanaryInstance provides a mock function with given fields: ctx, canaryID, instanceID, canary
+func (_m *MockChunkRepository) CreateCanaryInstance(ctx context.Context, canaryID string, instanceID string, canary bool) error {
+	ret := _m.Called(ctx, canaryID, instanceID, canary)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCanaryInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) error); ok {
+		r0 = rf(ctx, canaryID, instanceID, canary)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_CreateCanaryInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCanaryInstance'
+type MockChunkRepository_CreateCanaryInstance_Call struct {
+	*mock.Call
+}
+
+// CreateCanaryInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - canaryID string
+//   - instanceID string
+//   - canary bool
+func (_e *MockChunkRepository_Expecter) CreateCanaryInstance(ctx interface{}, canaryID interface{}, instanceID interface{}, canary interface{}) *MockChunkRepository_CreateCanaryInstance_Call {
+	return &MockChunkRepository_CreateCanaryInstance_Call{Call: _e.mock.On("CreateCanaryInstance", ctx, canaryID, instanceID, canary)}
+}
+
+func (_c *MockChunkRepository_CreateCanaryInstance_Call) Run(run func(ctx context.Context, canaryID string, instanceID string, canary bool)) *MockChunkRepository_CreateCanaryInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateCanaryInstance_Call) Return(_a0 error) *MockChunkRepository_CreateCanaryInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateCanaryInstance_Call) RunAndReturn(run func(context.Context, string, string, bool) error) *MockChunkRepository_CreateCanaryInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChunk provides a mock function with given fields: ctx, _a1
+func (_m *MockChunkRepository) CreateChunk(ctx context.Context, _a1 resource.Chunk) (resource.Chunk, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChunk")
+	}
+
+	var r0 resource.Chunk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Chunk) (resource.Chunk, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Chunk) resource.Chunk); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(resource.Chunk)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Chunk) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CreateChunk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChunk'
+type MockChunkRepository_CreateChunk_Call struct {
+	*mock.Call
+}
+
+// CreateChunk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 resource.Chunk
+func (_e *MockChunkRepository_Expecter) CreateChunk(ctx interface{}, _a1 interface{}) *MockChunkRepository_CreateChunk_Call {
+	return &MockChunkRepository_CreateChunk_Call{Call: _e.mock.On("CreateChunk", ctx, _a1)}
+}
+
+func (_c *MockChunkRepository_CreateChunk_Call) Run(run func(ctx context.Context, _a1 resource.Chunk)) *MockChunkRepository_CreateChunk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.Chunk))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateChunk_Call) Return(_a0 resource.Chunk, _a1 error) *MockChunkRepository_CreateChunk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateChunk_Call) RunAndReturn(run func(context.Context, resource.Chunk) (resource.Chunk, error)) *MockChunkRepository_CreateChunk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChunkImport provides a mock function with given fields: ctx, imp
+func (_m *MockChunkRepository) CreateChunkImport(ctx context.Context, imp resource.ChunkImport) (resource.ChunkImport, error) {
+	ret := _m.Called(ctx, imp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChunkImport")
+	}
+
+	var r0 resource.ChunkImport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.ChunkImport) (resource.ChunkImport, error)); ok {
+		return rf(ctx, imp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.ChunkImport) resource.ChunkImport); ok {
+		r0 = rf(ctx, imp)
+	} else {
+		r0 = ret.Get(0).(resource.ChunkImport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.ChunkImport) error); ok {
+		r1 = rf(ctx, imp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CreateChunkImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChunkImport'
+type MockChunkRepository_CreateChunkImport_Call struct {
+	*mock.Call
+}
+
+// CreateChunkImport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imp resource.ChunkImport
+func (_e *MockChunkRepository_Expecter) CreateChunkImport(ctx interface{}, imp interface{}) *MockChunkRepository_CreateChunkImport_Call {
+	return &MockChunkRepository_CreateChunkImport_Call{Call: _e.mock.On("CreateChunkImport", ctx, imp)}
+}
+
+func (_c *MockChunkRepository_CreateChunkImport_Call) Run(run func(ctx context.Context, imp resource.ChunkImport)) *MockChunkRepository_CreateChunkImport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.ChunkImport))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateChunkImport_Call) Return(_a0 resource.ChunkImport, _a1 error) *MockChunkRepository_CreateChunkImport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateChunkImport_Call) RunAndReturn(run func(context.Context, resource.ChunkImport) (resource.ChunkImport, error)) *MockChunkRepository_CreateChunkImport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChunkMedia provides a mock function with given fields: ctx, media
+func (_m *MockChunkRepository) CreateChunkMedia(ctx context.Context, media resource.ChunkMedia) (resource.ChunkMedia, error) {
+	ret := _m.Called(ctx, media)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChunkMedia")
+	}
+
+	var r0 resource.ChunkMedia
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.ChunkMedia) (resource.ChunkMedia, error)); ok {
+		return rf(ctx, media)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.ChunkMedia) resource.ChunkMedia); ok {
+		r0 = rf(ctx, media)
+	} else {
+		r0 = ret.Get(0).(resource.ChunkMedia)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.ChunkMedia) error); ok {
+		r1 = rf(ctx, media)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CreateChunkMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChunkMedia'
+type MockChunkRepository_CreateChunkMedia_Call struct {
+	*mock.Call
+}
+
+// CreateChunkMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - media resource.ChunkMedia
+func (_e *MockChunkRepository_Expecter) CreateChunkMedia(ctx interface{}, media interface{}) *MockChunkRepository_CreateChunkMedia_Call {
+	return &MockChunkRepository_CreateChunkMedia_Call{Call: _e.mock.On("CreateChunkMedia", ctx, media)}
+}
+
+func (_c *MockChunkRepository_CreateChunkMedia_Call) Run(run func(ctx context.Context, media resource.ChunkMedia)) *MockChunkRepository_CreateChunkMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.ChunkMedia))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateChunkMedia_Call) Return(_a0 resource.ChunkMedia, _a1 error) *MockChunkRepository_CreateChunkMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateChunkMedia_Call) RunAndReturn(run func(context.Context, resource.ChunkMedia) (resource.ChunkMedia, error)) *MockChunkRepository_CreateChunkMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChunkTemplate provides a mock function with given fields: ctx, tmpl
+func (_m *MockChunkRepository) CreateChunkTemplate(ctx context.Context, tmpl resource.ChunkTemplate) (resource.ChunkTemplate, error) {
+	ret := _m.Called(ctx, tmpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChunkTemplate")
+	}
+
+	var r0 resource.ChunkTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.ChunkTemplate) (resource.ChunkTemplate, error)); ok {
+		return rf(ctx, tmpl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.ChunkTemplate) resource.ChunkTemplate); ok {
+		r0 = rf(ctx, tmpl)
+	} else {
+		r0 = ret.Get(0).(resource.ChunkTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.ChunkTemplate) error); ok {
+		r1 = rf(ctx, tmpl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CreateChunkTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChunkTemplate'
+type MockChunkRepository_CreateChunkTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateChunkTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tmpl resource.ChunkTemplate
+func (_e *MockChunkRepository_Expecter) CreateChunkTemplate(ctx interface{}, tmpl interface{}) *MockChunkRepository_CreateChunkTemplate_Call {
+	return &MockChunkRepository_CreateChunkTemplate_Call{Call: _e.mock.On("CreateChunkTemplate", ctx, tmpl)}
+}
+
+func (_c *MockChunkRepository_CreateChunkTemplate_Call) Run(run func(ctx context.Context, tmpl resource.ChunkTemplate)) *MockChunkRepository_CreateChunkTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.ChunkTemplate))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateChunkTemplate_Call) Return(_a0 resource.ChunkTemplate, _a1 error) *MockChunkRepository_CreateChunkTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateChunkTemplate_Call) RunAndReturn(run func(context.Context, resource.ChunkTemplate) (resource.ChunkTemplate, error)) *MockChunkRepository_CreateChunkTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFlavor provides a mock function with given fields: ctx, chunkID, flavor
+func (_m *MockChunkRepository) CreateFlavor(ctx context.Context, chunkID string, flavor resource.Flavor) (resource.Flavor, error) {
+	ret := _m.Called(ctx, chunkID, flavor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFlavor")
+	}
+
+	var r0 resource.Flavor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.Flavor) (resource.Flavor, error)); ok {
+		return rf(ctx, chunkID, flavor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.Flavor) resource.Flavor); ok {
+		r0 = rf(ctx, chunkID, flavor)
+	} else {
+		r0 = ret.Get(0).(resource.Flavor)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, resource.Flavor) error); ok {
+		r1 = rf(ctx, chunkID, flavor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CreateFlavor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFlavor'
+type MockChunkRepository_CreateFlavor_Call struct {
+	*mock.Call
+}
+
+// CreateFlavor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chunkID string
+//   - flavor resource.Flavor
+func (_e *MockChunkRepository_Expecter) CreateFlavor(ctx interface{}, chunkID interface{}, flavor interface{}) *MockChunkRepository_CreateFlavor_Call {
+	return &MockChunkRepository_CreateFlavor_Call{Call: _e.mock.On("CreateFlavor", ctx, chunkID, flavor)}
+}
+
+func (_c *MockChunkRepository_CreateFlavor_Call) Run(run func(ctx context.Context, chunkID string, flavor resource.Flavor)) *MockChunkRepository_CreateFlavor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.Flavor))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateFlavor_Call) Return(_a0 resource.Flavor, _a1 error) *MockChunkRepository_CreateFlavor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateFlavor_Call) RunAndReturn(run func(context.Context, string, resource.Flavor) (resource.Flavor, error)) *MockChunkRepository_CreateFlavor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFlavorVersion provides a mock function with given fields: ctx, flavorID, version, prevVersionID
+func (_m *MockChunkRepository) CreateFlavorVersion(ctx context.Context, flavorID string, version resource.FlavorVersion, prevVersionID string) (resource.FlavorVersion, error) {
+	ret := _m.Called(ctx, flavorID, version, prevVersionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFlavorVersion")
+	}
+
+	var r0 resource.FlavorVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.FlavorVersion, string) (resource.FlavorVersion, error)); ok {
+		return rf(ctx, flavorID, version, prevVersionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.FlavorVersion, string) resource.FlavorVersion); ok {
+		r0 = rf(ctx, flavorID, version, prevVersionID)
+	} else {
+		r0 = ret.Get(0).(resource.FlavorVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, resource.FlavorVersion, string) error); ok {
+		r1 = rf(ctx, flavorID, version, prevVersionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CreateFlavorVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFlavorVersion'
+type MockChunkRepository_CreateFlavorVersion_Call struct {
+	*mock.Call
+}
+
+// CreateFlavorVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+//   - version resource.FlavorVersion
+//   - prevVersionID string
+func (_e *MockChunkRepository_Expecter) CreateFlavorVersion(ctx interface{}, flavorID interface{}, version interface{}, prevVersionID interface{}) *MockChunkRepository_CreateFlavorVersion_Call {
+	return &MockChunkRepository_CreateFlavorVersion_Call{Call: _e.mock.On("CreateFlavorVersion", ctx, flavorID, version, prevVersionID)}
+}
+
+func (_c *MockChunkRepository_CreateFlavorVersion_Call) Run(run func(ctx context.Context, flavorID string, version resource.FlavorVersion, prevVersionID string)) *MockChunkRepository_CreateFlavorVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.FlavorVersion), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateFlavorVersion_Call) Return(_a0 resource.FlavorVersion, _a1 error) *MockChunkRepository_CreateFlavorVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateFlavorVersion_Call) RunAndReturn(run func(context.Context, string, resource.FlavorVersion, string) (resource.FlavorVersion, error)) *MockChunkRepository_CreateFlavorVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInstanceSchedule provides a mock function with given fields: ctx, s
+func (_m *MockChunkRepository) CreateInstanceSchedule(ctx context.Context, s resource.InstanceSchedule) (resource.InstanceSchedule, error) {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstanceSchedule")
+	}
+
+	var r0 resource.InstanceSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.InstanceSchedule) (resource.InstanceSchedule, error)); ok {
+		return rf(ctx, s)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.InstanceSchedule) resource.InstanceSchedule); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Get(0).(resource.InstanceSchedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.InstanceSchedule) error); ok {
+		r1 = rf(ctx, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CreateInstanceSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstanceSchedule'
+type MockChunkRepository_CreateInstanceSchedule_Call struct {
+	*mock.Call
+}
+
+// CreateInstanceSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s resource.InstanceSchedule
+func (_e *MockChunkRepository_Expecter) CreateInstanceSchedule(ctx interface{}, s interface{}) *MockChunkRepository_CreateInstanceSchedule_Call {
+	return &MockChunkRepository_CreateInstanceSchedule_Call{Call: _e.mock.On("CreateInstanceSchedule", ctx, s)}
+}
+
+func (_c *MockChunkRepository_CreateInstanceSchedule_Call) Run(run func(ctx context.Context, s resource.InstanceSchedule)) *MockChunkRepository_CreateInstanceSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.InstanceSchedule))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateInstanceSchedule_Call) Return(_a0 resource.InstanceSchedule, _a1 error) *MockChunkRepository_CreateInstanceSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CreateInstanceSchedule_Call) RunAndReturn(run func(context.Context, resource.InstanceSchedule) (resource.InstanceSchedule, error)) *MockChunkRepository_CreateInstanceSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CrossInstanceTrafficApproved provides a mock function with given fields: ctx, flavorID
+func (_m *MockChunkRepository) CrossInstanceTrafficApproved(ctx context.Context, flavorID string) (bool, error) {
+	ret := _m.Called(ctx, flavorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CrossInstanceTrafficApproved")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, flavorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, flavorID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, flavorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CrossInstanceTrafficApproved_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CrossInstanceTrafficApproved'
+type MockChunkRepository_CrossInstanceTrafficApproved_Call struct {
+	*mock.Call
+}
+
+// CrossInstanceTrafficApproved is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+func (_e *MockChunkRepository_Expecter) CrossInstanceTrafficApproved(ctx interface{}, flavorID interface{}) *MockChunkRepository_CrossInstanceTrafficApproved_Call {
+	return &MockChunkRepository_CrossInstanceTrafficApproved_Call{Call: _e.mock.On("CrossInstanceTrafficApproved", ctx, flavorID)}
+}
+
+func (_c *MockChunkRepository_CrossInstanceTrafficApproved_Call) Run(run func(ctx context.Context, flavorID string)) *MockChunkRepository_CrossInstanceTrafficApproved_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CrossInstanceTrafficApproved_Call) Return(_a0 bool, _a1 error) *MockChunkRepository_CrossInstanceTrafficApproved_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CrossInstanceTrafficApproved_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockChunkRepository_CrossInstanceTrafficApproved_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CrossInstanceTrafficByChunkID provides a mock function with given fields: ctx, chunkID
+func (_m *MockChunkRepository) CrossInstanceTrafficByChunkID(ctx context.Context, chunkID string) (resource.CrossInstanceTraffic, error) {
+	ret := _m.Called(ctx, chunkID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CrossInstanceTrafficByChunkID")
+	}
+
+	var r0 resource.CrossInstanceTraffic
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.CrossInstanceTraffic, error)); ok {
+		return rf(ctx, chunkID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.CrossInstanceTraffic); ok {
+		r0 = rf(ctx, chunkID)
+	} else {
+		r0 = ret.Get(0).(resource.CrossInstanceTraffic)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, chunkID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CrossInstanceTrafficByChunkID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CrossInstanceTrafficByChunkID'
+type MockChunkRepository_CrossInstanceTrafficByChunkID_Call struct {
+	*mock.Call
+}
+
+// CrossInstanceTrafficByChunkID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chunkID string
+func (_e *MockChunkRepository_Expecter) CrossInstanceTrafficByChunkID(ctx interface{}, chunkID interface{}) *MockChunkRepository_CrossInstanceTrafficByChunkID_Call {
+	return &MockChunkRepository_CrossInstanceTrafficByChunkID_Call{Call: _e.mock.On("CrossInstanceTrafficByChunkID", ctx, chunkID)}
+}
+
+func (_c *MockChunkRepository_CrossInstanceTrafficByChunkID_Call) Run(run func(ctx context.Context, chunkID string)) *MockChunkRepository_CrossInstanceTrafficByChunkID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CrossInstanceTrafficByChunkID_Call) Return(_a0 resource.CrossInstanceTraffic, _a1 error) *MockChunkRepository_CrossInstanceTrafficByChunkID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CrossInstanceTrafficByChunkID_Call) RunAndReturn(run func(context.Context, string) (resource.CrossInstanceTraffic, error)) *MockChunkRepository_CrossInstanceTrafficByChunkID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CrossInstanceTrafficByState provides a mock function with given fields: ctx, state
+func (_m *MockChunkRepository) CrossInstanceTrafficByState(ctx context.Context, state resource.CrossInstanceTrafficState) ([]resource.CrossInstanceTraffic, error) {
+	ret := _m.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CrossInstanceTrafficByState")
+	}
+
+	var r0 []resource.CrossInstanceTraffic
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.CrossInstanceTrafficState) ([]resource.CrossInstanceTraffic, error)); ok {
+		return rf(ctx, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.CrossInstanceTrafficState) []resource.CrossInstanceTraffic); ok {
+		r0 = rf(ctx, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.CrossInstanceTraffic)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.CrossInstanceTrafficState) error); ok {
+		r1 = rf(ctx, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_CrossInstanceTrafficByState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CrossInstanceTrafficByState'
+type MockChunkRepository_CrossInstanceTrafficByState_Call struct {
+	*mock.Call
+}
+
+// CrossInstanceTrafficByState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state resource.CrossInstanceTrafficState
+func (_e *MockChunkRepository_Expecter) CrossInstanceTrafficByState(ctx interface{}, state interface{}) *MockChunkRepository_CrossInstanceTrafficByState_Call {
+	return &MockChunkRepository_CrossInstanceTrafficByState_Call{Call: _e.mock.On("CrossInstanceTrafficByState", ctx, state)}
+}
+
+func (_c *MockChunkRepository_CrossInstanceTrafficByState_Call) Run(run func(ctx context.Context, state resource.CrossInstanceTrafficState)) *MockChunkRepository_CrossInstanceTrafficByState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.CrossInstanceTrafficState))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_CrossInstanceTrafficByState_Call) Return(_a0 []resource.CrossInstanceTraffic, _a1 error) *MockChunkRepository_CrossInstanceTrafficByState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_CrossInstanceTrafficByState_Call) RunAndReturn(run func(context.Context, resource.CrossInstanceTrafficState) ([]resource.CrossInstanceTraffic, error)) *MockChunkRepository_CrossInstanceTrafficByState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAutoscalingPolicy provides a mock function with given fields: ctx, flavorID
+func (_m *MockChunkRepository) DeleteAutoscalingPolicy(ctx context.Context, flavorID string) error {
+	ret := _m.Called(ctx, flavorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAutoscalingPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, flavorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_DeleteAutoscalingPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAutoscalingPolicy'
+type MockChunkRepository_DeleteAutoscalingPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteAutoscalingPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+func (_e *MockChunkRepository_Expecter) DeleteAutoscalingPolicy(ctx interface{}, flavorID interface{}) *MockChunkRepository_DeleteAutoscalingPolicy_Call {
+	return &MockChunkRepository_DeleteAutoscalingPolicy_Call{Call: _e.mock.On("DeleteAutoscalingPolicy", ctx, flavorID)}
+}
+
+func (_c *MockChunkRepository_DeleteAutoscalingPolicy_Call) Run(run func(ctx context.Context, flavorID string)) *MockChunkRepository_DeleteAutoscalingPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteAutoscalingPolicy_Call) Return(_a0 error) *MockChunkRepository_DeleteAutoscalingPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteAutoscalingPolicy_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_DeleteAutoscalingPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteChunkMedia provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) DeleteChunkMedia(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChunkMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_DeleteChunkMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChunkMedia'
+type MockChunkRepository_DeleteChunkMedia_Call struct {
+	*mock.Call
+}
+
+// DeleteChunkMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) DeleteChunkMedia(ctx interface{}, id interface{}) *MockChunkRepository_DeleteChunkMedia_Call {
+	return &MockChunkRepository_DeleteChunkMedia_Call{Call: _e.mock.On("DeleteChunkMedia", ctx, id)}
+}
+
+func (_c *MockChunkRepository_DeleteChunkMedia_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_DeleteChunkMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteChunkMedia_Call) Return(_a0 error) *MockChunkRepository_DeleteChunkMedia_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteChunkMedia_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_DeleteChunkMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteChunkTemplate provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) DeleteChunkTemplate(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChunkTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_DeleteChunkTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChunkTemplate'
+type MockChunkRepository_DeleteChunkTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteChunkTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) DeleteChunkTemplate(ctx interface{}, id interface{}) *MockChunkRepository_DeleteChunkTemplate_Call {
+	return &MockChunkRepository_DeleteChunkTemplate_Call{Call: _e.mock.On("DeleteChunkTemplate", ctx, id)}
+}
+
+func (_c *MockChunkRepository_DeleteChunkTemplate_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_DeleteChunkTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteChunkTemplate_Call) Return(_a0 error) *MockChunkRepository_DeleteChunkTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteChunkTemplate_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_DeleteChunkTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCrossInstanceTraffic provides a mock function with given fields: ctx, chunkID
+func (_m *MockChunkRepository) DeleteCrossInstanceTraffic(ctx context.Context, chunkID string) error {
+	ret := _m.Called(ctx, chunkID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCrossInstanceTraffic")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, chunkID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_DeleteCrossInstanceTraffic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCrossInstanceTraffic'
+type MockChunkRepository_DeleteCrossInstanceTraffic_Call struct {
+	*mock.Call
+}
+
+// DeleteCrossInstanceTraffic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chunkID string
+func (_e *MockChunkRepository_Expecter) DeleteCrossInstanceTraffic(ctx interface{}, chunkID interface{}) *MockChunkRepository_DeleteCrossInstanceTraffic_Call {
+	return &MockChunkRepository_DeleteCrossInstanceTraffic_Call{Call: _e.mock.On("DeleteCrossInstanceTraffic", ctx, chunkID)}
+}
+
+func (_c *MockChunkRepository_DeleteCrossInstanceTraffic_Call) Run(run func(ctx context.Context, chunkID string)) *MockChunkRepository_DeleteCrossInstanceTraffic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteCrossInstanceTraffic_Call) Return(_a0 error) *MockChunkRepository_DeleteCrossInstanceTraffic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteCrossInstanceTraffic_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_DeleteCrossInstanceTraffic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFlavor provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) DeleteFlavor(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFlavor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_DeleteFlavor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFlavor'
+type MockChunkRepository_DeleteFlavor_Call struct {
+	*mock.Call
+}
+
+// DeleteFlavor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) DeleteFlavor(ctx interface{}, id interface{}) *MockChunkRepository_DeleteFlavor_Call {
+	return &MockChunkRepository_DeleteFlavor_Call{Call: _e.mock.On("DeleteFlavor", ctx, id)}
+}
+
+func (_c *MockChunkRepository_DeleteFlavor_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_DeleteFlavor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteFlavor_Call) Return(_a0 error) *MockChunkRepository_DeleteFlavor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteFlavor_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_DeleteFlavor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInstanceSchedule provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) DeleteInstanceSchedule(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInstanceSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_DeleteInstanceSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInstanceSchedule'
+type MockChunkRepository_DeleteInstanceSchedule_Call struct {
+	*mock.Call
+}
+
+// DeleteInstanceSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) DeleteInstanceSchedule(ctx interface{}, id interface{}) *MockChunkRepository_DeleteInstanceSchedule_Call {
+	return &MockChunkRepository_DeleteInstanceSchedule_Call{Call: _e.mock.On("DeleteInstanceSchedule", ctx, id)}
+}
+
+func (_c *MockChunkRepository_DeleteInstanceSchedule_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_DeleteInstanceSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteInstanceSchedule_Call) Return(_a0 error) *MockChunkRepository_DeleteInstanceSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_DeleteInstanceSchedule_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_DeleteInstanceSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlavorByID provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) FlavorByID(ctx context.Context, id string) (resource.Flavor, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlavorByID")
+	}
+
+	var r0 resource.Flavor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.Flavor, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.Flavor); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(resource.Flavor)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_FlavorByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorByID'
+type MockChunkRepository_FlavorByID_Call struct {
+	*mock.Call
+}
+
+// FlavorByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) FlavorByID(ctx interface{}, id interface{}) *MockChunkRepository_FlavorByID_Call {
+	return &MockChunkRepository_FlavorByID_Call{Call: _e.mock.On("FlavorByID", ctx, id)}
+}
+
+func (_c *MockChunkRepository_FlavorByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_FlavorByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorByID_Call) Return(_a0 resource.Flavor, _a1 error) *MockChunkRepository_FlavorByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorByID_Call) RunAndReturn(run func(context.Context, string) (resource.Flavor, error)) *MockChunkRepository_FlavorByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlavorChannel provides a mock function with given fields: ctx, flavorID, name
+func (_m *MockChunkRepository) FlavorChannel(ctx context.Context, flavorID string, name string) (resource.FlavorChannel, error) {
+	ret := _m.Called(ctx, flavorID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlavorChannel")
+	}
+
+	var r0 resource.FlavorChannel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (resource.FlavorChannel, error)); ok {
+		return rf(ctx, flavorID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) resource.FlavorChannel); ok {
+		r0 = rf(ctx, flavorID, name)
+	} else {
+		r0 = ret.Get(0).(resource.FlavorChannel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, flavorID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_FlavorChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorChannel'
+type MockChunkRepository_FlavorChannel_Call struct {
+	*mock.Call
+}
+
+// FlavorChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+//   - name string
+func (_e *MockChunkRepository_Expecter) FlavorChannel(ctx interface{}, flavorID interface{}, name interface{}) *MockChunkRepository_FlavorChannel_Call {
+	return &MockChunkRepository_FlavorChannel_Call{Call: _e.mock.On("FlavorChannel", ctx, flavorID, name)}
+}
+
+func (_c *MockChunkRepository_FlavorChannel_Call) Run(run func(ctx context.Context, flavorID string, name string)) *MockChunkRepository_FlavorChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorChannel_Call) Return(_a0 resource.FlavorChannel, _a1 error) *MockChunkRepository_FlavorChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorChannel_Call) RunAndReturn(run func(context.Context, string, string) (resource.FlavorChannel, error)) *MockChunkRepository_FlavorChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlavorChannels provides a mock function with given fields: ctx, flavorID
+func (_m *MockChunkRepository) FlavorChannels(ctx context.Context, flavorID string) ([]resource.FlavorChannel, error) {
+	ret := _m.Called(ctx, flavorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlavorChannels")
+	}
+
+	var r0 []resource.FlavorChannel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.FlavorChannel, error)); ok {
+		return rf(ctx, flavorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.FlavorChannel); ok {
+		r0 = rf(ctx, flavorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.FlavorChannel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, flavorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_FlavorChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorChannels'
+type MockChunkRepository_FlavorChannels_Call struct {
+	*mock.Call
+}
+
+// FlavorChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+func (_e *MockChunkRepository_Expecter) FlavorChannels(ctx interface{}, flavorID interface{}) *MockChunkRepository_FlavorChannels_Call {
+	return &MockChunkRepository_FlavorChannels_Call{Call: _e.mock.On("FlavorChannels", ctx, flavorID)}
+}
+
+func (_c *MockChunkRepository_FlavorChannels_Call) Run(run func(ctx context.Context, flavorID string)) *MockChunkRepository_FlavorChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorChannels_Call) Return(_a0 []resource.FlavorChannel, _a1 error) *MockChunkRepository_FlavorChannels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorChannels_Call) RunAndReturn(run func(context.Context, string) ([]resource.FlavorChannel, error)) *MockChunkRepository_FlavorChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlavorIDByFlavorVersionID provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) FlavorIDByFlavorVersionID(ctx context.Context, id string) (string, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlavorIDByFlavorVersionID")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_FlavorIDByFlavorVersionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorIDByFlavorVersionID'
+type MockChunkRepository_FlavorIDByFlavorVersionID_Call struct {
+	*mock.Call
+}
+
+// FlavorIDByFlavorVersionID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) FlavorIDByFlavorVersionID(ctx interface{}, id interface{}) *MockChunkRepository_FlavorIDByFlavorVersionID_Call {
+	return &MockChunkRepository_FlavorIDByFlavorVersionID_Call{Call: _e.mock.On("FlavorIDByFlavorVersionID", ctx, id)}
+}
+
+func (_c *MockChunkRepository_FlavorIDByFlavorVersionID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_FlavorIDByFlavorVersionID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorIDByFlavorVersionID_Call) Return(_a0 string, _a1 error) *MockChunkRepository_FlavorIDByFlavorVersionID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorIDByFlavorVersionID_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockChunkRepository_FlavorIDByFlavorVersionID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlavorNameExists provides a mock function with given fields: ctx, chunkID, name
+func (_m *MockChunkRepository) FlavorNameExists(ctx context.Context, chunkID string, name string) (bool, error) {
+	ret := _m.Called(ctx, chunkID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlavorNameExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, chunkID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, chunkID, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, chunkID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_FlavorNameExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorNameExists'
+type MockChunkRepository_FlavorNameExists_Call struct {
+	*mock.Call
+}
+
+// FlavorNameExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chunkID string
+//   - name string
+func (_e *MockChunkRepository_Expecter) FlavorNameExists(ctx interface{}, chunkID interface{}, name interface{}) *MockChunkRepository_FlavorNameExists_Call {
+	return &MockChunkRepository_FlavorNameExists_Call{Call: _e.mock.On("FlavorNameExists", ctx, chunkID, name)}
+}
+
+func (_c *MockChunkRepository_FlavorNameExists_Call) Run(run func(ctx context.Context, chunkID string, name string)) *MockChunkRepository_FlavorNameExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorNameExists_Call) Return(_a0 bool, _a1 error) *MockChunkRepository_FlavorNameExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorNameExists_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockChunkRepository_FlavorNameExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlavorVersionByID provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) FlavorVersionByID(ctx context.Context, id string) (resource.FlavorVersion, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlavorVersionByID")
+	}
+
+	var r0 resource.FlavorVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.FlavorVersion, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.FlavorVersion); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(resource.FlavorVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_FlavorVersionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorVersionByID'
+type MockChunkRepository_FlavorVersionByID_Call struct {
+	*mock.Call
+}
+
+// FlavorVersionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) FlavorVersionByID(ctx interface{}, id interface{}) *MockChunkRepository_FlavorVersionByID_Call {
+	return &MockChunkRepository_FlavorVersionByID_Call{Call: _e.mock.On("FlavorVersionByID", ctx, id)}
+}
+
+func (_c *MockChunkRepository_FlavorVersionByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_FlavorVersionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorVersionByID_Call) Return(_a0 resource.FlavorVersion, _a1 error) *MockChunkRepository_FlavorVersionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorVersionByID_Call) RunAndReturn(run func(context.Context, string) (resource.FlavorVersion, error)) *MockChunkRepository_FlavorVersionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlavorVersionExists provides a mock function with given fields: ctx, flavorID, version
+func (_m *MockChunkRepository) FlavorVersionExists(ctx context.Context, flavorID string, version string) (bool, error) {
+	ret := _m.Called(ctx, flavorID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlavorVersionExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, flavorID, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, flavorID, version)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, flavorID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_FlavorVersionExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorVersionExists'
+type MockChunkRepository_FlavorVersionExists_Call struct {
+	*mock.Call
+}
+
+// FlavorVersionExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+//   - version string
+func (_e *MockChunkRepository_Expecter) FlavorVersionExists(ctx interface{}, flavorID interface{}, version interface{}) *MockChunkRepository_FlavorVersionExists_Call {
+	return &MockChunkRepository_FlavorVersionExists_Call{Call: _e.mock.On("FlavorVersionExists", ctx, flavorID, version)}
+}
+
+func (_c *MockChunkRepository_FlavorVersionExists_Call) Run(run func(ctx context.Context, flavorID string, version string)) *MockChunkRepository_FlavorVersionExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorVersionExists_Call) Return(_a0 bool, _a1 error) *MockChunkRepository_FlavorVersionExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorVersionExists_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockChunkRepository_FlavorVersionExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlavorVersionHashByID provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) FlavorVersionHashByID(ctx context.Context, id string) (string, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlavorVersionHashByID")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_FlavorVersionHashByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorVersionHashByID'
+type MockChunkRepository_FlavorVersionHashByID_Call struct {
+	*mock.Call
+}
+
+// FlavorVersionHashByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) FlavorVersionHashByID(ctx interface{}, id interface{}) *MockChunkRepository_FlavorVersionHashByID_Call {
+	return &MockChunkRepository_FlavorVersionHashByID_Call{Call: _e.mock.On("FlavorVersionHashByID", ctx, id)}
+}
+
+func (_c *MockChunkRepository_FlavorVersionHashByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_FlavorVersionHashByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorVersionHashByID_Call) Return(_a0 string, _a1 error) *MockChunkRepository_FlavorVersionHashByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorVersionHashByID_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockChunkRepository_FlavorVersionHashByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlavorVersionProvenance provides a mock function with given fields: ctx, flavorVersionID
+func (_m *MockChunkRepository) FlavorVersionProvenance(ctx context.Context, flavorVersionID string) (resource.BuildProvenance, error) {
+	ret := _m.Called(ctx, flavorVersionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlavorVersionProvenance")
+	}
+
+	var r0 resource.BuildProvenance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.BuildProvenance, error)); ok {
+		return rf(ctx, flavorVersionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.BuildProvenance); ok {
+		r0 = rf(ctx, flavorVersionID)
+	} else {
+		r0 = ret.Get(0).(resource.BuildProvenance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, flavorVersionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_FlavorVersionProvenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlavorVersionProvenance'
+type MockChunkRepository_FlavorVersionProvenance_Call struct {
+	*mock.Call
+}
+
+// FlavorVersionProvenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorVersionID string
+func (_e *MockChunkRepository_Expecter) FlavorVersionProvenance(ctx interface{}, flavorVersionID interface{}) *MockChunkRepository_FlavorVersionProvenance_Call {
+	return &MockChunkRepository_FlavorVersionProvenance_Call{Call: _e.mock.On("FlavorVersionProvenance", ctx, flavorVersionID)}
+}
+
+func (_c *MockChunkRepository_FlavorVersionProvenance_Call) Run(run func(ctx context.Context, flavorVersionID string)) *MockChunkRepository_FlavorVersionProvenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorVersionProvenance_Call) Return(_a0 resource.BuildProvenance, _a1 error) *MockChunkRepository_FlavorVersionProvenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_FlavorVersionProvenance_Call) RunAndReturn(run func(context.Context, string) (resource.BuildProvenance, error)) *MockChunkRepository_FlavorVersionProvenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChunkByID provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) GetChunkByID(ctx context.Context, id string) (resource.Chunk, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChunkByID")
+	}
+
+	var r0 resource.Chunk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.Chunk, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.Chunk); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(resource.Chunk)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_GetChunkByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChunkByID'
+type MockChunkRepository_GetChunkByID_Call struct {
+	*mock.Call
+}
+
+// GetChunkByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) GetChunkByID(ctx interface{}, id interface{}) *MockChunkRepository_GetChunkByID_Call {
+	return &MockChunkRepository_GetChunkByID_Call{Call: _e.mock.On("GetChunkByID", ctx, id)}
+}
+
+func (_c *MockChunkRepository_GetChunkByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_GetChunkByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_GetChunkByID_Call) Return(_a0 resource.Chunk, _a1 error) *MockChunkRepository_GetChunkByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_GetChunkByID_Call) RunAndReturn(run func(context.Context, string) (resource.Chunk, error)) *MockChunkRepository_GetChunkByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMinecraftVersionByVersion provides a mock function with given fields: _a0, _a1
+func (_m *MockChunkRepository) GetMinecraftVersionByVersion(_a0 context.Context, _a1 string) (resource.MinecraftVersion, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMinecraftVersionByVersion")
+	}
+
+	var r0 resource.MinecraftVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.MinecraftVersion, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.MinecraftVersion); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(resource.MinecraftVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_GetMinecraftVersionByVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMinecraftVersionByVersion'
+type MockChunkRepository_GetMinecraftVersionByVersion_Call struct {
+	*mock.Call
+}
+
+// GetMinecraftVersionByVersion is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+func (_e *MockChunkRepository_Expecter) GetMinecraftVersionByVersion(_a0 interface{}, _a1 interface{}) *MockChunkRepository_GetMinecraftVersionByVersion_Call {
+	return &MockChunkRepository_GetMinecraftVersionByVersion_Call{Call: _e.mock.On("GetMinecraftVersionByVersion", _a0, _a1)}
+}
+
+func (_c *MockChunkRepository_GetMinecraftVersionByVersion_Call) Run(run func(_a0 context.Context, _a1 string)) *MockChunkRepository_GetMinecraftVersionByVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_GetMinecraftVersionByVersion_Call) Return(_a0 resource.MinecraftVersion, _a1 error) *MockChunkRepository_GetMinecraftVersionByVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_GetMinecraftVersionByVersion_Call) RunAndReturn(run func(context.Context, string) (resource.MinecraftVersion, error)) *MockChunkRepository_GetMinecraftVersionByVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstanceScheduleByID provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) InstanceScheduleByID(ctx context.Context, id string) (resource.InstanceSchedule, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstanceScheduleByID")
+	}
+
+	var r0 resource.InstanceSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.InstanceSchedule, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.InstanceSchedule); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(resource.InstanceSchedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_InstanceScheduleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstanceScheduleByID'
+type MockChunkRepository_InstanceScheduleByID_Call struct {
+	*mock.Call
+}
+
+// InstanceScheduleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) InstanceScheduleByID(ctx interface{}, id interface{}) *MockChunkRepository_InstanceScheduleByID_Call {
+	return &MockChunkRepository_InstanceScheduleByID_Call{Call: _e.mock.On("InstanceScheduleByID", ctx, id)}
+}
+
+func (_c *MockChunkRepository_InstanceScheduleByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_InstanceScheduleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_InstanceScheduleByID_Call) Return(_a0 resource.InstanceSchedule, _a1 error) *MockChunkRepository_InstanceScheduleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_InstanceScheduleByID_Call) RunAndReturn(run func(context.Context, string) (resource.InstanceSchedule, error)) *MockChunkRepository_InstanceScheduleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstanceSchedules provides a mock function with given fields: ctx, chunkID
+func (_m *MockChunkRepository) InstanceSchedules(ctx context.Context, chunkID string) ([]resource.InstanceSchedule, error) {
+	ret := _m.Called(ctx, chunkID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstanceSchedules")
+	}
+
+	var r0 []resource.InstanceSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.InstanceSchedule, error)); ok {
+		return rf(ctx, chunkID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.InstanceSchedule); ok {
+		r0 = rf(ctx, chunkID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.InstanceSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, chunkID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_InstanceSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstanceSchedules'
+type MockChunkRepository_InstanceSchedules_Call struct {
+	*mock.Call
+}
+
+// InstanceSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chunkID string
+func (_e *MockChunkRepository_Expecter) InstanceSchedules(ctx interface{}, chunkID interface{}) *MockChunkRepository_InstanceSchedules_Call {
+	return &MockChunkRepository_InstanceSchedules_Call{Call: _e.mock.On("InstanceSchedules", ctx, chunkID)}
+}
+
+func (_c *MockChunkRepository_InstanceSchedules_Call) Run(run func(ctx context.Context, chunkID string)) *MockChunkRepository_InstanceSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_InstanceSchedules_Call) Return(_a0 []resource.InstanceSchedule, _a1 error) *MockChunkRepository_InstanceSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_InstanceSchedules_Call) RunAndReturn(run func(context.Context, string) ([]resource.InstanceSchedule, error)) *MockChunkRepository_InstanceSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LatestCanary provides a mock function with given fields: ctx, flavorID
+func (_m *MockChunkRepository) LatestCanary(ctx context.Context, flavorID string) (resource.Canary, error) {
+	ret := _m.Called(ctx, flavorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestCanary")
+	}
+
+	var r0 resource.Canary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.Canary, error)); ok {
+		return rf(ctx, flavorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.Canary); ok {
+		r0 = rf(ctx, flavorID)
+	} else {
+		r0 = ret.Get(0).(resource.Canary)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, flavorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_LatestCanary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestCanary'
+type MockChunkRepository_LatestCanary_Call struct {
+	*mock.Call
+}
+
+// LatestCanary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+func (_e *MockChunkRepository_Expecter) LatestCanary(ctx interface{}, flavorID interface{}) *MockChunkRepository_LatestCanary_Call {
+	return &MockChunkRepository_LatestCanary_Call{Call: _e.mock.On("LatestCanary", ctx, flavorID)}
+}
+
+func (_c *MockChunkRepository_LatestCanary_Call) Run(run func(ctx context.Context, flavorID string)) *MockChunkRepository_LatestCanary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_LatestCanary_Call) Return(_a0 resource.Canary, _a1 error) *MockChunkRepository_LatestCanary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_LatestCanary_Call) RunAndReturn(run func(context.Context, string) (resource.Canary, error)) *MockChunkRepository_LatestCanary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LatestFlavorVersion provides a mock function with given fields: ctx, flavorID
+func (_m *MockChunkRepository) LatestFlavorVersion(ctx context.Context, flavorID string) (resource.FlavorVersion, error) {
+	ret := _m.Called(ctx, flavorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestFlavorVersion")
+	}
+
+	var r0 resource.FlavorVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.FlavorVersion, error)); ok {
+		return rf(ctx, flavorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.FlavorVersion); ok {
+		r0 = rf(ctx, flavorID)
+	} else {
+		r0 = ret.Get(0).(resource.FlavorVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, flavorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_LatestFlavorVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestFlavorVersion'
+type MockChunkRepository_LatestFlavorVersion_Call struct {
+	*mock.Call
+}
+
+// LatestFlavorVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+func (_e *MockChunkRepository_Expecter) LatestFlavorVersion(ctx interface{}, flavorID interface{}) *MockChunkRepository_LatestFlavorVersion_Call {
+	return &MockChunkRepository_LatestFlavorVersion_Call{Call: _e.mock.On("LatestFlavorVersion", ctx, flavorID)}
+}
+
+func (_c *MockChunkRepository_LatestFlavorVersion_Call) Run(run func(ctx context.Context, flavorID string)) *MockChunkRepository_LatestFlavorVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_LatestFlavorVersion_Call) Return(_a0 resource.FlavorVersion, _a1 error) *MockChunkRepository_LatestFlavorVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_LatestFlavorVersion_Call) RunAndReturn(run func(context.Context, string) (resource.FlavorVersion, error)) *MockChunkRepository_LatestFlavorVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListChunks provides a mock function with given fields: ctx, pageSize, afterID
+func (_m *MockChunkRepository) ListChunks(ctx context.Context, pageSize int, afterID *string) ([]resource.Chunk, error) {
+	ret := _m.Called(ctx, pageSize, afterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChunks")
+	}
+
+	var r0 []resource.Chunk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *string) ([]resource.Chunk, error)); ok {
+		return rf(ctx, pageSize, afterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *string) []resource.Chunk); ok {
+		r0 = rf(ctx, pageSize, afterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Chunk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *string) error); ok {
+		r1 = rf(ctx, pageSize, afterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_ListChunks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChunks'
+type MockChunkRepository_ListChunks_Call struct {
+	*mock.Call
+}
+
+// ListChunks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageSize int
+//   - afterID *string
+func (_e *MockChunkRepository_Expecter) ListChunks(ctx interface{}, pageSize interface{}, afterID interface{}) *MockChunkRepository_ListChunks_Call {
+	return &MockChunkRepository_ListChunks_Call{Call: _e.mock.On("ListChunks", ctx, pageSize, afterID)}
+}
+
+func (_c *MockChunkRepository_ListChunks_Call) Run(run func(ctx context.Context, pageSize int, afterID *string)) *MockChunkRepository_ListChunks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_ListChunks_Call) Return(_a0 []resource.Chunk, _a1 error) *MockChunkRepository_ListChunks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_ListChunks_Call) RunAndReturn(run func(context.Context, int, *string) ([]resource.Chunk, error)) *MockChunkRepository_ListChunks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkChunkAndFlavorsDeleted provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) MarkChunkAndFlavorsDeleted(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkChunkAndFlavorsDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_MarkChunkAndFlavorsDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkChunkAndFlavorsDeleted'
+type MockChunkRepository_MarkChunkAndFlavorsDeleted_Call struct {
+	*mock.Call
+}
+
+// MarkChunkAndFlavorsDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) MarkChunkAndFlavorsDeleted(ctx interface{}, id interface{}) *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call {
+	return &MockChunkRepository_MarkChunkAndFlavorsDeleted_Call{Call: _e.mock.On("MarkChunkAndFlavorsDeleted", ctx, id)}
+}
+
+func (_c *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call) Return(_a0 error) *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFlavorDeleted provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) MarkFlavorDeleted(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFlavorDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_MarkFlavorDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFlavorDeleted'
+type MockChunkRepository_MarkFlavorDeleted_Call struct {
+	*mock.Call
+}
+
+// MarkFlavorDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) MarkFlavorDeleted(ctx interface{}, id interface{}) *MockChunkRepository_MarkFlavorDeleted_Call {
+	return &MockChunkRepository_MarkFlavorDeleted_Call{Call: _e.mock.On("MarkFlavorDeleted", ctx, id)}
+}
+
+func (_c *MockChunkRepository_MarkFlavorDeleted_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_MarkFlavorDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_MarkFlavorDeleted_Call) Return(_a0 error) *MockChunkRepository_MarkFlavorDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_MarkFlavorDeleted_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_MarkFlavorDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFlavorVersionFilesUploaded provides a mock function with given fields: ctx, flavorVersionID
+func (_m *MockChunkRepository) MarkFlavorVersionFilesUploaded(ctx context.Context, flavorVersionID string) error {
+	ret := _m.Called(ctx, flavorVersionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFlavorVersionFilesUploaded")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, flavorVersionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_MarkFlavorVersionFilesUploaded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFlavorVersionFilesUploaded'
+type MockChunkRepository_MarkFlavorVersionFilesUploaded_Call struct {
+	*mock.Call
+}
+
+// MarkFlavorVersionFilesUploaded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorVersionID string
+func (_e *MockChunkRepository_Expecter) MarkFlavorVersionFilesUploaded(ctx interface{}, flavorVersionID interface{}) *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call {
+	return &MockChunkRepository_MarkFlavorVersionFilesUploaded_Call{Call: _e.mock.On("MarkFlavorVersionFilesUploaded", ctx, flavorVersionID)}
+}
+
+func (_c *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call) Run(run func(ctx context.Context, flavorVersionID string)) *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call) Return(_a0 error) *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFlavorVersionCheckpointProvenance provides a mock function with given fields: ctx, flavorVersionID, nodeID, builtAt
+func (_m *MockChunkRepository) RecordFlavorVersionCheckpointProvenance(ctx context.Context, flavorVersionID string, nodeID string, builtAt time.Time) error {
+	ret := _m.Called(ctx, flavorVersionID, nodeID, builtAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFlavorVersionCheckpointProvenance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, flavorVersionID, nodeID, builtAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_RecordFlavorVersionCheckpointProvenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFlavorVersionCheckpointProvenance'
+type MockChunkRepository_RecordFlavorVersionCheckpointProvenance_Call struct {
+	*mock.Call
+}
+
+// RecordFlavorVersionCheckpointProvenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorVersionID string
+//   - nodeID string
+//   - builtAt time.Time
+func (_e *MockChunkRepository_Expecter) RecordFlavorVersionCheckpointProvenance(ctx interface{}, flavorVersionID interface{}, nodeID interface{}, builtAt interface{}) *MockChunkRepository_RecordFlavorVersionCheckpointProvenance_Call {
+	return &MockChunkRepository_RecordFlavorVersionCheckpointProvenance_Call{Call: _e.mock.On("RecordFlavorVersionCheckpointProvenance", ctx, flavorVersionID, nodeID, builtAt)}
+}
+
+func (_c *MockChunkRepository_RecordFlavorVersionCheckpointProvenance_Call) Run(run func(ctx context.Context, flavorVersionID string, nodeID string, builtAt time.Time)) *MockChunkRepository_RecordFlavorVersionCheckpointProvenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_RecordFlavorVersionCheckpointProvenance_Call) Return(_a0 error) *MockChunkRepository_RecordFlavorVersionCheckpointProvenance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_RecordFlavorVersionCheckpointProvenance_Call) RunAndReturn(run func(context.Context, string, string, time.Time) error) *MockChunkRepository_RecordFlavorVersionCheckpointProvenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFlavorVersionImageProvenance provides a mock function with given fields: ctx, flavorVersionID, prov
+func (_m *MockChunkRepository) RecordFlavorVersionImageProvenance(ctx context.Context, flavorVersionID string, prov resource.BuildProvenance) error {
+	ret := _m.Called(ctx, flavorVersionID, prov)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFlavorVersionImageProvenance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.BuildProvenance) error); ok {
+		r0 = rf(ctx, flavorVersionID, prov)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_RecordFlavorVersionImageProvenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFlavorVersionImageProvenance'
+type MockChunkRepository_RecordFlavorVersionImageProvenance_Call struct {
+	*mock.Call
+}
+
+// RecordFlavorVersionImageProvenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorVersionID string
+//   - prov resource.BuildProvenance
+func (_e *MockChunkRepository_Expecter) RecordFlavorVersionImageProvenance(ctx interface{}, flavorVersionID interface{}, prov interface{}) *MockChunkRepository_RecordFlavorVersionImageProvenance_Call {
+	return &MockChunkRepository_RecordFlavorVersionImageProvenance_Call{Call: _e.mock.On("RecordFlavorVersionImageProvenance", ctx, flavorVersionID, prov)}
+}
+
+func (_c *MockChunkRepository_RecordFlavorVersionImageProvenance_Call) Run(run func(ctx context.Context, flavorVersionID string, prov resource.BuildProvenance)) *MockChunkRepository_RecordFlavorVersionImageProvenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.BuildProvenance))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_RecordFlavorVersionImageProvenance_Call) Return(_a0 error) *MockChunkRepository_RecordFlavorVersionImageProvenance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_RecordFlavorVersionImageProvenance_Call) RunAndReturn(run func(context.Context, string, resource.BuildProvenance) error) *MockChunkRepository_RecordFlavorVersionImageProvenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectFlavorVersionBuild provides a mock function with given fields: ctx, flavorVersionID, status, reason
+func (_m *MockChunkRepository) RejectFlavorVersionBuild(ctx context.Context, flavorVersionID string, status resource.FlavorVersionBuildStatus, reason string) error {
+	ret := _m.Called(ctx, flavorVersionID, status, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectFlavorVersionBuild")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.FlavorVersionBuildStatus, string) error); ok {
+		r0 = rf(ctx, flavorVersionID, status, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_RejectFlavorVersionBuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectFlavorVersionBuild'
+type MockChunkRepository_RejectFlavorVersionBuild_Call struct {
+	*mock.Call
+}
+
+// RejectFlavorVersionBuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorVersionID string
+//   - status resource.FlavorVersionBuildStatus
+//   - reason string
+func (_e *MockChunkRepository_Expecter) RejectFlavorVersionBuild(ctx interface{}, flavorVersionID interface{}, status interface{}, reason interface{}) *MockChunkRepository_RejectFlavorVersionBuild_Call {
+	return &MockChunkRepository_RejectFlavorVersionBuild_Call{Call: _e.mock.On("RejectFlavorVersionBuild", ctx, flavorVersionID, status, reason)}
+}
+
+func (_c *MockChunkRepository_RejectFlavorVersionBuild_Call) Run(run func(ctx context.Context, flavorVersionID string, status resource.FlavorVersionBuildStatus, reason string)) *MockChunkRepository_RejectFlavorVersionBuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.FlavorVersionBuildStatus), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_RejectFlavorVersionBuild_Call) Return(_a0 error) *MockChunkRepository_RejectFlavorVersionBuild_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_RejectFlavorVersionBuild_Call) RunAndReturn(run func(context.Context, string, resource.FlavorVersionBuildStatus, string) error) *MockChunkRepository_RejectFlavorVersionBuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestCrossInstanceTraffic provides a mock function with given fields: ctx, chunkID, reason, requestedBy
+func (_m *MockChunkRepository) RequestCrossInstanceTraffic(ctx context.Context, chunkID string, reason string, requestedBy string) (resource.CrossInstanceTraffic, error) {
+	ret := _m.Called(ctx, chunkID, reason, requestedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestCrossInstanceTraffic")
+	}
+
+	var r0 resource.CrossInstanceTraffic
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (resource.CrossInstanceTraffic, error)); ok {
+		return rf(ctx, chunkID, reason, requestedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) resource.CrossInstanceTraffic); ok {
+		r0 = rf(ctx, chunkID, reason, requestedBy)
+	} else {
+		r0 = ret.Get(0).(resource.CrossInstanceTraffic)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, chunkID, reason, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_RequestCrossInstanceTraffic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestCrossInstanceTraffic'
+type MockChunkRepository_RequestCrossInstanceTraffic_Call struct {
+	*mock.Call
+}
+
+// RequestCrossInstanceTraffic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chunkID string
+//   - reason string
+//   - requestedBy string
+func (_e *MockChunkRepository_Expecter) RequestCrossInstanceTraffic(ctx interface{}, chunkID interface{}, reason interface{}, requestedBy interface{}) *MockChunkRepository_RequestCrossInstanceTraffic_Call {
+	return &MockChunkRepository_RequestCrossInstanceTraffic_Call{Call: _e.mock.On("RequestCrossInstanceTraffic", ctx, chunkID, reason, requestedBy)}
+}
+
+func (_c *MockChunkRepository_RequestCrossInstanceTraffic_Call) Run(run func(ctx context.Context, chunkID string, reason string, requestedBy string)) *MockChunkRepository_RequestCrossInstanceTraffic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_RequestCrossInstanceTraffic_Call) Return(_a0 resource.CrossInstanceTraffic, _a1 error) *MockChunkRepository_RequestCrossInstanceTraffic_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_RequestCrossInstanceTraffic_Call) RunAndReturn(run func(context.Context, string, string, string) (resource.CrossInstanceTraffic, error)) *MockChunkRepository_RequestCrossInstanceTraffic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetentionPolicyByChunkID provides a mock function with given fields: ctx, chunkID
+func (_m *MockChunkRepository) RetentionPolicyByChunkID(ctx context.Context, chunkID string) (resource.RetentionPolicy, error) {
+	ret := _m.Called(ctx, chunkID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetentionPolicyByChunkID")
+	}
+
+	var r0 resource.RetentionPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.RetentionPolicy, error)); ok {
+		return rf(ctx, chunkID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.RetentionPolicy); ok {
+		r0 = rf(ctx, chunkID)
+	} else {
+		r0 = ret.Get(0).(resource.RetentionPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, chunkID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_RetentionPolicyByChunkID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetentionPolicyByChunkID'
+type MockChunkRepository_RetentionPolicyByChunkID_Call struct {
+	*mock.Call
+}
+
+// RetentionPolicyByChunkID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chunkID string
+func (_e *MockChunkRepository_Expecter) RetentionPolicyByChunkID(ctx interface{}, chunkID interface{}) *MockChunkRepository_RetentionPolicyByChunkID_Call {
+	return &MockChunkRepository_RetentionPolicyByChunkID_Call{Call: _e.mock.On("RetentionPolicyByChunkID", ctx, chunkID)}
+}
+
+func (_c *MockChunkRepository_RetentionPolicyByChunkID_Call) Run(run func(ctx context.Context, chunkID string)) *MockChunkRepository_RetentionPolicyByChunkID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_RetentionPolicyByChunkID_Call) Return(_a0 resource.RetentionPolicy, _a1 error) *MockChunkRepository_RetentionPolicyByChunkID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_RetentionPolicyByChunkID_Call) RunAndReturn(run func(context.Context, string) (resource.RetentionPolicy, error)) *MockChunkRepository_RetentionPolicyByChunkID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReviewCrossInstanceTraffic provides a mock function with given fields: ctx, chunkID, state, reviewedBy, message
+func (_m *MockChunkRepository) ReviewCrossInstanceTraffic(ctx context.Context, chunkID string, state resource.CrossInstanceTrafficState, reviewedBy string, message string) (resource.CrossInstanceTraffic, error) {
+	ret := _m.Called(ctx, chunkID, state, reviewedBy, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReviewCrossInstanceTraffic")
+	}
+
+	var r0 resource.CrossInstanceTraffic
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.CrossInstanceTrafficState, string, string) (resource.CrossInstanceTraffic, error)); ok {
+		return rf(ctx, chunkID, state, reviewedBy, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.CrossInstanceTrafficState, string, string) resource.CrossInstanceTraffic); ok {
+		r0 = rf(ctx, chunkID, state, reviewedBy, message)
+	} else {
+		r0 = ret.Get(0).(resource.CrossInstanceTraffic)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, resource.CrossInstanceTrafficState, string, string) error); ok {
+		r1 = rf(ctx, chunkID, state, reviewedBy, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_ReviewCrossInstanceTraffic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReviewCrossInstanceTraffic'
+type MockChunkRepository_ReviewCrossInstanceTraffic_Call struct {
+	*mock.Call
+}
+
+// ReviewCrossInstanceTraffic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chunkID string
+//   - state resource.CrossInstanceTrafficState
+//   - reviewedBy string
+//   - message string
+func (_e *MockChunkRepository_Expecter) ReviewCrossInstanceTraffic(ctx interface{}, chunkID interface{}, state interface{}, reviewedBy interface{}, message interface{}) *MockChunkRepository_ReviewCrossInstanceTraffic_Call {
+	return &MockChunkRepository_ReviewCrossInstanceTraffic_Call{Call: _e.mock.On("ReviewCrossInstanceTraffic", ctx, chunkID, state, reviewedBy, message)}
+}
+
+func (_c *MockChunkRepository_ReviewCrossInstanceTraffic_Call) Run(run func(ctx context.Context, chunkID string, state resource.CrossInstanceTrafficState, reviewedBy string, message string)) *MockChunkRepository_ReviewCrossInstanceTraffic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.CrossInstanceTrafficState), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_ReviewCrossInstanceTraffic_Call) Return(_a0 resource.CrossInstanceTraffic, _a1 error) *MockChunkRepository_ReviewCrossInstanceTraffic_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_ReviewCrossInstanceTraffic_Call) RunAndReturn(run func(context.Context, string, resource.CrossInstanceTrafficState, string, string) (resource.CrossInstanceTraffic, error)) *MockChunkRepository_ReviewCrossInstanceTraffic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartChunkImport provides a mock function with given fields: ctx, id
+func (_m *MockChunkRepository) StartChunkImport(ctx context.Context, id string) (resource.ChunkImport, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartChunkImport")
+	}
+
+	var r0 resource.ChunkImport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.ChunkImport, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.ChunkImport); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(resource.ChunkImport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_StartChunkImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartChunkImport'
+type MockChunkRepository_StartChunkImport_Call struct {
+	*mock.Call
+}
+
+// StartChunkImport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockChunkRepository_Expecter) StartChunkImport(ctx interface{}, id interface{}) *MockChunkRepository_StartChunkImport_Call {
+	return &MockChunkRepository_StartChunkImport_Call{Call: _e.mock.On("StartChunkImport", ctx, id)}
+}
+
+func (_c *MockChunkRepository_StartChunkImport_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_StartChunkImport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_StartChunkImport_Call) Return(_a0 resource.ChunkImport, _a1 error) *MockChunkRepository_StartChunkImport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_StartChunkImport_Call) RunAndReturn(run func(context.Context, string) (resource.ChunkImport, error)) *MockChunkRepository_StartChunkImport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SupportedMinecraftVersions provides a mock function with given fields: ctx
+func (_m *MockChunkRepository) SupportedMinecraftVersions(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SupportedMinecraftVersions")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -733,56 +3263,55 @@ func (_m *MockChunkRepository) GetChunkByID(ctx context.Context, id string) (res
 	return r0, r1
 }
 
-// MockChunkRepository_GetChunkByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChunkByID'
-type MockChunkRepository_GetChunkByID_Call struct {
+// MockChunkRepository_SupportedMinecraftVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SupportedMinecraftVersions'
+type MockChunkRepository_SupportedMinecraftVersions_Call struct {
 	*mock.Call
 }
 
-// GetChunkByID is a helper method to define mock.On call
+// SupportedMinecraftVersions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockChunkRepository_Expecter) GetChunkByID(ctx interface{}, id interface{}) *MockChunkRepository_GetChunkByID_Call {
-	return &MockChunkRepository_GetChunkByID_Call{Call: _e.mock.On("GetChunkByID", ctx, id)}
+func (_e *MockChunkRepository_Expecter) SupportedMinecraftVersions(ctx interface{}) *MockChunkRepository_SupportedMinecraftVersions_Call {
+	return &MockChunkRepository_SupportedMinecraftVersions_Call{Call: _e.mock.On("SupportedMinecraftVersions", ctx)}
 }
 
-func (_c *MockChunkRepository_GetChunkByID_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_GetChunkByID_Call {
+func (_c *MockChunkRepository_SupportedMinecraftVersions_Call) Run(run func(ctx context.Context)) *MockChunkRepository_SupportedMinecraftVersions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_GetChunkByID_Call) Return(_a0 resource.Chunk, _a1 error) *MockChunkRepository_GetChunkByID_Call {
+func (_c *MockChunkRepository_SupportedMinecraftVersions_Call) Return(_a0 []string, _a1 error) *MockChunkRepository_SupportedMinecraftVersions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_GetChunkByID_Call) RunAndReturn(run func(context.Context, string) (resource.Chunk, error)) *MockChunkRepository_GetChunkByID_Call {
+func (_c *MockChunkRepository_SupportedMinecraftVersions_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockChunkRepository_SupportedMinecraftVersions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMinecraftVersionByVersion provides a mock function with given fields: _a0, _a1
-func (_m *MockChunkRepository) GetMinecraftVersionByVersion(_a0 context.Context, _a1 string) (resource.MinecraftVersion, error) {
-	ret := _m.Called(_a0, _a1)
+// UpdateActiveCanaryState provides a mock function with given fields: ctx, flavorID, state
+func (_m *MockChunkRepository) UpdateActiveCanaryState(ctx context.Context, flavorID string, state resource.CanaryState) (resource.Canary, error) {
+	ret := _m.Called(ctx, flavorID, state)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMinecraftVersionByVersion")
+		panic("no return value specified for UpdateActiveCanaryState")
 	}
 
-	var r0 resource.MinecraftVersion
+	var r0 resource.Canary
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.MinecraftVersion, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.CanaryState) (resource.Canary, error)); ok {
+		return rf(ctx, flavorID, state)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) resource.MinecraftVersion); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.CanaryState) resource.Canary); ok {
+		r0 = rf(ctx, flavorID, state)
 	} else {
-		r0 = ret.Get(0).(resource.MinecraftVersion)
+		r0 = ret.Get(0).(resource.Canary)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, resource.CanaryState) error); ok {
+		r1 = rf(ctx, flavorID, state)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -790,56 +3319,57 @@ func (_m *MockChunkRepository) GetMinecraftVersionByVersion(_a0 context.Context,
 	return r0, r1
 }
 
-// MockChunkRepository_GetMinecraftVersionByVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMinecraftVersionByVersion'
-type MockChunkRepository_GetMinecraftVersionByVersion_Call struct {
+// MockChunkRepository_UpdateActiveCanaryState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateActiveCanaryState'
+type MockChunkRepository_UpdateActiveCanaryState_Call struct {
 	*mock.Call
 }
 
-// GetMinecraftVersionByVersion is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 string
-func (_e *MockChunkRepository_Expecter) GetMinecraftVersionByVersion(_a0 interface{}, _a1 interface{}) *MockChunkRepository_GetMinecraftVersionByVersion_Call {
-	return &MockChunkRepository_GetMinecraftVersionByVersion_Call{Call: _e.mock.On("GetMinecraftVersionByVersion", _a0, _a1)}
+// UpdateActiveCanaryState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorID string
+//   - state resource.CanaryState
+func (_e *MockChunkRepository_Expecter) UpdateActiveCanaryState(ctx interface{}, flavorID interface{}, state interface{}) *MockChunkRepository_UpdateActiveCanaryState_Call {
+	return &MockChunkRepository_UpdateActiveCanaryState_Call{Call: _e.mock.On("UpdateActiveCanaryState", ctx, flavorID, state)}
 }
 
-func (_c *MockChunkRepository_GetMinecraftVersionByVersion_Call) Run(run func(_a0 context.Context, _a1 string)) *MockChunkRepository_GetMinecraftVersionByVersion_Call {
+func (_c *MockChunkRepository_UpdateActiveCanaryState_Call) Run(run func(ctx context.Context, flavorID string, state resource.CanaryState)) *MockChunkRepository_UpdateActiveCanaryState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.CanaryState))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_GetMinecraftVersionByVersion_Call) Return(_a0 resource.MinecraftVersion, _a1 error) *MockChunkRepository_GetMinecraftVersionByVersion_Call {
+func (_c *MockChunkRepository_UpdateActiveCanaryState_Call) Return(_a0 resource.Canary, _a1 error) *MockChunkRepository_UpdateActiveCanaryState_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_GetMinecraftVersionByVersion_Call) RunAndReturn(run func(context.Context, string) (resource.MinecraftVersion, error)) *MockChunkRepository_GetMinecraftVersionByVersion_Call {
+func (_c *MockChunkRepository_UpdateActiveCanaryState_Call) RunAndReturn(run func(context.Context, string, resource.CanaryState) (resource.Canary, error)) *MockChunkRepository_UpdateActiveCanaryState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LatestFlavorVersion provides a mock function with given fields: ctx, flavorID
-func (_m *MockChunkRepository) LatestFlavorVersion(ctx context.Context, flavorID string) (resource.FlavorVersion, error) {
-	ret := _m.Called(ctx, flavorID)
+// UpdateChunk provides a mock function with given fields: ctx, _a1
+func (_m *MockChunkRepository) UpdateChunk(ctx context.Context, _a1 resource.Chunk) (resource.Chunk, error) {
+	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LatestFlavorVersion")
+		panic("no return value specified for UpdateChunk")
 	}
 
-	var r0 resource.FlavorVersion
+	var r0 resource.Chunk
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.FlavorVersion, error)); ok {
-		return rf(ctx, flavorID)
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Chunk) (resource.Chunk, error)); ok {
+		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) resource.FlavorVersion); ok {
-		r0 = rf(ctx, flavorID)
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Chunk) resource.Chunk); ok {
+		r0 = rf(ctx, _a1)
 	} else {
-		r0 = ret.Get(0).(resource.FlavorVersion)
+		r0 = ret.Get(0).(resource.Chunk)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, flavorID)
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Chunk) error); ok {
+		r1 = rf(ctx, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -847,106 +3377,296 @@ func (_m *MockChunkRepository) LatestFlavorVersion(ctx context.Context, flavorID
 	return r0, r1
 }
 
-// MockChunkRepository_LatestFlavorVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestFlavorVersion'
-type MockChunkRepository_LatestFlavorVersion_Call struct {
+// MockChunkRepository_UpdateChunk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChunk'
+type MockChunkRepository_UpdateChunk_Call struct {
 	*mock.Call
 }
 
-// LatestFlavorVersion is a helper method to define mock.On call
+// UpdateChunk is a helper method to define mock.On call
 //   - ctx context.Context
-//   - flavorID string
-func (_e *MockChunkRepository_Expecter) LatestFlavorVersion(ctx interface{}, flavorID interface{}) *MockChunkRepository_LatestFlavorVersion_Call {
-	return &MockChunkRepository_LatestFlavorVersion_Call{Call: _e.mock.On("LatestFlavorVersion", ctx, flavorID)}
+//   - _a1 resource.Chunk
+func (_e *MockChunkRepository_Expecter) UpdateChunk(ctx interface{}, _a1 interface{}) *MockChunkRepository_UpdateChunk_Call {
+	return &MockChunkRepository_UpdateChunk_Call{Call: _e.mock.On("UpdateChunk", ctx, _a1)}
 }
 
-func (_c *MockChunkRepository_LatestFlavorVersion_Call) Run(run func(ctx context.Context, flavorID string)) *MockChunkRepository_LatestFlavorVersion_Call {
+func (_c *MockChunkRepository_UpdateChunk_Call) Run(run func(ctx context.Context, _a1 resource.Chunk)) *MockChunkRepository_UpdateChunk_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(resource.Chunk))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_LatestFlavorVersion_Call) Return(_a0 resource.FlavorVersion, _a1 error) *MockChunkRepository_LatestFlavorVersion_Call {
+func (_c *MockChunkRepository_UpdateChunk_Call) Return(_a0 resource.Chunk, _a1 error) *MockChunkRepository_UpdateChunk_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_LatestFlavorVersion_Call) RunAndReturn(run func(context.Context, string) (resource.FlavorVersion, error)) *MockChunkRepository_LatestFlavorVersion_Call {
+func (_c *MockChunkRepository_UpdateChunk_Call) RunAndReturn(run func(context.Context, resource.Chunk) (resource.Chunk, error)) *MockChunkRepository_UpdateChunk_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListChunks provides a mock function with given fields: ctx, pageSize, afterID
-func (_m *MockChunkRepository) ListChunks(ctx context.Context, pageSize int, afterID *string) ([]resource.Chunk, error) {
-	ret := _m.Called(ctx, pageSize, afterID)
+// UpdateChunkImportFlavorVersion provides a mock function with given fields: ctx, id, flavorVersionID
+func (_m *MockChunkRepository) UpdateChunkImportFlavorVersion(ctx context.Context, id string, flavorVersionID string) error {
+	ret := _m.Called(ctx, id, flavorVersionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListChunks")
+		panic("no return value specified for UpdateChunkImportFlavorVersion")
 	}
 
-	var r0 []resource.Chunk
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, flavorVersionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_UpdateChunkImportFlavorVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChunkImportFlavorVersion'
+type MockChunkRepository_UpdateChunkImportFlavorVersion_Call struct {
+	*mock.Call
+}
+
+// UpdateChunkImportFlavorVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - flavorVersionID string
+func (_e *MockChunkRepository_Expecter) UpdateChunkImportFlavorVersion(ctx interface{}, id interface{}, flavorVersionID interface{}) *MockChunkRepository_UpdateChunkImportFlavorVersion_Call {
+	return &MockChunkRepository_UpdateChunkImportFlavorVersion_Call{Call: _e.mock.On("UpdateChunkImportFlavorVersion", ctx, id, flavorVersionID)}
+}
+
+func (_c *MockChunkRepository_UpdateChunkImportFlavorVersion_Call) Run(run func(ctx context.Context, id string, flavorVersionID string)) *MockChunkRepository_UpdateChunkImportFlavorVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_UpdateChunkImportFlavorVersion_Call) Return(_a0 error) *MockChunkRepository_UpdateChunkImportFlavorVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_UpdateChunkImportFlavorVersion_Call) RunAndReturn(run func(context.Context, string, string) error) *MockChunkRepository_UpdateChunkImportFlavorVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateChunkImportState provides a mock function with given fields: ctx, id, state, reason
+func (_m *MockChunkRepository) UpdateChunkImportState(ctx context.Context, id string, state resource.ChunkImportState, reason string) error {
+	ret := _m.Called(ctx, id, state, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChunkImportState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.ChunkImportState, string) error); ok {
+		r0 = rf(ctx, id, state, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_UpdateChunkImportState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChunkImportState'
+type MockChunkRepository_UpdateChunkImportState_Call struct {
+	*mock.Call
+}
+
+// UpdateChunkImportState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - state resource.ChunkImportState
+//   - reason string
+func (_e *MockChunkRepository_Expecter) UpdateChunkImportState(ctx interface{}, id interface{}, state interface{}, reason interface{}) *MockChunkRepository_UpdateChunkImportState_Call {
+	return &MockChunkRepository_UpdateChunkImportState_Call{Call: _e.mock.On("UpdateChunkImportState", ctx, id, state, reason)}
+}
+
+func (_c *MockChunkRepository_UpdateChunkImportState_Call) Run(run func(ctx context.Context, id string, state resource.ChunkImportState, reason string)) *MockChunkRepository_UpdateChunkImportState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.ChunkImportState), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_UpdateChunkImportState_Call) Return(_a0 error) *MockChunkRepository_UpdateChunkImportState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_UpdateChunkImportState_Call) RunAndReturn(run func(context.Context, string, resource.ChunkImportState, string) error) *MockChunkRepository_UpdateChunkImportState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateChunkTemplate provides a mock function with given fields: ctx, tmpl
+func (_m *MockChunkRepository) UpdateChunkTemplate(ctx context.Context, tmpl resource.ChunkTemplate) (resource.ChunkTemplate, error) {
+	ret := _m.Called(ctx, tmpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChunkTemplate")
+	}
+
+	var r0 resource.ChunkTemplate
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, *string) ([]resource.Chunk, error)); ok {
-		return rf(ctx, pageSize, afterID)
+	if rf, ok := ret.Get(0).(func(context.Context, resource.ChunkTemplate) (resource.ChunkTemplate, error)); ok {
+		return rf(ctx, tmpl)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, *string) []resource.Chunk); ok {
-		r0 = rf(ctx, pageSize, afterID)
+	if rf, ok := ret.Get(0).(func(context.Context, resource.ChunkTemplate) resource.ChunkTemplate); ok {
+		r0 = rf(ctx, tmpl)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]resource.Chunk)
-		}
+		r0 = ret.Get(0).(resource.ChunkTemplate)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, *string) error); ok {
-		r1 = rf(ctx, pageSize, afterID)
+	if rf, ok := ret.Get(1).(func(context.Context, resource.ChunkTemplate) error); ok {
+		r1 = rf(ctx, tmpl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChunkRepository_UpdateChunkTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChunkTemplate'
+type MockChunkRepository_UpdateChunkTemplate_Call struct {
+	*mock.Call
+}
+
+// UpdateChunkTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tmpl resource.ChunkTemplate
+func (_e *MockChunkRepository_Expecter) UpdateChunkTemplate(ctx interface{}, tmpl interface{}) *MockChunkRepository_UpdateChunkTemplate_Call {
+	return &MockChunkRepository_UpdateChunkTemplate_Call{Call: _e.mock.On("UpdateChunkTemplate", ctx, tmpl)}
+}
+
+func (_c *MockChunkRepository_UpdateChunkTemplate_Call) Run(run func(ctx context.Context, tmpl resource.ChunkTemplate)) *MockChunkRepository_UpdateChunkTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.ChunkTemplate))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_UpdateChunkTemplate_Call) Return(_a0 resource.ChunkTemplate, _a1 error) *MockChunkRepository_UpdateChunkTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChunkRepository_UpdateChunkTemplate_Call) RunAndReturn(run func(context.Context, resource.ChunkTemplate) (resource.ChunkTemplate, error)) *MockChunkRepository_UpdateChunkTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFlavorVersionBuildProgress provides a mock function with given fields: ctx, flavorVersionID, percent
+func (_m *MockChunkRepository) UpdateFlavorVersionBuildProgress(ctx context.Context, flavorVersionID string, percent uint32) error {
+	ret := _m.Called(ctx, flavorVersionID, percent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFlavorVersionBuildProgress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint32) error); ok {
+		r0 = rf(ctx, flavorVersionID, percent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChunkRepository_UpdateFlavorVersionBuildProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlavorVersionBuildProgress'
+type MockChunkRepository_UpdateFlavorVersionBuildProgress_Call struct {
+	*mock.Call
+}
+
+// UpdateFlavorVersionBuildProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorVersionID string
+//   - percent uint32
+func (_e *MockChunkRepository_Expecter) UpdateFlavorVersionBuildProgress(ctx interface{}, flavorVersionID interface{}, percent interface{}) *MockChunkRepository_UpdateFlavorVersionBuildProgress_Call {
+	return &MockChunkRepository_UpdateFlavorVersionBuildProgress_Call{Call: _e.mock.On("UpdateFlavorVersionBuildProgress", ctx, flavorVersionID, percent)}
+}
+
+func (_c *MockChunkRepository_UpdateFlavorVersionBuildProgress_Call) Run(run func(ctx context.Context, flavorVersionID string, percent uint32)) *MockChunkRepository_UpdateFlavorVersionBuildProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint32))
+	})
+	return _c
+}
+
+func (_c *MockChunkRepository_UpdateFlavorVersionBuildProgress_Call) Return(_a0 error) *MockChunkRepository_UpdateFlavorVersionBuildProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChunkRepository_UpdateFlavorVersionBuildProgress_Call) RunAndReturn(run func(context.Context, string, uint32) error) *MockChunkRepository_UpdateFlavorVersionBuildProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFlavorVersionBuildStatus provides a mock function with given fields: ctx, flavorVersionID, status
+func (_m *MockChunkRepository) UpdateFlavorVersionBuildStatus(ctx context.Context, flavorVersionID string, status resource.FlavorVersionBuildStatus) error {
+	ret := _m.Called(ctx, flavorVersionID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFlavorVersionBuildStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.FlavorVersionBuildStatus) error); ok {
+		r0 = rf(ctx, flavorVersionID, status)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockChunkRepository_ListChunks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChunks'
-type MockChunkRepository_ListChunks_Call struct {
+// MockChunkRepository_UpdateFlavorVersionBuildStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlavorVersionBuildStatus'
+type MockChunkRepository_UpdateFlavorVersionBuildStatus_Call struct {
 	*mock.Call
 }
 
-// ListChunks is a helper method to define mock.On call
+// UpdateFlavorVersionBuildStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pageSize int
-//   - afterID *string
-func (_e *MockChunkRepository_Expecter) ListChunks(ctx interface{}, pageSize interface{}, afterID interface{}) *MockChunkRepository_ListChunks_Call {
-	return &MockChunkRepository_ListChunks_Call{Call: _e.mock.On("ListChunks", ctx, pageSize, afterID)}
+//   - flavorVersionID string
+//   - status resource.FlavorVersionBuildStatus
+func (_e *MockChunkRepository_Expecter) UpdateFlavorVersionBuildStatus(ctx interface{}, flavorVersionID interface{}, status interface{}) *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call {
+	return &MockChunkRepository_UpdateFlavorVersionBuildStatus_Call{Call: _e.mock.On("UpdateFlavorVersionBuildStatus", ctx, flavorVersionID, status)}
 }
 
-func (_c *MockChunkRepository_ListChunks_Call) Run(run func(ctx context.Context, pageSize int, afterID *string)) *MockChunkRepository_ListChunks_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call) Run(run func(ctx context.Context, flavorVersionID string, status resource.FlavorVersionBuildStatus)) *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int), args[2].(*string))
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.FlavorVersionBuildStatus))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_ListChunks_Call) Return(_a0 []resource.Chunk, _a1 error) *MockChunkRepository_ListChunks_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call) Return(_a0 error) *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockChunkRepository_ListChunks_Call) RunAndReturn(run func(context.Context, int, *string) ([]resource.Chunk, error)) *MockChunkRepository_ListChunks_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call) RunAndReturn(run func(context.Context, string, resource.FlavorVersionBuildStatus) error) *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkChunkAndFlavorsDeleted provides a mock function with given fields: ctx, id
-func (_m *MockChunkRepository) MarkChunkAndFlavorsDeleted(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// UpdateFlavorVersionCheckpointResources provides a mock function with given fields: ctx, flavorVersionID, res
+func (_m *MockChunkRepository) UpdateFlavorVersionCheckpointResources(ctx context.Context, flavorVersionID string, res *resource.CheckpointResources) error {
+	ret := _m.Called(ctx, flavorVersionID, res)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkChunkAndFlavorsDeleted")
+		panic("no return value specified for UpdateFlavorVersionCheckpointResources")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *resource.CheckpointResources) error); ok {
+		r0 = rf(ctx, flavorVersionID, res)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -954,46 +3674,47 @@ func (_m *MockChunkRepository) MarkChunkAndFlavorsDeleted(ctx context.Context, i
 	return r0
 }
 
-// MockChunkRepository_MarkChunkAndFlavorsDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkChunkAndFlavorsDeleted'
-type MockChunkRepository_MarkChunkAndFlavorsDeleted_Call struct {
+// MockChunkRepository_UpdateFlavorVersionCheckpointResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlavorVersionCheckpointResources'
+type MockChunkRepository_UpdateFlavorVersionCheckpointResources_Call struct {
 	*mock.Call
 }
 
-// MarkChunkAndFlavorsDeleted is a helper method to define mock.On call
+// UpdateFlavorVersionCheckpointResources is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockChunkRepository_Expecter) MarkChunkAndFlavorsDeleted(ctx interface{}, id interface{}) *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call {
-	return &MockChunkRepository_MarkChunkAndFlavorsDeleted_Call{Call: _e.mock.On("MarkChunkAndFlavorsDeleted", ctx, id)}
+//   - flavorVersionID string
+//   - res *resource.CheckpointResources
+func (_e *MockChunkRepository_Expecter) UpdateFlavorVersionCheckpointResources(ctx interface{}, flavorVersionID interface{}, res interface{}) *MockChunkRepository_UpdateFlavorVersionCheckpointResources_Call {
+	return &MockChunkRepository_UpdateFlavorVersionCheckpointResources_Call{Call: _e.mock.On("UpdateFlavorVersionCheckpointResources", ctx, flavorVersionID, res)}
 }
 
-func (_c *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionCheckpointResources_Call) Run(run func(ctx context.Context, flavorVersionID string, res *resource.CheckpointResources)) *MockChunkRepository_UpdateFlavorVersionCheckpointResources_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(*resource.CheckpointResources))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call) Return(_a0 error) *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionCheckpointResources_Call) Return(_a0 error) *MockChunkRepository_UpdateFlavorVersionCheckpointResources_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_MarkChunkAndFlavorsDeleted_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionCheckpointResources_Call) RunAndReturn(run func(context.Context, string, *resource.CheckpointResources) error) *MockChunkRepository_UpdateFlavorVersionCheckpointResources_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkFlavorDeleted provides a mock function with given fields: ctx, id
-func (_m *MockChunkRepository) MarkFlavorDeleted(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// UpdateFlavorVersionPresignedURLData provides a mock function with given fields: ctx, flavorVersionID, date, url
+func (_m *MockChunkRepository) UpdateFlavorVersionPresignedURLData(ctx context.Context, flavorVersionID string, date time.Time, url string) error {
+	ret := _m.Called(ctx, flavorVersionID, date, url)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkFlavorDeleted")
+		panic("no return value specified for UpdateFlavorVersionPresignedURLData")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, string) error); ok {
+		r0 = rf(ctx, flavorVersionID, date, url)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1001,46 +3722,48 @@ func (_m *MockChunkRepository) MarkFlavorDeleted(ctx context.Context, id string)
 	return r0
 }
 
-// MockChunkRepository_MarkFlavorDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFlavorDeleted'
-type MockChunkRepository_MarkFlavorDeleted_Call struct {
+// MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlavorVersionPresignedURLData'
+type MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call struct {
 	*mock.Call
 }
 
-// MarkFlavorDeleted is a helper method to define mock.On call
+// UpdateFlavorVersionPresignedURLData is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockChunkRepository_Expecter) MarkFlavorDeleted(ctx interface{}, id interface{}) *MockChunkRepository_MarkFlavorDeleted_Call {
-	return &MockChunkRepository_MarkFlavorDeleted_Call{Call: _e.mock.On("MarkFlavorDeleted", ctx, id)}
+//   - flavorVersionID string
+//   - date time.Time
+//   - url string
+func (_e *MockChunkRepository_Expecter) UpdateFlavorVersionPresignedURLData(ctx interface{}, flavorVersionID interface{}, date interface{}, url interface{}) *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call {
+	return &MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call{Call: _e.mock.On("UpdateFlavorVersionPresignedURLData", ctx, flavorVersionID, date, url)}
 }
 
-func (_c *MockChunkRepository_MarkFlavorDeleted_Call) Run(run func(ctx context.Context, id string)) *MockChunkRepository_MarkFlavorDeleted_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call) Run(run func(ctx context.Context, flavorVersionID string, date time.Time, url string)) *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_MarkFlavorDeleted_Call) Return(_a0 error) *MockChunkRepository_MarkFlavorDeleted_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call) Return(_a0 error) *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockChunkRepository_MarkFlavorDeleted_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_MarkFlavorDeleted_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call) RunAndReturn(run func(context.Context, string, time.Time, string) error) *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkFlavorVersionFilesUploaded provides a mock function with given fields: ctx, flavorVersionID
-func (_m *MockChunkRepository) MarkFlavorVersionFilesUploaded(ctx context.Context, flavorVersionID string) error {
-	ret := _m.Called(ctx, flavorVersionID)
+// UpdateFlavorVersionRestoreLatency provides a mock function with given fields: ctx, flavorVersionID, latency
+func (_m *MockChunkRepository) UpdateFlavorVersionRestoreLatency(ctx context.Context, flavorVersionID string, latency time.Duration) error {
+	ret := _m.Called(ctx, flavorVersionID, latency)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkFlavorVersionFilesUploaded")
+		panic("no return value specified for UpdateFlavorVersionRestoreLatency")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, flavorVersionID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) error); ok {
+		r0 = rf(ctx, flavorVersionID, latency)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1048,114 +3771,105 @@ func (_m *MockChunkRepository) MarkFlavorVersionFilesUploaded(ctx context.Contex
 	return r0
 }
 
-// MockChunkRepository_MarkFlavorVersionFilesUploaded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFlavorVersionFilesUploaded'
-type MockChunkRepository_MarkFlavorVersionFilesUploaded_Call struct {
+// MockChunkRepository_UpdateFlavorVersionRestoreLatency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlavorVersionRestoreLatency'
+type MockChunkRepository_UpdateFlavorVersionRestoreLatency_Call struct {
 	*mock.Call
 }
 
-// MarkFlavorVersionFilesUploaded is a helper method to define mock.On call
+// UpdateFlavorVersionRestoreLatency is a helper method to define mock.On call
 //   - ctx context.Context
 //   - flavorVersionID string
-func (_e *MockChunkRepository_Expecter) MarkFlavorVersionFilesUploaded(ctx interface{}, flavorVersionID interface{}) *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call {
-	return &MockChunkRepository_MarkFlavorVersionFilesUploaded_Call{Call: _e.mock.On("MarkFlavorVersionFilesUploaded", ctx, flavorVersionID)}
+//   - latency time.Duration
+func (_e *MockChunkRepository_Expecter) UpdateFlavorVersionRestoreLatency(ctx interface{}, flavorVersionID interface{}, latency interface{}) *MockChunkRepository_UpdateFlavorVersionRestoreLatency_Call {
+	return &MockChunkRepository_UpdateFlavorVersionRestoreLatency_Call{Call: _e.mock.On("UpdateFlavorVersionRestoreLatency", ctx, flavorVersionID, latency)}
 }
 
-func (_c *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call) Run(run func(ctx context.Context, flavorVersionID string)) *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionRestoreLatency_Call) Run(run func(ctx context.Context, flavorVersionID string, latency time.Duration)) *MockChunkRepository_UpdateFlavorVersionRestoreLatency_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call) Return(_a0 error) *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionRestoreLatency_Call) Return(_a0 error) *MockChunkRepository_UpdateFlavorVersionRestoreLatency_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call) RunAndReturn(run func(context.Context, string) error) *MockChunkRepository_MarkFlavorVersionFilesUploaded_Call {
+func (_c *MockChunkRepository_UpdateFlavorVersionRestoreLatency_Call) RunAndReturn(run func(context.Context, string, time.Duration) error) *MockChunkRepository_UpdateFlavorVersionRestoreLatency_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SupportedMinecraftVersions provides a mock function with given fields: ctx
-func (_m *MockChunkRepository) SupportedMinecraftVersions(ctx context.Context) ([]string, error) {
-	ret := _m.Called(ctx)
+// UpdateThumbnail provides a mock function with given fields: ctx, chunkID, imgHash
+func (_m *MockChunkRepository) UpdateThumbnail(ctx context.Context, chunkID string, imgHash string) error {
+	ret := _m.Called(ctx, chunkID, imgHash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SupportedMinecraftVersions")
-	}
-
-	var r0 []string
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
-		r0 = rf(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		panic("no return value specified for UpdateThumbnail")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, chunkID, imgHash)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockChunkRepository_SupportedMinecraftVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SupportedMinecraftVersions'
-type MockChunkRepository_SupportedMinecraftVersions_Call struct {
+// MockChunkRepository_UpdateThumbnail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateThumbnail'
+type MockChunkRepository_UpdateThumbnail_Call struct {
 	*mock.Call
 }
 
-// SupportedMinecraftVersions is a helper method to define mock.On call
+// UpdateThumbnail is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockChunkRepository_Expecter) SupportedMinecraftVersions(ctx interface{}) *MockChunkRepository_SupportedMinecraftVersions_Call {
-	return &MockChunkRepository_SupportedMinecraftVersions_Call{Call: _e.mock.On("SupportedMinecraftVersions", ctx)}
+//   - chunkID string
+//   - imgHash string
+func (_e *MockChunkRepository_Expecter) UpdateThumbnail(ctx interface{}, chunkID interface{}, imgHash interface{}) *MockChunkRepository_UpdateThumbnail_Call {
+	return &MockChunkRepository_UpdateThumbnail_Call{Call: _e.mock.On("UpdateThumbnail", ctx, chunkID, imgHash)}
 }
 
-func (_c *MockChunkRepository_SupportedMinecraftVersions_Call) Run(run func(ctx context.Context)) *MockChunkRepository_SupportedMinecraftVersions_Call {
+func (_c *MockChunkRepository_UpdateThumbnail_Call) Run(run func(ctx context.Context, chunkID string, imgHash string)) *MockChunkRepository_UpdateThumbnail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_SupportedMinecraftVersions_Call) Return(_a0 []string, _a1 error) *MockChunkRepository_SupportedMinecraftVersions_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockChunkRepository_UpdateThumbnail_Call) Return(_a0 error) *MockChunkRepository_UpdateThumbnail_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockChunkRepository_SupportedMinecraftVersions_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockChunkRepository_SupportedMinecraftVersions_Call {
+func (_c *MockChunkRepository_UpdateThumbnail_Call) RunAndReturn(run func(context.Context, string, string) error) *MockChunkRepository_UpdateThumbnail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateChunk provides a mock function with given fields: ctx, _a1
-func (_m *MockChunkRepository) UpdateChunk(ctx context.Context, _a1 resource.Chunk) (resource.Chunk, error) {
-	ret := _m.Called(ctx, _a1)
+// UpsertAutoscalingPolicy provides a mock function with given fields: ctx, policy
+func (_m *MockChunkRepository) UpsertAutoscalingPolicy(ctx context.Context, policy resource.AutoscalingPolicy) (resource.AutoscalingPolicy, error) {
+	ret := _m.Called(ctx, policy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateChunk")
+		panic("no return value specified for UpsertAutoscalingPolicy")
 	}
 
-	var r0 resource.Chunk
+	var r0 resource.AutoscalingPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Chunk) (resource.Chunk, error)); ok {
-		return rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, resource.AutoscalingPolicy) (resource.AutoscalingPolicy, error)); ok {
+		return rf(ctx, policy)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Chunk) resource.Chunk); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, resource.AutoscalingPolicy) resource.AutoscalingPolicy); ok {
+		r0 = rf(ctx, policy)
 	} else {
-		r0 = ret.Get(0).(resource.Chunk)
+		r0 = ret.Get(0).(resource.AutoscalingPolicy)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, resource.Chunk) error); ok {
-		r1 = rf(ctx, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, resource.AutoscalingPolicy) error); ok {
+		r1 = rf(ctx, policy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1163,176 +3877,202 @@ func (_m *MockChunkRepository) UpdateChunk(ctx context.Context, _a1 resource.Chu
 	return r0, r1
 }
 
-// MockChunkRepository_UpdateChunk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChunk'
-type MockChunkRepository_UpdateChunk_Call struct {
+// MockChunkRepository_UpsertAutoscalingPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertAutoscalingPolicy'
+type MockChunkRepository_UpsertAutoscalingPolicy_Call struct {
 	*mock.Call
 }
 
-// UpdateChunk is a helper method to define mock.On call
+// UpsertAutoscalingPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - _a1 resource.Chunk
-func (_e *MockChunkRepository_Expecter) UpdateChunk(ctx interface{}, _a1 interface{}) *MockChunkRepository_UpdateChunk_Call {
-	return &MockChunkRepository_UpdateChunk_Call{Call: _e.mock.On("UpdateChunk", ctx, _a1)}
+//   - policy resource.AutoscalingPolicy
+func (_e *MockChunkRepository_Expecter) UpsertAutoscalingPolicy(ctx interface{}, policy interface{}) *MockChunkRepository_UpsertAutoscalingPolicy_Call {
+	return &MockChunkRepository_UpsertAutoscalingPolicy_Call{Call: _e.mock.On("UpsertAutoscalingPolicy", ctx, policy)}
 }
 
-func (_c *MockChunkRepository_UpdateChunk_Call) Run(run func(ctx context.Context, _a1 resource.Chunk)) *MockChunkRepository_UpdateChunk_Call {
+func (_c *MockChunkRepository_UpsertAutoscalingPolicy_Call) Run(run func(ctx context.Context, policy resource.AutoscalingPolicy)) *MockChunkRepository_UpsertAutoscalingPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(resource.Chunk))
+		run(args[0].(context.Context), args[1].(resource.AutoscalingPolicy))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_UpdateChunk_Call) Return(_a0 resource.Chunk, _a1 error) *MockChunkRepository_UpdateChunk_Call {
+func (_c *MockChunkRepository_UpsertAutoscalingPolicy_Call) Return(_a0 resource.AutoscalingPolicy, _a1 error) *MockChunkRepository_UpsertAutoscalingPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_UpdateChunk_Call) RunAndReturn(run func(context.Context, resource.Chunk) (resource.Chunk, error)) *MockChunkRepository_UpdateChunk_Call {
+func (_c *MockChunkRepository_UpsertAutoscalingPolicy_Call) RunAndReturn(run func(context.Context, resource.AutoscalingPolicy) (resource.AutoscalingPolicy, error)) *MockChunkRepository_UpsertAutoscalingPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateFlavorVersionBuildStatus provides a mock function with given fields: ctx, flavorVersionID, status
-func (_m *MockChunkRepository) UpdateFlavorVersionBuildStatus(ctx context.Context, flavorVersionID string, status resource.FlavorVersionBuildStatus) error {
-	ret := _m.Called(ctx, flavorVersionID, status)
+// UpsertChunkReadme provides a mock function with given fields: ctx, readme
+func (_m *MockChunkRepository) UpsertChunkReadme(ctx context.Context, readme resource.ChunkReadme) (resource.ChunkReadme, error) {
+	ret := _m.Called(ctx, readme)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateFlavorVersionBuildStatus")
+		panic("no return value specified for UpsertChunkReadme")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, resource.FlavorVersionBuildStatus) error); ok {
-		r0 = rf(ctx, flavorVersionID, status)
+	var r0 resource.ChunkReadme
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.ChunkReadme) (resource.ChunkReadme, error)); ok {
+		return rf(ctx, readme)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.ChunkReadme) resource.ChunkReadme); ok {
+		r0 = rf(ctx, readme)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(resource.ChunkReadme)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, resource.ChunkReadme) error); ok {
+		r1 = rf(ctx, readme)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockChunkRepository_UpdateFlavorVersionBuildStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlavorVersionBuildStatus'
-type MockChunkRepository_UpdateFlavorVersionBuildStatus_Call struct {
+// MockChunkRepository_UpsertChunkReadme_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertChunkReadme'
+type MockChunkRepository_UpsertChunkReadme_Call struct {
 	*mock.Call
 }
 
-// UpdateFlavorVersionBuildStatus is a helper method to define mock.On call
+// UpsertChunkReadme is a helper method to define mock.On call
 //   - ctx context.Context
-//   - flavorVersionID string
-//   - status resource.FlavorVersionBuildStatus
-func (_e *MockChunkRepository_Expecter) UpdateFlavorVersionBuildStatus(ctx interface{}, flavorVersionID interface{}, status interface{}) *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call {
-	return &MockChunkRepository_UpdateFlavorVersionBuildStatus_Call{Call: _e.mock.On("UpdateFlavorVersionBuildStatus", ctx, flavorVersionID, status)}
+//   - readme resource.ChunkReadme
+func (_e *MockChunkRepository_Expecter) UpsertChunkReadme(ctx interface{}, readme interface{}) *MockChunkRepository_UpsertChunkReadme_Call {
+	return &MockChunkRepository_UpsertChunkReadme_Call{Call: _e.mock.On("UpsertChunkReadme", ctx, readme)}
 }
 
-func (_c *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call) Run(run func(ctx context.Context, flavorVersionID string, status resource.FlavorVersionBuildStatus)) *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call {
+func (_c *MockChunkRepository_UpsertChunkReadme_Call) Run(run func(ctx context.Context, readme resource.ChunkReadme)) *MockChunkRepository_UpsertChunkReadme_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(resource.FlavorVersionBuildStatus))
+		run(args[0].(context.Context), args[1].(resource.ChunkReadme))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call) Return(_a0 error) *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call {
-	_c.Call.Return(_a0)
+func (_c *MockChunkRepository_UpsertChunkReadme_Call) Return(_a0 resource.ChunkReadme, _a1 error) *MockChunkRepository_UpsertChunkReadme_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call) RunAndReturn(run func(context.Context, string, resource.FlavorVersionBuildStatus) error) *MockChunkRepository_UpdateFlavorVersionBuildStatus_Call {
+func (_c *MockChunkRepository_UpsertChunkReadme_Call) RunAndReturn(run func(context.Context, resource.ChunkReadme) (resource.ChunkReadme, error)) *MockChunkRepository_UpsertChunkReadme_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateFlavorVersionPresignedURLData provides a mock function with given fields: ctx, flavorVersionID, date, url
-func (_m *MockChunkRepository) UpdateFlavorVersionPresignedURLData(ctx context.Context, flavorVersionID string, date time.Time, url string) error {
-	ret := _m.Called(ctx, flavorVersionID, date, url)
+// UpsertFlavorChannel provides a mock function with given fields: ctx, channel
+func (_m *MockChunkRepository) UpsertFlavorChannel(ctx context.Context, channel resource.FlavorChannel) (resource.FlavorChannel, error) {
+	ret := _m.Called(ctx, channel)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateFlavorVersionPresignedURLData")
+		panic("no return value specified for UpsertFlavorChannel")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, string) error); ok {
-		r0 = rf(ctx, flavorVersionID, date, url)
+	var r0 resource.FlavorChannel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.FlavorChannel) (resource.FlavorChannel, error)); ok {
+		return rf(ctx, channel)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.FlavorChannel) resource.FlavorChannel); ok {
+		r0 = rf(ctx, channel)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(resource.FlavorChannel)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, resource.FlavorChannel) error); ok {
+		r1 = rf(ctx, channel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFlavorVersionPresignedURLData'
-type MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call struct {
+// MockChunkRepository_UpsertFlavorChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertFlavorChannel'
+type MockChunkRepository_UpsertFlavorChannel_Call struct {
 	*mock.Call
 }
 
-// UpdateFlavorVersionPresignedURLData is a helper method to define mock.On call
+// UpsertFlavorChannel is a helper method to define mock.On call
 //   - ctx context.Context
-//   - flavorVersionID string
-//   - date time.Time
-//   - url string
-func (_e *MockChunkRepository_Expecter) UpdateFlavorVersionPresignedURLData(ctx interface{}, flavorVersionID interface{}, date interface{}, url interface{}) *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call {
-	return &MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call{Call: _e.mock.On("UpdateFlavorVersionPresignedURLData", ctx, flavorVersionID, date, url)}
+//   - channel resource.FlavorChannel
+func (_e *MockChunkRepository_Expecter) UpsertFlavorChannel(ctx interface{}, channel interface{}) *MockChunkRepository_UpsertFlavorChannel_Call {
+	return &MockChunkRepository_UpsertFlavorChannel_Call{Call: _e.mock.On("UpsertFlavorChannel", ctx, channel)}
 }
 
-func (_c *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call) Run(run func(ctx context.Context, flavorVersionID string, date time.Time, url string)) *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call {
+func (_c *MockChunkRepository_UpsertFlavorChannel_Call) Run(run func(ctx context.Context, channel resource.FlavorChannel)) *MockChunkRepository_UpsertFlavorChannel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(string))
+		run(args[0].(context.Context), args[1].(resource.FlavorChannel))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call) Return(_a0 error) *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call {
-	_c.Call.Return(_a0)
+func (_c *MockChunkRepository_UpsertFlavorChannel_Call) Return(_a0 resource.FlavorChannel, _a1 error) *MockChunkRepository_UpsertFlavorChannel_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call) RunAndReturn(run func(context.Context, string, time.Time, string) error) *MockChunkRepository_UpdateFlavorVersionPresignedURLData_Call {
+func (_c *MockChunkRepository_UpsertFlavorChannel_Call) RunAndReturn(run func(context.Context, resource.FlavorChannel) (resource.FlavorChannel, error)) *MockChunkRepository_UpsertFlavorChannel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateThumbnail provides a mock function with given fields: ctx, chunkID, imgHash
-func (_m *MockChunkRepository) UpdateThumbnail(ctx context.Context, chunkID string, imgHash string) error {
-	ret := _m.Called(ctx, chunkID, imgHash)
+// UpsertRetentionPolicy provides a mock function with given fields: ctx, policy
+func (_m *MockChunkRepository) UpsertRetentionPolicy(ctx context.Context, policy resource.RetentionPolicy) (resource.RetentionPolicy, error) {
+	ret := _m.Called(ctx, policy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateThumbnail")
+		panic("no return value specified for UpsertRetentionPolicy")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, chunkID, imgHash)
+	var r0 resource.RetentionPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.RetentionPolicy) (resource.RetentionPolicy, error)); ok {
+		return rf(ctx, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.RetentionPolicy) resource.RetentionPolicy); ok {
+		r0 = rf(ctx, policy)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(resource.RetentionPolicy)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, resource.RetentionPolicy) error); ok {
+		r1 = rf(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockChunkRepository_UpdateThumbnail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateThumbnail'
-type MockChunkRepository_UpdateThumbnail_Call struct {
+// MockChunkRepository_UpsertRetentionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertRetentionPolicy'
+type MockChunkRepository_UpsertRetentionPolicy_Call struct {
 	*mock.Call
 }
 
-// UpdateThumbnail is a helper method to define mock.On call
+// UpsertRetentionPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - chunkID string
-//   - imgHash string
-func (_e *MockChunkRepository_Expecter) UpdateThumbnail(ctx interface{}, chunkID interface{}, imgHash interface{}) *MockChunkRepository_UpdateThumbnail_Call {
-	return &MockChunkRepository_UpdateThumbnail_Call{Call: _e.mock.On("UpdateThumbnail", ctx, chunkID, imgHash)}
+//   - policy resource.RetentionPolicy
+func (_e *MockChunkRepository_Expecter) UpsertRetentionPolicy(ctx interface{}, policy interface{}) *MockChunkRepository_UpsertRetentionPolicy_Call {
+	return &MockChunkRepository_UpsertRetentionPolicy_Call{Call: _e.mock.On("UpsertRetentionPolicy", ctx, policy)}
 }
 
-func (_c *MockChunkRepository_UpdateThumbnail_Call) Run(run func(ctx context.Context, chunkID string, imgHash string)) *MockChunkRepository_UpdateThumbnail_Call {
+func (_c *MockChunkRepository_UpsertRetentionPolicy_Call) Run(run func(ctx context.Context, policy resource.RetentionPolicy)) *MockChunkRepository_UpsertRetentionPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(resource.RetentionPolicy))
 	})
 	return _c
 }
 
-func (_c *MockChunkRepository_UpdateThumbnail_Call) Return(_a0 error) *MockChunkRepository_UpdateThumbnail_Call {
-	_c.Call.Return(_a0)
+func (_c *MockChunkRepository_UpsertRetentionPolicy_Call) Return(_a0 resource.RetentionPolicy, _a1 error) *MockChunkRepository_UpsertRetentionPolicy_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChunkRepository_UpdateThumbnail_Call) RunAndReturn(run func(context.Context, string, string) error) *MockChunkRepository_UpdateThumbnail_Call {
+func (_c *MockChunkRepository_UpsertRetentionPolicy_Call) RunAndReturn(run func(context.Context, resource.RetentionPolicy) (resource.RetentionPolicy, error)) *MockChunkRepository_UpsertRetentionPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -660,6 +660,52 @@ func (_c *MockCniHandler_GetVethPair_Call) RunAndReturn(run func(uint16) (datapa
 	return _c
 }
 
+// UnpinBPF provides a mock function with given fields: paths
+func (_m *MockCniHandler) UnpinBPF(paths []string) error {
+	ret := _m.Called(paths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnpinBPF")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(paths)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCniHandler_UnpinBPF_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnpinBPF'
+type MockCniHandler_UnpinBPF_Call struct {
+	*mock.Call
+}
+
+// UnpinBPF is a helper method to define mock.On call
+//   - paths []string
+func (_e *MockCniHandler_Expecter) UnpinBPF(paths interface{}) *MockCniHandler_UnpinBPF_Call {
+	return &MockCniHandler_UnpinBPF_Call{Call: _e.mock.On("UnpinBPF", paths)}
+}
+
+func (_c *MockCniHandler_UnpinBPF_Call) Run(run func(paths []string)) *MockCniHandler_UnpinBPF_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockCniHandler_UnpinBPF_Call) Return(_a0 error) *MockCniHandler_UnpinBPF_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCniHandler_UnpinBPF_Call) RunAndReturn(run func([]string) error) *MockCniHandler_UnpinBPF_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCniHandler creates a new instance of MockCniHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCniHandler(t interface {
@@ -0,0 +1,275 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	condition "github.com/spacechunks/explorer/controlplane/condition"
+
+	mock "github.com/stretchr/testify/mock"
+
+	node "github.com/spacechunks/explorer/controlplane/node"
+)
+
+// MockConditionRepository is an autogenerated mock type for the Repository type
+type MockConditionRepository struct {
+	mock.Mock
+}
+
+type MockConditionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConditionRepository) EXPECT() *MockConditionRepository_Expecter {
+	return &MockConditionRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListNodeConditions provides a mock function with given fields: ctx
+func (_m *MockConditionRepository) ListNodeConditions(ctx context.Context) ([]condition.Condition, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNodeConditions")
+	}
+
+	var r0 []condition.Condition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]condition.Condition, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []condition.Condition); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]condition.Condition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConditionRepository_ListNodeConditions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNodeConditions'
+type MockConditionRepository_ListNodeConditions_Call struct {
+	*mock.Call
+}
+
+// ListNodeConditions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockConditionRepository_Expecter) ListNodeConditions(ctx interface{}) *MockConditionRepository_ListNodeConditions_Call {
+	return &MockConditionRepository_ListNodeConditions_Call{Call: _e.mock.On("ListNodeConditions", ctx)}
+}
+
+func (_c *MockConditionRepository_ListNodeConditions_Call) Run(run func(ctx context.Context)) *MockConditionRepository_ListNodeConditions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockConditionRepository_ListNodeConditions_Call) Return(_a0 []condition.Condition, _a1 error) *MockConditionRepository_ListNodeConditions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConditionRepository_ListNodeConditions_Call) RunAndReturn(run func(context.Context) ([]condition.Condition, error)) *MockConditionRepository_ListNodeConditions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNodeEvents provides a mock function with given fields: ctx, limit
+func (_m *MockConditionRepository) ListNodeEvents(ctx context.Context, limit int) ([]condition.Event, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNodeEvents")
+	}
+
+	var r0 []condition.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]condition.Event, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []condition.Event); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]condition.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConditionRepository_ListNodeEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNodeEvents'
+type MockConditionRepository_ListNodeEvents_Call struct {
+	*mock.Call
+}
+
+// ListNodeEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockConditionRepository_Expecter) ListNodeEvents(ctx interface{}, limit interface{}) *MockConditionRepository_ListNodeEvents_Call {
+	return &MockConditionRepository_ListNodeEvents_Call{Call: _e.mock.On("ListNodeEvents", ctx, limit)}
+}
+
+func (_c *MockConditionRepository_ListNodeEvents_Call) Run(run func(ctx context.Context, limit int)) *MockConditionRepository_ListNodeEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockConditionRepository_ListNodeEvents_Call) Return(_a0 []condition.Event, _a1 error) *MockConditionRepository_ListNodeEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConditionRepository_ListNodeEvents_Call) RunAndReturn(run func(context.Context, int) ([]condition.Event, error)) *MockConditionRepository_ListNodeEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNodes provides a mock function with given fields: ctx
+func (_m *MockConditionRepository) ListNodes(ctx context.Context) ([]node.Node, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNodes")
+	}
+
+	var r0 []node.Node
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]node.Node, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []node.Node); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]node.Node)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConditionRepository_ListNodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNodes'
+type MockConditionRepository_ListNodes_Call struct {
+	*mock.Call
+}
+
+// ListNodes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockConditionRepository_Expecter) ListNodes(ctx interface{}) *MockConditionRepository_ListNodes_Call {
+	return &MockConditionRepository_ListNodes_Call{Call: _e.mock.On("ListNodes", ctx)}
+}
+
+func (_c *MockConditionRepository_ListNodes_Call) Run(run func(ctx context.Context)) *MockConditionRepository_ListNodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockConditionRepository_ListNodes_Call) Return(_a0 []node.Node, _a1 error) *MockConditionRepository_ListNodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConditionRepository_ListNodes_Call) RunAndReturn(run func(context.Context) ([]node.Node, error)) *MockConditionRepository_ListNodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceNodeConditions provides a mock function with given fields: ctx, nodeID, conditions
+func (_m *MockConditionRepository) ReplaceNodeConditions(ctx context.Context, nodeID string, conditions []condition.Condition) ([]condition.Event, error) {
+	ret := _m.Called(ctx, nodeID, conditions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceNodeConditions")
+	}
+
+	var r0 []condition.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []condition.Condition) ([]condition.Event, error)); ok {
+		return rf(ctx, nodeID, conditions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []condition.Condition) []condition.Event); ok {
+		r0 = rf(ctx, nodeID, conditions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]condition.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []condition.Condition) error); ok {
+		r1 = rf(ctx, nodeID, conditions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConditionRepository_ReplaceNodeConditions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceNodeConditions'
+type MockConditionRepository_ReplaceNodeConditions_Call struct {
+	*mock.Call
+}
+
+// ReplaceNodeConditions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID string
+//   - conditions []condition.Condition
+func (_e *MockConditionRepository_Expecter) ReplaceNodeConditions(ctx interface{}, nodeID interface{}, conditions interface{}) *MockConditionRepository_ReplaceNodeConditions_Call {
+	return &MockConditionRepository_ReplaceNodeConditions_Call{Call: _e.mock.On("ReplaceNodeConditions", ctx, nodeID, conditions)}
+}
+
+func (_c *MockConditionRepository_ReplaceNodeConditions_Call) Run(run func(ctx context.Context, nodeID string, conditions []condition.Condition)) *MockConditionRepository_ReplaceNodeConditions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]condition.Condition))
+	})
+	return _c
+}
+
+func (_c *MockConditionRepository_ReplaceNodeConditions_Call) Return(_a0 []condition.Event, _a1 error) *MockConditionRepository_ReplaceNodeConditions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConditionRepository_ReplaceNodeConditions_Call) RunAndReturn(run func(context.Context, string, []condition.Condition) ([]condition.Event, error)) *MockConditionRepository_ReplaceNodeConditions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConditionRepository creates a new instance of MockConditionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConditionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConditionRepository {
+	mock := &MockConditionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
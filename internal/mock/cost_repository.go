@@ -0,0 +1,161 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	node "github.com/spacechunks/explorer/controlplane/node"
+
+	resource "github.com/spacechunks/explorer/internal/resource"
+
+	time "time"
+)
+
+// MockCostRepository is an autogenerated mock type for the Repository type
+type MockCostRepository struct {
+	mock.Mock
+}
+
+type MockCostRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCostRepository) EXPECT() *MockCostRepository_Expecter {
+	return &MockCostRepository_Expecter{mock: &_m.Mock}
+}
+
+// InstanceUsage provides a mock function with given fields: ctx, start, end, chunkID
+func (_m *MockCostRepository) InstanceUsage(ctx context.Context, start time.Time, end time.Time, chunkID *string) ([]resource.InstanceUsage, error) {
+	ret := _m.Called(ctx, start, end, chunkID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstanceUsage")
+	}
+
+	var r0 []resource.InstanceUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, *string) ([]resource.InstanceUsage, error)); ok {
+		return rf(ctx, start, end, chunkID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, *string) []resource.InstanceUsage); ok {
+		r0 = rf(ctx, start, end, chunkID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.InstanceUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, *string) error); ok {
+		r1 = rf(ctx, start, end, chunkID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCostRepository_InstanceUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstanceUsage'
+type MockCostRepository_InstanceUsage_Call struct {
+	*mock.Call
+}
+
+// InstanceUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start time.Time
+//   - end time.Time
+//   - chunkID *string
+func (_e *MockCostRepository_Expecter) InstanceUsage(ctx interface{}, start interface{}, end interface{}, chunkID interface{}) *MockCostRepository_InstanceUsage_Call {
+	return &MockCostRepository_InstanceUsage_Call{Call: _e.mock.On("InstanceUsage", ctx, start, end, chunkID)}
+}
+
+func (_c *MockCostRepository_InstanceUsage_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, chunkID *string)) *MockCostRepository_InstanceUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockCostRepository_InstanceUsage_Call) Return(_a0 []resource.InstanceUsage, _a1 error) *MockCostRepository_InstanceUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCostRepository_InstanceUsage_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, *string) ([]resource.InstanceUsage, error)) *MockCostRepository_InstanceUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNodes provides a mock function with given fields: ctx
+func (_m *MockCostRepository) ListNodes(ctx context.Context) ([]node.Node, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNodes")
+	}
+
+	var r0 []node.Node
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]node.Node, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []node.Node); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]node.Node)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCostRepository_ListNodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNodes'
+type MockCostRepository_ListNodes_Call struct {
+	*mock.Call
+}
+
+// ListNodes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCostRepository_Expecter) ListNodes(ctx interface{}) *MockCostRepository_ListNodes_Call {
+	return &MockCostRepository_ListNodes_Call{Call: _e.mock.On("ListNodes", ctx)}
+}
+
+func (_c *MockCostRepository_ListNodes_Call) Run(run func(ctx context.Context)) *MockCostRepository_ListNodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCostRepository_ListNodes_Call) Return(_a0 []node.Node, _a1 error) *MockCostRepository_ListNodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCostRepository_ListNodes_Call) RunAndReturn(run func(context.Context) ([]node.Node, error)) *MockCostRepository_ListNodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCostRepository creates a new instance of MockCostRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCostRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCostRepository {
+	mock := &MockCostRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,6 +5,7 @@ package mock
 import (
 	context "context"
 
+	image "github.com/spacechunks/explorer/internal/image"
 	mock "github.com/stretchr/testify/mock"
 
 	v1 "github.com/google/go-containerregistry/pkg/v1"
@@ -23,6 +24,112 @@ func (_m *MockImageService) EXPECT() *MockImageService_Expecter {
 	return &MockImageService_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function with given fields: ctx, imgRef
+func (_m *MockImageService) Delete(ctx context.Context, imgRef string) error {
+	ret := _m.Called(ctx, imgRef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, imgRef)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockImageService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockImageService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imgRef string
+func (_e *MockImageService_Expecter) Delete(ctx interface{}, imgRef interface{}) *MockImageService_Delete_Call {
+	return &MockImageService_Delete_Call{Call: _e.mock.On("Delete", ctx, imgRef)}
+}
+
+func (_c *MockImageService_Delete_Call) Run(run func(ctx context.Context, imgRef string)) *MockImageService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockImageService_Delete_Call) Return(_a0 error) *MockImageService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockImageService_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockImageService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fetch provides a mock function with given fields: ctx, imgRef
+func (_m *MockImageService) Fetch(ctx context.Context, imgRef string) (v1.Image, error) {
+	ret := _m.Called(ctx, imgRef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fetch")
+	}
+
+	var r0 v1.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (v1.Image, error)); ok {
+		return rf(ctx, imgRef)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) v1.Image); ok {
+		r0 = rf(ctx, imgRef)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, imgRef)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockImageService_Fetch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fetch'
+type MockImageService_Fetch_Call struct {
+	*mock.Call
+}
+
+// Fetch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imgRef string
+func (_e *MockImageService_Expecter) Fetch(ctx interface{}, imgRef interface{}) *MockImageService_Fetch_Call {
+	return &MockImageService_Fetch_Call{Call: _e.mock.On("Fetch", ctx, imgRef)}
+}
+
+func (_c *MockImageService_Fetch_Call) Run(run func(ctx context.Context, imgRef string)) *MockImageService_Fetch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockImageService_Fetch_Call) Return(_a0 v1.Image, _a1 error) *MockImageService_Fetch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockImageService_Fetch_Call) RunAndReturn(run func(context.Context, string) (v1.Image, error)) *MockImageService_Fetch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Pull provides a mock function with given fields: ctx, imgRef, platform
 func (_m *MockImageService) Pull(ctx context.Context, imgRef string, platform string) (v1.Image, error) {
 	ret := _m.Called(ctx, imgRef, platform)
@@ -83,17 +190,24 @@ func (_c *MockImageService_Pull_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
-// Push provides a mock function with given fields: ctx, img, imgRef
-func (_m *MockImageService) Push(ctx context.Context, img v1.Image, imgRef string) error {
-	ret := _m.Called(ctx, img, imgRef)
+// Push provides a mock function with given fields: ctx, img, imgRef, opts
+func (_m *MockImageService) Push(ctx context.Context, img v1.Image, imgRef string, opts ...image.PushOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, img, imgRef)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Push")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, v1.Image, string) error); ok {
-		r0 = rf(ctx, img, imgRef)
+	if rf, ok := ret.Get(0).(func(context.Context, v1.Image, string, ...image.PushOption) error); ok {
+		r0 = rf(ctx, img, imgRef, opts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -110,13 +224,21 @@ type MockImageService_Push_Call struct {
 //   - ctx context.Context
 //   - img v1.Image
 //   - imgRef string
-func (_e *MockImageService_Expecter) Push(ctx interface{}, img interface{}, imgRef interface{}) *MockImageService_Push_Call {
-	return &MockImageService_Push_Call{Call: _e.mock.On("Push", ctx, img, imgRef)}
+//   - opts ...image.PushOption
+func (_e *MockImageService_Expecter) Push(ctx interface{}, img interface{}, imgRef interface{}, opts ...interface{}) *MockImageService_Push_Call {
+	return &MockImageService_Push_Call{Call: _e.mock.On("Push",
+		append([]interface{}{ctx, img, imgRef}, opts...)...)}
 }
 
-func (_c *MockImageService_Push_Call) Run(run func(ctx context.Context, img v1.Image, imgRef string)) *MockImageService_Push_Call {
+func (_c *MockImageService_Push_Call) Run(run func(ctx context.Context, img v1.Image, imgRef string, opts ...image.PushOption)) *MockImageService_Push_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(v1.Image), args[2].(string))
+		variadicArgs := make([]image.PushOption, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(image.PushOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(v1.Image), args[2].(string), variadicArgs...)
 	})
 	return _c
 }
@@ -126,7 +248,55 @@ func (_c *MockImageService_Push_Call) Return(_a0 error) *MockImageService_Push_C
 	return _c
 }
 
-func (_c *MockImageService_Push_Call) RunAndReturn(run func(context.Context, v1.Image, string) error) *MockImageService_Push_Call {
+func (_c *MockImageService_Push_Call) RunAndReturn(run func(context.Context, v1.Image, string, ...image.PushOption) error) *MockImageService_Push_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tag provides a mock function with given fields: ctx, srcRef, dstRef
+func (_m *MockImageService) Tag(ctx context.Context, srcRef string, dstRef string) error {
+	ret := _m.Called(ctx, srcRef, dstRef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, srcRef, dstRef)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockImageService_Tag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tag'
+type MockImageService_Tag_Call struct {
+	*mock.Call
+}
+
+// Tag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - srcRef string
+//   - dstRef string
+func (_e *MockImageService_Expecter) Tag(ctx interface{}, srcRef interface{}, dstRef interface{}) *MockImageService_Tag_Call {
+	return &MockImageService_Tag_Call{Call: _e.mock.On("Tag", ctx, srcRef, dstRef)}
+}
+
+func (_c *MockImageService_Tag_Call) Run(run func(ctx context.Context, srcRef string, dstRef string)) *MockImageService_Tag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockImageService_Tag_Call) Return(_a0 error) *MockImageService_Tag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockImageService_Tag_Call) RunAndReturn(run func(context.Context, string, string) error) *MockImageService_Tag_Call {
 	_c.Call.Return(run)
 	return _c
 }
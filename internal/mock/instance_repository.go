@@ -5,9 +5,12 @@ package mock
 import (
 	context "context"
 
+	instance "github.com/spacechunks/explorer/controlplane/instance"
 	mock "github.com/stretchr/testify/mock"
 
 	resource "github.com/spacechunks/explorer/internal/resource"
+
+	time "time"
 )
 
 // MockInstanceRepository is an autogenerated mock type for the Repository type
@@ -23,22 +26,34 @@ func (_m *MockInstanceRepository) EXPECT() *MockInstanceRepository_Expecter {
 	return &MockInstanceRepository_Expecter{mock: &_m.Mock}
 }
 
-// ApplyStatusReports provides a mock function with given fields: ctx, reports
-func (_m *MockInstanceRepository) ApplyStatusReports(ctx context.Context, reports []resource.InstanceStatusReport) error {
-	ret := _m.Called(ctx, reports)
+// ApplyStatusReports provides a mock function with given fields: ctx, updates
+func (_m *MockInstanceRepository) ApplyStatusReports(ctx context.Context, updates []instance.StatusUpdate) ([]string, error) {
+	ret := _m.Called(ctx, updates)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ApplyStatusReports")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []resource.InstanceStatusReport) error); ok {
-		r0 = rf(ctx, reports)
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []instance.StatusUpdate) ([]string, error)); ok {
+		return rf(ctx, updates)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []instance.StatusUpdate) []string); ok {
+		r0 = rf(ctx, updates)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, []instance.StatusUpdate) error); ok {
+		r1 = rf(ctx, updates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockInstanceRepository_ApplyStatusReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyStatusReports'
@@ -48,24 +63,24 @@ type MockInstanceRepository_ApplyStatusReports_Call struct {
 
 // ApplyStatusReports is a helper method to define mock.On call
 //   - ctx context.Context
-//   - reports []resource.InstanceStatusReport
-func (_e *MockInstanceRepository_Expecter) ApplyStatusReports(ctx interface{}, reports interface{}) *MockInstanceRepository_ApplyStatusReports_Call {
-	return &MockInstanceRepository_ApplyStatusReports_Call{Call: _e.mock.On("ApplyStatusReports", ctx, reports)}
+//   - updates []instance.StatusUpdate
+func (_e *MockInstanceRepository_Expecter) ApplyStatusReports(ctx interface{}, updates interface{}) *MockInstanceRepository_ApplyStatusReports_Call {
+	return &MockInstanceRepository_ApplyStatusReports_Call{Call: _e.mock.On("ApplyStatusReports", ctx, updates)}
 }
 
-func (_c *MockInstanceRepository_ApplyStatusReports_Call) Run(run func(ctx context.Context, reports []resource.InstanceStatusReport)) *MockInstanceRepository_ApplyStatusReports_Call {
+func (_c *MockInstanceRepository_ApplyStatusReports_Call) Run(run func(ctx context.Context, updates []instance.StatusUpdate)) *MockInstanceRepository_ApplyStatusReports_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]resource.InstanceStatusReport))
+		run(args[0].(context.Context), args[1].([]instance.StatusUpdate))
 	})
 	return _c
 }
 
-func (_c *MockInstanceRepository_ApplyStatusReports_Call) Return(_a0 error) *MockInstanceRepository_ApplyStatusReports_Call {
-	_c.Call.Return(_a0)
+func (_c *MockInstanceRepository_ApplyStatusReports_Call) Return(_a0 []string, _a1 error) *MockInstanceRepository_ApplyStatusReports_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInstanceRepository_ApplyStatusReports_Call) RunAndReturn(run func(context.Context, []resource.InstanceStatusReport) error) *MockInstanceRepository_ApplyStatusReports_Call {
+func (_c *MockInstanceRepository_ApplyStatusReports_Call) RunAndReturn(run func(context.Context, []instance.StatusUpdate) ([]string, error)) *MockInstanceRepository_ApplyStatusReports_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -185,6 +200,216 @@ func (_c *MockInstanceRepository_CreateInstance_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// CreateInstanceInvite provides a mock function with given fields: ctx, invite
+func (_m *MockInstanceRepository) CreateInstanceInvite(ctx context.Context, invite resource.InstanceInvite) (resource.InstanceInvite, error) {
+	ret := _m.Called(ctx, invite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstanceInvite")
+	}
+
+	var r0 resource.InstanceInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.InstanceInvite) (resource.InstanceInvite, error)); ok {
+		return rf(ctx, invite)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.InstanceInvite) resource.InstanceInvite); ok {
+		r0 = rf(ctx, invite)
+	} else {
+		r0 = ret.Get(0).(resource.InstanceInvite)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.InstanceInvite) error); ok {
+		r1 = rf(ctx, invite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstanceRepository_CreateInstanceInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstanceInvite'
+type MockInstanceRepository_CreateInstanceInvite_Call struct {
+	*mock.Call
+}
+
+// CreateInstanceInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invite resource.InstanceInvite
+func (_e *MockInstanceRepository_Expecter) CreateInstanceInvite(ctx interface{}, invite interface{}) *MockInstanceRepository_CreateInstanceInvite_Call {
+	return &MockInstanceRepository_CreateInstanceInvite_Call{Call: _e.mock.On("CreateInstanceInvite", ctx, invite)}
+}
+
+func (_c *MockInstanceRepository_CreateInstanceInvite_Call) Run(run func(ctx context.Context, invite resource.InstanceInvite)) *MockInstanceRepository_CreateInstanceInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.InstanceInvite))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_CreateInstanceInvite_Call) Return(_a0 resource.InstanceInvite, _a1 error) *MockInstanceRepository_CreateInstanceInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstanceRepository_CreateInstanceInvite_Call) RunAndReturn(run func(context.Context, resource.InstanceInvite) (resource.InstanceInvite, error)) *MockInstanceRepository_CreateInstanceInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredInstanceRedirects provides a mock function with given fields: ctx
+func (_m *MockInstanceRepository) DeleteExpiredInstanceRedirects(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredInstanceRedirects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockInstanceRepository_DeleteExpiredInstanceRedirects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredInstanceRedirects'
+type MockInstanceRepository_DeleteExpiredInstanceRedirects_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredInstanceRedirects is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockInstanceRepository_Expecter) DeleteExpiredInstanceRedirects(ctx interface{}) *MockInstanceRepository_DeleteExpiredInstanceRedirects_Call {
+	return &MockInstanceRepository_DeleteExpiredInstanceRedirects_Call{Call: _e.mock.On("DeleteExpiredInstanceRedirects", ctx)}
+}
+
+func (_c *MockInstanceRepository_DeleteExpiredInstanceRedirects_Call) Run(run func(ctx context.Context)) *MockInstanceRepository_DeleteExpiredInstanceRedirects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_DeleteExpiredInstanceRedirects_Call) Return(_a0 error) *MockInstanceRepository_DeleteExpiredInstanceRedirects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockInstanceRepository_DeleteExpiredInstanceRedirects_Call) RunAndReturn(run func(context.Context) error) *MockInstanceRepository_DeleteExpiredInstanceRedirects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInstanceLogs provides a mock function with given fields: ctx, ids
+func (_m *MockInstanceRepository) DeleteInstanceLogs(ctx context.Context, ids []string) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInstanceLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockInstanceRepository_DeleteInstanceLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInstanceLogs'
+type MockInstanceRepository_DeleteInstanceLogs_Call struct {
+	*mock.Call
+}
+
+// DeleteInstanceLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *MockInstanceRepository_Expecter) DeleteInstanceLogs(ctx interface{}, ids interface{}) *MockInstanceRepository_DeleteInstanceLogs_Call {
+	return &MockInstanceRepository_DeleteInstanceLogs_Call{Call: _e.mock.On("DeleteInstanceLogs", ctx, ids)}
+}
+
+func (_c *MockInstanceRepository_DeleteInstanceLogs_Call) Run(run func(ctx context.Context, ids []string)) *MockInstanceRepository_DeleteInstanceLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_DeleteInstanceLogs_Call) Return(_a0 error) *MockInstanceRepository_DeleteInstanceLogs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockInstanceRepository_DeleteInstanceLogs_Call) RunAndReturn(run func(context.Context, []string) error) *MockInstanceRepository_DeleteInstanceLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpiredInstanceLogs provides a mock function with given fields: ctx, before, limit
+func (_m *MockInstanceRepository) ExpiredInstanceLogs(ctx context.Context, before time.Time, limit int) ([]resource.InstanceLog, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpiredInstanceLogs")
+	}
+
+	var r0 []resource.InstanceLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]resource.InstanceLog, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []resource.InstanceLog); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.InstanceLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstanceRepository_ExpiredInstanceLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpiredInstanceLogs'
+type MockInstanceRepository_ExpiredInstanceLogs_Call struct {
+	*mock.Call
+}
+
+// ExpiredInstanceLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *MockInstanceRepository_Expecter) ExpiredInstanceLogs(ctx interface{}, before interface{}, limit interface{}) *MockInstanceRepository_ExpiredInstanceLogs_Call {
+	return &MockInstanceRepository_ExpiredInstanceLogs_Call{Call: _e.mock.On("ExpiredInstanceLogs", ctx, before, limit)}
+}
+
+func (_c *MockInstanceRepository_ExpiredInstanceLogs_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *MockInstanceRepository_ExpiredInstanceLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_ExpiredInstanceLogs_Call) Return(_a0 []resource.InstanceLog, _a1 error) *MockInstanceRepository_ExpiredInstanceLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstanceRepository_ExpiredInstanceLogs_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]resource.InstanceLog, error)) *MockInstanceRepository_ExpiredInstanceLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetInstanceByID provides a mock function with given fields: ctx, id
 func (_m *MockInstanceRepository) GetInstanceByID(ctx context.Context, id string) (resource.Instance, error) {
 	ret := _m.Called(ctx, id)
@@ -242,29 +467,89 @@ func (_c *MockInstanceRepository_GetInstanceByID_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// GetInstancesByNodeID provides a mock function with given fields: ctx, id
-func (_m *MockInstanceRepository) GetInstancesByNodeID(ctx context.Context, id string) ([]resource.Instance, error) {
-	ret := _m.Called(ctx, id)
+// GetInstanceEvents provides a mock function with given fields: ctx, instanceID, limit
+func (_m *MockInstanceRepository) GetInstanceEvents(ctx context.Context, instanceID string, limit int) ([]resource.InstanceEvent, error) {
+	ret := _m.Called(ctx, instanceID, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetInstancesByNodeID")
+		panic("no return value specified for GetInstanceEvents")
 	}
 
-	var r0 []resource.Instance
+	var r0 []resource.InstanceEvent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.Instance, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]resource.InstanceEvent, error)); ok {
+		return rf(ctx, instanceID, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.Instance); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []resource.InstanceEvent); ok {
+		r0 = rf(ctx, instanceID, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]resource.Instance)
+			r0 = ret.Get(0).([]resource.InstanceEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, instanceID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstanceRepository_GetInstanceEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstanceEvents'
+type MockInstanceRepository_GetInstanceEvents_Call struct {
+	*mock.Call
+}
+
+// GetInstanceEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instanceID string
+//   - limit int
+func (_e *MockInstanceRepository_Expecter) GetInstanceEvents(ctx interface{}, instanceID interface{}, limit interface{}) *MockInstanceRepository_GetInstanceEvents_Call {
+	return &MockInstanceRepository_GetInstanceEvents_Call{Call: _e.mock.On("GetInstanceEvents", ctx, instanceID, limit)}
+}
+
+func (_c *MockInstanceRepository_GetInstanceEvents_Call) Run(run func(ctx context.Context, instanceID string, limit int)) *MockInstanceRepository_GetInstanceEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_GetInstanceEvents_Call) Return(_a0 []resource.InstanceEvent, _a1 error) *MockInstanceRepository_GetInstanceEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstanceRepository_GetInstanceEvents_Call) RunAndReturn(run func(context.Context, string, int) ([]resource.InstanceEvent, error)) *MockInstanceRepository_GetInstanceEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstanceRedirectsByNodeID provides a mock function with given fields: ctx, nodeID
+func (_m *MockInstanceRepository) GetInstanceRedirectsByNodeID(ctx context.Context, nodeID string) ([]resource.InstanceRedirect, error) {
+	ret := _m.Called(ctx, nodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstanceRedirectsByNodeID")
+	}
+
+	var r0 []resource.InstanceRedirect
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.InstanceRedirect, error)); ok {
+		return rf(ctx, nodeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.InstanceRedirect); ok {
+		r0 = rf(ctx, nodeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.InstanceRedirect)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+		r1 = rf(ctx, nodeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -272,58 +557,58 @@ func (_m *MockInstanceRepository) GetInstancesByNodeID(ctx context.Context, id s
 	return r0, r1
 }
 
-// MockInstanceRepository_GetInstancesByNodeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstancesByNodeID'
-type MockInstanceRepository_GetInstancesByNodeID_Call struct {
+// MockInstanceRepository_GetInstanceRedirectsByNodeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstanceRedirectsByNodeID'
+type MockInstanceRepository_GetInstanceRedirectsByNodeID_Call struct {
 	*mock.Call
 }
 
-// GetInstancesByNodeID is a helper method to define mock.On call
+// GetInstanceRedirectsByNodeID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockInstanceRepository_Expecter) GetInstancesByNodeID(ctx interface{}, id interface{}) *MockInstanceRepository_GetInstancesByNodeID_Call {
-	return &MockInstanceRepository_GetInstancesByNodeID_Call{Call: _e.mock.On("GetInstancesByNodeID", ctx, id)}
+//   - nodeID string
+func (_e *MockInstanceRepository_Expecter) GetInstanceRedirectsByNodeID(ctx interface{}, nodeID interface{}) *MockInstanceRepository_GetInstanceRedirectsByNodeID_Call {
+	return &MockInstanceRepository_GetInstanceRedirectsByNodeID_Call{Call: _e.mock.On("GetInstanceRedirectsByNodeID", ctx, nodeID)}
 }
 
-func (_c *MockInstanceRepository_GetInstancesByNodeID_Call) Run(run func(ctx context.Context, id string)) *MockInstanceRepository_GetInstancesByNodeID_Call {
+func (_c *MockInstanceRepository_GetInstanceRedirectsByNodeID_Call) Run(run func(ctx context.Context, nodeID string)) *MockInstanceRepository_GetInstanceRedirectsByNodeID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockInstanceRepository_GetInstancesByNodeID_Call) Return(_a0 []resource.Instance, _a1 error) *MockInstanceRepository_GetInstancesByNodeID_Call {
+func (_c *MockInstanceRepository_GetInstanceRedirectsByNodeID_Call) Return(_a0 []resource.InstanceRedirect, _a1 error) *MockInstanceRepository_GetInstanceRedirectsByNodeID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInstanceRepository_GetInstancesByNodeID_Call) RunAndReturn(run func(context.Context, string) ([]resource.Instance, error)) *MockInstanceRepository_GetInstancesByNodeID_Call {
+func (_c *MockInstanceRepository_GetInstanceRedirectsByNodeID_Call) RunAndReturn(run func(context.Context, string) ([]resource.InstanceRedirect, error)) *MockInstanceRepository_GetInstanceRedirectsByNodeID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListInstances provides a mock function with given fields: ctx, pageSize, afterID
-func (_m *MockInstanceRepository) ListInstances(ctx context.Context, pageSize int, afterID *string) ([]resource.Instance, error) {
-	ret := _m.Called(ctx, pageSize, afterID)
+// GetInstanceTimeline provides a mock function with given fields: ctx, instanceID, limit
+func (_m *MockInstanceRepository) GetInstanceTimeline(ctx context.Context, instanceID string, limit int) ([]resource.InstanceEvent, error) {
+	ret := _m.Called(ctx, instanceID, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListInstances")
+		panic("no return value specified for GetInstanceTimeline")
 	}
 
-	var r0 []resource.Instance
+	var r0 []resource.InstanceEvent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, *string) ([]resource.Instance, error)); ok {
-		return rf(ctx, pageSize, afterID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]resource.InstanceEvent, error)); ok {
+		return rf(ctx, instanceID, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, *string) []resource.Instance); ok {
-		r0 = rf(ctx, pageSize, afterID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []resource.InstanceEvent); ok {
+		r0 = rf(ctx, instanceID, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]resource.Instance)
+			r0 = ret.Get(0).([]resource.InstanceEvent)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, *string) error); ok {
-		r1 = rf(ctx, pageSize, afterID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, instanceID, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -331,32 +616,605 @@ func (_m *MockInstanceRepository) ListInstances(ctx context.Context, pageSize in
 	return r0, r1
 }
 
-// MockInstanceRepository_ListInstances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInstances'
-type MockInstanceRepository_ListInstances_Call struct {
+// MockInstanceRepository_GetInstanceTimeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstanceTimeline'
+type MockInstanceRepository_GetInstanceTimeline_Call struct {
 	*mock.Call
 }
 
-// ListInstances is a helper method to define mock.On call
+// GetInstanceTimeline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pageSize int
-//   - afterID *string
-func (_e *MockInstanceRepository_Expecter) ListInstances(ctx interface{}, pageSize interface{}, afterID interface{}) *MockInstanceRepository_ListInstances_Call {
-	return &MockInstanceRepository_ListInstances_Call{Call: _e.mock.On("ListInstances", ctx, pageSize, afterID)}
+//   - instanceID string
+//   - limit int
+func (_e *MockInstanceRepository_Expecter) GetInstanceTimeline(ctx interface{}, instanceID interface{}, limit interface{}) *MockInstanceRepository_GetInstanceTimeline_Call {
+	return &MockInstanceRepository_GetInstanceTimeline_Call{Call: _e.mock.On("GetInstanceTimeline", ctx, instanceID, limit)}
 }
 
-func (_c *MockInstanceRepository_ListInstances_Call) Run(run func(ctx context.Context, pageSize int, afterID *string)) *MockInstanceRepository_ListInstances_Call {
+func (_c *MockInstanceRepository_GetInstanceTimeline_Call) Run(run func(ctx context.Context, instanceID string, limit int)) *MockInstanceRepository_GetInstanceTimeline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int), args[2].(*string))
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *MockInstanceRepository_ListInstances_Call) Return(_a0 []resource.Instance, _a1 error) *MockInstanceRepository_ListInstances_Call {
+func (_c *MockInstanceRepository_GetInstanceTimeline_Call) Return(_a0 []resource.InstanceEvent, _a1 error) *MockInstanceRepository_GetInstanceTimeline_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInstanceRepository_ListInstances_Call) RunAndReturn(run func(context.Context, int, *string) ([]resource.Instance, error)) *MockInstanceRepository_ListInstances_Call {
+func (_c *MockInstanceRepository_GetInstanceTimeline_Call) RunAndReturn(run func(context.Context, string, int) ([]resource.InstanceEvent, error)) *MockInstanceRepository_GetInstanceTimeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstancesByNodeID provides a mock function with given fields: ctx, id, afterRevision
+func (_m *MockInstanceRepository) GetInstancesByNodeID(ctx context.Context, id string, afterRevision int64) ([]resource.Instance, int64, error) {
+	ret := _m.Called(ctx, id, afterRevision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstancesByNodeID")
+	}
+
+	var r0 []resource.Instance
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]resource.Instance, int64, error)); ok {
+		return rf(ctx, id, afterRevision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []resource.Instance); ok {
+		r0 = rf(ctx, id, afterRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) int64); ok {
+		r1 = rf(ctx, id, afterRevision)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int64) error); ok {
+		r2 = rf(ctx, id, afterRevision)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockInstanceRepository_GetInstancesByNodeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstancesByNodeID'
+type MockInstanceRepository_GetInstancesByNodeID_Call struct {
+	*mock.Call
+}
+
+// GetInstancesByNodeID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - afterRevision int64
+func (_e *MockInstanceRepository_Expecter) GetInstancesByNodeID(ctx interface{}, id interface{}, afterRevision interface{}) *MockInstanceRepository_GetInstancesByNodeID_Call {
+	return &MockInstanceRepository_GetInstancesByNodeID_Call{Call: _e.mock.On("GetInstancesByNodeID", ctx, id, afterRevision)}
+}
+
+func (_c *MockInstanceRepository_GetInstancesByNodeID_Call) Run(run func(ctx context.Context, id string, afterRevision int64)) *MockInstanceRepository_GetInstancesByNodeID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_GetInstancesByNodeID_Call) Return(_a0 []resource.Instance, _a1 int64, _a2 error) *MockInstanceRepository_GetInstancesByNodeID_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockInstanceRepository_GetInstancesByNodeID_Call) RunAndReturn(run func(context.Context, string, int64) ([]resource.Instance, int64, error)) *MockInstanceRepository_GetInstancesByNodeID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstancesByOwnerID provides a mock function with given fields: ctx, ownerID, afterRevision
+func (_m *MockInstanceRepository) GetInstancesByOwnerID(ctx context.Context, ownerID string, afterRevision int64) ([]resource.Instance, int64, error) {
+	ret := _m.Called(ctx, ownerID, afterRevision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstancesByOwnerID")
+	}
+
+	var r0 []resource.Instance
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]resource.Instance, int64, error)); ok {
+		return rf(ctx, ownerID, afterRevision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []resource.Instance); ok {
+		r0 = rf(ctx, ownerID, afterRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) int64); ok {
+		r1 = rf(ctx, ownerID, afterRevision)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int64) error); ok {
+		r2 = rf(ctx, ownerID, afterRevision)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockInstanceRepository_GetInstancesByOwnerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstancesByOwnerID'
+type MockInstanceRepository_GetInstancesByOwnerID_Call struct {
+	*mock.Call
+}
+
+// GetInstancesByOwnerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID string
+//   - afterRevision int64
+func (_e *MockInstanceRepository_Expecter) GetInstancesByOwnerID(ctx interface{}, ownerID interface{}, afterRevision interface{}) *MockInstanceRepository_GetInstancesByOwnerID_Call {
+	return &MockInstanceRepository_GetInstancesByOwnerID_Call{Call: _e.mock.On("GetInstancesByOwnerID", ctx, ownerID, afterRevision)}
+}
+
+func (_c *MockInstanceRepository_GetInstancesByOwnerID_Call) Run(run func(ctx context.Context, ownerID string, afterRevision int64)) *MockInstanceRepository_GetInstancesByOwnerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_GetInstancesByOwnerID_Call) Return(_a0 []resource.Instance, _a1 int64, _a2 error) *MockInstanceRepository_GetInstancesByOwnerID_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockInstanceRepository_GetInstancesByOwnerID_Call) RunAndReturn(run func(context.Context, string, int64) ([]resource.Instance, int64, error)) *MockInstanceRepository_GetInstancesByOwnerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertInstanceLog provides a mock function with given fields: ctx, log
+func (_m *MockInstanceRepository) InsertInstanceLog(ctx context.Context, log resource.InstanceLog) error {
+	ret := _m.Called(ctx, log)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertInstanceLog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.InstanceLog) error); ok {
+		r0 = rf(ctx, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockInstanceRepository_InsertInstanceLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertInstanceLog'
+type MockInstanceRepository_InsertInstanceLog_Call struct {
+	*mock.Call
+}
+
+// InsertInstanceLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - log resource.InstanceLog
+func (_e *MockInstanceRepository_Expecter) InsertInstanceLog(ctx interface{}, log interface{}) *MockInstanceRepository_InsertInstanceLog_Call {
+	return &MockInstanceRepository_InsertInstanceLog_Call{Call: _e.mock.On("InsertInstanceLog", ctx, log)}
+}
+
+func (_c *MockInstanceRepository_InsertInstanceLog_Call) Run(run func(ctx context.Context, log resource.InstanceLog)) *MockInstanceRepository_InsertInstanceLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.InstanceLog))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_InsertInstanceLog_Call) Return(_a0 error) *MockInstanceRepository_InsertInstanceLog_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockInstanceRepository_InsertInstanceLog_Call) RunAndReturn(run func(context.Context, resource.InstanceLog) error) *MockInstanceRepository_InsertInstanceLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstanceInviteByID provides a mock function with given fields: ctx, id
+func (_m *MockInstanceRepository) InstanceInviteByID(ctx context.Context, id string) (resource.InstanceInvite, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstanceInviteByID")
+	}
+
+	var r0 resource.InstanceInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.InstanceInvite, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.InstanceInvite); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(resource.InstanceInvite)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstanceRepository_InstanceInviteByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstanceInviteByID'
+type MockInstanceRepository_InstanceInviteByID_Call struct {
+	*mock.Call
+}
+
+// InstanceInviteByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockInstanceRepository_Expecter) InstanceInviteByID(ctx interface{}, id interface{}) *MockInstanceRepository_InstanceInviteByID_Call {
+	return &MockInstanceRepository_InstanceInviteByID_Call{Call: _e.mock.On("InstanceInviteByID", ctx, id)}
+}
+
+func (_c *MockInstanceRepository_InstanceInviteByID_Call) Run(run func(ctx context.Context, id string)) *MockInstanceRepository_InstanceInviteByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_InstanceInviteByID_Call) Return(_a0 resource.InstanceInvite, _a1 error) *MockInstanceRepository_InstanceInviteByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstanceRepository_InstanceInviteByID_Call) RunAndReturn(run func(context.Context, string) (resource.InstanceInvite, error)) *MockInstanceRepository_InstanceInviteByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstanceInvites provides a mock function with given fields: ctx, instanceID
+func (_m *MockInstanceRepository) InstanceInvites(ctx context.Context, instanceID string) ([]resource.InstanceInvite, error) {
+	ret := _m.Called(ctx, instanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstanceInvites")
+	}
+
+	var r0 []resource.InstanceInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.InstanceInvite, error)); ok {
+		return rf(ctx, instanceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.InstanceInvite); ok {
+		r0 = rf(ctx, instanceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.InstanceInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, instanceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstanceRepository_InstanceInvites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstanceInvites'
+type MockInstanceRepository_InstanceInvites_Call struct {
+	*mock.Call
+}
+
+// InstanceInvites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instanceID string
+func (_e *MockInstanceRepository_Expecter) InstanceInvites(ctx interface{}, instanceID interface{}) *MockInstanceRepository_InstanceInvites_Call {
+	return &MockInstanceRepository_InstanceInvites_Call{Call: _e.mock.On("InstanceInvites", ctx, instanceID)}
+}
+
+func (_c *MockInstanceRepository_InstanceInvites_Call) Run(run func(ctx context.Context, instanceID string)) *MockInstanceRepository_InstanceInvites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_InstanceInvites_Call) Return(_a0 []resource.InstanceInvite, _a1 error) *MockInstanceRepository_InstanceInvites_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstanceRepository_InstanceInvites_Call) RunAndReturn(run func(context.Context, string) ([]resource.InstanceInvite, error)) *MockInstanceRepository_InstanceInvites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstanceLogs provides a mock function with given fields: ctx, instanceID
+func (_m *MockInstanceRepository) InstanceLogs(ctx context.Context, instanceID string) ([]resource.InstanceLog, error) {
+	ret := _m.Called(ctx, instanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstanceLogs")
+	}
+
+	var r0 []resource.InstanceLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.InstanceLog, error)); ok {
+		return rf(ctx, instanceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.InstanceLog); ok {
+		r0 = rf(ctx, instanceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.InstanceLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, instanceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstanceRepository_InstanceLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstanceLogs'
+type MockInstanceRepository_InstanceLogs_Call struct {
+	*mock.Call
+}
+
+// InstanceLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instanceID string
+func (_e *MockInstanceRepository_Expecter) InstanceLogs(ctx interface{}, instanceID interface{}) *MockInstanceRepository_InstanceLogs_Call {
+	return &MockInstanceRepository_InstanceLogs_Call{Call: _e.mock.On("InstanceLogs", ctx, instanceID)}
+}
+
+func (_c *MockInstanceRepository_InstanceLogs_Call) Run(run func(ctx context.Context, instanceID string)) *MockInstanceRepository_InstanceLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_InstanceLogs_Call) Return(_a0 []resource.InstanceLog, _a1 error) *MockInstanceRepository_InstanceLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstanceRepository_InstanceLogs_Call) RunAndReturn(run func(context.Context, string) ([]resource.InstanceLog, error)) *MockInstanceRepository_InstanceLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInstances provides a mock function with given fields: ctx, pageSize, afterID
+func (_m *MockInstanceRepository) ListInstances(ctx context.Context, pageSize int, afterID *string) ([]resource.Instance, error) {
+	ret := _m.Called(ctx, pageSize, afterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInstances")
+	}
+
+	var r0 []resource.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *string) ([]resource.Instance, error)); ok {
+		return rf(ctx, pageSize, afterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *string) []resource.Instance); ok {
+		r0 = rf(ctx, pageSize, afterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *string) error); ok {
+		r1 = rf(ctx, pageSize, afterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstanceRepository_ListInstances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInstances'
+type MockInstanceRepository_ListInstances_Call struct {
+	*mock.Call
+}
+
+// ListInstances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageSize int
+//   - afterID *string
+func (_e *MockInstanceRepository_Expecter) ListInstances(ctx interface{}, pageSize interface{}, afterID interface{}) *MockInstanceRepository_ListInstances_Call {
+	return &MockInstanceRepository_ListInstances_Call{Call: _e.mock.On("ListInstances", ctx, pageSize, afterID)}
+}
+
+func (_c *MockInstanceRepository_ListInstances_Call) Run(run func(ctx context.Context, pageSize int, afterID *string)) *MockInstanceRepository_ListInstances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_ListInstances_Call) Return(_a0 []resource.Instance, _a1 error) *MockInstanceRepository_ListInstances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstanceRepository_ListInstances_Call) RunAndReturn(run func(context.Context, int, *string) ([]resource.Instance, error)) *MockInstanceRepository_ListInstances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeInstanceInvite provides a mock function with given fields: ctx, id
+func (_m *MockInstanceRepository) RevokeInstanceInvite(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeInstanceInvite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockInstanceRepository_RevokeInstanceInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeInstanceInvite'
+type MockInstanceRepository_RevokeInstanceInvite_Call struct {
+	*mock.Call
+}
+
+// RevokeInstanceInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockInstanceRepository_Expecter) RevokeInstanceInvite(ctx interface{}, id interface{}) *MockInstanceRepository_RevokeInstanceInvite_Call {
+	return &MockInstanceRepository_RevokeInstanceInvite_Call{Call: _e.mock.On("RevokeInstanceInvite", ctx, id)}
+}
+
+func (_c *MockInstanceRepository_RevokeInstanceInvite_Call) Run(run func(ctx context.Context, id string)) *MockInstanceRepository_RevokeInstanceInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_RevokeInstanceInvite_Call) Return(_a0 error) *MockInstanceRepository_RevokeInstanceInvite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockInstanceRepository_RevokeInstanceInvite_Call) RunAndReturn(run func(context.Context, string) error) *MockInstanceRepository_RevokeInstanceInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionStaleInstances provides a mock function with given fields: ctx, from, to, changedBefore
+func (_m *MockInstanceRepository) TransitionStaleInstances(ctx context.Context, from resource.InstanceState, to resource.InstanceState, changedBefore time.Time) ([]string, error) {
+	ret := _m.Called(ctx, from, to, changedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionStaleInstances")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.InstanceState, resource.InstanceState, time.Time) ([]string, error)); ok {
+		return rf(ctx, from, to, changedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.InstanceState, resource.InstanceState, time.Time) []string); ok {
+		r0 = rf(ctx, from, to, changedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.InstanceState, resource.InstanceState, time.Time) error); ok {
+		r1 = rf(ctx, from, to, changedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInstanceRepository_TransitionStaleInstances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionStaleInstances'
+type MockInstanceRepository_TransitionStaleInstances_Call struct {
+	*mock.Call
+}
+
+// TransitionStaleInstances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from resource.InstanceState
+//   - to resource.InstanceState
+//   - changedBefore time.Time
+func (_e *MockInstanceRepository_Expecter) TransitionStaleInstances(ctx interface{}, from interface{}, to interface{}, changedBefore interface{}) *MockInstanceRepository_TransitionStaleInstances_Call {
+	return &MockInstanceRepository_TransitionStaleInstances_Call{Call: _e.mock.On("TransitionStaleInstances", ctx, from, to, changedBefore)}
+}
+
+func (_c *MockInstanceRepository_TransitionStaleInstances_Call) Run(run func(ctx context.Context, from resource.InstanceState, to resource.InstanceState, changedBefore time.Time)) *MockInstanceRepository_TransitionStaleInstances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.InstanceState), args[2].(resource.InstanceState), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_TransitionStaleInstances_Call) Return(_a0 []string, _a1 error) *MockInstanceRepository_TransitionStaleInstances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInstanceRepository_TransitionStaleInstances_Call) RunAndReturn(run func(context.Context, resource.InstanceState, resource.InstanceState, time.Time) ([]string, error)) *MockInstanceRepository_TransitionStaleInstances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateInstanceState provides a mock function with given fields: ctx, id, to, from
+func (_m *MockInstanceRepository) UpdateInstanceState(ctx context.Context, id string, to resource.InstanceState, from []resource.InstanceState) error {
+	ret := _m.Called(ctx, id, to, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateInstanceState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.InstanceState, []resource.InstanceState) error); ok {
+		r0 = rf(ctx, id, to, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockInstanceRepository_UpdateInstanceState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInstanceState'
+type MockInstanceRepository_UpdateInstanceState_Call struct {
+	*mock.Call
+}
+
+// UpdateInstanceState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - to resource.InstanceState
+//   - from []resource.InstanceState
+func (_e *MockInstanceRepository_Expecter) UpdateInstanceState(ctx interface{}, id interface{}, to interface{}, from interface{}) *MockInstanceRepository_UpdateInstanceState_Call {
+	return &MockInstanceRepository_UpdateInstanceState_Call{Call: _e.mock.On("UpdateInstanceState", ctx, id, to, from)}
+}
+
+func (_c *MockInstanceRepository_UpdateInstanceState_Call) Run(run func(ctx context.Context, id string, to resource.InstanceState, from []resource.InstanceState)) *MockInstanceRepository_UpdateInstanceState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.InstanceState), args[3].([]resource.InstanceState))
+	})
+	return _c
+}
+
+func (_c *MockInstanceRepository_UpdateInstanceState_Call) Return(_a0 error) *MockInstanceRepository_UpdateInstanceState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockInstanceRepository_UpdateInstanceState_Call) RunAndReturn(run func(context.Context, string, resource.InstanceState, []resource.InstanceState) error) *MockInstanceRepository_UpdateInstanceState_Call {
 	_c.Call.Return(run)
 	return _c
 }
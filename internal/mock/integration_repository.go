@@ -0,0 +1,364 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	resource "github.com/spacechunks/explorer/internal/resource"
+)
+
+// MockIntegrationRepository is an autogenerated mock type for the Repository type
+type MockIntegrationRepository struct {
+	mock.Mock
+}
+
+type MockIntegrationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIntegrationRepository) EXPECT() *MockIntegrationRepository_Expecter {
+	return &MockIntegrationRepository_Expecter{mock: &_m.Mock}
+}
+
+// ApplyPlayerEvents provides a mock function with given fields: ctx, events
+func (_m *MockIntegrationRepository) ApplyPlayerEvents(ctx context.Context, events []resource.PlayerEvent) error {
+	ret := _m.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPlayerEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []resource.PlayerEvent) error); ok {
+		r0 = rf(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIntegrationRepository_ApplyPlayerEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyPlayerEvents'
+type MockIntegrationRepository_ApplyPlayerEvents_Call struct {
+	*mock.Call
+}
+
+// ApplyPlayerEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []resource.PlayerEvent
+func (_e *MockIntegrationRepository_Expecter) ApplyPlayerEvents(ctx interface{}, events interface{}) *MockIntegrationRepository_ApplyPlayerEvents_Call {
+	return &MockIntegrationRepository_ApplyPlayerEvents_Call{Call: _e.mock.On("ApplyPlayerEvents", ctx, events)}
+}
+
+func (_c *MockIntegrationRepository_ApplyPlayerEvents_Call) Run(run func(ctx context.Context, events []resource.PlayerEvent)) *MockIntegrationRepository_ApplyPlayerEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]resource.PlayerEvent))
+	})
+	return _c
+}
+
+func (_c *MockIntegrationRepository_ApplyPlayerEvents_Call) Return(_a0 error) *MockIntegrationRepository_ApplyPlayerEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIntegrationRepository_ApplyPlayerEvents_Call) RunAndReturn(run func(context.Context, []resource.PlayerEvent) error) *MockIntegrationRepository_ApplyPlayerEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Backends provides a mock function with given fields: ctx, chunkName, flavorName
+func (_m *MockIntegrationRepository) Backends(ctx context.Context, chunkName string, flavorName string) ([]resource.Backend, error) {
+	ret := _m.Called(ctx, chunkName, flavorName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backends")
+	}
+
+	var r0 []resource.Backend
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]resource.Backend, error)); ok {
+		return rf(ctx, chunkName, flavorName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []resource.Backend); ok {
+		r0 = rf(ctx, chunkName, flavorName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Backend)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, chunkName, flavorName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIntegrationRepository_Backends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backends'
+type MockIntegrationRepository_Backends_Call struct {
+	*mock.Call
+}
+
+// Backends is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chunkName string
+//   - flavorName string
+func (_e *MockIntegrationRepository_Expecter) Backends(ctx interface{}, chunkName interface{}, flavorName interface{}) *MockIntegrationRepository_Backends_Call {
+	return &MockIntegrationRepository_Backends_Call{Call: _e.mock.On("Backends", ctx, chunkName, flavorName)}
+}
+
+func (_c *MockIntegrationRepository_Backends_Call) Run(run func(ctx context.Context, chunkName string, flavorName string)) *MockIntegrationRepository_Backends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIntegrationRepository_Backends_Call) Return(_a0 []resource.Backend, _a1 error) *MockIntegrationRepository_Backends_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIntegrationRepository_Backends_Call) RunAndReturn(run func(context.Context, string, string) ([]resource.Backend, error)) *MockIntegrationRepository_Backends_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstanceBackend provides a mock function with given fields: ctx, instanceID
+func (_m *MockIntegrationRepository) InstanceBackend(ctx context.Context, instanceID string) (resource.Backend, error) {
+	ret := _m.Called(ctx, instanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstanceBackend")
+	}
+
+	var r0 resource.Backend
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.Backend, error)); ok {
+		return rf(ctx, instanceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.Backend); ok {
+		r0 = rf(ctx, instanceID)
+	} else {
+		r0 = ret.Get(0).(resource.Backend)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, instanceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIntegrationRepository_InstanceBackend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstanceBackend'
+type MockIntegrationRepository_InstanceBackend_Call struct {
+	*mock.Call
+}
+
+// InstanceBackend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instanceID string
+func (_e *MockIntegrationRepository_Expecter) InstanceBackend(ctx interface{}, instanceID interface{}) *MockIntegrationRepository_InstanceBackend_Call {
+	return &MockIntegrationRepository_InstanceBackend_Call{Call: _e.mock.On("InstanceBackend", ctx, instanceID)}
+}
+
+func (_c *MockIntegrationRepository_InstanceBackend_Call) Run(run func(ctx context.Context, instanceID string)) *MockIntegrationRepository_InstanceBackend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIntegrationRepository_InstanceBackend_Call) Return(_a0 resource.Backend, _a1 error) *MockIntegrationRepository_InstanceBackend_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIntegrationRepository_InstanceBackend_Call) RunAndReturn(run func(context.Context, string) (resource.Backend, error)) *MockIntegrationRepository_InstanceBackend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstanceInviteByToken provides a mock function with given fields: ctx, token
+func (_m *MockIntegrationRepository) InstanceInviteByToken(ctx context.Context, token string) (resource.InstanceInvite, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstanceInviteByToken")
+	}
+
+	var r0 resource.InstanceInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.InstanceInvite, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.InstanceInvite); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(resource.InstanceInvite)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIntegrationRepository_InstanceInviteByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstanceInviteByToken'
+type MockIntegrationRepository_InstanceInviteByToken_Call struct {
+	*mock.Call
+}
+
+// InstanceInviteByToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockIntegrationRepository_Expecter) InstanceInviteByToken(ctx interface{}, token interface{}) *MockIntegrationRepository_InstanceInviteByToken_Call {
+	return &MockIntegrationRepository_InstanceInviteByToken_Call{Call: _e.mock.On("InstanceInviteByToken", ctx, token)}
+}
+
+func (_c *MockIntegrationRepository_InstanceInviteByToken_Call) Run(run func(ctx context.Context, token string)) *MockIntegrationRepository_InstanceInviteByToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIntegrationRepository_InstanceInviteByToken_Call) Return(_a0 resource.InstanceInvite, _a1 error) *MockIntegrationRepository_InstanceInviteByToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIntegrationRepository_InstanceInviteByToken_Call) RunAndReturn(run func(context.Context, string) (resource.InstanceInvite, error)) *MockIntegrationRepository_InstanceInviteByToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordJoinMiss provides a mock function with given fields: ctx, chunkName, flavorName
+func (_m *MockIntegrationRepository) RecordJoinMiss(ctx context.Context, chunkName string, flavorName string) error {
+	ret := _m.Called(ctx, chunkName, flavorName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordJoinMiss")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, chunkName, flavorName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIntegrationRepository_RecordJoinMiss_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordJoinMiss'
+type MockIntegrationRepository_RecordJoinMiss_Call struct {
+	*mock.Call
+}
+
+// RecordJoinMiss is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chunkName string
+//   - flavorName string
+func (_e *MockIntegrationRepository_Expecter) RecordJoinMiss(ctx interface{}, chunkName interface{}, flavorName interface{}) *MockIntegrationRepository_RecordJoinMiss_Call {
+	return &MockIntegrationRepository_RecordJoinMiss_Call{Call: _e.mock.On("RecordJoinMiss", ctx, chunkName, flavorName)}
+}
+
+func (_c *MockIntegrationRepository_RecordJoinMiss_Call) Run(run func(ctx context.Context, chunkName string, flavorName string)) *MockIntegrationRepository_RecordJoinMiss_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIntegrationRepository_RecordJoinMiss_Call) Return(_a0 error) *MockIntegrationRepository_RecordJoinMiss_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIntegrationRepository_RecordJoinMiss_Call) RunAndReturn(run func(context.Context, string, string) error) *MockIntegrationRepository_RecordJoinMiss_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseInstanceInvite provides a mock function with given fields: ctx, id
+func (_m *MockIntegrationRepository) UseInstanceInvite(ctx context.Context, id string) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseInstanceInvite")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIntegrationRepository_UseInstanceInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseInstanceInvite'
+type MockIntegrationRepository_UseInstanceInvite_Call struct {
+	*mock.Call
+}
+
+// UseInstanceInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIntegrationRepository_Expecter) UseInstanceInvite(ctx interface{}, id interface{}) *MockIntegrationRepository_UseInstanceInvite_Call {
+	return &MockIntegrationRepository_UseInstanceInvite_Call{Call: _e.mock.On("UseInstanceInvite", ctx, id)}
+}
+
+func (_c *MockIntegrationRepository_UseInstanceInvite_Call) Run(run func(ctx context.Context, id string)) *MockIntegrationRepository_UseInstanceInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIntegrationRepository_UseInstanceInvite_Call) Return(_a0 bool, _a1 error) *MockIntegrationRepository_UseInstanceInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIntegrationRepository_UseInstanceInvite_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockIntegrationRepository_UseInstanceInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIntegrationRepository creates a new instance of MockIntegrationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIntegrationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIntegrationRepository {
+	mock := &MockIntegrationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLockLocker is an autogenerated mock type for the Locker type
+type MockLockLocker struct {
+	mock.Mock
+}
+
+type MockLockLocker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLockLocker) EXPECT() *MockLockLocker_Expecter {
+	return &MockLockLocker_Expecter{mock: &_m.Mock}
+}
+
+// TryLock provides a mock function with given fields: ctx, key, fn
+func (_m *MockLockLocker) TryLock(ctx context.Context, key string, fn func(context.Context) error) (bool, error) {
+	ret := _m.Called(ctx, key, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TryLock")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, func(context.Context) error) (bool, error)); ok {
+		return rf(ctx, key, fn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, func(context.Context) error) bool); ok {
+		r0 = rf(ctx, key, fn)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, func(context.Context) error) error); ok {
+		r1 = rf(ctx, key, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLockLocker_TryLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TryLock'
+type MockLockLocker_TryLock_Call struct {
+	*mock.Call
+}
+
+// TryLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - fn func(context.Context) error
+func (_e *MockLockLocker_Expecter) TryLock(ctx interface{}, key interface{}, fn interface{}) *MockLockLocker_TryLock_Call {
+	return &MockLockLocker_TryLock_Call{Call: _e.mock.On("TryLock", ctx, key, fn)}
+}
+
+func (_c *MockLockLocker_TryLock_Call) Run(run func(ctx context.Context, key string, fn func(context.Context) error)) *MockLockLocker_TryLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockLockLocker_TryLock_Call) Return(_a0 bool, _a1 error) *MockLockLocker_TryLock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLockLocker_TryLock_Call) RunAndReturn(run func(context.Context, string, func(context.Context) error) (bool, error)) *MockLockLocker_TryLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLockLocker creates a new instance of MockLockLocker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLockLocker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLockLocker {
+	mock := &MockLockLocker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
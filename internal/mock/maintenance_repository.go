@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	resource "github.com/spacechunks/explorer/internal/resource"
+)
+
+// MockMaintenanceRepository is an autogenerated mock type for the Repository type
+type MockMaintenanceRepository struct {
+	mock.Mock
+}
+
+type MockMaintenanceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMaintenanceRepository) EXPECT() *MockMaintenanceRepository_Expecter {
+	return &MockMaintenanceRepository_Expecter{mock: &_m.Mock}
+}
+
+// MaintenanceMode provides a mock function with given fields: ctx
+func (_m *MockMaintenanceRepository) MaintenanceMode(ctx context.Context) (resource.MaintenanceMode, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaintenanceMode")
+	}
+
+	var r0 resource.MaintenanceMode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (resource.MaintenanceMode, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) resource.MaintenanceMode); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(resource.MaintenanceMode)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMaintenanceRepository_MaintenanceMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaintenanceMode'
+type MockMaintenanceRepository_MaintenanceMode_Call struct {
+	*mock.Call
+}
+
+// MaintenanceMode is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockMaintenanceRepository_Expecter) MaintenanceMode(ctx interface{}) *MockMaintenanceRepository_MaintenanceMode_Call {
+	return &MockMaintenanceRepository_MaintenanceMode_Call{Call: _e.mock.On("MaintenanceMode", ctx)}
+}
+
+func (_c *MockMaintenanceRepository_MaintenanceMode_Call) Run(run func(ctx context.Context)) *MockMaintenanceRepository_MaintenanceMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockMaintenanceRepository_MaintenanceMode_Call) Return(_a0 resource.MaintenanceMode, _a1 error) *MockMaintenanceRepository_MaintenanceMode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMaintenanceRepository_MaintenanceMode_Call) RunAndReturn(run func(context.Context) (resource.MaintenanceMode, error)) *MockMaintenanceRepository_MaintenanceMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMaintenanceMode provides a mock function with given fields: ctx, enabled, message, actorID
+func (_m *MockMaintenanceRepository) SetMaintenanceMode(ctx context.Context, enabled bool, message string, actorID string) (resource.MaintenanceMode, error) {
+	ret := _m.Called(ctx, enabled, message, actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMaintenanceMode")
+	}
+
+	var r0 resource.MaintenanceMode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool, string, string) (resource.MaintenanceMode, error)); ok {
+		return rf(ctx, enabled, message, actorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool, string, string) resource.MaintenanceMode); ok {
+		r0 = rf(ctx, enabled, message, actorID)
+	} else {
+		r0 = ret.Get(0).(resource.MaintenanceMode)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool, string, string) error); ok {
+		r1 = rf(ctx, enabled, message, actorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMaintenanceRepository_SetMaintenanceMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMaintenanceMode'
+type MockMaintenanceRepository_SetMaintenanceMode_Call struct {
+	*mock.Call
+}
+
+// SetMaintenanceMode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enabled bool
+//   - message string
+//   - actorID string
+func (_e *MockMaintenanceRepository_Expecter) SetMaintenanceMode(ctx interface{}, enabled interface{}, message interface{}, actorID interface{}) *MockMaintenanceRepository_SetMaintenanceMode_Call {
+	return &MockMaintenanceRepository_SetMaintenanceMode_Call{Call: _e.mock.On("SetMaintenanceMode", ctx, enabled, message, actorID)}
+}
+
+func (_c *MockMaintenanceRepository_SetMaintenanceMode_Call) Run(run func(ctx context.Context, enabled bool, message string, actorID string)) *MockMaintenanceRepository_SetMaintenanceMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockMaintenanceRepository_SetMaintenanceMode_Call) Return(_a0 resource.MaintenanceMode, _a1 error) *MockMaintenanceRepository_SetMaintenanceMode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMaintenanceRepository_SetMaintenanceMode_Call) RunAndReturn(run func(context.Context, bool, string, string) (resource.MaintenanceMode, error)) *MockMaintenanceRepository_SetMaintenanceMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMaintenanceRepository creates a new instance of MockMaintenanceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMaintenanceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMaintenanceRepository {
+	mock := &MockMaintenanceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
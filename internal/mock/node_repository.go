@@ -7,6 +7,8 @@ import (
 
 	node "github.com/spacechunks/explorer/controlplane/node"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockNodeRepository is an autogenerated mock type for the Repository type
@@ -22,9 +24,9 @@ func (_m *MockNodeRepository) EXPECT() *MockNodeRepository_Expecter {
 	return &MockNodeRepository_Expecter{mock: &_m.Mock}
 }
 
-// BestNode provides a mock function with given fields: ctx
-func (_m *MockNodeRepository) BestNode(ctx context.Context) (node.Node, error) {
-	ret := _m.Called(ctx)
+// BestNode provides a mock function with given fields: ctx, placementGroup
+func (_m *MockNodeRepository) BestNode(ctx context.Context, placementGroup string) (node.Node, error) {
+	ret := _m.Called(ctx, placementGroup)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BestNode")
@@ -32,17 +34,17 @@ func (_m *MockNodeRepository) BestNode(ctx context.Context) (node.Node, error) {
 
 	var r0 node.Node
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (node.Node, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (node.Node, error)); ok {
+		return rf(ctx, placementGroup)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) node.Node); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) node.Node); ok {
+		r0 = rf(ctx, placementGroup)
 	} else {
 		r0 = ret.Get(0).(node.Node)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, placementGroup)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,13 +59,14 @@ type MockNodeRepository_BestNode_Call struct {
 
 // BestNode is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockNodeRepository_Expecter) BestNode(ctx interface{}) *MockNodeRepository_BestNode_Call {
-	return &MockNodeRepository_BestNode_Call{Call: _e.mock.On("BestNode", ctx)}
+//   - placementGroup string
+func (_e *MockNodeRepository_Expecter) BestNode(ctx interface{}, placementGroup interface{}) *MockNodeRepository_BestNode_Call {
+	return &MockNodeRepository_BestNode_Call{Call: _e.mock.On("BestNode", ctx, placementGroup)}
 }
 
-func (_c *MockNodeRepository_BestNode_Call) Run(run func(ctx context.Context)) *MockNodeRepository_BestNode_Call {
+func (_c *MockNodeRepository_BestNode_Call) Run(run func(ctx context.Context, placementGroup string)) *MockNodeRepository_BestNode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -73,7 +76,122 @@ func (_c *MockNodeRepository_BestNode_Call) Return(_a0 node.Node, _a1 error) *Mo
 	return _c
 }
 
-func (_c *MockNodeRepository_BestNode_Call) RunAndReturn(run func(context.Context) (node.Node, error)) *MockNodeRepository_BestNode_Call {
+func (_c *MockNodeRepository_BestNode_Call) RunAndReturn(run func(context.Context, string) (node.Node, error)) *MockNodeRepository_BestNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNodes provides a mock function with given fields: ctx
+func (_m *MockNodeRepository) ListNodes(ctx context.Context) ([]node.Node, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNodes")
+	}
+
+	var r0 []node.Node
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]node.Node, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []node.Node); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]node.Node)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNodeRepository_ListNodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNodes'
+type MockNodeRepository_ListNodes_Call struct {
+	*mock.Call
+}
+
+// ListNodes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNodeRepository_Expecter) ListNodes(ctx interface{}) *MockNodeRepository_ListNodes_Call {
+	return &MockNodeRepository_ListNodes_Call{Call: _e.mock.On("ListNodes", ctx)}
+}
+
+func (_c *MockNodeRepository_ListNodes_Call) Run(run func(ctx context.Context)) *MockNodeRepository_ListNodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockNodeRepository_ListNodes_Call) Return(_a0 []node.Node, _a1 error) *MockNodeRepository_ListNodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNodeRepository_ListNodes_Call) RunAndReturn(run func(context.Context) ([]node.Node, error)) *MockNodeRepository_ListNodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NodeByInstanceID provides a mock function with given fields: ctx, instanceID
+func (_m *MockNodeRepository) NodeByInstanceID(ctx context.Context, instanceID string) (node.Node, error) {
+	ret := _m.Called(ctx, instanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeByInstanceID")
+	}
+
+	var r0 node.Node
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (node.Node, error)); ok {
+		return rf(ctx, instanceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) node.Node); ok {
+		r0 = rf(ctx, instanceID)
+	} else {
+		r0 = ret.Get(0).(node.Node)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, instanceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNodeRepository_NodeByInstanceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeByInstanceID'
+type MockNodeRepository_NodeByInstanceID_Call struct {
+	*mock.Call
+}
+
+// NodeByInstanceID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instanceID string
+func (_e *MockNodeRepository_Expecter) NodeByInstanceID(ctx interface{}, instanceID interface{}) *MockNodeRepository_NodeByInstanceID_Call {
+	return &MockNodeRepository_NodeByInstanceID_Call{Call: _e.mock.On("NodeByInstanceID", ctx, instanceID)}
+}
+
+func (_c *MockNodeRepository_NodeByInstanceID_Call) Run(run func(ctx context.Context, instanceID string)) *MockNodeRepository_NodeByInstanceID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNodeRepository_NodeByInstanceID_Call) Return(_a0 node.Node, _a1 error) *MockNodeRepository_NodeByInstanceID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNodeRepository_NodeByInstanceID_Call) RunAndReturn(run func(context.Context, string) (node.Node, error)) *MockNodeRepository_NodeByInstanceID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -134,6 +252,113 @@ func (_c *MockNodeRepository_RandomNode_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ScheduleCheckpointBuild provides a mock function with given fields: ctx, flavorVersionID, seenAfter
+func (_m *MockNodeRepository) ScheduleCheckpointBuild(ctx context.Context, flavorVersionID string, seenAfter time.Time) (node.Node, error) {
+	ret := _m.Called(ctx, flavorVersionID, seenAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleCheckpointBuild")
+	}
+
+	var r0 node.Node
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (node.Node, error)); ok {
+		return rf(ctx, flavorVersionID, seenAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) node.Node); ok {
+		r0 = rf(ctx, flavorVersionID, seenAfter)
+	} else {
+		r0 = ret.Get(0).(node.Node)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, flavorVersionID, seenAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNodeRepository_ScheduleCheckpointBuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleCheckpointBuild'
+type MockNodeRepository_ScheduleCheckpointBuild_Call struct {
+	*mock.Call
+}
+
+// ScheduleCheckpointBuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorVersionID string
+//   - seenAfter time.Time
+func (_e *MockNodeRepository_Expecter) ScheduleCheckpointBuild(ctx interface{}, flavorVersionID interface{}, seenAfter interface{}) *MockNodeRepository_ScheduleCheckpointBuild_Call {
+	return &MockNodeRepository_ScheduleCheckpointBuild_Call{Call: _e.mock.On("ScheduleCheckpointBuild", ctx, flavorVersionID, seenAfter)}
+}
+
+func (_c *MockNodeRepository_ScheduleCheckpointBuild_Call) Run(run func(ctx context.Context, flavorVersionID string, seenAfter time.Time)) *MockNodeRepository_ScheduleCheckpointBuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockNodeRepository_ScheduleCheckpointBuild_Call) Return(_a0 node.Node, _a1 error) *MockNodeRepository_ScheduleCheckpointBuild_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNodeRepository_ScheduleCheckpointBuild_Call) RunAndReturn(run func(context.Context, string, time.Time) (node.Node, error)) *MockNodeRepository_ScheduleCheckpointBuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNodeHeartbeat provides a mock function with given fields: ctx, id, capabilities, capacity
+func (_m *MockNodeRepository) UpdateNodeHeartbeat(ctx context.Context, id string, capabilities []node.Capability, capacity *node.Capacity) error {
+	ret := _m.Called(ctx, id, capabilities, capacity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNodeHeartbeat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []node.Capability, *node.Capacity) error); ok {
+		r0 = rf(ctx, id, capabilities, capacity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNodeRepository_UpdateNodeHeartbeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNodeHeartbeat'
+type MockNodeRepository_UpdateNodeHeartbeat_Call struct {
+	*mock.Call
+}
+
+// UpdateNodeHeartbeat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - capabilities []node.Capability
+//   - capacity *node.Capacity
+func (_e *MockNodeRepository_Expecter) UpdateNodeHeartbeat(ctx interface{}, id interface{}, capabilities interface{}, capacity interface{}) *MockNodeRepository_UpdateNodeHeartbeat_Call {
+	return &MockNodeRepository_UpdateNodeHeartbeat_Call{Call: _e.mock.On("UpdateNodeHeartbeat", ctx, id, capabilities, capacity)}
+}
+
+func (_c *MockNodeRepository_UpdateNodeHeartbeat_Call) Run(run func(ctx context.Context, id string, capabilities []node.Capability, capacity *node.Capacity)) *MockNodeRepository_UpdateNodeHeartbeat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]node.Capability), args[3].(*node.Capacity))
+	})
+	return _c
+}
+
+func (_c *MockNodeRepository_UpdateNodeHeartbeat_Call) Return(_a0 error) *MockNodeRepository_UpdateNodeHeartbeat_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNodeRepository_UpdateNodeHeartbeat_Call) RunAndReturn(run func(context.Context, string, []node.Capability, *node.Capacity) error) *MockNodeRepository_UpdateNodeHeartbeat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockNodeRepository creates a new instance of MockNodeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockNodeRepository(t interface {
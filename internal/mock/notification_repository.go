@@ -0,0 +1,165 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	notification "github.com/spacechunks/explorer/controlplane/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockNotificationRepository is an autogenerated mock type for the Repository type
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+type MockNotificationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationRepository) EXPECT() *MockNotificationRepository_Expecter {
+	return &MockNotificationRepository_Expecter{mock: &_m.Mock}
+}
+
+// BuildNotificationData provides a mock function with given fields: ctx, flavorVersionID
+func (_m *MockNotificationRepository) BuildNotificationData(ctx context.Context, flavorVersionID string) (notification.Recipient, notification.Data, error) {
+	ret := _m.Called(ctx, flavorVersionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildNotificationData")
+	}
+
+	var r0 notification.Recipient
+	var r1 notification.Data
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (notification.Recipient, notification.Data, error)); ok {
+		return rf(ctx, flavorVersionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) notification.Recipient); ok {
+		r0 = rf(ctx, flavorVersionID)
+	} else {
+		r0 = ret.Get(0).(notification.Recipient)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) notification.Data); ok {
+		r1 = rf(ctx, flavorVersionID)
+	} else {
+		r1 = ret.Get(1).(notification.Data)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, flavorVersionID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNotificationRepository_BuildNotificationData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildNotificationData'
+type MockNotificationRepository_BuildNotificationData_Call struct {
+	*mock.Call
+}
+
+// BuildNotificationData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorVersionID string
+func (_e *MockNotificationRepository_Expecter) BuildNotificationData(ctx interface{}, flavorVersionID interface{}) *MockNotificationRepository_BuildNotificationData_Call {
+	return &MockNotificationRepository_BuildNotificationData_Call{Call: _e.mock.On("BuildNotificationData", ctx, flavorVersionID)}
+}
+
+func (_c *MockNotificationRepository_BuildNotificationData_Call) Run(run func(ctx context.Context, flavorVersionID string)) *MockNotificationRepository_BuildNotificationData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_BuildNotificationData_Call) Return(_a0 notification.Recipient, _a1 notification.Data, _a2 error) *MockNotificationRepository_BuildNotificationData_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNotificationRepository_BuildNotificationData_Call) RunAndReturn(run func(context.Context, string) (notification.Recipient, notification.Data, error)) *MockNotificationRepository_BuildNotificationData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstanceNotificationData provides a mock function with given fields: ctx, instanceID
+func (_m *MockNotificationRepository) InstanceNotificationData(ctx context.Context, instanceID string) (notification.Recipient, notification.Data, error) {
+	ret := _m.Called(ctx, instanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstanceNotificationData")
+	}
+
+	var r0 notification.Recipient
+	var r1 notification.Data
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (notification.Recipient, notification.Data, error)); ok {
+		return rf(ctx, instanceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) notification.Recipient); ok {
+		r0 = rf(ctx, instanceID)
+	} else {
+		r0 = ret.Get(0).(notification.Recipient)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) notification.Data); ok {
+		r1 = rf(ctx, instanceID)
+	} else {
+		r1 = ret.Get(1).(notification.Data)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, instanceID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNotificationRepository_InstanceNotificationData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstanceNotificationData'
+type MockNotificationRepository_InstanceNotificationData_Call struct {
+	*mock.Call
+}
+
+// InstanceNotificationData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instanceID string
+func (_e *MockNotificationRepository_Expecter) InstanceNotificationData(ctx interface{}, instanceID interface{}) *MockNotificationRepository_InstanceNotificationData_Call {
+	return &MockNotificationRepository_InstanceNotificationData_Call{Call: _e.mock.On("InstanceNotificationData", ctx, instanceID)}
+}
+
+func (_c *MockNotificationRepository_InstanceNotificationData_Call) Run(run func(ctx context.Context, instanceID string)) *MockNotificationRepository_InstanceNotificationData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_InstanceNotificationData_Call) Return(_a0 notification.Recipient, _a1 notification.Data, _a2 error) *MockNotificationRepository_InstanceNotificationData_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNotificationRepository_InstanceNotificationData_Call) RunAndReturn(run func(context.Context, string) (notification.Recipient, notification.Data, error)) *MockNotificationRepository_InstanceNotificationData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationRepository creates a new instance of MockNotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationRepository {
+	mock := &MockNotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	notification "github.com/spacechunks/explorer/controlplane/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockNotificationSender is an autogenerated mock type for the Sender type
+type MockNotificationSender struct {
+	mock.Mock
+}
+
+type MockNotificationSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationSender) EXPECT() *MockNotificationSender_Expecter {
+	return &MockNotificationSender_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, msg
+func (_m *MockNotificationSender) Send(ctx context.Context, msg notification.Message) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Message) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockNotificationSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg notification.Message
+func (_e *MockNotificationSender_Expecter) Send(ctx interface{}, msg interface{}) *MockNotificationSender_Send_Call {
+	return &MockNotificationSender_Send_Call{Call: _e.mock.On("Send", ctx, msg)}
+}
+
+func (_c *MockNotificationSender_Send_Call) Run(run func(ctx context.Context, msg notification.Message)) *MockNotificationSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(notification.Message))
+	})
+	return _c
+}
+
+func (_c *MockNotificationSender_Send_Call) Return(_a0 error) *MockNotificationSender_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationSender_Send_Call) RunAndReturn(run func(context.Context, notification.Message) error) *MockNotificationSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationSender creates a new instance of MockNotificationSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationSender {
+	mock := &MockNotificationSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
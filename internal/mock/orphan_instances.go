@@ -0,0 +1,156 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	resource "github.com/spacechunks/explorer/internal/resource"
+)
+
+// MockOrphanInstances is an autogenerated mock type for the Instances type
+type MockOrphanInstances struct {
+	mock.Mock
+}
+
+type MockOrphanInstances_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrphanInstances) EXPECT() *MockOrphanInstances_Expecter {
+	return &MockOrphanInstances_Expecter{mock: &_m.Mock}
+}
+
+// GetInstance provides a mock function with given fields: ctx, id
+func (_m *MockOrphanInstances) GetInstance(ctx context.Context, id string) (resource.Instance, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstance")
+	}
+
+	var r0 resource.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.Instance, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.Instance); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(resource.Instance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrphanInstances_GetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstance'
+type MockOrphanInstances_GetInstance_Call struct {
+	*mock.Call
+}
+
+// GetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockOrphanInstances_Expecter) GetInstance(ctx interface{}, id interface{}) *MockOrphanInstances_GetInstance_Call {
+	return &MockOrphanInstances_GetInstance_Call{Call: _e.mock.On("GetInstance", ctx, id)}
+}
+
+func (_c *MockOrphanInstances_GetInstance_Call) Run(run func(ctx context.Context, id string)) *MockOrphanInstances_GetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockOrphanInstances_GetInstance_Call) Return(_a0 resource.Instance, _a1 error) *MockOrphanInstances_GetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrphanInstances_GetInstance_Call) RunAndReturn(run func(context.Context, string) (resource.Instance, error)) *MockOrphanInstances_GetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunFlavorVersion provides a mock function with given fields: ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup
+func (_m *MockOrphanInstances) RunFlavorVersion(ctx context.Context, flavorVersionID string, ownerID string, orderedBy string, params resource.InstanceParams, placementGroup string) (resource.Instance, error) {
+	ret := _m.Called(ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunFlavorVersion")
+	}
+
+	var r0 resource.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, resource.InstanceParams, string) (resource.Instance, error)); ok {
+		return rf(ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, resource.InstanceParams, string) resource.Instance); ok {
+		r0 = rf(ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup)
+	} else {
+		r0 = ret.Get(0).(resource.Instance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, resource.InstanceParams, string) error); ok {
+		r1 = rf(ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrphanInstances_RunFlavorVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunFlavorVersion'
+type MockOrphanInstances_RunFlavorVersion_Call struct {
+	*mock.Call
+}
+
+// RunFlavorVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flavorVersionID string
+//   - ownerID string
+//   - orderedBy string
+//   - params resource.InstanceParams
+//   - placementGroup string
+func (_e *MockOrphanInstances_Expecter) RunFlavorVersion(ctx interface{}, flavorVersionID interface{}, ownerID interface{}, orderedBy interface{}, params interface{}, placementGroup interface{}) *MockOrphanInstances_RunFlavorVersion_Call {
+	return &MockOrphanInstances_RunFlavorVersion_Call{Call: _e.mock.On("RunFlavorVersion", ctx, flavorVersionID, ownerID, orderedBy, params, placementGroup)}
+}
+
+func (_c *MockOrphanInstances_RunFlavorVersion_Call) Run(run func(ctx context.Context, flavorVersionID string, ownerID string, orderedBy string, params resource.InstanceParams, placementGroup string)) *MockOrphanInstances_RunFlavorVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(resource.InstanceParams), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockOrphanInstances_RunFlavorVersion_Call) Return(_a0 resource.Instance, _a1 error) *MockOrphanInstances_RunFlavorVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrphanInstances_RunFlavorVersion_Call) RunAndReturn(run func(context.Context, string, string, string, resource.InstanceParams, string) (resource.Instance, error)) *MockOrphanInstances_RunFlavorVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrphanInstances creates a new instance of MockOrphanInstances. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrphanInstances(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrphanInstances {
+	mock := &MockOrphanInstances{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
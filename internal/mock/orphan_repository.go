@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockOrphanRepository is an autogenerated mock type for the Repository type
+type MockOrphanRepository struct {
+	mock.Mock
+}
+
+type MockOrphanRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrphanRepository) EXPECT() *MockOrphanRepository_Expecter {
+	return &MockOrphanRepository_Expecter{mock: &_m.Mock}
+}
+
+// MarkInstancesLost provides a mock function with given fields: ctx, seenBefore
+func (_m *MockOrphanRepository) MarkInstancesLost(ctx context.Context, seenBefore time.Time) ([]string, error) {
+	ret := _m.Called(ctx, seenBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkInstancesLost")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]string, error)); ok {
+		return rf(ctx, seenBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = rf(ctx, seenBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, seenBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrphanRepository_MarkInstancesLost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkInstancesLost'
+type MockOrphanRepository_MarkInstancesLost_Call struct {
+	*mock.Call
+}
+
+// MarkInstancesLost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seenBefore time.Time
+func (_e *MockOrphanRepository_Expecter) MarkInstancesLost(ctx interface{}, seenBefore interface{}) *MockOrphanRepository_MarkInstancesLost_Call {
+	return &MockOrphanRepository_MarkInstancesLost_Call{Call: _e.mock.On("MarkInstancesLost", ctx, seenBefore)}
+}
+
+func (_c *MockOrphanRepository_MarkInstancesLost_Call) Run(run func(ctx context.Context, seenBefore time.Time)) *MockOrphanRepository_MarkInstancesLost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockOrphanRepository_MarkInstancesLost_Call) Return(_a0 []string, _a1 error) *MockOrphanRepository_MarkInstancesLost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrphanRepository_MarkInstancesLost_Call) RunAndReturn(run func(context.Context, time.Time) ([]string, error)) *MockOrphanRepository_MarkInstancesLost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrphanRepository creates a new instance of MockOrphanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrphanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrphanRepository {
+	mock := &MockOrphanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,146 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockPartitionRepository is an autogenerated mock type for the Repository type
+type MockPartitionRepository struct {
+	mock.Mock
+}
+
+type MockPartitionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPartitionRepository) EXPECT() *MockPartitionRepository_Expecter {
+	return &MockPartitionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateMonthlyPartition provides a mock function with given fields: ctx, table, month
+func (_m *MockPartitionRepository) CreateMonthlyPartition(ctx context.Context, table string, month time.Time) error {
+	ret := _m.Called(ctx, table, month)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMonthlyPartition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, table, month)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPartitionRepository_CreateMonthlyPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMonthlyPartition'
+type MockPartitionRepository_CreateMonthlyPartition_Call struct {
+	*mock.Call
+}
+
+// CreateMonthlyPartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - table string
+//   - month time.Time
+func (_e *MockPartitionRepository_Expecter) CreateMonthlyPartition(ctx interface{}, table interface{}, month interface{}) *MockPartitionRepository_CreateMonthlyPartition_Call {
+	return &MockPartitionRepository_CreateMonthlyPartition_Call{Call: _e.mock.On("CreateMonthlyPartition", ctx, table, month)}
+}
+
+func (_c *MockPartitionRepository_CreateMonthlyPartition_Call) Run(run func(ctx context.Context, table string, month time.Time)) *MockPartitionRepository_CreateMonthlyPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPartitionRepository_CreateMonthlyPartition_Call) Return(_a0 error) *MockPartitionRepository_CreateMonthlyPartition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPartitionRepository_CreateMonthlyPartition_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockPartitionRepository_CreateMonthlyPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropMonthlyPartitionsBefore provides a mock function with given fields: ctx, table, cutoff
+func (_m *MockPartitionRepository) DropMonthlyPartitionsBefore(ctx context.Context, table string, cutoff time.Time) ([]string, error) {
+	ret := _m.Called(ctx, table, cutoff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropMonthlyPartitionsBefore")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]string, error)); ok {
+		return rf(ctx, table, cutoff)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []string); ok {
+		r0 = rf(ctx, table, cutoff)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, table, cutoff)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPartitionRepository_DropMonthlyPartitionsBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropMonthlyPartitionsBefore'
+type MockPartitionRepository_DropMonthlyPartitionsBefore_Call struct {
+	*mock.Call
+}
+
+// DropMonthlyPartitionsBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - table string
+//   - cutoff time.Time
+func (_e *MockPartitionRepository_Expecter) DropMonthlyPartitionsBefore(ctx interface{}, table interface{}, cutoff interface{}) *MockPartitionRepository_DropMonthlyPartitionsBefore_Call {
+	return &MockPartitionRepository_DropMonthlyPartitionsBefore_Call{Call: _e.mock.On("DropMonthlyPartitionsBefore", ctx, table, cutoff)}
+}
+
+func (_c *MockPartitionRepository_DropMonthlyPartitionsBefore_Call) Run(run func(ctx context.Context, table string, cutoff time.Time)) *MockPartitionRepository_DropMonthlyPartitionsBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPartitionRepository_DropMonthlyPartitionsBefore_Call) Return(_a0 []string, _a1 error) *MockPartitionRepository_DropMonthlyPartitionsBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPartitionRepository_DropMonthlyPartitionsBefore_Call) RunAndReturn(run func(context.Context, string, time.Time) ([]string, error)) *MockPartitionRepository_DropMonthlyPartitionsBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPartitionRepository creates a new instance of MockPartitionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPartitionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPartitionRepository {
+	mock := &MockPartitionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,228 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+	netip "net/netip"
+
+	mock "github.com/stretchr/testify/mock"
+
+	proxy "github.com/spacechunks/explorer/platformd/proxy"
+)
+
+// MockProxyService is an autogenerated mock type for the Service type
+type MockProxyService struct {
+	mock.Mock
+}
+
+type MockProxyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProxyService) EXPECT() *MockProxyService_Expecter {
+	return &MockProxyService_Expecter{mock: &_m.Mock}
+}
+
+// ApplyGlobalResources provides a mock function with given fields: ctx
+func (_m *MockProxyService) ApplyGlobalResources(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyGlobalResources")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxyService_ApplyGlobalResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyGlobalResources'
+type MockProxyService_ApplyGlobalResources_Call struct {
+	*mock.Call
+}
+
+// ApplyGlobalResources is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProxyService_Expecter) ApplyGlobalResources(ctx interface{}) *MockProxyService_ApplyGlobalResources_Call {
+	return &MockProxyService_ApplyGlobalResources_Call{Call: _e.mock.On("ApplyGlobalResources", ctx)}
+}
+
+func (_c *MockProxyService_ApplyGlobalResources_Call) Run(run func(ctx context.Context)) *MockProxyService_ApplyGlobalResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProxyService_ApplyGlobalResources_Call) Return(_a0 error) *MockProxyService_ApplyGlobalResources_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxyService_ApplyGlobalResources_Call) RunAndReturn(run func(context.Context) error) *MockProxyService_ApplyGlobalResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApplyRedirects provides a mock function with given fields: ctx, redirects
+func (_m *MockProxyService) ApplyRedirects(ctx context.Context, redirects []proxy.Redirect) error {
+	ret := _m.Called(ctx, redirects)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyRedirects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []proxy.Redirect) error); ok {
+		r0 = rf(ctx, redirects)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxyService_ApplyRedirects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyRedirects'
+type MockProxyService_ApplyRedirects_Call struct {
+	*mock.Call
+}
+
+// ApplyRedirects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - redirects []proxy.Redirect
+func (_e *MockProxyService_Expecter) ApplyRedirects(ctx interface{}, redirects interface{}) *MockProxyService_ApplyRedirects_Call {
+	return &MockProxyService_ApplyRedirects_Call{Call: _e.mock.On("ApplyRedirects", ctx, redirects)}
+}
+
+func (_c *MockProxyService_ApplyRedirects_Call) Run(run func(ctx context.Context, redirects []proxy.Redirect)) *MockProxyService_ApplyRedirects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]proxy.Redirect))
+	})
+	return _c
+}
+
+func (_c *MockProxyService_ApplyRedirects_Call) Return(_a0 error) *MockProxyService_ApplyRedirects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxyService_ApplyRedirects_Call) RunAndReturn(run func(context.Context, []proxy.Redirect) error) *MockProxyService_ApplyRedirects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateListeners provides a mock function with given fields: ctx, workloadID, addr, isolated
+func (_m *MockProxyService) CreateListeners(ctx context.Context, workloadID string, addr netip.Addr, isolated []netip.Prefix) error {
+	ret := _m.Called(ctx, workloadID, addr, isolated)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateListeners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, netip.Addr, []netip.Prefix) error); ok {
+		r0 = rf(ctx, workloadID, addr, isolated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxyService_CreateListeners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateListeners'
+type MockProxyService_CreateListeners_Call struct {
+	*mock.Call
+}
+
+// CreateListeners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workloadID string
+//   - addr netip.Addr
+//   - isolated []netip.Prefix
+func (_e *MockProxyService_Expecter) CreateListeners(ctx interface{}, workloadID interface{}, addr interface{}, isolated interface{}) *MockProxyService_CreateListeners_Call {
+	return &MockProxyService_CreateListeners_Call{Call: _e.mock.On("CreateListeners", ctx, workloadID, addr, isolated)}
+}
+
+func (_c *MockProxyService_CreateListeners_Call) Run(run func(ctx context.Context, workloadID string, addr netip.Addr, isolated []netip.Prefix)) *MockProxyService_CreateListeners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(netip.Addr), args[3].([]netip.Prefix))
+	})
+	return _c
+}
+
+func (_c *MockProxyService_CreateListeners_Call) Return(_a0 error) *MockProxyService_CreateListeners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxyService_CreateListeners_Call) RunAndReturn(run func(context.Context, string, netip.Addr, []netip.Prefix) error) *MockProxyService_CreateListeners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteListeners provides a mock function with given fields: ctx, workloadID
+func (_m *MockProxyService) DeleteListeners(ctx context.Context, workloadID string) error {
+	ret := _m.Called(ctx, workloadID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteListeners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, workloadID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxyService_DeleteListeners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteListeners'
+type MockProxyService_DeleteListeners_Call struct {
+	*mock.Call
+}
+
+// DeleteListeners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workloadID string
+func (_e *MockProxyService_Expecter) DeleteListeners(ctx interface{}, workloadID interface{}) *MockProxyService_DeleteListeners_Call {
+	return &MockProxyService_DeleteListeners_Call{Call: _e.mock.On("DeleteListeners", ctx, workloadID)}
+}
+
+func (_c *MockProxyService_DeleteListeners_Call) Run(run func(ctx context.Context, workloadID string)) *MockProxyService_DeleteListeners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProxyService_DeleteListeners_Call) Return(_a0 error) *MockProxyService_DeleteListeners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxyService_DeleteListeners_Call) RunAndReturn(run func(context.Context, string) error) *MockProxyService_DeleteListeners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProxyService creates a new instance of MockProxyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProxyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProxyService {
+	mock := &MockProxyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,263 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	rollout "github.com/spacechunks/explorer/controlplane/rollout"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRolloutRepository is an autogenerated mock type for the Repository type
+type MockRolloutRepository struct {
+	mock.Mock
+}
+
+type MockRolloutRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRolloutRepository) EXPECT() *MockRolloutRepository_Expecter {
+	return &MockRolloutRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListPlatformdRollouts provides a mock function with given fields: ctx
+func (_m *MockRolloutRepository) ListPlatformdRollouts(ctx context.Context) ([]rollout.Rollout, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPlatformdRollouts")
+	}
+
+	var r0 []rollout.Rollout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]rollout.Rollout, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []rollout.Rollout); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rollout.Rollout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRolloutRepository_ListPlatformdRollouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPlatformdRollouts'
+type MockRolloutRepository_ListPlatformdRollouts_Call struct {
+	*mock.Call
+}
+
+// ListPlatformdRollouts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRolloutRepository_Expecter) ListPlatformdRollouts(ctx interface{}) *MockRolloutRepository_ListPlatformdRollouts_Call {
+	return &MockRolloutRepository_ListPlatformdRollouts_Call{Call: _e.mock.On("ListPlatformdRollouts", ctx)}
+}
+
+func (_c *MockRolloutRepository_ListPlatformdRollouts_Call) Run(run func(ctx context.Context)) *MockRolloutRepository_ListPlatformdRollouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRolloutRepository_ListPlatformdRollouts_Call) Return(_a0 []rollout.Rollout, _a1 error) *MockRolloutRepository_ListPlatformdRollouts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRolloutRepository_ListPlatformdRollouts_Call) RunAndReturn(run func(context.Context) ([]rollout.Rollout, error)) *MockRolloutRepository_ListPlatformdRollouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportPlatformdVersion provides a mock function with given fields: ctx, nodeID, version
+func (_m *MockRolloutRepository) ReportPlatformdVersion(ctx context.Context, nodeID string, version string) (rollout.Rollout, error) {
+	ret := _m.Called(ctx, nodeID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportPlatformdVersion")
+	}
+
+	var r0 rollout.Rollout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (rollout.Rollout, error)); ok {
+		return rf(ctx, nodeID, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) rollout.Rollout); ok {
+		r0 = rf(ctx, nodeID, version)
+	} else {
+		r0 = ret.Get(0).(rollout.Rollout)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, nodeID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRolloutRepository_ReportPlatformdVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportPlatformdVersion'
+type MockRolloutRepository_ReportPlatformdVersion_Call struct {
+	*mock.Call
+}
+
+// ReportPlatformdVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID string
+//   - version string
+func (_e *MockRolloutRepository_Expecter) ReportPlatformdVersion(ctx interface{}, nodeID interface{}, version interface{}) *MockRolloutRepository_ReportPlatformdVersion_Call {
+	return &MockRolloutRepository_ReportPlatformdVersion_Call{Call: _e.mock.On("ReportPlatformdVersion", ctx, nodeID, version)}
+}
+
+func (_c *MockRolloutRepository_ReportPlatformdVersion_Call) Run(run func(ctx context.Context, nodeID string, version string)) *MockRolloutRepository_ReportPlatformdVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRolloutRepository_ReportPlatformdVersion_Call) Return(_a0 rollout.Rollout, _a1 error) *MockRolloutRepository_ReportPlatformdVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRolloutRepository_ReportPlatformdVersion_Call) RunAndReturn(run func(context.Context, string, string) (rollout.Rollout, error)) *MockRolloutRepository_ReportPlatformdVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDesiredPlatformdVersion provides a mock function with given fields: ctx, nodeIDs, version
+func (_m *MockRolloutRepository) SetDesiredPlatformdVersion(ctx context.Context, nodeIDs []string, version string) ([]rollout.Rollout, error) {
+	ret := _m.Called(ctx, nodeIDs, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDesiredPlatformdVersion")
+	}
+
+	var r0 []rollout.Rollout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string) ([]rollout.Rollout, error)); ok {
+		return rf(ctx, nodeIDs, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string) []rollout.Rollout); ok {
+		r0 = rf(ctx, nodeIDs, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rollout.Rollout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string) error); ok {
+		r1 = rf(ctx, nodeIDs, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRolloutRepository_SetDesiredPlatformdVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDesiredPlatformdVersion'
+type MockRolloutRepository_SetDesiredPlatformdVersion_Call struct {
+	*mock.Call
+}
+
+// SetDesiredPlatformdVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeIDs []string
+//   - version string
+func (_e *MockRolloutRepository_Expecter) SetDesiredPlatformdVersion(ctx interface{}, nodeIDs interface{}, version interface{}) *MockRolloutRepository_SetDesiredPlatformdVersion_Call {
+	return &MockRolloutRepository_SetDesiredPlatformdVersion_Call{Call: _e.mock.On("SetDesiredPlatformdVersion", ctx, nodeIDs, version)}
+}
+
+func (_c *MockRolloutRepository_SetDesiredPlatformdVersion_Call) Run(run func(ctx context.Context, nodeIDs []string, version string)) *MockRolloutRepository_SetDesiredPlatformdVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRolloutRepository_SetDesiredPlatformdVersion_Call) Return(_a0 []rollout.Rollout, _a1 error) *MockRolloutRepository_SetDesiredPlatformdVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRolloutRepository_SetDesiredPlatformdVersion_Call) RunAndReturn(run func(context.Context, []string, string) ([]rollout.Rollout, error)) *MockRolloutRepository_SetDesiredPlatformdVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePlatformdUpdateStatus provides a mock function with given fields: ctx, nodeID, version, status, message
+func (_m *MockRolloutRepository) UpdatePlatformdUpdateStatus(ctx context.Context, nodeID string, version string, status rollout.Status, message string) error {
+	ret := _m.Called(ctx, nodeID, version, status, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePlatformdUpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, rollout.Status, string) error); ok {
+		r0 = rf(ctx, nodeID, version, status, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRolloutRepository_UpdatePlatformdUpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePlatformdUpdateStatus'
+type MockRolloutRepository_UpdatePlatformdUpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdatePlatformdUpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID string
+//   - version string
+//   - status rollout.Status
+//   - message string
+func (_e *MockRolloutRepository_Expecter) UpdatePlatformdUpdateStatus(ctx interface{}, nodeID interface{}, version interface{}, status interface{}, message interface{}) *MockRolloutRepository_UpdatePlatformdUpdateStatus_Call {
+	return &MockRolloutRepository_UpdatePlatformdUpdateStatus_Call{Call: _e.mock.On("UpdatePlatformdUpdateStatus", ctx, nodeID, version, status, message)}
+}
+
+func (_c *MockRolloutRepository_UpdatePlatformdUpdateStatus_Call) Run(run func(ctx context.Context, nodeID string, version string, status rollout.Status, message string)) *MockRolloutRepository_UpdatePlatformdUpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(rollout.Status), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockRolloutRepository_UpdatePlatformdUpdateStatus_Call) Return(_a0 error) *MockRolloutRepository_UpdatePlatformdUpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRolloutRepository_UpdatePlatformdUpdateStatus_Call) RunAndReturn(run func(context.Context, string, string, rollout.Status, string) error) *MockRolloutRepository_UpdatePlatformdUpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRolloutRepository creates a new instance of MockRolloutRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRolloutRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRolloutRepository {
+	mock := &MockRolloutRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,194 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	schedule "github.com/spacechunks/explorer/controlplane/schedule"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockScheduleRepository is an autogenerated mock type for the Repository type
+type MockScheduleRepository struct {
+	mock.Mock
+}
+
+type MockScheduleRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockScheduleRepository) EXPECT() *MockScheduleRepository_Expecter {
+	return &MockScheduleRepository_Expecter{mock: &_m.Mock}
+}
+
+// ActiveInstanceSchedules provides a mock function with given fields: ctx
+func (_m *MockScheduleRepository) ActiveInstanceSchedules(ctx context.Context) ([]schedule.Target, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActiveInstanceSchedules")
+	}
+
+	var r0 []schedule.Target
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schedule.Target, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schedule.Target); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedule.Target)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockScheduleRepository_ActiveInstanceSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActiveInstanceSchedules'
+type MockScheduleRepository_ActiveInstanceSchedules_Call struct {
+	*mock.Call
+}
+
+// ActiveInstanceSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockScheduleRepository_Expecter) ActiveInstanceSchedules(ctx interface{}) *MockScheduleRepository_ActiveInstanceSchedules_Call {
+	return &MockScheduleRepository_ActiveInstanceSchedules_Call{Call: _e.mock.On("ActiveInstanceSchedules", ctx)}
+}
+
+func (_c *MockScheduleRepository_ActiveInstanceSchedules_Call) Run(run func(ctx context.Context)) *MockScheduleRepository_ActiveInstanceSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockScheduleRepository_ActiveInstanceSchedules_Call) Return(_a0 []schedule.Target, _a1 error) *MockScheduleRepository_ActiveInstanceSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockScheduleRepository_ActiveInstanceSchedules_Call) RunAndReturn(run func(context.Context) ([]schedule.Target, error)) *MockScheduleRepository_ActiveInstanceSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkInstanceScheduleEvaluated provides a mock function with given fields: ctx, id, at
+func (_m *MockScheduleRepository) MarkInstanceScheduleEvaluated(ctx context.Context, id string, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkInstanceScheduleEvaluated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockScheduleRepository_MarkInstanceScheduleEvaluated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkInstanceScheduleEvaluated'
+type MockScheduleRepository_MarkInstanceScheduleEvaluated_Call struct {
+	*mock.Call
+}
+
+// MarkInstanceScheduleEvaluated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - at time.Time
+func (_e *MockScheduleRepository_Expecter) MarkInstanceScheduleEvaluated(ctx interface{}, id interface{}, at interface{}) *MockScheduleRepository_MarkInstanceScheduleEvaluated_Call {
+	return &MockScheduleRepository_MarkInstanceScheduleEvaluated_Call{Call: _e.mock.On("MarkInstanceScheduleEvaluated", ctx, id, at)}
+}
+
+func (_c *MockScheduleRepository_MarkInstanceScheduleEvaluated_Call) Run(run func(ctx context.Context, id string, at time.Time)) *MockScheduleRepository_MarkInstanceScheduleEvaluated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockScheduleRepository_MarkInstanceScheduleEvaluated_Call) Return(_a0 error) *MockScheduleRepository_MarkInstanceScheduleEvaluated_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScheduleRepository_MarkInstanceScheduleEvaluated_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockScheduleRepository_MarkInstanceScheduleEvaluated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordInstanceScheduleRun provides a mock function with given fields: ctx, id, instanceID, at
+func (_m *MockScheduleRepository) RecordInstanceScheduleRun(ctx context.Context, id string, instanceID string, at time.Time) error {
+	ret := _m.Called(ctx, id, instanceID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordInstanceScheduleRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, id, instanceID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockScheduleRepository_RecordInstanceScheduleRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordInstanceScheduleRun'
+type MockScheduleRepository_RecordInstanceScheduleRun_Call struct {
+	*mock.Call
+}
+
+// RecordInstanceScheduleRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - instanceID string
+//   - at time.Time
+func (_e *MockScheduleRepository_Expecter) RecordInstanceScheduleRun(ctx interface{}, id interface{}, instanceID interface{}, at interface{}) *MockScheduleRepository_RecordInstanceScheduleRun_Call {
+	return &MockScheduleRepository_RecordInstanceScheduleRun_Call{Call: _e.mock.On("RecordInstanceScheduleRun", ctx, id, instanceID, at)}
+}
+
+func (_c *MockScheduleRepository_RecordInstanceScheduleRun_Call) Run(run func(ctx context.Context, id string, instanceID string, at time.Time)) *MockScheduleRepository_RecordInstanceScheduleRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockScheduleRepository_RecordInstanceScheduleRun_Call) Return(_a0 error) *MockScheduleRepository_RecordInstanceScheduleRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScheduleRepository_RecordInstanceScheduleRun_Call) RunAndReturn(run func(context.Context, string, string, time.Time) error) *MockScheduleRepository_RecordInstanceScheduleRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockScheduleRepository creates a new instance of MockScheduleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockScheduleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockScheduleRepository {
+	mock := &MockScheduleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,270 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	resource "github.com/spacechunks/explorer/internal/resource"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSettingsRepository is an autogenerated mock type for the Repository type
+type MockSettingsRepository struct {
+	mock.Mock
+}
+
+type MockSettingsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSettingsRepository) EXPECT() *MockSettingsRepository_Expecter {
+	return &MockSettingsRepository_Expecter{mock: &_m.Mock}
+}
+
+// RuntimeSettingChanges provides a mock function with given fields: ctx, limit
+func (_m *MockSettingsRepository) RuntimeSettingChanges(ctx context.Context, limit int) ([]resource.RuntimeSettingChange, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RuntimeSettingChanges")
+	}
+
+	var r0 []resource.RuntimeSettingChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]resource.RuntimeSettingChange, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []resource.RuntimeSettingChange); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.RuntimeSettingChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSettingsRepository_RuntimeSettingChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RuntimeSettingChanges'
+type MockSettingsRepository_RuntimeSettingChanges_Call struct {
+	*mock.Call
+}
+
+// RuntimeSettingChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockSettingsRepository_Expecter) RuntimeSettingChanges(ctx interface{}, limit interface{}) *MockSettingsRepository_RuntimeSettingChanges_Call {
+	return &MockSettingsRepository_RuntimeSettingChanges_Call{Call: _e.mock.On("RuntimeSettingChanges", ctx, limit)}
+}
+
+func (_c *MockSettingsRepository_RuntimeSettingChanges_Call) Run(run func(ctx context.Context, limit int)) *MockSettingsRepository_RuntimeSettingChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockSettingsRepository_RuntimeSettingChanges_Call) Return(_a0 []resource.RuntimeSettingChange, _a1 error) *MockSettingsRepository_RuntimeSettingChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSettingsRepository_RuntimeSettingChanges_Call) RunAndReturn(run func(context.Context, int) ([]resource.RuntimeSettingChange, error)) *MockSettingsRepository_RuntimeSettingChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RuntimeSettings provides a mock function with given fields: ctx
+func (_m *MockSettingsRepository) RuntimeSettings(ctx context.Context) (resource.RuntimeSettings, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RuntimeSettings")
+	}
+
+	var r0 resource.RuntimeSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (resource.RuntimeSettings, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) resource.RuntimeSettings); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(resource.RuntimeSettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSettingsRepository_RuntimeSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RuntimeSettings'
+type MockSettingsRepository_RuntimeSettings_Call struct {
+	*mock.Call
+}
+
+// RuntimeSettings is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSettingsRepository_Expecter) RuntimeSettings(ctx interface{}) *MockSettingsRepository_RuntimeSettings_Call {
+	return &MockSettingsRepository_RuntimeSettings_Call{Call: _e.mock.On("RuntimeSettings", ctx)}
+}
+
+func (_c *MockSettingsRepository_RuntimeSettings_Call) Run(run func(ctx context.Context)) *MockSettingsRepository_RuntimeSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSettingsRepository_RuntimeSettings_Call) Return(_a0 resource.RuntimeSettings, _a1 error) *MockSettingsRepository_RuntimeSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSettingsRepository_RuntimeSettings_Call) RunAndReturn(run func(context.Context) (resource.RuntimeSettings, error)) *MockSettingsRepository_RuntimeSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFeatureFlag provides a mock function with given fields: ctx, flag, enabled, actorID
+func (_m *MockSettingsRepository) SetFeatureFlag(ctx context.Context, flag resource.FeatureFlag, enabled bool, actorID string) (resource.RuntimeSettings, error) {
+	ret := _m.Called(ctx, flag, enabled, actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFeatureFlag")
+	}
+
+	var r0 resource.RuntimeSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.FeatureFlag, bool, string) (resource.RuntimeSettings, error)); ok {
+		return rf(ctx, flag, enabled, actorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.FeatureFlag, bool, string) resource.RuntimeSettings); ok {
+		r0 = rf(ctx, flag, enabled, actorID)
+	} else {
+		r0 = ret.Get(0).(resource.RuntimeSettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.FeatureFlag, bool, string) error); ok {
+		r1 = rf(ctx, flag, enabled, actorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSettingsRepository_SetFeatureFlag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFeatureFlag'
+type MockSettingsRepository_SetFeatureFlag_Call struct {
+	*mock.Call
+}
+
+// SetFeatureFlag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flag resource.FeatureFlag
+//   - enabled bool
+//   - actorID string
+func (_e *MockSettingsRepository_Expecter) SetFeatureFlag(ctx interface{}, flag interface{}, enabled interface{}, actorID interface{}) *MockSettingsRepository_SetFeatureFlag_Call {
+	return &MockSettingsRepository_SetFeatureFlag_Call{Call: _e.mock.On("SetFeatureFlag", ctx, flag, enabled, actorID)}
+}
+
+func (_c *MockSettingsRepository_SetFeatureFlag_Call) Run(run func(ctx context.Context, flag resource.FeatureFlag, enabled bool, actorID string)) *MockSettingsRepository_SetFeatureFlag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.FeatureFlag), args[2].(bool), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockSettingsRepository_SetFeatureFlag_Call) Return(_a0 resource.RuntimeSettings, _a1 error) *MockSettingsRepository_SetFeatureFlag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSettingsRepository_SetFeatureFlag_Call) RunAndReturn(run func(context.Context, resource.FeatureFlag, bool, string) (resource.RuntimeSettings, error)) *MockSettingsRepository_SetFeatureFlag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLogLevels provides a mock function with given fields: ctx, target, spec, actorID
+func (_m *MockSettingsRepository) SetLogLevels(ctx context.Context, target resource.LogTarget, spec string, actorID string) (resource.RuntimeSettings, error) {
+	ret := _m.Called(ctx, target, spec, actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLogLevels")
+	}
+
+	var r0 resource.RuntimeSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.LogTarget, string, string) (resource.RuntimeSettings, error)); ok {
+		return rf(ctx, target, spec, actorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.LogTarget, string, string) resource.RuntimeSettings); ok {
+		r0 = rf(ctx, target, spec, actorID)
+	} else {
+		r0 = ret.Get(0).(resource.RuntimeSettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.LogTarget, string, string) error); ok {
+		r1 = rf(ctx, target, spec, actorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSettingsRepository_SetLogLevels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLogLevels'
+type MockSettingsRepository_SetLogLevels_Call struct {
+	*mock.Call
+}
+
+// SetLogLevels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target resource.LogTarget
+//   - spec string
+//   - actorID string
+func (_e *MockSettingsRepository_Expecter) SetLogLevels(ctx interface{}, target interface{}, spec interface{}, actorID interface{}) *MockSettingsRepository_SetLogLevels_Call {
+	return &MockSettingsRepository_SetLogLevels_Call{Call: _e.mock.On("SetLogLevels", ctx, target, spec, actorID)}
+}
+
+func (_c *MockSettingsRepository_SetLogLevels_Call) Run(run func(ctx context.Context, target resource.LogTarget, spec string, actorID string)) *MockSettingsRepository_SetLogLevels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.LogTarget), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockSettingsRepository_SetLogLevels_Call) Return(_a0 resource.RuntimeSettings, _a1 error) *MockSettingsRepository_SetLogLevels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSettingsRepository_SetLogLevels_Call) RunAndReturn(run func(context.Context, resource.LogTarget, string, string) (resource.RuntimeSettings, error)) *MockSettingsRepository_SetLogLevels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSettingsRepository creates a new instance of MockSettingsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSettingsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSettingsRepository {
+	mock := &MockSettingsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
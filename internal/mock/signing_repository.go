@@ -0,0 +1,189 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	signing "github.com/spacechunks/explorer/controlplane/signing"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSigningRepository is an autogenerated mock type for the Repository type
+type MockSigningRepository struct {
+	mock.Mock
+}
+
+type MockSigningRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSigningRepository) EXPECT() *MockSigningRepository_Expecter {
+	return &MockSigningRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateSigningKey provides a mock function with given fields: ctx, key
+func (_m *MockSigningRepository) CreateSigningKey(ctx context.Context, key signing.Key) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSigningKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, signing.Key) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSigningRepository_CreateSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSigningKey'
+type MockSigningRepository_CreateSigningKey_Call struct {
+	*mock.Call
+}
+
+// CreateSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key signing.Key
+func (_e *MockSigningRepository_Expecter) CreateSigningKey(ctx interface{}, key interface{}) *MockSigningRepository_CreateSigningKey_Call {
+	return &MockSigningRepository_CreateSigningKey_Call{Call: _e.mock.On("CreateSigningKey", ctx, key)}
+}
+
+func (_c *MockSigningRepository_CreateSigningKey_Call) Run(run func(ctx context.Context, key signing.Key)) *MockSigningRepository_CreateSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(signing.Key))
+	})
+	return _c
+}
+
+func (_c *MockSigningRepository_CreateSigningKey_Call) Return(_a0 error) *MockSigningRepository_CreateSigningKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSigningRepository_CreateSigningKey_Call) RunAndReturn(run func(context.Context, signing.Key) error) *MockSigningRepository_CreateSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSigningKeys provides a mock function with given fields: ctx, ids
+func (_m *MockSigningRepository) DeleteSigningKeys(ctx context.Context, ids []string) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSigningKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSigningRepository_DeleteSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSigningKeys'
+type MockSigningRepository_DeleteSigningKeys_Call struct {
+	*mock.Call
+}
+
+// DeleteSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *MockSigningRepository_Expecter) DeleteSigningKeys(ctx interface{}, ids interface{}) *MockSigningRepository_DeleteSigningKeys_Call {
+	return &MockSigningRepository_DeleteSigningKeys_Call{Call: _e.mock.On("DeleteSigningKeys", ctx, ids)}
+}
+
+func (_c *MockSigningRepository_DeleteSigningKeys_Call) Run(run func(ctx context.Context, ids []string)) *MockSigningRepository_DeleteSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockSigningRepository_DeleteSigningKeys_Call) Return(_a0 error) *MockSigningRepository_DeleteSigningKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSigningRepository_DeleteSigningKeys_Call) RunAndReturn(run func(context.Context, []string) error) *MockSigningRepository_DeleteSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningKeys provides a mock function with given fields: ctx
+func (_m *MockSigningRepository) SigningKeys(ctx context.Context) ([]signing.Key, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKeys")
+	}
+
+	var r0 []signing.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]signing.Key, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []signing.Key); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]signing.Key)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSigningRepository_SigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKeys'
+type MockSigningRepository_SigningKeys_Call struct {
+	*mock.Call
+}
+
+// SigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSigningRepository_Expecter) SigningKeys(ctx interface{}) *MockSigningRepository_SigningKeys_Call {
+	return &MockSigningRepository_SigningKeys_Call{Call: _e.mock.On("SigningKeys", ctx)}
+}
+
+func (_c *MockSigningRepository_SigningKeys_Call) Run(run func(ctx context.Context)) *MockSigningRepository_SigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSigningRepository_SigningKeys_Call) Return(_a0 []signing.Key, _a1 error) *MockSigningRepository_SigningKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSigningRepository_SigningKeys_Call) RunAndReturn(run func(context.Context) ([]signing.Key, error)) *MockSigningRepository_SigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSigningRepository creates a new instance of MockSigningRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSigningRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSigningRepository {
+	mock := &MockSigningRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
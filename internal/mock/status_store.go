@@ -20,6 +20,169 @@ func (_m *MockStatusStore) EXPECT() *MockStatusStore_Expecter {
 	return &MockStatusStore_Expecter{mock: &_m.Mock}
 }
 
+// Changes provides a mock function with given fields: since
+func (_m *MockStatusStore) Changes(since uint64) (map[string]status.Entry, uint64) {
+	ret := _m.Called(since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Changes")
+	}
+
+	var r0 map[string]status.Entry
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(uint64) (map[string]status.Entry, uint64)); ok {
+		return rf(since)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) map[string]status.Entry); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]status.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) uint64); ok {
+		r1 = rf(since)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
+// MockStatusStore_Changes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Changes'
+type MockStatusStore_Changes_Call struct {
+	*mock.Call
+}
+
+// Changes is a helper method to define mock.On call
+//   - since uint64
+func (_e *MockStatusStore_Expecter) Changes(since interface{}) *MockStatusStore_Changes_Call {
+	return &MockStatusStore_Changes_Call{Call: _e.mock.On("Changes", since)}
+}
+
+func (_c *MockStatusStore_Changes_Call) Run(run func(since uint64)) *MockStatusStore_Changes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockStatusStore_Changes_Call) Return(_a0 map[string]status.Entry, _a1 uint64) *MockStatusStore_Changes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStatusStore_Changes_Call) RunAndReturn(run func(uint64) (map[string]status.Entry, uint64)) *MockStatusStore_Changes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompareAndDelete provides a mock function with given fields: id, version
+func (_m *MockStatusStore) CompareAndDelete(id string, version uint64) bool {
+	ret := _m.Called(id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareAndDelete")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, uint64) bool); ok {
+		r0 = rf(id, version)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockStatusStore_CompareAndDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompareAndDelete'
+type MockStatusStore_CompareAndDelete_Call struct {
+	*mock.Call
+}
+
+// CompareAndDelete is a helper method to define mock.On call
+//   - id string
+//   - version uint64
+func (_e *MockStatusStore_Expecter) CompareAndDelete(id interface{}, version interface{}) *MockStatusStore_CompareAndDelete_Call {
+	return &MockStatusStore_CompareAndDelete_Call{Call: _e.mock.On("CompareAndDelete", id, version)}
+}
+
+func (_c *MockStatusStore_CompareAndDelete_Call) Run(run func(id string, version uint64)) *MockStatusStore_CompareAndDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockStatusStore_CompareAndDelete_Call) Return(_a0 bool) *MockStatusStore_CompareAndDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStatusStore_CompareAndDelete_Call) RunAndReturn(run func(string, uint64) bool) *MockStatusStore_CompareAndDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompareAndSet provides a mock function with given fields: id, version, _a2
+func (_m *MockStatusStore) CompareAndSet(id string, version uint64, _a2 status.Status) (uint64, bool) {
+	ret := _m.Called(id, version, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareAndSet")
+	}
+
+	var r0 uint64
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string, uint64, status.Status) (uint64, bool)); ok {
+		return rf(id, version, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint64, status.Status) uint64); ok {
+		r0 = rf(id, version, _a2)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint64, status.Status) bool); ok {
+		r1 = rf(id, version, _a2)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockStatusStore_CompareAndSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompareAndSet'
+type MockStatusStore_CompareAndSet_Call struct {
+	*mock.Call
+}
+
+// CompareAndSet is a helper method to define mock.On call
+//   - id string
+//   - version uint64
+//   - _a2 status.Status
+func (_e *MockStatusStore_Expecter) CompareAndSet(id interface{}, version interface{}, _a2 interface{}) *MockStatusStore_CompareAndSet_Call {
+	return &MockStatusStore_CompareAndSet_Call{Call: _e.mock.On("CompareAndSet", id, version, _a2)}
+}
+
+func (_c *MockStatusStore_CompareAndSet_Call) Run(run func(id string, version uint64, _a2 status.Status)) *MockStatusStore_CompareAndSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(uint64), args[2].(status.Status))
+	})
+	return _c
+}
+
+func (_c *MockStatusStore_CompareAndSet_Call) Return(_a0 uint64, _a1 bool) *MockStatusStore_CompareAndSet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStatusStore_CompareAndSet_Call) RunAndReturn(run func(string, uint64, status.Status) (uint64, bool)) *MockStatusStore_CompareAndSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Del provides a mock function with given fields: id
 func (_m *MockStatusStore) Del(id string) {
 	_m.Called(id)
@@ -101,6 +264,143 @@ func (_c *MockStatusStore_Get_Call) RunAndReturn(run func(string) *status.Status
 	return _c
 }
 
+// Lookup provides a mock function with given fields: id
+func (_m *MockStatusStore) Lookup(id string) (status.Entry, bool) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lookup")
+	}
+
+	var r0 status.Entry
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (status.Entry, bool)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) status.Entry); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(status.Entry)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockStatusStore_Lookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lookup'
+type MockStatusStore_Lookup_Call struct {
+	*mock.Call
+}
+
+// Lookup is a helper method to define mock.On call
+//   - id string
+func (_e *MockStatusStore_Expecter) Lookup(id interface{}) *MockStatusStore_Lookup_Call {
+	return &MockStatusStore_Lookup_Call{Call: _e.mock.On("Lookup", id)}
+}
+
+func (_c *MockStatusStore_Lookup_Call) Run(run func(id string)) *MockStatusStore_Lookup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockStatusStore_Lookup_Call) Return(_a0 status.Entry, _a1 bool) *MockStatusStore_Lookup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStatusStore_Lookup_Call) RunAndReturn(run func(string) (status.Entry, bool)) *MockStatusStore_Lookup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Notify provides a mock function with no fields
+func (_m *MockStatusStore) Notify() <-chan struct{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// MockStatusStore_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type MockStatusStore_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+func (_e *MockStatusStore_Expecter) Notify() *MockStatusStore_Notify_Call {
+	return &MockStatusStore_Notify_Call{Call: _e.mock.On("Notify")}
+}
+
+func (_c *MockStatusStore_Notify_Call) Run(run func()) *MockStatusStore_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStatusStore_Notify_Call) Return(_a0 <-chan struct{}) *MockStatusStore_Notify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStatusStore_Notify_Call) RunAndReturn(run func() <-chan struct{}) *MockStatusStore_Notify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTraffic provides a mock function with given fields: id, traffic
+func (_m *MockStatusStore) SetTraffic(id string, traffic status.TrafficStats) {
+	_m.Called(id, traffic)
+}
+
+// MockStatusStore_SetTraffic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTraffic'
+type MockStatusStore_SetTraffic_Call struct {
+	*mock.Call
+}
+
+// SetTraffic is a helper method to define mock.On call
+//   - id string
+//   - traffic status.TrafficStats
+func (_e *MockStatusStore_Expecter) SetTraffic(id interface{}, traffic interface{}) *MockStatusStore_SetTraffic_Call {
+	return &MockStatusStore_SetTraffic_Call{Call: _e.mock.On("SetTraffic", id, traffic)}
+}
+
+func (_c *MockStatusStore_SetTraffic_Call) Run(run func(id string, traffic status.TrafficStats)) *MockStatusStore_SetTraffic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(status.TrafficStats))
+	})
+	return _c
+}
+
+func (_c *MockStatusStore_SetTraffic_Call) Return() *MockStatusStore_SetTraffic_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockStatusStore_SetTraffic_Call) RunAndReturn(run func(string, status.TrafficStats)) *MockStatusStore_SetTraffic_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: id, _a1
 func (_m *MockStatusStore) Update(id string, _a1 status.Status) {
 	_m.Called(id, _a1)
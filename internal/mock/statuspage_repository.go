@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	statuspage "github.com/spacechunks/explorer/controlplane/statuspage"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockStatuspageRepository is an autogenerated mock type for the Repository type
+type MockStatuspageRepository struct {
+	mock.Mock
+}
+
+type MockStatuspageRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStatuspageRepository) EXPECT() *MockStatuspageRepository_Expecter {
+	return &MockStatuspageRepository_Expecter{mock: &_m.Mock}
+}
+
+// PlatformStatus provides a mock function with given fields: ctx, seenAfter
+func (_m *MockStatuspageRepository) PlatformStatus(ctx context.Context, seenAfter time.Time) (statuspage.Status, error) {
+	ret := _m.Called(ctx, seenAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlatformStatus")
+	}
+
+	var r0 statuspage.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (statuspage.Status, error)); ok {
+		return rf(ctx, seenAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) statuspage.Status); ok {
+		r0 = rf(ctx, seenAfter)
+	} else {
+		r0 = ret.Get(0).(statuspage.Status)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, seenAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStatuspageRepository_PlatformStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlatformStatus'
+type MockStatuspageRepository_PlatformStatus_Call struct {
+	*mock.Call
+}
+
+// PlatformStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seenAfter time.Time
+func (_e *MockStatuspageRepository_Expecter) PlatformStatus(ctx interface{}, seenAfter interface{}) *MockStatuspageRepository_PlatformStatus_Call {
+	return &MockStatuspageRepository_PlatformStatus_Call{Call: _e.mock.On("PlatformStatus", ctx, seenAfter)}
+}
+
+func (_c *MockStatuspageRepository_PlatformStatus_Call) Run(run func(ctx context.Context, seenAfter time.Time)) *MockStatuspageRepository_PlatformStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockStatuspageRepository_PlatformStatus_Call) Return(_a0 statuspage.Status, _a1 error) *MockStatuspageRepository_PlatformStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStatuspageRepository_PlatformStatus_Call) RunAndReturn(run func(context.Context, time.Time) (statuspage.Status, error)) *MockStatuspageRepository_PlatformStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStatuspageRepository creates a new instance of MockStatuspageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStatuspageRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStatuspageRepository {
+	mock := &MockStatuspageRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
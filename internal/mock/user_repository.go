@@ -0,0 +1,872 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	resource "github.com/spacechunks/explorer/internal/resource"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockUserRepository is an autogenerated mock type for the Repository type
+type MockUserRepository struct {
+	mock.Mock
+}
+
+type MockUserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
+	return &MockUserRepository_Expecter{mock: &_m.Mock}
+}
+
+// ChunksByOwnerID provides a mock function with given fields: ctx, ownerID
+func (_m *MockUserRepository) ChunksByOwnerID(ctx context.Context, ownerID string) ([]resource.Chunk, error) {
+	ret := _m.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChunksByOwnerID")
+	}
+
+	var r0 []resource.Chunk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.Chunk, error)); ok {
+		return rf(ctx, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.Chunk); ok {
+		r0 = rf(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Chunk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_ChunksByOwnerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChunksByOwnerID'
+type MockUserRepository_ChunksByOwnerID_Call struct {
+	*mock.Call
+}
+
+// ChunksByOwnerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID string
+func (_e *MockUserRepository_Expecter) ChunksByOwnerID(ctx interface{}, ownerID interface{}) *MockUserRepository_ChunksByOwnerID_Call {
+	return &MockUserRepository_ChunksByOwnerID_Call{Call: _e.mock.On("ChunksByOwnerID", ctx, ownerID)}
+}
+
+func (_c *MockUserRepository_ChunksByOwnerID_Call) Run(run func(ctx context.Context, ownerID string)) *MockUserRepository_ChunksByOwnerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_ChunksByOwnerID_Call) Return(_a0 []resource.Chunk, _a1 error) *MockUserRepository_ChunksByOwnerID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_ChunksByOwnerID_Call) RunAndReturn(run func(context.Context, string) ([]resource.Chunk, error)) *MockUserRepository_ChunksByOwnerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateImpersonation provides a mock function with given fields: ctx, imp
+func (_m *MockUserRepository) CreateImpersonation(ctx context.Context, imp resource.Impersonation) (resource.Impersonation, error) {
+	ret := _m.Called(ctx, imp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateImpersonation")
+	}
+
+	var r0 resource.Impersonation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Impersonation) (resource.Impersonation, error)); ok {
+		return rf(ctx, imp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Impersonation) resource.Impersonation); ok {
+		r0 = rf(ctx, imp)
+	} else {
+		r0 = ret.Get(0).(resource.Impersonation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Impersonation) error); ok {
+		r1 = rf(ctx, imp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_CreateImpersonation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateImpersonation'
+type MockUserRepository_CreateImpersonation_Call struct {
+	*mock.Call
+}
+
+// CreateImpersonation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imp resource.Impersonation
+func (_e *MockUserRepository_Expecter) CreateImpersonation(ctx interface{}, imp interface{}) *MockUserRepository_CreateImpersonation_Call {
+	return &MockUserRepository_CreateImpersonation_Call{Call: _e.mock.On("CreateImpersonation", ctx, imp)}
+}
+
+func (_c *MockUserRepository_CreateImpersonation_Call) Run(run func(ctx context.Context, imp resource.Impersonation)) *MockUserRepository_CreateImpersonation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.Impersonation))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_CreateImpersonation_Call) Return(_a0 resource.Impersonation, _a1 error) *MockUserRepository_CreateImpersonation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_CreateImpersonation_Call) RunAndReturn(run func(context.Context, resource.Impersonation) (resource.Impersonation, error)) *MockUserRepository_CreateImpersonation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, _a1
+func (_m *MockUserRepository) CreateUser(ctx context.Context, _a1 resource.User) (resource.User, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 resource.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.User) (resource.User, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.User) resource.User); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(resource.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.User) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockUserRepository_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 resource.User
+func (_e *MockUserRepository_Expecter) CreateUser(ctx interface{}, _a1 interface{}) *MockUserRepository_CreateUser_Call {
+	return &MockUserRepository_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, _a1)}
+}
+
+func (_c *MockUserRepository_CreateUser_Call) Run(run func(ctx context.Context, _a1 resource.User)) *MockUserRepository_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.User))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_CreateUser_Call) Return(_a0 resource.User, _a1 error) *MockUserRepository_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_CreateUser_Call) RunAndReturn(run func(context.Context, resource.User) (resource.User, error)) *MockUserRepository_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: ctx, userID, transferTo
+func (_m *MockUserRepository) DeleteUser(ctx context.Context, userID string, transferTo string) ([]string, error) {
+	ret := _m.Called(ctx, userID, transferTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return rf(ctx, userID, transferTo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = rf(ctx, userID, transferTo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, transferTo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockUserRepository_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - transferTo string
+func (_e *MockUserRepository_Expecter) DeleteUser(ctx interface{}, userID interface{}, transferTo interface{}) *MockUserRepository_DeleteUser_Call {
+	return &MockUserRepository_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, userID, transferTo)}
+}
+
+func (_c *MockUserRepository_DeleteUser_Call) Run(run func(ctx context.Context, userID string, transferTo string)) *MockUserRepository_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_DeleteUser_Call) Return(_a0 []string, _a1 error) *MockUserRepository_DeleteUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_DeleteUser_Call) RunAndReturn(run func(context.Context, string, string) ([]string, error)) *MockUserRepository_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, id
+func (_m *MockUserRepository) GetUserByEmail(ctx context.Context, id string) (resource.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 resource.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(resource.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type MockUserRepository_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockUserRepository_Expecter) GetUserByEmail(ctx interface{}, id interface{}) *MockUserRepository_GetUserByEmail_Call {
+	return &MockUserRepository_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, id)}
+}
+
+func (_c *MockUserRepository_GetUserByEmail_Call) Run(run func(ctx context.Context, id string)) *MockUserRepository_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetUserByEmail_Call) Return(_a0 resource.User, _a1 error) *MockUserRepository_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (resource.User, error)) *MockUserRepository_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function with given fields: ctx, id
+func (_m *MockUserRepository) GetUserByID(ctx context.Context, id string) (resource.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 resource.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(resource.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type MockUserRepository_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockUserRepository_Expecter) GetUserByID(ctx interface{}, id interface{}) *MockUserRepository_GetUserByID_Call {
+	return &MockUserRepository_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+}
+
+func (_c *MockUserRepository_GetUserByID_Call) Run(run func(ctx context.Context, id string)) *MockUserRepository_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetUserByID_Call) Return(_a0 resource.User, _a1 error) *MockUserRepository_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_GetUserByID_Call) RunAndReturn(run func(context.Context, string) (resource.User, error)) *MockUserRepository_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImpersonationsByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockUserRepository) ImpersonationsByUserID(ctx context.Context, userID string) ([]resource.Impersonation, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImpersonationsByUserID")
+	}
+
+	var r0 []resource.Impersonation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.Impersonation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.Impersonation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Impersonation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_ImpersonationsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImpersonationsByUserID'
+type MockUserRepository_ImpersonationsByUserID_Call struct {
+	*mock.Call
+}
+
+// ImpersonationsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserRepository_Expecter) ImpersonationsByUserID(ctx interface{}, userID interface{}) *MockUserRepository_ImpersonationsByUserID_Call {
+	return &MockUserRepository_ImpersonationsByUserID_Call{Call: _e.mock.On("ImpersonationsByUserID", ctx, userID)}
+}
+
+func (_c *MockUserRepository_ImpersonationsByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockUserRepository_ImpersonationsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_ImpersonationsByUserID_Call) Return(_a0 []resource.Impersonation, _a1 error) *MockUserRepository_ImpersonationsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_ImpersonationsByUserID_Call) RunAndReturn(run func(context.Context, string) ([]resource.Impersonation, error)) *MockUserRepository_ImpersonationsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstanceEventsByOwnerID provides a mock function with given fields: ctx, ownerID
+func (_m *MockUserRepository) InstanceEventsByOwnerID(ctx context.Context, ownerID string) ([]resource.InstanceEvent, error) {
+	ret := _m.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstanceEventsByOwnerID")
+	}
+
+	var r0 []resource.InstanceEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.InstanceEvent, error)); ok {
+		return rf(ctx, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.InstanceEvent); ok {
+		r0 = rf(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.InstanceEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_InstanceEventsByOwnerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstanceEventsByOwnerID'
+type MockUserRepository_InstanceEventsByOwnerID_Call struct {
+	*mock.Call
+}
+
+// InstanceEventsByOwnerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID string
+func (_e *MockUserRepository_Expecter) InstanceEventsByOwnerID(ctx interface{}, ownerID interface{}) *MockUserRepository_InstanceEventsByOwnerID_Call {
+	return &MockUserRepository_InstanceEventsByOwnerID_Call{Call: _e.mock.On("InstanceEventsByOwnerID", ctx, ownerID)}
+}
+
+func (_c *MockUserRepository_InstanceEventsByOwnerID_Call) Run(run func(ctx context.Context, ownerID string)) *MockUserRepository_InstanceEventsByOwnerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_InstanceEventsByOwnerID_Call) Return(_a0 []resource.InstanceEvent, _a1 error) *MockUserRepository_InstanceEventsByOwnerID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_InstanceEventsByOwnerID_Call) RunAndReturn(run func(context.Context, string) ([]resource.InstanceEvent, error)) *MockUserRepository_InstanceEventsByOwnerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstancesByOwnerID provides a mock function with given fields: ctx, ownerID
+func (_m *MockUserRepository) InstancesByOwnerID(ctx context.Context, ownerID string) ([]resource.Instance, error) {
+	ret := _m.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstancesByOwnerID")
+	}
+
+	var r0 []resource.Instance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]resource.Instance, error)); ok {
+		return rf(ctx, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []resource.Instance); ok {
+		r0 = rf(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Instance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_InstancesByOwnerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstancesByOwnerID'
+type MockUserRepository_InstancesByOwnerID_Call struct {
+	*mock.Call
+}
+
+// InstancesByOwnerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID string
+func (_e *MockUserRepository_Expecter) InstancesByOwnerID(ctx interface{}, ownerID interface{}) *MockUserRepository_InstancesByOwnerID_Call {
+	return &MockUserRepository_InstancesByOwnerID_Call{Call: _e.mock.On("InstancesByOwnerID", ctx, ownerID)}
+}
+
+func (_c *MockUserRepository_InstancesByOwnerID_Call) Run(run func(ctx context.Context, ownerID string)) *MockUserRepository_InstancesByOwnerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_InstancesByOwnerID_Call) Return(_a0 []resource.Instance, _a1 error) *MockUserRepository_InstancesByOwnerID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_InstancesByOwnerID_Call) RunAndReturn(run func(context.Context, string) ([]resource.Instance, error)) *MockUserRepository_InstancesByOwnerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotificationPreferences provides a mock function with given fields: ctx, userID
+func (_m *MockUserRepository) NotificationPreferences(ctx context.Context, userID string) (resource.NotificationPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationPreferences")
+	}
+
+	var r0 resource.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (resource.NotificationPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) resource.NotificationPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(resource.NotificationPreferences)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_NotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotificationPreferences'
+type MockUserRepository_NotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// NotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserRepository_Expecter) NotificationPreferences(ctx interface{}, userID interface{}) *MockUserRepository_NotificationPreferences_Call {
+	return &MockUserRepository_NotificationPreferences_Call{Call: _e.mock.On("NotificationPreferences", ctx, userID)}
+}
+
+func (_c *MockUserRepository_NotificationPreferences_Call) Run(run func(ctx context.Context, userID string)) *MockUserRepository_NotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_NotificationPreferences_Call) Return(_a0 resource.NotificationPreferences, _a1 error) *MockUserRepository_NotificationPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_NotificationPreferences_Call) RunAndReturn(run func(context.Context, string) (resource.NotificationPreferences, error)) *MockUserRepository_NotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordImpersonatedAction provides a mock function with given fields: ctx, impersonationID, method
+func (_m *MockUserRepository) RecordImpersonatedAction(ctx context.Context, impersonationID string, method string) error {
+	ret := _m.Called(ctx, impersonationID, method)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordImpersonatedAction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, impersonationID, method)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_RecordImpersonatedAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordImpersonatedAction'
+type MockUserRepository_RecordImpersonatedAction_Call struct {
+	*mock.Call
+}
+
+// RecordImpersonatedAction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - impersonationID string
+//   - method string
+func (_e *MockUserRepository_Expecter) RecordImpersonatedAction(ctx interface{}, impersonationID interface{}, method interface{}) *MockUserRepository_RecordImpersonatedAction_Call {
+	return &MockUserRepository_RecordImpersonatedAction_Call{Call: _e.mock.On("RecordImpersonatedAction", ctx, impersonationID, method)}
+}
+
+func (_c *MockUserRepository_RecordImpersonatedAction_Call) Run(run func(ctx context.Context, impersonationID string, method string)) *MockUserRepository_RecordImpersonatedAction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_RecordImpersonatedAction_Call) Return(_a0 error) *MockUserRepository_RecordImpersonatedAction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_RecordImpersonatedAction_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserRepository_RecordImpersonatedAction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function with given fields: ctx, tokenID, userID, expiresAt
+func (_m *MockUserRepository) RevokeToken(ctx context.Context, tokenID string, userID string, expiresAt time.Time) error {
+	ret := _m.Called(ctx, tokenID, userID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, tokenID, userID, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type MockUserRepository_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - userID string
+//   - expiresAt time.Time
+func (_e *MockUserRepository_Expecter) RevokeToken(ctx interface{}, tokenID interface{}, userID interface{}, expiresAt interface{}) *MockUserRepository_RevokeToken_Call {
+	return &MockUserRepository_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx, tokenID, userID, expiresAt)}
+}
+
+func (_c *MockUserRepository_RevokeToken_Call) Run(run func(ctx context.Context, tokenID string, userID string, expiresAt time.Time)) *MockUserRepository_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_RevokeToken_Call) Return(_a0 error) *MockUserRepository_RevokeToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_RevokeToken_Call) RunAndReturn(run func(context.Context, string, string, time.Time) error) *MockUserRepository_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserTokens provides a mock function with given fields: ctx, userID, before
+func (_m *MockUserRepository) RevokeUserTokens(ctx context.Context, userID string, before time.Time) error {
+	ret := _m.Called(ctx, userID, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, userID, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_RevokeUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserTokens'
+type MockUserRepository_RevokeUserTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - before time.Time
+func (_e *MockUserRepository_Expecter) RevokeUserTokens(ctx interface{}, userID interface{}, before interface{}) *MockUserRepository_RevokeUserTokens_Call {
+	return &MockUserRepository_RevokeUserTokens_Call{Call: _e.mock.On("RevokeUserTokens", ctx, userID, before)}
+}
+
+func (_c *MockUserRepository_RevokeUserTokens_Call) Run(run func(ctx context.Context, userID string, before time.Time)) *MockUserRepository_RevokeUserTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_RevokeUserTokens_Call) Return(_a0 error) *MockUserRepository_RevokeUserTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_RevokeUserTokens_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockUserRepository_RevokeUserTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TokenRevoked provides a mock function with given fields: ctx, tokenID, userID, issuedAt
+func (_m *MockUserRepository) TokenRevoked(ctx context.Context, tokenID string, userID string, issuedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, tokenID, userID, issuedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TokenRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (bool, error)); ok {
+		return rf(ctx, tokenID, userID, issuedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) bool); ok {
+		r0 = rf(ctx, tokenID, userID, issuedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, tokenID, userID, issuedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_TokenRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TokenRevoked'
+type MockUserRepository_TokenRevoked_Call struct {
+	*mock.Call
+}
+
+// TokenRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - userID string
+//   - issuedAt time.Time
+func (_e *MockUserRepository_Expecter) TokenRevoked(ctx interface{}, tokenID interface{}, userID interface{}, issuedAt interface{}) *MockUserRepository_TokenRevoked_Call {
+	return &MockUserRepository_TokenRevoked_Call{Call: _e.mock.On("TokenRevoked", ctx, tokenID, userID, issuedAt)}
+}
+
+func (_c *MockUserRepository_TokenRevoked_Call) Run(run func(ctx context.Context, tokenID string, userID string, issuedAt time.Time)) *MockUserRepository_TokenRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_TokenRevoked_Call) Return(_a0 bool, _a1 error) *MockUserRepository_TokenRevoked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_TokenRevoked_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (bool, error)) *MockUserRepository_TokenRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNotificationPreferences provides a mock function with given fields: ctx, userID, prefs
+func (_m *MockUserRepository) UpdateNotificationPreferences(ctx context.Context, userID string, prefs resource.NotificationPreferences) error {
+	ret := _m.Called(ctx, userID, prefs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationPreferences")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.NotificationPreferences) error); ok {
+		r0 = rf(ctx, userID, prefs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_UpdateNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationPreferences'
+type MockUserRepository_UpdateNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - prefs resource.NotificationPreferences
+func (_e *MockUserRepository_Expecter) UpdateNotificationPreferences(ctx interface{}, userID interface{}, prefs interface{}) *MockUserRepository_UpdateNotificationPreferences_Call {
+	return &MockUserRepository_UpdateNotificationPreferences_Call{Call: _e.mock.On("UpdateNotificationPreferences", ctx, userID, prefs)}
+}
+
+func (_c *MockUserRepository_UpdateNotificationPreferences_Call) Run(run func(ctx context.Context, userID string, prefs resource.NotificationPreferences)) *MockUserRepository_UpdateNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.NotificationPreferences))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateNotificationPreferences_Call) Return(_a0 error) *MockUserRepository_UpdateNotificationPreferences_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateNotificationPreferences_Call) RunAndReturn(run func(context.Context, string, resource.NotificationPreferences) error) *MockUserRepository_UpdateNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserRepository {
+	mock := &MockUserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
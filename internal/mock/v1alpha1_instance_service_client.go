@@ -25,6 +25,154 @@ func (_m *MockV1alpha1InstanceServiceClient) EXPECT() *MockV1alpha1InstanceServi
 	return &MockV1alpha1InstanceServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// CreateInstanceInvite provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) CreateInstanceInvite(ctx context.Context, in *v1alpha1.CreateInstanceInviteRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstanceInviteResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstanceInvite")
+	}
+
+	var r0 *v1alpha1.CreateInstanceInviteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.CreateInstanceInviteRequest, ...grpc.CallOption) (*v1alpha1.CreateInstanceInviteResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.CreateInstanceInviteRequest, ...grpc.CallOption) *v1alpha1.CreateInstanceInviteResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.CreateInstanceInviteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.CreateInstanceInviteRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_CreateInstanceInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstanceInvite'
+type MockV1alpha1InstanceServiceClient_CreateInstanceInvite_Call struct {
+	*mock.Call
+}
+
+// CreateInstanceInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.CreateInstanceInviteRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) CreateInstanceInvite(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_CreateInstanceInvite_Call {
+	return &MockV1alpha1InstanceServiceClient_CreateInstanceInvite_Call{Call: _e.mock.On("CreateInstanceInvite",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_CreateInstanceInvite_Call) Run(run func(ctx context.Context, in *v1alpha1.CreateInstanceInviteRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_CreateInstanceInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.CreateInstanceInviteRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_CreateInstanceInvite_Call) Return(_a0 *v1alpha1.CreateInstanceInviteResponse, _a1 error) *MockV1alpha1InstanceServiceClient_CreateInstanceInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_CreateInstanceInvite_Call) RunAndReturn(run func(context.Context, *v1alpha1.CreateInstanceInviteRequest, ...grpc.CallOption) (*v1alpha1.CreateInstanceInviteResponse, error)) *MockV1alpha1InstanceServiceClient_CreateInstanceInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInstanceSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) CreateInstanceSnapshot(ctx context.Context, in *v1alpha1.CreateInstanceSnapshotRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstanceSnapshotResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstanceSnapshot")
+	}
+
+	var r0 *v1alpha1.CreateInstanceSnapshotResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.CreateInstanceSnapshotRequest, ...grpc.CallOption) (*v1alpha1.CreateInstanceSnapshotResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.CreateInstanceSnapshotRequest, ...grpc.CallOption) *v1alpha1.CreateInstanceSnapshotResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.CreateInstanceSnapshotResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.CreateInstanceSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_CreateInstanceSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstanceSnapshot'
+type MockV1alpha1InstanceServiceClient_CreateInstanceSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateInstanceSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.CreateInstanceSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) CreateInstanceSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_CreateInstanceSnapshot_Call {
+	return &MockV1alpha1InstanceServiceClient_CreateInstanceSnapshot_Call{Call: _e.mock.On("CreateInstanceSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_CreateInstanceSnapshot_Call) Run(run func(ctx context.Context, in *v1alpha1.CreateInstanceSnapshotRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_CreateInstanceSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.CreateInstanceSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_CreateInstanceSnapshot_Call) Return(_a0 *v1alpha1.CreateInstanceSnapshotResponse, _a1 error) *MockV1alpha1InstanceServiceClient_CreateInstanceSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_CreateInstanceSnapshot_Call) RunAndReturn(run func(context.Context, *v1alpha1.CreateInstanceSnapshotRequest, ...grpc.CallOption) (*v1alpha1.CreateInstanceSnapshotResponse, error)) *MockV1alpha1InstanceServiceClient_CreateInstanceSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DiscoverInstances provides a mock function with given fields: ctx, in, opts
 func (_m *MockV1alpha1InstanceServiceClient) DiscoverInstances(ctx context.Context, in *v1alpha1.DiscoverInstanceRequest, opts ...grpc.CallOption) (*v1alpha1.DiscoverInstanceResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -173,8 +321,8 @@ func (_c *MockV1alpha1InstanceServiceClient_GetInstance_Call) RunAndReturn(run f
 	return _c
 }
 
-// ListInstances provides a mock function with given fields: ctx, in, opts
-func (_m *MockV1alpha1InstanceServiceClient) ListInstances(ctx context.Context, in *v1alpha1.ListInstancesRequest, opts ...grpc.CallOption) (*v1alpha1.ListInstancesResponse, error) {
+// GetInstanceEvents provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) GetInstanceEvents(ctx context.Context, in *v1alpha1.GetInstanceEventsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstanceEventsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -185,23 +333,23 @@ func (_m *MockV1alpha1InstanceServiceClient) ListInstances(ctx context.Context,
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListInstances")
+		panic("no return value specified for GetInstanceEvents")
 	}
 
-	var r0 *v1alpha1.ListInstancesResponse
+	var r0 *v1alpha1.GetInstanceEventsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ListInstancesRequest, ...grpc.CallOption) (*v1alpha1.ListInstancesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.GetInstanceEventsRequest, ...grpc.CallOption) (*v1alpha1.GetInstanceEventsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ListInstancesRequest, ...grpc.CallOption) *v1alpha1.ListInstancesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.GetInstanceEventsRequest, ...grpc.CallOption) *v1alpha1.GetInstanceEventsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.ListInstancesResponse)
+			r0 = ret.Get(0).(*v1alpha1.GetInstanceEventsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.ListInstancesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.GetInstanceEventsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -210,21 +358,21 @@ func (_m *MockV1alpha1InstanceServiceClient) ListInstances(ctx context.Context,
 	return r0, r1
 }
 
-// MockV1alpha1InstanceServiceClient_ListInstances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInstances'
-type MockV1alpha1InstanceServiceClient_ListInstances_Call struct {
+// MockV1alpha1InstanceServiceClient_GetInstanceEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstanceEvents'
+type MockV1alpha1InstanceServiceClient_GetInstanceEvents_Call struct {
 	*mock.Call
 }
 
-// ListInstances is a helper method to define mock.On call
+// GetInstanceEvents is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1alpha1.ListInstancesRequest
+//   - in *v1alpha1.GetInstanceEventsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockV1alpha1InstanceServiceClient_Expecter) ListInstances(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_ListInstances_Call {
-	return &MockV1alpha1InstanceServiceClient_ListInstances_Call{Call: _e.mock.On("ListInstances",
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) GetInstanceEvents(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_GetInstanceEvents_Call {
+	return &MockV1alpha1InstanceServiceClient_GetInstanceEvents_Call{Call: _e.mock.On("GetInstanceEvents",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockV1alpha1InstanceServiceClient_ListInstances_Call) Run(run func(ctx context.Context, in *v1alpha1.ListInstancesRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_ListInstances_Call {
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceEvents_Call) Run(run func(ctx context.Context, in *v1alpha1.GetInstanceEventsRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_GetInstanceEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -232,23 +380,23 @@ func (_c *MockV1alpha1InstanceServiceClient_ListInstances_Call) Run(run func(ctx
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1alpha1.ListInstancesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1alpha1.GetInstanceEventsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockV1alpha1InstanceServiceClient_ListInstances_Call) Return(_a0 *v1alpha1.ListInstancesResponse, _a1 error) *MockV1alpha1InstanceServiceClient_ListInstances_Call {
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceEvents_Call) Return(_a0 *v1alpha1.GetInstanceEventsResponse, _a1 error) *MockV1alpha1InstanceServiceClient_GetInstanceEvents_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockV1alpha1InstanceServiceClient_ListInstances_Call) RunAndReturn(run func(context.Context, *v1alpha1.ListInstancesRequest, ...grpc.CallOption) (*v1alpha1.ListInstancesResponse, error)) *MockV1alpha1InstanceServiceClient_ListInstances_Call {
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceEvents_Call) RunAndReturn(run func(context.Context, *v1alpha1.GetInstanceEventsRequest, ...grpc.CallOption) (*v1alpha1.GetInstanceEventsResponse, error)) *MockV1alpha1InstanceServiceClient_GetInstanceEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReceiveInstanceStatusReports provides a mock function with given fields: ctx, in, opts
-func (_m *MockV1alpha1InstanceServiceClient) ReceiveInstanceStatusReports(ctx context.Context, in *v1alpha1.ReceiveInstanceStatusReportsRequest, opts ...grpc.CallOption) (*v1alpha1.ReceiveInstanceStatusReportsResponse, error) {
+// GetInstanceLogs provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) GetInstanceLogs(ctx context.Context, in *v1alpha1.GetInstanceLogsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstanceLogsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -259,23 +407,23 @@ func (_m *MockV1alpha1InstanceServiceClient) ReceiveInstanceStatusReports(ctx co
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReceiveInstanceStatusReports")
+		panic("no return value specified for GetInstanceLogs")
 	}
 
-	var r0 *v1alpha1.ReceiveInstanceStatusReportsResponse
+	var r0 *v1alpha1.GetInstanceLogsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ReceiveInstanceStatusReportsRequest, ...grpc.CallOption) (*v1alpha1.ReceiveInstanceStatusReportsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.GetInstanceLogsRequest, ...grpc.CallOption) (*v1alpha1.GetInstanceLogsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ReceiveInstanceStatusReportsRequest, ...grpc.CallOption) *v1alpha1.ReceiveInstanceStatusReportsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.GetInstanceLogsRequest, ...grpc.CallOption) *v1alpha1.GetInstanceLogsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.ReceiveInstanceStatusReportsResponse)
+			r0 = ret.Get(0).(*v1alpha1.GetInstanceLogsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.ReceiveInstanceStatusReportsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.GetInstanceLogsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -284,21 +432,21 @@ func (_m *MockV1alpha1InstanceServiceClient) ReceiveInstanceStatusReports(ctx co
 	return r0, r1
 }
 
-// MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveInstanceStatusReports'
-type MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call struct {
+// MockV1alpha1InstanceServiceClient_GetInstanceLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstanceLogs'
+type MockV1alpha1InstanceServiceClient_GetInstanceLogs_Call struct {
 	*mock.Call
 }
 
-// ReceiveInstanceStatusReports is a helper method to define mock.On call
+// GetInstanceLogs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1alpha1.ReceiveInstanceStatusReportsRequest
+//   - in *v1alpha1.GetInstanceLogsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockV1alpha1InstanceServiceClient_Expecter) ReceiveInstanceStatusReports(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call {
-	return &MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call{Call: _e.mock.On("ReceiveInstanceStatusReports",
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) GetInstanceLogs(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_GetInstanceLogs_Call {
+	return &MockV1alpha1InstanceServiceClient_GetInstanceLogs_Call{Call: _e.mock.On("GetInstanceLogs",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call) Run(run func(ctx context.Context, in *v1alpha1.ReceiveInstanceStatusReportsRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call {
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceLogs_Call) Run(run func(ctx context.Context, in *v1alpha1.GetInstanceLogsRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_GetInstanceLogs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -306,23 +454,23 @@ func (_c *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call) R
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1alpha1.ReceiveInstanceStatusReportsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1alpha1.GetInstanceLogsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call) Return(_a0 *v1alpha1.ReceiveInstanceStatusReportsResponse, _a1 error) *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call {
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceLogs_Call) Return(_a0 *v1alpha1.GetInstanceLogsResponse, _a1 error) *MockV1alpha1InstanceServiceClient_GetInstanceLogs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call) RunAndReturn(run func(context.Context, *v1alpha1.ReceiveInstanceStatusReportsRequest, ...grpc.CallOption) (*v1alpha1.ReceiveInstanceStatusReportsResponse, error)) *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call {
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceLogs_Call) RunAndReturn(run func(context.Context, *v1alpha1.GetInstanceLogsRequest, ...grpc.CallOption) (*v1alpha1.GetInstanceLogsResponse, error)) *MockV1alpha1InstanceServiceClient_GetInstanceLogs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RunFlavorVersion provides a mock function with given fields: ctx, in, opts
-func (_m *MockV1alpha1InstanceServiceClient) RunFlavorVersion(ctx context.Context, in *v1alpha1.RunFlavorVersionRequest, opts ...grpc.CallOption) (*v1alpha1.RunFlavorVersionResponse, error) {
+// GetInstanceRedirects provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) GetInstanceRedirects(ctx context.Context, in *v1alpha1.GetInstanceRedirectsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstanceRedirectsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -333,23 +481,23 @@ func (_m *MockV1alpha1InstanceServiceClient) RunFlavorVersion(ctx context.Contex
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RunFlavorVersion")
+		panic("no return value specified for GetInstanceRedirects")
 	}
 
-	var r0 *v1alpha1.RunFlavorVersionResponse
+	var r0 *v1alpha1.GetInstanceRedirectsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.RunFlavorVersionRequest, ...grpc.CallOption) (*v1alpha1.RunFlavorVersionResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.GetInstanceRedirectsRequest, ...grpc.CallOption) (*v1alpha1.GetInstanceRedirectsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.RunFlavorVersionRequest, ...grpc.CallOption) *v1alpha1.RunFlavorVersionResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.GetInstanceRedirectsRequest, ...grpc.CallOption) *v1alpha1.GetInstanceRedirectsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.RunFlavorVersionResponse)
+			r0 = ret.Get(0).(*v1alpha1.GetInstanceRedirectsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.RunFlavorVersionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.GetInstanceRedirectsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -358,21 +506,21 @@ func (_m *MockV1alpha1InstanceServiceClient) RunFlavorVersion(ctx context.Contex
 	return r0, r1
 }
 
-// MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunFlavorVersion'
-type MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call struct {
+// MockV1alpha1InstanceServiceClient_GetInstanceRedirects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstanceRedirects'
+type MockV1alpha1InstanceServiceClient_GetInstanceRedirects_Call struct {
 	*mock.Call
 }
 
-// RunFlavorVersion is a helper method to define mock.On call
+// GetInstanceRedirects is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1alpha1.RunFlavorVersionRequest
+//   - in *v1alpha1.GetInstanceRedirectsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockV1alpha1InstanceServiceClient_Expecter) RunFlavorVersion(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call {
-	return &MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call{Call: _e.mock.On("RunFlavorVersion",
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) GetInstanceRedirects(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_GetInstanceRedirects_Call {
+	return &MockV1alpha1InstanceServiceClient_GetInstanceRedirects_Call{Call: _e.mock.On("GetInstanceRedirects",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call) Run(run func(ctx context.Context, in *v1alpha1.RunFlavorVersionRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call {
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceRedirects_Call) Run(run func(ctx context.Context, in *v1alpha1.GetInstanceRedirectsRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_GetInstanceRedirects_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -380,17 +528,979 @@ func (_c *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call) Run(run func(
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*v1alpha1.RunFlavorVersionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*v1alpha1.GetInstanceRedirectsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call) Return(_a0 *v1alpha1.RunFlavorVersionResponse, _a1 error) *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call {
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceRedirects_Call) Return(_a0 *v1alpha1.GetInstanceRedirectsResponse, _a1 error) *MockV1alpha1InstanceServiceClient_GetInstanceRedirects_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call) RunAndReturn(run func(context.Context, *v1alpha1.RunFlavorVersionRequest, ...grpc.CallOption) (*v1alpha1.RunFlavorVersionResponse, error)) *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call {
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceRedirects_Call) RunAndReturn(run func(context.Context, *v1alpha1.GetInstanceRedirectsRequest, ...grpc.CallOption) (*v1alpha1.GetInstanceRedirectsResponse, error)) *MockV1alpha1InstanceServiceClient_GetInstanceRedirects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstanceTimeline provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) GetInstanceTimeline(ctx context.Context, in *v1alpha1.GetInstanceTimelineRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstanceTimelineResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstanceTimeline")
+	}
+
+	var r0 *v1alpha1.GetInstanceTimelineResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.GetInstanceTimelineRequest, ...grpc.CallOption) (*v1alpha1.GetInstanceTimelineResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.GetInstanceTimelineRequest, ...grpc.CallOption) *v1alpha1.GetInstanceTimelineResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.GetInstanceTimelineResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.GetInstanceTimelineRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_GetInstanceTimeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstanceTimeline'
+type MockV1alpha1InstanceServiceClient_GetInstanceTimeline_Call struct {
+	*mock.Call
+}
+
+// GetInstanceTimeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.GetInstanceTimelineRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) GetInstanceTimeline(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_GetInstanceTimeline_Call {
+	return &MockV1alpha1InstanceServiceClient_GetInstanceTimeline_Call{Call: _e.mock.On("GetInstanceTimeline",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceTimeline_Call) Run(run func(ctx context.Context, in *v1alpha1.GetInstanceTimelineRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_GetInstanceTimeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.GetInstanceTimelineRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceTimeline_Call) Return(_a0 *v1alpha1.GetInstanceTimelineResponse, _a1 error) *MockV1alpha1InstanceServiceClient_GetInstanceTimeline_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_GetInstanceTimeline_Call) RunAndReturn(run func(context.Context, *v1alpha1.GetInstanceTimelineRequest, ...grpc.CallOption) (*v1alpha1.GetInstanceTimelineResponse, error)) *MockV1alpha1InstanceServiceClient_GetInstanceTimeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlatformdUpdate provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) GetPlatformdUpdate(ctx context.Context, in *v1alpha1.GetPlatformdUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.GetPlatformdUpdateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlatformdUpdate")
+	}
+
+	var r0 *v1alpha1.GetPlatformdUpdateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.GetPlatformdUpdateRequest, ...grpc.CallOption) (*v1alpha1.GetPlatformdUpdateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.GetPlatformdUpdateRequest, ...grpc.CallOption) *v1alpha1.GetPlatformdUpdateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.GetPlatformdUpdateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.GetPlatformdUpdateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_GetPlatformdUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlatformdUpdate'
+type MockV1alpha1InstanceServiceClient_GetPlatformdUpdate_Call struct {
+	*mock.Call
+}
+
+// GetPlatformdUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.GetPlatformdUpdateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) GetPlatformdUpdate(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_GetPlatformdUpdate_Call {
+	return &MockV1alpha1InstanceServiceClient_GetPlatformdUpdate_Call{Call: _e.mock.On("GetPlatformdUpdate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_GetPlatformdUpdate_Call) Run(run func(ctx context.Context, in *v1alpha1.GetPlatformdUpdateRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_GetPlatformdUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.GetPlatformdUpdateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_GetPlatformdUpdate_Call) Return(_a0 *v1alpha1.GetPlatformdUpdateResponse, _a1 error) *MockV1alpha1InstanceServiceClient_GetPlatformdUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_GetPlatformdUpdate_Call) RunAndReturn(run func(context.Context, *v1alpha1.GetPlatformdUpdateRequest, ...grpc.CallOption) (*v1alpha1.GetPlatformdUpdateResponse, error)) *MockV1alpha1InstanceServiceClient_GetPlatformdUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInstanceInvites provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) ListInstanceInvites(ctx context.Context, in *v1alpha1.ListInstanceInvitesRequest, opts ...grpc.CallOption) (*v1alpha1.ListInstanceInvitesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInstanceInvites")
+	}
+
+	var r0 *v1alpha1.ListInstanceInvitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ListInstanceInvitesRequest, ...grpc.CallOption) (*v1alpha1.ListInstanceInvitesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ListInstanceInvitesRequest, ...grpc.CallOption) *v1alpha1.ListInstanceInvitesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ListInstanceInvitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.ListInstanceInvitesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_ListInstanceInvites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInstanceInvites'
+type MockV1alpha1InstanceServiceClient_ListInstanceInvites_Call struct {
+	*mock.Call
+}
+
+// ListInstanceInvites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.ListInstanceInvitesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) ListInstanceInvites(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_ListInstanceInvites_Call {
+	return &MockV1alpha1InstanceServiceClient_ListInstanceInvites_Call{Call: _e.mock.On("ListInstanceInvites",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ListInstanceInvites_Call) Run(run func(ctx context.Context, in *v1alpha1.ListInstanceInvitesRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_ListInstanceInvites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.ListInstanceInvitesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ListInstanceInvites_Call) Return(_a0 *v1alpha1.ListInstanceInvitesResponse, _a1 error) *MockV1alpha1InstanceServiceClient_ListInstanceInvites_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ListInstanceInvites_Call) RunAndReturn(run func(context.Context, *v1alpha1.ListInstanceInvitesRequest, ...grpc.CallOption) (*v1alpha1.ListInstanceInvitesResponse, error)) *MockV1alpha1InstanceServiceClient_ListInstanceInvites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInstances provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) ListInstances(ctx context.Context, in *v1alpha1.ListInstancesRequest, opts ...grpc.CallOption) (*v1alpha1.ListInstancesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInstances")
+	}
+
+	var r0 *v1alpha1.ListInstancesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ListInstancesRequest, ...grpc.CallOption) (*v1alpha1.ListInstancesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ListInstancesRequest, ...grpc.CallOption) *v1alpha1.ListInstancesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ListInstancesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.ListInstancesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_ListInstances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInstances'
+type MockV1alpha1InstanceServiceClient_ListInstances_Call struct {
+	*mock.Call
+}
+
+// ListInstances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.ListInstancesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) ListInstances(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_ListInstances_Call {
+	return &MockV1alpha1InstanceServiceClient_ListInstances_Call{Call: _e.mock.On("ListInstances",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ListInstances_Call) Run(run func(ctx context.Context, in *v1alpha1.ListInstancesRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_ListInstances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.ListInstancesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ListInstances_Call) Return(_a0 *v1alpha1.ListInstancesResponse, _a1 error) *MockV1alpha1InstanceServiceClient_ListInstances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ListInstances_Call) RunAndReturn(run func(context.Context, *v1alpha1.ListInstancesRequest, ...grpc.CallOption) (*v1alpha1.ListInstancesResponse, error)) *MockV1alpha1InstanceServiceClient_ListInstances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlanPlacement provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) PlanPlacement(ctx context.Context, in *v1alpha1.PlanPlacementRequest, opts ...grpc.CallOption) (*v1alpha1.PlanPlacementResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanPlacement")
+	}
+
+	var r0 *v1alpha1.PlanPlacementResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.PlanPlacementRequest, ...grpc.CallOption) (*v1alpha1.PlanPlacementResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.PlanPlacementRequest, ...grpc.CallOption) *v1alpha1.PlanPlacementResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.PlanPlacementResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.PlanPlacementRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_PlanPlacement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanPlacement'
+type MockV1alpha1InstanceServiceClient_PlanPlacement_Call struct {
+	*mock.Call
+}
+
+// PlanPlacement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.PlanPlacementRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) PlanPlacement(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_PlanPlacement_Call {
+	return &MockV1alpha1InstanceServiceClient_PlanPlacement_Call{Call: _e.mock.On("PlanPlacement",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_PlanPlacement_Call) Run(run func(ctx context.Context, in *v1alpha1.PlanPlacementRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_PlanPlacement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.PlanPlacementRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_PlanPlacement_Call) Return(_a0 *v1alpha1.PlanPlacementResponse, _a1 error) *MockV1alpha1InstanceServiceClient_PlanPlacement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_PlanPlacement_Call) RunAndReturn(run func(context.Context, *v1alpha1.PlanPlacementRequest, ...grpc.CallOption) (*v1alpha1.PlanPlacementResponse, error)) *MockV1alpha1InstanceServiceClient_PlanPlacement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveInstanceLogs provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) ReceiveInstanceLogs(ctx context.Context, in *v1alpha1.ReceiveInstanceLogsRequest, opts ...grpc.CallOption) (*v1alpha1.ReceiveInstanceLogsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveInstanceLogs")
+	}
+
+	var r0 *v1alpha1.ReceiveInstanceLogsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ReceiveInstanceLogsRequest, ...grpc.CallOption) (*v1alpha1.ReceiveInstanceLogsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ReceiveInstanceLogsRequest, ...grpc.CallOption) *v1alpha1.ReceiveInstanceLogsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReceiveInstanceLogsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.ReceiveInstanceLogsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_ReceiveInstanceLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveInstanceLogs'
+type MockV1alpha1InstanceServiceClient_ReceiveInstanceLogs_Call struct {
+	*mock.Call
+}
+
+// ReceiveInstanceLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.ReceiveInstanceLogsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) ReceiveInstanceLogs(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_ReceiveInstanceLogs_Call {
+	return &MockV1alpha1InstanceServiceClient_ReceiveInstanceLogs_Call{Call: _e.mock.On("ReceiveInstanceLogs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReceiveInstanceLogs_Call) Run(run func(ctx context.Context, in *v1alpha1.ReceiveInstanceLogsRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_ReceiveInstanceLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.ReceiveInstanceLogsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReceiveInstanceLogs_Call) Return(_a0 *v1alpha1.ReceiveInstanceLogsResponse, _a1 error) *MockV1alpha1InstanceServiceClient_ReceiveInstanceLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReceiveInstanceLogs_Call) RunAndReturn(run func(context.Context, *v1alpha1.ReceiveInstanceLogsRequest, ...grpc.CallOption) (*v1alpha1.ReceiveInstanceLogsResponse, error)) *MockV1alpha1InstanceServiceClient_ReceiveInstanceLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveInstanceStatusReports provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) ReceiveInstanceStatusReports(ctx context.Context, in *v1alpha1.ReceiveInstanceStatusReportsRequest, opts ...grpc.CallOption) (*v1alpha1.ReceiveInstanceStatusReportsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveInstanceStatusReports")
+	}
+
+	var r0 *v1alpha1.ReceiveInstanceStatusReportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ReceiveInstanceStatusReportsRequest, ...grpc.CallOption) (*v1alpha1.ReceiveInstanceStatusReportsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ReceiveInstanceStatusReportsRequest, ...grpc.CallOption) *v1alpha1.ReceiveInstanceStatusReportsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReceiveInstanceStatusReportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.ReceiveInstanceStatusReportsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveInstanceStatusReports'
+type MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call struct {
+	*mock.Call
+}
+
+// ReceiveInstanceStatusReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.ReceiveInstanceStatusReportsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) ReceiveInstanceStatusReports(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call {
+	return &MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call{Call: _e.mock.On("ReceiveInstanceStatusReports",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call) Run(run func(ctx context.Context, in *v1alpha1.ReceiveInstanceStatusReportsRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.ReceiveInstanceStatusReportsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call) Return(_a0 *v1alpha1.ReceiveInstanceStatusReportsResponse, _a1 error) *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call) RunAndReturn(run func(context.Context, *v1alpha1.ReceiveInstanceStatusReportsRequest, ...grpc.CallOption) (*v1alpha1.ReceiveInstanceStatusReportsResponse, error)) *MockV1alpha1InstanceServiceClient_ReceiveInstanceStatusReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportNodeConditions provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) ReportNodeConditions(ctx context.Context, in *v1alpha1.ReportNodeConditionsRequest, opts ...grpc.CallOption) (*v1alpha1.ReportNodeConditionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportNodeConditions")
+	}
+
+	var r0 *v1alpha1.ReportNodeConditionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ReportNodeConditionsRequest, ...grpc.CallOption) (*v1alpha1.ReportNodeConditionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ReportNodeConditionsRequest, ...grpc.CallOption) *v1alpha1.ReportNodeConditionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReportNodeConditionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.ReportNodeConditionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_ReportNodeConditions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportNodeConditions'
+type MockV1alpha1InstanceServiceClient_ReportNodeConditions_Call struct {
+	*mock.Call
+}
+
+// ReportNodeConditions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.ReportNodeConditionsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) ReportNodeConditions(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_ReportNodeConditions_Call {
+	return &MockV1alpha1InstanceServiceClient_ReportNodeConditions_Call{Call: _e.mock.On("ReportNodeConditions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReportNodeConditions_Call) Run(run func(ctx context.Context, in *v1alpha1.ReportNodeConditionsRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_ReportNodeConditions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.ReportNodeConditionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReportNodeConditions_Call) Return(_a0 *v1alpha1.ReportNodeConditionsResponse, _a1 error) *MockV1alpha1InstanceServiceClient_ReportNodeConditions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReportNodeConditions_Call) RunAndReturn(run func(context.Context, *v1alpha1.ReportNodeConditionsRequest, ...grpc.CallOption) (*v1alpha1.ReportNodeConditionsResponse, error)) *MockV1alpha1InstanceServiceClient_ReportNodeConditions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportPlatformdUpdateStatus provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) ReportPlatformdUpdateStatus(ctx context.Context, in *v1alpha1.ReportPlatformdUpdateStatusRequest, opts ...grpc.CallOption) (*v1alpha1.ReportPlatformdUpdateStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportPlatformdUpdateStatus")
+	}
+
+	var r0 *v1alpha1.ReportPlatformdUpdateStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ReportPlatformdUpdateStatusRequest, ...grpc.CallOption) (*v1alpha1.ReportPlatformdUpdateStatusResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.ReportPlatformdUpdateStatusRequest, ...grpc.CallOption) *v1alpha1.ReportPlatformdUpdateStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReportPlatformdUpdateStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.ReportPlatformdUpdateStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_ReportPlatformdUpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportPlatformdUpdateStatus'
+type MockV1alpha1InstanceServiceClient_ReportPlatformdUpdateStatus_Call struct {
+	*mock.Call
+}
+
+// ReportPlatformdUpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.ReportPlatformdUpdateStatusRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) ReportPlatformdUpdateStatus(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_ReportPlatformdUpdateStatus_Call {
+	return &MockV1alpha1InstanceServiceClient_ReportPlatformdUpdateStatus_Call{Call: _e.mock.On("ReportPlatformdUpdateStatus",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReportPlatformdUpdateStatus_Call) Run(run func(ctx context.Context, in *v1alpha1.ReportPlatformdUpdateStatusRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_ReportPlatformdUpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.ReportPlatformdUpdateStatusRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReportPlatformdUpdateStatus_Call) Return(_a0 *v1alpha1.ReportPlatformdUpdateStatusResponse, _a1 error) *MockV1alpha1InstanceServiceClient_ReportPlatformdUpdateStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_ReportPlatformdUpdateStatus_Call) RunAndReturn(run func(context.Context, *v1alpha1.ReportPlatformdUpdateStatusRequest, ...grpc.CallOption) (*v1alpha1.ReportPlatformdUpdateStatusResponse, error)) *MockV1alpha1InstanceServiceClient_ReportPlatformdUpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeInstanceInvite provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) RevokeInstanceInvite(ctx context.Context, in *v1alpha1.RevokeInstanceInviteRequest, opts ...grpc.CallOption) (*v1alpha1.RevokeInstanceInviteResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeInstanceInvite")
+	}
+
+	var r0 *v1alpha1.RevokeInstanceInviteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.RevokeInstanceInviteRequest, ...grpc.CallOption) (*v1alpha1.RevokeInstanceInviteResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.RevokeInstanceInviteRequest, ...grpc.CallOption) *v1alpha1.RevokeInstanceInviteResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.RevokeInstanceInviteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.RevokeInstanceInviteRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_RevokeInstanceInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeInstanceInvite'
+type MockV1alpha1InstanceServiceClient_RevokeInstanceInvite_Call struct {
+	*mock.Call
+}
+
+// RevokeInstanceInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.RevokeInstanceInviteRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) RevokeInstanceInvite(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_RevokeInstanceInvite_Call {
+	return &MockV1alpha1InstanceServiceClient_RevokeInstanceInvite_Call{Call: _e.mock.On("RevokeInstanceInvite",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_RevokeInstanceInvite_Call) Run(run func(ctx context.Context, in *v1alpha1.RevokeInstanceInviteRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_RevokeInstanceInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.RevokeInstanceInviteRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_RevokeInstanceInvite_Call) Return(_a0 *v1alpha1.RevokeInstanceInviteResponse, _a1 error) *MockV1alpha1InstanceServiceClient_RevokeInstanceInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_RevokeInstanceInvite_Call) RunAndReturn(run func(context.Context, *v1alpha1.RevokeInstanceInviteRequest, ...grpc.CallOption) (*v1alpha1.RevokeInstanceInviteResponse, error)) *MockV1alpha1InstanceServiceClient_RevokeInstanceInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunFlavorVersion provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) RunFlavorVersion(ctx context.Context, in *v1alpha1.RunFlavorVersionRequest, opts ...grpc.CallOption) (*v1alpha1.RunFlavorVersionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunFlavorVersion")
+	}
+
+	var r0 *v1alpha1.RunFlavorVersionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.RunFlavorVersionRequest, ...grpc.CallOption) (*v1alpha1.RunFlavorVersionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.RunFlavorVersionRequest, ...grpc.CallOption) *v1alpha1.RunFlavorVersionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.RunFlavorVersionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.RunFlavorVersionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunFlavorVersion'
+type MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call struct {
+	*mock.Call
+}
+
+// RunFlavorVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.RunFlavorVersionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) RunFlavorVersion(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call {
+	return &MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call{Call: _e.mock.On("RunFlavorVersion",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call) Run(run func(ctx context.Context, in *v1alpha1.RunFlavorVersionRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.RunFlavorVersionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call) Return(_a0 *v1alpha1.RunFlavorVersionResponse, _a1 error) *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call) RunAndReturn(run func(context.Context, *v1alpha1.RunFlavorVersionRequest, ...grpc.CallOption) (*v1alpha1.RunFlavorVersionResponse, error)) *MockV1alpha1InstanceServiceClient_RunFlavorVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopInstance provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) StopInstance(ctx context.Context, in *v1alpha1.StopInstanceRequest, opts ...grpc.CallOption) (*v1alpha1.StopInstanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopInstance")
+	}
+
+	var r0 *v1alpha1.StopInstanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.StopInstanceRequest, ...grpc.CallOption) (*v1alpha1.StopInstanceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.StopInstanceRequest, ...grpc.CallOption) *v1alpha1.StopInstanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.StopInstanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.StopInstanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_StopInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopInstance'
+type MockV1alpha1InstanceServiceClient_StopInstance_Call struct {
+	*mock.Call
+}
+
+// StopInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.StopInstanceRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) StopInstance(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_StopInstance_Call {
+	return &MockV1alpha1InstanceServiceClient_StopInstance_Call{Call: _e.mock.On("StopInstance",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_StopInstance_Call) Run(run func(ctx context.Context, in *v1alpha1.StopInstanceRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_StopInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.StopInstanceRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_StopInstance_Call) Return(_a0 *v1alpha1.StopInstanceResponse, _a1 error) *MockV1alpha1InstanceServiceClient_StopInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_StopInstance_Call) RunAndReturn(run func(context.Context, *v1alpha1.StopInstanceRequest, ...grpc.CallOption) (*v1alpha1.StopInstanceResponse, error)) *MockV1alpha1InstanceServiceClient_StopInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchInstances provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha1InstanceServiceClient) WatchInstances(ctx context.Context, in *v1alpha1.WatchInstancesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha1.WatchInstancesResponse], error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchInstances")
+	}
+
+	var r0 grpc.ServerStreamingClient[v1alpha1.WatchInstancesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.WatchInstancesRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha1.WatchInstancesResponse], error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.WatchInstancesRequest, ...grpc.CallOption) grpc.ServerStreamingClient[v1alpha1.WatchInstancesResponse]); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[v1alpha1.WatchInstancesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.WatchInstancesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha1InstanceServiceClient_WatchInstances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchInstances'
+type MockV1alpha1InstanceServiceClient_WatchInstances_Call struct {
+	*mock.Call
+}
+
+// WatchInstances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha1.WatchInstancesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha1InstanceServiceClient_Expecter) WatchInstances(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha1InstanceServiceClient_WatchInstances_Call {
+	return &MockV1alpha1InstanceServiceClient_WatchInstances_Call{Call: _e.mock.On("WatchInstances",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_WatchInstances_Call) Run(run func(ctx context.Context, in *v1alpha1.WatchInstancesRequest, opts ...grpc.CallOption)) *MockV1alpha1InstanceServiceClient_WatchInstances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha1.WatchInstancesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_WatchInstances_Call) Return(_a0 grpc.ServerStreamingClient[v1alpha1.WatchInstancesResponse], _a1 error) *MockV1alpha1InstanceServiceClient_WatchInstances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha1InstanceServiceClient_WatchInstances_Call) RunAndReturn(run func(context.Context, *v1alpha1.WatchInstancesRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha1.WatchInstancesResponse], error)) *MockV1alpha1InstanceServiceClient_WatchInstances_Call {
 	_c.Call.Return(run)
 	return _c
 }
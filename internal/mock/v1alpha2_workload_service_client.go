@@ -25,6 +25,154 @@ func (_m *MockV1alpha2WorkloadServiceClient) EXPECT() *MockV1alpha2WorkloadServi
 	return &MockV1alpha2WorkloadServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// PrepareWorkload provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha2WorkloadServiceClient) PrepareWorkload(ctx context.Context, in *v1alpha2.PrepareWorkloadRequest, opts ...grpc.CallOption) (*v1alpha2.PrepareWorkloadResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrepareWorkload")
+	}
+
+	var r0 *v1alpha2.PrepareWorkloadResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha2.PrepareWorkloadRequest, ...grpc.CallOption) (*v1alpha2.PrepareWorkloadResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha2.PrepareWorkloadRequest, ...grpc.CallOption) *v1alpha2.PrepareWorkloadResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha2.PrepareWorkloadResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha2.PrepareWorkloadRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha2WorkloadServiceClient_PrepareWorkload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrepareWorkload'
+type MockV1alpha2WorkloadServiceClient_PrepareWorkload_Call struct {
+	*mock.Call
+}
+
+// PrepareWorkload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha2.PrepareWorkloadRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha2WorkloadServiceClient_Expecter) PrepareWorkload(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha2WorkloadServiceClient_PrepareWorkload_Call {
+	return &MockV1alpha2WorkloadServiceClient_PrepareWorkload_Call{Call: _e.mock.On("PrepareWorkload",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha2WorkloadServiceClient_PrepareWorkload_Call) Run(run func(ctx context.Context, in *v1alpha2.PrepareWorkloadRequest, opts ...grpc.CallOption)) *MockV1alpha2WorkloadServiceClient_PrepareWorkload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha2.PrepareWorkloadRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha2WorkloadServiceClient_PrepareWorkload_Call) Return(_a0 *v1alpha2.PrepareWorkloadResponse, _a1 error) *MockV1alpha2WorkloadServiceClient_PrepareWorkload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha2WorkloadServiceClient_PrepareWorkload_Call) RunAndReturn(run func(context.Context, *v1alpha2.PrepareWorkloadRequest, ...grpc.CallOption) (*v1alpha2.PrepareWorkloadResponse, error)) *MockV1alpha2WorkloadServiceClient_PrepareWorkload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SnapshotWorkload provides a mock function with given fields: ctx, in, opts
+func (_m *MockV1alpha2WorkloadServiceClient) SnapshotWorkload(ctx context.Context, in *v1alpha2.SnapshotWorkloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha2.SnapshotWorkloadResponse], error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SnapshotWorkload")
+	}
+
+	var r0 grpc.ServerStreamingClient[v1alpha2.SnapshotWorkloadResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha2.SnapshotWorkloadRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha2.SnapshotWorkloadResponse], error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha2.SnapshotWorkloadRequest, ...grpc.CallOption) grpc.ServerStreamingClient[v1alpha2.SnapshotWorkloadResponse]); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[v1alpha2.SnapshotWorkloadResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha2.SnapshotWorkloadRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockV1alpha2WorkloadServiceClient_SnapshotWorkload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnapshotWorkload'
+type MockV1alpha2WorkloadServiceClient_SnapshotWorkload_Call struct {
+	*mock.Call
+}
+
+// SnapshotWorkload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1alpha2.SnapshotWorkloadRequest
+//   - opts ...grpc.CallOption
+func (_e *MockV1alpha2WorkloadServiceClient_Expecter) SnapshotWorkload(ctx interface{}, in interface{}, opts ...interface{}) *MockV1alpha2WorkloadServiceClient_SnapshotWorkload_Call {
+	return &MockV1alpha2WorkloadServiceClient_SnapshotWorkload_Call{Call: _e.mock.On("SnapshotWorkload",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockV1alpha2WorkloadServiceClient_SnapshotWorkload_Call) Run(run func(ctx context.Context, in *v1alpha2.SnapshotWorkloadRequest, opts ...grpc.CallOption)) *MockV1alpha2WorkloadServiceClient_SnapshotWorkload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v1alpha2.SnapshotWorkloadRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockV1alpha2WorkloadServiceClient_SnapshotWorkload_Call) Return(_a0 grpc.ServerStreamingClient[v1alpha2.SnapshotWorkloadResponse], _a1 error) *MockV1alpha2WorkloadServiceClient_SnapshotWorkload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockV1alpha2WorkloadServiceClient_SnapshotWorkload_Call) RunAndReturn(run func(context.Context, *v1alpha2.SnapshotWorkloadRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[v1alpha2.SnapshotWorkloadResponse], error)) *MockV1alpha2WorkloadServiceClient_SnapshotWorkload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StopWorkload provides a mock function with given fields: ctx, in, opts
 func (_m *MockV1alpha2WorkloadServiceClient) StopWorkload(ctx context.Context, in *v1alpha2.WorkloadStopRequest, opts ...grpc.CallOption) (*v1alpha2.WorkloadStopResponse, error) {
 	_va := make([]interface{}, len(opts))
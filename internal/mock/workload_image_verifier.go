@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWorkloadImageVerifier is an autogenerated mock type for the ImageVerifier type
+type MockWorkloadImageVerifier struct {
+	mock.Mock
+}
+
+type MockWorkloadImageVerifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWorkloadImageVerifier) EXPECT() *MockWorkloadImageVerifier_Expecter {
+	return &MockWorkloadImageVerifier_Expecter{mock: &_m.Mock}
+}
+
+// Verify provides a mock function with given fields: ctx, imgRef
+func (_m *MockWorkloadImageVerifier) Verify(ctx context.Context, imgRef string) error {
+	ret := _m.Called(ctx, imgRef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, imgRef)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkloadImageVerifier_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockWorkloadImageVerifier_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imgRef string
+func (_e *MockWorkloadImageVerifier_Expecter) Verify(ctx interface{}, imgRef interface{}) *MockWorkloadImageVerifier_Verify_Call {
+	return &MockWorkloadImageVerifier_Verify_Call{Call: _e.mock.On("Verify", ctx, imgRef)}
+}
+
+func (_c *MockWorkloadImageVerifier_Verify_Call) Run(run func(ctx context.Context, imgRef string)) *MockWorkloadImageVerifier_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWorkloadImageVerifier_Verify_Call) Return(_a0 error) *MockWorkloadImageVerifier_Verify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkloadImageVerifier_Verify_Call) RunAndReturn(run func(context.Context, string) error) *MockWorkloadImageVerifier_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWorkloadImageVerifier creates a new instance of MockWorkloadImageVerifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWorkloadImageVerifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWorkloadImageVerifier {
+	mock := &MockWorkloadImageVerifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
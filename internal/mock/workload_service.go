@@ -4,11 +4,17 @@ package mock
 
 import (
 	context "context"
+	io "io"
 
-	status "github.com/spacechunks/explorer/platformd/status"
 	mock "github.com/stretchr/testify/mock"
 
+	status "github.com/spacechunks/explorer/platformd/status"
+
+	time "time"
+
 	workload "github.com/spacechunks/explorer/platformd/workload"
+
+	workloadenv "github.com/spacechunks/explorer/internal/workloadenv"
 )
 
 // MockWorkloadService is an autogenerated mock type for the Service type
@@ -24,9 +30,9 @@ func (_m *MockWorkloadService) EXPECT() *MockWorkloadService_Expecter {
 	return &MockWorkloadService_Expecter{mock: &_m.Mock}
 }
 
-// GetWorkloadHealth provides a mock function with given fields: ctx, id
-func (_m *MockWorkloadService) GetWorkloadHealth(ctx context.Context, id string) (status.WorkloadHealthStatus, error) {
-	ret := _m.Called(ctx, id)
+// GetWorkloadHealth provides a mock function with given fields: ctx, id, startupGracePeriod
+func (_m *MockWorkloadService) GetWorkloadHealth(ctx context.Context, id string, startupGracePeriod time.Duration) (status.WorkloadHealthStatus, error) {
+	ret := _m.Called(ctx, id, startupGracePeriod)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetWorkloadHealth")
@@ -34,17 +40,17 @@ func (_m *MockWorkloadService) GetWorkloadHealth(ctx context.Context, id string)
 
 	var r0 status.WorkloadHealthStatus
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (status.WorkloadHealthStatus, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (status.WorkloadHealthStatus, error)); ok {
+		return rf(ctx, id, startupGracePeriod)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) status.WorkloadHealthStatus); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) status.WorkloadHealthStatus); ok {
+		r0 = rf(ctx, id, startupGracePeriod)
 	} else {
 		r0 = ret.Get(0).(status.WorkloadHealthStatus)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, id, startupGracePeriod)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,13 +66,14 @@ type MockWorkloadService_GetWorkloadHealth_Call struct {
 // GetWorkloadHealth is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockWorkloadService_Expecter) GetWorkloadHealth(ctx interface{}, id interface{}) *MockWorkloadService_GetWorkloadHealth_Call {
-	return &MockWorkloadService_GetWorkloadHealth_Call{Call: _e.mock.On("GetWorkloadHealth", ctx, id)}
+//   - startupGracePeriod time.Duration
+func (_e *MockWorkloadService_Expecter) GetWorkloadHealth(ctx interface{}, id interface{}, startupGracePeriod interface{}) *MockWorkloadService_GetWorkloadHealth_Call {
+	return &MockWorkloadService_GetWorkloadHealth_Call{Call: _e.mock.On("GetWorkloadHealth", ctx, id, startupGracePeriod)}
 }
 
-func (_c *MockWorkloadService_GetWorkloadHealth_Call) Run(run func(ctx context.Context, id string)) *MockWorkloadService_GetWorkloadHealth_Call {
+func (_c *MockWorkloadService_GetWorkloadHealth_Call) Run(run func(ctx context.Context, id string, startupGracePeriod time.Duration)) *MockWorkloadService_GetWorkloadHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
 	})
 	return _c
 }
@@ -76,7 +83,229 @@ func (_c *MockWorkloadService_GetWorkloadHealth_Call) Return(_a0 status.Workload
 	return _c
 }
 
-func (_c *MockWorkloadService_GetWorkloadHealth_Call) RunAndReturn(run func(context.Context, string) (status.WorkloadHealthStatus, error)) *MockWorkloadService_GetWorkloadHealth_Call {
+func (_c *MockWorkloadService_GetWorkloadHealth_Call) RunAndReturn(run func(context.Context, string, time.Duration) (status.WorkloadHealthStatus, error)) *MockWorkloadService_GetWorkloadHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstancePorts provides a mock function with given fields: ctx
+func (_m *MockWorkloadService) InstancePorts(ctx context.Context) (map[string]uint16, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstancePorts")
+	}
+
+	var r0 map[string]uint16
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]uint16, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]uint16); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]uint16)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkloadService_InstancePorts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstancePorts'
+type MockWorkloadService_InstancePorts_Call struct {
+	*mock.Call
+}
+
+// InstancePorts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWorkloadService_Expecter) InstancePorts(ctx interface{}) *MockWorkloadService_InstancePorts_Call {
+	return &MockWorkloadService_InstancePorts_Call{Call: _e.mock.On("InstancePorts", ctx)}
+}
+
+func (_c *MockWorkloadService_InstancePorts_Call) Run(run func(ctx context.Context)) *MockWorkloadService_InstancePorts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockWorkloadService_InstancePorts_Call) Return(_a0 map[string]uint16, _a1 error) *MockWorkloadService_InstancePorts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkloadService_InstancePorts_Call) RunAndReturn(run func(context.Context) (map[string]uint16, error)) *MockWorkloadService_InstancePorts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorkloads provides a mock function with given fields: ctx, sel
+func (_m *MockWorkloadService) ListWorkloads(ctx context.Context, sel workloadenv.Selector) ([]workload.Info, error) {
+	ret := _m.Called(ctx, sel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkloads")
+	}
+
+	var r0 []workload.Info
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, workloadenv.Selector) ([]workload.Info, error)); ok {
+		return rf(ctx, sel)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, workloadenv.Selector) []workload.Info); ok {
+		r0 = rf(ctx, sel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]workload.Info)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, workloadenv.Selector) error); ok {
+		r1 = rf(ctx, sel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkloadService_ListWorkloads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkloads'
+type MockWorkloadService_ListWorkloads_Call struct {
+	*mock.Call
+}
+
+// ListWorkloads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sel workloadenv.Selector
+func (_e *MockWorkloadService_Expecter) ListWorkloads(ctx interface{}, sel interface{}) *MockWorkloadService_ListWorkloads_Call {
+	return &MockWorkloadService_ListWorkloads_Call{Call: _e.mock.On("ListWorkloads", ctx, sel)}
+}
+
+func (_c *MockWorkloadService_ListWorkloads_Call) Run(run func(ctx context.Context, sel workloadenv.Selector)) *MockWorkloadService_ListWorkloads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(workloadenv.Selector))
+	})
+	return _c
+}
+
+func (_c *MockWorkloadService_ListWorkloads_Call) Return(_a0 []workload.Info, _a1 error) *MockWorkloadService_ListWorkloads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkloadService_ListWorkloads_Call) RunAndReturn(run func(context.Context, workloadenv.Selector) ([]workload.Info, error)) *MockWorkloadService_ListWorkloads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PullImages provides a mock function with given fields: ctx, w
+func (_m *MockWorkloadService) PullImages(ctx context.Context, w workload.Workload) error {
+	ret := _m.Called(ctx, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PullImages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, workload.Workload) error); ok {
+		r0 = rf(ctx, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkloadService_PullImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PullImages'
+type MockWorkloadService_PullImages_Call struct {
+	*mock.Call
+}
+
+// PullImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - w workload.Workload
+func (_e *MockWorkloadService_Expecter) PullImages(ctx interface{}, w interface{}) *MockWorkloadService_PullImages_Call {
+	return &MockWorkloadService_PullImages_Call{Call: _e.mock.On("PullImages", ctx, w)}
+}
+
+func (_c *MockWorkloadService_PullImages_Call) Run(run func(ctx context.Context, w workload.Workload)) *MockWorkloadService_PullImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(workload.Workload))
+	})
+	return _c
+}
+
+func (_c *MockWorkloadService_PullImages_Call) Return(_a0 error) *MockWorkloadService_PullImages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkloadService_PullImages_Call) RunAndReturn(run func(context.Context, workload.Workload) error) *MockWorkloadService_PullImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadyEvent provides a mock function with given fields: id
+func (_m *MockWorkloadService) ReadyEvent(id string) (*status.WorkloadEvent, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadyEvent")
+	}
+
+	var r0 *status.WorkloadEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*status.WorkloadEvent, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *status.WorkloadEvent); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.WorkloadEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkloadService_ReadyEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadyEvent'
+type MockWorkloadService_ReadyEvent_Call struct {
+	*mock.Call
+}
+
+// ReadyEvent is a helper method to define mock.On call
+//   - id string
+func (_e *MockWorkloadService_Expecter) ReadyEvent(id interface{}) *MockWorkloadService_ReadyEvent_Call {
+	return &MockWorkloadService_ReadyEvent_Call{Call: _e.mock.On("ReadyEvent", id)}
+}
+
+func (_c *MockWorkloadService_ReadyEvent_Call) Run(run func(id string)) *MockWorkloadService_ReadyEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockWorkloadService_ReadyEvent_Call) Return(_a0 *status.WorkloadEvent, _a1 error) *MockWorkloadService_ReadyEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkloadService_ReadyEvent_Call) RunAndReturn(run func(string) (*status.WorkloadEvent, error)) *MockWorkloadService_ReadyEvent_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -176,6 +405,113 @@ func (_c *MockWorkloadService_RunWorkload_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// Snapshot provides a mock function with given fields: ctx, id, w
+func (_m *MockWorkloadService) Snapshot(ctx context.Context, id string, w io.Writer) error {
+	ret := _m.Called(ctx, id, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Writer) error); ok {
+		r0 = rf(ctx, id, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkloadService_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type MockWorkloadService_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - w io.Writer
+func (_e *MockWorkloadService_Expecter) Snapshot(ctx interface{}, id interface{}, w interface{}) *MockWorkloadService_Snapshot_Call {
+	return &MockWorkloadService_Snapshot_Call{Call: _e.mock.On("Snapshot", ctx, id, w)}
+}
+
+func (_c *MockWorkloadService_Snapshot_Call) Run(run func(ctx context.Context, id string, w io.Writer)) *MockWorkloadService_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockWorkloadService_Snapshot_Call) Return(_a0 error) *MockWorkloadService_Snapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkloadService_Snapshot_Call) RunAndReturn(run func(context.Context, string, io.Writer) error) *MockWorkloadService_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TerminationEvent provides a mock function with given fields: ctx, id
+func (_m *MockWorkloadService) TerminationEvent(ctx context.Context, id string) (*status.WorkloadEvent, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TerminationEvent")
+	}
+
+	var r0 *status.WorkloadEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*status.WorkloadEvent, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *status.WorkloadEvent); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*status.WorkloadEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkloadService_TerminationEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TerminationEvent'
+type MockWorkloadService_TerminationEvent_Call struct {
+	*mock.Call
+}
+
+// TerminationEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockWorkloadService_Expecter) TerminationEvent(ctx interface{}, id interface{}) *MockWorkloadService_TerminationEvent_Call {
+	return &MockWorkloadService_TerminationEvent_Call{Call: _e.mock.On("TerminationEvent", ctx, id)}
+}
+
+func (_c *MockWorkloadService_TerminationEvent_Call) Run(run func(ctx context.Context, id string)) *MockWorkloadService_TerminationEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWorkloadService_TerminationEvent_Call) Return(_a0 *status.WorkloadEvent, _a1 error) *MockWorkloadService_TerminationEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkloadService_TerminationEvent_Call) RunAndReturn(run func(context.Context, string) (*status.WorkloadEvent, error)) *MockWorkloadService_TerminationEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WorkloadMetadata provides a mock function with given fields: ctx, id
 func (_m *MockWorkloadService) WorkloadMetadata(ctx context.Context, id string) (workload.Metadata, error) {
 	ret := _m.Called(ctx, id)
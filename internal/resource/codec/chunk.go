@@ -19,46 +19,24 @@
 package codec
 
 import (
+	"time"
+
 	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
-	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
 	"github.com/spacechunks/explorer/internal/file"
 	"github.com/spacechunks/explorer/internal/resource"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ChunkToTransport(domain resource.Chunk) *chunkv1alpha1.Chunk {
-	c := &chunkv1alpha1.Chunk{
-		Id:          domain.ID,
-		Name:        domain.Name,
-		Description: domain.Description,
-		Tags:        domain.Tags,
-		Owner: &userv1alpha1.User{
-			Id:        domain.Owner.ID,
-			Nickname:  domain.Owner.Nickname,
-			CreatedAt: timestamppb.New(domain.Owner.CreatedAt),
-			UpdatedAt: timestamppb.New(domain.Owner.UpdatedAt),
-		},
-		CreatedAt: timestamppb.New(domain.CreatedAt),
-		UpdatedAt: timestamppb.New(domain.UpdatedAt),
-		Thumbnail: &chunkv1alpha1.Thumbnail{
-			Hash: domain.Thumbnail.Hash,
-		},
+	c := chunkMetaToTransport(domain)
+	c.Owner = UserToTransport(domain.Owner)
+	c.Thumbnail = &chunkv1alpha1.Thumbnail{
+		Hash: domain.Thumbnail.Hash,
 	}
 
 	flavors := make([]*chunkv1alpha1.Flavor, 0, len(domain.Flavors))
 	for _, f := range domain.Flavors {
-		versions := make([]*chunkv1alpha1.FlavorVersion, 0, len(f.Versions))
-		for _, v := range f.Versions {
-			versions = append(versions, FlavorVersionToTransport(v))
-		}
-
-		flavors = append(flavors, &chunkv1alpha1.Flavor{
-			Id:        f.ID,
-			Name:      f.Name,
-			Versions:  versions,
-			CreatedAt: timestamppb.New(f.CreatedAt),
-			UpdatedAt: timestamppb.New(f.UpdatedAt),
-		})
+		flavors = append(flavors, FlavorToTransport(f))
 	}
 
 	c.Flavors = flavors
@@ -66,32 +44,225 @@ func ChunkToTransport(domain resource.Chunk) *chunkv1alpha1.Chunk {
 	return c
 }
 
+// chunkMetaToTransport only converts the fields describing the chunk itself.
+// it is used where the chunk is embedded in other objects, like instances,
+// which do not carry its owner, thumbnail or flavors.
+func chunkMetaToTransport(domain resource.Chunk) *chunkv1alpha1.Chunk {
+	return &chunkv1alpha1.Chunk{
+		Id:          domain.ID,
+		Name:        domain.Name,
+		Description: domain.Description,
+		Tags:        domain.Tags,
+		CreatedAt:   timestamppb.New(domain.CreatedAt),
+		UpdatedAt:   timestamppb.New(domain.UpdatedAt),
+	}
+}
+
 func FlavorVersionToDomain(transport *chunkv1alpha1.FlavorVersion) resource.FlavorVersion {
 	return resource.FlavorVersion{
-		ID:               transport.GetId(),
-		Version:          transport.GetVersion(),
-		MinecraftVersion: transport.MinecraftVersion,
-		Hash:             transport.GetHash(),
-		BuildStatus:      resource.FlavorVersionBuildStatus(transport.BuildStatus),
-		FileHashes:       FileHashSliceToDomain(transport.FileHashes),
-		CreatedAt:        transport.GetCreatedAt().AsTime(),
-		MinPlayers:       transport.MinPlayers,
-		MaxPlayers:       transport.MaxPlayers,
+		ID:                 transport.GetId(),
+		Version:            transport.GetVersion(),
+		MinecraftVersion:   transport.MinecraftVersion,
+		Hash:               transport.GetHash(),
+		BuildStatus:        resource.FlavorVersionBuildStatus(transport.BuildStatus),
+		FileHashes:         FileHashSliceToDomain(transport.FileHashes),
+		CreatedAt:          transport.GetCreatedAt().AsTime(),
+		MinPlayers:         transport.MinPlayers,
+		MaxPlayers:         transport.MaxPlayers,
+		StartupGracePeriod: time.Duration(transport.StartupGracePeriodSeconds) * time.Second,
+		RestartPolicy:      resource.RestartPolicy(transport.RestartPolicy.String()),
+		RestartMaxRetries:  transport.RestartMaxRetries,
+		BuildError:         transport.GetBuildError(),
+		BuildProgress:      transport.GetBuildProgress(),
+		AssetHashes:        transport.GetAssetHashes(),
+		RestoreLatency:     time.Duration(transport.GetRestoreLatencyMs()) * time.Millisecond,
+		JavaVersion:        transport.GetJavaVersion(),
+		StartupCommand:     transport.GetStartupCommand(),
+
+		CheckpointResources: CheckpointResourcesToDomain(transport.GetCheckpointResources()),
 	}
 }
 
 func FlavorVersionToTransport(domain resource.FlavorVersion) *chunkv1alpha1.FlavorVersion {
 	return &chunkv1alpha1.FlavorVersion{
-		Id:               domain.ID,
-		Version:          domain.Version,
-		MinecraftVersion: domain.MinecraftVersion,
-		Hash:             domain.Hash,
-		FileHashes:       FileHashSliceToTransport(domain.FileHashes),
-		BuildStatus:      chunkv1alpha1.BuildStatus(chunkv1alpha1.BuildStatus_value[string(domain.BuildStatus)]),
-		FilesUploaded:    domain.FilesUploaded,
-		CreatedAt:        timestamppb.New(domain.CreatedAt),
-		MinPlayers:       domain.MinPlayers,
-		MaxPlayers:       domain.MaxPlayers,
+		Id:                        domain.ID,
+		Version:                   domain.Version,
+		MinecraftVersion:          domain.MinecraftVersion,
+		Hash:                      domain.Hash,
+		FileHashes:                FileHashSliceToTransport(domain.FileHashes),
+		BuildStatus:               chunkv1alpha1.BuildStatus(chunkv1alpha1.BuildStatus_value[string(domain.BuildStatus)]),
+		FilesUploaded:             domain.FilesUploaded,
+		CreatedAt:                 timestamppb.New(domain.CreatedAt),
+		MinPlayers:                domain.MinPlayers,
+		MaxPlayers:                domain.MaxPlayers,
+		StartupGracePeriodSeconds: uint32(domain.StartupGracePeriod / time.Second),
+		RestartPolicy:             chunkv1alpha1.RestartPolicy(chunkv1alpha1.RestartPolicy_value[string(domain.RestartPolicy)]),
+		RestartMaxRetries:         domain.RestartMaxRetries,
+		BuildError:                domain.BuildError,
+		BuildProgress:             domain.BuildProgress,
+		AssetHashes:               domain.AssetHashes,
+		RestoreLatencyMs:          uint32(domain.RestoreLatency.Milliseconds()),
+		JavaVersion:               domain.JavaVersion,
+		StartupCommand:            domain.StartupCommand,
+
+		CheckpointResources: CheckpointResourcesToTransport(domain.CheckpointResources),
+	}
+}
+
+func AssetToTransport(domain resource.Asset) *chunkv1alpha1.Asset {
+	return &chunkv1alpha1.Asset{
+		Hash:      domain.Hash,
+		SizeBytes: domain.SizeBytes,
+		CreatedAt: timestamppb.New(domain.CreatedAt),
+	}
+}
+
+func BuildProvenanceToTransport(domain resource.BuildProvenance) *chunkv1alpha1.BuildProvenance {
+	plugins := make([]*chunkv1alpha1.PluginHash, 0, len(domain.Plugins))
+	for _, p := range domain.Plugins {
+		plugins = append(plugins, &chunkv1alpha1.PluginHash{
+			Path:   p.Path,
+			Sha256: p.SHA256,
+		})
+	}
+
+	ret := &chunkv1alpha1.BuildProvenance{
+		BaseImageDigest:  domain.BaseImageDigest,
+		ImageDigest:      domain.ImageDigest,
+		BuilderVersion:   domain.BuilderVersion,
+		InputHash:        domain.InputHash,
+		Plugins:          plugins,
+		ImageBuiltAt:     timestamppb.New(domain.ImageBuiltAt),
+		CheckpointNodeId: domain.CheckpointNodeID,
+		PlatformdVersion: domain.PlatformdVersion,
+	}
+
+	if domain.CheckpointBuiltAt != nil {
+		ret.CheckpointBuiltAt = timestamppb.New(*domain.CheckpointBuiltAt)
+	}
+
+	return ret
+}
+
+func CanaryToTransport(domain resource.Canary) *chunkv1alpha1.Canary {
+	return &chunkv1alpha1.Canary{
+		Id:                    domain.ID,
+		FlavorId:              domain.FlavorID,
+		StableFlavorVersionId: domain.StableVersionID,
+		CanaryFlavorVersionId: domain.CanaryVersionID,
+		Percentage:            domain.Percentage,
+		State:                 chunkv1alpha1.CanaryState(chunkv1alpha1.CanaryState_value[string(domain.State)]),
+		StableStats:           canaryStatsToTransport(domain.StableStats),
+		CanaryStats:           canaryStatsToTransport(domain.CanaryStats),
+		CreatedAt:             timestamppb.New(domain.CreatedAt),
+		UpdatedAt:             timestamppb.New(domain.UpdatedAt),
+	}
+}
+
+func FlavorChannelToTransport(domain resource.FlavorChannel) *chunkv1alpha1.FlavorChannel {
+	return &chunkv1alpha1.FlavorChannel{
+		FlavorId:        domain.FlavorID,
+		Name:            domain.Name,
+		FlavorVersionId: domain.FlavorVersionID,
+		UpdatedAt:       timestamppb.New(domain.UpdatedAt),
+	}
+}
+
+func AutoscalingPolicyToTransport(domain resource.AutoscalingPolicy) *chunkv1alpha1.AutoscalingPolicy {
+	ret := &chunkv1alpha1.AutoscalingPolicy{
+		FlavorId:                 domain.FlavorID,
+		MinInstances:             domain.MinInstances,
+		MaxInstances:             domain.MaxInstances,
+		TargetUtilization:        domain.TargetUtilization,
+		ScaleUpCooldownSeconds:   uint32(domain.ScaleUpCooldown.Seconds()),
+		ScaleDownCooldownSeconds: uint32(domain.ScaleDownCooldown.Seconds()),
+	}
+
+	if domain.LastScaleUpAt != nil {
+		ret.LastScaleUpAt = timestamppb.New(*domain.LastScaleUpAt)
+	}
+
+	if domain.LastScaleDownAt != nil {
+		ret.LastScaleDownAt = timestamppb.New(*domain.LastScaleDownAt)
+	}
+
+	if !domain.UpdatedAt.IsZero() {
+		ret.UpdatedAt = timestamppb.New(domain.UpdatedAt)
+	}
+
+	return ret
+}
+
+func RetentionPolicyToTransport(domain resource.RetentionPolicy) *chunkv1alpha1.RetentionPolicy {
+	ret := &chunkv1alpha1.RetentionPolicy{
+		ChunkId:          domain.ChunkID,
+		KeepLastVersions: domain.KeepLastVersions,
+		KeepDays:         domain.KeepDays,
+	}
+
+	if !domain.UpdatedAt.IsZero() {
+		ret.UpdatedAt = timestamppb.New(domain.UpdatedAt)
+	}
+
+	return ret
+}
+
+func ChunkReadmeToTransport(domain resource.ChunkReadme) *chunkv1alpha1.ChunkReadme {
+	ret := &chunkv1alpha1.ChunkReadme{
+		ChunkId:  domain.ChunkID,
+		Markdown: domain.Markdown,
+	}
+
+	if !domain.UpdatedAt.IsZero() {
+		ret.UpdatedAt = timestamppb.New(domain.UpdatedAt)
+	}
+
+	return ret
+}
+
+func ChunkMediaToTransport(domain resource.ChunkMedia) *chunkv1alpha1.ChunkMedia {
+	ret := &chunkv1alpha1.ChunkMedia{
+		Id:          domain.ID,
+		ChunkId:     domain.ChunkID,
+		Hash:        domain.Hash,
+		ContentType: domain.ContentType,
+		SizeBytes:   domain.SizeBytes,
+		DownloadUrl: domain.DownloadURL,
+		CreatedAt:   timestamppb.New(domain.CreatedAt),
+	}
+
+	if !domain.DownloadURLExpiresAt.IsZero() {
+		ret.DownloadUrlExpiresAt = timestamppb.New(domain.DownloadURLExpiresAt)
+	}
+
+	return ret
+}
+
+func canaryStatsToTransport(domain resource.CanaryStats) *chunkv1alpha1.CanaryStats {
+	return &chunkv1alpha1.CanaryStats{
+		Launches:         domain.Launches,
+		CrashedInstances: domain.CrashedInstances,
+		Connections:      domain.Connections,
+	}
+}
+
+func CheckpointResourcesToDomain(transport *chunkv1alpha1.CheckpointResources) *resource.CheckpointResources {
+	if transport == nil {
+		return nil
+	}
+	return &resource.CheckpointResources{
+		CPUMillis:        transport.GetCpuMillis(),
+		MemoryLimitBytes: transport.GetMemoryLimitBytes(),
+	}
+}
+
+func CheckpointResourcesToTransport(domain *resource.CheckpointResources) *chunkv1alpha1.CheckpointResources {
+	if domain == nil {
+		return nil
+	}
+	return &chunkv1alpha1.CheckpointResources{
+		CpuMillis:        domain.CPUMillis,
+		MemoryLimitBytes: domain.MemoryLimitBytes,
 	}
 }
 
@@ -99,8 +270,9 @@ func FileHashSliceToTransport(domain []file.Hash) []*chunkv1alpha1.FileHashes {
 	hashes := make([]*chunkv1alpha1.FileHashes, 0, len(domain))
 	for _, fh := range domain {
 		hashes = append(hashes, &chunkv1alpha1.FileHashes{
-			Path: fh.Path,
-			Hash: fh.Hash,
+			Path:      fh.Path,
+			Hash:      fh.Hash,
+			SizeBytes: fh.SizeBytes,
 		})
 	}
 	return hashes
@@ -110,8 +282,9 @@ func FileHashSliceToDomain(transport []*chunkv1alpha1.FileHashes) []file.Hash {
 	hashes := make([]file.Hash, 0, len(transport))
 	for _, fh := range transport {
 		hashes = append(hashes, file.Hash{
-			Path: fh.GetPath(),
-			Hash: fh.GetHash(),
+			Path:      fh.GetPath(),
+			Hash:      fh.GetHash(),
+			SizeBytes: fh.GetSizeBytes(),
 		})
 	}
 	return hashes
@@ -140,3 +313,165 @@ func FlavorToDomain(transport *chunkv1alpha1.Flavor) resource.Flavor {
 		UpdatedAt: transport.GetUpdatedAt().AsTime(),
 	}
 }
+
+func ChunkImportToTransport(domain resource.ChunkImport) *chunkv1alpha1.ChunkImport {
+	return &chunkv1alpha1.ChunkImport{
+		Id:              domain.ID,
+		ChunkId:         domain.ChunkID,
+		FlavorId:        domain.FlavorID,
+		FlavorVersionId: domain.FlavorVersionID,
+		State: chunkv1alpha1.ChunkImportState(
+			chunkv1alpha1.ChunkImportState_value["IMPORT_"+string(domain.State)],
+		),
+		Error:     domain.Error,
+		CreatedAt: timestamppb.New(domain.CreatedAt),
+		UpdatedAt: timestamppb.New(domain.UpdatedAt),
+	}
+}
+
+func ChunkTemplateToTransport(domain resource.ChunkTemplate) *chunkv1alpha1.ChunkTemplate {
+	return &chunkv1alpha1.ChunkTemplate{
+		Id:              domain.ID,
+		Name:            domain.Name,
+		Description:     domain.Description,
+		FlavorVersionId: domain.FlavorVersionID,
+		CreatedAt:       timestamppb.New(domain.CreatedAt),
+		UpdatedAt:       timestamppb.New(domain.UpdatedAt),
+	}
+}
+
+func CostToTransport(domain resource.Cost) *chunkv1alpha1.Cost {
+	return &chunkv1alpha1.Cost{
+		Cpu:    domain.CPU,
+		Memory: domain.Memory,
+		Node:   domain.Node,
+		Total:  domain.Total(),
+	}
+}
+
+func InstanceCostToTransport(domain resource.InstanceCost) *chunkv1alpha1.InstanceCost {
+	ret := &chunkv1alpha1.InstanceCost{
+		InstanceId:  domain.Usage.InstanceID,
+		CpuMillis:   domain.Usage.CPUMillis,
+		MemoryBytes: domain.Usage.MemoryBytes,
+		StartedAt:   timestamppb.New(domain.Usage.StartedAt),
+		Hours:       domain.Hours,
+		Cost:        CostToTransport(domain.Cost),
+	}
+
+	if domain.Usage.StoppedAt != nil {
+		ret.StoppedAt = timestamppb.New(*domain.Usage.StoppedAt)
+	}
+
+	return ret
+}
+
+func ChunkCostToTransport(domain resource.ChunkCost) *chunkv1alpha1.ChunkCost {
+	return &chunkv1alpha1.ChunkCost{
+		ChunkId:       domain.ChunkID,
+		ChunkName:     domain.ChunkName,
+		Instances:     uint32(domain.Instances),
+		InstanceHours: domain.InstanceHours,
+		Cost:          CostToTransport(domain.Cost),
+	}
+}
+
+func CrossInstanceTrafficToTransport(domain resource.CrossInstanceTraffic) *chunkv1alpha1.CrossInstanceTraffic {
+	ret := &chunkv1alpha1.CrossInstanceTraffic{
+		ChunkId:       domain.ChunkID,
+		Reason:        domain.Reason,
+		RequestedBy:   domain.RequestedBy,
+		ReviewedBy:    domain.ReviewedBy,
+		ReviewMessage: domain.ReviewMessage,
+	}
+
+	// chunks that have not requested anything have no state.
+	if domain.State != "" {
+		ret.State = chunkv1alpha1.CrossInstanceTrafficState(
+			chunkv1alpha1.CrossInstanceTrafficState_value["TRAFFIC_"+string(domain.State)],
+		)
+	}
+
+	if !domain.CreatedAt.IsZero() {
+		ret.CreatedAt = timestamppb.New(domain.CreatedAt)
+	}
+
+	if !domain.UpdatedAt.IsZero() {
+		ret.UpdatedAt = timestamppb.New(domain.UpdatedAt)
+	}
+
+	return ret
+}
+
+func InstanceScheduleToTransport(domain resource.InstanceSchedule) *chunkv1alpha1.InstanceSchedule {
+	return &chunkv1alpha1.InstanceSchedule{
+		Id:              domain.ID,
+		ChunkId:         domain.ChunkID,
+		FlavorVersionId: domain.FlavorVersionID,
+		StartCron:       domain.StartCron,
+		StopCron:        domain.StopCron,
+		Timezone:        domain.Timezone,
+		InstanceId:      domain.InstanceID,
+		CreatedBy:       domain.CreatedBy,
+		CreatedAt:       timestamppb.New(domain.CreatedAt),
+	}
+}
+
+func ScheduleRunToTransport(domain resource.ScheduleRun) *chunkv1alpha1.ScheduleRun {
+	return &chunkv1alpha1.ScheduleRun{
+		Time: timestamppb.New(domain.Time),
+		Action: chunkv1alpha1.ScheduleAction(
+			chunkv1alpha1.ScheduleAction_value["SCHEDULE_"+string(domain.Action)],
+		),
+	}
+}
+
+func ChunkSpecToDomain(transport *chunkv1alpha1.ChunkSpec) resource.ChunkSpec {
+	flavors := make([]resource.FlavorSpec, 0, len(transport.GetFlavors()))
+	for _, f := range transport.GetFlavors() {
+		spec := resource.FlavorSpec{
+			Name:     f.GetName(),
+			Channels: f.GetChannels(),
+		}
+
+		if a := f.GetAutoscaling(); a != nil {
+			spec.Autoscaling = &resource.AutoscalingSpec{
+				MinInstances:      a.GetMinInstances(),
+				MaxInstances:      a.GetMaxInstances(),
+				TargetUtilization: a.GetTargetUtilization(),
+			}
+		}
+
+		flavors = append(flavors, spec)
+	}
+
+	schedules := make([]resource.ScheduleSpec, 0, len(transport.GetSchedules()))
+	for _, s := range transport.GetSchedules() {
+		schedules = append(schedules, resource.ScheduleSpec{
+			Flavor:    s.GetFlavor(),
+			Version:   s.GetVersion(),
+			StartCron: s.GetStartCron(),
+			StopCron:  s.GetStopCron(),
+			Timezone:  s.GetTimezone(),
+		})
+	}
+
+	return resource.ChunkSpec{
+		Name:        transport.GetName(),
+		Description: transport.GetDescription(),
+		Tags:        transport.GetTags(),
+		Flavors:     flavors,
+		Schedules:   schedules,
+	}
+}
+
+func ChunkSpecChangeToTransport(domain resource.ChunkSpecChange) *chunkv1alpha1.ChunkSpecChange {
+	return &chunkv1alpha1.ChunkSpecChange{
+		Action: chunkv1alpha1.ChunkSpecChangeAction(
+			chunkv1alpha1.ChunkSpecChangeAction_value["SPEC_CHANGE_"+string(domain.Action)],
+		),
+		Path:     domain.Path,
+		OldValue: domain.OldValue,
+		NewValue: domain.NewValue,
+	}
+}
@@ -19,73 +19,224 @@
 package codec
 
 import (
-	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
-	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
 	"github.com/spacechunks/explorer/internal/resource"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // InstanceToTransport converts the domain object to a transport layer object
 func InstanceToTransport(ins resource.Instance) *instancev1alpha1.Instance {
-	var (
-		port  uint32 = 0
-		state        = instancev1alpha1.InstanceState(instancev1alpha1.InstanceState_value[string(ins.State)])
-	)
-
+	var port uint32 = 0
 	if ins.Port != nil {
 		port = uint32(*ins.Port)
 	}
 
 	return &instancev1alpha1.Instance{
-		Id: ins.ID,
-		Chunk: &chunkv1alpha1.Chunk{
-			Id:          ins.Chunk.ID,
-			Name:        ins.Chunk.Name,
-			Description: ins.Chunk.Description,
-			Tags:        ins.Chunk.Tags,
-			CreatedAt:   timestamppb.New(ins.Chunk.CreatedAt),
-			UpdatedAt:   timestamppb.New(ins.Chunk.UpdatedAt),
-		},
-		Flavor: FlavorToTransport(ins.Flavor),
-		FlavorVersion: &chunkv1alpha1.FlavorVersion{
-			Id:               ins.FlavorVersion.ID,
-			Version:          ins.FlavorVersion.Version,
-			MinecraftVersion: ins.FlavorVersion.MinecraftVersion,
-			Hash:             ins.FlavorVersion.Hash,
-			FileHashes:       FileHashSliceToTransport(ins.FlavorVersion.FileHashes),
-			BuildStatus:      chunkv1alpha1.BuildStatus(chunkv1alpha1.BuildStatus_value[string(ins.FlavorVersion.BuildStatus)]),
-			CreatedAt:        timestamppb.New(ins.FlavorVersion.CreatedAt),
-			MinPlayers:       ins.FlavorVersion.MinPlayers,
-			MaxPlayers:       ins.FlavorVersion.MaxPlayers,
-		},
-		Owner: &userv1alpha1.User{
-			Id:        ins.Owner.ID,
-			Nickname:  ins.Owner.Nickname,
-			CreatedAt: timestamppb.New(ins.Owner.CreatedAt),
-			UpdatedAt: timestamppb.New(ins.Owner.UpdatedAt),
-		},
-		Ip:        ins.Address.String(),
-		Port:      port,
-		State:     state,
-		OrderedBy: ins.OrderedBy,
+		Id:                   ins.ID,
+		Chunk:                chunkMetaToTransport(ins.Chunk),
+		Flavor:               FlavorToTransport(ins.Flavor),
+		FlavorVersion:        FlavorVersionToTransport(ins.FlavorVersion),
+		Owner:                UserToTransport(ins.Owner),
+		Ip:                   ins.Address.String(),
+		Port:                 port,
+		State:                InstanceStateToTransport(ins.State),
+		OrderedBy:            ins.OrderedBy,
+		Traffic:              InstanceTrafficToTransport(ins.Traffic),
+		Params:               InstanceParamsToTransport(ins.Params),
+		PlacementGroup:       ins.PlacementGroup,
+		CrossInstanceTraffic: ins.CrossInstanceTraffic,
 	}
 }
 
+func InstanceStateToDomain(state instancev1alpha1.InstanceState) resource.InstanceState {
+	return resource.InstanceState(state.String())
+}
+
+func InstanceStateToTransport(state resource.InstanceState) instancev1alpha1.InstanceState {
+	return instancev1alpha1.InstanceState(instancev1alpha1.InstanceState_value[string(state)])
+}
+
 func StatusReportToDomain(report *instancev1alpha1.InstanceStatusReport) resource.InstanceStatusReport {
+	var events []resource.InstanceEvent
+	for _, ev := range report.GetEvents() {
+		events = append(events, InstanceEventToDomain(ev))
+	}
+
 	return resource.InstanceStatusReport{
 		InstanceID: report.GetInstanceId(),
-		State:      resource.InstanceState(report.GetState().String()), // TODO: state to domain function
+		State:      InstanceStateToDomain(report.GetState()),
 		Port:       uint16(report.GetPort()),
+		Events:     events,
+		Traffic:    InstanceTrafficToDomain(report.GetTraffic()),
 	}
 }
 
 func StatusReportToTransport(report resource.InstanceStatusReport) *instancev1alpha1.InstanceStatusReport {
+	var events []*instancev1alpha1.InstanceEvent
+	for _, ev := range report.Events {
+		events = append(events, InstanceEventToTransport(ev))
+	}
+
 	return &instancev1alpha1.InstanceStatusReport{
 		InstanceId: report.InstanceID,
 		Port:       uint32(report.Port),
-		State:      instancev1alpha1.InstanceState(instancev1alpha1.InstanceState_value[string(report.State)]),
+		State:      InstanceStateToTransport(report.State),
+		Events:     events,
+		Traffic:    InstanceTrafficToTransport(report.Traffic),
+	}
+}
+
+func InstanceTrafficToDomain(t *instancev1alpha1.InstanceTraffic) *resource.InstanceTraffic {
+	if t == nil {
+		return nil
+	}
+	return &resource.InstanceTraffic{
+		Connections: t.GetConnections(),
+		RxBytes:     t.GetRxBytes(),
+		TxBytes:     t.GetTxBytes(),
+	}
+}
+
+func InstanceTrafficToTransport(t *resource.InstanceTraffic) *instancev1alpha1.InstanceTraffic {
+	if t == nil {
+		return nil
+	}
+	return &instancev1alpha1.InstanceTraffic{
+		Connections: t.Connections,
+		RxBytes:     t.RxBytes,
+		TxBytes:     t.TxBytes,
+	}
+}
+
+func InstanceParamsToDomain(p *instancev1alpha1.InstanceParams) resource.InstanceParams {
+	var mode resource.GameMode
+	if p.GetGameMode() != instancev1alpha1.GameMode_GAME_MODE_DEFAULT {
+		mode = resource.GameMode(p.GetGameMode().String())
+	}
+
+	var custom []byte
+	if p.GetCustom() != "" {
+		custom = []byte(p.GetCustom())
+	}
+
+	return resource.InstanceParams{
+		WorldSeed: p.GetWorldSeed(),
+		GameMode:  mode,
+		Custom:    custom,
+	}
+}
+
+func InstanceParamsToTransport(p resource.InstanceParams) *instancev1alpha1.InstanceParams {
+	return &instancev1alpha1.InstanceParams{
+		WorldSeed: p.WorldSeed,
+		GameMode:  instancev1alpha1.GameMode(instancev1alpha1.GameMode_value[string(p.GameMode)]),
+		Custom:    string(p.Custom),
+	}
+}
+
+func InstanceEventToDomain(ev *instancev1alpha1.InstanceEvent) resource.InstanceEvent {
+	return resource.InstanceEvent{
+		ID:         ev.GetId(),
+		InstanceID: ev.GetInstanceId(),
+		Type:       resource.InstanceEventType(ev.GetType().String()),
+		ExitCode:   ev.GetExitCode(),
+		Message:    ev.GetMessage(),
+		CreatedAt:  ev.GetCreatedAt().AsTime(),
+	}
+}
+
+func InstanceEventToTransport(ev resource.InstanceEvent) *instancev1alpha1.InstanceEvent {
+	return &instancev1alpha1.InstanceEvent{
+		Id:         ev.ID,
+		InstanceId: ev.InstanceID,
+		Type:       instancev1alpha1.InstanceEventType(instancev1alpha1.InstanceEventType_value[string(ev.Type)]),
+		ExitCode:   ev.ExitCode,
+		Message:    ev.Message,
+		CreatedAt:  timestamppb.New(ev.CreatedAt),
+	}
+}
+
+func InstanceSnapshotToTransport(snap resource.InstanceSnapshot) *instancev1alpha1.InstanceSnapshot {
+	return &instancev1alpha1.InstanceSnapshot{
+		Id:                   snap.ID,
+		InstanceId:           snap.InstanceID,
+		SizeBytes:            snap.SizeBytes,
+		DownloadUrl:          snap.DownloadURL,
+		DownloadUrlExpiresAt: timestamppb.New(snap.DownloadURLExpiresAt),
+		CreatedAt:            timestamppb.New(snap.CreatedAt),
+	}
+}
+
+func InstanceLogToTransport(l resource.InstanceLog) *instancev1alpha1.InstanceLogFile {
+	return &instancev1alpha1.InstanceLogFile{
+		Id:                   l.ID,
+		InstanceId:           l.InstanceID,
+		SizeBytes:            l.SizeBytes,
+		FirstLineAt:          timestamppb.New(l.FirstLineAt),
+		LastLineAt:           timestamppb.New(l.LastLineAt),
+		DownloadUrl:          l.DownloadURL,
+		DownloadUrlExpiresAt: timestamppb.New(l.DownloadURLExpiresAt),
+	}
+}
+
+func InstanceInviteToTransport(i resource.InstanceInvite) *instancev1alpha1.InstanceInvite {
+	ret := &instancev1alpha1.InstanceInvite{
+		Id:         i.ID,
+		InstanceId: i.InstanceID,
+		Token:      i.Token,
+		MaxUses:    i.MaxUses,
+		Uses:       i.Uses,
+		ExpiresAt:  timestamppb.New(i.ExpiresAt),
+		CreatedAt:  timestamppb.New(i.CreatedAt),
+	}
+
+	if i.RevokedAt != nil {
+		ret.RevokedAt = timestamppb.New(*i.RevokedAt)
+	}
+
+	return ret
+}
+
+func InstanceLogBatchToDomain(b *instancev1alpha1.InstanceLogBatch) resource.InstanceLogBatch {
+	lines := make([]resource.InstanceLogLine, 0, len(b.GetLines()))
+	for _, l := range b.GetLines() {
+		lines = append(lines, resource.InstanceLogLine{
+			Time:    l.GetTime().AsTime(),
+			Stream:  l.GetStream(),
+			Message: l.GetMessage(),
+		})
+	}
+
+	return resource.InstanceLogBatch{
+		InstanceID: b.GetInstanceId(),
+		Lines:      lines,
+	}
+}
+
+func InstanceLogBatchToTransport(b resource.InstanceLogBatch) *instancev1alpha1.InstanceLogBatch {
+	lines := make([]*instancev1alpha1.InstanceLogLine, 0, len(b.Lines))
+	for _, l := range b.Lines {
+		lines = append(lines, &instancev1alpha1.InstanceLogLine{
+			Time:    timestamppb.New(l.Time),
+			Stream:  l.Stream,
+			Message: l.Message,
+		})
+	}
+
+	return &instancev1alpha1.InstanceLogBatch{
+		InstanceId: b.InstanceID,
+		Lines:      lines,
 	}
 }
 
 // TODO: tests
+
+func InstanceRedirectToTransport(r resource.InstanceRedirect) *instancev1alpha1.InstanceRedirect {
+	return &instancev1alpha1.InstanceRedirect{
+		InstanceId: r.InstanceID,
+		FromPort:   uint32(r.FromPort),
+		ToAddress:  r.To.Addr().String(),
+		ToPort:     uint32(r.To.Port()),
+		ExpiresAt:  timestamppb.New(r.ExpiresAt),
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package codec
+
+import (
+	adminv1alpha1 "github.com/spacechunks/explorer/api/admin/v1alpha1"
+	announcementv1alpha1 "github.com/spacechunks/explorer/api/announcement/v1alpha1"
+	integrationv1alpha1 "github.com/spacechunks/explorer/api/integration/v1alpha1"
+	"github.com/spacechunks/explorer/internal/resource"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AnnouncementToTransport(a resource.Announcement) *announcementv1alpha1.Announcement {
+	ret := &announcementv1alpha1.Announcement{
+		Id:        a.ID,
+		Message:   a.Message,
+		Severity:  announcementv1alpha1.Severity(announcementv1alpha1.Severity_value[string(a.Severity)]),
+		StartsAt:  timestamppb.New(a.StartsAt),
+		CreatedBy: a.CreatedBy,
+		CreatedAt: timestamppb.New(a.CreatedAt),
+	}
+
+	if a.EndsAt != nil {
+		ret.EndsAt = timestamppb.New(*a.EndsAt)
+	}
+
+	return ret
+}
+
+func MaintenanceModeToTransport(m resource.MaintenanceMode) *adminv1alpha1.MaintenanceMode {
+	ret := &adminv1alpha1.MaintenanceMode{
+		Enabled:   m.Enabled,
+		Message:   m.Message,
+		UpdatedBy: m.UpdatedBy,
+	}
+
+	if !m.UpdatedAt.IsZero() {
+		ret.UpdatedAt = timestamppb.New(m.UpdatedAt)
+	}
+
+	return ret
+}
+
+func BackendToTransport(b resource.Backend) *integrationv1alpha1.Backend {
+	return &integrationv1alpha1.Backend{
+		// instance ids never change, so the name is stable
+		// for as long as the instance exists.
+		Name:       "explorer-" + b.InstanceID,
+		InstanceId: b.InstanceID,
+		ChunkId:    b.ChunkID,
+		ChunkName:  b.ChunkName,
+		FlavorName: b.FlavorName,
+		Address:    b.Address.Addr().String(),
+		Port:       uint32(b.Address.Port()),
+		Players:    b.Players,
+		MaxPlayers: b.MaxPlayers,
+	}
+}
@@ -0,0 +1,62 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package codec
+
+import (
+	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
+	"github.com/spacechunks/explorer/internal/resource"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func UserToTransport(domain resource.User) *userv1alpha1.User {
+	return &userv1alpha1.User{
+		Id:        domain.ID,
+		Nickname:  domain.Nickname,
+		CreatedAt: timestamppb.New(domain.CreatedAt),
+		UpdatedAt: timestamppb.New(domain.UpdatedAt),
+	}
+}
+
+func NotificationPreferencesToTransport(prefs resource.NotificationPreferences) *userv1alpha1.NotificationPreferences {
+	return &userv1alpha1.NotificationPreferences{
+		BuildCompleted:  prefs.BuildCompleted,
+		BuildFailed:     prefs.BuildFailed,
+		InstanceCrashed: prefs.InstanceCrashed,
+	}
+}
+
+func ImpersonationToTransport(imp resource.Impersonation) *userv1alpha1.Impersonation {
+	actions := make([]*userv1alpha1.ImpersonatedAction, 0, len(imp.Actions))
+	for _, a := range imp.Actions {
+		actions = append(actions, &userv1alpha1.ImpersonatedAction{
+			Method:    a.Method,
+			CreatedAt: timestamppb.New(a.CreatedAt),
+		})
+	}
+
+	return &userv1alpha1.Impersonation{
+		Id:        imp.ID,
+		UserId:    imp.UserID,
+		AdminId:   imp.AdminID,
+		Reason:    imp.Reason,
+		CreatedAt: timestamppb.New(imp.CreatedAt),
+		ExpiresAt: timestamppb.New(imp.ExpiresAt),
+		Actions:   actions,
+	}
+}
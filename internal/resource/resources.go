@@ -30,6 +30,7 @@ const (
 	MaxChunkNameChars           = 50
 	MaxChunkDescriptionChars    = 100
 	MaxChunkThumbnailDimensions = 512
+	MaxChunkReadmeBytes         = 64 * 1024
 )
 
 /*
@@ -53,6 +54,50 @@ type Thumbnail struct {
 	Hash string
 }
 
+// ChunkNameStatus tells whether a name can be used for a new chunk.
+type ChunkNameStatus string
+
+const (
+	// ChunkNameStatusAvailable means no chunk uses the name.
+	ChunkNameStatusAvailable ChunkNameStatus = "AVAILABLE"
+
+	// ChunkNameStatusOwned means the name is used by one of the chunks of the
+	// user, either as its current or one of its previous names.
+	ChunkNameStatusOwned ChunkNameStatus = "OWNED"
+
+	// ChunkNameStatusTaken means the name is used by a chunk of another user
+	// or by a chunk that has been deleted.
+	ChunkNameStatusTaken ChunkNameStatus = "TAKEN"
+)
+
+// ChunkReadme is the long-form markdown description shown on the page of a chunk.
+type ChunkReadme struct {
+	ChunkID   string
+	Markdown  string
+	UpdatedAt time.Time
+}
+
+// ChunkMediaContentTypes are the content types of images
+// that can be shown on the page of a chunk.
+var ChunkMediaContentTypes = []string{"image/png", "image/jpeg", "image/webp"}
+
+// ChunkMedia is an image shown on the page of a chunk, like a screenshot.
+// the image is stored in the object storage bucket and identified by the
+// hex encoded SHA256 digest of its content.
+type ChunkMedia struct {
+	ID          string
+	ChunkID     string
+	Hash        string
+	ContentType string
+	SizeBytes   uint64
+	CreatedAt   time.Time
+
+	// DownloadURL is a presigned url the image can be downloaded from.
+	// it is only set if the media is retrieved for displaying it.
+	DownloadURL          string
+	DownloadURLExpiresAt time.Time
+}
+
 /*
  * flavor-related types
  */
@@ -96,6 +141,91 @@ type FlavorVersion struct {
 	PresignedURL           *string                  `json:"presignedURL"`
 	MinPlayers             uint32                   `json:"minPlayers"`
 	MaxPlayers             uint32                   `json:"maxPlayers"`
+	CheckpointResources    *CheckpointResources     `json:"checkpointResources"`
+	BuildError             string                   `json:"buildError"`
+	BuildProgress          uint32                   `json:"buildProgress"`
+	AssetHashes            []string                 `json:"assetHashes"`
+
+	// StartupGracePeriod is the time the server has to start, before failing
+	// health probes cause the workload to be considered unhealthy. zero means
+	// the default of the node is used.
+	StartupGracePeriod time.Duration `json:"startupGracePeriod"`
+
+	// RestartPolicy decides whether the server is restarted once it exits or
+	// is considered unhealthy. RestartMaxRetries only applies to
+	// RestartPolicyOnFailure, zero means unlimited retries.
+	RestartPolicy     RestartPolicy `json:"restartPolicy"`
+	RestartMaxRetries uint32        `json:"restartMaxRetries"`
+
+	// RestoreLatency is the time it took until the restored checkpoint
+	// responded when it was verified during the build. zero if the
+	// checkpoint has not been verified.
+	RestoreLatency time.Duration `json:"restoreLatency"`
+
+	// JavaVersion selects the base image the flavor version is built on.
+	// zero means the default base image of the minecraft version is used.
+	JavaVersion uint32 `json:"javaVersion"`
+
+	// StartupCommand is the template of the command the server is started
+	// with. empty means the command of the base image is used.
+	StartupCommand string `json:"startupCommand"`
+}
+
+type RestartPolicy string
+
+const (
+	// RestartPolicyNever removes the instance once its server stops running.
+	RestartPolicyNever RestartPolicy = "NEVER"
+
+	// RestartPolicyAlways restarts the server regardless of why it stopped.
+	RestartPolicyAlways RestartPolicy = "ALWAYS"
+
+	// RestartPolicyOnFailure only restarts the server if it crashed, has been
+	// killed or is considered unhealthy, but not if it exited cleanly.
+	RestartPolicyOnFailure RestartPolicy = "ON_FAILURE"
+)
+
+// Asset is a bundle of files, like a resource pack, that is shared between
+// flavor versions. assets are identified by the hex encoded sha256 hash of
+// their tarball.
+type Asset struct {
+	Hash      string    `json:"hash"`
+	SizeBytes uint64    `json:"sizeBytes"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CheckpointResources overrides the resources assigned to the server while
+// its checkpoint is being created. zero values fall back to the node defaults.
+type CheckpointResources struct {
+	CPUMillis        uint32 `json:"cpuMillis"`
+	MemoryLimitBytes uint64 `json:"memoryLimitBytes"`
+}
+
+// BuildProvenance records what a flavor version has been built from, so it
+// can be traced back long after the flavor version has been published.
+type BuildProvenance struct {
+	BaseImageDigest string `json:"baseImageDigest"`
+	ImageDigest     string `json:"imageDigest"`
+
+	// BuilderVersion is the vcs revision of the control plane that built the image.
+	BuilderVersion string `json:"builderVersion"`
+
+	// InputHash identifies the files and assets the image has been built from.
+	InputHash    string       `json:"inputHash"`
+	Plugins      []PluginHash `json:"plugins"`
+	ImageBuiltAt time.Time    `json:"imageBuiltAt"`
+
+	// the following fields are only set once the checkpoint has been built.
+	CheckpointNodeID  string     `json:"checkpointNodeId"`
+	PlatformdVersion  string     `json:"platformdVersion"`
+	CheckpointBuiltAt *time.Time `json:"checkpointBuiltAt"`
+}
+
+// PluginHash is a jar file contained in the image of a flavor version.
+type PluginHash struct {
+	// Path is the absolute path of the jar file inside the image.
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
 }
 
 /*
@@ -110,6 +240,49 @@ type User struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// UserDataExport is a gzip compressed tarball containing
+// all data stored about a user at the time of the export.
+type UserDataExport struct {
+	ID                   string
+	UserID               string
+	DownloadURL          string
+	DownloadURLExpiresAt time.Time
+}
+
+// Impersonation is a time-boxed period in which an admin acts as
+// a user using an api token that has been issued for the user.
+type Impersonation struct {
+	ID        string
+	UserID    string
+	AdminID   string
+	Reason    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+
+	// Actions are the calls made with the api token, oldest first.
+	Actions []ImpersonatedAction
+}
+
+type ImpersonatedAction struct {
+	Method    string
+	CreatedAt time.Time
+}
+
+// ChunkPolicy defines what happens to the chunks of a user that is deleted.
+type ChunkPolicy string
+
+const (
+	ChunkPolicyDelete   ChunkPolicy = "DELETE"
+	ChunkPolicyTransfer ChunkPolicy = "TRANSFER"
+)
+
+// NotificationPreferences define which notifications a user opted into.
+type NotificationPreferences struct {
+	BuildCompleted  bool
+	BuildFailed     bool
+	InstanceCrashed bool
+}
+
 /*
  * instance-related types
  */
@@ -126,12 +299,377 @@ type Instance struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	OrderedBy     string
+	Traffic       *InstanceTraffic
+	Params        InstanceParams
+
+	// PlacementGroup is an opaque identifier chosen by the caller.
+	// instances of the same group are placed on the same node when
+	// it has free slots, so parties of players stay together.
+	PlacementGroup string
+
+	// CrossInstanceTraffic reports whether the instance is allowed to
+	// connect to other instances on its node. it is decided when the
+	// instance is created, see [CrossInstanceTraffic].
+	CrossInstanceTraffic bool
+}
+
+// InstanceRedirect forwards connections to the previous endpoint of an
+// instance, which is identified by the node and port, to its current
+// endpoint. this way players can still reach an instance whose endpoint
+// changed, until the redirect expires.
+type InstanceRedirect struct {
+	InstanceID string
+	NodeID     string
+	FromPort   uint16
+	To         netip.AddrPort
+	ExpiresAt  time.Time
+}
+
+// Canary splits the instance launches of a flavor between its stable version
+// and a new canary version. while active, launches of either version run the
+// canary version with the given percentage and the stable version otherwise.
+// once promoted, launches of the stable version run the canary version. once
+// aborted, launches of the canary version run the stable version.
+type Canary struct {
+	ID              string
+	FlavorID        string
+	StableVersionID string
+	CanaryVersionID string
+	Percentage      uint32
+	State           CanaryState
+	StableStats     CanaryStats
+	CanaryStats     CanaryStats
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type CanaryState string
+
+const (
+	CanaryStateActive   CanaryState = "ACTIVE"
+	CanaryStatePromoted CanaryState = "PROMOTED"
+	CanaryStateAborted  CanaryState = "ABORTED"
+)
+
+// CanaryStats compares the instances launched for one of the versions of a
+// canary. Connections only contains the connections of instances that have
+// not been removed yet.
+type CanaryStats struct {
+	Launches         uint64
+	CrashedInstances uint64
+	Connections      uint64
+}
+
+// FlavorChannel points to the flavor version that is launched when running
+// the channel of a flavor. this allows publishing a version to the beta
+// channel for test instances, while the stable channel keeps serving players.
+type FlavorChannel struct {
+	FlavorID        string
+	Name            string
+	FlavorVersionID string
+	UpdatedAt       time.Time
+}
+
+const (
+	FlavorChannelStable = "stable"
+	FlavorChannelBeta   = "beta"
+)
+
+// ChunkImport creates the first version of a flavor from an uploaded archive
+// of an existing server. the chunk and flavor are created right away, while
+// FlavorVersionID is only set once the archive has been unpacked. Error is
+// only set if the import failed.
+type ChunkImport struct {
+	ID               string
+	ChunkID          string
+	FlavorID         string
+	FlavorVersionID  string
+	Version          string
+	MinecraftVersion string
+	MinPlayers       uint32
+	MaxPlayers       uint32
+	ArchiveSizeBytes uint64
+	State            ChunkImportState
+	Error            string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type ChunkImportState string
+
+const (
+	ChunkImportStatePendingUpload ChunkImportState = "PENDING_UPLOAD"
+	ChunkImportStateImporting     ChunkImportState = "IMPORTING"
+	ChunkImportStateCompleted     ChunkImportState = "COMPLETED"
+	ChunkImportStateFailed        ChunkImportState = "FAILED"
+)
+
+// ChunkTemplate is a curated starter chunk. creating a chunk from a template
+// copies the files of the flavor version into the first version of the new
+// chunk by referencing them, so nothing has to be uploaded.
+type ChunkTemplate struct {
+	ID              string
+	Name            string
+	Description     string
+	FlavorVersionID string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// RetentionPolicy decides which flavor versions of a chunk are removed. a
+// flavor version is kept if it is one of the KeepLastVersions most recently
+// created versions of its flavor or if it has been created less than KeepDays
+// ago. rules set to zero are disabled and if all rules are disabled, all
+// flavor versions are kept. versions referenced by instances, an active
+// canary or a channel, as well as versions that are still being built, are
+// always kept.
+type RetentionPolicy struct {
+	ChunkID          string
+	KeepLastVersions uint32
+	KeepDays         uint32
+	UpdatedAt        time.Time
+}
+
+// AutoscalingPolicy keeps between MinInstances and MaxInstances instances of
+// the latest built version of a flavor running. TargetUtilization is the
+// percentage of player slots that should be in use. the cooldowns limit how
+// often instances are started or stopped.
+type AutoscalingPolicy struct {
+	FlavorID          string
+	MinInstances      uint32
+	MaxInstances      uint32
+	TargetUtilization uint32
+	ScaleUpCooldown   time.Duration
+	ScaleDownCooldown time.Duration
+	LastScaleUpAt     *time.Time
+	LastScaleDownAt   *time.Time
+	UpdatedAt         time.Time
+}
+
+// InstanceSchedule starts an instance of a flavor version at the times
+// matching StartCron and stops it at the times matching StopCron. both are
+// evaluated in Timezone. at most one instance is running per schedule.
+type InstanceSchedule struct {
+	ID              string
+	ChunkID         string
+	FlavorVersionID string
+	StartCron       string
+	// StopCron is empty if the instance is not stopped by the schedule.
+	StopCron string
+	Timezone string
+	// InstanceID is the instance that is running because of the schedule,
+	// empty if there is none.
+	InstanceID string
+	// EvaluatedAt is the point in time up to which runs have been handled.
+	EvaluatedAt time.Time
+	CreatedBy   string
+	CreatedAt   time.Time
+}
+
+type ScheduleAction string
+
+const (
+	ScheduleActionStart ScheduleAction = "START"
+	ScheduleActionStop  ScheduleAction = "STOP"
+)
+
+// ScheduleRun is a point in time an instance schedule starts or stops its instance.
+type ScheduleRun struct {
+	Time   time.Time
+	Action ScheduleAction
+}
+
+// ChunkSpec is the desired state of a chunk, that is applied declaratively.
+// versions are not part of the spec, they have to be published beforehand.
+type ChunkSpec struct {
+	Name        string
+	Description string
+	Tags        []string
+	Flavors     []FlavorSpec
+	Schedules   []ScheduleSpec
+}
+
+type FlavorSpec struct {
+	Name string
+	// Channels maps the name of a channel to the version published to it.
+	// channels that are not part of the spec are left as they are.
+	Channels map[string]string
+	// Autoscaling is nil if the flavor should not be autoscaled.
+	Autoscaling *AutoscalingSpec
+}
+
+type AutoscalingSpec struct {
+	MinInstances      uint32
+	MaxInstances      uint32
+	TargetUtilization uint32
+}
+
+// ScheduleSpec refers to the flavor version by the names of the flavor
+// and version, because their ids are not known when writing the spec.
+type ScheduleSpec struct {
+	Flavor    string
+	Version   string
+	StartCron string
+	StopCron  string
+	Timezone  string
+}
+
+type ChunkSpecChangeAction string
+
+const (
+	ChunkSpecChangeCreate ChunkSpecChangeAction = "CREATE"
+	ChunkSpecChangeUpdate ChunkSpecChangeAction = "UPDATE"
+	ChunkSpecChangeDelete ChunkSpecChangeAction = "DELETE"
+)
+
+// ChunkSpecChange is a single change made when applying a chunk spec. Path
+// identifies the changed part of the chunk, e.g. flavors/lobby/channels/stable.
+// OldValue is empty for created and NewValue for deleted parts.
+type ChunkSpecChange struct {
+	Action   ChunkSpecChangeAction
+	Path     string
+	OldValue string
+	NewValue string
+}
+
+type CrossInstanceTrafficState string
+
+const (
+	CrossInstanceTrafficStateRequested CrossInstanceTrafficState = "REQUESTED"
+	CrossInstanceTrafficStateApproved  CrossInstanceTrafficState = "APPROVED"
+	CrossInstanceTrafficStateRejected  CrossInstanceTrafficState = "REJECTED"
+)
+
+// CrossInstanceTraffic is the request of a chunk to let its instances open
+// connections to other instances running on the same node. instances are
+// isolated from each other unless the request has been approved by an admin.
+type CrossInstanceTraffic struct {
+	ChunkID       string
+	State         CrossInstanceTrafficState
+	Reason        string
+	RequestedBy   string
+	ReviewedBy    string
+	ReviewMessage string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
 
 type InstanceStatusReport struct {
 	InstanceID string
 	State      InstanceState
 	Port       uint16
+	Events     []InstanceEvent
+	Traffic    *InstanceTraffic
+}
+
+// InstanceTraffic contains the cumulative traffic counters envoy
+// has recorded for an instance. they are reset if envoy restarts.
+type InstanceTraffic struct {
+	Connections uint64
+	RxBytes     uint64
+	TxBytes     uint64
+}
+
+// InstanceParams are runtime parameters of a single instance that are
+// passed to its server. zero values fall back to the flavor configuration.
+type InstanceParams struct {
+	WorldSeed string
+	GameMode  GameMode
+
+	// Custom is a json document passed to the server as is.
+	Custom []byte
+}
+
+type GameMode string
+
+const (
+	GameModeDefault   GameMode = ""
+	GameModeSurvival  GameMode = "SURVIVAL"
+	GameModeCreative  GameMode = "CREATIVE"
+	GameModeAdventure GameMode = "ADVENTURE"
+	GameModeSpectator GameMode = "SPECTATOR"
+)
+
+type InstanceEventType string
+
+const (
+	InstanceEventTypeOOMKilled InstanceEventType = "OOM_KILLED"
+	InstanceEventTypeCrashed   InstanceEventType = "CRASHED"
+	InstanceEventTypeExited    InstanceEventType = "EXITED"
+
+	// lifecycle events, which make up the timeline of an instance.
+
+	InstanceEventTypeScheduled        InstanceEventType = "SCHEDULED"
+	InstanceEventTypeImagePulled      InstanceEventType = "IMAGE_PULLED"
+	InstanceEventTypeContainerStarted InstanceEventType = "CONTAINER_STARTED"
+	InstanceEventTypeReady            InstanceEventType = "READY"
+	InstanceEventTypePlayerJoined     InstanceEventType = "PLAYER_JOINED"
+	InstanceEventTypeRemoved          InstanceEventType = "REMOVED"
+	InstanceEventTypeNodeLost         InstanceEventType = "NODE_LOST"
+)
+
+type InstanceEvent struct {
+	ID         string
+	InstanceID string
+	Type       InstanceEventType
+	ExitCode   int32
+	Message    string
+	CreatedAt  time.Time
+}
+
+type InstanceSnapshot struct {
+	ID                   string
+	InstanceID           string
+	SizeBytes            uint64
+	DownloadURL          string
+	DownloadURLExpiresAt time.Time
+	CreatedAt            time.Time
+}
+
+// InstanceLog is a file containing the output of an instance, that has been
+// shipped by its node. the download url is only set when listing the logs.
+type InstanceLog struct {
+	ID                   string
+	InstanceID           string
+	OwnerID              string
+	ObjectKey            string
+	SizeBytes            uint64
+	FirstLineAt          time.Time
+	LastLineAt           time.Time
+	DownloadURL          string
+	DownloadURLExpiresAt time.Time
+	CreatedAt            time.Time
+	ExpiresAt            time.Time
+}
+
+// InstanceInvite allows players to join an instance until it expires,
+// has been used MaxUses times or has been revoked. the token is only
+// set when creating the invite, afterward only its hash is known.
+type InstanceInvite struct {
+	ID         string
+	InstanceID string
+	Token      string
+	// MaxUses is the number of times the invite can be used.
+	// zero means unlimited.
+	MaxUses   uint32
+	Uses      uint32
+	CreatedBy string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// InstanceLogBatch contains the lines an instance logged
+// since the last batch has been shipped by its node.
+type InstanceLogBatch struct {
+	InstanceID string
+	Lines      []InstanceLogLine
+}
+
+type InstanceLogLine struct {
+	Time    time.Time
+	Stream  string
+	Message string
 }
 
 type InstanceState string
@@ -143,6 +681,11 @@ const (
 	InstanceStateDeleting  InstanceState = "DELETING"
 	InstanceStateDeleted   InstanceState = "DELETED"
 	InstanceCreationFailed InstanceState = "CREATION_FAILED"
+
+	// InstanceStateLost is set if the node of the instance stopped
+	// sending heartbeats. lost instances are removed once the node
+	// returns.
+	InstanceStateLost InstanceState = "LOST"
 )
 
 /*
@@ -153,4 +696,186 @@ type MinecraftVersion struct {
 	Version   string    `json:"version"`
 	ImageURL  string    `json:"imageURL"`
 	CreatedAt time.Time `json:"createdAt"`
+
+	// JavaImageURLs are the base images shipping a specific java
+	// runtime, keyed by the major version of the runtime.
+	JavaImageURLs map[uint32]string `json:"javaImageURLs,omitempty"`
+}
+
+// BaseImage returns the base image shipping the given java version.
+// a java version of zero returns the default image.
+func (v MinecraftVersion) BaseImage(javaVersion uint32) (string, bool) {
+	if javaVersion == 0 {
+		return v.ImageURL, true
+	}
+
+	url, ok := v.JavaImageURLs[javaVersion]
+	return url, ok
+}
+
+/*
+ * maintenance
+ */
+
+// MaintenanceMode rejects requests that would create new work for the
+// platform, like running instances or building flavor versions, while
+// it is enabled. UpdatedBy is empty if it has never been changed.
+type MaintenanceMode struct {
+	Enabled   bool
+	Message   string
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+/*
+ * runtime settings
+ */
+
+// FeatureFlag toggles a feature of the control plane or platformd without
+// restarting them. features are enabled, unless an admin disabled them.
+type FeatureFlag string
+
+const (
+	// FeatureFlagPlacementWebhook lets the scheduler ask the placement
+	// webhook where to place instances, if one has been configured.
+	FeatureFlagPlacementWebhook FeatureFlag = "placement-webhook"
+
+	// FeatureFlagDeltaDiscovery lets nodes only discover the instances that
+	// changed since their last discovery, instead of all of their instances.
+	FeatureFlagDeltaDiscovery FeatureFlag = "delta-discovery"
+)
+
+// FeatureFlags contains all known feature flags.
+var FeatureFlags = []FeatureFlag{
+	FeatureFlagPlacementWebhook,
+	FeatureFlagDeltaDiscovery,
+}
+
+// LogTarget is a program whose log levels can be changed at runtime.
+type LogTarget string
+
+const (
+	LogTargetControlPlane LogTarget = "controlplane"
+	LogTargetPlatformd    LogTarget = "platformd"
+)
+
+// RuntimeSettings are changed by admins while the platform is running.
+// LogLevels are in the format of the log-level flag, keyed by target.
+// targets without levels keep the levels they have been started with.
+// FeatureFlags only contains the flags that have been changed.
+type RuntimeSettings struct {
+	LogLevels    map[LogTarget]string
+	FeatureFlags map[FeatureFlag]bool
+}
+
+// Enabled reports whether the given feature is enabled.
+func (s RuntimeSettings) Enabled(flag FeatureFlag) bool {
+	enabled, ok := s.FeatureFlags[flag]
+	return !ok || enabled
+}
+
+// RuntimeSettingChange records an admin changing a runtime setting. Setting
+// is the name of the changed setting, like log-levels/platformd or
+// feature-flags/delta-discovery. OldValue is empty if it has not been set.
+type RuntimeSettingChange struct {
+	ID        string
+	Setting   string
+	OldValue  string
+	NewValue  string
+	ActorID   string
+	CreatedAt time.Time
+}
+
+// Announcement is a message published by the platform admins, that is
+// shown to users between StartsAt and EndsAt. if EndsAt is nil, it is
+// shown until it is deleted.
+type Announcement struct {
+	ID        string
+	Message   string
+	Severity  AnnouncementSeverity
+	StartsAt  time.Time
+	EndsAt    *time.Time
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+type AnnouncementSeverity string
+
+const (
+	AnnouncementSeverityInfo     AnnouncementSeverity = "INFO"
+	AnnouncementSeverityWarning  AnnouncementSeverity = "WARNING"
+	AnnouncementSeverityCritical AnnouncementSeverity = "CRITICAL"
+)
+
+// Backend is a running instance proxy plugins can send players to.
+// Players is the number of players reported to be connected to it.
+type Backend struct {
+	InstanceID string
+	ChunkID    string
+	ChunkName  string
+	FlavorName string
+	Address    netip.AddrPort
+	Players    uint32
+	MaxPlayers uint32
+}
+
+type PlayerEventType string
+
+const (
+	PlayerEventTypeJoin  PlayerEventType = "JOIN"
+	PlayerEventTypeLeave PlayerEventType = "LEAVE"
+)
+
+type PlayerEvent struct {
+	InstanceID string
+	PlayerID   string
+	Type       PlayerEventType
+}
+
+// InstanceUsage is the time an instance has been running
+// together with the resources it has been assigned.
+type InstanceUsage struct {
+	InstanceID  string
+	ChunkID     string
+	ChunkName   string
+	NodeID      string
+	CPUMillis   uint64
+	MemoryBytes uint64
+
+	// NodeSlots is the number of instances the node was able
+	// to run when the instance has been created.
+	NodeSlots int
+	StartedAt time.Time
+
+	// StoppedAt is nil while the instance is running.
+	StoppedAt *time.Time
+}
+
+// Cost is attributed to instances based on the time they have been
+// running and the resources assigned to them. Node is the share of
+// the flat price of the node the instances ran on.
+type Cost struct {
+	CPU    float64
+	Memory float64
+	Node   float64
+}
+
+func (c Cost) Total() float64 {
+	return c.CPU + c.Memory + c.Node
+}
+
+type InstanceCost struct {
+	Usage InstanceUsage
+
+	// Hours is the time the instance has been running in the window.
+	Hours float64
+	Cost  Cost
+}
+
+type ChunkCost struct {
+	ChunkID       string
+	ChunkName     string
+	Instances     int
+	InstanceHours float64
+	Cost          Cost
 }
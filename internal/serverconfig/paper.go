@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/goccy/go-yaml"
-	"github.com/spacechunks/explorer/controlplane/blob"
 )
 
 func defaultPaperGlobalStr() string {
@@ -34,7 +33,7 @@ type proxiesConfig struct {
 
 func sanatizePaperGlobal(data []byte) ([]byte, error) {
 	var global paperGlobal
-	if err := yaml.Unmarshal(data, &blob.Object{}); err != nil {
+	if err := yaml.Unmarshal(data, &global); err != nil {
 		return nil, fmt.Errorf("unmarshal: %w", err)
 	}
 
@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
-	"github.com/spacechunks/explorer/controlplane/serverconfig"
+	"github.com/spacechunks/explorer/internal/serverconfig"
 	"github.com/stretchr/testify/require"
 )
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/goccy/go-yaml"
-	"github.com/spacechunks/explorer/controlplane/blob"
 )
 
 func defaultSpigotStr() string {
@@ -22,7 +21,7 @@ type spigotCfg struct {
 
 func sanatizeSpigot(data []byte) ([]byte, error) {
 	var spigot spigotCfg
-	if err := yaml.Unmarshal(data, &blob.Object{}); err != nil {
+	if err := yaml.Unmarshal(data, &spigot); err != nil {
 		return nil, fmt.Errorf("unmarshal: %w", err)
 	}
 
@@ -21,8 +21,10 @@ package tarhelper
 import (
 	"archive/tar"
 	"compress/gzip"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -77,7 +79,97 @@ func TarFiles(rootDir string, files []*os.File, dest string) error {
 	return nil
 }
 
+// TarFS writes a gzip compressed archive of all regular files of fsys to w.
+// other file types like symlinks are skipped. files may be modified while
+// the archive is being written, so each file is cut off or padded with zeros
+// to the size it had when it was first encountered.
+func TarFS(w io.Writer, fsys fs.FS) error {
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+
+	if err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", path, err)
+		}
+
+		f, err := fsys.Open(path)
+		if err != nil {
+			return fmt.Errorf("open %s: %w", path, err)
+		}
+
+		defer f.Close()
+
+		if err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     path,
+			Size:     info.Size(),
+			Mode:     int64(info.Mode().Perm()),
+			ModTime:  info.ModTime(),
+		}); err != nil {
+			return fmt.Errorf("tar header: %w", err)
+		}
+
+		n, err := io.Copy(tw, io.LimitReader(f, info.Size()))
+		if err != nil {
+			return fmt.Errorf("copy %s: %w", path, err)
+		}
+
+		if _, err := io.CopyN(tw, zeroReader{}, info.Size()-n); err != nil {
+			return fmt.Errorf("pad %s: %w", path, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("close tar: %w", err)
+	}
+
+	if err := gzw.Close(); err != nil {
+		return fmt.Errorf("close gzip: %w", err)
+	}
+
+	return nil
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+// ErrLimitExceeded is returned by UntarLimited if the archive exceeds the given limits.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// Limits restricts the contents of an archive that is being extracted. zero values disable the limit.
+type Limits struct {
+	MaxFiles uint64
+	MaxBytes uint64
+
+	// MaxFileBytes restricts the size of each single file.
+	MaxFileBytes uint64
+}
+
 func Untar(r io.Reader, dest string) ([]string, error) {
+	return UntarLimited(r, dest, Limits{})
+}
+
+// UntarLimited extracts all regular files of the archive to dest. files cannot be written outside
+// of dest. extraction is aborted as soon as one of the limits is exceeded, so archives containing
+// lots of or huge files do not fill up the disk.
+func UntarLimited(r io.Reader, dest string, limits Limits) ([]string, error) {
 	gzr, err := gzip.NewReader(r)
 	if err != nil {
 		return nil, fmt.Errorf("gzip reader: %w", err)
@@ -85,14 +177,29 @@ func Untar(r io.Reader, dest string) ([]string, error) {
 
 	defer gzr.Close()
 
-	tr := tar.NewReader(gzr)
-	paths := make([]string, 0)
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return nil, fmt.Errorf("create dest: %w", err)
+	}
+
+	root, err := os.OpenRoot(dest)
+	if err != nil {
+		return nil, fmt.Errorf("open root: %w", err)
+	}
+
+	defer root.Close()
+
+	var (
+		tr        = tar.NewReader(gzr)
+		paths     = make([]string, 0)
+		remaining = limits.MaxBytes
+	)
 
 	for {
 		header, err := tr.Next()
 		if err == io.EOF {
 			break
 		}
+
 		if err != nil {
 			return nil, fmt.Errorf("tar next: %w", err)
 		}
@@ -101,24 +208,46 @@ func Untar(r io.Reader, dest string) ([]string, error) {
 			continue
 		}
 
-		target := filepath.Join(dest, header.Name)
-		paths = append(paths, target)
+		name := strings.TrimPrefix(header.Name, "/")
+		if !filepath.IsLocal(name) {
+			return nil, fmt.Errorf("invalid path %s", header.Name)
+		}
+
+		if limits.MaxFiles > 0 && uint64(len(paths)) >= limits.MaxFiles {
+			return nil, fmt.Errorf("%w: more than %d files", ErrLimitExceeded, limits.MaxFiles)
+		}
+
+		if limits.MaxFileBytes > 0 && (header.Size < 0 || uint64(header.Size) > limits.MaxFileBytes) {
+			return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrLimitExceeded, name, limits.MaxFileBytes)
+		}
+
+		if limits.MaxBytes > 0 {
+			if header.Size < 0 || uint64(header.Size) > remaining {
+				return nil, fmt.Errorf("%w: more than %d bytes", ErrLimitExceeded, limits.MaxBytes)
+			}
+			remaining -= uint64(header.Size)
+		}
+
+		paths = append(paths, filepath.Join(dest, name))
 
 		if err := func() error {
-			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			if err := root.MkdirAll(filepath.Dir(name), 0755); err != nil {
 				return err
 			}
 
-			f, err := os.Create(target)
+			f, err := root.Create(name)
 			if err != nil {
 				return fmt.Errorf("create file: %w", err)
 			}
 
 			defer f.Close()
 
-			if _, err := io.Copy(f, tr); err != nil {
+			// the header size has already been checked, but make sure
+			// we never write more than announced by the header.
+			if _, err := io.Copy(f, io.LimitReader(tr, header.Size)); err != nil {
 				return fmt.Errorf("copy: %w", err)
 			}
+
 			return nil
 		}(); err != nil {
 			return nil, err
@@ -19,12 +19,15 @@
 package tarhelper_test
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/spacechunks/explorer/internal/tarhelper"
 	"github.com/spacechunks/explorer/internal/tarhelper/testdata"
@@ -68,6 +71,31 @@ func TestTarFiles(t *testing.T) {
 	checkPaths(t, want, got)
 }
 
+func TestTarFS(t *testing.T) {
+	dir := t.TempDir()
+
+	fsys := fstest.MapFS{
+		"server.properties": &fstest.MapFile{Data: []byte("motd=hello")},
+		"logs/latest.log":   &fstest.MapFile{Data: []byte("started")},
+		"link":              &fstest.MapFile{Data: []byte("/etc/passwd"), Mode: os.ModeSymlink},
+	}
+
+	buf := bytes.Buffer{}
+	require.NoError(t, tarhelper.TarFS(&buf, fsys))
+
+	got, err := tarhelper.Untar(&buf, dir)
+	require.NoError(t, err)
+
+	checkPaths(t, []string{
+		dir + "/logs/latest.log",
+		dir + "/server.properties",
+	}, got)
+
+	data, err := os.ReadFile(filepath.Join(dir, "logs/latest.log"))
+	require.NoError(t, err)
+	require.Equal(t, "started", string(data))
+}
+
 func TestUntar(t *testing.T) {
 	dir := t.TempDir()
 
@@ -82,6 +110,118 @@ func TestUntar(t *testing.T) {
 	checkPaths(t, want, got)
 }
 
+func TestUntarLimited(t *testing.T) {
+	tests := []struct {
+		name   string
+		files  map[string]string
+		limits tarhelper.Limits
+		err    error
+	}{
+		{
+			name: "within limits",
+			files: map[string]string{
+				"a":     "aaaa",
+				"dir/b": "bbbb",
+			},
+			limits: tarhelper.Limits{
+				MaxFiles:     2,
+				MaxBytes:     8,
+				MaxFileBytes: 4,
+			},
+		},
+		{
+			name: "too many files",
+			files: map[string]string{
+				"a":     "aaaa",
+				"dir/b": "bbbb",
+			},
+			limits: tarhelper.Limits{
+				MaxFiles: 1,
+			},
+			err: tarhelper.ErrLimitExceeded,
+		},
+		{
+			name: "too many bytes",
+			files: map[string]string{
+				"a":     "aaaa",
+				"dir/b": "bbbb",
+			},
+			limits: tarhelper.Limits{
+				MaxBytes: 7,
+			},
+			err: tarhelper.ErrLimitExceeded,
+		},
+		{
+			name: "file too big",
+			files: map[string]string{
+				"a":     "aaaa",
+				"dir/b": "bbbbb",
+			},
+			limits: tarhelper.Limits{
+				MaxFileBytes: 4,
+			},
+			err: tarhelper.ErrLimitExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			_, err := tarhelper.UntarLimited(bytes.NewReader(tarGz(t, tt.files)), dir, tt.limits)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			for name, content := range tt.files {
+				data, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				require.Equal(t, content, string(data))
+			}
+		})
+	}
+}
+
+func TestUntarRejectsPathsOutsideOfDest(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		dest = filepath.Join(dir, "dest")
+	)
+
+	_, err := tarhelper.Untar(bytes.NewReader(tarGz(t, map[string]string{
+		"../escaped": "nope",
+	})), dest)
+	require.Error(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, "escaped"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+	var (
+		buf = bytes.Buffer{}
+		gzw = gzip.NewWriter(&buf)
+		tw  = tar.NewWriter(gzw)
+	)
+
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Size:     int64(len(content)),
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	return buf.Bytes()
+}
+
 func checkPaths(t *testing.T, want []string, got []string) {
 	sort.Slice(want, func(i, j int) bool {
 		return strings.Compare(want[i], want[j]) < 0
@@ -0,0 +1,114 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package tarhelper
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// UnzipLimited extracts all regular files of the zip archive to dest. like UntarLimited,
+// files cannot be written outside of dest and extraction is aborted as soon as one of
+// the limits is exceeded.
+func UnzipLimited(r io.ReaderAt, size int64, dest string, limits Limits) ([]string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("zip reader: %w", err)
+	}
+
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return nil, fmt.Errorf("create dest: %w", err)
+	}
+
+	root, err := os.OpenRoot(dest)
+	if err != nil {
+		return nil, fmt.Errorf("open root: %w", err)
+	}
+
+	defer root.Close()
+
+	var (
+		paths     = make([]string, 0)
+		remaining = limits.MaxBytes
+	)
+
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+
+		name := strings.TrimPrefix(f.Name, "/")
+		if !filepath.IsLocal(name) {
+			return nil, fmt.Errorf("invalid path %s", f.Name)
+		}
+
+		if limits.MaxFiles > 0 && uint64(len(paths)) >= limits.MaxFiles {
+			return nil, fmt.Errorf("%w: more than %d files", ErrLimitExceeded, limits.MaxFiles)
+		}
+
+		if limits.MaxFileBytes > 0 && f.UncompressedSize64 > limits.MaxFileBytes {
+			return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrLimitExceeded, name, limits.MaxFileBytes)
+		}
+
+		if limits.MaxBytes > 0 {
+			if f.UncompressedSize64 > remaining {
+				return nil, fmt.Errorf("%w: more than %d bytes", ErrLimitExceeded, limits.MaxBytes)
+			}
+			remaining -= f.UncompressedSize64
+		}
+
+		paths = append(paths, filepath.Join(dest, name))
+
+		if err := func() error {
+			if err := root.MkdirAll(filepath.Dir(name), 0755); err != nil {
+				return err
+			}
+
+			out, err := root.Create(name)
+			if err != nil {
+				return fmt.Errorf("create file: %w", err)
+			}
+
+			defer out.Close()
+
+			rc, err := f.Open()
+			if err != nil {
+				return fmt.Errorf("open %s: %w", f.Name, err)
+			}
+
+			defer rc.Close()
+
+			// the announced size has already been checked, but make
+			// sure we never write more than the header says.
+			if _, err := io.Copy(out, io.LimitReader(rc, int64(f.UncompressedSize64))); err != nil {
+				return fmt.Errorf("copy: %w", err)
+			}
+
+			return nil
+		}(); err != nil {
+			return nil, err
+		}
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,141 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package tarhelper_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/tarhelper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnzipLimited(t *testing.T) {
+	tests := []struct {
+		name   string
+		files  map[string]string
+		limits tarhelper.Limits
+		err    error
+	}{
+		{
+			name: "within limits",
+			files: map[string]string{
+				"a":     "aaaa",
+				"dir/b": "bbbb",
+			},
+			limits: tarhelper.Limits{
+				MaxFiles:     2,
+				MaxBytes:     8,
+				MaxFileBytes: 4,
+			},
+		},
+		{
+			name: "too many files",
+			files: map[string]string{
+				"a":     "aaaa",
+				"dir/b": "bbbb",
+			},
+			limits: tarhelper.Limits{
+				MaxFiles: 1,
+			},
+			err: tarhelper.ErrLimitExceeded,
+		},
+		{
+			name: "too many bytes",
+			files: map[string]string{
+				"a":     "aaaa",
+				"dir/b": "bbbb",
+			},
+			limits: tarhelper.Limits{
+				MaxBytes: 7,
+			},
+			err: tarhelper.ErrLimitExceeded,
+		},
+		{
+			name: "file too big",
+			files: map[string]string{
+				"a":     "aaaa",
+				"dir/b": "bbbbb",
+			},
+			limits: tarhelper.Limits{
+				MaxFileBytes: 4,
+			},
+			err: tarhelper.ErrLimitExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				dir  = t.TempDir()
+				data = zipArchive(t, tt.files)
+			)
+
+			_, err := tarhelper.UnzipLimited(bytes.NewReader(data), int64(len(data)), dir, tt.limits)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			for name, content := range tt.files {
+				data, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				require.Equal(t, content, string(data))
+			}
+		})
+	}
+}
+
+func TestUnzipRejectsPathsOutsideOfDest(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		dest = filepath.Join(dir, "dest")
+		data = zipArchive(t, map[string]string{
+			"../escaped": "nope",
+		})
+	)
+
+	_, err := tarhelper.UnzipLimited(bytes.NewReader(data), int64(len(data)), dest, tarhelper.Limits{})
+	require.Error(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, "escaped"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	var (
+		buf = bytes.Buffer{}
+		zw  = zip.NewWriter(&buf)
+	)
+
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
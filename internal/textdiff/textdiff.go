@@ -0,0 +1,218 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package textdiff computes line based differences of text files.
+package textdiff
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrTooLarge is returned if the inputs differ in too many lines
+// to compute their differences with reasonable memory usage.
+var ErrTooLarge = errors.New("too many changed lines")
+
+// maxCells limits the size of the table used for computing the longest
+// common subsequence of the changed lines, which uses 4 bytes per cell.
+const maxCells = 4_000_000
+
+type opKind byte
+
+const (
+	opEqual  opKind = ' '
+	opDelete opKind = '-'
+	opInsert opKind = '+'
+)
+
+type op struct {
+	kind opKind
+	line string
+}
+
+// IsText reports whether data looks like the content of a text file, which is
+// the case if it is valid utf-8 and does not contain any null bytes.
+func IsText(data []byte) bool {
+	return utf8.Valid(data) && !bytes.Contains(data, []byte{0})
+}
+
+// Unified returns the differences between from and to in the unified diff
+// format, showing the given number of unchanged lines around each change.
+// an empty string is returned if from and to are equal.
+func Unified(fromName string, toName string, from string, to string, context int) (string, error) {
+	if from == to {
+		return "", nil
+	}
+
+	ops, err := diff(splitLines(from), splitLines(to))
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", fromName, toName)
+
+	// fromLine and toLine hold the number of lines of from and
+	// to that come before the op at the same index.
+	var (
+		fromLine = make([]int, len(ops)+1)
+		toLine   = make([]int, len(ops)+1)
+	)
+
+	for i, o := range ops {
+		fromLine[i+1] = fromLine[i]
+		toLine[i+1] = toLine[i]
+		if o.kind != opInsert {
+			fromLine[i+1]++
+		}
+		if o.kind != opDelete {
+			toLine[i+1]++
+		}
+	}
+
+	for i := 0; i < len(ops); {
+		if ops[i].kind == opEqual {
+			i++
+			continue
+		}
+
+		// extend the hunk as long as the next change is close enough
+		// that their context lines would overlap.
+		start := max(0, i-context)
+		end := i
+		for j := i; j < len(ops); j++ {
+			if ops[j].kind == opEqual {
+				continue
+			}
+			if j-end-1 > 2*context {
+				break
+			}
+			end = j
+		}
+		stop := min(len(ops), end+context+1)
+
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n",
+			hunkRange(fromLine[start], fromLine[stop]-fromLine[start]),
+			hunkRange(toLine[start], toLine[stop]-toLine[start]),
+		)
+
+		for _, o := range ops[start:stop] {
+			b.WriteByte(byte(o.kind))
+			b.WriteString(o.line)
+			b.WriteByte('\n')
+		}
+
+		i = stop
+	}
+
+	return b.String(), nil
+}
+
+func hunkRange(before int, n int) string {
+	// empty ranges refer to the line before them.
+	if n == 0 {
+		return fmt.Sprintf("%d,0", before)
+	}
+	if n == 1 {
+		return fmt.Sprintf("%d", before+1)
+	}
+	return fmt.Sprintf("%d,%d", before+1, n)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diff computes the shortest edit script turning a into b using the longest
+// common subsequence of the lines that are not part of the common prefix
+// and suffix.
+func diff(a []string, b []string) ([]op, error) {
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	var suffix int
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var (
+		ma = a[prefix : len(a)-suffix]
+		mb = b[prefix : len(b)-suffix]
+		n  = len(ma)
+		m  = len(mb)
+	)
+
+	if (n+1)*(m+1) > maxCells {
+		return nil, ErrTooLarge
+	}
+
+	// lcs[i*(m+1)+j] is the length of the longest common subsequence of ma[i:] and mb[j:].
+	lcs := make([]int32, (n+1)*(m+1))
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if ma[i] == mb[j] {
+				lcs[i*(m+1)+j] = lcs[(i+1)*(m+1)+j+1] + 1
+			} else {
+				lcs[i*(m+1)+j] = max(lcs[(i+1)*(m+1)+j], lcs[i*(m+1)+j+1])
+			}
+		}
+	}
+
+	ops := make([]op, 0, len(a)+len(b))
+	for _, l := range a[:prefix] {
+		ops = append(ops, op{kind: opEqual, line: l})
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case ma[i] == mb[j]:
+			ops = append(ops, op{kind: opEqual, line: ma[i]})
+			i++
+			j++
+		case lcs[(i+1)*(m+1)+j] >= lcs[i*(m+1)+j+1]:
+			ops = append(ops, op{kind: opDelete, line: ma[i]})
+			i++
+		default:
+			ops = append(ops, op{kind: opInsert, line: mb[j]})
+			j++
+		}
+	}
+
+	for ; i < n; i++ {
+		ops = append(ops, op{kind: opDelete, line: ma[i]})
+	}
+
+	for ; j < m; j++ {
+		ops = append(ops, op{kind: opInsert, line: mb[j]})
+	}
+
+	for _, l := range a[len(a)-suffix:] {
+		ops = append(ops, op{kind: opEqual, line: l})
+	}
+
+	return ops, nil
+}
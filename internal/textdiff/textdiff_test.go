@@ -0,0 +1,138 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package textdiff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/textdiff"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		context  int
+		expected string
+	}{
+		{
+			name: "equal",
+			from: "a\nb\n",
+			to:   "a\nb\n",
+		},
+		{
+			name:    "changed line with context",
+			from:    "a\nb\nc\nd\ne\nf\ng\n",
+			to:      "a\nb\nc\nD\ne\nf\ng\n",
+			context: 1,
+			expected: `--- old
++++ new
+@@ -3,3 +3,3 @@
+ c
+-d
++D
+ e
+`,
+		},
+		{
+			name:    "changes far apart use separate hunks",
+			from:    "1\n2\n3\n4\n5\n6\n7\n8\n",
+			to:      "x\n2\n3\n4\n5\n6\n7\ny\n",
+			context: 1,
+			expected: `--- old
++++ new
+@@ -1,2 +1,2 @@
+-1
++x
+ 2
+@@ -7,2 +7,2 @@
+ 7
+-8
++y
+`,
+		},
+		{
+			name:    "close changes share a hunk",
+			from:    "1\n2\n3\n4\n",
+			to:      "x\n2\n3\ny\n",
+			context: 1,
+			expected: `--- old
++++ new
+@@ -1,4 +1,4 @@
+-1
++x
+ 2
+ 3
+-4
++y
+`,
+		},
+		{
+			name:    "new file",
+			from:    "",
+			to:      "a\nb\n",
+			context: 3,
+			expected: `--- old
++++ new
+@@ -0,0 +1,2 @@
++a
++b
+`,
+		},
+		{
+			name:    "inserted line",
+			from:    "a\nc\n",
+			to:      "a\nb\nc\n",
+			context: 0,
+			expected: `--- old
++++ new
+@@ -1,0 +2 @@
++b
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := textdiff.Unified("old", "new", tt.from, tt.to, tt.context)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestUnifiedTooLarge(t *testing.T) {
+	var from, to strings.Builder
+	for i := range 3000 {
+		from.WriteString("a" + strings.Repeat("x", i%7) + "\n")
+		to.WriteString("b" + strings.Repeat("y", i%5) + "\n")
+	}
+
+	_, err := textdiff.Unified("old", "new", from.String(), to.String(), 3)
+	require.ErrorIs(t, err, textdiff.ErrTooLarge)
+}
+
+func TestIsText(t *testing.T) {
+	require.True(t, textdiff.IsText([]byte("motd=hello wörld\n")))
+	require.False(t, textdiff.IsText([]byte{'P', 'K', 0x03, 0x04, 0x00}))
+	require.False(t, textdiff.IsText([]byte{0xff, 0xfe}))
+}
@@ -0,0 +1,91 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package unixsock_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/unixsock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListen(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, path string)
+		err     error
+	}{
+		{
+			name:    "socket does not exist",
+			prepare: func(*testing.T, string) {},
+		},
+		{
+			name: "stale socket is removed",
+			prepare: func(t *testing.T, path string) {
+				lis, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+				require.NoError(t, err)
+				// simulate a crash by not removing the socket file
+				lis.SetUnlinkOnClose(false)
+				require.NoError(t, lis.Close())
+			},
+		},
+		{
+			name: "socket in use",
+			prepare: func(t *testing.T, path string) {
+				lis, err := net.Listen("unix", path)
+				require.NoError(t, err)
+				t.Cleanup(func() {
+					_ = lis.Close()
+				})
+			},
+			err: unixsock.ErrInUse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "test.sock")
+
+			tt.prepare(t, path)
+
+			lis, err := unixsock.Listen(path)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, lis.Close())
+		})
+	}
+}
+
+func TestListenDoesNotRemoveRegularFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.sock")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0600))
+
+	_, err := unixsock.Listen(path)
+	require.Error(t, err)
+
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+}
@@ -0,0 +1,221 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package workloadenv
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LabelSchemaV1 is the version of the label schema described by Labels.
+// the version is stored alongside the other labels, so readers are able to
+// tell workloads created by an older or newer platformd apart. workloads
+// created before the schema has been versioned do not carry the label and
+// are treated as LabelSchemaV1.
+const LabelSchemaV1 = "v1"
+
+const labelPrefix = "explorer.chunks.cloud/"
+
+const (
+	LabelSchemaVersion = labelPrefix + "label-schema"
+
+	LabelWorkloadID   = labelPrefix + "workload-id"
+	LabelWorkloadName = labelPrefix + "workload-name"
+	LabelWorkloadType = labelPrefix + "workload-type"
+
+	LabelChunkID   = labelPrefix + "chunk-id"
+	LabelChunkName = labelPrefix + "chunk-name"
+
+	LabelFlavorID        = labelPrefix + "flavor-id"
+	LabelFlavorVersionID = labelPrefix + "flavor-version-id"
+
+	LabelWorkloadPort = labelPrefix + "workload-port"
+
+	LabelInstanceOrderedBy = labelPrefix + "ordered-by"
+)
+
+const (
+	WorkloadTypeInstance   = "instance"
+	WorkloadTypeSystem     = "system"
+	WorkloadTypeCheckpoint = "checkpoint"
+)
+
+var ErrInvalidLabels = errors.New("invalid labels")
+
+// requiredLabels lists the labels each workload type has to carry.
+var requiredLabels = map[string][]string{
+	WorkloadTypeInstance:   {LabelWorkloadID, LabelChunkID, LabelFlavorVersionID},
+	WorkloadTypeSystem:     {LabelWorkloadName},
+	WorkloadTypeCheckpoint: {LabelWorkloadID},
+}
+
+var knownLabels = map[string]struct{}{
+	LabelSchemaVersion:     {},
+	LabelWorkloadID:        {},
+	LabelWorkloadName:      {},
+	LabelWorkloadType:      {},
+	LabelChunkID:           {},
+	LabelChunkName:         {},
+	LabelFlavorID:          {},
+	LabelFlavorVersionID:   {},
+	LabelWorkloadPort:      {},
+	LabelInstanceOrderedBy: {},
+}
+
+// Labels are the labels attached to workloads and their containers.
+// zero values are omitted when encoding them.
+type Labels struct {
+	WorkloadID      string
+	WorkloadName    string
+	WorkloadType    string
+	ChunkID         string
+	ChunkName       string
+	FlavorID        string
+	FlavorVersionID string
+	WorkloadPort    uint16
+	OrderedBy       string
+}
+
+// Map encodes the labels, including the schema version.
+func (l Labels) Map() map[string]string {
+	m := map[string]string{
+		LabelSchemaVersion: LabelSchemaV1,
+	}
+
+	set := func(k, v string) {
+		if v != "" {
+			m[k] = v
+		}
+	}
+
+	set(LabelWorkloadID, l.WorkloadID)
+	set(LabelWorkloadName, l.WorkloadName)
+	set(LabelWorkloadType, l.WorkloadType)
+	set(LabelChunkID, l.ChunkID)
+	set(LabelChunkName, l.ChunkName)
+	set(LabelFlavorID, l.FlavorID)
+	set(LabelFlavorVersionID, l.FlavorVersionID)
+	set(LabelInstanceOrderedBy, l.OrderedBy)
+
+	if l.WorkloadPort != 0 {
+		m[LabelWorkloadPort] = strconv.Itoa(int(l.WorkloadPort))
+	}
+
+	return m
+}
+
+// ValidateLabels checks that the labels conform to the schema. labels
+// outside the explorer.chunks.cloud/ prefix are ignored, because the
+// container runtime or other tooling might attach their own.
+func ValidateLabels(m map[string]string) error {
+	for k := range m {
+		if !strings.HasPrefix(k, labelPrefix) {
+			continue
+		}
+		if _, ok := knownLabels[k]; !ok {
+			return fmt.Errorf("%w: unknown label %s", ErrInvalidLabels, k)
+		}
+	}
+
+	if v, ok := m[LabelSchemaVersion]; ok && v != LabelSchemaV1 {
+		return fmt.Errorf("%w: unsupported schema version %s", ErrInvalidLabels, v)
+	}
+
+	if v, ok := m[LabelWorkloadPort]; ok {
+		if _, err := strconv.ParseUint(v, 10, 16); err != nil {
+			return fmt.Errorf("%w: workload port %s", ErrInvalidLabels, v)
+		}
+	}
+
+	typ, ok := m[LabelWorkloadType]
+	if !ok {
+		return nil
+	}
+
+	required, ok := requiredLabels[typ]
+	if !ok {
+		return fmt.Errorf("%w: unknown workload type %s", ErrInvalidLabels, typ)
+	}
+
+	for _, k := range required {
+		if m[k] == "" {
+			return fmt.Errorf("%w: %s workload is missing %s", ErrInvalidLabels, typ, k)
+		}
+	}
+
+	return nil
+}
+
+// ParseLabels validates and decodes the given labels.
+func ParseLabels(m map[string]string) (Labels, error) {
+	if err := ValidateLabels(m); err != nil {
+		return Labels{}, err
+	}
+
+	var port uint16
+	if v, ok := m[LabelWorkloadPort]; ok {
+		// already validated above
+		p, _ := strconv.ParseUint(v, 10, 16)
+		port = uint16(p)
+	}
+
+	return Labels{
+		WorkloadID:      m[LabelWorkloadID],
+		WorkloadName:    m[LabelWorkloadName],
+		WorkloadType:    m[LabelWorkloadType],
+		ChunkID:         m[LabelChunkID],
+		ChunkName:       m[LabelChunkName],
+		FlavorID:        m[LabelFlavorID],
+		FlavorVersionID: m[LabelFlavorVersionID],
+		WorkloadPort:    port,
+		OrderedBy:       m[LabelInstanceOrderedBy],
+	}, nil
+}
+
+// Selector selects workloads by their labels. only workloads matching all
+// set fields are selected, the zero value selects all workloads.
+//
+// note that workloads created before the flavor id label has been
+// introduced are never matched when selecting by flavor.
+type Selector struct {
+	WorkloadType    string
+	ChunkID         string
+	FlavorID        string
+	FlavorVersionID string
+}
+
+// LabelSelector returns the selector in the form expected by the CRI.
+func (s Selector) LabelSelector() map[string]string {
+	m := make(map[string]string)
+
+	set := func(k, v string) {
+		if v != "" {
+			m[k] = v
+		}
+	}
+
+	set(LabelWorkloadType, s.WorkloadType)
+	set(LabelChunkID, s.ChunkID)
+	set(LabelFlavorID, s.FlavorID)
+	set(LabelFlavorVersionID, s.FlavorVersionID)
+
+	return m
+}
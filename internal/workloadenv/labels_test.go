@@ -0,0 +1,143 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package workloadenv_test
+
+import (
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/workloadenv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		expected workloadenv.Labels
+		err      error
+	}{
+		{
+			name: "instance",
+			labels: workloadenv.Labels{
+				WorkloadID:      "ins",
+				WorkloadType:    workloadenv.WorkloadTypeInstance,
+				ChunkID:         "chunk",
+				ChunkName:       "my chunk",
+				FlavorID:        "flavor",
+				FlavorVersionID: "fv",
+				WorkloadPort:    30001,
+				OrderedBy:       "player",
+			}.Map(),
+			expected: workloadenv.Labels{
+				WorkloadID:      "ins",
+				WorkloadType:    workloadenv.WorkloadTypeInstance,
+				ChunkID:         "chunk",
+				ChunkName:       "my chunk",
+				FlavorID:        "flavor",
+				FlavorVersionID: "fv",
+				WorkloadPort:    30001,
+				OrderedBy:       "player",
+			},
+		},
+		{
+			name: "labels without schema version are accepted",
+			labels: map[string]string{
+				workloadenv.LabelWorkloadName: "coredns",
+				workloadenv.LabelWorkloadType: workloadenv.WorkloadTypeSystem,
+			},
+			expected: workloadenv.Labels{
+				WorkloadName: "coredns",
+				WorkloadType: workloadenv.WorkloadTypeSystem,
+			},
+		},
+		{
+			name: "foreign labels are ignored",
+			labels: map[string]string{
+				"io.kubernetes.pod.uid":       "uid",
+				workloadenv.LabelWorkloadID:   "check",
+				workloadenv.LabelWorkloadType: workloadenv.WorkloadTypeCheckpoint,
+			},
+			expected: workloadenv.Labels{
+				WorkloadID:   "check",
+				WorkloadType: workloadenv.WorkloadTypeCheckpoint,
+			},
+		},
+		{
+			name: "unsupported schema version",
+			labels: map[string]string{
+				workloadenv.LabelSchemaVersion: "v2",
+			},
+			err: workloadenv.ErrInvalidLabels,
+		},
+		{
+			name: "unknown label",
+			labels: map[string]string{
+				"explorer.chunks.cloud/workload-typ": "instance",
+			},
+			err: workloadenv.ErrInvalidLabels,
+		},
+		{
+			name: "unknown workload type",
+			labels: map[string]string{
+				workloadenv.LabelWorkloadType: "blub",
+			},
+			err: workloadenv.ErrInvalidLabels,
+		},
+		{
+			name: "invalid port",
+			labels: map[string]string{
+				workloadenv.LabelWorkloadPort: "70000",
+			},
+			err: workloadenv.ErrInvalidLabels,
+		},
+		{
+			name: "instance without flavor version",
+			labels: map[string]string{
+				workloadenv.LabelWorkloadID:   "ins",
+				workloadenv.LabelWorkloadType: workloadenv.WorkloadTypeInstance,
+				workloadenv.LabelChunkID:      "chunk",
+			},
+			err: workloadenv.ErrInvalidLabels,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels, err := workloadenv.ParseLabels(tt.labels)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, labels)
+		})
+	}
+}
+
+func TestSelectorOmitsUnsetFields(t *testing.T) {
+	require.Empty(t, workloadenv.Selector{}.LabelSelector())
+	require.Equal(t, map[string]string{
+		workloadenv.LabelWorkloadType: workloadenv.WorkloadTypeInstance,
+		workloadenv.LabelChunkID:      "chunk",
+	}, workloadenv.Selector{
+		WorkloadType: workloadenv.WorkloadTypeInstance,
+		ChunkID:      "chunk",
+	}.LabelSelector())
+}
@@ -0,0 +1,98 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package workloadenv describes the environment the platform runs minecraft
+// server workloads in. it is shared by platformd and the cli, so that locally
+// started servers look the same as the ones running on the platform.
+package workloadenv
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+)
+
+const (
+	// ServerPort is the port the minecraft server listens on inside the container.
+	ServerPort = 25565
+
+	// ServerDir is the directory the flavor files are placed in.
+	ServerDir = "/opt/paper"
+
+	// ParamsDir is the directory the params file is mounted
+	// to inside the minecraft server container.
+	ParamsDir      = "/run/explorer"
+	ParamsFileName = "params.json"
+)
+
+// Params is the content of the params file. custom is embedded as is,
+// so plugins do not have to decode it a second time.
+type Params struct {
+	WorldSeed string          `json:"worldSeed,omitempty"`
+	GameMode  string          `json:"gameMode,omitempty"`
+	Custom    json.RawMessage `json:"custom,omitempty"`
+}
+
+// EnvVar is an environment variable set in the minecraft server container.
+type EnvVar struct {
+	Key   string
+	Value string
+}
+
+// NewParams converts the instance params to their file representation.
+// false is returned if no params are set.
+func NewParams(p *instancev1alpha1.InstanceParams) (Params, bool) {
+	if p.GetWorldSeed() == "" && p.GetGameMode() == instancev1alpha1.GameMode_GAME_MODE_DEFAULT && p.GetCustom() == "" {
+		return Params{}, false
+	}
+
+	var mode string
+	if p.GetGameMode() != instancev1alpha1.GameMode_GAME_MODE_DEFAULT {
+		mode = strings.ToLower(p.GetGameMode().String())
+	}
+
+	return Params{
+		WorldSeed: p.GetWorldSeed(),
+		GameMode:  mode,
+		Custom:    json.RawMessage(p.GetCustom()),
+	}, true
+}
+
+// Env returns the env vars exposing the params to the minecraft server container.
+func (p Params) Env() []EnvVar {
+	return []EnvVar{
+		{
+			Key:   "EXPLORER_PARAMS_FILE",
+			Value: path.Join(ParamsDir, ParamsFileName),
+		},
+		{
+			Key:   "EXPLORER_WORLD_SEED",
+			Value: p.WorldSeed,
+		},
+		{
+			Key:   "EXPLORER_GAME_MODE",
+			Value: p.GameMode,
+		},
+		{
+			Key:   "EXPLORER_CUSTOM_PARAMS",
+			Value: string(p.Custom),
+		},
+	}
+}
@@ -0,0 +1,456 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	"github.com/spacechunks/explorer/internal/file"
+	"github.com/spacechunks/explorer/internal/tarhelper"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrFlavorNotFound is returned if the chunk does not have a flavor with the given name.
+	ErrFlavorNotFound = errors.New("flavor not found")
+
+	// ErrNoBuiltVersion is returned if no version of a flavor has been built successfully.
+	ErrNoBuiltVersion = errors.New("flavor has no successfully built version")
+
+	// ErrBuildFailed is returned if building a flavor version failed
+	// or has been rejected by the control plane.
+	ErrBuildFailed = errors.New("build failed")
+)
+
+// FlavorSpec describes the flavor version that is published.
+type FlavorSpec struct {
+	Name             string
+	Version          string
+	MinecraftVersion string
+	MinPlayers       uint32
+	MaxPlayers       uint32
+
+	// StartupGracePeriod is the time the server has to start, before
+	// failing health probes cause it to be considered unhealthy.
+	// 0 means node default.
+	StartupGracePeriod time.Duration
+	RestartPolicy      chunkv1alpha1.RestartPolicy
+	RestartMaxRetries  uint32
+
+	// JavaVersion is the java runtime the server is started with.
+	// StartupCommand has to be one of the templates allowed by the
+	// control plane. zero values use the defaults of the base image.
+	JavaVersion    uint32
+	StartupCommand string
+}
+
+// Chunks returns all chunks, requesting further
+// pages from the control plane as needed.
+func (c *Client) Chunks(ctx context.Context) iter.Seq2[*chunkv1alpha1.Chunk, error] {
+	return func(yield func(*chunkv1alpha1.Chunk, error) bool) {
+		var token string
+		for {
+			resp, err := c.Chunk.ListChunks(ctx, &chunkv1alpha1.ListChunksRequest{
+				PageSize:  pageSize,
+				PageToken: token,
+			})
+			if err != nil {
+				yield(nil, fmt.Errorf("list chunks: %w", err))
+				return
+			}
+
+			for _, chunk := range resp.GetChunks() {
+				if !yield(chunk, nil) {
+					return
+				}
+			}
+
+			if resp.GetNextPageToken() == "" {
+				return
+			}
+
+			token = resp.GetNextPageToken()
+		}
+	}
+}
+
+// PublishFlavor creates a new version of the flavor from the files in dir,
+// uploads the files that changed since the previous version, builds it and
+// waits until the build completed. the flavor is created if the chunk does
+// not have one with the given name yet. if a version with the same files
+// already exists, it is built if necessary and returned.
+func (c *Client) PublishFlavor(
+	ctx context.Context,
+	chunkID string,
+	dir string,
+	spec FlavorSpec,
+) (*chunkv1alpha1.FlavorVersion, error) {
+	hash, files, err := file.HashDir(c.logger, dir)
+	if err != nil {
+		return nil, fmt.Errorf("hash files: %w", err)
+	}
+
+	if err := c.precheck(ctx, files); err != nil {
+		return nil, err
+	}
+
+	flavor, err := c.findOrCreateFlavor(ctx, chunkID, spec.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	version := findVersion(flavor, hash)
+	if version == nil {
+		hashes := make([]*chunkv1alpha1.FileHashes, 0, len(files))
+		for _, f := range files {
+			hashes = append(hashes, &chunkv1alpha1.FileHashes{
+				Path:      serverRelPath(dir, f.Path),
+				Hash:      f.Hash,
+				SizeBytes: f.SizeBytes,
+			})
+		}
+
+		if _, err := c.Chunk.CreateFlavorVersion(ctx, &chunkv1alpha1.CreateFlavorVersionRequest{
+			FlavorId:                  flavor.GetId(),
+			Version:                   spec.Version,
+			Hash:                      hash,
+			FileHashes:                hashes,
+			MinecraftVersion:          spec.MinecraftVersion,
+			MinPlayers:                spec.MinPlayers,
+			MaxPlayers:                spec.MaxPlayers,
+			StartupGracePeriodSeconds: uint32(spec.StartupGracePeriod.Seconds()),
+			RestartPolicy:             spec.RestartPolicy,
+			RestartMaxRetries:         spec.RestartMaxRetries,
+			JavaVersion:               spec.JavaVersion,
+			StartupCommand:            spec.StartupCommand,
+		}); err != nil {
+			return nil, fmt.Errorf("create flavor version: %w", err)
+		}
+
+		// re-read the flavor, because the created version only
+		// contains the files of the flavor version, but we also
+		// need the previous version to compute the changes.
+		resp, err := c.Chunk.GetFlavor(ctx, &chunkv1alpha1.GetFlavorRequest{
+			Id: flavor.GetId(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get flavor: %w", err)
+		}
+
+		flavor = resp.GetFlavor()
+		version = findVersion(flavor, hash)
+		if version == nil {
+			return nil, fmt.Errorf("flavor version with hash %s not found after creating it", hash)
+		}
+	}
+
+	if version.GetBuildStatus() == chunkv1alpha1.BuildStatus_COMPLETED {
+		return version, nil
+	}
+
+	if !version.GetFilesUploaded() {
+		if err := c.uploadChanges(ctx, dir, files, flavor, version); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := c.Chunk.BuildFlavorVersion(ctx, &chunkv1alpha1.BuildFlavorVersionRequest{
+		FlavorVersionId: version.GetId(),
+	}); err != nil && !IsAlreadyBuilding(err) {
+		return nil, fmt.Errorf("build flavor version: %w", err)
+	}
+
+	return c.WaitForBuild(ctx, flavor.GetId(), version.GetId())
+}
+
+// WaitForBuild waits until the build of the given flavor version completed.
+// [ErrBuildFailed] is returned if the build failed or has been rejected.
+func (c *Client) WaitForBuild(ctx context.Context, flavorID string, versionID string) (*chunkv1alpha1.FlavorVersion, error) {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.Chunk.GetFlavor(ctx, &chunkv1alpha1.GetFlavorRequest{
+			Id: flavorID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get flavor: %w", err)
+		}
+
+		var version *chunkv1alpha1.FlavorVersion
+		for _, v := range resp.GetFlavor().GetVersions() {
+			if v.GetId() == versionID {
+				version = v
+				break
+			}
+		}
+
+		if version == nil {
+			return nil, fmt.Errorf("flavor version %s not found", versionID)
+		}
+
+		if buildErr := version.GetBuildError(); buildErr != "" {
+			return nil, fmt.Errorf("%w: %s", ErrBuildFailed, buildErr)
+		}
+
+		switch version.GetBuildStatus() {
+		case chunkv1alpha1.BuildStatus_COMPLETED:
+			return version, nil
+		case chunkv1alpha1.BuildStatus_IMAGE_BUILD_FAILED,
+			chunkv1alpha1.BuildStatus_CHECKPOINT_BUILD_FAILED:
+			return nil, fmt.Errorf("%w: %s", ErrBuildFailed, version.GetBuildStatus())
+		}
+
+		c.logger.DebugContext(ctx,
+			"waiting for build",
+			"flavor_version_id", versionID,
+			"status", version.GetBuildStatus(),
+			"progress", version.GetBuildProgress(),
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// IsAlreadyBuilding reports whether BuildFlavorVersion failed, because the
+// build has been initiated by a concurrent request, for example one that
+// has been retried.
+func IsAlreadyBuilding(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return false
+	}
+
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Reason == "ALREADY_BUILDING" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// precheck asks the control plane whether the flavor is within the build limits,
+// so we do not create a flavor version and upload files that will be rejected anyway.
+func (c *Client) precheck(ctx context.Context, files []file.Hash) error {
+	var totalSize, largestSize uint64
+	for _, f := range files {
+		info, err := os.Stat(f.Path)
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", f.Path, err)
+		}
+		totalSize += uint64(info.Size())
+		largestSize = max(largestSize, uint64(info.Size()))
+	}
+
+	resp, err := c.Chunk.PrecheckFlavorVersion(ctx, &chunkv1alpha1.PrecheckFlavorVersionRequest{
+		FileCount:            uint64(len(files)),
+		TotalSizeBytes:       totalSize,
+		LargestFileSizeBytes: largestSize,
+	})
+	if err != nil {
+		return fmt.Errorf("precheck flavor version: %w", err)
+	}
+
+	if len(resp.GetViolations()) > 0 {
+		return fmt.Errorf("%w: flavor exceeds build limits: %s", ErrBuildFailed, strings.Join(resp.GetViolations(), "; "))
+	}
+
+	return nil
+}
+
+func (c *Client) findOrCreateFlavor(ctx context.Context, chunkID string, name string) (*chunkv1alpha1.Flavor, error) {
+	resp, err := c.Chunk.GetChunk(ctx, &chunkv1alpha1.GetChunkRequest{
+		Id: chunkID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get chunk: %w", err)
+	}
+
+	for _, f := range resp.GetChunk().GetFlavors() {
+		if f.GetName() == name {
+			return f, nil
+		}
+	}
+
+	created, err := c.Chunk.CreateFlavor(ctx, &chunkv1alpha1.CreateFlavorRequest{
+		ChunkId: chunkID,
+		Name:    name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create flavor: %w", err)
+	}
+
+	return created.GetFlavor(), nil
+}
+
+// latestBuiltVersion returns the latest version of the flavor that has been built successfully.
+func (c *Client) latestBuiltVersion(ctx context.Context, chunkID string, flavorName string) (*chunkv1alpha1.FlavorVersion, error) {
+	resp, err := c.Chunk.GetChunk(ctx, &chunkv1alpha1.GetChunkRequest{
+		Id: chunkID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get chunk: %w", err)
+	}
+
+	for _, f := range resp.GetChunk().GetFlavors() {
+		if f.GetName() != flavorName {
+			continue
+		}
+
+		// versions are ordered from latest to oldest
+		for _, v := range f.GetVersions() {
+			if v.GetBuildStatus() == chunkv1alpha1.BuildStatus_COMPLETED {
+				return v, nil
+			}
+		}
+
+		return nil, ErrNoBuiltVersion
+	}
+
+	return nil, ErrFlavorNotFound
+}
+
+// uploadChanges uploads a tarball containing the files that have been
+// added or changed compared to the version preceding the given one.
+func (c *Client) uploadChanges(
+	ctx context.Context,
+	dir string,
+	files []file.Hash,
+	flavor *chunkv1alpha1.Flavor,
+	version *chunkv1alpha1.FlavorVersion,
+) error {
+	// versions are ordered from latest to oldest, so
+	// the previous version is the one following it.
+	prev := make(map[string]string)
+	for i, v := range flavor.GetVersions() {
+		if v.GetId() != version.GetId() || i+1 >= len(flavor.GetVersions()) {
+			continue
+		}
+
+		for _, fh := range flavor.GetVersions()[i+1].GetFileHashes() {
+			prev[fh.GetPath()] = fh.GetHash()
+		}
+	}
+
+	changed := make([]*os.File, 0, len(files))
+	defer func() {
+		for _, f := range changed {
+			_ = f.Close()
+		}
+	}()
+
+	for _, fh := range files {
+		if h, ok := prev[serverRelPath(dir, fh.Path)]; ok && h == fh.Hash {
+			continue
+		}
+
+		f, err := os.Open(fh.Path)
+		if err != nil {
+			return fmt.Errorf("open %s: %w", fh.Path, err)
+		}
+
+		changed = append(changed, f)
+	}
+
+	tmp, err := os.MkdirTemp("", "explorer-changeset-")
+	if err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	changeSet := filepath.Join(tmp, version.GetId()+".tar.gz")
+	if err := tarhelper.TarFiles(dir, changed, changeSet); err != nil {
+		return fmt.Errorf("tar files: %w", err)
+	}
+
+	data, err := os.ReadFile(changeSet)
+	if err != nil {
+		return fmt.Errorf("read change set: %w", err)
+	}
+
+	digest := sha256.Sum256(data)
+
+	resp, err := c.Chunk.GetUploadURL(ctx, &chunkv1alpha1.GetUploadURLRequest{
+		FlavorVersionId:  version.GetId(),
+		TarballHash:      base64.StdEncoding.EncodeToString(digest[:]),
+		TarballSizeBytes: uint64(len(data)),
+	})
+	if err != nil {
+		return fmt.Errorf("get upload url: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, resp.GetUrl(), bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create upload request: %w", err)
+	}
+
+	req.ContentLength = int64(len(data))
+
+	for k, v := range resp.GetHeaders() {
+		req.Header.Set(k, v)
+	}
+
+	uploadResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("upload: %w", err)
+	}
+	defer uploadResp.Body.Close()
+
+	if uploadResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(uploadResp.Body, 1024))
+		return fmt.Errorf("upload: unexpected status code %d: %s", uploadResp.StatusCode, body)
+	}
+
+	return nil
+}
+
+func findVersion(flavor *chunkv1alpha1.Flavor, hash string) *chunkv1alpha1.FlavorVersion {
+	for _, v := range flavor.GetVersions() {
+		if v.GetHash() == hash {
+			return v
+		}
+	}
+	return nil
+}
+
+// serverRelPath returns the path of the file relative to the
+// flavor directory, which is the path on the server.
+func serverRelPath(dir string, path string) string {
+	return strings.TrimPrefix(filepath.ToSlash(path), filepath.ToSlash(filepath.Clean(dir))+"/")
+}
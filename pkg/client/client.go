@@ -0,0 +1,246 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package client provides a client for the control plane api, wrapping the
+// generated grpc clients with high-level helpers for common workflows, like
+// publishing flavors or running instances and waiting for them to be ready.
+// Rpcs failing with UNAVAILABLE are retried and the api token is attached to
+// every request.
+//
+// The functions and types exported by this package are considered stable.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"time"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
+	"github.com/spacechunks/explorer/internal/grpcclient"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// DefaultPollInterval is the interval in which the state of builds and
+// instances is checked while waiting for them.
+const DefaultPollInterval = 2 * time.Second
+
+// pageSize is the maximum page size allowed by the control plane.
+const pageSize = 100
+
+// TokenSource returns the api token requests are authenticated with.
+// it is called for every request, so implementations can refresh
+// expired tokens.
+type TokenSource interface {
+	APIToken(ctx context.Context) (string, error)
+}
+
+// StaticToken is a [TokenSource] that always returns the same token.
+type StaticToken string
+
+func (t StaticToken) APIToken(_ context.Context) (string, error) {
+	return string(t), nil
+}
+
+type Option func(*options)
+
+type options struct {
+	tokens       TokenSource
+	creds        credentials.TransportCredentials
+	maxAttempts  int
+	pollInterval time.Duration
+	logger       *slog.Logger
+}
+
+// WithToken authenticates all requests using the given api token.
+func WithToken(token string) Option {
+	return WithTokenSource(StaticToken(token))
+}
+
+// WithTokenSource authenticates all requests using the
+// tokens returned by the given source.
+func WithTokenSource(src TokenSource) Option {
+	return func(o *options) {
+		o.tokens = src
+	}
+}
+
+// WithTransportCredentials sets the credentials used to secure the connection.
+// by default tls is used and the certificate of the server is verified using
+// the system roots.
+func WithTransportCredentials(creds credentials.TransportCredentials) Option {
+	return func(o *options) {
+		o.creds = creds
+	}
+}
+
+// WithMaxAttempts sets the maximum number of attempts for an rpc including
+// the original one. grpc caps this at 5. 1 disables retries.
+func WithMaxAttempts(n int) Option {
+	return func(o *options) {
+		o.maxAttempts = n
+	}
+}
+
+// WithPollInterval sets the interval in which the state of builds and
+// instances is checked while waiting for them. defaults to DefaultPollInterval.
+func WithPollInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.pollInterval = d
+	}
+}
+
+// WithLogger sets the logger used to report progress.
+// by default all logs are discarded.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// Client talks to the control plane. the generated clients are
+// exposed for rpcs that do not have a high-level helper.
+type Client struct {
+	Chunk    chunkv1alpha1.ChunkServiceClient
+	Instance instancev1alpha1.InstanceServiceClient
+	User     userv1alpha1.UserServiceClient
+
+	conn         *grpc.ClientConn
+	pollInterval time.Duration
+	logger       *slog.Logger
+}
+
+// New creates a client for the control plane listening on the given
+// address. no connection is established until the first request.
+func New(addr string, opts ...Option) (*Client, error) {
+	o := options{
+		creds:        credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12}),
+		maxAttempts:  grpcclient.DefaultConfig.MaxAttempts,
+		pollInterval: DefaultPollInterval,
+		logger:       slog.New(slog.DiscardHandler),
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	cfg := grpcclient.DefaultConfig
+	cfg.MaxAttempts = o.maxAttempts
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(o.creds),
+	}
+
+	if o.tokens != nil {
+		dialOpts = append(dialOpts,
+			grpc.WithUnaryInterceptor(unaryAuthInterceptor(o.tokens)),
+			grpc.WithStreamInterceptor(streamAuthInterceptor(o.tokens)),
+		)
+	}
+
+	conn, err := grpcclient.New(addr, cfg, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("create grpc client: %w", err)
+	}
+
+	return &Client{
+		Chunk:        chunkv1alpha1.NewChunkServiceClient(conn),
+		Instance:     instancev1alpha1.NewInstanceServiceClient(conn),
+		User:         userv1alpha1.NewUserServiceClient(conn),
+		conn:         conn,
+		pollInterval: o.pollInterval,
+		logger:       o.logger,
+	}, nil
+}
+
+// NewFromConn creates a client using an existing connection, which is not
+// closed by Close. options configuring the connection, like the token source
+// or the transport credentials, are ignored.
+func NewFromConn(conn grpc.ClientConnInterface, opts ...Option) *Client {
+	o := options{
+		pollInterval: DefaultPollInterval,
+		logger:       slog.New(slog.DiscardHandler),
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Client{
+		Chunk:        chunkv1alpha1.NewChunkServiceClient(conn),
+		Instance:     instancev1alpha1.NewInstanceServiceClient(conn),
+		User:         userv1alpha1.NewUserServiceClient(conn),
+		pollInterval: o.pollInterval,
+		logger:       o.logger,
+	}
+}
+
+// Close closes the underlying connection.
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+func unaryAuthInterceptor(tokens TokenSource) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		ctx, err := withToken(ctx, tokens)
+		if err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func streamAuthInterceptor(tokens TokenSource) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		ctx, err := withToken(ctx, tokens)
+		if err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func withToken(ctx context.Context, tokens TokenSource) (context.Context, error) {
+	tok, err := tokens.APIToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("api token: %w", err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", tok), nil
+}
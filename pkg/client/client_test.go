@@ -0,0 +1,540 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package client_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/tarhelper"
+	"github.com/spacechunks/explorer/pkg/client"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeInstanceServer struct {
+	instancev1alpha1.UnimplementedInstanceServiceServer
+	instances []*instancev1alpha1.Instance
+	states    []instancev1alpha1.InstanceState
+	tokens    []string
+	mu        sync.Mutex
+}
+
+func (s *fakeInstanceServer) ListInstances(
+	ctx context.Context,
+	req *instancev1alpha1.ListInstancesRequest,
+) (*instancev1alpha1.ListInstancesResponse, error) {
+	s.recordToken(ctx)
+
+	// serve one instance per page to exercise pagination
+	idx := 0
+	for i, ins := range s.instances {
+		if ins.GetId() == req.GetPageToken() {
+			idx = i + 1
+		}
+	}
+
+	if idx >= len(s.instances) {
+		return &instancev1alpha1.ListInstancesResponse{}, nil
+	}
+
+	resp := &instancev1alpha1.ListInstancesResponse{
+		Instances: []*instancev1alpha1.Instance{s.instances[idx]},
+	}
+
+	if idx+1 < len(s.instances) {
+		resp.NextPageToken = s.instances[idx].GetId()
+	}
+
+	return resp, nil
+}
+
+func (s *fakeInstanceServer) RunFlavorVersion(
+	_ context.Context,
+	req *instancev1alpha1.RunFlavorVersionRequest,
+) (*instancev1alpha1.RunFlavorVersionResponse, error) {
+	return &instancev1alpha1.RunFlavorVersionResponse{
+		Instance: &instancev1alpha1.Instance{
+			Id: "instance-" + req.GetFlavorVersionId(),
+		},
+	}, nil
+}
+
+func (s *fakeInstanceServer) GetInstance(
+	_ context.Context,
+	req *instancev1alpha1.GetInstanceRequest,
+) (*instancev1alpha1.GetInstanceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.states[0]
+	if len(s.states) > 1 {
+		s.states = s.states[1:]
+	}
+
+	return &instancev1alpha1.GetInstanceResponse{
+		Instance: &instancev1alpha1.Instance{
+			Id:    req.GetId(),
+			State: state,
+		},
+	}, nil
+}
+
+func (s *fakeInstanceServer) recordToken(ctx context.Context) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = append(s.tokens, md.Get("authorization")...)
+}
+
+type fakeChunkServer struct {
+	chunkv1alpha1.UnimplementedChunkServiceServer
+	uploadURL   string
+	downloadURL string
+	chunk       *chunkv1alpha1.Chunk
+	built       []string
+	mu          sync.Mutex
+}
+
+func (s *fakeChunkServer) GetChunk(
+	_ context.Context,
+	_ *chunkv1alpha1.GetChunkRequest,
+) (*chunkv1alpha1.GetChunkResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &chunkv1alpha1.GetChunkResponse{Chunk: s.chunk}, nil
+}
+
+func (s *fakeChunkServer) GetFlavor(
+	_ context.Context,
+	req *chunkv1alpha1.GetFlavorRequest,
+) (*chunkv1alpha1.GetFlavorResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, f := range s.chunk.GetFlavors() {
+		if f.GetId() != req.GetId() {
+			continue
+		}
+
+		// pretend the build finishes as soon as it is being looked at
+		for _, v := range f.GetVersions() {
+			for _, id := range s.built {
+				if v.GetId() == id {
+					v.BuildStatus = chunkv1alpha1.BuildStatus_COMPLETED
+				}
+			}
+		}
+
+		return &chunkv1alpha1.GetFlavorResponse{Flavor: f}, nil
+	}
+
+	return &chunkv1alpha1.GetFlavorResponse{}, nil
+}
+
+func (s *fakeChunkServer) CreateFlavor(
+	_ context.Context,
+	req *chunkv1alpha1.CreateFlavorRequest,
+) (*chunkv1alpha1.CreateFlavorResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := &chunkv1alpha1.Flavor{
+		Id:   "flavor-" + req.GetName(),
+		Name: req.GetName(),
+	}
+
+	s.chunk.Flavors = append(s.chunk.Flavors, f)
+	return &chunkv1alpha1.CreateFlavorResponse{Flavor: f}, nil
+}
+
+func (s *fakeChunkServer) PrecheckFlavorVersion(
+	_ context.Context,
+	_ *chunkv1alpha1.PrecheckFlavorVersionRequest,
+) (*chunkv1alpha1.PrecheckFlavorVersionResponse, error) {
+	return &chunkv1alpha1.PrecheckFlavorVersionResponse{}, nil
+}
+
+func (s *fakeChunkServer) CreateFlavorVersion(
+	_ context.Context,
+	req *chunkv1alpha1.CreateFlavorVersionRequest,
+) (*chunkv1alpha1.CreateFlavorVersionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v := &chunkv1alpha1.FlavorVersion{
+		Id:         "version-" + req.GetVersion(),
+		Version:    req.GetVersion(),
+		Hash:       req.GetHash(),
+		FileHashes: req.GetFileHashes(),
+	}
+
+	for _, f := range s.chunk.GetFlavors() {
+		if f.GetId() == req.GetFlavorId() {
+			f.Versions = append([]*chunkv1alpha1.FlavorVersion{v}, f.Versions...)
+		}
+	}
+
+	return &chunkv1alpha1.CreateFlavorVersionResponse{Version: v}, nil
+}
+
+func (s *fakeChunkServer) GetUploadURL(
+	_ context.Context,
+	_ *chunkv1alpha1.GetUploadURLRequest,
+) (*chunkv1alpha1.GetUploadURLResponse, error) {
+	return &chunkv1alpha1.GetUploadURLResponse{Url: s.uploadURL}, nil
+}
+
+func (s *fakeChunkServer) BuildFlavorVersion(
+	_ context.Context,
+	req *chunkv1alpha1.BuildFlavorVersionRequest,
+) (*chunkv1alpha1.BuildFlavorVersionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.built = append(s.built, req.GetFlavorVersionId())
+	return &chunkv1alpha1.BuildFlavorVersionResponse{}, nil
+}
+
+func (s *fakeChunkServer) GetFlavorVersionFile(
+	_ context.Context,
+	req *chunkv1alpha1.GetFlavorVersionFileRequest,
+) (*chunkv1alpha1.GetFlavorVersionFileResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, f := range s.chunk.GetFlavors() {
+		for _, v := range f.GetVersions() {
+			if v.GetId() != req.GetFlavorVersionId() {
+				continue
+			}
+
+			for _, fh := range v.GetFileHashes() {
+				if fh.GetPath() == req.GetPath() {
+					return &chunkv1alpha1.GetFlavorVersionFileResponse{
+						File:        fh,
+						DownloadUrl: s.downloadURL + "/" + fh.GetPath(),
+					}, nil
+				}
+			}
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, "file not found")
+}
+
+func setup(
+	t *testing.T,
+	chunkSrv *fakeChunkServer,
+	insSrv *fakeInstanceServer,
+) *client.Client {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	chunkv1alpha1.RegisterChunkServiceServer(srv, chunkSrv)
+	instancev1alpha1.RegisterInstanceServiceServer(srv, insSrv)
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	t.Cleanup(srv.Stop)
+
+	c, err := client.New(
+		lis.Addr().String(),
+		client.WithToken("some-token"),
+		client.WithTransportCredentials(insecure.NewCredentials()),
+		client.WithPollInterval(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = c.Close()
+	})
+
+	return c
+}
+
+func TestInstancesPaginatesAndAuthenticates(t *testing.T) {
+	insSrv := &fakeInstanceServer{
+		instances: []*instancev1alpha1.Instance{
+			{Id: "a"},
+			{Id: "b"},
+			{Id: "c"},
+		},
+	}
+
+	c := setup(t, &fakeChunkServer{}, insSrv)
+
+	var ids []string
+	for ins, err := range c.Instances(t.Context()) {
+		require.NoError(t, err)
+		ids = append(ids, ins.GetId())
+	}
+
+	require.Equal(t, []string{"a", "b", "c"}, ids)
+	require.Equal(t, []string{"some-token", "some-token", "some-token"}, insSrv.tokens)
+}
+
+func TestRunAndWait(t *testing.T) {
+	tests := []struct {
+		name   string
+		states []instancev1alpha1.InstanceState
+		err    error
+	}{
+		{
+			name: "instance becomes running",
+			states: []instancev1alpha1.InstanceState{
+				instancev1alpha1.InstanceState_PENDING,
+				instancev1alpha1.InstanceState_CREATING,
+				instancev1alpha1.InstanceState_RUNNING,
+			},
+		},
+		{
+			name: "instance creation failed",
+			states: []instancev1alpha1.InstanceState{
+				instancev1alpha1.InstanceState_PENDING,
+				instancev1alpha1.InstanceState_CREATION_FAILED,
+			},
+			err: client.ErrInstanceFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunkSrv := &fakeChunkServer{
+				chunk: &chunkv1alpha1.Chunk{
+					Id: "chunk",
+					Flavors: []*chunkv1alpha1.Flavor{
+						{
+							Id:   "flavor",
+							Name: "vanilla",
+							Versions: []*chunkv1alpha1.FlavorVersion{
+								{
+									Id:          "v3",
+									BuildStatus: chunkv1alpha1.BuildStatus_IMAGE_BUILD,
+								},
+								{
+									Id:          "v2",
+									BuildStatus: chunkv1alpha1.BuildStatus_COMPLETED,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			c := setup(t, chunkSrv, &fakeInstanceServer{states: tt.states})
+
+			ins, err := c.RunAndWait(t.Context(), "chunk", "vanilla", nil)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "instance-v2", ins.GetId())
+			require.Equal(t, instancev1alpha1.InstanceState_RUNNING, ins.GetState())
+		})
+	}
+}
+
+func TestPublishFlavorUploadsChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "plugins"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "server.properties"), []byte("motd=hi"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plugins", "a.jar"), []byte("a"), 0o644))
+
+	var (
+		uploaded []string
+		mu       sync.Mutex
+	)
+
+	upload := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		files, err := tarhelper.Untar(r.Body, t.TempDir())
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		uploaded = files
+	}))
+	defer upload.Close()
+
+	chunkSrv := &fakeChunkServer{
+		uploadURL: upload.URL,
+		chunk: &chunkv1alpha1.Chunk{
+			Id: "chunk",
+		},
+	}
+
+	c := setup(t, chunkSrv, &fakeInstanceServer{})
+
+	spec := client.FlavorSpec{
+		Name:             "vanilla",
+		Version:          "v1",
+		MinecraftVersion: "1.21.4",
+	}
+
+	v1, err := c.PublishFlavor(t.Context(), "chunk", dir, spec)
+	require.NoError(t, err)
+	require.Equal(t, chunkv1alpha1.BuildStatus_COMPLETED, v1.GetBuildStatus())
+	require.Len(t, uploaded, 2)
+
+	// publishing the same files again must not create a new version
+	again, err := c.PublishFlavor(t.Context(), "chunk", dir, spec)
+	require.NoError(t, err)
+	require.Equal(t, v1.GetId(), again.GetId())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plugins", "a.jar"), []byte("b"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plugins", "c.jar"), []byte("c"), 0o644))
+
+	spec.Version = "v2"
+	v2, err := c.PublishFlavor(t.Context(), "chunk", dir, spec)
+	require.NoError(t, err)
+	require.Equal(t, "version-v2", v2.GetId())
+
+	rel := make([]string, 0, len(uploaded))
+	for _, f := range uploaded {
+		rel = append(rel, filepath.Base(f))
+	}
+	sort.Strings(rel)
+
+	require.Equal(t, []string{"a.jar", "c.jar"}, rel)
+}
+
+func TestDiffFlavor(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "plugins"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "server.properties"), []byte("motd=hi\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plugins", "a.jar"), []byte("a"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plugins", "b.jar"), []byte("b"), 0o644))
+
+	upload := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer upload.Close()
+
+	chunkSrv := &fakeChunkServer{
+		uploadURL: upload.URL,
+		chunk: &chunkv1alpha1.Chunk{
+			Id: "chunk",
+		},
+	}
+
+	c := setup(t, chunkSrv, &fakeInstanceServer{})
+
+	diff, err := c.DiffFlavor(t.Context(), "chunk", dir, "vanilla")
+	require.NoError(t, err)
+	require.Nil(t, diff.Remote)
+	require.Len(t, diff.Changes, 3)
+
+	_, err = c.PublishFlavor(t.Context(), "chunk", dir, client.FlavorSpec{
+		Name:             "vanilla",
+		Version:          "v1",
+		MinecraftVersion: "1.21.4",
+	})
+	require.NoError(t, err)
+
+	diff, err = c.DiffFlavor(t.Context(), "chunk", dir, "vanilla")
+	require.NoError(t, err)
+	require.Equal(t, "version-v1", diff.Remote.GetId())
+	require.Equal(t, diff.Remote.GetHash(), diff.LocalHash)
+	require.Empty(t, diff.Changes)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "server.properties"), []byte("motd=hello\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plugins", "c.jar"), []byte("ccc"), 0o644))
+	require.NoError(t, os.Remove(filepath.Join(dir, "plugins", "b.jar")))
+
+	diff, err = c.DiffFlavor(t.Context(), "chunk", dir, "vanilla")
+	require.NoError(t, err)
+
+	for i := range diff.Changes {
+		diff.Changes[i].LocalPath = ""
+	}
+
+	require.Equal(t, []client.FileChange{
+		{Kind: client.ChangeRemoved, Path: "plugins/b.jar", RemoteSizeBytes: 1},
+		{Kind: client.ChangeAdded, Path: "plugins/c.jar", LocalSizeBytes: 3},
+		{Kind: client.ChangeChanged, Path: "server.properties", LocalSizeBytes: 11, RemoteSizeBytes: 8},
+	}, diff.Changes)
+	require.Equal(t, int64(3), diff.Changes[2].SizeDelta())
+}
+
+func TestFlavorVersionFile(t *testing.T) {
+	var (
+		content = []byte("motd=hi\n")
+		files   = t.TempDir()
+	)
+
+	require.NoError(t, os.WriteFile(filepath.Join(files, "server.properties"), content, 0o644))
+
+	download := httptest.NewServer(http.FileServer(http.Dir(files)))
+	defer download.Close()
+
+	chunkSrv := &fakeChunkServer{
+		downloadURL: download.URL,
+		chunk: &chunkv1alpha1.Chunk{
+			Id: "chunk",
+			Flavors: []*chunkv1alpha1.Flavor{
+				{
+					Id: "flavor",
+					Versions: []*chunkv1alpha1.FlavorVersion{
+						{
+							Id: "version",
+							FileHashes: []*chunkv1alpha1.FileHashes{
+								{Path: "server.properties", Hash: "a", SizeBytes: uint64(len(content))},
+								// size is unknown for versions created before sizes were recorded
+								{Path: "unknown-size", Hash: "b"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(files, "unknown-size"), content, 0o644))
+
+	c := setup(t, chunkSrv, &fakeInstanceServer{})
+
+	data, err := c.FlavorVersionFile(t.Context(), "version", "server.properties", 1024)
+	require.NoError(t, err)
+	require.Equal(t, content, data)
+
+	_, err = c.FlavorVersionFile(t.Context(), "version", "server.properties", 4)
+	require.ErrorIs(t, err, client.ErrFileTooLarge)
+
+	_, err = c.FlavorVersionFile(t.Context(), "version", "unknown-size", 4)
+	require.ErrorIs(t, err, client.ErrFileTooLarge)
+}
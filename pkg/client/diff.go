@@ -0,0 +1,286 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	"github.com/spacechunks/explorer/internal/file"
+)
+
+// ChangeKind tells how a file differs from the remote version.
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeChanged ChangeKind = "changed"
+	ChangeRemoved ChangeKind = "removed"
+)
+
+// FileChange is a file that differs between the local files of
+// a flavor and the latest version of the flavor.
+type FileChange struct {
+	Kind ChangeKind
+
+	// Path is the path of the file relative to the flavor directory
+	// using forward slashes, which is the path on the server.
+	Path string
+
+	// LocalPath is the path of the file on disk. it is empty for removed files.
+	LocalPath string
+
+	LocalSizeBytes uint64
+
+	// RemoteSizeBytes is 0 for added files and files of versions
+	// created before the control plane recorded file sizes.
+	RemoteSizeBytes uint64
+}
+
+// SizeDelta returns by how many bytes the file grew compared to the remote version.
+func (c FileChange) SizeDelta() int64 {
+	return int64(c.LocalSizeBytes) - int64(c.RemoteSizeBytes)
+}
+
+// FlavorDiff is the difference between the local files of a flavor and the
+// latest version of the flavor. publishing the local files creates a
+// version containing exactly these changes.
+type FlavorDiff struct {
+	Flavor string
+
+	// Remote is the latest version of the flavor. it is nil if the flavor
+	// does not exist or has no versions, all files are added in this case.
+	Remote *chunkv1alpha1.FlavorVersion
+
+	// LocalHash is the hash of the local files. it equals the hash of
+	// the remote version, if there are no changes.
+	LocalHash string
+
+	// Changes are sorted by path.
+	Changes []FileChange
+}
+
+// DiffFlavor compares the files in dir with the files of the latest version
+// of the flavor with the given name, without creating or uploading anything.
+// the chunk does not need to have a flavor with the given name.
+func (c *Client) DiffFlavor(ctx context.Context, chunkID string, dir string, flavorName string) (*FlavorDiff, error) {
+	hash, files, err := file.HashDir(c.logger, dir)
+	if err != nil {
+		return nil, fmt.Errorf("hash files: %w", err)
+	}
+
+	d := &FlavorDiff{
+		Flavor:    flavorName,
+		LocalHash: hash,
+	}
+
+	if chunkID != "" {
+		resp, err := c.Chunk.GetChunk(ctx, &chunkv1alpha1.GetChunkRequest{
+			Id: chunkID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get chunk: %w", err)
+		}
+
+		for _, f := range resp.GetChunk().GetFlavors() {
+			// versions are ordered from latest to oldest
+			if f.GetName() == flavorName && len(f.GetVersions()) > 0 {
+				d.Remote = f.GetVersions()[0]
+			}
+		}
+	}
+
+	d.Changes = diffFiles(dir, files, d.Remote.GetFileHashes())
+	return d, nil
+}
+
+func diffFiles(dir string, local []file.Hash, remote []*chunkv1alpha1.FileHashes) []FileChange {
+	var (
+		remoteMap = make(map[string]*chunkv1alpha1.FileHashes, len(remote))
+		seen      = make(map[string]bool, len(local))
+		changes   = make([]FileChange, 0)
+	)
+
+	for _, fh := range remote {
+		remoteMap[fh.GetPath()] = fh
+	}
+
+	for _, fh := range local {
+		path := serverRelPath(dir, fh.Path)
+		seen[path] = true
+
+		r, ok := remoteMap[path]
+		if ok && r.GetHash() == fh.Hash {
+			continue
+		}
+
+		change := FileChange{
+			Kind:           ChangeAdded,
+			Path:           path,
+			LocalPath:      fh.Path,
+			LocalSizeBytes: fh.SizeBytes,
+		}
+
+		if ok {
+			change.Kind = ChangeChanged
+			change.RemoteSizeBytes = r.GetSizeBytes()
+		}
+
+		changes = append(changes, change)
+	}
+
+	for _, fh := range remote {
+		if seen[fh.GetPath()] {
+			continue
+		}
+
+		changes = append(changes, FileChange{
+			Kind:            ChangeRemoved,
+			Path:            fh.GetPath(),
+			RemoteSizeBytes: fh.GetSizeBytes(),
+		})
+	}
+
+	slices.SortFunc(changes, func(a, b FileChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return changes
+}
+
+// ErrFileTooLarge is returned by FlavorVersionFile if the
+// file is bigger than the maximum size requested by the caller.
+var ErrFileTooLarge = errors.New("file too large")
+
+// FlavorVersionFile downloads the content of the file with the given path
+// of a flavor version. files are only available once the flavor version has
+// been built. [ErrFileTooLarge] is returned if the file is bigger than
+// maxSizeBytes.
+func (c *Client) FlavorVersionFile(ctx context.Context, versionID string, path string, maxSizeBytes int64) ([]byte, error) {
+	resp, err := c.Chunk.GetFlavorVersionFile(ctx, &chunkv1alpha1.GetFlavorVersionFileRequest{
+		FlavorVersionId: versionID,
+		Path:            path,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get flavor version file: %w", err)
+	}
+
+	if size := resp.GetFile().GetSizeBytes(); size > uint64(maxSizeBytes) {
+		return nil, ErrFileTooLarge
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resp.GetDownloadUrl(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create download request: %w", err)
+	}
+
+	dlResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download: %w", err)
+	}
+	defer dlResp.Body.Close()
+
+	if dlResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download: unexpected status code %d", dlResp.StatusCode)
+	}
+
+	// the size is unknown for files of older versions, so
+	// read one more byte to detect files that are too large.
+	data, err := io.ReadAll(io.LimitReader(dlResp.Body, maxSizeBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	if int64(len(data)) > maxSizeBytes {
+		return nil, ErrFileTooLarge
+	}
+
+	return data, nil
+}
+
+// DownloadFlavorVersion writes all files of the flavor version to dir, keeping
+// their paths. like with FlavorVersionFile, the files are only available once
+// they have been built, either for this or another flavor version.
+func (c *Client) DownloadFlavorVersion(ctx context.Context, version *chunkv1alpha1.FlavorVersion, dir string) error {
+	for _, fh := range version.GetFileHashes() {
+		if !filepath.IsLocal(filepath.FromSlash(fh.GetPath())) {
+			return fmt.Errorf("%s: path is not local", fh.GetPath())
+		}
+
+		if err := c.downloadFile(
+			ctx,
+			version.GetId(),
+			fh.GetPath(),
+			filepath.Join(dir, filepath.FromSlash(fh.GetPath())),
+		); err != nil {
+			return fmt.Errorf("%s: %w", fh.GetPath(), err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) downloadFile(ctx context.Context, versionID string, path string, dest string) error {
+	resp, err := c.Chunk.GetFlavorVersionFile(ctx, &chunkv1alpha1.GetFlavorVersionFileRequest{
+		FlavorVersionId: versionID,
+		Path:            path,
+	})
+	if err != nil {
+		return fmt.Errorf("get flavor version file: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resp.GetDownloadUrl(), nil)
+	if err != nil {
+		return fmt.Errorf("create download request: %w", err)
+	}
+
+	dlResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("download: %w", err)
+	}
+	defer dlResp.Body.Close()
+
+	if dlResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download: unexpected status code %d", dlResp.StatusCode)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return fmt.Errorf("create directory: %w", err)
+	}
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+
+	if _, err := io.Copy(f, dlResp.Body); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write file: %w", err)
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,122 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+)
+
+// ErrInstanceFailed is returned if an instance that is waited
+// for failed to be created or has been removed.
+var ErrInstanceFailed = errors.New("instance failed")
+
+// Instances returns all instances, requesting further
+// pages from the control plane as needed.
+func (c *Client) Instances(ctx context.Context) iter.Seq2[*instancev1alpha1.Instance, error] {
+	return func(yield func(*instancev1alpha1.Instance, error) bool) {
+		var token string
+		for {
+			resp, err := c.Instance.ListInstances(ctx, &instancev1alpha1.ListInstancesRequest{
+				PageSize:  pageSize,
+				PageToken: token,
+			})
+			if err != nil {
+				yield(nil, fmt.Errorf("list instances: %w", err))
+				return
+			}
+
+			for _, ins := range resp.GetInstances() {
+				if !yield(ins, nil) {
+					return
+				}
+			}
+
+			if resp.GetNextPageToken() == "" {
+				return
+			}
+
+			token = resp.GetNextPageToken()
+		}
+	}
+}
+
+// RunAndWait runs the latest successfully built version of the given flavor
+// and waits until the instance is running. params are optional.
+func (c *Client) RunAndWait(
+	ctx context.Context,
+	chunkID string,
+	flavorName string,
+	params *instancev1alpha1.InstanceParams,
+) (*instancev1alpha1.Instance, error) {
+	version, err := c.latestBuiltVersion(ctx, chunkID, flavorName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Instance.RunFlavorVersion(ctx, &instancev1alpha1.RunFlavorVersionRequest{
+		FlavorVersionId: version.GetId(),
+		Params:          params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("run flavor version: %w", err)
+	}
+
+	return c.WaitForInstance(ctx, resp.GetInstance().GetId())
+}
+
+// WaitForInstance waits until the given instance is running. [ErrInstanceFailed]
+// is returned if the instance could not be created or is being removed.
+func (c *Client) WaitForInstance(ctx context.Context, id string) (*instancev1alpha1.Instance, error) {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.Instance.GetInstance(ctx, &instancev1alpha1.GetInstanceRequest{
+			Id: id,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get instance: %w", err)
+		}
+
+		ins := resp.GetInstance()
+
+		switch ins.GetState() {
+		case instancev1alpha1.InstanceState_RUNNING:
+			return ins, nil
+		case instancev1alpha1.InstanceState_CREATION_FAILED,
+			instancev1alpha1.InstanceState_DELETING,
+			instancev1alpha1.InstanceState_DELETED:
+			return nil, fmt.Errorf("%w: instance is %s", ErrInstanceFailed, ins.GetState())
+		}
+
+		c.logger.DebugContext(ctx, "waiting for instance", "instance_id", id, "state", ins.GetState())
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
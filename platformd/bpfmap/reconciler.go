@@ -0,0 +1,116 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package bpfmap removes entries of bpf maps that belong to
+// workloads whose veth pair does not exist anymore.
+package bpfmap
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spacechunks/explorer/internal/datapath"
+)
+
+type Maps interface {
+	WorkloadMapEntries() ([]datapath.MapEntry, error)
+	DelMapEntry(e datapath.MapEntry) error
+}
+
+// InterfaceExists reports whether a network interface with the given index exists.
+func InterfaceExists(index int) bool {
+	_, err := net.InterfaceByIndex(index)
+	return err == nil
+}
+
+// Reconciler removes map entries left behind, because the cni plugin failed
+// to remove them when deleting the workload. entries of the net data and veth
+// pair maps are stale once the host peer of their veth pair does not exist.
+// dnat targets are stale once no net data entry exists for their port.
+//
+// the cni plugin adds the entries of a workload one after another, so an
+// entry is only removed if it has been stale in two consecutive runs.
+type Reconciler struct {
+	logger      *slog.Logger
+	maps        Maps
+	ifaceExists func(index int) bool
+
+	// stale contains the entries that have been stale in the previous run.
+	stale map[datapath.MapEntry]bool
+}
+
+func NewReconciler(logger *slog.Logger, maps Maps, ifaceExists func(index int) bool) *Reconciler {
+	return &Reconciler{
+		logger:      logger,
+		maps:        maps,
+		ifaceExists: ifaceExists,
+		stale:       make(map[datapath.MapEntry]bool),
+	}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context) error {
+	entries, err := r.maps.WorkloadMapEntries()
+	if err != nil {
+		return fmt.Errorf("map entries: %w", err)
+	}
+
+	livePorts := make(map[uint16]bool)
+	for _, e := range entries {
+		if e.Map == datapath.MapNetData && r.ifaceExists(int(e.HostIfIndex)) {
+			livePorts[e.HostPort] = true
+		}
+	}
+
+	var (
+		stale = make(map[datapath.MapEntry]bool)
+		errs  *multierror.Error
+	)
+
+	for _, e := range entries {
+		if !r.isStale(e, livePorts) {
+			continue
+		}
+
+		if !r.stale[e] {
+			stale[e] = true
+			continue
+		}
+
+		if err := r.maps.DelMapEntry(e); err != nil {
+			stale[e] = true
+			errs = multierror.Append(errs, fmt.Errorf("delete %s entry %d: %w", e.Map, e.Key, err))
+			continue
+		}
+
+		r.logger.InfoContext(ctx, "removed stale map entry", "map", e.Map, "key", e.Key)
+	}
+
+	r.stale = stale
+
+	return errs.ErrorOrNil()
+}
+
+func (r *Reconciler) isStale(e datapath.MapEntry, livePorts map[uint16]bool) bool {
+	if e.Map == datapath.MapDNATTargets {
+		return !livePorts[e.HostPort]
+	}
+	return !r.ifaceExists(int(e.HostIfIndex))
+}
@@ -0,0 +1,71 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package bpfmap_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/datapath"
+	"github.com/spacechunks/explorer/platformd/bpfmap"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMaps struct {
+	entries []datapath.MapEntry
+	deleted []datapath.MapEntry
+}
+
+func (m *fakeMaps) WorkloadMapEntries() ([]datapath.MapEntry, error) {
+	return m.entries, nil
+}
+
+func (m *fakeMaps) DelMapEntry(e datapath.MapEntry) error {
+	m.deleted = append(m.deleted, e)
+	return nil
+}
+
+func TestReconcile(t *testing.T) {
+	var (
+		live = []datapath.MapEntry{
+			{Map: datapath.MapDNATTargets, Key: 30000, HostPort: 30000},
+			{Map: datapath.MapNetData, Key: 30000, HostIfIndex: 10, HostPort: 30000},
+			{Map: datapath.MapVethPairs, Key: 10, HostIfIndex: 10},
+		}
+		stale = []datapath.MapEntry{
+			{Map: datapath.MapDNATTargets, Key: 30001, HostPort: 30001},
+			{Map: datapath.MapNetData, Key: 30001, HostIfIndex: 11, HostPort: 30001},
+			{Map: datapath.MapVethPairs, Key: 11, HostIfIndex: 11},
+		}
+		maps = &fakeMaps{
+			entries: append(live, stale...),
+		}
+		r = bpfmap.NewReconciler(slog.New(slog.DiscardHandler), maps, func(index int) bool {
+			return index == 10
+		})
+	)
+
+	// stale entries are only removed in the second run
+	require.NoError(t, r.Reconcile(context.Background()))
+	require.Empty(t, maps.deleted)
+
+	require.NoError(t, r.Reconcile(context.Background()))
+	require.ElementsMatch(t, stale, maps.deleted)
+}
@@ -26,6 +26,8 @@ type Config struct {
 	CPUPeriod                int64
 	CPUQuota                 int64
 	MemoryLimitBytes         int64
+	MaxCPUQuota              int64
+	MaxMemoryLimitBytes      int64
 	CheckpointFileDir        string
 	CheckpointTimeoutSeconds int64
 	RegistryUser             string
@@ -33,4 +35,15 @@ type Config struct {
 	ListenAddr               string
 	StatusRetentionPeriod    time.Duration
 	ContainerReadyTimeout    time.Duration
+
+	// RestoreVerifyTimeout is the maximum time to wait until a restored
+	// checkpoint responds to a server list ping, if verification has
+	// been requested.
+	RestoreVerifyTimeout time.Duration
+
+	// MaxDiskUsageBytes limits the disk space used by files in the
+	// CheckpointFileDir. once exceeded, the least recently modified
+	// files are removed and new checkpoints are rejected if that is
+	// not enough. zero disables the limit.
+	MaxDiskUsageBytes uint64
 }
@@ -0,0 +1,193 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/spacechunks/explorer/platformd/status"
+)
+
+var ErrDiskQuotaExceeded = errors.New("checkpoint files exceed the disk quota of the node")
+
+// artifact is a file or directory in the checkpoint file dir.
+type artifact struct {
+	name    string
+	size    uint64
+	modTime time.Time
+}
+
+// DiskUsage returns the disk space used by files in the checkpoint file dir.
+func (s *ServiceImpl) DiskUsage() (status.DiskUsage, error) {
+	artifacts, err := readArtifacts(s.cfg.CheckpointFileDir)
+	if err != nil {
+		return status.DiskUsage{}, err
+	}
+
+	return status.DiskUsage{
+		UsedBytes: totalSize(artifacts),
+		MaxBytes:  s.cfg.MaxDiskUsageBytes,
+	}, nil
+}
+
+// admit makes sure there is disk space left for another checkpoint. if the
+// quota is exceeded, old artifacts are evicted first. ErrDiskQuotaExceeded
+// is returned if there is still no space left afterward.
+func (s *ServiceImpl) admit(ctx context.Context) error {
+	if s.cfg.MaxDiskUsageBytes == 0 {
+		return nil
+	}
+
+	used, err := s.evict(ctx)
+	if err != nil {
+		return fmt.Errorf("evict: %w", err)
+	}
+
+	if used >= s.cfg.MaxDiskUsageBytes {
+		s.metrics.rejectedCount.Add(ctx, 1)
+		return ErrDiskQuotaExceeded
+	}
+
+	return nil
+}
+
+// evict removes the least recently modified artifacts until the disk usage
+// is below the quota. artifacts of running checkpoint jobs are never removed.
+// returns the disk usage after evicting.
+func (s *ServiceImpl) evict(ctx context.Context) (uint64, error) {
+	artifacts, err := readArtifacts(s.cfg.CheckpointFileDir)
+	if err != nil {
+		return 0, err
+	}
+
+	used := totalSize(artifacts)
+	if s.cfg.MaxDiskUsageBytes == 0 || used < s.cfg.MaxDiskUsageBytes {
+		return used, nil
+	}
+
+	slices.SortFunc(artifacts, func(a, b artifact) int {
+		return a.modTime.Compare(b.modTime)
+	})
+
+	running := s.runningCheckpoints()
+
+	for _, a := range artifacts {
+		if used < s.cfg.MaxDiskUsageBytes {
+			break
+		}
+
+		if running[a.name] {
+			continue
+		}
+
+		p := filepath.Join(s.cfg.CheckpointFileDir, a.name)
+		if err := os.RemoveAll(p); err != nil {
+			return 0, fmt.Errorf("remove %s: %w", p, err)
+		}
+
+		used -= a.size
+
+		s.logger.InfoContext(
+			ctx,
+			"evicted checkpoint artifact",
+			"path", p,
+			"size_bytes", a.size,
+			"used_bytes", used,
+			"max_bytes", s.cfg.MaxDiskUsageBytes,
+		)
+	}
+
+	return used, nil
+}
+
+// runningCheckpoints returns the ids of all checkpoint jobs that are still running.
+func (s *ServiceImpl) runningCheckpoints() map[string]bool {
+	ret := make(map[string]bool)
+	for id, st := range s.statusStore.View() {
+		if st.CheckpointStatus != nil && st.CheckpointStatus.State == status.CheckpointStateRunning {
+			ret[id] = true
+		}
+	}
+	return ret
+}
+
+// readArtifacts returns all entries of dir. the size of directories is the size
+// of all files they contain, their modification time the latest one of them.
+func readArtifacts(dir string) ([]artifact, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read dir: %w", err)
+	}
+
+	ret := make([]artifact, 0, len(entries))
+	for _, e := range entries {
+		a := artifact{
+			name: e.Name(),
+		}
+
+		// files can be removed while walking, e.g. by the garbage collector.
+		if err := filepath.WalkDir(filepath.Join(dir, e.Name()), func(_ string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+
+			if !d.IsDir() {
+				a.size += uint64(info.Size())
+			}
+
+			if info.ModTime().After(a.modTime) {
+				a.modTime = info.ModTime()
+			}
+
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("walk %s: %w", e.Name(), err)
+		}
+
+		ret = append(ret, a)
+	}
+
+	return ret, nil
+}
+
+func totalSize(artifacts []artifact) uint64 {
+	var ret uint64
+	for _, a := range artifacts {
+		ret += a.size
+	}
+	return ret
+}
@@ -0,0 +1,180 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package checkpoint
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/platformd/status"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmit(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		maxBytes  uint64
+		files     map[string]time.Time
+		running   []string
+		remaining []string
+		err       error
+	}{
+		{
+			name:     "no limit configured",
+			maxBytes: 0,
+			files: map[string]time.Time{
+				"a": now.Add(-2 * time.Hour),
+				"b": now.Add(-1 * time.Hour),
+			},
+			remaining: []string{"a", "b"},
+		},
+		{
+			name:     "below limit",
+			maxBytes: 300,
+			files: map[string]time.Time{
+				"a": now.Add(-2 * time.Hour),
+				"b": now.Add(-1 * time.Hour),
+			},
+			remaining: []string{"a", "b"},
+		},
+		{
+			name:     "least recently modified artifacts are evicted",
+			maxBytes: 150,
+			files: map[string]time.Time{
+				"a": now.Add(-3 * time.Hour),
+				"b": now.Add(-2 * time.Hour),
+				"c": now.Add(-1 * time.Hour),
+			},
+			remaining: []string{"c"},
+		},
+		{
+			name:     "artifacts of running checkpoints are kept",
+			maxBytes: 150,
+			files: map[string]time.Time{
+				"a": now.Add(-3 * time.Hour),
+				"b": now.Add(-2 * time.Hour),
+				"c": now.Add(-1 * time.Hour),
+			},
+			running:   []string{"a"},
+			remaining: []string{"a"},
+		},
+		{
+			name:     "quota exceeded by running checkpoints",
+			maxBytes: 150,
+			files: map[string]time.Time{
+				"a": now.Add(-2 * time.Hour),
+				"b": now.Add(-1 * time.Hour),
+			},
+			running:   []string{"a", "b"},
+			remaining: []string{"a", "b"},
+			err:       ErrDiskQuotaExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx   = context.Background()
+				dir   = t.TempDir()
+				store = status.NewMemStore()
+			)
+
+			for name, modTime := range tt.files {
+				path := filepath.Join(dir, name)
+				require.NoError(t, os.WriteFile(path, make([]byte, 100), 0777))
+				require.NoError(t, os.Chtimes(path, modTime, modTime))
+			}
+
+			for _, id := range tt.running {
+				store.Update(id, status.Status{
+					CheckpointStatus: &status.CheckpointStatus{
+						State: status.CheckpointStateRunning,
+					},
+				})
+			}
+
+			svc, err := NewService(
+				slog.New(slog.DiscardHandler),
+				Config{
+					CheckpointFileDir: dir,
+					MaxDiskUsageBytes: tt.maxBytes,
+				},
+				nil,
+				nil,
+				store,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+
+			err = svc.admit(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+
+			remaining := make([]string, 0, len(entries))
+			for _, e := range entries {
+				remaining = append(remaining, e.Name())
+			}
+
+			require.ElementsMatch(t, tt.remaining, remaining)
+		})
+	}
+}
+
+func TestDiskUsage(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a"), make([]byte, 100), 0777))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "b"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b", "c"), make([]byte, 50), 0777))
+
+	svc, err := NewService(
+		slog.New(slog.DiscardHandler),
+		Config{
+			CheckpointFileDir: dir,
+			MaxDiskUsageBytes: 1000,
+		},
+		nil,
+		nil,
+		status.NewMemStore(),
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	usage, err := svc.DiskUsage()
+	require.NoError(t, err)
+	require.Equal(t, status.DiskUsage{
+		UsedBytes: 150,
+		MaxBytes:  1000,
+	}, usage)
+}
@@ -0,0 +1,77 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type metrics struct {
+	rejectedCount metric.Int64Counter
+}
+
+func initMetrics(s *ServiceImpl) (metrics, error) {
+	meter := otel.Meter("github.com/spacechunks/explorer/platformd/checkpoint")
+
+	_, err := meter.Int64ObservableGauge(
+		"explorer.platformd.checkpoint.disk_usage.bytes",
+		metric.WithDescription("Disk space used by files in the checkpoint file dir"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			usage, err := s.DiskUsage()
+			if err != nil {
+				// failing the callback would drop the other metrics as well
+				s.logger.WarnContext(ctx, "failed to read checkpoint disk usage", "err", err)
+				return nil
+			}
+			o.Observe(int64(usage.UsedBytes))
+			return nil
+		}),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("disk usage gauge: %w", err)
+	}
+
+	_, err = meter.Int64ObservableGauge(
+		"explorer.platformd.checkpoint.disk_limit.bytes",
+		metric.WithDescription("Disk space files in the checkpoint file dir may use, zero if not limited"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(int64(s.cfg.MaxDiskUsageBytes))
+			return nil
+		}),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("disk limit gauge: %w", err)
+	}
+
+	rejectedCount, err := meter.Int64Counter(
+		"explorer.platformd.checkpoint.rejected.count",
+		metric.WithDescription("Total number of checkpoints rejected, because the disk quota has been exceeded"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("rejected counter: %w", err)
+	}
+
+	return metrics{
+		rejectedCount: rejectedCount,
+	}, nil
+}
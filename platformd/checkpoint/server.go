@@ -20,6 +20,7 @@ package checkpoint
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/uuid"
@@ -48,8 +49,17 @@ func (s *Server) CreateCheckpoint(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid image url: %v", err)
 	}
 
-	id, err := s.service.CreateCheckpoint(context.Background(), ref)
+	id, err := s.service.CreateCheckpoint(context.Background(), ref, Resources{
+		CPUMillis:        req.GetResources().GetCpuMillis(),
+		MemoryLimitBytes: req.GetResources().GetMemoryLimitBytes(),
+	}, req.GetCommand(), req.GetReuseKey(), req.GetVerifyRestore())
 	if err != nil {
+		if errors.Is(err, ErrResourcesExceedMaximum) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, ErrDiskQuotaExceeded) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
 		return nil, err
 	}
 
@@ -72,7 +82,8 @@ func (s *Server) CheckpointStatus(
 				State: checkpointv1alpha1.CheckpointState(
 					checkpointv1alpha1.CheckpointState_value[string(s.CheckpointStatus.State)],
 				),
-				Message: s.CheckpointStatus.Message,
+				Message:          s.CheckpointStatus.Message,
+				RestoreLatencyMs: uint32(s.CheckpointStatus.RestoreLatency.Milliseconds()),
 			},
 		}, nil
 	}
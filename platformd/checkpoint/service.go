@@ -19,12 +19,16 @@
 package checkpoint
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"os"
+	"path"
 	"runtime"
+	"strconv"
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/name"
@@ -32,6 +36,7 @@ import (
 	"github.com/spacechunks/explorer/internal/datapath"
 	"github.com/spacechunks/explorer/internal/image"
 	"github.com/spacechunks/explorer/internal/ptr"
+	"github.com/spacechunks/explorer/internal/workloadenv"
 	"github.com/spacechunks/explorer/platformd/cri"
 	"github.com/spacechunks/explorer/platformd/status"
 	"github.com/spacechunks/explorer/platformd/workload"
@@ -43,8 +48,36 @@ const Namespace = "checkpoint"
 
 type RemoteCMDExecutorFactory func(url string) (remotecommand.Executor, error)
 
+// defaultCPUPeriod is the cfs period the container runtime
+// uses if no period has been configured.
+const defaultCPUPeriod = 100000
+
+// restorePingInterval is the time to wait between server list pings while
+// waiting for a restored checkpoint to respond, restorePingTimeout limits
+// the duration of a single ping.
+const (
+	restorePingInterval = 1 * time.Second
+	restorePingTimeout  = 5 * time.Second
+)
+
+var ErrResourcesExceedMaximum = errors.New("requested resources exceed the maximum")
+
+// Resources are requested overrides for the resources assigned to the
+// checkpoint container. zero values fall back to the configured defaults.
+type Resources struct {
+	CPUMillis        uint32
+	MemoryLimitBytes uint64
+}
+
 type Service interface {
-	CreateCheckpoint(ctx context.Context, baseRef name.Reference) (string, error)
+	CreateCheckpoint(
+		ctx context.Context,
+		baseRef name.Reference,
+		res Resources,
+		command []string,
+		reuseKey string,
+		verifyRestore bool,
+	) (string, error)
 	CheckpointStatus(checkpointID string) *status.Status
 }
 
@@ -56,9 +89,14 @@ type ServiceImpl struct {
 	statusStore status.Store
 	portAlloc   *workload.PortAllocator
 	sockHandler datapath.SockHandler
+	metrics     metrics
 
 	// this factory function allows us to inject a mock executor for testing.
 	newRemoteCMDExecutor RemoteCMDExecutorFactory
+
+	// serverListPing is replaced in tests, because restored
+	// servers are not reachable from there.
+	serverListPing func(ctx context.Context, addr string) (time.Duration, error)
 }
 
 func NewService(
@@ -70,8 +108,8 @@ func NewService(
 	newRemoteCMDExecutor RemoteCMDExecutorFactory,
 	portAlloc *workload.PortAllocator,
 	sockCleaner datapath.SockHandler,
-) *ServiceImpl {
-	return &ServiceImpl{
+) (*ServiceImpl, error) {
+	s := &ServiceImpl{
 		logger:               logger,
 		criService:           criService,
 		imgService:           imgService,
@@ -80,17 +118,44 @@ func NewService(
 		portAlloc:            portAlloc,
 		newRemoteCMDExecutor: newRemoteCMDExecutor,
 		sockHandler:          sockCleaner,
+		serverListPing:       workload.ServerListPing,
+	}
+
+	met, err := initMetrics(s)
+	if err != nil {
+		return nil, fmt.Errorf("init metrics: %w", err)
 	}
+
+	s.metrics = met
+	return s, nil
 }
 
-func (s *ServiceImpl) CreateCheckpoint(ctx context.Context, baseRef name.Reference) (string, error) {
+func (s *ServiceImpl) CreateCheckpoint(
+	ctx context.Context,
+	baseRef name.Reference,
+	res Resources,
+	command []string,
+	reuseKey string,
+	verifyRestore bool,
+) (string, error) {
+	limits, err := s.containerResources(res)
+	if err != nil {
+		return "", err
+	}
+
+	// checkpoint tarballs are as large as the memory of the server,
+	// so starting jobs while the disk is full would take the node down.
+	if err := s.admit(ctx); err != nil {
+		return "", err
+	}
+
 	id, err := uuid.NewV7()
 	if err != nil {
 		return "", fmt.Errorf("generate id: %w", err)
 	}
 
 	go func() {
-		if err := s.checkpoint(ctx, id.String(), baseRef); err != nil {
+		if err := s.checkpoint(ctx, id.String(), baseRef, limits, command, reuseKey, verifyRestore); err != nil {
 			s.logger.ErrorContext(ctx, "error creating checkpoint", "err", err, "checkpoint_id", id.String())
 		}
 	}()
@@ -166,13 +231,19 @@ func (s *ServiceImpl) CollectGarbage(ctx context.Context) error {
 		)
 	}
 
+	// files that could not be attributed to a checkpoint job, like
+	// directories, are only removed once the disk quota is exceeded.
+	if _, err := s.evict(ctx); err != nil {
+		return fmt.Errorf("evict: %w", err)
+	}
+
 	// clean up all zombie checkpoint pods
 
 	resp, err := s.criService.ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
 		Filter: &runtimev1.PodSandboxFilter{
-			LabelSelector: map[string]string{
-				workload.LabelWorkloadType: "checkpoint",
-			},
+			LabelSelector: workloadenv.Selector{
+				WorkloadType: workloadenv.WorkloadTypeCheckpoint,
+			}.LabelSelector(),
 		},
 	})
 	if err != nil {
@@ -210,9 +281,23 @@ func (s *ServiceImpl) CollectGarbage(ctx context.Context) error {
 	return nil
 }
 
-func (s *ServiceImpl) checkpoint(ctx context.Context, id string, baseRef name.Reference) (ret error) {
+func (s *ServiceImpl) checkpoint(
+	ctx context.Context,
+	id string,
+	baseRef name.Reference,
+	limits *runtimev1.LinuxContainerResources,
+	command []string,
+	reuseKey string,
+	verifyRestore bool,
+) (ret error) {
 	logger := s.logger.With("checkpoint", id, "baseRef", baseRef.String())
-	logger.InfoContext(ctx, "creating checkpoint")
+	logger.InfoContext(
+		ctx,
+		"creating checkpoint",
+		"cpu_period", limits.CpuPeriod,
+		"cpu_quota", limits.CpuQuota,
+		"memory_limit_bytes", limits.MemoryLimitInBytes,
+	)
 
 	s.statusStore.Update(id, status.Status{
 		CheckpointStatus: &status.CheckpointStatus{
@@ -220,7 +305,10 @@ func (s *ServiceImpl) checkpoint(ctx context.Context, id string, baseRef name.Re
 		},
 	})
 
-	state := status.CheckpointStateCompleted
+	var (
+		state          = status.CheckpointStateCompleted
+		restoreLatency time.Duration
+	)
 
 	defer func() {
 		var msg string
@@ -230,13 +318,53 @@ func (s *ServiceImpl) checkpoint(ctx context.Context, id string, baseRef name.Re
 
 		s.statusStore.Update(id, status.Status{
 			CheckpointStatus: &status.CheckpointStatus{
-				State:       state,
-				Message:     msg,
-				CompletedAt: ptr.Pointer(time.Now()),
+				State:          state,
+				Message:        msg,
+				CompletedAt:    ptr.Pointer(time.Now()),
+				RestoreLatency: restoreLatency,
 			},
 		})
 	}()
 
+	var (
+		checkpointRef = baseRef.Context().Tag("checkpoint").String()
+		reuseRef      string
+	)
+
+	// verify is called once the checkpoint image is available, regardless
+	// of whether it has been created or reused. a checkpoint that has been
+	// created on a different node is not guaranteed to be restorable here.
+	verify := func() error {
+		if !verifyRestore {
+			return nil
+		}
+
+		latency, err := s.verifyRestore(ctx, logger, baseRef, checkpointRef, limits)
+		if err != nil {
+			state = status.CheckpointStateVerifyRestoreFailed
+			return fmt.Errorf("verify restore: %w", err)
+		}
+
+		restoreLatency = latency
+		return nil
+	}
+
+	if reuseKey != "" {
+		reuseRef = reuseImageRef(baseRef, reuseKey)
+
+		err := s.imgService.Tag(ctx, reuseRef, checkpointRef)
+		if err == nil {
+			logger.InfoContext(ctx, "reusing existing checkpoint", "reuse_ref", reuseRef)
+			return verify()
+		}
+
+		// not being able to reuse a checkpoint is not fatal,
+		// we simply fall back to creating a new one.
+		if !errors.Is(err, image.ErrNotFound) {
+			logger.WarnContext(ctx, "failed to reuse checkpoint", "reuse_ref", reuseRef, "err", err)
+		}
+	}
+
 	if _, err := s.criService.EnsureImage(ctx, baseRef.String(), cri.RegistryAuth{
 		Username: s.cfg.RegistryUser,
 		Password: s.cfg.RegistryPass,
@@ -251,13 +379,12 @@ func (s *ServiceImpl) checkpoint(ctx context.Context, id string, baseRef name.Re
 		"regex", paperServerReadyRegex.String(),
 	)
 
-	ctrID, err := s.waitContainerReady(ctx, id, baseRef.String(), s.cfg.ContainerReadyTimeout)
+	ctrID, err := s.waitContainerReady(ctx, id, baseRef.String(), command, limits, s.cfg.ContainerReadyTimeout)
 	if err != nil {
 		state = status.CheckpointStateContainerWaitReadyFailed
 		return fmt.Errorf("wait ctr ready: %w", err)
 	}
 
-	// TODO: check if checkpoint is already present and then only build image and push
 	location := fmt.Sprintf("%s/%s", s.cfg.CheckpointFileDir, id)
 
 	info, err := s.criService.ContainerInfo(ctx, ctrID)
@@ -301,21 +428,154 @@ func (s *ServiceImpl) checkpoint(ctx context.Context, id string, baseRef name.Re
 		return fmt.Errorf("create image: %w", err)
 	}
 
-	if err := s.imgService.Push(ctx, img, baseRef.Context().Tag("checkpoint").String()); err != nil {
+	if err := s.imgService.Push(ctx, img, checkpointRef); err != nil {
 		state = status.CheckpointStatePushCheckpointFailed
 		return fmt.Errorf("push image: %w", err)
 	}
 
-	return nil
+	if reuseRef != "" {
+		if err := s.imgService.Tag(ctx, checkpointRef, reuseRef); err != nil {
+			logger.WarnContext(ctx, "failed to store checkpoint for reuse", "reuse_ref", reuseRef, "err", err)
+		}
+	}
+
+	return verify()
+}
+
+// verifyRestore restores the checkpoint in a separate sandbox and waits until the
+// server responds to a server list ping. the returned duration is the time it took
+// from creating the container until the server responded. the sandbox is removed
+// by the garbage collector, like the one used for creating the checkpoint.
+func (s *ServiceImpl) verifyRestore(
+	ctx context.Context,
+	logger *slog.Logger,
+	baseRef name.Reference,
+	checkpointRef string,
+	limits *runtimev1.LinuxContainerResources,
+) (time.Duration, error) {
+	uid, err := uuid.NewV7()
+	if err != nil {
+		return 0, fmt.Errorf("generate id: %w", err)
+	}
+
+	var (
+		id   = uid.String()
+		auth = cri.RegistryAuth{
+			Username: s.cfg.RegistryUser,
+			Password: s.cfg.RegistryPass,
+		}
+	)
+
+	logger = logger.With("restore_id", id)
+	logger.InfoContext(ctx, "verifying checkpoint restore")
+
+	// the base image is needed for restoring the checkpoint and
+	// is not present if an existing checkpoint has been reused.
+	if _, err := s.criService.EnsureImage(ctx, baseRef.String(), auth); err != nil {
+		return 0, fmt.Errorf("pull base image: %w", err)
+	}
+
+	if _, err := s.criService.EnsureImage(ctx, checkpointRef, auth); err != nil {
+		return 0, fmt.Errorf("pull checkpoint image: %w", err)
+	}
+
+	port, err := s.portAlloc.Allocate()
+	if err != nil {
+		return 0, fmt.Errorf("allocate port: %w", err)
+	}
+
+	// the cni looks up the port of the sandbox using its id, so the
+	// status has to be present before the sandbox is created. marking
+	// it as completed afterward causes it to be garbage collected.
+	s.statusStore.Update(id, status.Status{
+		CheckpointStatus: &status.CheckpointStatus{
+			State: status.CheckpointStateRunning,
+			Port:  port,
+		},
+	})
+
+	defer s.statusStore.Update(id, status.Status{
+		CheckpointStatus: &status.CheckpointStatus{
+			State:       status.CheckpointStateCompleted,
+			CompletedAt: ptr.Pointer(time.Now()),
+		},
+	})
+
+	podCfg := s.podConfig(id, limits)
+
+	runPodResp, err := s.criService.RunPodSandbox(ctx, &runtimev1.RunPodSandboxRequest{
+		Config: podCfg,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("create pod: %w", err)
+	}
+
+	start := time.Now()
+
+	if _, err := s.criService.RunContainer(ctx, &runtimev1.CreateContainerRequest{
+		PodSandboxId:  runPodResp.PodSandboxId,
+		Config:        s.ctrConfig(id, checkpointRef, nil),
+		SandboxConfig: podCfg,
+	}); err != nil {
+		return 0, fmt.Errorf("run container: %w", err)
+	}
+
+	statusResp, err := s.criService.PodSandboxStatus(ctx, &runtimev1.PodSandboxStatusRequest{
+		PodSandboxId: runPodResp.PodSandboxId,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("pod sandbox status: %w", err)
+	}
+
+	ip := statusResp.GetStatus().GetNetwork().GetIp()
+	if ip == "" {
+		return 0, errors.New("pod has no ip address")
+	}
+
+	// see waitContainerReady on why ctx is not used as parent.
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), s.cfg.RestoreVerifyTimeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(ip, strconv.Itoa(workloadenv.ServerPort))
+
+	for {
+		pingCtx, cancelPing := context.WithTimeout(timeoutCtx, restorePingTimeout)
+		_, err := s.serverListPing(pingCtx, addr)
+		cancelPing()
+
+		if err == nil {
+			latency := time.Since(start)
+			logger.InfoContext(ctx, "checkpoint restore verified", "latency", latency)
+			return latency, nil
+		}
+
+		logger.DebugContext(ctx, "restored server not responding yet", "err", err)
+
+		select {
+		case <-timeoutCtx.Done():
+			return 0, fmt.Errorf("server list ping: %w: %w", timeoutCtx.Err(), err)
+		case <-time.After(restorePingInterval):
+		}
+	}
+}
+
+// reuseImageRef returns the reference under which checkpoints created for
+// reuseKey are stored. they are located in the checkpoints repository next
+// to the repository of the base image.
+func reuseImageRef(baseRef name.Reference, reuseKey string) string {
+	repo := path.Join(path.Dir(baseRef.Context().RepositoryStr()), "checkpoints")
+	return fmt.Sprintf("%s/%s:%s", baseRef.Context().RegistryStr(), repo, reuseKey)
 }
 
 func (s *ServiceImpl) waitContainerReady(
 	ctx context.Context,
 	id string,
 	baseImgURL string,
+	command []string,
+	limits *runtimev1.LinuxContainerResources,
 	timeout time.Duration,
 ) (string, error) {
-	ctrID, attachURL, err := s.runAndAttachContainer(ctx, id, baseImgURL)
+	ctrID, attachURL, err := s.runAndAttachContainer(ctx, id, baseImgURL, command, limits)
 	if err != nil {
 		return "", fmt.Errorf("run and attach container: %w", err)
 	}
@@ -341,8 +601,14 @@ func (s *ServiceImpl) waitContainerReady(
 	return ctrID, nil
 }
 
-func (s *ServiceImpl) runAndAttachContainer(ctx context.Context, id string, baseImgURL string) (string, string, error) {
-	podCfg := s.podConfig(id)
+func (s *ServiceImpl) runAndAttachContainer(
+	ctx context.Context,
+	id string,
+	baseImgURL string,
+	command []string,
+	limits *runtimev1.LinuxContainerResources,
+) (string, string, error) {
+	podCfg := s.podConfig(id, limits)
 
 	port, err := s.portAlloc.Allocate()
 	if err != nil {
@@ -365,7 +631,7 @@ func (s *ServiceImpl) runAndAttachContainer(ctx context.Context, id string, base
 
 	ctrID, err := s.criService.RunContainer(ctx, &runtimev1.CreateContainerRequest{
 		PodSandboxId:  runPodResp.PodSandboxId,
-		Config:        s.ctrConfig(id, baseImgURL),
+		Config:        s.ctrConfig(id, baseImgURL, command),
 		SandboxConfig: podCfg,
 	})
 	if err != nil {
@@ -383,7 +649,7 @@ func (s *ServiceImpl) runAndAttachContainer(ctx context.Context, id string, base
 	return ctrID, attachResp.Url, nil
 }
 
-func (s *ServiceImpl) podConfig(id string) *runtimev1.PodSandboxConfig {
+func (s *ServiceImpl) podConfig(id string, limits *runtimev1.LinuxContainerResources) *runtimev1.PodSandboxConfig {
 	return &runtimev1.PodSandboxConfig{
 		Metadata: &runtimev1.PodSandboxMetadata{
 			Name:      id,
@@ -397,21 +663,54 @@ func (s *ServiceImpl) podConfig(id string) *runtimev1.PodSandboxConfig {
 			Options:  []string{"edns0", "trust-ad"},
 			Searches: []string{"."},
 		},
-		Labels: map[string]string{
-			workload.LabelWorkloadID:   id,
-			workload.LabelWorkloadType: "checkpoint",
-		},
+		Labels: workloadenv.Labels{
+			WorkloadID:   id,
+			WorkloadType: workloadenv.WorkloadTypeCheckpoint,
+		}.Map(),
 		Linux: &runtimev1.LinuxPodSandboxConfig{
-			Resources: &runtimev1.LinuxContainerResources{
-				CpuPeriod:          s.cfg.CPUPeriod,
-				CpuQuota:           s.cfg.CPUQuota,
-				MemoryLimitInBytes: s.cfg.MemoryLimitBytes,
-			},
+			Resources: limits,
 		},
 	}
 }
 
-func (s *ServiceImpl) ctrConfig(checkID string, baseImgURL string) *runtimev1.ContainerConfig {
+// containerResources applies the requested overrides to the configured defaults.
+// if no maximum has been configured, the defaults are treated as the maximum.
+func (s *ServiceImpl) containerResources(res Resources) (*runtimev1.LinuxContainerResources, error) {
+	limits := &runtimev1.LinuxContainerResources{
+		CpuPeriod:          s.cfg.CPUPeriod,
+		CpuQuota:           s.cfg.CPUQuota,
+		MemoryLimitInBytes: s.cfg.MemoryLimitBytes,
+	}
+
+	if res.CPUMillis > 0 {
+		period := s.cfg.CPUPeriod
+		if period == 0 {
+			period = defaultCPUPeriod
+		}
+
+		quota := int64(res.CPUMillis) * period / 1000
+		if quota > cmp.Or(s.cfg.MaxCPUQuota, s.cfg.CPUQuota) {
+			return nil, fmt.Errorf("%w: cpu quota %d", ErrResourcesExceedMaximum, quota)
+		}
+
+		limits.CpuPeriod = period
+		limits.CpuQuota = quota
+	}
+
+	if res.MemoryLimitBytes > 0 {
+		if res.MemoryLimitBytes > uint64(cmp.Or(s.cfg.MaxMemoryLimitBytes, s.cfg.MemoryLimitBytes)) {
+			return nil, fmt.Errorf("%w: memory limit %d", ErrResourcesExceedMaximum, res.MemoryLimitBytes)
+		}
+
+		limits.MemoryLimitInBytes = int64(res.MemoryLimitBytes)
+	}
+
+	return limits, nil
+}
+
+// ctrConfig returns the config of the checkpoint container. if command
+// is empty, the server is started using the command of the image.
+func (s *ServiceImpl) ctrConfig(checkID string, baseImgURL string, command []string) *runtimev1.ContainerConfig {
 	return &runtimev1.ContainerConfig{
 		Metadata: &runtimev1.ContainerMetadata{
 			Name: "payload_" + checkID,
@@ -420,10 +719,11 @@ func (s *ServiceImpl) ctrConfig(checkID string, baseImgURL string) *runtimev1.Co
 			UserSpecifiedImage: baseImgURL,
 			Image:              baseImgURL,
 		},
-		Labels: map[string]string{
-			workload.LabelWorkloadID:   checkID,
-			workload.LabelWorkloadType: "checkpoint",
-		},
+		Labels: workloadenv.Labels{
+			WorkloadID:   checkID,
+			WorkloadType: workloadenv.WorkloadTypeCheckpoint,
+		}.Map(),
+		Command: command,
 		LogPath: "checkpoint.log",
 	}
 }
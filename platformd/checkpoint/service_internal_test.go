@@ -20,6 +20,7 @@ package checkpoint
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -27,6 +28,7 @@ import (
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/spacechunks/explorer/internal/image"
 	"github.com/spacechunks/explorer/internal/mock"
 	"github.com/spacechunks/explorer/platformd/cri"
 	"github.com/spacechunks/explorer/platformd/status"
@@ -38,6 +40,8 @@ import (
 	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
 )
 
+const testReuseKey = "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3a94a8fe5ccb19ba61c4c0873"
+
 type prepArgs struct {
 	svc             *ServiceImpl
 	mockCRISvc      *mock.MockCriService
@@ -48,15 +52,21 @@ type prepArgs struct {
 	podID           string
 	ctrID           string
 	baseRef         name.Reference
+	command         []string
+	limits          *runtimev1.LinuxContainerResources
 }
 
 func TestCheckpoint(t *testing.T) {
 	tests := []struct {
-		name  string
-		cfg   Config
-		err   error
-		state status.CheckpointState
-		prep  func(args prepArgs)
+		name          string
+		cfg           Config
+		command       []string
+		reuseKey      string
+		verifyRestore bool
+		ping          func(ctx context.Context, addr string) (time.Duration, error)
+		err           error
+		state         status.CheckpointState
+		prep          func(args prepArgs)
 	}{
 		{
 			name: "works",
@@ -79,52 +89,164 @@ func TestCheckpoint(t *testing.T) {
 					args.ctrID,
 					args.mockCRISvc,
 					args.baseRef,
+					args.command,
+					args.limits,
 					cri.RegistryAuth{
 						Username: args.cfg.RegistryUser,
 						Password: args.cfg.RegistryPass,
 					},
 				)
 
-				fileLoc := fmt.Sprintf("%s/%s", args.cfg.CheckpointFileDir, args.checkID)
-
-				args.mockCRISvc.EXPECT().
-					ContainerInfo(mocky.Anything, args.ctrID).
-					Return(cri.ContainerInfo{
-						RuntimeSpec: cri.RuntimeSpec{
-							Linux: cri.Linux{
-								CgroupsPath: "system.slice:crio:<container-id>",
-								Namespaces: []cri.Namespace{
-									{
-										Type: string(cri.NamespaceTypeNet),
-										Path: "/var/run/something",
-									},
-								},
-							},
-						},
-					}, nil)
+				prepCheckpointAndPush(t, args)
+			},
+		},
+		{
+			name: "container is started with startup command",
+			cfg: Config{
+				CPUPeriod:                100,
+				CPUQuota:                 200,
+				MemoryLimitBytes:         300,
+				CheckpointFileDir:        t.TempDir(),
+				CheckpointTimeoutSeconds: 60,
+				ContainerReadyTimeout:    10 * time.Second,
+			},
+			command: []string{"java", "-jar", "paper.jar", "nogui"},
+			state:   status.CheckpointStateCompleted,
+			prep: func(args prepArgs) {
+				prepUntilContainerAttach(
+					args.svc,
+					args.checkID,
+					args.podID,
+					args.ctrID,
+					args.mockCRISvc,
+					args.baseRef,
+					args.command,
+					args.limits,
+					cri.RegistryAuth{},
+				)
 
-				args.mockSockHandler.EXPECT().
-					BlockNewConnections("system.slice:crio:<container-id>").
+				prepCheckpointAndPush(t, args)
+			},
+		},
+		{
+			name: "existing checkpoint is reused",
+			cfg: Config{
+				CheckpointFileDir:        t.TempDir(),
+				CheckpointTimeoutSeconds: 60,
+				ContainerReadyTimeout:    10 * time.Second,
+			},
+			reuseKey: testReuseKey,
+			state:    status.CheckpointStateCompleted,
+			prep: func(args prepArgs) {
+				args.mockImgSvc.EXPECT().
+					Tag(
+						mocky.Anything,
+						"example.com/checkpoints:"+testReuseKey,
+						args.baseRef.Context().Tag("checkpoint").String(),
+					).
 					Return(nil)
+			},
+		},
+		{
+			name: "checkpoint is stored for reuse if none exists",
+			cfg: Config{
+				CPUPeriod:                100,
+				CPUQuota:                 200,
+				MemoryLimitBytes:         300,
+				CheckpointFileDir:        t.TempDir(),
+				CheckpointTimeoutSeconds: 60,
+				ContainerReadyTimeout:    10 * time.Second,
+				RegistryUser:             "user",
+				RegistryPass:             "pass",
+			},
+			reuseKey: testReuseKey,
+			state:    status.CheckpointStateCompleted,
+			prep: func(args prepArgs) {
+				var (
+					reuseRef      = "example.com/checkpoints:" + testReuseKey
+					checkpointRef = args.baseRef.Context().Tag("checkpoint").String()
+				)
 
-				args.mockSockHandler.EXPECT().
-					DestroySocks("/var/run/something").
-					Return(nil)
+				args.mockImgSvc.EXPECT().
+					Tag(mocky.Anything, reuseRef, checkpointRef).
+					Return(image.ErrNotFound)
 
-				args.mockCRISvc.EXPECT().
-					CheckpointContainer(mocky.Anything, &runtimev1.CheckpointContainerRequest{
-						ContainerId: args.ctrID,
-						Location:    fileLoc,
-						Timeout:     args.cfg.CheckpointTimeoutSeconds,
-					}).
-					Return(&runtimev1.CheckpointContainerResponse{}, nil)
+				prepUntilContainerAttach(
+					args.svc,
+					args.checkID,
+					args.podID,
+					args.ctrID,
+					args.mockCRISvc,
+					args.baseRef,
+					args.command,
+					args.limits,
+					cri.RegistryAuth{
+						Username: args.cfg.RegistryUser,
+						Password: args.cfg.RegistryPass,
+					},
+				)
 
-				err := os.WriteFile(fileLoc, []byte("hello"), 0777)
-				require.NoError(t, err)
+				prepCheckpointAndPush(t, args)
+
+				args.mockImgSvc.EXPECT().
+					Tag(mocky.Anything, checkpointRef, reuseRef).
+					Return(nil)
+			},
+		},
+		{
+			name: "restore is verified",
+			cfg: Config{
+				CheckpointFileDir:        t.TempDir(),
+				CheckpointTimeoutSeconds: 60,
+				ContainerReadyTimeout:    10 * time.Second,
+				RestoreVerifyTimeout:     10 * time.Second,
+			},
+			reuseKey:      testReuseKey,
+			verifyRestore: true,
+			ping: func(ctx context.Context, addr string) (time.Duration, error) {
+				if addr != "10.0.0.1:25565" {
+					return 0, fmt.Errorf("unexpected addr %s", addr)
+				}
+				return time.Millisecond, nil
+			},
+			state: status.CheckpointStateCompleted,
+			prep: func(args prepArgs) {
+				args.mockImgSvc.EXPECT().
+					Tag(
+						mocky.Anything,
+						"example.com/checkpoints:"+testReuseKey,
+						args.baseRef.Context().Tag("checkpoint").String(),
+					).
+					Return(nil)
 
+				prepRestore(args)
+			},
+		},
+		{
+			name: "restore verification timeout exceeded",
+			cfg: Config{
+				CheckpointFileDir:        t.TempDir(),
+				CheckpointTimeoutSeconds: 60,
+				ContainerReadyTimeout:    10 * time.Second,
+				RestoreVerifyTimeout:     1 * time.Second,
+			},
+			reuseKey:      testReuseKey,
+			verifyRestore: true,
+			ping: func(ctx context.Context, addr string) (time.Duration, error) {
+				return 0, errors.New("connection refused")
+			},
+			state: status.CheckpointStateVerifyRestoreFailed,
+			err:   context.DeadlineExceeded,
+			prep: func(args prepArgs) {
 				args.mockImgSvc.EXPECT().
-					Push(mocky.Anything, mocky.Anything, args.baseRef.Context().Tag("checkpoint").String()).
+					Tag(
+						mocky.Anything,
+						"example.com/checkpoints:"+testReuseKey,
+						args.baseRef.Context().Tag("checkpoint").String(),
+					).
 					Return(nil)
+
+				prepRestore(args)
 			},
 		},
 		{
@@ -149,6 +271,8 @@ func TestCheckpoint(t *testing.T) {
 					args.ctrID,
 					args.mockCRISvc,
 					args.baseRef,
+					args.command,
+					args.limits,
 					cri.RegistryAuth{
 						Username: args.cfg.RegistryUser,
 						Password: args.cfg.RegistryPass,
@@ -174,22 +298,30 @@ func TestCheckpoint(t *testing.T) {
 				mockExecer      = func(url string) (remotecommand.Executor, error) {
 					return &test.RemoteCmdExecutor{}, nil
 				}
+			)
 
-				svc = NewService(
-					logger,
-					tt.cfg,
-					mockCRISvc,
-					mockImgSvc,
-					statusStore,
-					mockExecer,
-					workload.NewPortAllocator(1, 1),
-					mockSockHandler,
-				)
+			svc, err := NewService(
+				logger,
+				tt.cfg,
+				mockCRISvc,
+				mockImgSvc,
+				statusStore,
+				mockExecer,
+				workload.NewPortAllocator(1, 1),
+				mockSockHandler,
 			)
+			require.NoError(t, err)
+
+			if tt.ping != nil {
+				svc.serverListPing = tt.ping
+			}
 
 			baseRef, err := name.ParseReference("example.com/test-img:latest")
 			require.NoError(t, err)
 
+			limits, err := svc.containerResources(Resources{})
+			require.NoError(t, err)
+
 			tt.prep(prepArgs{
 				svc:             svc,
 				mockCRISvc:      mockCRISvc,
@@ -200,9 +332,11 @@ func TestCheckpoint(t *testing.T) {
 				podID:           podID,
 				ctrID:           ctrID,
 				baseRef:         baseRef,
+				command:         tt.command,
+				limits:          limits,
 			})
 
-			err = svc.checkpoint(ctx, checkID, baseRef)
+			err = svc.checkpoint(ctx, checkID, baseRef, limits, tt.command, tt.reuseKey, tt.verifyRestore)
 
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -212,10 +346,100 @@ func TestCheckpoint(t *testing.T) {
 
 			st := statusStore.Get(checkID)
 			require.Equal(t, tt.state, st.CheckpointStatus.State)
+
+			if tt.verifyRestore && tt.err == nil {
+				require.NotZero(t, st.CheckpointStatus.RestoreLatency)
+			}
 		})
 	}
 }
 
+func prepCheckpointAndPush(t *testing.T, args prepArgs) {
+	fileLoc := fmt.Sprintf("%s/%s", args.cfg.CheckpointFileDir, args.checkID)
+
+	args.mockCRISvc.EXPECT().
+		ContainerInfo(mocky.Anything, args.ctrID).
+		Return(cri.ContainerInfo{
+			RuntimeSpec: cri.RuntimeSpec{
+				Linux: cri.Linux{
+					CgroupsPath: "system.slice:crio:<container-id>",
+					Namespaces: []cri.Namespace{
+						{
+							Type: string(cri.NamespaceTypeNet),
+							Path: "/var/run/something",
+						},
+					},
+				},
+			},
+		}, nil)
+
+	args.mockSockHandler.EXPECT().
+		BlockNewConnections("system.slice:crio:<container-id>").
+		Return(nil)
+
+	args.mockSockHandler.EXPECT().
+		DestroySocks("/var/run/something").
+		Return(nil)
+
+	args.mockCRISvc.EXPECT().
+		CheckpointContainer(mocky.Anything, &runtimev1.CheckpointContainerRequest{
+			ContainerId: args.ctrID,
+			Location:    fileLoc,
+			Timeout:     args.cfg.CheckpointTimeoutSeconds,
+		}).
+		Return(&runtimev1.CheckpointContainerResponse{}, nil)
+
+	err := os.WriteFile(fileLoc, []byte("hello"), 0777)
+	require.NoError(t, err)
+
+	args.mockImgSvc.EXPECT().
+		Push(mocky.Anything, mocky.Anything, args.baseRef.Context().Tag("checkpoint").String()).
+		Return(nil)
+}
+
+func prepRestore(args prepArgs) {
+	var (
+		restorePodID  = "restore-pod-id"
+		checkpointRef = args.baseRef.Context().Tag("checkpoint").String()
+		auth          = cri.RegistryAuth{
+			Username: args.cfg.RegistryUser,
+			Password: args.cfg.RegistryPass,
+		}
+	)
+
+	args.mockCRISvc.EXPECT().
+		EnsureImage(mocky.Anything, args.baseRef.String(), auth).
+		Return(false, nil)
+
+	args.mockCRISvc.EXPECT().
+		EnsureImage(mocky.Anything, checkpointRef, auth).
+		Return(false, nil)
+
+	args.mockCRISvc.EXPECT().
+		RunPodSandbox(mocky.Anything, mocky.Anything).
+		Return(&runtimev1.RunPodSandboxResponse{
+			PodSandboxId: restorePodID,
+		}, nil)
+
+	args.mockCRISvc.EXPECT().
+		RunContainer(mocky.Anything, mocky.MatchedBy(func(req *runtimev1.CreateContainerRequest) bool {
+			return req.PodSandboxId == restorePodID && req.Config.Image.Image == checkpointRef
+		})).
+		Return("restore-container-id", nil)
+
+	args.mockCRISvc.EXPECT().
+		PodSandboxStatus(mocky.Anything, &runtimev1.PodSandboxStatusRequest{
+			PodSandboxId: restorePodID,
+		}).
+		Return(&runtimev1.PodSandboxStatusResponse{
+			Status: &runtimev1.PodSandboxStatus{
+				Network: &runtimev1.PodSandboxNetworkStatus{
+					Ip: "10.0.0.1",
+				},
+			},
+		}, nil)
+}
+
 func prepUntilContainerAttach(
 	svc *ServiceImpl,
 	checkID string,
@@ -223,6 +447,8 @@ func prepUntilContainerAttach(
 	ctrID string,
 	mockCRISvc *mock.MockCriService,
 	baseRef name.Reference,
+	command []string,
+	limits *runtimev1.LinuxContainerResources,
 	auth cri.RegistryAuth,
 ) {
 	mockCRISvc.EXPECT().
@@ -231,18 +457,18 @@ func prepUntilContainerAttach(
 
 	mockCRISvc.EXPECT().
 		RunPodSandbox(mocky.Anything, &runtimev1.RunPodSandboxRequest{
-			Config: svc.podConfig(checkID),
+			Config: svc.podConfig(checkID, limits),
 		}).
 		Return(&runtimev1.RunPodSandboxResponse{
 			PodSandboxId: podID,
 		}, nil)
 
-	podCfg := svc.podConfig(checkID)
+	podCfg := svc.podConfig(checkID, limits)
 
 	mockCRISvc.EXPECT().
 		RunContainer(mocky.Anything, &runtimev1.CreateContainerRequest{
 			PodSandboxId:  podID,
-			Config:        svc.ctrConfig(checkID, baseRef.String()),
+			Config:        svc.ctrConfig(checkID, baseRef.String(), command),
 			SandboxConfig: podCfg,
 		}).
 		Return(ctrID, nil)
@@ -254,3 +480,100 @@ func prepUntilContainerAttach(
 		}).
 		Return(&runtimev1.AttachResponse{}, nil)
 }
+
+func TestContainerResources(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      Config
+		res      Resources
+		expected *runtimev1.LinuxContainerResources
+		err      error
+	}{
+		{
+			name: "defaults are used if nothing is requested",
+			cfg: Config{
+				CPUPeriod:        100000,
+				CPUQuota:         200000,
+				MemoryLimitBytes: 300,
+			},
+			expected: &runtimev1.LinuxContainerResources{
+				CpuPeriod:          100000,
+				CpuQuota:           200000,
+				MemoryLimitInBytes: 300,
+			},
+		},
+		{
+			name: "overrides within maximum",
+			cfg: Config{
+				CPUPeriod:           50000,
+				CPUQuota:            100000,
+				MemoryLimitBytes:    300,
+				MaxCPUQuota:         200000,
+				MaxMemoryLimitBytes: 1000,
+			},
+			res: Resources{
+				CPUMillis:        4000,
+				MemoryLimitBytes: 1000,
+			},
+			expected: &runtimev1.LinuxContainerResources{
+				CpuPeriod:          50000,
+				CpuQuota:           200000,
+				MemoryLimitInBytes: 1000,
+			},
+		},
+		{
+			name: "runtime default period is used if none is configured",
+			cfg: Config{
+				CPUQuota:    100000,
+				MaxCPUQuota: 400000,
+			},
+			res: Resources{
+				CPUMillis: 2500,
+			},
+			expected: &runtimev1.LinuxContainerResources{
+				CpuPeriod: 100000,
+				CpuQuota:  250000,
+			},
+		},
+		{
+			name: "defaults are the maximum if none is configured",
+			cfg: Config{
+				CPUPeriod:        100000,
+				CPUQuota:         200000,
+				MemoryLimitBytes: 300,
+			},
+			res: Resources{
+				MemoryLimitBytes: 301,
+			},
+			err: ErrResourcesExceedMaximum,
+		},
+		{
+			name: "cpu exceeds maximum",
+			cfg: Config{
+				CPUPeriod:   100000,
+				CPUQuota:    100000,
+				MaxCPUQuota: 200000,
+			},
+			res: Resources{
+				CPUMillis: 2001,
+			},
+			err: ErrResourcesExceedMaximum,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &ServiceImpl{
+				cfg: tt.cfg,
+			}
+
+			actual, err := svc.containerResources(tt.res)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/spacechunks/explorer/internal/mock"
 	"github.com/spacechunks/explorer/internal/ptr"
+	"github.com/spacechunks/explorer/internal/workloadenv"
 	"github.com/spacechunks/explorer/platformd/checkpoint"
 	"github.com/spacechunks/explorer/platformd/cri"
 	"github.com/spacechunks/explorer/platformd/status"
@@ -110,18 +111,20 @@ func TestCollectGarbage(t *testing.T) {
 				store           = status.NewMemStore()
 				mockCRISvc      = mock.NewMockCriService(t)
 				mockSockHandler = mock.NewMockDatapathSockHandler(t)
-				svc             = checkpoint.NewService(
-					logger,
-					tt.cfg,
-					mockCRISvc,
-					nil,
-					store,
-					nil,
-					workload.NewPortAllocator(1, 1),
-					mockSockHandler,
-				)
 			)
 
+			svc, err := checkpoint.NewService(
+				logger,
+				tt.cfg,
+				mockCRISvc,
+				nil,
+				store,
+				nil,
+				workload.NewPortAllocator(1, 1),
+				mockSockHandler,
+			)
+			require.NoError(t, err)
+
 			for id, st := range tt.storeItems {
 				store.Update(id, st)
 			}
@@ -168,7 +171,7 @@ func TestCollectGarbage(t *testing.T) {
 				ListPodSandbox(mocky.Anything, &runtimev1.ListPodSandboxRequest{
 					Filter: &runtimev1.PodSandboxFilter{
 						LabelSelector: map[string]string{
-							workload.LabelWorkloadType: "checkpoint",
+							workloadenv.LabelWorkloadType: "checkpoint",
 						},
 					},
 				}).
@@ -176,7 +179,7 @@ func TestCollectGarbage(t *testing.T) {
 					Items: pods,
 				}, nil)
 
-			err := svc.CollectGarbage(ctx)
+			err = svc.CollectGarbage(ctx)
 			require.NoError(t, err)
 
 			for id, st := range store.View() {
@@ -0,0 +1,216 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package condition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/datapath"
+	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+const (
+	// DefaultMinFreeDiskRatio is the share of disk space and inodes that
+	// has to be available, so checkpoints can still be stored.
+	DefaultMinFreeDiskRatio = 0.1
+
+	// DefaultMaxUsageRatio is the share of entries of bpf maps and the
+	// conntrack table that can be used before they are considered full.
+	DefaultMaxUsageRatio = 0.9
+
+	// DefaultCRITimeout is the time the container runtime has to respond in.
+	DefaultCRITimeout = 5 * time.Second
+
+	// ConntrackDir contains the conntrack sysctls.
+	ConntrackDir = "/proc/sys/net/netfilter"
+)
+
+type diskDetector struct {
+	dir          string
+	minFreeRatio float64
+}
+
+// NewDiskPressureDetector detects if less than the given share of
+// disk space or inodes is available on the filesystem of dir.
+func NewDiskPressureDetector(dir string, minFreeRatio float64) Detector {
+	return &diskDetector{
+		dir:          dir,
+		minFreeRatio: minFreeRatio,
+	}
+}
+
+func (d *diskDetector) Detect(_ context.Context) (Condition, bool, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(d.dir, &st); err != nil {
+		return Condition{}, false, fmt.Errorf("statfs %s: %w", d.dir, err)
+	}
+
+	if st.Blocks > 0 && float64(st.Bavail)/float64(st.Blocks) < d.minFreeRatio {
+		return Condition{
+			Type: TypeDiskPressure,
+			Message: fmt.Sprintf(
+				"%.1f%% of disk space is available in %s",
+				float64(st.Bavail)/float64(st.Blocks)*100,
+				d.dir,
+			),
+		}, true, nil
+	}
+
+	// filesystems that do not have a fixed number of inodes report zero
+	if st.Files > 0 && float64(st.Ffree)/float64(st.Files) < d.minFreeRatio {
+		return Condition{
+			Type: TypeDiskPressure,
+			Message: fmt.Sprintf(
+				"%.1f%% of inodes are available in %s",
+				float64(st.Ffree)/float64(st.Files)*100,
+				d.dir,
+			),
+		}, true, nil
+	}
+
+	return Condition{}, false, nil
+}
+
+type criDetector struct {
+	client  runtimev1.RuntimeServiceClient
+	timeout time.Duration
+}
+
+// NewCRIDetector detects if the container runtime does not
+// answer version requests within the given timeout.
+func NewCRIDetector(client runtimev1.RuntimeServiceClient, timeout time.Duration) Detector {
+	return &criDetector{
+		client:  client,
+		timeout: timeout,
+	}
+}
+
+func (d *criDetector) Detect(ctx context.Context) (Condition, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	if _, err := d.client.Version(ctx, &runtimev1.VersionRequest{}); err != nil {
+		return Condition{
+			Type:    TypeCRIUnresponsive,
+			Message: fmt.Sprintf("container runtime did not respond: %v", err),
+		}, true, nil
+	}
+
+	return Condition{}, false, nil
+}
+
+// MapUsageReader returns the usage of the bpf maps, see datapath.Objects.
+type MapUsageReader interface {
+	MapUsage() ([]datapath.MapUsage, error)
+}
+
+type bpfMapDetector struct {
+	reader        MapUsageReader
+	maxUsageRatio float64
+}
+
+// NewBPFMapDetector detects if more than the given share of
+// entries of one of the bpf maps is in use.
+func NewBPFMapDetector(reader MapUsageReader, maxUsageRatio float64) Detector {
+	return &bpfMapDetector{
+		reader:        reader,
+		maxUsageRatio: maxUsageRatio,
+	}
+}
+
+func (d *bpfMapDetector) Detect(_ context.Context) (Condition, bool, error) {
+	usages, err := d.reader.MapUsage()
+	if err != nil {
+		return Condition{}, false, fmt.Errorf("map usage: %w", err)
+	}
+
+	full := make([]string, 0)
+	for _, u := range usages {
+		if u.MaxEntries == 0 || float64(u.Entries)/float64(u.MaxEntries) < d.maxUsageRatio {
+			continue
+		}
+		full = append(full, fmt.Sprintf("%s (%d/%d)", u.Name, u.Entries, u.MaxEntries))
+	}
+
+	if len(full) == 0 {
+		return Condition{}, false, nil
+	}
+
+	return Condition{
+		Type:    TypeBPFMapFull,
+		Message: "bpf maps are running full: " + strings.Join(full, ", "),
+	}, true, nil
+}
+
+type conntrackDetector struct {
+	dir           string
+	maxUsageRatio float64
+}
+
+// NewConntrackDetector detects if more than the given share of entries of the
+// conntrack table is in use. dir is the directory containing the conntrack
+// sysctls, usually ConntrackDir.
+func NewConntrackDetector(dir string, maxUsageRatio float64) Detector {
+	return &conntrackDetector{
+		dir:           dir,
+		maxUsageRatio: maxUsageRatio,
+	}
+}
+
+func (d *conntrackDetector) Detect(_ context.Context) (Condition, bool, error) {
+	count, err := readUint(filepath.Join(d.dir, "nf_conntrack_count"))
+	if err != nil {
+		// the sysctls do not exist if the conntrack
+		// module is not loaded, so there is no table.
+		if errors.Is(err, os.ErrNotExist) {
+			return Condition{}, false, nil
+		}
+		return Condition{}, false, fmt.Errorf("conntrack count: %w", err)
+	}
+
+	maxEntries, err := readUint(filepath.Join(d.dir, "nf_conntrack_max"))
+	if err != nil {
+		return Condition{}, false, fmt.Errorf("conntrack max: %w", err)
+	}
+
+	if maxEntries == 0 || float64(count)/float64(maxEntries) < d.maxUsageRatio {
+		return Condition{}, false, nil
+	}
+
+	return Condition{
+		Type:    TypeConntrackExhausted,
+		Message: fmt.Sprintf("conntrack table is running full: %d/%d entries", count, maxEntries),
+	}, true, nil
+}
+
+func readUint(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
@@ -0,0 +1,152 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package condition_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/datapath"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/platformd/condition"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+type fakeMaps []datapath.MapUsage
+
+func (m fakeMaps) MapUsage() ([]datapath.MapUsage, error) {
+	return m, nil
+}
+
+func TestDiskPressureDetector(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dir = t.TempDir()
+	)
+
+	// no filesystem has more than everything available
+	_, ok, err := condition.NewDiskPressureDetector(dir, 1.1).Detect(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, ok, err = condition.NewDiskPressureDetector(dir, 0).Detect(ctx)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, _, err = condition.NewDiskPressureDetector(filepath.Join(dir, "missing"), 0).Detect(ctx)
+	require.Error(t, err)
+}
+
+func TestCRIDetector(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		criSvc = mock.NewMockCriService(t)
+	)
+
+	criSvc.EXPECT().
+		Version(mocky.Anything, &runtimev1.VersionRequest{}).
+		Return(&runtimev1.VersionResponse{}, nil).
+		Once()
+
+	_, ok, err := condition.NewCRIDetector(criSvc, time.Second).Detect(ctx)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	criSvc.EXPECT().
+		Version(mocky.Anything, &runtimev1.VersionRequest{}).
+		Return(nil, context.DeadlineExceeded).
+		Once()
+
+	c, ok, err := condition.NewCRIDetector(criSvc, time.Second).Detect(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, condition.TypeCRIUnresponsive, c.Type)
+}
+
+func TestBPFMapDetector(t *testing.T) {
+	ctx := context.Background()
+
+	_, ok, err := condition.NewBPFMapDetector(fakeMaps{
+		{Name: "net_data_map", Entries: 100, MaxEntries: 256},
+	}, 0.9).Detect(ctx)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	c, ok, err := condition.NewBPFMapDetector(fakeMaps{
+		{Name: "net_data_map", Entries: 100, MaxEntries: 256},
+		{Name: "ptp_dnat_targets", Entries: 240, MaxEntries: 256},
+	}, 0.9).Detect(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, condition.Condition{
+		Type:    condition.TypeBPFMapFull,
+		Message: "bpf maps are running full: ptp_dnat_targets (240/256)",
+	}, c)
+}
+
+func TestConntrackDetector(t *testing.T) {
+	tests := []struct {
+		name     string
+		count    string
+		max      string
+		detected bool
+	}{
+		{
+			name:  "table has enough entries left",
+			count: "100\n",
+			max:   "1000\n",
+		},
+		{
+			name:     "table is running full",
+			count:    "950\n",
+			max:      "1000\n",
+			detected: true,
+		},
+		{
+			name: "conntrack module is not loaded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx = context.Background()
+				dir = t.TempDir()
+			)
+
+			if tt.count != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "nf_conntrack_count"), []byte(tt.count), 0644))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "nf_conntrack_max"), []byte(tt.max), 0644))
+			}
+
+			c, ok, err := condition.NewConntrackDetector(dir, 0.9).Detect(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tt.detected, ok)
+
+			if tt.detected {
+				require.Equal(t, condition.TypeConntrackExhausted, c.Type)
+			}
+		})
+	}
+}
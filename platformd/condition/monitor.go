@@ -0,0 +1,172 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package condition
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+)
+
+// DefaultCheckInterval is used if Config.Interval is not set.
+const DefaultCheckInterval = 30 * time.Second
+
+// maxMessageLen is the maximum length of messages accepted by the control plane.
+const maxMessageLen = 512
+
+// Type is a local problem that prevents the node from running instances reliably.
+type Type string
+
+const (
+	// TypeDiskPressure is detected if the disk checkpoints are stored on is running out of space.
+	TypeDiskPressure Type = "DISK_PRESSURE"
+
+	// TypeCRIUnresponsive is detected if the container runtime does not respond.
+	TypeCRIUnresponsive Type = "CRI_UNRESPONSIVE"
+
+	// TypeBPFMapFull is detected if one of the bpf maps used to wire up workloads is full.
+	TypeBPFMapFull Type = "BPF_MAP_FULL"
+
+	// TypeConntrackExhausted is detected if the connection tracking table is full.
+	TypeConntrackExhausted Type = "CONNTRACK_EXHAUSTED"
+)
+
+type Condition struct {
+	Type    Type
+	Message string
+}
+
+// Detector checks the node for a single problem. false is returned if the
+// problem is not present. an error is returned if the check itself failed,
+// in which case the condition is not reported.
+type Detector interface {
+	Detect(ctx context.Context) (Condition, bool, error)
+}
+
+type Config struct {
+	NodeID string
+
+	// Interval in which the detectors are run and their
+	// results are reported to the control plane.
+	Interval time.Duration
+}
+
+// Monitor periodically runs all detectors and reports the detected conditions
+// to the control plane. the control plane stops scheduling instances to the
+// node as long as at least one condition is reported.
+type Monitor struct {
+	logger    *slog.Logger
+	cfg       Config
+	insClient instancev1alpha1.InstanceServiceClient
+	detectors []Detector
+	ticker    *time.Ticker
+	stop      chan struct{}
+}
+
+func NewMonitor(
+	logger *slog.Logger,
+	cfg Config,
+	insClient instancev1alpha1.InstanceServiceClient,
+	detectors ...Detector,
+) *Monitor {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultCheckInterval
+	}
+
+	return &Monitor{
+		logger:    logger,
+		cfg:       cfg,
+		insClient: insClient,
+		detectors: detectors,
+		ticker:    time.NewTicker(cfg.Interval),
+		stop:      make(chan struct{}),
+	}
+}
+
+func (m *Monitor) Run(ctx context.Context) {
+	// check right away, so conditions left behind by a previous
+	// run of platformd are cleared as soon as possible.
+	m.tick(ctx)
+
+	for {
+		select {
+		case <-m.ticker.C:
+			m.tick(ctx)
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+func (m *Monitor) tick(ctx context.Context) {
+	if _, err := m.Check(ctx); err != nil {
+		m.logger.ErrorContext(ctx, "reporting node conditions failed", "err", err)
+	}
+}
+
+func (m *Monitor) Stop() {
+	m.ticker.Stop()
+	close(m.stop)
+}
+
+// Check runs all detectors and reports the detected conditions. all conditions
+// are reported every time, so the control plane clears the ones that are not
+// detected anymore.
+func (m *Monitor) Check(ctx context.Context) ([]Condition, error) {
+	var (
+		conditions = make([]Condition, 0)
+		transport  = make([]*instancev1alpha1.NodeCondition, 0)
+	)
+
+	for _, d := range m.detectors {
+		c, ok, err := d.Detect(ctx)
+		if err != nil {
+			m.logger.WarnContext(ctx, "detecting node condition failed", "err", err)
+			continue
+		}
+
+		if !ok {
+			continue
+		}
+
+		if len(c.Message) > maxMessageLen {
+			c.Message = c.Message[:maxMessageLen]
+		}
+
+		conditions = append(conditions, c)
+
+		// the enum values are named after the condition types.
+		transport = append(transport, &instancev1alpha1.NodeCondition{
+			Type:    instancev1alpha1.NodeConditionType(instancev1alpha1.NodeConditionType_value[string(c.Type)]),
+			Message: c.Message,
+		})
+	}
+
+	if _, err := m.insClient.ReportNodeConditions(ctx, &instancev1alpha1.ReportNodeConditionsRequest{
+		NodeKey:    m.cfg.NodeID,
+		Conditions: transport,
+	}); err != nil {
+		return nil, fmt.Errorf("report node conditions: %w", err)
+	}
+
+	return conditions, nil
+}
@@ -0,0 +1,83 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package condition_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/platformd/condition"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+const nodeID = "node"
+
+type fakeDetector struct {
+	c   condition.Condition
+	ok  bool
+	err error
+}
+
+func (d fakeDetector) Detect(context.Context) (condition.Condition, bool, error) {
+	return d.c, d.ok, d.err
+}
+
+func TestMonitorCheck(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		client   = mock.NewMockV1alpha1InstanceServiceClient(t)
+		pressure = condition.Condition{Type: condition.TypeDiskPressure, Message: "5% free"}
+		monitor  = condition.NewMonitor(
+			slog.New(slog.DiscardHandler),
+			condition.Config{NodeID: nodeID, Interval: time.Minute},
+			client,
+			fakeDetector{c: pressure, ok: true},
+			fakeDetector{},
+			// failing detectors must not prevent reporting the other conditions
+			fakeDetector{err: errors.New("boom")},
+		)
+		expected = &instancev1alpha1.ReportNodeConditionsRequest{
+			NodeKey: nodeID,
+			Conditions: []*instancev1alpha1.NodeCondition{
+				{
+					Type:    instancev1alpha1.NodeConditionType_DISK_PRESSURE,
+					Message: pressure.Message,
+				},
+			},
+		}
+	)
+
+	client.EXPECT().
+		ReportNodeConditions(mocky.Anything, mocky.MatchedBy(func(req *instancev1alpha1.ReportNodeConditionsRequest) bool {
+			return cmp.Diff(expected, req, protocmp.Transform()) == ""
+		})).
+		Return(&instancev1alpha1.ReportNodeConditionsResponse{}, nil)
+
+	conditions, err := monitor.Check(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []condition.Condition{pressure}, conditions)
+}
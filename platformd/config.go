@@ -3,30 +3,64 @@ package platformd
 import (
 	"net/url"
 	"time"
+
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/spacechunks/explorer/platformd/system"
+	"github.com/spacechunks/explorer/platformd/workload"
 )
 
 type Config struct {
-	ManagementServerListenSock *url.URL
-	CRIListenSock              string
-	EnvoyImage                 string
-	CoreDNSImage               string
-	GetsockoptCGroup           string
-	DNSServer                  string
-	HostIface                  string
-	MaxAttempts                uint
-	SyncInterval               time.Duration
-	NodeID                     string
-	MinPort                    uint16
-	MaxPort                    uint16
-	WorkloadNamespace          string
-	RegistryEndpoint           string
-	RegistryUser               string
-	RegistryPass               string
-	ControlPlaneEndpoint       string
-	CheckpointConfig           struct {
+	ManagementServerListenSock  *url.URL
+	CRIListenSock               string
+	EnvoyImage                  string
+	EnvoyAdminAddr              string
+	CoreDNSImage                string
+	GetsockoptCGroup            string
+	DNSServer                   string
+	DNSConfigDir                string
+	DNSBaseConfig               string
+	DNSZone                     string
+	HostIface                   string
+	MaxAttempts                 uint
+	SyncInterval                time.Duration
+	FullResyncInterval          time.Duration
+	WatchInstances              bool
+	DryRun                      bool
+	ReportBatchSize             int
+	SystemWorkloadCheckInterval time.Duration
+	TrafficCollectInterval      time.Duration
+	NodeID                      string
+	Builder                     bool
+	MinPort                     uint16
+	MaxPort                     uint16
+	WorkloadNamespace           string
+	RegistryEndpoint            string
+	RegistryUser                string
+	RegistryPass                string
+	RegistryMirrors             []string
+	RegistryNodeMirrors         map[string][]string
+	RegistryMirrorConfigPath    string
+	ControlPlaneEndpoint        string
+	VerifyImages                bool
+	ImageVerificationKeyFile    string
+	UpdateVerificationKeyFile   string
+	UpdateCheckInterval         time.Duration
+	ConditionCheckInterval      time.Duration
+	LogShippingInterval         time.Duration
+	LogArchiveDir               string
+	BPFMapReconcileInterval     time.Duration
+	ImagePruneInterval          time.Duration
+	ImagePruneUnusedFor         time.Duration
+	PprofListenAddr             string
+	PprofTokenFile              string
+	LogLevels                   *logging.Levels
+	LogLevelSpec                string
+	CheckpointConfig            struct {
 		CPUPeriod                int64
 		CPUQuota                 int64
 		MemoryLimitBytes         int64
+		MaxCPUQuota              int64
+		MaxMemoryLimitBytes      int64
 		CheckpointFileDir        string
 		CheckpointTimeoutSeconds int64
 		RegistryUser             string
@@ -34,11 +68,26 @@ type Config struct {
 		ListenAddr               string
 		StatusRetentionPeriod    time.Duration
 		ContainerReadyTimeout    time.Duration
+		RestoreVerifyTimeout     time.Duration
+		MaxDiskUsageBytes        uint64
 	}
+	NodeCapacity        system.CapacityConfig
 	ManagementSocketUID uint64
 	ManagementSocketGID uint64
 	WorkloadConfig      struct {
 		MCManagementAPIToken string
 		ServerMonImage       string
+		Probe                workload.ProbeConfig
+		ParamsDir            string
+	}
+}
+
+// registryMirrors returns the mirrors configured for this node. node specific
+// mirrors take precedence over the ones configured for all nodes, so an empty
+// override can be used to disable mirrors on a particular node.
+func (c Config) registryMirrors() []string {
+	if m, ok := c.RegistryNodeMirrors[c.NodeID]; ok {
+		return m
 	}
+	return c.RegistryMirrors
 }
@@ -0,0 +1,149 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cri
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// ImagePruner removes base and checkpoint images that have not been used by
+// any container for a while, so images of flavor versions that are no longer
+// run do not fill up the disk of the node. images are only removed once they
+// have been unused for the configured duration, which also keeps images that
+// have just been pulled for a workload that is about to be created.
+type ImagePruner struct {
+	logger    *slog.Logger
+	rtClient  runtimev1.RuntimeServiceClient
+	imgClient runtimev1.ImageServiceClient
+	registry  string
+	unusedFor time.Duration
+
+	// unusedSince contains the time each image has
+	// first been seen unused, keyed by the image id.
+	unusedSince map[string]time.Time
+}
+
+// NewImagePruner returns a pruner removing images pulled from the given
+// registry. other images, like the ones of system workloads, are kept.
+func NewImagePruner(
+	logger *slog.Logger,
+	rtClient runtimev1.RuntimeServiceClient,
+	imgClient runtimev1.ImageServiceClient,
+	registry string,
+	unusedFor time.Duration,
+) *ImagePruner {
+	return &ImagePruner{
+		logger:      logger,
+		rtClient:    rtClient,
+		imgClient:   imgClient,
+		registry:    registry,
+		unusedFor:   unusedFor,
+		unusedSince: make(map[string]time.Time),
+	}
+}
+
+func (p *ImagePruner) Prune(ctx context.Context) error {
+	imgResp, err := p.imgClient.ListImages(ctx, &runtimev1.ListImagesRequest{})
+	if err != nil {
+		return fmt.Errorf("list images: %w", err)
+	}
+
+	// exited containers still reference their image, so
+	// they are taken into account as well.
+	ctrResp, err := p.rtClient.ListContainers(ctx, &runtimev1.ListContainersRequest{})
+	if err != nil {
+		return fmt.Errorf("list containers: %w", err)
+	}
+
+	used := make(map[string]bool, len(ctrResp.Containers))
+	for _, ctr := range ctrResp.Containers {
+		used[ctr.ImageRef] = true
+		used[ctr.GetImage().GetImage()] = true
+	}
+
+	var (
+		now         = time.Now()
+		unusedSince = make(map[string]time.Time)
+		errs        *multierror.Error
+	)
+
+	for _, img := range imgResp.Images {
+		if !p.pulledFromRegistry(img) || isUsed(img, used) {
+			continue
+		}
+
+		since, ok := p.unusedSince[img.Id]
+		if !ok {
+			since = now
+		}
+
+		if now.Sub(since) < p.unusedFor {
+			unusedSince[img.Id] = since
+			continue
+		}
+
+		if _, err := p.imgClient.RemoveImage(ctx, &runtimev1.RemoveImageRequest{
+			Image: &runtimev1.ImageSpec{
+				Image: img.Id,
+			},
+		}); err != nil {
+			// try again in the next run
+			unusedSince[img.Id] = since
+			errs = multierror.Append(errs, fmt.Errorf("remove image %s: %w", img.Id, err))
+			continue
+		}
+
+		p.logger.InfoContext(ctx, "removed unused image", "image_id", img.Id, "tags", img.RepoTags)
+	}
+
+	p.unusedSince = unusedSince
+
+	return errs.ErrorOrNil()
+}
+
+func (p *ImagePruner) pulledFromRegistry(img *runtimev1.Image) bool {
+	prefix := p.registry + "/"
+	return slices.ContainsFunc(img.RepoTags, func(ref string) bool {
+		return strings.HasPrefix(ref, prefix)
+	}) || slices.ContainsFunc(img.RepoDigests, func(ref string) bool {
+		return strings.HasPrefix(ref, prefix)
+	})
+}
+
+func isUsed(img *runtimev1.Image, used map[string]bool) bool {
+	if used[img.Id] {
+		return true
+	}
+
+	for _, ref := range slices.Concat(img.RepoTags, img.RepoDigests) {
+		if used[ref] {
+			return true
+		}
+	}
+
+	return false
+}
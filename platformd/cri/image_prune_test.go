@@ -0,0 +1,117 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cri_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/platformd/cri"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func TestImagePrunerPrune(t *testing.T) {
+	const registry = "registry.example.com"
+
+	images := &runtimev1.ListImagesResponse{
+		Images: []*runtimev1.Image{
+			{
+				Id:       "unused",
+				RepoTags: []string{registry + "/unused:base"},
+			},
+			{
+				Id:       "used-by-tag",
+				RepoTags: []string{registry + "/used:base"},
+			},
+			{
+				Id:          "used-by-digest",
+				RepoDigests: []string{registry + "/used@sha256:abc"},
+			},
+			{
+				Id:       "system",
+				RepoTags: []string{"docker.io/envoyproxy/envoy:v1"},
+			},
+		},
+	}
+
+	containers := &runtimev1.ListContainersResponse{
+		Containers: []*runtimev1.Container{
+			{
+				Image:    &runtimev1.ImageSpec{Image: registry + "/used:base"},
+				ImageRef: "used-by-tag",
+			},
+			{
+				Image:    &runtimev1.ImageSpec{Image: "other"},
+				ImageRef: registry + "/used@sha256:abc",
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		unusedFor time.Duration
+		removed   bool
+	}{
+		{
+			name:    "removes unused images of the registry",
+			removed: true,
+		},
+		{
+			name:      "keeps images that have recently become unused",
+			unusedFor: time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx           = context.Background()
+				mockRtClient  = mock.NewMockV1RuntimeServiceClient(t)
+				mockImgClient = mock.NewMockV1ImageServiceClient(t)
+				pruner        = cri.NewImagePruner(
+					slog.New(slog.DiscardHandler),
+					mockRtClient,
+					mockImgClient,
+					registry,
+					tt.unusedFor,
+				)
+			)
+
+			mockImgClient.EXPECT().ListImages(mocky.Anything, &runtimev1.ListImagesRequest{}).Return(images, nil)
+			mockRtClient.EXPECT().
+				ListContainers(mocky.Anything, &runtimev1.ListContainersRequest{}).
+				Return(containers, nil)
+
+			if tt.removed {
+				mockImgClient.EXPECT().
+					RemoveImage(mocky.Anything, &runtimev1.RemoveImageRequest{
+						Image: &runtimev1.ImageSpec{Image: "unused"},
+					}).
+					Return(&runtimev1.RemoveImageResponse{}, nil)
+			}
+
+			require.NoError(t, pruner.Prune(ctx))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package cri
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultMirrorConfigPath is the drop-in file containers/image reads the mirror configuration from.
+const DefaultMirrorConfigPath = "/etc/containers/registries.conf.d/99-platformd-mirrors.conf"
+
+// MirrorConfig configures the mirrors the container runtime pulls images of Registry from.
+type MirrorConfig struct {
+	// Registry is the location of the primary registry, including an
+	// optional namespace (e.g. registry.example.com/explorer). mirrors
+	// are expected to serve the same repositories below their location.
+	Registry string
+
+	// Mirrors are tried in order before falling back to Registry. if
+	// a mirror is unreachable or does not have the requested image,
+	// the next one is tried, so a failing mirror never blocks pulls.
+	Mirrors []string
+
+	// Path is the registries.conf drop-in file the configuration is written to.
+	Path string
+}
+
+// EnsureMirrorConfig writes the mirror configuration as registries.conf drop-in, which
+// is picked up by cri-o when pulling images. this way the runtime takes care of the
+// failover to the primary registry and images keep the name they are referenced with,
+// which is required for restoring checkpoints. if no mirrors are configured, a
+// previously written configuration is removed. returns true if the file has changed
+// and the runtime needs to be reloaded.
+func EnsureMirrorConfig(cfg MirrorConfig) (bool, error) {
+	current, err := os.ReadFile(cfg.Path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, fmt.Errorf("read mirror config: %w", err)
+	}
+
+	exists := err == nil
+
+	if len(cfg.Mirrors) == 0 {
+		if !exists {
+			return false, nil
+		}
+
+		if err := os.Remove(cfg.Path); err != nil {
+			return false, fmt.Errorf("remove mirror config: %w", err)
+		}
+
+		return true, nil
+	}
+
+	data := mirrorConfig(cfg)
+	if exists && bytes.Equal(current, data) {
+		return false, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0o755); err != nil {
+		return false, fmt.Errorf("create mirror config dir: %w", err)
+	}
+
+	if err := os.WriteFile(cfg.Path, data, 0o644); err != nil {
+		return false, fmt.Errorf("write mirror config: %w", err)
+	}
+
+	return true, nil
+}
+
+func mirrorConfig(cfg MirrorConfig) []byte {
+	var sb strings.Builder
+	sb.WriteString("# generated by platformd. do not edit.\n\n")
+	sb.WriteString("[[registry]]\n")
+	fmt.Fprintf(&sb, "location = %s\n", strconv.Quote(cfg.Registry))
+
+	for _, m := range cfg.Mirrors {
+		sb.WriteString("\n[[registry.mirror]]\n")
+		fmt.Fprintf(&sb, "location = %s\n", strconv.Quote(m))
+	}
+
+	return []byte(sb.String())
+}
@@ -0,0 +1,76 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cri_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spacechunks/explorer/platformd/cri"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureMirrorConfig(t *testing.T) {
+	var (
+		path = filepath.Join(t.TempDir(), "registries.conf.d", "mirrors.conf")
+		cfg  = cri.MirrorConfig{
+			Registry: "registry.example.com/explorer",
+			Mirrors:  []string{"mirror-a.example.com/explorer", "mirror-b.example.com/explorer"},
+			Path:     path,
+		}
+		expected = `# generated by platformd. do not edit.
+
+[[registry]]
+location = "registry.example.com/explorer"
+
+[[registry.mirror]]
+location = "mirror-a.example.com/explorer"
+
+[[registry.mirror]]
+location = "mirror-b.example.com/explorer"
+`
+	)
+
+	changed, err := cri.EnsureMirrorConfig(cfg)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(data))
+
+	// writing the same config again must not require a reload
+	changed, err = cri.EnsureMirrorConfig(cfg)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	cfg.Mirrors = nil
+
+	changed, err = cri.EnsureMirrorConfig(cfg)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	_, err = os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	changed, err = cri.EnsureMirrorConfig(cfg)
+	require.NoError(t, err)
+	require.False(t, changed)
+}
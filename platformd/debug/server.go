@@ -0,0 +1,257 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package debug exposes the in-memory state of platformd over the
+// management socket, so operators can inspect why instances are not
+// being created, without having to attach a debugger or restart
+// platformd with a higher log level.
+package debug
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	debugv1alpha1 "github.com/spacechunks/explorer/api/platformd/debug/v1alpha1"
+	"github.com/spacechunks/explorer/internal/workloadenv"
+	"github.com/spacechunks/explorer/platformd/status"
+	"github.com/spacechunks/explorer/platformd/workload"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReconcilerState is a snapshot of the state of the reconciler.
+type ReconcilerState struct {
+	// Queue contains the instances known to the reconciler ordered by id.
+	Queue []QueuedInstance
+
+	// Attempts counts the failed attempts to create the workload
+	// of an instance, keyed by instance id.
+	Attempts map[string]uint
+
+	// Revision of the instances received from the control plane.
+	Revision string
+
+	// Reported is the revision of the status store up to which
+	// statuses have been reported to the control plane.
+	Reported uint64
+
+	Watching     bool
+	LastFullSync time.Time
+
+	// DryRun reports whether the reconciler only plans what it would
+	// do, see [QueuedInstance.Planned].
+	DryRun bool
+}
+
+type QueuedInstance struct {
+	ID              string
+	FlavorVersionID string
+
+	// State is the state of the instance as known to the control plane.
+	State    string
+	Restarts uint32
+
+	// Planned is what the reconciler would do with the instance.
+	// only set in dry run mode.
+	Planned *PlannedAction
+}
+
+const (
+	PlannedActionCreate  = "create"
+	PlannedActionRemove  = "remove"
+	PlannedActionRestart = "restart"
+)
+
+// PlannedAction is what the reconciler would do with an instance,
+// if it was not running in dry run mode.
+type PlannedAction struct {
+	// Action is empty if nothing would be done.
+	Action string
+	Port   uint16
+
+	// Err is set if the action would fail.
+	Err error
+}
+
+type Reconciler interface {
+	State() ReconcilerState
+}
+
+type Workloads interface {
+	ListWorkloads(ctx context.Context, sel workloadenv.Selector) ([]workload.Info, error)
+}
+
+type Server struct {
+	debugv1alpha1.UnimplementedDebugServiceServer
+	reconciler Reconciler
+	portAlloc  *workload.PortAllocator
+	store      status.Store
+	workloads  Workloads
+}
+
+func NewServer(
+	reconciler Reconciler,
+	portAlloc *workload.PortAllocator,
+	store status.Store,
+	workloads Workloads,
+) *Server {
+	return &Server{
+		reconciler: reconciler,
+		portAlloc:  portAlloc,
+		store:      store,
+		workloads:  workloads,
+	}
+}
+
+func (s *Server) GetReconcilerState(
+	_ context.Context,
+	_ *debugv1alpha1.GetReconcilerStateRequest,
+) (*debugv1alpha1.GetReconcilerStateResponse, error) {
+	var (
+		state             = s.reconciler.State()
+		entries, revision = s.store.Changes(0)
+	)
+
+	queue := make([]*debugv1alpha1.QueuedInstance, 0, len(state.Queue))
+	for _, ins := range state.Queue {
+		q := &debugv1alpha1.QueuedInstance{
+			InstanceId:      ins.ID,
+			FlavorVersionId: ins.FlavorVersionID,
+			State:           ins.State,
+			Restarts:        ins.Restarts,
+		}
+
+		if ins.Planned != nil {
+			q.Planned = &debugv1alpha1.PlannedAction{
+				Action: ins.Planned.Action,
+				Port:   uint32(ins.Planned.Port),
+			}
+
+			if ins.Planned.Err != nil {
+				q.Planned.Error = ins.Planned.Err.Error()
+			}
+		}
+
+		queue = append(queue, q)
+	}
+
+	attempts := make(map[string]uint32, len(state.Attempts))
+	for id, n := range state.Attempts {
+		attempts[id] = uint32(n)
+	}
+
+	allocs := s.portAlloc.Allocations()
+	ports := make([]*debugv1alpha1.PortAllocation, 0, len(allocs))
+	for _, a := range allocs {
+		p := &debugv1alpha1.PortAllocation{
+			Port:        uint32(a.Port),
+			ReservedFor: a.ReservedFor,
+		}
+
+		if !a.ExpiresAt.IsZero() {
+			p.ReservationExpiresAt = timestamppb.New(a.ExpiresAt)
+		}
+
+		ports = append(ports, p)
+	}
+
+	statuses := make([]*debugv1alpha1.StatusEntry, 0, len(entries))
+	for _, id := range slices.SortedFunc(maps.Keys(entries), strings.Compare) {
+		statuses = append(statuses, statusEntryToTransport(id, entries[id]))
+	}
+
+	resp := &debugv1alpha1.GetReconcilerStateResponse{
+		Queue:            queue,
+		Attempts:         attempts,
+		PortAllocations:  ports,
+		Statuses:         statuses,
+		Revision:         state.Revision,
+		ReportedRevision: state.Reported,
+		StatusRevision:   revision,
+		Watching:         state.Watching,
+		DryRun:           state.DryRun,
+	}
+
+	if !state.LastFullSync.IsZero() {
+		resp.LastFullSync = timestamppb.New(state.LastFullSync)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ListWorkloads(
+	ctx context.Context,
+	req *debugv1alpha1.ListWorkloadsRequest,
+) (*debugv1alpha1.ListWorkloadsResponse, error) {
+	workloads, err := s.workloads.ListWorkloads(ctx, workloadenv.Selector{
+		WorkloadType:    req.GetType(),
+		ChunkID:         req.GetChunkId(),
+		FlavorID:        req.GetFlavorId(),
+		FlavorVersionID: req.GetFlavorVersionId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*debugv1alpha1.Workload, 0, len(workloads))
+	for _, w := range workloads {
+		ret = append(ret, &debugv1alpha1.Workload{
+			PodId:           w.PodID,
+			Id:              w.Labels.WorkloadID,
+			Name:            w.Labels.WorkloadName,
+			Type:            w.Labels.WorkloadType,
+			ChunkId:         w.Labels.ChunkID,
+			FlavorId:        w.Labels.FlavorID,
+			FlavorVersionId: w.Labels.FlavorVersionID,
+			Port:            uint32(w.Labels.WorkloadPort),
+			Ready:           w.Ready,
+			CreatedAt:       timestamppb.New(w.CreatedAt),
+		})
+	}
+
+	return &debugv1alpha1.ListWorkloadsResponse{
+		Workloads: ret,
+	}, nil
+}
+
+func statusEntryToTransport(id string, e status.Entry) *debugv1alpha1.StatusEntry {
+	ret := &debugv1alpha1.StatusEntry{
+		Id:      id,
+		Version: e.Version,
+	}
+
+	if wst := e.Status.WorkloadStatus; wst != nil {
+		ret.Workload = &debugv1alpha1.WorkloadStatus{
+			State:         string(wst.State),
+			Port:          uint32(wst.Port),
+			PendingEvents: uint32(len(wst.Events)),
+		}
+	}
+
+	if cst := e.Status.CheckpointStatus; cst != nil {
+		ret.Checkpoint = &debugv1alpha1.CheckpointStatus{
+			State:   string(cst.State),
+			Message: cst.Message,
+			Port:    uint32(cst.Port),
+		}
+	}
+
+	return ret
+}
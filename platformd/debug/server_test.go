@@ -0,0 +1,216 @@
+package debug_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	debugv1alpha1 "github.com/spacechunks/explorer/api/platformd/debug/v1alpha1"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/workloadenv"
+	"github.com/spacechunks/explorer/platformd/debug"
+	"github.com/spacechunks/explorer/platformd/status"
+	"github.com/spacechunks/explorer/platformd/workload"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type reconciler struct {
+	state debug.ReconcilerState
+}
+
+func (r *reconciler) State() debug.ReconcilerState {
+	return r.state
+}
+
+func TestGetReconcilerState(t *testing.T) {
+	var (
+		portAlloc = workload.NewPortAllocator(30000, 30000)
+		store     = status.NewMemStore()
+		rec       = &reconciler{
+			state: debug.ReconcilerState{
+				Queue: []debug.QueuedInstance{
+					{
+						ID:              "pending",
+						FlavorVersionID: "fv",
+						State:           "PENDING",
+					},
+				},
+				Attempts: map[string]uint{"pending": 3},
+				Revision: "2",
+				Reported: 1,
+				Watching: true,
+			},
+		}
+	)
+
+	require.True(t, portAlloc.Claim(30001))
+
+	store.Update("pending", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateCreating,
+			Port:  30001,
+		},
+	})
+
+	store.Update("checkpoint", status.Status{
+		CheckpointStatus: &status.CheckpointStatus{
+			State:   status.CheckpointStatePullBaseImageFailed,
+			Message: "not found",
+		},
+	})
+
+	resp, err := debug.NewServer(rec, portAlloc, store, nil).GetReconcilerState(
+		context.Background(),
+		&debugv1alpha1.GetReconcilerStateRequest{},
+	)
+	require.NoError(t, err)
+
+	expected := &debugv1alpha1.GetReconcilerStateResponse{
+		Queue: []*debugv1alpha1.QueuedInstance{
+			{
+				InstanceId:      "pending",
+				FlavorVersionId: "fv",
+				State:           "PENDING",
+			},
+		},
+		Attempts: map[string]uint32{"pending": 3},
+		PortAllocations: []*debugv1alpha1.PortAllocation{
+			{
+				Port: 30001,
+			},
+		},
+		Statuses: []*debugv1alpha1.StatusEntry{
+			{
+				Id:      "checkpoint",
+				Version: 2,
+				Checkpoint: &debugv1alpha1.CheckpointStatus{
+					State:   "PULL_BASE_IMAGE_FAILED",
+					Message: "not found",
+				},
+			},
+			{
+				Id:      "pending",
+				Version: 1,
+				Workload: &debugv1alpha1.WorkloadStatus{
+					State: "CREATING",
+					Port:  30001,
+				},
+			},
+		},
+		Revision:         "2",
+		ReportedRevision: 1,
+		StatusRevision:   2,
+		Watching:         true,
+	}
+
+	if d := cmp.Diff(expected, resp, protocmp.Transform()); d != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestGetReconcilerStateDryRun(t *testing.T) {
+	rec := &reconciler{
+		state: debug.ReconcilerState{
+			Queue: []debug.QueuedInstance{
+				{
+					ID:    "pending",
+					State: "PENDING",
+					Planned: &debug.PlannedAction{
+						Action: debug.PlannedActionCreate,
+						Port:   30000,
+						Err:    errors.New("pull images: unauthorized"),
+					},
+				},
+			},
+			DryRun: true,
+		},
+	}
+
+	resp, err := debug.NewServer(rec, workload.NewPortAllocator(30000, 30000), status.NewMemStore(), nil).
+		GetReconcilerState(context.Background(), &debugv1alpha1.GetReconcilerStateRequest{})
+	require.NoError(t, err)
+
+	expected := &debugv1alpha1.GetReconcilerStateResponse{
+		Queue: []*debugv1alpha1.QueuedInstance{
+			{
+				InstanceId: "pending",
+				State:      "PENDING",
+				Planned: &debugv1alpha1.PlannedAction{
+					Action: debug.PlannedActionCreate,
+					Port:   30000,
+					Error:  "pull images: unauthorized",
+				},
+			},
+		},
+		Attempts:        map[string]uint32{},
+		PortAllocations: []*debugv1alpha1.PortAllocation{},
+		Statuses:        []*debugv1alpha1.StatusEntry{},
+		DryRun:          true,
+	}
+
+	if d := cmp.Diff(expected, resp, protocmp.Transform()); d != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestListWorkloads(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		mockWlSvc = mock.NewMockWorkloadService(t)
+		createdAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		sel       = workloadenv.Selector{
+			WorkloadType: workloadenv.WorkloadTypeInstance,
+			ChunkID:      "chunk",
+		}
+	)
+
+	mockWlSvc.EXPECT().
+		ListWorkloads(ctx, sel).
+		Return([]workload.Info{
+			{
+				PodID: "pod",
+				Labels: workloadenv.Labels{
+					WorkloadID:      "ins",
+					WorkloadName:    "fv",
+					WorkloadType:    workloadenv.WorkloadTypeInstance,
+					ChunkID:         "chunk",
+					FlavorID:        "flavor",
+					FlavorVersionID: "fv",
+					WorkloadPort:    30001,
+				},
+				Ready:     true,
+				CreatedAt: createdAt,
+			},
+		}, nil)
+
+	resp, err := debug.NewServer(nil, nil, nil, mockWlSvc).ListWorkloads(ctx, &debugv1alpha1.ListWorkloadsRequest{
+		Type:    workloadenv.WorkloadTypeInstance,
+		ChunkId: "chunk",
+	})
+	require.NoError(t, err)
+
+	expected := &debugv1alpha1.ListWorkloadsResponse{
+		Workloads: []*debugv1alpha1.Workload{
+			{
+				PodId:           "pod",
+				Id:              "ins",
+				Name:            "fv",
+				Type:            "instance",
+				ChunkId:         "chunk",
+				FlavorId:        "flavor",
+				FlavorVersionId: "fv",
+				Port:            30001,
+				Ready:           true,
+				CreatedAt:       timestamppb.New(createdAt),
+			},
+		},
+	}
+
+	if d := cmp.Diff(expected, resp, protocmp.Transform()); d != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", d)
+	}
+}
@@ -0,0 +1,21 @@
+package dns
+
+import "net/netip"
+
+type Config struct {
+	// Dir is the directory the generated Corefile and hosts
+	// file are written to. it is mounted into the coredns
+	// container at ContainerDir.
+	Dir          string
+	ContainerDir string
+
+	// BaseCorefile is the path to the operator provided Corefile.
+	// the server block for Zone is appended to its contents.
+	BaseCorefile string
+
+	Zone       string
+	ListenAddr netip.AddrPort
+
+	// NodeAddr is used for records that do not specify an address.
+	NodeAddr netip.Addr
+}
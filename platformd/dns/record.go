@@ -0,0 +1,45 @@
+package dns
+
+import (
+	"errors"
+	"net/netip"
+	"regexp"
+)
+
+var (
+	ErrInvalidNamespace = errors.New("namespace is not a valid dns label")
+	ErrInvalidName      = errors.New("name is not a valid dns label")
+)
+
+var labelRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// Record maps <Name>.<Namespace>.<zone> to Addr. if Addr is
+// not set, the record resolves to the address of the node.
+type Record struct {
+	Namespace string     `json:"namespace"`
+	Name      string     `json:"name"`
+	Addr      netip.Addr `json:"addr"`
+}
+
+func (r Record) FQDN(zone string) string {
+	return r.Name + "." + r.Namespace + "." + zone
+}
+
+// Validate checks that namespace and name are valid dns labels. the dns
+// api is also served on the checkpoint server, which does not validate
+// requests, so we cannot solely rely on the proto validation rules.
+func (r Record) Validate() error {
+	if !labelRegex.MatchString(r.Namespace) {
+		return ErrInvalidNamespace
+	}
+
+	if !labelRegex.MatchString(r.Name) {
+		return ErrInvalidName
+	}
+
+	return nil
+}
+
+func (r Record) key() string {
+	return r.Namespace + "/" + r.Name
+}
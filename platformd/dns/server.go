@@ -0,0 +1,91 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	dnsv1alpha1 "github.com/spacechunks/explorer/api/platformd/dns/v1alpha1"
+)
+
+type Server struct {
+	dnsv1alpha1.UnimplementedDNSServiceServer
+	svc Service
+}
+
+func NewServer(svc Service) *Server {
+	return &Server{
+		svc: svc,
+	}
+}
+
+func (s *Server) PutRecords(
+	ctx context.Context,
+	req *dnsv1alpha1.PutRecordsRequest,
+) (*dnsv1alpha1.PutRecordsResponse, error) {
+	records := make([]Record, 0, len(req.GetRecords()))
+	for _, r := range req.GetRecords() {
+		rec := Record{
+			Namespace: r.GetNamespace(),
+			Name:      r.GetName(),
+		}
+
+		if r.GetIp() != "" {
+			addr, err := netip.ParseAddr(r.GetIp())
+			if err != nil {
+				return nil, fmt.Errorf("parse addr: %w", err)
+			}
+			rec.Addr = addr
+		}
+
+		records = append(records, rec)
+	}
+
+	if err := s.svc.PutRecords(ctx, records); err != nil {
+		return nil, fmt.Errorf("put records: %w", err)
+	}
+
+	return &dnsv1alpha1.PutRecordsResponse{}, nil
+}
+
+func (s *Server) DeleteRecords(
+	ctx context.Context,
+	req *dnsv1alpha1.DeleteRecordsRequest,
+) (*dnsv1alpha1.DeleteRecordsResponse, error) {
+	records := make([]Record, 0, len(req.GetRecords()))
+	for _, r := range req.GetRecords() {
+		records = append(records, Record{
+			Namespace: r.GetNamespace(),
+			Name:      r.GetName(),
+		})
+	}
+
+	if err := s.svc.DeleteRecords(ctx, records); err != nil {
+		return nil, fmt.Errorf("delete records: %w", err)
+	}
+
+	return &dnsv1alpha1.DeleteRecordsResponse{}, nil
+}
+
+func (s *Server) ListRecords(
+	ctx context.Context,
+	_ *dnsv1alpha1.ListRecordsRequest,
+) (*dnsv1alpha1.ListRecordsResponse, error) {
+	records := s.svc.ListRecords(ctx)
+
+	ret := make([]*dnsv1alpha1.Record, 0, len(records))
+	for _, r := range records {
+		rec := &dnsv1alpha1.Record{
+			Namespace: r.Namespace,
+			Name:      r.Name,
+		}
+		if r.Addr.IsValid() {
+			rec.Ip = r.Addr.String()
+		}
+		ret = append(ret, rec)
+	}
+
+	return &dnsv1alpha1.ListRecordsResponse{
+		Records: ret,
+	}, nil
+}
@@ -0,0 +1,223 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+const (
+	CorefileName    = "Corefile"
+	HostsFileName   = "hosts"
+	recordsFileName = "records.json"
+)
+
+type Service interface {
+	// EnsureConfig writes the Corefile and the hosts file containing all
+	// records. records registered before platformd restarted are restored.
+	EnsureConfig(ctx context.Context) error
+
+	// PutRecords adds the given records or replaces existing records
+	// with the same namespace and name.
+	PutRecords(ctx context.Context, records []Record) error
+
+	// DeleteRecords removes the records with the same namespace and name
+	// as the given ones. records that do not exist are ignored.
+	DeleteRecords(ctx context.Context, records []Record) error
+
+	// ListRecords returns all records sorted by namespace and name.
+	ListRecords(ctx context.Context) []Record
+}
+
+type dnsService struct {
+	logger  *slog.Logger
+	cfg     Config
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+func NewService(logger *slog.Logger, cfg Config) Service {
+	return &dnsService{
+		logger:  logger,
+		cfg:     cfg,
+		records: make(map[string]Record),
+	}
+}
+
+func (s *dnsService) EnsureConfig(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.cfg.Dir, 0o755); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+
+	base, err := os.ReadFile(s.cfg.BaseCorefile)
+	if err != nil {
+		return fmt.Errorf("read base corefile: %w", err)
+	}
+
+	if err := writeFileAtomic(filepath.Join(s.cfg.Dir, CorefileName), s.corefile(base)); err != nil {
+		return fmt.Errorf("write corefile: %w", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.cfg.Dir, recordsFileName))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("read records: %w", err)
+	}
+
+	if len(data) > 0 {
+		var records []Record
+		if err := json.Unmarshal(data, &records); err != nil {
+			return fmt.Errorf("unmarshal records: %w", err)
+		}
+		for _, r := range records {
+			s.records[r.key()] = r
+		}
+	}
+
+	s.logger.InfoContext(ctx, "restored dns records", "count", len(s.records))
+
+	return s.persist()
+}
+
+func (s *dnsService) PutRecords(ctx context.Context, records []Record) error {
+	for _, r := range records {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("record %s/%s: %w", r.Namespace, r.Name, err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range records {
+		s.logger.InfoContext(ctx, "putting dns record", "fqdn", r.FQDN(s.cfg.Zone), "addr", r.Addr)
+		s.records[r.key()] = r
+	}
+
+	return s.persist()
+}
+
+func (s *dnsService) DeleteRecords(ctx context.Context, records []Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range records {
+		s.logger.InfoContext(ctx, "deleting dns record", "fqdn", r.FQDN(s.cfg.Zone))
+		delete(s.records, r.key())
+	}
+
+	return s.persist()
+}
+
+func (s *dnsService) ListRecords(_ context.Context) []Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sorted()
+}
+
+// persist writes the records file and the hosts file. the records file
+// keeps records as they have been registered, so records resolving to the
+// node still work if the address of the node changes.
+func (s *dnsService) persist() error {
+	records := s.sorted()
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("marshal records: %w", err)
+	}
+
+	if err := writeFileAtomic(filepath.Join(s.cfg.Dir, recordsFileName), data); err != nil {
+		return fmt.Errorf("write records: %w", err)
+	}
+
+	var sb strings.Builder
+	for _, r := range records {
+		addr := r.Addr
+		if !addr.IsValid() {
+			addr = s.cfg.NodeAddr
+		}
+		fmt.Fprintf(&sb, "%s %s\n", addr, r.FQDN(s.cfg.Zone))
+	}
+
+	// coredns picks up changes to the hosts file on its own,
+	// so there is no need to restart or signal the container.
+	if err := writeFileAtomic(filepath.Join(s.cfg.Dir, HostsFileName), []byte(sb.String())); err != nil {
+		return fmt.Errorf("write hosts: %w", err)
+	}
+
+	return nil
+}
+
+func (s *dnsService) sorted() []Record {
+	ret := make([]Record, 0, len(s.records))
+	for _, r := range s.records {
+		ret = append(ret, r)
+	}
+	slices.SortFunc(ret, func(a, b Record) int {
+		return strings.Compare(a.key(), b.key())
+	})
+	return ret
+}
+
+func (s *dnsService) corefile(base []byte) []byte {
+	var sb strings.Builder
+	sb.Write(base)
+	fmt.Fprintf(&sb, `
+# generated by platformd. serves records registered
+# through the dns service api.
+%s:%d {
+    bind %s
+    errors
+    hosts %s {
+        reload 2s
+    }
+}
+`,
+		s.cfg.Zone,
+		s.cfg.ListenAddr.Port(),
+		s.cfg.ListenAddr.Addr(),
+		filepath.Join(s.cfg.ContainerDir, HostsFileName),
+	)
+	return []byte(sb.String())
+}
+
+// writeFileAtomic replaces the file at path, so coredns never observes
+// a partially written file. this only works, because the whole directory
+// is mounted into the container instead of the individual files.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write: %w", err)
+	}
+
+	if err := f.Chmod(0o644); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("chmod: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close: %w", err)
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("rename: %w", err)
+	}
+
+	return nil
+}
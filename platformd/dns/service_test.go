@@ -0,0 +1,155 @@
+package dns_test
+
+import (
+	"context"
+	"log/slog"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spacechunks/explorer/platformd/dns"
+	"github.com/stretchr/testify/require"
+)
+
+const baseCorefile = `.:1053 {
+    bind 127.0.0.1
+    forward . 1.1.1.1
+}
+`
+
+func TestEnsureConfigWritesCorefile(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		cfg    = testConfig(t)
+		logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		svc    = dns.NewService(logger, cfg)
+	)
+
+	require.NoError(t, svc.EnsureConfig(ctx))
+
+	expected := baseCorefile + `
+# generated by platformd. serves records registered
+# through the dns service api.
+svc.chunks.internal:1053 {
+    bind 127.0.0.1
+    errors
+    hosts /etc/coredns/hosts {
+        reload 2s
+    }
+}
+`
+
+	actual, err := os.ReadFile(filepath.Join(cfg.Dir, dns.CorefileName))
+	require.NoError(t, err)
+	require.Equal(t, expected, string(actual))
+
+	hosts, err := os.ReadFile(filepath.Join(cfg.Dir, dns.HostsFileName))
+	require.NoError(t, err)
+	require.Empty(t, string(hosts))
+}
+
+func TestRecordsAreServedAndRestored(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		cfg    = testConfig(t)
+		logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		svc    = dns.NewService(logger, cfg)
+		redis  = dns.Record{
+			Namespace: "shared",
+			Name:      "redis",
+		}
+		db = dns.Record{
+			Namespace: "shared",
+			Name:      "db",
+			Addr:      netip.MustParseAddr("10.1.0.5"),
+		}
+		other = dns.Record{
+			Namespace: "other",
+			Name:      "redis",
+			Addr:      netip.MustParseAddr("10.1.0.6"),
+		}
+	)
+
+	require.NoError(t, svc.EnsureConfig(ctx))
+	require.NoError(t, svc.PutRecords(ctx, []dns.Record{redis, db, other}))
+	require.NoError(t, svc.DeleteRecords(ctx, []dns.Record{{Namespace: "other", Name: "redis"}}))
+
+	// simulate platformd restarting, records have to be restored
+	restarted := dns.NewService(logger, cfg)
+	require.NoError(t, restarted.EnsureConfig(ctx))
+
+	require.Equal(t, []dns.Record{db, redis}, restarted.ListRecords(ctx))
+
+	hosts, err := os.ReadFile(filepath.Join(cfg.Dir, dns.HostsFileName))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"10.1.0.5 db.shared.svc.chunks.internal\n192.168.1.10 redis.shared.svc.chunks.internal\n",
+		string(hosts),
+	)
+}
+
+func TestPutRecordsRejectsInvalidLabels(t *testing.T) {
+	tests := []struct {
+		name   string
+		record dns.Record
+		err    error
+	}{
+		{
+			name: "namespace contains dot",
+			record: dns.Record{
+				Namespace: "a.b",
+				Name:      "redis",
+			},
+			err: dns.ErrInvalidNamespace,
+		},
+		{
+			name: "empty name",
+			record: dns.Record{
+				Namespace: "shared",
+			},
+			err: dns.ErrInvalidName,
+		},
+		{
+			name: "name with uppercase letters",
+			record: dns.Record{
+				Namespace: "shared",
+				Name:      "Redis",
+			},
+			err: dns.ErrInvalidName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx    = context.Background()
+				logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+				svc    = dns.NewService(logger, testConfig(t))
+			)
+
+			require.NoError(t, svc.EnsureConfig(ctx))
+			require.ErrorIs(t, svc.PutRecords(ctx, []dns.Record{tt.record}), tt.err)
+			require.Empty(t, svc.ListRecords(ctx))
+		})
+	}
+}
+
+func testConfig(t *testing.T) dns.Config {
+	var (
+		dir  = t.TempDir()
+		base = filepath.Join(dir, "dns.conf")
+	)
+
+	require.NoError(t, os.WriteFile(base, []byte(baseCorefile), 0o644))
+
+	return dns.Config{
+		Dir:          filepath.Join(dir, "dns"),
+		ContainerDir: "/etc/coredns",
+		BaseCorefile: base,
+		Zone:         "svc.chunks.internal",
+		ListenAddr:   netip.MustParseAddrPort("127.0.0.1:1053"),
+		NodeAddr:     netip.MustParseAddr("192.168.1.10"),
+	}
+}
@@ -0,0 +1,489 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package logship
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/platformd/workload"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultInterval is used if Config.Interval is not set.
+const DefaultInterval = 10 * time.Second
+
+const (
+	// maxRequestBytes is the maximum number of bytes read from
+	// log files, before the read lines are shipped.
+	maxRequestBytes = 1 << 20
+
+	// maxBatches is the maximum number of batches accepted by the control plane.
+	maxBatches = 256
+
+	// offsetsFile is the file in the archive dir the offsets are
+	// persisted to, so lines are not shipped again after a restart.
+	offsetsFile = "offsets.json"
+)
+
+type Config struct {
+	NodeID string
+
+	// LogDir contains the log directories of the running workloads.
+	LogDir string
+
+	// ArchiveDir contains the log directories of removed workloads, see
+	// workload.Config.LogArchiveDir. they are deleted once all their
+	// lines have been shipped.
+	ArchiveDir string
+
+	// Interval in which new lines are shipped to the control plane.
+	Interval time.Duration
+}
+
+// Shipper tails the container logs of all workloads and ships new lines to
+// the control plane, where they are kept after the instance and the node
+// are long gone. the servermon logs are not shipped, because they are not
+// meant to be seen by the owner of the instance.
+type Shipper struct {
+	logger    *slog.Logger
+	cfg       Config
+	insClient instancev1alpha1.InstanceServiceClient
+	ticker    *time.Ticker
+	stop      chan struct{}
+
+	// offsets contains the number of bytes of each log file that have been
+	// shipped. files are identified by their inode instead of their path,
+	// because the log dirs of removed workloads are moved to the archive.
+	// it is only accessed by the goroutine calling Ship.
+	offsets map[string]int64
+}
+
+func NewShipper(
+	logger *slog.Logger,
+	cfg Config,
+	insClient instancev1alpha1.InstanceServiceClient,
+) *Shipper {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+
+	return &Shipper{
+		logger:    logger,
+		cfg:       cfg,
+		insClient: insClient,
+		ticker:    time.NewTicker(cfg.Interval),
+		stop:      make(chan struct{}),
+		offsets:   make(map[string]int64),
+	}
+}
+
+func (s *Shipper) Run(ctx context.Context) {
+	if err := s.loadOffsets(); err != nil {
+		// shipping still works, the lines are shipped again in the worst case.
+		s.logger.WarnContext(ctx, "loading log offsets failed", "err", err)
+	}
+
+	for {
+		select {
+		case <-s.ticker.C:
+			if err := s.Ship(ctx); err != nil {
+				s.logger.ErrorContext(ctx, "shipping logs failed", "err", err)
+			}
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+func (s *Shipper) Stop() {
+	s.ticker.Stop()
+	close(s.stop)
+}
+
+// logFile is a container log file of a workload.
+type logFile struct {
+	key        string
+	path       string
+	dir        string
+	instanceID string
+	archived   bool
+	size       int64
+}
+
+// Ship sends all lines written since the last call to the control plane.
+// archived log directories are removed once they have been shipped entirely.
+func (s *Shipper) Ship(ctx context.Context) error {
+	files, err := s.logFiles()
+	if err != nil {
+		return err
+	}
+
+	// forget the offsets of files that have been removed in the meantime.
+	known := make(map[string]bool, len(files))
+	for _, f := range files {
+		known[f.key] = true
+	}
+
+	for key := range s.offsets {
+		if !known[key] {
+			delete(s.offsets, key)
+		}
+	}
+
+	for {
+		batches, offsets, more, err := s.read(files)
+		if err != nil {
+			return err
+		}
+
+		if len(batches) > 0 {
+			if _, err := s.insClient.ReceiveInstanceLogs(ctx, &instancev1alpha1.ReceiveInstanceLogsRequest{
+				NodeKey: s.cfg.NodeID,
+				Batches: batches,
+			}); err != nil {
+				return fmt.Errorf("receive instance logs: %w", err)
+			}
+		}
+
+		// only commit the offsets once the lines have been received,
+		// otherwise they are read again the next time.
+		for key, off := range offsets {
+			s.offsets[key] = off
+		}
+
+		if err := s.saveOffsets(); err != nil {
+			s.logger.WarnContext(ctx, "saving log offsets failed", "err", err)
+		}
+
+		// stop if no complete line could be read, even though
+		// there is more to read. this is retried next time.
+		if !more || len(offsets) == 0 {
+			break
+		}
+	}
+
+	return s.removeShipped(ctx, files)
+}
+
+// logFiles returns the container log files of all running and removed workloads.
+func (s *Shipper) logFiles() ([]logFile, error) {
+	var files []logFile
+	for _, d := range []struct {
+		path     string
+		archived bool
+	}{
+		{path: s.cfg.LogDir},
+		{path: s.cfg.ArchiveDir, archived: true},
+	} {
+		if d.path == "" {
+			continue
+		}
+
+		entries, err := os.ReadDir(d.path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("read dir: %w", err)
+		}
+
+		for _, e := range entries {
+			if !e.IsDir() {
+				continue
+			}
+
+			id, ok := workload.InstanceIDFromLogDir(e.Name())
+			if !ok {
+				continue
+			}
+
+			dir := filepath.Join(d.path, e.Name())
+
+			logs, err := filepath.Glob(filepath.Join(dir, "*.log"))
+			if err != nil {
+				return nil, fmt.Errorf("glob: %w", err)
+			}
+
+			for _, l := range logs {
+				info, err := os.Stat(l)
+				if err != nil {
+					if errors.Is(err, fs.ErrNotExist) {
+						continue
+					}
+					return nil, fmt.Errorf("stat: %w", err)
+				}
+
+				st, ok := info.Sys().(*syscall.Stat_t)
+				if !ok {
+					return nil, fmt.Errorf("unexpected stat type %T", info.Sys())
+				}
+
+				files = append(files, logFile{
+					key:        fmt.Sprintf("%d:%d", st.Dev, st.Ino),
+					path:       l,
+					dir:        dir,
+					instanceID: id,
+					archived:   d.archived,
+					size:       info.Size(),
+				})
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// read reads the lines written to the files since their last offset, until
+// maxRequestBytes have been read. the returned offsets have to be committed
+// once the batches have been shipped. more is true if not all lines could
+// be read.
+func (s *Shipper) read(files []logFile) (
+	batches []*instancev1alpha1.InstanceLogBatch,
+	offsets map[string]int64,
+	more bool,
+	err error,
+) {
+	var (
+		budget int64 = maxRequestBytes
+		ret          = make(map[string]int64)
+	)
+
+	for _, f := range files {
+		if budget <= 0 || len(batches) == maxBatches {
+			return batches, ret, true, nil
+		}
+
+		data, off, err := s.readFile(f, budget)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("read %s: %w", f.path, err)
+		}
+
+		budget -= int64(len(data))
+
+		// lines written by removed workloads are never completed, so
+		// they have to be shipped as they are. this is also the case if
+		// a single line does not fit into a request.
+		flush := (f.archived && off+int64(len(data)) >= f.size) || int64(len(data)) == maxRequestBytes
+
+		lines, n := ParseLines(data, flush)
+		if n == 0 {
+			continue
+		}
+
+		ret[f.key] = off + int64(n)
+
+		if len(lines) == 0 {
+			continue
+		}
+
+		batches = append(batches, &instancev1alpha1.InstanceLogBatch{
+			InstanceId: f.instanceID,
+			Lines:      lines,
+		})
+	}
+
+	return batches, ret, false, nil
+}
+
+// readFile returns at most limit bytes of the file starting at its current offset.
+func (s *Shipper) readFile(f logFile, limit int64) ([]byte, int64, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, 0, nil
+		}
+		return nil, 0, fmt.Errorf("open: %w", err)
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, 0, fmt.Errorf("stat: %w", err)
+	}
+
+	off := s.offsets[f.key]
+
+	// the file has been truncated, start over.
+	if info.Size() < off {
+		off = 0
+	}
+
+	if _, err := file.Seek(off, io.SeekStart); err != nil {
+		return nil, 0, fmt.Errorf("seek: %w", err)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, limit))
+	if err != nil {
+		return nil, 0, fmt.Errorf("read: %w", err)
+	}
+
+	return data, off, nil
+}
+
+// removeShipped removes the archived log dirs whose files have been shipped entirely.
+func (s *Shipper) removeShipped(ctx context.Context, files []logFile) error {
+	// archived files are not written to anymore, so
+	// the size they had when listing them is still valid.
+	pending := make(map[string]bool)
+	for _, f := range files {
+		if !f.archived {
+			continue
+		}
+		pending[f.dir] = pending[f.dir] || f.size > s.offsets[f.key]
+	}
+
+	for dir, p := range pending {
+		if p {
+			continue
+		}
+
+		s.logger.InfoContext(ctx, "remove shipped log dir", "path", dir)
+
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("remove: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shipper) loadOffsets() error {
+	if s.cfg.ArchiveDir == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.cfg.ArchiveDir, offsetsFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("read: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &s.offsets); err != nil {
+		return fmt.Errorf("unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Shipper) saveOffsets() error {
+	if s.cfg.ArchiveDir == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(s.offsets)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+
+	var (
+		path = filepath.Join(s.cfg.ArchiveDir, offsetsFile)
+		tmp  = path + ".tmp"
+	)
+
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write: %w", err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("rename: %w", err)
+	}
+
+	return nil
+}
+
+// ParseLines parses the lines of a container log written by the container
+// runtime, which are in the format "<time> <stream> <P|F> <message>". lines
+// split by the runtime (P) are joined with the following lines, up to the
+// final line (F). the number of bytes parsed is returned. incomplete lines at
+// the end of data are not parsed, unless flush is true. malformed lines are
+// skipped.
+func ParseLines(data []byte, flush bool) ([]*instancev1alpha1.InstanceLogLine, int) {
+	var (
+		lines   []*instancev1alpha1.InstanceLogLine
+		partial *instancev1alpha1.InstanceLogLine
+		off     int
+		n       int
+	)
+
+	for off < len(data) {
+		i := bytes.IndexByte(data[off:], '\n')
+		if i < 0 {
+			if !flush {
+				break
+			}
+			// the line has not been terminated.
+			i = len(data) - off
+		}
+
+		line := string(data[off : off+i])
+		off = min(off+i+1, len(data))
+
+		ts, rest, _ := strings.Cut(line, " ")
+		stream, rest, _ := strings.Cut(rest, " ")
+		tag, msg, ok := strings.Cut(rest, " ")
+
+		t, err := time.Parse(time.RFC3339Nano, ts)
+		if !ok || err != nil {
+			if partial == nil {
+				n = off
+			}
+			continue
+		}
+
+		if partial == nil {
+			partial = &instancev1alpha1.InstanceLogLine{
+				Time:   timestamppb.New(t),
+				Stream: stream,
+			}
+		}
+
+		partial.Message += msg
+
+		if tag == "P" {
+			continue
+		}
+
+		lines = append(lines, partial)
+		partial = nil
+		n = off
+	}
+
+	if flush && partial != nil {
+		lines = append(lines, partial)
+		n = off
+	}
+
+	return lines, n
+}
@@ -0,0 +1,237 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package logship_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/platformd/logship"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	nodeID     = "node"
+	instanceID = "inst-id"
+	logDirName = "chunk_flavor_1.0.0_chunk-id_fv-id_" + instanceID + "_owner-id"
+)
+
+func TestParseLines(t *testing.T) {
+	ts := time.Date(2026, 1, 1, 12, 0, 0, 123, time.UTC)
+
+	line := func(stream string, msg string) *instancev1alpha1.InstanceLogLine {
+		return &instancev1alpha1.InstanceLogLine{
+			Time:    timestamppb.New(ts),
+			Stream:  stream,
+			Message: msg,
+		}
+	}
+
+	tests := []struct {
+		name  string
+		data  string
+		flush bool
+		lines []*instancev1alpha1.InstanceLogLine
+		// rest is the suffix of data that is not parsed.
+		rest string
+	}{
+		{
+			name: "complete lines",
+			data: "2026-01-01T12:00:00.000000123Z stdout F Starting server\n" +
+				"2026-01-01T12:00:00.000000123Z stderr F Done\n",
+			lines: []*instancev1alpha1.InstanceLogLine{
+				line("stdout", "Starting server"),
+				line("stderr", "Done"),
+			},
+		},
+		{
+			name: "partial lines are joined",
+			data: "2026-01-01T12:00:00.000000123Z stdout P abc\n" +
+				"2026-01-01T12:00:00.000000123Z stdout F def\n",
+			lines: []*instancev1alpha1.InstanceLogLine{
+				line("stdout", "abcdef"),
+			},
+		},
+		{
+			name: "incomplete lines are not parsed",
+			data: "2026-01-01T12:00:00.000000123Z stdout F abc\n" +
+				"2026-01-01T12:00:00.000000123Z stdout P def\n" +
+				"2026-01-01T12:00:00.000000123Z stdout F gh",
+			lines: []*instancev1alpha1.InstanceLogLine{
+				line("stdout", "abc"),
+			},
+			rest: "2026-01-01T12:00:00.000000123Z stdout P def\n" +
+				"2026-01-01T12:00:00.000000123Z stdout F gh",
+		},
+		{
+			name: "incomplete lines are parsed when flushing",
+			data: "2026-01-01T12:00:00.000000123Z stdout P abc\n" +
+				"2026-01-01T12:00:00.000000123Z stdout F de",
+			flush: true,
+			lines: []*instancev1alpha1.InstanceLogLine{
+				line("stdout", "abcde"),
+			},
+		},
+		{
+			name: "malformed lines are skipped",
+			data: "garbage\n" +
+				"2026-01-01T12:00:00.000000123Z stdout F abc\n",
+			lines: []*instancev1alpha1.InstanceLogLine{
+				line("stdout", "abc"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, n := logship.ParseLines([]byte(tt.data), tt.flush)
+			require.Equal(t, len(tt.data)-len(tt.rest), n)
+
+			if d := cmp.Diff(tt.lines, lines, protocmp.Transform()); d != "" {
+				t.Fatalf("diff (-want +got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestShipperShipsNewLines(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		logDir  = t.TempDir()
+		archive = t.TempDir()
+		client  = mock.NewMockV1alpha1InstanceServiceClient(t)
+		shipper = logship.NewShipper(
+			slog.New(slog.DiscardHandler),
+			logship.Config{
+				NodeID:     nodeID,
+				LogDir:     logDir,
+				ArchiveDir: archive,
+				Interval:   time.Minute,
+			},
+			client,
+		)
+		dir     = filepath.Join(logDir, logDirName)
+		logFile = filepath.Join(dir, "ctr-id.log")
+	)
+
+	require.NoError(t, os.Mkdir(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "servermon.slog"), []byte(
+		"2026-01-01T12:00:00Z stdout F not shipped\n",
+	), 0o600))
+
+	appendLog(t, logFile, "2026-01-01T12:00:00Z stdout F first\n")
+
+	var received []string
+	client.EXPECT().
+		ReceiveInstanceLogs(mocky.Anything, mocky.Anything).
+		RunAndReturn(func(
+			_ context.Context,
+			req *instancev1alpha1.ReceiveInstanceLogsRequest,
+			_ ...grpc.CallOption,
+		) (*instancev1alpha1.ReceiveInstanceLogsResponse, error) {
+			require.Equal(t, nodeID, req.GetNodeKey())
+			for _, b := range req.GetBatches() {
+				require.Equal(t, instanceID, b.GetInstanceId())
+				for _, l := range b.GetLines() {
+					received = append(received, l.GetMessage())
+				}
+			}
+			return &instancev1alpha1.ReceiveInstanceLogsResponse{}, nil
+		})
+
+	require.NoError(t, shipper.Ship(ctx))
+	require.Equal(t, []string{"first"}, received)
+
+	// only lines written in the meantime are shipped
+	appendLog(t, logFile, "2026-01-01T12:00:01Z stdout F second\n")
+	require.NoError(t, shipper.Ship(ctx))
+	require.Equal(t, []string{"first", "second"}, received)
+
+	// lines written before the workload has been removed are
+	// shipped, afterward the archived log dir is removed.
+	appendLog(t, logFile, "2026-01-01T12:00:02Z stdout F last\n")
+
+	archived := filepath.Join(archive, logDirName+".1")
+	require.NoError(t, os.Rename(dir, archived))
+
+	require.NoError(t, shipper.Ship(ctx))
+	require.Equal(t, []string{"first", "second", "last"}, received)
+
+	_, err := os.Stat(archived)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestShipperRetriesFailedLines(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		logDir  = t.TempDir()
+		client  = mock.NewMockV1alpha1InstanceServiceClient(t)
+		shipper = logship.NewShipper(
+			slog.New(slog.DiscardHandler),
+			logship.Config{
+				NodeID:     nodeID,
+				LogDir:     logDir,
+				ArchiveDir: t.TempDir(),
+				Interval:   time.Minute,
+			},
+			client,
+		)
+		dir = filepath.Join(logDir, logDirName)
+	)
+
+	require.NoError(t, os.Mkdir(dir, 0o755))
+	appendLog(t, filepath.Join(dir, "ctr-id.log"), "2026-01-01T12:00:00Z stdout F first\n")
+
+	client.EXPECT().
+		ReceiveInstanceLogs(mocky.Anything, mocky.Anything).
+		Return(nil, errors.New("unavailable")).
+		Once()
+
+	require.Error(t, shipper.Ship(ctx))
+
+	client.EXPECT().
+		ReceiveInstanceLogs(mocky.Anything, mocky.MatchedBy(func(req *instancev1alpha1.ReceiveInstanceLogsRequest) bool {
+			return len(req.GetBatches()) == 1 && req.GetBatches()[0].GetLines()[0].GetMessage() == "first"
+		})).
+		Return(&instancev1alpha1.ReceiveInstanceLogsResponse{}, nil).
+		Once()
+
+	require.NoError(t, shipper.Ship(ctx))
+}
+
+func appendLog(t *testing.T, path string, data string) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = f.WriteString(data)
+	require.NoError(t, err)
+}
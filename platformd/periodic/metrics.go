@@ -0,0 +1,57 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package periodic
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type metrics struct {
+	runCount    metric.Int64Counter
+	runDuration metric.Float64Histogram
+}
+
+func initMetrics() (metrics, error) {
+	meter := otel.Meter("github.com/spacechunks/explorer/platformd/periodic")
+
+	runCount, err := meter.Int64Counter(
+		"explorer.platformd.job.run.count",
+		metric.WithDescription("Total number of job runs by result: success, failure or panic"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("run counter: %w", err)
+	}
+
+	runDuration, err := meter.Float64Histogram(
+		"explorer.platformd.job.run.duration",
+		metric.WithDescription("Duration of job runs"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("run duration histogram: %w", err)
+	}
+
+	return metrics{
+		runCount:    runCount,
+		runDuration: runDuration,
+	}, nil
+}
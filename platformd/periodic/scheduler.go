@@ -0,0 +1,162 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package periodic runs background jobs of platformd, like garbage
+// collection, each in its own interval.
+package periodic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Job is run periodically by the [Scheduler].
+type Job struct {
+	// Name identifies the job in logs and metrics.
+	Name string
+
+	// Interval is the time between the end of a run and the start of the
+	// next one. the first run starts once the interval elapsed.
+	Interval time.Duration
+
+	// Jitter is the maximum fraction of the interval randomly added to
+	// it, so jobs of different nodes do not run in lockstep. 0 disables
+	// jitter.
+	Jitter float64
+
+	Run func(ctx context.Context) error
+}
+
+// Scheduler runs each job in its own goroutine, so slow jobs do
+// not delay the others. a job is never run concurrently with itself.
+type Scheduler struct {
+	logger  *slog.Logger
+	jobs    []Job
+	metrics metrics
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+func NewScheduler(logger *slog.Logger, jobs ...Job) (*Scheduler, error) {
+	for _, j := range jobs {
+		if j.Name == "" {
+			return nil, errors.New("job name is missing")
+		}
+
+		if j.Interval <= 0 {
+			return nil, fmt.Errorf("job %s: interval has to be positive", j.Name)
+		}
+
+		if j.Jitter < 0 {
+			return nil, fmt.Errorf("job %s: jitter must not be negative", j.Name)
+		}
+	}
+
+	met, err := initMetrics()
+	if err != nil {
+		return nil, fmt.Errorf("init metrics: %w", err)
+	}
+
+	return &Scheduler{
+		logger:  logger,
+		jobs:    jobs,
+		metrics: met,
+		stop:    make(chan struct{}),
+	}, nil
+}
+
+// Run blocks until Stop is called or ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.loop(ctx, j)
+		}()
+	}
+	s.wg.Wait()
+}
+
+// Stop waits until running jobs returned.
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, j Job) {
+	t := time.NewTimer(delay(j))
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			s.run(ctx, j)
+			t.Reset(delay(j))
+		case <-s.stop:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// run recovers from panics of the job, so a faulty job
+// neither stops the other jobs nor crashes platformd.
+func (s *Scheduler) run(ctx context.Context, j Job) {
+	var (
+		start  = time.Now()
+		result = "success"
+	)
+
+	defer func() {
+		if r := recover(); r != nil {
+			result = "panic"
+			s.logger.ErrorContext(ctx, "job panicked", "job", j.Name, "panic", r, "stack", string(debug.Stack()))
+		}
+
+		s.metrics.runCount.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("job", j.Name),
+			attribute.String("result", result),
+		))
+		s.metrics.runDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+			attribute.String("job", j.Name),
+		))
+	}()
+
+	if err := j.Run(ctx); err != nil {
+		result = "failure"
+		s.logger.ErrorContext(ctx, "job failed", "job", j.Name, "err", err)
+	}
+}
+
+func delay(j Job) time.Duration {
+	maxJitter := time.Duration(float64(j.Interval) * j.Jitter)
+	if maxJitter <= 0 {
+		return j.Interval
+	}
+	return j.Interval + rand.N(maxJitter)
+}
@@ -0,0 +1,110 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package periodic_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/platformd/periodic"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler(t *testing.T) {
+	var (
+		ok       atomic.Int32
+		failed   atomic.Int32
+		panicked atomic.Int32
+	)
+
+	s, err := periodic.NewScheduler(
+		slog.New(slog.DiscardHandler),
+		periodic.Job{
+			Name:     "ok",
+			Interval: 10 * time.Millisecond,
+			Jitter:   0.5,
+			Run: func(context.Context) error {
+				ok.Add(1)
+				return nil
+			},
+		},
+		periodic.Job{
+			Name:     "failing",
+			Interval: 10 * time.Millisecond,
+			Run: func(context.Context) error {
+				failed.Add(1)
+				return errors.New("failed")
+			},
+		},
+		periodic.Job{
+			Name:     "panicking",
+			Interval: 10 * time.Millisecond,
+			Run: func(context.Context) error {
+				panicked.Add(1)
+				panic("boom")
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	go s.Run(context.Background())
+
+	// failing and panicking jobs are run again and
+	// do not affect the other jobs.
+	require.Eventually(t, func() bool {
+		return ok.Load() >= 2 && failed.Load() >= 2 && panicked.Load() >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	s.Stop()
+
+	stopped := ok.Load()
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, stopped, ok.Load())
+}
+
+func TestNewSchedulerInvalidJob(t *testing.T) {
+	tests := []struct {
+		name string
+		job  periodic.Job
+	}{
+		{
+			name: "missing name",
+			job:  periodic.Job{Interval: time.Second},
+		},
+		{
+			name: "interval not positive",
+			job:  periodic.Job{Name: "job"},
+		},
+		{
+			name: "negative jitter",
+			job:  periodic.Job{Name: "job", Interval: time.Second, Jitter: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := periodic.NewScheduler(slog.New(slog.DiscardHandler), tt.job)
+			require.Error(t, err)
+		})
+	}
+}
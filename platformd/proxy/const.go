@@ -21,6 +21,7 @@ package proxy
 const (
 	DNSClusterName         = "dns"
 	OriginalDstClusterName = "public"
+	IsolatedClusterName    = "isolated"
 	TCPPort                = 9111
 	HTTPPort               = 9080
 	DNSPort                = 9053
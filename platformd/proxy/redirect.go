@@ -0,0 +1,80 @@
+package proxy
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"time"
+
+	accesslogv3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/spacechunks/explorer/platformd/proxy/xds"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// redirectsKey is the key all redirects are stored under in the resource map.
+const redirectsKey = "redirects"
+
+// Redirect forwards connections to a port of the node, that previously
+// belonged to an instance, to the current endpoint of the instance.
+type Redirect struct {
+	InstanceID string
+	FromPort   uint16
+	To         netip.AddrPort
+}
+
+// RedirectResources returns a tcp proxy listener for each redirect that
+// forwards all connections to the redirect target. listeners are bound to
+// all addresses of the node, because envoy runs in the host network
+// namespace and players connect using the address of the node.
+func RedirectResources(redirects []Redirect) (xds.ResourceGroup, error) {
+	rg := xds.ResourceGroup{}
+	for _, r := range redirects {
+		// listener and cluster names have to be unique, ports
+		// are unique per node, so use them in the names.
+		name := "redirect-" + strconv.Itoa(int(r.FromPort))
+
+		alog, err := xds.JSONStdoutAccessLog(accessLogFormat(r.InstanceID, map[string]any{
+			"redirect_to": r.To.String(),
+		}))
+		if err != nil {
+			return xds.ResourceGroup{}, fmt.Errorf("create access log: %w", err)
+		}
+
+		l, err := xds.TCPProxyListener(xds.ListenerConfig{
+			ListenerName: name,
+			Addr:         netip.AddrPortFrom(netip.IPv4Unspecified(), r.FromPort),
+			Proto:        corev3.SocketAddress_TCP,
+		}, xds.TCPProxyConfig{
+			StatPrefix:  name,
+			ClusterName: name,
+			AccessLog:   []*accesslogv3.AccessLog{alog},
+		})
+		if err != nil {
+			return xds.ResourceGroup{}, fmt.Errorf("create listener: %w", err)
+		}
+
+		rg.Listeners = append(rg.Listeners, l)
+		rg.Clusters = append(rg.Clusters, redirectCluster(name))
+		rg.CLAS = append(rg.CLAS, xds.CreateCLA(name, r.To, corev3.SocketAddress_TCP))
+	}
+
+	return rg, nil
+}
+
+func redirectCluster(name string) *clusterv3.Cluster {
+	return &clusterv3.Cluster{
+		Name: name,
+		ClusterDiscoveryType: &clusterv3.Cluster_Type{
+			Type: clusterv3.Cluster_EDS,
+		},
+		EdsClusterConfig: &clusterv3.Cluster_EdsClusterConfig{
+			EdsConfig: &corev3.ConfigSource{
+				ConfigSourceSpecifier: &corev3.ConfigSource_Ads{},
+			},
+		},
+		ConnectTimeout: durationpb.New(5 * time.Second),
+		LbPolicy:       clusterv3.Cluster_ROUND_ROBIN,
+	}
+}
@@ -28,9 +28,18 @@ func (s *Server) CreateListeners(
 		return nil, fmt.Errorf("parse addr: %w", err)
 	}
 
+	isolated := make([]netip.Prefix, 0, len(req.GetIsolatedPrefixes()))
+	for _, p := range req.GetIsolatedPrefixes() {
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			return nil, fmt.Errorf("parse isolated prefix: %w", err)
+		}
+		isolated = append(isolated, prefix)
+	}
+
 	// TODO: if workload does not exist return err
 
-	if err := s.svc.CreateListeners(ctx, req.WorkloadID, addr); err != nil {
+	if err := s.svc.CreateListeners(ctx, req.WorkloadID, addr, isolated); err != nil {
 		return nil, fmt.Errorf("create listener: %w", err)
 	}
 
@@ -11,9 +11,13 @@ import (
 )
 
 type Service interface {
-	CreateListeners(ctx context.Context, workloadID string, addr netip.Addr) error
+	CreateListeners(ctx context.Context, workloadID string, addr netip.Addr, isolated []netip.Prefix) error
 	ApplyGlobalResources(ctx context.Context) error
 	DeleteListeners(ctx context.Context, workloadID string) error
+
+	// ApplyRedirects replaces all previously applied redirects
+	// with the given ones. pass no redirects to remove all of them.
+	ApplyRedirects(ctx context.Context, redirects []Redirect) error
 }
 
 type proxyService struct {
@@ -37,11 +41,14 @@ func NewService(logger *slog.Logger, cfg Config, resourceMap xds.Map) Service {
 //     routed to.
 //   - dns cluster where dns traffic from all workloads will be
 //     routed to.
+//   - isolated cluster where connections of workloads to other
+//     workloads are routed to, if they are isolated from each other.
 func (s *proxyService) ApplyGlobalResources(ctx context.Context) error {
 	rg := xds.ResourceGroup{
 		Clusters: []*clusterv3.Cluster{
 			DNSClusterResource(),
 			OriginalDstClusterResource(),
+			IsolatedClusterResource(),
 		},
 	}
 	if _, err := s.resourceMap.Put(ctx, "global", rg); err != nil {
@@ -51,13 +58,20 @@ func (s *proxyService) ApplyGlobalResources(ctx context.Context) error {
 }
 
 // CreateListeners creates HTTP, TCP as well as UDP(DNS) and TCP(DNS) listeners for the provided
-// workload. this will fail if the workload does not exist.
-func (s *proxyService) CreateListeners(ctx context.Context, workloadID string, addr netip.Addr) error {
+// workload. connections of the workload to addresses in the isolated prefixes are closed.
+// this will fail if the workload does not exist.
+func (s *proxyService) CreateListeners(
+	ctx context.Context,
+	workloadID string,
+	addr netip.Addr,
+	isolated []netip.Prefix,
+) error {
 	wrg, err := WorkloadResources(
 		workloadID,
 		netip.AddrPortFrom(addr, HTTPPort),
 		netip.AddrPortFrom(addr, TCPPort),
 		OriginalDstClusterName,
+		isolated,
 	)
 	if err != nil {
 		return fmt.Errorf("create workload resources: %w", err)
@@ -94,3 +108,25 @@ func (s *proxyService) DeleteListeners(ctx context.Context, workloadID string) e
 	}
 	return nil
 }
+
+func (s *proxyService) ApplyRedirects(ctx context.Context, redirects []Redirect) error {
+	if len(redirects) == 0 {
+		if _, err := s.resourceMap.Del(ctx, redirectsKey); err != nil {
+			return fmt.Errorf("delete redirects: %w", err)
+		}
+		return nil
+	}
+
+	rg, err := RedirectResources(redirects)
+	if err != nil {
+		return fmt.Errorf("create redirect resources: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "applying redirects", "redirects", len(redirects))
+
+	if _, err := s.resourceMap.Put(ctx, redirectsKey, rg); err != nil {
+		return fmt.Errorf("apply envoy config: %w", err)
+	}
+
+	return nil
+}
@@ -40,6 +40,7 @@ func TestApplyGlobalResources(t *testing.T) {
 			Clusters: []*clusterv3.Cluster{
 				proxy.DNSClusterResource(),
 				proxy.OriginalDstClusterResource(),
+				proxy.IsolatedClusterResource(),
 			},
 		}
 		mockMap = mock.NewMockXdsMap(t)
@@ -55,6 +56,7 @@ func TestCreateListeners(t *testing.T) {
 		wlID        = "abc"
 		addr        = netip.MustParseAddr("127.0.0.1")
 		dnsUpstream = netip.MustParseAddrPort("127.0.0.1:53")
+		isolated    = []netip.Prefix{netip.MustParsePrefix("10.2.0.0/16")}
 	)
 
 	wrg, err := proxy.WorkloadResources(
@@ -62,6 +64,7 @@ func TestCreateListeners(t *testing.T) {
 		netip.AddrPortFrom(addr, proxy.HTTPPort),
 		netip.AddrPortFrom(addr, proxy.TCPPort),
 		proxy.OriginalDstClusterName,
+		isolated,
 	)
 	require.NoError(t, err)
 
@@ -88,7 +91,7 @@ func TestCreateListeners(t *testing.T) {
 	)
 
 	mockMap.EXPECT().Put(mocky.Anything, wlID, merged).Return(nil, nil)
-	require.NoError(t, svc.CreateListeners(ctx, wlID, addr))
+	require.NoError(t, svc.CreateListeners(ctx, wlID, addr, isolated))
 }
 
 func TestDeleteListeners(t *testing.T) {
@@ -104,3 +107,29 @@ func TestDeleteListeners(t *testing.T) {
 	mockMap.EXPECT().Del(mocky.Anything, wlID).Return(nil, nil)
 	require.NoError(t, svc.DeleteListeners(ctx, wlID))
 }
+
+func TestApplyRedirects(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		mockMap   = mock.NewMockXdsMap(t)
+		logger    = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		svc       = proxy.NewService(logger, proxy.Config{}, mockMap)
+		redirects = []proxy.Redirect{
+			{
+				InstanceID: "abc",
+				FromPort:   30001,
+				To:         netip.MustParseAddrPort("10.0.0.2:30002"),
+			},
+		}
+	)
+
+	rg, err := proxy.RedirectResources(redirects)
+	require.NoError(t, err)
+
+	mockMap.EXPECT().Put(mocky.Anything, "redirects", rg).Return(nil, nil)
+	require.NoError(t, svc.ApplyRedirects(ctx, redirects))
+
+	// applying no redirects removes all of them
+	mockMap.EXPECT().Del(mocky.Anything, "redirects").Return(nil, nil)
+	require.NoError(t, svc.ApplyRedirects(ctx, nil))
+}
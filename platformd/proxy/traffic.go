@@ -0,0 +1,144 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/spacechunks/explorer/platformd/status"
+)
+
+// DefaultTrafficCollectInterval is used if no interval has been configured.
+const DefaultTrafficCollectInterval = 10 * time.Second
+
+// trafficStatRegex matches the downstream connection counters of the tcp proxy and http
+// connection manager filters of the workload listeners. both use the workload id as stat prefix.
+var trafficStatRegex = regexp.MustCompile(
+	`^(?:tcp|http)\.(.+)\.downstream_cx_(total|rx_bytes_total|tx_bytes_total)$`,
+)
+
+// TrafficCollector periodically scrapes the connection statistics of all workload
+// listeners from the envoy admin api and attaches them to the workload status, so
+// they are included in the status reports sent to the control plane.
+type TrafficCollector struct {
+	logger   *slog.Logger
+	adminURL string
+	client   *http.Client
+	store    status.Store
+	ticker   *time.Ticker
+	stop     chan bool
+}
+
+func NewTrafficCollector(
+	logger *slog.Logger,
+	adminAddr netip.AddrPort,
+	interval time.Duration,
+	store status.Store,
+) *TrafficCollector {
+	if interval <= 0 {
+		interval = DefaultTrafficCollectInterval
+	}
+	return &TrafficCollector{
+		logger:   logger,
+		adminURL: "http://" + adminAddr.String(),
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		store:  store,
+		ticker: time.NewTicker(interval),
+		stop:   make(chan bool),
+	}
+}
+
+func (c *TrafficCollector) Run(ctx context.Context) {
+	for {
+		select {
+		case <-c.ticker.C:
+			if err := c.Collect(ctx); err != nil {
+				c.logger.ErrorContext(ctx, "collecting traffic stats failed", "err", err)
+			}
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+func (c *TrafficCollector) Stop() {
+	c.ticker.Stop()
+	c.stop <- true
+}
+
+// Collect fetches the traffic stats of all workloads once and updates the status store.
+func (c *TrafficCollector) Collect(ctx context.Context) error {
+	stats, err := c.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	for id, s := range stats {
+		c.store.SetTraffic(id, s)
+	}
+
+	return nil
+}
+
+func (c *TrafficCollector) fetch(ctx context.Context) (map[string]status.TrafficStats, error) {
+	q := url.Values{}
+	q.Set("format", "json")
+	q.Set("filter", trafficStatRegex.String())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.adminURL+"/stats?"+q.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get stats: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Stats []struct {
+			Name  string `json:"name"`
+			Value uint64 `json:"value"`
+		} `json:"stats"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decode stats: %w", err)
+	}
+
+	ret := make(map[string]status.TrafficStats)
+	for _, s := range body.Stats {
+		m := trafficStatRegex.FindStringSubmatch(s.Name)
+		if m == nil {
+			continue
+		}
+
+		// each workload has a tcp and http listener,
+		// so we sum up the counters of both of them.
+		t := ret[m[1]]
+		switch m[2] {
+		case "total":
+			t.Connections += s.Value
+		case "rx_bytes_total":
+			t.RxBytes += s.Value
+		case "tx_bytes_total":
+			t.TxBytes += s.Value
+		}
+		ret[m[1]] = t
+	}
+
+	return ret, nil
+}
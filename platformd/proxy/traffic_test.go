@@ -0,0 +1,114 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package proxy_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/platformd/proxy"
+	"github.com/spacechunks/explorer/platformd/status"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrafficCollectorCollect(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		prep       func(*mock.MockStatusStore)
+		err        bool
+	}{
+		{
+			name:       "sums up tcp and http listener stats",
+			statusCode: http.StatusOK,
+			body: `{
+  "stats": [
+    {"name": "tcp.abc.downstream_cx_total", "value": 2},
+    {"name": "tcp.abc.downstream_cx_rx_bytes_total", "value": 100},
+    {"name": "tcp.abc.downstream_cx_tx_bytes_total", "value": 200},
+    {"name": "http.abc.downstream_cx_total", "value": 1},
+    {"name": "http.abc.downstream_cx_rx_bytes_total", "value": 10},
+    {"name": "http.abc.downstream_cx_tx_bytes_total", "value": 20},
+    {"name": "tcp.def.downstream_cx_total", "value": 5},
+    {"name": "listener.abc.downstream_cx_total", "value": 1337},
+    {"histograms": {}}
+  ]
+}`,
+			prep: func(store *mock.MockStatusStore) {
+				store.EXPECT().SetTraffic("abc", status.TrafficStats{
+					Connections: 3,
+					RxBytes:     110,
+					TxBytes:     220,
+				})
+				store.EXPECT().SetTraffic("def", status.TrafficStats{
+					Connections: 5,
+				})
+			},
+		},
+		{
+			name:       "unexpected status code",
+			statusCode: http.StatusInternalServerError,
+			prep:       func(*mock.MockStatusStore) {},
+			err:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx    = context.Background()
+				logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+				store  = mock.NewMockStatusStore(t)
+			)
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/stats", r.URL.Path)
+				require.Equal(t, "json", r.URL.Query().Get("format"))
+				require.NotEmpty(t, r.URL.Query().Get("filter"))
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			tt.prep(store)
+
+			c := proxy.NewTrafficCollector(
+				logger,
+				netip.MustParseAddrPort(srv.Listener.Addr().String()),
+				time.Minute,
+				store,
+			)
+
+			err := c.Collect(ctx)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
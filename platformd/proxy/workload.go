@@ -2,20 +2,24 @@ package proxy
 
 import (
 	"fmt"
+	"maps"
 	"net/netip"
 	"time"
 
 	accesslogv3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	routerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	httpconnmgr "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tcpproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	"github.com/spacechunks/explorer/platformd/proxy/xds"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func OriginalDstClusterResource() *clusterv3.Cluster {
@@ -30,11 +34,31 @@ func OriginalDstClusterResource() *clusterv3.Cluster {
 	}
 }
 
+// IsolatedClusterResource is a cluster without any endpoints. connections
+// of workloads to destinations they are isolated from are proxied to it,
+// so they are closed without ever connecting to the destination.
+func IsolatedClusterResource() *clusterv3.Cluster {
+	return &clusterv3.Cluster{
+		Name: IsolatedClusterName,
+		ClusterDiscoveryType: &clusterv3.Cluster_Type{
+			Type: clusterv3.Cluster_STATIC,
+		},
+		ConnectTimeout: durationpb.New(time.Second),
+		LoadAssignment: &endpointv3.ClusterLoadAssignment{
+			ClusterName: IsolatedClusterName,
+		},
+	}
+}
+
+// WorkloadResources returns the listeners all traffic originating from the
+// workload is redirected to. connections to addresses in one of the isolated
+// prefixes are closed, see IsolatedClusterResource.
 func WorkloadResources(
 	workloadID string,
 	httpListenerAddr netip.AddrPort,
 	tcpListenerAddr netip.AddrPort,
 	originalDstClusterName string,
+	isolated []netip.Prefix,
 ) (xds.ResourceGroup, error) {
 	httpLis, err := httpListener(workloadID, httpListenerAddr, originalDstClusterName)
 	if err != nil {
@@ -46,6 +70,16 @@ func WorkloadResources(
 		return xds.ResourceGroup{}, fmt.Errorf("create tcp listener: %w", err)
 	}
 
+	if len(isolated) > 0 {
+		for _, lis := range []*listenerv3.Listener{httpLis, tcpLis} {
+			chain, err := isolatedFilterChain(workloadID, isolated)
+			if err != nil {
+				return xds.ResourceGroup{}, fmt.Errorf("create isolated filter chain: %w", err)
+			}
+			lis.FilterChains = append(lis.FilterChains, chain)
+		}
+	}
+
 	return xds.ResourceGroup{
 		Listeners: []*listenerv3.Listener{
 			tcpLis,
@@ -59,6 +93,11 @@ func tcpListener(workloadID string, addr netip.AddrPort, clusterName string) (*l
 	// removed from existing resources when applied. that's why it is
 	// extremely important to use the workloadID in the listeners name
 	// to make it unique.
+	alog, err := xds.JSONStdoutAccessLog(accessLogFormat(workloadID, nil))
+	if err != nil {
+		return nil, fmt.Errorf("create access log: %w", err)
+	}
+
 	tcpLis, err := xds.TCPProxyListener(xds.ListenerConfig{
 		ListenerName: "tcp-" + workloadID,
 		Addr:         addr,
@@ -66,6 +105,7 @@ func tcpListener(workloadID string, addr netip.AddrPort, clusterName string) (*l
 	}, xds.TCPProxyConfig{
 		StatPrefix:  workloadID,
 		ClusterName: clusterName,
+		AccessLog:   []*accesslogv3.AccessLog{alog},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create listener: %w", err)
@@ -124,8 +164,59 @@ func httpListener(workloadID string, addr netip.AddrPort, clusterName string) (*
 	return httpLis, nil
 }
 
+// isolatedFilterChain matches connections to the isolated prefixes. envoy
+// selects the filter chain with the most specific destination prefix, so
+// the chain takes precedence over the one matching all destinations.
+func isolatedFilterChain(workloadID string, isolated []netip.Prefix) (*listenerv3.FilterChain, error) {
+	alog, err := xds.JSONStdoutAccessLog(accessLogFormat(workloadID, map[string]any{
+		"isolated": true,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("create access log: %w", err)
+	}
+
+	var proxyAny anypb.Any
+	if err := anypb.MarshalFrom(&proxyAny, &tcpproxyv3.TcpProxy{
+		StatPrefix: workloadID + "_isolated",
+		ClusterSpecifier: &tcpproxyv3.TcpProxy_Cluster{
+			Cluster: IsolatedClusterName,
+		},
+		AccessLog: []*accesslogv3.AccessLog{alog},
+	}, proto.MarshalOptions{}); err != nil {
+		return nil, fmt.Errorf("marshal to any: %w", err)
+	}
+
+	ranges := make([]*corev3.CidrRange, 0, len(isolated))
+	for _, p := range isolated {
+		ranges = append(ranges, &corev3.CidrRange{
+			AddressPrefix: p.Masked().Addr().String(),
+			PrefixLen:     wrapperspb.UInt32(uint32(p.Bits())),
+		})
+	}
+
+	return &listenerv3.FilterChain{
+		Name: "isolated",
+		FilterChainMatch: &listenerv3.FilterChainMatch{
+			PrefixRanges: ranges,
+		},
+		Filters: []*listenerv3.Filter{
+			{
+				Name: "envoy.filters.network.tcp_proxy",
+				ConfigType: &listenerv3.Filter_TypedConfig{
+					TypedConfig: &proxyAny,
+				},
+			},
+		},
+	}, nil
+}
+
 func httpConnenctionManager(workloadID string, clusterName string) (*httpconnmgr.HttpConnectionManager, error) {
-	alog, err := xds.JSONStdoutAccessLog(nil)
+	alog, err := xds.JSONStdoutAccessLog(accessLogFormat(workloadID, map[string]any{
+		"method":        "%REQ(:METHOD)%",
+		"path":          "%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%",
+		"protocol":      "%PROTOCOL%",
+		"response_code": "%RESPONSE_CODE%",
+	}))
 	if err != nil {
 		return nil, fmt.Errorf("create access log: %w", err)
 	}
@@ -177,3 +268,21 @@ func httpConnenctionManager(workloadID string, clusterName string) (*httpconnmgr
 		},
 	}, nil
 }
+
+// accessLogFormat returns the json access log format used by all workload
+// listeners. the workload id is included, so log lines can be attributed to
+// a workload when collecting them from envoy's stdout.
+func accessLogFormat(workloadID string, extra map[string]any) map[string]any {
+	format := map[string]any{
+		"workload_id":    workloadID,
+		"start_time":     "%START_TIME%",
+		"duration_ms":    "%DURATION%",
+		"downstream":     "%DOWNSTREAM_REMOTE_ADDRESS%",
+		"upstream":       "%UPSTREAM_HOST%",
+		"bytes_received": "%BYTES_RECEIVED%",
+		"bytes_sent":     "%BYTES_SENT%",
+		"response_flags": "%RESPONSE_FLAGS%",
+	}
+	maps.Copy(format, extra)
+	return format
+}
@@ -55,7 +55,31 @@ func TestWorkloadResourceGroupConfig(t *testing.T) {
           "typedConfig": {
             "@type": "type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy",
             "statPrefix": "%s",
-            "cluster": "%s"
+            "cluster": "%s",
+            "accessLog": [
+              {
+                "name": "json_stdout_access_log",
+                "typedConfig": {
+                  "@type": "type.googleapis.com/envoy.extensions.access_loggers.stream.v3.StdoutAccessLog",
+                  "logFormat": {
+                    "jsonFormat": {
+                      "bytes_received": "%%BYTES_RECEIVED%%",
+                      "bytes_sent": "%%BYTES_SENT%%",
+                      "downstream": "%%DOWNSTREAM_REMOTE_ADDRESS%%",
+                      "duration_ms": "%%DURATION%%",
+                      "response_flags": "%%RESPONSE_FLAGS%%",
+                      "start_time": "%%START_TIME%%",
+                      "upstream": "%%UPSTREAM_HOST%%",
+                      "workload_id": "%s"
+                    },
+                    "omitEmptyValues": true,
+                    "jsonFormatOptions": {
+                      "sortProperties": true
+                    }
+                  }
+                }
+              }
+            ]
           }
         }
       ]
@@ -69,7 +93,7 @@ func TestWorkloadResourceGroupConfig(t *testing.T) {
       }
     }
   ]
-}`, wlID, tcpListenerAddr.Addr().String(), tcpListenerAddr.Port(), wlID, proxy.OriginalDstClusterName)
+}`, wlID, tcpListenerAddr.Addr().String(), tcpListenerAddr.Port(), wlID, proxy.OriginalDstClusterName, wlID)
 
 		//nolint:lll
 		expectedHTTPListener = fmt.Sprintf(`
@@ -125,7 +149,20 @@ func TestWorkloadResourceGroupConfig(t *testing.T) {
                 "typedConfig": {
                   "@type": "type.googleapis.com/envoy.extensions.access_loggers.stream.v3.StdoutAccessLog",
                   "logFormat": {
-                    "jsonFormat": {},
+                    "jsonFormat": {
+                      "method": "%%REQ(:METHOD)%%",
+                      "path": "%%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%%",
+                      "protocol": "%%PROTOCOL%%",
+                      "response_code": "%%RESPONSE_CODE%%",
+                      "bytes_received": "%%BYTES_RECEIVED%%",
+                      "bytes_sent": "%%BYTES_SENT%%",
+                      "downstream": "%%DOWNSTREAM_REMOTE_ADDRESS%%",
+                      "duration_ms": "%%DURATION%%",
+                      "response_flags": "%%RESPONSE_FLAGS%%",
+                      "start_time": "%%START_TIME%%",
+                      "upstream": "%%UPSTREAM_HOST%%",
+                      "workload_id": "%s"
+                    },
                     "omitEmptyValues": true,
                     "jsonFormatOptions": {
                       "sortProperties": true
@@ -147,7 +184,7 @@ func TestWorkloadResourceGroupConfig(t *testing.T) {
       }
     }
   ]
-}`, wlID, httpListenerAddr.Addr().String(), httpListenerAddr.Port(), wlID, wlID, proxy.OriginalDstClusterName)
+}`, wlID, httpListenerAddr.Addr().String(), httpListenerAddr.Port(), wlID, wlID, proxy.OriginalDstClusterName, wlID)
 	)
 
 	tcpLis := &listenerv3.Listener{}
@@ -160,7 +197,7 @@ func TestWorkloadResourceGroupConfig(t *testing.T) {
 		Listeners: []*listenerv3.Listener{tcpLis, httpLis},
 	}
 
-	actualRG, err := proxy.WorkloadResources(wlID, httpListenerAddr, tcpListenerAddr, proxy.OriginalDstClusterName)
+	actualRG, err := proxy.WorkloadResources(wlID, httpListenerAddr, tcpListenerAddr, proxy.OriginalDstClusterName, nil)
 	require.NoError(t, err)
 
 	d := cmp.Diff(expectedRG, actualRG, protocmp.Transform())
@@ -168,3 +205,89 @@ func TestWorkloadResourceGroupConfig(t *testing.T) {
 		t.Fatal(d)
 	}
 }
+
+func TestWorkloadResourcesIsolated(t *testing.T) {
+	var (
+		wlID             = "abc"
+		httpListenerAddr = netip.MustParseAddrPort(fmt.Sprintf("127.0.0.1:%d", proxy.HTTPPort))
+		tcpListenerAddr  = netip.MustParseAddrPort(fmt.Sprintf("127.0.0.1:%d", proxy.TCPPort))
+		isolated         = []netip.Prefix{
+			netip.MustParsePrefix("10.1.0.0/16"),
+			netip.MustParsePrefix("10.2.3.4/16"),
+		}
+
+		expectedChain = fmt.Sprintf(`
+{
+  "name": "isolated",
+  "filterChainMatch": {
+    "prefixRanges": [
+      {
+        "addressPrefix": "10.1.0.0",
+        "prefixLen": 16
+      },
+      {
+        "addressPrefix": "10.2.0.0",
+        "prefixLen": 16
+      }
+    ]
+  },
+  "filters": [
+    {
+      "name": "envoy.filters.network.tcp_proxy",
+      "typedConfig": {
+        "@type": "type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy",
+        "statPrefix": "%s_isolated",
+        "cluster": "%s",
+        "accessLog": [
+          {
+            "name": "json_stdout_access_log",
+            "typedConfig": {
+              "@type": "type.googleapis.com/envoy.extensions.access_loggers.stream.v3.StdoutAccessLog",
+              "logFormat": {
+                "jsonFormat": {
+                  "bytes_received": "%%BYTES_RECEIVED%%",
+                  "bytes_sent": "%%BYTES_SENT%%",
+                  "downstream": "%%DOWNSTREAM_REMOTE_ADDRESS%%",
+                  "duration_ms": "%%DURATION%%",
+                  "isolated": true,
+                  "response_flags": "%%RESPONSE_FLAGS%%",
+                  "start_time": "%%START_TIME%%",
+                  "upstream": "%%UPSTREAM_HOST%%",
+                  "workload_id": "%s"
+                },
+                "omitEmptyValues": true,
+                "jsonFormatOptions": {
+                  "sortProperties": true
+                }
+              }
+            }
+          }
+        ]
+      }
+    }
+  ]
+}`, wlID, proxy.IsolatedClusterName, wlID)
+	)
+
+	chain := &listenerv3.FilterChain{}
+	require.NoError(t, protojson.Unmarshal([]byte(expectedChain), chain))
+
+	actualRG, err := proxy.WorkloadResources(
+		wlID,
+		httpListenerAddr,
+		tcpListenerAddr,
+		proxy.OriginalDstClusterName,
+		isolated,
+	)
+	require.NoError(t, err)
+
+	// the isolated chain is added after the chain matching
+	// all destinations of both the tcp and http listener.
+	for _, lis := range actualRG.Listeners {
+		require.Len(t, lis.FilterChains, 2)
+		d := cmp.Diff(chain, lis.FilterChains[1], protocmp.Transform())
+		if d != "" {
+			t.Fatal(d)
+		}
+	}
+}
@@ -96,8 +96,10 @@ func JSONStdoutAccessLog(format map[string]any) (*accesslogv3.AccessLog, error)
 		},
 	}
 
+	// the json format is a map, so marshal deterministically. otherwise
+	// the same access log config would result in different resources.
 	var stdoutAny anypb.Any
-	if err := anypb.MarshalFrom(&stdoutAny, stdout, proto.MarshalOptions{}); err != nil {
+	if err := anypb.MarshalFrom(&stdoutAny, stdout, proto.MarshalOptions{Deterministic: true}); err != nil {
 		return nil, fmt.Errorf("marshal to any: %w", err)
 	}
 
@@ -112,6 +114,7 @@ func JSONStdoutAccessLog(format map[string]any) (*accesslogv3.AccessLog, error)
 type TCPProxyConfig struct {
 	StatPrefix  string
 	ClusterName string
+	AccessLog   []*accesslogv3.AccessLog
 }
 
 func TCPProxyListener(listenerCfg ListenerConfig, proxyCfg TCPProxyConfig) (*listenerv3.Listener, error) {
@@ -120,6 +123,7 @@ func TCPProxyListener(listenerCfg ListenerConfig, proxyCfg TCPProxyConfig) (*lis
 		ClusterSpecifier: &tcpproxyv3.TcpProxy_Cluster{
 			Cluster: proxyCfg.ClusterName,
 		},
+		AccessLog: proxyCfg.AccessLog,
 	}
 	var filterCfgAny anypb.Any
 	if err := anypb.MarshalFrom(&filterCfgAny, filterCfg, proto.MarshalOptions{}); err != nil {
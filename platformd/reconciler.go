@@ -19,29 +19,57 @@
 package platformd
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 	"maps"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/internal/workloadenv"
+	"github.com/spacechunks/explorer/platformd/debug"
+	"github.com/spacechunks/explorer/platformd/settings"
 	"github.com/spacechunks/explorer/platformd/status"
 	"github.com/spacechunks/explorer/platformd/workload"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
 	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var errMaxAttemptsReached = errors.New("reconciler: max attempts reached")
 
+// MaxStatusReportBatchSize is the maximum number of status
+// reports accepted by the control plane in a single request.
+const MaxStatusReportBatchSize = 1000
+
+// watchRetryInterval is the time to wait before watching
+// instances again, after the stream has been interrupted.
+const watchRetryInterval = 5 * time.Second
+
 // reconciler is responsible for syncing the state found in the control plane
 // with what is currently running on the CRI side. basically, it does the
 // following:
 //
-//   - fetch all instances from control plane
+//   - fetch instances that changed since the last sync from the control plane
+//     and merge them into the locally known instances. all instances are
+//     fetched periodically, to get rid of instances that have been removed
+//     without us noticing.
+//
+//   - if watching is enabled, changes pushed by the control plane are merged
+//     and reconciled right away. polling for changes is only used as long as
+//     the watch stream is not established.
 //
 //   - instances with state [instancev1alpha1.InstanceState_PENDING]
 //
@@ -63,7 +91,15 @@ var errMaxAttemptsReached = errors.New("reconciler: max attempts reached")
 //
 //     -> if workload is already gone, set status [workload.StateDeleted]
 //
-//     -> if workload container has status exited or unknown, remove it
+//     -> if workload container has status exited or unknown, record why
+//     it terminated
+//
+//     -> restart the workload if the restart policy of the flavor version
+//     permits it, otherwise remove it
+//
+// in dry run mode workloads are neither created nor removed and no statuses
+// are reported to the control plane. instead, what would be done is planned
+// and exposed via [reconciler.State], see [reconciler.simulate].
 type reconciler struct {
 	logger *slog.Logger
 
@@ -74,20 +110,75 @@ type reconciler struct {
 	store     status.Store
 	portAlloc *workload.PortAllocator
 
-	attempts map[string]uint
-	ticker   *time.Ticker
-	stop     chan bool
+	// settings are the runtime settings sent by the control plane
+	// during discovery. nil if they should not be applied.
+	settings *settings.Settings
+
+	// attempts counts the failed attempts to create the workload
+	// of an instance, keyed by instance id.
+	attempts   map[string]uint
+	attemptsMu sync.Mutex
+
+	ticker *time.Ticker
+	stop   chan bool
+
+	// updates receives the instance changes pushed by the control plane.
+	// watching reports whether the watch stream is currently established.
+	updates  chan *instancev1alpha1.WatchInstancesResponse
+	watching atomic.Bool
+
+	// restarts counts how often the workload of an instance has been
+	// restarted, keyed by instance id.
+	restarts   map[string]uint32
+	restartsMu sync.Mutex
+
+	// instances known to this node, keyed by instance id.
+	instances    map[string]*instancev1alpha1.Instance
+	revision     string
+	lastFullSync time.Time
+
+	// reported is the revision of the status store up to
+	// which statuses have been reported to the control plane.
+	reported uint64
+
+	// stateMu has to be held when modifying instances, revision,
+	// lastFullSync or reported, so State can be called from other
+	// goroutines. the reconcile loop is the only one modifying them,
+	// so it can read them without holding the lock.
+	stateMu sync.RWMutex
+
+	// planned contains what would be done with the known
+	// instances, keyed by instance id. only used in dry run mode.
+	planned   map[string]debug.PlannedAction
+	plannedMu sync.Mutex
 }
 
 type reconcilerConfig struct {
 	MaxAttempts         uint
 	SyncInterval        time.Duration
+	FullResyncInterval  time.Duration
 	NodeID              string
+	Builder             bool
+	Watch               bool
 	WorkloadNamespace   string
 	WorkloadCPUPeriod   uint64
 	WorkloadCPUQuota    uint64
 	WorkloadMemoryLimit uint64
 	RegistryEndpoint    string
+
+	// ReportBatchSize is the maximum number of status reports sent in a
+	// single request. values outside of (0, [MaxStatusReportBatchSize]]
+	// use the maximum.
+	ReportBatchSize int
+
+	// DryRun disables creating and removing workloads as well as
+	// reporting statuses. images are still pulled, to verify that
+	// the registry can be accessed.
+	DryRun bool
+
+	// MaxInstances is reported to the control plane, so the scheduler
+	// does not place more instances on the node. nil if unbounded.
+	MaxInstances *uint32
 }
 
 func newReconciler(
@@ -97,6 +188,7 @@ func newReconciler(
 	wlService workload.Service,
 	store status.Store,
 	portAlloc *workload.PortAllocator,
+	settings *settings.Settings,
 ) reconciler {
 	return reconciler{
 		logger:    logger.With("component", "reconciler"),
@@ -105,44 +197,140 @@ func newReconciler(
 		wlService: wlService,
 		store:     store,
 		portAlloc: portAlloc,
+		settings:  settings,
 		ticker:    time.NewTicker(cfg.SyncInterval),
 		attempts:  make(map[string]uint),
+		restarts:  make(map[string]uint32),
 		stop:      make(chan bool),
+		updates:   make(chan *instancev1alpha1.WatchInstancesResponse),
+		instances: make(map[string]*instancev1alpha1.Instance),
+		planned:   make(map[string]debug.PlannedAction),
 	}
 }
 
 func (r *reconciler) Start(ctx context.Context) {
+	if r.cfg.Watch {
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go r.watch(watchCtx)
+	}
+
 	for {
 		select {
 		case <-r.ticker.C:
 			r.tick(ctx)
+		case resp := <-r.updates:
+			r.merge(resp.GetInstances(), resp.GetRevision(), resp.GetFull(), time.Now())
+			r.sync(ctx)
 		case <-r.stop:
 			return
 		}
 	}
 }
 
+// recoverPorts claims the ports of the instance workloads that already exist
+// on the node, so they are not allocated to other workloads. workloads keep
+// running while platformd is restarted, e.g. when handing over to a new
+// version, but the port allocations only live in memory.
+func (r *reconciler) recoverPorts(ctx context.Context) {
+	ports, err := r.wlService.InstancePorts(ctx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to recover ports of existing workloads", "err", err)
+		return
+	}
+
+	for id, port := range ports {
+		if !r.portAlloc.Claim(port) {
+			r.logger.WarnContext(ctx, "port of existing workload already allocated", "instance_id", id, "port", port)
+		}
+	}
+
+	r.logger.InfoContext(ctx, "recovered ports of existing workloads", "workloads", len(ports))
+}
+
 func (r *reconciler) Stop() {
 	r.ticker.Stop()
 	r.stop <- true
 }
 
-func (r *reconciler) tick(ctx context.Context) {
-	discResp, err := r.insClient.DiscoverInstances(ctx, &instancev1alpha1.DiscoverInstanceRequest{
-		NodeKey: r.cfg.NodeID,
+// State returns a snapshot of the instances known to the
+// reconciler and the attempts made to create their workloads.
+func (r *reconciler) State() debug.ReconcilerState {
+	r.stateMu.RLock()
+	ret := debug.ReconcilerState{
+		Queue:        make([]debug.QueuedInstance, 0, len(r.instances)),
+		Revision:     r.revision,
+		Reported:     r.reported,
+		LastFullSync: r.lastFullSync,
+		Watching:     r.watching.Load(),
+		DryRun:       r.cfg.DryRun,
+	}
+
+	for id, ins := range r.instances {
+		ret.Queue = append(ret.Queue, debug.QueuedInstance{
+			ID:              id,
+			FlavorVersionID: ins.GetFlavorVersion().GetId(),
+			State:           ins.GetState().String(),
+		})
+	}
+	r.stateMu.RUnlock()
+
+	slices.SortFunc(ret.Queue, func(a, b debug.QueuedInstance) int {
+		return strings.Compare(a.ID, b.ID)
 	})
-	if err != nil {
-		r.logger.ErrorContext(ctx, "discover instances failed", "node_id", r.cfg.NodeID, "err", err)
-		// if we encounter an error backoff for a longer period than the sync interval,
-		// because we don't want to spam the control plane if things are not working.
-		// FIXME: use exponential backoff
-		r.ticker.Reset(3 * time.Second)
+
+	r.restartsMu.Lock()
+	for i := range ret.Queue {
+		ret.Queue[i].Restarts = r.restarts[ret.Queue[i].ID]
+	}
+	r.restartsMu.Unlock()
+
+	r.attemptsMu.Lock()
+	ret.Attempts = maps.Clone(r.attempts)
+	r.attemptsMu.Unlock()
+
+	if r.cfg.DryRun {
+		r.plannedMu.Lock()
+		for i := range ret.Queue {
+			if p, ok := r.planned[ret.Queue[i].ID]; ok {
+				ret.Queue[i].Planned = &p
+			}
+		}
+		r.plannedMu.Unlock()
+	}
+
+	return ret
+}
+
+func (r *reconciler) tick(ctx context.Context) {
+	// while watching, changes are pushed by the control plane,
+	// so we only have to poll for the periodic full resync.
+	if !r.watching.Load() || time.Since(r.lastFullSync) >= r.cfg.FullResyncInterval {
+		if err := r.discover(ctx); err != nil {
+			r.logger.ErrorContext(ctx, "discover instances failed", "node_id", r.cfg.NodeID, "err", err)
+			// if we encounter an error backoff for a longer period than the sync interval,
+			// because we don't want to spam the control plane if things are not working.
+			// FIXME: use exponential backoff
+			r.ticker.Reset(3 * time.Second)
+			return
+		}
+	}
+
+	r.sync(ctx)
+}
+
+// sync reconciles all known instances and reports
+// their statuses back to the control plane.
+func (r *reconciler) sync(ctx context.Context) {
+	if r.cfg.DryRun {
+		r.simulate(ctx)
+		r.ticker.Reset(r.cfg.SyncInterval)
 		return
 	}
 
 	var wg sync.WaitGroup
 
-	for _, ins := range discResp.Instances {
+	for _, ins := range r.instances {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -152,50 +340,268 @@ func (r *reconciler) tick(ctx context.Context) {
 
 	wg.Wait()
 
-	var (
-		statuses = r.store.View()
-		items    = make([]*instancev1alpha1.InstanceStatusReport, 0, len(statuses))
-	)
+	// only statuses that changed since the last acknowledged
+	// report are sent, to keep the reports small.
+	changes, revision := r.store.Changes(r.reported)
+
+	acked, err := r.report(ctx, changes, revision)
 
-	for k, v := range statuses {
-		if v.WorkloadStatus == nil {
+	r.stateMu.Lock()
+	r.reported = acked
+	r.stateMu.Unlock()
+
+	// we deliberately remove statuses from the store AFTER sending the
+	// reports to the control plane succeeds. this is, so we don't have
+	// inconsistencies in the control plane. statuses that changed in the
+	// meantime are kept, because the change has not been reported yet.
+	for k, v := range changes {
+		if v.Status.WorkloadStatus == nil || v.Version > acked {
 			continue
 		}
 
-		wst := v.WorkloadStatus
+		wst := v.Status.WorkloadStatus
 
-		items = append(items, &instancev1alpha1.InstanceStatusReport{
-			InstanceId: k,
-			Port:       uint32(wst.Port),
-			State:      instancev1alpha1.InstanceState(instancev1alpha1.InstanceState_value[string(wst.State)]),
-		})
+		if wst.State == status.WorkloadStateDeleted || wst.State == status.WorkloadStateCreationFailed {
+			if !r.store.CompareAndDelete(k, v.Version) {
+				continue
+			}
+		}
+
+		// the control plane removes instances once they have been reported
+		// as deleted, so they will not show up in any delta anymore.
+		if wst.State == status.WorkloadStateDeleted {
+			r.stateMu.Lock()
+			delete(r.instances, k)
+			r.stateMu.Unlock()
+			r.restartsMu.Lock()
+			delete(r.restarts, k)
+			r.restartsMu.Unlock()
+		}
 	}
 
-	if _, err := r.insClient.ReceiveInstanceStatusReports(ctx, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
-		Reports: items,
-	}); err != nil {
+	if err != nil {
 		r.logger.ErrorContext(ctx, "sending workload status reports failed", "err", err)
 		r.ticker.Reset(3 * time.Second)
 		return
 	}
 
-	// we deliberately remove statuses from the store AFTER sending the
-	// reports to the control plane succeeds. this is, so we don't have
-	// inconsistencies in the control plane.
-	for k, v := range statuses {
-		if v.WorkloadStatus == nil {
+	// set the sync interval again, in case we errored before
+	r.ticker.Reset(r.cfg.SyncInterval)
+}
+
+// report sends the changed statuses to the control plane in batches of at
+// most [reconcilerConfig.ReportBatchSize] reports, ordered by the revision
+// they changed at. it returns the revision up to which the control plane
+// acknowledged the statuses, so statuses of batches that could not be sent
+// are reported again during the next sync.
+func (r *reconciler) report(ctx context.Context, changes map[string]status.Entry, revision uint64) (uint64, error) {
+	type change struct {
+		version uint64
+		report  *instancev1alpha1.InstanceStatusReport
+	}
+
+	items := make([]change, 0, len(changes))
+	for k, v := range changes {
+		if v.Status.WorkloadStatus == nil {
 			continue
 		}
 
-		wst := v.WorkloadStatus
+		items = append(items, change{
+			version: v.Version,
+			report:  statusReport(k, v.Status.WorkloadStatus),
+		})
+	}
 
-		if wst.State == status.WorkloadStateDeleted || wst.State == status.WorkloadStateCreationFailed {
-			r.store.Del(k)
+	slices.SortFunc(items, func(a, b change) int {
+		if c := cmp.Compare(a.version, b.version); c != 0 {
+			return c
 		}
+		return strings.Compare(a.report.GetInstanceId(), b.report.GetInstanceId())
+	})
+
+	size := r.cfg.ReportBatchSize
+	if size <= 0 || size > MaxStatusReportBatchSize {
+		size = MaxStatusReportBatchSize
 	}
 
-	// set the sync interval again, in case we errored before
-	r.ticker.Reset(r.cfg.SyncInterval)
+	acked := r.reported
+	for batch := range slices.Chunk(items, size) {
+		reports := make([]*instancev1alpha1.InstanceStatusReport, 0, len(batch))
+		for _, c := range batch {
+			reports = append(reports, c.report)
+		}
+
+		if _, err := r.insClient.ReceiveInstanceStatusReports(
+			ctx,
+			&instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+				Reports: reports,
+			},
+			grpc.UseCompressor(gzip.Name),
+		); err != nil {
+			return acked, err
+		}
+
+		// all statuses that changed up to this
+		// version have been sent, as items are sorted.
+		acked = batch[len(batch)-1].version
+	}
+
+	return revision, nil
+}
+
+func statusReport(id string, wst *status.WorkloadStatus) *instancev1alpha1.InstanceStatusReport {
+	var events []*instancev1alpha1.InstanceEvent
+	for _, ev := range wst.Events {
+		events = append(events, &instancev1alpha1.InstanceEvent{
+			Id:         ev.ID,
+			InstanceId: id,
+			Type:       instancev1alpha1.InstanceEventType(instancev1alpha1.InstanceEventType_value[string(ev.Type)]),
+			ExitCode:   ev.ExitCode,
+			Message:    ev.Message,
+			CreatedAt:  timestamppb.New(ev.Timestamp),
+		})
+	}
+
+	var traffic *instancev1alpha1.InstanceTraffic
+	if wst.Traffic != nil {
+		traffic = &instancev1alpha1.InstanceTraffic{
+			Connections: wst.Traffic.Connections,
+			RxBytes:     wst.Traffic.RxBytes,
+			TxBytes:     wst.Traffic.TxBytes,
+		}
+	}
+
+	return &instancev1alpha1.InstanceStatusReport{
+		InstanceId: id,
+		Port:       uint32(wst.Port),
+		State:      instancev1alpha1.InstanceState(instancev1alpha1.InstanceState_value[string(wst.State)]),
+		Events:     events,
+		Traffic:    traffic,
+	}
+}
+
+// discover fetches the instances that changed since the last call and
+// merges them into the known instances. if a full resync is due, all
+// instances are fetched and replace the known ones.
+func (r *reconciler) discover(ctx context.Context) error {
+	var (
+		now      = time.Now()
+		revision = r.revision
+	)
+
+	// without a revision the control plane sends all instances,
+	// so delta discovery can be turned off if it misbehaves.
+	if now.Sub(r.lastFullSync) >= r.cfg.FullResyncInterval ||
+		!r.settings.Enabled(resource.FeatureFlagDeltaDiscovery) {
+		revision = ""
+	}
+
+	resp, err := r.insClient.DiscoverInstances(ctx, &instancev1alpha1.DiscoverInstanceRequest{
+		NodeKey:      r.cfg.NodeID,
+		Revision:     revision,
+		Capabilities: r.capabilities(),
+		Capacity:     r.capacity(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if r.settings != nil {
+		r.settings.Apply(ctx, resp.GetSettings())
+	}
+
+	r.merge(resp.GetInstances(), resp.GetRevision(), resp.GetFull(), now)
+	return nil
+}
+
+// merge applies the given instances to the known ones. if full
+// is set, the known instances are replaced instead.
+func (r *reconciler) merge(instances []*instancev1alpha1.Instance, revision string, full bool, now time.Time) {
+	r.stateMu.Lock()
+	defer r.stateMu.Unlock()
+
+	if full {
+		clear(r.instances)
+		r.lastFullSync = now
+
+		// report all statuses again, in case the control
+		// plane rejected some of them in the meantime.
+		r.reported = 0
+	}
+
+	for _, ins := range instances {
+		r.instances[ins.GetId()] = ins
+	}
+
+	r.revision = revision
+}
+
+// watch passes the instance changes pushed by the control plane to the
+// reconcile loop until ctx is done. if the stream is interrupted, it is
+// established again after watchRetryInterval.
+func (r *reconciler) watch(ctx context.Context) {
+	for {
+		if err := r.watchInstances(ctx); err != nil && ctx.Err() == nil {
+			r.logger.WarnContext(ctx, "watching instances failed, falling back to polling", "err", err)
+		}
+
+		r.watching.Store(false)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRetryInterval):
+		}
+	}
+}
+
+func (r *reconciler) watchInstances(ctx context.Context) error {
+	stream, err := r.insClient.WatchInstances(ctx, &instancev1alpha1.WatchInstancesRequest{
+		NodeKey:      r.cfg.NodeID,
+		Capabilities: r.capabilities(),
+		Capacity:     r.capacity(),
+	})
+	if err != nil {
+		return fmt.Errorf("watch instances: %w", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("receive: %w", err)
+		}
+
+		r.watching.Store(true)
+
+		select {
+		case r.updates <- resp:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (r *reconciler) capabilities() []instancev1alpha1.NodeCapability {
+	var caps []instancev1alpha1.NodeCapability
+	if r.cfg.Builder {
+		caps = append(caps, instancev1alpha1.NodeCapability_BUILDER)
+	}
+	return caps
+}
+
+func (r *reconciler) capacity() *instancev1alpha1.NodeCapacity {
+	if r.cfg.MaxInstances == nil {
+		return nil
+	}
+	var cpuMillis uint64
+	if r.cfg.WorkloadCPUPeriod > 0 {
+		cpuMillis = r.cfg.WorkloadCPUQuota * 1000 / r.cfg.WorkloadCPUPeriod
+	}
+	return &instancev1alpha1.NodeCapacity{
+		MaxInstances:        *r.cfg.MaxInstances,
+		WorkloadCpuMillis:   cpuMillis,
+		WorkloadMemoryBytes: r.cfg.WorkloadMemoryLimit,
+	}
 }
 
 func (r *reconciler) reconcile(ctx context.Context, ins *instancev1alpha1.Instance) {
@@ -207,15 +613,20 @@ func (r *reconciler) reconcile(ctx context.Context, ins *instancev1alpha1.Instan
 				r.logger.WarnContext(ctx,
 					"max attempts reached",
 					"instance_id", id,
-					"attempt", r.attempts[id],
+					"attempt", r.attempt(id),
 				)
 				return
 			}
-			r.attempts[id] = r.attempts[id] + 1
+
+			r.attemptsMu.Lock()
+			r.attempts[id]++
+			attempt := r.attempts[id]
+			r.attemptsMu.Unlock()
+
 			r.logger.ErrorContext(ctx,
 				"failed to run workload",
 				"instance_id", id,
-				"attempt", r.attempts[id],
+				"attempt", attempt,
 				"err", err,
 			)
 		}
@@ -248,10 +659,18 @@ func (r *reconciler) reconcile(ctx context.Context, ins *instancev1alpha1.Instan
 	}
 }
 
+// attempt returns the number of failed attempts to
+// create the workload of the instance with the given id.
+func (r *reconciler) attempt(id string) uint {
+	r.attemptsMu.Lock()
+	defer r.attemptsMu.Unlock()
+	return r.attempts[id]
+}
+
 func (r *reconciler) handleInstanceCreation(ctx context.Context, instance *instancev1alpha1.Instance) (reterr error) {
 	var (
 		id      = instance.GetId()
-		attempt = r.attempts[id]
+		attempt = r.attempt(id)
 	)
 
 	if attempt >= 5 {
@@ -287,44 +706,35 @@ func (r *reconciler) handleInstanceCreation(ctx context.Context, instance *insta
 		},
 	})
 
-	port, err := r.portAlloc.Allocate()
+	// the port might have already been reserved when the control plane
+	// asked us to prepare the workload after scheduling the instance.
+	port, err := r.portAlloc.AllocateFor(id)
 	if err != nil {
 		return fmt.Errorf("failed to allocate port: %w", err)
 	}
 
 	// port needs to be updated BEFORE calling RunWorkload
 	// so netglue can be aware of the host port that has been
-	// allocated.
+	// allocated and whether to isolate the workload.
 	r.store.Update(id, status.Status{
 		WorkloadStatus: &status.WorkloadStatus{
-			Port: port,
+			Port:                 port,
+			CrossInstanceTraffic: instance.GetCrossInstanceTraffic(),
 		},
 	})
 
-	var (
-		baseURL = fmt.Sprintf("%s/%s", r.cfg.RegistryEndpoint, instance.GetFlavorVersion().GetId())
-		labels  = map[string]string{
-			workload.LabelWorkloadPort: strconv.Itoa(int(port)),
-		}
-	)
-
-	maps.Copy(labels, workload.InstanceLabels(instance))
+	wl := r.workload(instance, port)
 
-	w := workload.Workload{
-		ID:               id,
-		Name:             instance.GetFlavorVersion().GetId(),
-		BaseImage:        baseURL + ":base",
-		CheckpointImage:  baseURL + ":checkpoint",
-		Instance:         instance,
-		Namespace:        r.cfg.WorkloadNamespace,
-		Hostname:         id,
-		Labels:           labels,
-		CPUPeriod:        r.cfg.WorkloadCPUPeriod,
-		CPUQuota:         r.cfg.WorkloadCPUQuota,
-		MemoryLimitBytes: r.cfg.WorkloadMemoryLimit,
+	// images are pulled separately, so the time spent pulling
+	// them shows up in the timeline of the instance.
+	if err := r.wlService.PullImages(ctx, wl); err != nil {
+		r.portAlloc.Free(port)
+		return fmt.Errorf("pull images: %w", err)
 	}
 
-	if err := r.wlService.RunWorkload(ctx, w, attempt); err != nil {
+	r.recordEvent(ctx, id, status.WorkloadEventTypeImagePulled, "images are present on the node")
+
+	if err := r.wlService.RunWorkload(ctx, wl, attempt); err != nil {
 		// very important to free the allocated port here, because
 		// if we exceed the maximum amount of attempts, the port
 		// will stay allocated as we return the function.
@@ -344,6 +754,8 @@ func (r *reconciler) handleInstanceCreation(ctx context.Context, instance *insta
 		return fmt.Errorf("run workload: %w", err)
 	}
 
+	r.recordEvent(ctx, id, status.WorkloadEventTypeContainerStarted, "containers have been started")
+
 	r.store.Update(id, status.Status{
 		WorkloadStatus: &status.WorkloadStatus{
 			State: status.WorkloadStateRunning,
@@ -352,6 +764,55 @@ func (r *reconciler) handleInstanceCreation(ctx context.Context, instance *insta
 	return nil
 }
 
+// recordEvent adds an event of the given type to the status of the
+// workload, so it is sent to the control plane with the next report.
+// failing to do so is not critical, so the error is only logged.
+func (r *reconciler) recordEvent(ctx context.Context, id string, typ status.WorkloadEventType, msg string) {
+	evID, err := uuid.NewV7()
+	if err != nil {
+		r.logger.WarnContext(ctx, "failed to generate event id", "instance_id", id, "type", typ, "err", err)
+		return
+	}
+
+	r.store.Update(id, status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			Events: []status.WorkloadEvent{
+				{
+					ID:        evID.String(),
+					Type:      typ,
+					Message:   msg,
+					Timestamp: time.Now(),
+				},
+			},
+		},
+	})
+}
+
+// workload builds the workload that should be run for the given instance.
+func (r *reconciler) workload(instance *instancev1alpha1.Instance, port uint16) workload.Workload {
+	baseImage, checkpointImage := workload.ImageRefs(r.cfg.RegistryEndpoint, instance.GetFlavorVersion().GetId())
+
+	labels := map[string]string{
+		workloadenv.LabelWorkloadPort: strconv.Itoa(int(port)),
+	}
+
+	maps.Copy(labels, workload.InstanceLabels(instance))
+
+	return workload.Workload{
+		ID:               instance.GetId(),
+		Name:             instance.GetFlavorVersion().GetId(),
+		BaseImage:        baseImage,
+		CheckpointImage:  checkpointImage,
+		Instance:         instance,
+		Namespace:        r.cfg.WorkloadNamespace,
+		Hostname:         instance.GetId(),
+		Labels:           labels,
+		CPUPeriod:        r.cfg.WorkloadCPUPeriod,
+		CPUQuota:         r.cfg.WorkloadCPUQuota,
+		MemoryLimitBytes: r.cfg.WorkloadMemoryLimit,
+	}
+}
+
 func (r *reconciler) handleInstanceDeleting(ctx context.Context, instance *instancev1alpha1.Instance) error {
 	if err := r.wlService.RemoveWorkload(ctx, instance.GetId()); err != nil {
 		if isNotFound(err) {
@@ -375,15 +836,55 @@ func (r *reconciler) handleInstanceDeleting(ctx context.Context, instance *insta
 }
 
 func (r *reconciler) handleInstanceRunning(ctx context.Context, instance *instancev1alpha1.Instance) error {
-	health, err := r.wlService.GetWorkloadHealth(ctx, instance.GetId())
+	health, err := r.wlService.GetWorkloadHealth(
+		ctx,
+		instance.GetId(),
+		time.Duration(instance.GetFlavorVersion().GetStartupGracePeriodSeconds())*time.Second,
+	)
 	if err != nil {
 		return fmt.Errorf("get workload health: %w", err)
 	}
 
 	if health == status.WorkloadHealthStatusHealthy {
+		ev, err := r.wlService.ReadyEvent(instance.GetId())
+		if err != nil {
+			r.logger.WarnContext(ctx, "failed to determine ready event", "instance_id", instance.GetId(), "err", err)
+		}
+
+		if ev != nil {
+			r.store.Update(instance.GetId(), status.Status{
+				WorkloadStatus: &status.WorkloadStatus{
+					Events: []status.WorkloadEvent{*ev},
+				},
+			})
+		}
 		return nil
 	}
 
+	// the event has to be determined before removing the workload,
+	// because the container status is gone afterward. failing to do
+	// so is not critical, so only log the error.
+	ev, err := r.wlService.TerminationEvent(ctx, instance.GetId())
+	if err != nil {
+		r.logger.WarnContext(ctx,
+			"failed to determine termination event",
+			"instance_id", instance.GetId(),
+			"err", err,
+		)
+	}
+
+	if ev != nil {
+		r.store.Update(instance.GetId(), status.Status{
+			WorkloadStatus: &status.WorkloadStatus{
+				Events: []status.WorkloadEvent{*ev},
+			},
+		})
+	}
+
+	if r.shouldRestart(instance, ev) {
+		return r.restartWorkload(ctx, instance)
+	}
+
 	if err := r.wlService.RemoveWorkload(ctx, instance.GetId()); err != nil {
 		// can happen if control plane update fails, but workload has already been deleted.
 		// this also enables manual deletion of pods, can come in handy when debugging.
@@ -407,6 +908,80 @@ func (r *reconciler) handleInstanceRunning(ctx context.Context, instance *instan
 	return nil
 }
 
+// shouldRestart reports whether the workload of the instance should be
+// restarted instead of being removed, based on the restart policy of its
+// flavor version. ev is the termination event of the workload and nil if
+// the workload has been considered unhealthy while still running.
+func (r *reconciler) shouldRestart(instance *instancev1alpha1.Instance, ev *status.WorkloadEvent) bool {
+	fv := instance.GetFlavorVersion()
+	switch fv.GetRestartPolicy() {
+	case chunkv1alpha1.RestartPolicy_ALWAYS:
+		return true
+	case chunkv1alpha1.RestartPolicy_ON_FAILURE:
+		// a clean exit is not considered a failure
+		if ev != nil && ev.Type == status.WorkloadEventTypeExited {
+			return false
+		}
+
+		r.restartsMu.Lock()
+		defer r.restartsMu.Unlock()
+
+		// zero means unlimited retries
+		return fv.GetRestartMaxRetries() == 0 || r.restarts[instance.GetId()] < fv.GetRestartMaxRetries()
+	default:
+		return false
+	}
+}
+
+// restartWorkload removes the workload of the instance and runs it again
+// using the port that has already been allocated for it.
+func (r *reconciler) restartWorkload(ctx context.Context, instance *instancev1alpha1.Instance) error {
+	id := instance.GetId()
+
+	r.restartsMu.Lock()
+	r.restarts[id]++
+	restarts := r.restarts[id]
+	r.restartsMu.Unlock()
+
+	r.logger.InfoContext(ctx,
+		"restarting workload",
+		"instance_id", id,
+		"restart_policy", instance.GetFlavorVersion().GetRestartPolicy().String(),
+		"restarts", restarts,
+	)
+
+	if err := r.wlService.RemoveWorkload(ctx, id); err != nil && !isNotFound(err) {
+		return fmt.Errorf("remove workload: %w", err)
+	}
+
+	var port uint16
+	if st := r.store.Get(id); st != nil && st.WorkloadStatus != nil {
+		port = st.WorkloadStatus.Port
+	}
+
+	if port == 0 {
+		p, err := r.portAlloc.AllocateFor(id)
+		if err != nil {
+			return fmt.Errorf("failed to allocate port: %w", err)
+		}
+		port = p
+		r.store.Update(id, status.Status{
+			WorkloadStatus: &status.WorkloadStatus{
+				Port:                 port,
+				CrossInstanceTraffic: instance.GetCrossInstanceTraffic(),
+			},
+		})
+	}
+
+	if err := r.wlService.RunWorkload(ctx, r.workload(instance, port), uint(restarts)); err != nil {
+		return fmt.Errorf("run workload: %w", err)
+	}
+
+	r.recordEvent(ctx, id, status.WorkloadEventTypeContainerStarted, fmt.Sprintf("containers have been restarted (restart %d)", restarts))
+
+	return nil
+}
+
 func isNotFound(err error) bool {
 	st, ok := grpcstatus.FromError(err)
 	if ok && st.Code() == codes.NotFound {
@@ -0,0 +1,166 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package platformd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/platformd/debug"
+	"github.com/spacechunks/explorer/platformd/status"
+)
+
+// simulate plans what would be done with every known instance, without
+// creating or removing any workloads. changed plans are logged, so the
+// log does not repeat itself on every sync.
+func (r *reconciler) simulate(ctx context.Context) {
+	// ports of the instance workloads present on the node
+	// tell us which workloads exist.
+	existing, err := r.wlService.InstancePorts(ctx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "dry run: failed to list existing workloads", "err", err)
+		return
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		planned = make(map[string]debug.PlannedAction, len(r.instances))
+	)
+
+	for id, ins := range r.instances {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, exists := existing[id]
+			p := r.plan(ctx, ins, exists)
+			mu.Lock()
+			planned[id] = p
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	r.plannedMu.Lock()
+	prev := r.planned
+	r.planned = planned
+	r.plannedMu.Unlock()
+
+	for id, p := range planned {
+		if old, ok := prev[id]; ok && old.Action == p.Action && errString(old.Err) == errString(p.Err) {
+			continue
+		}
+
+		if p.Action == "" {
+			continue
+		}
+
+		if p.Err != nil {
+			r.logger.WarnContext(ctx, "dry run: action would fail", "instance_id", id, "action", p.Action, "err", p.Err)
+			continue
+		}
+
+		r.logger.InfoContext(ctx, "dry run: would "+p.Action+" workload", "instance_id", id, "port", p.Port)
+	}
+}
+
+// plan determines what [reconciler.reconcile] would do with the instance.
+// exists reports whether the workload of the instance is present on the node.
+func (r *reconciler) plan(
+	ctx context.Context,
+	ins *instancev1alpha1.Instance,
+	exists bool,
+) debug.PlannedAction {
+	switch ins.GetState() {
+	case instancev1alpha1.InstanceState_PENDING, instancev1alpha1.InstanceState_CREATING:
+		if exists {
+			return debug.PlannedAction{}
+		}
+		return r.planCreation(ctx, ins)
+	case instancev1alpha1.InstanceState_DELETING, instancev1alpha1.InstanceState_CREATION_FAILED:
+		if !exists {
+			return debug.PlannedAction{}
+		}
+		return debug.PlannedAction{Action: debug.PlannedActionRemove}
+	case instancev1alpha1.InstanceState_RUNNING:
+		if !exists {
+			// the instance would be reported as deleted
+			return debug.PlannedAction{Action: debug.PlannedActionRemove}
+		}
+
+		health, err := r.wlService.GetWorkloadHealth(
+			ctx,
+			ins.GetId(),
+			time.Duration(ins.GetFlavorVersion().GetStartupGracePeriodSeconds())*time.Second,
+		)
+		if err != nil {
+			return debug.PlannedAction{Err: fmt.Errorf("get workload health: %w", err)}
+		}
+
+		if health == status.WorkloadHealthStatusHealthy {
+			return debug.PlannedAction{}
+		}
+
+		ev, err := r.wlService.TerminationEvent(ctx, ins.GetId())
+		if err != nil {
+			r.logger.WarnContext(ctx, "failed to determine termination event", "instance_id", ins.GetId(), "err", err)
+		}
+
+		if r.shouldRestart(ins, ev) {
+			return debug.PlannedAction{Action: debug.PlannedActionRestart}
+		}
+
+		return debug.PlannedAction{Action: debug.PlannedActionRemove}
+	default:
+		return debug.PlannedAction{}
+	}
+}
+
+// planCreation checks that a port can be allocated and the images of the
+// workload can be pulled, which verifies the registry configuration.
+func (r *reconciler) planCreation(ctx context.Context, ins *instancev1alpha1.Instance) debug.PlannedAction {
+	ret := debug.PlannedAction{Action: debug.PlannedActionCreate}
+
+	// the port is freed right away, because the workload is never created.
+	port, err := r.portAlloc.Allocate()
+	if err != nil {
+		ret.Err = fmt.Errorf("failed to allocate port: %w", err)
+		return ret
+	}
+	r.portAlloc.Free(port)
+
+	ret.Port = port
+
+	if err := r.wlService.PullImages(ctx, r.workload(ins, port)); err != nil {
+		ret.Err = fmt.Errorf("pull images: %w", err)
+	}
+
+	return ret
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -22,6 +22,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"testing"
@@ -30,12 +31,19 @@ import (
 	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
 	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/internal/workloadenv"
+	"github.com/spacechunks/explorer/platformd/debug"
+	"github.com/spacechunks/explorer/platformd/settings"
 	"github.com/spacechunks/explorer/platformd/status"
 	"github.com/spacechunks/explorer/platformd/workload"
 	"github.com/spacechunks/explorer/test"
 	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestReconciler(t *testing.T) {
@@ -51,7 +59,7 @@ func TestReconciler(t *testing.T) {
 
 	expectedWorkload := func(ins *instancev1alpha1.Instance) workload.Workload {
 		labels := workload.InstanceLabels(ins)
-		labels[workload.LabelWorkloadPort] = "1"
+		labels[workloadenv.LabelWorkloadPort] = "1"
 
 		baseURL := fmt.Sprintf("%s/%s", registryEndpoint, ins.GetFlavorVersion().GetId())
 
@@ -113,10 +121,18 @@ func TestReconciler(t *testing.T) {
 						},
 					})
 
+				wlSvc.EXPECT().
+					PullImages(mocky.Anything, expectedWorkload(ins)).
+					Return(nil)
+
+				expectRecordedEvent(store, ins.GetId(), status.WorkloadEventTypeImagePulled)
+
 				wlSvc.EXPECT().
 					RunWorkload(mocky.Anything, expectedWorkload(ins), uint(1)).
 					Return(nil)
 
+				expectRecordedEvent(store, ins.GetId(), status.WorkloadEventTypeContainerStarted)
+
 				store.EXPECT().
 					Update(ins.GetId(), status.Status{
 						WorkloadStatus: &status.WorkloadStatus{
@@ -124,14 +140,14 @@ func TestReconciler(t *testing.T) {
 						},
 					})
 
-				store.EXPECT().View().Return(map[string]status.Status{
+				store.EXPECT().Changes(uint64(0)).Return(changed(map[string]status.Status{
 					ins.GetId(): {
 						WorkloadStatus: &status.WorkloadStatus{
 							State: status.WorkloadStateRunning,
 							Port:  1,
 						},
 					},
-				})
+				}))
 
 				expectReportedStatus(insClient, ins.GetId(), instancev1alpha1.InstanceState_RUNNING, 1)
 			},
@@ -181,6 +197,14 @@ func TestReconciler(t *testing.T) {
 						},
 					}).Call
 
+				wlSvc.EXPECT().
+					PullImages(mocky.Anything, expectedWorkload(ins)).
+					Return(nil).
+					Times(int(maxAttempts))
+
+				expectRecordedEvent(store, ins.GetId(), status.WorkloadEventTypeImagePulled).
+					Times(int(maxAttempts))
+
 				for i := 0; i < int(maxAttempts); i++ {
 					wlSvc.EXPECT().
 						RunWorkload(mocky.Anything, expectedWorkload(ins), uint(i+1)).
@@ -193,17 +217,17 @@ func TestReconciler(t *testing.T) {
 					Return(nil).
 					Times(int(maxAttempts))
 
-				store.EXPECT().View().Return(map[string]status.Status{
+				store.EXPECT().Changes(uint64(0)).Return(changed(map[string]status.Status{
 					ins.GetId(): {
 						WorkloadStatus: &status.WorkloadStatus{
 							State: status.WorkloadStateCreationFailed,
 						},
 					},
-				})
+				}))
 
 				expectReportedStatus(insClient, ins.GetId(), instancev1alpha1.InstanceState_CREATION_FAILED, 0)
 
-				store.EXPECT().Del(ins.GetId())
+				store.EXPECT().CompareAndDelete(ins.GetId(), uint64(1)).Return(true)
 			},
 		},
 		{
@@ -255,11 +279,20 @@ func TestReconciler(t *testing.T) {
 						},
 					}).Call
 
+				wlSvc.EXPECT().
+					PullImages(mocky.Anything, expectedWorkload(ins)).
+					Return(nil).
+					NotBefore(updatePortCall)
+
+				expectRecordedEvent(store, ins.GetId(), status.WorkloadEventTypeImagePulled)
+
 				wlSvc.EXPECT().
 					RunWorkload(mocky.Anything, expectedWorkload(ins), uint(1)).
 					Return(nil).
 					NotBefore(updatePortCall)
 
+				expectRecordedEvent(store, ins.GetId(), status.WorkloadEventTypeContainerStarted)
+
 				store.EXPECT().
 					Update(ins.GetId(), status.Status{
 						WorkloadStatus: &status.WorkloadStatus{
@@ -267,14 +300,14 @@ func TestReconciler(t *testing.T) {
 						},
 					})
 
-				store.EXPECT().View().Return(map[string]status.Status{
+				store.EXPECT().Changes(uint64(0)).Return(changed(map[string]status.Status{
 					ins.GetId(): {
 						WorkloadStatus: &status.WorkloadStatus{
 							State: status.WorkloadStateRunning,
 							Port:  1,
 						},
 					},
-				})
+				}))
 
 				expectReportedStatus(insClient, ins.GetId(), instancev1alpha1.InstanceState_RUNNING, 1)
 			},
@@ -299,17 +332,17 @@ func TestReconciler(t *testing.T) {
 						},
 					})
 
-				store.EXPECT().View().Return(map[string]status.Status{
+				store.EXPECT().Changes(uint64(0)).Return(changed(map[string]status.Status{
 					ins.GetId(): {
 						WorkloadStatus: &status.WorkloadStatus{
 							State: status.WorkloadStateDeleted,
 						},
 					},
-				})
+				}))
 
 				expectReportedStatus(insClient, ins.GetId(), instancev1alpha1.InstanceState_DELETED, 0)
 
-				store.EXPECT().Del(ins.GetId())
+				store.EXPECT().CompareAndDelete(ins.GetId(), uint64(1)).Return(true)
 			},
 		},
 		{
@@ -332,17 +365,17 @@ func TestReconciler(t *testing.T) {
 						},
 					})
 
-				store.EXPECT().View().Return(map[string]status.Status{
+				store.EXPECT().Changes(uint64(0)).Return(changed(map[string]status.Status{
 					ins.GetId(): {
 						WorkloadStatus: &status.WorkloadStatus{
 							State: status.WorkloadStateDeleted,
 						},
 					},
-				})
+				}))
 
 				expectReportedStatus(insClient, ins.GetId(), instancev1alpha1.InstanceState_DELETED, 0)
 
-				store.EXPECT().Del(ins.GetId())
+				store.EXPECT().CompareAndDelete(ins.GetId(), uint64(1)).Return(true)
 			},
 		},
 		{
@@ -354,14 +387,101 @@ func TestReconciler(t *testing.T) {
 			) {
 				ins := discoverInstance(t, insClient, nodeKey, instancev1alpha1.InstanceState_RUNNING)
 				wlSvc.EXPECT().
-					GetWorkloadHealth(mocky.Anything, ins.GetId()).
+					GetWorkloadHealth(mocky.Anything, ins.GetId(), mocky.Anything).
+					Return(status.WorkloadHealthStatusHealthy, nil)
+
+				wlSvc.EXPECT().
+					ReadyEvent(ins.GetId()).
+					Return(nil, nil)
+
+				store.EXPECT().Changes(uint64(0)).Return(map[string]status.Entry{}, 0)
+			},
+		},
+		{
+			name: "instance RUNNING: record ready event once workload responded",
+			prep: func(
+				wlSvc *mock.MockWorkloadService,
+				insClient *mock.MockV1alpha1InstanceServiceClient,
+				store *mock.MockStatusStore,
+			) {
+				ins := discoverInstance(t, insClient, nodeKey, instancev1alpha1.InstanceState_RUNNING)
+
+				ev := status.WorkloadEvent{
+					ID:        "ev",
+					Type:      status.WorkloadEventTypeReady,
+					Message:   "server responded to the probe",
+					Timestamp: time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC),
+				}
+
+				wlSvc.EXPECT().
+					GetWorkloadHealth(mocky.Anything, ins.GetId(), mocky.Anything).
+					Return(status.WorkloadHealthStatusHealthy, nil)
+
+				wlSvc.EXPECT().
+					ReadyEvent(ins.GetId()).
+					Return(&ev, nil).
+					Once()
+
+				wlSvc.EXPECT().
+					ReadyEvent(ins.GetId()).
+					Return(nil, nil)
+
+				store.EXPECT().
+					Update(ins.GetId(), status.Status{
+						WorkloadStatus: &status.WorkloadStatus{
+							Events: []status.WorkloadEvent{ev},
+						},
+					}).
+					Once()
+
+				store.EXPECT().Changes(uint64(0)).Return(map[string]status.Entry{}, 0)
+			},
+		},
+		{
+			name: "instance RUNNING: report collected traffic",
+			prep: func(
+				wlSvc *mock.MockWorkloadService,
+				insClient *mock.MockV1alpha1InstanceServiceClient,
+				store *mock.MockStatusStore,
+			) {
+				ins := discoverInstance(t, insClient, nodeKey, instancev1alpha1.InstanceState_RUNNING)
+				wlSvc.EXPECT().
+					GetWorkloadHealth(mocky.Anything, ins.GetId(), mocky.Anything).
 					Return(status.WorkloadHealthStatusHealthy, nil)
 
-				store.EXPECT().View().Return(map[string]status.Status{})
+				wlSvc.EXPECT().
+					ReadyEvent(ins.GetId()).
+					Return(nil, nil)
+
+				store.EXPECT().Changes(uint64(0)).Return(changed(map[string]status.Status{
+					ins.GetId(): {
+						WorkloadStatus: &status.WorkloadStatus{
+							State: status.WorkloadStateRunning,
+							Port:  1,
+							Traffic: &status.TrafficStats{
+								Connections: 3,
+								RxBytes:     1024,
+								TxBytes:     2048,
+							},
+						},
+					},
+				}))
+
 				insClient.EXPECT().ReceiveInstanceStatusReports(
 					mocky.Anything, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
-						Reports: []*instancev1alpha1.InstanceStatusReport{},
-					}).
+						Reports: []*instancev1alpha1.InstanceStatusReport{
+							{
+								InstanceId: ins.GetId(),
+								State:      instancev1alpha1.InstanceState_RUNNING,
+								Port:       1,
+								Traffic: &instancev1alpha1.InstanceTraffic{
+									Connections: 3,
+									RxBytes:     1024,
+									TxBytes:     2048,
+								},
+							},
+						},
+					}, mocky.Anything).
 					Return(nil, nil)
 			},
 		},
@@ -374,9 +494,13 @@ func TestReconciler(t *testing.T) {
 			) {
 				ins := discoverInstance(t, insClient, nodeKey, instancev1alpha1.InstanceState_RUNNING)
 				wlSvc.EXPECT().
-					GetWorkloadHealth(mocky.Anything, ins.GetId()).
+					GetWorkloadHealth(mocky.Anything, ins.GetId(), mocky.Anything).
 					Return(status.WorkloadHealthStatusUnhealthy, nil)
 
+				wlSvc.EXPECT().
+					TerminationEvent(mocky.Anything, ins.GetId()).
+					Return(nil, nil)
+
 				wlSvc.EXPECT().
 					RemoveWorkload(mocky.Anything, ins.GetId()).
 					Return(nil)
@@ -388,17 +512,95 @@ func TestReconciler(t *testing.T) {
 						},
 					})
 
-				store.EXPECT().View().Return(map[string]status.Status{
+				store.EXPECT().Changes(uint64(0)).Return(changed(map[string]status.Status{
 					ins.GetId(): {
 						WorkloadStatus: &status.WorkloadStatus{
 							State: status.WorkloadStateDeleted,
 						},
 					},
-				})
+				}))
 
 				expectReportedStatus(insClient, ins.GetId(), instancev1alpha1.InstanceState_DELETED, 0)
 
-				store.EXPECT().Del(ins.GetId())
+				store.EXPECT().CompareAndDelete(ins.GetId(), uint64(1)).Return(true)
+			},
+		},
+		{
+			name: "instance RUNNING: report termination event of UNHEALTHY workload",
+			prep: func(
+				wlSvc *mock.MockWorkloadService,
+				insClient *mock.MockV1alpha1InstanceServiceClient,
+				store *mock.MockStatusStore,
+			) {
+				var (
+					ins = discoverInstance(t, insClient, nodeKey, instancev1alpha1.InstanceState_RUNNING)
+					ts  = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+					ev  = status.WorkloadEvent{
+						ID:        "019bb3a9-7e34-7a9c-a2c4-3b2b5e6f7a10",
+						Type:      status.WorkloadEventTypeOOMKilled,
+						ExitCode:  137,
+						Message:   "container abc exited with code 137",
+						Timestamp: ts,
+					}
+				)
+
+				wlSvc.EXPECT().
+					GetWorkloadHealth(mocky.Anything, ins.GetId(), mocky.Anything).
+					Return(status.WorkloadHealthStatusUnhealthy, nil)
+
+				wlSvc.EXPECT().
+					TerminationEvent(mocky.Anything, ins.GetId()).
+					Return(&ev, nil)
+
+				store.EXPECT().
+					Update(ins.GetId(), status.Status{
+						WorkloadStatus: &status.WorkloadStatus{
+							Events: []status.WorkloadEvent{ev},
+						},
+					})
+
+				wlSvc.EXPECT().
+					RemoveWorkload(mocky.Anything, ins.GetId()).
+					Return(nil)
+
+				store.EXPECT().
+					Update(ins.GetId(), status.Status{
+						WorkloadStatus: &status.WorkloadStatus{
+							State: status.WorkloadStateDeleted,
+						},
+					})
+
+				store.EXPECT().Changes(uint64(0)).Return(changed(map[string]status.Status{
+					ins.GetId(): {
+						WorkloadStatus: &status.WorkloadStatus{
+							State:  status.WorkloadStateDeleted,
+							Events: []status.WorkloadEvent{ev},
+						},
+					},
+				}))
+
+				insClient.EXPECT().ReceiveInstanceStatusReports(
+					mocky.Anything, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+						Reports: []*instancev1alpha1.InstanceStatusReport{
+							{
+								InstanceId: ins.GetId(),
+								State:      instancev1alpha1.InstanceState_DELETED,
+								Events: []*instancev1alpha1.InstanceEvent{
+									{
+										Id:         ev.ID,
+										InstanceId: ins.GetId(),
+										Type:       instancev1alpha1.InstanceEventType_OOM_KILLED,
+										ExitCode:   ev.ExitCode,
+										Message:    ev.Message,
+										CreatedAt:  timestamppb.New(ts),
+									},
+								},
+							},
+						},
+					}, mocky.Anything).
+					Return(nil, nil)
+
+				store.EXPECT().CompareAndDelete(ins.GetId(), uint64(1)).Return(true)
 			},
 		},
 		{
@@ -410,9 +612,13 @@ func TestReconciler(t *testing.T) {
 			) {
 				ins := discoverInstance(t, insClient, nodeKey, instancev1alpha1.InstanceState_RUNNING)
 				wlSvc.EXPECT().
-					GetWorkloadHealth(mocky.Anything, ins.GetId()).
+					GetWorkloadHealth(mocky.Anything, ins.GetId(), mocky.Anything).
 					Return(status.WorkloadHealthStatusUnhealthy, nil)
 
+				wlSvc.EXPECT().
+					TerminationEvent(mocky.Anything, ins.GetId()).
+					Return(nil, nil)
+
 				wlSvc.EXPECT().
 					RemoveWorkload(mocky.Anything, ins.GetId()).
 					Return(nil)
@@ -424,13 +630,13 @@ func TestReconciler(t *testing.T) {
 						},
 					})
 
-				store.EXPECT().View().Return(map[string]status.Status{
+				store.EXPECT().Changes(uint64(0)).Return(changed(map[string]status.Status{
 					ins.GetId(): {
 						WorkloadStatus: &status.WorkloadStatus{
 							State: status.WorkloadStateDeleted,
 						},
 					},
-				})
+				}))
 
 				insClient.EXPECT().ReceiveInstanceStatusReports(
 					mocky.Anything, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
@@ -441,7 +647,7 @@ func TestReconciler(t *testing.T) {
 								Port:       uint32(0),
 							},
 						},
-					}).
+					}, mocky.Anything).
 					Return(nil, errors.New("some error"))
 			},
 		},
@@ -464,16 +670,16 @@ func TestReconciler(t *testing.T) {
 						},
 					})
 
-				store.EXPECT().View().Return(map[string]status.Status{
+				store.EXPECT().Changes(uint64(0)).Return(changed(map[string]status.Status{
 					ins.GetId(): {
 						WorkloadStatus: &status.WorkloadStatus{
 							State: status.WorkloadStateDeleted,
 						},
 					},
-				})
+				}))
 
 				expectReportedStatus(insClient, ins.GetId(), instancev1alpha1.InstanceState_DELETED, 0)
-				store.EXPECT().Del(ins.GetId())
+				store.EXPECT().CompareAndDelete(ins.GetId(), uint64(1)).Return(true)
 			},
 		},
 	}
@@ -502,11 +708,16 @@ func TestReconciler(t *testing.T) {
 					mockWlSvc,
 					mockStore,
 					workload.NewPortAllocator(1, 1),
+					nil,
 				)
 			)
 
 			tt.prep(mockWlSvc, mockInsSvc, mockStore)
 
+			// statuses are only reported once, so subsequent
+			// ticks do not see any changes.
+			mockStore.EXPECT().Changes(uint64(1)).Return(map[string]status.Entry{}, 1).Maybe()
+
 			time.AfterFunc(1*time.Second, func() {
 				syncer.Stop()
 			})
@@ -550,6 +761,19 @@ func discoverInstance(
 	return ins
 }
 
+// changed returns the statuses as if they all have been
+// changed at the first revision of the status store.
+func changed(statuses map[string]status.Status) (map[string]status.Entry, uint64) {
+	ret := make(map[string]status.Entry, len(statuses))
+	for id, st := range statuses {
+		ret[id] = status.Entry{
+			Status:  st,
+			Version: 1,
+		}
+	}
+	return ret, 1
+}
+
 func expectReportedStatus(
 	insClient *mock.MockV1alpha1InstanceServiceClient,
 	id string,
@@ -565,6 +789,740 @@ func expectReportedStatus(
 					Port:       port,
 				},
 			},
+		}, mocky.Anything).
+		Return(nil, nil)
+}
+
+// expectRecordedEvent expects a single event of the given type
+// to be added to the status of the workload.
+func expectRecordedEvent(
+	store *mock.MockStatusStore,
+	id string,
+	typ status.WorkloadEventType,
+) *mock.MockStatusStore_Update_Call {
+	return store.EXPECT().
+		Update(id, mocky.MatchedBy(func(st status.Status) bool {
+			return st.WorkloadStatus != nil &&
+				len(st.WorkloadStatus.Events) == 1 &&
+				st.WorkloadStatus.Events[0].Type == typ &&
+				st.WorkloadStatus.Events[0].ID != ""
+		}))
+}
+
+func TestReconcilerDiscoverAppliesDeltas(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		nodeKey    = "uggeee"
+		mockInsSvc = mock.NewMockV1alpha1InstanceServiceClient(t)
+		first      = &instancev1alpha1.Instance{Id: "first", State: instancev1alpha1.InstanceState_PENDING}
+		second     = &instancev1alpha1.Instance{Id: "second", State: instancev1alpha1.InstanceState_PENDING}
+		updated    = &instancev1alpha1.Instance{Id: "first", State: instancev1alpha1.InstanceState_RUNNING}
+		syncer     = newReconciler(
+			slog.New(slog.DiscardHandler),
+			reconcilerConfig{
+				SyncInterval:       time.Hour,
+				FullResyncInterval: time.Hour,
+				NodeID:             nodeKey,
+			},
+			mockInsSvc,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+	)
+
+	mockInsSvc.EXPECT().
+		DiscoverInstances(mocky.Anything, &instancev1alpha1.DiscoverInstanceRequest{
+			NodeKey: nodeKey,
+		}).
+		Return(&instancev1alpha1.DiscoverInstanceResponse{
+			Instances: []*instancev1alpha1.Instance{first, second},
+			Revision:  "2",
+			Full:      true,
+		}, nil).
+		Once()
+
+	mockInsSvc.EXPECT().
+		DiscoverInstances(mocky.Anything, &instancev1alpha1.DiscoverInstanceRequest{
+			NodeKey:  nodeKey,
+			Revision: "2",
+		}).
+		Return(&instancev1alpha1.DiscoverInstanceResponse{
+			Instances: []*instancev1alpha1.Instance{updated},
+			Revision:  "3",
+		}, nil).
+		Once()
+
+	require.NoError(t, syncer.discover(ctx))
+	require.NoError(t, syncer.discover(ctx))
+
+	require.Equal(t, "3", syncer.revision)
+	require.Equal(t, map[string]*instancev1alpha1.Instance{
+		"first":  updated,
+		"second": second,
+	}, syncer.instances)
+
+	// once the resync interval elapsed, all instances are
+	// requested again and replace the known ones.
+	syncer.lastFullSync = time.Now().Add(-2 * time.Hour)
+
+	mockInsSvc.EXPECT().
+		DiscoverInstances(mocky.Anything, &instancev1alpha1.DiscoverInstanceRequest{
+			NodeKey: nodeKey,
+		}).
+		Return(&instancev1alpha1.DiscoverInstanceResponse{
+			Instances: []*instancev1alpha1.Instance{second},
+			Revision:  "4",
+			Full:      true,
+		}, nil).
+		Once()
+
+	require.NoError(t, syncer.discover(ctx))
+
+	require.Equal(t, "4", syncer.revision)
+	require.Equal(t, map[string]*instancev1alpha1.Instance{
+		"second": second,
+	}, syncer.instances)
+}
+
+func TestReconcilerDiscoverDeltaDiscoveryDisabled(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		nodeKey    = "uggeee"
+		mockInsSvc = mock.NewMockV1alpha1InstanceServiceClient(t)
+		ins        = &instancev1alpha1.Instance{Id: "first", State: instancev1alpha1.InstanceState_PENDING}
+		syncer     = newReconciler(
+			slog.New(slog.DiscardHandler),
+			reconcilerConfig{
+				SyncInterval:       time.Hour,
+				FullResyncInterval: time.Hour,
+				NodeID:             nodeKey,
+			},
+			mockInsSvc,
+			nil,
+			nil,
+			nil,
+			settings.New(slog.New(slog.DiscardHandler), nil),
+		)
+	)
+
+	// the first response disables delta discovery, so the revision
+	// is not sent anymore and all instances are requested every time.
+	mockInsSvc.EXPECT().
+		DiscoverInstances(mocky.Anything, &instancev1alpha1.DiscoverInstanceRequest{
+			NodeKey: nodeKey,
+		}).
+		Return(&instancev1alpha1.DiscoverInstanceResponse{
+			Instances: []*instancev1alpha1.Instance{ins},
+			Revision:  "2",
+			Full:      true,
+			Settings: &instancev1alpha1.NodeSettings{
+				FeatureFlags: map[string]bool{
+					string(resource.FeatureFlagDeltaDiscovery): false,
+				},
+			},
+		}, nil).
+		Twice()
+
+	require.NoError(t, syncer.discover(ctx))
+	require.NoError(t, syncer.discover(ctx))
+
+	require.Equal(t, "2", syncer.revision)
+}
+
+func TestReconcilerSyncReportsOnlyChangedStatuses(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		mockInsSvc = mock.NewMockV1alpha1InstanceServiceClient(t)
+		store      = status.NewMemStore()
+		syncer     = newReconciler(
+			slog.New(slog.DiscardHandler),
+			reconcilerConfig{
+				SyncInterval:       time.Hour,
+				FullResyncInterval: time.Hour,
+			},
+			mockInsSvc,
+			nil,
+			store,
+			nil,
+			nil,
+		)
+	)
+
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateRunning,
+			Port:  1,
+		},
+	})
+
+	expectReport := func(state instancev1alpha1.InstanceState) {
+		mockInsSvc.EXPECT().ReceiveInstanceStatusReports(
+			mocky.Anything, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+				Reports: []*instancev1alpha1.InstanceStatusReport{
+					{
+						InstanceId: "abc",
+						State:      state,
+						Port:       1,
+					},
+				},
+			}, mocky.Anything).
+			Return(nil, nil).
+			Once()
+	}
+
+	expectReport(instancev1alpha1.InstanceState_RUNNING)
+
+	// unchanged statuses are not reported again
+	syncer.sync(ctx)
+	syncer.sync(ctx)
+
+	expectReport(instancev1alpha1.InstanceState_DELETED)
+
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateDeleted,
+		},
+	})
+
+	syncer.sync(ctx)
+
+	// deleted statuses are removed once they have been reported
+	require.Nil(t, store.Get("abc"))
+}
+
+func TestReconcilerSyncReportsInBatches(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		mockInsSvc = mock.NewMockV1alpha1InstanceServiceClient(t)
+		store      = status.NewMemStore()
+		syncer     = newReconciler(
+			slog.New(slog.DiscardHandler),
+			reconcilerConfig{
+				SyncInterval:       time.Hour,
+				FullResyncInterval: time.Hour,
+				ReportBatchSize:    2,
+			},
+			mockInsSvc,
+			nil,
+			store,
+			nil,
+			nil,
+		)
+	)
+
+	// statuses are reported in the order they changed
+	ids := []string{"e", "d", "c", "b", "a"}
+	for i, id := range ids {
+		store.Update(id, status.Status{
+			WorkloadStatus: &status.WorkloadStatus{
+				State: status.WorkloadStateRunning,
+				Port:  uint16(i + 1),
+			},
+		})
+	}
+
+	expectBatch := func(err error, ids ...string) {
+		reports := make([]*instancev1alpha1.InstanceStatusReport, 0, len(ids))
+		for _, id := range ids {
+			reports = append(reports, &instancev1alpha1.InstanceStatusReport{
+				InstanceId: id,
+				State:      instancev1alpha1.InstanceState_RUNNING,
+				Port:       uint32(store.Get(id).WorkloadStatus.Port),
+			})
+		}
+
+		mockInsSvc.EXPECT().ReceiveInstanceStatusReports(
+			mocky.Anything,
+			&instancev1alpha1.ReceiveInstanceStatusReportsRequest{Reports: reports},
+			mocky.Anything,
+		).
+			Return(nil, err).
+			Once()
+	}
+
+	expectBatch(nil, "e", "d")
+	expectBatch(errors.New("unavailable"), "c", "b")
+
+	syncer.sync(ctx)
+
+	// only the first batch has been acknowledged
+	require.Equal(t, uint64(2), syncer.State().Reported)
+
+	expectBatch(nil, "c", "b")
+	expectBatch(nil, "a")
+
+	syncer.sync(ctx)
+
+	require.Equal(t, uint64(5), syncer.State().Reported)
+}
+
+func TestReconcilerDiscoverAdvertisesBuilderCapability(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		nodeKey    = "uggeee"
+		mockInsSvc = mock.NewMockV1alpha1InstanceServiceClient(t)
+		syncer     = newReconciler(
+			slog.New(slog.DiscardHandler),
+			reconcilerConfig{
+				SyncInterval:       time.Hour,
+				FullResyncInterval: time.Hour,
+				NodeID:             nodeKey,
+				Builder:            true,
+			},
+			mockInsSvc,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+	)
+
+	mockInsSvc.EXPECT().
+		DiscoverInstances(mocky.Anything, &instancev1alpha1.DiscoverInstanceRequest{
+			NodeKey: nodeKey,
+			Capabilities: []instancev1alpha1.NodeCapability{
+				instancev1alpha1.NodeCapability_BUILDER,
+			},
+		}).
+		Return(&instancev1alpha1.DiscoverInstanceResponse{
+			Revision: "1",
+			Full:     true,
+		}, nil)
+
+	require.NoError(t, syncer.discover(ctx))
+}
+
+func TestReconcilerDiscoverReportsCapacity(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		nodeKey    = "uggeee"
+		mockInsSvc = mock.NewMockV1alpha1InstanceServiceClient(t)
+		syncer     = newReconciler(
+			slog.New(slog.DiscardHandler),
+			reconcilerConfig{
+				SyncInterval:        time.Hour,
+				FullResyncInterval:  time.Hour,
+				NodeID:              nodeKey,
+				MaxInstances:        new(uint32(12)),
+				WorkloadCPUPeriod:   100000,
+				WorkloadCPUQuota:    150000,
+				WorkloadMemoryLimit: 2 << 30,
+			},
+			mockInsSvc,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+	)
+
+	mockInsSvc.EXPECT().
+		DiscoverInstances(mocky.Anything, &instancev1alpha1.DiscoverInstanceRequest{
+			NodeKey: nodeKey,
+			Capacity: &instancev1alpha1.NodeCapacity{
+				MaxInstances:        12,
+				WorkloadCpuMillis:   1500,
+				WorkloadMemoryBytes: 2 << 30,
+			},
 		}).
+		Return(&instancev1alpha1.DiscoverInstanceResponse{
+			Revision: "1",
+			Full:     true,
+		}, nil)
+
+	require.NoError(t, syncer.discover(ctx))
+}
+
+func TestReconcilerRecoverPorts(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		mockWlSvc = mock.NewMockWorkloadService(t)
+		portAlloc = workload.NewPortAllocator(30000, 30010)
+		syncer    = newReconciler(
+			slog.New(slog.DiscardHandler),
+			reconcilerConfig{
+				SyncInterval: time.Hour,
+			},
+			nil,
+			mockWlSvc,
+			nil,
+			portAlloc,
+			nil,
+		)
+	)
+
+	mockWlSvc.EXPECT().
+		InstancePorts(ctx).
+		Return(map[string]uint16{"first": 30001, "second": 30002}, nil)
+
+	syncer.recoverPorts(ctx)
+
+	require.False(t, portAlloc.Claim(30001))
+	require.False(t, portAlloc.Claim(30002))
+	require.True(t, portAlloc.Claim(30003))
+}
+
+func TestReconcilerState(t *testing.T) {
+	var (
+		now    = time.Now()
+		syncer = newReconciler(
+			slog.New(slog.DiscardHandler),
+			reconcilerConfig{
+				SyncInterval: time.Hour,
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+	)
+
+	syncer.merge([]*instancev1alpha1.Instance{
+		{
+			Id:    "second",
+			State: instancev1alpha1.InstanceState_RUNNING,
+			FlavorVersion: &chunkv1alpha1.FlavorVersion{
+				Id: "fv",
+			},
+		},
+		{
+			Id:    "first",
+			State: instancev1alpha1.InstanceState_PENDING,
+		},
+	}, "3", true, now)
+
+	syncer.attempts["first"] = 2
+	syncer.restarts["second"] = 1
+
+	require.Equal(t, debug.ReconcilerState{
+		Queue: []debug.QueuedInstance{
+			{
+				ID:    "first",
+				State: "PENDING",
+			},
+			{
+				ID:              "second",
+				FlavorVersionID: "fv",
+				State:           "RUNNING",
+				Restarts:        1,
+			},
+		},
+		Attempts:     map[string]uint{"first": 2},
+		Revision:     "3",
+		LastFullSync: now,
+	}, syncer.State())
+}
+
+func TestReconcilerRestartPolicy(t *testing.T) {
+	exited := &status.WorkloadEvent{
+		ID:   "019bb3a9-7e34-7a9c-a2c4-3b2b5e6f7a10",
+		Type: status.WorkloadEventTypeExited,
+	}
+
+	crashed := &status.WorkloadEvent{
+		ID:       "019bb3a9-7e34-7a9c-a2c4-3b2b5e6f7a11",
+		Type:     status.WorkloadEventTypeCrashed,
+		ExitCode: 1,
+	}
+
+	tests := []struct {
+		name        string
+		policy      chunkv1alpha1.RestartPolicy
+		maxRetries  uint32
+		restarts    uint32
+		ev          *status.WorkloadEvent
+		wantRestart bool
+	}{
+		{
+			name:        "NEVER removes the workload",
+			policy:      chunkv1alpha1.RestartPolicy_NEVER,
+			ev:          crashed,
+			wantRestart: false,
+		},
+		{
+			name:        "ALWAYS restarts cleanly exited workload",
+			policy:      chunkv1alpha1.RestartPolicy_ALWAYS,
+			ev:          exited,
+			wantRestart: true,
+		},
+		{
+			name:        "ON_FAILURE restarts crashed workload",
+			policy:      chunkv1alpha1.RestartPolicy_ON_FAILURE,
+			ev:          crashed,
+			wantRestart: true,
+		},
+		{
+			name:        "ON_FAILURE restarts workload with failing probe",
+			policy:      chunkv1alpha1.RestartPolicy_ON_FAILURE,
+			wantRestart: true,
+		},
+		{
+			name:        "ON_FAILURE removes cleanly exited workload",
+			policy:      chunkv1alpha1.RestartPolicy_ON_FAILURE,
+			ev:          exited,
+			wantRestart: false,
+		},
+		{
+			name:        "ON_FAILURE removes workload once max retries are reached",
+			policy:      chunkv1alpha1.RestartPolicy_ON_FAILURE,
+			maxRetries:  2,
+			restarts:    2,
+			ev:          crashed,
+			wantRestart: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx       = context.Background()
+				mockStore = mock.NewMockStatusStore(t)
+				mockWlSvc = mock.NewMockWorkloadService(t)
+				syncer    = newReconciler(
+					slog.New(slog.DiscardHandler),
+					reconcilerConfig{
+						SyncInterval: time.Hour,
+					},
+					nil,
+					mockWlSvc,
+					mockStore,
+					workload.NewPortAllocator(1, 1),
+					nil,
+				)
+				ins = &instancev1alpha1.Instance{
+					Id: test.NewUUIDv7(t),
+					FlavorVersion: &chunkv1alpha1.FlavorVersion{
+						Id:                "flavor-version-id",
+						RestartPolicy:     tt.policy,
+						RestartMaxRetries: tt.maxRetries,
+					},
+					State: instancev1alpha1.InstanceState_RUNNING,
+				}
+			)
+
+			syncer.restarts[ins.GetId()] = tt.restarts
+
+			mockWlSvc.EXPECT().
+				GetWorkloadHealth(mocky.Anything, ins.GetId(), mocky.Anything).
+				Return(status.WorkloadHealthStatusUnhealthy, nil)
+
+			mockWlSvc.EXPECT().
+				TerminationEvent(mocky.Anything, ins.GetId()).
+				Return(tt.ev, nil)
+
+			if tt.ev != nil {
+				mockStore.EXPECT().
+					Update(ins.GetId(), status.Status{
+						WorkloadStatus: &status.WorkloadStatus{
+							Events: []status.WorkloadEvent{*tt.ev},
+						},
+					})
+			}
+
+			mockWlSvc.EXPECT().
+				RemoveWorkload(mocky.Anything, ins.GetId()).
+				Return(nil)
+
+			if tt.wantRestart {
+				mockStore.EXPECT().
+					Get(ins.GetId()).
+					Return(&status.Status{
+						WorkloadStatus: &status.WorkloadStatus{
+							State: status.WorkloadStateRunning,
+							Port:  1,
+						},
+					})
+
+				mockWlSvc.EXPECT().
+					RunWorkload(mocky.Anything, syncer.workload(ins, 1), uint(tt.restarts+1)).
+					Return(nil)
+
+				expectRecordedEvent(mockStore, ins.GetId(), status.WorkloadEventTypeContainerStarted)
+			} else {
+				mockStore.EXPECT().
+					Update(ins.GetId(), status.Status{
+						WorkloadStatus: &status.WorkloadStatus{
+							State: status.WorkloadStateDeleted,
+						},
+					})
+			}
+
+			require.NoError(t, syncer.handleInstanceRunning(ctx, ins))
+
+			expected := tt.restarts
+			if tt.wantRestart {
+				expected++
+			}
+			require.Equal(t, expected, syncer.restarts[ins.GetId()])
+		})
+	}
+}
+
+func TestReconcilerWatchPassesPushedInstances(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		nodeKey    = "uggeee"
+		mockInsSvc = mock.NewMockV1alpha1InstanceServiceClient(t)
+		ins        = &instancev1alpha1.Instance{Id: "first", State: instancev1alpha1.InstanceState_PENDING}
+		pushed     = &instancev1alpha1.WatchInstancesResponse{
+			Instances: []*instancev1alpha1.Instance{ins},
+			Revision:  "2",
+			Full:      true,
+		}
+		syncer = newReconciler(
+			slog.New(slog.DiscardHandler),
+			reconcilerConfig{
+				SyncInterval:       time.Hour,
+				FullResyncInterval: time.Hour,
+				NodeID:             nodeKey,
+				Builder:            true,
+				Watch:              true,
+			},
+			mockInsSvc,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		done = make(chan error)
+	)
+
+	mockInsSvc.EXPECT().
+		WatchInstances(mocky.Anything, &instancev1alpha1.WatchInstancesRequest{
+			NodeKey:      nodeKey,
+			Capabilities: []instancev1alpha1.NodeCapability{instancev1alpha1.NodeCapability_BUILDER},
+		}).
+		Return(&watchStream{responses: []*instancev1alpha1.WatchInstancesResponse{pushed}}, nil)
+
+	go func() {
+		done <- syncer.watchInstances(ctx)
+	}()
+
+	resp := <-syncer.updates
+	require.Equal(t, pushed, resp)
+	require.True(t, syncer.watching.Load())
+
+	// the stream ends after all responses have been received
+	require.ErrorIs(t, <-done, io.EOF)
+
+	syncer.merge(resp.GetInstances(), resp.GetRevision(), resp.GetFull(), time.Now())
+
+	require.Equal(t, "2", syncer.revision)
+	require.Equal(t, map[string]*instancev1alpha1.Instance{
+		"first": ins,
+	}, syncer.instances)
+}
+
+type watchStream struct {
+	grpc.ClientStream
+	responses []*instancev1alpha1.WatchInstancesResponse
+}
+
+func (s *watchStream) Recv() (*instancev1alpha1.WatchInstancesResponse, error) {
+	if len(s.responses) == 0 {
+		return nil, io.EOF
+	}
+
+	resp := s.responses[0]
+	s.responses = s.responses[1:]
+
+	return resp, nil
+}
+
+func TestReconcilerDryRun(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		mockWlSvc  = mock.NewMockWorkloadService(t)
+		mockInsSvc = mock.NewMockV1alpha1InstanceServiceClient(t)
+		portAlloc  = workload.NewPortAllocator(30000, 30000)
+		pullErr    = errors.New("unauthorized")
+		syncer     = newReconciler(
+			slog.New(slog.DiscardHandler),
+			reconcilerConfig{
+				SyncInterval:     time.Hour,
+				RegistryEndpoint: "reg.example.com",
+				DryRun:           true,
+			},
+			mockInsSvc,
+			mockWlSvc,
+			nil,
+			portAlloc,
+			nil,
+		)
+	)
+
+	instance := func(id string, state instancev1alpha1.InstanceState) *instancev1alpha1.Instance {
+		return &instancev1alpha1.Instance{
+			Id:    id,
+			State: state,
+			FlavorVersion: &chunkv1alpha1.FlavorVersion{
+				Id: "fv-" + id,
+			},
+		}
+	}
+
+	syncer.merge([]*instancev1alpha1.Instance{
+		instance("pending", instancev1alpha1.InstanceState_PENDING),
+		instance("no-access", instancev1alpha1.InstanceState_PENDING),
+		instance("created", instancev1alpha1.InstanceState_CREATING),
+		instance("deleting", instancev1alpha1.InstanceState_DELETING),
+		instance("healthy", instancev1alpha1.InstanceState_RUNNING),
+		instance("unhealthy", instancev1alpha1.InstanceState_RUNNING),
+	}, "1", true, time.Now())
+
+	mockWlSvc.EXPECT().
+		InstancePorts(mocky.Anything).
+		Return(map[string]uint16{
+			"created":   30001,
+			"deleting":  30002,
+			"healthy":   30003,
+			"unhealthy": 30004,
+		}, nil)
+
+	mockWlSvc.EXPECT().
+		PullImages(mocky.Anything, mocky.MatchedBy(func(w workload.Workload) bool {
+			return w.ID == "pending"
+		})).
+		Return(nil)
+
+	mockWlSvc.EXPECT().
+		PullImages(mocky.Anything, mocky.MatchedBy(func(w workload.Workload) bool {
+			return w.ID == "no-access"
+		})).
+		Return(pullErr)
+
+	mockWlSvc.EXPECT().
+		GetWorkloadHealth(mocky.Anything, "healthy", time.Duration(0)).
+		Return(status.WorkloadHealthStatusHealthy, nil)
+
+	mockWlSvc.EXPECT().
+		GetWorkloadHealth(mocky.Anything, "unhealthy", time.Duration(0)).
+		Return(status.WorkloadHealthStatusUnhealthy, nil)
+
+	mockWlSvc.EXPECT().
+		TerminationEvent(mocky.Anything, "unhealthy").
 		Return(nil, nil)
+
+	// neither RunWorkload nor RemoveWorkload are expected
+	// to be called, nor are any statuses reported.
+	syncer.sync(ctx)
+
+	planned := make(map[string]*debug.PlannedAction)
+	for _, q := range syncer.State().Queue {
+		planned[q.ID] = q.Planned
+	}
+
+	require.Equal(t, &debug.PlannedAction{Action: debug.PlannedActionCreate, Port: 30000}, planned["pending"])
+	require.Equal(t, debug.PlannedActionCreate, planned["no-access"].Action)
+	require.ErrorIs(t, planned["no-access"].Err, pullErr)
+	require.Equal(t, &debug.PlannedAction{}, planned["created"])
+	require.Equal(t, &debug.PlannedAction{Action: debug.PlannedActionRemove}, planned["deleting"])
+	require.Equal(t, &debug.PlannedAction{}, planned["healthy"])
+	require.Equal(t, &debug.PlannedAction{Action: debug.PlannedActionRemove}, planned["unhealthy"])
+
+	// ports are not kept allocated
+	require.True(t, portAlloc.Claim(30000))
 }
@@ -0,0 +1,167 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package platformd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/netip"
+	"slices"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/platformd/proxy"
+	"github.com/spacechunks/explorer/platformd/workload"
+)
+
+// redirector applies the instance redirects of this node, so players
+// connecting to the previous endpoint of an instance are forwarded to its
+// current endpoint. the ports of the previous endpoints are claimed for as
+// long as the redirects are applied, so they are not handed out to new
+// workloads in the meantime.
+type redirector struct {
+	logger    *slog.Logger
+	nodeID    string
+	insClient instancev1alpha1.InstanceServiceClient
+	proxySvc  proxy.Service
+	portAlloc *workload.PortAllocator
+	ticker    *time.Ticker
+	stop      chan struct{}
+
+	// claimed contains the ports claimed for redirects.
+	claimed map[uint16]bool
+
+	// applied contains the redirects currently applied, keyed by port.
+	applied map[uint16]proxy.Redirect
+}
+
+func newRedirector(
+	logger *slog.Logger,
+	nodeID string,
+	interval time.Duration,
+	insClient instancev1alpha1.InstanceServiceClient,
+	proxySvc proxy.Service,
+	portAlloc *workload.PortAllocator,
+) *redirector {
+	return &redirector{
+		logger:    logger,
+		nodeID:    nodeID,
+		insClient: insClient,
+		proxySvc:  proxySvc,
+		portAlloc: portAlloc,
+		ticker:    time.NewTicker(interval),
+		stop:      make(chan struct{}),
+		claimed:   make(map[uint16]bool),
+		applied:   make(map[uint16]proxy.Redirect),
+	}
+}
+
+func (r *redirector) Run(ctx context.Context) {
+	for {
+		select {
+		case <-r.ticker.C:
+			if err := r.sync(ctx); err != nil {
+				r.logger.ErrorContext(ctx, "syncing redirects failed", "err", err)
+			}
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+func (r *redirector) Stop() {
+	r.ticker.Stop()
+	close(r.stop)
+}
+
+// sync fetches the redirects of this node and applies them, if they
+// differ from the currently applied ones.
+func (r *redirector) sync(ctx context.Context) error {
+	resp, err := r.insClient.GetInstanceRedirects(ctx, &instancev1alpha1.GetInstanceRedirectsRequest{
+		NodeKey: r.nodeID,
+	})
+	if err != nil {
+		return fmt.Errorf("get redirects: %w", err)
+	}
+
+	var (
+		now  = time.Now()
+		want = make(map[uint16]proxy.Redirect)
+	)
+
+	for _, rd := range resp.GetRedirects() {
+		if rd.GetExpiresAt().AsTime().Before(now) {
+			continue
+		}
+
+		to, err := netip.ParseAddr(rd.GetToAddress())
+		if err != nil {
+			r.logger.ErrorContext(ctx,
+				"invalid redirect address",
+				"instance_id", rd.GetInstanceId(),
+				"address", rd.GetToAddress(),
+			)
+			continue
+		}
+
+		port := uint16(rd.GetFromPort())
+		if !r.claimed[port] {
+			// if the port is already in use again, connections reach the
+			// workload that uses it, so there is nothing to redirect anymore.
+			if !r.portAlloc.Claim(port) {
+				r.logger.DebugContext(ctx, "redirect port in use, skip", "instance_id", rd.GetInstanceId(), "port", port)
+				continue
+			}
+			r.claimed[port] = true
+		}
+
+		want[port] = proxy.Redirect{
+			InstanceID: rd.GetInstanceId(),
+			FromPort:   port,
+			To:         netip.AddrPortFrom(to, uint16(rd.GetToPort())),
+		}
+	}
+
+	if maps.Equal(r.applied, want) {
+		return nil
+	}
+
+	redirects := make([]proxy.Redirect, 0, len(want))
+	for _, port := range slices.Sorted(maps.Keys(want)) {
+		redirects = append(redirects, want[port])
+	}
+
+	if err := r.proxySvc.ApplyRedirects(ctx, redirects); err != nil {
+		return fmt.Errorf("apply redirects: %w", err)
+	}
+
+	// only free ports once the listeners of the removed redirects are gone,
+	// otherwise new workloads could receive a port envoy is still bound to.
+	for port := range r.claimed {
+		if _, ok := want[port]; !ok {
+			r.portAlloc.Free(port)
+			delete(r.claimed, port)
+		}
+	}
+
+	r.applied = want
+	return nil
+}
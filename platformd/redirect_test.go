@@ -0,0 +1,106 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package platformd
+
+import (
+	"context"
+	"log/slog"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/platformd/proxy"
+	"github.com/spacechunks/explorer/platformd/workload"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRedirectorSync(t *testing.T) {
+	var (
+		ctx            = context.Background()
+		nodeKey        = "node"
+		logger         = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		mockInsClient  = mock.NewMockV1alpha1InstanceServiceClient(t)
+		mockProxySvc   = mock.NewMockProxyService(t)
+		portAlloc      = workload.NewPortAllocator(30000, 30010)
+		r              = newRedirector(logger, nodeKey, time.Hour, mockInsClient, mockProxySvc, portAlloc)
+		req            = &instancev1alpha1.GetInstanceRedirectsRequest{NodeKey: nodeKey}
+		activeRedirect = &instancev1alpha1.InstanceRedirect{
+			InstanceId: "abc",
+			FromPort:   30001,
+			ToAddress:  "10.0.0.2",
+			ToPort:     30002,
+			ExpiresAt:  timestamppb.New(time.Now().Add(time.Minute)),
+		}
+	)
+
+	mockInsClient.EXPECT().
+		GetInstanceRedirects(mocky.Anything, req).
+		Return(&instancev1alpha1.GetInstanceRedirectsResponse{
+			Redirects: []*instancev1alpha1.InstanceRedirect{
+				activeRedirect,
+				{
+					InstanceId: "def",
+					FromPort:   30003,
+					ToAddress:  "10.0.0.2",
+					ToPort:     30004,
+					ExpiresAt:  timestamppb.New(time.Now().Add(-time.Minute)),
+				},
+			},
+		}, nil).
+		Twice()
+
+	mockProxySvc.EXPECT().
+		ApplyRedirects(mocky.Anything, []proxy.Redirect{
+			{
+				InstanceID: "abc",
+				FromPort:   30001,
+				To:         netip.MustParseAddrPort("10.0.0.2:30002"),
+			},
+		}).
+		Return(nil).
+		Once()
+
+	require.NoError(t, r.sync(ctx))
+
+	// the port of the redirect must not be handed out to workloads
+	require.False(t, portAlloc.Claim(30001))
+
+	// unchanged redirects are not applied again
+	require.NoError(t, r.sync(ctx))
+
+	mockInsClient.EXPECT().
+		GetInstanceRedirects(mocky.Anything, req).
+		Return(&instancev1alpha1.GetInstanceRedirectsResponse{}, nil).
+		Once()
+
+	mockProxySvc.EXPECT().
+		ApplyRedirects(mocky.Anything, []proxy.Redirect{}).
+		Return(nil).
+		Once()
+
+	require.NoError(t, r.sync(ctx))
+
+	// the port is freed after the redirect has been removed
+	require.True(t, portAlloc.Claim(30001))
+}
@@ -2,14 +2,18 @@ package platformd
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"net/netip"
 	"os"
+	"os/exec"
 	"path/filepath"
-	"sync"
+	"sync/atomic"
 	"time"
 
 	"buf.build/go/protovalidate"
@@ -17,30 +21,53 @@ import (
 	protovalidatemw "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/protovalidate"
 	"github.com/hashicorp/go-multierror"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/diagnostics"
+	"github.com/spacechunks/explorer/internal/grpcclient"
 	"github.com/spacechunks/explorer/internal/image"
-	"github.com/spacechunks/explorer/platformd/garbage"
+	"github.com/spacechunks/explorer/internal/instr"
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/spacechunks/explorer/internal/unixsock"
 	"github.com/spacechunks/explorer/platformd/status"
 	"google.golang.org/grpc/credentials"
 	"k8s.io/client-go/tools/remotecommand"
 
 	//instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
 	checkpointv1alpha1 "github.com/spacechunks/explorer/api/platformd/checkpoint/v1alpha1"
+	debugv1alpha1 "github.com/spacechunks/explorer/api/platformd/debug/v1alpha1"
+	dnsv1alpha1 "github.com/spacechunks/explorer/api/platformd/dns/v1alpha1"
+	nodev1alpha1 "github.com/spacechunks/explorer/api/platformd/node/v1alpha1"
 	proxyv1alpha1 "github.com/spacechunks/explorer/api/platformd/proxy/v1alpha1"
 	workloadv1alpha2 "github.com/spacechunks/explorer/api/platformd/workload/v1alpha2"
 	"github.com/spacechunks/explorer/internal/datapath"
+	"github.com/spacechunks/explorer/platformd/bpfmap"
 	"github.com/spacechunks/explorer/platformd/checkpoint"
+	"github.com/spacechunks/explorer/platformd/condition"
 	"github.com/spacechunks/explorer/platformd/cri"
+	"github.com/spacechunks/explorer/platformd/debug"
+	"github.com/spacechunks/explorer/platformd/dns"
+	"github.com/spacechunks/explorer/platformd/logship"
+	"github.com/spacechunks/explorer/platformd/periodic"
 	"github.com/spacechunks/explorer/platformd/proxy"
 	"github.com/spacechunks/explorer/platformd/proxy/xds"
+	"github.com/spacechunks/explorer/platformd/settings"
+	"github.com/spacechunks/explorer/platformd/system"
+	"github.com/spacechunks/explorer/platformd/update"
 	"github.com/spacechunks/explorer/platformd/workload"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
 )
 
+// corednsConfigDir is the directory the dns config is mounted at inside the coredns container.
+const corednsConfigDir = "/etc/coredns"
+
 type Server struct {
 	logger *slog.Logger
 	stopCh chan struct{}
+
+	// handoff is the path of the updated binary
+	// platformd has to hand over to once stopped.
+	handoff atomic.Pointer[string]
 }
 
 func NewServer(logger *slog.Logger) *Server {
@@ -53,6 +80,32 @@ func NewServer(logger *slog.Logger) *Server {
 func (s *Server) Run(ctx context.Context, cfg Config) error {
 	s.logger.Info("started with config", "config", cfg)
 
+	// platformd does not emit traces, but exports metrics if
+	// an otlp endpoint has been configured by the operator.
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_METRICS_ENDPOINT") != "" {
+		shutdown, err := instr.SetupOTel(ctx, "platformd", true)
+		if err != nil {
+			return fmt.Errorf("setup otel: %w", err)
+		}
+
+		defer func() {
+			if err := shutdown(ctx); err != nil {
+				s.logger.Warn("error shutting down otel", "err", err)
+			}
+		}()
+	}
+
+	// profiling is off by default and only meant for diagnosing
+	// issues, like cpu spikes during reconciliation.
+	var pprofServer *http.Server
+	if cfg.PprofListenAddr != "" {
+		srv, err := diagnostics.NewServer(cfg.PprofListenAddr, cfg.PprofTokenFile)
+		if err != nil {
+			return fmt.Errorf("pprof server: %w", err)
+		}
+		pprofServer = srv
+	}
+
 	if err := os.MkdirAll(filepath.Dir(cfg.ManagementServerListenSock.Path), os.ModePerm); err != nil {
 		return fmt.Errorf("create dir: %w", err)
 	}
@@ -61,6 +114,35 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 		return fmt.Errorf("create log dirs: %w", err)
 	}
 
+	// log dirs of removed workloads only have to be archived,
+	// if their remaining lines are shipped afterward.
+	var logArchiveDir string
+	if cfg.LogShippingInterval > 0 {
+		logArchiveDir = cfg.LogArchiveDir
+		if err := os.MkdirAll(logArchiveDir, os.ModePerm); err != nil {
+			return fmt.Errorf("create log archive dir: %w", err)
+		}
+	}
+
+	mirrors := cfg.registryMirrors()
+	changed, err := cri.EnsureMirrorConfig(cri.MirrorConfig{
+		Registry: cfg.RegistryEndpoint,
+		Mirrors:  mirrors,
+		Path:     cfg.RegistryMirrorConfigPath,
+	})
+	if err != nil {
+		return fmt.Errorf("ensure registry mirror config: %w", err)
+	}
+
+	// cri-o only reads the registry configuration on startup or reload,
+	// so base and checkpoint image pulls would not use the mirrors otherwise.
+	if changed {
+		s.logger.Info("registry mirrors changed, reloading crio", "mirrors", mirrors)
+		if out, err := exec.Command("systemctl", "reload", "crio").CombinedOutput(); err != nil {
+			return fmt.Errorf("systemctl reload crio: %w: %s", err, out)
+		}
+	}
+
 	tlsCreds := credentials.NewTLS(&tls.Config{
 		InsecureSkipVerify: true,
 	})
@@ -70,7 +152,12 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 		return fmt.Errorf("failed to create cri grpc client: %w", err)
 	}
 
-	cpConn, err := grpc.NewClient(cfg.ControlPlaneEndpoint, grpc.WithTransportCredentials(tlsCreds))
+	cpConn, err := grpcclient.New(
+		cfg.ControlPlaneEndpoint,
+		grpcclient.DefaultConfig,
+		grpc.WithTransportCredentials(tlsCreds),
+		grpc.WithUnaryInterceptor(logging.UnaryClientInterceptor()),
+	)
 	//cpConn, err := grpc.NewClient(cfg.ControlPlaneEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return fmt.Errorf("failed to create cri grpc client: %w", err)
@@ -81,11 +168,67 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 		return fmt.Errorf("failed to parse dns server address: %w", err)
 	}
 
+	envoyAdminAddr, err := netip.ParseAddrPort(cfg.EnvoyAdminAddr)
+	if err != nil {
+		return fmt.Errorf("failed to parse envoy admin address: %w", err)
+	}
+
 	bpf, err := datapath.LoadBPF()
 	if err != nil {
 		return fmt.Errorf("failed to load bpf: %w", err)
 	}
 
+	var imgVerifier workload.ImageVerifier
+	if cfg.VerifyImages {
+		keyData, err := os.ReadFile(cfg.ImageVerificationKeyFile)
+		if err != nil {
+			return fmt.Errorf("read image verification key: %w", err)
+		}
+
+		key, err := image.ParsePublicKey(keyData)
+		if err != nil {
+			return fmt.Errorf("image verification key: %w", err)
+		}
+
+		imgVerifier = image.NewVerifier(
+			image.NewService(s.logger.With("component", "image-verifier"), cfg.RegistryUser, cfg.RegistryPass, "/tmp"),
+			key,
+		)
+	}
+
+	var updateKey *ecdsa.PublicKey
+	if cfg.UpdateVerificationKeyFile != "" {
+		keyData, err := os.ReadFile(cfg.UpdateVerificationKeyFile)
+		if err != nil {
+			return fmt.Errorf("read update verification key: %w", err)
+		}
+
+		updateKey, err = image.ParsePublicKey(keyData)
+		if err != nil {
+			return fmt.Errorf("update verification key: %w", err)
+		}
+	}
+
+	host, err := system.ReadHostResources()
+	if err != nil {
+		return fmt.Errorf("read host resources: %w", err)
+	}
+
+	capacity := system.ComputeCapacity(host, cfg.NodeCapacity)
+
+	capacityAttrs := []any{
+		"cpu_millis", host.CPUMillis,
+		"memory_bytes", host.MemoryBytes,
+		"allocatable_cpu_millis", capacity.AllocatableCPUMillis,
+		"allocatable_memory_bytes", capacity.AllocatableMemoryBytes,
+	}
+
+	if capacity.MaxInstances != nil {
+		capacityAttrs = append(capacityAttrs, "max_instances", *capacity.MaxInstances)
+	}
+
+	s.logger.InfoContext(ctx, "computed node capacity", capacityAttrs...)
+
 	// hardcode envoy node id here, because implementing support
 	// for multiple proxy nodes is currently way out of scope
 	// and might not even be needed at all. being configurable
@@ -118,9 +261,14 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 				PlatformdListenSockURL: cfg.ManagementServerListenSock,
 				PlatformdSocketUID:     cfg.ManagementSocketUID,
 				PlatformdSocketGID:     cfg.ManagementSocketGID,
+				DNSZone:                cfg.DNSZone,
+				Probe:                  cfg.WorkloadConfig.Probe,
+				ParamsDir:              cfg.WorkloadConfig.ParamsDir,
+				LogArchiveDir:          logArchiveDir,
 			},
 			criSvc,
 			registryAuth,
+			imgVerifier,
 		)
 		proxySvc = proxy.NewService(
 			s.logger.With("component", "proxy-service"),
@@ -132,45 +280,95 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 
 		portAlloc   = workload.NewPortAllocator(cfg.MinPort, cfg.MaxPort)
 		statusStore = status.NewMemStore()
+	)
 
-		checkSvcLogger = s.logger.With("component", "checkpoint-service")
-		checkSvc       = checkpoint.NewService(
-			checkSvcLogger,
-			checkpoint.Config{
-				CPUPeriod:                cfg.CheckpointConfig.CPUPeriod,
-				CPUQuota:                 cfg.CheckpointConfig.CPUQuota,
-				MemoryLimitBytes:         cfg.CheckpointConfig.MemoryLimitBytes,
-				CheckpointFileDir:        cfg.CheckpointConfig.CheckpointFileDir,
-				CheckpointTimeoutSeconds: cfg.CheckpointConfig.CheckpointTimeoutSeconds,
-				RegistryUser:             cfg.CheckpointConfig.RegistryUser,
-				RegistryPass:             cfg.CheckpointConfig.RegistryPass,
-				ListenAddr:               cfg.CheckpointConfig.ListenAddr,
-				StatusRetentionPeriod:    cfg.CheckpointConfig.StatusRetentionPeriod,
-				ContainerReadyTimeout:    cfg.CheckpointConfig.ContainerReadyTimeout,
-			},
-			criSvc,
-			image.NewService(checkSvcLogger, cfg.RegistryUser, cfg.RegistryPass, "/tmp"),
-			statusStore,
-			func(url string) (remotecommand.Executor, error) {
-				return checkpoint.NewSPDYExecutor(url)
-			},
-			portAlloc,
-			datapath.NewLinuxSockHandler(bpf),
-		)
+	if err := workload.RegisterMetrics(s.logger, wlSvc); err != nil {
+		return fmt.Errorf("register workload metrics: %w", err)
+	}
+
+	checkSvcLogger := s.logger.With("component", "checkpoint-service")
+	checkSvc, err := checkpoint.NewService(
+		checkSvcLogger,
+		checkpoint.Config{
+			CPUPeriod:                cfg.CheckpointConfig.CPUPeriod,
+			CPUQuota:                 cfg.CheckpointConfig.CPUQuota,
+			MemoryLimitBytes:         cfg.CheckpointConfig.MemoryLimitBytes,
+			MaxCPUQuota:              cfg.CheckpointConfig.MaxCPUQuota,
+			MaxMemoryLimitBytes:      cfg.CheckpointConfig.MaxMemoryLimitBytes,
+			CheckpointFileDir:        cfg.CheckpointConfig.CheckpointFileDir,
+			MaxDiskUsageBytes:        cfg.CheckpointConfig.MaxDiskUsageBytes,
+			CheckpointTimeoutSeconds: cfg.CheckpointConfig.CheckpointTimeoutSeconds,
+			RegistryUser:             cfg.CheckpointConfig.RegistryUser,
+			RegistryPass:             cfg.CheckpointConfig.RegistryPass,
+			ListenAddr:               cfg.CheckpointConfig.ListenAddr,
+			StatusRetentionPeriod:    cfg.CheckpointConfig.StatusRetentionPeriod,
+			ContainerReadyTimeout:    cfg.CheckpointConfig.ContainerReadyTimeout,
+			RestoreVerifyTimeout:     cfg.CheckpointConfig.RestoreVerifyTimeout,
+		},
+		criSvc,
+		image.NewService(checkSvcLogger, cfg.RegistryUser, cfg.RegistryPass, "/tmp"),
+		statusStore,
+		func(url string) (remotecommand.Executor, error) {
+			return checkpoint.NewSPDYExecutor(url)
+		},
+		portAlloc,
+		datapath.NewLinuxSockHandler(bpf),
+	)
+	if err != nil {
+		return fmt.Errorf("create checkpoint service: %w", err)
+	}
 
+	// log levels are only changed at runtime, if the
+	// levels platformd has been started with are known.
+	var levelOverride *logging.Override
+	if cfg.LogLevels != nil {
+		levelOverride = logging.NewOverride(cfg.LogLevels, cfg.LogLevelSpec)
+	}
+
+	nodeSettings := settings.New(s.logger.With("component", "settings"), levelOverride)
+
+	var (
 		proxyServer = proxy.NewServer(proxySvc)
-		wlServer    = workload.NewServer(statusStore, wlSvc)
+		wlServer    = workload.NewServer(statusStore, wlSvc, portAlloc, cfg.RegistryEndpoint)
 		checkServer = checkpoint.NewServer(checkSvc)
 		reconciler  = newReconciler(s.logger, reconcilerConfig{
-			MaxAttempts:       cfg.MaxAttempts,
-			SyncInterval:      cfg.SyncInterval,
-			NodeID:            cfg.NodeID,
-			WorkloadNamespace: cfg.WorkloadNamespace,
-			RegistryEndpoint:  cfg.RegistryEndpoint,
-		}, insClient, wlSvc, statusStore, portAlloc)
-		gc = garbage.NewExecutor(s.logger, 1*time.Second, checkSvc)
+			MaxAttempts:        cfg.MaxAttempts,
+			SyncInterval:       cfg.SyncInterval,
+			FullResyncInterval: cfg.FullResyncInterval,
+			NodeID:             cfg.NodeID,
+			Builder:            cfg.Builder,
+			Watch:              cfg.WatchInstances,
+			DryRun:             cfg.DryRun,
+			ReportBatchSize:    cfg.ReportBatchSize,
+			WorkloadNamespace:  cfg.WorkloadNamespace,
+			RegistryEndpoint:   cfg.RegistryEndpoint,
+			MaxInstances:       capacity.MaxInstances,
+
+			WorkloadCPUPeriod:   workloadCPUPeriod(cfg.NodeCapacity.WorkloadCPUMillis),
+			WorkloadCPUQuota:    workloadCPUQuota(cfg.NodeCapacity.WorkloadCPUMillis),
+			WorkloadMemoryLimit: cfg.NodeCapacity.WorkloadMemoryBytes,
+		}, insClient, wlSvc, statusStore, portAlloc, nodeSettings)
+		redirects = newRedirector(
+			s.logger.With("component", "redirector"),
+			cfg.NodeID,
+			cfg.SyncInterval,
+			insClient,
+			proxySvc,
+			portAlloc,
+		)
+		traffic = proxy.NewTrafficCollector(
+			s.logger.With("component", "traffic-collector"),
+			envoyAdminAddr,
+			cfg.TrafficCollectInterval,
+			statusStore,
+		)
 	)
 
+	jobs, err := s.newJobScheduler(cfg, checkSvc, bpf, criConn)
+	if err != nil {
+		return fmt.Errorf("create job scheduler: %w", err)
+	}
+
 	validator, err := protovalidate.New()
 	if err != nil {
 		return fmt.Errorf("create validator: %w", err)
@@ -178,16 +376,27 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 
 	mgmtServer := grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
-		grpc.UnaryInterceptor(protovalidatemw.UnaryServerInterceptor(validator)),
+		grpc.KeepaliveEnforcementPolicy(grpcclient.KeepaliveEnforcementPolicy),
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(),
+			protovalidatemw.UnaryServerInterceptor(validator),
+		),
 	)
 	proxyv1alpha1.RegisterProxyServiceServer(mgmtServer, proxyServer)
 	workloadv1alpha2.RegisterWorkloadServiceServer(mgmtServer, wlServer)
 	checkpointv1alpha1.RegisterCheckpointServiceServer(mgmtServer, checkServer)
 	xds.CreateAndRegisterServer(ctx, s.logger.With("component", "xds"), mgmtServer, xdsCfg)
 
-	checkGRPCServer := grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	checkGRPCServer := grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		grpc.UnaryInterceptor(logging.UnaryServerInterceptor()),
+	)
 	checkpointv1alpha1.RegisterCheckpointServiceServer(checkGRPCServer, checkServer)
 
+	// the control plane prepares workloads of instances it scheduled to
+	// this node, so the workload service has to be reachable by it.
+	workloadv1alpha2.RegisterWorkloadServiceServer(checkGRPCServer, wlServer)
+
 	iface, err := net.InterfaceByName(cfg.HostIface)
 	if err != nil {
 		return fmt.Errorf("failed to get host interface: %w", err)
@@ -203,6 +412,54 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 		return fmt.Errorf("failed to parse ip: %w", err)
 	}
 
+	nodeAddr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return fmt.Errorf("invalid host address: %s", ip)
+	}
+
+	// coredns is bound to the same address we forward dns traffic of the
+	// workloads to, so we serve our zone on the same address as well.
+	dnsSvc := dns.NewService(s.logger.With("component", "dns-service"), dns.Config{
+		Dir:          cfg.DNSConfigDir,
+		ContainerDir: corednsConfigDir,
+		BaseCorefile: cfg.DNSBaseConfig,
+		Zone:         cfg.DNSZone,
+		ListenAddr:   dnsUpstream,
+		NodeAddr:     nodeAddr.Unmap(),
+	})
+
+	if err := dnsSvc.EnsureConfig(ctx); err != nil {
+		return fmt.Errorf("ensure dns config: %w", err)
+	}
+
+	// the checkpoint server is reachable by the control plane, so it
+	// can register records and query the node status using the api.
+	dnsServer := dns.NewServer(dnsSvc)
+	dnsv1alpha1.RegisterDNSServiceServer(mgmtServer, dnsServer)
+	dnsv1alpha1.RegisterDNSServiceServer(checkGRPCServer, dnsServer)
+
+	sysMonitor, err := system.NewMonitor(
+		s.logger.With("component", "system-monitor"),
+		criSvc,
+		cfg.SystemWorkloadCheckInterval,
+		envoyWorkload(cfg),
+		corednsWorkload(cfg),
+	)
+	if err != nil {
+		return fmt.Errorf("create system workload monitor: %w", err)
+	}
+
+	nodeServer := system.NewServer(sysMonitor, capacity, checkSvc)
+	nodev1alpha1.RegisterNodeServiceServer(mgmtServer, nodeServer)
+	nodev1alpha1.RegisterNodeServiceServer(checkGRPCServer, nodeServer)
+
+	// the debug service exposes internal state, so it
+	// must only be reachable through the management socket.
+	debugv1alpha1.RegisterDebugServiceServer(
+		mgmtServer,
+		debug.NewServer(&reconciler, portAlloc, statusStore, wlSvc),
+	)
+
 	if err := bpf.AttachAndPinDNAT(iface); err != nil {
 		return fmt.Errorf("attach dnat bpf: %w", err) // TODO: ignore exists, FIXME: update if exists
 	}
@@ -223,10 +480,23 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 		return fmt.Errorf("create checkpoint location dir: %w", err)
 	}
 
-	var (
-		g  multierror.Group
-		wg sync.WaitGroup
-	)
+	// the socket file is left behind if platformd did not shut down
+	// cleanly, so stale sockets are removed before listening on it.
+	unixSock, err := unixsock.Listen(cfg.ManagementServerListenSock.Path)
+	if err != nil {
+		return fmt.Errorf("failed to listen on mgmt server socket: %w", err)
+	}
+
+	if err := os.Chown(
+		cfg.ManagementServerListenSock.Path,
+		int(cfg.ManagementSocketUID),
+		int(cfg.ManagementSocketGID),
+	); err != nil {
+		_ = unixSock.Close()
+		return fmt.Errorf("failed to chown mgmt server socket: %w", err)
+	}
+
+	var g multierror.Group
 
 	g.Go(func() error {
 		checkSock, err := net.Listen("tcp", cfg.CheckpointConfig.ListenAddr)
@@ -242,26 +512,7 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 		return nil
 	})
 
-	wg.Add(1)
-
 	g.Go(func() error {
-		unixSock, err := net.Listen("unix", cfg.ManagementServerListenSock.Path)
-		if err != nil {
-			s.stopCh <- struct{}{}
-			return fmt.Errorf("failed to listen on unix socket: %v", err)
-		}
-
-		if err := os.Chown(
-			cfg.ManagementServerListenSock.Path,
-			int(cfg.ManagementSocketUID),
-			int(cfg.ManagementSocketGID),
-		); err != nil {
-			s.stopCh <- struct{}{}
-			return fmt.Errorf("failed to chown mgmt server socket: %w", err)
-		}
-
-		wg.Done()
-
 		if err := mgmtServer.Serve(unixSock); err != nil {
 			s.stopCh <- struct{}{}
 			return fmt.Errorf("failed to serve mgmt server: %w", err)
@@ -269,105 +520,71 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 		return nil
 	})
 
-	s.logger.Info("waiting for sockets")
-	wg.Wait()
-
-	if err := criSvc.EnsurePod(ctx, cri.RunOptions{
-		PodConfig: &runtimev1.PodSandboxConfig{
-			Metadata: &runtimev1.PodSandboxMetadata{
-				Uid:       "envoy",
-				Name:      "envoy",
-				Namespace: "explorer-system",
-			},
-			Hostname:     "envoy",
-			LogDirectory: cri.PodLogDir,
-			Linux: &runtimev1.LinuxPodSandboxConfig{
-				SecurityContext: &runtimev1.LinuxSandboxSecurityContext{
-					NamespaceOptions: &runtimev1.NamespaceOption{
-						Network: runtimev1.NamespaceMode_NODE,
-					},
-				},
-				// TODO: resources
-			},
-		},
-		ContainerConfig: &runtimev1.ContainerConfig{
-			Args: []string{"-c /etc/envoy/config.yaml", "-l debug"},
-			Image: &runtimev1.ImageSpec{
-				Image:              cfg.EnvoyImage,
-				UserSpecifiedImage: cfg.EnvoyImage,
-			},
-			Mounts: []*runtimev1.Mount{
-				{
-					HostPath:      "/etc/platformd/proxy.conf",
-					ContainerPath: "/etc/envoy/config.yaml",
-				},
-				{
-					// mount the parent dir of the socket instead of the socket
-					// path directly, because if platformd restarts the socket
-					// mount will go stale as the socket is closed. this causes
-					// all clients requests to fail until we restart the container.
-					// the parent dir will still be present, if platformd is stopped.
-					// once it's back up clients can access the socket again.
-					HostPath:      filepath.Dir(cfg.ManagementServerListenSock.Path),
-					ContainerPath: filepath.Dir(cfg.ManagementServerListenSock.Path),
-				},
-			},
-			Linux: &runtimev1.LinuxContainerConfig{
-				SecurityContext: &runtimev1.LinuxContainerSecurityContext{
-					RunAsUser: &runtimev1.Int64Value{
-						Value: int64(cfg.ManagementSocketUID),
-					},
-					RunAsGroup: &runtimev1.Int64Value{
-						Value: int64(cfg.ManagementSocketGID),
-					},
-				},
-			},
-		},
-	}); err != nil {
-		return fmt.Errorf("ensure envoy: %w", err)
+	if pprofServer != nil {
+		g.Go(func() error {
+			if err := pprofServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.stopCh <- struct{}{}
+				return fmt.Errorf("failed to serve pprof server: %w", err)
+			}
+			return nil
+		})
 	}
 
-	if err := criSvc.EnsurePod(ctx, cri.RunOptions{
-		PodConfig: &runtimev1.PodSandboxConfig{
-			Metadata: &runtimev1.PodSandboxMetadata{
-				Uid:       "coredns",
-				Name:      "coredns",
-				Namespace: "explorer-system",
-			},
-			Labels:       workload.SystemWorkloadLabels("coredns"),
-			Hostname:     "coredns",
-			LogDirectory: cri.PodLogDir,
-			Linux: &runtimev1.LinuxPodSandboxConfig{
-				SecurityContext: &runtimev1.LinuxSandboxSecurityContext{
-					NamespaceOptions: &runtimev1.NamespaceOption{
-						Network: runtimev1.NamespaceMode_NODE,
-					},
-				},
-				// TODO: resources
-			},
-		},
-		ContainerConfig: &runtimev1.ContainerConfig{
-			Args: []string{"-conf", "/etc/coredns/Corefile"},
-			Image: &runtimev1.ImageSpec{
-				Image:              cfg.CoreDNSImage,
-				UserSpecifiedImage: cfg.CoreDNSImage,
-			},
-			Mounts: []*runtimev1.Mount{
-				{
-					HostPath:      "/etc/platformd/dns.conf",
-					ContainerPath: "/etc/coredns/Corefile",
-				},
-			},
-		},
-	}); err != nil {
-		return fmt.Errorf("ensure coredns: %w", err)
+	if err := sysMonitor.Ensure(ctx); err != nil {
+		return fmt.Errorf("ensure system workloads: %w", err)
 	}
 
 	// start reconciler after mgmt server has been started,
 	// because otherwise creating pending instances will
 	// fail as netglue is not able to retrieve the allocated
 	// host port.
-	go gc.Run(ctx)
+	// ports of workloads that kept running while platformd has been
+	// restarted have to be claimed, before new ports are allocated.
+	reconciler.recoverPorts(ctx)
+
+	updater, err := s.newUpdater(cfg, updateKey, insClient)
+	if err != nil {
+		return fmt.Errorf("create updater: %w", err)
+	}
+
+	if updater != nil {
+		go updater.Run(ctx)
+	}
+
+	conditions := condition.NewMonitor(
+		s.logger.With("component", "condition-monitor"),
+		condition.Config{
+			NodeID:   cfg.NodeID,
+			Interval: cfg.ConditionCheckInterval,
+		},
+		insClient,
+		condition.NewDiskPressureDetector(cfg.CheckpointConfig.CheckpointFileDir, condition.DefaultMinFreeDiskRatio),
+		condition.NewCRIDetector(criSvc, condition.DefaultCRITimeout),
+		condition.NewBPFMapDetector(bpf, condition.DefaultMaxUsageRatio),
+		condition.NewConntrackDetector(condition.ConntrackDir, condition.DefaultMaxUsageRatio),
+	)
+
+	go conditions.Run(ctx)
+
+	var shipper *logship.Shipper
+	if cfg.LogShippingInterval > 0 {
+		shipper = logship.NewShipper(
+			s.logger.With("component", "log-shipper"),
+			logship.Config{
+				NodeID:     cfg.NodeID,
+				LogDir:     cri.PodLogDir,
+				ArchiveDir: logArchiveDir,
+				Interval:   cfg.LogShippingInterval,
+			},
+			insClient,
+		)
+		go shipper.Run(ctx)
+	}
+
+	go jobs.Run(ctx)
+	go sysMonitor.Run(ctx)
+	go traffic.Run(ctx)
+	go redirects.Run(ctx)
 	go reconciler.Start(ctx)
 
 	<-s.stopCh
@@ -377,9 +594,28 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 	mgmtServer.Stop()
 	checkGRPCServer.Stop()
 
-	gc.Stop()
+	if pprofServer != nil {
+		if err := pprofServer.Shutdown(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "failed to stop pprof server", "err", err)
+		}
+	}
+
+	jobs.Stop()
+	sysMonitor.Stop()
+	traffic.Stop()
+	redirects.Stop()
 	reconciler.Stop()
 
+	conditions.Stop()
+
+	if shipper != nil {
+		shipper.Stop()
+	}
+
+	if updater != nil {
+		updater.Stop()
+	}
+
 	g.Go(func() error {
 		if err := criConn.Close(); err != nil {
 			return fmt.Errorf("cri conn close: %w", err)
@@ -393,3 +629,219 @@ func (s *Server) Run(ctx context.Context, cfg Config) error {
 func (s *Server) Stop() {
 	s.stopCh <- struct{}{}
 }
+
+// Handoff returns the path of the updated platformd binary that has to be
+// executed once Run returned. false is returned if platformd has not been
+// updated.
+func (s *Server) Handoff() (string, bool) {
+	if p := s.handoff.Load(); p != nil {
+		return *p, true
+	}
+	return "", false
+}
+
+// newJobScheduler returns the scheduler running the periodic background jobs of
+// platformd. the checkpoint garbage collection runs every second, so checkpoint
+// files are removed as soon as their status has been retrieved.
+func (s *Server) newJobScheduler(
+	cfg Config,
+	checkSvc *checkpoint.ServiceImpl,
+	bpf *datapath.Objects,
+	criConn *grpc.ClientConn,
+) (*periodic.Scheduler, error) {
+	maps := bpfmap.NewReconciler(s.logger.With("component", "bpf-map-reconciler"), bpf, bpfmap.InterfaceExists)
+
+	jobs := []periodic.Job{
+		{
+			Name:     "checkpoint-gc",
+			Interval: 1 * time.Second,
+			Run:      checkSvc.CollectGarbage,
+		},
+		{
+			Name:     "bpf-map-reconcile",
+			Interval: cfg.BPFMapReconcileInterval,
+			Jitter:   0.1,
+			Run:      maps.Reconcile,
+		},
+	}
+
+	if cfg.ImagePruneInterval > 0 {
+		pruner := cri.NewImagePruner(
+			s.logger.With("component", "image-pruner"),
+			runtimev1.NewRuntimeServiceClient(criConn),
+			runtimev1.NewImageServiceClient(criConn),
+			cfg.RegistryEndpoint,
+			cfg.ImagePruneUnusedFor,
+		)
+
+		jobs = append(jobs, periodic.Job{
+			Name:     "image-prune",
+			Interval: cfg.ImagePruneInterval,
+			Jitter:   0.1,
+			Run:      pruner.Prune,
+		})
+	}
+
+	return periodic.NewScheduler(s.logger.With("component", "job-scheduler"), jobs...)
+}
+
+// newUpdater returns nil if updates are disabled, because
+// no key to verify the signatures of binaries is configured.
+func (s *Server) newUpdater(
+	cfg Config,
+	key *ecdsa.PublicKey,
+	insClient instancev1alpha1.InstanceServiceClient,
+) (*update.Updater, error) {
+	if key == nil {
+		return nil, nil
+	}
+
+	if cfg.UpdateCheckInterval <= 0 {
+		return nil, errors.New("update check interval has to be positive")
+	}
+
+	// without a version, the node would keep installing
+	// the desired version over and over again.
+	version := update.Version()
+	if version == "" {
+		return nil, errors.New("platformd has been built without vcs information")
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("executable: %w", err)
+	}
+
+	return update.NewUpdater(
+		s.logger.With("component", "updater"),
+		update.Config{
+			NodeID:     cfg.NodeID,
+			Version:    version,
+			Interval:   cfg.UpdateCheckInterval,
+			Executable: executable,
+			Key:        key,
+		},
+		insClient,
+		func() {
+			s.handoff.Store(&executable)
+			s.Stop()
+		},
+	), nil
+}
+
+// cpuPeriodMicros is the cfs period workload cpu quotas are based on.
+const cpuPeriodMicros = 100_000
+
+// workloadCPUPeriod returns the cfs period for the given cpu limit.
+// zero disables the limit.
+func workloadCPUPeriod(millis uint64) uint64 {
+	if millis == 0 {
+		return 0
+	}
+	return cpuPeriodMicros
+}
+
+// workloadCPUQuota converts the cpu limit into a cfs quota.
+func workloadCPUQuota(millis uint64) uint64 {
+	return millis * cpuPeriodMicros / 1000
+}
+
+func envoyWorkload(cfg Config) system.Workload {
+	return system.Workload{
+		Name: "envoy",
+		Options: cri.RunOptions{
+			PodConfig: &runtimev1.PodSandboxConfig{
+				Metadata: &runtimev1.PodSandboxMetadata{
+					Uid:       "envoy",
+					Name:      "envoy",
+					Namespace: "explorer-system",
+				},
+				Hostname:     "envoy",
+				LogDirectory: cri.PodLogDir,
+				Linux: &runtimev1.LinuxPodSandboxConfig{
+					SecurityContext: &runtimev1.LinuxSandboxSecurityContext{
+						NamespaceOptions: &runtimev1.NamespaceOption{
+							Network: runtimev1.NamespaceMode_NODE,
+						},
+					},
+					// TODO: resources
+				},
+			},
+			ContainerConfig: &runtimev1.ContainerConfig{
+				Args: []string{"-c /etc/envoy/config.yaml", "-l debug"},
+				Image: &runtimev1.ImageSpec{
+					Image:              cfg.EnvoyImage,
+					UserSpecifiedImage: cfg.EnvoyImage,
+				},
+				Mounts: []*runtimev1.Mount{
+					{
+						HostPath:      "/etc/platformd/proxy.conf",
+						ContainerPath: "/etc/envoy/config.yaml",
+					},
+					{
+						// mount the parent dir of the socket instead of the socket
+						// path directly, because if platformd restarts the socket
+						// mount will go stale as the socket is closed. this causes
+						// all clients requests to fail until we restart the container.
+						// the parent dir will still be present, if platformd is stopped.
+						// once it's back up clients can access the socket again.
+						HostPath:      filepath.Dir(cfg.ManagementServerListenSock.Path),
+						ContainerPath: filepath.Dir(cfg.ManagementServerListenSock.Path),
+					},
+				},
+				Linux: &runtimev1.LinuxContainerConfig{
+					SecurityContext: &runtimev1.LinuxContainerSecurityContext{
+						RunAsUser: &runtimev1.Int64Value{
+							Value: int64(cfg.ManagementSocketUID),
+						},
+						RunAsGroup: &runtimev1.Int64Value{
+							Value: int64(cfg.ManagementSocketGID),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func corednsWorkload(cfg Config) system.Workload {
+	return system.Workload{
+		Name: "coredns",
+		Options: cri.RunOptions{
+			PodConfig: &runtimev1.PodSandboxConfig{
+				Metadata: &runtimev1.PodSandboxMetadata{
+					Uid:       "coredns",
+					Name:      "coredns",
+					Namespace: "explorer-system",
+				},
+				Labels:       workload.SystemWorkloadLabels("coredns"),
+				Hostname:     "coredns",
+				LogDirectory: cri.PodLogDir,
+				Linux: &runtimev1.LinuxPodSandboxConfig{
+					SecurityContext: &runtimev1.LinuxSandboxSecurityContext{
+						NamespaceOptions: &runtimev1.NamespaceOption{
+							Network: runtimev1.NamespaceMode_NODE,
+						},
+					},
+					// TODO: resources
+				},
+			},
+			ContainerConfig: &runtimev1.ContainerConfig{
+				Args: []string{"-conf", filepath.Join(corednsConfigDir, dns.CorefileName)},
+				Image: &runtimev1.ImageSpec{
+					Image:              cfg.CoreDNSImage,
+					UserSpecifiedImage: cfg.CoreDNSImage,
+				},
+				Mounts: []*runtimev1.Mount{
+					{
+						// mount the directory instead of the files, because the files
+						// are replaced when records change. a file mount would still
+						// point to the old file afterward.
+						HostPath:      cfg.DNSConfigDir,
+						ContainerPath: corednsConfigDir,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package settings
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"sync"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// Settings holds the runtime settings the control plane sends to nodes
+// during discovery. they allow admins to change log levels and toggle
+// features without restarting platformd.
+type Settings struct {
+	logger *slog.Logger
+	levels *logging.Override
+
+	mu    sync.RWMutex
+	flags map[string]bool
+}
+
+// New returns settings applying the log levels to levels, which may
+// be nil if the log levels should not be changed at runtime.
+func New(logger *slog.Logger, levels *logging.Override) *Settings {
+	return &Settings{
+		logger: logger,
+		levels: levels,
+		flags:  make(map[string]bool),
+	}
+}
+
+// Apply replaces the current settings with the ones sent by the control
+// plane. nil settings are ignored, because control planes that do not
+// support runtime settings yet do not send any.
+func (s *Settings) Apply(ctx context.Context, ns *instancev1alpha1.NodeSettings) {
+	if ns == nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.flags = maps.Clone(ns.GetFeatureFlags())
+	s.mu.Unlock()
+
+	if s.levels == nil {
+		return
+	}
+
+	changed, err := s.levels.Apply(ns.GetLogLevels())
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to apply log levels", "levels", ns.GetLogLevels(), "err", err)
+		return
+	}
+
+	if changed {
+		s.logger.InfoContext(ctx, "applied log levels", "levels", ns.GetLogLevels())
+	}
+}
+
+// Enabled reports whether the given feature is enabled. features are
+// enabled unless the control plane disabled them, which is also the
+// case for nil settings.
+func (s *Settings) Enabled(flag resource.FeatureFlag) bool {
+	if s == nil {
+		return true
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	enabled, ok := s.flags[string(flag)]
+	return !ok || enabled
+}
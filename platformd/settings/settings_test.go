@@ -0,0 +1,73 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package settings_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/logging"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/platformd/settings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApply(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		levels = logging.NewLevels(slog.LevelInfo)
+		s      = settings.New(slog.New(slog.DiscardHandler), logging.NewOverride(levels, "warn"))
+	)
+
+	require.NoError(t, levels.Set("warn"))
+
+	// features are enabled until the control plane disables them.
+	require.True(t, s.Enabled(resource.FeatureFlagDeltaDiscovery))
+
+	s.Apply(ctx, &instancev1alpha1.NodeSettings{
+		LogLevels: "info,reconciler=debug",
+		FeatureFlags: map[string]bool{
+			string(resource.FeatureFlagDeltaDiscovery): false,
+		},
+	})
+
+	require.False(t, s.Enabled(resource.FeatureFlagDeltaDiscovery))
+	require.True(t, s.Enabled(resource.FeatureFlagPlacementWebhook))
+	require.Equal(t, "info,reconciler=debug", levels.String())
+
+	// nil settings are sent by older control planes and keep the current ones.
+	s.Apply(ctx, nil)
+	require.False(t, s.Enabled(resource.FeatureFlagDeltaDiscovery))
+
+	// invalid levels keep the current levels.
+	s.Apply(ctx, &instancev1alpha1.NodeSettings{LogLevels: "invalid"})
+	require.Equal(t, "info,reconciler=debug", levels.String())
+	require.True(t, s.Enabled(resource.FeatureFlagDeltaDiscovery))
+
+	// empty levels restore the startup levels.
+	s.Apply(ctx, &instancev1alpha1.NodeSettings{})
+	require.Equal(t, "warn", levels.String())
+}
+
+func TestEnabledNil(t *testing.T) {
+	var s *settings.Settings
+	require.True(t, s.Enabled(resource.FeatureFlagDeltaDiscovery))
+}
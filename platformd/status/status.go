@@ -44,6 +44,47 @@ var (
 type WorkloadStatus struct {
 	State WorkloadState
 	Port  uint16
+
+	// CrossInstanceTraffic is whether the workload is allowed to connect
+	// to other workloads on the node. it is decided when the port is
+	// allocated, because netglue needs both when setting up the network.
+	CrossInstanceTraffic bool
+
+	// Events that have not been reported to the control plane yet.
+	Events []WorkloadEvent
+
+	// Traffic observed by envoy. nil if it has not been collected yet.
+	Traffic *TrafficStats
+}
+
+// TrafficStats contains the cumulative counters of all listeners
+// envoy has created for a workload. they are reset if envoy restarts.
+type TrafficStats struct {
+	Connections uint64
+	RxBytes     uint64
+	TxBytes     uint64
+}
+
+type WorkloadEventType string
+
+const (
+	WorkloadEventTypeOOMKilled WorkloadEventType = "OOM_KILLED"
+	WorkloadEventTypeCrashed   WorkloadEventType = "CRASHED"
+	WorkloadEventTypeExited    WorkloadEventType = "EXITED"
+
+	WorkloadEventTypeImagePulled      WorkloadEventType = "IMAGE_PULLED"
+	WorkloadEventTypeContainerStarted WorkloadEventType = "CONTAINER_STARTED"
+	WorkloadEventTypeReady            WorkloadEventType = "READY"
+)
+
+// WorkloadEvent describes why a workload stopped running
+// or a step it reached while being started.
+type WorkloadEvent struct {
+	ID        string
+	Type      WorkloadEventType
+	ExitCode  int32
+	Message   string
+	Timestamp time.Time
 }
 
 type CheckpointState string
@@ -55,6 +96,7 @@ const (
 	CheckpointStateContainerCheckpointFailed CheckpointState = "CONTAINER_CHECKPOINT_FAILED"
 	CheckpointStatePushCheckpointFailed      CheckpointState = "PUSH_CHECKPOINT_FAILED"
 	CheckpointStateCompleted                 CheckpointState = "COMPLETED"
+	CheckpointStateVerifyRestoreFailed       CheckpointState = "VERIFY_RESTORE_FAILED"
 )
 
 type CheckpointStatus struct {
@@ -62,4 +104,16 @@ type CheckpointStatus struct {
 	Message     string
 	CompletedAt *time.Time
 	Port        uint16
+
+	// RestoreLatency is the time it took until the restored checkpoint
+	// responded to a server list ping. zero if it has not been verified.
+	RestoreLatency time.Duration
+}
+
+// DiskUsage is the disk space used by checkpoint files.
+type DiskUsage struct {
+	UsedBytes uint64
+
+	// MaxBytes is zero if the disk usage is not limited.
+	MaxBytes uint64
 }
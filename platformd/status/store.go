@@ -19,7 +19,7 @@
 package status
 
 import (
-	"maps"
+	"slices"
 	"sync"
 )
 
@@ -28,65 +28,160 @@ type Store interface {
 	Get(id string) *Status
 	Del(id string)
 	View() map[string]Status
+	SetTraffic(id string, traffic TrafficStats)
+
+	// Lookup returns the status of the workload together with its version.
+	Lookup(id string) (Entry, bool)
+
+	// CompareAndSet replaces the status of the workload, if its version
+	// still matches. a version of zero only matches if there is no status
+	// for the workload yet. returns the new version on success.
+	CompareAndSet(id string, version uint64, status Status) (uint64, bool)
+
+	// CompareAndDelete removes the status of the workload, if its version
+	// still matches.
+	CompareAndDelete(id string, version uint64) bool
+
+	// Changes returns all statuses that changed after the given revision,
+	// as well as the current revision of the store. passing the returned
+	// revision to the next call only yields the statuses changed since.
+	Changes(since uint64) (map[string]Entry, uint64)
+
+	// Notify returns a channel that is closed once the store changes.
+	Notify() <-chan struct{}
+}
+
+// Entry is a status together with the revision of the
+// store at which it has been changed the last time.
+type Entry struct {
+	Status  Status
+	Version uint64
 }
 
 func NewMemStore() *MemStore {
 	return &MemStore{
-		data: make(map[string]Status),
+		data:   make(map[string]Entry),
+		notify: make(chan struct{}),
 	}
 }
 
+// MemStore never modifies statuses in place, instead they are copied
+// before being changed. this way statuses returned by the store can be
+// read without holding the lock, while others are updating them.
 type MemStore struct {
-	data map[string]Status
-	mu   sync.Mutex
+	data     map[string]Entry
+	revision uint64
+	notify   chan struct{}
+	mu       sync.RWMutex
 }
 
+// Update merges the non-zero fields of new into the current status of the
+// workload. the version is only changed if this results in a different status.
 func (s *MemStore) Update(id string, new Status) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	curr, ok := s.data[id]
 	if !ok {
-		s.data[id] = new
+		s.set(id, new)
 		return
 	}
 
-	if curr.WorkloadStatus != nil {
-		if new.WorkloadStatus.State != "" {
-			curr.WorkloadStatus.State = new.WorkloadStatus.State
+	var (
+		st       = curr.Status
+		modified bool
+	)
+
+	if st.WorkloadStatus != nil && new.WorkloadStatus != nil {
+		wst := *st.WorkloadStatus
+
+		if new.WorkloadStatus.State != "" && new.WorkloadStatus.State != wst.State {
+			wst.State = new.WorkloadStatus.State
+			modified = true
 		}
 
-		if new.WorkloadStatus.Port != 0 {
-			curr.WorkloadStatus.Port = new.WorkloadStatus.Port
+		if new.WorkloadStatus.Port != 0 && new.WorkloadStatus.Port != wst.Port {
+			wst.Port = new.WorkloadStatus.Port
+			modified = true
 		}
-		s.data[id] = curr
+
+		// only updated together with the port, otherwise every
+		// update that leaves it out would isolate the workload.
+		if new.WorkloadStatus.Port != 0 && new.WorkloadStatus.CrossInstanceTraffic != wst.CrossInstanceTraffic {
+			wst.CrossInstanceTraffic = new.WorkloadStatus.CrossInstanceTraffic
+			modified = true
+		}
+
+		if len(new.WorkloadStatus.Events) > 0 {
+			wst.Events = slices.Concat(wst.Events, new.WorkloadStatus.Events)
+			modified = true
+		}
+		st.WorkloadStatus = &wst
 	}
 
-	if curr.CheckpointStatus != nil {
-		if new.CheckpointStatus.State != "" {
-			curr.CheckpointStatus.State = new.CheckpointStatus.State
+	if st.CheckpointStatus != nil && new.CheckpointStatus != nil {
+		cst := *st.CheckpointStatus
+
+		if new.CheckpointStatus.State != "" && new.CheckpointStatus.State != cst.State {
+			cst.State = new.CheckpointStatus.State
+			modified = true
 		}
 
-		if new.CheckpointStatus.Port != 0 {
-			curr.CheckpointStatus.Port = new.CheckpointStatus.Port
+		if new.CheckpointStatus.Port != 0 && new.CheckpointStatus.Port != cst.Port {
+			cst.Port = new.CheckpointStatus.Port
+			modified = true
 		}
 
 		if new.CheckpointStatus.CompletedAt != nil {
-			curr.CheckpointStatus.CompletedAt = new.CheckpointStatus.CompletedAt
+			cst.CompletedAt = new.CheckpointStatus.CompletedAt
+			modified = true
+		}
+
+		if new.CheckpointStatus.Message != "" && new.CheckpointStatus.Message != cst.Message {
+			cst.Message = new.CheckpointStatus.Message
+			modified = true
 		}
 
-		if new.CheckpointStatus.Message != "" {
-			curr.CheckpointStatus.Message = new.CheckpointStatus.Message
+		if new.CheckpointStatus.RestoreLatency != 0 && new.CheckpointStatus.RestoreLatency != cst.RestoreLatency {
+			cst.RestoreLatency = new.CheckpointStatus.RestoreLatency
+			modified = true
 		}
-		s.data[id] = curr
+		st.CheckpointStatus = &cst
+	}
+
+	if modified {
+		s.set(id, st)
 	}
 }
 
-func (s *MemStore) Get(id string) *Status {
+// SetTraffic replaces the traffic stats of the workload. it does nothing
+// if there is no workload status for the given id, because the workload
+// might have been removed since the stats have been collected.
+func (s *MemStore) SetTraffic(id string, traffic TrafficStats) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if status, ok := s.data[id]; ok {
-		return &status
+
+	curr, ok := s.data[id]
+	if !ok || curr.Status.WorkloadStatus == nil {
+		return
+	}
+
+	if t := curr.Status.WorkloadStatus.Traffic; t != nil && *t == traffic {
+		return
+	}
+
+	st := curr.Status
+	wst := *st.WorkloadStatus
+	wst.Traffic = &traffic
+	st.WorkloadStatus = &wst
+	s.set(id, st)
+}
+
+func (s *MemStore) Get(id string) *Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if e, ok := s.data[id]; ok {
+		return &e.Status
 	}
 	return nil
 }
@@ -94,16 +189,93 @@ func (s *MemStore) Get(id string) *Status {
 func (s *MemStore) Del(id string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	if _, ok := s.data[id]; !ok {
+		return
+	}
 	delete(s.data, id)
+	s.changed()
 }
 
 // View returns a copy of the current state of the underlying map
 func (s *MemStore) View() map[string]Status {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
 	cpy := make(map[string]Status, len(s.data))
-	maps.Copy(cpy, s.data)
+	for id, e := range s.data {
+		cpy[id] = e.Status
+	}
 
 	return cpy
 }
+
+func (s *MemStore) Lookup(id string) (Entry, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	e, ok := s.data[id]
+	return e, ok
+}
+
+func (s *MemStore) CompareAndSet(id string, version uint64, status Status) (uint64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// missing entries have a version of zero
+	if s.data[id].Version != version {
+		return 0, false
+	}
+
+	s.set(id, status)
+	return s.revision, true
+}
+
+func (s *MemStore) CompareAndDelete(id string, version uint64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	curr, ok := s.data[id]
+	if !ok || curr.Version != version {
+		return false
+	}
+
+	delete(s.data, id)
+	s.changed()
+	return true
+}
+
+func (s *MemStore) Changes(since uint64) (map[string]Entry, uint64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := make(map[string]Entry)
+	for id, e := range s.data {
+		if e.Version > since {
+			ret[id] = e
+		}
+	}
+
+	return ret, s.revision
+}
+
+func (s *MemStore) Notify() <-chan struct{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.notify
+}
+
+// set stores the status with a new version. s.mu has to be held.
+func (s *MemStore) set(id string, st Status) {
+	s.changed()
+	s.data[id] = Entry{
+		Status:  st,
+		Version: s.revision,
+	}
+}
+
+// changed increments the revision and wakes up everyone waiting
+// for changes. s.mu has to be held.
+func (s *MemStore) changed() {
+	s.revision++
+	close(s.notify)
+	s.notify = make(chan struct{})
+}
@@ -20,6 +20,7 @@ package status_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/spacechunks/explorer/platformd/status"
 	"github.com/stretchr/testify/require"
@@ -84,6 +85,73 @@ func TestStatusStoreUpdate(t *testing.T) {
 	}
 }
 
+func TestStatusStoreUpdateCrossInstanceTraffic(t *testing.T) {
+	store := status.NewMemStore()
+
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateCreating,
+		},
+	})
+
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			Port:                 1337,
+			CrossInstanceTraffic: true,
+		},
+	})
+
+	// updates without a port must not touch it
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateRunning,
+		},
+	})
+
+	require.Equal(t, status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State:                status.WorkloadStateRunning,
+			Port:                 1337,
+			CrossInstanceTraffic: true,
+		},
+	}, *store.Get("abc"))
+}
+
+func TestStatusStoreUpdateAppendsEvents(t *testing.T) {
+	var (
+		store = status.NewMemStore()
+		ev1   = status.WorkloadEvent{
+			ID:   "ev1",
+			Type: status.WorkloadEventTypeCrashed,
+		}
+		ev2 = status.WorkloadEvent{
+			ID:   "ev2",
+			Type: status.WorkloadEventTypeOOMKilled,
+		}
+	)
+
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State:  status.WorkloadStateRunning,
+			Events: []status.WorkloadEvent{ev1},
+		},
+	})
+
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State:  status.WorkloadStateDeleted,
+			Events: []status.WorkloadEvent{ev2},
+		},
+	})
+
+	require.Equal(t, status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State:  status.WorkloadStateDeleted,
+			Events: []status.WorkloadEvent{ev1, ev2},
+		},
+	}, *store.Get("abc"))
+}
+
 func TestStatusStoreDelete(t *testing.T) {
 	store := status.NewMemStore()
 	store.Update("abc", status.Status{
@@ -121,3 +189,193 @@ func TestStatusStoreView(t *testing.T) {
 
 	require.Equal(t, expected, store.View())
 }
+
+func TestStatusStoreSetTraffic(t *testing.T) {
+	var (
+		store   = status.NewMemStore()
+		traffic = status.TrafficStats{
+			Connections: 3,
+			RxBytes:     1024,
+			TxBytes:     2048,
+		}
+	)
+
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateRunning,
+			Port:  1337,
+		},
+	})
+
+	store.SetTraffic("abc", traffic)
+
+	// unknown workloads must not be added to the store
+	store.SetTraffic("def", traffic)
+
+	require.Equal(t, map[string]status.Status{
+		"abc": {
+			WorkloadStatus: &status.WorkloadStatus{
+				State:   status.WorkloadStateRunning,
+				Port:    1337,
+				Traffic: &traffic,
+			},
+		},
+	}, store.View())
+}
+
+func TestStatusStoreCompareAndSet(t *testing.T) {
+	var (
+		store = status.NewMemStore()
+		st    = status.Status{
+			WorkloadStatus: &status.WorkloadStatus{
+				State: status.WorkloadStateCreating,
+			},
+		}
+		running = status.Status{
+			WorkloadStatus: &status.WorkloadStatus{
+				State: status.WorkloadStateRunning,
+			},
+		}
+	)
+
+	// version zero only matches if there is no status yet
+	v1, ok := store.CompareAndSet("abc", 0, st)
+	require.True(t, ok)
+
+	_, ok = store.CompareAndSet("abc", 0, running)
+	require.False(t, ok)
+
+	v2, ok := store.CompareAndSet("abc", v1, running)
+	require.True(t, ok)
+	require.Greater(t, v2, v1)
+
+	// outdated versions must not overwrite newer statuses
+	_, ok = store.CompareAndSet("abc", v1, st)
+	require.False(t, ok)
+
+	e, ok := store.Lookup("abc")
+	require.True(t, ok)
+	require.Equal(t, status.Entry{
+		Status:  running,
+		Version: v2,
+	}, e)
+}
+
+func TestStatusStoreCompareAndDelete(t *testing.T) {
+	store := status.NewMemStore()
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateDeleted,
+		},
+	})
+
+	e, ok := store.Lookup("abc")
+	require.True(t, ok)
+
+	require.False(t, store.CompareAndDelete("abc", e.Version+1))
+	require.NotNil(t, store.Get("abc"))
+
+	require.True(t, store.CompareAndDelete("abc", e.Version))
+	require.Nil(t, store.Get("abc"))
+}
+
+func TestStatusStoreChanges(t *testing.T) {
+	var (
+		store   = status.NewMemStore()
+		running = status.Status{
+			WorkloadStatus: &status.WorkloadStatus{
+				State: status.WorkloadStateRunning,
+				Port:  1337,
+			},
+		}
+	)
+
+	store.Update("abc", running)
+	store.Update("def", running)
+
+	changes, rev := store.Changes(0)
+	require.Len(t, changes, 2)
+
+	// updating a status without changing it must not
+	// result in the status being reported again.
+	store.Update("abc", running)
+
+	changes, rev = store.Changes(rev)
+	require.Empty(t, changes)
+
+	store.Update("def", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateDeleted,
+		},
+	})
+
+	changes, _ = store.Changes(rev)
+	require.Equal(t, map[string]status.Status{
+		"def": {
+			WorkloadStatus: &status.WorkloadStatus{
+				State: status.WorkloadStateDeleted,
+				Port:  1337,
+			},
+		},
+	}, statuses(changes))
+}
+
+func TestStatusStoreUpdateDoesNotModifyPreviousStatuses(t *testing.T) {
+	store := status.NewMemStore()
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateCreating,
+		},
+	})
+
+	prev := store.Get("abc")
+
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateRunning,
+		},
+	})
+
+	require.Equal(t, status.WorkloadStateCreating, prev.WorkloadStatus.State)
+	require.Equal(t, status.WorkloadStateRunning, store.Get("abc").WorkloadStatus.State)
+}
+
+func TestStatusStoreNotify(t *testing.T) {
+	var (
+		store  = status.NewMemStore()
+		notify = store.Notify()
+	)
+
+	select {
+	case <-notify:
+		t.Fatal("notified without changes")
+	default:
+	}
+
+	store.Update("abc", status.Status{
+		WorkloadStatus: &status.WorkloadStatus{
+			State: status.WorkloadStateRunning,
+		},
+	})
+
+	select {
+	case <-notify:
+	case <-time.After(time.Second):
+		t.Fatal("not notified about change")
+	}
+
+	// the channel returned after the change is only closed by the next one
+	select {
+	case <-store.Notify():
+		t.Fatal("notified without changes")
+	default:
+	}
+}
+
+func statuses(entries map[string]status.Entry) map[string]status.Status {
+	ret := make(map[string]status.Status, len(entries))
+	for id, e := range entries {
+		ret[id] = e.Status
+	}
+	return ret
+}
@@ -0,0 +1,149 @@
+package system
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// CapacityConfig limits how densely instances are packed onto the node.
+type CapacityConfig struct {
+	// MaxInstances is the hard upper bound of instances. zero means
+	// the bound is only derived from the resources of the node.
+	MaxInstances uint32
+
+	// CPUOvercommitRatio and MemoryOvercommitRatio allow scheduling more
+	// instances than the allocatable resources would fit, because servers
+	// rarely use their full limits. zero is treated like 1, so no overcommit.
+	CPUOvercommitRatio    float64
+	MemoryOvercommitRatio float64
+
+	// ReservedCPUMillis and ReservedMemoryBytes are kept free for the
+	// system workloads, like envoy, coredns and platformd itself.
+	ReservedCPUMillis   uint64
+	ReservedMemoryBytes uint64
+
+	// WorkloadCPUMillis and WorkloadMemoryBytes are the resources assigned
+	// to a single instance. if zero, the resource does not bound the number
+	// of instances.
+	WorkloadCPUMillis   uint64
+	WorkloadMemoryBytes uint64
+}
+
+// HostResources are the resources of the node.
+type HostResources struct {
+	CPUMillis   uint64
+	MemoryBytes uint64
+}
+
+// Capacity describes how many instances the node is able to run.
+type Capacity struct {
+	Config CapacityConfig
+	Host   HostResources
+
+	// AllocatableCPUMillis and AllocatableMemoryBytes are the resources
+	// available to instances after subtracting the reserved resources
+	// and applying the overcommit ratios.
+	AllocatableCPUMillis   uint64
+	AllocatableMemoryBytes uint64
+
+	// MaxInstances is nil if the number of instances is not bounded.
+	MaxInstances *uint32
+}
+
+// ComputeCapacity returns the number of instances that fit onto
+// a node with the given resources.
+func ComputeCapacity(host HostResources, cfg CapacityConfig) Capacity {
+	if cfg.CPUOvercommitRatio <= 0 {
+		cfg.CPUOvercommitRatio = 1
+	}
+
+	if cfg.MemoryOvercommitRatio <= 0 {
+		cfg.MemoryOvercommitRatio = 1
+	}
+
+	c := Capacity{
+		Config:                 cfg,
+		Host:                   host,
+		AllocatableCPUMillis:   allocatable(host.CPUMillis, cfg.ReservedCPUMillis, cfg.CPUOvercommitRatio),
+		AllocatableMemoryBytes: allocatable(host.MemoryBytes, cfg.ReservedMemoryBytes, cfg.MemoryOvercommitRatio),
+	}
+
+	bound := func(n uint64) {
+		n = min(n, uint64(^uint32(0)))
+		if c.MaxInstances == nil || uint32(n) < *c.MaxInstances {
+			v := uint32(n)
+			c.MaxInstances = &v
+		}
+	}
+
+	if cfg.MaxInstances > 0 {
+		bound(uint64(cfg.MaxInstances))
+	}
+
+	if cfg.WorkloadCPUMillis > 0 {
+		bound(c.AllocatableCPUMillis / cfg.WorkloadCPUMillis)
+	}
+
+	if cfg.WorkloadMemoryBytes > 0 {
+		bound(c.AllocatableMemoryBytes / cfg.WorkloadMemoryBytes)
+	}
+
+	return c
+}
+
+func allocatable(total uint64, reserved uint64, ratio float64) uint64 {
+	if reserved >= total {
+		return 0
+	}
+	return uint64(float64(total-reserved) * ratio)
+}
+
+// ReadHostResources returns the cpus and the total memory of the node.
+func ReadHostResources() (HostResources, error) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return HostResources{}, fmt.Errorf("open meminfo: %w", err)
+	}
+	defer f.Close()
+
+	mem, err := parseMemTotal(f)
+	if err != nil {
+		return HostResources{}, err
+	}
+
+	return HostResources{
+		CPUMillis:   uint64(runtime.NumCPU()) * 1000,
+		MemoryBytes: mem,
+	}, nil
+}
+
+// parseMemTotal returns the MemTotal entry of /proc/meminfo in bytes.
+func parseMemTotal(r io.Reader) (uint64, error) {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		// MemTotal:       16316412 kB
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 || fields[0] != "MemTotal:" {
+			continue
+		}
+
+		kb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse MemTotal: %w", err)
+		}
+
+		return kb * 1024, nil
+	}
+
+	if err := s.Err(); err != nil {
+		return 0, fmt.Errorf("read meminfo: %w", err)
+	}
+
+	return 0, errors.New("MemTotal not found")
+}
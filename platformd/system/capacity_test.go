@@ -0,0 +1,117 @@
+package system_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	nodev1alpha1 "github.com/spacechunks/explorer/api/platformd/node/v1alpha1"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/platformd/system"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeCapacity(t *testing.T) {
+	const gib = 1024 * 1024 * 1024
+
+	host := system.HostResources{
+		CPUMillis:   8000,
+		MemoryBytes: 16 * gib,
+	}
+
+	tests := []struct {
+		name         string
+		cfg          system.CapacityConfig
+		maxInstances *uint32
+	}{
+		{
+			name: "unbounded",
+			cfg:  system.CapacityConfig{},
+		},
+		{
+			name: "max instances",
+			cfg: system.CapacityConfig{
+				MaxInstances: 20,
+			},
+			maxInstances: new(uint32(20)),
+		},
+		{
+			name: "memory bound after reserving headroom",
+			cfg: system.CapacityConfig{
+				MaxInstances:        20,
+				ReservedMemoryBytes: 2 * gib,
+				WorkloadMemoryBytes: 1 * gib,
+			},
+			maxInstances: new(uint32(14)),
+		},
+		{
+			name: "overcommit",
+			cfg: system.CapacityConfig{
+				ReservedCPUMillis:     1000,
+				ReservedMemoryBytes:   2 * gib,
+				CPUOvercommitRatio:    2,
+				MemoryOvercommitRatio: 1.5,
+				WorkloadCPUMillis:     1000,
+				WorkloadMemoryBytes:   1 * gib,
+			},
+			maxInstances: new(uint32(14)),
+		},
+		{
+			name: "cpu bound",
+			cfg: system.CapacityConfig{
+				ReservedCPUMillis:   500,
+				WorkloadCPUMillis:   1000,
+				WorkloadMemoryBytes: 1 * gib,
+			},
+			maxInstances: new(uint32(7)),
+		},
+		{
+			name: "reserved exceeds host",
+			cfg: system.CapacityConfig{
+				ReservedMemoryBytes: 32 * gib,
+				WorkloadMemoryBytes: 1 * gib,
+			},
+			maxInstances: new(uint32(0)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := system.ComputeCapacity(host, tt.cfg)
+			require.Equal(t, tt.maxInstances, c.MaxInstances)
+		})
+	}
+}
+
+func TestNodeStatusReportsCapacity(t *testing.T) {
+	monitor, err := system.NewMonitor(slog.New(slog.DiscardHandler), mock.NewMockCriService(t), time.Second)
+	require.NoError(t, err)
+
+	capacity := system.ComputeCapacity(
+		system.HostResources{CPUMillis: 4000, MemoryBytes: 8000},
+		system.CapacityConfig{
+			ReservedMemoryBytes: 2000,
+			WorkloadMemoryBytes: 1000,
+		},
+	)
+
+	resp, err := system.NewServer(monitor, capacity, &diskUsageReader{}).NodeStatus(
+		context.Background(),
+		&nodev1alpha1.NodeStatusRequest{},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, &nodev1alpha1.NodeCapacity{
+		CpuMillis:              4000,
+		MemoryBytes:            8000,
+		ReservedMemoryBytes:    2000,
+		CpuOvercommitRatio:     1,
+		MemoryOvercommitRatio:  1,
+		AllocatableCpuMillis:   4000,
+		AllocatableMemoryBytes: 6000,
+		WorkloadMemoryBytes:    1000,
+		MaxInstances:           6,
+		Bounded:                true,
+	}, resp.GetCapacity())
+}
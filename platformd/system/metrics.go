@@ -0,0 +1,59 @@
+package system
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spacechunks/explorer/platformd/status"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type metrics struct {
+	restartCount       metric.Int64Counter
+	restartFailedCount metric.Int64Counter
+}
+
+func initMetrics(m *Monitor) (metrics, error) {
+	meter := otel.Meter("github.com/spacechunks/explorer/platformd/system")
+
+	_, err := meter.Int64ObservableGauge(
+		"explorer.platformd.system_workload.healthy",
+		metric.WithDescription("Whether the system workload is healthy (1) or not (0)"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			for _, h := range m.Health() {
+				var v int64
+				if h.Status == status.WorkloadHealthStatusHealthy {
+					v = 1
+				}
+				o.Observe(v, metric.WithAttributes(attribute.String("workload", h.Name)))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("healthy gauge: %w", err)
+	}
+
+	restartCount, err := meter.Int64Counter(
+		"explorer.platformd.system_workload.restart.count",
+		metric.WithDescription("Total number of times a system workload has been restarted"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("restart counter: %w", err)
+	}
+
+	restartFailedCount, err := meter.Int64Counter(
+		"explorer.platformd.system_workload.restart_failed.count",
+		metric.WithDescription("Total number of failed attempts to restart a system workload"),
+	)
+	if err != nil {
+		return metrics{}, fmt.Errorf("restart failed counter: %w", err)
+	}
+
+	return metrics{
+		restartCount:       restartCount,
+		restartFailedCount: restartFailedCount,
+	}, nil
+}
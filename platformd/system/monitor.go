@@ -0,0 +1,246 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/spacechunks/explorer/platformd/cri"
+	"github.com/spacechunks/explorer/platformd/status"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+const DefaultCheckInterval = 10 * time.Second
+
+// Workload is a pod required by platformd to function, like
+// envoy and coredns. if one of them is not running, instances
+// lose network connectivity.
+type Workload struct {
+	Name    string
+	Options cri.RunOptions
+}
+
+type Health struct {
+	Name   string
+	Status status.WorkloadHealthStatus
+
+	// Message describes why the workload is unhealthy.
+	Message string
+
+	// Restarts is the number of times the workload has
+	// been restarted since the monitor has been created.
+	Restarts        uint64
+	LastCheckedAt   time.Time
+	LastRestartedAt time.Time
+}
+
+// Monitor periodically checks the health of the system workloads
+// and restarts the ones that are not running anymore.
+type Monitor struct {
+	logger    *slog.Logger
+	criSvc    cri.Service
+	workloads []Workload
+	ticker    *time.Ticker
+	stop      chan bool
+	metrics   metrics
+
+	mu     sync.Mutex
+	health map[string]Health
+}
+
+func NewMonitor(
+	logger *slog.Logger,
+	criSvc cri.Service,
+	interval time.Duration,
+	workloads ...Workload,
+) (*Monitor, error) {
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+
+	m := &Monitor{
+		logger:    logger,
+		criSvc:    criSvc,
+		workloads: workloads,
+		ticker:    time.NewTicker(interval),
+		stop:      make(chan bool),
+		health:    make(map[string]Health, len(workloads)),
+	}
+
+	for _, w := range workloads {
+		m.health[w.Name] = Health{
+			Name: w.Name,
+		}
+	}
+
+	met, err := initMetrics(m)
+	if err != nil {
+		return nil, fmt.Errorf("init metrics: %w", err)
+	}
+
+	m.metrics = met
+	return m, nil
+}
+
+// Ensure creates all system workloads that are not present yet.
+func (m *Monitor) Ensure(ctx context.Context) error {
+	for _, w := range m.workloads {
+		if err := m.criSvc.EnsurePod(ctx, w.Options); err != nil {
+			return fmt.Errorf("ensure %s: %w", w.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *Monitor) Run(ctx context.Context) {
+	for {
+		select {
+		case <-m.ticker.C:
+			m.Check(ctx)
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+func (m *Monitor) Stop() {
+	m.ticker.Stop()
+	m.stop <- true
+}
+
+// Health returns the health of all system workloads as observed by the last check.
+func (m *Monitor) Health() []Health {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ret := make([]Health, 0, len(m.workloads))
+	for _, w := range m.workloads {
+		ret = append(ret, m.health[w.Name])
+	}
+	return ret
+}
+
+// Check inspects all system workloads once and restarts the unhealthy ones.
+func (m *Monitor) Check(ctx context.Context) {
+	for _, w := range m.workloads {
+		m.check(ctx, w)
+	}
+}
+
+func (m *Monitor) check(ctx context.Context, w Workload) {
+	var (
+		logger = m.logger.With("system_workload", w.Name)
+		attrs  = metric.WithAttributes(attribute.String("workload", w.Name))
+		now    = time.Now()
+	)
+
+	m.mu.Lock()
+	h := m.health[w.Name]
+	m.mu.Unlock()
+
+	h.LastCheckedAt = now
+
+	podID, reason, err := m.inspect(ctx, w)
+	switch {
+	case err != nil:
+		// we cannot tell whether the workload is running, so do not attempt
+		// to restart it. the cri is most likely unavailable anyway.
+		logger.ErrorContext(ctx, "failed to check system workload health", "err", err)
+		h.Status = status.WorkloadHealthStatusUnhealthy
+		h.Message = fmt.Sprintf("health check failed: %v", err)
+	case reason == "":
+		h.Status = status.WorkloadHealthStatusHealthy
+		h.Message = ""
+	default:
+		logger.WarnContext(ctx, "system workload unhealthy, restarting", "reason", reason)
+		h.Status = status.WorkloadHealthStatusUnhealthy
+		h.Message = reason
+
+		if err := m.restart(ctx, w, podID); err != nil {
+			logger.ErrorContext(ctx, "failed to restart system workload", "err", err)
+			m.metrics.restartFailedCount.Add(ctx, 1, attrs)
+			break
+		}
+
+		h.Restarts++
+		h.LastRestartedAt = now
+		m.metrics.restartCount.Add(ctx, 1, attrs)
+	}
+
+	m.mu.Lock()
+	m.health[w.Name] = h
+	m.mu.Unlock()
+}
+
+// inspect returns the id of the workloads pod, if it exists, and the
+// reason why the workload is unhealthy. an empty reason means healthy.
+func (m *Monitor) inspect(ctx context.Context, w Workload) (string, string, error) {
+	podResp, err := m.criSvc.ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
+		Filter: &runtimev1.PodSandboxFilter{
+			LabelSelector: map[string]string{
+				cri.LabelPodUID: w.Options.PodConfig.Metadata.Uid,
+			},
+		},
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("list pod sandbox: %w", err)
+	}
+
+	if len(podResp.Items) == 0 {
+		return "", "pod not found", nil
+	}
+
+	pod := podResp.Items[0]
+	if pod.State != runtimev1.PodSandboxState_SANDBOX_READY {
+		return pod.Id, "pod is not ready", nil
+	}
+
+	ctrResp, err := m.criSvc.ListContainers(ctx, &runtimev1.ListContainersRequest{
+		Filter: &runtimev1.ContainerFilter{
+			PodSandboxId: pod.Id,
+		},
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("list containers: %w", err)
+	}
+
+	if len(ctrResp.Containers) == 0 {
+		return pod.Id, "no containers found", nil
+	}
+
+	for _, c := range ctrResp.Containers {
+		if c.State != runtimev1.ContainerState_CONTAINER_RUNNING {
+			return pod.Id, fmt.Sprintf("container %s is in state %s", c.GetMetadata().GetName(), c.State), nil
+		}
+	}
+
+	return pod.Id, "", nil
+}
+
+// restart removes the pod if present, so it is recreated from scratch.
+// removing the pod sandbox removes all of its containers as well.
+func (m *Monitor) restart(ctx context.Context, w Workload, podID string) error {
+	if podID != "" {
+		if _, err := m.criSvc.StopPodSandbox(ctx, &runtimev1.StopPodSandboxRequest{
+			PodSandboxId: podID,
+		}); err != nil {
+			return fmt.Errorf("stop pod sandbox: %w", err)
+		}
+
+		if _, err := m.criSvc.RemovePodSandbox(ctx, &runtimev1.RemovePodSandboxRequest{
+			PodSandboxId: podID,
+		}); err != nil {
+			return fmt.Errorf("remove pod sandbox: %w", err)
+		}
+	}
+
+	if err := m.criSvc.EnsurePod(ctx, w.Options); err != nil {
+		return fmt.Errorf("ensure pod: %w", err)
+	}
+
+	return nil
+}
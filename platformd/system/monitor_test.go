@@ -0,0 +1,187 @@
+package system_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	nodev1alpha1 "github.com/spacechunks/explorer/api/platformd/node/v1alpha1"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/platformd/cri"
+	"github.com/spacechunks/explorer/platformd/system"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func TestMonitorCheck(t *testing.T) {
+	var (
+		podID = "pod-id"
+		wl    = system.Workload{
+			Name: "envoy",
+			Options: cri.RunOptions{
+				PodConfig: &runtimev1.PodSandboxConfig{
+					Metadata: &runtimev1.PodSandboxMetadata{
+						Uid:       "envoy",
+						Name:      "envoy",
+						Namespace: "explorer-system",
+					},
+				},
+			},
+		}
+		listPods = func(criService *mock.MockCriService, pods []*runtimev1.PodSandbox, err error) {
+			criService.EXPECT().
+				ListPodSandbox(mocky.Anything, &runtimev1.ListPodSandboxRequest{
+					Filter: &runtimev1.PodSandboxFilter{
+						LabelSelector: map[string]string{
+							cri.LabelPodUID: wl.Options.PodConfig.Metadata.Uid,
+						},
+					},
+				}).
+				Return(&runtimev1.ListPodSandboxResponse{Items: pods}, err)
+		}
+		listContainers = func(criService *mock.MockCriService, state runtimev1.ContainerState) {
+			criService.EXPECT().
+				ListContainers(mocky.Anything, &runtimev1.ListContainersRequest{
+					Filter: &runtimev1.ContainerFilter{
+						PodSandboxId: podID,
+					},
+				}).
+				Return(&runtimev1.ListContainersResponse{
+					Containers: []*runtimev1.Container{
+						{
+							Id:    "ctr-id",
+							State: state,
+							Metadata: &runtimev1.ContainerMetadata{
+								Name: wl.Name,
+							},
+						},
+					},
+				}, nil)
+		}
+		readyPod = []*runtimev1.PodSandbox{
+			{
+				Id:    podID,
+				State: runtimev1.PodSandboxState_SANDBOX_READY,
+			},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		expected *nodev1alpha1.SystemWorkloadStatus
+		prep     func(*mock.MockCriService)
+	}{
+		{
+			name: "healthy workload is not restarted",
+			expected: &nodev1alpha1.SystemWorkloadStatus{
+				Name:   wl.Name,
+				Health: nodev1alpha1.HealthStatus_HEALTHY,
+			},
+			prep: func(criService *mock.MockCriService) {
+				listPods(criService, readyPod, nil)
+				listContainers(criService, runtimev1.ContainerState_CONTAINER_RUNNING)
+			},
+		},
+		{
+			name: "exited container causes pod to be recreated",
+			expected: &nodev1alpha1.SystemWorkloadStatus{
+				Name:     wl.Name,
+				Health:   nodev1alpha1.HealthStatus_UNHEALTHY,
+				Message:  "container envoy is in state CONTAINER_EXITED",
+				Restarts: 1,
+			},
+			prep: func(criService *mock.MockCriService) {
+				listPods(criService, readyPod, nil)
+				listContainers(criService, runtimev1.ContainerState_CONTAINER_EXITED)
+
+				criService.EXPECT().
+					StopPodSandbox(mocky.Anything, &runtimev1.StopPodSandboxRequest{PodSandboxId: podID}).
+					Return(&runtimev1.StopPodSandboxResponse{}, nil)
+
+				criService.EXPECT().
+					RemovePodSandbox(mocky.Anything, &runtimev1.RemovePodSandboxRequest{PodSandboxId: podID}).
+					Return(&runtimev1.RemovePodSandboxResponse{}, nil)
+
+				criService.EXPECT().EnsurePod(mocky.Anything, wl.Options).Return(nil)
+			},
+		},
+		{
+			name: "missing pod is created",
+			expected: &nodev1alpha1.SystemWorkloadStatus{
+				Name:     wl.Name,
+				Health:   nodev1alpha1.HealthStatus_UNHEALTHY,
+				Message:  "pod not found",
+				Restarts: 1,
+			},
+			prep: func(criService *mock.MockCriService) {
+				listPods(criService, nil, nil)
+				criService.EXPECT().EnsurePod(mocky.Anything, wl.Options).Return(nil)
+			},
+		},
+		{
+			name: "failed restart is not counted",
+			expected: &nodev1alpha1.SystemWorkloadStatus{
+				Name:    wl.Name,
+				Health:  nodev1alpha1.HealthStatus_UNHEALTHY,
+				Message: "pod not found",
+			},
+			prep: func(criService *mock.MockCriService) {
+				listPods(criService, nil, nil)
+				criService.EXPECT().EnsurePod(mocky.Anything, wl.Options).Return(errors.New("some error"))
+			},
+		},
+		{
+			name: "workload is not restarted if cri is unavailable",
+			expected: &nodev1alpha1.SystemWorkloadStatus{
+				Name:    wl.Name,
+				Health:  nodev1alpha1.HealthStatus_UNHEALTHY,
+				Message: "health check failed: list pod sandbox: some error",
+			},
+			prep: func(criService *mock.MockCriService) {
+				listPods(criService, nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx          = context.Background()
+				logger       = slog.New(slog.NewTextHandler(os.Stdout, nil))
+				mockCRISvc   = mock.NewMockCriService(t)
+				before       = time.Now()
+				monitor, err = system.NewMonitor(logger, mockCRISvc, time.Second, wl)
+			)
+			require.NoError(t, err)
+
+			tt.prep(mockCRISvc)
+
+			monitor.Check(ctx)
+
+			resp, err := system.NewServer(monitor, system.Capacity{}, &diskUsageReader{}).NodeStatus(
+				ctx,
+				&nodev1alpha1.NodeStatusRequest{},
+			)
+			require.NoError(t, err)
+			require.Len(t, resp.SystemWorkloads, 1)
+
+			actual := resp.SystemWorkloads[0]
+			require.False(t, actual.GetLastCheckedAt().AsTime().Before(before))
+
+			if tt.expected.Restarts > 0 {
+				require.NotNil(t, actual.GetLastRestartedAt())
+			} else {
+				require.Nil(t, actual.GetLastRestartedAt())
+			}
+
+			require.Equal(t, tt.expected.GetName(), actual.GetName())
+			require.Equal(t, tt.expected.GetHealth(), actual.GetHealth())
+			require.Equal(t, tt.expected.GetMessage(), actual.GetMessage())
+			require.Equal(t, tt.expected.GetRestarts(), actual.GetRestarts())
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package system
+
+import (
+	"context"
+	"fmt"
+
+	nodev1alpha1 "github.com/spacechunks/explorer/api/platformd/node/v1alpha1"
+	"github.com/spacechunks/explorer/platformd/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DiskUsageReader reports the disk space used by checkpoint files.
+type DiskUsageReader interface {
+	DiskUsage() (status.DiskUsage, error)
+}
+
+type Server struct {
+	nodev1alpha1.UnimplementedNodeServiceServer
+	monitor   *Monitor
+	capacity  Capacity
+	diskUsage DiskUsageReader
+}
+
+func NewServer(monitor *Monitor, capacity Capacity, diskUsage DiskUsageReader) *Server {
+	return &Server{
+		monitor:   monitor,
+		capacity:  capacity,
+		diskUsage: diskUsage,
+	}
+}
+
+func (s *Server) NodeStatus(
+	_ context.Context,
+	_ *nodev1alpha1.NodeStatusRequest,
+) (*nodev1alpha1.NodeStatusResponse, error) {
+	health := s.monitor.Health()
+
+	ret := make([]*nodev1alpha1.SystemWorkloadStatus, 0, len(health))
+	for _, h := range health {
+		st := &nodev1alpha1.SystemWorkloadStatus{
+			Name:     h.Name,
+			Health:   healthToTransport(h.Status),
+			Message:  h.Message,
+			Restarts: h.Restarts,
+		}
+
+		if !h.LastCheckedAt.IsZero() {
+			st.LastCheckedAt = timestamppb.New(h.LastCheckedAt)
+		}
+
+		if !h.LastRestartedAt.IsZero() {
+			st.LastRestartedAt = timestamppb.New(h.LastRestartedAt)
+		}
+
+		ret = append(ret, st)
+	}
+
+	usage, err := s.diskUsage.DiskUsage()
+	if err != nil {
+		return nil, fmt.Errorf("checkpoint disk usage: %w", err)
+	}
+
+	return &nodev1alpha1.NodeStatusResponse{
+		SystemWorkloads: ret,
+		Capacity:        capacityToTransport(s.capacity),
+		CheckpointDiskUsage: &nodev1alpha1.CheckpointDiskUsage{
+			UsedBytes: usage.UsedBytes,
+			MaxBytes:  usage.MaxBytes,
+		},
+	}, nil
+}
+
+func capacityToTransport(c Capacity) *nodev1alpha1.NodeCapacity {
+	ret := &nodev1alpha1.NodeCapacity{
+		CpuMillis:              c.Host.CPUMillis,
+		MemoryBytes:            c.Host.MemoryBytes,
+		ReservedCpuMillis:      c.Config.ReservedCPUMillis,
+		ReservedMemoryBytes:    c.Config.ReservedMemoryBytes,
+		CpuOvercommitRatio:     c.Config.CPUOvercommitRatio,
+		MemoryOvercommitRatio:  c.Config.MemoryOvercommitRatio,
+		AllocatableCpuMillis:   c.AllocatableCPUMillis,
+		AllocatableMemoryBytes: c.AllocatableMemoryBytes,
+		WorkloadCpuMillis:      c.Config.WorkloadCPUMillis,
+		WorkloadMemoryBytes:    c.Config.WorkloadMemoryBytes,
+	}
+
+	if c.MaxInstances != nil {
+		ret.MaxInstances = *c.MaxInstances
+		ret.Bounded = true
+	}
+
+	return ret
+}
+
+func healthToTransport(s status.WorkloadHealthStatus) nodev1alpha1.HealthStatus {
+	switch s {
+	case status.WorkloadHealthStatusHealthy:
+		return nodev1alpha1.HealthStatus_HEALTHY
+	case status.WorkloadHealthStatusUnhealthy:
+		return nodev1alpha1.HealthStatus_UNHEALTHY
+	default:
+		return nodev1alpha1.HealthStatus_UNKNOWN
+	}
+}
@@ -0,0 +1,55 @@
+package system_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	nodev1alpha1 "github.com/spacechunks/explorer/api/platformd/node/v1alpha1"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/platformd/status"
+	"github.com/spacechunks/explorer/platformd/system"
+	"github.com/stretchr/testify/require"
+)
+
+type diskUsageReader struct {
+	usage status.DiskUsage
+	err   error
+}
+
+func (r *diskUsageReader) DiskUsage() (status.DiskUsage, error) {
+	return r.usage, r.err
+}
+
+func TestNodeStatusReportsCheckpointDiskUsage(t *testing.T) {
+	monitor, err := system.NewMonitor(slog.New(slog.DiscardHandler), mock.NewMockCriService(t), time.Second)
+	require.NoError(t, err)
+
+	reader := &diskUsageReader{
+		usage: status.DiskUsage{
+			UsedBytes: 100,
+			MaxBytes:  1000,
+		},
+	}
+
+	resp, err := system.NewServer(monitor, system.Capacity{}, reader).NodeStatus(
+		context.Background(),
+		&nodev1alpha1.NodeStatusRequest{},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, &nodev1alpha1.CheckpointDiskUsage{
+		UsedBytes: 100,
+		MaxBytes:  1000,
+	}, resp.GetCheckpointDiskUsage())
+
+	reader.err = errors.New("read dir")
+
+	_, err = system.NewServer(monitor, system.Capacity{}, reader).NodeStatus(
+		context.Background(),
+		&nodev1alpha1.NodeStatusRequest{},
+	)
+	require.ErrorIs(t, err, reader.err)
+}
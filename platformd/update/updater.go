@@ -0,0 +1,315 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package update
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/buildinfo"
+)
+
+const (
+	// maxBinarySize limits the size of downloaded platformd binaries.
+	maxBinarySize = 512 << 20
+
+	// maxSignatureSize limits the size of downloaded signatures.
+	maxSignatureSize = 4 << 10
+
+	// maxMessageLen is the maximum length of messages accepted by the control plane.
+	maxMessageLen = 512
+)
+
+var ErrSignatureInvalid = errors.New("binary signature is invalid")
+
+type Config struct {
+	NodeID string
+
+	// Version of the running platformd binary, see Version.
+	Version string
+
+	// Interval in which the control plane is asked for updates.
+	Interval time.Duration
+
+	// Executable is the path of the platformd binary that is replaced.
+	Executable string
+
+	// Key is used to verify the signatures of downloaded binaries.
+	Key *ecdsa.PublicKey
+}
+
+// Updater installs the platformd versions rolled out to this node. once an
+// update has been installed, handoff is called, which is expected to stop
+// platformd and execute the new binary. workloads are run by the cri, so
+// they keep running while platformd is replaced.
+type Updater struct {
+	logger     *slog.Logger
+	cfg        Config
+	insClient  instancev1alpha1.InstanceServiceClient
+	httpClient *http.Client
+	handoff    func()
+	ticker     *time.Ticker
+	stop       chan struct{}
+}
+
+func NewUpdater(
+	logger *slog.Logger,
+	cfg Config,
+	insClient instancev1alpha1.InstanceServiceClient,
+	handoff func(),
+) *Updater {
+	return &Updater{
+		logger:     logger,
+		cfg:        cfg,
+		insClient:  insClient,
+		httpClient: &http.Client{Timeout: 5 * time.Minute},
+		handoff:    handoff,
+		ticker:     time.NewTicker(cfg.Interval),
+		stop:       make(chan struct{}),
+	}
+}
+
+func (u *Updater) Run(ctx context.Context) {
+	// check right away, so the rollout to this node
+	// completes as soon as the new version is running.
+	if u.tick(ctx) {
+		return
+	}
+
+	for {
+		select {
+		case <-u.ticker.C:
+			if u.tick(ctx) {
+				return
+			}
+		case <-u.stop:
+			return
+		}
+	}
+}
+
+// tick returns true if an update has been installed
+// and platformd is handing over to it.
+func (u *Updater) tick(ctx context.Context) bool {
+	installed, err := u.Check(ctx)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "checking for platformd update failed", "err", err)
+		return false
+	}
+
+	if installed {
+		u.handoff()
+	}
+
+	return installed
+}
+
+func (u *Updater) Stop() {
+	u.ticker.Stop()
+	close(u.stop)
+}
+
+// Check reports the running version to the control plane and installs the
+// update rolled out to this node, if there is one. returns true if an update
+// has been installed and platformd has to hand over to the new binary.
+func (u *Updater) Check(ctx context.Context) (bool, error) {
+	resp, err := u.insClient.GetPlatformdUpdate(ctx, &instancev1alpha1.GetPlatformdUpdateRequest{
+		NodeKey:        u.cfg.NodeID,
+		CurrentVersion: u.cfg.Version,
+	})
+	if err != nil {
+		return false, fmt.Errorf("get platformd update: %w", err)
+	}
+
+	upd := resp.GetUpdate()
+	if upd == nil {
+		return false, nil
+	}
+
+	u.logger.InfoContext(ctx, "installing platformd update", "current", u.cfg.Version, "version", upd.GetVersion())
+
+	if err := u.install(ctx, upd); err != nil {
+		msg := err.Error()
+		if len(msg) > maxMessageLen {
+			msg = msg[:maxMessageLen]
+		}
+
+		u.report(ctx, upd.GetVersion(), instancev1alpha1.PlatformdUpdateStatus_UPDATE_FAILED, msg)
+		return false, fmt.Errorf("install %s: %w", upd.GetVersion(), err)
+	}
+
+	return true, nil
+}
+
+func (u *Updater) install(ctx context.Context, upd *instancev1alpha1.PlatformdUpdate) error {
+	u.report(ctx, upd.GetVersion(), instancev1alpha1.PlatformdUpdateStatus_UPDATE_DOWNLOADING, "")
+
+	bin, err := u.download(ctx, upd.GetBinaryUrl(), maxBinarySize)
+	if err != nil {
+		return fmt.Errorf("download binary: %w", err)
+	}
+
+	sig, err := u.download(ctx, upd.GetSignatureUrl(), maxSignatureSize)
+	if err != nil {
+		return fmt.Errorf("download signature: %w", err)
+	}
+
+	if err := VerifySignature(bin, sig, u.cfg.Key); err != nil {
+		return err
+	}
+
+	u.report(ctx, upd.GetVersion(), instancev1alpha1.PlatformdUpdateStatus_UPDATE_INSTALLING, "")
+
+	if err := Replace(u.cfg.Executable, bin); err != nil {
+		return fmt.Errorf("replace binary: %w", err)
+	}
+
+	return nil
+}
+
+// report sends the status of the update to the control plane. failing to do
+// so is not critical, the update itself completes once the new version
+// reports to be running.
+func (u *Updater) report(
+	ctx context.Context,
+	version string,
+	status instancev1alpha1.PlatformdUpdateStatus,
+	message string,
+) {
+	if _, err := u.insClient.ReportPlatformdUpdateStatus(ctx, &instancev1alpha1.ReportPlatformdUpdateStatusRequest{
+		NodeKey: u.cfg.NodeID,
+		Version: version,
+		Status:  status,
+		Message: message,
+	}); err != nil {
+		u.logger.WarnContext(ctx, "failed to report platformd update status", "status", status.String(), "err", err)
+	}
+}
+
+func (u *Updater) download(ctx context.Context, url string, maxSize int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("exceeds maximum size of %d bytes", maxSize)
+	}
+
+	return data, nil
+}
+
+// VerifySignature returns nil if sig is a base64 encoded ecdsa signature of
+// the sha256 digest of bin, that has been created with the private key of
+// key. signatures created using cosign sign-blob have this format.
+func VerifySignature(bin []byte, sig []byte, key *ecdsa.PublicKey) error {
+	rawSig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig)))
+	if err != nil {
+		return fmt.Errorf("decode signature: %w", err)
+	}
+
+	sum := sha256.Sum256(bin)
+	if !ecdsa.VerifyASN1(key, sum[:], rawSig) {
+		return ErrSignatureInvalid
+	}
+
+	return nil
+}
+
+// Replace atomically replaces the executable with bin. the previous binary
+// is kept next to it with a .prev suffix, so it can be restored manually if
+// the new version does not work.
+func Replace(executable string, bin []byte) error {
+	// the temporary file has to be on the same filesystem
+	// as the executable, otherwise it cannot be renamed.
+	f, err := os.CreateTemp(filepath.Dir(executable), ".platformd-update-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(bin); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write binary: %w", err)
+	}
+
+	if err := f.Chmod(0o755); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("chmod binary: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close binary: %w", err)
+	}
+
+	prev := executable + ".prev"
+	if err := os.Remove(prev); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove previous binary: %w", err)
+	}
+
+	if err := os.Link(executable, prev); err != nil {
+		return fmt.Errorf("keep previous binary: %w", err)
+	}
+
+	if err := os.Rename(f.Name(), executable); err != nil {
+		return fmt.Errorf("rename binary: %w", err)
+	}
+
+	return nil
+}
+
+// Version returns the vcs revision platformd has been built from.
+// empty if it has been built without vcs information.
+func Version() string {
+	return buildinfo.Revision()
+}
+
+// Exec replaces the running process with the binary at executable,
+// passing the same arguments and environment.
+func Exec(executable string) error {
+	return syscall.Exec(executable, os.Args, os.Environ())
+}
@@ -0,0 +1,189 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package update_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/platformd/update"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const nodeID = "node"
+
+func TestCheck(t *testing.T) {
+	var (
+		key     = newKey(t)
+		bin     = []byte("new platformd")
+		goodSig = sign(t, key, bin)
+	)
+
+	tests := []struct {
+		name      string
+		sig       string
+		installed bool
+		prep      func(*mock.MockV1alpha1InstanceServiceClient)
+	}{
+		{
+			name:      "installs verified update",
+			sig:       goodSig,
+			installed: true,
+			prep: func(client *mock.MockV1alpha1InstanceServiceClient) {
+				expectReport(client, instancev1alpha1.PlatformdUpdateStatus_UPDATE_DOWNLOADING)
+				expectReport(client, instancev1alpha1.PlatformdUpdateStatus_UPDATE_INSTALLING)
+			},
+		},
+		{
+			name: "rejects invalid signature",
+			sig:  sign(t, newKey(t), bin),
+			prep: func(client *mock.MockV1alpha1InstanceServiceClient) {
+				expectReport(client, instancev1alpha1.PlatformdUpdateStatus_UPDATE_DOWNLOADING)
+				expectReport(client, instancev1alpha1.PlatformdUpdateStatus_UPDATE_FAILED)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx        = context.Background()
+				client     = mock.NewMockV1alpha1InstanceServiceClient(t)
+				executable = filepath.Join(t.TempDir(), "platformd")
+				mux        = http.NewServeMux()
+			)
+
+			mux.HandleFunc("/v2/platformd", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write(bin)
+			})
+			mux.HandleFunc("/v2/platformd.sig", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(tt.sig))
+			})
+
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			require.NoError(t, os.WriteFile(executable, []byte("old platformd"), 0o755))
+
+			client.EXPECT().
+				GetPlatformdUpdate(mocky.Anything, &instancev1alpha1.GetPlatformdUpdateRequest{
+					NodeKey:        nodeID,
+					CurrentVersion: "v1",
+				}).
+				Return(&instancev1alpha1.GetPlatformdUpdateResponse{
+					Update: &instancev1alpha1.PlatformdUpdate{
+						Version:      "v2",
+						BinaryUrl:    srv.URL + "/v2/platformd",
+						SignatureUrl: srv.URL + "/v2/platformd.sig",
+					},
+				}, nil)
+
+			tt.prep(client)
+
+			u := update.NewUpdater(slog.New(slog.DiscardHandler), update.Config{
+				NodeID:     nodeID,
+				Version:    "v1",
+				Interval:   time.Hour,
+				Executable: executable,
+				Key:        &key.PublicKey,
+			}, client, func() {})
+
+			installed, err := u.Check(ctx)
+			require.Equal(t, tt.installed, installed)
+
+			data, readErr := os.ReadFile(executable)
+			require.NoError(t, readErr)
+
+			if !tt.installed {
+				require.ErrorIs(t, err, update.ErrSignatureInvalid)
+				require.Equal(t, "old platformd", string(data))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, bin, data)
+
+			prev, err := os.ReadFile(executable + ".prev")
+			require.NoError(t, err)
+			require.Equal(t, "old platformd", string(prev))
+		})
+	}
+}
+
+func TestCheckWithoutUpdate(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		client = mock.NewMockV1alpha1InstanceServiceClient(t)
+	)
+
+	client.EXPECT().
+		GetPlatformdUpdate(mocky.Anything, &instancev1alpha1.GetPlatformdUpdateRequest{
+			NodeKey:        nodeID,
+			CurrentVersion: "v1",
+		}).
+		Return(&instancev1alpha1.GetPlatformdUpdateResponse{}, nil)
+
+	u := update.NewUpdater(slog.New(slog.DiscardHandler), update.Config{
+		NodeID:   nodeID,
+		Version:  "v1",
+		Interval: time.Hour,
+	}, client, func() {})
+
+	installed, err := u.Check(ctx)
+	require.NoError(t, err)
+	require.False(t, installed)
+}
+
+func expectReport(client *mock.MockV1alpha1InstanceServiceClient, status instancev1alpha1.PlatformdUpdateStatus) {
+	client.EXPECT().
+		ReportPlatformdUpdateStatus(mocky.Anything, mocky.MatchedBy(
+			func(req *instancev1alpha1.ReportPlatformdUpdateStatusRequest) bool {
+				return req.GetNodeKey() == nodeID && req.GetVersion() == "v2" && req.GetStatus() == status
+			},
+		)).
+		Return(&instancev1alpha1.ReportPlatformdUpdateStatusResponse{}, nil).
+		Once()
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, data []byte) string {
+	sum := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(sig)
+}
@@ -29,8 +29,9 @@ func StatusToTransport(st status.Status) *workloadv1alpha2.WorkloadStatus {
 
 	if st.WorkloadStatus != nil {
 		return &workloadv1alpha2.WorkloadStatus{
-			State: StateToTransport(st.WorkloadStatus.State),
-			Port:  uint32(st.WorkloadStatus.Port),
+			State:                StateToTransport(st.WorkloadStatus.State),
+			Port:                 uint32(st.WorkloadStatus.Port),
+			CrossInstanceTraffic: st.WorkloadStatus.CrossInstanceTraffic,
 		}
 	}
 
@@ -8,4 +8,22 @@ type Config struct {
 	PlatformdListenSockURL *url.URL
 	PlatformdSocketUID     uint64
 	PlatformdSocketGID     uint64
+
+	// DNSZone is the zone node-local records are served in. if set,
+	// <namespace>.<zone> is added to the search domains of workloads,
+	// so they can resolve records of their namespace by name only.
+	DNSZone string
+
+	// Probe is executed in addition to checking the container states
+	// when determining the health of a workload.
+	Probe ProbeConfig
+
+	// ParamsDir is the directory the runtime parameters of instances
+	// are written to before being mounted into the workload.
+	ParamsDir string
+
+	// LogArchiveDir is the directory the log directories of removed workloads
+	// are moved to, so lines not yet shipped to the control plane are not lost.
+	// if empty, log directories are deleted right away.
+	LogArchiveDir string
 }
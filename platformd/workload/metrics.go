@@ -0,0 +1,72 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package workload
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/spacechunks/explorer/internal/workloadenv"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type countKey struct {
+	typ   string
+	ready bool
+}
+
+// RegisterMetrics registers the metrics derived from the workloads present
+// on the node. the workloads are listed every time the metrics are collected.
+func RegisterMetrics(logger *slog.Logger, svc Service) error {
+	meter := otel.Meter("github.com/spacechunks/explorer/platformd/workload")
+
+	_, err := meter.Int64ObservableGauge(
+		"explorer.platformd.workload.count",
+		metric.WithDescription("Number of workloads present on the node by type and readiness"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			workloads, err := svc.ListWorkloads(ctx, workloadenv.Selector{})
+			if err != nil {
+				// failing the callback would drop the other metrics as well
+				logger.WarnContext(ctx, "failed to list workloads", "err", err)
+				return nil
+			}
+
+			counts := make(map[countKey]int64)
+			for _, w := range workloads {
+				counts[countKey{typ: w.Labels.WorkloadType, ready: w.Ready}]++
+			}
+
+			for k, n := range counts {
+				o.Observe(n, metric.WithAttributes(
+					attribute.String("type", k.typ),
+					attribute.Bool("ready", k.ready),
+				))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("workload count gauge: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package workload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spacechunks/explorer/internal/workloadenv"
+	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// writeParams writes the runtime parameters of the instance to the params dir
+// of the workload and returns the mount and env vars that expose them to the
+// minecraft server container. nothing is returned if the instance has no params.
+//
+// the server process is restored from a checkpoint, so it still sees the env
+// vars that were set when the checkpoint was created. plugins therefore have
+// to read the params file, the env vars are only visible to processes that
+// are started inside the container afterward.
+func (s *svc) writeParams(w Workload) (*runtimev1.Mount, []*runtimev1.KeyValue, error) {
+	p, ok := workloadenv.NewParams(w.Instance.GetParams())
+	if !ok {
+		return nil, nil, nil
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal params: %w", err)
+	}
+
+	dir := filepath.Join(s.cfg.ParamsDir, w.ID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, nil, fmt.Errorf("create params dir: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, workloadenv.ParamsFileName), data, 0o644); err != nil {
+		return nil, nil, fmt.Errorf("write params file: %w", err)
+	}
+
+	mount := &runtimev1.Mount{
+		HostPath:      dir,
+		ContainerPath: workloadenv.ParamsDir,
+		Readonly:      true,
+	}
+
+	envs := make([]*runtimev1.KeyValue, 0, 4)
+	for _, env := range p.Env() {
+		envs = append(envs, &runtimev1.KeyValue{
+			Key:   env.Key,
+			Value: env.Value,
+		})
+	}
+
+	return mount, envs, nil
+}
+
+func (s *svc) removeParamsDir(ctx context.Context, id string) error {
+	if s.cfg.ParamsDir == "" {
+		return nil
+	}
+
+	path := filepath.Join(s.cfg.ParamsDir, id)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+
+	s.logger.InfoContext(ctx, "remove params dir", "workload_id", id, "path", path)
+
+	if err := os.RemoveAll(path); err != nil {
+		return fmt.Errorf("remove: %w", err)
+	}
+
+	return nil
+}
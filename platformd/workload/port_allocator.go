@@ -19,18 +19,43 @@
 package workload
 
 import (
+	"cmp"
 	"math/rand/v2"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
 var ErrMaxPortTriesReached = errors.New("maximum number of retries reached")
 
+// reservationTTL is the time after which a reserved port is freed,
+// if it has not been claimed by the workload it was reserved for.
+const reservationTTL = 5 * time.Minute
+
+type reservation struct {
+	port      uint16
+	expiresAt time.Time
+}
+
+// PortAllocation is a port currently allocated by the PortAllocator.
+type PortAllocation struct {
+	Port uint16
+
+	// ReservedFor is the id of the workload the port has been reserved
+	// for using Reserve. empty if the port is not reserved.
+	ReservedFor string
+
+	// ExpiresAt is the time the reservation expires. zero if not reserved.
+	ExpiresAt time.Time
+}
+
 type PortAllocator struct {
 	portMin   int
 	portMax   int
 	allocated map[int]bool
+	reserved  map[string]reservation
 
 	mu sync.Mutex
 }
@@ -38,6 +63,7 @@ type PortAllocator struct {
 func NewPortAllocator(portMin, portMax uint16) *PortAllocator {
 	return &PortAllocator{
 		allocated: make(map[int]bool),
+		reserved:  make(map[string]reservation),
 		portMin:   int(portMin),
 		portMax:   int(portMax),
 	}
@@ -46,7 +72,103 @@ func NewPortAllocator(portMin, portMax uint16) *PortAllocator {
 func (a *PortAllocator) Allocate() (uint16, error) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
+	return a.allocate()
+}
+
+// Reserve allocates a port for the workload with the given id ahead of its
+// creation. reserving a port for the same workload again returns the same
+// port. reservations that have not been claimed using AllocateFor are freed
+// after some time, so ports of workloads that are never created do not leak.
+func (a *PortAllocator) Reserve(id string) (uint16, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+
+	for resID, r := range a.reserved {
+		if now.After(r.expiresAt) {
+			delete(a.allocated, int(r.port))
+			delete(a.reserved, resID)
+		}
+	}
+
+	if r, ok := a.reserved[id]; ok {
+		return r.port, nil
+	}
+
+	port, err := a.allocate()
+	if err != nil {
+		return 0, err
+	}
+
+	a.reserved[id] = reservation{
+		port:      port,
+		expiresAt: now.Add(reservationTTL),
+	}
+
+	return port, nil
+}
+
+// AllocateFor returns the port reserved for the workload with the given id.
+// if there is no reservation, a new port is allocated.
+func (a *PortAllocator) AllocateFor(id string) (uint16, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if r, ok := a.reserved[id]; ok {
+		delete(a.reserved, id)
+		return r.port, nil
+	}
+
+	return a.allocate()
+}
+
+// Claim allocates the given port, if it is not allocated already.
+// returns false if the port is already in use.
+func (a *PortAllocator) Claim(port uint16) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.allocated[int(port)] {
+		return false
+	}
+
+	a.allocated[int(port)] = true
+	return true
+}
+
+// Allocations returns all allocated ports ordered by port.
+func (a *PortAllocator) Allocations() []PortAllocation {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	reservedFor := make(map[uint16]string, len(a.reserved))
+	for id, r := range a.reserved {
+		reservedFor[r.port] = id
+	}
+
+	ret := make([]PortAllocation, 0, len(a.allocated))
+	for p := range a.allocated {
+		alloc := PortAllocation{
+			Port: uint16(p),
+		}
+
+		if id, ok := reservedFor[alloc.Port]; ok {
+			alloc.ReservedFor = id
+			alloc.ExpiresAt = a.reserved[id].expiresAt
+		}
+
+		ret = append(ret, alloc)
+	}
+
+	slices.SortFunc(ret, func(a, b PortAllocation) int {
+		return cmp.Compare(a.Port, b.Port)
+	})
+
+	return ret
+}
 
+func (a *PortAllocator) allocate() (uint16, error) {
 	var (
 		maxTries = 5
 		try      = 0
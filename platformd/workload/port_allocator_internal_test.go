@@ -20,6 +20,7 @@ package workload
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -67,3 +68,68 @@ func TestPortAllocation(t *testing.T) {
 		})
 	}
 }
+
+func TestPortReservation(t *testing.T) {
+	alloc := NewPortAllocator(1000, 2000)
+
+	reserved, err := alloc.Reserve("workload-1")
+	require.NoError(t, err)
+
+	// reserving again must not allocate another port
+	again, err := alloc.Reserve("workload-1")
+	require.NoError(t, err)
+	require.Equal(t, reserved, again)
+
+	claimed, err := alloc.AllocateFor("workload-1")
+	require.NoError(t, err)
+	require.Equal(t, reserved, claimed)
+	require.Empty(t, alloc.reserved)
+	require.True(t, alloc.allocated[int(claimed)])
+}
+
+func TestExpiredPortReservationsAreFreed(t *testing.T) {
+	alloc := NewPortAllocator(1000, 2000)
+	alloc.allocated[1500] = true
+	alloc.reserved["expired"] = reservation{
+		port:      1500,
+		expiresAt: time.Now().Add(-time.Second),
+	}
+
+	_, err := alloc.Reserve("workload-1")
+	require.NoError(t, err)
+
+	require.NotContains(t, alloc.reserved, "expired")
+	require.NotContains(t, alloc.allocated, 1500)
+}
+
+func TestPortClaim(t *testing.T) {
+	alloc := NewPortAllocator(1000, 1000)
+
+	require.True(t, alloc.Claim(1000))
+	require.False(t, alloc.Claim(1000))
+
+	// the claimed port must not be handed out
+	_, err := alloc.Allocate()
+	require.ErrorIs(t, err, ErrMaxPortTriesReached)
+
+	alloc.Free(1000)
+	require.True(t, alloc.Claim(1000))
+}
+
+func TestPortAllocations(t *testing.T) {
+	alloc := NewPortAllocator(1000, 1000)
+
+	require.True(t, alloc.Claim(2000))
+
+	reserved, err := alloc.Reserve("workload-1")
+	require.NoError(t, err)
+	require.Equal(t, uint16(1000), reserved)
+
+	allocs := alloc.Allocations()
+	require.Len(t, allocs, 2)
+
+	require.Equal(t, PortAllocation{Port: 2000}, allocs[1])
+	require.Equal(t, uint16(1000), allocs[0].Port)
+	require.Equal(t, "workload-1", allocs[0].ReservedFor)
+	require.WithinDuration(t, time.Now().Add(reservationTTL), allocs[0].ExpiresAt, time.Second)
+}
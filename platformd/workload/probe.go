@@ -0,0 +1,196 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package workload
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+type ProbeType string
+
+const (
+	// ProbeTypeNone disables probing, only container states are checked.
+	ProbeTypeNone ProbeType = ""
+
+	// ProbeTypeTCP checks whether a tcp connection to the minecraft port can be established.
+	ProbeTypeTCP ProbeType = "tcp"
+
+	// ProbeTypeServerListPing performs a server list ping, like the
+	// multiplayer screen of the minecraft client does.
+	ProbeTypeServerListPing ProbeType = "server-list-ping"
+)
+
+// DefaultProbePort is the port the minecraft server is listening on inside the pod.
+const DefaultProbePort = 25565
+
+var ErrProbeLatencyExceeded = errors.New("probe latency threshold exceeded")
+
+// ProbeConfig configures the health probe executed against the minecraft
+// server of a workload in addition to checking its container states.
+type ProbeConfig struct {
+	Type    ProbeType
+	Port    uint16
+	Timeout time.Duration
+
+	// LatencyThreshold is the maximum duration a server list ping may
+	// take before it is counted as failure. zero disables the check.
+	LatencyThreshold time.Duration
+
+	// StartupGracePeriod is used for workloads whose flavor
+	// version does not specify its own startup grace period.
+	StartupGracePeriod time.Duration
+
+	// FailureThreshold is the number of consecutive probe failures
+	// after which a workload is considered unhealthy. single failures,
+	// for example caused by long gc pauses, are tolerated this way.
+	FailureThreshold uint
+}
+
+type probeState struct {
+	// started is set once the probe succeeded for the first time.
+	started  bool
+	failures uint
+
+	// startedAt is the time the probe succeeded for the first time.
+	startedAt time.Time
+
+	// readyReported is whether the ready event has already been returned.
+	readyReported bool
+}
+
+// probe runs the configured probe against the given ip address.
+func (c ProbeConfig) probe(ctx context.Context, ip string) error {
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	port := c.Port
+	if port == 0 {
+		port = DefaultProbePort
+	}
+
+	addr := net.JoinHostPort(ip, strconv.Itoa(int(port)))
+
+	switch c.Type {
+	case ProbeTypeTCP:
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return fmt.Errorf("dial: %w", err)
+		}
+		return conn.Close()
+	case ProbeTypeServerListPing:
+		latency, err := ServerListPing(ctx, addr)
+		if err != nil {
+			return fmt.Errorf("server list ping: %w", err)
+		}
+		if c.LatencyThreshold > 0 && latency > c.LatencyThreshold {
+			return fmt.Errorf("%w: took %s", ErrProbeLatencyExceeded, latency)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown probe type %q", c.Type)
+	}
+}
+
+// ServerListPing requests the status of the minecraft server listening on addr.
+// the returned duration is the time it took the server to respond to the status
+// request. see https://minecraft.wiki/w/Java_Edition_protocol/Server_List_Ping
+func ServerListPing(ctx context.Context, addr string) (time.Duration, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return 0, fmt.Errorf("dial: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return 0, fmt.Errorf("set deadline: %w", err)
+		}
+	}
+
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return 0, fmt.Errorf("split host port: %w", err)
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("parse port: %w", err)
+	}
+
+	handshake := make([]byte, 0, 32)
+	handshake = binary.AppendUvarint(handshake, 0x00) // packet id
+	// -1 is used by clients that do not know which version the server runs.
+	handshake = binary.AppendUvarint(handshake, 0xffffffff)
+	handshake = binary.AppendUvarint(handshake, uint64(len(host)))
+	handshake = append(handshake, host...)
+	handshake = binary.BigEndian.AppendUint16(handshake, uint16(port))
+	handshake = binary.AppendUvarint(handshake, 0x01) // next state: status
+
+	statusReq := []byte{0x00} // packet id
+
+	start := time.Now()
+
+	if _, err := conn.Write(append(framePacket(handshake), framePacket(statusReq)...)); err != nil {
+		return 0, fmt.Errorf("write request: %w", err)
+	}
+
+	r := bufio.NewReader(conn)
+
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, fmt.Errorf("read packet length: %w", err)
+	}
+
+	// the response contains the motd and player samples among others,
+	// so generously limit the size to not allocate arbitrary amounts.
+	if length == 0 || length > 1<<20 {
+		return 0, fmt.Errorf("invalid packet length %d", length)
+	}
+
+	packet := make([]byte, length)
+	if _, err := io.ReadFull(r, packet); err != nil {
+		return 0, fmt.Errorf("read packet: %w", err)
+	}
+
+	latency := time.Since(start)
+
+	if packet[0] != 0x00 {
+		return 0, fmt.Errorf("unexpected packet id %#x", packet[0])
+	}
+
+	return latency, nil
+}
+
+// framePacket prefixes the packet with its length.
+func framePacket(p []byte) []byte {
+	return append(binary.AppendUvarint(nil, uint64(len(p))), p...)
+}
@@ -0,0 +1,113 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package workload
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerListPing(t *testing.T) {
+	tests := []struct {
+		name  string
+		delay time.Duration
+		err   error
+	}{
+		{
+			name: "server responds in time",
+		},
+		{
+			name:  "server exceeds latency threshold",
+			delay: 200 * time.Millisecond,
+			err:   ErrProbeLatencyExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = l.Close() })
+
+			nextState := make(chan uint64, 1)
+
+			go func() {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+
+				r := bufio.NewReader(conn)
+
+				// handshake
+				handshake := readPacket(r)
+				hr := bufio.NewReader(handshake)
+				_, _ = binary.ReadUvarint(hr) // packet id
+				_, _ = binary.ReadUvarint(hr) // protocol version
+				hostLen, _ := binary.ReadUvarint(hr)
+				_, _ = io.CopyN(io.Discard, hr, int64(hostLen)+2) // host and port
+				state, _ := binary.ReadUvarint(hr)
+				nextState <- state
+
+				// status request
+				readPacket(r)
+
+				time.Sleep(tt.delay)
+
+				resp := []byte(`{"version":{"name":"1.21.4","protocol":769}}`)
+				p := binary.AppendUvarint([]byte{0x00}, uint64(len(resp)))
+				p = append(p, resp...)
+				_, _ = conn.Write(framePacket(p))
+			}()
+
+			cfg := ProbeConfig{
+				Type:             ProbeTypeServerListPing,
+				Port:             uint16(l.Addr().(*net.TCPAddr).Port),
+				Timeout:          time.Second,
+				LatencyThreshold: 100 * time.Millisecond,
+			}
+
+			err = cfg.probe(context.Background(), "127.0.0.1")
+			require.Equal(t, uint64(1), <-nextState)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// readPacket reads a length prefixed packet. errors are not returned,
+// because they surface as failing probe in the test anyway.
+func readPacket(r *bufio.Reader) *bufio.Reader {
+	length, _ := binary.ReadUvarint(r)
+	p := make([]byte, length)
+	_, _ = io.ReadFull(r, p)
+	return bufio.NewReader(bytes.NewReader(p))
+}
@@ -19,6 +19,7 @@
 package workload
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 
@@ -28,14 +29,18 @@ import (
 
 type Server struct {
 	workloadv1alpha2.UnimplementedWorkloadServiceServer
-	store   status.Store
-	service Service
+	store            status.Store
+	service          Service
+	portAlloc        *PortAllocator
+	registryEndpoint string
 }
 
-func NewServer(store status.Store, service Service) *Server {
+func NewServer(store status.Store, service Service, portAlloc *PortAllocator, registryEndpoint string) *Server {
 	return &Server{
-		store:   store,
-		service: service,
+		store:            store,
+		service:          service,
+		portAlloc:        portAlloc,
+		registryEndpoint: registryEndpoint,
 	}
 }
 
@@ -103,3 +108,77 @@ func (s *Server) WorkloadMetadata(
 		Metadata: MetadataToTransport(meta),
 	}, nil
 }
+
+func (s *Server) PrepareWorkload(
+	ctx context.Context,
+	req *workloadv1alpha2.PrepareWorkloadRequest,
+) (*workloadv1alpha2.PrepareWorkloadResponse, error) {
+	id := req.GetId()
+
+	if id == "" {
+		return nil, fmt.Errorf("workload id required")
+	}
+
+	if req.GetFlavorVersionId() == "" {
+		return nil, fmt.Errorf("flavor version id required")
+	}
+
+	port, err := s.portAlloc.Reserve(id)
+	if err != nil {
+		return nil, fmt.Errorf("reserve port: %w", err)
+	}
+
+	baseImage, checkpointImage := ImageRefs(s.registryEndpoint, req.GetFlavorVersionId())
+
+	if err := s.service.PullImages(ctx, Workload{
+		ID:              id,
+		BaseImage:       baseImage,
+		CheckpointImage: checkpointImage,
+	}); err != nil {
+		return nil, fmt.Errorf("pull images: %w", err)
+	}
+
+	return &workloadv1alpha2.PrepareWorkloadResponse{
+		Port: uint32(port),
+	}, nil
+}
+
+// snapshotChunkSize is the maximum size of the chunks a snapshot is streamed in.
+const snapshotChunkSize = 64 * 1024
+
+func (s *Server) SnapshotWorkload(
+	req *workloadv1alpha2.SnapshotWorkloadRequest,
+	stream workloadv1alpha2.WorkloadService_SnapshotWorkloadServer,
+) error {
+	id := req.GetId()
+
+	if id == "" {
+		return fmt.Errorf("workload id required")
+	}
+
+	w := bufio.NewWriterSize(snapshotWriter{stream: stream}, snapshotChunkSize)
+
+	if err := s.service.Snapshot(stream.Context(), id, w); err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("flush: %w", err)
+	}
+
+	return nil
+}
+
+// snapshotWriter sends everything written to it as chunk of the snapshot.
+type snapshotWriter struct {
+	stream workloadv1alpha2.WorkloadService_SnapshotWorkloadServer
+}
+
+func (w snapshotWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&workloadv1alpha2.SnapshotWorkloadResponse{
+		Data: p,
+	}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -2,18 +2,25 @@ package workload
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/google/uuid"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
 	"github.com/spacechunks/explorer/internal/resource"
 	"github.com/spacechunks/explorer/internal/resource/codec"
+	"github.com/spacechunks/explorer/internal/tarhelper"
+	"github.com/spacechunks/explorer/internal/workloadenv"
 	"github.com/spacechunks/explorer/platformd/cri"
 	"github.com/spacechunks/explorer/platformd/status"
 	"google.golang.org/grpc/codes"
@@ -22,18 +29,73 @@ import (
 	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
 )
 
+const serverMonContainerName = "servermon"
+
+// serverRootDir is the directory the minecraft server is run from inside the container.
+const serverRootDir = "/opt/paper"
+
 type Service interface {
 	RunWorkload(ctx context.Context, w Workload, attempt uint) error
 	RemoveWorkload(ctx context.Context, id string) error
-	GetWorkloadHealth(ctx context.Context, id string) (status.WorkloadHealthStatus, error)
+
+	// GetWorkloadHealth determines the health of the workload. failing probes are
+	// tolerated until the server either responded once or startupGracePeriod has
+	// passed since the pod has been created. if startupGracePeriod is zero, the
+	// grace period configured for the node is used.
+	GetWorkloadHealth(
+		ctx context.Context,
+		id string,
+		startupGracePeriod time.Duration,
+	) (status.WorkloadHealthStatus, error)
+
 	WorkloadMetadata(ctx context.Context, id string) (Metadata, error)
+
+	// ListWorkloads returns the workloads present on the node matching the
+	// selector. workloads whose labels do not conform to the label schema
+	// are skipped.
+	ListWorkloads(ctx context.Context, sel workloadenv.Selector) ([]Info, error)
+
+	// InstancePorts returns the host ports of the instance workloads present
+	// on the node, keyed by instance id. it is used to recover the allocated
+	// ports once platformd has been restarted.
+	InstancePorts(ctx context.Context) (map[string]uint16, error)
+
+	// TerminationEvent inspects the exited containers of the workload and
+	// returns an event describing why it stopped. nil is returned if none
+	// of the containers exited.
+	TerminationEvent(ctx context.Context, id string) (*status.WorkloadEvent, error)
+
+	// ReadyEvent returns an event describing when the workload responded
+	// to the probe for the first time. the event is only returned once,
+	// nil is returned afterward, if the workload did not respond yet or
+	// no probe is configured.
+	ReadyEvent(id string) (*status.WorkloadEvent, error)
+
+	// PullImages pulls the base and checkpoint image of the workload,
+	// so they are already present once the workload is created.
+	PullImages(ctx context.Context, w Workload) error
+
+	// Snapshot writes a gzip compressed tarball of the server directory
+	// of the running minecraft server container of the workload to w.
+	Snapshot(ctx context.Context, id string, w io.Writer) error
+}
+
+// ImageVerifier checks that an image has been signed
+// by the control plane before it is used for a workload.
+type ImageVerifier interface {
+	Verify(ctx context.Context, imgRef string) error
 }
 
 type svc struct {
 	logger       *slog.Logger
 	criService   cri.Service
 	registryAuth cri.RegistryAuth
+	verifier     ImageVerifier
 	cfg          Config
+
+	// probes keeps track of the probe results per workload.
+	probes   map[string]*probeState
+	probesMu sync.Mutex
 }
 
 func NewService(
@@ -41,17 +103,87 @@ func NewService(
 	cfg Config,
 	criService cri.Service,
 	registryAuth cri.RegistryAuth,
+	verifier ImageVerifier,
 ) Service {
 	return &svc{
 		logger:       logger.With("component", "workload-service"),
 		criService:   criService,
 		registryAuth: registryAuth,
+		verifier:     verifier,
 		cfg:          cfg,
+		probes:       make(map[string]*probeState),
 	}
 }
 
+func (s *svc) PullImages(ctx context.Context, w Workload) error {
+	if err := s.verifyImages(ctx, w); err != nil {
+		return err
+	}
+
+	if err := s.ensureBaseImage(ctx, w.BaseImage); err != nil {
+		return err
+	}
+
+	if _, err := s.criService.EnsureImage(ctx, w.CheckpointImage, s.registryAuth); err != nil {
+		return fmt.Errorf("pull checkpoint image if not present: %w", err)
+	}
+
+	return nil
+}
+
+// verifyImages refuses images that have not been signed by the control
+// plane. if no verifier has been configured, all images are accepted.
+//
+// note that images are pulled by tag afterward, so verification does not
+// protect against the tag being moved between verifying and pulling.
+func (s *svc) verifyImages(ctx context.Context, w Workload) error {
+	if s.verifier == nil {
+		return nil
+	}
+
+	for _, ref := range []string{w.BaseImage, w.CheckpointImage} {
+		if err := s.verifier.Verify(ctx, ref); err != nil {
+			return fmt.Errorf("verify image %s: %w", ref, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *svc) ensureBaseImage(ctx context.Context, image string) error {
+	pulled, err := s.criService.EnsureImage(ctx, image, s.registryAuth)
+	if err != nil {
+		return fmt.Errorf("pull image if not present: %w", err)
+	}
+
+	// HACK START - there is currently a strange behavior in crio (maybe a bug)
+	// that when freshly pulling the base image, restoring the checkpoint after
+	// will fail. this can be fixed by restarting crio and then restoring. until
+	// this has been further investigated, use this as a workaround.
+	//
+	// keep it behind env var guard, to make testing easier.
+	if ok := os.Getenv("PLATFORMD_ENABLE_CRIO_RESTART"); ok == "true" && pulled {
+		s.logger.InfoContext(ctx, "restarting crio")
+		if out, err := exec.Command("systemctl", "restart", "crio").CombinedOutput(); err != nil {
+			return fmt.Errorf("systemctl restart crio: %w: %s", err, out)
+		}
+		time.Sleep(5 * time.Second)
+	}
+	// HACK END
+
+	return nil
+}
+
 // RunWorkload calls the CRI to create a new pod based on the passed workload.
 func (s *svc) RunWorkload(ctx context.Context, w Workload, attempt uint) error {
+	if err := s.verifyImages(ctx, w); err != nil {
+		return err
+	}
+
+	if err := workloadenv.ValidateLabels(w.Labels); err != nil {
+		return err
+	}
+
 	logger := s.logger.With("workload_id", w.ID, "pod_name", w.Name, "namespace", w.Namespace)
 
 	data, err := protojson.Marshal(w.Instance)
@@ -75,7 +207,7 @@ func (s *svc) RunWorkload(ctx context.Context, w Workload, attempt uint) error {
 		DnsConfig: &runtimev1.DNSConfig{
 			Servers:  []string{"10.0.0.53"}, // TODO: make configurable
 			Options:  []string{"edns0", "trust-ad"},
-			Searches: []string{"."},
+			Searches: s.dnsSearches(w.Namespace),
 		},
 		Linux: &runtimev1.LinuxPodSandboxConfig{
 			Resources: &runtimev1.LinuxContainerResources{
@@ -91,25 +223,9 @@ func (s *svc) RunWorkload(ctx context.Context, w Workload, attempt uint) error {
 		},
 	}
 
-	pulled, err := s.criService.EnsureImage(ctx, w.BaseImage, s.registryAuth)
-	if err != nil {
-		return fmt.Errorf("pull image if not present: %w", err)
-	}
-
-	// HACK START - there is currently a strange behavior in crio (maybe a bug)
-	// that when freshly pulling the base image, restoring the checkpoint after
-	// will fail. this can be fixed by restarting crio and then restoring. until
-	// this has been further investigated, use this as a workaround.
-	//
-	// keep it behind env var guard, to make testing easier.
-	if ok := os.Getenv("PLATFORMD_ENABLE_CRIO_RESTART"); ok == "true" && pulled {
-		s.logger.InfoContext(ctx, "restarting crio")
-		if out, err := exec.Command("systemctl", "restart", "crio").CombinedOutput(); err != nil {
-			return fmt.Errorf("systemctl restart crio: %w: %s", err, out)
-		}
-		time.Sleep(5 * time.Second)
+	if err := s.ensureBaseImage(ctx, w.BaseImage); err != nil {
+		return err
 	}
-	// HACK END
 
 	sboxResp, err := s.criService.RunPodSandbox(ctx, &runtimev1.RunPodSandboxRequest{
 		Config: sboxCfg,
@@ -129,6 +245,11 @@ func (s *svc) RunWorkload(ctx context.Context, w Workload, attempt uint) error {
 	logger = logger.With("pod_id", sboxResp.PodSandboxId)
 	logger.InfoContext(ctx, "started pod sandbox")
 
+	paramsMount, paramsEnvs, err := s.writeParams(w)
+	if err != nil {
+		return fmt.Errorf("params: %w", err)
+	}
+
 	mcServerReq := &runtimev1.CreateContainerRequest{
 		PodSandboxId: sboxResp.PodSandboxId,
 		Config: &runtimev1.ContainerConfig{
@@ -148,6 +269,11 @@ func (s *svc) RunWorkload(ctx context.Context, w Workload, attempt uint) error {
 		SandboxConfig: sboxCfg,
 	}
 
+	if paramsMount != nil {
+		mcServerReq.Config.Mounts = append(mcServerReq.Config.Mounts, paramsMount)
+		mcServerReq.Config.Envs = append(mcServerReq.Config.Envs, paramsEnvs...)
+	}
+
 	mcCtrID, err := s.criService.RunContainer(ctx, mcServerReq)
 	if err != nil {
 		return fmt.Errorf("run mc server container: %w", err)
@@ -159,11 +285,11 @@ func (s *svc) RunWorkload(ctx context.Context, w Workload, attempt uint) error {
 		PodSandboxId: sboxResp.PodSandboxId,
 		Config: &runtimev1.ContainerConfig{
 			Metadata: &runtimev1.ContainerMetadata{
-				Name: "servermon",
-			},
-			Labels: map[string]string{
-				LabelWorkloadID: w.ID,
+				Name: serverMonContainerName,
 			},
+			Labels: workloadenv.Labels{
+				WorkloadID: w.ID,
+			}.Map(),
 			Image: &runtimev1.ImageSpec{
 				UserSpecifiedImage: s.cfg.ServerMonImage,
 				Image:              s.cfg.ServerMonImage,
@@ -241,10 +367,19 @@ func (s *svc) RunWorkload(ctx context.Context, w Workload, attempt uint) error {
 
 func (s *svc) RemoveWorkload(ctx context.Context, id string) error {
 	s.logger.InfoContext(ctx, "removing workload", "workload_id", id)
+
+	s.probesMu.Lock()
+	delete(s.probes, id)
+	s.probesMu.Unlock()
+
+	if err := s.removeParamsDir(ctx, id); err != nil {
+		s.logger.WarnContext(ctx, "removing params dir failed", "workload_id", id, "err", err)
+	}
+
 	listResp, err := s.criService.ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
 		Filter: &runtimev1.PodSandboxFilter{
 			LabelSelector: map[string]string{
-				LabelWorkloadID: id,
+				workloadenv.LabelWorkloadID: id,
 			},
 		},
 	})
@@ -303,12 +438,34 @@ func (s *svc) RemoveWorkload(ctx context.Context, id string) error {
 
 // GetWorkloadHealth checks whether a container can be found for the given workload.
 // if it cannot be found, or the status is CREATED, EXITED or UNKNOWN, the workload
-// is considered unhealthy.
-func (s *svc) GetWorkloadHealth(ctx context.Context, id string) (status.WorkloadHealthStatus, error) {
+// is considered unhealthy. if a probe is configured, the workload is also considered
+// unhealthy once the probe failed more than the configured threshold in a row.
+func (s *svc) GetWorkloadHealth(
+	ctx context.Context,
+	id string,
+	startupGracePeriod time.Duration,
+) (status.WorkloadHealthStatus, error) {
+	health, err := s.containerHealth(ctx, id)
+	if err != nil {
+		return status.WorkloadHealthStatusUnhealthy, err
+	}
+
+	if health != status.WorkloadHealthStatusHealthy || s.cfg.Probe.Type == ProbeTypeNone {
+		return health, nil
+	}
+
+	if startupGracePeriod == 0 {
+		startupGracePeriod = s.cfg.Probe.StartupGracePeriod
+	}
+
+	return s.probeHealth(ctx, id, startupGracePeriod)
+}
+
+func (s *svc) containerHealth(ctx context.Context, id string) (status.WorkloadHealthStatus, error) {
 	resp, err := s.criService.ListContainers(ctx, &runtimev1.ListContainersRequest{
 		Filter: &runtimev1.ContainerFilter{
 			LabelSelector: map[string]string{
-				LabelWorkloadID: id,
+				workloadenv.LabelWorkloadID: id,
 			},
 		},
 	})
@@ -342,11 +499,114 @@ func (s *svc) GetWorkloadHealth(ctx context.Context, id string) (status.Workload
 	return status.WorkloadHealthStatusHealthy, nil
 }
 
+func (s *svc) probeHealth(
+	ctx context.Context,
+	id string,
+	startupGracePeriod time.Duration,
+) (status.WorkloadHealthStatus, error) {
+	pod, err := s.podSandbox(ctx, id)
+	if err != nil {
+		return status.WorkloadHealthStatusUnhealthy, fmt.Errorf("get pod: %w", err)
+	}
+
+	ip, err := s.podIP(ctx, pod.Id)
+	if err != nil {
+		return status.WorkloadHealthStatusUnhealthy, fmt.Errorf("get pod ip: %w", err)
+	}
+
+	err = s.cfg.Probe.probe(ctx, ip)
+
+	s.probesMu.Lock()
+	defer s.probesMu.Unlock()
+
+	st, ok := s.probes[id]
+	if !ok {
+		st = &probeState{}
+		s.probes[id] = st
+	}
+
+	if err == nil {
+		if !st.started {
+			st.startedAt = time.Now()
+		}
+		st.started = true
+		st.failures = 0
+		return status.WorkloadHealthStatusHealthy, nil
+	}
+
+	// big modpacks can take minutes until the server accepts connections,
+	// so failures are not counted until it either responded once or had
+	// enough time to start.
+	if age := time.Since(time.Unix(0, pod.CreatedAt)); !st.started && age < startupGracePeriod {
+		s.logger.DebugContext(
+			ctx,
+			"workload probe failed during startup",
+			"workload_id", id,
+			"age", age,
+			"startup_grace_period", startupGracePeriod,
+			"err", err,
+		)
+		return status.WorkloadHealthStatusHealthy, nil
+	}
+
+	st.failures++
+
+	s.logger.InfoContext(
+		ctx,
+		"workload probe failed",
+		"workload_id", id,
+		"failures", st.failures,
+		"err", err,
+	)
+
+	if st.failures < s.cfg.Probe.FailureThreshold {
+		return status.WorkloadHealthStatusHealthy, nil
+	}
+
+	delete(s.probes, id)
+	return status.WorkloadHealthStatusUnhealthy, nil
+}
+
+func (s *svc) podSandbox(ctx context.Context, id string) (*runtimev1.PodSandbox, error) {
+	listResp, err := s.criService.ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
+		Filter: &runtimev1.PodSandboxFilter{
+			LabelSelector: map[string]string{
+				workloadenv.LabelWorkloadID: id,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pod sandbox: %w", err)
+	}
+
+	if len(listResp.Items) == 0 {
+		return nil, grpcstatus.Error(codes.NotFound, "workload not found")
+	}
+
+	return listResp.Items[0], nil
+}
+
+func (s *svc) podIP(ctx context.Context, podID string) (string, error) {
+	statusResp, err := s.criService.PodSandboxStatus(ctx, &runtimev1.PodSandboxStatusRequest{
+		PodSandboxId: podID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("pod sandbox status: %w", err)
+	}
+
+	ip := statusResp.GetStatus().GetNetwork().GetIp()
+	if ip == "" {
+		return "", errors.New("pod has no ip address")
+	}
+
+	return ip, nil
+}
+
 func (s *svc) WorkloadMetadata(ctx context.Context, id string) (Metadata, error) {
 	listResp, err := s.criService.ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
 		Filter: &runtimev1.PodSandboxFilter{
 			LabelSelector: map[string]string{
-				LabelWorkloadID: id,
+				workloadenv.LabelWorkloadID: id,
 			},
 		},
 	})
@@ -383,6 +643,144 @@ func (s *svc) WorkloadMetadata(ctx context.Context, id string) (Metadata, error)
 	}, nil
 }
 
+func (s *svc) ListWorkloads(ctx context.Context, sel workloadenv.Selector) ([]Info, error) {
+	listResp, err := s.criService.ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
+		Filter: &runtimev1.PodSandboxFilter{
+			LabelSelector: sel.LabelSelector(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pod sandbox: %w", err)
+	}
+
+	ret := make([]Info, 0, len(listResp.Items))
+	for _, pod := range listResp.Items {
+		labels, err := workloadenv.ParseLabels(pod.Labels)
+		if err != nil {
+			s.logger.WarnContext(ctx, "skipping pod with invalid labels", "pod_id", pod.Id, "err", err)
+			continue
+		}
+
+		ret = append(ret, Info{
+			PodID:     pod.Id,
+			Labels:    labels,
+			Ready:     pod.State == runtimev1.PodSandboxState_SANDBOX_READY,
+			CreatedAt: time.Unix(0, pod.CreatedAt),
+		})
+	}
+
+	return ret, nil
+}
+
+func (s *svc) InstancePorts(ctx context.Context) (map[string]uint16, error) {
+	workloads, err := s.ListWorkloads(ctx, workloadenv.Selector{
+		WorkloadType: workloadenv.WorkloadTypeInstance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string]uint16, len(workloads))
+	for _, w := range workloads {
+		if w.Labels.WorkloadID == "" || w.Labels.WorkloadPort == 0 {
+			s.logger.WarnContext(ctx, "pod has no valid workload port", "pod_id", w.PodID)
+			continue
+		}
+		ret[w.Labels.WorkloadID] = w.Labels.WorkloadPort
+	}
+
+	return ret, nil
+}
+
+func (s *svc) TerminationEvent(ctx context.Context, id string) (*status.WorkloadEvent, error) {
+	resp, err := s.criService.ListContainers(ctx, &runtimev1.ListContainersRequest{
+		Filter: &runtimev1.ContainerFilter{
+			LabelSelector: map[string]string{
+				workloadenv.LabelWorkloadID: id,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+
+	var exited *runtimev1.ContainerStatus
+	for _, c := range resp.Containers {
+		if c.State != runtimev1.ContainerState_CONTAINER_EXITED {
+			continue
+		}
+
+		statusResp, err := s.criService.ContainerStatus(ctx, &runtimev1.ContainerStatusRequest{
+			ContainerId: c.Id,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("container status: %w", err)
+		}
+
+		exited = statusResp.Status
+
+		// the minecraft server container is the one users care about,
+		// so prefer it over servermon if both have exited.
+		if c.GetMetadata().GetName() != serverMonContainerName {
+			break
+		}
+	}
+
+	if exited == nil {
+		return nil, nil
+	}
+
+	evID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("generate id: %w", err)
+	}
+
+	typ := status.WorkloadEventTypeExited
+	switch {
+	case exited.Reason == "OOMKilled":
+		typ = status.WorkloadEventTypeOOMKilled
+	case exited.ExitCode != 0:
+		typ = status.WorkloadEventTypeCrashed
+	}
+
+	msg := fmt.Sprintf("container %s exited with code %d", exited.GetMetadata().GetName(), exited.ExitCode)
+	if exited.Message != "" {
+		msg += ": " + exited.Message
+	}
+
+	return &status.WorkloadEvent{
+		ID:        evID.String(),
+		Type:      typ,
+		ExitCode:  exited.ExitCode,
+		Message:   msg,
+		Timestamp: time.Unix(0, exited.FinishedAt),
+	}, nil
+}
+
+func (s *svc) ReadyEvent(id string) (*status.WorkloadEvent, error) {
+	s.probesMu.Lock()
+	defer s.probesMu.Unlock()
+
+	st, ok := s.probes[id]
+	if !ok || !st.started || st.readyReported {
+		return nil, nil
+	}
+
+	evID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("generate id: %w", err)
+	}
+
+	st.readyReported = true
+
+	return &status.WorkloadEvent{
+		ID:        evID.String(),
+		Type:      status.WorkloadEventTypeReady,
+		Message:   "server responded to the probe",
+		Timestamp: st.startedAt,
+	}, nil
+}
+
 func (s *svc) removeLogDir(ctx context.Context, instanceID string) error {
 	entries, err := os.ReadDir(cri.PodLogDir)
 	if err != nil {
@@ -395,6 +793,24 @@ func (s *svc) removeLogDir(ctx context.Context, instanceID string) error {
 		}
 		path := filepath.Join(cri.PodLogDir, e.Name())
 
+		if s.cfg.LogArchiveDir != "" {
+			// a workload can be recreated with the same log dir,
+			// so the archived dirs have to be distinguishable.
+			dst := filepath.Join(s.cfg.LogArchiveDir, fmt.Sprintf("%s.%d", e.Name(), time.Now().UnixNano()))
+
+			s.logger.InfoContext(
+				ctx,
+				"archive log dir",
+				"workload_id", instanceID,
+				"path", path,
+				"archive_path", dst,
+			)
+			if err := os.Rename(path, dst); err != nil {
+				return fmt.Errorf("archive: %w", err)
+			}
+			continue
+		}
+
 		s.logger.InfoContext(
 			ctx,
 			"remove log dir",
@@ -409,6 +825,17 @@ func (s *svc) removeLogDir(ctx context.Context, instanceID string) error {
 	return nil
 }
 
+// InstanceIDFromLogDir returns the id of the instance the given log dir
+// has been created for. archived log dirs are supported as well.
+func InstanceIDFromLogDir(name string) (string, bool) {
+	// see podLogDir, the owner id is the last part.
+	parts := strings.Split(filepath.Base(name), "_")
+	if len(parts) < 7 {
+		return "", false
+	}
+	return parts[len(parts)-2], true
+}
+
 func podLogDir(ins *instancev1alpha1.Instance) string {
 	var (
 		cName = strings.ReplaceAll(ins.Chunk.Name, " ", "-")
@@ -429,3 +856,54 @@ func podLogDir(ins *instancev1alpha1.Instance) string {
 		ins.Owner.Id,
 	)
 }
+
+func (s *svc) dnsSearches(namespace string) []string {
+	if s.cfg.DNSZone == "" {
+		return []string{"."}
+	}
+	return []string{namespace + "." + s.cfg.DNSZone, "."}
+}
+
+func (s *svc) Snapshot(ctx context.Context, id string, w io.Writer) error {
+	resp, err := s.criService.ListContainers(ctx, &runtimev1.ListContainersRequest{
+		Filter: &runtimev1.ContainerFilter{
+			LabelSelector: map[string]string{
+				workloadenv.LabelWorkloadID: id,
+			},
+			State: &runtimev1.ContainerStateValue{
+				State: runtimev1.ContainerState_CONTAINER_RUNNING,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("list containers: %w", err)
+	}
+
+	idx := slices.IndexFunc(resp.Containers, func(c *runtimev1.Container) bool {
+		return c.GetMetadata().GetName() != serverMonContainerName
+	})
+	if idx == -1 {
+		return grpcstatus.Error(codes.NotFound, "server container not running")
+	}
+
+	info, err := s.criService.ContainerInfo(ctx, resp.Containers[idx].Id)
+	if err != nil {
+		return fmt.Errorf("container info: %w", err)
+	}
+
+	// the server directory is accessed through the root of the container
+	// process. using os.Root makes sure symlinks created by the server,
+	// or plugins running in it, cannot be used to read files of the host.
+	root, err := os.OpenRoot(fmt.Sprintf("/proc/%d/root%s", info.Pid, serverRootDir))
+	if err != nil {
+		return fmt.Errorf("open root: %w", err)
+	}
+
+	defer root.Close()
+
+	if err := tarhelper.TarFS(w, root.FS()); err != nil {
+		return fmt.Errorf("tar: %w", err)
+	}
+
+	return nil
+}
@@ -135,3 +135,56 @@ func TestPodLogDir(t *testing.T) {
 		})
 	}
 }
+
+func TestInstanceIDFromLogDir(t *testing.T) {
+	ins := &instancev1alpha1.Instance{
+		Id: "inst-id",
+		Chunk: &chunkv1alpha1.Chunk{
+			Id:   "chunk-id",
+			Name: "chunk",
+		},
+		Flavor: &chunkv1alpha1.Flavor{
+			Name: "flavor",
+		},
+		FlavorVersion: &chunkv1alpha1.FlavorVersion{
+			Id:      "fv-id",
+			Version: "1.0_beta",
+		},
+		Owner: &userv1alpha1.User{
+			Id: "owner-id",
+		},
+	}
+
+	tests := []struct {
+		name string
+		dir  string
+		id   string
+		ok   bool
+	}{
+		{
+			name: "pod log dir",
+			dir:  podLogDir(ins),
+			id:   "inst-id",
+			ok:   true,
+		},
+		{
+			name: "archived log dir",
+			dir:  podLogDir(ins) + ".1760659200000000000",
+			id:   "inst-id",
+			ok:   true,
+		},
+		{
+			name: "checkpoint log dir",
+			dir:  cri.PodLogDir + "/checkpoints",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := InstanceIDFromLogDir(tt.dir)
+			if ok != tt.ok || id != tt.id {
+				t.Errorf("InstanceIDFromLogDir() = %q, %v, want %q, %v", id, ok, tt.id, tt.ok)
+			}
+		})
+	}
+}
@@ -22,16 +22,19 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
 	"github.com/spacechunks/explorer/internal/mock"
 	"github.com/spacechunks/explorer/internal/resource"
 	"github.com/spacechunks/explorer/internal/resource/codec"
+	"github.com/spacechunks/explorer/internal/workloadenv"
 	"github.com/spacechunks/explorer/platformd/cri"
 	"github.com/spacechunks/explorer/platformd/status"
 	"github.com/spacechunks/explorer/platformd/workload"
@@ -77,6 +80,7 @@ func TestRunWorkload(t *testing.T) {
 				PlatformdListenSockURL: test.MustParseURL(t, "unix:///var/run/platform.sock"),
 				PlatformdSocketUID:     1337,
 				PlatformdSocketGID:     1337,
+				ParamsDir:              t.TempDir(),
 			},
 			attempt: 1,
 			prep: func(criService *mock.MockCriService, cfg workload.Config, w workload.Workload, attempt uint) {
@@ -126,6 +130,31 @@ func TestRunWorkload(t *testing.T) {
 							},
 							Labels:  w.Labels,
 							LogPath: "mcserver.log",
+							Mounts: []*runtimev1.Mount{
+								{
+									HostPath:      filepath.Join(cfg.ParamsDir, w.ID),
+									ContainerPath: "/run/explorer",
+									Readonly:      true,
+								},
+							},
+							Envs: []*runtimev1.KeyValue{
+								{
+									Key:   "EXPLORER_PARAMS_FILE",
+									Value: "/run/explorer/params.json",
+								},
+								{
+									Key:   "EXPLORER_WORLD_SEED",
+									Value: w.Instance.GetParams().GetWorldSeed(),
+								},
+								{
+									Key:   "EXPLORER_GAME_MODE",
+									Value: "survival",
+								},
+								{
+									Key:   "EXPLORER_CUSTOM_PARAMS",
+									Value: w.Instance.GetParams().GetCustom(),
+								},
+							},
 						},
 						SandboxConfig: sboxCfg,
 					}
@@ -136,7 +165,8 @@ func TestRunWorkload(t *testing.T) {
 								Name: "servermon",
 							},
 							Labels: map[string]string{
-								workload.LabelWorkloadID: w.ID,
+								workloadenv.LabelSchemaVersion: workloadenv.LabelSchemaV1,
+								workloadenv.LabelWorkloadID:    w.ID,
 							},
 							Image: &runtimev1.ImageSpec{
 								UserSpecifiedImage: cfg.ServerMonImage,
@@ -220,17 +250,65 @@ func TestRunWorkload(t *testing.T) {
 				ctx            = context.Background()
 				logger         = slog.New(slog.NewTextHandler(os.Stdout, nil))
 				mockCRIService = mock.NewMockCriService(t)
-				svc            = workload.NewService(logger, tt.cfg, mockCRIService, regAuth)
+				svc            = workload.NewService(logger, tt.cfg, mockCRIService, regAuth, nil)
 			)
 
 			tt.prep(mockCRIService, tt.cfg, tt.w, tt.attempt)
 
 			err := svc.RunWorkload(ctx, tt.w, tt.attempt)
 			require.NoError(t, err)
+
+			data, err := os.ReadFile(filepath.Join(tt.cfg.ParamsDir, tt.w.ID, "params.json"))
+			require.NoError(t, err)
+			require.JSONEq(t, `{
+				"worldSeed": "8678942899319966093",
+				"gameMode": "survival",
+				"custom": {"map": "castle"}
+			}`, string(data))
 		})
 	}
 }
 
+func TestUnsignedImagesAreRejected(t *testing.T) {
+	var (
+		ctx            = context.Background()
+		logger         = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		mockCRIService = mock.NewMockCriService(t)
+		mockVerifier   = mock.NewMockWorkloadImageVerifier(t)
+		svc            = workload.NewService(logger, workload.Config{}, mockCRIService, cri.Unauthenticated, mockVerifier)
+		w              = workload.Workload{
+			ID:              test.NewUUIDv7(t),
+			BaseImage:       "registry.local/chunks/flavor:base",
+			CheckpointImage: "registry.local/chunks/flavor:checkpoint",
+		}
+		errUnsigned = fmt.Errorf("not signed")
+	)
+
+	mockVerifier.EXPECT().
+		Verify(mocky.Anything, w.BaseImage).
+		Return(errUnsigned)
+
+	require.ErrorIs(t, svc.PullImages(ctx, w), errUnsigned)
+	require.ErrorIs(t, svc.RunWorkload(ctx, w, 1), errUnsigned)
+}
+
+func TestRunWorkloadRejectsInvalidLabels(t *testing.T) {
+	var (
+		ctx            = context.Background()
+		logger         = slog.New(slog.DiscardHandler)
+		mockCRIService = mock.NewMockCriService(t)
+		svc            = workload.NewService(logger, workload.Config{}, mockCRIService, cri.Unauthenticated, nil)
+		w              = workload.Workload{
+			ID: test.NewUUIDv7(t),
+			Labels: map[string]string{
+				workloadenv.LabelWorkloadType: workloadenv.WorkloadTypeInstance,
+			},
+		}
+	)
+
+	require.ErrorIs(t, svc.RunWorkload(ctx, w, 1), workloadenv.ErrInvalidLabels)
+}
+
 func TestRemoveWorkload(t *testing.T) {
 	var (
 		ctx     = context.Background()
@@ -242,14 +320,14 @@ func TestRemoveWorkload(t *testing.T) {
 			Password: "pass",
 		}
 		mockCRIService = mock.NewMockCriService(t)
-		svc            = workload.NewService(logger, workload.Config{}, mockCRIService, regAuth)
+		svc            = workload.NewService(logger, workload.Config{}, mockCRIService, regAuth, nil)
 	)
 
 	mockCRIService.EXPECT().
 		ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
 			Filter: &runtimev1.PodSandboxFilter{
 				LabelSelector: map[string]string{
-					workload.LabelWorkloadID: wlID,
+					workloadenv.LabelWorkloadID: wlID,
 				},
 			},
 		}).
@@ -354,7 +432,7 @@ func TestGetWorkloadHealth(t *testing.T) {
 					Username: "user",
 					Password: "pass",
 				}
-				svc = workload.NewService(logger, workload.Config{}, mockCRIService, regAuth)
+				svc = workload.NewService(logger, workload.Config{}, mockCRIService, regAuth, nil)
 			)
 
 			var ctrs []*runtimev1.Container
@@ -371,7 +449,7 @@ func TestGetWorkloadHealth(t *testing.T) {
 				ListContainers(ctx, &runtimev1.ListContainersRequest{
 					Filter: &runtimev1.ContainerFilter{
 						LabelSelector: map[string]string{
-							workload.LabelWorkloadID: "",
+							workloadenv.LabelWorkloadID: "",
 						},
 					},
 				}).
@@ -379,7 +457,7 @@ func TestGetWorkloadHealth(t *testing.T) {
 					Containers: ctrs,
 				}, nil)
 
-			st, err := svc.GetWorkloadHealth(ctx, "")
+			st, err := svc.GetWorkloadHealth(ctx, "", 0)
 			require.NoError(t, err)
 
 			require.Equal(t, tt.expected, st)
@@ -387,6 +465,317 @@ func TestGetWorkloadHealth(t *testing.T) {
 	}
 }
 
+func TestGetWorkloadHealthProbe(t *testing.T) {
+	// port that is closed, because the listener has been closed already.
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := closed.Addr().(*net.TCPAddr).Port
+	require.NoError(t, closed.Close())
+
+	open, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = open.Close() })
+	openPort := open.Addr().(*net.TCPAddr).Port
+
+	go func() {
+		for {
+			conn, err := open.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	tests := []struct {
+		name         string
+		port         int
+		podCreatedAt time.Time
+		gracePeriod  time.Duration
+		expected     []status.WorkloadHealthStatus
+		ready        bool
+	}{
+		{
+			name:         "HEALTHY: probe succeeds",
+			podCreatedAt: time.Now(),
+			port:         openPort,
+			expected: []status.WorkloadHealthStatus{
+				status.WorkloadHealthStatusHealthy,
+				status.WorkloadHealthStatusHealthy,
+			},
+			ready: true,
+		},
+		{
+			name:         "UNHEALTHY: probe failure threshold reached",
+			podCreatedAt: time.Now(),
+			port:         closedPort,
+			expected: []status.WorkloadHealthStatus{
+				status.WorkloadHealthStatusHealthy,
+				status.WorkloadHealthStatusUnhealthy,
+			},
+		},
+		{
+			name:         "HEALTHY: probe fails during startup grace period",
+			port:         closedPort,
+			podCreatedAt: time.Now(),
+			gracePeriod:  time.Minute,
+			expected: []status.WorkloadHealthStatus{
+				status.WorkloadHealthStatusHealthy,
+				status.WorkloadHealthStatusHealthy,
+				status.WorkloadHealthStatusHealthy,
+			},
+		},
+		{
+			name:         "UNHEALTHY: probe fails after startup grace period",
+			port:         closedPort,
+			podCreatedAt: time.Now().Add(-2 * time.Minute),
+			gracePeriod:  time.Minute,
+			expected: []status.WorkloadHealthStatus{
+				status.WorkloadHealthStatusHealthy,
+				status.WorkloadHealthStatusUnhealthy,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx            = context.Background()
+				logger         = slog.New(slog.NewTextHandler(os.Stdout, nil))
+				mockCRIService = mock.NewMockCriService(t)
+				svc            = workload.NewService(logger, workload.Config{
+					Probe: workload.ProbeConfig{
+						Type:             workload.ProbeTypeTCP,
+						Port:             uint16(tt.port),
+						Timeout:          time.Second,
+						FailureThreshold: 2,
+					},
+				}, mockCRIService, cri.RegistryAuth{}, nil)
+			)
+
+			mockCRIService.EXPECT().
+				ListContainers(ctx, &runtimev1.ListContainersRequest{
+					Filter: &runtimev1.ContainerFilter{
+						LabelSelector: map[string]string{
+							workloadenv.LabelWorkloadID: "wl",
+						},
+					},
+				}).
+				Return(&runtimev1.ListContainersResponse{
+					Containers: []*runtimev1.Container{
+						{
+							Metadata: &runtimev1.ContainerMetadata{
+								Name: "ctr",
+							},
+							State: runtimev1.ContainerState_CONTAINER_RUNNING,
+						},
+					},
+				}, nil)
+
+			mockCRIService.EXPECT().
+				ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
+					Filter: &runtimev1.PodSandboxFilter{
+						LabelSelector: map[string]string{
+							workloadenv.LabelWorkloadID: "wl",
+						},
+					},
+				}).
+				Return(&runtimev1.ListPodSandboxResponse{
+					Items: []*runtimev1.PodSandbox{
+						{
+							Id:        "pod",
+							CreatedAt: tt.podCreatedAt.UnixNano(),
+						},
+					},
+				}, nil)
+
+			mockCRIService.EXPECT().
+				PodSandboxStatus(ctx, &runtimev1.PodSandboxStatusRequest{
+					PodSandboxId: "pod",
+				}).
+				Return(&runtimev1.PodSandboxStatusResponse{
+					Status: &runtimev1.PodSandboxStatus{
+						Network: &runtimev1.PodSandboxNetworkStatus{
+							Ip: "127.0.0.1",
+						},
+					},
+				}, nil)
+
+			for _, expected := range tt.expected {
+				st, err := svc.GetWorkloadHealth(ctx, "wl", tt.gracePeriod)
+				require.NoError(t, err)
+				require.Equal(t, expected, st)
+			}
+
+			ev, err := svc.ReadyEvent("wl")
+			require.NoError(t, err)
+
+			if !tt.ready {
+				require.Nil(t, ev)
+				return
+			}
+
+			require.NotNil(t, ev)
+			require.Equal(t, status.WorkloadEventTypeReady, ev.Type)
+			require.NotEmpty(t, ev.ID)
+
+			// the event is only returned once
+			ev, err = svc.ReadyEvent("wl")
+			require.NoError(t, err)
+			require.Nil(t, ev)
+		})
+	}
+}
+
+func TestTerminationEvent(t *testing.T) {
+	finishedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		ctrs     []*runtimev1.Container
+		statuses map[string]*runtimev1.ContainerStatus
+		expected *status.WorkloadEvent
+	}{
+		{
+			name: "OOM_KILLED: mc server killed by kernel",
+			ctrs: []*runtimev1.Container{
+				{
+					Id:       "servermon",
+					Metadata: &runtimev1.ContainerMetadata{Name: "servermon"},
+					State:    runtimev1.ContainerState_CONTAINER_EXITED,
+				},
+				{
+					Id:       "mcserver",
+					Metadata: &runtimev1.ContainerMetadata{Name: "mcserver"},
+					State:    runtimev1.ContainerState_CONTAINER_EXITED,
+				},
+			},
+			statuses: map[string]*runtimev1.ContainerStatus{
+				"servermon": {
+					Metadata:   &runtimev1.ContainerMetadata{Name: "servermon"},
+					ExitCode:   1,
+					FinishedAt: finishedAt.UnixNano(),
+				},
+				"mcserver": {
+					Metadata:   &runtimev1.ContainerMetadata{Name: "mcserver"},
+					ExitCode:   137,
+					Reason:     "OOMKilled",
+					FinishedAt: finishedAt.UnixNano(),
+				},
+			},
+			expected: &status.WorkloadEvent{
+				Type:      status.WorkloadEventTypeOOMKilled,
+				ExitCode:  137,
+				Message:   "container mcserver exited with code 137",
+				Timestamp: finishedAt,
+			},
+		},
+		{
+			name: "CRASHED: non-zero exit code",
+			ctrs: []*runtimev1.Container{
+				{
+					Id:       "mcserver",
+					Metadata: &runtimev1.ContainerMetadata{Name: "mcserver"},
+					State:    runtimev1.ContainerState_CONTAINER_EXITED,
+				},
+			},
+			statuses: map[string]*runtimev1.ContainerStatus{
+				"mcserver": {
+					Metadata:   &runtimev1.ContainerMetadata{Name: "mcserver"},
+					ExitCode:   1,
+					Reason:     "Error",
+					Message:    "boom",
+					FinishedAt: finishedAt.UnixNano(),
+				},
+			},
+			expected: &status.WorkloadEvent{
+				Type:      status.WorkloadEventTypeCrashed,
+				ExitCode:  1,
+				Message:   "container mcserver exited with code 1: boom",
+				Timestamp: finishedAt,
+			},
+		},
+		{
+			name: "EXITED: zero exit code",
+			ctrs: []*runtimev1.Container{
+				{
+					Id:       "mcserver",
+					Metadata: &runtimev1.ContainerMetadata{Name: "mcserver"},
+					State:    runtimev1.ContainerState_CONTAINER_EXITED,
+				},
+			},
+			statuses: map[string]*runtimev1.ContainerStatus{
+				"mcserver": {
+					Metadata:   &runtimev1.ContainerMetadata{Name: "mcserver"},
+					Reason:     "Completed",
+					FinishedAt: finishedAt.UnixNano(),
+				},
+			},
+			expected: &status.WorkloadEvent{
+				Type:      status.WorkloadEventTypeExited,
+				Message:   "container mcserver exited with code 0",
+				Timestamp: finishedAt,
+			},
+		},
+		{
+			name: "no event if no container exited",
+			ctrs: []*runtimev1.Container{
+				{
+					Id:       "mcserver",
+					Metadata: &runtimev1.ContainerMetadata{Name: "mcserver"},
+					State:    runtimev1.ContainerState_CONTAINER_RUNNING,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx            = context.Background()
+				wlID           = test.NewUUIDv7(t)
+				logger         = slog.New(slog.NewTextHandler(os.Stdout, nil))
+				mockCRIService = mock.NewMockCriService(t)
+				svc            = workload.NewService(logger, workload.Config{}, mockCRIService, cri.Unauthenticated, nil)
+			)
+
+			mockCRIService.EXPECT().
+				ListContainers(ctx, &runtimev1.ListContainersRequest{
+					Filter: &runtimev1.ContainerFilter{
+						LabelSelector: map[string]string{
+							workloadenv.LabelWorkloadID: wlID,
+						},
+					},
+				}).
+				Return(&runtimev1.ListContainersResponse{
+					Containers: tt.ctrs,
+				}, nil)
+
+			for id, st := range tt.statuses {
+				mockCRIService.EXPECT().
+					ContainerStatus(ctx, &runtimev1.ContainerStatusRequest{
+						ContainerId: id,
+					}).
+					Return(&runtimev1.ContainerStatusResponse{
+						Status: st,
+					}, nil).
+					Maybe()
+			}
+
+			ev, err := svc.TerminationEvent(ctx, wlID)
+			require.NoError(t, err)
+
+			if tt.expected == nil {
+				require.Nil(t, ev)
+				return
+			}
+
+			require.NotEmpty(t, ev.ID)
+			ev.ID = ""
+			ev.Timestamp = ev.Timestamp.UTC()
+
+			require.Equal(t, tt.expected, ev)
+		})
+	}
+}
+
 func TestWorkloadMetadata(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -456,14 +845,14 @@ func TestWorkloadMetadata(t *testing.T) {
 					Password: "pass",
 				}
 				mockCRIService = mock.NewMockCriService(t)
-				svc            = workload.NewService(logger, workload.Config{}, mockCRIService, regAuth)
+				svc            = workload.NewService(logger, workload.Config{}, mockCRIService, regAuth, nil)
 			)
 
 			mockCRIService.EXPECT().
 				ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
 					Filter: &runtimev1.PodSandboxFilter{
 						LabelSelector: map[string]string{
-							workload.LabelWorkloadID: wlID,
+							workloadenv.LabelWorkloadID: wlID,
 						},
 					},
 				}).
@@ -502,3 +891,114 @@ func podLogDir(ins *instancev1alpha1.Instance) string {
 		ins.Owner.Id,
 	)
 }
+
+func TestInstancePorts(t *testing.T) {
+	var (
+		ctx            = context.Background()
+		logger         = slog.New(slog.DiscardHandler)
+		mockCRIService = mock.NewMockCriService(t)
+		svc            = workload.NewService(logger, workload.Config{}, mockCRIService, cri.RegistryAuth{}, nil)
+	)
+
+	mockCRIService.EXPECT().
+		ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
+			Filter: &runtimev1.PodSandboxFilter{
+				LabelSelector: map[string]string{
+					workloadenv.LabelWorkloadType: "instance",
+				},
+			},
+		}).
+		Return(&runtimev1.ListPodSandboxResponse{
+			Items: []*runtimev1.PodSandbox{
+				{
+					Id: "pod-1",
+					Labels: map[string]string{
+						workloadenv.LabelWorkloadID:   "ins-1",
+						workloadenv.LabelWorkloadPort: "30001",
+					},
+				},
+				{
+					// pods without a valid port are skipped
+					Id: "pod-2",
+					Labels: map[string]string{
+						workloadenv.LabelWorkloadID:   "ins-2",
+						workloadenv.LabelWorkloadPort: "abc",
+					},
+				},
+			},
+		}, nil)
+
+	ports, err := svc.InstancePorts(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint16{"ins-1": 30001}, ports)
+}
+
+func TestListWorkloads(t *testing.T) {
+	var (
+		ctx            = context.Background()
+		logger         = slog.New(slog.DiscardHandler)
+		mockCRIService = mock.NewMockCriService(t)
+		svc            = workload.NewService(logger, workload.Config{}, mockCRIService, cri.RegistryAuth{}, nil)
+	)
+
+	mockCRIService.EXPECT().
+		ListPodSandbox(ctx, &runtimev1.ListPodSandboxRequest{
+			Filter: &runtimev1.PodSandboxFilter{
+				LabelSelector: map[string]string{
+					workloadenv.LabelWorkloadType: "instance",
+					workloadenv.LabelFlavorID:     "flavor",
+				},
+			},
+		}).
+		Return(&runtimev1.ListPodSandboxResponse{
+			Items: []*runtimev1.PodSandbox{
+				{
+					Id:        "pod-1",
+					State:     runtimev1.PodSandboxState_SANDBOX_READY,
+					CreatedAt: 1000,
+					Labels: map[string]string{
+						workloadenv.LabelSchemaVersion:   workloadenv.LabelSchemaV1,
+						workloadenv.LabelWorkloadID:      "ins-1",
+						workloadenv.LabelWorkloadType:    "instance",
+						workloadenv.LabelChunkID:         "chunk",
+						workloadenv.LabelFlavorID:        "flavor",
+						workloadenv.LabelFlavorVersionID: "fv",
+						workloadenv.LabelWorkloadPort:    "30001",
+					},
+				},
+				{
+					// pods with labels not conforming to the schema are skipped
+					Id: "pod-2",
+					Labels: map[string]string{
+						workloadenv.LabelSchemaVersion: "v2",
+						workloadenv.LabelWorkloadID:    "ins-2",
+						workloadenv.LabelWorkloadType:  "instance",
+					},
+				},
+			},
+		}, nil)
+
+	workloads, err := svc.ListWorkloads(ctx, workloadenv.Selector{
+		WorkloadType: workloadenv.WorkloadTypeInstance,
+		FlavorID:     "flavor",
+	})
+	require.NoError(t, err)
+
+	expected := []workload.Info{
+		{
+			PodID: "pod-1",
+			Labels: workloadenv.Labels{
+				WorkloadID:      "ins-1",
+				WorkloadType:    "instance",
+				ChunkID:         "chunk",
+				FlavorID:        "flavor",
+				FlavorVersionID: "fv",
+				WorkloadPort:    30001,
+			},
+			Ready:     true,
+			CreatedAt: time.Unix(0, 1000),
+		},
+	}
+
+	require.Equal(t, expected, workloads)
+}
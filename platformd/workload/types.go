@@ -19,8 +19,12 @@
 package workload
 
 import (
+	"fmt"
+	"time"
+
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
 	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/internal/workloadenv"
 )
 
 type Workload struct {
@@ -39,6 +43,20 @@ type Workload struct {
 	MemoryLimitBytes uint64
 }
 
+// ImageRefs returns the base and checkpoint image of the given flavor version.
+func ImageRefs(registryEndpoint string, flavorVersionID string) (string, string) {
+	baseURL := fmt.Sprintf("%s/%s", registryEndpoint, flavorVersionID)
+	return baseURL + ":base", baseURL + ":checkpoint"
+}
+
+// Info describes a workload present on the node.
+type Info struct {
+	PodID     string
+	Labels    workloadenv.Labels
+	Ready     bool
+	CreatedAt time.Time
+}
+
 type Metadata struct {
 	ID            string
 	Chunk         resource.Chunk
@@ -0,0 +1,211 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package e2e
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/proto"
+)
+
+// controlPlane is a minimal control plane platformd syncs with. it only
+// implements the rpcs required to discover instances and receive status
+// reports, everything else is answered with codes.Unimplemented, which
+// platformd only logs.
+type controlPlane struct {
+	instancev1alpha1.UnimplementedInstanceServiceServer
+
+	// Addr is the address the instance api is served on.
+	Addr string
+
+	mu        sync.Mutex
+	instances map[string]*instancev1alpha1.Instance
+	reports   map[string][]*instancev1alpha1.InstanceStatusReport
+}
+
+// runControlPlane starts the control plane. platformd always connects
+// using tls, so the api is served with a self-signed certificate.
+func runControlPlane(t *testing.T) *controlPlane {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	cp := &controlPlane{
+		Addr:      lis.Addr().String(),
+		instances: make(map[string]*instancev1alpha1.Instance),
+		reports:   make(map[string][]*instancev1alpha1.InstanceStatusReport),
+	}
+
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{selfSignedCert(t)},
+	})))
+
+	instancev1alpha1.RegisterInstanceServiceServer(server, cp)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return cp
+}
+
+// Schedule adds the instance in state PENDING, so it is
+// created by platformd the next time it discovers instances.
+func (c *controlPlane) Schedule(ins *instancev1alpha1.Instance) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ins = proto.Clone(ins).(*instancev1alpha1.Instance)
+	ins.State = instancev1alpha1.InstanceState_PENDING
+	c.instances[ins.GetId()] = ins
+}
+
+// Delete marks the instance as DELETING, so its workload is removed.
+func (c *controlPlane) Delete(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if ins, ok := c.instances[id]; ok {
+		ins.State = instancev1alpha1.InstanceState_DELETING
+	}
+}
+
+// WaitForReport waits until platformd reported the given state for the
+// instance and returns the report. the test fails after the timeout.
+func (c *controlPlane) WaitForReport(
+	t *testing.T,
+	id string,
+	state instancev1alpha1.InstanceState,
+	timeout time.Duration,
+) *instancev1alpha1.InstanceStatusReport {
+	var ret *instancev1alpha1.InstanceStatusReport
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for _, r := range c.reports[id] {
+			if r.GetState() == state {
+				ret = r
+				return true
+			}
+		}
+		return false
+	}, timeout, time.Second, "instance %s has not been reported as %s", id, state)
+	return ret
+}
+
+// Events returns all events reported for the instance.
+func (c *controlPlane) Events(id string) []*instancev1alpha1.InstanceEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var ret []*instancev1alpha1.InstanceEvent
+	for _, r := range c.reports[id] {
+		ret = append(ret, r.GetEvents()...)
+	}
+	return ret
+}
+
+func (c *controlPlane) DiscoverInstances(
+	_ context.Context,
+	_ *instancev1alpha1.DiscoverInstanceRequest,
+) (*instancev1alpha1.DiscoverInstanceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ret := make([]*instancev1alpha1.Instance, 0, len(c.instances))
+	for _, ins := range c.instances {
+		ret = append(ret, proto.Clone(ins).(*instancev1alpha1.Instance))
+	}
+
+	return &instancev1alpha1.DiscoverInstanceResponse{
+		Instances: ret,
+		Full:      true,
+	}, nil
+}
+
+// ReceiveInstanceStatusReports records the reports and applies the reported
+// states to the instances, like the real control plane does.
+func (c *controlPlane) ReceiveInstanceStatusReports(
+	_ context.Context,
+	req *instancev1alpha1.ReceiveInstanceStatusReportsRequest,
+) (*instancev1alpha1.ReceiveInstanceStatusReportsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, r := range req.GetReports() {
+		id := r.GetInstanceId()
+		c.reports[id] = append(c.reports[id], r)
+
+		ins, ok := c.instances[id]
+		if !ok {
+			continue
+		}
+
+		switch r.GetState() {
+		case instancev1alpha1.InstanceState_RUNNING:
+			ins.State = instancev1alpha1.InstanceState_RUNNING
+			ins.Port = r.GetPort()
+		case instancev1alpha1.InstanceState_DELETED, instancev1alpha1.InstanceState_CREATION_FAILED:
+			delete(c.instances, id)
+		}
+	}
+
+	return &instancev1alpha1.ReceiveInstanceStatusReportsResponse{}, nil
+}
+
+func selfSignedCert(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			CommonName: "e2e.explorer.chunks.cloud",
+		},
+		NotBefore:   time.Now().Add(-time.Minute),
+		NotAfter:    time.Now().Add(time.Hour),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
@@ -0,0 +1,415 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package e2e contains tests that run against real infrastructure.
+//
+// The platformd tests run platformd in-process against cri-o, the netglue
+// cni and a minimal control plane, so they have to be executed as root on a
+// host that has been provisioned using provision.sh. Tests are skipped if
+// E2E_WORKLOAD_IMAGE is not set, which is the path to the image archive
+// built from testdata/Dockerfile.
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	checkpointv1alpha1 "github.com/spacechunks/explorer/api/platformd/checkpoint/v1alpha1"
+	"github.com/spacechunks/explorer/internal/workloadenv"
+	"github.com/spacechunks/explorer/platformd"
+	"github.com/spacechunks/explorer/platformd/cri"
+	"github.com/spacechunks/explorer/platformd/system"
+	"github.com/spacechunks/explorer/platformd/workload"
+	"github.com/spacechunks/explorer/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	runtimev1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+const (
+	minPort = 30000
+	maxPort = 30100
+
+	// has to match the platformdListenSock of the netglue cni config.
+	mgmtSock = "/run/platformd/platformd.sock"
+
+	// has to match the user and group created by provision.sh.
+	platformdUID = 9012
+	platformdGID = 9012
+
+	checkpointListenAddr = "127.0.0.1:3011"
+	workloadNamespace    = "explorer-e2e"
+	reportTimeout        = 2 * time.Minute
+)
+
+type env struct {
+	criSock         string
+	hostIface       string
+	registry        string
+	envoyImage      string
+	corednsImage    string
+	servermonImage  string
+	workloadArchive string
+}
+
+func loadEnv(t *testing.T) env {
+	e := env{
+		criSock:         getenv("E2E_CRI_SOCK", "unix:///var/run/crio/crio.sock"),
+		hostIface:       getenv("E2E_HOST_IFACE", "eth0"),
+		registry:        getenv("E2E_REGISTRY", "localhost:5000"),
+		envoyImage:      getenv("E2E_ENVOY_IMAGE", "docker.io/envoyproxy/envoy:v1.31.4"),
+		corednsImage:    getenv("E2E_COREDNS_IMAGE", "docker.io/coredns/coredns:1.12.0"),
+		servermonImage:  getenv("E2E_SERVERMON_IMAGE", "ghcr.io/spacechunks/explorer/servermon:dev"),
+		workloadArchive: os.Getenv("E2E_WORKLOAD_IMAGE"),
+	}
+
+	if e.workloadArchive == "" {
+		t.Skip("E2E_WORKLOAD_IMAGE not set, skipping e2e tests")
+	}
+
+	if os.Geteuid() != 0 {
+		t.Skip("e2e tests have to be run as root")
+	}
+
+	return e
+}
+
+func getenv(key string, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func TestPlatformd(t *testing.T) {
+	var (
+		e   = loadEnv(t)
+		cp  = runControlPlane(t)
+		cri = criClient(t, e)
+	)
+
+	runPlatformd(t, e, cp)
+
+	t.Run("workload lifecycle", func(t *testing.T) {
+		ins := newInstance(t, e)
+		cp.Schedule(ins)
+
+		report := cp.WaitForReport(t, ins.GetId(), instancev1alpha1.InstanceState_RUNNING, reportTimeout)
+		require.GreaterOrEqual(t, report.GetPort(), uint32(minPort))
+		require.LessOrEqual(t, report.GetPort(), uint32(maxPort))
+
+		// host ports are only forwarded for traffic arriving on the host
+		// interface, so the pod is reached directly using its ip instead.
+		requireReachable(t, podIP(t, cri, ins.GetId()))
+
+		cp.Delete(ins.GetId())
+		cp.WaitForReport(t, ins.GetId(), instancev1alpha1.InstanceState_DELETED, reportTimeout)
+
+		require.Empty(t, pods(t, cri, ins.GetId()))
+	})
+
+	t.Run("ports are allocated uniquely", func(t *testing.T) {
+		var (
+			first  = newInstance(t, e)
+			second = newInstance(t, e)
+		)
+
+		cp.Schedule(first)
+		cp.Schedule(second)
+
+		var (
+			firstReport  = cp.WaitForReport(t, first.GetId(), instancev1alpha1.InstanceState_RUNNING, reportTimeout)
+			secondReport = cp.WaitForReport(t, second.GetId(), instancev1alpha1.InstanceState_RUNNING, reportTimeout)
+		)
+
+		require.NotEqual(t, firstReport.GetPort(), secondReport.GetPort())
+
+		cp.Delete(first.GetId())
+		cp.Delete(second.GetId())
+
+		cp.WaitForReport(t, first.GetId(), instancev1alpha1.InstanceState_DELETED, reportTimeout)
+		cp.WaitForReport(t, second.GetId(), instancev1alpha1.InstanceState_DELETED, reportTimeout)
+	})
+
+	t.Run("stopped workloads are removed", func(t *testing.T) {
+		ins := newInstance(t, e)
+		cp.Schedule(ins)
+
+		cp.WaitForReport(t, ins.GetId(), instancev1alpha1.InstanceState_RUNNING, reportTimeout)
+
+		ctrs, err := cri.ListContainers(context.Background(), &runtimev1.ListContainersRequest{
+			Filter: &runtimev1.ContainerFilter{
+				LabelSelector: map[string]string{
+					workloadenv.LabelWorkloadID: ins.GetId(),
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// the flavor version has restart policy NEVER, so the
+		// workload is removed once the server stopped running.
+		for _, c := range ctrs.GetContainers() {
+			if c.GetMetadata().GetName() != ins.GetFlavorVersion().GetId() {
+				continue
+			}
+			_, err := cri.StopContainer(context.Background(), &runtimev1.StopContainerRequest{
+				ContainerId: c.GetId(),
+			})
+			require.NoError(t, err)
+		}
+
+		cp.WaitForReport(t, ins.GetId(), instancev1alpha1.InstanceState_DELETED, reportTimeout)
+		require.NotEmpty(t, cp.Events(ins.GetId()))
+	})
+
+	t.Run("checkpoint", func(t *testing.T) {
+		if _, err := exec.LookPath("criu"); err != nil {
+			t.Skip("criu not installed, skipping checkpoint test")
+		}
+
+		var (
+			ctx    = context.Background()
+			fvID   = test.NewUUIDv7(t)
+			client = checkpointClient(t)
+		)
+
+		base, checkpoint := workload.ImageRefs(registryEndpoint(e), fvID)
+		pushImage(t, e.workloadArchive, base)
+
+		resp, err := client.CreateCheckpoint(ctx, &checkpointv1alpha1.CreateCheckpointRequest{
+			BaseImageUrl: base,
+		})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			st, err := client.CheckpointStatus(ctx, &checkpointv1alpha1.CheckpointStatusRequest{
+				CheckpointId: resp.GetCheckpointId(),
+			})
+			require.NoError(t, err)
+
+			state := st.GetStatus().GetState()
+			require.Contains(t, []checkpointv1alpha1.CheckpointState{
+				checkpointv1alpha1.CheckpointState_RUNNING,
+				checkpointv1alpha1.CheckpointState_COMPLETED,
+			}, state, st.GetStatus().GetMessage())
+
+			return state == checkpointv1alpha1.CheckpointState_COMPLETED
+		}, 3*time.Minute, time.Second)
+
+		ref, err := name.ParseReference(checkpoint)
+		require.NoError(t, err)
+
+		_, err = remote.Head(ref)
+		require.NoError(t, err)
+	})
+}
+
+// runPlatformd starts platformd and stops it once the test completed.
+func runPlatformd(t *testing.T, e env, cp *controlPlane) {
+	mgmtSockURL, err := url.Parse(mgmtSock)
+	require.NoError(t, err)
+
+	cfg := platformd.Config{
+		ManagementServerListenSock:  mgmtSockURL,
+		CRIListenSock:               e.criSock,
+		EnvoyImage:                  e.envoyImage,
+		EnvoyAdminAddr:              "127.0.0.1:5555",
+		CoreDNSImage:                e.corednsImage,
+		GetsockoptCGroup:            "/sys/fs/cgroup",
+		DNSServer:                   "127.0.0.1:1053",
+		DNSConfigDir:                "/etc/platformd/dns",
+		DNSBaseConfig:               "/etc/platformd/dns.conf",
+		DNSZone:                     "svc.chunks.internal",
+		HostIface:                   e.hostIface,
+		MaxAttempts:                 3,
+		SyncInterval:                200 * time.Millisecond,
+		FullResyncInterval:          time.Minute,
+		SystemWorkloadCheckInterval: 10 * time.Second,
+		TrafficCollectInterval:      10 * time.Second,
+		NodeID:                      test.NewUUIDv7(t),
+		MinPort:                     minPort,
+		MaxPort:                     maxPort,
+		WorkloadNamespace:           workloadNamespace,
+		RegistryEndpoint:            registryEndpoint(e),
+		RegistryMirrorConfigPath:    cri.DefaultMirrorConfigPath,
+		ControlPlaneEndpoint:        cp.Addr,
+		UpdateCheckInterval:         time.Minute,
+		ConditionCheckInterval:      time.Minute,
+		NodeCapacity: system.CapacityConfig{
+			CPUOvercommitRatio:    1,
+			MemoryOvercommitRatio: 1,
+		},
+		ManagementSocketUID: platformdUID,
+		ManagementSocketGID: platformdGID,
+	}
+
+	cfg.CheckpointConfig.CPUPeriod = 100000
+	cfg.CheckpointConfig.CPUQuota = 200000
+	cfg.CheckpointConfig.MemoryLimitBytes = 512 << 20
+	cfg.CheckpointConfig.CheckpointFileDir = t.TempDir()
+	cfg.CheckpointConfig.CheckpointTimeoutSeconds = 60
+	cfg.CheckpointConfig.ListenAddr = checkpointListenAddr
+	cfg.CheckpointConfig.StatusRetentionPeriod = time.Minute
+	cfg.CheckpointConfig.ContainerReadyTimeout = time.Minute
+	cfg.CheckpointConfig.RestoreVerifyTimeout = time.Minute
+
+	cfg.WorkloadConfig.ServerMonImage = e.servermonImage
+	cfg.WorkloadConfig.ParamsDir = t.TempDir()
+	cfg.WorkloadConfig.Probe = workload.ProbeConfig{
+		Type:               workload.ProbeTypeTCP,
+		Port:               workload.DefaultProbePort,
+		Timeout:            5 * time.Second,
+		StartupGracePeriod: 30 * time.Second,
+		FailureThreshold:   3,
+	}
+
+	var (
+		logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		server = platformd.NewServer(logger)
+		errCh  = make(chan error, 1)
+	)
+
+	go func() {
+		errCh <- server.Run(context.Background(), cfg)
+	}()
+
+	t.Cleanup(func() {
+		server.Stop()
+		require.NoError(t, <-errCh)
+	})
+
+	test.WaitServerReady(t, "tcp", checkpointListenAddr, time.Minute)
+}
+
+// newInstance pushes the workload image as base and checkpoint image of a
+// new flavor version and returns an instance running this flavor version.
+func newInstance(t *testing.T, e env) *instancev1alpha1.Instance {
+	fvID := test.NewUUIDv7(t)
+
+	base, checkpoint := workload.ImageRefs(registryEndpoint(e), fvID)
+	pushImage(t, e.workloadArchive, base)
+	pushImage(t, e.workloadArchive, checkpoint)
+
+	return &instancev1alpha1.Instance{
+		Id: test.NewUUIDv7(t),
+		Chunk: &chunkv1alpha1.Chunk{
+			Id:   test.NewUUIDv7(t),
+			Name: "e2e",
+		},
+		Flavor: &chunkv1alpha1.Flavor{
+			Id:   test.NewUUIDv7(t),
+			Name: "e2e",
+		},
+		FlavorVersion: &chunkv1alpha1.FlavorVersion{
+			Id:            fvID,
+			RestartPolicy: chunkv1alpha1.RestartPolicy_NEVER,
+		},
+	}
+}
+
+func registryEndpoint(e env) string {
+	return e.registry + "/e2e"
+}
+
+func pushImage(t *testing.T, archive string, dst string) {
+	img, err := tarball.ImageFromPath(archive, nil)
+	require.NoError(t, err)
+
+	ref, err := name.ParseReference(dst)
+	require.NoError(t, err)
+
+	require.NoError(t, remote.Write(ref, img))
+}
+
+func criClient(t *testing.T, e env) runtimev1.RuntimeServiceClient {
+	conn, err := grpc.NewClient(e.criSock, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return runtimev1.NewRuntimeServiceClient(conn)
+}
+
+func checkpointClient(t *testing.T) checkpointv1alpha1.CheckpointServiceClient {
+	conn, err := grpc.NewClient(checkpointListenAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return checkpointv1alpha1.NewCheckpointServiceClient(conn)
+}
+
+func pods(t *testing.T, cri runtimev1.RuntimeServiceClient, workloadID string) []*runtimev1.PodSandbox {
+	resp, err := cri.ListPodSandbox(context.Background(), &runtimev1.ListPodSandboxRequest{
+		Filter: &runtimev1.PodSandboxFilter{
+			LabelSelector: map[string]string{
+				workloadenv.LabelWorkloadID: workloadID,
+			},
+		},
+	})
+	require.NoError(t, err)
+	return resp.GetItems()
+}
+
+func podIP(t *testing.T, cri runtimev1.RuntimeServiceClient, workloadID string) string {
+	items := pods(t, cri, workloadID)
+	require.Len(t, items, 1)
+
+	resp, err := cri.PodSandboxStatus(context.Background(), &runtimev1.PodSandboxStatusRequest{
+		PodSandboxId: items[0].GetId(),
+	})
+	require.NoError(t, err)
+
+	ip := resp.GetStatus().GetNetwork().GetIp()
+	require.NotEmpty(t, ip, "pod has no ip assigned")
+
+	return ip
+}
+
+// requireReachable makes sure the server of the workload image
+// can be reached and responds with the expected payload.
+func requireReachable(t *testing.T, ip string) {
+	addr := net.JoinHostPort(ip, fmt.Sprint(workload.DefaultProbePort))
+
+	require.Eventually(t, func() bool {
+		conn, err := net.DialTimeout("tcp", addr, time.Second)
+		if err != nil {
+			return false
+		}
+		defer conn.Close()
+
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+
+		buf := make([]byte, 4)
+		n, _ := conn.Read(buf)
+		return string(buf[:n]) == "pong"
+	}, time.Minute, time.Second, "workload not reachable on %s", addr)
+}
@@ -0,0 +1,83 @@
+#!/bin/bash -e
+
+# Explorer Platform, a platform for hosting and discovering Minecraft servers.
+# Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+#
+# This program is free software: you can redistribute it and/or modify
+# it under the terms of the GNU Affero General Public License as published by
+# the Free Software Foundation, either version 3 of the License, or
+# (at your option) any later version.
+#
+# This program is distributed in the hope that it will be useful,
+# but WITHOUT ANY WARRANTY; without even the implied warranty of
+# MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+# GNU Affero General Public License for more details.
+#
+# You should have received a copy of the GNU Affero General Public License
+# along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+# provisions a host for running the platformd e2e tests.
+# has to be executed from the root of the repository.
+
+export DEBIAN_FRONTEND=noninteractive
+
+apt-get update
+apt-get install -y gnupg2 git curl
+
+# platformd
+mkdir -p /etc/platformd/dns
+cp dev/platformd/config.json /etc/platformd/config.json
+cp dev/platformd/Corefile /etc/platformd/dns.conf
+cp dev/platformd/envoy-xds.yaml /etc/platformd/proxy.conf
+getent group platformd || groupadd platformd -g 9012
+id platformd || useradd platformd -u 9012 -g 9012
+
+# cni plugins
+git clone https://github.com/containernetworking/plugins.git /tmp/plugins
+(cd /tmp/plugins && ./build_linux.sh)
+mkdir -p /opt/cni
+cp -r /tmp/plugins/bin /opt/cni
+
+# install cni
+go build -o /opt/cni/bin/netglue ./cmd/netglue
+mkdir -p /etc/cni/net.d/
+cp dev/cni/00-netglue.conflist /etc/cni/net.d/00-netglue.conflist
+
+# crio
+MAJOR_VERSION=1.32
+mkdir -p /etc/apt/keyrings
+curl -fsSL https://pkgs.k8s.io/addons:/cri-o:/stable:/v$MAJOR_VERSION/deb/Release.key | gpg --dearmor --yes -o /etc/apt/keyrings/cri-o-apt-keyring.gpg
+echo "deb [signed-by=/etc/apt/keyrings/cri-o-apt-keyring.gpg] https://pkgs.k8s.io/addons:/cri-o:/stable:/v$MAJOR_VERSION/deb/ /" | tee /etc/apt/sources.list.d/cri-o.list
+apt-get update
+apt-get install -y cri-o
+sysctl -w net.ipv4.ip_forward=1
+cp dev/platformd/crio.conf /etc/crio/crio.conf.d/99-nodedev.conf
+mkdir -p /etc/containers
+cp /etc/crio/policy.json /etc/containers/policy.json
+
+# local registry the workload images are pushed to
+docker run -d --restart always --name e2e-registry -p 5000:5000 registry:2
+mkdir -p /etc/containers/registries.conf.d
+cat > /etc/containers/registries.conf.d/50-e2e.conf <<EOR
+[[registry]]
+location = "localhost:5000"
+insecure = true
+EOR
+
+# criu
+apt-get install -y libprotobuf-dev libprotobuf-c-dev protobuf-c-compiler protobuf-compiler python3-protobuf \
+  libnl-3-dev libnet-dev libcap-dev iproute2 libnftables-dev libgnutls28-dev pkg-config libbsd-dev \
+  build-essential asciidoctor uuid-dev
+curl -fsSL https://github.com/checkpoint-restore/criu/archive/refs/tags/v4.2.tar.gz | tar -C /tmp -xz
+make -C /tmp/criu-4.2 install
+
+# crun (version shipped with crio does not support checkpointing)
+# its also important to install after crio, otherwise we do not
+# have checkpointing enabled.
+apt-get install -y make gcc pkgconf libtool libsystemd-dev libseccomp-dev libyajl-dev \
+   go-md2man autoconf python3 automake
+git clone https://github.com/containers/crun.git /tmp/crun
+(cd /tmp/crun && ./autogen.sh && ./configure && make)
+mv /tmp/crun/crun /usr/bin/crun
+
+systemctl restart crio.service
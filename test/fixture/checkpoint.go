@@ -54,32 +54,34 @@ func RunCheckpointAPIFixtures(t *testing.T, registryUser string, registryPass st
 
 		rtClient  = runtimev1.NewRuntimeServiceClient(criConn)
 		imgClient = runtimev1.NewImageServiceClient(criConn)
+	)
 
-		svc = checkpoint.NewService(
-			logger,
-			checkpoint.Config{
-				CPUPeriod:                100,
-				CPUQuota:                 200,
-				MemoryLimitBytes:         300,
-				CheckpointFileDir:        t.TempDir(),
-				CheckpointTimeoutSeconds: 60,
-				//RegistryUser:             registryUser,
-				//RegistryPass:             registryPass,
-				ListenAddr:            CheckpointAPIAddr,
-				StatusRetentionPeriod: 10 * time.Second,
-				ContainerReadyTimeout: 5 * time.Second,
-			},
-			cri.NewService(logger.With("component", "cri-service"), rtClient, imgClient),
-			image.NewService(logger.With("component", "image-service"), registryUser, registryPass, t.TempDir()),
-			status.NewMemStore(),
-			func(url string) (remotecommand.Executor, error) {
-				return &test.RemoteCmdExecutor{}, nil
-			},
-			workload.NewPortAllocator(5000, 6000),
-			&noopSockHandler{},
-		)
-		checkServ = checkpoint.NewServer(svc)
+	svc, err := checkpoint.NewService(
+		logger,
+		checkpoint.Config{
+			CPUPeriod:                100,
+			CPUQuota:                 200,
+			MemoryLimitBytes:         300,
+			CheckpointFileDir:        t.TempDir(),
+			CheckpointTimeoutSeconds: 60,
+			//RegistryUser:             registryUser,
+			//RegistryPass:             registryPass,
+			ListenAddr:            CheckpointAPIAddr,
+			StatusRetentionPeriod: 10 * time.Second,
+			ContainerReadyTimeout: 5 * time.Second,
+		},
+		cri.NewService(logger.With("component", "cri-service"), rtClient, imgClient),
+		image.NewService(logger.With("component", "image-service"), registryUser, registryPass, t.TempDir()),
+		status.NewMemStore(),
+		func(url string) (remotecommand.Executor, error) {
+			return &test.RemoteCmdExecutor{}, nil
+		},
+		workload.NewPortAllocator(5000, 6000),
+		&noopSockHandler{},
 	)
+	require.NoError(t, err)
+
+	checkServ := checkpoint.NewServer(svc)
 
 	checkpointv1alpha1.RegisterCheckpointServiceServer(grpcServ, checkServ)
 
@@ -180,6 +180,11 @@ func Instance(mod ...func(i *resource.Instance)) resource.Instance {
 		CreatedAt:     time.Date(2025, 2, 23, 13, 12, 15, 0, time.UTC),
 		UpdatedAt:     time.Date(2025, 2, 28, 10, 26, 0, 0, time.UTC),
 		OrderedBy:     "orderer",
+		Params: resource.InstanceParams{
+			WorldSeed: "8678942899319966093",
+			GameMode:  resource.GameModeSurvival,
+			Custom:    []byte(`{"map":"castle"}`),
+		},
 	}
 
 	for _, fn := range mod {
@@ -32,12 +32,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/v4/jwa"
 	"github.com/lestrrat-go/jwx/v4/jwt"
 	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	instancev1beta1 "github.com/spacechunks/explorer/api/instance/v1beta1"
 	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
 	"github.com/spacechunks/explorer/controlplane"
+	"github.com/spacechunks/explorer/controlplane/instance"
 	"github.com/spacechunks/explorer/internal/resource"
 	"github.com/spacechunks/explorer/test"
 	"github.com/stretchr/testify/require"
@@ -51,8 +54,19 @@ const (
 	BaseImage               = "base-image:latest"
 	OAuthClientID           = "public-functest-client"
 	APITokenIssuer          = "functest-issuer.explorer.chunks.cloud"
+	AdminUserID             = "019a88ab-3240-7f61-b560-cc755d5572a1"
 	ResourcePackTemplateKey = "explorer/pack_template.zip"
 	MaxChangeSetTarballSize = 1024
+	FlavorMaxFileCount      = 100
+	PatchMaxFiles           = 5
+	PatchMaxSize            = 1024
+	AssetMaxSize            = 1024
+	MediaMaxSize            = 1024
+	MediaMaxCount           = 2
+	AutoscalingMaxInstances = 3
+	SnapshotMaxSize         = 1024 * 1024
+	FlavorMaxSize           = 10 * 1024 * 1024
+	FlavorMaxFileSize       = 1024 * 1024
 )
 
 type ControlPlane struct {
@@ -161,7 +175,9 @@ func (c ControlPlane) Run(t *testing.T, opts ...ControlPlaneRunOption) {
 				OAuthIssuerURL:                c.IDP.Endpoint,
 				APITokenIssuer:                APITokenIssuer,
 				APITokenExpiry:                5 * time.Second,
+				ImpersonationMaxDuration:      time.Minute,
 				APITokenSigningKey:            keyPem.String(),
+				AdminUserIDs:                  []string{AdminUserID},
 				ThumbnailMaxSizeKB:            100,
 				ResourcePackBuildInterval:     2 * time.Second,
 				ResourcePackWorkingDir:        t.TempDir(),
@@ -172,7 +188,23 @@ func (c ControlPlane) Run(t *testing.T, opts ...ControlPlaneRunOption) {
 				ResourcePackModelDir:          "assets/spc/models/item/test",
 				ResourcePackTextureDir:        "assets/spc/textures/item/test",
 				ChangeSetTarballMaxSizeBytes:  MaxChangeSetTarballSize,
+				PatchMaxFiles:                 PatchMaxFiles,
+				PatchMaxSizeBytes:             PatchMaxSize,
+				AssetMaxSizeBytes:             AssetMaxSize,
+				MediaMaxSizeBytes:             MediaMaxSize,
+				MediaMaxCount:                 MediaMaxCount,
+				SnapshotMaxSizeBytes:          SnapshotMaxSize,
+				FlavorMaxFileCount:            FlavorMaxFileCount,
+				FlavorMaxSizeBytes:            FlavorMaxSize,
+				FlavorMaxFileSizeBytes:        FlavorMaxFileSize,
 				ArchiveInterval:               5 * time.Second,
+				RetentionInterval:             5 * time.Second,
+				InstanceCleanupInterval:       5 * time.Second,
+				InstanceCleanupPolicies:       instance.DefaultCleanupPolicies,
+				InstanceLogRetention:          time.Hour,
+				AutoscalerInterval:            5 * time.Second,
+				AutoscalerDemandWindow:        time.Minute,
+				AutoscalingMaxInstances:       AutoscalingMaxInstances,
 				DisableTracing:                true,
 			})
 	)
@@ -192,6 +224,7 @@ func (c ControlPlane) Run(t *testing.T, opts ...ControlPlaneRunOption) {
 // and creates a grpc metadata pair that will be added to the passed context.
 func (c ControlPlane) AddUserAPIKey(t *testing.T, ctx *context.Context, u resource.User) {
 	apiKey, err := jwt.NewBuilder().
+		JwtID(uuid.NewString()).
 		IssuedAt(time.Now()).
 		Issuer(APITokenIssuer).
 		Audience([]string{APITokenIssuer}).
@@ -225,6 +258,15 @@ func (c ControlPlane) InstanceClient(t *testing.T) instancev1alpha1.InstanceServ
 	return instancev1alpha1.NewInstanceServiceClient(conn)
 }
 
+func (c ControlPlane) InstanceBetaClient(t *testing.T) instancev1beta1.InstanceServiceClient {
+	conn, err := grpc.NewClient(
+		ControlPlaneAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	return instancev1beta1.NewInstanceServiceClient(conn)
+}
+
 func (c ControlPlane) UserClient(t *testing.T) userv1alpha1.UserServiceClient {
 	conn, err := grpc.NewClient(
 		ControlPlaneAddr,
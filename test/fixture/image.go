@@ -47,10 +47,11 @@ func RunRegistry(t *testing.T) string {
 			Image:        "registry:2",
 			ExposedPorts: []string{"5000/tcp"},
 			Env: map[string]string{
-				"REGISTRY_STORAGE":             "inmemory",
-				"REGISTRY_AUTH":                "htpasswd",
-				"REGISTRY_AUTH_HTPASSWD_REALM": "RegistryRealm",
-				"REGISTRY_AUTH_HTPASSWD_PATH":  "/auth/htpasswd",
+				"REGISTRY_STORAGE":                "inmemory",
+				"REGISTRY_STORAGE_DELETE_ENABLED": "true",
+				"REGISTRY_AUTH":                   "htpasswd",
+				"REGISTRY_AUTH_HTPASSWD_REALM":    "RegistryRealm",
+				"REGISTRY_AUTH_HTPASSWD_PATH":     "/auth/htpasswd",
 			},
 			HostConfigModifier: func(cfg *container.HostConfig) {
 				cfg.AutoRemove = true
@@ -37,8 +37,11 @@ import (
 	"github.com/spacechunks/explorer/controlplane"
 	"github.com/spacechunks/explorer/controlplane/blob"
 	cperrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/notification"
 	"github.com/spacechunks/explorer/controlplane/postgres"
 	"github.com/spacechunks/explorer/controlplane/worker"
+	"github.com/spacechunks/explorer/internal/chunkname"
 	"github.com/spacechunks/explorer/internal/image"
 	"github.com/spacechunks/explorer/internal/resource"
 	"github.com/spacechunks/explorer/test"
@@ -60,7 +63,7 @@ func NewPostgres() *Postgres {
 	}
 }
 
-func (p *Postgres) Run(t *testing.T, ctx context.Context) {
+func (p *Postgres) Run(t testing.TB, ctx context.Context) {
 	var (
 		user = os.Getenv("FUNCTESTS_POSTGRES_USER")
 		pass = os.Getenv("FUNCTESTS_POSTGRES_PASS")
@@ -137,12 +140,17 @@ func (p *Postgres) CreateRiverClient(t *testing.T) {
 		p.logger,
 		p.DB,
 		imgService,
-		blob.NewS3Store(Bucket, s3client, s3.NewPresignClient(s3client)),
+		blob.NewS3Store(Bucket, s3client, s3.NewPresignClient(s3client), blob.S3Config{}),
 		p.Pool,
 		p.DB,
 		p.DB,
 		1*time.Second,
 		5*time.Second,
+		5*time.Second,
+		instance.DefaultCleanupPolicies,
+		5*time.Second,
+		time.Hour,
+		"",
 		worker.CreateImageWorkerConfig{
 			ImagePlatform: runtime.GOOS + "/" + runtime.GOARCH,
 		},
@@ -153,8 +161,15 @@ func (p *Postgres) CreateRiverClient(t *testing.T) {
 		worker.CreateResourcePackWorkerConfig{
 			PackTemplateKey: "blabla",
 		},
+		worker.ImportChunkWorkerConfig{},
+		worker.PartitionWorkerConfig{},
+		p.DB,
 		p.DB,
 		p.DB,
+		p.DB,
+		notification.LogSender{Logger: p.logger},
+		nil,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -212,6 +227,16 @@ VALUES
 	)
 	require.NoError(t, err)
 
+	// chunks created by tests frequently share the same name,
+	// so only the first one claims the slug.
+	_, err = p.Pool.Exec(
+		ctx,
+		`INSERT INTO chunk_slugs (slug, chunk_id) VALUES ($1, $2) ON CONFLICT (slug) DO NOTHING`,
+		chunkname.Slug(c.Name),
+		c.ID,
+	)
+	require.NoError(t, err)
+
 	if opts.WithFlavors {
 		flavors := make([]resource.Flavor, 0, len(c.Flavors))
 		for i := range c.Flavors {
@@ -291,7 +316,11 @@ func (p *Postgres) CreateInstance(t *testing.T, nodeID string, ins *resource.Ins
 
 func (p *Postgres) CreateFlavorVersion(t *testing.T, flavorID string, version *resource.FlavorVersion) {
 	ctx := context.Background()
-	created, err := p.DB.CreateFlavorVersion(ctx, flavorID, *version, "")
+
+	prev, err := p.DB.LatestFlavorVersion(ctx, flavorID)
+	require.NoError(t, err)
+
+	created, err := p.DB.CreateFlavorVersion(ctx, flavorID, *version, prev.ID)
 	require.NoError(t, err)
 	*version = created
 }
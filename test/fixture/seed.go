@@ -0,0 +1,429 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package fixture
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/chunkname"
+	"github.com/spacechunks/explorer/internal/file"
+	"github.com/spacechunks/explorer/internal/resource"
+)
+
+// DemoTime is the point in time all demo data has been created at.
+// it is fixed, so seeding always produces the same rows.
+var DemoTime = time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+
+// Demo is a set of demo data, that can be inserted into a fresh database
+// using [SeedDemo].
+type Demo struct {
+	Users     []resource.User
+	Nodes     []node.Node
+	Chunks    []resource.Chunk
+	Instances []DemoInstance
+}
+
+// DemoInstance is an instance placed on the node with NodeID.
+type DemoInstance struct {
+	resource.Instance
+	NodeID string
+}
+
+// DemoID returns a uuid v7 derived from name. the timestamp of the
+// uuid is at, so ids sort in the order the demo data has been created.
+func DemoID(name string, at time.Time) string {
+	var (
+		sum = sha256.Sum256([]byte(name))
+		id  uuid.UUID
+		ts  [8]byte
+	)
+
+	binary.BigEndian.PutUint64(ts[:], uint64(at.UnixMilli()))
+	copy(id[:6], ts[2:])
+	copy(id[6:], sum[:10])
+
+	id[6] = 0x70 | (id[6] & 0x0f) // version 7
+	id[8] = 0x80 | (id[8] & 0x3f) // rfc 4122 variant
+
+	return id.String()
+}
+
+// DemoData returns users owning chunks with several flavors and versions,
+// as well as nodes running instances of these chunks in various states.
+// calling it always returns the same data.
+func DemoData() Demo {
+	var (
+		alice = demoUser("alice", 0)
+		bob   = demoUser("bob", 1)
+		carol = demoUser("carol", 2)
+		nodes = []node.Node{demoNode("demo-node-1", 1, 20), demoNode("demo-node-2", 2, 10)}
+	)
+
+	chunks := []resource.Chunk{
+		demoChunk(alice, "Skyblock Classic", "start on a tiny island and expand it", []string{"skyblock", "survival"},
+			[]demoFlavor{
+				{name: "classic", versions: []string{"v1", "v2", "v3"}},
+				{name: "hardcore", versions: []string{"v1"}},
+			}),
+		demoChunk(alice, "Castle Siege", "defend your castle against waves of attackers", []string{"pvp", "teams"},
+			[]demoFlavor{
+				{name: "small", versions: []string{"v1", "v2"}},
+				{name: "large", versions: []string{"v1", "v2"}, failed: true},
+			}),
+		demoChunk(bob, "Parkour Paradise", "over a hundred parkour maps of rising difficulty", []string{"parkour"},
+			[]demoFlavor{
+				{name: "easy", versions: []string{"v1", "v2"}},
+				{name: "expert", versions: []string{"v1"}, pending: true},
+			}),
+		demoChunk(carol, "Creative Plots", "build whatever you want on your own plot", []string{"creative", "build"},
+			[]demoFlavor{
+				{name: "default", versions: []string{"v1"}},
+			}),
+	}
+
+	states := []resource.InstanceState{
+		resource.InstanceStateRunning,
+		resource.InstanceStateRunning,
+		resource.InstanceStateCreating,
+		resource.InstanceStatePending,
+		resource.InstanceCreationFailed,
+		resource.InstanceStateDeleted,
+	}
+
+	var (
+		instances []DemoInstance
+		users     = []resource.User{alice, bob, carol}
+		i         int
+	)
+
+	for _, c := range chunks {
+		for _, f := range c.Flavors {
+			v := f.Versions[0]
+			if v.BuildStatus != resource.FlavorVersionBuildStatusCompleted {
+				continue
+			}
+
+			for n := 0; n < 2; n++ {
+				var (
+					createdAt = DemoTime.Add(time.Duration(24+i) * time.Hour)
+					state     = states[i%len(states)]
+					ins       = Instance(func(tmp *resource.Instance) {
+						tmp.ID = DemoID(fmt.Sprintf("instance-%d", i), createdAt)
+						tmp.Chunk = c
+						tmp.Flavor = f
+						tmp.FlavorVersion = v
+						tmp.Owner = users[i%len(users)]
+						tmp.State = state
+						tmp.Port = nil
+						tmp.CreatedAt = createdAt
+						tmp.UpdatedAt = createdAt.Add(time.Minute)
+						tmp.OrderedBy = "seed"
+					})
+				)
+
+				if state == resource.InstanceStateRunning {
+					ins.Port = new(uint16(30000 + i))
+				}
+
+				instances = append(instances, DemoInstance{
+					Instance: ins,
+					NodeID:   nodes[i%len(nodes)].ID,
+				})
+				i++
+			}
+		}
+	}
+
+	return Demo{
+		Users:     users,
+		Nodes:     nodes,
+		Chunks:    chunks,
+		Instances: instances,
+	}
+}
+
+// SeedDemo inserts the demo data. rows that already exist are left
+// untouched, so seeding the same database twice is fine.
+func SeedDemo(ctx context.Context, pool *pgxpool.Pool, d Demo) error {
+	return pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(
+			ctx,
+			`INSERT INTO minecraft_versions (version, image_url) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+			MinecraftVersion,
+			"demo.local/paper:"+MinecraftVersion,
+		); err != nil {
+			return fmt.Errorf("insert minecraft version: %w", err)
+		}
+
+		for _, u := range d.Users {
+			if _, err := tx.Exec(
+				ctx,
+				`INSERT INTO users (id, nickname, email, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`,
+				u.ID, u.Nickname, u.Email, u.CreatedAt, u.UpdatedAt,
+			); err != nil {
+				return fmt.Errorf("insert user %s: %w", u.Nickname, err)
+			}
+		}
+
+		for _, n := range d.Nodes {
+			if _, err := tx.Exec(
+				ctx,
+				`INSERT INTO nodes (id, name, address, checkpoint_api_endpoint, slots, created_at, last_seen_at)
+				VALUES ($1, $2, $3, $4, $5, $6, now()) ON CONFLICT DO NOTHING`,
+				n.ID, n.Name, n.Addr, n.CheckpointAPIEndpoint, n.Slots, DemoTime,
+			); err != nil {
+				return fmt.Errorf("insert node %s: %w", n.Name, err)
+			}
+		}
+
+		for _, c := range d.Chunks {
+			if err := seedChunk(ctx, tx, c); err != nil {
+				return fmt.Errorf("insert chunk %s: %w", c.Name, err)
+			}
+		}
+
+		for _, ins := range d.Instances {
+			var port *int32
+			if ins.Port != nil {
+				port = new(int32(*ins.Port))
+			}
+
+			if _, err := tx.Exec(
+				ctx,
+				`INSERT INTO instances
+					(id, flavor_version_id, node_id, port, state, created_at, updated_at, state_changed_at,
+					 owner_id, ordered_by, world_seed, game_mode, custom_params)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $8, $9, $10, $11, $12) ON CONFLICT DO NOTHING`,
+				ins.ID,
+				ins.FlavorVersion.ID,
+				ins.NodeID,
+				port,
+				string(ins.State),
+				ins.CreatedAt,
+				ins.UpdatedAt,
+				ins.Owner.ID,
+				ins.OrderedBy,
+				ins.Params.WorldSeed,
+				string(ins.Params.GameMode),
+				ins.Params.Custom,
+			); err != nil {
+				return fmt.Errorf("insert instance %s: %w", ins.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func seedChunk(ctx context.Context, tx pgx.Tx, c resource.Chunk) error {
+	if _, err := tx.Exec(
+		ctx,
+		`INSERT INTO chunks (id, name, description, tags, created_at, updated_at, owner_id, thumbnail_updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $5) ON CONFLICT DO NOTHING`,
+		c.ID, c.Name, c.Description, c.Tags, c.CreatedAt, c.UpdatedAt, c.Owner.ID,
+	); err != nil {
+		return fmt.Errorf("insert chunk: %w", err)
+	}
+
+	if _, err := tx.Exec(
+		ctx,
+		`INSERT INTO chunk_slugs (slug, chunk_id, created_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`,
+		chunkname.Slug(c.Name), c.ID, c.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("insert slug: %w", err)
+	}
+
+	for _, f := range c.Flavors {
+		if _, err := tx.Exec(
+			ctx,
+			`INSERT INTO flavors (id, chunk_id, name, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`,
+			f.ID, c.ID, f.Name, f.CreatedAt, f.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("insert flavor %s: %w", f.Name, err)
+		}
+
+		// versions are ordered from latest to oldest
+		for i := len(f.Versions) - 1; i >= 0; i-- {
+			var (
+				v    = f.Versions[i]
+				prev *string
+			)
+
+			if i < len(f.Versions)-1 {
+				prev = &f.Versions[i+1].ID
+			}
+
+			tag, err := tx.Exec(
+				ctx,
+				`INSERT INTO flavor_versions
+					(id, flavor_id, hash, build_status, version, files_uploaded, prev_version_id,
+					 created_at, minecraft_version, min_players, max_players)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT DO NOTHING`,
+				v.ID,
+				f.ID,
+				v.Hash,
+				string(v.BuildStatus),
+				v.Version,
+				v.FilesUploaded,
+				prev,
+				v.CreatedAt,
+				v.MinecraftVersion,
+				v.MinPlayers,
+				v.MaxPlayers,
+			)
+			if err != nil {
+				return fmt.Errorf("insert flavor version %s: %w", v.Version, err)
+			}
+
+			// files have no primary key, so they are only
+			// inserted together with their flavor version.
+			if tag.RowsAffected() == 0 {
+				continue
+			}
+
+			for _, h := range v.FileHashes {
+				if _, err := tx.Exec(
+					ctx,
+					`INSERT INTO flavor_version_files (flavor_version_id, file_hash, file_path, created_at)
+					VALUES ($1, $2, $3, $4)`,
+					v.ID, h.Hash, h.Path, v.CreatedAt,
+				); err != nil {
+					return fmt.Errorf("insert file %s: %w", h.Path, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+type demoFlavor struct {
+	name     string
+	versions []string // oldest first
+	// failed and pending set the build status of the latest version.
+	failed  bool
+	pending bool
+}
+
+func demoUser(nickname string, n int) resource.User {
+	createdAt := DemoTime.Add(time.Duration(n) * time.Minute)
+	return User(func(u *resource.User) {
+		u.ID = DemoID("user-"+nickname, createdAt)
+		u.Nickname = nickname
+		u.Email = nickname + "@demo.explorer.local"
+		u.CreatedAt = createdAt
+		u.UpdatedAt = createdAt
+	})
+}
+
+func demoNode(name string, n int, slots int) node.Node {
+	return node.Node{
+		ID:                    DemoID("node-"+name, DemoTime),
+		Name:                  name,
+		Addr:                  netip.AddrFrom4([4]byte{198, 51, 100, byte(10 + n)}),
+		CheckpointAPIEndpoint: netip.AddrPortFrom(netip.AddrFrom4([4]byte{198, 51, 100, byte(10 + n)}), 3012),
+		Slots:                 slots,
+	}
+}
+
+func demoChunk(owner resource.User, name string, desc string, tags []string, flavors []demoFlavor) resource.Chunk {
+	createdAt := owner.CreatedAt.Add(time.Hour)
+
+	c := Chunk(func(c *resource.Chunk) {
+		c.ID = DemoID("chunk-"+name, createdAt)
+		c.Name = name
+		c.Description = desc
+		c.Tags = tags
+		c.Owner = owner
+		c.CreatedAt = createdAt
+		c.UpdatedAt = createdAt
+		c.Thumbnail = resource.Thumbnail{}
+		c.Flavors = nil
+	})
+
+	// the latest flavor has to be first
+	for i := len(flavors) - 1; i >= 0; i-- {
+		var (
+			df       = flavors[i]
+			key      = name + "/" + df.name
+			flavorAt = createdAt.Add(time.Duration(i+1) * time.Minute)
+			versions = make([]resource.FlavorVersion, 0, len(df.versions))
+		)
+
+		// the latest version has to be first
+		for j := len(df.versions) - 1; j >= 0; j-- {
+			var (
+				ver       = df.versions[j]
+				versionAt = flavorAt.Add(time.Duration(j+1) * time.Hour)
+				status    = resource.FlavorVersionBuildStatusCompleted
+			)
+
+			if j == len(df.versions)-1 {
+				switch {
+				case df.failed:
+					status = resource.FlavorVersionBuildStatusBuildImageFailed
+				case df.pending:
+					status = resource.FlavorVersionBuildStatusPending
+				}
+			}
+
+			versions = append(versions, FlavorVersion(func(v *resource.FlavorVersion) {
+				v.ID = DemoID("version-"+key+"/"+ver, versionAt)
+				v.Version = ver
+				v.FileHashes = []file.Hash{
+					{Path: "server.properties", Hash: demoHash("server.properties", key, ver)},
+					{Path: "plugins/" + df.name + "/config.yml", Hash: demoHash("config.yml", key, ver)},
+				}
+				v.BuildStatus = status
+				v.FilesUploaded = status != resource.FlavorVersionBuildStatusPending
+				v.CreatedAt = versionAt
+			}))
+		}
+
+		c.Flavors = append(c.Flavors, Flavor(func(f *resource.Flavor) {
+			f.ID = DemoID("flavor-"+key, flavorAt)
+			f.Name = df.name
+			f.Versions = versions
+			f.CreatedAt = flavorAt
+			f.UpdatedAt = flavorAt
+		}))
+	}
+
+	return c
+}
+
+// demoHash returns a file hash, which can only be 16 chars long.
+func demoHash(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))[:16]
+}
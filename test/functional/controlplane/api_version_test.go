@@ -0,0 +1,98 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controlplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	instancev1beta1 "github.com/spacechunks/explorer/api/instance/v1beta1"
+	"github.com/spacechunks/explorer/internal/deprecation"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestInstanceAPIVersions(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cp  = fixture.NewControlPlane(t)
+		ins = fixture.Instance()
+	)
+
+	cp.Run(t)
+	cp.Postgres.InsertNode(t)
+	cp.Postgres.CreateInstance(t, fixture.Node().ID, &ins)
+
+	_, err := cp.Postgres.Pool.Exec(ctx, `UPDATE instances SET port = $1 WHERE id = $2`, ins.Port, ins.ID)
+	require.NoError(t, err)
+
+	cp.AddUserAPIKey(t, &ctx, ins.Owner)
+
+	var alphaHeader metadata.MD
+	_, err = cp.InstanceClient(t).GetInstance(
+		ctx,
+		&instancev1alpha1.GetInstanceRequest{Id: ins.ID},
+		grpc.Header(&alphaHeader),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/instance.v1alpha1.InstanceService/GetInstance is deprecated and will be removed in a future release, " +
+			"use /instance.v1beta1.InstanceService/GetInstance instead",
+	}, alphaHeader.Get(deprecation.Header))
+
+	var betaHeader metadata.MD
+	resp, err := cp.InstanceBetaClient(t).GetInstance(
+		ctx,
+		&instancev1beta1.GetInstanceRequest{Id: ins.ID},
+		grpc.Header(&betaHeader),
+	)
+	require.NoError(t, err)
+	require.Empty(t, betaHeader.Get(deprecation.Header))
+
+	expected := &instancev1beta1.Instance{
+		Id:              ins.ID,
+		ChunkId:         ins.Chunk.ID,
+		ChunkName:       ins.Chunk.Name,
+		FlavorId:        ins.Flavor.ID,
+		FlavorName:      ins.Flavor.Name,
+		FlavorVersionId: ins.FlavorVersion.ID,
+		FlavorVersion:   ins.FlavorVersion.Version,
+		Endpoint: &instancev1beta1.Endpoint{
+			Ip:   ins.Address.String(),
+			Port: uint32(*ins.Port),
+		},
+		State:     instancev1beta1.InstanceState_INSTANCE_STATE_PENDING,
+		OwnerId:   ins.Owner.ID,
+		OrderedBy: ins.OrderedBy,
+		Params: &instancev1beta1.InstanceParams{
+			WorldSeed: ins.Params.WorldSeed,
+			GameMode:  instancev1beta1.GameMode_GAME_MODE_SURVIVAL,
+			Custom:    string(ins.Params.Custom),
+		},
+	}
+
+	if d := cmp.Diff(expected, resp.GetInstance(), protocmp.Transform()); d != "" {
+		t.Fatalf("diff (-want +got):\n%s", d)
+	}
+}
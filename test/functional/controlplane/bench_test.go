@@ -0,0 +1,244 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/loadgen"
+	"github.com/spacechunks/explorer/pkg/client"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/spacechunks/explorer/testkit"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// the benchmarks run against a control plane in dev mode that is backed
+// by a real postgres database. use make bench-controlplane to run them.
+
+func BenchmarkNodeSync(b *testing.B) {
+	var (
+		pg = fixture.NewPostgres()
+		cp = runBenchControlPlane(b, pg)
+	)
+
+	for _, n := range []int{10, 100} {
+		b.Run(fmt.Sprintf("nodes=%d", n), func(b *testing.B) {
+			var (
+				rec   = loadgen.NewRecorder()
+				nodes = registerNodes(b, pg, cp, n, rec)
+			)
+
+			// schedule some instances, so discovery and
+			// status reports have to process actual data.
+			runInstances(b, cp, fmt.Sprintf("sync-%d", n), 5*n)
+			require.NoError(b, syncAll(b.Context(), nodes))
+
+			// only measure the steady state, where
+			// nodes discover changes incrementally.
+			rec.Reset()
+
+			for b.Loop() {
+				require.NoError(b, syncAll(b.Context(), nodes))
+			}
+
+			reportOps(b, rec.Report(b.Elapsed()), loadgen.OpDiscoverInstances)
+		})
+	}
+}
+
+func BenchmarkPublishAndRun(b *testing.B) {
+	var (
+		pg = fixture.NewPostgres()
+		cp = runBenchControlPlane(b, pg)
+	)
+
+	for _, m := range []int{1, 4} {
+		b.Run(fmt.Sprintf("users=%d", m), func(b *testing.B) {
+			var (
+				rec        = loadgen.NewRecorder()
+				nodes      = registerNodes(b, pg, cp, 10, rec)
+				publishers = make([]*loadgen.Publisher, 0, m)
+			)
+
+			for i := range m {
+				c := userClient(b, cp, fmt.Sprintf("publish-%d-%d", m, i))
+				publishers = append(publishers, loadgen.NewPublisher(c, rec, testkit.MinecraftVersions[0], b.TempDir()))
+			}
+
+			// users wait for their instances to be running,
+			// so nodes have to sync in the background.
+			syncInBackground(b, nodes)
+
+			for b.Loop() {
+				var (
+					wg   sync.WaitGroup
+					mu   sync.Mutex
+					errs error
+				)
+
+				for _, p := range publishers {
+					wg.Go(func() {
+						err := p.Iterate(b.Context())
+						mu.Lock()
+						defer mu.Unlock()
+						errs = errors.Join(errs, err)
+					})
+				}
+
+				wg.Wait()
+				require.NoError(b, errs)
+			}
+
+			reportOps(
+				b,
+				rec.Report(b.Elapsed()),
+				loadgen.OpCreateChunk,
+				loadgen.OpPublishFlavor,
+				loadgen.OpRunInstance,
+				loadgen.OpStopInstance,
+			)
+		})
+	}
+}
+
+func runBenchControlPlane(b *testing.B, pg *fixture.Postgres) *testkit.ControlPlane {
+	pg.Run(b, context.Background())
+	return testkit.RunControlPlane(b, testkit.WithPostgresDSN(pg.ConnString))
+}
+
+func registerNodes(
+	b *testing.B,
+	pg *fixture.Postgres,
+	cp *testkit.ControlPlane,
+	n int,
+	rec *loadgen.Recorder,
+) []*loadgen.Node {
+	keys, err := loadgen.RegisterNodes(b.Context(), pg.Pool, n, 100)
+	require.NoError(b, err)
+
+	nodes := make([]*loadgen.Node, 0, n)
+	for _, k := range keys {
+		nodes = append(nodes, loadgen.NewNode(k, cp.InstanceClient(), rec))
+	}
+
+	return nodes
+}
+
+func runInstances(b *testing.B, cp *testkit.ControlPlane, nickname string, n int) {
+	var (
+		c = userClient(b, cp, nickname)
+		p = loadgen.NewPublisher(c, loadgen.NewRecorder(), testkit.MinecraftVersions[0], b.TempDir())
+	)
+
+	_, version, err := p.Publish(b.Context())
+	require.NoError(b, err)
+
+	for range n {
+		_, err := c.Instance.RunFlavorVersion(b.Context(), &instancev1alpha1.RunFlavorVersionRequest{
+			FlavorVersionId: version.GetId(),
+		})
+		require.NoError(b, err)
+	}
+}
+
+func userClient(b *testing.B, cp *testkit.ControlPlane, nickname string) *client.Client {
+	u := cp.CreateUser(b, nickname, nickname+"@example.com")
+
+	c, err := client.New(
+		cp.Addr,
+		client.WithToken(cp.APIToken(b, u)),
+		client.WithTransportCredentials(insecure.NewCredentials()),
+		client.WithPollInterval(10*time.Millisecond),
+	)
+	require.NoError(b, err)
+
+	b.Cleanup(func() {
+		_ = c.Close()
+	})
+
+	return c
+}
+
+func syncAll(ctx context.Context, nodes []*loadgen.Node) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs error
+	)
+
+	for _, n := range nodes {
+		wg.Go(func() {
+			err := n.Sync(ctx)
+			mu.Lock()
+			defer mu.Unlock()
+			errs = errors.Join(errs, err)
+		})
+	}
+
+	wg.Wait()
+	return errs
+}
+
+func syncInBackground(b *testing.B, nodes []*loadgen.Node) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		wg          sync.WaitGroup
+	)
+
+	for _, n := range nodes {
+		wg.Go(func() {
+			ticker := time.NewTicker(50 * time.Millisecond)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					_ = n.Sync(ctx)
+				}
+			}
+		})
+	}
+
+	b.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+}
+
+// reportOps adds the latency and throughput of the given operations
+// to the benchmark results, so they can be compared using benchstat.
+func reportOps(b *testing.B, rep loadgen.Report, ops ...string) {
+	for _, name := range ops {
+		op, ok := rep.Op(name)
+		require.True(b, ok, "operation %s has not been recorded", name)
+		require.Zero(b, op.Errors, "operation %s failed", name)
+
+		b.ReportMetric(float64(op.P50)/float64(time.Millisecond), name+"-p50-ms")
+		b.ReportMetric(float64(op.P99)/float64(time.Millisecond), name+"-p99-ms")
+		b.ReportMetric(op.Throughput, name+"/s")
+	}
+}
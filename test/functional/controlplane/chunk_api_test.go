@@ -1321,6 +1321,108 @@ func TestBuildFlavorVersion(t *testing.T) {
 	}
 }
 
+func TestBuildFlavorVersionRejectsFlavorVersionExceedingLimits(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cp  = fixture.NewControlPlane(t)
+		c   = fixture.Chunk(func(tmp *resource.Chunk) {
+			hashes := make([]file.Hash, 0, fixture.FlavorMaxFileCount+1)
+			for i := range fixture.FlavorMaxFileCount + 1 {
+				hashes = append(hashes, file.Hash{
+					Path: fmt.Sprintf("plugins/%d.jar", i),
+					Hash: fmt.Sprintf("%016d", i),
+				})
+			}
+			tmp.Flavors[0].Versions[0].FileHashes = hashes
+		})
+	)
+
+	cp.Run(t)
+
+	cp.Postgres.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	cp.AddUserAPIKey(t, &ctx, c.Owner)
+	client := cp.ChunkClient(t)
+
+	_, err := client.BuildFlavorVersion(ctx, &chunkv1alpha1.BuildFlavorVersionRequest{
+		FlavorVersionId: c.Flavors[0].Versions[0].ID,
+	})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+
+	details := st.Details()
+	require.Len(t, details, 1)
+
+	failure, ok := details[0].(*errdetails.PreconditionFailure)
+	require.True(t, ok)
+	require.Len(t, failure.GetViolations(), 1)
+	require.Equal(t, "BUILD_LIMITS", failure.GetViolations()[0].GetType())
+}
+
+func TestPrecheckFlavorVersion(t *testing.T) {
+	tests := []struct {
+		name                 string
+		fileCount            uint64
+		totalSizeBytes       uint64
+		largestFileSizeBytes uint64
+		violations           int
+	}{
+		{
+			name:           "within limits",
+			fileCount:      fixture.FlavorMaxFileCount,
+			totalSizeBytes: fixture.FlavorMaxSize,
+		},
+		{
+			name:           "too many files",
+			fileCount:      fixture.FlavorMaxFileCount + 1,
+			totalSizeBytes: 1,
+			violations:     1,
+		},
+		{
+			name:           "too many files and too big",
+			fileCount:      fixture.FlavorMaxFileCount + 1,
+			totalSizeBytes: fixture.FlavorMaxSize + 1,
+			violations:     2,
+		},
+		{
+			name:                 "file too big",
+			fileCount:            1,
+			totalSizeBytes:       fixture.FlavorMaxFileSize + 1,
+			largestFileSizeBytes: fixture.FlavorMaxFileSize + 1,
+			violations:           1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx = context.Background()
+				cp  = fixture.NewControlPlane(t)
+				c   = fixture.Chunk()
+			)
+
+			cp.Run(t)
+
+			cp.Postgres.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+			cp.AddUserAPIKey(t, &ctx, c.Owner)
+			client := cp.ChunkClient(t)
+
+			resp, err := client.PrecheckFlavorVersion(ctx, &chunkv1alpha1.PrecheckFlavorVersionRequest{
+				FileCount:            tt.fileCount,
+				TotalSizeBytes:       tt.totalSizeBytes,
+				LargestFileSizeBytes: tt.largestFileSizeBytes,
+			})
+			require.NoError(t, err)
+			require.Len(t, resp.GetViolations(), tt.violations)
+			require.Equal(t, uint64(fixture.FlavorMaxFileCount), resp.GetLimits().GetMaxFileCount())
+			require.Equal(t, uint64(fixture.FlavorMaxSize), resp.GetLimits().GetMaxTotalSizeBytes())
+			require.Equal(t, uint64(fixture.FlavorMaxFileSize), resp.GetLimits().GetMaxFileSizeBytes())
+		})
+	}
+}
+
 func TestGetUploadURLWorks(t *testing.T) {
 	var (
 		ctx = context.Background()
@@ -1350,6 +1452,91 @@ func TestGetUploadURLWorks(t *testing.T) {
 	require.Equal(t, "blabla", u.Query().Get("X-Amz-Checksum-Sha256"))
 }
 
+func TestPatchFlavorVersion(t *testing.T) {
+	c := fixture.Chunk()
+	version := c.Flavors[0].Versions[0]
+
+	tests := []struct {
+		name  string
+		files []*chunkv1alpha1.PatchFile
+		err   error
+	}{
+		{
+			name: "returns upload urls",
+			files: []*chunkv1alpha1.PatchFile{
+				{
+					Path:        version.FileHashes[0].Path,
+					Hash:        version.FileHashes[0].Hash,
+					ContentHash: "blabla",
+					SizeBytes:   10,
+				},
+			},
+		},
+		{
+			name: "file not part of flavor version",
+			files: []*chunkv1alpha1.PatchFile{
+				{
+					Path:        version.FileHashes[0].Path,
+					Hash:        "0000000000000000",
+					ContentHash: "blabla",
+					SizeBytes:   10,
+				},
+			},
+			err: apierrs.ErrPatchFileMismatch.GRPCStatus().Err(),
+		},
+		{
+			name: "patch too big",
+			files: []*chunkv1alpha1.PatchFile{
+				{
+					Path:        version.FileHashes[0].Path,
+					Hash:        version.FileHashes[0].Hash,
+					ContentHash: "blabla",
+					SizeBytes:   fixture.PatchMaxSize + 1,
+				},
+			},
+			err: apierrs.ErrPatchTooBig.GRPCStatus().Err(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx = context.Background()
+				cp  = fixture.NewControlPlane(t)
+				c   = c
+			)
+
+			fixture.RunFakeS3(t)
+			cp.Run(t)
+
+			cp.Postgres.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+			cp.AddUserAPIKey(t, &ctx, c.Owner)
+			client := cp.ChunkClient(t)
+
+			resp, err := client.PatchFlavorVersion(ctx, &chunkv1alpha1.PatchFlavorVersionRequest{
+				FlavorVersionId: version.ID,
+				Files:           tt.files,
+			})
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Uploads, 1)
+			require.Equal(t, version.FileHashes[0].Path, resp.Uploads[0].Path)
+
+			u, err := url.Parse(resp.Uploads[0].Url)
+			require.NoError(t, err)
+
+			require.Contains(t, u.Path, blob.PatchKey(version.ID, version.FileHashes[0].Hash))
+			require.Equal(t, "blabla", u.Query().Get("X-Amz-Checksum-Sha256"))
+		})
+	}
+}
+
 func TestGetUploadURLRenews(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -1513,6 +1700,32 @@ func TestGetSupportedMinecraftVersions(t *testing.T) {
 	}
 }
 
+func TestGetMinecraftVersion(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cp  = fixture.NewControlPlane(t)
+		u   = fixture.User()
+	)
+
+	cp.Run(t)
+	cp.Postgres.CreateUser(t, &u)
+
+	cp.AddUserAPIKey(t, &ctx, u)
+	client := cp.ChunkClient(t)
+
+	resp, err := client.GetMinecraftVersion(ctx, &chunkv1alpha1.GetMinecraftVersionRequest{
+		Version: fixture.MinecraftVersion,
+	})
+	require.NoError(t, err)
+	require.Equal(t, fixture.MinecraftVersion, resp.GetVersion().GetVersion())
+	require.True(t, strings.HasSuffix(resp.GetVersion().GetBaseImage(), "/base-image:latest"))
+
+	_, err = client.GetMinecraftVersion(ctx, &chunkv1alpha1.GetMinecraftVersionRequest{
+		Version: "1.0.0",
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
 func TestUserCannotCreateFlavorVersionForFlavorHeIsNotOwnerOf(t *testing.T) {
 	var (
 		ctx       = context.Background()
@@ -29,7 +29,6 @@ import (
 	"github.com/google/go-cmp/cmp"
 	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
 	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
-	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
 	"github.com/spacechunks/explorer/internal/ptr"
 	"github.com/spacechunks/explorer/internal/resource"
@@ -37,6 +36,10 @@ import (
 	"github.com/spacechunks/explorer/test"
 	"github.com/spacechunks/explorer/test/fixture"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -253,15 +256,11 @@ func TestRunFlavorVersion(t *testing.T) {
 					MinPlayers:       v.MinPlayers,
 					MaxPlayers:       v.MaxPlayers,
 				},
-				Owner: &userv1alpha1.User{
-					Id:        c.Owner.ID,
-					Nickname:  c.Owner.Nickname,
-					CreatedAt: timestamppb.New(c.Owner.CreatedAt),
-					UpdatedAt: timestamppb.New(c.Owner.UpdatedAt),
-				},
+				Owner:     codec.UserToTransport(c.Owner),
 				Ip:        fixture.Node().Addr.String(),
 				State:     instancev1alpha1.InstanceState_PENDING,
 				OrderedBy: "orderer",
+				Params:    codec.InstanceParamsToTransport(fixture.Instance().Params),
 			}
 
 			if tt.chunkID == "" {
@@ -278,6 +277,7 @@ func TestRunFlavorVersion(t *testing.T) {
 			resp, err := client.RunFlavorVersion(ctx, &instancev1alpha1.RunFlavorVersionRequest{
 				FlavorVersionId: tt.flavorVersionID,
 				OrderedBy:       "orderer",
+				Params:          codec.InstanceParamsToTransport(fixture.Instance().Params),
 			})
 
 			if tt.err != nil {
@@ -422,6 +422,107 @@ func TestDiscoverInstances(t *testing.T) {
 	}
 }
 
+func TestDiscoverInstancesReturnsDeltas(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cp  = fixture.NewControlPlane(t)
+		ins = fixture.Instance()
+	)
+
+	cp.Run(t)
+
+	cp.Postgres.InsertNode(t)
+	cp.Postgres.CreateInstance(t, fixture.Node().ID, &ins)
+
+	client := cp.InstanceClient(t)
+
+	full, err := client.DiscoverInstances(ctx, &instancev1alpha1.DiscoverInstanceRequest{
+		NodeKey: fixture.Node().ID,
+	})
+	require.NoError(t, err)
+	require.True(t, full.GetFull())
+	require.Len(t, full.GetInstances(), 1)
+
+	unchanged, err := client.DiscoverInstances(ctx, &instancev1alpha1.DiscoverInstanceRequest{
+		NodeKey:  fixture.Node().ID,
+		Revision: full.GetRevision(),
+	})
+	require.NoError(t, err)
+	require.False(t, unchanged.GetFull())
+	require.Empty(t, unchanged.GetInstances())
+	require.Equal(t, full.GetRevision(), unchanged.GetRevision())
+
+	_, err = client.ReceiveInstanceStatusReports(ctx, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+		Reports: []*instancev1alpha1.InstanceStatusReport{
+			{
+				InstanceId: ins.ID,
+				Port:       420,
+				State:      instancev1alpha1.InstanceState_RUNNING,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	delta, err := client.DiscoverInstances(ctx, &instancev1alpha1.DiscoverInstanceRequest{
+		NodeKey:  fixture.Node().ID,
+		Revision: full.GetRevision(),
+	})
+	require.NoError(t, err)
+	require.False(t, delta.GetFull())
+	require.Len(t, delta.GetInstances(), 1)
+	require.Equal(t, instancev1alpha1.InstanceState_RUNNING, delta.GetInstances()[0].GetState())
+	require.NotEqual(t, full.GetRevision(), delta.GetRevision())
+
+	// unknown revision tokens result in a full resync
+	resync, err := client.DiscoverInstances(ctx, &instancev1alpha1.DiscoverInstanceRequest{
+		NodeKey:  fixture.Node().ID,
+		Revision: "garbage",
+	})
+	require.NoError(t, err)
+	require.True(t, resync.GetFull())
+	require.Len(t, resync.GetInstances(), 1)
+}
+
+func TestStopInstance(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cp  = fixture.NewControlPlane(t)
+		ins = fixture.Instance()
+	)
+
+	cp.Run(t)
+
+	cp.Postgres.InsertNode(t)
+	cp.Postgres.CreateInstance(t, fixture.Node().ID, &ins)
+
+	cp.AddUserAPIKey(t, &ctx, ins.Owner)
+	client := cp.InstanceClient(t)
+
+	_, err := client.StopInstance(ctx, &instancev1alpha1.StopInstanceRequest{
+		Id: ins.ID,
+	})
+	require.NoError(t, err)
+
+	// platformd has not picked up the change yet and
+	// reports the instance as running, which is ignored.
+	_, err = client.ReceiveInstanceStatusReports(ctx, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+		Reports: []*instancev1alpha1.InstanceStatusReport{
+			{
+				InstanceId: ins.ID,
+				Port:       420,
+				State:      instancev1alpha1.InstanceState_RUNNING,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := client.GetInstance(ctx, &instancev1alpha1.GetInstanceRequest{
+		Id: ins.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, instancev1alpha1.InstanceState_DELETING, resp.GetInstance().GetState())
+}
+
 func TestReceiveInstanceStatusReports(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -503,3 +604,43 @@ func TestReceiveInstanceStatusReports(t *testing.T) {
 		})
 	}
 }
+
+func TestReceiveInstanceStatusReportsBatches(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cp  = fixture.NewControlPlane(t)
+		ins = fixture.Instance()
+	)
+
+	cp.Run(t)
+
+	cp.Postgres.InsertNode(t)
+	cp.Postgres.CreateInstance(t, fixture.Node().ID, &ins)
+
+	cp.AddUserAPIKey(t, &ctx, ins.Owner)
+	client := cp.InstanceClient(t)
+
+	// compressed reports are accepted
+	_, err := client.ReceiveInstanceStatusReports(ctx, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+		Reports: []*instancev1alpha1.InstanceStatusReport{
+			codec.StatusReportToTransport(resource.InstanceStatusReport{
+				InstanceID: ins.ID,
+				State:      resource.InstanceCreationFailed,
+			}),
+		},
+	}, grpc.UseCompressor(gzip.Name))
+	require.NoError(t, err)
+
+	reports := make([]*instancev1alpha1.InstanceStatusReport, 1001)
+	for i := range reports {
+		reports[i] = &instancev1alpha1.InstanceStatusReport{
+			InstanceId: test.NewUUIDv7(t),
+			State:      instancev1alpha1.InstanceState_RUNNING,
+		}
+	}
+
+	_, err = client.ReceiveInstanceStatusReports(ctx, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+		Reports: reports,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
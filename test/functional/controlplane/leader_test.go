@@ -0,0 +1,84 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controlplane
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/leader"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnlyOneReplicaIsLeader(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		pg      = fixture.NewPostgres()
+		running atomic.Int32
+	)
+
+	pg.Run(t, ctx)
+
+	newElector := func(id string) *leader.Elector {
+		e, err := leader.NewElector(slog.New(slog.DiscardHandler), pg.Pool, leader.Config{
+			ReplicaID:     id,
+			LockID:        leader.DefaultLockID,
+			RetryInterval: 50 * time.Millisecond,
+		})
+		require.NoError(t, err)
+
+		e.Register("test", func(ctx context.Context) {
+			running.Add(1)
+			<-ctx.Done()
+			running.Add(-1)
+		})
+
+		return e
+	}
+
+	var (
+		first       = newElector("first")
+		second      = newElector("second")
+		firstCtx, c = context.WithCancel(ctx)
+		secondCtx   = t.Context()
+	)
+
+	go first.Run(firstCtx)
+
+	require.Eventually(t, first.IsLeader, 5*time.Second, 10*time.Millisecond)
+
+	go second.Run(secondCtx)
+
+	// second replica must not take over as long as the first one is alive
+	require.Never(t, second.IsLeader, 500*time.Millisecond, 50*time.Millisecond)
+	require.Equal(t, int32(1), running.Load())
+
+	// stopping the leader releases the lock, so the second replica takes over.
+	c()
+
+	require.Eventually(t, second.IsLeader, 5*time.Second, 10*time.Millisecond)
+	require.False(t, first.IsLeader())
+	require.Eventually(t, func() bool {
+		return running.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
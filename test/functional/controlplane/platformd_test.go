@@ -0,0 +1,89 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controlplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/spacechunks/explorer/testkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInstanceLifecycleWithFakePlatformd(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cp  = fixture.NewControlPlane(t)
+		c   = fixture.Chunk()
+	)
+
+	cp.Run(t)
+	cp.Postgres.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	var (
+		client = cp.InstanceClient(t)
+		pd     = testkit.RunPlatformd(t, client)
+	)
+
+	testkit.RegisterNode(t, cp.Postgres.Pool, pd, 10)
+
+	cp.AddUserAPIKey(t, &ctx, c.Owner)
+
+	resp, err := client.RunFlavorVersion(ctx, &instancev1alpha1.RunFlavorVersionRequest{
+		FlavorVersionId: c.Flavors[0].Versions[0].ID,
+		OrderedBy:       "orderer",
+	})
+	require.NoError(t, err)
+
+	insID := resp.GetInstance().GetId()
+
+	require.EventuallyWithT(t, func(ct *assert.CollectT) {
+		resp, err := client.GetInstance(ctx, &instancev1alpha1.GetInstanceRequest{
+			Id: insID,
+		})
+		require.NoError(ct, err)
+		require.Equal(ct, instancev1alpha1.InstanceState_RUNNING, resp.GetInstance().GetState())
+		require.Equal(ct, uint32(30000), resp.GetInstance().GetPort())
+	}, 10*time.Second, 100*time.Millisecond)
+
+	require.Len(t, pd.Workloads(), 1)
+	require.True(t, pd.OOMKill(insID))
+
+	// instances reported as deleted are removed by the control plane,
+	// but the events explaining why are kept.
+	require.EventuallyWithT(t, func(ct *assert.CollectT) {
+		_, err := client.GetInstance(ctx, &instancev1alpha1.GetInstanceRequest{
+			Id: insID,
+		})
+		require.Equal(ct, codes.NotFound, status.Code(err))
+	}, 10*time.Second, 100*time.Millisecond)
+
+	evResp, err := client.GetInstanceEvents(ctx, &instancev1alpha1.GetInstanceEventsRequest{
+		InstanceId: insID,
+	})
+	require.NoError(t, err)
+	require.Len(t, evResp.GetEvents(), 1)
+	require.Equal(t, instancev1alpha1.InstanceEventType_OOM_KILLED, evResp.GetEvents()[0].GetType())
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/spacechunks/explorer/test"
 	"github.com/spacechunks/explorer/test/fixture"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -172,3 +173,158 @@ func TestLoginUser(t *testing.T) {
 		})
 	}
 }
+
+func TestLogout(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cp  = fixture.NewControlPlane(t)
+		u   = fixture.User()
+	)
+
+	cp.Run(t)
+	cp.Postgres.CreateUser(t, &u)
+	cp.AddUserAPIKey(t, &ctx, u)
+
+	client := cp.UserClient(t)
+
+	_, err := client.GetNotificationPreferences(ctx, &userv1alpha1.GetNotificationPreferencesRequest{})
+	require.NoError(t, err)
+
+	_, err = client.Logout(ctx, &userv1alpha1.LogoutRequest{})
+	require.NoError(t, err)
+
+	_, err = client.GetNotificationPreferences(ctx, &userv1alpha1.GetNotificationPreferencesRequest{})
+	require.ErrorIs(t, err, apierrs.ErrInvalidToken.GRPCStatus().Err())
+}
+
+func TestRevokeUserTokens(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID func(resource.User) string
+		err     error
+	}{
+		{
+			name: "user revokes own tokens",
+			actorID: func(u resource.User) string {
+				return u.ID
+			},
+		},
+		{
+			name: "admin revokes tokens of other user",
+			actorID: func(resource.User) string {
+				return fixture.AdminUserID
+			},
+		},
+		{
+			name: "user cannot revoke tokens of other user",
+			actorID: func(resource.User) string {
+				return "019a88ab-3240-7f61-b560-cc755d5572a2"
+			},
+			err: apierrs.ErrPermissionDenied.GRPCStatus().Err(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx      = context.Background()
+				actorCtx = context.Background()
+				cp       = fixture.NewControlPlane(t)
+				u        = fixture.User()
+			)
+
+			cp.Run(t)
+			cp.Postgres.CreateUser(t, &u)
+			cp.AddUserAPIKey(t, &ctx, u)
+			cp.AddUserAPIKey(t, &actorCtx, resource.User{ID: tt.actorID(u)})
+
+			client := cp.UserClient(t)
+
+			_, err := client.RevokeUserTokens(actorCtx, &userv1alpha1.RevokeUserTokensRequest{
+				UserId: u.ID,
+			})
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			_, err = client.GetNotificationPreferences(ctx, &userv1alpha1.GetNotificationPreferencesRequest{})
+			require.ErrorIs(t, err, apierrs.ErrInvalidToken.GRPCStatus().Err())
+		})
+	}
+}
+
+func TestImpersonateUser(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		adminCtx = context.Background()
+		cp       = fixture.NewControlPlane(t)
+		u        = fixture.User()
+	)
+
+	cp.Run(t)
+	cp.Postgres.CreateUser(t, &u)
+	cp.AddUserAPIKey(t, &ctx, u)
+	cp.AddUserAPIKey(t, &adminCtx, resource.User{ID: fixture.AdminUserID})
+
+	client := cp.UserClient(t)
+
+	// users cannot impersonate other users
+	_, err := client.ImpersonateUser(ctx, &userv1alpha1.ImpersonateUserRequest{
+		UserId: fixture.AdminUserID,
+		Reason: "ticket 1337",
+	})
+	require.ErrorIs(t, err, apierrs.ErrPermissionDenied.GRPCStatus().Err())
+
+	_, err = client.ImpersonateUser(adminCtx, &userv1alpha1.ImpersonateUserRequest{
+		UserId:          u.ID,
+		Reason:          "ticket 1337",
+		DurationSeconds: 3600,
+	})
+	require.ErrorIs(t, err, apierrs.ErrImpersonationTooLong.GRPCStatus().Err())
+
+	resp, err := client.ImpersonateUser(adminCtx, &userv1alpha1.ImpersonateUserRequest{
+		UserId: u.ID,
+		Reason: "ticket 1337",
+	})
+	require.NoError(t, err)
+
+	impCtx := metadata.NewOutgoingContext(
+		context.Background(),
+		metadata.Pairs("authorization", resp.GetApiToken()),
+	)
+
+	_, err = client.GetNotificationPreferences(impCtx, &userv1alpha1.GetNotificationPreferencesRequest{})
+	require.NoError(t, err)
+
+	// impersonation tokens cannot be used to impersonate another user
+	_, err = client.ImpersonateUser(impCtx, &userv1alpha1.ImpersonateUserRequest{
+		UserId: fixture.AdminUserID,
+		Reason: "ticket 1337",
+	})
+	require.ErrorIs(t, err, apierrs.ErrPermissionDenied.GRPCStatus().Err())
+
+	list, err := client.ListImpersonations(ctx, &userv1alpha1.ListImpersonationsRequest{
+		UserId: u.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, list.GetImpersonations(), 1)
+
+	imp := list.GetImpersonations()[0]
+	require.Equal(t, resp.GetImpersonation().GetId(), imp.GetId())
+	require.Equal(t, fixture.AdminUserID, imp.GetAdminId())
+	require.Equal(t, "ticket 1337", imp.GetReason())
+
+	methods := make([]string, 0, len(imp.GetActions()))
+	for _, a := range imp.GetActions() {
+		methods = append(methods, a.GetMethod())
+	}
+
+	require.Equal(t, []string{
+		userv1alpha1.UserService_GetNotificationPreferences_FullMethodName,
+		userv1alpha1.UserService_ImpersonateUser_FullMethodName,
+	}, methods)
+}
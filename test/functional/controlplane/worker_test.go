@@ -138,7 +138,7 @@ func TestImageWorkerCreatesImageWithMissingFilesDownloadedFromBlobStore(t *testi
 
 	var (
 		flavorVersionID = c.Flavors[0].Versions[0].ID
-		store           = blob.NewS3Store(fixture.Bucket, fixture.NewS3Client(t, ctx), nil)
+		store           = blob.NewS3Store(fixture.Bucket, fixture.NewS3Client(t, ctx), nil, blob.S3Config{})
 		objs            = make([]blob.Object, 0)
 	)
 
@@ -0,0 +1,83 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnouncements(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		pg      = fixture.NewPostgres()
+		actorID = test.NewUUIDv7(t)
+		now     = time.Now().UTC().Truncate(time.Microsecond)
+		past    = now.Add(-time.Hour)
+		future  = now.Add(time.Hour)
+	)
+
+	pg.Run(t, ctx)
+
+	create := func(msg string, sev resource.AnnouncementSeverity, startsAt time.Time, endsAt *time.Time) resource.Announcement {
+		a, err := pg.DB.CreateAnnouncement(ctx, resource.Announcement{
+			Message:   msg,
+			Severity:  sev,
+			StartsAt:  startsAt,
+			EndsAt:    endsAt,
+			CreatedBy: actorID,
+		})
+		require.NoError(t, err)
+		return a
+	}
+
+	info := create("new feature", resource.AnnouncementSeverityInfo, past, nil)
+	critical := create("outage", resource.AnnouncementSeverityCritical, past, &future)
+	create("upcoming maintenance", resource.AnnouncementSeverityWarning, future, nil)
+	create("past maintenance", resource.AnnouncementSeverityWarning, past.Add(-time.Hour), &past)
+
+	actual, err := pg.DB.ActiveAnnouncements(ctx, now)
+	require.NoError(t, err)
+
+	// most severe announcement comes first
+	require.Len(t, actual, 2)
+	require.Equal(t, critical.ID, actual[0].ID)
+	require.Equal(t, info.ID, actual[1].ID)
+	require.Equal(t, critical.Message, actual[0].Message)
+	require.Equal(t, actorID, actual[0].CreatedBy)
+	require.Nil(t, actual[1].EndsAt)
+	require.NotNil(t, actual[0].EndsAt)
+	require.True(t, future.Equal(*actual[0].EndsAt))
+
+	require.NoError(t, pg.DB.DeleteAnnouncement(ctx, critical.ID))
+
+	actual, err = pg.DB.ActiveAnnouncements(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, actual, 1)
+	require.Equal(t, info.ID, actual[0].ID)
+
+	require.ErrorIs(t, pg.DB.DeleteAnnouncement(ctx, critical.ID), apierrs.ErrNotFound)
+}
@@ -0,0 +1,171 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/autoscaler"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoscalingPolicy(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	flavorID := c.Flavors[0].ID
+
+	_, err := pg.DB.AutoscalingPolicyByFlavorID(ctx, flavorID)
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+
+	_, err = pg.DB.UpsertAutoscalingPolicy(ctx, resource.AutoscalingPolicy{
+		FlavorID:          flavorID,
+		MinInstances:      1,
+		MaxInstances:      3,
+		TargetUtilization: 80,
+		ScaleUpCooldown:   time.Minute,
+		ScaleDownCooldown: 10 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	scaledAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, pg.DB.RecordScaling(ctx, flavorID, autoscaler.DirectionUp, scaledAt))
+
+	// upserting replaces the policy, but keeps the cooldowns
+	updated, err := pg.DB.UpsertAutoscalingPolicy(ctx, resource.AutoscalingPolicy{
+		FlavorID:          flavorID,
+		MinInstances:      2,
+		MaxInstances:      4,
+		TargetUtilization: 50,
+		ScaleUpCooldown:   2 * time.Minute,
+		ScaleDownCooldown: 5 * time.Minute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), updated.MinInstances)
+	require.Equal(t, 2*time.Minute, updated.ScaleUpCooldown)
+	require.Equal(t, &scaledAt, updated.LastScaleUpAt)
+	require.Nil(t, updated.LastScaleDownAt)
+
+	actual, err := pg.DB.AutoscalingPolicyByFlavorID(ctx, flavorID)
+	require.NoError(t, err)
+	require.Equal(t, updated, actual)
+
+	targets, err := pg.DB.AutoscalingTargets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []autoscaler.Target{{Policy: actual, OwnerID: c.Owner.ID}}, targets)
+
+	require.NoError(t, pg.DB.DeleteAutoscalingPolicy(ctx, flavorID))
+
+	_, err = pg.DB.AutoscalingPolicyByFlavorID(ctx, flavorID)
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+}
+
+func TestFlavorDemand(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		n   = fixture.Node()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	f := c.Flavors[0]
+
+	autoscaled := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = f.Versions[0]
+		tmp.Owner = c.Owner
+		tmp.State = resource.InstanceStateCreating
+	})
+
+	manual := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = f.Versions[0]
+		tmp.Owner = c.Owner
+		tmp.State = resource.InstanceStatePending
+	})
+
+	for _, ins := range []resource.Instance{autoscaled, manual} {
+		_, err := pg.DB.CreateInstance(ctx, ins, n.ID)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, pg.DB.InsertAutoscaledInstance(ctx, f.ID, autoscaled.ID))
+
+	_, err := pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: autoscaled.ID,
+		Port:       1337,
+		State:      resource.InstanceStateRunning,
+	}))
+	require.NoError(t, err)
+
+	require.NoError(t, pg.DB.ApplyPlayerEvents(ctx, []resource.PlayerEvent{
+		{InstanceID: autoscaled.ID, PlayerID: test.NewUUIDv7(t), Type: resource.PlayerEventTypeJoin},
+	}))
+
+	// misses for other flavors and unknown chunks are not counted
+	require.NoError(t, pg.DB.RecordJoinMiss(ctx, c.Name, f.Name))
+	require.NoError(t, pg.DB.RecordJoinMiss(ctx, c.Name, ""))
+	require.NoError(t, pg.DB.RecordJoinMiss(ctx, c.Name, "other"))
+	require.NoError(t, pg.DB.RecordJoinMiss(ctx, "unknown", ""))
+
+	since := time.Now().Add(-time.Minute)
+
+	demand, err := pg.DB.FlavorDemand(ctx, f.ID, since)
+	require.NoError(t, err)
+	require.Equal(t, autoscaler.Demand{
+		Players:         1,
+		QueuedPlayers:   2,
+		ManualInstances: 1,
+	}, demand)
+
+	instances, err := pg.DB.AutoscaledInstances(ctx, f.ID)
+	require.NoError(t, err)
+	require.Equal(t, []autoscaler.Instance{
+		{
+			ID:      autoscaled.ID,
+			OwnerID: c.Owner.ID,
+			State:   resource.InstanceStateRunning,
+			Players: 1,
+		},
+	}, instances)
+
+	require.NoError(t, pg.DB.DeleteJoinMissesBefore(ctx, time.Now().Add(time.Minute)))
+
+	demand, err = pg.DB.FlavorDemand(ctx, f.ID, since)
+	require.NoError(t, err)
+	require.Zero(t, demand.QueuedPlayers)
+}
@@ -20,8 +20,12 @@ package database
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -29,6 +33,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 	"github.com/riverqueue/river/rivertest"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
 	"github.com/spacechunks/explorer/controlplane/job"
 	"github.com/spacechunks/explorer/internal/resource"
 	"github.com/spacechunks/explorer/test"
@@ -206,6 +211,9 @@ func TestGetMinecraftVersion(t *testing.T) {
 			Version:   "1.21.7",
 			ImageURL:  "localhost/lol",
 			CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			JavaImageURLs: map[uint32]string{
+				17: "localhost/lol-java17",
+			},
 		}
 	)
 
@@ -220,6 +228,15 @@ func TestGetMinecraftVersion(t *testing.T) {
 	)
 	require.NoError(t, err)
 
+	_, err = pg.Pool.Exec(
+		ctx,
+		`INSERT INTO minecraft_version_java_images (minecraft_version, java_version, image_url) VALUES ($1, $2, $3)`,
+		expected.Version,
+		17,
+		expected.JavaImageURLs[17],
+	)
+	require.NoError(t, err)
+
 	actual, err := pg.DB.GetMinecraftVersionByVersion(ctx, "1.21.7")
 	require.NoError(t, err)
 
@@ -431,3 +448,782 @@ func TestFlavorByID(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateFlavorVersionCheckpointResources(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected *resource.CheckpointResources
+	}{
+		{
+			name: "overrides are stored",
+			expected: &resource.CheckpointResources{
+				CPUMillis:        4000,
+				MemoryLimitBytes: 8 << 30,
+			},
+		},
+		{
+			name:     "nil resets overrides",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx = context.Background()
+				pg  = fixture.NewPostgres()
+				c   = fixture.Chunk()
+			)
+
+			pg.Run(t, ctx)
+			pg.InsertMinecraftVersion(t)
+			pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+			versionID := c.Flavors[0].Versions[0].ID
+
+			err := pg.DB.UpdateFlavorVersionCheckpointResources(ctx, versionID, &resource.CheckpointResources{
+				CPUMillis:        1000,
+				MemoryLimitBytes: 1 << 30,
+			})
+			require.NoError(t, err)
+
+			err = pg.DB.UpdateFlavorVersionCheckpointResources(ctx, versionID, tt.expected)
+			require.NoError(t, err)
+
+			actual, err := pg.DB.FlavorVersionByID(ctx, versionID)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expected, actual.CheckpointResources)
+		})
+	}
+}
+
+func TestRejectFlavorVersionBuild(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+	pg.CreateRiverClient(t)
+
+	versionID := c.Flavors[0].Versions[0].ID
+
+	err := pg.DB.RejectFlavorVersionBuild(
+		ctx,
+		versionID,
+		resource.FlavorVersionBuildStatusBuildImageFailed,
+		"too many files",
+	)
+	require.NoError(t, err)
+
+	actual, err := pg.DB.FlavorVersionByID(ctx, versionID)
+	require.NoError(t, err)
+	require.Equal(t, resource.FlavorVersionBuildStatusBuildImageFailed, actual.BuildStatus)
+	require.Equal(t, "too many files", actual.BuildError)
+
+	// starting a new build resets the error
+	err = pg.DB.UpdateFlavorVersionBuildStatus(ctx, versionID, resource.FlavorVersionBuildStatusBuildImage)
+	require.NoError(t, err)
+
+	actual, err = pg.DB.FlavorVersionByID(ctx, versionID)
+	require.NoError(t, err)
+	require.Empty(t, actual.BuildError)
+}
+
+func TestUpdateFlavorVersionBuildProgress(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	versionID := c.Flavors[0].Versions[0].ID
+
+	err := pg.DB.UpdateFlavorVersionBuildProgress(ctx, versionID, 42)
+	require.NoError(t, err)
+
+	actual, err := pg.DB.FlavorVersionByID(ctx, versionID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(42), actual.BuildProgress)
+
+	// moving on to the next build step resets the progress
+	err = pg.DB.UpdateFlavorVersionBuildStatus(ctx, versionID, resource.FlavorVersionBuildStatusBuildCheckpoint)
+	require.NoError(t, err)
+
+	actual, err = pg.DB.FlavorVersionByID(ctx, versionID)
+	require.NoError(t, err)
+	require.Zero(t, actual.BuildProgress)
+}
+
+func TestUpdateFlavorVersionRestoreLatency(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	versionID := c.Flavors[0].Versions[0].ID
+
+	actual, err := pg.DB.FlavorVersionByID(ctx, versionID)
+	require.NoError(t, err)
+	require.Zero(t, actual.RestoreLatency)
+
+	err = pg.DB.UpdateFlavorVersionRestoreLatency(ctx, versionID, 1500*time.Millisecond)
+	require.NoError(t, err)
+
+	actual, err = pg.DB.FlavorVersionByID(ctx, versionID)
+	require.NoError(t, err)
+	require.Equal(t, 1500*time.Millisecond, actual.RestoreLatency)
+}
+
+func TestFlavorVersionProvenance(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		pg     = fixture.NewPostgres()
+		c      = fixture.Chunk()
+		nodeID = fixture.Node().ID
+		now    = time.Now().UTC().Truncate(time.Microsecond)
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.InsertNode(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	_, err := pg.DB.ReportPlatformdVersion(ctx, nodeID, "v1")
+	require.NoError(t, err)
+
+	versionID := c.Flavors[0].Versions[0].ID
+
+	_, err = pg.DB.FlavorVersionProvenance(ctx, versionID)
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+
+	expected := resource.BuildProvenance{
+		BaseImageDigest: "sha256:" + strings.Repeat("a", 64),
+		ImageDigest:     "sha256:" + strings.Repeat("b", 64),
+		BuilderVersion:  "abc",
+		InputHash:       strings.Repeat("c", 64),
+		Plugins: []resource.PluginHash{
+			{Path: "/opt/paper/plugins/a.jar", SHA256: strings.Repeat("d", 64)},
+		},
+		ImageBuiltAt: now,
+	}
+
+	err = pg.DB.RecordFlavorVersionImageProvenance(ctx, versionID, expected)
+	require.NoError(t, err)
+
+	err = pg.DB.RecordFlavorVersionCheckpointProvenance(ctx, versionID, nodeID, now)
+	require.NoError(t, err)
+
+	expected.CheckpointNodeID = nodeID
+	expected.PlatformdVersion = "v1"
+	expected.CheckpointBuiltAt = &now
+
+	actual, err := pg.DB.FlavorVersionProvenance(ctx, versionID)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff(expected, actual))
+
+	// rebuilding the image resets the checkpoint provenance
+	err = pg.DB.RecordFlavorVersionImageProvenance(ctx, versionID, expected)
+	require.NoError(t, err)
+
+	actual, err = pg.DB.FlavorVersionProvenance(ctx, versionID)
+	require.NoError(t, err)
+	require.Empty(t, actual.CheckpointNodeID)
+	require.Empty(t, actual.PlatformdVersion)
+	require.Nil(t, actual.CheckpointBuiltAt)
+}
+
+func TestFlavorVersionAssets(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+		a1  = strings.Repeat("a", 64)
+		a2  = strings.Repeat("b", 64)
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	for _, h := range []string{a2, a1} {
+		created, err := pg.DB.CreateAsset(ctx, resource.Asset{
+			Hash:      h,
+			SizeBytes: 1024,
+		})
+		require.NoError(t, err)
+		require.Equal(t, h, created.Hash)
+		require.Equal(t, uint64(1024), created.SizeBytes)
+	}
+
+	// creating an existing asset returns the stored one
+	existing, err := pg.DB.CreateAsset(ctx, resource.Asset{
+		Hash:      a1,
+		SizeBytes: 2048,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1024), existing.SizeBytes)
+
+	exists, err := pg.DB.AssetsExist(ctx, []string{a1, a2})
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = pg.DB.AssetsExist(ctx, []string{a1, strings.Repeat("c", 64)})
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	version := fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+		v.Version = "v2"
+		v.AssetHashes = []string{a2, a1}
+	})
+
+	pg.CreateFlavorVersion(t, c.Flavors[0].ID, &version)
+
+	actual, err := pg.DB.FlavorVersionByID(ctx, version.ID)
+	require.NoError(t, err)
+
+	// order has to be preserved, because it defines the order the assets are layered in
+	require.Equal(t, []string{a2, a1}, actual.AssetHashes)
+}
+
+func TestCreateFlavorVersionConcurrently(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	flavorID := c.Flavors[0].ID
+
+	prev, err := pg.DB.LatestFlavorVersion(ctx, flavorID)
+	require.NoError(t, err)
+
+	const n = 5
+
+	var (
+		wg        sync.WaitGroup
+		created   atomic.Int32
+		conflicts atomic.Int32
+	)
+
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			version := fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+				v.Version = fmt.Sprintf("concurrent-%d", i)
+			})
+
+			_, err := pg.DB.CreateFlavorVersion(ctx, flavorID, version, prev.ID)
+			if errors.Is(err, apierrs.ErrFlavorVersionConflict) {
+				conflicts.Add(1)
+				return
+			}
+
+			assert.NoError(t, err)
+			created.Add(1)
+		}()
+	}
+
+	wg.Wait()
+
+	// only one version can be based on the previous version,
+	// all others have to compute their changes again.
+	require.Equal(t, int32(1), created.Load())
+	require.Equal(t, int32(n-1), conflicts.Load())
+
+	latest, err := pg.DB.LatestFlavorVersion(ctx, flavorID)
+	require.NoError(t, err)
+
+	// the created version is only visible including all of its files
+	actual, err := pg.DB.FlavorVersionByID(ctx, latest.ID)
+	require.NoError(t, err)
+	require.Len(t, actual.FileHashes, len(fixture.FlavorVersion().FileHashes))
+
+	// creating a version based on an outdated previous version is rejected
+	_, err = pg.DB.CreateFlavorVersion(ctx, flavorID, fixture.FlavorVersion(func(v *resource.FlavorVersion) {
+		v.Version = "outdated"
+	}), prev.ID)
+	require.ErrorIs(t, err, apierrs.ErrFlavorVersionConflict)
+}
+
+func TestCanaries(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	var (
+		flavor = c.Flavors[0]
+		canary = flavor.Versions[0]
+		stable = flavor.Versions[1]
+	)
+
+	created, err := pg.DB.CreateCanary(ctx, resource.Canary{
+		FlavorID:        flavor.ID,
+		StableVersionID: stable.ID,
+		CanaryVersionID: canary.ID,
+		Percentage:      10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, resource.CanaryStateActive, created.State)
+
+	// only one canary can be active per flavor
+	_, err = pg.DB.CreateCanary(ctx, created)
+	require.ErrorIs(t, err, apierrs.ErrCanaryActive)
+
+	for i, version := range []resource.FlavorVersion{canary, stable, stable} {
+		ins := fixture.Instance()
+		ins.ID = test.NewUUIDv7(t)
+		ins.Chunk = c
+		ins.FlavorVersion = version
+		ins.Owner = c.Owner
+
+		_, err := pg.DB.CreateInstance(ctx, ins, fixture.Node().ID)
+		require.NoError(t, err)
+
+		err = pg.DB.CreateCanaryInstance(ctx, created.ID, ins.ID, version.ID == canary.ID)
+		require.NoError(t, err)
+
+		// the canary instance crashed twice, which only counts once
+		if i == 0 {
+			for range 2 {
+				_, err := pg.Pool.Exec(
+					ctx,
+					`INSERT INTO instance_events (id, instance_id, type, created_at) VALUES ($1, $2, 'CRASHED', now())`,
+					test.NewUUIDv7(t),
+					ins.ID,
+				)
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	latest, err := pg.DB.LatestCanary(ctx, flavor.ID)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, latest.ID)
+	require.Equal(t, resource.CanaryStats{Launches: 1, CrashedInstances: 1}, latest.CanaryStats)
+	require.Equal(t, resource.CanaryStats{Launches: 2}, latest.StableStats)
+
+	byVersion, err := pg.DB.CanaryByFlavorVersionID(ctx, stable.ID)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, byVersion.ID)
+
+	promoted, err := pg.DB.UpdateActiveCanaryState(ctx, flavor.ID, resource.CanaryStatePromoted)
+	require.NoError(t, err)
+	require.Equal(t, resource.CanaryStatePromoted, promoted.State)
+	require.Equal(t, latest.CanaryStats, promoted.CanaryStats)
+
+	_, err = pg.DB.UpdateActiveCanaryState(ctx, flavor.ID, resource.CanaryStateAborted)
+	require.ErrorIs(t, err, apierrs.ErrNoActiveCanary)
+}
+
+func TestFlavorChannels(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	var (
+		flavor = c.Flavors[0]
+		beta   = flavor.Versions[0]
+		stable = flavor.Versions[1]
+	)
+
+	_, err := pg.DB.FlavorChannel(ctx, flavor.ID, resource.FlavorChannelStable)
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+
+	for _, ch := range []resource.FlavorChannel{
+		{FlavorID: flavor.ID, Name: resource.FlavorChannelStable, FlavorVersionID: beta.ID},
+		{FlavorID: flavor.ID, Name: resource.FlavorChannelBeta, FlavorVersionID: beta.ID},
+		// promoting replaces the version of the channel
+		{FlavorID: flavor.ID, Name: resource.FlavorChannelStable, FlavorVersionID: stable.ID},
+	} {
+		_, err := pg.DB.UpsertFlavorChannel(ctx, ch)
+		require.NoError(t, err)
+	}
+
+	actual, err := pg.DB.FlavorChannel(ctx, flavor.ID, resource.FlavorChannelStable)
+	require.NoError(t, err)
+	require.Equal(t, stable.ID, actual.FlavorVersionID)
+
+	channels, err := pg.DB.FlavorChannels(ctx, flavor.ID)
+	require.NoError(t, err)
+	require.Len(t, channels, 2)
+	require.Equal(t, resource.FlavorChannelBeta, channels[0].Name)
+	require.Equal(t, beta.ID, channels[0].FlavorVersionID)
+	require.Equal(t, actual, channels[1])
+}
+
+func TestRetentionPolicies(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		pg      = fixture.NewPostgres()
+		c       = fixture.Chunk()
+		deleted = fixture.Chunk(func(tmp *resource.Chunk) {
+			tmp.ID = test.NewUUIDv7(t)
+			tmp.Flavors = nil
+		})
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+	pg.CreateChunk(t, &deleted, fixture.CreateOptions{})
+
+	_, err := pg.DB.RetentionPolicyByChunkID(ctx, c.ID)
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+
+	created, err := pg.DB.UpsertRetentionPolicy(ctx, resource.RetentionPolicy{
+		ChunkID:          c.ID,
+		KeepLastVersions: 5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), created.KeepLastVersions)
+
+	// upserting replaces the existing policy
+	updated, err := pg.DB.UpsertRetentionPolicy(ctx, resource.RetentionPolicy{
+		ChunkID:  c.ID,
+		KeepDays: 30,
+	})
+	require.NoError(t, err)
+
+	actual, err := pg.DB.RetentionPolicyByChunkID(ctx, c.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated, actual)
+	require.Zero(t, actual.KeepLastVersions)
+	require.Equal(t, uint32(30), actual.KeepDays)
+
+	_, err = pg.DB.UpsertRetentionPolicy(ctx, resource.RetentionPolicy{
+		ChunkID:          deleted.ID,
+		KeepLastVersions: 1,
+	})
+	require.NoError(t, err)
+
+	err = pg.DB.MarkChunkAndFlavorsDeleted(ctx, deleted.ID)
+	require.NoError(t, err)
+
+	// policies of deleted chunks are not returned
+	all, err := pg.DB.AllRetentionPolicies(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []resource.RetentionPolicy{actual}, all)
+}
+
+func TestChunkReadme(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	_, err := pg.DB.ChunkReadmeByChunkID(ctx, c.ID)
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+
+	_, err = pg.DB.UpsertChunkReadme(ctx, resource.ChunkReadme{
+		ChunkID:  c.ID,
+		Markdown: "# my chunk",
+	})
+	require.NoError(t, err)
+
+	// upserting replaces the existing readme
+	updated, err := pg.DB.UpsertChunkReadme(ctx, resource.ChunkReadme{
+		ChunkID:  c.ID,
+		Markdown: "# my updated chunk",
+	})
+	require.NoError(t, err)
+
+	actual, err := pg.DB.ChunkReadmeByChunkID(ctx, c.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated, actual)
+	require.Equal(t, "# my updated chunk", actual.Markdown)
+}
+
+func TestChunkMedia(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	png, err := pg.DB.CreateChunkMedia(ctx, resource.ChunkMedia{
+		ChunkID:     c.ID,
+		Hash:        strings.Repeat("ab", 32),
+		ContentType: "image/png",
+		SizeBytes:   10,
+	})
+	require.NoError(t, err)
+
+	jpeg, err := pg.DB.CreateChunkMedia(ctx, resource.ChunkMedia{
+		ChunkID:     c.ID,
+		Hash:        strings.Repeat("cd", 32),
+		ContentType: "image/jpeg",
+		SizeBytes:   20,
+	})
+	require.NoError(t, err)
+
+	// adding the same image again returns the existing media
+	again, err := pg.DB.CreateChunkMedia(ctx, resource.ChunkMedia{
+		ChunkID:     c.ID,
+		Hash:        strings.Repeat("ab", 32),
+		ContentType: "image/png",
+		SizeBytes:   10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, png, again)
+
+	actual, err := pg.DB.ChunkMediaByChunkID(ctx, c.ID)
+	require.NoError(t, err)
+	require.Equal(t, []resource.ChunkMedia{png, jpeg}, actual)
+
+	m, err := pg.DB.ChunkMediaByID(ctx, jpeg.ID)
+	require.NoError(t, err)
+	require.Equal(t, jpeg, m)
+
+	require.NoError(t, pg.DB.DeleteChunkMedia(ctx, png.ID))
+	require.ErrorIs(t, pg.DB.DeleteChunkMedia(ctx, png.ID), apierrs.ErrNotFound)
+
+	_, err = pg.DB.ChunkMediaByID(ctx, png.ID)
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+}
+
+func TestChunkImports(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateRiverClient(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	var (
+		flavor  = c.Flavors[0]
+		version = flavor.Versions[0]
+	)
+
+	created, err := pg.DB.CreateChunkImport(ctx, resource.ChunkImport{
+		ChunkID:          c.ID,
+		FlavorID:         flavor.ID,
+		Version:          "v3",
+		MinecraftVersion: fixture.MinecraftVersion,
+		MinPlayers:       1,
+		MaxPlayers:       10,
+		ArchiveSizeBytes: 100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, resource.ChunkImportStatePendingUpload, created.State)
+
+	actual, err := pg.DB.ChunkImportByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, created, actual)
+
+	started, err := pg.DB.StartChunkImport(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, resource.ChunkImportStateImporting, started.State)
+
+	// starting the import again does not change anything
+	again, err := pg.DB.StartChunkImport(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, started, again)
+
+	require.NoError(t, pg.DB.UpdateChunkImportFlavorVersion(ctx, created.ID, version.ID))
+	require.NoError(t, pg.DB.UpdateChunkImportState(ctx, created.ID, resource.ChunkImportStateFailed, "broken"))
+
+	actual, err = pg.DB.ChunkImportByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, version.ID, actual.FlavorVersionID)
+	require.Equal(t, resource.ChunkImportStateFailed, actual.State)
+	require.Equal(t, "broken", actual.Error)
+
+	_, err = pg.DB.ChunkImportByID(ctx, "019df3b1-6c2a-7e4d-9b1f-2a3c4d5e6f7f")
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+}
+
+func TestChunkTemplates(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	versionID := c.Flavors[0].Versions[0].ID
+
+	created, err := pg.DB.CreateChunkTemplate(ctx, resource.ChunkTemplate{
+		Name:            "minigame",
+		Description:     "a minigame to start with",
+		FlavorVersionID: versionID,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+
+	_, err = pg.DB.CreateChunkTemplate(ctx, resource.ChunkTemplate{
+		Name:            "minigame",
+		FlavorVersionID: versionID,
+	})
+	require.ErrorIs(t, err, apierrs.ErrTemplateNameExists)
+
+	actual, err := pg.DB.ChunkTemplateByName(ctx, "minigame")
+	require.NoError(t, err)
+	require.Equal(t, created, actual)
+
+	created.Description = "changed"
+	updated, err := pg.DB.UpdateChunkTemplate(ctx, created)
+	require.NoError(t, err)
+	require.Equal(t, "changed", updated.Description)
+
+	all, err := pg.DB.ChunkTemplates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []resource.ChunkTemplate{updated}, all)
+
+	require.NoError(t, pg.DB.DeleteChunkTemplate(ctx, created.ID))
+	require.ErrorIs(t, pg.DB.DeleteChunkTemplate(ctx, created.ID), apierrs.ErrTemplateNotFound)
+
+	_, err = pg.DB.ChunkTemplateByName(ctx, "minigame")
+	require.ErrorIs(t, err, apierrs.ErrTemplateNotFound)
+}
+
+func TestChunkNames(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		pg    = fixture.NewPostgres()
+		c     = fixture.Chunk()
+		other = fixture.Chunk(func(tmp *resource.Chunk) {
+			tmp.ID = "019a0f2e-6c1a-7d4e-9b3a-5f2c8e1d4a70"
+			tmp.Name = "other-chunk"
+		})
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+	pg.CreateChunk(t, &other, fixture.CreateOptions{})
+
+	// names are compared by their slug, so different casing
+	// and separators still collide.
+	_, err := pg.DB.CreateChunk(ctx, fixture.Chunk(func(tmp *resource.Chunk) {
+		tmp.Name = "Chunk Fixture"
+	}))
+	require.ErrorIs(t, err, apierrs.ErrChunkNameTaken)
+
+	c.Name = "Sky Wars"
+	_, err = pg.DB.UpdateChunk(ctx, c)
+	require.NoError(t, err)
+
+	id, err := pg.DB.ChunkIDByName(ctx, "sky-wars")
+	require.NoError(t, err)
+	require.Equal(t, c.ID, id)
+
+	// the old name keeps resolving to the renamed chunk
+	id, err = pg.DB.ChunkIDByName(ctx, "chunk-fixture")
+	require.NoError(t, err)
+	require.Equal(t, c.ID, id)
+
+	// old names stay reserved for the chunk
+	other.Name = "chunk-fixture"
+	_, err = pg.DB.UpdateChunk(ctx, other)
+	require.ErrorIs(t, err, apierrs.ErrChunkNameTaken)
+
+	_, err = pg.DB.ChunkIDByName(ctx, "does-not-exist")
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+}
+
+func TestCrossInstanceTraffic(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		pg      = fixture.NewPostgres()
+		c       = fixture.Chunk()
+		ownerID = test.NewUUIDv7(t)
+		adminID = test.NewUUIDv7(t)
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	flavorID := c.Flavors[0].ID
+
+	_, err := pg.DB.CrossInstanceTrafficByChunkID(ctx, c.ID)
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+
+	requested, err := pg.DB.RequestCrossInstanceTraffic(ctx, c.ID, "arenas", ownerID)
+	require.NoError(t, err)
+	require.Equal(t, resource.CrossInstanceTrafficStateRequested, requested.State)
+
+	pending, err := pg.DB.CrossInstanceTrafficByState(ctx, resource.CrossInstanceTrafficStateRequested)
+	require.NoError(t, err)
+	require.Equal(t, []resource.CrossInstanceTraffic{requested}, pending)
+
+	approved, err := pg.DB.CrossInstanceTrafficApproved(ctx, flavorID)
+	require.NoError(t, err)
+	require.False(t, approved)
+
+	reviewed, err := pg.DB.ReviewCrossInstanceTraffic(
+		ctx,
+		c.ID,
+		resource.CrossInstanceTrafficStateApproved,
+		adminID,
+		"ok",
+	)
+	require.NoError(t, err)
+	require.Equal(t, adminID, reviewed.ReviewedBy)
+
+	approved, err = pg.DB.CrossInstanceTrafficApproved(ctx, flavorID)
+	require.NoError(t, err)
+	require.True(t, approved)
+
+	// requesting again requires another review
+	again, err := pg.DB.RequestCrossInstanceTraffic(ctx, c.ID, "arenas and lobby", ownerID)
+	require.NoError(t, err)
+	require.Equal(t, resource.CrossInstanceTrafficStateRequested, again.State)
+	require.Empty(t, again.ReviewedBy)
+	require.Empty(t, again.ReviewMessage)
+
+	require.NoError(t, pg.DB.DeleteCrossInstanceTraffic(ctx, c.ID))
+	require.ErrorIs(t, pg.DB.DeleteCrossInstanceTraffic(ctx, c.ID), apierrs.ErrNotFound)
+
+	_, err = pg.DB.ReviewCrossInstanceTraffic(ctx, c.ID, resource.CrossInstanceTrafficStateRejected, adminID, "")
+	require.ErrorIs(t, err, apierrs.ErrNotFound)
+}
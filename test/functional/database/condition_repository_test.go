@@ -0,0 +1,100 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spacechunks/explorer/controlplane/condition"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/test"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeConditions(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		pg     = fixture.NewPostgres()
+		nodeID = fixture.Node().ID
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+
+	_, err := pg.DB.ReplaceNodeConditions(ctx, test.NewUUIDv7(t), nil)
+	require.ErrorIs(t, err, apierrs.ErrNodeNotFound)
+
+	events, err := pg.DB.ReplaceNodeConditions(ctx, nodeID, []condition.Condition{
+		{Type: condition.TypeDiskPressure, Message: "5% free"},
+		{Type: condition.TypeCRIUnresponsive, Message: "timeout"},
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	for _, ev := range events {
+		require.Equal(t, condition.EventTypeRaised, ev.Type)
+	}
+
+	// unhealthy nodes must not get any instances scheduled
+	_, err = pg.DB.BestNode(ctx, "")
+	require.ErrorIs(t, err, apierrs.ErrNoSlotsAvailable)
+
+	nodes, err := pg.DB.ListNodes(ctx)
+	require.NoError(t, err)
+	require.True(t, nodes[0].Unhealthy)
+
+	before, err := pg.DB.ListNodeConditions(ctx)
+	require.NoError(t, err)
+	require.Len(t, before, 2)
+
+	// conditions that are reported again do not raise another event,
+	// but the ones that are not reported anymore are cleared.
+	events, err = pg.DB.ReplaceNodeConditions(ctx, nodeID, []condition.Condition{
+		{Type: condition.TypeDiskPressure, Message: "4% free"},
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, condition.EventTypeCleared, events[0].Type)
+	require.Equal(t, condition.TypeCRIUnresponsive, events[0].ConditionType)
+
+	after, err := pg.DB.ListNodeConditions(ctx)
+	require.NoError(t, err)
+	require.Len(t, after, 1)
+	require.Equal(t, fixture.Node().Name, after[0].NodeName)
+	require.Equal(t, "4% free", after[0].Message)
+
+	// conditions are ordered by type, so disk pressure comes first
+	require.Equal(t, condition.TypeDiskPressure, before[0].Type)
+	require.Equal(t, before[0].Since, after[0].Since)
+
+	_, err = pg.DB.ReplaceNodeConditions(ctx, nodeID, nil)
+	require.NoError(t, err)
+
+	n, err := pg.DB.BestNode(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, nodeID, n.ID)
+
+	all, err := pg.DB.ListNodeEvents(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+	require.Equal(t, condition.EventTypeCleared, all[0].Type)
+	require.Equal(t, condition.TypeDiskPressure, all[0].ConditionType)
+}
@@ -20,15 +20,24 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/netip"
+	"os"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/controlplane/worker"
 	"github.com/spacechunks/explorer/internal/resource"
 	"github.com/spacechunks/explorer/test"
 	"github.com/spacechunks/explorer/test/fixture"
@@ -49,7 +58,7 @@ func TestBestNodeSelectsAndExhaustsSlots(t *testing.T) {
 	c.Flavors = []resource.Flavor{c.Flavors[0]}
 	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
 
-	n, err := pg.DB.BestNode(ctx)
+	n, err := pg.DB.BestNode(ctx, "")
 	require.NoError(t, err)
 	require.Equal(t, fixture.Node().ID, n.ID)
 
@@ -64,10 +73,131 @@ func TestBestNodeSelectsAndExhaustsSlots(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	_, err = pg.DB.BestNode(ctx)
+	_, err = pg.DB.BestNode(ctx, "")
 	require.ErrorIs(t, err, apierrs.ErrNoSlotsAvailable)
 }
 
+func TestBestNodePrefersPlacementGroup(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		pg          = fixture.NewPostgres()
+		c           = fixture.Chunk()
+		otherNodeID = "019a0f8c-2d4b-7e1a-8c3f-6b9d2e4a1c57"
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+
+	c.Flavors = []resource.Flavor{c.Flavors[0]}
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	_, err := pg.Pool.Exec(
+		ctx,
+		`INSERT INTO nodes (id, name, address, checkpoint_api_endpoint, slots) VALUES ($1, $2, $3, $4, $5)`,
+		otherNodeID, "other-node", "198.51.100.2", "198.51.100.2:9012", 2,
+	)
+	require.NoError(t, err)
+
+	create := func(nodeID string, group string) resource.Instance {
+		ins := fixture.Instance()
+		ins.ID = test.NewUUIDv7(t)
+		ins.Chunk = c
+		ins.FlavorVersion = c.Flavors[0].Versions[0]
+		ins.Owner = c.Owner
+		ins.PlacementGroup = group
+		created, err := pg.DB.CreateInstance(ctx, ins, nodeID)
+		require.NoError(t, err)
+		return created
+	}
+
+	created := create(otherNodeID, "party")
+	require.Equal(t, "party", created.PlacementGroup)
+
+	// without a placement group, the node with the least instances wins.
+	n, err := pg.DB.BestNode(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, fixture.Node().ID, n.ID)
+
+	n, err = pg.DB.BestNode(ctx, "party")
+	require.NoError(t, err)
+	require.Equal(t, otherNodeID, n.ID)
+
+	// once the node of the group is full, instances
+	// are placed like any other instance.
+	create(otherNodeID, "party")
+
+	n, err = pg.DB.BestNode(ctx, "party")
+	require.NoError(t, err)
+	require.Equal(t, fixture.Node().ID, n.ID)
+}
+
+func TestConcurrentCreateInstanceDoesNotExceedSlots(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		pg    = fixture.NewPostgres()
+		slots = fixture.Node().Slots
+		// way more callers than slots, so many of them race for the last slot.
+		callers = slots * 5
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+
+	c := fixture.Chunk()
+	c.Flavors = []resource.Flavor{c.Flavors[0]}
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	var (
+		wg         sync.WaitGroup
+		created    atomic.Int32
+		rejected   atomic.Int32
+		unexpected = make(chan error, callers)
+		start      = make(chan struct{})
+	)
+
+	for range callers {
+		ins := fixture.Instance()
+		ins.ID = test.NewUUIDv7(t)
+		ins.Chunk = c
+		ins.FlavorVersion = c.Flavors[0].Versions[0]
+		ins.Owner = c.Owner
+
+		wg.Go(func() {
+			<-start
+			_, err := pg.DB.CreateInstance(ctx, ins, fixture.Node().ID)
+			switch {
+			case err == nil:
+				created.Add(1)
+			case errors.Is(err, apierrs.ErrNoSlotsAvailable):
+				rejected.Add(1)
+			default:
+				unexpected <- err
+			}
+		})
+	}
+
+	close(start)
+	wg.Wait()
+	close(unexpected)
+
+	for err := range unexpected {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, int32(slots), created.Load())
+	require.Equal(t, int32(callers-slots), rejected.Load())
+
+	var count int
+	require.NoError(t, pg.Pool.QueryRow(
+		ctx,
+		"SELECT COUNT(*) FROM instances WHERE node_id = $1",
+		fixture.Node().ID,
+	).Scan(&count))
+	require.Equal(t, slots, count)
+}
+
 func TestCreateInstance(t *testing.T) {
 	// TODO:
 	// * check that creating does not work if no flavor is present
@@ -342,7 +472,7 @@ func TestGetInstancesByNodeID(t *testing.T) {
 		return strings.Compare(expected[i].ID, expected[j].ID) < 0
 	})
 
-	acutalInstances, err := pg.DB.GetInstancesByNodeID(ctx, nodeID)
+	acutalInstances, _, err := pg.DB.GetInstancesByNodeID(ctx, nodeID, 0)
 	require.NoError(t, err)
 
 	sort.Slice(acutalInstances, func(i, j int) bool {
@@ -359,6 +489,109 @@ func TestGetInstancesByNodeID(t *testing.T) {
 	}
 }
 
+func TestGetInstancesByNodeIDReturnsChangesSinceRevision(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		pg     = fixture.NewPostgres()
+		nodeID = fixture.Node().ID
+		c      = fixture.Chunk()
+		ids    = []string{test.NewUUIDv7(t), test.NewUUIDv7(t)}
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	for _, id := range ids {
+		ins := fixture.Instance(func(tmp *resource.Instance) {
+			tmp.ID = id
+			tmp.FlavorVersion = c.Flavors[0].Versions[0]
+			tmp.Owner = c.Owner
+		})
+
+		_, err := pg.DB.CreateInstance(ctx, ins, nodeID)
+		require.NoError(t, err)
+	}
+
+	all, revision, err := pg.DB.GetInstancesByNodeID(ctx, nodeID, 0)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	report := resource.InstanceStatusReport{
+		InstanceID: ids[1],
+		Port:       1337,
+		State:      resource.InstanceStateRunning,
+	}
+
+	_, err = pg.DB.ApplyStatusReports(ctx, statusUpdates(report))
+	require.NoError(t, err)
+
+	changed, next, err := pg.DB.GetInstancesByNodeID(ctx, nodeID, revision)
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	require.Equal(t, ids[1], changed[0].ID)
+	require.Greater(t, next, revision)
+
+	// reporting the same status again must not produce a new revision,
+	// otherwise every instance would be part of every delta.
+	_, err = pg.DB.ApplyStatusReports(ctx, statusUpdates(report))
+	require.NoError(t, err)
+
+	changed, last, err := pg.DB.GetInstancesByNodeID(ctx, nodeID, next)
+	require.NoError(t, err)
+	require.Empty(t, changed)
+	require.Equal(t, next, last)
+}
+
+func TestGetInstancesByOwnerIDReturnsChangesSinceRevision(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		pg     = fixture.NewPostgres()
+		nodeID = fixture.Node().ID
+		c      = fixture.Chunk()
+		ids    = []string{test.NewUUIDv7(t), test.NewUUIDv7(t)}
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	for _, id := range ids {
+		ins := fixture.Instance(func(tmp *resource.Instance) {
+			tmp.ID = id
+			tmp.FlavorVersion = c.Flavors[0].Versions[0]
+			tmp.Owner = c.Owner
+		})
+
+		_, err := pg.DB.CreateInstance(ctx, ins, nodeID)
+		require.NoError(t, err)
+	}
+
+	all, revision, err := pg.DB.GetInstancesByOwnerID(ctx, c.Owner.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	others, _, err := pg.DB.GetInstancesByOwnerID(ctx, test.NewUUIDv7(t), 0)
+	require.NoError(t, err)
+	require.Empty(t, others)
+
+	_, err = pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: ids[0],
+		Port:       1337,
+		State:      resource.InstanceStateRunning,
+	}))
+	require.NoError(t, err)
+
+	changed, next, err := pg.DB.GetInstancesByOwnerID(ctx, c.Owner.ID, revision)
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	require.Equal(t, ids[0], changed[0].ID)
+	require.Equal(t, resource.InstanceStateRunning, changed[0].State)
+	require.Greater(t, next, revision)
+}
+
 func TestCountInstancesByFlavorID(t *testing.T) {
 	var (
 		ctx    = context.Background()
@@ -398,3 +631,499 @@ func TestCountInstancesByFlavorID(t *testing.T) {
 }
 
 // TODO: add test for applystatusreports
+
+func TestApplyStatusReportsStoresEvents(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		pg     = fixture.NewPostgres()
+		nodeID = fixture.Node().ID
+		c      = fixture.Chunk()
+		now    = time.Now().UTC().Truncate(time.Microsecond)
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+	pg.CreateRiverClient(t)
+
+	ins := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = c.Flavors[0].Versions[0]
+		tmp.Owner = c.Owner
+	})
+
+	_, err := pg.DB.CreateInstance(ctx, ins, nodeID)
+	require.NoError(t, err)
+
+	var (
+		older = resource.InstanceEvent{
+			ID:         test.NewUUIDv7(t),
+			InstanceID: ins.ID,
+			Type:       resource.InstanceEventTypeCrashed,
+			ExitCode:   1,
+			Message:    "container abc exited with code 1",
+			CreatedAt:  now.Add(-time.Minute),
+		}
+		newer = resource.InstanceEvent{
+			ID:         test.NewUUIDv7(t),
+			InstanceID: ins.ID,
+			Type:       resource.InstanceEventTypeOOMKilled,
+			ExitCode:   137,
+			Message:    "container abc exited with code 137",
+			CreatedAt:  now,
+		}
+		report = resource.InstanceStatusReport{
+			InstanceID: ins.ID,
+			State:      resource.InstanceStateDeleted,
+			Events:     []resource.InstanceEvent{older, newer},
+		}
+	)
+
+	// events can be reported multiple times, make sure they are only stored once.
+	for range 2 {
+		_, err := pg.DB.ApplyStatusReports(ctx, statusUpdates(report))
+		require.NoError(t, err)
+	}
+
+	// events have to be available even though the instance has been deleted.
+	actual, err := pg.DB.GetInstanceEvents(ctx, ins.ID, 10)
+	require.NoError(t, err)
+
+	if d := cmp.Diff([]resource.InstanceEvent{newer, older}, actual); d != "" {
+		t.Fatalf("diff (-want +got):\n%s", d)
+	}
+}
+
+func TestApplyStatusReportsRejectsInvalidTransitions(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		pg     = fixture.NewPostgres()
+		nodeID = fixture.Node().ID
+		c      = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	ins := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = c.Flavors[0].Versions[0]
+		tmp.Owner = c.Owner
+		tmp.State = resource.InstanceStateCreating
+	})
+
+	_, err := pg.DB.CreateInstance(ctx, ins, nodeID)
+	require.NoError(t, err)
+
+	stop := instance.Transition{
+		From: resource.InstanceStateCreating,
+		To:   resource.InstanceStateDeleting,
+	}
+	require.NoError(t, pg.DB.UpdateInstanceState(ctx, ins.ID, stop.To, instance.SourceStates(stop)))
+
+	// platformd did not see the instance has been stopped yet,
+	// so it reports it to be running, which must not be applied.
+	rejected, err := pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: ins.ID,
+		Port:       1337,
+		State:      resource.InstanceStateRunning,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{ins.ID}, rejected)
+
+	actual, err := pg.DB.GetInstanceByID(ctx, ins.ID)
+	require.NoError(t, err)
+	require.Equal(t, resource.InstanceStateDeleting, actual.State)
+
+	// going back to a previous state is not possible either
+	err = pg.DB.UpdateInstanceState(
+		ctx,
+		ins.ID,
+		resource.InstanceStatePending,
+		instance.SourceStates(instance.Transition{To: resource.InstanceStatePending}),
+	)
+	require.ErrorIs(t, err, apierrs.ErrInvalidStateTransition)
+}
+
+func TestFailedInstancesAreRetainedAcrossRestarts(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		pg     = fixture.NewPostgres()
+		nodeID = fixture.Node().ID
+		c      = fixture.Chunk()
+		logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	ins := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = c.Flavors[0].Versions[0]
+		tmp.Owner = c.Owner
+	})
+
+	_, err := pg.DB.CreateInstance(ctx, ins, nodeID)
+	require.NoError(t, err)
+
+	_, err = pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: ins.ID,
+		State:      resource.InstanceCreationFailed,
+	}))
+	require.NoError(t, err)
+
+	// platformd removes the failed workload right away and reports it as
+	// deleted, but we want to keep the instance until its retention expires.
+	rejected, err := pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: ins.ID,
+		State:      resource.InstanceStateDeleted,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{ins.ID}, rejected)
+
+	// each run uses a new worker, simulating that the control plane
+	// restarted in between. the retention window has to be honored
+	// anyway, because it is based on what is stored in the database.
+	runCleanup := func(failedSince time.Duration) {
+		_, err := pg.Pool.Exec(
+			ctx,
+			`UPDATE instances SET state_changed_at = now() - make_interval(secs => $1) WHERE id = $2`,
+			failedSince.Seconds(),
+			ins.ID,
+		)
+		require.NoError(t, err)
+
+		w, err := worker.NewInstanceCleanupWorker(logger, pg.DB, instance.DefaultCleanupPolicies)
+		require.NoError(t, err)
+		require.NoError(t, w.Work(ctx, nil))
+	}
+
+	runCleanup(3 * time.Minute)
+
+	actual, err := pg.DB.GetInstanceByID(ctx, ins.ID)
+	require.NoError(t, err)
+	require.Equal(t, resource.InstanceCreationFailed, actual.State)
+
+	runCleanup(6 * time.Minute)
+
+	actual, err = pg.DB.GetInstanceByID(ctx, ins.ID)
+	require.NoError(t, err)
+	require.Equal(t, resource.InstanceStateDeleting, actual.State)
+
+	// once platformd confirms the removal, the instance is gone.
+	rejected, err = pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: ins.ID,
+		State:      resource.InstanceStateDeleted,
+	}))
+	require.NoError(t, err)
+	require.Empty(t, rejected)
+
+	_, err = pg.DB.GetInstanceByID(ctx, ins.ID)
+	require.ErrorIs(t, err, apierrs.ErrInstanceNotFound)
+}
+
+func TestApplyStatusReportsStoresTraffic(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		pg     = fixture.NewPostgres()
+		nodeID = fixture.Node().ID
+		c      = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	ins := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = c.Flavors[0].Versions[0]
+		tmp.Owner = c.Owner
+		tmp.State = resource.InstanceStateCreating
+	})
+
+	_, err := pg.DB.CreateInstance(ctx, ins, nodeID)
+	require.NoError(t, err)
+
+	actual, err := pg.DB.GetInstanceByID(ctx, ins.ID)
+	require.NoError(t, err)
+	require.Nil(t, actual.Traffic)
+
+	traffic := &resource.InstanceTraffic{
+		Connections: 3,
+		RxBytes:     1024,
+		TxBytes:     2048,
+	}
+
+	_, err = pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: ins.ID,
+		Port:       1337,
+		State:      resource.InstanceStateRunning,
+		Traffic:    traffic,
+	}))
+	require.NoError(t, err)
+
+	_, revision, err := pg.DB.GetInstancesByNodeID(ctx, nodeID, 0)
+	require.NoError(t, err)
+
+	// reports without traffic, for example because envoy could not be
+	// reached, must not reset the previously reported traffic.
+	_, err = pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: ins.ID,
+		Port:       1337,
+		State:      resource.InstanceStateRunning,
+	}))
+	require.NoError(t, err)
+
+	actual, err = pg.DB.GetInstanceByID(ctx, ins.ID)
+	require.NoError(t, err)
+	require.Equal(t, traffic, actual.Traffic)
+
+	// traffic changes constantly, so it must not cause the
+	// instance to be included in every delta.
+	_, err = pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: ins.ID,
+		Port:       1337,
+		State:      resource.InstanceStateRunning,
+		Traffic: &resource.InstanceTraffic{
+			Connections: 4,
+		},
+	}))
+	require.NoError(t, err)
+
+	delta, _, err := pg.DB.GetInstancesByNodeID(ctx, nodeID, revision)
+	require.NoError(t, err)
+	require.Empty(t, delta)
+}
+
+func TestApplyStatusReportsRecordsRedirects(t *testing.T) {
+	var (
+		ctx           = context.Background()
+		pg            = fixture.NewPostgres()
+		n             = fixture.Node()
+		c             = fixture.Chunk()
+		redirectUntil = time.Now().Add(time.Minute).Truncate(time.Microsecond).UTC()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	ins := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = c.Flavors[0].Versions[0]
+		tmp.Owner = c.Owner
+		tmp.State = resource.InstanceStateCreating
+	})
+
+	_, err := pg.DB.CreateInstance(ctx, ins, n.ID)
+	require.NoError(t, err)
+
+	report := func(port uint16) []instance.StatusUpdate {
+		updates := statusUpdates(resource.InstanceStatusReport{
+			InstanceID: ins.ID,
+			Port:       port,
+			State:      resource.InstanceStateRunning,
+		})
+		updates[0].RedirectUntil = redirectUntil
+		return updates
+	}
+
+	// the instance did not have an endpoint before, so there is nothing to redirect.
+	_, err = pg.DB.ApplyStatusReports(ctx, report(1337))
+	require.NoError(t, err)
+
+	_, err = pg.DB.ApplyStatusReports(ctx, report(1337))
+	require.NoError(t, err)
+
+	redirects, err := pg.DB.GetInstanceRedirectsByNodeID(ctx, n.ID)
+	require.NoError(t, err)
+	require.Empty(t, redirects)
+
+	_, err = pg.DB.ApplyStatusReports(ctx, report(1338))
+	require.NoError(t, err)
+
+	redirects, err = pg.DB.GetInstanceRedirectsByNodeID(ctx, n.ID)
+	require.NoError(t, err)
+	require.Equal(t, []resource.InstanceRedirect{
+		{
+			InstanceID: ins.ID,
+			NodeID:     n.ID,
+			FromPort:   1337,
+			To:         netip.AddrPortFrom(n.Addr, 1338),
+			ExpiresAt:  redirectUntil,
+		},
+	}, redirects)
+
+	_, err = pg.Pool.Exec(ctx, `UPDATE instance_redirects SET expires_at = now() - interval '1 second'`)
+	require.NoError(t, err)
+
+	redirects, err = pg.DB.GetInstanceRedirectsByNodeID(ctx, n.ID)
+	require.NoError(t, err)
+	require.Empty(t, redirects)
+
+	require.NoError(t, pg.DB.DeleteExpiredInstanceRedirects(ctx))
+
+	var count int
+	require.NoError(t, pg.Pool.QueryRow(ctx, `SELECT count(*) FROM instance_redirects`).Scan(&count))
+	require.Zero(t, count)
+}
+
+func TestInstanceLogs(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		pg         = fixture.NewPostgres()
+		c          = fixture.Chunk()
+		instanceID = test.NewUUIDv7(t)
+		now        = time.Now().Truncate(time.Microsecond).UTC()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	logs := []resource.InstanceLog{
+		{
+			ID:          test.NewUUIDv7(t),
+			InstanceID:  instanceID,
+			OwnerID:     c.Owner.ID,
+			ObjectKey:   "explorer/logs/1.log",
+			SizeBytes:   10,
+			FirstLineAt: now.Add(-2 * time.Minute),
+			LastLineAt:  now.Add(-time.Minute),
+			ExpiresAt:   now.Add(time.Minute),
+		},
+		{
+			ID:          test.NewUUIDv7(t),
+			InstanceID:  instanceID,
+			OwnerID:     c.Owner.ID,
+			ObjectKey:   "explorer/logs/2.log",
+			SizeBytes:   20,
+			FirstLineAt: now.Add(-time.Minute),
+			LastLineAt:  now,
+			ExpiresAt:   now.Add(time.Hour),
+		},
+	}
+
+	for _, l := range logs {
+		require.NoError(t, pg.DB.InsertInstanceLog(ctx, l))
+	}
+
+	got, err := pg.DB.InstanceLogs(ctx, instanceID)
+	require.NoError(t, err)
+
+	// the retention of the first log has been extended by the second one
+	logs[0].ExpiresAt = logs[1].ExpiresAt
+
+	if d := cmp.Diff(logs, got, cmpopts.IgnoreFields(resource.InstanceLog{}, "CreatedAt")); d != "" {
+		t.Fatalf("diff (-want +got):\n%s", d)
+	}
+
+	expired, err := pg.DB.ExpiredInstanceLogs(ctx, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Empty(t, expired)
+
+	expired, err = pg.DB.ExpiredInstanceLogs(ctx, now.Add(2*time.Hour), 1)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+
+	require.NoError(t, pg.DB.DeleteInstanceLogs(ctx, []string{logs[0].ID, logs[1].ID}))
+
+	got, err = pg.DB.InstanceLogs(ctx, instanceID)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestInstanceUsage(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		pg     = fixture.NewPostgres()
+		nodeID = fixture.Node().ID
+		c      = fixture.Chunk()
+		now    = time.Now().UTC().Truncate(time.Microsecond)
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	// the resources of the node are copied when the instance is created.
+	require.NoError(t, pg.DB.UpdateNodeHeartbeat(ctx, nodeID, nil, &node.Capacity{
+		MaxInstances:        4,
+		WorkloadCPUMillis:   1500,
+		WorkloadMemoryBytes: 2 << 30,
+	}))
+
+	ins := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = c.Flavors[0].Versions[0]
+		tmp.Owner = c.Owner
+		tmp.CreatedAt = now.Add(-time.Hour)
+	})
+
+	_, err := pg.DB.CreateInstance(ctx, ins, nodeID)
+	require.NoError(t, err)
+
+	require.NoError(t, pg.DB.UpdateNodeHeartbeat(ctx, nodeID, nil, nil))
+
+	expected := resource.InstanceUsage{
+		InstanceID:  ins.ID,
+		ChunkID:     c.ID,
+		ChunkName:   c.Name,
+		NodeID:      nodeID,
+		CPUMillis:   1500,
+		MemoryBytes: 2 << 30,
+		NodeSlots:   4,
+		StartedAt:   ins.CreatedAt,
+	}
+
+	actual, err := pg.DB.InstanceUsage(ctx, now.Add(-2*time.Hour), now, &c.ID)
+	require.NoError(t, err)
+
+	if d := cmp.Diff([]resource.InstanceUsage{expected}, actual); d != "" {
+		t.Fatalf("diff (-want +got):\n%s", d)
+	}
+
+	// the usage is kept after the instance has been removed.
+	_, err = pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: ins.ID,
+		State:      resource.InstanceStateDeleted,
+	}))
+	require.NoError(t, err)
+
+	actual, err = pg.DB.InstanceUsage(ctx, now.Add(-2*time.Hour), now.Add(time.Hour), nil)
+	require.NoError(t, err)
+	require.Len(t, actual, 1)
+	require.NotNil(t, actual[0].StoppedAt)
+	require.WithinDuration(t, time.Now(), *actual[0].StoppedAt, time.Minute)
+
+	// instances that did not run in the window are not returned.
+	actual, err = pg.DB.InstanceUsage(ctx, now.Add(-3*time.Hour), now.Add(-2*time.Hour), nil)
+	require.NoError(t, err)
+	require.Empty(t, actual)
+
+	actual, err = pg.DB.InstanceUsage(ctx, now.Add(-2*time.Hour), now, new(test.NewUUIDv7(t)))
+	require.NoError(t, err)
+	require.Empty(t, actual)
+}
+
+func statusUpdates(reports ...resource.InstanceStatusReport) []instance.StatusUpdate {
+	ret := make([]instance.StatusUpdate, 0, len(reports))
+	for _, r := range reports {
+		ret = append(ret, instance.StatusUpdate{
+			Report: r,
+			From: instance.SourceStates(instance.Transition{
+				To:   r.State,
+				Port: r.Port,
+			}),
+		})
+	}
+	return ret
+}
@@ -0,0 +1,194 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrationBackendsAndPlayerEvents(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		n   = fixture.Node()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	running := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = c.Flavors[0].Versions[0]
+		tmp.Owner = c.Owner
+		tmp.State = resource.InstanceStateCreating
+	})
+
+	pending := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = c.Flavors[0].Versions[0]
+		tmp.Owner = c.Owner
+		tmp.State = resource.InstanceStatePending
+	})
+
+	for _, ins := range []resource.Instance{running, pending} {
+		_, err := pg.DB.CreateInstance(ctx, ins, n.ID)
+		require.NoError(t, err)
+	}
+
+	_, err := pg.DB.ApplyStatusReports(ctx, statusUpdates(resource.InstanceStatusReport{
+		InstanceID: running.ID,
+		Port:       1337,
+		State:      resource.InstanceStateRunning,
+	}))
+	require.NoError(t, err)
+
+	var (
+		player1 = test.NewUUIDv7(t)
+		player2 = test.NewUUIDv7(t)
+	)
+
+	// joins of unknown instances are ignored and joining
+	// twice does not count the player twice.
+	require.NoError(t, pg.DB.ApplyPlayerEvents(ctx, []resource.PlayerEvent{
+		{InstanceID: running.ID, PlayerID: player1, Type: resource.PlayerEventTypeJoin},
+		{InstanceID: running.ID, PlayerID: player2, Type: resource.PlayerEventTypeJoin},
+		{InstanceID: running.ID, PlayerID: player2, Type: resource.PlayerEventTypeJoin},
+		{InstanceID: test.NewUUIDv7(t), PlayerID: player1, Type: resource.PlayerEventTypeJoin},
+		{InstanceID: running.ID, PlayerID: player1, Type: resource.PlayerEventTypeLeave},
+	}))
+
+	expected := []resource.Backend{
+		{
+			InstanceID: running.ID,
+			ChunkID:    c.ID,
+			ChunkName:  c.Name,
+			FlavorName: c.Flavors[0].Name,
+			Address:    netip.AddrPortFrom(n.Addr, 1337),
+			Players:    1,
+			MaxPlayers: c.Flavors[0].Versions[0].MaxPlayers,
+		},
+	}
+
+	backends, err := pg.DB.Backends(ctx, "", "")
+	require.NoError(t, err)
+	require.Equal(t, expected, backends)
+
+	backends, err = pg.DB.Backends(ctx, c.Name, c.Flavors[0].Name)
+	require.NoError(t, err)
+	require.Equal(t, expected, backends)
+
+	backends, err = pg.DB.Backends(ctx, c.Name, "other")
+	require.NoError(t, err)
+	require.Empty(t, backends)
+
+	b, err := pg.DB.InstanceBackend(ctx, running.ID)
+	require.NoError(t, err)
+	require.Equal(t, expected[0], b)
+
+	_, err = pg.DB.InstanceBackend(ctx, pending.ID)
+	require.ErrorIs(t, err, apierrs.ErrInstanceNotRunning)
+}
+
+func TestInstanceInvites(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		n   = fixture.Node()
+		c   = fixture.Chunk()
+		now = time.Now().Truncate(time.Microsecond).UTC()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	ins := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = c.Flavors[0].Versions[0]
+		tmp.Owner = c.Owner
+	})
+
+	_, err := pg.DB.CreateInstance(ctx, ins, n.ID)
+	require.NoError(t, err)
+
+	invite := resource.InstanceInvite{
+		ID:         test.NewUUIDv7(t),
+		InstanceID: ins.ID,
+		Token:      "token",
+		MaxUses:    1,
+		CreatedBy:  c.Owner.ID,
+		ExpiresAt:  now.Add(time.Hour),
+	}
+
+	created, err := pg.DB.CreateInstanceInvite(ctx, invite)
+	require.NoError(t, err)
+
+	opts := cmpopts.IgnoreFields(resource.InstanceInvite{}, "CreatedAt")
+	if d := cmp.Diff(invite, created, opts); d != "" {
+		t.Fatalf("diff (-want +got):\n%s", d)
+	}
+
+	// the token is not stored, so it is not returned afterward
+	invite.Token = ""
+
+	got, err := pg.DB.InstanceInviteByToken(ctx, "token")
+	require.NoError(t, err)
+	if d := cmp.Diff(invite, got, opts); d != "" {
+		t.Fatalf("diff (-want +got):\n%s", d)
+	}
+
+	_, err = pg.DB.InstanceInviteByToken(ctx, "other")
+	require.ErrorIs(t, err, apierrs.ErrInstanceInviteNotFound)
+
+	used, err := pg.DB.UseInstanceInvite(ctx, invite.ID)
+	require.NoError(t, err)
+	require.True(t, used)
+
+	// max uses reached
+	used, err = pg.DB.UseInstanceInvite(ctx, invite.ID)
+	require.NoError(t, err)
+	require.False(t, used)
+
+	require.NoError(t, pg.DB.RevokeInstanceInvite(ctx, invite.ID))
+
+	invites, err := pg.DB.InstanceInvites(ctx, ins.ID)
+	require.NoError(t, err)
+	require.Len(t, invites, 1)
+	require.Equal(t, uint32(1), invites[0].Uses)
+	require.NotNil(t, invites[0].RevokedAt)
+
+	got, err = pg.DB.InstanceInviteByID(ctx, invite.ID)
+	require.NoError(t, err)
+	require.Equal(t, invites[0], got)
+}
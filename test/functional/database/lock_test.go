@@ -0,0 +1,77 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTryLock(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		key = "test/019a0000-0000-7000-8000-000000000001"
+	)
+
+	pg.Run(t, ctx)
+
+	called := false
+	locked, err := pg.DB.TryLock(ctx, key, func(ctx context.Context) error {
+		called = true
+
+		// the lock is held, so it cannot be acquired by another caller.
+		nested, err := pg.DB.TryLock(ctx, key, func(context.Context) error {
+			t.Fatal("must not be called while the lock is held")
+			return nil
+		})
+		require.NoError(t, err)
+		require.False(t, nested)
+
+		// other keys are not affected.
+		other, err := pg.DB.TryLock(ctx, "test/other", func(context.Context) error {
+			return nil
+		})
+		require.NoError(t, err)
+		require.True(t, other)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.True(t, locked)
+	require.True(t, called)
+
+	// the lock is released once fn returns, even if it failed.
+	fnErr := errors.New("fail")
+	locked, err = pg.DB.TryLock(ctx, key, func(context.Context) error {
+		return fnErr
+	})
+	require.ErrorIs(t, err, fnErr)
+	require.True(t, locked)
+
+	locked, err = pg.DB.TryLock(ctx, key, func(context.Context) error {
+		return nil
+	})
+	require.NoError(t, err)
+	require.True(t, locked)
+}
@@ -0,0 +1,65 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/test"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceMode(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		pg      = fixture.NewPostgres()
+		actorID = test.NewUUIDv7(t)
+	)
+
+	pg.Run(t, ctx)
+
+	// never changed, so it is disabled
+	m, err := pg.DB.MaintenanceMode(ctx)
+	require.NoError(t, err)
+	require.False(t, m.Enabled)
+	require.Empty(t, m.UpdatedBy)
+
+	enabled, err := pg.DB.SetMaintenanceMode(ctx, true, "database upgrade", actorID)
+	require.NoError(t, err)
+	require.True(t, enabled.Enabled)
+	require.Equal(t, "database upgrade", enabled.Message)
+	require.Equal(t, actorID, enabled.UpdatedBy)
+	require.WithinDuration(t, time.Now(), enabled.UpdatedAt, time.Minute)
+
+	m, err = pg.DB.MaintenanceMode(ctx)
+	require.NoError(t, err)
+	require.Equal(t, enabled, m)
+
+	// there is only a single row, so setting it again overwrites it
+	_, err = pg.DB.SetMaintenanceMode(ctx, false, "", actorID)
+	require.NoError(t, err)
+
+	m, err = pg.DB.MaintenanceMode(ctx)
+	require.NoError(t, err)
+	require.False(t, m.Enabled)
+	require.Empty(t, m.Message)
+}
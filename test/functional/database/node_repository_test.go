@@ -0,0 +1,183 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/node"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleCheckpointBuild(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		pg          = fixture.NewPostgres()
+		c           = fixture.Chunk()
+		otherNodeID = "0199f0b4-3a3e-7d55-9c53-1a8b0e1f2f6c"
+		seenAfter   = time.Now().Add(-time.Minute)
+		builder     = []node.Capability{node.CapabilityBuilder}
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+
+	c.Flavors = []resource.Flavor{c.Flavors[0]}
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	_, err := pg.Pool.Exec(
+		ctx,
+		`INSERT INTO nodes (id, name, address, checkpoint_api_endpoint) VALUES ($1, $2, $3, $4)`,
+		otherNodeID, "other-node", "198.51.100.2", "198.51.100.2:9012",
+	)
+	require.NoError(t, err)
+
+	var (
+		v1 = c.Flavors[0].Versions[0].ID
+		v2 = c.Flavors[0].Versions[1].ID
+	)
+
+	// nodes that have never been seen or do not advertise
+	// the builder capability must not be selected.
+
+	_, err = pg.DB.ScheduleCheckpointBuild(ctx, v1, seenAfter)
+	require.ErrorIs(t, err, apierrs.ErrNoBuilderOnline)
+
+	require.NoError(t, pg.DB.UpdateNodeHeartbeat(ctx, fixture.Node().ID, nil, nil))
+
+	_, err = pg.DB.ScheduleCheckpointBuild(ctx, v1, seenAfter)
+	require.ErrorIs(t, err, apierrs.ErrNoBuilderOnline)
+
+	require.NoError(t, pg.DB.UpdateNodeHeartbeat(ctx, fixture.Node().ID, builder, nil))
+	require.NoError(t, pg.DB.UpdateNodeHeartbeat(ctx, otherNodeID, builder, nil))
+
+	// builders that have not been seen recently are considered offline.
+	_, err = pg.DB.ScheduleCheckpointBuild(ctx, v1, time.Now().Add(time.Minute))
+	require.ErrorIs(t, err, apierrs.ErrNoBuilderOnline)
+
+	first, err := pg.DB.ScheduleCheckpointBuild(ctx, v1, seenAfter)
+	require.NoError(t, err)
+
+	err = pg.DB.UpdateFlavorVersionBuildStatus(ctx, v1, resource.FlavorVersionBuildStatusBuildCheckpoint)
+	require.NoError(t, err)
+
+	// the second build has to be placed on the other
+	// builder, because the first one is busy.
+	second, err := pg.DB.ScheduleCheckpointBuild(ctx, v2, seenAfter)
+	require.NoError(t, err)
+	require.NotEqual(t, first.ID, second.ID)
+
+	var actual string
+	err = pg.Pool.QueryRow(ctx, `SELECT checkpoint_node_id FROM flavor_versions WHERE id = $1`, v1).Scan(&actual)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, actual)
+}
+
+func TestListNodes(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+
+	c.Flavors = []resource.Flavor{c.Flavors[0]}
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	ins := fixture.Instance()
+	ins.Chunk = c
+	ins.FlavorVersion = c.Flavors[0].Versions[0]
+	ins.Owner = c.Owner
+
+	_, err := pg.DB.CreateInstance(ctx, ins, fixture.Node().ID)
+	require.NoError(t, err)
+
+	err = pg.DB.UpdateNodeHeartbeat(ctx, fixture.Node().ID, []node.Capability{node.CapabilityBuilder}, nil)
+	require.NoError(t, err)
+
+	nodes, err := pg.DB.ListNodes(ctx)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+
+	require.Equal(t, fixture.Node().ID, nodes[0].ID)
+	require.Equal(t, fixture.Node().Slots, nodes[0].Slots)
+	require.Equal(t, fixture.Node().Slots-1, nodes[0].AvailableSlots)
+	require.Equal(t, []node.Capability{node.CapabilityBuilder}, nodes[0].Capabilities)
+	require.WithinDuration(t, time.Now(), nodes[0].LastSeenAt, time.Minute)
+}
+
+func TestNodeMaxInstances(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+
+	c.Flavors = []resource.Flavor{c.Flavors[0]}
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	newInstance := func() resource.Instance {
+		ins := fixture.Instance()
+		ins.ID = test.NewUUIDv7(t)
+		ins.Chunk = c
+		ins.FlavorVersion = c.Flavors[0].Versions[0]
+		ins.Owner = c.Owner
+		return ins
+	}
+
+	// the node reports less instances than slots have been configured
+	require.NoError(t, pg.DB.UpdateNodeHeartbeat(ctx, fixture.Node().ID, nil, &node.Capacity{MaxInstances: 1}))
+
+	nodes, err := pg.DB.ListNodes(ctx)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	require.Equal(t, 1, nodes[0].Slots)
+	require.Equal(t, 1, nodes[0].AvailableSlots)
+
+	_, err = pg.DB.CreateInstance(ctx, newInstance(), fixture.Node().ID)
+	require.NoError(t, err)
+
+	_, err = pg.DB.BestNode(ctx, "")
+	require.ErrorIs(t, err, apierrs.ErrNoSlotsAvailable)
+
+	_, err = pg.DB.CreateInstance(ctx, newInstance(), fixture.Node().ID)
+	require.ErrorIs(t, err, apierrs.ErrNoSlotsAvailable)
+
+	// once the node stops reporting max instances, the slots apply again
+	require.NoError(t, pg.DB.UpdateNodeHeartbeat(ctx, fixture.Node().ID, nil, nil))
+
+	n, err := pg.DB.BestNode(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, fixture.Node().Slots, n.Slots)
+	require.Equal(t, fixture.Node().Slots-1, n.AvailableSlots)
+}
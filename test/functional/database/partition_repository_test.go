@@ -0,0 +1,61 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spacechunks/explorer/controlplane/partition"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonthlyPartitions(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+	)
+
+	pg.Run(t, ctx)
+
+	old := time.Date(2020, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	// creating a partition is idempotent
+	require.NoError(t, pg.DB.CreateMonthlyPartition(ctx, partition.TableNodeEvents, old))
+	require.NoError(t, pg.DB.CreateMonthlyPartition(ctx, partition.TableNodeEvents, old))
+
+	// the partition still contains rows created right before the cutoff
+	dropped, err := pg.DB.DropMonthlyPartitionsBefore(
+		ctx,
+		partition.TableNodeEvents,
+		time.Date(2020, 3, 31, 23, 59, 0, 0, time.UTC),
+	)
+	require.NoError(t, err)
+	require.Empty(t, dropped)
+
+	dropped, err = pg.DB.DropMonthlyPartitionsBefore(
+		ctx,
+		partition.TableNodeEvents,
+		time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"node_events_202003"}, dropped)
+}
@@ -0,0 +1,84 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/rollout"
+	"github.com/spacechunks/explorer/test"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlatformdRollouts(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		pg     = fixture.NewPostgres()
+		nodeID = fixture.Node().ID
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+
+	r, err := pg.DB.ReportPlatformdVersion(ctx, nodeID, "v1")
+	require.NoError(t, err)
+	require.Equal(t, "v1", r.CurrentVersion)
+	require.Equal(t, rollout.StatusNone, r.Status)
+
+	// unknown nodes do not start the rollout for any node
+	_, err = pg.DB.SetDesiredPlatformdVersion(ctx, []string{nodeID, test.NewUUIDv7(t)}, "v2")
+	require.ErrorIs(t, err, apierrs.ErrNodeNotFound)
+
+	rollouts, err := pg.DB.ListPlatformdRollouts(ctx)
+	require.NoError(t, err)
+	require.Len(t, rollouts, 1)
+	require.Empty(t, rollouts[0].DesiredVersion)
+
+	rollouts, err = pg.DB.SetDesiredPlatformdVersion(ctx, nil, "v2")
+	require.NoError(t, err)
+	require.Len(t, rollouts, 1)
+	require.Equal(t, "v2", rollouts[0].DesiredVersion)
+	require.Equal(t, rollout.StatusPending, rollouts[0].Status)
+
+	// reports for other versions are ignored
+	require.NoError(t, pg.DB.UpdatePlatformdUpdateStatus(ctx, nodeID, "v3", rollout.StatusFailed, "boom"))
+	require.NoError(t, pg.DB.UpdatePlatformdUpdateStatus(ctx, nodeID, "v2", rollout.StatusInstalling, ""))
+
+	rollouts, err = pg.DB.ListPlatformdRollouts(ctx)
+	require.NoError(t, err)
+	require.Equal(t, rollout.StatusInstalling, rollouts[0].Status)
+
+	// the rollout completes once the node runs the desired version
+	r, err = pg.DB.ReportPlatformdVersion(ctx, nodeID, "v2")
+	require.NoError(t, err)
+	require.Equal(t, "v2", r.CurrentVersion)
+	require.Equal(t, rollout.StatusSucceeded, r.Status)
+	require.False(t, r.UpdatedAt.IsZero())
+
+	// nodes already running the version are done right away
+	rollouts, err = pg.DB.SetDesiredPlatformdVersion(ctx, []string{nodeID}, "v2")
+	require.NoError(t, err)
+	require.Equal(t, rollout.StatusSucceeded, rollouts[0].Status)
+
+	_, err = pg.DB.ReportPlatformdVersion(ctx, test.NewUUIDv7(t), "v2")
+	require.ErrorIs(t, err, apierrs.ErrNodeNotFound)
+}
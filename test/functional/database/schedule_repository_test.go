@@ -0,0 +1,109 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/controlplane/schedule"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceSchedules(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		n   = fixture.Node()
+		c   = fixture.Chunk()
+	)
+
+	pg.Run(t, ctx)
+	pg.InsertNode(t)
+	pg.InsertMinecraftVersion(t)
+	pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+
+	version := c.Flavors[0].Versions[0]
+
+	created, err := pg.DB.CreateInstanceSchedule(ctx, resource.InstanceSchedule{
+		ChunkID:         c.ID,
+		FlavorVersionID: version.ID,
+		StartCron:       "0 18 * * FRI",
+		StopCron:        "0 23 * * FRI",
+		Timezone:        "Europe/Berlin",
+		EvaluatedAt:     time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+		CreatedBy:       c.Owner.ID,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+	require.Empty(t, created.InstanceID)
+
+	actual, err := pg.DB.InstanceScheduleByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, created, actual)
+
+	l, err := pg.DB.InstanceSchedules(ctx, c.ID)
+	require.NoError(t, err)
+	require.Equal(t, []resource.InstanceSchedule{created}, l)
+
+	ins := fixture.Instance(func(tmp *resource.Instance) {
+		tmp.ID = test.NewUUIDv7(t)
+		tmp.FlavorVersion = version
+		tmp.Owner = c.Owner
+	})
+
+	_, err = pg.DB.CreateInstance(ctx, ins, n.ID)
+	require.NoError(t, err)
+
+	ranAt := time.Date(2026, 10, 17, 12, 1, 0, 0, time.UTC)
+	require.NoError(t, pg.DB.RecordInstanceScheduleRun(ctx, created.ID, ins.ID, ranAt))
+
+	targets, err := pg.DB.ActiveInstanceSchedules(ctx)
+	require.NoError(t, err)
+
+	expected := created
+	expected.InstanceID = ins.ID
+	expected.EvaluatedAt = ranAt
+	require.Equal(t, []schedule.Target{{Schedule: expected, OwnerID: c.Owner.ID}}, targets)
+
+	evaluatedAt := time.Date(2026, 10, 17, 12, 2, 0, 0, time.UTC)
+	require.NoError(t, pg.DB.MarkInstanceScheduleEvaluated(ctx, created.ID, evaluatedAt))
+
+	// marking the schedule evaluated keeps its instance.
+	actual, err = pg.DB.InstanceScheduleByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, ins.ID, actual.InstanceID)
+	require.Equal(t, evaluatedAt, actual.EvaluatedAt)
+
+	require.NoError(t, pg.DB.RecordInstanceScheduleRun(ctx, created.ID, "", evaluatedAt))
+
+	actual, err = pg.DB.InstanceScheduleByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Empty(t, actual.InstanceID)
+
+	require.NoError(t, pg.DB.DeleteInstanceSchedule(ctx, created.ID))
+
+	_, err = pg.DB.InstanceScheduleByID(ctx, created.ID)
+	require.ErrorIs(t, err, apierrs.ErrScheduleNotFound)
+}
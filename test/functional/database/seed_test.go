@@ -0,0 +1,65 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedDemo(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		d   = fixture.DemoData()
+	)
+
+	pg.Run(t, ctx)
+
+	require.Equal(t, d, fixture.DemoData())
+
+	// seeding twice must not fail or duplicate anything
+	require.NoError(t, fixture.SeedDemo(ctx, pg.Pool, d))
+	require.NoError(t, fixture.SeedDemo(ctx, pg.Pool, d))
+
+	for _, c := range d.Chunks {
+		actual, err := pg.DB.GetChunkByID(ctx, c.ID)
+		require.NoError(t, err)
+		require.Equal(t, c.Name, actual.Name)
+		require.Equal(t, c.Owner.ID, actual.Owner.ID)
+		require.Len(t, actual.Flavors, len(c.Flavors))
+
+		for i, f := range c.Flavors {
+			require.Equal(t, f.ID, actual.Flavors[i].ID)
+			require.Len(t, actual.Flavors[i].Versions, len(f.Versions))
+			require.Equal(t, f.Versions[0].Hash, actual.Flavors[i].Versions[0].Hash)
+		}
+	}
+
+	for _, ins := range d.Instances {
+		actual, err := pg.DB.GetInstanceByID(ctx, ins.ID)
+		require.NoError(t, err)
+		require.Equal(t, ins.State, actual.State)
+		require.Equal(t, ins.FlavorVersion.ID, actual.FlavorVersion.ID)
+		require.Equal(t, ins.Port, actual.Port)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeSettings(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		pg      = fixture.NewPostgres()
+		actorID = test.NewUUIDv7(t)
+	)
+
+	pg.Run(t, ctx)
+
+	// never changed, so all features are enabled
+	s, err := pg.DB.RuntimeSettings(ctx)
+	require.NoError(t, err)
+	require.Empty(t, s.LogLevels)
+	require.True(t, s.Enabled(resource.FeatureFlagDeltaDiscovery))
+
+	_, err = pg.DB.SetLogLevels(ctx, resource.LogTargetPlatformd, "info,reconciler=debug", actorID)
+	require.NoError(t, err)
+
+	_, err = pg.DB.SetFeatureFlag(ctx, resource.FeatureFlagDeltaDiscovery, false, actorID)
+	require.NoError(t, err)
+
+	s, err = pg.DB.SetLogLevels(ctx, resource.LogTargetPlatformd, "warn", actorID)
+	require.NoError(t, err)
+
+	expected := resource.RuntimeSettings{
+		LogLevels: map[resource.LogTarget]string{
+			resource.LogTargetPlatformd: "warn",
+		},
+		FeatureFlags: map[resource.FeatureFlag]bool{
+			resource.FeatureFlagDeltaDiscovery: false,
+		},
+	}
+
+	require.Equal(t, expected, s)
+
+	s, err = pg.DB.RuntimeSettings(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, s)
+
+	changes, err := pg.DB.RuntimeSettingChanges(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	// newest first
+	require.Equal(t, "log-levels/platformd", changes[0].Setting)
+	require.Equal(t, "info,reconciler=debug", changes[0].OldValue)
+	require.Equal(t, "warn", changes[0].NewValue)
+	require.Equal(t, actorID, changes[0].ActorID)
+
+	require.Equal(t, "feature-flags/delta-discovery", changes[1].Setting)
+	require.Empty(t, changes[1].OldValue)
+	require.Equal(t, "false", changes[1].NewValue)
+
+	require.Equal(t, "log-levels/platformd", changes[2].Setting)
+	require.Empty(t, changes[2].OldValue)
+}
@@ -21,9 +21,11 @@ package database
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	apierrs "github.com/spacechunks/explorer/controlplane/errors"
+	"github.com/spacechunks/explorer/internal/resource"
 	"github.com/spacechunks/explorer/test"
 	"github.com/spacechunks/explorer/test/fixture"
 	"github.com/stretchr/testify/require"
@@ -83,3 +85,162 @@ func TestCreateUserWithExistingEmailFails(t *testing.T) {
 	_, err = pg.DB.CreateUser(ctx, u)
 	require.ErrorIs(t, err, apierrs.ErrAlreadyExists)
 }
+
+func TestTokenRevoked(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		pg      = fixture.NewPostgres()
+		u       = fixture.User()
+		tokenID = "019a0000-0000-7000-8000-000000000001"
+		now     = time.Now()
+	)
+
+	pg.Run(t, ctx)
+	pg.CreateUser(t, &u)
+
+	revoked, err := pg.DB.TokenRevoked(ctx, tokenID, u.ID, now)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, pg.DB.RevokeToken(ctx, tokenID, u.ID, now.Add(time.Hour)))
+
+	revoked, err = pg.DB.TokenRevoked(ctx, tokenID, u.ID, now)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// other tokens of the user are still valid
+	revoked, err = pg.DB.TokenRevoked(ctx, "other-token", u.ID, now)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, pg.DB.RevokeUserTokens(ctx, u.ID, now))
+
+	revoked, err = pg.DB.TokenRevoked(ctx, "other-token", u.ID, now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// tokens issued after the revocation are valid
+	revoked, err = pg.DB.TokenRevoked(ctx, "other-token", u.ID, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
+
+func TestDeleteUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer bool
+	}{
+		{
+			name: "chunks are marked deleted",
+		},
+		{
+			name:     "chunks are transferred",
+			transfer: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx   = context.Background()
+				pg    = fixture.NewPostgres()
+				c     = fixture.Chunk()
+				other = fixture.User(func(u *resource.User) {
+					u.Nickname = "other-user"
+					u.Email = "other-user@example.com"
+				})
+			)
+
+			pg.Run(t, ctx)
+			pg.InsertMinecraftVersion(t)
+			pg.CreateChunk(t, &c, fixture.CreateOptionsAll)
+			pg.CreateUser(t, &other)
+
+			owner := c.Owner
+
+			require.NoError(t, pg.DB.UpdateNotificationPreferences(ctx, owner.ID, resource.NotificationPreferences{
+				BuildCompleted: true,
+			}))
+
+			var transferTo string
+			if tt.transfer {
+				transferTo = other.ID
+			}
+
+			chunkIDs, err := pg.DB.DeleteUser(ctx, owner.ID, transferTo)
+			require.NoError(t, err)
+			require.Equal(t, []string{c.ID}, chunkIDs)
+
+			u, err := pg.DB.GetUserByID(ctx, owner.ID)
+			require.NoError(t, err)
+			require.NotEqual(t, owner.Nickname, u.Nickname)
+			require.Equal(t, owner.ID+"@deleted.invalid", u.Email)
+
+			_, err = pg.DB.GetUserByEmail(ctx, owner.Email)
+			require.ErrorIs(t, err, apierrs.ErrNotFound)
+
+			prefs, err := pg.DB.NotificationPreferences(ctx, owner.ID)
+			require.NoError(t, err)
+			require.Equal(t, resource.NotificationPreferences{}, prefs)
+
+			actual, err := pg.DB.GetChunkByID(ctx, c.ID)
+			require.NoError(t, err)
+
+			if tt.transfer {
+				require.Equal(t, other.ID, actual.Owner.ID)
+				require.Nil(t, actual.DeletedAt)
+				return
+			}
+
+			require.Equal(t, owner.ID, actual.Owner.ID)
+			require.NotNil(t, actual.DeletedAt)
+			for _, f := range actual.Flavors {
+				require.NotNil(t, f.DeletedAt)
+			}
+		})
+	}
+}
+
+func TestImpersonations(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+		u   = fixture.User()
+	)
+
+	pg.Run(t, ctx)
+	pg.CreateUser(t, &u)
+
+	first, err := pg.DB.CreateImpersonation(ctx, resource.Impersonation{
+		UserID:    u.ID,
+		AdminID:   fixture.AdminUserID,
+		Reason:    "ticket 1",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	second, err := pg.DB.CreateImpersonation(ctx, resource.Impersonation{
+		UserID:    u.ID,
+		AdminID:   fixture.AdminUserID,
+		Reason:    "ticket 2",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, pg.DB.RecordImpersonatedAction(ctx, first.ID, "/a"))
+	require.NoError(t, pg.DB.RecordImpersonatedAction(ctx, first.ID, "/b"))
+
+	imps, err := pg.DB.ImpersonationsByUserID(ctx, u.ID)
+	require.NoError(t, err)
+	require.Len(t, imps, 2)
+
+	// the most recent impersonation comes first
+	require.Equal(t, second.ID, imps[0].ID)
+	require.Empty(t, imps[0].Actions)
+
+	require.Equal(t, first.ID, imps[1].ID)
+	require.Equal(t, "ticket 1", imps[1].Reason)
+	require.Len(t, imps[1].Actions, 2)
+	require.Equal(t, "/a", imps[1].Actions[0].Method)
+	require.Equal(t, "/b", imps[1].Actions[1].Method)
+}
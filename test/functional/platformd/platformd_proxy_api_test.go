@@ -189,6 +189,7 @@ func createListeners(
 		netip.MustParseAddrPort(fmt.Sprintf("%s:%d", ip, proxy.HTTPPort)),
 		netip.MustParseAddrPort(fmt.Sprintf("%s:%d", ip, proxy.TCPPort)),
 		proxy.OriginalDstClusterName,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -54,3 +54,74 @@ func TestImagePull(t *testing.T) {
 
 	require.FileExists(t, cacheDir+"/"+strings.ReplaceAll(ref, "/", "_"))
 }
+
+func TestImageTag(t *testing.T) {
+	tests := []struct {
+		name string
+		dst  string
+	}{
+		{
+			name: "same repository",
+			dst:  "/test:tagged",
+		},
+		{
+			name: "different repository",
+			dst:  "/other:tagged",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx     = context.Background()
+				service = image.NewService(
+					slog.New(slog.NewTextHandler(os.Stdout, nil)),
+					fixture.OCIRegsitryUser,
+					fixture.OCIRegistryPass,
+					"",
+				)
+				endpoint = strings.ReplaceAll(fixture.RunRegistry(t), "http://", "")
+				src      = endpoint + "/test:latest"
+				dst      = endpoint + tt.dst
+			)
+
+			err := service.Tag(ctx, src, dst)
+			require.ErrorIs(t, err, image.ErrNotFound)
+
+			err = service.Push(ctx, imgtestdata.Image(t), src)
+			require.NoError(t, err)
+
+			err = service.Tag(ctx, src, dst)
+			require.NoError(t, err)
+
+			_, err = service.Pull(ctx, dst, "linux/amd64")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestImageDelete(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		service = image.NewService(
+			slog.New(slog.NewTextHandler(os.Stdout, nil)),
+			fixture.OCIRegsitryUser,
+			fixture.OCIRegistryPass,
+			"",
+		)
+		endpoint = strings.ReplaceAll(fixture.RunRegistry(t), "http://", "")
+		ref      = endpoint + "/test:latest"
+	)
+
+	err := service.Delete(ctx, ref)
+	require.ErrorIs(t, err, image.ErrNotFound)
+
+	err = service.Push(ctx, imgtestdata.Image(t), ref)
+	require.NoError(t, err)
+
+	err = service.Delete(ctx, ref)
+	require.NoError(t, err)
+
+	_, err = service.Fetch(ctx, ref)
+	require.ErrorIs(t, err, image.ErrNotFound)
+}
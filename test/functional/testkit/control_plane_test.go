@@ -0,0 +1,70 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package testkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	"github.com/spacechunks/explorer/test/fixture"
+	"github.com/spacechunks/explorer/testkit"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestControlPlaneServesAuthenticatedRequests(t *testing.T) {
+	var (
+		ctx = context.Background()
+		pg  = fixture.NewPostgres()
+	)
+
+	pg.Run(t, ctx)
+
+	var (
+		cp     = testkit.RunControlPlane(t, testkit.WithPostgresDSN(pg.ConnString))
+		client = cp.ChunkClient()
+		u      = cp.CreateUser(t, "testkit", "testkit@example.com")
+	)
+
+	_, err := client.CreateChunk(ctx, &chunkv1alpha1.CreateChunkRequest{
+		Name: "unauthenticated",
+	})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	authCtx := cp.AuthContext(t, ctx, u)
+
+	created, err := client.CreateChunk(authCtx, &chunkv1alpha1.CreateChunkRequest{
+		Name:        "testkit",
+		Description: "created by the testkit",
+		Tags:        []string{"pvp"},
+	})
+	require.NoError(t, err)
+
+	resp, err := client.GetChunk(authCtx, &chunkv1alpha1.GetChunkRequest{
+		Id: created.GetChunk().GetId(),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "testkit", resp.GetChunk().GetName())
+	require.Equal(t, u.GetId(), resp.GetChunk().GetOwner().GetId())
+	require.WithinDuration(t, time.Now(), resp.GetChunk().GetCreatedAt().AsTime(), time.Minute)
+}
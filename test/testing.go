@@ -36,7 +36,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func RandHexStr(t *testing.T) string {
+func RandHexStr(t testing.TB) string {
 	bytes := make([]byte, 4)
 	if _, err := rand.Read(bytes); err != nil {
 		t.Fatalf("failed reading random bytes: %v", err)
@@ -47,7 +47,7 @@ func RandHexStr(t *testing.T) string {
 // WaitServerReady waits until a process, usually some kind of server, can
 // accept connections. Fails after no successful connection could be established
 // after the timeout.
-func WaitServerReady(t *testing.T, network, addr string, timeout time.Duration) {
+func WaitServerReady(t testing.TB, network, addr string, timeout time.Duration) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
@@ -66,7 +66,7 @@ func WaitServerReady(t *testing.T, network, addr string, timeout time.Duration)
 	}
 }
 
-func NewUUIDv7(t *testing.T) string {
+func NewUUIDv7(t testing.TB) string {
 	id, err := uuid.NewV7()
 	require.NoError(t, err)
 	return id.String()
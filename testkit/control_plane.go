@@ -0,0 +1,299 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package testkit allows running a faked control plane inside of go tests.
+// The control plane runs in dev mode, so it does not depend on any external
+// services apart from postgres: objects are stored on the filesystem and
+// image and checkpoint builds are stubbed out and complete immediately.
+// [RunPlatformd] starts a fake platformd that instances can be scheduled to.
+//
+// The functions and types exported by this package are considered stable.
+package testkit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"net"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	chunkv1alpha1 "github.com/spacechunks/explorer/api/chunk/v1alpha1"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	userv1alpha1 "github.com/spacechunks/explorer/api/user/v1alpha1"
+	"github.com/spacechunks/explorer/controlplane"
+	"github.com/spacechunks/explorer/controlplane/blob"
+	"github.com/spacechunks/explorer/controlplane/dev"
+	"github.com/spacechunks/explorer/controlplane/instance"
+	"github.com/spacechunks/explorer/controlplane/postgres"
+	"github.com/spacechunks/explorer/controlplane/postgres/migrations"
+	"github.com/spacechunks/explorer/controlplane/signing"
+	"github.com/spacechunks/explorer/controlplane/user"
+	"github.com/spacechunks/explorer/internal/resource"
+	"github.com/spacechunks/explorer/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	apiTokenIssuer = "testkit.explorer.chunks.cloud"
+	apiTokenExpiry = time.Hour
+)
+
+// MinecraftVersions contains the minecraft versions that
+// flavors can be created with.
+var MinecraftVersions = dev.MinecraftVersions
+
+type Option func(*options)
+
+type options struct {
+	postgresDSN string
+	logger      *slog.Logger
+}
+
+// WithPostgresDSN uses the database behind the given dsn instead of
+// starting an embedded postgres server. migrations are applied
+// automatically, so the database should be empty.
+func WithPostgresDSN(dsn string) Option {
+	return func(o *options) {
+		o.postgresDSN = dsn
+	}
+}
+
+// WithLogger sets the logger used by the control plane.
+// by default all logs are discarded.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// ControlPlane is a control plane running in the
+// background for the duration of a test.
+type ControlPlane struct {
+	// Addr is the address the grpc api is served on.
+	Addr string
+
+	conn   *grpc.ClientConn
+	pool   *pgxpool.Pool
+	db     *postgres.DB
+	signer signing.Signer
+}
+
+// RunControlPlane starts a new control plane and waits until it is able
+// to serve requests. it is stopped once the test and all its subtests
+// have completed.
+func RunControlPlane(t testing.TB, opts ...Option) *ControlPlane {
+	o := options{
+		logger: slog.New(slog.DiscardHandler),
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var (
+		ctx     = context.Background()
+		dataDir = t.TempDir()
+		addr    = freeAddr(t)
+		upload  = freeAddr(t)
+	)
+
+	if o.postgresDSN == "" {
+		dsn, stop, err := dev.StartPostgres(dataDir, freePort(t), io.Discard)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, stop())
+		})
+		o.postgresDSN = dsn
+	}
+
+	require.NoError(t, migrations.Migrate(o.postgresDSN))
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	keyPem := pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: der,
+	})
+
+	server := controlplane.NewServer(o.logger, controlplane.Config{
+		ListenAddr:                   addr,
+		DBConnString:                 o.postgresDSN,
+		OCIRegistry:                  "registry.testkit.local",
+		ImageCacheDir:                filepath.Join(dataDir, "images"),
+		PresignedURLExpiry:           5 * time.Minute,
+		StorageBackend:               string(blob.BackendFilesystem),
+		StorageDir:                   filepath.Join(dataDir, "blobs"),
+		StorageUploadListenAddr:      upload,
+		StorageUploadPublicURL:       "http://" + upload,
+		APITokenIssuer:               apiTokenIssuer,
+		APITokenExpiry:               apiTokenExpiry,
+		ImpersonationMaxDuration:     time.Hour,
+		APITokenSigningKey:           string(keyPem),
+		ThumbnailMaxSizeKB:           1000,
+		ResourcePackBuildInterval:    time.Hour,
+		ChangeSetTarballMaxSizeBytes: 1 << 30,
+		AssetMaxSizeBytes:            1 << 30,
+		MediaMaxSizeBytes:            1 << 30,
+		MediaMaxCount:                10,
+		SnapshotMaxSizeBytes:         1 << 30,
+		ArchiveInterval:              time.Minute,
+		RetentionInterval:            time.Minute,
+		InstanceCleanupInterval:      30 * time.Second,
+		InstanceCleanupPolicies:      instance.DefaultCleanupPolicies,
+		AutoscalerInterval:           30 * time.Second,
+		AutoscalerDemandWindow:       time.Minute,
+		AutoscalingMaxInstances:      20,
+		DisableTracing:               true,
+		DevMode:                      true,
+	})
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Run(ctx)
+	}()
+
+	test.WaitServerReady(t, "tcp", addr, 20*time.Second)
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	pool, err := pgxpool.New(ctx, o.postgresDSN)
+	require.NoError(t, err)
+
+	// cleanups are executed in reverse order, so the control
+	// plane is stopped before the database goes away.
+	t.Cleanup(func() {
+		_ = conn.Close()
+		pool.Close()
+		server.Stop()
+		require.NoError(t, <-errCh)
+	})
+
+	keyRing, err := signing.NewKeyRing(o.logger, key, nil, signing.Config{})
+	require.NoError(t, err)
+
+	return &ControlPlane{
+		Addr:   addr,
+		conn:   conn,
+		pool:   pool,
+		db:     postgres.NewDB(o.logger, pool),
+		signer: keyRing,
+	}
+}
+
+func (c *ControlPlane) ChunkClient() chunkv1alpha1.ChunkServiceClient {
+	return chunkv1alpha1.NewChunkServiceClient(c.conn)
+}
+
+func (c *ControlPlane) InstanceClient() instancev1alpha1.InstanceServiceClient {
+	return instancev1alpha1.NewInstanceServiceClient(c.conn)
+}
+
+func (c *ControlPlane) UserClient() userv1alpha1.UserServiceClient {
+	return userv1alpha1.NewUserServiceClient(c.conn)
+}
+
+// CreateUser registers a new user without going through an identity
+// provider. use [ControlPlane.AuthContext] to issue requests as this user.
+func (c *ControlPlane) CreateUser(t testing.TB, nickname string, email string) *userv1alpha1.User {
+	u, err := c.db.CreateUser(context.Background(), resource.User{
+		Nickname: nickname,
+		Email:    email,
+	})
+	require.NoError(t, err)
+
+	return &userv1alpha1.User{
+		Id:        u.ID,
+		Nickname:  u.Nickname,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+		UpdatedAt: timestamppb.New(u.UpdatedAt),
+	}
+}
+
+// AuthContext returns a context that authenticates all requests made
+// with it as the given user.
+func (c *ControlPlane) AuthContext(t testing.TB, ctx context.Context, u *userv1alpha1.User) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", c.APIToken(t, u))
+}
+
+// APIToken issues an api token for the given user. it is useful for
+// clients that attach the token to requests themselves.
+func (c *ControlPlane) APIToken(t testing.TB, u *userv1alpha1.User) string {
+	tok, err := user.NewAPIToken(c.signer, apiTokenIssuer, apiTokenExpiry, resource.User{
+		ID: u.GetId(),
+	})
+	require.NoError(t, err)
+
+	return string(tok)
+}
+
+// RegisterNode makes the given fake platformd known to the control plane,
+// so instances can be scheduled to it.
+func (c *ControlPlane) RegisterNode(t testing.TB, p *Platformd, slots int) {
+	RegisterNode(t, c.pool, p, slots)
+}
+
+// RegisterNode inserts the given fake platformd into the nodes table of
+// the database behind pool. this is useful when the control plane has
+// not been started by [RunControlPlane].
+func RegisterNode(t testing.TB, pool *pgxpool.Pool, p *Platformd, slots int) {
+	_, err := pool.Exec(
+		context.Background(),
+		`INSERT INTO nodes (id, name, address, checkpoint_api_endpoint, slots) VALUES ($1, $2, $3, $4, $5)`,
+		p.NodeID,
+		"fake-platformd-"+p.NodeID,
+		p.Addr.Addr().String(),
+		p.Addr.String(),
+		slots,
+	)
+	require.NoError(t, err)
+}
+
+func freeAddr(t testing.TB) string {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	return lis.Addr().String()
+}
+
+func freePort(t testing.TB) uint32 {
+	_, port, err := net.SplitHostPort(freeAddr(t))
+	require.NoError(t, err)
+
+	p, err := strconv.ParseUint(port, 10, 32)
+	require.NoError(t, err)
+
+	return uint32(p)
+}
@@ -0,0 +1,477 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package testkit
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	checkpointv1alpha1 "github.com/spacechunks/explorer/api/platformd/checkpoint/v1alpha1"
+	workloadv1alpha2 "github.com/spacechunks/explorer/api/platformd/workload/v1alpha2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Workload is a snapshot of a workload run by the fake platformd.
+type Workload struct {
+	InstanceID      string
+	FlavorVersionID string
+	Port            uint32
+}
+
+type PlatformdOption func(*platformdOptions)
+
+type platformdOptions struct {
+	nodeID             string
+	syncInterval       time.Duration
+	failingCheckpoints bool
+	failingWorkloads   bool
+	logger             *slog.Logger
+}
+
+// WithNodeID sets the id the fake platformd uses to discover instances.
+// by default a random one is generated.
+func WithNodeID(id string) PlatformdOption {
+	return func(o *platformdOptions) {
+		o.nodeID = id
+	}
+}
+
+// WithSyncInterval sets how often instances are discovered and status
+// reports are sent to the control plane. defaults to 200ms.
+func WithSyncInterval(d time.Duration) PlatformdOption {
+	return func(o *platformdOptions) {
+		o.syncInterval = d
+	}
+}
+
+// WithFailingCheckpoints makes all checkpoints end up in the failed state.
+func WithFailingCheckpoints() PlatformdOption {
+	return func(o *platformdOptions) {
+		o.failingCheckpoints = true
+	}
+}
+
+// WithFailingWorkloads makes the creation of all workloads fail.
+func WithFailingWorkloads() PlatformdOption {
+	return func(o *platformdOptions) {
+		o.failingWorkloads = true
+	}
+}
+
+// WithPlatformdLogger sets the logger used by the fake platformd.
+// by default all logs are discarded.
+func WithPlatformdLogger(logger *slog.Logger) PlatformdOption {
+	return func(o *platformdOptions) {
+		o.logger = logger
+	}
+}
+
+// Platformd is an in-memory fake of platformd. it serves the checkpoint
+// and workload apis and syncs instances with the control plane the same
+// way platformd does, but without running any containers. checkpoints
+// complete immediately and workloads are running as soon as they are
+// created, until they are terminated using [Platformd.Crash],
+// [Platformd.OOMKill] or deleted through the control plane.
+type Platformd struct {
+	checkpointv1alpha1.UnimplementedCheckpointServiceServer
+	workloadv1alpha2.UnimplementedWorkloadServiceServer
+
+	// NodeID is the key used to discover instances
+	// scheduled to this node.
+	NodeID string
+
+	// Addr is the address the checkpoint and workload apis are served on.
+	Addr netip.AddrPort
+
+	opts      platformdOptions
+	insClient instancev1alpha1.InstanceServiceClient
+
+	mu          sync.Mutex
+	nextPort    uint32
+	checkpoints map[string]checkpointv1alpha1.CheckpointState
+	workloads   map[string]*fakeWorkload
+	reports     map[string]*instancev1alpha1.InstanceStatusReport
+}
+
+type fakeWorkload struct {
+	instance   *instancev1alpha1.Instance
+	port       uint32
+	terminated *instancev1alpha1.InstanceEvent
+}
+
+// RunPlatformd starts a fake platformd that syncs with the control plane
+// reachable through the passed client. it is stopped once the test and
+// all its subtests have completed.
+func RunPlatformd(t testing.TB, insClient instancev1alpha1.InstanceServiceClient, opts ...PlatformdOption) *Platformd {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var (
+		p = newPlatformd(insClient, opts...)
+		o = p.opts
+	)
+
+	p.Addr = netip.MustParseAddrPort(lis.Addr().String())
+
+	grpcServer := grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	checkpointv1alpha1.RegisterCheckpointServiceServer(grpcServer, p)
+	workloadv1alpha2.RegisterWorkloadServiceServer(grpcServer, p)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		if err := grpcServer.Serve(lis); err != nil {
+			o.logger.Error("failed to serve", "err", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(o.syncInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.sync(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		grpcServer.Stop()
+		wg.Wait()
+	})
+
+	return p
+}
+
+func newPlatformd(insClient instancev1alpha1.InstanceServiceClient, opts ...PlatformdOption) *Platformd {
+	o := platformdOptions{
+		nodeID:       uuid.NewString(),
+		syncInterval: 200 * time.Millisecond,
+		logger:       slog.New(slog.DiscardHandler),
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Platformd{
+		NodeID:      o.nodeID,
+		opts:        o,
+		insClient:   insClient,
+		nextPort:    30000,
+		checkpoints: make(map[string]checkpointv1alpha1.CheckpointState),
+		workloads:   make(map[string]*fakeWorkload),
+		reports:     make(map[string]*instancev1alpha1.InstanceStatusReport),
+	}
+}
+
+// Workloads returns all workloads that are currently running.
+func (p *Platformd) Workloads() []Workload {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ret := make([]Workload, 0, len(p.workloads))
+	for id, wl := range p.workloads {
+		if wl.terminated != nil {
+			continue
+		}
+		ret = append(ret, Workload{
+			InstanceID:      id,
+			FlavorVersionID: wl.instance.GetFlavorVersion().GetId(),
+			Port:            wl.port,
+		})
+	}
+
+	return ret
+}
+
+// Crash terminates the workload of the given instance as if
+// the minecraft server exited with the given exit code.
+func (p *Platformd) Crash(instanceID string, exitCode int32) bool {
+	typ := instancev1alpha1.InstanceEventType_CRASHED
+	if exitCode == 0 {
+		typ = instancev1alpha1.InstanceEventType_EXITED
+	}
+	return p.terminate(instanceID, typ, exitCode, "")
+}
+
+// OOMKill terminates the workload of the given instance as if the
+// minecraft server had been killed for exceeding its memory limit.
+func (p *Platformd) OOMKill(instanceID string) bool {
+	return p.terminate(instanceID, instancev1alpha1.InstanceEventType_OOM_KILLED, 137, "OOMKilled")
+}
+
+func (p *Platformd) terminate(
+	instanceID string,
+	typ instancev1alpha1.InstanceEventType,
+	exitCode int32,
+	msg string,
+) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	wl, ok := p.workloads[instanceID]
+	if !ok || wl.terminated != nil {
+		return false
+	}
+
+	wl.terminated = &instancev1alpha1.InstanceEvent{
+		Id:         uuid.NewString(),
+		InstanceId: instanceID,
+		Type:       typ,
+		ExitCode:   exitCode,
+		Message:    msg,
+		CreatedAt:  timestamppb.Now(),
+	}
+
+	return true
+}
+
+func (p *Platformd) CreateCheckpoint(
+	_ context.Context,
+	_ *checkpointv1alpha1.CreateCheckpointRequest,
+) (*checkpointv1alpha1.CreateCheckpointResponse, error) {
+	state := checkpointv1alpha1.CheckpointState_COMPLETED
+	if p.opts.failingCheckpoints {
+		state = checkpointv1alpha1.CheckpointState_CONTAINER_CHECKPOINT_FAILED
+	}
+
+	id := uuid.NewString()
+
+	p.mu.Lock()
+	p.checkpoints[id] = state
+	p.mu.Unlock()
+
+	return &checkpointv1alpha1.CreateCheckpointResponse{
+		CheckpointId: id,
+	}, nil
+}
+
+func (p *Platformd) CheckpointStatus(
+	_ context.Context,
+	req *checkpointv1alpha1.CheckpointStatusRequest,
+) (*checkpointv1alpha1.CheckpointStatusResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	state, ok := p.checkpoints[req.GetCheckpointId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "checkpoint not found")
+	}
+
+	var msg string
+	if state != checkpointv1alpha1.CheckpointState_COMPLETED {
+		msg = "checkpoint failed"
+	}
+
+	return &checkpointv1alpha1.CheckpointStatusResponse{
+		Status: &checkpointv1alpha1.CheckpointStatus{
+			State:   state,
+			Message: msg,
+		},
+	}, nil
+}
+
+func (p *Platformd) WorkloadStatus(
+	_ context.Context,
+	req *workloadv1alpha2.WorkloadStatusRequest,
+) (*workloadv1alpha2.WorkloadStatusResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	wl, ok := p.workloads[req.GetId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "workload not found")
+	}
+
+	state := workloadv1alpha2.WorkloadState_RUNNING
+	if wl.terminated != nil {
+		state = workloadv1alpha2.WorkloadState_DELETING
+	}
+
+	return &workloadv1alpha2.WorkloadStatusResponse{
+		Status: &workloadv1alpha2.WorkloadStatus{
+			State: state,
+			Port:  wl.port,
+		},
+	}, nil
+}
+
+func (p *Platformd) StopWorkload(
+	_ context.Context,
+	req *workloadv1alpha2.WorkloadStopRequest,
+) (*workloadv1alpha2.WorkloadStopResponse, error) {
+	if !p.Crash(req.GetId(), 0) {
+		return nil, status.Error(codes.NotFound, "workload not found")
+	}
+	return &workloadv1alpha2.WorkloadStopResponse{}, nil
+}
+
+func (p *Platformd) WorkloadMetadata(
+	_ context.Context,
+	req *workloadv1alpha2.WorkloadMetadataRequest,
+) (*workloadv1alpha2.WorkloadMetadataResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	wl, ok := p.workloads[req.GetWorkloadId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "workload not found")
+	}
+
+	return &workloadv1alpha2.WorkloadMetadataResponse{
+		Metadata: &workloadv1alpha2.WorkloadMetadata{
+			Id:            wl.instance.GetId(),
+			Chunk:         wl.instance.GetChunk(),
+			FlavorVersion: wl.instance.GetFlavorVersion(),
+			OrderedBy:     wl.instance.GetOrderedBy(),
+		},
+	}, nil
+}
+
+// sync mirrors what the platformd reconciler does on each tick: discover
+// instances, reconcile them against the running workloads and report the
+// resulting status back to the control plane.
+func (p *Platformd) sync(ctx context.Context) {
+	resp, err := p.insClient.DiscoverInstances(ctx, &instancev1alpha1.DiscoverInstanceRequest{
+		NodeKey: p.NodeID,
+	})
+	if err != nil {
+		p.opts.logger.ErrorContext(ctx, "discover instances failed", "err", err)
+		return
+	}
+
+	p.mu.Lock()
+	for _, ins := range resp.GetInstances() {
+		p.reconcile(ins)
+	}
+
+	reports := make([]*instancev1alpha1.InstanceStatusReport, 0, len(p.reports))
+	for _, r := range p.reports {
+		reports = append(reports, r)
+	}
+	p.mu.Unlock()
+
+	if _, err := p.insClient.ReceiveInstanceStatusReports(ctx, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+		Reports: reports,
+	}); err != nil {
+		p.opts.logger.ErrorContext(ctx, "sending status reports failed", "err", err)
+		return
+	}
+
+	// like platformd, only forget about reports once
+	// they have been received by the control plane.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, r := range reports {
+		if r.GetState() == instancev1alpha1.InstanceState_DELETED ||
+			r.GetState() == instancev1alpha1.InstanceState_CREATION_FAILED {
+			delete(p.reports, r.GetInstanceId())
+			continue
+		}
+		r.Events = nil
+	}
+}
+
+// reconcile has to be called with p.mu held.
+func (p *Platformd) reconcile(ins *instancev1alpha1.Instance) {
+	id := ins.GetId()
+
+	switch ins.GetState() {
+	case instancev1alpha1.InstanceState_PENDING, instancev1alpha1.InstanceState_CREATING:
+		if _, ok := p.workloads[id]; ok {
+			return
+		}
+
+		if p.opts.failingWorkloads {
+			p.report(id, instancev1alpha1.InstanceState_CREATION_FAILED, 0)
+			return
+		}
+
+		p.workloads[id] = &fakeWorkload{
+			instance: ins,
+			port:     p.nextPort,
+		}
+		p.report(id, instancev1alpha1.InstanceState_RUNNING, p.nextPort)
+		p.nextPort++
+	case instancev1alpha1.InstanceState_RUNNING:
+		wl, ok := p.workloads[id]
+		if ok && wl.terminated == nil {
+			return
+		}
+
+		if ok {
+			r := p.report(id, instancev1alpha1.InstanceState_DELETED, 0)
+			r.Events = append(r.Events, wl.terminated)
+		} else {
+			p.report(id, instancev1alpha1.InstanceState_DELETED, 0)
+		}
+
+		delete(p.workloads, id)
+	case instancev1alpha1.InstanceState_DELETING, instancev1alpha1.InstanceState_CREATION_FAILED:
+		delete(p.workloads, id)
+		p.report(id, instancev1alpha1.InstanceState_DELETED, 0)
+	default:
+		p.opts.logger.Debug("skipping instance", "instance_id", id, "state", ins.GetState())
+	}
+}
+
+// report has to be called with p.mu held.
+func (p *Platformd) report(
+	instanceID string,
+	state instancev1alpha1.InstanceState,
+	port uint32,
+) *instancev1alpha1.InstanceStatusReport {
+	r, ok := p.reports[instanceID]
+	if !ok {
+		r = &instancev1alpha1.InstanceStatusReport{
+			InstanceId: instanceID,
+		}
+		p.reports[instanceID] = r
+	}
+
+	r.State = state
+	if port != 0 {
+		r.Port = port
+	}
+
+	return r
+}
@@ -0,0 +1,111 @@
+/*
+ Explorer Platform, a platform for hosting and discovering Minecraft servers.
+ Copyright (C) 2024 Yannic Rieger <oss@76k.io>
+
+ This program is free software: you can redistribute it and/or modify
+ it under the terms of the GNU Affero General Public License as published by
+ the Free Software Foundation, either version 3 of the License, or
+ (at your option) any later version.
+
+ This program is distributed in the hope that it will be useful,
+ but WITHOUT ANY WARRANTY; without even the implied warranty of
+ MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ GNU Affero General Public License for more details.
+
+ You should have received a copy of the GNU Affero General Public License
+ along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package testkit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	instancev1alpha1 "github.com/spacechunks/explorer/api/instance/v1alpha1"
+	"github.com/spacechunks/explorer/internal/mock"
+	"github.com/spacechunks/explorer/test"
+	mocky "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestPlatformdSync(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		insClient = mock.NewMockV1alpha1InstanceServiceClient(t)
+		p         = newPlatformd(insClient)
+		insID     = test.NewUUIDv7(t)
+	)
+
+	syncOnce := func(state instancev1alpha1.InstanceState) []*instancev1alpha1.InstanceStatusReport {
+		insClient.EXPECT().
+			DiscoverInstances(mocky.Anything, &instancev1alpha1.DiscoverInstanceRequest{
+				NodeKey: p.NodeID,
+			}).
+			Return(&instancev1alpha1.DiscoverInstanceResponse{
+				Instances: []*instancev1alpha1.Instance{
+					{
+						Id:    insID,
+						State: state,
+					},
+				},
+			}, nil).
+			Once()
+
+		var reports []*instancev1alpha1.InstanceStatusReport
+		insClient.EXPECT().
+			ReceiveInstanceStatusReports(mocky.Anything, mocky.Anything).
+			Run(func(_ context.Context, req *instancev1alpha1.ReceiveInstanceStatusReportsRequest, _ ...grpc.CallOption) {
+				// reports are modified after sending them,
+				// so we have to copy them here.
+				for _, r := range req.GetReports() {
+					reports = append(reports, proto.Clone(r).(*instancev1alpha1.InstanceStatusReport))
+				}
+			}).
+			Return(&instancev1alpha1.ReceiveInstanceStatusReportsResponse{}, nil).
+			Once()
+
+		p.sync(ctx)
+		return reports
+	}
+
+	reports := syncOnce(instancev1alpha1.InstanceState_PENDING)
+	require.Empty(t, cmp.Diff([]*instancev1alpha1.InstanceStatusReport{
+		{
+			InstanceId: insID,
+			Port:       30000,
+			State:      instancev1alpha1.InstanceState_RUNNING,
+		},
+	}, reports, protocmp.Transform()))
+
+	require.Equal(t, []Workload{{InstanceID: insID, Port: 30000}}, p.Workloads())
+	require.True(t, p.OOMKill(insID))
+
+	reports = syncOnce(instancev1alpha1.InstanceState_RUNNING)
+	require.Len(t, reports, 1)
+	require.Equal(t, instancev1alpha1.InstanceState_DELETED, reports[0].GetState())
+	require.Len(t, reports[0].GetEvents(), 1)
+	require.Equal(t, instancev1alpha1.InstanceEventType_OOM_KILLED, reports[0].GetEvents()[0].GetType())
+
+	require.Empty(t, p.Workloads())
+
+	// deleted instances are only reported until the
+	// control plane has received the report once.
+	insClient.EXPECT().
+		DiscoverInstances(mocky.Anything, mocky.Anything).
+		Return(&instancev1alpha1.DiscoverInstanceResponse{}, nil).
+		Once()
+
+	insClient.EXPECT().
+		ReceiveInstanceStatusReports(mocky.Anything, &instancev1alpha1.ReceiveInstanceStatusReportsRequest{
+			Reports: []*instancev1alpha1.InstanceStatusReport{},
+		}).
+		Return(&instancev1alpha1.ReceiveInstanceStatusReportsResponse{}, nil).
+		Once()
+
+	p.sync(ctx)
+}